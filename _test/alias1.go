@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type A = int
+
+type T struct{ X int }
+
+func (t T) M() int { return t.X }
+
+type U = T
+
+func (u U) N() int { return u.X * 2 }
+
+type D = time.Duration
+
+type B = bytes.Buffer
+
+type Set[K comparable] = map[K]struct{}
+
+type Pair[K comparable, V any] = struct {
+	Key K
+	Val V
+}
+
+func main() {
+	var a A = 1
+	var i int = a
+	fmt.Println(a, i, reflect.TypeOf(a) == reflect.TypeOf(i))
+	var v interface{} = i
+	switch v.(type) {
+	case A:
+		fmt.Println("A")
+	}
+	u := U{3}
+	var t T = u
+	fmt.Println(u.M(), t.N(), T{4}.N())
+	var d D = time.Second
+	fmt.Println(d, d.Seconds())
+	var b B
+	b.WriteString("hello")
+	fmt.Println(b.String())
+	s := Set[string]{"a": {}}
+	var m map[string]struct{} = s
+	fmt.Println(len(m))
+	p := Pair[string, int]{"k", 1}
+	var q struct {
+		Key string
+		Val int
+	} = p
+	fmt.Println(q)
+	type L = []string
+	l := L{"x"}
+	var ls []string = l
+	fmt.Println(ls, len(l))
+	type S = struct{ X int }
+	var st struct{ X int } = S{2}
+	fmt.Println(st)
+}
+
+// Output:
+// 1 1 true
+// A
+// 3 6 8
+// 1s 1
+// hello
+// 1
+// {k 1}
+// [x] 1
+// {2}
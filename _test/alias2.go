@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+type V = W
+
+func (v *V) Inc() { v.N++ }
+
+type W struct{ N int }
+
+type List[T any] struct{ items []T }
+
+func (l *List[T]) Push(v T) { l.items = append(l.items, v) }
+
+type IntList = List[int]
+
+type E = error
+
+func main() {
+	v := &V{}
+	v.Inc()
+	var w *W = v
+	w.Inc()
+	fmt.Println(v.N, w.N)
+	var l IntList
+	l.Push(1)
+	var l2 *List[int] = &l
+	l2.Push(2)
+	fmt.Println(l.items)
+	var e E = errors.New("boom")
+	fmt.Println(e)
+	f := func() int {
+		type R = W
+		return R{N: 5}.N
+	}
+	fmt.Println(f())
+}
+
+// Output:
+// 2 2
+// [1 2]
+// boom
+// 5
@@ -0,0 +1,11 @@
+package main
+
+type Id[T any] = T
+
+func main() {
+	var x Id[int]
+	println(x)
+}
+
+// Error:
+// 3:18: cannot use type parameter declared in alias declaration as RHS
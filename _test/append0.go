@@ -0,0 +1,17 @@
+package main
+
+import "fmt"
+
+func main() {
+	a := make([]int, 3, 5)
+	b := append(a, 4)
+	b[0] = 9
+	fmt.Println(a, b, len(b), cap(b))
+	c := append(b, 5, 6)
+	c[1] = 8
+	fmt.Println(a, b, c, len(c))
+}
+
+// Output:
+// [9 0 0] [9 0 0 4] 4 5
+// [9 0 0] [9 0 0 4] [9 8 0 4 5 6] 6
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+type I interface{ M() string }
+
+type T struct{ n int }
+
+func (t T) M() string { return fmt.Sprint("T", t.n) }
+
+type U int
+
+type S []int
+
+func show(x interface{}) {
+	_, isInt := x.(int)
+	_, isU := x.(U)
+	_, isT := x.(T)
+	_, isPT := x.(*T)
+	_, isI := x.(I)
+	_, isErr := x.(error)
+	_, isS := x.(S)
+	_, isSl := x.([]int)
+	_, isStr := x.(fmt.Stringer)
+	_, isR := x.(io.Reader)
+	fmt.Println(isInt, isU, isT, isPT, isI, isErr, isS, isSl, isStr, isR)
+}
+
+func try(f func()) {
+	defer func() { fmt.Println("recovered:", recover()) }()
+	f()
+}
+
+func main() {
+	show(1)
+	show(U(1))
+	show(T{1})
+	show(&T{2})
+	show(S{1})
+	show([]int{1})
+	show(nil)
+	v := T{3}
+	var x interface{} = v
+	i, ok := x.(I)
+	fmt.Println(i.M(), ok)
+	t := x.(T)
+	fmt.Println(t.n)
+	try(func() { fmt.Println(x.(int)) })
+	try(func() { fmt.Println(x.(error)) })
+	var y interface{}
+	try(func() { fmt.Println(y.(int)) })
+	var z interface{} = "s"
+	try(func() { fmt.Println(z.(U)) })
+	u, ok := z.(U)
+	fmt.Println(u, ok)
+}
+
+// Output:
+// true false false false false false false false false false
+// false true false false false false false false false false
+// false false true false true false false false false false
+// false false false true true false false false false false
+// false false false false false false true false false false
+// false false false false false false false true false false
+// false false false false false false false false false false
+// T3 true
+// 3
+// recovered: interface conversion: interface {} is main.T, not int
+// recovered: interface conversion: main.T is not error: missing method Error
+// recovered: interface conversion: interface {} is nil, not int
+// recovered: interface conversion: interface {} is string, not main.U
+// 0 false
@@ -0,0 +1,12 @@
+package main
+
+import "fmt"
+
+func main() {
+	a, b := 1.0, 2.0
+	a, b = a*a-b*b, 2*a*b
+	fmt.Println(a, b)
+}
+
+// Output:
+// -3 4
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+type T struct{ X int }
+
+func f(x int) int { return x * 10 }
+
+var g1, g2 = []int{1}, T{2}
+
+func main() {
+	a, size := 1, 16
+	m, b := a+size, min(a, 3)
+	fmt.Println(m, b)
+	c, d := a+size, math.Abs(-2)
+	fmt.Println(c, d)
+	a, b = f(b), f(a)
+	fmt.Println(a, b)
+	s, t := []int{1}, T{2}
+	ch := make(chan int, 1)
+	ch <- 3
+	e, l := <-ch, len(s)
+	fmt.Println(s, t, e, l, g1, g2)
+}
+
+// Output:
+// 17 1
+// 17 2
+// 10 10
+// [1] {2} 3 1 [1] {2}
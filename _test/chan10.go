@@ -0,0 +1,30 @@
+package main
+
+import "fmt"
+
+func try(name string, f func()) {
+	defer func() { fmt.Println(name+":", recover()) }()
+	f()
+}
+
+func main() {
+	try("close nil", func() {
+		var c chan int
+		close(c)
+	})
+	try("close closed", func() {
+		c := make(chan int)
+		close(c)
+		close(c)
+	})
+	try("send closed", func() {
+		c := make(chan int, 1)
+		close(c)
+		c <- 1
+	})
+}
+
+// Output:
+// close nil: close of nil channel
+// close closed: close of closed channel
+// send closed: send on closed channel
@@ -0,0 +1,9 @@
+package main
+
+func main() {
+	var r <-chan int = make(chan int)
+	r <- 1
+}
+
+// Error:
+// 5:2: invalid operation: cannot send to receive-only channel
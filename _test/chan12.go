@@ -0,0 +1,9 @@
+package main
+
+func main() {
+	var w chan<- int = make(chan int)
+	println(<-w)
+}
+
+// Error:
+// 5:12: invalid operation: cannot receive from send-only channel
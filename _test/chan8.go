@@ -0,0 +1,37 @@
+package main
+
+import "fmt"
+
+func main() {
+	var nc chan int
+	select {
+	case v := <-nc:
+		fmt.Println("received", v)
+	case nc <- 1:
+		fmt.Println("sent")
+	default:
+		fmt.Println("nil channel blocks")
+	}
+
+	c := make(chan int, 2)
+	c <- 1
+	close(c)
+	v, ok := <-c
+	fmt.Println(v, ok)
+	v, ok = <-c
+	fmt.Println(v, ok)
+	fmt.Println(<-c + 1)
+
+	n := 0
+	for range c {
+		n++
+	}
+	fmt.Println(n)
+}
+
+// Output:
+// nil channel blocks
+// 1 true
+// 0 false
+// 1
+// 0
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func produce(c chan<- int, n int) {
+	for i := 0; i < n; i++ {
+		c <- i
+	}
+	close(c)
+}
+
+func generate(n int) <-chan int {
+	c := make(chan int)
+	go produce(c, n)
+	return c
+}
+
+func main() {
+	s := 0
+	for v := range generate(4) {
+		s += v
+	}
+	fmt.Println(s)
+
+	c := make(chan int, 1)
+	var r <-chan int = c
+	var w chan<- int = c
+	w <- 2
+	fmt.Printf("%T %T %T %d\n", r, w, (<-chan int)(c), <-r)
+
+	var t <-chan time.Time = time.After(time.Millisecond)
+	<-t
+	fmt.Printf("%T\n", t)
+}
+
+// Output:
+// 6
+// <-chan int chan<- int <-chan int 2
+// <-chan time.Time
@@ -0,0 +1,29 @@
+package main
+
+import "fmt"
+
+func main() {
+	m := map[string]int{"a": 1, "b": 2}
+	n := m
+	clear(m)
+	fmt.Println(len(n), n)
+
+	s := []int{1, 2, 3}
+	clear(s[1:])
+	fmt.Println(s)
+
+	x, y := 1, 2
+	ps := []*int{&x, &y}
+	clear(ps)
+	fmt.Println(ps)
+
+	var nm map[int]bool
+	clear(nm)
+	fmt.Println(nm == nil)
+}
+
+// Output:
+// 0 map[]
+// [1 0 0]
+// [<nil> <nil>]
+// true
@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+func main() {
+	var fs []func() int
+	var ps []*int
+	for i := 0; i < 3; i++ {
+		fs = append(fs, func() int { return i })
+		ps = append(ps, &i)
+	}
+	for _, s := range []string{"a", "bc"} {
+		fs = append(fs, func() int { return len(s) })
+	}
+	for _, f := range fs {
+		fmt.Print(f(), " ")
+	}
+	fmt.Println(*ps[0], *ps[1], *ps[2])
+}
+
+// Output:
+// 0 1 2 1 2 0 1 2
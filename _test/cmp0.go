@@ -0,0 +1,19 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+)
+
+type S string
+
+func main() {
+	nan := math.NaN()
+	fmt.Println(cmp.Compare(1, 2), cmp.Compare(S("b"), S("a")), cmp.Compare(nan, 1.0), cmp.Compare(nan, nan))
+	fmt.Println(cmp.Less(nan, 1.0), cmp.Less(2, 1), cmp.Or("", "x", "y"), cmp.Or(0, 0))
+}
+
+// Output:
+// -1 1 -1 0
+// true false x 0
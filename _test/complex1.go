@@ -0,0 +1,32 @@
+package main
+
+import "fmt"
+
+type F float64
+
+type C complex128
+
+type C64 complex64
+
+func main() {
+	var a, b F = 1.5, 2
+	c := complex(a, b)
+	fmt.Printf("%T %v\n", c, c)
+
+	var d C = 3 + 4i
+	fmt.Printf("%T %v %T %v\n", real(d), real(d), imag(d), imag(d))
+
+	var e C64 = 1 + 1i
+	fmt.Printf("%T %v %v\n", real(e), imag(e), e*e)
+
+	var h complex64 = complex(float32(1), 2)
+	fmt.Printf("%T %v\n", h, h)
+	fmt.Println(d == 3+4i, d != C(1))
+}
+
+// Output:
+// complex128 (1.5+2i)
+// float64 3 float64 4
+// float32 1 (0+2i)
+// complex64 (1+2i)
+// true true
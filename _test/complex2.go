@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+)
+
+const (
+	z   = complex(1, 2)
+	big = 1 << 100
+)
+
+func square(c complex128) complex128 { return c * c }
+
+func main() {
+	fmt.Println(z, real(z), imag(z), real(big*1i/big))
+	fmt.Println(cmplx.Abs(3+4i), cmplx.Abs(4i+3), cmplx.Sqrt(-1), square(1+2i))
+	fmt.Println(cmplx.Exp(1i * math.Pi))
+	fmt.Println(1e300i * 1e300i / 1e300)
+	fmt.Println(complex(1e400/1e300, 1), real(1e400*1i*1i/1e300))
+}
+
+// Output:
+// (1+2i) 1 2 0
+// 5 5 (0+1i) (-3+4i)
+// (-1+1.2246467991473515e-16i)
+// (-1e+300+0i)
+// (1e+100+1i) -1e+100
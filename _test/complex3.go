@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"math/cmplx"
+)
+
+type C complex128
+
+func (c C) Abs() float64 { return cmplx.Abs(complex128(c)) }
+
+type P struct {
+	Z C
+	W complex64
+}
+
+func main() {
+	x := 1 + 2i
+	x += 1
+	x *= 2i
+	x++
+	fmt.Println(x, -x, x == complex(-3, 4))
+
+	x = 2
+	s := []complex128{1, 2i, x}
+	m := map[complex64]string{1i: "i"}
+	fmt.Println(s, m[1i], len(m))
+
+	p := P{Z: 3 + 4i, W: 1}
+	fmt.Println(p.Z.Abs(), p, real(p.Z)+imag(p.Z))
+
+	var zero complex128
+	fmt.Println(1/zero, cmplx.IsInf(1/zero))
+
+	var y complex64 = complex64(x)
+	fmt.Println(y, complex128(y) == x)
+}
+
+// Output:
+// (-3+4i) (3-4i) true
+// [(1+0i) (0+2i) (2+0i)] i 1
+// 5 {(3+4i) (1+0i)} 7
+// (+Inf+NaNi) true
+// (2+0i) true
@@ -0,0 +1,32 @@
+package main
+
+import "fmt"
+
+const big = 1 << 100
+
+const (
+	KB = 1 << (10 * (iota + 1))
+	MB
+	GB
+	TB
+	PB
+	EB
+	ZB
+	YB
+)
+
+func main() {
+	const f = 0.1
+	fmt.Println(big>>98, f+0.2 == 0.3, YB/ZB, float64(YB))
+	var x float64 = big
+	fmt.Println(x, x*2 > big)
+	fmt.Println(1e400/1e390, 7/2, 7/2.0)
+	var i int = 2.0
+	fmt.Println(i, uint64(1<<64-1), 1<<62*4/8)
+}
+
+// Output:
+// 4 true 1024 1.2089258196146292e+24
+// 1.2676506002282294e+30 true
+// 1e+10 3 3.5
+// 2 18446744073709551615 2305843009213693952
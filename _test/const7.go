@@ -0,0 +1,23 @@
+package main
+
+const debug = false
+
+func main() {
+	if debug {
+		println("debug")
+	} else {
+		println("nodebug")
+	}
+	if !debug && 1 < 2 {
+		println("not")
+	}
+	for i := 0; debug; i++ {
+		println("loop")
+	}
+	println("end")
+}
+
+// Output:
+// nodebug
+// not
+// end
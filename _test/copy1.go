@@ -0,0 +1,16 @@
+package main
+
+import "fmt"
+
+func main() {
+	a := []int{1, 2, 3, 4, 5}
+	n := copy(a[1:], a)
+	fmt.Println(n, a)
+	b := []int{1, 2, 3, 4, 5}
+	n = copy(b, b[2:])
+	fmt.Println(n, b)
+}
+
+// Output:
+// 4 [1 1 2 3 4]
+// 3 [3 4 5 4 5]
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func double() (r int) {
+	defer func() { r *= 2 }()
+	return 3
+}
+
+func local() int {
+	x := 1
+	defer func() { x = 5 }()
+	return x
+}
+
+func bare() (x int) {
+	defer func() { x = 7 }()
+	x = 1
+	return
+}
+
+func wrap() (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.New("wrapped " + err.Error())
+		}
+	}()
+	return errors.New("e")
+}
+
+func main() {
+	fmt.Println(double())
+	fmt.Println(local())
+	fmt.Println(bare())
+	fmt.Println(wrap())
+}
+
+// Output:
+// 6
+// 1
+// 7
+// wrapped e
@@ -0,0 +1,37 @@
+package main
+
+import "fmt"
+
+func args() (s string) {
+	for i := 0; i < 3; i++ {
+		defer func(i int) { s += fmt.Sprint(i) }(i)
+	}
+	return "a"
+}
+
+func closures() (s string) {
+	for i := 0; i < 3; i++ {
+		j := i
+		defer func() { s += fmt.Sprint(j) }()
+	}
+	return "b"
+}
+
+func calls() {
+	for _, v := range []string{"x", "y", "z"} {
+		defer fmt.Println("deferred", v)
+	}
+}
+
+func main() {
+	fmt.Println(args())
+	fmt.Println(closures())
+	calls()
+}
+
+// Output:
+// a210
+// b210
+// deferred z
+// deferred y
+// deferred x
@@ -0,0 +1,45 @@
+package main
+
+import "fmt"
+
+type T struct{ n int }
+
+func (t *T) Show() { fmt.Println("show", t == nil) }
+
+func (t *T) Get() { fmt.Println("get", t.n) }
+
+func (t T) Value() { fmt.Println("value", t.n) }
+
+func nilShow() {
+	var t *T
+	defer t.Show()
+	fmt.Println("nilShow")
+}
+
+func nilGet() {
+	defer func() { fmt.Println("recovered:", recover() != nil) }()
+	var t *T
+	defer t.Get()
+	fmt.Println("nilGet")
+}
+
+func receiver() {
+	t := T{1}
+	defer t.Value()
+	defer t.Get()
+	t.n = 2
+}
+
+func main() {
+	nilShow()
+	nilGet()
+	receiver()
+}
+
+// Output:
+// nilShow
+// show true
+// nilGet
+// recovered: true
+// get 2
+// value 1
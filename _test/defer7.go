@@ -0,0 +1,23 @@
+package main
+
+import "fmt"
+
+func div(a, b int) (q int, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return a / b, true
+}
+
+func main() {
+	q, ok := div(6, 2)
+	fmt.Println(q, ok)
+	q, ok = div(6, 0)
+	fmt.Println(q, ok)
+}
+
+// Output:
+// 3 true
+// 0 false
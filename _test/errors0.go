@@ -0,0 +1,38 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+type E struct{ Code int }
+
+func (e *E) Error() string { return fmt.Sprint("code ", e.Code) }
+
+type F struct{}
+
+func (F) Error() string { return "F" }
+
+func main() {
+	err := fmt.Errorf("wrap: %w", &E{3})
+	var target *E
+	fmt.Println(errors.As(err, &target), target.Code)
+	var f F
+	fmt.Println(errors.As(err, &f))
+	fmt.Println(errors.As(fmt.Errorf("a: %w", F{}), &f), f)
+	var e error = &E{4}
+	fmt.Println(errors.As(e, &target), target.Code, target)
+	j := errors.Join(errors.New("x"), fmt.Errorf("y: %w", &E{5}))
+	fmt.Println(errors.As(j, &target), target.Code)
+	var pe *fs.PathError
+	fmt.Println(errors.As(fmt.Errorf("z: %w", &fs.PathError{Op: "open"}), &pe), pe.Op)
+}
+
+// Output:
+// true 3
+// false
+// true F
+// true 4 code 4
+// true 5
+// true open
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+)
+
+func main() {
+	fs := flag.NewFlagSet("flag1", flag.ContinueOnError)
+	n := fs.Int("n", 1, "count")
+	v := fs.Bool("v", false, "verbose")
+	fs.Parse([]string{"-n", "3", "-v", "a"})
+	fmt.Println(*n, *v, fs.Args())
+	if *v {
+		*n = *n * 2
+	}
+	fmt.Println(*n)
+}
+
+// Output:
+// 3 true [a]
+// 6
@@ -0,0 +1,39 @@
+package main
+
+import "fmt"
+
+type T struct{ a, b int }
+
+func (t *T) sum() int { return t.a + t.b }
+
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+func swap(t T) T { return T{t.b, t.a} }
+
+func ptr(n int) *int {
+	x := n
+	return &x
+}
+
+func apply(f func(int) int, n int) int { return f(n) }
+
+func send(c chan int, n int) { c <- fib(n) }
+
+func main() {
+	p, q := ptr(1), ptr(2)
+	t := T{3, 4}
+	for k := 0; k < 3; k++ {
+		t = swap(t)
+	}
+	c := make(chan int)
+	go send(c, 10)
+	fmt.Println(fib(20), *p, *q, t, t.sum(), apply(fib, 15), <-c)
+}
+
+// Output:
+// 6765 1 2 {4 3} 7 610 55
@@ -0,0 +1,37 @@
+package main
+
+import "fmt"
+
+type T struct{ s string }
+
+func (t T) Hello(name string) string { return t.s + " " + name }
+
+func bye(name string) string { return "bye " + name }
+
+func main() {
+	t := T{"hello"}
+
+	fs := []func(string) string{t.Hello, bye}
+	fs = append(fs, T{"hi"}.Hello)
+	for _, f := range fs {
+		fmt.Println(f("world"))
+	}
+	fmt.Println(fs[1]("you"))
+
+	m := map[string]func(string) string{"hello": t.Hello}
+	m["bye"] = bye
+	fmt.Println(m["hello"]("map"), m["bye"]("map"))
+
+	c := make(chan func(string) string, 1)
+	c <- t.Hello
+	g := <-c
+	fmt.Println(g("chan"))
+}
+
+// Output:
+// hello world
+// bye world
+// hi world
+// bye you
+// hello map bye map
+// hello chan
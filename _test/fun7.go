@@ -0,0 +1,12 @@
+package main
+
+func f() int { return 1 }
+
+func f() int { return 2 }
+
+func main() {
+	println(f())
+}
+
+// Error:
+// 5:6: f redeclared in this block
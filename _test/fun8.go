@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+var x = 5
+
+func inc() int { return x + 1 }
+
+func named() (r int) {
+	r = 1
+	return x + r
+}
+
+func main() {
+	x = inc()
+	fmt.Println(x)
+	x = named()
+	fmt.Println(x)
+	y := 3
+	double := func() int { return y * 2 }
+	func() { y = double() }()
+	fmt.Println(y)
+}
+
+// Output:
+// 6
+// 7
+// 6
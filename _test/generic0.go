@@ -0,0 +1,37 @@
+package main
+
+import "fmt"
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+func Sum[T Number](s []T) T {
+	var t T
+	for _, v := range s {
+		t += v
+	}
+	return t
+}
+
+func Map[T, U any](s []T, f func(T) U) []U {
+	r := []U{}
+	for _, v := range s {
+		r = append(r, f(v))
+	}
+	return r
+}
+
+func main() {
+	fmt.Println(Sum([]int{1, 2, 3}))
+	fmt.Println(Sum([]float64{1.5, 2.5}))
+	fmt.Println(Sum[int64]([]int64{4, 5}))
+	s := Map([]int{1, 2}, func(i int) string { return "x" })
+	fmt.Println(len(s), s[1])
+}
+
+// Output:
+// 6
+// 4
+// 9
+// 2 x
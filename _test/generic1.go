@@ -0,0 +1,23 @@
+package main
+
+import "fmt"
+
+type Pair[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+func (p Pair[K, V]) String() string { return fmt.Sprint(p.Key, "=", p.Val) }
+
+func NewPair[K comparable, V any](k K, v V) Pair[K, V] { return Pair[K, V]{k, v} }
+
+func main() {
+	p := Pair[string, int]{"a", 1}
+	fmt.Println(p.String())
+	q := NewPair("b", 2.5)
+	fmt.Println(q.Key, q.Val)
+}
+
+// Output:
+// a=1
+// b 2.5
@@ -0,0 +1,45 @@
+package main
+
+import "fmt"
+
+type Stack[T any] struct {
+	items []T
+}
+
+func (s Stack[T]) Len() int { return len(s.items) }
+
+func (s Stack[T]) Top() T { return s.items[len(s.items)-1] }
+
+func Push[T any](s Stack[T], v T) Stack[T] {
+	s.items = append(s.items, v)
+	return s
+}
+
+func Max[T int | float64 | string](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func Fact[T int | int64](n T) T {
+	if n <= 1 {
+		return 1
+	}
+	return n * Fact(n-1)
+}
+
+func main() {
+	s := Stack[string]{}
+	s = Push(s, "a")
+	s = Push(s, "b")
+	fmt.Println(s.Len(), s.Top())
+	m := Max[string]
+	fmt.Println(m("x", "y"), Max(3, 2))
+	fmt.Println(Fact(5))
+}
+
+// Output:
+// 2 b
+// y 3
+// 120
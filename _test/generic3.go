@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+func (l List[T]) Len() int { return len(l) }
+
+type List[T any] []T
+
+type Node[T any] struct {
+	val  T
+	next *Node[T]
+}
+
+func Keys[K comparable, V any](m map[K]V) []K {
+	var r []K
+	for k, _ := range m {
+		r = append(r, k)
+	}
+	return r
+}
+
+func Filter[T any](s []T, keep func(T) bool) []T {
+	var r []T
+	for _, v := range s {
+		if keep(v) {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
+func main() {
+	k := Keys(map[string]int{"b": 1, "a": 2})
+	sort.Strings(k)
+	fmt.Println(k)
+	l := List[int]{1, 2, 3}
+	fmt.Println(l.Len())
+	n := Node[int]{val: 1}
+	fmt.Println(n.val, n.next == nil)
+	fmt.Println(Filter([]int{1, 2, 3, 4}, func(i int) bool { return i > 2 }))
+}
+
+// Output:
+// [a b]
+// 3
+// 1 true
+// [3 4]
@@ -0,0 +1,33 @@
+package main
+
+import "fmt"
+
+type Ints []int
+
+func Index[S ~[]E, E comparable](s S, v E) int {
+	for i := range s {
+		if v == s[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+func Contains[S ~[]E, E comparable](s S, v E) bool { return Index(s, v) >= 0 }
+
+func Sum[S ~[]E, E int | string](x S) E {
+	var e E
+	for _, v := range x {
+		e += v
+	}
+	return e
+}
+
+func main() {
+	fmt.Println(Contains(Ints{1, 2}, 2), Index([]string{"a"}, "b"))
+	fmt.Println(Sum(Ints{1, 2}), Sum([]string{"a", "b"}))
+}
+
+// Output:
+// true -1
+// 3 ab
@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+type Pair[K, V comparable] struct {
+	K K
+	V V
+}
+
+func (p Pair[K, V]) Swap() Pair[V, K] { return Pair[V, K]{K: p.V, V: p.K} }
+
+func main() {
+	p := Pair[string, int]{"a", 1}
+	fmt.Println(p, p.K, p.V)
+	fmt.Println(p.Swap())
+}
+
+// Output:
+// {a 1} a 1
+// {1 a}
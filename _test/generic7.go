@@ -0,0 +1,13 @@
+package main
+
+type Bytes []byte
+
+func Len[S ~[]byte](s S) int { return len(s) }
+
+func main() {
+	println(Len(Bytes("ab")))
+	println(Len([]string{"a"}))
+}
+
+// Error:
+// 9:10: []string does not satisfy ~[]byte
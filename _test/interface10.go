@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+type Conn struct {
+	in  string
+	out []byte
+}
+
+func (c *Conn) Read(p []byte) (int, error) {
+	if c.in == "" {
+		return 0, io.EOF
+	}
+	n := copy(p, c.in)
+	c.in = c.in[n:]
+	return n, nil
+}
+
+func (c *Conn) Write(p []byte) (int, error) {
+	c.out = append(c.out, p...)
+	return len(p), nil
+}
+
+func (c *Conn) Close() error { return nil }
+
+type ReadWriter interface {
+	io.Reader
+	io.WriteCloser
+}
+
+func echo(rwc io.ReadWriteCloser) {
+	io.Copy(rwc, rwc)
+	rwc.Close()
+}
+
+func main() {
+	c := &Conn{in: "hello"}
+	echo(c)
+	var rw ReadWriter = &Conn{in: "world"}
+	rw.Write([]byte("> "))
+	io.Copy(rw, rw)
+	fmt.Fprint(rw, "!")
+	fmt.Println(string(c.out), string(rw.(*Conn).out))
+}
+
+// Output:
+// hello > world!
@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+type T struct{ x int }
+
+func main() {
+	var a, b interface{}
+	fmt.Println(a == b, a == nil)
+	a, b = 1, 1
+	fmt.Println(a == b)
+	a, b = 1, "1"
+	fmt.Println(a == b)
+	a, b = T{1}, T{1}
+	fmt.Println(a == b)
+	a = T{2}
+	fmt.Println(a == b, a != b)
+	var i interface{} = 3
+	fmt.Println(i == 3, i == 4, 3 == i)
+}
+
+// Output:
+// true true
+// true
+// false
+// true
+// false true
+// true false true
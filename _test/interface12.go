@@ -0,0 +1,31 @@
+package main
+
+import "fmt"
+
+type I interface{ M() int }
+
+type T struct{ x int }
+
+func (t T) M() int { return t.x }
+
+type P struct{ x int }
+
+func (p *P) M() int { return p.x }
+
+func main() {
+	var i, j I = T{1}, T{1}
+	fmt.Println(i == j, i != j)
+	t := T{1}
+	fmt.Println(i == t, t == i)
+	var e interface{} = t
+	fmt.Println(e == i, i == e)
+	p := &P{1}
+	var k I = p
+	fmt.Println(k == p, k == I(&P{1}), nil == k)
+}
+
+// Output:
+// true false
+// true true
+// true true
+// true false false
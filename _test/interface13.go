@@ -0,0 +1,31 @@
+package main
+
+import "fmt"
+
+type P struct{ s string }
+
+func (p *P) Error() string {
+	if p == nil {
+		return "nil P"
+	}
+	return p.s
+}
+
+func get() error {
+	var p *P
+	return p
+}
+
+func main() {
+	err := get()
+	fmt.Println(err == nil, err)
+	var e error = (*P)(nil)
+	fmt.Println(e == nil, e)
+	e = nil
+	fmt.Println(e == nil)
+}
+
+// Output:
+// false nil P
+// false nil P
+// true
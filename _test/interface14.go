@@ -0,0 +1,19 @@
+package main
+
+import "fmt"
+
+type S []int
+
+func main() {
+	defer func() {
+		fmt.Println("recovered:", recover())
+	}()
+	var a, b interface{} = map[int]int{}, 1
+	fmt.Println(a == b)
+	a, b = S{1}, S{1}
+	fmt.Println(a == b)
+}
+
+// Output:
+// false
+// recovered: runtime error: comparing uncomparable type main.S
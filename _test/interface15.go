@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Stringer interface{ String() string }
+
+type W interface {
+	Write([]byte) (int, error)
+	Len() int
+}
+
+type B struct{ strings.Builder }
+
+func str(s Stringer) string { return s.String() }
+
+func fill(w W, parts ...string) int {
+	for _, p := range parts {
+		w.Write([]byte(p))
+	}
+	return w.Len()
+}
+
+func main() {
+	fmt.Println(str(3 * time.Second))
+	var s Stringer = &bytes.Buffer{}
+	fmt.Println(str(s) == "")
+	var buf bytes.Buffer
+	fmt.Println(fill(&buf, "ab", "cd"), buf.String())
+	b := &B{}
+	b.WriteString("xy")
+	fmt.Println(str(b))
+	var w W = &buf
+	if w.Len() > 3 {
+		fmt.Println("long")
+	}
+	w.Write([]byte("!"))
+	fmt.Println(buf.Len())
+}
+
+// Output:
+// 3s
+// true
+// 4 abcd
+// xy
+// long
+// 5
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+)
+
+func count(n int) iter.Seq[int] {
+	if n < 0 {
+		return nil
+	}
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+var s iter.Seq[int]
+
+func main() {
+	fmt.Println(s == nil, count(-1) == nil)
+	s = count(3)
+	for i := range s {
+		fmt.Println(i)
+	}
+	p := iter.Seq2[string, int](func(yield func(string, int) bool) {
+		yield("a", 1)
+	})
+	for k, v := range p {
+		fmt.Println(k, v)
+	}
+}
+
+// Output:
+// true true
+// 0
+// 1
+// 2
+// a 1
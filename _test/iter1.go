@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+func main() {
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{3, 1, 2} {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	fmt.Println(slices.Sorted(seq), slices.Collect(seq))
+	m := maps.Collect(func(yield func(string, int) bool) {
+		_ = yield("a", 1) && yield("b", 2)
+	})
+	fmt.Println(m)
+}
+
+// Output:
+// [1 2 3] [3 1 2]
+// map[a:1 b:2]
@@ -0,0 +1,12 @@
+package main
+
+import "iter"
+
+var s iter.Seq
+
+func main() {
+	println(s == nil)
+}
+
+// Error:
+// 5:7: cannot use generic type Seq without instantiation
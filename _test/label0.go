@@ -0,0 +1,63 @@
+package main
+
+import "fmt"
+
+func main() {
+	m := map[int]int{1: 1, 2: 2, 3: 3}
+	n := 0
+outer:
+	for k, v := range m {
+		for i := 0; i < 3; i++ {
+			if i == 1 {
+				continue outer
+			}
+			n += k + v
+		}
+	}
+	fmt.Println(n)
+	c := make(chan int, 5)
+	for i := 0; i < 5; i++ {
+		c <- i
+	}
+	close(c)
+	s := 0
+loop:
+	for v := range c {
+		for {
+			if v == 3 {
+				break loop
+			}
+			if v%2 == 0 {
+				s += v
+				continue loop
+			}
+			break
+		}
+	}
+	fmt.Println(s)
+	t := 0
+sel:
+	for i := 0; i < 3; i++ {
+		switch {
+		case i == 1:
+			continue sel
+		case i == 2:
+			break sel
+		}
+		t += 10
+	}
+	fmt.Println(t)
+	i := 0
+again:
+	if i < 3 {
+		i++
+		goto again
+	}
+	fmt.Println(i)
+}
+
+// Output:
+// 12
+// 2
+// 10
+// 3
@@ -0,0 +1,113 @@
+package main
+
+import "fmt"
+
+func main() {
+	c := make(chan int)
+	go func() {
+		for i := 0; i < 5; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	n := 0
+loop:
+	for {
+		select {
+		case v, ok := <-c:
+			if !ok {
+				break loop
+			}
+			if v == 1 {
+				continue loop
+			}
+			n += v
+		}
+	}
+	fmt.Println("select", n)
+
+	// A label can have the name of a variable
+	x := 0
+x:
+	for x < 3 {
+		x++
+		continue x
+	}
+	fmt.Println("x", x)
+
+	// Forward goto out of a block, over a declaration in a nested block
+	i := 0
+	{
+		if i == 0 {
+			goto end
+		}
+		{
+			y := 1
+			i = y
+		}
+	}
+end:
+	fmt.Println("end", i)
+
+	// Labels of closures are separate
+	f := func() int {
+		s := 0
+	L:
+		for j := 0; j < 10; j++ {
+			if j == 4 {
+				break L
+			}
+			s += j
+		}
+		return s
+	}
+	fmt.Println("closure", f())
+
+	// Labeled statement at end of block
+	for k := 0; k < 3; k++ {
+		if k == 1 {
+			goto next
+		}
+		fmt.Println("k", k)
+	next:
+	}
+
+	m := map[string][]int{"a": {1, 2, 3}, "b": {4, 5, 6}}
+	t := 0
+keys:
+	for _, v := range m {
+		for _, e := range v {
+			if e%3 == 2 {
+				continue keys
+			}
+			t += e
+		}
+	}
+	fmt.Println("t", t)
+
+	// Labeled break of a switch
+	r := 0
+sw:
+	switch {
+	case r == 0:
+		for {
+			break sw
+		}
+		r = 10
+	}
+	fmt.Println("r", r)
+	var s string
+	s = "done"
+	fmt.Println(s)
+}
+
+// Output:
+// select 9
+// x 3
+// end 0
+// closure 6
+// k 0
+// k 2
+// t 5
+// r 0
+// done
@@ -0,0 +1,34 @@
+package main
+
+func main() {
+	n := 0
+	for {
+		for i := 0; i < 2; i++ {
+			n++
+		}
+		break
+	}
+	println(n)
+	for i := 0; i < 5; i++ {
+		switch i {
+		case 1:
+			n += 10
+		}
+		if i == 2 {
+			continue
+		}
+		if i == 3 {
+			break
+		}
+		n += 100
+	}
+	println(n)
+	for {
+		f := func() {}
+		f()
+		break
+	}
+	println("ok")
+}
+
+// Output:
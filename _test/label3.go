@@ -0,0 +1,12 @@
+package main
+
+func main() {
+	goto L
+	x := 1
+	_ = x
+L:
+	println("x")
+}
+
+// Error:
+// 4:7: goto L jumps over declaration of x
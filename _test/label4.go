@@ -0,0 +1,12 @@
+package main
+
+func main() {
+	goto L
+	{
+	L:
+		println("x")
+	}
+}
+
+// Error:
+// 4:7: goto L jumps into block starting at
@@ -0,0 +1,10 @@
+package main
+
+func main() {
+	for {
+		break L
+	}
+}
+
+// Error:
+// 5:9: break label not defined: L
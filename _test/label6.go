@@ -0,0 +1,12 @@
+package main
+
+func main() {
+L:
+	println("x")
+	for {
+		continue L
+	}
+}
+
+// Error:
+// 7:12: invalid continue label L
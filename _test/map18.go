@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+func two() (int, int) { return 1, 2 }
+
+func main() {
+	m := map[string]int{}
+	m["a"]++
+	m["a"]++
+	m["b"]--
+	m["c"] += 5
+	m["c"] *= 2
+	m["d"] = len("abc")
+	m["e"], m["f"] = two()
+	fmt.Println(m)
+}
+
+// Output:
+// map[a:2 b:-1 c:10 d:3 e:1 f:2]
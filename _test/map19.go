@@ -0,0 +1,14 @@
+package main
+
+import "fmt"
+
+func main() {
+	ms := map[string][]int{}
+	ms["a"] = append(ms["a"], 1)
+	ms["a"] = append(ms["a"], 2)
+	ms["b"] = []int{3}
+	fmt.Println(ms)
+}
+
+// Output:
+// map[a:[1 2] b:[3]]
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+type M map[string]int
+
+func main() {
+	m := M{"a": 1, "b": 2}
+	c := maps.Clone(m)
+	c["c"] = 3
+	fmt.Println(maps.Equal(m, c), len(c), m)
+	maps.DeleteFunc(c, func(k string, v int) bool { return v > 2 })
+	fmt.Println(maps.Equal(m, c), maps.EqualFunc(m, map[string]string{"a": "1", "b": "2"}, func(v int, s string) bool { return fmt.Sprint(v) == s }))
+	d := map[string]int{"z": 26}
+	maps.Copy(d, m)
+	fmt.Println(d)
+	fmt.Println(slices.Sorted(maps.Keys(d)), slices.Sorted(maps.Values(d)))
+	e := maps.Collect(maps.All(d))
+	maps.Insert(e, maps.All(map[string]int{"y": 25}))
+	fmt.Println(e, strings.Join(slices.Sorted(maps.Keys(e)), ","))
+}
+
+// Output:
+// false 3 map[a:1 b:2]
+// true true
+// map[a:1 b:2 z:26]
+// [a b z] [1 2 26]
+// map[a:1 b:2 y:25 z:26] a,b,y,z
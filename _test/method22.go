@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type T struct{ n int }
+
+func (t T) Get() int { return t.n }
+
+func (t *T) Add(d int) int { t.n += d; return t.n }
+
+func (t T) Repeat(s string) string { return strings.Repeat(s, t.n) }
+
+func main() {
+	t := &T{2}
+
+	var f func(string) string = t.Repeat
+	fmt.Println(strings.Map(func(r rune) rune { return r + 1 }, f("a")))
+
+	add := t.Add
+	fmt.Println(add(1), t.n)
+
+	s := []T{T{3}, T{1}, T{2}}
+	get := T.Get
+	sort.Slice(s, func(i, j int) bool { return get(s[i]) < get(s[j]) })
+	fmt.Println(s)
+
+	inc := (*T).Add
+	fmt.Println(inc(t, 2), t.n)
+}
+
+// Output:
+// bb
+// 3 3
+// [{1} {2} {3}]
+// 5 5
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+type T struct{ n int }
+
+func (t T) Get() int { return t.n }
+
+type H struct{ msg string }
+
+func (h *H) Serve(w http.ResponseWriter, r *http.Request) { fmt.Fprint(w, h.msg) }
+
+type E struct {
+	*T
+	H
+}
+
+func main() {
+	e := E{T: &T{5}, H: H{"hello"}}
+
+	var get func() int = e.Get
+	fmt.Println(get())
+
+	rec := httptest.NewRecorder()
+	http.HandlerFunc(e.Serve).ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	fmt.Println(rec.Body.String())
+}
+
+// Output:
+// 5
+// hello
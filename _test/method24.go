@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type I interface{ M() string }
+
+type J interface {
+	I
+	N() int
+}
+
+type T struct{}
+
+func (*T) M() string { return "T" }
+
+type V struct{}
+
+func (V) M() string { return "V" }
+func (V) N() int    { return 1 }
+
+type E struct{ *T }
+
+type F struct{ T }
+
+type R struct{ io.Reader }
+
+type B struct{ strings.Builder }
+
+func show(name string, x interface{}) {
+	_, i := x.(I)
+	_, j := x.(J)
+	_, r := x.(io.Reader)
+	_, s := x.(fmt.Stringer)
+	_, w := x.(io.Writer)
+	fmt.Println(name, i, j, r, s, w)
+}
+
+func main() {
+	show("T", T{})
+	show("*T", &T{})
+	show("V", V{})
+	show("*V", &V{})
+	show("E", E{&T{}})
+	show("F", F{})
+	show("*F", &F{})
+	show("R", R{})
+	show("B", B{})
+	show("*B", &B{})
+
+	var x interface{} = F{}
+	defer func() { fmt.Println(recover()) }()
+	_ = x.(I)
+}
+
+// Output:
+// T false false false false false
+// *T true false false false false
+// V true true false false false
+// *V true true false false false
+// E true false false false false
+// F false false false false false
+// *F true false false false false
+// R false false true false false
+// B false false false false false
+// *B false false false true true
+// interface conversion: main.F is not main.I: missing method M
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type B struct{ strings.Builder }
+
+type C struct{ *strings.Builder }
+
+type D struct{ time.Duration }
+
+func main() {
+	var b B
+	b.WriteString("b")
+	fmt.Println(b.String())
+
+	p := &B{}
+	p.WriteString("p")
+	var s fmt.Stringer = p
+	fmt.Println(s.String())
+
+	c := C{&strings.Builder{}}
+	c.WriteString("c")
+	s = c
+	fmt.Println(s.String())
+
+	d := D{3 * time.Second}
+	fmt.Println(d.Round(time.Second), d.Seconds())
+}
+
+// Output:
+// b
+// p
+// c
+// 3s 3
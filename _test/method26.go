@@ -0,0 +1,16 @@
+package main
+
+type I interface{ M() string }
+
+type T struct{}
+
+func (*T) M() string { return "T" }
+
+func main() {
+	var t T
+	var i I = t
+	println(i.M())
+}
+
+// Error:
+// 11:12: cannot use t (variable of type T) as I value in variable declaration: T does not implement I (method M has pointer receiver)
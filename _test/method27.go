@@ -0,0 +1,14 @@
+package main
+
+import "fmt"
+
+type W struct{}
+
+func (*W) Write(b []byte) (int, error) { return len(b), nil }
+
+func main() {
+	fmt.Fprintln(W{}, "hello")
+}
+
+// Error:
+// 10:15: cannot use value of type W as io.Writer value in argument to fmt.Fprintln: W does not implement io.Writer (method Write has pointer receiver)
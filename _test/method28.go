@@ -0,0 +1,18 @@
+package main
+
+type I interface{ M() string }
+
+type T struct{}
+
+func (*T) M() string { return "T" }
+
+func get() I {
+	return T{}
+}
+
+func main() {
+	println(get().M())
+}
+
+// Error:
+// 10:9: cannot use value of type T as I value in return statement: T does not implement I (method M has pointer receiver)
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+func main() {
+	const c = min(3, 1.5, 2)
+	fmt.Println(c, max(1, 2, 3), min("b", "a", "c"))
+
+	var x, y int = 4, -2
+	fmt.Println(min(x, y, 10), max(x, y, 10), min(x))
+	var u uint8 = 200
+	fmt.Println(min(u, 7), max(u, 255))
+	f := 2.5
+	fmt.Println(min(f, 1), max(f, 3), min(f, math.NaN()), max(math.Inf(-1), f))
+	z, nz := 0.0, math.Copysign(0, -1)
+	fmt.Println(math.Signbit(min(z, nz)), math.Signbit(max(nz, z)))
+	s1, s2 := "x", "abc"
+	fmt.Println(min(s1, s2), max(s1, s2, "y"))
+	const k int8 = 5
+	fmt.Println(min(k, 100))
+}
+
+// Output:
+// 1.5 3 a
+// -2 10 4
+// 7 255
+// 1 3 NaN 2.5
+// true false
+// abc y
+// 5
@@ -0,0 +1,10 @@
+package main
+
+func main() {
+	var x int = 1
+	var f float64 = 2.5
+	println(min(x, f))
+}
+
+// Error:
+// 6:17: invalid argument: mismatched types int (previous argument) and f (variable of type float64)
@@ -0,0 +1,23 @@
+package main
+
+import "fmt"
+
+const c = 10
+
+var (
+	a = min(1, 2)
+	b = max(c, 2.5)
+	s = max("a", "b")
+	n = min(len(s), c)
+)
+
+func main() {
+	x := min(1, 2)
+	y := max(c, a)
+	fmt.Printf("%T %v %T %v %T %v %T %v\n", a, a, b, b, s, s, n, n)
+	fmt.Printf("%T %v %T %v\n", x, x, y, y)
+}
+
+// Output:
+// int 1 float64 10 string b int 1
+// int 1 int 10
@@ -0,0 +1,11 @@
+package main
+
+type T struct{ f func() }
+
+func main() {
+	a, b := T{}, T{}
+	println(a == b)
+}
+
+// Error:
+// 7:10: invalid operation: main.T cannot be compared
@@ -0,0 +1,32 @@
+package main
+
+import "fmt"
+
+func f(b bool) bool { return b }
+
+func t1(x, y int) bool { return (f(false) && !f(true)) || x < y }
+
+func t2(x, y int) bool { return (f(false) && f(true)) || x < y }
+
+func main() {
+	x, y := 1, 2
+	fmt.Println(t1(x, y), t2(x, y))
+	if x > y {
+		fmt.Println("bad1")
+	}
+	if f(true) && (x > y || f(false)) {
+		fmt.Println("bad2")
+	}
+	for i := 0; i < 2; i++ {
+		fmt.Println("i", i)
+	}
+	a := (f(false) && !f(true)) || x < y
+	b := !(x < y) || (f(false) || x < y)
+	fmt.Println(a, b)
+}
+
+// Output:
+// true true
+// i 0
+// i 1
+// true true
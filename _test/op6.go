@@ -0,0 +1,29 @@
+package main
+
+import "fmt"
+
+const debug = false
+
+func trace(s string) bool {
+	fmt.Println("trace", s)
+	return true
+}
+
+func main() {
+	b := false
+	if b && trace("and") {
+		fmt.Println("b")
+	}
+	if debug && trace("const and") {
+		fmt.Println("debug")
+	}
+	if !debug || trace("const or") {
+		fmt.Println("not debug")
+	}
+	v := b && trace("assign")
+	fmt.Println(v)
+}
+
+// Output:
+// not debug
+// false
@@ -0,0 +1,31 @@
+package main
+
+import "fmt"
+
+type T int
+
+func main() {
+	var a, b int8 = 100, 50
+	c := a + b
+	d := 1 - a
+	var x, y uint16 = 3, 5
+	z := x - y
+	f, g := 1.5, 2.0
+	h := 10 / f * g
+	s, t := "a", "b"
+	u := s + t + "c"
+	m, n := T(4), T(3)
+	p := m % n
+	fmt.Println(c, d, z, h, u, p)
+	k := 0
+	for i := 0; 10 > i; i++ {
+		if i < 3 || f >= float64(i) {
+			k += i
+		}
+	}
+	fmt.Println(k, a > b, f == 1.5, 2 != g, c <= d)
+}
+
+// Output:
+// -106 -99 65534 13.333333333333334 abc 1
+// 3 true true false true
@@ -0,0 +1,14 @@
+package main
+
+import "fmt"
+
+type T struct{ x int }
+
+func main() {
+	a := []*T{{1}, {2}}
+	m := map[string]*T{"a": {3}}
+	fmt.Println(a[0].x, a[1].x, m["a"].x)
+}
+
+// Output:
+// 1 2 3
@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+func main() {
+	s := []int{1, 2, 3}
+	for i := range s {
+		s = append(s, i)
+	}
+	fmt.Println(s)
+	a := [3]int{1, 2, 3}
+	for i, v := range a {
+		a[2] = 10
+		fmt.Println(i, v)
+	}
+}
+
+// Output:
+// [1 2 3 0 1 2]
+// 0 1
+// 1 2
+// 2 3
@@ -0,0 +1,16 @@
+package main
+
+import "fmt"
+
+func main() {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	n := 0
+	for k := range m {
+		delete(m, k)
+		n++
+	}
+	fmt.Println(n, len(m))
+}
+
+// Output:
+// 3 0
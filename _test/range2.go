@@ -0,0 +1,19 @@
+package main
+
+import "fmt"
+
+func main() {
+	p := &[3]int{1, 2, 3}
+	for i, v := range p {
+		p[2] = 10
+		fmt.Println(i, v)
+	}
+	var np *[4]int
+	fmt.Println(len(np), cap(p))
+}
+
+// Output:
+// 0 1
+// 1 2
+// 2 10
+// 4 3
@@ -0,0 +1,86 @@
+package main
+
+import "fmt"
+
+func count(n int) func(func(int) bool) {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			if !yield(i) {
+				fmt.Println("stopped at", i)
+				return
+			}
+		}
+	}
+}
+
+func find(n, x int) int {
+	for i := range count(n) {
+		if i == x {
+			return i * 10
+		}
+	}
+	return -1
+}
+
+func deferred() (r int) {
+	for i := range count(3) {
+		defer func() { r += i }()
+	}
+	return 10
+}
+
+func main() {
+	for i := range count(5) {
+		if i == 1 {
+			continue
+		}
+		if i == 3 {
+			break
+		}
+		fmt.Println("i", i)
+	}
+	fmt.Println(find(5, 2), find(2, 3), deferred())
+
+outer:
+	for i := range count(3) {
+		for j := range count(3) {
+			if j == 2 {
+				continue outer
+			}
+			if i == 2 {
+				break outer
+			}
+			fmt.Println(i, j)
+		}
+	}
+
+	k := 0
+	for x := range count(10) {
+		switch {
+		case x%2 == 0:
+			continue
+		case x > 6:
+			goto end
+		}
+		k += x
+	}
+end:
+	fmt.Println("k", k)
+}
+
+// Output:
+// i 0
+// i 2
+// stopped at 3
+// stopped at 2
+// 20 -1 13
+// 0 0
+// 0 1
+// stopped at 2
+// 1 0
+// 1 1
+// stopped at 2
+// stopped at 0
+// stopped at 2
+// stopped at 7
+// k 9
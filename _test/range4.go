@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"iter"
+)
+
+type List struct{ items []string }
+
+func (l *List) All(yield func(int, string) bool) {
+	for i, s := range l.items {
+		if !yield(i, s) {
+			return
+		}
+	}
+}
+
+func (l *List) Values() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, s := range l.items {
+			if !yield(s) {
+				return
+			}
+		}
+	}
+}
+
+func times(yield func() bool) {
+	_ = yield() && yield()
+}
+
+func again(yield func(int) bool) {
+	yield(1)
+	yield(2)
+}
+
+func main() {
+	l := &List{[]string{"a", "b", "c"}}
+	for i, s := range l.All {
+		fmt.Println(i, s)
+	}
+	for s := range l.Values() {
+		fmt.Println(s)
+	}
+	n := 0
+	for range times {
+		n++
+	}
+	fmt.Println("n", n)
+
+	next, stop := iter.Pull(l.Values())
+	fmt.Println(next())
+	fmt.Println(next())
+	stop()
+	fmt.Println(next())
+
+	defer func() { fmt.Println("recovered:", recover()) }()
+	for i := range again {
+		fmt.Println(i)
+		break
+	}
+}
+
+// Output:
+// 0 a
+// 1 b
+// 2 c
+// a
+// b
+// c
+// n 2
+// a true
+// b true
+//  false
+// 1
+// recovered: runtime error: range function continued iteration after function for loop body returned false
@@ -0,0 +1,12 @@
+package main
+
+func seq(yield func(int) bool) {}
+
+func main() {
+	for i, j := range seq {
+		println(i, j)
+	}
+}
+
+// Error:
+// 6:9: range over func(func(int) bool) permits only one iteration variable
@@ -0,0 +1,62 @@
+package main
+
+import "fmt"
+
+func repanic() {
+	defer func() {
+		fmt.Println("outer recover:", recover())
+	}()
+	defer func() {
+		fmt.Println("inner recover:", recover())
+		panic("second")
+	}()
+	panic("first")
+}
+
+func result() (r int, err error) {
+	defer func() {
+		if x := recover(); x != nil {
+			err = fmt.Errorf("recovered: %v", x)
+			r = -1
+		}
+	}()
+	panic("boom")
+}
+
+func assert() (r int) {
+	defer func() {
+		r = recover().(int) + 1
+	}()
+	panic(41)
+}
+
+func helper() interface{} { return recover() }
+
+func indirect() {
+	defer func() {
+		fmt.Println("helper:", helper())
+		fmt.Println("direct:", recover())
+	}()
+	panic("p")
+}
+
+func none() {
+	defer func() { fmt.Println("none:", recover()) }()
+}
+
+func main() {
+	repanic()
+	fmt.Println(result())
+	fmt.Println(assert())
+	indirect()
+	none()
+}
+
+// Output:
+// inner recover: first
+// outer recover: second
+// -1 recovered: boom
+// 42
+// helper: <nil>
+// direct: p
+// none: <nil>
@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+func write() (n int) {
+	defer func() {
+		fmt.Println("recovered:", recover())
+		n++
+	}()
+	n = 10
+	var m map[string]int
+	m["a"] = 1
+	return 5
+}
+
+func main() {
+	fmt.Println(write())
+}
+
+// Output:
+// recovered: assignment to entry in nil map
+// 11
@@ -0,0 +1,65 @@
+package main
+
+import "fmt"
+
+func get(c chan int) chan int {
+	fmt.Println("get")
+	return c
+}
+
+func main() {
+	var chans [2]chan int
+	chans[1] = make(chan int, 1)
+	for i := 0; i < 4; i++ {
+		select {
+		case chans[0] <- i:
+			fmt.Println("sent0")
+		case get(chans[1]) <- i * 10:
+			fmt.Println("sent1", i)
+			continue
+		default:
+			if i == 3 {
+				break
+			}
+			fmt.Println("default", i)
+		}
+		fmt.Println("end", i)
+	}
+
+	select {
+	case <-chans[1]:
+	}
+
+	var v int
+	var ok bool
+	chans[1] <- 2
+	close(chans[1])
+	for i := 0; i < 2; i++ {
+		select {
+		case v, ok = <-chans[1]:
+			fmt.Println(v, ok)
+		}
+	}
+	select {
+	case x, ok := <-chans[1]:
+		fmt.Println(x, ok)
+	default:
+		fmt.Println("not closed")
+		fmt.Println("error")
+	}
+}
+
+// Output:
+// get
+// sent1 0
+// get
+// default 1
+// end 1
+// get
+// default 2
+// end 2
+// get
+// end 3
+// 2 true
+// 0 false
+// 0 false
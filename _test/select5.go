@@ -0,0 +1,39 @@
+package main
+
+import "fmt"
+
+type I interface{ M() int }
+
+type T struct{ a int }
+
+func (t T) M() int { return t.a }
+
+func main() {
+	c := make(chan I, 1)
+	select {
+	case c <- T{3}:
+	}
+	select {
+	case v := <-c:
+		fmt.Println(v.M())
+	}
+
+	var v I
+	var ok bool
+	c <- T{4}
+	select {
+	case v, ok = <-c:
+		fmt.Println(v.M(), ok)
+	}
+
+	close(c)
+	select {
+	case _, ok := <-c:
+		fmt.Println(ok)
+	}
+}
+
+// Output:
+// 3
+// 4 true
+// false
@@ -0,0 +1,35 @@
+package main
+
+import "fmt"
+
+func main() {
+	c := make(chan int)
+	go func() {
+		for i := 0; i < 5; i++ {
+			c <- i
+		}
+		close(c)
+	}()
+	n := 0
+	done := false
+	for !done {
+		select {
+		case v, ok := <-c:
+			if !ok {
+				done = true
+			}
+			fmt.Println(v, ok)
+			n += v
+		}
+	}
+	fmt.Println("select", n)
+}
+
+// Output:
+// 0 true
+// 1 true
+// 2 true
+// 3 true
+// 4 true
+// 0 false
+// select 10
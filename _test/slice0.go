@@ -0,0 +1,18 @@
+package main
+
+import "fmt"
+
+func main() {
+	d := []int{1, 2, 3, 4, 5}
+	e := d[1:3]
+	e = append(e, 10)
+	fmt.Println(d, e, len(e), cap(e))
+	f := d[1:3:3]
+	f = append(f, 20)
+	f[0] = 30
+	fmt.Println(d, f, len(f), cap(f))
+}
+
+// Output:
+// [1 2 3 10 5] [2 3 10] 3 4
+// [1 2 3 10 5] [30 3 20] 3 4
@@ -0,0 +1,17 @@
+package main
+
+import "fmt"
+
+func main() {
+	var arr [5]int
+	p := &arr
+	p[0] = 1
+	q := p[3:]
+	q[0] = 4
+	r := arr[1:2:4]
+	r = append(r, 8)
+	fmt.Println(arr, len(p), len(q), cap(r))
+}
+
+// Output:
+// [1 0 8 4 0] 5 2 3
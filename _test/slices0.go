@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type P struct {
+	Name string
+	Age  int
+}
+
+func main() {
+	s := []int{3, 1, 2}
+	fmt.Println(slices.Contains(s, 2), slices.Index(s, 1), slices.Equal(s, []int{3, 1, 2}))
+	slices.Sort(s)
+	fmt.Println(s, slices.IsSorted(s))
+	i, ok := slices.BinarySearch(s, 2)
+	fmt.Println(i, ok)
+	s = slices.Insert(s, 1, 10, 11)
+	fmt.Println(s)
+	s = slices.Delete(s, 0, 2)
+	fmt.Println(s, slices.Max(s), slices.Min(s))
+	ws := strings.Fields("b a c a")
+	slices.Sort(ws)
+	fmt.Println(slices.Compact(ws), slices.Compare([]string{"a"}, []string{"b"}))
+	ps := []P{{"x", 3}, {"y", 1}, {"z", 3}, {"w", 2}}
+	slices.SortStableFunc(ps, func(a, b P) int { return a.Age - b.Age })
+	fmt.Println(ps, slices.IndexFunc(ps, func(p P) bool { return p.Name == "z" }))
+	c := slices.Clone(s)
+	slices.Reverse(c)
+	fmt.Println(c, s)
+	for i, v := range slices.All([]string{"p", "q"}) {
+		fmt.Println(i, v)
+	}
+	fmt.Println(slices.Collect(slices.Values([]int{5, 6})), slices.Sorted(slices.Values([]int{3, 2, 1})))
+	for c := range slices.Chunk([]int{1, 2, 3, 4, 5}, 2) {
+		fmt.Print(c, " ")
+	}
+	fmt.Println(slices.Concat([]int{1}, []int{2, 3}), slices.Repeat([]int{0}, 3))
+	big := make([]int, 100)
+	for i := range big {
+		big[i] = (i * 37) % 101
+	}
+	slices.Sort(big)
+	fmt.Println(slices.IsSorted(big), big[:5], slices.Replace([]int{1, 2, 3}, 0, 2, 9))
+	fmt.Println(slices.DeleteFunc([]int{1, 2, 3, 4}, func(v int) bool { return v%2 == 0 }))
+}
+
+// Output:
+// true 1 true
+// [1 2 3] true
+// 1 true
+// [1 10 11 2 3]
+// [11 2 3] 11 2
+// [a b c] -1
+// [{y 1} {w 2} {x 3} {z 3}] 3
+// [3 2 11] [11 2 3]
+// 0 p
+// 1 q
+// [5 6] [1 2 3]
+// [1 2] [3 4] [5] [1 2 3] [0 0 0]
+// true [0 1 2 3 4] [9 3]
+// [1 3]
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+)
+
+func main() {
+	opts := &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, opts))
+	logger.Info("hello", "count", 3, slog.String("name", "x"))
+	logger.With("id", 7).Debug("debug", slog.Group("g", slog.Bool("ok", true)))
+}
+
+// Output:
+// level=INFO msg=hello count=3 name=x
+// level=DEBUG msg=debug id=7 g.ok=true
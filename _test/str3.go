@@ -0,0 +1,27 @@
+package main
+
+import "fmt"
+
+func main() {
+	s := "a\xffb\xe2\x82\xacc\xe2\x82"
+	fmt.Println([]byte(s), []rune(s), len([]rune(s)))
+	var runes, offsets []int
+	for i, r := range s {
+		runes = append(runes, i, int(r))
+	}
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+	fmt.Println(runes, offsets)
+
+	var n int
+	for range "héllo" {
+		n++
+	}
+	fmt.Println(n)
+}
+
+// Output:
+// [97 255 98 226 130 172 99 226 130] [97 65533 98 8364 99 65533 65533] 7
+// [0 97 1 65533 2 98 3 8364 6 99 7 65533 8 65533] [0 1 2 3 6 7 8]
+// 5
@@ -0,0 +1,50 @@
+package main
+
+import "fmt"
+
+type B []byte
+
+type S string
+
+type MyRune rune
+
+func main() {
+	var i, neg, sur int = 65, -1, 0xD800
+	var big int64 = 0x110000
+	fmt.Printf("%q %q %q %q\n", string(rune(i)), string(rune(neg)), string(rune(big)), string(rune(sur)))
+
+	rs := []rune{0x41, -1, 0x110000, 0xD800, 0x20AC}
+	fmt.Printf("%q\n", string(rs))
+
+	bs := []byte{0x61, 0xff, 0x62}
+	fmt.Printf("%q %v\n", string(bs), []byte(string(bs)))
+	fmt.Printf("%q %q %v %v\n", string(B("xy")), S([]byte{0x41}), B(S("hi")), []rune(S("hé")))
+
+	var mr MyRune = 0x263A
+	fmt.Printf("%q %q\n", string(mr), S(mr))
+
+	var x byte = 200
+	fmt.Printf("%q %v\n", string(x), []byte(string(rune(0xDFFF))))
+
+	var s S = "a\xc0z"
+	var runes []rune
+	for _, r := range s {
+		runes = append(runes, r)
+	}
+	fmt.Println(runes)
+
+	b := []byte("abc")
+	t := string(b)
+	b[0] = 'X'
+	fmt.Println(t, string(b))
+}
+
+// Output:
+// "A" "�" "�" "�"
+// "A���€"
+// "a\xffb" [97 255 98]
+// "xy" "A" [104 105] [104 233]
+// "☺" "☺"
+// "È" [239 191 189]
+// [97 65533 122]
+// abc Xbc
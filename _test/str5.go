@@ -0,0 +1,14 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	s := strings.Repeat("ab", 2)
+	fmt.Println(s[1], string(s[2]))
+}
+
+// Output:
+// 98 a
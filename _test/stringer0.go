@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type S struct{ N int }
+
+func (s S) String() string { return fmt.Sprint("S", s.N) }
+
+type P struct{ N int }
+
+func (p *P) String() string { return fmt.Sprint("P", p.N) }
+
+func main() {
+	var i interface{} = S{2}
+	var st fmt.Stringer = &P{3}
+	fmt.Println(fmt.Sprint(S{1}), i, st, P{4}, &P{5})
+	fmt.Printf("%v %s %q %d %+v %5s|\n", S{6}, S{7}, S{8}, S{9}, S{10}, S{11})
+	fmt.Println(strings.ToUpper(fmt.Sprintf("%v", S{12})))
+}
+
+// Output:
+// S1 S2 P3 {4} P5
+// S6 S7 "S8" {9} S10   S11|
+// S12
@@ -11,8 +11,8 @@ type Fromage struct {
 
 func main() {
 	a := Fromage{}
-	fmt.Println(a.Server)
+	fmt.Println(a.Server.Addr == "", a.Server.Handler == nil, a.Server.ReadTimeout, a.Addr == a.Server.Addr)
 }
 
 // Output:
-// { <nil> <nil> 0s 0s 0s 0s 0 map[] <nil> <nil> 0 0 {{0 0} 0} <nil> {0 0} map[] map[] <nil> []}
+// true true 0s true
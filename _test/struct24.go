@@ -0,0 +1,57 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+type Base struct {
+	ID int `json:"id"`
+}
+
+type Doc struct {
+	*Base
+	Title string            `json:"title" db:"t"`
+	A, B  int               "json:\"ab,omitempty\""
+	Meta  map[string]string `json:"meta,omitempty"`
+	Skip  int               `json:"-"`
+}
+
+func main() {
+	t := reflect.TypeOf(Doc{})
+	for _, name := range []string{"Title", "A", "B", "Meta", "Skip"} {
+		f, _ := t.FieldByName(name)
+		v, ok := f.Tag.Lookup("json")
+		fmt.Printf("%s %s %v %q\n", name, v, ok, f.Tag.Get("db"))
+	}
+
+	b, err := json.Marshal(Doc{Base: &Base{3}, Title: "t", A: 1, Skip: 2})
+	fmt.Println(string(b), err)
+
+	var d Doc
+	err = json.Unmarshal([]byte(`{"id":8,"title":"x","meta":{"k":"v"},"Skip":4}`), &d)
+	fmt.Println(d.ID, d.Title, d.Meta, d.Skip, err)
+
+	b, _ = json.Marshal([]struct {
+		K string `json:"k"`
+	}{{"x"}, {"y"}})
+	fmt.Println(string(b))
+
+	var q struct {
+		Q int `json:"q"`
+	}
+	json.Unmarshal([]byte(`{"q":9}`), &q)
+	fmt.Println(q.Q, reflect.TypeOf(q).Field(0).Tag)
+}
+
+// Output:
+// Title title true "t"
+// A ab,omitempty true ""
+// B ab,omitempty true ""
+// Meta meta,omitempty true ""
+// Skip - true ""
+// {"id":3,"title":"t"} <nil>
+// 8 x map[k:v] 0 <nil>
+// [{"k":"x"},{"k":"y"}]
+// 9 json:"q"
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+type Item struct {
+	XMLName xml.Name `xml:"item"`
+	ID      int      `xml:"id,attr"`
+	Body    string   `xml:"body"`
+	Notes   []string `xml:"notes>note"`
+}
+
+func main() {
+	out, err := xml.Marshal(Item{ID: 1, Body: "hi", Notes: []string{"a", "b"}})
+	fmt.Println(string(out), err)
+
+	var it Item
+	err = xml.Unmarshal([]byte(`<item id="7"><body>yo</body><notes><note>c</note></notes></item>`), &it)
+	fmt.Println(it.ID, it.Body, it.Notes, err)
+}
+
+// Output:
+// <item id="1"><body>hi</body><notes><note>a</note><note>b</note></notes></item> <nil>
+// 7 yo [c] <nil>
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type T struct {
+	A int `json:"a"`
+	B string
+}
+
+func main() {
+	t := reflect.TypeOf(T{})
+	fmt.Printf("%q %q\n", t.Field(0).Tag, t.Field(1).Tag)
+	f, _ := t.FieldByName("A")
+	fmt.Printf("%q\n", f.Tag)
+	for _, f := range reflect.VisibleFields(t) {
+		fmt.Printf("%s %q\n", f.Name, f.Tag)
+	}
+	_, ok := t.Field(0).Tag.Lookup("yaegi")
+	fmt.Println(ok)
+}
+
+// Output:
+// "json:\"a\"" ""
+// "json:\"a\""
+// A "json:\"a\""
+// B ""
+// false
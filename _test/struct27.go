@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+func id(v interface{}) interface{} { return v }
+
+func show(s interface{ String() string }) string { return "<" + s.String() + ">" }
+
+func read(r interface {
+	Read([]byte) (int, error)
+}) string {
+	b := make([]byte, 3)
+	n, _ := r.Read(b)
+	return string(b[:n])
+}
+
+type T struct{ n int }
+
+func (t T) String() string { return fmt.Sprint("T", t.n) }
+
+func main() {
+	a := struct {
+		X int    `json:"x"`
+		Y string `json:"y"`
+	}{1, "a"}
+	b, _ := json.Marshal(a)
+	fmt.Println(string(b))
+	fmt.Printf("%v %+v\n", a, a)
+	v := reflect.ValueOf(a)
+	fmt.Println(v.NumField(), v.Field(1), v.Type().Field(0).Tag.Get("json"))
+
+	r := reflect.ValueOf(&a).Elem()
+	r.Field(0).SetInt(5)
+	fmt.Println(a.X)
+
+	var x interface{} = a
+	back, ok := x.(struct {
+		X int    `json:"x"`
+		Y string `json:"y"`
+	})
+	fmt.Println(back.Y, ok)
+	_, ok = x.(struct {
+		X int
+		Y string
+	})
+	fmt.Println(ok)
+
+	fmt.Println(show(T{2}))
+	fmt.Println(read(strings.NewReader("hello")))
+	var s interface{ String() string } = T{3}
+	fmt.Println(s.String(), show(s))
+
+	var p struct {
+		A []int
+		M map[string]int
+	}
+	json.Unmarshal([]byte(`{"A":[1,2],"M":{"k":3}}`), &p)
+	fmt.Println(p.A, p.M)
+}
+
+// Output:
+// {"x":1,"y":"a"}
+// {1 a} {X:1 Y:a}
+// 2 a x
+// 5
+// a true
+// false
+// <T2>
+// hel
+// T3 <T3>
+// [1 2] map[k:3]
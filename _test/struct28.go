@@ -0,0 +1,16 @@
+package main
+
+import "fmt"
+
+type S struct{ A int }
+
+func main() {
+	var s S = struct{ A int }{1}
+	fmt.Println(s)
+	s = struct{ A int }{2}
+	fmt.Println(s)
+}
+
+// Output:
+// {1}
+// {2}
@@ -0,0 +1,26 @@
+package main
+
+import "fmt"
+
+type T struct{ A, B int }
+
+type U struct{ A, B int }
+
+func (t T) Sum() int { return t.A + t.B }
+
+func (u U) Sum() int { return 2 * (u.A + u.B) }
+
+type Summer interface{ Sum() int }
+
+func main() {
+	m := map[interface{}]int{T{1, 1}: 1}
+	_, ok := m[U{1, 1}]
+	fmt.Println(ok, m[T{1, 1}])
+
+	var s Summer = U{3, 4}
+	fmt.Println(s.Sum())
+}
+
+// Output:
+// false 1
+// 14
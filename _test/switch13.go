@@ -14,4 +14,4 @@ func main() {
 }
 
 // Error:
-// 9:2: i is not a type
+// 9:7: i is not a type
@@ -0,0 +1,14 @@
+package main
+
+func main() {
+	n, i := 2, 1
+	var v interface{} = i
+	switch x := v.(type) {
+	case string:
+		n = len(x)
+	default:
+	}
+	println(n)
+}
+
+// Output:
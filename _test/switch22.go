@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type I interface{ M() string }
+
+type T struct{ s string }
+
+func (t T) M() string { return "T" + t.s }
+
+type MyErr struct{}
+
+func (MyErr) Error() string { return "myerr" }
+
+func kind(x interface{}) string {
+	switch v := x.(type) {
+	case nil:
+		return fmt.Sprintf("nil %v", v)
+	case int, int64:
+		return fmt.Sprintf("int-ish %v", v)
+	case string:
+		return "string " + v
+	case I:
+		return "I " + v.M()
+	case error:
+		return "error " + v.Error()
+	case []int:
+		return fmt.Sprint("slice ", len(v))
+	case io.Reader, fmt.Stringer:
+		return fmt.Sprintf("reader-or-stringer %T", v)
+	default:
+		return fmt.Sprintf("other %T", v)
+	}
+}
+
+func main() {
+	fmt.Println(kind(nil))
+	fmt.Println(kind(1))
+	fmt.Println(kind(int64(2)))
+	fmt.Println(kind("ab"))
+	fmt.Println(kind(T{"x"}))
+	fmt.Println(kind(MyErr{}))
+	fmt.Println(kind(errors.New("e")))
+	fmt.Println(kind([]int{1, 2}))
+	fmt.Println(kind(strings.NewReader("r")))
+	fmt.Println(kind(1.5))
+}
+
+// Output:
+// nil <nil>
+// int-ish 1
+// int-ish 2
+// string ab
+// I Tx
+// error myerr
+// error e
+// slice 2
+// reader-or-stringer *strings.Reader
+// other float64
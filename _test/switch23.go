@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+type I interface{ M() string }
+
+type T struct{ s string }
+
+func (t T) M() string { return "T" + t.s }
+
+type P struct{}
+
+func (*P) M() string { return "P" }
+
+func ikind(i I) string {
+	switch v := i.(type) {
+	case T:
+		return "T " + v.s
+	case *P:
+		return "*P " + v.M()
+	case nil:
+		return fmt.Sprint("nil ", v == nil)
+	}
+	return "?"
+}
+
+func main() {
+	fmt.Println(ikind(T{"y"}), ikind(&P{}), ikind(nil))
+	var w io.Writer = &bytes.Buffer{}
+	switch b := w.(type) {
+	case *bytes.Buffer:
+		b.WriteString("hi")
+		fmt.Println(b.Len())
+	}
+}
+
+// Output:
+// T y *P P nil true
+// 2
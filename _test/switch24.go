@@ -0,0 +1,40 @@
+package main
+
+import "fmt"
+
+type S struct{ v interface{} }
+
+func get() interface{} { return "g" }
+
+func main() {
+	s := S{1.5}
+	switch v := s.v.(type) {
+	case float64:
+		fmt.Println("float", v)
+	}
+	switch get().(type) {
+	case string:
+		fmt.Println("string")
+	}
+	switch x := get(); y := x.(type) {
+	case string:
+		fmt.Println(y + "!")
+	}
+	m := map[string]interface{}{"a": []int{1}}
+	switch v := m["a"].(type) {
+	case []int:
+		fmt.Println(len(v))
+	}
+	switch x := interface{}(3).(type) {
+	case int:
+		x++
+		fmt.Println(x)
+	}
+}
+
+// Output:
+// float 1.5
+// string
+// g!
+// 1
+// 4
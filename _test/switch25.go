@@ -0,0 +1,30 @@
+package main
+
+import "fmt"
+
+func class(i int) string {
+	if i%3 == 0 {
+		return "fizz"
+	}
+	return "number"
+}
+
+func main() {
+	for i := 2; i < 4; i++ {
+		switch class(i) {
+		case "fizz":
+			fmt.Println(i, "fizz")
+		default:
+			fmt.Println(i, "number")
+		}
+		switch i % 2 {
+		case 0:
+			fmt.Println(i, "even")
+		}
+	}
+}
+
+// Output:
+// 2 number
+// 2 even
+// 3 fizz
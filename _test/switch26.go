@@ -0,0 +1,21 @@
+package main
+
+import "fmt"
+
+const debug = false
+
+func main() {
+	b, x := false, 2
+	switch {
+	case debug:
+		x = 1
+	case b:
+		x = 3
+	case x > 1:
+		x = 4
+	}
+	fmt.Println(x)
+}
+
+// Output:
+// 4
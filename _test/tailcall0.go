@@ -0,0 +1,36 @@
+package main
+
+import "fmt"
+
+func sum(n, acc int) int {
+	if n == 0 {
+		return acc
+	}
+	return sum(n-1, acc+n)
+}
+
+func last(n int) (r int) {
+	if n == 0 {
+		return
+	}
+	r = n
+	return last(n - 1)
+}
+
+func keep(n int, p *int) *int {
+	x := n
+	if n == 0 {
+		return p
+	}
+	if n == 3 {
+		p = &x
+	}
+	return keep(n-1, p)
+}
+
+func main() {
+	fmt.Println(sum(1000000, 0), last(3), *keep(5, nil))
+}
+
+// Output:
+// 500000500000 0 3
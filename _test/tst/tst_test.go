@@ -0,0 +1,22 @@
+package tst
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if r := Add(1, 2); r != 3 {
+		t.Fatalf("got %d, want 3", r)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	t.Skip("skipped")
+	t.Fatal("not skipped")
+}
+
+func Testlower(t *testing.T) {}
+
+func BenchmarkAdd(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Add(i, i)
+	}
+}
@@ -0,0 +1,5 @@
+package tst_test
+
+import "testing"
+
+func TestExternal(t *testing.T) { t.Fatal("external tests should be ignored") }
@@ -0,0 +1,14 @@
+package tstlog
+
+import "testing"
+
+func TestLog(t *testing.T) {
+	t.Log("logged")
+	t.Logf("logged %d", 2)
+	check(t, 1, 1)
+}
+
+func check(t *testing.T, got, want int) {
+	t.Helper()
+	t.Logf("got %d, want %d", got, want)
+}
@@ -0,0 +1,12 @@
+package main
+
+type Celsius float64
+
+func main() {
+	var c Celsius = 20
+	var f float64 = c
+	println(f)
+}
+
+// Error:
+// 7:18: cannot use c (variable of type Celsius) as float64 value in variable declaration
@@ -0,0 +1,14 @@
+package main
+
+import "fmt"
+
+func f(a ...string) int { return len(a) }
+
+func g(s string) string { return s }
+
+func main() {
+	fmt.Println(f("a", "b"), g("x"))
+}
+
+// Output:
+// 2 x
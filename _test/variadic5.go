@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type L struct{ p string }
+
+func (l L) Log(args ...interface{}) string { return l.p + fmt.Sprint(args...) }
+
+func (l *L) Join(sep string, s ...string) string { return l.p + strings.Join(s, sep) }
+
+func count(args ...interface{}) int { return len(args) }
+
+func fields() []interface{} { return []interface{}{"f", 1} }
+
+func main() {
+	args := []interface{}{1, "a"}
+	fmt.Println(args...)
+	fmt.Println(count(args...), count(fields()...), fmt.Sprint(fields()...))
+
+	l := L{">"}
+	fmt.Println(l.Log(args...), l.Log(), l.Log(2, 3))
+	fmt.Println(l.Join(",", "a", "b"), l.Join("-", strings.Fields("x y z")...))
+
+	sp := fmt.Sprintln
+	fmt.Print(sp(args...))
+	var w strings.Builder
+	fmt.Fprintf(&w, "%d-%s", args...)
+	fmt.Println(w.String())
+}
+
+// Output:
+// 1 a
+// 2 2 f1
+// >1a > >2 3
+// >a,b >x-y-z
+// 1 a
+// 1-a
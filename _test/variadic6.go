@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func fields() []interface{} { return []interface{}{"f", 1} }
+
+func main() {
+	args := []interface{}{1, "a"}
+	var is []interface{}
+	is = append(is, args...)
+	is = append(is, 4, "x")
+	is = append(is, errors.New("e"))
+	fmt.Println(len(is), is)
+
+	all := append(fields(), args...)
+	fmt.Println(all...)
+	all = append(args, fields()...)
+	fmt.Println(all...)
+
+	parts := strings.Split("a,b", ",")
+	mine := []string{"z"}
+	mine = append(mine, parts...)
+	parts = append(parts, mine...)
+	fmt.Println(mine, parts)
+}
+
+// Output:
+// 5 [1 a 4 x e]
+// f 1 1 a
+// 1 a f 1
+// [z a b] [a b z a b]
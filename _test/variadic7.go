@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+)
+
+type P interface {
+	Printf(format string, args ...interface{})
+}
+
+type S struct{ n int }
+
+func (s S) Printf(format string, args ...interface{}) {
+	fmt.Printf("S%d:"+format+"\n", append([]interface{}{s.n}, args...)...)
+}
+
+func main() {
+	args := []interface{}{1, "b"}
+	var p P = S{7}
+	p.Printf("%d %s", args...)
+	p.Printf("%d %s", 2, "c")
+	p = log.New(os.Stdout, "log:", 0)
+	p.Printf("%d %s", args...)
+	p.Printf("%d %s", 3, "d")
+}
+
+// Output:
+// S7:1 b
+// S7:2 c
+// log:1 b
+// log:3 d
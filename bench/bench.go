@@ -0,0 +1,263 @@
+// Package bench measures the performance of the interpreter against compiled
+// Go, on a corpus of programs each exercising a language feature.
+//
+// Each program of the corpus, in the testdata directory, is a main package
+// in a single file, named after the feature it exercises, and printing a
+// result. A program is run interpreted and compiled, its outputs must be the
+// same, and its slowdown is the ratio of the run times:
+//
+//	programs, err := bench.Load("bench/testdata")
+//	for _, p := range programs {
+//		r, err := bench.Run(p, bench.Options{Count: 3})
+//		fmt.Println(r.Name, r.Ratio)
+//	}
+//
+// The results of successive runs, written as JSON, are compared by Compare,
+// to detect the performance regressions of the interpreter.
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// A Program is a program of the benchmark corpus.
+type Program struct {
+	Name string // name of the file, without the .go extension
+	Path string // path of the file
+}
+
+// A Result is the measure of a program.
+type Result struct {
+	Name  string        `json:"name"`
+	Go    time.Duration `json:"go"`    // run time of the compiled program, in nanoseconds, or 0 if not measured
+	Yaegi time.Duration `json:"yaegi"` // run time of the interpreted program, including its compilation
+	Ratio float64       `json:"ratio"` // Yaegi divided by Go, or 0 if Go is not measured
+}
+
+// Options are the options of Run.
+type Options struct {
+	// Count is the number of runs of a program, the fastest being kept.
+	// If 0, a program is run once.
+	Count int
+	// NoGo disables the measure of the compiled program, which requires
+	// the go command.
+	NoGo bool
+}
+
+// Load returns the programs of the given files, and of the Go files of the
+// given directories, in the order of their names.
+func Load(paths ...string) ([]Program, error) {
+	var programs []Program
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		files := []string{p}
+		if fi.IsDir() {
+			if files, err = filepath.Glob(filepath.Join(p, "*.go")); err != nil {
+				return nil, err
+			}
+			sort.Strings(files)
+		}
+		for _, f := range files {
+			if strings.HasSuffix(f, "_test.go") {
+				continue
+			}
+			programs = append(programs, Program{Name: strings.TrimSuffix(filepath.Base(f), ".go"), Path: f})
+		}
+	}
+	return programs, nil
+}
+
+// Run runs the program p interpreted, and compiled unless opt.NoGo is set,
+// and returns the fastest run times. An error is returned if a run fails, or
+// if the outputs of the interpreted and compiled programs differ.
+//
+// The run time of the compiled program excludes the start of a process, as
+// measured by running an empty program, so that the ratio reflects the
+// speed of the code of p.
+func Run(p Program, opt Options) (Result, error) {
+	count := opt.Count
+	if count < 1 {
+		count = 1
+	}
+	r := Result{Name: p.Name}
+	var out string
+	for i := 0; i < count; i++ {
+		d, o, err := interpret(p.Path)
+		if err != nil {
+			return r, fmt.Errorf("%s: %v", p.Name, err)
+		}
+		if i == 0 || d < r.Yaegi {
+			r.Yaegi = d
+		}
+		out = o
+	}
+	if opt.NoGo {
+		return r, nil
+	}
+
+	dir, err := ioutil.TempDir("", "yaegi-bench")
+	if err != nil {
+		return r, err
+	}
+	defer os.RemoveAll(dir)
+	bin, err := compile(dir, p.Path)
+	if err != nil {
+		return r, fmt.Errorf("%s: %v", p.Name, err)
+	}
+	for i := 0; i < count; i++ {
+		d, o, err := execute(bin)
+		if err != nil {
+			return r, fmt.Errorf("%s: %v", p.Name, err)
+		}
+		if o != out {
+			return r, fmt.Errorf("%s: interpreted output %q differs from compiled output %q", p.Name, out, o)
+		}
+		if i == 0 || d < r.Go {
+			r.Go = d
+		}
+	}
+	start, err := startup()
+	if err != nil {
+		return r, err
+	}
+	if r.Go -= start; r.Go < time.Microsecond {
+		r.Go = time.Microsecond
+	}
+	r.Ratio = float64(r.Yaegi) / float64(r.Go)
+	return r, nil
+}
+
+// interpret runs the program of file path in a new interpreter, and returns
+// the time spent evaluating it and its output.
+func interpret(path string) (time.Duration, string, error) {
+	i := interp.New(interp.Options{GoPath: build.Default.GOPATH})
+	i.Use(stdlib.Symbols)
+	var d time.Duration
+	var err error
+	out, stderr := i.CaptureOutput(func() {
+		runtime.GC()
+		start := time.Now()
+		_, err = i.EvalPath(path)
+		d = time.Since(start)
+	})
+	fmt.Fprint(os.Stderr, stderr)
+	return d, out, err
+}
+
+// compile builds the program of file path in dir, and returns the path of
+// the executable.
+func compile(dir, path string) (string, error) {
+	bin := filepath.Join(dir, strings.TrimSuffix(filepath.Base(path), ".go"))
+	if runtime.GOOS == "windows" {
+		bin += ".exe"
+	}
+	if out, err := exec.Command("go", "build", "-o", bin, path).CombinedOutput(); err != nil {
+		return "", fmt.Errorf("go build: %v\n%s", err, out)
+	}
+	return bin, nil
+}
+
+// execute runs the executable bin, and returns its run time and output.
+func execute(bin string) (time.Duration, string, error) {
+	var out bytes.Buffer
+	cmd := exec.Command(bin)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	runtime.GC()
+	start := time.Now()
+	err := cmd.Run()
+	return time.Since(start), out.String(), err
+}
+
+var (
+	startOnce sync.Once
+	startTime time.Duration // run time of an empty program
+	startErr  error
+)
+
+// startup returns the fastest run time of an empty compiled program, the
+// time spent starting a process.
+func startup() (time.Duration, error) {
+	startOnce.Do(func() {
+		var dir string
+		if dir, startErr = ioutil.TempDir("", "yaegi-bench"); startErr != nil {
+			return
+		}
+		defer os.RemoveAll(dir)
+		src := filepath.Join(dir, "empty.go")
+		if startErr = ioutil.WriteFile(src, []byte("package main\n\nfunc main() {}\n"), 0644); startErr != nil {
+			return
+		}
+		bin, err := compile(dir, src)
+		if err != nil {
+			startErr = err
+			return
+		}
+		for i := 0; i < 10; i++ {
+			d, _, err := execute(bin)
+			if err != nil {
+				startErr = err
+				return
+			}
+			if i == 0 || d < startTime {
+				startTime = d
+			}
+		}
+	})
+	return startTime, startErr
+}
+
+// ReadResults returns the results read from r, written as JSON values, as
+// by the -json option of the yaegi bench command.
+func ReadResults(r io.Reader) ([]Result, error) {
+	var results []Result
+	dec := json.NewDecoder(r)
+	for {
+		var res Result
+		if err := dec.Decode(&res); err != nil {
+			if err == io.EOF {
+				return results, nil
+			}
+			return nil, err
+		}
+		results = append(results, res)
+	}
+}
+
+// Compare returns the descriptions of the regressions of results from base:
+// the programs whose ratio increased by more than the tolerance, a fraction
+// of the base ratio. Programs without a ratio in both results are ignored.
+func Compare(base, results []Result, tolerance float64) []string {
+	ratios := map[string]float64{}
+	for _, r := range base {
+		ratios[r.Name] = r.Ratio
+	}
+	var regressions []string
+	for _, r := range results {
+		old := ratios[r.Name]
+		if old == 0 || r.Ratio == 0 || r.Ratio <= old*(1+tolerance) {
+			continue
+		}
+		regressions = append(regressions, fmt.Sprintf("%s: ratio %.1f, was %.1f (+%.0f%%)", r.Name, r.Ratio, old, 100*(r.Ratio/old-1)))
+	}
+	return regressions
+}
@@ -0,0 +1,73 @@
+package bench
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	programs, err := Load("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(programs) == 0 || programs[0].Name != "arith" || programs[0].Path != filepath.Join("testdata", "arith.go") {
+		t.Fatalf("got programs %v", programs)
+	}
+	for i := 1; i < len(programs); i++ {
+		if programs[i-1].Name >= programs[i].Name {
+			t.Errorf("programs not sorted: %s, %s", programs[i-1].Name, programs[i].Name)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yaegi-bench")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "hello.go")
+	src := "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(\"hello\") }\n"
+	if err := ioutil.WriteFile(file, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := Program{Name: "hello", Path: file}
+
+	r, err := Run(p, Options{NoGo: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Name != "hello" || r.Yaegi <= 0 || r.Go != 0 || r.Ratio != 0 {
+		t.Errorf("got result %+v", r)
+	}
+
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+	if r, err = Run(p, Options{Count: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if r.Go <= 0 || r.Ratio <= 0 {
+		t.Errorf("got result %+v", r)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base, err := ReadResults(strings.NewReader(`{"name":"a","go":1000,"yaegi":50000,"ratio":50}
+{"name":"b","go":1000,"yaegi":20000,"ratio":20}
+{"name":"c","go":0,"yaegi":20000,"ratio":0}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := []Result{{Name: "a", Ratio: 55}, {Name: "b", Ratio: 30}, {Name: "c", Ratio: 90}, {Name: "d", Ratio: 10}}
+	want := []string{"b: ratio 30.0, was 20.0 (+50%)"}
+	if got := Compare(base, results, 0.2); !reflect.DeepEqual(got, want) {
+		t.Errorf("got regressions %q, want %q", got, want)
+	}
+}
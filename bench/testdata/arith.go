@@ -0,0 +1,13 @@
+// Integer arithmetic in a loop.
+package main
+
+import "fmt"
+
+func main() {
+	var s, x uint32 = 0, 1
+	for i := 0; i < 2000000; i++ {
+		x = x*1664525 + 1013904223
+		s += x>>16 ^ uint32(i)%7
+	}
+	fmt.Println(s)
+}
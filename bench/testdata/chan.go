@@ -0,0 +1,31 @@
+// Goroutines and channels: a pipeline of stages.
+package main
+
+import "fmt"
+
+func stage(in <-chan int, f func(int) int) <-chan int {
+	out := make(chan int, 16)
+	go func() {
+		for v := range in {
+			out <- f(v)
+		}
+		close(out)
+	}()
+	return out
+}
+
+func main() {
+	src := make(chan int, 16)
+	go func() {
+		for i := 0; i < 20000; i++ {
+			src <- i
+		}
+		close(src)
+	}()
+	out := stage(stage(src, func(v int) int { return v * 2 }), func(v int) int { return v + 1 })
+	sum := 0
+	for v := range out {
+		sum += v
+	}
+	fmt.Println(sum)
+}
@@ -0,0 +1,18 @@
+// Closures: calls of function values capturing variables.
+package main
+
+import "fmt"
+
+func counter() (func(int), func() int) {
+	n := 0
+	return func(d int) { n += d }, func() int { return n }
+}
+
+func main() {
+	add, get := counter()
+	apply := func(f func(int), v int) { f(v) }
+	for i := 0; i < 1000000; i++ {
+		apply(add, i%3)
+	}
+	fmt.Println(get())
+}
@@ -0,0 +1,25 @@
+// Defer, panic and recover.
+package main
+
+import "fmt"
+
+func safeDiv(a, b int) (q int, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return a / b, true
+}
+
+func main() {
+	n, failed := 0, 0
+	for i := 0; i < 100000; i++ {
+		q, ok := safeDiv(i, i%10)
+		if !ok {
+			failed++
+		}
+		n += q
+	}
+	fmt.Println(n, failed)
+}
@@ -0,0 +1,23 @@
+// Floating point arithmetic: the area of the Mandelbrot set.
+package main
+
+import "fmt"
+
+func main() {
+	const size = 300
+	inside := 0
+	for py := 0; py < size; py++ {
+		for px := 0; px < size; px++ {
+			cr, ci := 3*float64(px)/size-2, 3*float64(py)/size-1.5
+			zr, zi := 0.0, 0.0
+			n := 0
+			for ; n < 50 && zr*zr+zi*zi < 4; n++ {
+				zr, zi = zr*zr-zi*zi+cr, 2*zr*zi+ci
+			}
+			if n == 50 {
+				inside++
+			}
+		}
+	}
+	fmt.Println(inside)
+}
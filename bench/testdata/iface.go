@@ -0,0 +1,33 @@
+// Interfaces: dynamic dispatch and type assertions.
+package main
+
+import "fmt"
+
+type shape interface{ area() int }
+
+type rect struct{ w, h int }
+
+type square struct{ s int }
+
+func (r rect) area() int { return r.w * r.h }
+
+func (s square) area() int { return s.s * s.s }
+
+func pick(i int) shape {
+	if i%3 == 1 {
+		return square{4}
+	}
+	return rect{2, 3 + i%3}
+}
+
+func main() {
+	total, squares := 0, 0
+	for i := 0; i < 300000; i++ {
+		s := pick(i)
+		total += s.area()
+		if _, ok := s.(square); ok {
+			squares++
+		}
+	}
+	fmt.Println(total, squares)
+}
@@ -0,0 +1,21 @@
+// Maps: insertion, lookup and deletion.
+package main
+
+import "fmt"
+
+func main() {
+	m := map[int]int{}
+	for i := 0; i < 100000; i++ {
+		m[i%5000] += i
+	}
+	hits := 0
+	for i := 0; i < 100000; i++ {
+		if _, ok := m[i]; ok {
+			hits++
+		}
+		if i%2 == 0 {
+			delete(m, i)
+		}
+	}
+	fmt.Println(len(m), hits)
+}
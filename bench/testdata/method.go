@@ -0,0 +1,18 @@
+// Method calls on a pointer receiver.
+package main
+
+import "fmt"
+
+type acc struct{ sum, n int }
+
+func (a *acc) add(v int) { a.sum += v; a.n++ }
+
+func (a *acc) mean() int { return a.sum / a.n }
+
+func main() {
+	a := &acc{}
+	for i := 0; i < 500000; i++ {
+		a.add(i % 100)
+	}
+	fmt.Println(a.mean(), a.n)
+}
@@ -0,0 +1,20 @@
+// Slices: append, indexing and slicing, in a sieve of Eratosthenes.
+package main
+
+import "fmt"
+
+func main() {
+	const n = 1000000
+	composite := make([]bool, n)
+	var primes []int
+	for i := 2; i < n; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j < n; j += i {
+			composite[j] = true
+		}
+	}
+	fmt.Println(len(primes), primes[len(primes)-3:])
+}
@@ -0,0 +1,26 @@
+// Calls of the standard library: sorting and number formatting.
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+func main() {
+	var words []string
+	x := 7
+	for i := 0; i < 20000; i++ {
+		x = (x * 31) % 100003
+		words = append(words, strconv.Itoa(x))
+	}
+	sort.Strings(words)
+	n := 0
+	for _, w := range words {
+		v, err := strconv.Atoi(w)
+		if err == nil {
+			n += v % 10
+		}
+	}
+	fmt.Println(words[0], words[len(words)-1], n)
+}
@@ -0,0 +1,29 @@
+// Strings: building, indexing and converting strings.
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	var b strings.Builder
+	for i := 0; i < 50000; i++ {
+		b.WriteString("abc")
+		b.WriteByte(byte('0' + i%10))
+	}
+	s := b.String()
+	digits := 0
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= '0' && c <= '9' {
+			digits++
+		}
+	}
+	words := 0
+	for _, r := range string([]rune(s)) {
+		if r == 'a' {
+			words++
+		}
+	}
+	fmt.Println(len(s), digits, words)
+}
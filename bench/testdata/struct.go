@@ -0,0 +1,32 @@
+// Structs: field access and struct values copy.
+package main
+
+import "fmt"
+
+type vec struct{ x, y, z float64 }
+
+type particle struct {
+	pos, vel vec
+	mass     float64
+}
+
+func main() {
+	ps := make([]particle, 100)
+	for i := range ps {
+		ps[i] = particle{vel: vec{1, float64(i), 2}, mass: 1 + float64(i%3)}
+	}
+	var energy float64
+	for step := 0; step < 10000; step++ {
+		for i := range ps {
+			p := &ps[i]
+			p.pos.x += p.vel.x
+			p.pos.y += p.vel.y
+			p.pos.z += p.vel.z
+		}
+	}
+	for _, p := range ps {
+		v := p.vel
+		energy += p.mass * (v.x*v.x + v.y*v.y + v.z*v.z) / 2
+	}
+	fmt.Println(energy, ps[99].pos)
+}
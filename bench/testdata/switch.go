@@ -0,0 +1,33 @@
+// Switch statements on integers and strings.
+package main
+
+import "fmt"
+
+func class(i int) string {
+	switch {
+	case i%15 == 0:
+		return "fizzbuzz"
+	case i%5 == 0:
+		return "buzz"
+	case i%3 == 0:
+		return "fizz"
+	}
+	return "number"
+}
+
+func main() {
+	counts := [4]int{}
+	for i := 1; i <= 300000; i++ {
+		switch class(i) {
+		case "fizz":
+			counts[0]++
+		case "buzz":
+			counts[1]++
+		case "fizzbuzz":
+			counts[2]++
+		default:
+			counts[3]++
+		}
+	}
+	fmt.Println(counts)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/containous/yaegi/bench"
+)
+
+// benchmark runs the programs of the benchmark corpus, interpreted and
+// compiled, and prints the slowdown of the interpreter for each program.
+// The exit status is non zero if a ratio regressed from the baseline.
+func benchmark(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	run := fs.String("run", "", "run only the programs matching `regexp`")
+	count := fs.Int("count", 3, "run each program `n` times, and keep the fastest run")
+	noGo := fs.Bool("nogo", false, "do not run the compiled programs")
+	asJSON := fs.Bool("json", false, "print the results as JSON objects, one per line")
+	baseline := fs.String("baseline", "", "compare the ratios with the results printed by -json in `file`")
+	tolerance := fs.Float64("tolerance", 0.2, "increase of a ratio reported as a regression, as a `fraction` of the baseline")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: yaegi bench [-run regexp] [-count n] [-nogo] [-json] [-baseline file] [-tolerance fraction] [dir|file...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	re, err := regexp.Compile(*run)
+	if err != nil {
+		return err
+	}
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"bench/testdata"}
+	}
+	programs, err := bench.Load(paths...)
+	if err != nil {
+		return err
+	}
+
+	var base []bench.Result
+	if *baseline != "" {
+		f, err := os.Open(*baseline)
+		if err != nil {
+			return err
+		}
+		base, err = bench.ReadResults(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", *baseline, err)
+		}
+	}
+
+	var results []bench.Result
+	enc := json.NewEncoder(os.Stdout)
+	for _, p := range programs {
+		if !re.MatchString(p.Name) {
+			continue
+		}
+		r, err := bench.Run(p, bench.Options{Count: *count, NoGo: *noGo})
+		if err != nil {
+			return err
+		}
+		results = append(results, r)
+		switch {
+		case *asJSON:
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		case *noGo:
+			fmt.Printf("%-12s %12s\n", r.Name, r.Yaegi.Round(time.Microsecond))
+		default:
+			fmt.Printf("%-12s %12s %12s %8.1fx\n", r.Name, r.Go.Round(time.Microsecond), r.Yaegi.Round(time.Microsecond), r.Ratio)
+		}
+	}
+
+	regressions := bench.Compare(base, results, *tolerance)
+	for _, r := range regressions {
+		fmt.Fprintln(os.Stderr, r)
+	}
+	if len(regressions) > 0 {
+		return errors.New("performance regression")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// check compiles scripts without running them, and prints all their errors.
+// The exit status is non zero if an error is found.
+func check(args []string) error {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	tags := fs.String("tags", "", "a comma-separated `list` of build tags to consider satisfied")
+	asJSON := fs.Bool("json", false, "print the errors as JSON objects, one per line")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: yaegi check [-tags tag,list] [-json] file...")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	failed := false
+	for _, name := range fs.Args() {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		s := string(b)
+
+		// Each script is compiled by its own interpreter, as when it is run
+		i := interp.New(interp.Options{GoPath: build.Default.GOPATH, GoModCache: os.Getenv("GOMODCACHE"), BuildTags: buildTags(*tags)})
+		i.Use(stdlib.Symbols)
+		i.Use(interp.Symbols)
+		i.Name = name
+		for _, err := range i.Check(s) {
+			if *asJSON {
+				printDiagnostics(err)
+			} else {
+				fmt.Fprint(os.Stderr, errorText(err))
+			}
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// A diagnostic is an error of a script, printed as a JSON object by the
+// -json option of check and the -json-errors option of run. The code is
+// the phase of the error: scan, parse, type, vet or run.
+type diagnostic struct {
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Col      int      `json:"col"`
+	Severity string   `json:"severity"` // error, or warning for a vet report
+	Message  string   `json:"message"`
+	Code     string   `json:"code"`
+	Notes    []string `json:"notes,omitempty"`
+}
+
+// diagnostics returns the diagnostics of err, one per error of a list.
+func diagnostics(err error) []diagnostic {
+	switch e := err.(type) {
+	case interp.ErrorList:
+		var diags []diagnostic
+		for _, e := range e {
+			diags = append(diags, diagnostics(e)...)
+		}
+		return diags
+	case *interp.Error:
+		d := diagnostic{File: e.Pos.Filename, Line: e.Pos.Line, Col: e.Pos.Column, Severity: "error", Message: e.Msg, Code: e.Phase.String(), Notes: e.Notes}
+		if e.Phase == interp.VetPhase {
+			d.Severity = "warning"
+		}
+		return []diagnostic{d}
+	}
+	return []diagnostic{{Severity: "error", Message: err.Error()}}
+}
+
+// printDiagnostics prints the diagnostics of err on the standard error, one
+// JSON object per line.
+func printDiagnostics(err error) {
+	enc := json.NewEncoder(os.Stderr)
+	for _, d := range diagnostics(err) {
+		if err := enc.Encode(d); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"go/build"
+	"io"
+	"log"
+	"net"
+	"os"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/interp/dap"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// debug runs a Debug Adapter Protocol server for a single session.
+func debug(args []string) error {
+	fs := flag.NewFlagSet("debug", flag.ExitOnError)
+	listen := fs.String("listen", "", "serve on TCP `address` instead of standard input and output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	newInterp := func() *interp.Interpreter {
+		i := interp.New(interp.Options{GoPath: build.Default.GOPATH, GoModCache: os.Getenv("GOMODCACHE")})
+		i.Use(stdlib.Symbols)
+		i.Use(interp.Symbols)
+		return i
+	}
+
+	if *listen != "" {
+		l, err := net.Listen("tcp", *listen)
+		if err != nil {
+			return err
+		}
+		log.Println("debug server listening on", l.Addr())
+		conn, err := l.Accept()
+		l.Close()
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		return dap.NewServer(conn, newInterp).Serve()
+	}
+
+	// The protocol runs on standard output: redirect the program output
+	// to output events.
+	s := dap.NewServer(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, newInterp)
+	if err := s.CaptureOutput(); err != nil {
+		return err
+	}
+	return s.Serve()
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// generator runs the //go:generate directives of source files, as go generate.
+type generator struct {
+	run     *regexp.Regexp // selects the directives to run, or nil for all
+	dryRun  bool           // print commands without running them
+	verbose bool           // print commands as they are run
+	tags    []string       // build tags selecting the files of a directory
+}
+
+// generate runs the //go:generate directives of the files of packages or
+// of single files, given as arguments, the current directory by default.
+func generate(args []string) error {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	run := fs.String("run", "", "run only the directives matching `regexp`")
+	dryRun := fs.Bool("n", false, "print the commands but do not run them")
+	verbose := fs.Bool("x", false, "print the commands as they are run")
+	tags := fs.String("tags", "", "a comma-separated `list` of build tags to consider satisfied")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: yaegi generate [-run regexp] [-n] [-x] [-tags tag,list] [dir|file...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	g := &generator{dryRun: *dryRun, verbose: *verbose || *dryRun, tags: buildTags(*tags)}
+	if *run != "" {
+		re, err := regexp.Compile(*run)
+		if err != nil {
+			return err
+		}
+		g.run = re
+	}
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	for _, p := range paths {
+		if err := g.generate(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate runs the directives of a file, or of the files of the package
+// in a directory, in the order of file names.
+func (g *generator) generate(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return g.generateFile(path)
+	}
+	ctx := build.Default
+	ctx.BuildTags = g.tags
+	pkg, err := ctx.ImportDir(path, 0)
+	if err != nil {
+		return err
+	}
+	var files []string
+	for _, l := range [][]string{pkg.GoFiles, pkg.TestGoFiles, pkg.XTestGoFiles} {
+		files = append(files, l...)
+	}
+	for _, name := range files {
+		if err := g.generateFile(filepath.Join(path, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generateFile runs the directives of a source file, from its directory.
+func (g *generator) generateFile(file string) error {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+	if err != nil {
+		return err
+	}
+	src, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	aliases := map[string][]string{} // commands defined by -command
+	scanner := bufio.NewScanner(src)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if !strings.HasPrefix(text, "//go:generate ") && !strings.HasPrefix(text, "//go:generate\t") {
+			continue
+		}
+		if g.run != nil && !g.run.MatchString(text) {
+			continue
+		}
+		pos := fmt.Sprintf("%s:%d", file, line)
+		words, err := splitDirective(text[len("//go:generate "):])
+		if err != nil {
+			return fmt.Errorf("%s: %v", pos, err)
+		}
+		if len(words) == 0 {
+			return fmt.Errorf("%s: no arguments to directive", pos)
+		}
+		env := []string{
+			"GOARCH=" + runtime.GOARCH,
+			"GOOS=" + runtime.GOOS,
+			"GOFILE=" + filepath.Base(file),
+			"GOLINE=" + strconv.Itoa(line),
+			"GOPACKAGE=" + f.Name.Name,
+			"DOLLAR=$",
+		}
+		for i, w := range words {
+			words[i] = os.Expand(w, func(name string) string {
+				for _, e := range env {
+					if strings.HasPrefix(e, name+"=") {
+						return e[len(name)+1:]
+					}
+				}
+				return os.Getenv(name)
+			})
+		}
+		if words[0] == "-command" {
+			if len(words) < 3 {
+				return fmt.Errorf("%s: usage: -command name command args...", pos)
+			}
+			aliases[words[1]] = words[2:]
+			continue
+		}
+		if a, ok := aliases[words[0]]; ok {
+			words = append(append([]string{}, a...), words[1:]...)
+		}
+		if err := g.exec(filepath.Dir(file), words, env); err != nil {
+			return fmt.Errorf("%s: running %q: %v", pos, words[0], err)
+		}
+	}
+	return scanner.Err()
+}
+
+// exec runs a command in directory dir, with the variables of env added to
+// the environment. The command yaegi runs the current executable.
+func (g *generator) exec(dir string, words, env []string) error {
+	if g.verbose {
+		fmt.Fprintln(os.Stderr, strings.Join(words, " "))
+	}
+	if g.dryRun {
+		return nil
+	}
+	name := words[0]
+	if name == "yaegi" {
+		if self, err := os.Executable(); err == nil {
+			name = self
+		}
+	}
+	cmd := exec.Command(name, words[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// splitDirective splits the arguments of a directive into words, separated
+// by spaces, a double-quoted string being a single word, unquoted as in Go.
+func splitDirective(s string) ([]string, error) {
+	var words []string
+	for {
+		s = strings.TrimLeft(s, " \t")
+		if s == "" {
+			return words, nil
+		}
+		if s[0] != '"' {
+			i := strings.IndexAny(s, " \t")
+			if i < 0 {
+				i = len(s)
+			}
+			words = append(words, s[:i])
+			s = s[i:]
+			continue
+		}
+		// Find the closing quote, skipping escaped characters
+		i := 1
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' {
+				i++
+			}
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("unterminated quoted string")
+		}
+		w, err := strconv.Unquote(s[:i+1])
+		if err != nil {
+			return nil, err
+		}
+		words = append(words, w)
+		s = s[i+1:]
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/interp/jupyter"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// kernel runs a Jupyter kernel, on the sockets described by a connection file.
+func kernel(args []string) error {
+	fs := flag.NewFlagSet("kernel", flag.ExitOnError)
+	install := fs.Bool("install", false, "install the kernel specification for Jupyter, and exit")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *install {
+		return installKernel()
+	}
+	if fs.NArg() != 1 {
+		return errors.New("usage: yaegi kernel [-install] connection_file")
+	}
+
+	b, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	c := &jupyter.Connection{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return err
+	}
+
+	s, err := jupyter.NewServer(c, func() *interp.Interpreter {
+		i := interp.New(interp.Options{GoPath: build.Default.GOPATH, GoModCache: os.Getenv("GOMODCACHE"), AutoImport: true})
+		i.Use(stdlib.Symbols)
+		i.Use(interp.Symbols)
+		return i
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.CaptureOutput(); err != nil {
+		return err
+	}
+
+	// Some clients interrupt the kernel with a signal instead of a message
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	go func() {
+		for range sig {
+			s.Interrupt()
+		}
+	}()
+	return s.Serve()
+}
+
+// installKernel writes the kernel specification in the Jupyter data
+// directory of the user, so the kernel is proposed by Jupyter.
+func installKernel() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	dir := os.Getenv("JUPYTER_DATA_DIR")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		switch runtime.GOOS {
+		case "darwin":
+			dir = filepath.Join(home, "Library", "Jupyter")
+		case "windows":
+			dir = filepath.Join(os.Getenv("APPDATA"), "jupyter")
+		default:
+			dir = filepath.Join(home, ".local", "share", "jupyter")
+		}
+	}
+	dir = filepath.Join(dir, "kernels", "yaegi")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(map[string]interface{}{
+		"argv":           []string{exe, "kernel", "{connection_file}"},
+		"display_name":   "Go (yaegi)",
+		"language":       "go",
+		"interrupt_mode": "message",
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "kernel.json"), append(b, '\n'), 0644)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"go/build"
+	"io"
+	"os"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/interp/lsp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// lspServer runs a Language Server Protocol server on standard input and output.
+func lspServer(args []string) error {
+	fs := flag.NewFlagSet("lsp", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	newInterp := func() *interp.Interpreter {
+		i := interp.New(interp.Options{GoPath: build.Default.GOPATH, GoModCache: os.Getenv("GOMODCACHE")})
+		i.Use(stdlib.Symbols)
+		i.Use(interp.Symbols)
+		return i
+	}
+
+	return lsp.NewServer(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, newInterp).Serve()
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"go/build"
+	"io"
+	"log"
+	"net"
+	"os"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/interp/rpcserver"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// rpc runs a JSON-RPC evaluation service, on standard input and output or
+// for each client connecting to a TCP address.
+func rpc(args []string) error {
+	fs := flag.NewFlagSet("rpc", flag.ExitOnError)
+	listen := fs.String("listen", "", "serve on TCP `address` instead of standard input and output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	newInterp := func() *interp.Interpreter {
+		i := interp.New(interp.Options{GoPath: build.Default.GOPATH, GoModCache: os.Getenv("GOMODCACHE")})
+		i.Use(stdlib.Symbols)
+		i.Use(interp.Symbols)
+		return i
+	}
+
+	if *listen == "" {
+		return rpcserver.NewServer(struct {
+			io.Reader
+			io.Writer
+		}{os.Stdin, os.Stdout}, newInterp).Serve()
+	}
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		return err
+	}
+	log.Println("rpc server listening on", l.Addr())
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			if err := rpcserver.NewServer(conn, newInterp).Serve(); err != nil {
+				log.Println(err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"testing/fstest"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// playgroundPkg lists the packages which can be imported by the programs
+// run by serve: they give no access to the host filesystem, network or
+// processes. Packages reading files, even through the methods of their
+// types only, as text/template, are excluded.
+var playgroundPkg = []string{
+	"bufio", "bytes", "container/heap", "container/list", "container/ring", "context",
+	"encoding/base64", "encoding/hex", "encoding/json", "errors", "fmt", "io", "math",
+	"math/big", "math/bits", "math/cmplx", "math/rand", "path", "regexp", "sort",
+	"strconv", "strings", "sync", "sync/atomic", "text/tabwriter",
+	"time", "unicode", "unicode/utf16", "unicode/utf8",
+}
+
+// maxSource is the maximum size of a program run by serve, and maxOutput
+// the maximum size kept of each of its output streams.
+const (
+	maxSource = 1 << 20
+	maxOutput = 1 << 20
+)
+
+// serve runs an HTTP server, which runs the programs posted to /run in a
+// sandboxed interpreter, and serves a page to edit and run them at /.
+func serve(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	listen := fs.String("listen", "localhost:8080", "serve on TCP `address`")
+	timeout := fs.Duration("timeout", 5*time.Second, "maximum `duration` of a run")
+	maxMemory := fs.Int64("max-memory", 64<<20, "maximum memory, in `bytes`, allocated by a run")
+	maxSteps := fs.Int64("max-steps", 0, "maximum number of execution `steps` of a run, or 0 if unlimited")
+	maxRuns := fs.Int("max-runs", runtime.NumCPU(), "maximum `number` of programs running at once")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	p := newPlayground(*timeout, *maxRuns, interp.Options{
+		AllowedPackages: playgroundPkg,
+		SourcecodeFS:    fstest.MapFS{},
+		MaxMemory:       *maxMemory,
+		MaxSteps:        *maxSteps,
+	})
+	http.HandleFunc("/", p.page)
+	http.HandleFunc("/run", p.run)
+	log.Println("playground listening on", *listen)
+	return http.ListenAndServe(*listen, nil)
+}
+
+// playground runs programs for serve.
+//
+// A program blocked in binary code, as by sync.Mutex.Lock or time.Sleep, can
+// not be stopped at the timeout: its run keeps a slot of runs until it really
+// terminates, so that such programs can not exhaust the resources of the
+// server. Requests are rejected while all the slots are held.
+type playground struct {
+	timeout time.Duration
+	opt     interp.Options
+	runs    chan struct{} // semaphore of the running programs
+}
+
+// newPlayground returns a playground running at most maxRuns programs at once,
+// each for at most timeout, in interpreters of options opt.
+func newPlayground(timeout time.Duration, maxRuns int, opt interp.Options) *playground {
+	return &playground{
+		timeout: timeout,
+		opt:     opt,
+		runs:    make(chan struct{}, maxRuns),
+	}
+}
+
+// runResult is the JSON response of /run.
+type runResult struct {
+	Stdout string `json:"stdout"`
+	Stderr string `json:"stderr"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (p *playground) run(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxSource))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	select {
+	case p.runs <- struct{}{}:
+	default:
+		http.Error(w, "too many programs running, retry later", http.StatusServiceUnavailable)
+		return
+	}
+
+	stdout, stderr := &limitWriter{max: maxOutput}, &limitWriter{max: maxOutput}
+	opt := p.opt
+	opt.Stdin, opt.Stdout, opt.Stderr = strings.NewReader(""), stdout, stderr
+	i := interp.New(opt)
+	i.Use(stdlib.Symbols)
+
+	ctx, cancel := context.WithTimeout(r.Context(), p.timeout)
+	defer cancel()
+	var res runResult
+	if err := p.eval(ctx, i, string(b)); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = errors.New("timeout: program ran for more than " + p.timeout.String())
+		}
+		res.Error = err.Error()
+	}
+	res.Stdout, res.Stderr = stdout.String(), stderr.String()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.Println(err)
+	}
+}
+
+// eval runs the program src in the interpreter i, holding a slot of p.runs.
+// If ctx is done first, the program is stopped and ctx.Err() is returned,
+// while the slot is released only once the evaluation and the goroutines of
+// the program have terminated.
+func (p *playground) eval(ctx context.Context, i *interp.Interpreter, src string) error {
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+			if i.NumGoroutine() == 0 {
+				<-p.runs
+				done <- err
+				return
+			}
+			done <- err
+			// Stop the goroutines left running by the program
+			i.Stop()
+			<-p.runs
+		}()
+		_, err = i.Eval(src)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		go i.Stop()
+		return ctx.Err()
+	}
+}
+
+func (p *playground) page(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(playgroundPage))
+}
+
+// limitWriter keeps the first max bytes written to it. It is safe for
+// concurrent use, as the output of interpreted goroutines.
+type limitWriter struct {
+	mutex sync.Mutex
+	max   int
+	buf   strings.Builder
+	cut   bool
+}
+
+func (l *limitWriter) Write(b []byte) (int, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if n := l.max - l.buf.Len(); len(b) > n {
+		l.buf.Write(b[:n])
+		l.cut = true
+	} else {
+		l.buf.Write(b)
+	}
+	return len(b), nil
+}
+
+// String returns the output kept, followed by a mark if it was truncated.
+func (l *limitWriter) String() string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.cut {
+		return l.buf.String() + "\n[output truncated]\n"
+	}
+	return l.buf.String()
+}
+
+const playgroundPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Yaegi playground</title>
+<style>
+body { font-family: sans-serif; margin: 1em; }
+textarea, pre { font-family: monospace; width: 100%; box-sizing: border-box; }
+pre { background: #f4f4f4; padding: 0.5em; min-height: 4em; white-space: pre-wrap; }
+.error { color: #c00; }
+</style>
+</head>
+<body>
+<textarea id="src" rows="20" spellcheck="false">package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Hello, playground")
+}
+</textarea>
+<p><button id="run">Run</button></p>
+<pre id="out"></pre>
+<script>
+document.getElementById("run").onclick = function() {
+	var out = document.getElementById("out");
+	out.textContent = "Running...";
+	fetch("run", {method: "POST", body: document.getElementById("src").value})
+		.then(function(r) { return r.json(); })
+		.then(function(res) {
+			out.textContent = res.stdout + res.stderr;
+			if (res.error) {
+				var e = document.createElement("span");
+				e.className = "error";
+				e.textContent = res.error;
+				out.appendChild(e);
+			}
+		})
+		.catch(function(err) { out.textContent = err; });
+};
+</script>
+</body>
+</html>
+`
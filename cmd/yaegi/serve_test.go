@@ -0,0 +1,128 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+)
+
+func TestPlaygroundTimeout(t *testing.T) {
+	const maxRuns = 2
+	p := newPlayground(50*time.Millisecond, maxRuns, interp.Options{AllowedPackages: playgroundPkg})
+	src := `package main
+
+import "sync"
+
+func main() {
+	var mu sync.Mutex
+	mu.Lock()
+	mu.Lock()
+}
+`
+	before := runtime.NumGoroutine()
+	var rejected int
+	for i := 0; i < 20; i++ {
+		w := httptest.NewRecorder()
+		p.run(w, httptest.NewRequest(http.MethodPost, "/run", strings.NewReader(src)))
+		switch w.Code {
+		case http.StatusOK:
+			if !strings.Contains(w.Body.String(), "timeout") {
+				t.Fatalf("got %s, want a timeout error", w.Body.String())
+			}
+		case http.StatusServiceUnavailable:
+			rejected++
+		default:
+			t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+		}
+	}
+	if rejected != 20-maxRuns {
+		t.Errorf("got %d rejected runs, want %d", rejected, 20-maxRuns)
+	}
+
+	// Each blocked run keeps its evaluation goroutine, the one stopping it,
+	// and the ones forwarding the output of its interpreter
+	if n := runtime.NumGoroutine() - before; n > 4*maxRuns {
+		t.Errorf("got %d more goroutines after repeated timeouts, want at most %d", n, 4*maxRuns)
+	}
+}
+
+func TestPlaygroundRelease(t *testing.T) {
+	p := newPlayground(50*time.Millisecond, 1, interp.Options{AllowedPackages: playgroundPkg})
+	src := `package main
+
+import "time"
+
+func main() {
+	time.Sleep(200 * time.Millisecond)
+}
+`
+	w := httptest.NewRecorder()
+	p.run(w, httptest.NewRequest(http.MethodPost, "/run", strings.NewReader(src)))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "timeout") {
+		t.Fatalf("got status %d: %s, want a timeout error", w.Code, w.Body.String())
+	}
+
+	// The slot of the run is released once the sleep returns
+	w = httptest.NewRecorder()
+	p.run(w, httptest.NewRequest(http.MethodPost, "/run", strings.NewReader(src)))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("got status %d, want %d while the program sleeps", w.Code, http.StatusServiceUnavailable)
+	}
+	time.Sleep(300 * time.Millisecond)
+	w = httptest.NewRecorder()
+	p.run(w, httptest.NewRequest(http.MethodPost, "/run", strings.NewReader("package main\n\nfunc main() {}\n")))
+	if w.Code != http.StatusOK || strings.Contains(w.Body.String(), "error") {
+		t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPlaygroundRuns(t *testing.T) {
+	p := newPlayground(time.Second, 1, interp.Options{AllowedPackages: playgroundPkg})
+	src := "package main\n\nimport \"fmt\"\n\nfunc main() { fmt.Println(1) }\n"
+	before := runtime.NumGoroutine()
+	for i := 0; i < 20; i++ {
+		w := httptest.NewRecorder()
+		p.run(w, httptest.NewRequest(http.MethodPost, "/run", strings.NewReader(src)))
+		if w.Code != http.StatusOK {
+			t.Fatalf("got status %d: %s", w.Code, w.Body.String())
+		}
+	}
+
+	// The goroutines of the terminated runs exit once their interpreters are collected
+	n := runtime.NumGoroutine() - before
+	for i := 0; i < 50 && n > 0; i++ {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+		n = runtime.NumGoroutine() - before
+	}
+	if n > 0 {
+		t.Errorf("got %d more goroutines after the runs", n)
+	}
+}
+
+func TestPlaygroundMemory(t *testing.T) {
+	p := newPlayground(5*time.Second, 1, interp.Options{AllowedPackages: playgroundPkg, MaxMemory: 1 << 20})
+	src := `package main
+
+import "strings"
+
+func main() {
+	s := strings.Repeat("a", 1000)
+	n := 0
+	for i := 0; i < 1<<20; i++ {
+		n += len([]byte(s))
+	}
+	println(n)
+}
+`
+	w := httptest.NewRecorder()
+	p.run(w, httptest.NewRequest(http.MethodPost, "/run", strings.NewReader(src)))
+	if !strings.Contains(w.Body.String(), interp.ErrMemoryLimit.Error()) {
+		t.Errorf("got %s, want a memory limit error", w.Body.String())
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"go/build"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// test runs the tests and benchmarks of the package in a directory,
+// with an output compatible with a test binary built by go test.
+func test(args []string) error {
+	fs := flag.NewFlagSet("test", flag.ExitOnError)
+	verbose := fs.Bool("v", false, "verbose: print additional output")
+	run := fs.String("run", "", "run only tests matching `regexp`")
+	bench := fs.String("bench", "", "run only benchmarks matching `regexp`")
+	short := fs.Bool("short", false, "tell long running tests to shorten their run time")
+	cover := fs.Bool("cover", false, "enable coverage analysis")
+	coverProfile := fs.String("coverprofile", "", "write a coverage profile to `file`, implies -cover")
+	tags := fs.String("tags", "", "a comma-separated `list` of build tags to consider satisfied")
+	seed := fs.Int64("seed", 0, "make the order of map iterations and select choices a function of `n`, if not 0")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	*cover = *cover || *coverProfile != ""
+	dir := "."
+	if fs.NArg() > 0 {
+		dir = fs.Arg(0)
+	}
+
+	// Configure the testing package as go test does, through its flags
+	testing.Init()
+	for name, value := range map[string]string{
+		"test.v":            strconv.FormatBool(*verbose),
+		"test.run":          *run,
+		"test.bench":        *bench,
+		"test.short":        strconv.FormatBool(*short),
+		"test.coverprofile": *coverProfile,
+	} {
+		if err := flag.Set(name, value); err != nil {
+			return err
+		}
+	}
+
+	i := interp.New(interp.Options{
+		GoPath:     build.Default.GOPATH,
+		GoModCache: os.Getenv("GOMODCACHE"),
+		Cover:      *cover,
+		BuildTags:  buildTags(*tags),
+		Seed:       *seed,
+	})
+	i.Use(stdlib.Symbols)
+	i.Use(interp.Symbols)
+	i.Name = dir
+
+	tests, benchmarks, err := i.Test(dir)
+	if err != nil {
+		return err
+	}
+	// Run prints the results, and the exit status is non zero on failure, as by testing.Main
+	os.Exit(testing.MainStart(testDeps{i, *cover}, tests, benchmarks, nil, nil).Run())
+	return nil
+}
+
+// testDeps implements the dependencies of testing.MainStart, as testing.Main
+// does, and reports the coverage of the interpreted package. Its methods
+// follow the testDeps interface of the testing package.
+type testDeps struct {
+	interp *interp.Interpreter
+	cover  bool
+}
+
+// corpusEntry is the type of fuzzing corpus entries, as defined by the testing package.
+type corpusEntry = struct {
+	Parent     string
+	Path       string
+	Data       []byte
+	Values     []interface{}
+	Generation int
+	IsSeed     bool
+}
+
+var errTestDeps = errors.New("testing: unsupported by yaegi test")
+
+func (d testDeps) ImportPath() string                          { return "" }
+func (d testDeps) ModulePath() string                          { return "" }
+func (d testDeps) MatchString(pat, str string) (bool, error)   { return matchString(pat, str) }
+func (d testDeps) SetPanicOnExit0(bool)                        {}
+func (d testDeps) StartCPUProfile(io.Writer) error             { return errTestDeps }
+func (d testDeps) StopCPUProfile()                             {}
+func (d testDeps) StartTestLog(io.Writer)                      {}
+func (d testDeps) StopTestLog() error                          { return errTestDeps }
+func (d testDeps) WriteProfileTo(string, io.Writer, int) error { return errTestDeps }
+func (d testDeps) CoordinateFuzzing(time.Duration, int64, time.Duration, int64, int, []corpusEntry, []reflect.Type, string, string) error {
+	return errTestDeps
+}
+func (d testDeps) RunFuzzWorker(func(corpusEntry) error) error { return errTestDeps }
+func (d testDeps) ReadCorpus(string, []reflect.Type) ([]corpusEntry, error) {
+	return nil, errTestDeps
+}
+func (d testDeps) CheckCorpus([]interface{}, []reflect.Type) error { return nil }
+func (d testDeps) ResetCoverage()                                  {}
+func (d testDeps) SnapshotCoverage()                               {}
+
+// InitRuntimeCoverage returns the coverage mode, or an empty string if the
+// coverage is disabled, a function called to report coverage at the end of
+// tests, and a function returning the current coverage.
+func (d testDeps) InitRuntimeCoverage() (mode string, tearDown func(string, string) (string, error), snapcov func() float64) {
+	if !d.cover {
+		return "", nil, nil
+	}
+	tearDown = func(coverProfile, _ string) (string, error) {
+		fmt.Printf("coverage: %.1f%% of statements\n", 100*d.interp.Coverage())
+		if coverProfile == "" {
+			return "", nil
+		}
+		f, err := os.Create(coverProfile)
+		if err != nil {
+			return "error creating coverage profile", err
+		}
+		if err := d.interp.WriteCoverProfile(f); err != nil {
+			return "error writing coverage profile", err
+		}
+		if err := f.Close(); err != nil {
+			return "error writing coverage profile", err
+		}
+		return "", nil
+	}
+	return "count", tearDown, d.interp.Coverage
+}
+
+var matchRe = map[string]*regexp.Regexp{}
+
+// matchString reports whether str matches the regular expression pat.
+func matchString(pat, str string) (bool, error) {
+	re, ok := matchRe[pat]
+	if !ok {
+		var err error
+		if re, err = regexp.Compile(pat); err != nil {
+			return false, err
+		}
+		matchRe[pat] = re
+	}
+	return re.MatchString(str), nil
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+// watchPeriod is the period of checks for changed files.
+const watchPeriod = 500 * time.Millisecond
+
+// watch runs the script file, then runs it again from a new interpreter
+// each time the script or its interpreted dependencies change. Changed
+// files are detected by polling their modification time. It only returns on error.
+func watch(file string, options interp.Options) error {
+	// The directory of the script must be absolute to locate its go.mod
+	path, err := filepath.Abs(file)
+	if err != nil {
+		return err
+	}
+	var errs []string // errors of the previous run
+	for {
+		fsys := &watchFS{times: map[string]time.Time{}}
+		fsys.record(path)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() { done <- runWatched(ctx, path, fsys, options) }()
+
+		changed := ""
+		ticker := time.NewTicker(watchPeriod)
+		for changed == "" {
+			select {
+			case err := <-done:
+				errs = reportErrors(file, errs, err)
+				done = nil
+			case <-ticker.C:
+				changed = fsys.changed()
+			}
+		}
+		ticker.Stop()
+		cancel()
+		if done != nil {
+			<-done
+		}
+		if rel, err := filepath.Rel(filepath.Dir(path), changed); err == nil {
+			changed = rel
+		}
+		fmt.Fprintf(os.Stderr, "yaegi: %s changed, restarting %s\n", changed, file)
+	}
+}
+
+// runWatched runs the script file in a new interpreter, reading source
+// files from fsys, until completion or cancelation of ctx.
+func runWatched(ctx context.Context, file string, fsys fs.FS, options interp.Options) (err error) {
+	b, err := fs.ReadFile(fsys, file)
+	if err != nil {
+		return err
+	}
+	s := string(b)
+
+	options.SourcecodeFS = fsys
+	i := interp.New(options)
+	i.Use(stdlib.Symbols)
+	i.Use(interp.Symbols)
+	i.Name = file
+
+	// Flags and panics of a run must not affect the next ones
+	resetFlags()
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	_, err = i.EvalWithContext(ctx, s)
+	return err
+}
+
+// reportErrors prints the outcome of a completed run of file, and the
+// difference between its errors and the previous ones, prefixed by "+" for
+// new errors and by "-" for fixed ones. It returns the errors of the run.
+func reportErrors(file string, prev []string, err error) []string {
+	var errs []string
+	if err != nil && !errors.Is(err, context.Canceled) {
+		errs = strings.Split(strings.TrimSpace(err.Error()), "\n")
+	}
+	status := "exited"
+	if len(errs) > 0 {
+		status = "failed"
+	}
+	fmt.Fprintf(os.Stderr, "yaegi: %s %s, waiting for changes\n", file, status)
+	if len(errs) > 0 && strings.Join(errs, "\n") == strings.Join(prev, "\n") {
+		fmt.Fprintln(os.Stderr, "  same errors as previous run")
+		return errs
+	}
+
+	seen := map[string]bool{}
+	for _, e := range prev {
+		seen[e] = true
+	}
+	for _, e := range errs {
+		if !seen[e] {
+			fmt.Fprintln(os.Stderr, "+", e)
+		}
+		delete(seen, e)
+	}
+	for _, e := range prev {
+		if seen[e] {
+			fmt.Fprintln(os.Stderr, "-", e)
+		}
+	}
+	return errs
+}
+
+// watchFS is the OS filesystem, recording the modification time of the
+// files and directories opened by the interpreter.
+type watchFS struct {
+	mutex sync.Mutex
+	times map[string]time.Time // modification times, zero for missing files, indexed by name
+}
+
+// Open implements fs.FS.
+func (w *watchFS) Open(name string) (fs.File, error) {
+	w.record(name)
+	return os.Open(name)
+}
+
+func (w *watchFS) record(name string) {
+	var t time.Time
+	if info, err := os.Stat(name); err == nil {
+		t = info.ModTime()
+	}
+	w.mutex.Lock()
+	if _, ok := w.times[name]; !ok {
+		w.times[name] = t
+	}
+	w.mutex.Unlock()
+}
+
+// changed returns the name of a recorded file which is created, modified
+// or removed since it was opened, or an empty string.
+func (w *watchFS) changed() string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for name, t := range w.times {
+		var mt time.Time
+		if info, err := os.Stat(name); err == nil {
+			mt = info.ModTime()
+		}
+		if !mt.Equal(t) {
+			return name
+		}
+	}
+	return ""
+}
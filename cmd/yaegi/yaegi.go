@@ -6,19 +6,188 @@ and evaluates them.
 
 If invoked with no arguments, it processes the standard input
 in a Read-Eval-Print-Loop. A prompt is displayed if standard input
-is a terminal.
+is a terminal. In a terminal, lines can be edited, previous lines are
+recalled with the up and down arrows from a history saved in
+$HOME/.yaegi_history, and package names, identifiers and fields are
+completed with the tab key. Input continues on several lines until
+braces, brackets and parentheses are balanced. Lines starting with a colon
+are meta-commands, listed by ":help": ":doc fmt.Println" prints the
+declaration and the documentation of a package, symbol or method, ":vars",
+":types" and ":imports" list the declarations of the session, ":load file"
+evaluates a file, ":save file" writes the session as a Go program,
+":time expr" reports the time spent evaluating expr and ":reset" starts
+over.
 
 Given a file, it operates on that file. if the first line starts with
 "#!/usr/bin/env yaegi", and the file has exec permission, then the file
-can be invoked directly from the shell.
+can be invoked directly from the shell. The file can also be given to the
+run subcommand, followed by options, as in "yaegi run -download script".
+Given a directory, it runs the main package of the directory, made of all
+its files, as "go run". The arguments following the script are passed to it
+in os.Args, with the script as os.Args[0], and are parsed by the flag
+package of the script, so options of yaegi must precede the script.
+Relative imports, such as "./lib", are resolved from the script directory.
+
+With the -e option, the given code is evaluated instead of a script, as a
+line of the REPL, with the standard library packages imported implicitly,
+and the remaining arguments are passed to it in os.Args:
+
+	yaegi -e 'fmt.Println(strings.Join(os.Args[1:], " "))' a b
+
+Imported source packages are resolved from the go.mod file of the script
+directory or its parents, if any: packages of the main module are loaded
+from its directory, and required modules from the module cache, $GOMODCACHE
+or $GOPATH/pkg/mod, honoring replace directives. Otherwise, packages are
+loaded from the vendor directory of the script, if present, then from
+$GOPATH/src. With the -download option, required modules
+missing from the module cache are downloaded with "go mod download".
+
+In watch mode, a run in progress is canceled before restart, but a call
+blocked in a binary package is not interrupted, and a script calling
+os.Exit terminates yaegi. The -download option is ignored in watch mode.
 
 In file mode, as in standard Go, files are read entirely, then parsed,
 then evaluated. In REPL mode, each line is parsed and evaluated separately,
-at global level in an implicit main package.
+at global level in an implicit main package. The standard library packages
+are imported implicitly when used, as with goimports, so that "strings" in
+strings.ToUpper("a") needs no import declaration. A function can be
+redefined: if its signature is unchanged, the code and the values already
+referring to it use the new definition.
 
 Options:
     -i
 	   start an interactive REPL after file execution
+    -e code
+	   evaluate code instead of a script
+    -download
+	   download the modules required by go.mod which are missing from
+	   the module cache, with the go command
+    -cpuprofile file
+	   write a CPU profile of the interpreted program to file, in the
+	   pprof format, to be analyzed with go tool pprof
+    -race
+	   report data races between interpreted goroutines on the standard
+	   error, with the stacks of the racing accesses
+    -unsafe
+	   allow the import of package unsafe, denied by default
+    -tags tag,list
+	   a comma-separated list of build tags to consider satisfied, as
+	   for go build, to include or exclude the files of the script and
+	   of its imported packages with build constraints
+    -generate
+	   run the //go:generate directives of the script, or of the files
+	   of the script directory, before running it, as yaegi generate
+    -json-errors
+	   print the compilation errors and the panic of the script on the
+	   standard error as JSON objects, one per line, with the fields
+	   file, line, col, severity, message and code, as yaegi check -json
+
+The debug subcommand runs a Debug Adapter Protocol server, to debug
+interpreted programs from an editor such as VS Code:
+
+	yaegi debug [-listen address]
+
+The server communicates on standard input and output, or on a TCP address
+if -listen is set. The program to debug is given by the launch request of
+the client.
+
+The lsp subcommand runs a Language Server Protocol server on standard input
+and output, to edit scripts in an editor with diagnostics, hover
+information, go to definition and completion, including for the symbols of
+the standard library:
+
+	yaegi lsp
+
+The test subcommand runs the tests and benchmarks of the package in a
+directory, the current one by default, with an output similar to go test:
+
+	yaegi test [-v] [-run regexp] [-bench regexp] [-short] [-cover] [-coverprofile file] [-tags tag,list] [-seed n] [dir]
+
+With -cover, the coverage of the statements of the package, excluding test
+files, is reported. With -coverprofile, a coverage profile is written to
+file, in the format of go test, to be analyzed with go tool cover. With a
+non zero -seed, the iteration order of maps and the choice among ready select
+cases are deterministic, to reproduce the failures of tests depending on
+them, and bisect them by varying the seed.
+
+The bench subcommand runs the programs of a benchmark corpus, each
+exercising a language feature, interpreted and compiled by the go command,
+and prints their run times and the slowdown of the interpreter:
+
+	yaegi bench [-run regexp] [-count n] [-nogo] [-json] [-baseline file] [-tolerance fraction] [dir|file...]
+
+The corpus of the yaegi repository, in bench/testdata, is run by default.
+Each program is run -count times, 3 by default, and the fastest run is kept.
+The outputs of the interpreted and compiled programs must be the same. With
+-json, the results are printed as JSON objects, one per line, to be compared
+by a later run with -baseline: the exit status is then non zero if a ratio
+increased by more than -tolerance, 20% by default.
+
+The generate subcommand runs the commands of the //go:generate directives
+of source files, as go generate, so script projects relying on generated
+code can be built without the go command:
+
+	yaegi generate [-run regexp] [-n] [-x] [-tags tag,list] [dir|file...]
+
+The files of the package in a directory, the current one by default, are
+processed in the order of their names, or only the given files. Commands run
+in the directory of their file, with $GOFILE, $GOLINE, $GOPACKAGE, $GOOS,
+$GOARCH and $DOLLAR set, and the -command directive defines aliases, as in
+go generate. The command yaegi runs the yaegi executable itself, so that
+generators can be scripts. With -run, only the directives matching regexp
+are run. With -n, commands are printed but not run, and with -x, they are
+printed as they are run.
+
+The check subcommand compiles scripts without running them, as a linter, and
+prints all the errors found, continuing after an error at the next top level
+declaration or statement. As for the errors of a script being run, the source
+line of each error is printed with a caret under its column, followed by the
+types of the operands involved, or a suggestion for a misspelled name:
+
+	yaegi check [-tags tag,list] [-json] file...
+
+With -json, each error is printed as a JSON object on one line, for editors
+and continuous integration, with the fields file, line, col, severity
+(error, or warning for a suspicious construct), message, code (the phase of
+the error: scan, parse, type, vet or run) and notes, if any.
+
+The kernel subcommand runs a Jupyter kernel, to evaluate the cells of Go
+notebooks in Jupyter or nteract, on the sockets described by the connection
+file given by the client:
+
+	yaegi kernel connection_file
+
+The kernel is registered in Jupyter by "yaegi kernel -install". Cells are
+evaluated as lines of the REPL, and the value of a cell ending with an
+expression is displayed, as an image for an image.Image, or as HTML,
+Markdown, SVG, PNG, JPEG or JSON data built by the functions of package
+github.com/containous/yaegi/interp/jupyter.
+
+The rpc subcommand runs a JSON-RPC 2.0 service, for other processes to
+evaluate code in interpreter sessions, as described by the documentation of
+package github.com/containous/yaegi/interp/rpcserver:
+
+	yaegi rpc [-listen address]
+
+The service runs on standard input and output, or for each client
+connecting to a TCP address if -listen is set.
+
+The serve subcommand runs a web playground: an HTTP server which serves a
+page to edit and run programs, and runs the program posted to /run in a
+sandboxed interpreter, returning its output and error as JSON:
+
+	yaegi serve [-listen address] [-timeout duration] [-max-memory bytes] [-max-steps n] [-max-runs n]
+
+Programs can only import packages without access to the host filesystem,
+network or processes, such as fmt, strings or encoding/json. A run is
+stopped after -timeout, 5 seconds by default, or once it allocates more
+than -max-memory, 64 MB by default. The memory allocated inside the
+functions of packages, as strings.Repeat, is not accounted: it is only
+bounded by the timeout.
+At most -max-runs programs, the number of CPUs by default, run at once.
+A program blocked in a function of a package, as sync.Mutex.Lock or
+time.Sleep, can not be stopped: it keeps its place until the call returns,
+and requests are rejected with status 503 while all places are taken.
 
 Debugging support (may be removed at any time):
   YAEGI_AST_DOT=1
@@ -38,12 +207,31 @@ import (
 	"strings"
 
 	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/interp/profile"
 	"github.com/containous/yaegi/stdlib"
 )
 
 func main() {
 	var interactive bool
+	var download bool
+	var cpuprofile string
+	var watchMode bool
+	var race bool
+	var allowUnsafe bool
+	var tags string
+	var expr string
+	var gen bool
+	var jsonErrors bool
 	flag.BoolVar(&interactive, "i", false, "start an interactive REPL")
+	flag.BoolVar(&download, "download", false, "download missing modules required by go.mod")
+	flag.StringVar(&cpuprofile, "cpuprofile", "", "write a CPU profile of the interpreted program to `file`")
+	flag.BoolVar(&watchMode, "watch", false, "restart the script when its source files change")
+	flag.BoolVar(&race, "race", false, "report data races between interpreted goroutines")
+	flag.BoolVar(&allowUnsafe, "unsafe", false, "allow the import of package unsafe")
+	flag.StringVar(&tags, "tags", "", "a comma-separated `list` of build tags to consider satisfied")
+	flag.StringVar(&expr, "e", "", "evaluate `code` instead of a script")
+	flag.BoolVar(&gen, "generate", false, "run the //go:generate directives of the script before running it")
+	flag.BoolVar(&jsonErrors, "json-errors", false, "print the errors as JSON objects on the standard error")
 	flag.Usage = func() {
 		fmt.Println("Usage:", os.Args[0], "[options] [script] [args]")
 		fmt.Println("Options:")
@@ -53,29 +241,154 @@ func main() {
 	args := flag.Args()
 	log.SetFlags(log.Lshortfile)
 
-	i := interp.New(interp.Options{GoPath: build.Default.GOPATH})
+	if len(args) > 0 && args[0] == "debug" {
+		if err := debug(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "lsp" {
+		if err := lspServer(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "check" {
+		if err := check(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "generate" {
+		if err := generate(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "test" {
+		if err := test(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "bench" {
+		if err := benchmark(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "rpc" {
+		if err := rpc(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "serve" {
+		if err := serve(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "kernel" {
+		if err := kernel(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(args) > 0 && args[0] == "run" {
+		// "yaegi run [options] script" is the same as "yaegi [options] script"
+		if err := flag.CommandLine.Parse(args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		args = flag.Args()
+	}
+
+	options := interp.Options{
+		GoPath:       build.Default.GOPATH,
+		GoModCache:   os.Getenv("GOMODCACHE"),
+		AutoDownload: download,
+		DetectRaces:  race,
+		AutoImport:   len(args) == 0 || expr != "",
+		AllowUnsafe:  allowUnsafe,
+		BuildTags:    buildTags(tags),
+	}
+
+	if gen && len(args) > 0 {
+		g := &generator{tags: options.BuildTags}
+		if err := g.generate(args[0]); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if watchMode {
+		if len(args) == 0 || interactive || expr != "" {
+			log.Fatal("-watch requires a script, and no -i or -e option")
+		}
+		os.Args = args
+		log.Fatal(watch(args[0], options))
+	}
+
+	i := interp.New(options)
 	i.Use(stdlib.Symbols)
 	i.Use(interp.Symbols)
 
-	if len(args) > 0 {
-		// Skip first os arg to set command line as expected by interpreted main
-		os.Args = os.Args[1:]
-		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
-
-		b, err := ioutil.ReadFile(args[0])
-		if err != nil {
-			log.Fatal("Could not read file: ", args[0])
+	if expr != "" {
+		os.Args = append([]string{os.Args[0]}, args...)
+		resetFlags()
+		if _, err := i.Eval(expr); err != nil {
+			printError(err, jsonErrors)
+		}
+		if interactive {
+			i.Repl(os.Stdin, os.Stdout)
 		}
+	} else if len(args) > 0 {
+		// Skip interpreter args to set command line as expected by interpreted main
+		os.Args = args
+		resetFlags()
 
-		s := string(b)
-		if s[:2] == "#!" {
-			// Allow executable go scripts, but fix them prior to parse
-			s = strings.Replace(s, "#!", "//", 1)
+		var s string
+		info, err := os.Stat(args[0])
+		isDir := err == nil && info.IsDir()
+		if !isDir {
+			b, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				log.Fatal("Could not read file: ", args[0])
+			}
+			s = string(b)
+			i.Name = args[0]
 		}
 
-		i.Name = args[0]
-		if _, err := i.Eval(s); err != nil {
-			fmt.Println(err)
+		if cpuprofile != "" {
+			f, err := os.Create(cpuprofile)
+			if err != nil {
+				log.Fatal(err)
+			}
+			p := profile.Start(i, f)
+			defer func() {
+				if err := p.Stop(); err != nil {
+					log.Fatal(err)
+				}
+				if err := f.Close(); err != nil {
+					log.Fatal(err)
+				}
+			}()
+		}
+		if isDir {
+			_, err = i.EvalPath(args[0])
+		} else {
+			_, err = i.Eval(s)
+		}
+		if err != nil {
+			printError(err, jsonErrors)
 		}
 
 		if interactive {
@@ -85,3 +398,57 @@ func main() {
 		i.Repl(os.Stdin, os.Stdout)
 	}
 }
+
+// printError prints the error of an evaluation, with the source line of
+// each error and its notes, or as JSON diagnostics if asJSON is set. On
+// panic, it exits as a Go program, with the interpreted stack.
+func printError(err error, asJSON bool) {
+	e, ok := err.(*interp.Error)
+	isPanic := ok && e.Phase == interp.RunPhase
+	switch {
+	case asJSON:
+		printDiagnostics(err)
+	case isPanic:
+		fmt.Fprintf(os.Stderr, "%v\n%s\n%s", err, e.Excerpt(), e.StackTrace())
+	default:
+		fmt.Print(errorText(err))
+	}
+	if isPanic {
+		os.Exit(2)
+	}
+}
+
+// errorText returns the error err, one line per error of a list, each one
+// followed by its excerpt.
+func errorText(err error) string {
+	switch e := err.(type) {
+	case interp.ErrorList:
+		var s string
+		for _, e := range e {
+			s += errorText(e)
+		}
+		return s
+	case *interp.Error:
+		return e.Error() + "\n" + e.Excerpt()
+	}
+	return err.Error() + "\n"
+}
+
+// resetFlags sets the command line flags of package flag as for a new
+// program, without the flags of yaegi, so the interpreted main parses its
+// own arguments from os.Args, and its flag.Usage, if set, is called on
+// error. The default usage names the script, os.Args[0].
+func resetFlags() {
+	flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	flag.CommandLine.Usage = func() { flag.Usage() }
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+}
+
+// buildTags returns the build tags of list, separated by commas or, as in
+// older releases of go build, by spaces.
+func buildTags(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' })
+}
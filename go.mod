@@ -1,3 +1,3 @@
 module github.com/containous/yaegi
 
-go 1.11
+go 1.26
@@ -0,0 +1,371 @@
+// Package extract generates the wrappers of the exported symbols of a Go
+// package, which give interpreted code access to the compiled package.
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"go/constant"
+	"go/format"
+	"go/types"
+	"log"
+	"path"
+	"runtime"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const model = `{{if .BuildTags}}//go:build {{.BuildExpr}}
+// +build {{.BuildTags}}{{end}}
+
+package {{.Dest}}
+
+// Code generated by 'goexports {{.PkgName}}'. DO NOT EDIT.
+
+import (
+{{- range $key, $value := .Imports }}
+	{{- if $value}}
+	"{{$key}}"
+	{{- end}}
+{{- end}}
+	"{{.PkgName}}"
+	"reflect"
+)
+
+func init() {
+	Symbols["{{.PkgName}}"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		{{range $key, $value := .Val -}}
+			{{- if $value.Addr -}}
+				"{{$key}}": reflect.ValueOf(&{{$value.Name}}).Elem(),
+			{{else -}}
+				"{{$key}}": reflect.ValueOf({{$value.Name}}),
+			{{end -}}
+		{{end}}
+
+		// type definitions
+		{{range $key, $value := .Typ -}}
+			"{{$key}}": reflect.ValueOf((*{{$value}})(nil)),
+		{{end}}
+
+		// interface wrapper definitions
+		{{range $key, $value := .Wrap -}}
+			"_{{$key}}": reflect.ValueOf((*{{$value.Name}})(nil)),
+		{{end}}
+	}
+}
+{{range $key, $value := .Wrap -}}
+	// {{$value.Name}} is an interface wrapper for {{$key}} type
+	type {{$value.Name}} struct {
+		{{if $value.Embed -}}
+		{{$value.Embed}}
+		{{end -}}
+		{{range $m := $value.Method -}}
+		W{{$m.Name}} func{{$m.Param}} {{$m.Result}}
+		{{end}}
+	}
+	{{range $m := $value.Method -}}
+		func (W {{$value.Name}}) {{$m.Name}}{{$m.Param}} {{$m.Result}} { {{$m.Ret}} W.W{{$m.Name}}{{$m.Arg}} }
+	{{end}}
+{{end}}
+`
+
+// Val store the value name and addressable status of symbols
+type Val struct {
+	Name string // "package.name"
+	Addr bool   // true if symbol is a Var
+}
+
+// Method store information for generating interface wrapper method
+type Method struct {
+	Name, Param, Result, Arg, Ret string
+}
+
+// Wrap store information for generating interface wrapper
+type Wrap struct {
+	Name   string
+	Embed  string // embedded interface, if it has unexported methods
+	Method []Method
+}
+
+// isResolved returns true if type t does not depend on unresolved types.
+func isResolved(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Basic:
+		return t.Kind() != types.Invalid
+	case *types.Array:
+		return isResolved(t.Elem())
+	case *types.Chan:
+		return isResolved(t.Elem())
+	case *types.Map:
+		return isResolved(t.Key()) && isResolved(t.Elem())
+	case *types.Pointer:
+		return isResolved(t.Elem())
+	case *types.Slice:
+		return isResolved(t.Elem())
+	case *types.Signature:
+		return isResolved(t.Params()) && isResolved(t.Results())
+	case *types.Tuple:
+		for i := 0; i < t.Len(); i++ {
+			if !isResolved(t.At(i).Type()) {
+				return false
+			}
+		}
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if !isResolved(t.Field(i).Type()) {
+				return false
+			}
+		}
+	case *types.Named:
+		// Types of package C are faked, and types of internal packages can
+		// not be imported, so neither can be named in generated code
+		pkg := t.Obj().Pkg()
+		return pkg == nil || pkg.Path() != "C" && !isInternal(pkg.Path())
+	}
+	return true
+}
+
+// isInternal returns true if the package of import path pkgPath is internal,
+// so it can only be imported by the packages of its parent directory.
+func isInternal(pkgPath string) bool {
+	return pkgPath == "internal" || strings.HasPrefix(pkgPath, "internal/") ||
+		strings.HasSuffix(pkgPath, "/internal") || strings.Contains(pkgPath, "/internal/")
+}
+
+// Generate returns the source code, in the package dest, of the wrappers of
+// the exported symbols of the type checked package p of import path pkgName.
+// It returns nil if the package has no symbol to export, for example if all
+// its symbols are generic.
+func Generate(dest, pkgName string, p *types.Package) ([]byte, error) {
+	prefix := "_" + pkgName + "_"
+	prefix = strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(prefix)
+
+	typ := map[string]string{}
+	val := map[string]Val{}
+	wrap := map[string]Wrap{}
+	imports := map[string]bool{}
+	sc := p.Scope()
+
+	for _, pkg := range p.Imports() {
+		imports[pkg.Path()] = false
+	}
+	qualify := func(pkg *types.Package) string {
+		if pkg.Path() != pkgName {
+			imports[pkg.Path()] = true
+		}
+		return pkg.Name()
+	}
+
+	for _, name := range sc.Names() {
+		o := sc.Lookup(name)
+		if !o.Exported() {
+			continue
+		}
+		if isGeneric(o) {
+			log.Printf("%s: %s omitted, generic symbols can not be exported", pkgName, name)
+			continue
+		}
+
+		pname := path.Base(pkgName) + "." + name
+		switch o := o.(type) {
+		case *types.Const:
+			val[name] = Val{fixConst(pname, o.Val()), false}
+		case *types.Func:
+			val[name] = Val{pname, false}
+		case *types.Var:
+			val[name] = Val{pname, true}
+		case *types.TypeName:
+			typ[name] = pname
+			if t, ok := o.Type().Underlying().(*types.Interface); ok {
+				var methods []Method
+				var embed string
+				resolved := true
+				// The method set includes the methods of embedded interfaces
+				for i := 0; i < t.NumMethods(); i++ {
+					f := t.Method(i)
+					if !f.Exported() {
+						// The interface is embedded to implement the unexported methods
+						embed = pname
+						continue
+					}
+
+					sign := f.Type().(*types.Signature)
+					if !isResolved(sign) {
+						resolved = false
+						break
+					}
+					args := make([]string, sign.Params().Len())
+					params := make([]string, len(args))
+					for j := range args {
+						v := sign.Params().At(j)
+						if args[j] = v.Name(); args[j] == "" {
+							args[j] = fmt.Sprintf("a%d", j)
+						}
+						params[j] = args[j] + " " + types.TypeString(v.Type(), qualify)
+					}
+					if sign.Variadic() {
+						// Variadic methods are forwarded as such, so the wrapper implements the interface
+						j := len(args) - 1
+						params[j] = args[j] + " ..." + types.TypeString(sign.Params().At(j).Type().(*types.Slice).Elem(), qualify)
+						args[j] += "..."
+					}
+					arg := "(" + strings.Join(args, ", ") + ")"
+					param := "(" + strings.Join(params, ", ") + ")"
+
+					results := make([]string, sign.Results().Len())
+					for j := range results {
+						v := sign.Results().At(j)
+						results[j] = v.Name() + " " + types.TypeString(v.Type(), qualify)
+					}
+					result := "(" + strings.Join(results, ", ") + ")"
+
+					ret := ""
+					if sign.Results().Len() > 0 {
+						ret = "return"
+					}
+
+					methods = append(methods, Method{f.Name(), param, result, arg, ret})
+				}
+				if !resolved {
+					log.Printf("%s: wrapper of %s omitted, unresolved method types", pkgName, name)
+					continue
+				}
+				wrap[name] = Wrap{prefix + name, embed, methods}
+			}
+		}
+	}
+
+	if len(val) == 0 && len(typ) == 0 {
+		return nil, nil
+	}
+
+	var tags []string
+	if runtime.Version() != "devel" {
+		parts := strings.Split(runtime.Version(), ".")
+
+		minorRaw := Minor(parts[1])
+
+		currentGoVersion := parts[0] + "." + minorRaw
+
+		minor, errParse := strconv.Atoi(minorRaw)
+		if errParse != nil {
+			return nil, fmt.Errorf("failed to parse version: %v", errParse)
+		}
+
+		nextGoVersion := parts[0] + "." + strconv.Itoa(minor+1)
+
+		tags = append(tags, currentGoVersion, "!"+nextGoVersion)
+	}
+
+	base := template.New("goexports")
+	parse, err := base.Parse(model)
+	if err != nil {
+		return nil, fmt.Errorf("template parsing error: %v", err)
+	}
+
+	if pkgName == "log/syslog" {
+		tags = append(tags, "!windows", "!nacl", "!plan9")
+	}
+	for _, tag := range GroupTags(pkgName) {
+		tags = append(tags, "!"+tag)
+	}
+
+	b := &bytes.Buffer{}
+	data := map[string]interface{}{
+		"Dest":      dest,
+		"Imports":   imports,
+		"PkgName":   pkgName,
+		"Val":       val,
+		"Typ":       typ,
+		"Wrap":      wrap,
+		"BuildTags": strings.Join(tags, ","),
+		"BuildExpr": strings.Join(tags, " && "),
+	}
+	err = parse.Execute(b, data)
+	if err != nil {
+		return nil, fmt.Errorf("template error: %v", err)
+	}
+
+	// gofmt
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format source: %v: %s", err, b.Bytes())
+	}
+	return source, nil
+}
+
+// fixConst checks untyped constant value, converting it if necessary to avoid overflow
+func fixConst(name string, val constant.Value) string {
+	if val.Kind() == constant.Int {
+		str := val.ExactString()
+		i, err := strconv.ParseInt(str, 0, 64)
+		if err == nil {
+			switch {
+			case i == int64(int32(i)):
+				return name
+			case i == int64(uint32(i)):
+				return "uint32(" + name + ")"
+			default:
+				return "int64(" + name + ")"
+			}
+		}
+		_, err = strconv.ParseUint(str, 0, 64)
+		if err == nil {
+			return "uint64(" + name + ")"
+		}
+	}
+	return name
+}
+
+// tagGroups lists the groups of standard packages which can be excluded
+// from a build by a tag, indexed by the first element of their import path.
+var tagGroups = map[string]bool{
+	"archive":  true,
+	"compress": true,
+	"crypto":   true,
+	"database": true,
+	"encoding": true,
+	"html":     true,
+	"image":    true,
+	"mime":     true,
+	"net":      true,
+}
+
+// groupDeps lists the packages outside of a group which are excluded with
+// it, as they would link most of the group.
+var groupDeps = map[string][]string{
+	"crypto/tls": {"net"},
+	"expvar":     {"net"},
+	"log/syslog": {"net"},
+}
+
+// GroupTags returns the build tags excluding the wrappers of package
+// pkgName, yaegi_no followed by the group of the package, as yaegi_nonet
+// for net/http, or nil if the package is not in a group.
+func GroupTags(pkgName string) []string {
+	var tags []string
+	if group := strings.SplitN(pkgName, "/", 2)[0]; tagGroups[group] {
+		tags = append(tags, "yaegi_no"+group)
+	}
+	for _, group := range groupDeps[pkgName] {
+		tags = append(tags, "yaegi_no"+group)
+	}
+	return tags
+}
+
+// Minor returns the minor version of part, the second element of a Go
+// version, without its beta or rc suffix.
+func Minor(part string) string {
+	minor := part
+	index := strings.Index(minor, "beta")
+	if index < 0 {
+		index = strings.Index(minor, "rc")
+	}
+	if index > 0 {
+		minor = minor[:index]
+	}
+
+	return minor
+}
@@ -0,0 +1,21 @@
+//go:build go1.18
+// +build go1.18
+
+package extract
+
+import "go/types"
+
+// isGeneric returns true if the object o is a generic function or type, or a
+// type constraint, which can not be exported as a reflect value.
+func isGeneric(o types.Object) bool {
+	switch t := o.Type().(type) {
+	case *types.Signature:
+		return t.TypeParams().Len() > 0
+	case *types.Named:
+		if i, ok := t.Underlying().(*types.Interface); ok && !i.IsMethodSet() {
+			return true
+		}
+		return t.TypeParams().Len() > 0
+	}
+	return false
+}
@@ -0,0 +1,10 @@
+//go:build !go1.18
+// +build !go1.18
+
+package extract
+
+import "go/types"
+
+// isGeneric returns false, as there are no generic objects nor type
+// constraints before go1.18.
+func isGeneric(o types.Object) bool { return false }
@@ -21,6 +21,114 @@ func {{$name}}(n *node) {
 	next := getExec(n.tnext)
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
+	{{- if not $op.Shift}}
+
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		{{- if $op.Str}}
+		case reflect.String:
+			switch {
+			case i0 < 0:
+				s0 := c0.rval.String()
+				n.exec = func(f *frame) bltn {
+					s1 := f.data[i1].String()
+					if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+						return nil
+					}
+					f.data[d].SetString(s0 {{$op.Name}} s1)
+					return next
+				}
+			case i1 < 0:
+				s1 := c1.rval.String()
+				n.exec = func(f *frame) bltn {
+					s0 := f.data[i0].String()
+					if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+						return nil
+					}
+					f.data[d].SetString(s0 {{$op.Name}} s1)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					s0, s1 := f.data[i0].String(), f.data[i1].String()
+					if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+						return nil
+					}
+					f.data[d].SetString(s0 {{$op.Name}} s1)
+					return next
+				}
+			}
+			return
+		{{- end}}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i {{$op.Name}} f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() {{$op.Name}} j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() {{$op.Name}} f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i {{$op.Name}} f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() {{$op.Name}} j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() {{$op.Name}} f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		{{- if $op.Float}}
+		case reflect.Float32, reflect.Float64:
+			switch {
+			case i0 < 0:
+				i := vFloat(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(i {{$op.Name}} f.data[i1].Float())
+					return next
+				}
+			case i1 < 0:
+				j := vFloat(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() {{$op.Name}} j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() {{$op.Name}} f.data[i1].Float())
+					return next
+				}
+			}
+			return
+		{{- end}}
+		}
+	}
+	{{- end}}
 
 	switch typ.Kind() {
 	{{- if $op.Str}}
@@ -30,21 +138,33 @@ func {{$name}}(n *node) {
 			s0 := c0.rval.String()
 			v1 := genValue(c1)
 			n.exec = func(f *frame) bltn {
-				dest(f).SetString(s0 {{$op.Name}} v1(f).String())
+				s1 := v1(f).String()
+				if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+					return nil
+				}
+				dest(f).SetString(s0 {{$op.Name}} s1)
 				return next
 			}
 		case c1.rval.IsValid():
 			v0 := genValue(c0)
 			s1 := c1.rval.String()
 			n.exec = func(f *frame) bltn {
-				dest(f).SetString(v0(f).String() {{$op.Name}} s1)
+				s0 := v0(f).String()
+				if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+					return nil
+				}
+				dest(f).SetString(s0 {{$op.Name}} s1)
 				return next
 			}
 		default:
 			v0 := genValue(c0)
 			v1 := genValue(c1)
 			n.exec = func(f *frame) bltn {
-				dest(f).SetString(v0(f).String() {{$op.Name}} v1(f).String())
+				s0, s1 := v0(f).String(), v1(f).String()
+				if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+					return nil
+				}
+				dest(f).SetString(s0 {{$op.Name}} s1)
 				return next
 			}
 		}
@@ -54,7 +174,7 @@ func {{$name}}(n *node) {
 		case c0.rval.IsValid():
 			i := vInt(c0.rval)
 			{{- if $op.Shift}}
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			{{else}}
 			v1 := genValueInt(c1)
 			{{end -}}
@@ -78,7 +198,7 @@ func {{$name}}(n *node) {
 		default:
 			v0 := genValueInt(c0)
 			{{- if $op.Shift}}
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			{{else}}
 			v1 := genValueInt(c1)
 			{{end -}}
@@ -93,7 +213,11 @@ func {{$name}}(n *node) {
 		switch {
 		case c0.rval.IsValid():
 			i := vUint(c0.rval)
+			{{- if $op.Shift}}
+			v1 := genValueShift(c1)
+			{{- else}}
 			v1 := genValueUint(c1)
+			{{- end}}
 			n.exec = func(f *frame) bltn {
 				_, j := v1(f)
 				dest(f).SetUint(i {{$op.Name}} j)
@@ -109,7 +233,11 @@ func {{$name}}(n *node) {
 			}
 		default:
 			v0 := genValueUint(c0)
+			{{- if $op.Shift}}
+			v1 := genValueShift(c1)
+			{{- else}}
 			v1 := genValueUint(c1)
+			{{- end}}
 			n.exec = func(f *frame) bltn {
 				_, i := v0(f)
 				_, j := v1(f)
@@ -215,6 +343,9 @@ func {{$name}}Assign(n *node) {
 			v1 := c1.rval.String()
 			n.exec = func(f *frame) bltn {
 				v, s := v0(f)
+				if !n.interp.alloc(f, len(s)+len(v1), 1) {
+					return nil
+				}
 				v.SetString(s {{$op.Name}} v1)
 				return next
 			}
@@ -266,14 +397,18 @@ func {{$name}}Assign(n *node) {
 			v1 := genValue(c1)
 			n.exec = func(f *frame) bltn {
 				v, s := v0(f)
-				v.SetString(s {{$op.Name}} v1(f).String())
+				s1 := v1(f).String()
+				if !n.interp.alloc(f, len(s)+len(s1), 1) {
+					return nil
+				}
+				v.SetString(s {{$op.Name}} s1)
 				return next
 			}
 		{{- end}}
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			v0 := genValueInt(c0)
 			{{- if $op.Shift}}
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			{{else}}
 			v1 := genValueInt(c1)
 			{{end -}}
@@ -285,7 +420,11 @@ func {{$name}}Assign(n *node) {
 			}
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 			v0 := genValueUint(c0)
+			{{- if $op.Shift}}
+			v1 := genValueShift(c1)
+			{{- else}}
 			v1 := genValueUint(c1)
+			{{- end}}
 			n.exec = func(f *frame) bltn {
 				v, i := v0(f)
 				_, j := v1(f)
@@ -328,7 +467,7 @@ func {{$name}}(n *node) {
 			v.SetInt(i {{$op.Name}} 1)
 			return next
 		}
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		v0 := genValueUint(n.child[0])
 		n.exec = func(f *frame) bltn {
 			v, i := v0(f)
@@ -358,7 +497,154 @@ func {{$name}}(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+					switch {
+					case i0 < 0:
+						s0 := vInt(c0.rval)
+						if n.fnext != nil {
+							fnext := getExec(n.fnext)
+							n.exec = func(f *frame) bltn {
+								if s0 {{$op.Name}} f.data[i1].Int() {
+									f.data[d].SetBool(true)
+									return tnext
+								}
+								f.data[d].SetBool(false)
+								return fnext
+							}
+						} else {
+							n.exec = func(f *frame) bltn {
+								f.data[d].SetBool(s0 {{$op.Name}} f.data[i1].Int())
+								return tnext
+							}
+						}
+					case i1 < 0:
+						s1 := vInt(c1.rval)
+						if n.fnext != nil {
+							fnext := getExec(n.fnext)
+							n.exec = func(f *frame) bltn {
+								if f.data[i0].Int() {{$op.Name}} s1 {
+									f.data[d].SetBool(true)
+									return tnext
+								}
+								f.data[d].SetBool(false)
+								return fnext
+							}
+						} else {
+							n.exec = func(f *frame) bltn {
+								f.data[d].SetBool(f.data[i0].Int() {{$op.Name}} s1)
+								return tnext
+							}
+						}
+					default:
+						if n.fnext != nil {
+							fnext := getExec(n.fnext)
+							n.exec = func(f *frame) bltn {
+								if f.data[i0].Int() {{$op.Name}} f.data[i1].Int() {
+									f.data[d].SetBool(true)
+									return tnext
+								}
+								f.data[d].SetBool(false)
+								return fnext
+							}
+						} else {
+							n.exec = func(f *frame) bltn {
+								f.data[d].SetBool(f.data[i0].Int() {{$op.Name}} f.data[i1].Int())
+								return tnext
+							}
+						}
+					}
+					return
+			case reflect.Float32, reflect.Float64:
+					switch {
+					case i0 < 0:
+						s0 := vFloat(c0.rval)
+						if n.fnext != nil {
+							fnext := getExec(n.fnext)
+							n.exec = func(f *frame) bltn {
+								if s0 {{$op.Name}} f.data[i1].Float() {
+									f.data[d].SetBool(true)
+									return tnext
+								}
+								f.data[d].SetBool(false)
+								return fnext
+							}
+						} else {
+							n.exec = func(f *frame) bltn {
+								f.data[d].SetBool(s0 {{$op.Name}} f.data[i1].Float())
+								return tnext
+							}
+						}
+					case i1 < 0:
+						s1 := vFloat(c1.rval)
+						if n.fnext != nil {
+							fnext := getExec(n.fnext)
+							n.exec = func(f *frame) bltn {
+								if f.data[i0].Float() {{$op.Name}} s1 {
+									f.data[d].SetBool(true)
+									return tnext
+								}
+								f.data[d].SetBool(false)
+								return fnext
+							}
+						} else {
+							n.exec = func(f *frame) bltn {
+								f.data[d].SetBool(f.data[i0].Float() {{$op.Name}} s1)
+								return tnext
+							}
+						}
+					default:
+						if n.fnext != nil {
+							fnext := getExec(n.fnext)
+							n.exec = func(f *frame) bltn {
+								if f.data[i0].Float() {{$op.Name}} f.data[i1].Float() {
+									f.data[d].SetBool(true)
+									return tnext
+								}
+								f.data[d].SetBool(false)
+								return fnext
+							}
+						} else {
+							n.exec = func(f *frame) bltn {
+								f.data[d].SetBool(f.data[i0].Float() {{$op.Name}} f.data[i1].Float())
+								return tnext
+							}
+						}
+					}
+					return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
+	{{- if $op.Complex}}
+	case isInterface(c0.typ) || isInterface(c1.typ):
+		v0 := genCompareKey(c0)
+		v1 := genCompareKey(c1)
+		if n.fnext != nil {
+			fnext := getExec(n.fnext)
+			n.exec = func(f *frame) bltn {
+				if {{if eq $op.Name "!="}}!{{end}}v0(f).equal(v1(f)) {
+					dest(f).SetBool(true)
+					return tnext
+				}
+				dest(f).SetBool(false)
+				return fnext
+			}
+		} else {
+			n.exec = func(f *frame) bltn {
+				dest(f).SetBool({{if eq $op.Name "!="}}!{{end}}v0(f).equal(v1(f)))
+				return tnext
+			}
+		}
+	{{- end}}
 	case isString(t0) || isString(t1):
 		switch {
 		case c0.rval.IsValid():
@@ -643,7 +929,11 @@ func {{$name}}(n *node) {
 		switch {
 		case c0.rval.IsValid():
 			s0 := vUint(c0.rval)
+			{{- if $op.Shift}}
+			v1 := genValueShift(c1)
+			{{- else}}
 			v1 := genValueUint(c1)
+			{{- end}}
 			if n.fnext != nil {
 				fnext := getExec(n.fnext)
 				n.exec = func(f *frame) bltn {
@@ -687,7 +977,11 @@ func {{$name}}(n *node) {
 			}
 		default:
 			v0 := genValueUint(c0)
+			{{- if $op.Shift}}
+			v1 := genValueShift(c1)
+			{{- else}}
 			v1 := genValueUint(c1)
+			{{- end}}
 			if n.fnext != nil {
 				fnext := getExec(n.fnext)
 				n.exec = func(f *frame) bltn {
@@ -1,13 +1,16 @@
 package interp
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/parser"
 	"go/scanner"
 	"go/token"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // nkind defines the kind of AST, i.e. the grammar category
@@ -29,7 +32,10 @@ const (
 	caseBody
 	caseClause
 	chanType
+	chanTypeRecv
+	chanTypeSend
 	commClause
+	commClauseDefault
 	compositeLitExpr
 	constDecl
 	continueStmt
@@ -86,6 +92,7 @@ const (
 	switchIfStmt
 	typeAssertExpr
 	typeDecl
+	typeParams
 	typeSpec
 	typeSwitch
 	unaryExpr
@@ -94,82 +101,86 @@ const (
 )
 
 var kinds = [...]string{
-	undefNode:        "undefNode",
-	addressExpr:      "addressExpr",
-	arrayType:        "arrayType",
-	assignStmt:       "assignStmt",
-	assignXStmt:      "assignXStmt",
-	basicLit:         "basicLit",
-	binaryExpr:       "binaryExpr",
-	blockStmt:        "blockStmt",
-	branchStmt:       "branchStmt",
-	breakStmt:        "breakStmt",
-	callExpr:         "callExpr",
-	caseBody:         "caseBody",
-	caseClause:       "caseClause",
-	chanType:         "chanType",
-	commClause:       "commClause",
-	compositeLitExpr: "compositeLitExpr",
-	constDecl:        "constDecl",
-	continueStmt:     "continueStmt",
-	declStmt:         "declStmt",
-	deferStmt:        "deferStmt",
-	defineStmt:       "defineStmt",
-	defineXStmt:      "defineXStmt",
-	ellipsisExpr:     "ellipsisExpr",
-	exprStmt:         "exprStmt",
-	fallthroughtStmt: "fallthroughStmt",
-	fieldExpr:        "fieldExpr",
-	fieldList:        "fieldList",
-	fileStmt:         "fileStmt",
-	forStmt0:         "forStmt0",
-	forStmt1:         "forStmt1",
-	forStmt2:         "forStmt2",
-	forStmt3:         "forStmt3",
-	forStmt3a:        "forStmt3a",
-	forStmt4:         "forStmt4",
-	forRangeStmt:     "forRangeStmt",
-	funcDecl:         "funcDecl",
-	funcType:         "funcType",
-	funcLit:          "funcLit",
-	goStmt:           "goStmt",
-	gotoStmt:         "gotoStmt",
-	identExpr:        "identExpr",
-	ifStmt0:          "ifStmt0",
-	ifStmt1:          "ifStmt1",
-	ifStmt2:          "ifStmt2",
-	ifStmt3:          "ifStmt3",
-	importDecl:       "importDecl",
-	importSpec:       "importSpec",
-	incDecStmt:       "incDecStmt",
-	indexExpr:        "indexExpr",
-	interfaceType:    "interfaceType",
-	keyValueExpr:     "keyValueExpr",
-	labeledStmt:      "labeledStmt",
-	landExpr:         "landExpr",
-	lorExpr:          "lorExpr",
-	mapType:          "mapType",
-	parenExpr:        "parenExpr",
-	rangeStmt:        "rangeStmt",
-	returnStmt:       "returnStmt",
-	rvalueExpr:       "rvalueExpr",
-	rtypeExpr:        "rtypeExpr",
-	selectStmt:       "selectStmt",
-	selectorExpr:     "selectorExpr",
-	selectorImport:   "selectorImport",
-	sendStmt:         "sendStmt",
-	sliceExpr:        "sliceExpr",
-	starExpr:         "starExpr",
-	structType:       "structType",
-	switchStmt:       "switchStmt",
-	switchIfStmt:     "switchIfStmt",
-	typeAssertExpr:   "typeAssertExpr",
-	typeDecl:         "typeDecl",
-	typeSpec:         "typeSpec",
-	typeSwitch:       "typeSwitch",
-	unaryExpr:        "unaryExpr",
-	valueSpec:        "valueSpec",
-	varDecl:          "varDecl",
+	undefNode:         "undefNode",
+	addressExpr:       "addressExpr",
+	arrayType:         "arrayType",
+	assignStmt:        "assignStmt",
+	assignXStmt:       "assignXStmt",
+	basicLit:          "basicLit",
+	binaryExpr:        "binaryExpr",
+	blockStmt:         "blockStmt",
+	branchStmt:        "branchStmt",
+	breakStmt:         "breakStmt",
+	callExpr:          "callExpr",
+	caseBody:          "caseBody",
+	caseClause:        "caseClause",
+	chanType:          "chanType",
+	chanTypeRecv:      "chanTypeRecv",
+	chanTypeSend:      "chanTypeSend",
+	commClause:        "commClause",
+	commClauseDefault: "commClauseDefault",
+	compositeLitExpr:  "compositeLitExpr",
+	constDecl:         "constDecl",
+	continueStmt:      "continueStmt",
+	declStmt:          "declStmt",
+	deferStmt:         "deferStmt",
+	defineStmt:        "defineStmt",
+	defineXStmt:       "defineXStmt",
+	ellipsisExpr:      "ellipsisExpr",
+	exprStmt:          "exprStmt",
+	fallthroughtStmt:  "fallthroughStmt",
+	fieldExpr:         "fieldExpr",
+	fieldList:         "fieldList",
+	fileStmt:          "fileStmt",
+	forStmt0:          "forStmt0",
+	forStmt1:          "forStmt1",
+	forStmt2:          "forStmt2",
+	forStmt3:          "forStmt3",
+	forStmt3a:         "forStmt3a",
+	forStmt4:          "forStmt4",
+	forRangeStmt:      "forRangeStmt",
+	funcDecl:          "funcDecl",
+	funcType:          "funcType",
+	funcLit:           "funcLit",
+	goStmt:            "goStmt",
+	gotoStmt:          "gotoStmt",
+	identExpr:         "identExpr",
+	ifStmt0:           "ifStmt0",
+	ifStmt1:           "ifStmt1",
+	ifStmt2:           "ifStmt2",
+	ifStmt3:           "ifStmt3",
+	importDecl:        "importDecl",
+	importSpec:        "importSpec",
+	incDecStmt:        "incDecStmt",
+	indexExpr:         "indexExpr",
+	interfaceType:     "interfaceType",
+	keyValueExpr:      "keyValueExpr",
+	labeledStmt:       "labeledStmt",
+	landExpr:          "landExpr",
+	lorExpr:           "lorExpr",
+	mapType:           "mapType",
+	parenExpr:         "parenExpr",
+	rangeStmt:         "rangeStmt",
+	returnStmt:        "returnStmt",
+	rvalueExpr:        "rvalueExpr",
+	rtypeExpr:         "rtypeExpr",
+	selectStmt:        "selectStmt",
+	selectorExpr:      "selectorExpr",
+	selectorImport:    "selectorImport",
+	sendStmt:          "sendStmt",
+	sliceExpr:         "sliceExpr",
+	starExpr:          "starExpr",
+	structType:        "structType",
+	switchStmt:        "switchStmt",
+	switchIfStmt:      "switchIfStmt",
+	typeAssertExpr:    "typeAssertExpr",
+	typeDecl:          "typeDecl",
+	typeParams:        "typeParams",
+	typeSpec:          "typeSpec",
+	typeSwitch:        "typeSwitch",
+	unaryExpr:         "unaryExpr",
+	valueSpec:         "valueSpec",
+	varDecl:           "varDecl",
 }
 
 func (k nkind) String() string {
@@ -197,7 +208,9 @@ const (
 	aAndAssign
 	aAndNot
 	aAndNotAssign
+	aBitNot
 	aCall
+	aCallSlice
 	aCase
 	aCompositeLit
 	aDec
@@ -220,6 +233,7 @@ const (
 	aNotEqual
 	aOr
 	aOrAssign
+	aPos
 	aQuo
 	aQuoAssign
 	aRange
@@ -253,7 +267,9 @@ var actions = [...]string{
 	aAndAssign:    "&=",
 	aAndNot:       "&^",
 	aAndNotAssign: "&^=",
+	aBitNot:       "^",
 	aCall:         "call",
+	aCallSlice:    "callSlice",
 	aCase:         "case",
 	aCompositeLit: "compositeLit",
 	aDec:          "--",
@@ -274,6 +290,7 @@ var actions = [...]string{
 	aNotEqual:     "!=",
 	aOr:           "|",
 	aOrAssign:     "|=",
+	aPos:          "+",
 	aQuo:          "/",
 	aQuoAssign:    "/=",
 	aRange:        "range",
@@ -318,35 +335,103 @@ func (interp *Interpreter) firstToken(src string) token.Token {
 // ast parses src string containing Go code and generates the corresponding AST.
 // The package name and the AST root node are returned.
 func (interp *Interpreter) ast(src, name string) (string, *node, error) {
-	var inFunc bool
+	return interp.astOf(interp.parse(src, name))
+}
+
+// parsedSrc is a Go source parsed by parse, to be converted to nodes by astOf.
+type parsedSrc struct {
+	name   string    // file name
+	buf    string    // source, as given to parse
+	text   srcText   // parsed source
+	file   *ast.File // syntax tree, or nil if the source is skipped
+	inFunc bool      // true if the source is a list of statements
+	isFile bool      // true if the source is a complete file
+	err    error     // syntax error, or nil
+}
+
+// parse parses src string of file name, and returns the result for
+// astOf. It does not modify the interpreter, except its file set, and can
+// be called concurrently.
+func (interp *Interpreter) parse(src, name string) *parsedSrc {
+	p := &parsedSrc{name: name, buf: src}
+
+	if strings.HasPrefix(src, "#!") {
+		// Allow executable go scripts: the shebang line is turned into a
+		// comment of the same length, to preserve positions
+		src = "//" + src[2:]
+	}
 
 	// Allow incremental parsing of declarations or statements, by inserting
 	// them in a pseudo file package or function. Those statements or
 	// declarations will be always evaluated in the global scope
 	switch interp.firstToken(src) {
 	case token.PACKAGE:
-		// nothing to do
+		p.isFile = true
 	case token.CONST, token.FUNC, token.IMPORT, token.TYPE, token.VAR:
 		src = "package main;" + src
+		p.text.head = len("package main;")
 	default:
-		inFunc = true
+		p.inFunc = true
 		src = "package main; func main() {" + src + "}"
+		p.text.head, p.text.tail = len("package main; func main() {"), len("}")
 	}
+	p.text.src = src
 
 	if !interp.buildOk(interp.context, name, src) {
-		return "", nil, nil // skip source not matching build constraints
+		return p // skip source not matching build constraints
 	}
 
-	f, err := parser.ParseFile(interp.fset, name, src, 0)
-	if err != nil {
-		return "", nil, err
+	// Comments are only needed for go:embed directives
+	var mode parser.Mode
+	if strings.Contains(src, embedDirective) {
+		mode = parser.ParseComments
 	}
+	if p.file, p.err = parser.ParseFile(interp.fset, name, src, mode); p.err != nil {
+		p.file, p.err = nil, syntaxError(name, p.text, p.err)
+	}
+	return p
+}
+
+// astOf generates the AST of the source parsed by p, as ast.
+func (interp *Interpreter) astOf(p *parsedSrc) (string, *node, error) {
+	if interp.optErr != nil {
+		return "", nil, interp.optErr
+	}
+	if p.file == nil {
+		return "", nil, p.err
+	}
+	f, name, src, inFunc, isFile := p.file, p.name, p.text.src, p.inFunc, p.isFile
+	interp.texts[interp.fset.File(f.Package)] = p.text
 
+	var err error
 	var root *node
 	var anc astNode
 	var st nodestack
 	var pkgName string
 
+	// Basic blocks of source files, except test files, are counted for coverage
+	var blocks map[ast.Node]*coverBlock
+	if c := interp.cover; c != nil && isFile && name != "" && !strings.HasSuffix(name, "_test.go") {
+		blocks = c.add(interp, f, src)
+	}
+
+	// newCaseBody returns a new body node for case clause c
+	newCaseBody := func(c *node, pos token.Pos) *node {
+		interp.nindex++
+		var i interface{}
+		body := &node{anc: c, interp: interp, index: interp.nindex, pos: pos, kind: caseBody, action: aNop, val: &i, gen: nop}
+
+		if ts := c.anc.anc; ts.kind == typeSwitch && ts.child[1].action == aAssign {
+			// In type switch clause, if a switch guard is assigned, duplicate the switch guard symbol
+			// in each clause body, so a different guard type can be set in each clause
+			name := ts.child[1].child[0].ident
+			interp.nindex++
+			gn := &node{anc: body, interp: interp, ident: name, index: interp.nindex, pos: pos, kind: identExpr, action: aNop, val: &i, gen: nop}
+			body.child = append(body.child, gn)
+		}
+		return body
+	}
+
 	addChild := func(root **node, anc astNode, pos token.Pos, kind nkind, act action) *node {
 		interp.nindex++
 		var i interface{}
@@ -361,17 +446,7 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 				if len(ancAst.List)+len(ancAst.Body) == len(anc.node.child) {
 					// All case clause children are collected.
 					// Split children in condition and body nodes to desambiguify the AST.
-					interp.nindex++
-					body := &node{anc: anc.node, interp: interp, index: interp.nindex, pos: pos, kind: caseBody, action: aNop, val: &i, gen: nop}
-
-					if ts := anc.node.anc.anc; ts.kind == typeSwitch && ts.child[1].action == aAssign {
-						// In type switch clause, if a switch guard is assigned, duplicate the switch guard symbol
-						// in each clause body, so a different guard type can be set in each clause
-						name := ts.child[1].child[0].ident
-						interp.nindex++
-						gn := &node{anc: body, interp: interp, ident: name, index: interp.nindex, pos: pos, kind: identExpr, action: aNop, val: &i, gen: nop}
-						body.child = append(body.child, gn)
-					}
+					body := newCaseBody(anc.node, pos)
 
 					// Add regular body children
 					body.child = append(body.child, anc.node.child[len(ancAst.List):]...)
@@ -396,6 +471,9 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 		switch a := nod.(type) {
 		case nil:
 			anc = st.pop()
+			if b, ok := blocks[anc.ast]; ok {
+				interp.cover.bind(b, anc.node)
+			}
 
 		case *ast.ArrayType:
 			st.push(addChild(&root, anc, pos, arrayType, aNop), nod)
@@ -450,6 +528,7 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 		case *ast.BasicLit:
 			n := addChild(&root, anc, pos, basicLit, aNop)
 			n.ident = a.Value
+			n.cval = constant.MakeFromLiteral(a.Value, a.Kind, 0)
 			switch a.Kind {
 			case token.CHAR:
 				v, _, _, _ := strconv.UnquoteChar(a.Value[1:len(a.Value)-1], '\'')
@@ -534,20 +613,45 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 			st.push(addChild(&root, anc, pos, kind, aNop), nod)
 
 		case *ast.CallExpr:
-			st.push(addChild(&root, anc, pos, callExpr, aCall), nod)
+			action := aCall
+			if a.Ellipsis.IsValid() {
+				// The last argument is passed as the variadic slice: f(s...)
+				action = aCallSlice
+			}
+			st.push(addChild(&root, anc, pos, callExpr, action), nod)
 
 		case *ast.CaseClause:
-			st.push(addChild(&root, anc, pos, caseClause, aCase), nod)
+			n := addChild(&root, anc, pos, caseClause, aCase)
+			if len(a.List)+len(a.Body) == 0 {
+				// Empty default clause, without children to split
+				n.child = append(n.child, newCaseBody(n, pos))
+			}
+			st.push(n, nod)
 
 		case *ast.ChanType:
-			st.push(addChild(&root, anc, pos, chanType, aNop), nod)
+			switch a.Dir {
+			case ast.RECV:
+				st.push(addChild(&root, anc, pos, chanTypeRecv, aNop), nod)
+			case ast.SEND:
+				st.push(addChild(&root, anc, pos, chanTypeSend, aNop), nod)
+			default:
+				st.push(addChild(&root, anc, pos, chanType, aNop), nod)
+			}
 
 		case *ast.CommClause:
-			st.push(addChild(&root, anc, pos, commClause, aNop), nod)
+			kind := commClause
+			if a.Comm == nil {
+				kind = commClauseDefault
+			}
+			st.push(addChild(&root, anc, pos, kind, aNop), nod)
 
 		case *ast.CompositeLit:
 			st.push(addChild(&root, anc, pos, compositeLitExpr, aCompositeLit), nod)
 
+		case *ast.EmptyStmt:
+			// An empty statement, as the target of a label, is an empty block
+			st.push(addChild(&root, anc, pos, blockStmt, aNop), nod)
+
 		case *ast.DeclStmt:
 			st.push(addChild(&root, anc, pos, declStmt, aNop), nod)
 
@@ -564,6 +668,10 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 			st.push(addChild(&root, anc, pos, fieldExpr, aNop), nod)
 
 		case *ast.FieldList:
+			if isTypeParams(anc.ast, a) {
+				st.push(addChild(&root, anc, pos, typeParams, aNop), nod)
+				break
+			}
 			st.push(addChild(&root, anc, pos, fieldList, aNop), nod)
 
 		case *ast.File:
@@ -678,6 +786,10 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 		case *ast.IndexExpr:
 			st.push(addChild(&root, anc, pos, indexExpr, aGetIndex), nod)
 
+		case *ast.IndexListExpr:
+			// Instantiation of a generic function or type with several type arguments
+			st.push(addChild(&root, anc, pos, indexExpr, aGetIndex), nod)
+
 		case *ast.InterfaceType:
 			st.push(addChild(&root, anc, pos, interfaceType, aNop), nod)
 
@@ -740,7 +852,12 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 			st.push(addChild(&root, anc, pos, typeAssertExpr, aTypeAssert), nod)
 
 		case *ast.TypeSpec:
-			st.push(addChild(&root, anc, pos, typeSpec, aNop), nod)
+			act := aNop
+			if a.Assign.IsValid() {
+				// Alias declaration
+				act = aAssign
+			}
+			st.push(addChild(&root, anc, pos, typeSpec, act), nod)
 
 		case *ast.TypeSwitchStmt:
 			n := addChild(&root, anc, pos, typeSwitch, aNop)
@@ -763,10 +880,39 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 				act = aNot
 			case token.SUB:
 				act = aNegate
+			case token.ADD:
+				act = aPos
+			case token.XOR:
+				act = aBitNot
 			}
 			st.push(addChild(&root, anc, pos, kind, act), nod)
 
+		case *ast.CommentGroup:
+			return false
+
 		case *ast.ValueSpec:
+			doc := a.Doc
+			if g, ok := anc.ast.(*ast.GenDecl); ok && doc == nil && !g.Lparen.IsValid() {
+				doc = g.Doc
+			}
+			patterns, perr := embedPatterns(doc)
+			if perr == nil && patterns != nil {
+				switch {
+				case anc.node.kind != varDecl:
+					perr = errors.New("misplaced go:embed directive")
+				case len(a.Names) > 1:
+					perr = errors.New("go:embed cannot apply to multiple vars")
+				case a.Values != nil:
+					perr = errors.New("go:embed cannot apply to var with initializer")
+				case a.Type == nil:
+					perr = errors.New("go:embed cannot apply to var without type")
+				}
+			}
+			if perr != nil {
+				err = astError(fmt.Errorf("%s: %v", interp.fset.Position(pos), perr))
+				return false
+			}
+
 			kind := valueSpec
 			act := aNop
 			if a.Values != nil {
@@ -791,6 +937,9 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 			n := addChild(&root, anc, pos, kind, act)
 			n.nleft = len(a.Names)
 			n.nright = len(a.Values)
+			if patterns != nil {
+				interp.embeds[n] = patterns
+			}
 			st.push(n, nod)
 
 		default:
@@ -808,6 +957,18 @@ func (interp *Interpreter) ast(src, name string) (string, *node, error) {
 	return pkgName, root, err
 }
 
+// isTypeParams returns true if field list f is the type parameter list of a
+// generic function or type declaration.
+func isTypeParams(anc ast.Node, f *ast.FieldList) bool {
+	switch a := anc.(type) {
+	case *ast.FuncType:
+		return a.TypeParams == f
+	case *ast.TypeSpec:
+		return a.TypeParams == f
+	}
+	return false
+}
+
 type astNode struct {
 	node *node
 	ast  ast.Node
@@ -1,7 +1,9 @@
 package interp
 
 import (
+	"fmt"
 	"go/build"
+	"go/build/constraint"
 	"go/parser"
 	"path"
 	"strconv"
@@ -10,61 +12,73 @@ import (
 
 // buildOk returns true if a file or script matches build constraints
 // as specified in https://golang.org/pkg/go/build/#hdr-Build_Constraints
+// A "//go:build" line, if present, takes precedence over "// +build" lines.
 func (interp *Interpreter) buildOk(ctx build.Context, name, src string) bool {
 	// Extract comments before the first clause
 	f, err := parser.ParseFile(interp.fset, name, src, parser.PackageClauseOnly|parser.ParseComments)
 	if err != nil {
 		return false
 	}
+	tagOk := func(tag string) bool { return buildTagOk(ctx, tag) }
+	var plusBuild []constraint.Expr
 	for _, g := range f.Comments {
-		// in file, evaluate the AND of multiple line build constraints
-		for _, line := range strings.Split(strings.TrimSpace(g.Text()), "\n") {
-			if !buildLineOk(ctx, line) {
-				return false
+		// Raw comment lines are used, as g.Text() drops "//go:" directives
+		for _, c := range g.List {
+			line := c.Text
+			switch {
+			case constraint.IsGoBuild(line):
+				x, err := constraint.Parse(line)
+				if err != nil {
+					return false
+				}
+				return x.Eval(tagOk)
+			case constraint.IsPlusBuild(line):
+				x, err := constraint.Parse(line)
+				if err != nil {
+					return false
+				}
+				plusBuild = append(plusBuild, x)
 			}
 		}
 	}
+	// in file, evaluate the AND of multiple line build constraints
+	for _, x := range plusBuild {
+		if !x.Eval(tagOk) {
+			return false
+		}
+	}
 	return true
 }
 
-// buildLineOk returns true if line is not a build constraint or
-// if build constraint is satisfied
-func buildLineOk(ctx build.Context, line string) (ok bool) {
-	if len(line) < 7 || line[:7] != "+build " {
-		return true
+// buildContext returns the build context c, completed by the host values
+// of its empty fields.
+func buildContext(c build.Context) build.Context {
+	if c.GOOS == "" {
+		c.GOOS = build.Default.GOOS
 	}
-	// In line, evaluate the OR of space-separated options
-	options := strings.Split(strings.TrimSpace(line[6:]), " ")
-	for _, o := range options {
-		if ok = buildOptionOk(ctx, o); ok {
-			break
-		}
+	if c.GOARCH == "" {
+		c.GOARCH = build.Default.GOARCH
 	}
-	return ok
-}
-
-// buildOptionOk return true if all comma separated tags match, false otherwise
-func buildOptionOk(ctx build.Context, tag string) bool {
-	// in option, evaluate the AND of individual tags
-	for _, t := range strings.Split(tag, ",") {
-		if !buildTagOk(ctx, t) {
-			return false
-		}
+	if c.GOROOT == "" {
+		c.GOROOT = build.Default.GOROOT
 	}
-	return true
+	if c.Compiler == "" {
+		c.Compiler = build.Default.Compiler
+	}
+	if len(c.ReleaseTags) == 0 {
+		c.ReleaseTags = build.Default.ReleaseTags
+	}
+	return c
 }
 
 // buildTagOk returns true if a build tag matches, false otherwise
-// if first character is !, result is negated
 func buildTagOk(ctx build.Context, s string) (r bool) {
-	not := s[0] == '!'
-	if not {
-		s = s[1:]
-	}
 	switch {
 	case contains(ctx.BuildTags, s):
 		r = true
-	case s == ctx.GOOS:
+	case osTagOk(ctx, s):
+		r = true
+	case s == "unix" && unixOs[ctx.GOOS]:
 		r = true
 	case s == ctx.GOARCH:
 		r = true
@@ -75,9 +89,6 @@ func buildTagOk(ctx build.Context, s string) (r bool) {
 			r = goMinorVersion(ctx) >= n
 		}
 	}
-	if not {
-		r = !r
-	}
 	return
 }
 
@@ -106,6 +117,20 @@ func goMinorVersion(ctx build.Context) int {
 	return m
 }
 
+// parseGoVersion returns the minor version number of a Go release, given
+// as "1.20", "go1.20" or "1.20.3".
+func parseGoVersion(v string) (int, error) {
+	s := strings.Split(strings.TrimPrefix(v, "go"), ".")
+	if len(s) < 2 || len(s) > 3 || s[0] != "1" {
+		return 0, fmt.Errorf("invalid Go version: %q", v)
+	}
+	m, err := strconv.Atoi(s[1])
+	if err != nil || m < 1 {
+		return 0, fmt.Errorf("invalid Go version: %q", v)
+	}
+	return m, nil
+}
+
 // skipFile returns true if file should be skipped
 func skipFile(ctx build.Context, p string) bool {
 	if !strings.HasSuffix(p, ".go") {
@@ -121,21 +146,48 @@ func skipFile(ctx build.Context, p string) bool {
 	}
 	a := strings.Split(p[i+1:], "_")
 	last := len(a) - 1
-	if last1 := last - 1; last1 >= 0 && a[last1] == ctx.GOOS && a[last] == ctx.GOARCH {
-		return false
+	if last1 := last - 1; last1 >= 0 && knownOs[a[last1]] && knownArch[a[last]] {
+		return !osTagOk(ctx, a[last1]) || a[last] != ctx.GOARCH
 	}
-	if s := a[last]; s != ctx.GOOS && s != ctx.GOARCH && knownOs[s] || knownArch[s] {
+	if s := a[last]; knownOs[s] {
+		return !osTagOk(ctx, s)
+	} else if knownArch[s] {
+		return s != ctx.GOARCH
+	}
+	return false
+}
+
+// osTagOk returns true if the operating system tag s matches the target
+// of ctx, including the systems derived from s, such as android from linux.
+func osTagOk(ctx build.Context, s string) bool {
+	switch s {
+	case ctx.GOOS:
 		return true
+	case "linux":
+		return ctx.GOOS == "android"
+	case "darwin":
+		return ctx.GOOS == "ios"
+	case "solaris":
+		return ctx.GOOS == "illumos"
 	}
 	return false
 }
 
+// isTestFile returns true if file is a test file which should be loaded
+// when testing a package.
+func isTestFile(ctx build.Context, p string) bool {
+	return strings.HasSuffix(p, "_test.go") && !skipFile(ctx, strings.TrimSuffix(p, "_test.go")+".go")
+}
+
 var knownOs = map[string]bool{
 	"aix":       true,
 	"android":   true,
 	"darwin":    true,
 	"dragonfly": true,
 	"freebsd":   true,
+	"hurd":      true,
+	"illumos":   true,
+	"ios":       true,
 	"js":        true,
 	"linux":     true,
 	"nacl":      true,
@@ -143,7 +195,25 @@ var knownOs = map[string]bool{
 	"openbsd":   true,
 	"plan9":     true,
 	"solaris":   true,
+	"wasip1":    true,
 	"windows":   true,
+	"zos":       true,
+}
+
+// unixOs lists the operating systems matching the unix build tag.
+var unixOs = map[string]bool{
+	"aix":       true,
+	"android":   true,
+	"darwin":    true,
+	"dragonfly": true,
+	"freebsd":   true,
+	"hurd":      true,
+	"illumos":   true,
+	"ios":       true,
+	"linux":     true,
+	"netbsd":    true,
+	"openbsd":   true,
+	"solaris":   true,
 }
 
 var knownArch = map[string]bool{
@@ -152,12 +222,14 @@ var knownArch = map[string]bool{
 	"amd64p32": true,
 	"arm":      true,
 	"arm64":    true,
+	"loong64":  true,
 	"mips":     true,
 	"mips64":   true,
 	"mips64le": true,
 	"mipsle":   true,
 	"ppc64":    true,
 	"ppc64le":  true,
+	"riscv64":  true,
 	"s390x":    true,
 	"wasm":     true,
 }
@@ -37,6 +37,18 @@ func TestBuildTag(t *testing.T) {
 		{"// +build foo", true},
 		{"// +build !foo", false},
 		{"// +build bar", false},
+		{"//go:build linux", true},
+		{"//go:build windows", false},
+		{"//go:build linux && amd64", true},
+		{"//go:build linux && i386", false},
+		{"//go:build windows || linux", true},
+		{"//go:build !windows", true},
+		{"//go:build !(windows || darwin) && go1.11", true},
+		{"//go:build (linux && i386) || go1.12", false},
+		{"//go:build foo && !bar", true},
+		{"//go:build linux &&", false},
+		{"//go:build linux\n// +build windows", true},
+		{"//go:build windows\n// +build linux", false},
 	}
 
 	i := New(Options{})
@@ -69,6 +81,10 @@ func TestBuildFile(t *testing.T) {
 		{"bar_aix_s390x.go", true},
 		{"bar_aix_amd64.go", true},
 		{"bar_linux_arm.go", true},
+		{"bar_amd64.go", false},
+		{"bar_arm64.go", true},
+		{"bar_windows.go", true},
+		{"bar_windows_amd64.go", true},
 	}
 
 	for _, test := range tests {
@@ -81,6 +97,39 @@ func TestBuildFile(t *testing.T) {
 	}
 }
 
+func TestBuildTarget(t *testing.T) {
+	ctx := buildContext(build.Context{GOOS: "android", GOARCH: "arm64"})
+	if ctx.GOROOT != build.Default.GOROOT || len(ctx.ReleaseTags) == 0 {
+		t.Fatalf("got GOROOT %q and release tags %v, want host ones", ctx.GOROOT, ctx.ReleaseTags)
+	}
+
+	files := []testBuild{
+		{"bar_android.go", false},
+		{"bar_linux.go", false},
+		{"bar_linux_arm64.go", false},
+		{"bar_linux_amd64.go", true},
+		{"bar_darwin.go", true},
+	}
+	for _, test := range files {
+		if r := skipFile(ctx, test.src); r != test.res {
+			t.Errorf("%s: got %v, want %v", test.src, r, test.res)
+		}
+	}
+
+	tags := []testBuild{
+		{"//go:build linux && arm64", true},
+		{"//go:build unix", true},
+		{"//go:build android && !darwin", true},
+		{"//go:build windows || amd64", false},
+	}
+	i := New(Options{})
+	for _, test := range tags {
+		if r := i.buildOk(ctx, "", test.src+"\npackage x"); r != test.res {
+			t.Errorf("%s: got %v, want %v", test.src, r, test.res)
+		}
+	}
+}
+
 func Test_goMinorVersion(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -2,9 +2,12 @@ package interp
 
 import (
 	"fmt"
+	"go/constant"
+	"go/token"
 	"log"
 	"path"
 	"reflect"
+	"sync"
 	"unicode"
 )
 
@@ -32,15 +35,26 @@ var constOp = map[action]func(*node){
 func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 	sc, pkgName := interp.initScopePkg(root)
 	var loop, loopRestart *node
+	var loops [][2]*node // enclosing loop and restart nodes of loops, switch and select statements, restored at exit
 	var initNodes []*node
 	var iotaValue int
 	var err error
+	var declScope *scope // scope of the top level declaration or statement
 
 	root.Walk(func(n *node) bool {
 		// Pre-order processing
+		if err != nil && n.anc == root && root.anc == nil && interp.allErrors {
+			// Resume at the next top level declaration or statement
+			interp.errs = append(interp.errs, err)
+			err, sc = nil, declScope
+			loop, loopRestart, loops = nil, nil, nil
+		}
 		if err != nil {
 			return false
 		}
+		if n.anc == root {
+			declScope = sc
+		}
 		switch n.kind {
 		case blockStmt:
 			if n.anc != nil && n.anc.kind == rangeStmt {
@@ -51,10 +65,15 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				// RangeStmt. The following workaround is less elegant but ok.
 				if t := sc.rangeChanType(n.anc); t != nil {
 					// range over channel
+					if isSendChan(t) {
+						err = n.anc.child[1].cfgErrorf("invalid operation: range over send-only channel")
+						return false
+					}
 					e := n.anc.child[0]
-					index := sc.add(t.val)
-					sc.sym[e.ident] = &symbol{index: index, kind: varSym, typ: t.val}
-					e.typ = t.val
+					et := chanElemType(t)
+					index := sc.add(et)
+					sc.sym[e.ident] = &symbol{index: index, kind: varSym, typ: et}
+					e.typ = et
 					e.findex = index
 					n.anc.gen = rangeChan
 				} else {
@@ -67,31 +86,70 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 						k, o = n.anc.child[0], n.anc.child[1]
 					}
 
-					switch o.typ.cat {
+					ot := o.typ
+					for ot.cat == aliasT {
+						ot = ot.val
+					}
+					yt := rangeYield(ot)
+					switch ot.cat {
 					case valueT:
-						typ := o.typ.rtype
+						typ := ot.rtype
 						switch typ.Kind() {
+						case reflect.Func:
+							if yt != nil {
+								n.anc.gen = rangeFunc
+								if ktyp, vtyp, err = interp.rangeFuncVars(sc, o, yt, k, v); err != nil {
+									return false
+								}
+							}
 						case reflect.Map:
 							n.anc.gen = rangeMap
 							ktyp = &itype{cat: valueT, rtype: typ.Key()}
 							vtyp = &itype{cat: valueT, rtype: typ.Elem()}
 						case reflect.String:
+							n.anc.gen = rangeString
 							ktyp = sc.getType("int")
-							vtyp = sc.getType("byte")
+							vtyp = sc.getType("rune")
 						case reflect.Array, reflect.Slice:
 							ktyp = sc.getType("int")
 							vtyp = &itype{cat: valueT, rtype: typ.Elem()}
+						case reflect.Ptr:
+							if typ.Elem().Kind() == reflect.Array {
+								ktyp = sc.getType("int")
+								vtyp = &itype{cat: valueT, rtype: typ.Elem().Elem()}
+							}
 						}
 					case mapT:
 						n.anc.gen = rangeMap
-						ktyp = o.typ.key
-						vtyp = o.typ.val
+						ktyp = ot.key
+						vtyp = ot.val
+					case funcT:
+						if yt != nil {
+							n.anc.gen = rangeFunc
+							if ktyp, vtyp, err = interp.rangeFuncVars(sc, o, yt, k, v); err != nil {
+								return false
+							}
+						}
 					case stringT:
+						n.anc.gen = rangeString
 						ktyp = sc.getType("int")
-						vtyp = sc.getType("byte")
+						vtyp = sc.getType("rune")
 					case arrayT:
 						ktyp = sc.getType("int")
-						vtyp = o.typ.val
+						vtyp = ot.val
+					case ptrT:
+						if at := arrayPtrElem(ot); at != nil {
+							ktyp = sc.getType("int")
+							vtyp = at.val
+						}
+					}
+					if ktyp == nil {
+						err = o.cfgErrorf("cannot range over %s", o.typ.id())
+						return false
+					}
+					if vtyp != nil && vtyp.cat == funcT && yt == nil {
+						// function in an array, slice or map element is always wrapped in reflect.Value
+						vtyp = &itype{cat: valueT, rtype: vtyp.TypeOf()}
 					}
 
 					kindex := sc.add(ktyp)
@@ -105,62 +163,44 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 						v.typ = vtyp
 						v.findex = vindex
 					}
+
+					// The range expression is evaluated once, before the iterations
+					if ot.TypeOf().Kind() == reflect.Map {
+						n.anc.findex = sc.add(&itype{cat: valueT, rtype: mapRangeType})
+					} else if yt != nil {
+						// The iteration state, followed by the yield function
+						n.anc.findex = sc.add(sc.getType("int"))
+						sc.add(yt)
+					} else {
+						n.anc.findex = sc.add(o.typ)
+					}
 				}
 			}
 			n.findex = -1
 			n.val = nil
 			sc = sc.pushBloc()
-
-		case breakStmt, continueStmt, gotoStmt:
-			if len(n.child) > 0 {
-				// Handle labeled statements
-				label := n.child[0].ident
-				if sym, _, ok := sc.lookup(label); ok {
-					if sym.kind != labelSym {
-						err = n.child[0].cfgErrorf("label %s not defined", label)
-						break
-					}
-					sym.from = append(sym.from, n)
-					n.sym = sym
-				} else {
-					n.sym = &symbol{kind: labelSym, from: []*node{n}, index: -1}
-					sc.sym[label] = n.sym
-				}
-			}
-
-		case labeledStmt:
-			label := n.child[0].ident
-			if sym, _, ok := sc.lookup(label); ok {
-				if sym.kind != labelSym {
-					err = n.child[0].cfgErrorf("label %s not defined", label)
-					break
-				}
-				sym.node = n
-				n.sym = sym
-			} else {
-				n.sym = &symbol{kind: labelSym, node: n, index: -1}
-				sc.sym[label] = n.sym
+			n.scope = sc
+			sc.loop = n.anc != nil && (isLoop(n.anc) || n.anc.kind == rangeStmt) && n == n.anc.lastChild()
+			if sc.loop && interp.perIterationLoopVars() {
+				sc.anc.body = sc
 			}
 
 		case caseClause:
 			sc = sc.pushBloc()
+			n.scope = sc
 			if sn := n.anc.anc; sn.kind == typeSwitch && sn.child[1].action == aAssign {
 				// Type switch clause with a var defined in switch guard
-				var typ *itype
-				if len(n.child) == 2 {
-					// 1 type in clause: define the var with this type in the case clause scope
-					switch sym, _, ok := sc.lookup(n.child[0].ident); {
-					case ok && sym.kind == typeSym:
-						typ = sym.typ
-					case n.child[0].ident == "nil":
-						typ = sc.getType("interface{}")
-					default:
-						err = n.cfgErrorf("%s is not a type", n.child[0].ident)
+				// The var has the type of the switch guard expression, unless
+				// the clause lists exactly one type, other than nil
+				typ := sn.child[1].child[1].child[0].typ
+				if c := n.child[0]; len(n.child) == 2 && c.ident != "nil" {
+					if !c.isType(sc) {
+						err = c.cfgErrorf("%s is not a type", c.ident)
+						return false
+					}
+					if typ, err = nodeType(interp, sc, c); err != nil {
 						return false
 					}
-				} else {
-					// define the var with the type in the switch guard expression
-					typ = sn.child[1].child[1].child[0].typ
 				}
 				nod := n.lastChild().child[0]
 				index := sc.add(typ)
@@ -169,47 +209,45 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				nod.typ = typ
 			}
 
-		case commClause:
+		case commClause, commClauseDefault:
 			sc = sc.pushBloc()
-			if n.child[0].action == aAssign {
-				ch := n.child[0].child[1].child[0]
-				if sym, _, ok := sc.lookup(ch.ident); ok {
-					assigned := n.child[0].child[0]
-					index := sc.add(sym.typ.val)
-					sc.sym[assigned.ident] = &symbol{index: index, kind: varSym, typ: sym.typ.val}
-					assigned.findex = index
-					assigned.typ = sym.typ.val
-				}
-			}
+			n.scope = sc
 
 		case compositeLitExpr:
-			if n.child[0].isType(sc) {
+			if len(n.child) > 0 && n.child[0].isType(sc) {
 				// Get type from 1st child
 				if n.typ, err = nodeType(interp, sc, n.child[0]); err != nil {
 					return false
 				}
 			} else {
 				// Get type from ancestor (implicit type)
-				if n.anc.kind == keyValueExpr && n == n.anc.child[0] {
-					n.typ = n.anc.typ.key
-				} else if n.anc.typ != nil {
-					n.typ = n.anc.typ.val
+				t := elidedType(n)
+				if t == nil {
+					if len(n.child) > 0 && n.child[0].kind == identExpr {
+						c := n.child[0]
+						err = suggest(c.cfgErrorf("undefined: %s", c.ident), c.ident, sc.names())
+					} else {
+						err = n.cfgErrorf("invalid composite literal type")
+					}
+					return false
 				}
-				// FIXME n.typ can be nil.
-				n.typ.untyped = true
+				// The type is marked untyped for this literal only, as it has no type child
+				u := *t
+				u.untyped = true
+				n.typ = &u
 			}
-			// Propagate type to children, to handle implicit types
+			// Propagate type to key-value children, to handle implicit types
 			for _, c := range n.child {
-				c.typ = n.typ
+				if c.kind == keyValueExpr {
+					c.typ = n.typ
+				}
 			}
 
-		case forStmt0, forRangeStmt:
-			loop, loopRestart = n, n.child[0]
-			sc = sc.pushBloc()
-
-		case forStmt1, forStmt2, forStmt3, forStmt3a, forStmt4:
-			loop, loopRestart = n, n.lastChild()
+		case forStmt0, forStmt1, forStmt2, forStmt3, forStmt3a, forStmt4, forRangeStmt:
+			loops = append(loops, [2]*node{loop, loopRestart})
+			loop, loopRestart = n, restartNode(n)
 			sc = sc.pushBloc()
+			n.scope = sc
 
 		case funcLit:
 			n.typ = nil // to force nodeType to recompute the type
@@ -220,9 +258,17 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			fallthrough
 
 		case funcDecl:
+			if isGeneric(n) {
+				// Generic templates are compiled at instantiation
+				return false
+			}
 			n.val = n
+			if err = resolveLabels(n.lastChild()); err != nil {
+				return false
+			}
 			// Add a frame indirection level as we enter in a func
 			sc = sc.pushFunc()
+			n.scope = sc
 			sc.def = n
 			if len(n.child[2].child) == 2 {
 				// Allocate frame space for return values, define output symbols
@@ -270,6 +316,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 
 		case ifStmt0, ifStmt1, ifStmt2, ifStmt3:
 			sc = sc.pushBloc()
+			n.scope = sc
+
+		case selectStmt:
+			// A break statement in a comm clause terminates the select
+			loops = append(loops, [2]*node{loop, loopRestart})
+			loop = n
 
 		case switchStmt, switchIfStmt, typeSwitch:
 			// Make sure default clause is in last position
@@ -278,6 +330,8 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				c[i], c[l] = c[l], c[i]
 			}
 			sc = sc.pushBloc()
+			n.scope = sc
+			loops = append(loops, [2]*node{loop, loopRestart})
 			loop = n
 
 		case importSpec:
@@ -289,7 +343,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				ipath = n.child[0].rval.String()
 				name = path.Base(ipath)
 			}
-			if interp.binPkg[ipath] != nil && name != "." {
+			if interp.binSymbols(ipath) != nil && name != "." {
 				sc.sym[name] = &symbol{kind: pkgSym, typ: &itype{cat: binPkgT}, path: ipath}
 			} else {
 				sc.sym[name] = &symbol{kind: pkgSym, typ: &itype{cat: srcPkgT}, path: ipath}
@@ -297,10 +351,14 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			return false
 
 		case typeSpec:
-			// processing already done in GTA pass
+			if !sc.global {
+				// Type declared in a function body
+				err = interp.declType(sc, n, pkgName)
+			}
+			// processing of package level types already done in GTA pass
 			return false
 
-		case arrayType, basicLit, chanType, funcType, mapType, structType:
+		case arrayType, basicLit, chanType, chanTypeRecv, chanTypeSend, funcType, interfaceType, mapType, structType:
 			n.typ, err = nodeType(interp, sc, n)
 			return false
 		}
@@ -315,6 +373,9 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			wireChild(n)
 			n.typ = &itype{cat: ptrT, val: n.child[0].typ}
 			n.findex = sc.add(n.typ)
+			if c := n.child[0]; c.kind == identExpr && c.sym != nil {
+				sc.addressOf(c.ident, c.sym)
+			}
 
 		case assignStmt, defineStmt:
 			if n.anc.kind == typeSwitch && n.anc.child[1] == n {
@@ -322,8 +383,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				n.gen = nop
 				break
 			}
-			if n.anc.kind == commClause {
+			if n.anc.kind == commClause && n.anc.child[0] == n {
+				// Receive performed by select, define the received variable
 				n.gen = nop
+				if n.kind == defineStmt {
+					defineRecv(sc, n, n.child[:1])
+				}
 				break
 			}
 			var atyp *itype
@@ -357,9 +422,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 						dest.typ.size = compositeArrayLen(src)
 						dest.typ.rtype = nil
 					}
-					if sc.global {
+					if sc.global && !isLoop(n.anc) {
 						// Do not overload existings symbols (defined in GTA) in global scope
 						sym, _, _ = sc.lookup(dest.ident)
+						if sym.index < 0 {
+							sym.index = sc.add(dest.typ)
+						}
 					} else {
 						sym = &symbol{index: sc.add(dest.typ), kind: varSym, typ: dest.typ}
 						sc.sym[dest.ident] = sym
@@ -371,6 +439,18 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				} else {
 					sym, level, _ = sc.lookup(dest.ident)
 				}
+				if n.action == aAssign && n.nright > 0 {
+					context := "assignment"
+					if n.kind == defineStmt {
+						context = "variable declaration"
+					}
+					if err = checkImplements(src, dest.typ, context); err != nil {
+						return
+					}
+					if err = checkAssignable(src, dest.typ, context); err != nil {
+						return
+					}
+				}
 				switch t0, t1 := dest.typ.TypeOf(), src.typ.TypeOf(); n.action {
 				case aAddAssign:
 					if !(isNumber(t0) && isNumber(t1) || isString(t0) && isString(t1)) || isInt(t0) && isFloat(t1) {
@@ -385,12 +465,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 						err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
 					}
 				case aShlAssign, aShrAssign:
-					if !(isInt(t0) && isUint(t1)) {
+					if !(isInt(t0) && isInt(t1) && (src.cval == nil || constant.Sign(src.cval) >= 0)) {
 						err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
 					}
 				default:
-					// Detect invalid float truncate
-					if isInt(t0) && isFloat(t1) {
+					// Detect invalid float truncate, untyped constants are checked at conversion
+					if isInt(t0) && isFloat(t1) && !(src.cval != nil && src.typ.untyped) {
 						err = src.cfgErrorf("invalid float truncate")
 						return
 					}
@@ -400,27 +480,44 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				// Propagate type
 				// TODO: Check that existing destination type matches source type
 				switch {
-				case n.action == aAssign && src.action == aCall:
+				case isMapEntry(dest):
+					// Assign the map entry in assign, or store the result of an operation
+					if n.action != aAssign {
+						n.gen = storeMapEntry(n.gen, n.child[:1])
+					}
+				case n.action == aAssign && isInterfaceConv(dest, src) && (src.action == aCall || src.action == aCallSlice || src.action == aRecv || src.action == aCompositeLit):
+					// The value is converted to an interface value by assign
+					if src.action == aCompositeLit {
+						src.findex = sc.add(src.typ)
+					}
+				case n.action == aAssign && n.nleft == 1 && (src.action == aCall || src.action == aCallSlice) && !(isBuiltinCall(src) && src.rval.IsValid()):
 					n.gen = nop
 					src.level = level
 					src.findex = dest.findex
-				case n.action == aAssign && src.action == aRecv:
+				case n.action == aAssign && n.nleft == 1 && src.action == aRecv:
 					// Assign by reading from a receiving channel
 					n.gen = nop
 					src.findex = dest.findex // Set recv address to LHS
-					dest.typ = src.typ.val
-				case n.action == aAssign && src.action == aCompositeLit:
+					dest.typ = src.typ
+				case n.action == aAssign && n.nleft == 1 && src.action == aCompositeLit && isDistinctStruct(src.typ, dest.typ):
+					// The value is converted to the destination type by assign
+					src.findex = sc.add(src.typ)
+				case n.action == aAssign && n.nleft == 1 && src.action == aCompositeLit:
 					n.gen = nop
 					src.findex = dest.findex
 					src.level = level
-				case src.kind == basicLit:
-					// TODO: perform constant folding and propagation here
+				case src.kind == basicLit || src.cval != nil && src.typ.untyped:
 					switch {
 					case dest.typ.cat == interfaceT:
 						// value set in genValue
 					case !src.rval.IsValid():
 						// Assign to nil
 						src.rval = reflect.New(dest.typ.TypeOf()).Elem()
+					case src.cval != nil && src.typ.untyped && (!dest.typ.untyped || n.kind == assignStmt) && isConstConvertible(src.cval, dest.typ.TypeOf()):
+						// Convert exact constant value to destination type
+						if err == nil {
+							err = convertConst(src, dest.typ)
+						}
 					default:
 						// Convert literal value to destination type
 						src.rval = src.rval.Convert(dest.typ.TypeOf())
@@ -431,9 +528,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				if sym != nil {
 					sym.typ = n.typ
 					sym.recv = src.recv
+					if n.anc.kind == constDecl && src.cval != nil {
+						sym.kind, sym.rval, sym.cval = constSym, src.rval, src.cval
+					}
 				}
 				n.level = level
-				if isMapEntry(dest) {
+				if isMapEntry(dest) && n.action == aAssign {
 					dest.gen = nop // skip getIndexMap
 				}
 			}
@@ -450,9 +550,17 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				sym.typ = n.typ
 				n.level = level
 			}
+			if isMapEntry(n.child[0]) {
+				n.gen = storeMapEntry(n.gen, n.child[:1])
+			}
 
 		case assignXStmt:
 			wireChild(n)
+			if n.anc.kind == commClause && n.anc.child[0] == n {
+				// Receive with status performed by select
+				n.gen = nop
+				break
+			}
 			l := len(n.child) - 1
 			switch n.child[l].kind {
 			case callExpr:
@@ -469,9 +577,22 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					n.gen = nop
 				}
 			}
+			for _, c := range n.child[:l] {
+				if isMapEntry(c) {
+					// The values assigned to map entries by the source are stored in maps
+					n.gen = storeMapEntry(n.gen, n.child[:l])
+					break
+				}
+			}
 
 		case defineXStmt:
 			wireChild(n)
+			if n.anc.kind == commClause && n.anc.child[0] == n {
+				// Receive with status performed by select, define the received variables
+				n.gen = nop
+				defineRecv(sc, n, n.child[:2])
+				break
+			}
 			if sc.def == nil {
 				// in global scope, type definition already handled by GTA
 				break
@@ -483,8 +604,11 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			nilSym := interp.universe.sym["nil"]
 			c0, c1 := n.child[0], n.child[1]
 			t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf()
-			if !c0.typ.untyped && !c1.typ.untyped && c0.typ.id() != c1.typ.id() {
-				err = n.cfgErrorf("mismatched types %s and %s", c0.typ.id(), c1.typ.id())
+			isShift := n.action == aShl || n.action == aShr
+			// An interface value can be compared to any value implementing it
+			isIfaceCompare := (n.action == aEqual || n.action == aNotEqual) && (isInterface(c0.typ) || isInterface(c1.typ))
+			if !isShift && !isIfaceCompare && !c0.typ.untyped && !c1.typ.untyped && c0.typ.id() != c1.typ.id() {
+				err = operandNotes(n.cfgErrorf("mismatched types %s and %s", c0.typ.id(), c1.typ.id()), c0, c1)
 				break
 			}
 			switch n.action {
@@ -501,22 +625,43 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
 				}
 			case aShl, aShr:
-				if !(isInt(t0) && isUint(t1)) {
+				// A shift count must be an integer, non negative if constant
+				isCount := isInt(t1) && (c1.cval == nil || constant.Sign(c1.cval) >= 0)
+				if !(isInt(t0) && isCount) {
 					err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
+					break
+				}
+				if c0.cval != nil && c0.typ.untyped && c1.cval == nil {
+					// An untyped constant shifted by a non-constant count has
+					// the type expected by the context of the shift
+					t := shiftContextType(interp, sc, n)
+					if t == nil || isInterface(t) {
+						t = sc.getType("int")
+					}
+					if err = convertConst(c0, t); err != nil {
+						break
+					}
 				}
 				n.typ = c0.typ
 			case aEqual, aNotEqual:
-				if isNumber(t0) && !isNumber(t1) || isString(t0) && !isString(t1) {
-					err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
-				}
 				n.typ = sc.getType("bool")
-				if n.child[0].sym == nilSym || n.child[1].sym == nilSym {
+				if c0.sym == nilSym || c1.sym == nilSym {
+					if c0.sym == nilSym {
+						// Compare the non nil operand, which is tested by isNil
+						n.child[0], n.child[1] = c1, c0
+					}
 					if n.action == aEqual {
 						n.gen = isNil
 					} else {
 						n.gen = isNotNil
 					}
+					break
 				}
+				if !isIfaceCompare && (isNumber(t0) && !isNumber(t1) || isString(t0) && !isString(t1)) {
+					err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
+					break
+				}
+				err = checkComparable(n, c0, c1)
 			case aGreater, aGreaterEqual, aLower, aLowerEqual:
 				if isNumber(t0) && !isNumber(t1) || isString(t0) && !isString(t1) {
 					err = n.cfgErrorf("illegal operand types for '%v' operator", n.action)
@@ -524,9 +669,35 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				n.typ = sc.getType("bool")
 			}
 			if err != nil {
+				err = operandNotes(err, c0, c1)
 				break
 			}
-			if c0.rval.IsValid() && c1.rval.IsValid() && constOp[n.action] != nil {
+			if !isShift {
+				// Convert an untyped constant operand to the type of the other operand
+				switch {
+				case c0.cval != nil && c0.typ.untyped && c1.cval == nil && isNumber(t1) && isConstConvertible(c0.cval, t1):
+					err = convertConst(c0, c1.typ)
+				case c1.cval != nil && c1.typ.untyped && c0.cval == nil && isNumber(t0) && isConstConvertible(c1.cval, t0):
+					err = convertConst(c1, c0.typ)
+				}
+				if err != nil {
+					return
+				}
+			}
+			switch {
+			case c0.cval != nil && c1.cval != nil:
+				if n.typ != nil && !isConstType(n.typ.TypeOf()) {
+					n.typ = nil // type propagated from a composite literal
+				}
+				if n.typ == nil {
+					if n.typ, err = nodeType(interp, sc, n); err != nil {
+						return
+					}
+				}
+				if err = foldBinary(n); err != nil {
+					return
+				}
+			case c0.rval.IsValid() && c1.rval.IsValid() && constOp[n.action] != nil:
 				if n.typ == nil {
 					if n.typ, err = nodeType(interp, sc, n); err != nil {
 						return
@@ -541,10 +712,13 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			case n.rval.IsValid():
 				n.gen = nop
 				n.findex = -1
-			case n.anc.kind == assignStmt && n.anc.action == aAssign:
+			case n.anc.kind == assignStmt && n.anc.action == aAssign && n.anc.nleft == 1:
+				// The result is stored in the destination, unless in a
+				// multiple assignment, where all operands are evaluated first
 				dest := n.anc.child[childPos(n)-n.anc.nright]
 				n.typ = dest.typ
 				n.findex = dest.findex
+				n.level = dest.level
 			case n.anc.kind == returnStmt:
 				pos := childPos(n)
 				n.typ = sc.def.typ.ret[pos]
@@ -559,15 +733,40 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			}
 
 		case indexExpr:
+			if g := n.child[0].typ; g != nil && g.cat == genericT {
+				// Explicit instantiation of a generic function or type
+				if isPartialCallee(g, n) {
+					n.typ = g
+					break
+				}
+				var sym *symbol
+				var name string
+				if sym, name, err = interp.instantiateNode(sc, g, n.child[1:], n); err != nil {
+					break
+				}
+				setGenericRef(n, sym, name)
+				break
+			}
 			wireChild(n)
 			t := n.child[0].typ
-			switch t.cat {
-			case valueT:
+			if at := arrayPtrElem(t); at != nil {
+				// Index an array through a pointer to it
+				t = at
+			}
+			switch {
+			case t.cat == valueT && t.rtype.Kind() == reflect.String:
+				// Index of a string of a binary type
+				n.typ = sc.getType("byte")
+			case t.cat == valueT:
 				n.typ = &itype{cat: valueT, rtype: t.rtype.Elem()}
-			case stringT:
+			case t.cat == stringT:
 				n.typ = sc.getType("byte")
 			default:
 				n.typ = t.val
+				if n.typ.cat == funcT {
+					// function in an array, slice or map element is always wrapped in reflect.Value
+					n.typ = &itype{cat: valueT, rtype: n.typ.TypeOf()}
+				}
 			}
 			n.findex = sc.add(n.typ)
 			n.recv = &receiver{node: n}
@@ -590,6 +789,10 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				n.typ = l.typ
 				n.rval = l.rval
 			}
+			if len(sc.iter) > 0 {
+				// Renew captured variables at the end of each iteration
+				n.gen = renew
+			}
 			sc = sc.pop()
 
 		case constDecl:
@@ -600,6 +803,10 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			wireChild(n)
 
 		case declStmt, exprStmt, sendStmt:
+			if n.kind == sendStmt && isRecvChan(n.child[0].typ) {
+				err = n.cfgErrorf("invalid operation: cannot send to receive-only channel")
+				break
+			}
 			wireChild(n)
 			l := n.lastChild()
 			n.findex = l.findex
@@ -610,14 +817,14 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 
 		case breakStmt:
 			if len(n.child) > 0 {
-				gotoLabel(n.sym)
+				n.tnext = n.sym.node.child[1]
 			} else {
 				n.tnext = loop
 			}
 
 		case continueStmt:
 			if len(n.child) > 0 {
-				gotoLabel(n.sym)
+				n.tnext = restartNode(n.sym.node.child[1])
 			} else {
 				n.tnext = loopRestart
 			}
@@ -631,54 +838,54 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			gotoLabel(n.sym)
 
 		case callExpr:
+			if t := n.child[0].typ; t != nil && t.cat == genericT {
+				// Call of a generic function: infer type arguments
+				var sym *symbol
+				if sym, err = interp.inferCall(sc, n); err != nil {
+					break
+				}
+				setGenericRef(n.child[0], sym, sym.node.child[1].ident)
+			}
 			wireChild(n)
 			switch {
+			case unsafeFunc(n) != "":
+				err = unsafeCall(sc, n, unsafeFunc(n))
 			case isBuiltinCall(n):
 				n.gen = n.child[0].sym.builtin
 				n.child[0].typ = &itype{cat: builtinT}
+				if name := n.child[0].ident; (name == "clear" || name == "max" || name == "min") && interp.langVersion() < 21 {
+					err = n.cfgErrorf("%s requires go1.21 or later", name)
+					break
+				}
 				switch n.child[0].ident {
 				case "append":
-					c1, c2 := n.child[1], n.child[2]
+					c1 := n.child[1]
 					if n.typ = sc.getType(c1.ident); n.typ == nil {
 						if n.typ, err = nodeType(interp, sc, c1); err != nil {
 							return
 						}
 					}
-					if len(n.child) == 3 {
-						if c2.typ.cat == arrayT && c2.typ.val.id() == n.typ.val.id() ||
-							isByteArray(c1.typ.TypeOf()) && isString(c2.typ.TypeOf()) {
-							n.gen = appendSlice
-						}
+					if n.action == aCallSlice {
+						n.gen = appendSlice
 					}
 				case "cap", "copy", "len":
 					n.typ = sc.getType("int")
-				case "complex":
-					c0, c1 := n.child[1], n.child[2]
-					switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
-					case isFloat32(t0) && isFloat32(t1):
-						n.typ = sc.getType("complex64")
-					case isFloat64(t0) && isFloat64(t1):
-						n.typ = sc.getType("complex128")
-					case c0.typ.untyped && isNumber(t0) && c1.typ.untyped && isNumber(t1):
-						n.typ = &itype{cat: valueT, rtype: complexType}
-					case c0.typ.untyped && isFloat32(t1) || c1.typ.untyped && isFloat32(t0):
-						n.typ = sc.getType("complex64")
-					case c0.typ.untyped && isFloat64(t1) || c1.typ.untyped && isFloat64(t0):
-						n.typ = sc.getType("complex128")
-					default:
-						err = n.cfgErrorf("invalid types %s and %s", t0.Kind(), t1.Kind())
+				case "clear":
+					if len(n.child) != 2 {
+						err = n.cfgErrorf("wrong number of arguments for clear")
+					} else if k := n.child[1].typ.TypeOf().Kind(); k != reflect.Map && k != reflect.Slice {
+						err = n.child[1].cfgErrorf("invalid argument: %s must be a map or a slice", valueString(n.child[1]))
 					}
-				case "real", "imag":
-					switch k := n.child[1].typ.TypeOf().Kind(); {
-					case k == reflect.Complex64:
-						n.typ = sc.getType("float32")
-					case k == reflect.Complex128:
-						n.typ = sc.getType("float64")
-					case n.child[1].typ.untyped && isNumber(n.child[1].typ.TypeOf()):
-						n.typ = &itype{cat: valueT, rtype: floatType}
-					default:
-						err = n.cfgErrorf("invalid complex type %s", k)
+				case "close":
+					if isRecvChan(n.child[1].typ) {
+						err = n.child[1].cfgErrorf("invalid operation: cannot close receive-only channel")
 					}
+				case "complex":
+					n.typ, err = complexBuiltinType(sc, n, n.child[1].typ, n.child[2].typ)
+				case "real", "imag":
+					n.typ, err = partBuiltinType(sc, n, n.child[1].typ)
+				case "max", "min":
+					n.typ, err = minMaxBuiltinType(n)
 				case "make":
 					if n.typ = sc.getType(n.child[1].ident); n.typ == nil {
 						if n.typ, err = nodeType(interp, sc, n.child[1]); err != nil {
@@ -695,7 +902,13 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				case "recover":
 					n.typ = sc.getType("interface{}")
 				}
-				if n.typ != nil {
+				if err == nil {
+					err = foldBuiltin(n)
+				}
+				if n.rval.IsValid() {
+					n.gen = nop
+					n.findex = -1
+				} else if n.typ != nil {
 					n.findex = sc.add(n.typ)
 				} else {
 					n.findex = -1
@@ -703,27 +916,57 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				}
 			case n.child[0].isType(sc):
 				// Type conversion expression
-				if isInt(n.child[0].typ.TypeOf()) && n.child[1].kind == basicLit && isFloat(n.child[1].typ.TypeOf()) {
+				c0, c1 := n.child[0], n.child[1]
+				interp.vetStringConversion(n)
+				if isInt(c0.typ.TypeOf()) && c1.cval != nil && isNumericKind(c1.cval.Kind()) && constant.ToInt(c1.cval).Kind() != constant.Int {
 					err = n.cfgErrorf("truncated to integer")
+					break
+				}
+				if c1.cval != nil && !isInterface(c0.typ) && isConstConvertible(c1.cval, c0.typ.TypeOf()) {
+					// Constant conversion: the converted value is also a constant
+					if err = convertConst(c1, c0.typ); err != nil {
+						break
+					}
+					n.rval, n.cval = c1.rval, c1.cval
 				}
 				if isInterface(n.child[0].typ) {
 					// Convert to interface: just check that all required methods are defined by concrete type.
 					c0, c1 := n.child[0], n.child[1]
-					if !c1.typ.implements(c0.typ) {
-						err = n.cfgErrorf("type %v does not implement interface %v", c1.typ.id(), c0.typ.id())
+					if reason := notImplemented(c1, c0.typ); reason != "" {
+						err = c1.cfgErrorf("cannot convert %s to type %s: %s", valueString(c1), typeString(c0.typ), reason)
+						break
 					}
-					// Pass value as is
-					n.gen = nop
-					n.typ = n.child[1].typ
-					n.findex = n.child[1].findex
-					n.val = n.child[1].val
-					n.rval = n.child[1].rval
+					n.gen = convert
+					n.typ = c0.typ
+					n.findex = sc.add(n.typ)
 				} else {
 					n.gen = convert
 					n.typ = n.child[0].typ
 					n.findex = sc.add(n.typ)
 				}
 			case isBinCall(n):
+				if err = checkBinCallArgs(n); err != nil {
+					break
+				}
+				if f := n.child[0]; len(f.child) == 2 && f.child[0].typ != nil && f.child[0].typ.cat == binPkgT {
+					// Function of a binary package, whose parameters are not receivers
+					rtype := f.typ.rtype
+					err = checkCallArgs(n, func(i int) *itype {
+						switch {
+						case rtype.IsVariadic() && i >= rtype.NumIn()-1:
+							if n.action == aCallSlice {
+								return nil
+							}
+							return &itype{cat: valueT, rtype: rtype.In(rtype.NumIn() - 1).Elem()}
+						case i < rtype.NumIn():
+							return &itype{cat: valueT, rtype: rtype.In(i)}
+						}
+						return nil
+					})
+					if err != nil {
+						break
+					}
+				}
 				n.gen = callBin
 				if typ := n.child[0].typ.rtype; typ.NumOut() > 0 {
 					n.typ = &itype{cat: valueT, rtype: typ.Out(0)}
@@ -733,6 +976,24 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					}
 				}
 			default:
+				if t := n.child[0].typ; t.cat == funcT {
+					vpos := variadicPos(n)
+					err = checkCallArgs(n, func(i int) *itype {
+						switch {
+						case vpos >= 0 && i >= vpos:
+							if n.action == aCallSlice {
+								return nil
+							}
+							return t.arg[vpos]
+						case i < len(t.arg):
+							return t.arg[i]
+						}
+						return nil
+					})
+					if err != nil {
+						break
+					}
+				}
 				if n.child[0].action == aGetFunc {
 					// allocate frame entry for anonymous function
 					sc.add(n.child[0].typ)
@@ -753,8 +1014,8 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			switch {
 			case typeSwichAssign(n) && len(n.child) > 1:
 				n.start = n.child[1].start
-			case len(n.child) == 0:
-				// empty case body: jump to switch node (exit node)
+			case len(n.child) == 0, typeSwichAssign(n):
+				// empty case body, or with only the switch guard: jump to switch node (exit node)
 				n.start = n.anc.anc.anc
 			default:
 				n.start = n.child[0].start
@@ -763,19 +1024,28 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 		case caseClause:
 			sc = sc.pop()
 
-		case commClause:
+		case commClause, commClauseDefault:
 			wireChild(n)
-			if len(n.child) > 1 {
-				n.start = n.child[1].start // Skip chan operation, performed by select
+			body := n.child
+			if n.kind == commClause {
+				body = body[1:] // Skip chan operation, performed by select
+			}
+			if len(body) == 0 {
+				n.start = n.anc.anc // exit node is SelectStmt
 			} else {
-				n.start = n.child[0].start // default clause
+				n.start = body[0].start
+				switch last := body[len(body)-1]; last.kind {
+				case breakStmt, continueStmt, gotoStmt, returnStmt:
+					// tnext is already computed, no change
+				default:
+					last.tnext = n.anc.anc // exit node is SelectStmt
+				}
 			}
-			n.lastChild().tnext = n.anc.anc // exit node is SelectStmt
 			sc = sc.pop()
 
 		case compositeLitExpr:
 			wireChild(n)
-			if n.anc.action != aAssign {
+			if a := n.anc; a.action != aAssign || a.nleft > 1 || a.kind == assignStmt && isMapEntry(a.child[childPos(n)-a.nleft]) {
 				n.findex = sc.add(n.typ)
 			}
 			// TODO: Check that composite literal expr matches corresponding type
@@ -795,7 +1065,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			body := n.child[0]
 			n.start = body.start
 			body.tnext = n.start
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			sc = sc.pop()
 
 		case forStmt1: // for cond {}
@@ -804,7 +1074,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			cond.tnext = body.start
 			cond.fnext = n
 			body.tnext = cond.start
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			sc = sc.pop()
 
 		case forStmt2: // for init; cond; {}
@@ -814,7 +1084,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			cond.tnext = body.start
 			cond.fnext = n
 			body.tnext = cond.start
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			sc = sc.pop()
 
 		case forStmt3: // for ; cond; post {}
@@ -824,7 +1094,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			cond.fnext = n
 			body.tnext = post.start
 			post.tnext = cond.start
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			sc = sc.pop()
 
 		case forStmt3a: // for int; ; post {}
@@ -833,7 +1103,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			init.tnext = body.start
 			body.tnext = post.start
 			post.tnext = body.start
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			sc = sc.pop()
 
 		case forStmt4: // for init; cond; post {}
@@ -844,11 +1114,11 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			cond.fnext = n
 			body.tnext = post.start
 			post.tnext = cond.start
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			sc = sc.pop()
 
 		case forRangeStmt:
-			loop, loopRestart = nil, nil
+			loop, loopRestart, loops = popLoop(loops)
 			n.start = n.child[0].start
 			n.child[0].fnext = n
 			sc = sc.pop()
@@ -856,9 +1126,17 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 		case funcDecl:
 			n.start = n.child[3].start
 			n.types = sc.types
+			n.reuse = reusableFrame(n)
+			markTailCalls(n)
 			sc = sc.pop()
 			funcName := n.child[1].ident
 			if !isMethod(n) {
+				if def := interp.scopes[pkgName].sym[funcName].node; funcName != "init" && funcName != "_" && def != n && def.kind == funcDecl && def.start != def {
+					// Redefined function: the previous definition is replaced in place
+					*def = *n
+					def.val = def
+					n = def
+				}
 				interp.scopes[pkgName].sym[funcName].index = -1 // to force value to n.val
 				interp.scopes[pkgName].sym[funcName].typ = n.typ
 				interp.scopes[pkgName].sym[funcName].kind = funcSym
@@ -867,6 +1145,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 
 		case funcLit:
 			n.types = sc.types
+			n.reuse = reusableFrame(n)
 			sc = sc.pop()
 
 		case goStmt:
@@ -875,19 +1154,24 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 		case identExpr:
 			if isKey(n) || isNewDefine(n, sc) {
 				break
-			} else if sym, level, ok := sc.lookup(n.ident); ok {
+			}
+			if n.anc.kind == selectorExpr && childPos(n) == 0 {
+				interp.implicitImport(sc, n.ident)
+			}
+			if sym, level, ok := sc.lookup(n.ident); ok {
 				// Found symbol, populate node info
 				n.typ, n.findex, n.level = sym.typ, sym.index, level
+				sc.capture(n.ident, sym, level)
 				if n.findex < 0 {
 					n.val = sym.node
 				} else {
 					n.sym = sym
 					switch {
 					case sym.kind == constSym && sym.rval.IsValid():
-						n.rval = sym.rval
+						n.rval, n.cval = sym.rval, sym.cval
 						n.kind = basicLit
 					case n.ident == "iota":
-						n.rval = reflect.ValueOf(iotaValue)
+						n.rval, n.cval = reflect.ValueOf(iotaValue), constant.MakeInt64(int64(iotaValue))
 						n.kind = basicLit
 					case n.ident == "nil":
 						n.kind = basicLit
@@ -904,18 +1188,15 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 							err = n.cfgErrorf("use of builtin %s not in function call", n.ident)
 						}
 					}
-					if sym.kind == varSym && sym.typ != nil && sym.typ.TypeOf().Kind() == reflect.Bool {
-						switch n.anc.kind {
-						case ifStmt0, ifStmt1, ifStmt2, ifStmt3, forStmt1, forStmt2, forStmt3, forStmt4:
-							n.gen = branch
-						}
+					if sym.kind == varSym && sym.typ != nil && sym.typ.TypeOf().Kind() == reflect.Bool && isCond(n) {
+						n.gen = branch
 					}
 				}
 				if n.sym != nil {
 					n.recv = n.sym.recv
 				}
 			} else {
-				err = n.cfgErrorf("undefined: %s", n.ident)
+				err = suggest(n.cfgErrorf("undefined: %s", n.ident), n.ident, sc.names())
 			}
 
 		case ifStmt0: // if cond {}
@@ -961,6 +1242,10 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			n.start = n.child[0].start
 			n.child[0].tnext = n.child[1].start
 			n.child[0].fnext = n
+			if isGen(n.child[0], nop) {
+				// Branch on the value of an identifier or a literal
+				n.child[0].gen = branch
+			}
 			n.child[1].tnext = n
 			n.typ = n.child[0].typ
 			n.findex = sc.add(n.typ)
@@ -969,6 +1254,10 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			n.start = n.child[0].start
 			n.child[0].tnext = n
 			n.child[0].fnext = n.child[1].start
+			if isGen(n.child[0], nop) {
+				// Branch on the value of an identifier or a literal
+				n.child[0].gen = branch
+			}
 			n.child[1].tnext = n
 			n.typ = n.child[0].typ
 			n.findex = sc.add(n.typ)
@@ -976,13 +1265,15 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 		case parenExpr:
 			wireChild(n)
 			c := n.lastChild()
+			n.gen = paren
 			n.findex = c.findex
 			n.typ = c.typ
 			n.rval = c.rval
+			n.cval = c.cval
 
 		case rangeStmt:
 			if sc.rangeChanType(n) != nil {
-				n.start = n.child[1]       // Get chan
+				n.start = n.child[1].start // Get chan
 				n.child[1].tnext = n       // then go to range function
 				n.tnext = n.child[2].start // then go to range body
 				n.child[2].tnext = n       // then body go to range function (loop)
@@ -994,7 +1285,14 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				} else {
 					k, o, body = n.child[0], n.child[1], n.child[2]
 				}
-				n.start = o          // Get array or map object
+				if rangeYield(o.typ) != nil {
+					n.start = o.start // Get function
+					o.tnext = k.start // then go to iterator init
+					k.tnext = n       // then go to range function, which runs the body
+					k.gen = nop
+					break
+				}
+				n.start = o.start    // Get array or map object
 				o.tnext = k.start    // then go to iterator init
 				k.tnext = n          // then go to range function
 				n.tnext = body.start // then go to range body
@@ -1006,6 +1304,13 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			wireChild(n)
 			n.tnext = nil
 			n.val = sc.def
+			if ret := sc.def.typ.ret; len(ret) == len(n.child) {
+				for i, c := range n.child {
+					if err = checkImplements(c, ret[i], "return statement"); err != nil {
+						return
+					}
+				}
+			}
 			for i, c := range n.child {
 				if c.typ.cat == nilT {
 					// nil: Set node value to zero of return type
@@ -1014,7 +1319,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					if typ, err = nodeType(interp, sc, f.child[2].child[1].child[i].lastChild()); err != nil {
 						return
 					}
-					c.rval = reflect.New(typ.TypeOf()).Elem()
+					c.rval = reflect.New(zeroType(typ)).Elem()
 				}
 			}
 
@@ -1042,7 +1347,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 						n.val = field.Index
 						n.gen = getPtrIndexSeq
 					} else {
-						err = n.cfgErrorf("undefined field or method: %s", n.child[1].ident)
+						err = suggest(n.cfgErrorf("undefined field or method: %s", n.child[1].ident), n.child[1].ident, selectorNames(n.typ))
 					}
 				case n.typ.rtype.Kind() == reflect.Struct:
 					if field, ok := n.typ.rtype.FieldByName(n.child[1].ident); ok {
@@ -1058,11 +1363,11 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 							n.typ = &itype{cat: valueT, rtype: m2.Type}
 							n.recv = &receiver{node: n.child[0]}
 						} else {
-							err = n.cfgErrorf("undefined field or method: %s", n.child[1].ident)
+							err = suggest(n.cfgErrorf("undefined field or method: %s", n.child[1].ident), n.child[1].ident, selectorNames(n.typ))
 						}
 					}
 				default:
-					err = n.cfgErrorf("undefined field or method: %s", n.child[1].ident)
+					err = suggest(n.cfgErrorf("undefined field or method: %s", n.child[1].ident), n.child[1].ident, selectorNames(n.typ))
 				}
 			} else if n.typ.cat == ptrT && (n.typ.val.cat == valueT || n.typ.val.cat == errorT) {
 				// Handle pointer on object defined in runtime
@@ -1081,13 +1386,21 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					n.typ = &itype{cat: valueT, rtype: method.Type}
 					n.recv = &receiver{node: n.child[0]}
 				} else {
-					err = n.cfgErrorf("undefined selector: %s", n.child[1].ident)
+					err = suggest(n.cfgErrorf("undefined selector: %s", n.child[1].ident), n.child[1].ident, selectorNames(n.typ))
 				}
 			} else if n.typ.cat == binPkgT {
 				// Resolve binary package symbol: a type or a value
 				name := n.child[1].ident
 				pkg := n.child[0].sym.path
-				if s, ok := interp.binPkg[pkg][name]; ok {
+				if s, ok := interp.binSymbols(pkg)[name]; ok {
+					if m := interp.newerAPI(pkg + "." + name); m > 0 {
+						err = n.cfgErrorf("%s.%s requires go1.%d", pkg, name, m)
+						break
+					}
+					if !interp.allowedSym(pkg, name) {
+						err = n.cfgErrorf("use of %s.%s not allowed", pkg, name)
+						break
+					}
 					if isBinType(s) {
 						n.kind = rtypeExpr
 						n.typ = &itype{cat: valueT, rtype: s.Type().Elem()}
@@ -1095,10 +1408,15 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 						n.kind = rvalueExpr
 						n.typ = &itype{cat: valueT, rtype: s.Type()}
 						n.rval = s
+						if isBinFloatConst(s) {
+							// Float constants are exported as float64 values, but are untyped
+							n.typ.untyped = true
+							n.cval, _ = valueConst(s)
+						}
 					}
 					n.gen = nop
 				} else {
-					err = n.cfgErrorf("package %s \"%s\" has no symbol %s", n.child[0].ident, pkg, name)
+					err = suggest(n.cfgErrorf("package %s \"%s\" has no symbol %s", n.child[0].ident, pkg, name), name, exportedNames(interp.binSymbols(pkg)))
 				}
 			} else if n.typ.cat == srcPkgT {
 				pkg, name := n.child[0].ident, n.child[1].ident
@@ -1109,8 +1427,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					n.gen = nop
 					n.typ = sym.typ
 					n.sym = sym
+					if sym.kind == typeSym && sym.typ != nil && sym.typ.cat == genericT {
+						// Generic type, instantiated by the parent index expression
+						n.findex = -1
+					}
 				} else {
-					err = n.cfgErrorf("undefined selector: %s", n.child[1].ident)
+					err = suggest(n.cfgErrorf("undefined selector: %s", n.child[1].ident), n.child[1].ident, exportedSyms(interp.scopes[pkg].sym))
 				}
 			} else if m, lind := n.typ.lookupMethod(n.child[1].ident); m != nil {
 				if n.child[0].isType(sc) {
@@ -1129,8 +1451,15 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					n.recv = &receiver{node: n.child[0], index: lind}
 				}
 			} else if m, lind, ok := n.typ.lookupBinMethod(n.child[1].ident); ok {
-				n.gen = getIndexSeqMethod
-				n.val = append([]int{m.Index}, lind...)
+				if n.typ.cat == interfaceT {
+					// Method of a binary interface embedded in an interpreted interface
+					n.gen = getBinMethodByName
+					n.val = lind
+				} else {
+					n.gen = getIndexSeqMethod
+					n.val = append([]int{m.Index}, lind...)
+					m.Type = methodValueType(m)
+				}
 				n.typ = &itype{cat: valueT, rtype: m.Type}
 			} else if ti := n.typ.lookupField(n.child[1].ident); len(ti) > 0 {
 				// Handle struct field
@@ -1164,7 +1493,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				n.val = lind
 				n.typ = &itype{cat: valueT, rtype: s.Type}
 			} else {
-				err = n.cfgErrorf("undefined selector: %s", n.child[1].ident)
+				err = suggest(n.cfgErrorf("undefined selector: %s", n.child[1].ident), n.child[1].ident, selectorNames(n.typ))
 			}
 			if err == nil && n.findex != -1 {
 				n.findex = sc.add(n.typ)
@@ -1174,7 +1503,22 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			wireChild(n)
 			// Move action to block statement, so select node can be an exit point
 			n.child[0].gen = _select
-			n.start = n.child[0]
+			// Channel and send operands are evaluated once, in source order,
+			// before the select operation
+			next := n.child[0]
+			for i := len(n.child[0].child) - 1; i >= 0; i-- {
+				operands := commOperands(n.child[0].child[i])
+				for j := len(operands) - 1; j >= 0; j-- {
+					switch c := operands[j]; c.kind {
+					case basicLit, identExpr, rvalueExpr:
+					default:
+						c.tnext = next
+						next = c.start
+					}
+				}
+			}
+			n.start = next
+			loop, loopRestart, loops = popLoop(loops)
 
 		case starExpr:
 			switch {
@@ -1194,7 +1538,12 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			default:
 				// dereference expression
 				wireChild(n)
-				n.typ = n.child[0].typ.val
+				if t := n.child[0].typ; t.cat == valueT && t.rtype.Kind() == reflect.Ptr {
+					// pointer returned by a binary function
+					n.typ = &itype{cat: valueT, rtype: t.rtype.Elem()}
+				} else {
+					n.typ = t.val
+				}
 				n.findex = sc.add(n.typ)
 			}
 
@@ -1203,9 +1552,9 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			usedCase := map[string]bool{}
 			for _, c := range n.lastChild().child {
 				for _, t := range c.child[:len(c.child)-1] {
-					tid := t.typ.id()
+					tid := runtimeTypeName(t.typ)
 					if usedCase[tid] {
-						err = c.cfgErrorf("duplicate case %s in type switch", t.ident)
+						err = c.cfgErrorf("duplicate case %s in type switch", typeString(t.typ))
 						return
 					}
 					usedCase[tid] = true
@@ -1233,16 +1582,35 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 			}
 			c := clauses[l-1]
 			c.tnext = c.lastChild().start
+			if len(c.child) > 1 {
+				// No default clause: exit the switch if no case matches
+				c.fnext = n
+			}
+			if body := c.lastChild(); len(body.child) == 0 || body.lastChild().kind != fallthroughtStmt {
+				body.tnext = n
+			}
+			start := sbn.start
+			if n.kind == typeSwitch {
+				if x := n.child[1].lastChild().child[0]; x.kind != identExpr && x.kind != basicLit {
+					// Evaluate the switch guard expression before the clauses
+					x.tnext = sbn.start
+					start = x.start
+				}
+			} else if x := n.child[len(n.child)-2]; x.kind != identExpr && x.kind != basicLit && !x.rval.IsValid() {
+				// Evaluate the switch tag expression before the clauses
+				x.tnext = sbn.start
+				start = x.start
+			}
 			if n.child[0].action == aAssign &&
 				(n.child[0].child[0].kind != typeAssertExpr || len(n.child[0].child[0].child) > 1) {
 				// switch init statement is defined
 				n.start = n.child[0].start
-				n.child[0].tnext = sbn.start
+				n.child[0].tnext = start
 			} else {
-				n.start = sbn.start
+				n.start = start
 			}
 			sc = sc.pop()
-			loop = nil
+			loop, loopRestart, loops = popLoop(loops)
 
 		case switchIfStmt: // like an if-else chain
 			sbn := n.lastChild() // switch block node
@@ -1268,6 +1636,8 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				// If last case body statement is a fallthrough, then jump to next case body
 				if i < l-1 && len(body.child) > 0 && body.lastChild().kind == fallthroughtStmt {
 					body.tnext = clauses[i+1].lastChild().start
+				} else {
+					body.tnext = n
 				}
 			}
 			sbn.start = clauses[0].start
@@ -1279,7 +1649,7 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 				n.start = sbn.start
 			}
 			sc = sc.pop()
-			loop = nil
+			loop, loopRestart, loops = popLoop(loops)
 
 		case typeAssertExpr:
 			if len(n.child) > 1 {
@@ -1297,20 +1667,58 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 
 		case sliceExpr:
 			wireChild(n)
-			if ctyp := n.child[0].typ; ctyp.size != 0 {
-				// Create a slice type from an array type
-				n.typ = &itype{}
-				*n.typ = *ctyp
-				n.typ.size = 0
-				n.typ.rtype = nil
-			} else {
+			ctyp := n.child[0].typ
+			if at := arrayPtrElem(ctyp); at != nil {
+				// Slice an array through a pointer to it
+				ctyp = at
+			}
+			if full := n.action == aSlice && len(n.child) == 4 || n.action == aSlice0 && len(n.child) == 3; full && isString(ctyp.TypeOf()) {
+				err = n.cfgErrorf("invalid operation: 3-index slice of string")
+				break
+			}
+			switch t := ctyp.TypeOf(); {
+			case t.Kind() != reflect.Array:
 				n.typ = ctyp
+			case ctyp.cat == valueT:
+				n.typ = &itype{cat: valueT, rtype: reflect.SliceOf(t.Elem())}
+			default:
+				// Create a slice type from an array type
+				for ctyp.cat == aliasT {
+					ctyp = ctyp.val
+				}
+				n.typ = &itype{cat: arrayT, val: ctyp.val, scope: ctyp.scope}
 			}
 			n.findex = sc.add(n.typ)
 
 		case unaryExpr:
+			if n.action == aRecv && isSendChan(n.child[0].typ) {
+				err = n.child[0].cfgErrorf("invalid operation: cannot receive from send-only channel")
+				break
+			}
 			wireChild(n)
 			n.typ = n.child[0].typ
+			switch t := n.typ.TypeOf(); {
+			case n.action == aBitNot && !isInt(t), (n.action == aNegate || n.action == aPos) && !isNumber(t):
+				err = n.cfgErrorf("illegal operand type for '%v' operator", n.action)
+				return
+			}
+			if n.action == aRecv {
+				n.typ = chanElemType(n.typ)
+				if n.typ.cat == funcT {
+					// function received from a channel is always wrapped in reflect.Value
+					n.typ = &itype{cat: valueT, rtype: n.typ.TypeOf()}
+				}
+			}
+			if n.child[0].cval != nil {
+				if err = foldUnary(n); err != nil {
+					break
+				}
+			}
+			if n.rval.IsValid() {
+				n.gen = nop
+				n.findex = -1
+				break
+			}
 			// TODO: Optimisation: avoid allocation if boolean branch op (i.e. '!' in an 'if' expr)
 			n.findex = sc.add(n.typ)
 
@@ -1322,12 +1730,34 @@ func (interp *Interpreter) cfg(root *node) ([]*node, error) {
 					return
 				}
 			}
+			if n.typ.cat == genericT {
+				err = n.child[l].cfgErrorf("cannot use generic type %s without instantiation", n.typ.name)
+				return
+			}
 			for _, c := range n.child[:l] {
 				index := sc.add(n.typ)
 				sc.sym[c.ident] = &symbol{index: index, kind: varSym, typ: n.typ}
 				c.typ = n.typ
 				c.findex = index
 			}
+			if patterns, ok := interp.embeds[n]; ok {
+				delete(interp.embeds, n)
+				if !sc.global {
+					err = n.cfgErrorf("go:embed cannot apply to var inside func")
+					return
+				}
+				if n.rval, err = interp.embedValue(n, n.typ.TypeOf(), patterns); err != nil {
+					return
+				}
+				n.gen = embedVar
+			}
+		}
+		if err == nil {
+			err = checkConstUse(n)
+		}
+		if n.rval.IsValid() && isCond(n) {
+			// Constant condition
+			n.gen = branch
 		}
 	})
 
@@ -1388,6 +1818,76 @@ func compDefineX(sc *scope, n *node) error {
 	return nil
 }
 
+// defineRecv defines the variables assigned by the receive operation of
+// a select comm clause n, the received value and the optional status.
+func defineRecv(sc *scope, n *node, vars []*node) {
+	types := []*itype{chanElemType(n.lastChild().child[0].typ), sc.getType("bool")}
+	for i, c := range vars {
+		c.typ = types[i]
+		c.findex = sc.add(c.typ)
+		if c.ident != "_" {
+			sc.sym[c.ident] = &symbol{index: c.findex, kind: varSym, typ: c.typ}
+		}
+	}
+}
+
+// commOperands returns the channel and value to send of a select comm
+// clause, or nil for a default clause.
+func commOperands(n *node) []*node {
+	if n.kind == commClauseDefault {
+		return nil
+	}
+	ch, value, _, dir := clauseChanDir(n)
+	if dir == reflect.SelectSend {
+		return []*node{ch, value}
+	}
+	return []*node{ch}
+}
+
+// elidedType returns the type of composite literal n, elided as an element
+// or a key of an enclosing composite literal, or nil. The literal of an
+// elided &T is of type T, its address being taken by the enclosing literal.
+func elidedType(n *node) *itype {
+	a := n.anc.typ
+	if a == nil {
+		return nil
+	}
+	for a.cat == aliasT {
+		a = a.val
+	}
+	key := n.anc.kind == keyValueExpr && n == n.anc.child[0]
+	var t *itype
+	switch {
+	case a.cat == valueT && key && a.rtype.Kind() == reflect.Map:
+		t = &itype{cat: valueT, rtype: a.rtype.Key()}
+	case a.cat == valueT:
+		switch a.rtype.Kind() {
+		case reflect.Array, reflect.Slice, reflect.Map:
+			t = &itype{cat: valueT, rtype: a.rtype.Elem()}
+		}
+	case key:
+		t = a.key
+	default:
+		t = a.val
+	}
+	switch {
+	case t == nil:
+	case t.cat == ptrT:
+		t = t.val
+	case t.cat == valueT && t.rtype.Kind() == reflect.Ptr:
+		t = &itype{cat: valueT, rtype: t.rtype.Elem()}
+	}
+	return t
+}
+
+// chanElemType returns the element type of channel type t.
+func chanElemType(t *itype) *itype {
+	if t.cat == valueT {
+		return &itype{cat: valueT, rtype: t.rtype.Elem()}
+	}
+	return t.val
+}
+
 // TODO used for allocation optimization, temporarily disabled
 //func isAncBranch(n *node) bool {
 //	switch n.anc.kind {
@@ -1397,6 +1897,47 @@ func compDefineX(sc *scope, n *node) error {
 //	return false
 //}
 
+// shiftContextType returns the type expected by the context of expression n,
+// or nil if the expression is not in a typed context.
+func shiftContextType(interp *Interpreter, sc *scope, n *node) *itype {
+	a := n.anc
+	switch pos := childPos(n); a.kind {
+	case assignStmt, defineStmt:
+		if pos < a.nleft {
+			return nil
+		}
+		if len(a.child) > a.nleft+a.nright {
+			t, _ := nodeType(interp, sc, a.child[a.nleft])
+			return t
+		}
+		if a.kind == assignStmt && a.action == aAssign && a.nleft == a.nright {
+			if t := a.child[pos-a.nright].typ; t != nil && !t.untyped {
+				return t
+			}
+		}
+	case binaryExpr:
+		switch a.action {
+		case aShl, aShr, aEqual, aNotEqual, aGreater, aGreaterEqual, aLower, aLowerEqual:
+			return nil
+		}
+		if t := a.child[1-pos].typ; t != nil && !t.untyped {
+			return t
+		}
+		return shiftContextType(interp, sc, a)
+	case parenExpr:
+		return shiftContextType(interp, sc, a)
+	case returnStmt:
+		if sc.def != nil && sc.def.typ != nil && pos < len(sc.def.typ.ret) {
+			return sc.def.typ.ret[pos]
+		}
+	case callExpr:
+		if t := a.child[0].typ; pos > 0 && t != nil && t.cat == funcT && pos-1 < len(t.arg) && !t.arg[pos-1].variadic {
+			return t.arg[pos-1]
+		}
+	}
+	return nil
+}
+
 func childPos(n *node) int {
 	for i, c := range n.anc.child {
 		if n == c {
@@ -1407,27 +1948,40 @@ func childPos(n *node) int {
 }
 
 func (n *node) cfgErrorf(format string, a ...interface{}) cfgError {
-	a = append([]interface{}{n.interp.fset.Position(n.pos)}, a...)
-	return cfgError(fmt.Errorf("%s: "+format, a...))
+	e := &Error{Phase: TypePhase, Pos: n.interp.fset.Position(n.pos), Msg: fmt.Sprintf(format, a...)}
+	n.interp.quote(e, n.pos)
+	return cfgError(e)
 }
 
 func genRun(nod *node) error {
 	var err cfgError
 
+	if c := nod.interp.cover; c != nil {
+		c.resolve()
+	}
+
 	nod.Walk(func(n *node) bool {
 		if err != nil {
 			return false
 		}
 		switch n.kind {
+		case funcDecl:
+			if isGeneric(n) {
+				return false // generic template, not compiled
+			}
 		case funcType:
-			if len(n.anc.child) == 4 {
+			if k := n.anc.kind; (k == funcDecl || k == funcLit) && len(n.anc.child) == 4 {
 				// function body entry point
-				setExec(n.anc.child[3].start)
+				if body := n.anc.child[3]; nod.interp.lazyBodies() {
+					body.once = &sync.Once{}
+				} else {
+					setExec(body.start)
+				}
 			}
 			// continue in function body as there may be inner function definitions
 		case constDecl, varDecl:
 			setExec(n.start)
-			return false
+			// continue in declarations, as there may be function literals
 		}
 		return true
 	}, nil)
@@ -1435,6 +1989,26 @@ func genRun(nod *node) error {
 	return err
 }
 
+// lazyBodies returns true if the execution of function bodies is generated
+// on first call, by funcBody.
+func (interp *Interpreter) lazyBodies() bool {
+	return !interp.eager && interp.cover == nil && interp.racer == nil && interp.debugger == nil && interp.profiler == nil && interp.tracer == nil
+}
+
+// funcBody returns the entry point of the body of function def, once its
+// execution is generated.
+func funcBody(def *node) *node {
+	body := def.child[3]
+	if o := body.once; o != nil {
+		o.Do(func() {
+			def.interp.gmutex.Lock()
+			defer def.interp.gmutex.Unlock()
+			setExec(body.start)
+		})
+	}
+	return body.start
+}
+
 // Find default case clause index of a switch statement, if any
 func getDefault(n *node) int {
 	for i, c := range n.lastChild().child {
@@ -1450,7 +2024,7 @@ func isBinType(v reflect.Value) bool { return v.IsValid() && v.Kind() == reflect
 // isType returns true if node refers to a type definition, false otherwise
 func (n *node) isType(sc *scope) bool {
 	switch n.kind {
-	case arrayType, chanType, funcType, mapType, structType, rtypeExpr:
+	case arrayType, chanType, chanTypeRecv, chanTypeSend, funcType, interfaceType, mapType, structType, rtypeExpr:
 		return true
 	case parenExpr, starExpr:
 		if len(n.child) == 1 {
@@ -1459,7 +2033,7 @@ func (n *node) isType(sc *scope) bool {
 	case selectorExpr:
 		pkg, name := n.child[0].ident, n.child[1].ident
 		if sym, _, ok := sc.lookup(pkg); ok {
-			if p, ok := n.interp.binPkg[sym.path]; ok && isBinType(p[name]) {
+			if p := n.interp.binSymbols(sym.path); p != nil && isBinType(p[name]) {
 				return true // Imported binary type
 			}
 			if p, ok := n.interp.scopes[pkg]; ok && p.sym[name] != nil && p.sym[name].kind == typeSym {
@@ -1468,6 +2042,14 @@ func (n *node) isType(sc *scope) bool {
 		}
 	case identExpr:
 		return sc.getType(n.ident) != nil
+	case indexExpr:
+		// Instantiation of a generic type
+		if n.child[0].kind == selectorExpr {
+			return n.child[0].isType(sc)
+		}
+		if t := sc.getType(n.child[0].ident); t != nil && t.cat == genericT {
+			return t.node == nil || t.node.kind == typeSpec
+		}
 	}
 	return false
 }
@@ -1477,7 +2059,7 @@ func wireChild(n *node) {
 	// Set start node, in subtree (propagated to ancestors by post-order processing)
 	for _, child := range n.child {
 		switch child.kind {
-		case arrayType, chanType, funcDecl, importDecl, mapType, basicLit, identExpr, typeDecl:
+		case arrayType, chanType, chanTypeRecv, chanTypeSend, funcDecl, importDecl, interfaceType, mapType, basicLit, identExpr, typeDecl:
 			continue
 		default:
 			n.start = child.start
@@ -1503,7 +2085,7 @@ func wireChild(n *node) {
 	// Chain subtree next to self
 	for i := len(n.child) - 1; i >= 0; i-- {
 		switch n.child[i].kind {
-		case arrayType, chanType, importDecl, mapType, funcDecl, basicLit, identExpr, typeDecl:
+		case arrayType, chanType, chanTypeRecv, chanTypeSend, importDecl, interfaceType, mapType, funcDecl, basicLit, identExpr, typeDecl:
 			continue
 		case breakStmt, continueStmt, gotoStmt, returnStmt:
 			// tnext is already computed, no change
@@ -1521,7 +2103,37 @@ func isKey(n *node) bool {
 	return n.anc.kind == fileStmt ||
 		(n.anc.kind == selectorExpr && n.anc.child[0] != n) ||
 		(n.anc.kind == funcDecl && isMethod(n.anc)) ||
-		(n.anc.kind == keyValueExpr && isStruct(n.anc.typ) && n.anc.child[0] == n)
+		(n.anc.kind == keyValueExpr && isStruct(n.anc.typ) && n.anc.child[0] == n) ||
+		isLabel(n)
+}
+
+// isCond returns true if node n is the condition of an if or for statement,
+// or of a case clause of a switch statement without tag.
+func isCond(n *node) bool {
+	a := n.anc
+	if a == nil {
+		return false
+	}
+	switch a.kind {
+	case ifStmt0, ifStmt1, forStmt1, forStmt3:
+		return a.child[0] == n
+	case ifStmt2, ifStmt3, forStmt2, forStmt4:
+		return a.child[1] == n
+	case caseClause:
+		return a.anc.anc.kind == switchIfStmt && len(a.child) > 1 && a.child[0] == n
+	}
+	return false
+}
+
+// isLabel returns true if node n is the label of a labeled or branch statement.
+func isLabel(n *node) bool {
+	switch n.anc.kind {
+	case labeledStmt:
+		return n.anc.child[0] == n
+	case breakStmt, continueStmt, gotoStmt:
+		return true
+	}
+	return false
 }
 
 // isNewDefine returns true if node refers to a new definition
@@ -1548,8 +2160,124 @@ func isMethod(n *node) bool {
 	return len(n.child[0].child) > 0 // receiver defined
 }
 
+// checkComparable returns an error if the operands c0 and c1 of the
+// equality comparison n are not comparable. Interface values are always
+// comparable at compile time, the comparison of uncomparable dynamic values
+// panics at run time.
+func checkComparable(n *node, c0, c1 *node) error {
+	for _, c := range []*node{c0, c1} {
+		if isInterface(c.typ) {
+			continue
+		}
+		switch t := c.typ.TypeOf(); {
+		case t.Kind() == reflect.Slice || t.Kind() == reflect.Map || t.Kind() == reflect.Func:
+			return n.cfgErrorf("invalid operation: %s can only be compared to nil", t.Kind())
+		case !t.Comparable():
+			return n.cfgErrorf("invalid operation: %s cannot be compared", c.typ.id())
+		}
+	}
+	return nil
+}
+
+// isInterfaceConv returns true if the value of src must be converted to an
+// interface value to be assigned to dest.
+func isInterfaceConv(dest, src *node) bool {
+	return src.typ != nil && isInterface(dest.typ) && !isInterface(src.typ)
+}
+
+// checkImplements returns an error if the value of src, used as a value of
+// interface type t in context, does not implement t.
+func checkImplements(src *node, t *itype, context string) error {
+	if reason := notImplemented(src, t); reason != "" {
+		return src.cfgErrorf("cannot use %s as %s value in %s: %s", valueString(src), typeString(t), context, reason)
+	}
+	return nil
+}
+
+// checkAssignable returns an error if the value of src, of a named type, can
+// not be assigned to a variable of the distinct named type t. An alias
+// declaration denotes the aliased type itself, which is not distinct.
+func checkAssignable(src *node, t *itype, context string) error {
+	st := src.typ
+	if st == nil || t == nil || st.untyped || st.cat == nilT || st.incomplete || t.incomplete || isInterface(st) || isInterface(t) {
+		return nil
+	}
+	if !isNamed(st) || !isNamed(t) || st.id() == t.id() {
+		return nil
+	}
+	if k := src.kind; st.TypeOf().Kind() == reflect.Bool && (k == binaryExpr || k == unaryExpr || k == parenExpr) {
+		// The result of a comparison is an untyped boolean
+		return nil
+	}
+	return src.cfgErrorf("cannot use %s as %s value in %s", valueString(src), typeString(t), context)
+}
+
+// notImplemented returns why the value of src does not implement interface
+// type t, one of the methods of t being missing in the method set of its type,
+// or an empty string if it does.
+func notImplemented(src *node, t *itype) string {
+	if src.typ == nil || t == nil || !isInterface(t) || src.typ.cat == nilT || src.typ.incomplete || t.incomplete {
+		return ""
+	}
+	name, ptrRecv := missingMethod(src.typ, t)
+	if name == "" {
+		return ""
+	}
+	reason := "missing method " + name
+	if ptrRecv {
+		reason = "method " + name + " has pointer receiver"
+	}
+	return fmt.Sprintf("%s does not implement %s (%s)", typeString(src.typ), typeString(t), reason)
+}
+
+// valueString returns the description of the value of n in error messages.
+func valueString(n *node) string {
+	if n.kind == identExpr {
+		return n.ident + " (variable of type " + typeString(n.typ) + ")"
+	}
+	return "value of type " + typeString(n.typ)
+}
+
+// operandNotes returns err with the operands x and y of a binary operation,
+// and their types, noted.
+func operandNotes(err error, x, y *node) error {
+	return note(note(err, "left operand: %s", operandString(x)), "right operand: %s", operandString(y))
+}
+
+// operandString returns the representation of the operand n in errors, as
+// valueString, or as its value if n is an untyped constant.
+func operandString(n *node) string {
+	if n.cval != nil && n.typ.untyped && n.kind == basicLit {
+		return n.cval.String() + " (untyped " + typeString(n.typ) + " constant)"
+	}
+	return valueString(n)
+}
+
+// checkCallArgs returns an error if an argument of the call n does not
+// implement the interface type of its parameter, given by param. A single
+// call argument, possibly multi-valued, is not checked.
+func checkCallArgs(n *node, param func(i int) *itype) error {
+	args := n.child[1:]
+	if len(args) == 1 && args[0].kind == callExpr {
+		return nil
+	}
+	name := n.child[0].ident
+	if c := n.child[0]; name == "" && len(c.child) == 2 {
+		name = c.child[1].ident
+		if c.child[0].kind == identExpr {
+			name = c.child[0].ident + "." + name
+		}
+	}
+	for i, c := range args {
+		if err := checkImplements(c, param(i), "argument to "+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func isMapEntry(n *node) bool {
-	return n.action == aGetIndex && n.child[0].typ.cat == mapT
+	return n.kind == indexExpr && n.child[0].typ.TypeOf().Kind() == reflect.Map
 }
 
 func isBuiltinCall(n *node) bool {
@@ -1560,6 +2288,44 @@ func isBinCall(n *node) bool {
 	return n.kind == callExpr && n.child[0].typ.cat == valueT && n.child[0].typ.rtype.Kind() == reflect.Func
 }
 
+// checkBinCallArgs returns an error if the number of arguments of the call n
+// of a function of a binary package does not match its parameters. Methods,
+// and calls with a single call argument, possibly multi-valued, are not
+// checked.
+func checkBinCallArgs(n *node) error {
+	f, args := n.child[0], n.child[1:]
+	if len(f.child) != 2 || f.child[0].typ == nil || f.child[0].typ.cat != binPkgT || len(args) == 1 && args[0].kind == callExpr {
+		return nil
+	}
+	t := f.typ.rtype
+	if len(args) == t.NumIn() || t.IsVariadic() && len(args) >= t.NumIn()-1 {
+		return nil
+	}
+	name := f.child[0].ident + "." + f.child[1].ident
+	if len(args) < t.NumIn() {
+		return n.cfgErrorf("not enough arguments in call to %s", name)
+	}
+	return n.cfgErrorf("too many arguments in call to %s", name)
+}
+
+// methodValueType returns the type of the method value of binary method m,
+// bound to its receiver, which is the first parameter of the method type.
+func methodValueType(m reflect.Method) reflect.Type {
+	if !m.Func.IsValid() {
+		// Method of an interface type, without receiver
+		return m.Type
+	}
+	in := make([]reflect.Type, m.Type.NumIn()-1)
+	for i := range in {
+		in[i] = m.Type.In(i + 1)
+	}
+	out := make([]reflect.Type, m.Type.NumOut())
+	for i := range out {
+		out[i] = m.Type.Out(i)
+	}
+	return reflect.FuncOf(in, out, m.Type.IsVariadic())
+}
+
 func isRegularCall(n *node) bool {
 	return n.kind == callExpr && n.child[0].typ.cat == funcT
 }
@@ -1623,6 +2389,21 @@ func setExec(n *node) {
 			}
 		}
 		n.gen(n)
+		if c := n.interp.cover; c != nil {
+			c.wrap(n)
+		}
+		if r := n.interp.racer; r != nil {
+			r.wrap(n)
+		}
+		if d := n.interp.debugger; d != nil {
+			d.wrap(n)
+		}
+		if p := n.interp.profiler; p != nil {
+			p.wrap(n)
+		}
+		if t := n.interp.tracer; t != nil {
+			t.wrap(n)
+		}
 	}
 
 	set(n)
@@ -1633,26 +2414,222 @@ func typeSwichAssign(n *node) bool {
 	return ts.kind == typeSwitch && ts.child[1].action == aAssign
 }
 
-func gotoLabel(s *symbol) {
-	if s.node == nil {
-		return
+// popLoop returns the loop and restart nodes at the top of the loops stack,
+// and the stack without them.
+func popLoop(loops [][2]*node) (loop, restart *node, rest [][2]*node) {
+	l := loops[len(loops)-1]
+	return l[0], l[1], loops[:len(loops)-1]
+}
+
+// restartNode returns the node executed by a continue statement in loop n.
+func restartNode(n *node) *node {
+	switch n.kind {
+	case forStmt0:
+		return n.child[0]
+	case forRangeStmt:
+		return n.child[0].lastChild() // the body, which goes to the range node
 	}
+	return n.lastChild()
+}
+
+// gotoLabel wires the goto statements referring to label s.
+func gotoLabel(s *symbol) {
 	for _, c := range s.from {
-		c.tnext = s.node.start
+		if c.kind == gotoStmt {
+			c.tnext = s.node.start
+		}
 	}
 }
 
+// resolveLabels checks the labels of a function body and the branch
+// statements referring to them, as the gc compiler does, and binds them to
+// label symbols. Labels are scoped to the function body, excluding the
+// bodies of function literals.
+func resolveLabels(body *node) error {
+	labels := map[string]*symbol{}
+	var defs []*node // labeled statements in source order
+	var err error
+	walk := func(in func(n *node)) {
+		body.Walk(func(n *node) bool {
+			if err != nil || n.kind == funcLit {
+				return false
+			}
+			in(n)
+			return true
+		}, nil)
+	}
+
+	walk(func(n *node) {
+		if n.kind != labeledStmt {
+			return
+		}
+		name := n.child[0].ident
+		if s, ok := labels[name]; ok {
+			err = n.cfgErrorf("label %s already defined at %s", name, n.interp.fset.Position(s.node.pos))
+			return
+		}
+		labels[name] = &symbol{kind: labelSym, node: n, index: -1}
+		n.sym = labels[name]
+		defs = append(defs, n)
+	})
+	var errPos token.Pos // position of the first branch in error
+	walk(func(n *node) {
+		if n.kind != breakStmt && n.kind != continueStmt && n.kind != gotoStmt || len(n.child) == 0 {
+			return
+		}
+		name := n.child[0].ident
+		s, ok := labels[name]
+		switch {
+		case n.kind == gotoStmt && !ok:
+			err = n.child[0].cfgErrorf("label %s not defined", name)
+		case n.kind != gotoStmt && (!ok || s.node.pos > n.pos && !isAncestor(s.node, n)):
+			err = n.child[0].cfgErrorf("%s label not defined: %s", branchName(n), name)
+		}
+		if err != nil {
+			errPos = n.pos
+			return
+		}
+		// The label is used, even if the branch is invalid.
+		switch {
+		case n.kind == gotoStmt:
+			err = checkGoto(n, s.node)
+		case !isAncestor(s.node, n), !isLoop(s.node.child[1]) && (n.kind == continueStmt || !isBreakable(s.node.child[1])):
+			err = n.child[0].cfgErrorf("invalid %s label %s", branchName(n), name)
+		}
+		if err != nil {
+			errPos = n.pos
+		}
+		s.from = append(s.from, n)
+		n.sym = s
+	})
+	// Report errors in source order, as the go compiler.
+	for _, n := range defs {
+		if len(n.sym.from) == 0 && (err == nil || n.pos < errPos) {
+			return n.cfgErrorf("label %s defined and not used", n.child[0].ident)
+		}
+	}
+	return err
+}
+
+// checkGoto returns an error if goto statement g jumps into a block, or
+// over a variable declaration, to labeled statement l.
+func checkGoto(g, l *node) error {
+	name := l.child[0].ident
+	var into *node // outermost block containing l, but not g
+	b := l.anc
+	for ; !isAncestor(b, g); b = b.anc {
+		switch b.kind {
+		case blockStmt:
+			into = b
+		case caseBody:
+			into = b.anc
+		}
+	}
+	if into != nil {
+		return g.child[0].cfgErrorf("goto %s jumps into block starting at %s", name, g.interp.fset.Position(into.pos))
+	}
+
+	// Jumping forward over a declaration in the block of l is not allowed
+	i := 0
+	for ; !isAncestor(b.child[i], g); i++ {
+	}
+	for _, c := range b.child[i+1:] {
+		if c == l {
+			break
+		}
+		var v *node
+		switch c.kind {
+		case defineStmt, defineXStmt:
+			v = c.child[0]
+		case declStmt:
+			if vd := c.child[0]; vd.kind == varDecl {
+				v = vd.child[0].child[0]
+			}
+		}
+		if v != nil {
+			return g.child[0].cfgErrorf("goto %s jumps over declaration of %s at %s", name, v.ident, g.interp.fset.Position(v.pos))
+		}
+	}
+	return nil
+}
+
+// isAncestor returns true if node a is n or one of its ancestors.
+func isAncestor(a, n *node) bool {
+	for ; n != nil; n = n.anc {
+		if n == a {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeFuncVars returns the types of the iteration variables k and v of the
+// range over function expression o, given the type of its yield function yt.
+func (interp *Interpreter) rangeFuncVars(sc *scope, n *node, yt *itype, k, v *node) (ktyp, vtyp *itype, err error) {
+	o := n.typ
+	if interp.langVersion() < 23 {
+		return nil, nil, n.cfgErrorf("range over %s requires go1.23 or later", o.TypeOf())
+	}
+	nargs := len(yt.arg)
+	if yt.cat == valueT {
+		nargs = yt.rtype.NumIn()
+	}
+	switch {
+	case nargs == 0 && (v != nil || k.ident != "_"):
+		return nil, nil, k.cfgErrorf("range over %s permits no iteration variables", o.TypeOf())
+	case nargs == 1 && v != nil:
+		return nil, nil, v.cfgErrorf("range over %s permits only one iteration variable", o.TypeOf())
+	case nargs == 0:
+		// The blank key is not set
+		return sc.getType("bool"), nil, nil
+	case nargs == 1:
+		return yieldArg(yt, 0), nil, nil
+	}
+	return yieldArg(yt, 0), yieldArg(yt, 1), nil
+}
+
+func isLoop(n *node) bool {
+	switch n.kind {
+	case forStmt0, forStmt1, forStmt2, forStmt3, forStmt3a, forStmt4, forRangeStmt:
+		return true
+	}
+	return false
+}
+
+func isBreakable(n *node) bool {
+	switch n.kind {
+	case selectStmt, switchStmt, switchIfStmt, typeSwitch:
+		return true
+	}
+	return isLoop(n)
+}
+
+// branchName returns the keyword of branch statement n.
+func branchName(n *node) string {
+	if n.kind == continueStmt {
+		return "continue"
+	}
+	return "break"
+}
+
 func compositeGenerator(n *node) (gen bltnGenerator) {
 	switch n.typ.cat {
 	case aliasT:
-		n.typ = n.typ.val
+		t := n.typ
+		n.typ = t.val
+		if t.untyped {
+			// Preserve the elided type of the literal
+			u := *n.typ
+			u.untyped = true
+			n.typ = &u
+		}
 		gen = compositeGenerator(n)
 	case arrayT:
 		gen = arrayLit
 	case mapT:
 		gen = mapLit
 	case structT:
-		if n.lastChild().kind == keyValueExpr {
+		if len(n.child) > 0 && n.lastChild().kind == keyValueExpr {
 			gen = compositeSparse
 		} else {
 			gen = compositeLit
@@ -0,0 +1,182 @@
+package interp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// replCommands are the meta-commands of the REPL, with their usage.
+var replCommands = []struct{ usage, help string }{
+	{":help", "print this help"},
+	{":imports", "list the imported packages"},
+	{":vars", "list the variables, with their type and value"},
+	{":types", "list the declared types"},
+	{":doc name", "print the documentation of a package, symbol or method, such as fmt.Println"},
+	{":reset", "discard the declarations, variables and imports of the session"},
+	{":load file", "evaluate the Go source file"},
+	{":save file", "write the session to file, as a Go program"},
+	{":time expr", "evaluate expr, and print the time spent"},
+}
+
+// isCommand returns true if the line of input is a REPL meta-command. As no
+// Go statement starts with a colon, meta-commands do not conflict with code.
+func isCommand(line string) bool { return strings.HasPrefix(strings.TrimSpace(line), ":") }
+
+// command runs the REPL meta-command of line, and prints its output on out.
+// It returns an error only if ctx is done.
+func (interp *Interpreter) command(ctx context.Context, out io.Writer, opt REPLOptions, line string) error {
+	name, arg := strings.TrimSpace(line), ""
+	if i := strings.IndexAny(name, " \t"); i >= 0 {
+		name, arg = name[:i], strings.TrimSpace(name[i+1:])
+	}
+	for _, c := range replCommands {
+		if u := strings.Fields(c.usage); u[0] == name && len(u) > 1 && arg == "" {
+			fmt.Fprintln(out, "usage:", c.usage)
+			return nil
+		}
+	}
+
+	switch name {
+	case ":help":
+		for _, c := range replCommands {
+			fmt.Fprintf(out, "%-12s %s\n", c.usage, c.help)
+		}
+
+	case ":imports":
+		for _, s := range interp.mainSymbols(pkgSym) {
+			if p := s.sym.path; path.Base(p) == s.name {
+				fmt.Fprintln(out, strconv.Quote(p))
+			} else {
+				fmt.Fprintln(out, s.name, strconv.Quote(p))
+			}
+		}
+
+	case ":vars":
+		format := interp.formatter(opt)
+		for _, s := range interp.mainSymbols(varSym) {
+			desc := s.name
+			if t := identType(s.sym.typ); t != "" {
+				desc += " " + t
+			}
+			if v := interp.symbolValue(s.sym); v.IsValid() {
+				desc += " = " + format(v)
+			}
+			fmt.Fprintln(out, desc)
+		}
+
+	case ":types":
+		for _, s := range interp.mainSymbols(typeSym) {
+			fmt.Fprintln(out, interp.srcSymbol(mainID, s.name, s.sym).Signature)
+		}
+
+	case ":doc":
+		interp.printDoc(out, arg)
+
+	case ":reset":
+		interp.Reset()
+
+	case ":load":
+		b, err := ioutil.ReadFile(arg)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			break
+		}
+		name := interp.Name
+		interp.Name = arg
+		res, err := interp.replEval(ctx, string(b), opt.Interrupt)
+		interp.Name = name
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		interp.printEval(out, opt, res, err)
+
+	case ":save":
+		src, err := interp.SourceDump()
+		if err != nil {
+			fmt.Fprintln(out, err)
+			break
+		}
+		if err := ioutil.WriteFile(arg, []byte(src), 0644); err != nil {
+			fmt.Fprintln(out, err)
+		}
+
+	case ":time":
+		start := time.Now()
+		res, err := interp.replEval(ctx, arg, opt.Interrupt)
+		elapsed := time.Since(start)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		interp.printEval(out, opt, res, err)
+		fmt.Fprintln(out, elapsed)
+
+	default:
+		fmt.Fprintf(out, "unknown command %s, see :help\n", name)
+	}
+	return nil
+}
+
+// printEval prints on out the results of an evaluation, formatted as set by
+// opt, or its error.
+func (interp *Interpreter) printEval(out io.Writer, opt REPLOptions, res []reflect.Value, err error) {
+	if err != nil {
+		fmt.Fprintln(out, err)
+		if e, ok := err.(*Error); ok {
+			fmt.Fprint(out, e.Excerpt(), e.StackTrace())
+		}
+		return
+	}
+	if len(res) == 0 || !res[0].IsValid() {
+		return
+	}
+	format := interp.formatter(opt)
+	s := make([]string, len(res))
+	for i, v := range res {
+		s[i] = format(v)
+	}
+	fmt.Fprintln(out, strings.Join(s, ", "))
+}
+
+// formatter returns the function formatting the results of the REPL: the
+// Format option, or the function set by SetPrinter, or the default printer.
+func (interp *Interpreter) formatter(opt REPLOptions) func(reflect.Value) string {
+	if opt.Format != nil {
+		return opt.Format
+	}
+	if interp.printer != nil {
+		return interp.printer
+	}
+	return defaultPrinter.Sprint
+}
+
+// A namedSymbol is a symbol with its name.
+type namedSymbol struct {
+	name string
+	sym  *symbol
+}
+
+// mainSymbols returns the symbols of kind declared in the main package,
+// sorted by name. Symbols with a name starting with an underscore are not
+// returned.
+func (interp *Interpreter) mainSymbols(kind sKind) []namedSymbol {
+	sc := interp.scopes[mainID]
+	if sc == nil {
+		return nil
+	}
+	var syms []namedSymbol
+	for name, sym := range sc.sym {
+		if sym.kind == kind && !strings.HasPrefix(name, "_") {
+			syms = append(syms, namedSymbol{name, sym})
+		}
+	}
+	sort.Slice(syms, func(i, j int) bool { return syms[i].name < syms[j].name })
+	return syms
+}
@@ -0,0 +1,350 @@
+package interp
+
+import (
+	"go/constant"
+	"go/token"
+	"math"
+	"reflect"
+)
+
+// Constant expressions are evaluated with arbitrary precision, as specified
+// by the language: the exact value of a constant node is stored in its cval
+// field, and its rval field holds the same value, of the type of the node.
+// The value of an untyped constant is only required to be representable in
+// a type where it is used, and not in its default type.
+
+// constTok maps actions to the operators of constant expressions.
+var constTok = map[action]token.Token{
+	aAdd:          token.ADD,
+	aSub:          token.SUB,
+	aMul:          token.MUL,
+	aQuo:          token.QUO,
+	aRem:          token.REM,
+	aAnd:          token.AND,
+	aOr:           token.OR,
+	aXor:          token.XOR,
+	aAndNot:       token.AND_NOT,
+	aShl:          token.SHL,
+	aShr:          token.SHR,
+	aEqual:        token.EQL,
+	aNotEqual:     token.NEQ,
+	aGreater:      token.GTR,
+	aGreaterEqual: token.GEQ,
+	aLower:        token.LSS,
+	aLowerEqual:   token.LEQ,
+	aNegate:       token.SUB,
+	aNot:          token.NOT,
+	aBitNot:       token.XOR,
+	aPos:          token.ADD,
+}
+
+// foldBinary sets the constant value of binary expression n, computed from
+// the constant values of its operands. Operands of incompatible kinds are
+// left to the type checks and to the runtime.
+func foldBinary(n *node) error {
+	c0, c1 := n.child[0], n.child[1]
+	x, y := c0.cval, c1.cval
+	op, ok := constTok[n.action]
+	if !ok || x.Kind() != y.Kind() && !(isNumericKind(x.Kind()) && isNumericKind(y.Kind())) {
+		return nil
+	}
+	t := n.typ.TypeOf()
+	var v constant.Value
+	switch op {
+	case token.SHL, token.SHR:
+		s, ok := constant.Uint64Val(constant.ToInt(y))
+		if !ok {
+			return c1.cfgErrorf("invalid shift count %s", y)
+		}
+		if x = constant.ToInt(x); x.Kind() != constant.Int {
+			return c0.cfgErrorf("invalid operation: shifted operand %s must be integer", c0.cval)
+		}
+		v = constant.Shift(x, op, uint(s))
+	case token.EQL, token.NEQ:
+		v = constant.MakeBool(constant.Compare(x, op, y))
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		if x.Kind() == constant.Bool || x.Kind() == constant.Complex || y.Kind() == constant.Complex {
+			return nil
+		}
+		v = constant.MakeBool(constant.Compare(x, op, y))
+	default:
+		if isInt(t) {
+			if x = constant.ToInt(x); x.Kind() != constant.Int {
+				return c0.cfgErrorf("constant %s truncated to integer", c0.cval)
+			}
+			if y = constant.ToInt(y); y.Kind() != constant.Int {
+				return c1.cfgErrorf("constant %s truncated to integer", c1.cval)
+			}
+			if op == token.QUO {
+				op = token.QUO_ASSIGN // force integer division
+			}
+		}
+		if (op == token.QUO || op == token.QUO_ASSIGN || op == token.REM) && constant.Sign(y) == 0 {
+			return c1.cfgErrorf("invalid operation: division by zero")
+		}
+		v = constant.BinaryOp(x, op, y)
+	}
+	return setConst(n, v)
+}
+
+// foldUnary sets the constant value of unary expression n, computed from the
+// constant value of its operand.
+func foldUnary(n *node) error {
+	x := n.child[0].cval
+	switch {
+	case (n.action == aNegate || n.action == aPos) && isNumericKind(x.Kind()), n.action == aNot && x.Kind() == constant.Bool:
+		return setConst(n, constant.UnaryOp(constTok[n.action], x, 0))
+	case n.action == aBitNot && x.Kind() == constant.Int:
+		// The complement of an unsigned value has all the bits of its type
+		var prec uint
+		if t := n.typ.TypeOf(); !n.typ.untyped && isUint(t) {
+			prec = uint(t.Bits())
+		}
+		return setConst(n, constant.UnaryOp(token.XOR, x, prec))
+	}
+	return nil
+}
+
+// foldBuiltin sets the constant value of a call n to the complex, real,
+// imag, min or max builtin, computed from the constant values of its
+// arguments.
+func foldBuiltin(n *node) error {
+	args := n.child[1:]
+	for _, c := range args {
+		if c.cval == nil || !isNumericKind(c.cval.Kind()) && c.cval.Kind() != constant.String {
+			return nil
+		}
+	}
+	switch name := n.child[0].ident; name {
+	case "max", "min":
+		op := token.LSS
+		if name == "max" {
+			op = token.GTR
+		}
+		v := args[0].cval
+		for _, c := range args[1:] {
+			if constant.Compare(c.cval, op, v) {
+				v = c.cval
+			}
+		}
+		return setConst(n, v)
+	case "complex":
+		re, im := constant.ToFloat(args[0].cval), constant.ToFloat(args[1].cval)
+		if re.Kind() != constant.Float || im.Kind() != constant.Float {
+			return n.cfgErrorf("invalid operation: complex(%s, %s) requires real arguments", args[0].cval, args[1].cval)
+		}
+		return setConst(n, constant.BinaryOp(re, token.ADD, constant.MakeImag(im)))
+	case "real":
+		return setConst(n, constant.Real(args[0].cval))
+	case "imag":
+		return setConst(n, constant.Imag(args[0].cval))
+	}
+	return nil
+}
+
+// setConst sets the constant value v of node n, and its reflect value of the
+// type of n, or of the default type of v for an interface. The value of a
+// typed constant must be representable in its type, and is rounded to it.
+func setConst(n *node, v constant.Value) error {
+	t := n.typ.TypeOf()
+	if t.Kind() == reflect.Interface {
+		t = defaultConstType(v)
+	}
+	r := constValue(v, t)
+	switch {
+	case n.typ.untyped && !r.IsValid():
+		// Checked where the constant is used
+		r = reflect.New(t).Elem()
+	case !r.IsValid():
+		return constError(n, v, t)
+	case !n.typ.untyped:
+		v, _ = valueConst(r)
+	}
+	n.cval, n.rval = v, r
+	return nil
+}
+
+// convertConst converts the value of constant node n to type t, or returns
+// an error if it is not representable in t.
+func convertConst(n *node, t *itype) error {
+	rt := t.TypeOf()
+	r := constValue(n.cval, rt)
+	if !r.IsValid() {
+		return constError(n, n.cval, rt)
+	}
+	n.cval, _ = valueConst(r)
+	n.rval, n.typ = r, t
+	return nil
+}
+
+// checkConstUse returns an error if an untyped constant operand of node n,
+// where it is used, is not representable in its type. The operands of
+// constant expressions and declarations are not used, and the ones of calls,
+// composite literals, send and return statements are converted to the type
+// expected by their context at code generation.
+func checkConstUse(n *node) error {
+	if n.cval != nil {
+		return nil
+	}
+	switch n.kind {
+	case callExpr, compositeLitExpr, keyValueExpr, sendStmt, returnStmt:
+		return nil
+	case defineStmt:
+		if n.anc.kind == constDecl {
+			return nil
+		}
+	}
+	for _, c := range n.child {
+		if c.cval == nil || c.typ == nil || !c.typ.untyped {
+			continue
+		}
+		if t := c.typ.TypeOf(); isConstType(t) && !constValue(c.cval, t).IsValid() {
+			return constError(c, c.cval, t)
+		}
+	}
+	return nil
+}
+
+// constError returns the error of a constant value v of node n, not
+// representable in type t.
+func constError(n *node, v constant.Value, t reflect.Type) error {
+	switch {
+	case isInt(t) && (v.Kind() == constant.Float || v.Kind() == constant.Complex) && constant.ToInt(v).Kind() != constant.Int:
+		return n.cfgErrorf("constant %s truncated to integer", v)
+	case isNumber(t) && isNumericKind(v.Kind()):
+		return n.cfgErrorf("constant %s overflows %s", v, t)
+	}
+	return n.cfgErrorf("cannot use constant %s as %s value", v, t)
+}
+
+// isConstConvertible returns true if constant value v can be converted to
+// type t at compile time, as a constant conversion.
+func isConstConvertible(v constant.Value, t reflect.Type) bool {
+	switch {
+	case isNumber(t):
+		return isNumericKind(v.Kind())
+	case t.Kind() == reflect.String:
+		return v.Kind() == constant.String
+	case t.Kind() == reflect.Bool:
+		return v.Kind() == constant.Bool
+	}
+	return false
+}
+
+// isConstType returns true if values of type t can be constants.
+func isConstType(t reflect.Type) bool {
+	return isNumber(t) || t.Kind() == reflect.String || t.Kind() == reflect.Bool
+}
+
+// isBinFloatConst returns true if v is the value of a binary package
+// constant of a predeclared floating-point or complex type.
+func isBinFloatConst(v reflect.Value) bool {
+	t := v.Type()
+	return !v.CanAddr() && t.PkgPath() == "" && (isFloat(t) || isComplex(t))
+}
+
+func isNumericKind(k constant.Kind) bool {
+	return k == constant.Int || k == constant.Float || k == constant.Complex
+}
+
+// defaultConstType returns the default type of constant value v.
+func defaultConstType(v constant.Value) reflect.Type {
+	switch v.Kind() {
+	case constant.Bool:
+		return reflect.TypeOf(false)
+	case constant.String:
+		return reflect.TypeOf("")
+	case constant.Float:
+		return floatType
+	case constant.Complex:
+		return complexType
+	}
+	return reflect.TypeOf(0)
+}
+
+// valueConst returns the constant representation of a basic value.
+func valueConst(v reflect.Value) (constant.Value, bool) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return constant.MakeBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return constant.MakeInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return constant.MakeUint64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return constant.MakeFloat64(v.Float()), true
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return constant.BinaryOp(constant.MakeFloat64(real(c)), token.ADD, constant.MakeImag(constant.MakeFloat64(imag(c)))), true
+	case reflect.String:
+		return constant.MakeString(v.String()), true
+	}
+	return nil, false
+}
+
+// constValue returns the value of constant c, of type t, or an invalid
+// value if c is not representable in t.
+func constValue(c constant.Value, t reflect.Type) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		if c.Kind() != constant.Bool {
+			return reflect.Value{}
+		}
+		v.SetBool(constant.BoolVal(c))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, ok := constant.Int64Val(constant.ToInt(c))
+		if !ok || v.OverflowInt(i) {
+			return reflect.Value{}
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, ok := constant.Uint64Val(constant.ToInt(c))
+		if !ok || v.OverflowUint(u) {
+			return reflect.Value{}
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, ok := constFloat(constant.ToFloat(c), t.Kind())
+		if !ok {
+			return reflect.Value{}
+		}
+		v.SetFloat(f)
+	case reflect.Complex64, reflect.Complex128:
+		k := reflect.Float64
+		if t.Kind() == reflect.Complex64 {
+			k = reflect.Float32
+		}
+		c = constant.ToComplex(c)
+		re, ok1 := constFloat(constant.Real(c), k)
+		im, ok2 := constFloat(constant.Imag(c), k)
+		if !ok1 || !ok2 {
+			return reflect.Value{}
+		}
+		v.SetComplex(complex(re, im))
+	case reflect.String:
+		if c.Kind() != constant.String {
+			return reflect.Value{}
+		}
+		v.SetString(constant.StringVal(c))
+	default:
+		return reflect.Value{}
+	}
+	return v
+}
+
+// constFloat returns the value of constant c rounded to a float of kind k,
+// or false if c is not a finite float value of kind k.
+func constFloat(c constant.Value, k reflect.Kind) (float64, bool) {
+	if c.Kind() != constant.Int && c.Kind() != constant.Float {
+		return 0, false
+	}
+	var f float64
+	if k == reflect.Float32 {
+		f32, _ := constant.Float32Val(c)
+		f = float64(f32)
+	} else {
+		f, _ = constant.Float64Val(c)
+	}
+	return f, !math.IsInf(f, 0)
+}
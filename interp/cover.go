@@ -0,0 +1,294 @@
+package interp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+)
+
+// coverMode is the mode of coverage profiles, where blocks are counted.
+const coverMode = "count"
+
+// coverBlock is a basic block of source code, as defined by go test -cover.
+type coverBlock struct {
+	file        string // source file name
+	line0, col0 int    // start position
+	line1, col1 int    // end position
+	stmts       int    // number of statements
+	count       uint32 // number of executions, updated atomically
+	first       *node  // first statement, pending CFG
+}
+
+// coverage counts the executions of the basic blocks of interpreted files.
+type coverage struct {
+	blocks  []*coverBlock           // all blocks, in source order for each file
+	pending []*coverBlock           // blocks not yet bound to their first executed node
+	starts  map[*node][]*coverBlock // blocks indexed by their first executed node
+}
+
+// Coverage returns the fraction of statements of interpreted source files
+// executed so far, in the range [0, 1], as testing.Coverage. It returns 0
+// if neither Options.Cover nor Options.CoverProfile is set.
+func (interp *Interpreter) Coverage() float64 {
+	c := interp.cover
+	if c == nil {
+		return 0
+	}
+	var total, covered int
+	for _, b := range c.blocks {
+		total += b.stmts
+		if atomic.LoadUint32(&b.count) > 0 {
+			covered += b.stmts
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(covered) / float64(total)
+}
+
+// WriteCoverProfile writes to w the coverage profile of the interpreted
+// source files, in the format of go test -coverprofile, so it can be
+// analyzed with go tool cover. Test files are not covered.
+func (interp *Interpreter) WriteCoverProfile(w io.Writer) error {
+	c := interp.cover
+	if c == nil {
+		return fmt.Errorf("coverage is not enabled")
+	}
+	if _, err := fmt.Fprintf(w, "mode: %s\n", coverMode); err != nil {
+		return err
+	}
+	for _, b := range c.blocks {
+		if _, err := fmt.Fprintf(w, "%s:%d.%d,%d.%d %d %d\n", b.file, b.line0, b.col0, b.line1, b.col1, b.stmts, atomic.LoadUint32(&b.count)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeCoverFile writes the coverage profile to the file set by Options.CoverProfile.
+func (interp *Interpreter) writeCoverFile() error {
+	f, err := os.Create(interp.coverFile)
+	if err != nil {
+		return err
+	}
+	if err := interp.WriteCoverProfile(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// add records the basic blocks of the functions of the file f parsed from
+// src, and returns them indexed by their first statement.
+func (c *coverage) add(interp *Interpreter, f *ast.File, src string) map[ast.Node]*coverBlock {
+	name := interp.fset.File(f.Pos()).Name()
+	if _, ok := interp.srcFS().(realFS); ok {
+		// Absolute file names are resolved by go tool cover
+		if abs, err := filepath.Abs(name); err == nil {
+			name = abs
+		}
+	}
+	res := map[ast.Node]*coverBlock{}
+	addBlock := func(first ast.Stmt, start, end token.Pos, stmts int) {
+		p0, p1 := interp.fset.Position(start), interp.fset.Position(end)
+		b := &coverBlock{file: name, line0: p0.Line, col0: p0.Column, line1: p1.Line, col1: p1.Column, stmts: stmts}
+		c.blocks = append(c.blocks, b)
+		res[first] = b
+	}
+
+	// Blocks are split as by cmd/cover, which rewrites the else parts of
+	// if statements as blocks starting after the else keyword.
+	lbrace := map[*ast.BlockStmt]token.Pos{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			// Functions with a blank name can not be executed
+			return n.Name.Name != "_"
+		case *ast.BlockStmt:
+			if len(n.List) > 0 {
+				switch n.List[0].(type) {
+				case *ast.CaseClause:
+					for _, s := range n.List {
+						clause := s.(*ast.CaseClause)
+						coverList(clause.Colon+1, clause.End(), clause.Body, false, addBlock)
+					}
+					return true
+				case *ast.CommClause:
+					for _, s := range n.List {
+						clause := s.(*ast.CommClause)
+						coverList(clause.Colon+1, clause.End(), clause.Body, false, addBlock)
+					}
+					return true
+				}
+			}
+			start, ok := lbrace[n]
+			if !ok {
+				start = n.Lbrace
+			}
+			coverList(start, n.Rbrace+1, n.List, true, addBlock)
+		case *ast.IfStmt:
+			switch e := n.Else.(type) {
+			case *ast.IfStmt:
+				coverList(elsePos(interp.fset, src, n), e.End()+1, []ast.Stmt{e}, true, addBlock)
+			case *ast.BlockStmt:
+				lbrace[e] = elsePos(interp.fset, src, n)
+			}
+		}
+		return true
+	})
+	return res
+}
+
+// elsePos returns the position following the else keyword of if statement n.
+func elsePos(fset *token.FileSet, src string, n *ast.IfStmt) token.Pos {
+	file := fset.File(n.Pos())
+	offset := file.Offset(n.Body.End())
+	if i := strings.Index(src[offset:], "else"); i >= 0 {
+		offset += i + len("else")
+	}
+	return file.Pos(offset)
+}
+
+// coverList splits the statement list of a block, from start to end, in
+// basic blocks, and calls add for each of them.
+func coverList(start, end token.Pos, list []ast.Stmt, extendToClosingBrace bool, add func(first ast.Stmt, start, end token.Pos, stmts int)) {
+	for len(list) > 0 {
+		var last int
+		blockEnd := end
+		for last = 0; last < len(list); last++ {
+			s := list[last]
+			blockEnd = coverBoundary(s)
+			if coverEndsBlock(s) {
+				if label, ok := s.(*ast.LabeledStmt); ok && !isCoverControl(label.Stmt) {
+					// The label may be the target of a goto, so the labeled
+					// statement starts a new block
+					blockEnd = label.Pos()
+					list = append(append(list[:last+1:last+1], label.Stmt), list[last+1:]...)
+				}
+				last++
+				extendToClosingBrace = false
+				break
+			}
+		}
+		if extendToClosingBrace {
+			blockEnd = end
+		}
+		if start != blockEnd {
+			add(list[0], start, blockEnd, last)
+		}
+		list = list[last:]
+		if len(list) > 0 {
+			start = list[0].Pos()
+		}
+	}
+}
+
+// coverBoundary returns the end position of statement s in its block,
+// which excludes the body of control statements and function literals.
+func coverBoundary(s ast.Stmt) token.Pos {
+	switch s := s.(type) {
+	case *ast.BlockStmt:
+		return s.Lbrace
+	case *ast.IfStmt:
+		return funcLitPos(s.Body.Lbrace, s.Init, s.Cond)
+	case *ast.ForStmt:
+		return funcLitPos(s.Body.Lbrace, s.Init, s.Cond, s.Post)
+	case *ast.LabeledStmt:
+		return coverBoundary(s.Stmt)
+	case *ast.RangeStmt:
+		return funcLitPos(s.Body.Lbrace, s.X)
+	case *ast.SwitchStmt:
+		return funcLitPos(s.Body.Lbrace, s.Init, s.Tag)
+	case *ast.SelectStmt:
+		return s.Body.Lbrace
+	case *ast.TypeSwitchStmt:
+		return funcLitPos(s.Body.Lbrace, s.Init)
+	}
+	return funcLitPos(s.End(), s)
+}
+
+// coverEndsBlock returns true if statement s ends a basic block.
+func coverEndsBlock(s ast.Stmt) bool {
+	switch s := s.(type) {
+	case *ast.BlockStmt, *ast.BranchStmt, *ast.ForStmt, *ast.IfStmt, *ast.LabeledStmt,
+		*ast.RangeStmt, *ast.SwitchStmt, *ast.SelectStmt, *ast.TypeSwitchStmt:
+		return true
+	case *ast.ExprStmt:
+		// Calls to panic change the flow
+		if call, ok := s.X.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "panic" && len(call.Args) == 1 {
+				return true
+			}
+		}
+	}
+	return funcLitPos(token.NoPos, s) != token.NoPos
+}
+
+// isCoverControl returns true if s is a loop, switch or select statement.
+func isCoverControl(s ast.Stmt) bool {
+	switch s.(type) {
+	case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.SelectStmt, *ast.TypeSwitchStmt:
+		return true
+	}
+	return false
+}
+
+// funcLitPos returns the position of the body of the first function
+// literal in nodes, or pos if there is none.
+func funcLitPos(pos token.Pos, nodes ...ast.Node) token.Pos {
+	for _, n := range nodes {
+		if n == nil {
+			continue
+		}
+		found := token.NoPos
+		ast.Inspect(n, func(n ast.Node) bool {
+			if l, ok := n.(*ast.FuncLit); ok && found == token.NoPos {
+				found = l.Body.Lbrace
+			}
+			return found == token.NoPos
+		})
+		if found != token.NoPos {
+			return found
+		}
+	}
+	return pos
+}
+
+// bind records that block b starts with statement n.
+func (c *coverage) bind(b *coverBlock, n *node) {
+	b.first = n
+	c.pending = append(c.pending, b)
+}
+
+// resolve indexes pending blocks by the first executed node of their first
+// statement, once the CFG is built.
+func (c *coverage) resolve() {
+	for _, b := range c.pending {
+		if s := b.first.start; s != nil {
+			c.starts[s] = append(c.starts[s], b)
+		}
+		b.first = nil
+	}
+	c.pending = nil
+}
+
+// wrap counts the blocks starting at node n when n is executed.
+func (c *coverage) wrap(n *node) {
+	blocks := c.starts[n]
+	if blocks == nil {
+		return
+	}
+	exec := n.exec
+	n.exec = func(f *frame) bltn {
+		for _, b := range blocks {
+			atomic.AddUint32(&b.count, 1)
+		}
+		return exec(f)
+	}
+}
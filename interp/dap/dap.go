@@ -0,0 +1,222 @@
+// Package dap implements a Debug Adapter Protocol server for the interpreter.
+//
+// The Debug Adapter Protocol is the JSON based protocol used by editors such
+// as VS Code to control a debugger. It is specified at
+// https://microsoft.github.io/debug-adapter-protocol/specification.
+//
+// A Server handles one debugging session: it launches a program in a new
+// interpreter, and lets the client set breakpoints, step through the source,
+// inspect goroutines, frames and variables, and evaluate expressions in a
+// stopped frame.
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"sync"
+)
+
+// request is a client request.
+type request struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+// response is a server response to a request.
+type response struct {
+	Seq        int         `json:"seq"`
+	Type       string      `json:"type"`
+	RequestSeq int         `json:"request_seq"`
+	Success    bool        `json:"success"`
+	Command    string      `json:"command"`
+	Message    string      `json:"message,omitempty"`
+	Body       interface{} `json:"body,omitempty"`
+}
+
+// event is a message initiated by the server.
+type event struct {
+	Seq   int         `json:"seq"`
+	Type  string      `json:"type"`
+	Event string      `json:"event"`
+	Body  interface{} `json:"body,omitempty"`
+}
+
+// conn reads and writes protocol messages, which are made of a header with
+// a Content-Length field, followed by a JSON content.
+type conn struct {
+	r *textproto.Reader
+
+	mutex sync.Mutex // protects w and seq
+	w     io.Writer
+	seq   int
+}
+
+func newConn(rw io.ReadWriter) *conn {
+	return &conn{r: textproto.NewReader(bufio.NewReader(rw)), w: rw}
+}
+
+// read decodes the next message in v.
+func (c *conn) read(v interface{}) error {
+	h, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		return err
+	}
+	length, err := strconv.Atoi(h.Get("Content-Length"))
+	if err != nil || length < 0 {
+		return errors.New("invalid Content-Length header")
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(c.r.R, b); err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// respond sends the response to request req. A non nil err makes it an
+// error response.
+func (c *conn) respond(req *request, body interface{}, err error) error {
+	resp := &response{Type: "response", RequestSeq: req.Seq, Success: err == nil, Command: req.Command, Body: body}
+	if err != nil {
+		resp.Message = err.Error()
+		resp.Body = nil
+	}
+	return c.write(func(seq int) interface{} { resp.Seq = seq; return resp })
+}
+
+// send sends an event.
+func (c *conn) send(name string, body interface{}) error {
+	return c.write(func(seq int) interface{} { return &event{Seq: seq, Type: "event", Event: name, Body: body} })
+}
+
+// write sends the message returned by msg for the next sequence number.
+func (c *conn) write(msg func(seq int) interface{}) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.seq++
+	b, err := json.Marshal(msg(c.seq))
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(b)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(b)
+	return err
+}
+
+// Request arguments and response bodies of the protocol, limited to the
+// fields in use.
+
+type launchArguments struct {
+	Program     string   `json:"program"`
+	Args        []string `json:"args"`
+	StopOnEntry bool     `json:"stopOnEntry"`
+	NoDebug     bool     `json:"noDebug"`
+}
+
+type capabilities struct {
+	SupportsConfigurationDoneRequest bool `json:"supportsConfigurationDoneRequest"`
+	SupportsEvaluateForHovers        bool `json:"supportsEvaluateForHovers"`
+}
+
+type source struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type sourceBreakpoint struct {
+	Line int `json:"line"`
+}
+
+type setBreakpointsArguments struct {
+	Source      source             `json:"source"`
+	Breakpoints []sourceBreakpoint `json:"breakpoints"`
+	Lines       []int              `json:"lines"`
+}
+
+type breakpoint struct {
+	Verified bool `json:"verified"`
+	Line     int  `json:"line"`
+}
+
+type threadArguments struct {
+	ThreadID int `json:"threadId"`
+}
+
+type thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type stackTraceArguments struct {
+	ThreadID   int `json:"threadId"`
+	StartFrame int `json:"startFrame"`
+	Levels     int `json:"levels"`
+}
+
+type stackFrame struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Source *source `json:"source,omitempty"`
+	Line   int     `json:"line"`
+	Column int     `json:"column"`
+}
+
+type scopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+type scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+type variablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+type variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+type evaluateArguments struct {
+	Expression string `json:"expression"`
+	FrameID    int    `json:"frameId"`
+}
+
+type evaluateResponse struct {
+	Result             string `json:"result"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+type stoppedEvent struct {
+	Reason            string `json:"reason"`
+	ThreadID          int    `json:"threadId"`
+	AllThreadsStopped bool   `json:"allThreadsStopped"`
+}
+
+type threadEvent struct {
+	Reason   string `json:"reason"`
+	ThreadID int    `json:"threadId"`
+}
+
+type outputEvent struct {
+	Category string `json:"category"`
+	Output   string `json:"output"`
+}
+
+type exitedEvent struct {
+	ExitCode int `json:"exitCode"`
+}
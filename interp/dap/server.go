@@ -0,0 +1,508 @@
+package dap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/containous/yaegi/interp"
+)
+
+const (
+	maxChildren = 100  // maximum number of children of a variable
+	maxValueLen = 1000 // maximum length of a formatted value
+)
+
+// A Server handles a debugging session on a connection.
+type Server struct {
+	conn      *conn
+	newInterp func() *interp.Interpreter
+
+	mutex       sync.Mutex // protects fields below, accessed from interpreter goroutines
+	interp      *interp.Interpreter
+	debugger    *interp.Debugger
+	program     *launchArguments
+	breakpoints map[string][]int // pending breakpoints lines, indexed by file
+
+	// Output redirection, set by CaptureOutput
+	stdout     *os.File      // original standard output
+	output     *os.File      // pipe replacing standard output
+	outputDone chan struct{} // closed when output is forwarded
+
+	// Handles of frames and variables, valid until execution is resumed
+	frames []*interp.DebugFrame
+	refs   []func() []variable
+}
+
+// NewServer returns a server for a debugging session on rw. Function newInterp
+// returns the interpreter used to run the program of the launch request.
+func NewServer(rw io.ReadWriter, newInterp func() *interp.Interpreter) *Server {
+	return &Server{conn: newConn(rw), newInterp: newInterp, breakpoints: map[string][]int{}}
+}
+
+// Serve handles client requests until a disconnect request or the end of the
+// connection.
+func (s *Server) Serve() error {
+	for {
+		req := &request{}
+		err := s.conn.read(req)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		body, err := s.handle(req)
+		if err := s.conn.respond(req, body, err); err != nil {
+			return err
+		}
+		switch req.Command {
+		case "initialize":
+			if err := s.conn.send("initialized", nil); err != nil {
+				return err
+			}
+		case "disconnect":
+			return nil
+		}
+	}
+}
+
+// CaptureOutput redirects the standard output of the process to output
+// events, for a session running on the original standard output.
+// The redirection ends with the execution of the launched program.
+func (s *Server) CaptureOutput() error {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	s.stdout, s.output, s.outputDone = os.Stdout, w, make(chan struct{})
+	os.Stdout = w
+	go func() {
+		defer close(s.outputDone)
+		b := make([]byte, 4096)
+		for {
+			n, err := r.Read(b)
+			if n > 0 {
+				_ = s.conn.send("output", &outputEvent{Category: "stdout", Output: string(b[:n])})
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// handle processes a request and returns the response body.
+func (s *Server) handle(req *request) (interface{}, error) {
+	switch req.Command {
+	case "initialize":
+		return &capabilities{SupportsConfigurationDoneRequest: true, SupportsEvaluateForHovers: true}, nil
+
+	case "launch":
+		args := &launchArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		return nil, s.launch(args)
+
+	case "setBreakpoints":
+		args := &setBreakpointsArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		return s.setBreakpoints(args), nil
+
+	case "setExceptionBreakpoints":
+		return nil, nil
+
+	case "configurationDone":
+		s.mutex.Lock()
+		i, program := s.interp, s.program
+		s.program = nil
+		s.mutex.Unlock()
+		if i == nil {
+			return nil, errors.New("no program launched")
+		}
+		if program != nil {
+			go s.run(i, program)
+		}
+		return nil, nil
+
+	case "threads":
+		var threads []thread
+		if d := s.getDebugger(); d != nil {
+			for _, id := range d.Routines() {
+				threads = append(threads, thread{ID: id, Name: threadName(id)})
+			}
+		}
+		return map[string]interface{}{"threads": threads}, nil
+
+	case "stackTrace":
+		args := &stackTraceArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		return s.stackTrace(args)
+
+	case "scopes":
+		args := &scopesArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		f, err := s.frame(args.FrameID)
+		if err != nil {
+			return nil, err
+		}
+		scopes := []scope{
+			{Name: "Locals", VariablesReference: s.addRef(func() []variable { return s.variables(f.Locals()) })},
+			{Name: "Globals", VariablesReference: s.addRef(func() []variable { return s.variables(f.Globals()) })},
+		}
+		return map[string]interface{}{"scopes": scopes}, nil
+
+	case "variables":
+		args := &variablesArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		s.mutex.Lock()
+		n := args.VariablesReference
+		if n < 1 || n > len(s.refs) {
+			s.mutex.Unlock()
+			return nil, fmt.Errorf("invalid variables reference %d", n)
+		}
+		ref := s.refs[n-1]
+		s.mutex.Unlock()
+		vars := ref()
+		if vars == nil {
+			vars = []variable{}
+		}
+		return map[string]interface{}{"variables": vars}, nil
+
+	case "evaluate":
+		args := &evaluateArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		f, err := s.frame(args.FrameID)
+		if err != nil {
+			return nil, err
+		}
+		v, err := f.Eval(args.Expression)
+		if err != nil {
+			return nil, err
+		}
+		r := s.variable("", v)
+		return &evaluateResponse{Result: r.Value, Type: r.Type, VariablesReference: r.VariablesReference}, nil
+
+	case "continue", "next", "stepIn", "stepOut", "pause":
+		args := &threadArguments{}
+		if err := unmarshal(req, args); err != nil {
+			return nil, err
+		}
+		d := s.getDebugger()
+		if d == nil {
+			return nil, errors.New("no program launched")
+		}
+		if req.Command == "pause" {
+			return nil, d.Pause(args.ThreadID)
+		}
+		s.clearHandles()
+		resume := map[string]func(int) error{"continue": d.Continue, "next": d.Next, "stepIn": d.StepIn, "stepOut": d.StepOut}
+		if err := resume[req.Command](args.ThreadID); err != nil {
+			return nil, err
+		}
+		if req.Command == "continue" {
+			return map[string]interface{}{"allThreadsContinued": false}, nil
+		}
+		return nil, nil
+
+	case "disconnect":
+		if d := s.getDebugger(); d != nil {
+			d.Close()
+		}
+		return nil, nil
+	}
+	return nil, fmt.Errorf("unsupported command %q", req.Command)
+}
+
+// launch prepares the interpreter to run a program, which is started by
+// the configurationDone request.
+func (s *Server) launch(args *launchArguments) error {
+	if args.Program == "" {
+		return errors.New("missing program")
+	}
+	program, err := filepath.Abs(args.Program)
+	if err != nil {
+		return err
+	}
+	args.Program = program
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.interp != nil {
+		return errors.New("program already launched")
+	}
+	s.interp = s.newInterp()
+	s.interp.Name = program
+	s.program = args
+	if !args.NoDebug {
+		s.debugger = s.interp.Debug(interp.DebugOptions{StopOnEntry: args.StopOnEntry}, s.onEvent)
+		for file, lines := range s.breakpoints {
+			s.debugger.SetBreakpoints(file, lines)
+		}
+	}
+	return nil
+}
+
+// run evaluates the launched program, then reports its termination.
+func (s *Server) run(i *interp.Interpreter, args *launchArguments) {
+	exitCode := 0
+	b, err := ioutil.ReadFile(args.Program)
+	if err == nil {
+		src := string(b)
+		// Set command line as expected by the interpreted main
+		os.Args = append([]string{args.Program}, args.Args...)
+		_, err = i.Eval(src)
+	}
+	if s.output != nil {
+		// Forward remaining output prior to termination
+		os.Stdout = s.stdout
+		s.output.Close()
+		<-s.outputDone
+	}
+	if err != nil {
+		exitCode = 1
+		_ = s.conn.send("output", &outputEvent{Category: "stderr", Output: err.Error() + "\n"})
+	}
+	_ = s.conn.send("exited", &exitedEvent{ExitCode: exitCode})
+	_ = s.conn.send("terminated", nil)
+}
+
+// onEvent reports debugger events to the client.
+func (s *Server) onEvent(e *interp.DebugEvent) {
+	switch e.Reason {
+	case interp.DebugGoroutineStart:
+		_ = s.conn.send("thread", &threadEvent{Reason: "started", ThreadID: e.Routine})
+	case interp.DebugGoroutineExit:
+		_ = s.conn.send("thread", &threadEvent{Reason: "exited", ThreadID: e.Routine})
+	default:
+		_ = s.conn.send("stopped", &stoppedEvent{Reason: e.Reason.String(), ThreadID: e.Routine})
+	}
+}
+
+func (s *Server) setBreakpoints(args *setBreakpointsArguments) interface{} {
+	lines := args.Lines
+	if args.Breakpoints != nil {
+		lines = make([]int, len(args.Breakpoints))
+		for i, b := range args.Breakpoints {
+			lines[i] = b.Line
+		}
+	}
+	s.mutex.Lock()
+	s.breakpoints[args.Source.Path] = lines
+	if s.debugger != nil {
+		s.debugger.SetBreakpoints(args.Source.Path, lines)
+	}
+	s.mutex.Unlock()
+
+	breakpoints := make([]breakpoint, len(lines))
+	for i, l := range lines {
+		breakpoints[i] = breakpoint{Verified: true, Line: l}
+	}
+	return map[string]interface{}{"breakpoints": breakpoints}
+}
+
+func (s *Server) stackTrace(args *stackTraceArguments) (interface{}, error) {
+	d := s.getDebugger()
+	if d == nil {
+		return nil, errors.New("no program launched")
+	}
+	frames, err := d.Frames(args.ThreadID)
+	if err != nil {
+		return nil, err
+	}
+	total := len(frames)
+	if args.StartFrame > 0 && args.StartFrame < len(frames) {
+		frames = frames[args.StartFrame:]
+	} else if args.StartFrame > 0 {
+		frames = nil
+	}
+	if args.Levels > 0 && args.Levels < len(frames) {
+		frames = frames[:args.Levels]
+	}
+
+	stack := make([]stackFrame, len(frames))
+	s.mutex.Lock()
+	for i, f := range frames {
+		s.frames = append(s.frames, f)
+		pos := f.Position()
+		stack[i] = stackFrame{ID: len(s.frames), Name: f.Name(), Line: pos.Line, Column: pos.Column}
+		if pos.Filename != "" {
+			stack[i].Source = &source{Name: filepath.Base(pos.Filename), Path: pos.Filename}
+		}
+	}
+	s.mutex.Unlock()
+	return map[string]interface{}{"stackFrames": stack, "totalFrames": total}, nil
+}
+
+func (s *Server) getDebugger() *interp.Debugger {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.debugger
+}
+
+// frame returns the frame of handle id.
+func (s *Server) frame(id int) (*interp.DebugFrame, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if id < 1 || id > len(s.frames) {
+		return nil, fmt.Errorf("invalid frame id %d", id)
+	}
+	return s.frames[id-1], nil
+}
+
+// addRef returns a new variables reference, resolved by function children.
+func (s *Server) addRef(children func() []variable) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.refs = append(s.refs, children)
+	return len(s.refs)
+}
+
+func (s *Server) clearHandles() {
+	s.mutex.Lock()
+	s.frames, s.refs = nil, nil
+	s.mutex.Unlock()
+}
+
+func (s *Server) variables(vars []*interp.DebugVariable) []variable {
+	r := make([]variable, len(vars))
+	for i, v := range vars {
+		r[i] = s.variable(v.Name, v.Value)
+	}
+	return r
+}
+
+// variable returns the protocol representation of value v. Composite values
+// get a reference to their children.
+func (s *Server) variable(name string, v reflect.Value) variable {
+	r := variable{Name: name, Value: formatValue(v)}
+	if !v.IsValid() {
+		return r
+	}
+	r.Type = v.Type().String()
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.NumField() == 0 {
+			return r
+		}
+	case reflect.Array, reflect.Map, reflect.Slice:
+		if v.Len() == 0 {
+			return r
+		}
+	case reflect.Ptr:
+		if v.IsNil() {
+			return r
+		}
+	default:
+		return r
+	}
+	r.VariablesReference = s.addRef(func() []variable { return s.children(v) })
+	return r
+}
+
+// children returns the elements, fields or referenced value of value v.
+func (s *Server) children(v reflect.Value) []variable {
+	var vars []variable
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len() && i < maxChildren; i++ {
+			vars = append(vars, s.variable("["+strconv.Itoa(i)+"]", v.Index(i)))
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		names := make([]string, len(keys))
+		for i, k := range keys {
+			names[i] = formatValue(k)
+		}
+		sort.Sort(byName{names, keys})
+		for i, k := range keys {
+			if i == maxChildren {
+				break
+			}
+			vars = append(vars, s.variable("["+names[i]+"]", v.MapIndex(k)))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			vars = append(vars, s.variable(v.Type().Field(i).Name, v.Field(i)))
+		}
+	case reflect.Ptr:
+		vars = append(vars, s.variable("*", v.Elem()))
+	}
+	return vars
+}
+
+// byName sorts map keys by formatted value.
+type byName struct {
+	names []string
+	keys  []reflect.Value
+}
+
+func (b byName) Len() int           { return len(b.names) }
+func (b byName) Less(i, j int) bool { return b.names[i] < b.names[j] }
+func (b byName) Swap(i, j int) {
+	b.names[i], b.names[j] = b.names[j], b.names[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
+// formatValue returns a short textual representation of value v.
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	var s string
+	switch v.Kind() {
+	case reflect.String:
+		s = strconv.Quote(v.String())
+	case reflect.Func:
+		if v.IsNil() {
+			return "nil"
+		}
+		return v.Type().String()
+	default:
+		s = fmt.Sprintf("%v", v)
+	}
+	if len(s) > maxValueLen {
+		s = s[:maxValueLen] + "..."
+	}
+	return s
+}
+
+func threadName(id int) string {
+	if id == 1 {
+		return "main"
+	}
+	return "goroutine " + strconv.Itoa(id)
+}
+
+func unmarshal(req *request, v interface{}) error {
+	if len(req.Arguments) == 0 {
+		return nil
+	}
+	return json.Unmarshal(req.Arguments, v)
+}
@@ -0,0 +1,200 @@
+package dap
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+const testProgram = `package main
+
+import "fmt"
+
+func main() {
+	a := 1
+	b := []int{2, 3}
+	fmt.Println(a, b)
+}
+`
+
+// message is a response or an event received by the test client.
+type message struct {
+	Type       string          `json:"type"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Event      string          `json:"event"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type client struct {
+	t    *testing.T
+	conn *conn
+	seq  int
+	msgs chan *message
+}
+
+func newClient(t *testing.T) *client {
+	c1, c2 := net.Pipe()
+	s := NewServer(c1, func() *interp.Interpreter {
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		return i
+	})
+	go func() { _ = s.Serve() }()
+
+	c := &client{t: t, conn: newConn(c2), msgs: make(chan *message, 100)}
+	go func() {
+		for {
+			m := &message{}
+			if err := c.conn.read(m); err != nil {
+				close(c.msgs)
+				return
+			}
+			c.msgs <- m
+		}
+	}()
+	return c
+}
+
+// request sends a request and returns its response body, decoded in body.
+func (c *client) request(command string, args interface{}, body interface{}) {
+	c.t.Helper()
+	c.seq++
+	seq := c.seq
+	if err := c.conn.write(func(int) interface{} {
+		return map[string]interface{}{"seq": seq, "type": "request", "command": command, "arguments": args}
+	}); err != nil {
+		c.t.Fatal(err)
+	}
+	m := c.wait(func(m *message) bool { return m.Type == "response" && m.RequestSeq == seq })
+	if !m.Success {
+		c.t.Fatalf("%s: %s", command, m.Message)
+	}
+	if body != nil {
+		if err := json.Unmarshal(m.Body, body); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+}
+
+// event waits for an event, and decodes its body in body.
+func (c *client) event(name string, body interface{}) {
+	c.t.Helper()
+	m := c.wait(func(m *message) bool { return m.Type == "event" && m.Event == name })
+	if body != nil {
+		if err := json.Unmarshal(m.Body, body); err != nil {
+			c.t.Fatal(err)
+		}
+	}
+}
+
+func (c *client) wait(match func(*message) bool) *message {
+	c.t.Helper()
+	for {
+		select {
+		case m, ok := <-c.msgs:
+			if !ok {
+				c.t.Fatal("connection closed")
+			}
+			if match(m) {
+				return m
+			}
+		case <-time.After(5 * time.Second):
+			c.t.Fatal("timeout")
+		}
+	}
+}
+
+func TestServer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	program := filepath.Join(dir, "main.go")
+	if err := ioutil.WriteFile(program, []byte(testProgram), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newClient(t)
+	var caps capabilities
+	c.request("initialize", map[string]string{"adapterID": "yaegi"}, &caps)
+	if !caps.SupportsConfigurationDoneRequest {
+		t.Fatal("configurationDone request should be supported")
+	}
+	c.event("initialized", nil)
+	c.request("launch", map[string]interface{}{"program": program}, nil)
+	c.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]string{"path": program},
+		"breakpoints": []map[string]int{{"line": 8}},
+	}, nil)
+	c.request("configurationDone", nil, nil)
+
+	var stopped stoppedEvent
+	c.event("stopped", &stopped)
+	if stopped.Reason != "breakpoint" || stopped.ThreadID != 1 {
+		t.Fatalf("unexpected stopped event %+v", stopped)
+	}
+
+	var threads struct{ Threads []thread }
+	c.request("threads", nil, &threads)
+	if len(threads.Threads) != 1 || threads.Threads[0].Name != "main" {
+		t.Fatalf("unexpected threads %+v", threads)
+	}
+
+	var trace struct{ StackFrames []stackFrame }
+	c.request("stackTrace", map[string]int{"threadId": 1}, &trace)
+	if len(trace.StackFrames) != 1 {
+		t.Fatalf("unexpected stack %+v", trace)
+	}
+	if f := trace.StackFrames[0]; f.Name != "main" || f.Line != 8 || f.Source == nil || f.Source.Path != program {
+		t.Fatalf("unexpected frame %+v", f)
+	}
+	frameID := trace.StackFrames[0].ID
+
+	var scopes struct{ Scopes []scope }
+	c.request("scopes", map[string]int{"frameId": frameID}, &scopes)
+	if len(scopes.Scopes) != 2 || scopes.Scopes[0].Name != "Locals" {
+		t.Fatalf("unexpected scopes %+v", scopes)
+	}
+
+	var vars struct{ Variables []variable }
+	c.request("variables", map[string]int{"variablesReference": scopes.Scopes[0].VariablesReference}, &vars)
+	if len(vars.Variables) != 2 {
+		t.Fatalf("unexpected variables %+v", vars)
+	}
+	a, b := vars.Variables[0], vars.Variables[1]
+	if a.Name != "a" || a.Value != "1" || a.VariablesReference != 0 {
+		t.Fatalf("unexpected variable %+v", a)
+	}
+	if b.Name != "b" || b.Value != "[2 3]" || b.Type != "[]int" || b.VariablesReference == 0 {
+		t.Fatalf("unexpected variable %+v", b)
+	}
+	c.request("variables", map[string]int{"variablesReference": b.VariablesReference}, &vars)
+	if len(vars.Variables) != 2 || vars.Variables[1].Name != "[1]" || vars.Variables[1].Value != "3" {
+		t.Fatalf("unexpected elements %+v", vars)
+	}
+
+	var result evaluateResponse
+	c.request("evaluate", map[string]interface{}{"expression": "a + b[1]", "frameId": frameID}, &result)
+	if result.Result != "4" {
+		t.Fatalf("got %q, want %q", result.Result, "4")
+	}
+
+	c.request("continue", map[string]int{"threadId": 1}, nil)
+	var exited exitedEvent
+	c.event("exited", &exited)
+	if exited.ExitCode != 0 {
+		t.Fatalf("got exit code %d", exited.ExitCode)
+	}
+	c.event("terminated", nil)
+	c.request("disconnect", nil, nil)
+}
@@ -0,0 +1,851 @@
+package interp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// A Debugger controls the execution of an interpreter: it stops goroutines at
+// breakpoints or on request, steps through statements, and gives access to
+// the call stack and variables of stopped goroutines.
+//
+// Execution stops at statements, each goroutine independently. A stopped
+// goroutine waits until it is resumed by Continue, Next, StepIn or StepOut.
+// Stops are reported to the events function given to Debug, or can be
+// waited for with Wait, so a debugging interface can be built in the host
+// program, as in:
+//
+//	d := i.Debug(interp.DebugOptions{}, nil)
+//	d.SetBreakpoint("script.go", 12)
+//	go i.Eval(src)
+//	id, _ := d.Wait(ctx)
+//	frames, _ := d.Frames(id)
+//	fmt.Println(frames[0].Position(), frames[0].Locals())
+//	d.Continue(id)
+type Debugger struct {
+	interp *Interpreter
+	events func(*DebugEvent)
+
+	mutex       sync.Mutex
+	breakpoints map[string]map[int]bool // breakpoint lines, indexed by absolute file name
+	files       map[string]string       // absolute file names, indexed by source file name
+	routines    map[int]*debugRoutine   // running goroutines, indexed by id
+	lastID      int                     // last allocated goroutine id
+	closed      bool                    // set by Close
+	stopped     chan struct{}           // closed and replaced when a goroutine stops
+}
+
+// DebugOptions are the debugger options.
+type DebugOptions struct {
+	// StopOnEntry stops the main goroutine before its first statement.
+	StopOnEntry bool
+}
+
+// DebugEventReason is the reason of a debugger event.
+type DebugEventReason int
+
+// Debugger event reasons.
+const (
+	DebugBreakpoint     DebugEventReason = iota + 1 // goroutine stopped at a breakpoint
+	DebugStep                                       // goroutine stopped after a step
+	DebugPause                                      // goroutine stopped by Pause
+	DebugEntry                                      // goroutine stopped on program entry
+	DebugGoroutineStart                             // goroutine started
+	DebugGoroutineExit                              // goroutine exited
+)
+
+var debugReasons = [...]string{
+	DebugBreakpoint:     "breakpoint",
+	DebugStep:           "step",
+	DebugPause:          "pause",
+	DebugEntry:          "entry",
+	DebugGoroutineStart: "goroutine start",
+	DebugGoroutineExit:  "goroutine exit",
+}
+
+func (r DebugEventReason) String() string {
+	if r > 0 && int(r) < len(debugReasons) {
+		return debugReasons[r]
+	}
+	return "DebugEventReason(" + strconv.Itoa(int(r)) + ")"
+}
+
+// A DebugEvent reports a change of state of a goroutine.
+type DebugEvent struct {
+	Reason  DebugEventReason
+	Routine int // goroutine id
+}
+
+// A DebugFrame is a function frame of a stopped goroutine, from the
+// innermost to the outermost call.
+type DebugFrame struct {
+	d    *Debugger
+	f    *frame
+	node *node // node being executed
+	def  *node // function definition, or nil at global level
+}
+
+// A DebugVariable is a named value of a frame.
+type DebugVariable struct {
+	Name  string
+	Value reflect.Value
+}
+
+// stepMode defines how execution resumes after a stop.
+type stepMode int
+
+const (
+	stepNone stepMode = iota // run until next breakpoint
+	stepIn                   // stop at next statement
+	stepOver                 // stop at next statement in the same or a calling function
+	stepOut                  // stop at next statement in a calling function
+)
+
+// debugRoutine stores the debugging state of a goroutine.
+type debugRoutine struct {
+	id     int
+	mode   stepMode      // set when resumed
+	depth  int           // frame depth when resumed, for stepOver and stepOut
+	pause  bool          // stop requested by Pause
+	entry  bool          // stop requested on entry
+	frame  *frame        // innermost frame if stopped, nil otherwise
+	resume chan struct{} // resumes a stopped goroutine
+}
+
+// frameDebug stores the debugging state of a frame.
+type frameDebug struct {
+	routine *debugRoutine
+	caller  *frame // calling frame, or nil
+	def     *node  // function definition, or nil at global level
+	node    *node  // node being executed
+	depth   int    // number of calling frames
+	entry   bool   // frame is the entry point of routine
+}
+
+// Debug attaches a debugger to the interpreter, and returns it. Only code
+// compiled afterwards can be debugged, so Debug must be called prior to Eval.
+// Function events, if not nil, is called to report debugger events. It is
+// called from interpreted goroutines, which are blocked until it returns.
+func (interp *Interpreter) Debug(options DebugOptions, events func(*DebugEvent)) *Debugger {
+	if events == nil {
+		events = func(*DebugEvent) {}
+	}
+	d := &Debugger{
+		interp:      interp,
+		events:      events,
+		breakpoints: map[string]map[int]bool{},
+		files:       map[string]string{},
+		routines:    map[int]*debugRoutine{},
+		stopped:     make(chan struct{}),
+	}
+	r := d.newRoutine()
+	r.entry = options.StopOnEntry
+	interp.frame.debug = &frameDebug{routine: r}
+	interp.debugger = d
+	return d
+}
+
+// SetBreakpoints sets the breakpoints of a source file at lines,
+// replacing the previous ones.
+func (d *Debugger) SetBreakpoints(file string, lines []int) {
+	b := map[int]bool{}
+	for _, l := range lines {
+		b[l] = true
+	}
+	d.mutex.Lock()
+	d.breakpoints[absPath(file)] = b
+	d.mutex.Unlock()
+}
+
+// SetBreakpoint adds a breakpoint on a line of a source file.
+func (d *Debugger) SetBreakpoint(file string, line int) {
+	file = absPath(file)
+	d.mutex.Lock()
+	if d.breakpoints[file] == nil {
+		d.breakpoints[file] = map[int]bool{}
+	}
+	d.breakpoints[file][line] = true
+	d.mutex.Unlock()
+}
+
+// ClearBreakpoint removes the breakpoint of a line of a source file, if any.
+func (d *Debugger) ClearBreakpoint(file string, line int) {
+	d.mutex.Lock()
+	delete(d.breakpoints[absPath(file)], line)
+	d.mutex.Unlock()
+}
+
+// Routines returns the ids of running goroutines, in increasing order.
+func (d *Debugger) Routines() []int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	ids := make([]int, 0, len(d.routines))
+	for id := range d.routines {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// Stopped returns the ids of stopped goroutines, in increasing order.
+func (d *Debugger) Stopped() []int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.stoppedIDs()
+}
+
+// Wait waits until a goroutine is stopped, and returns its id, the lowest
+// one if several goroutines are stopped. It returns an error if ctx is done
+// before, for example if the program terminates without stopping.
+func (d *Debugger) Wait(ctx context.Context) (int, error) {
+	for {
+		d.mutex.Lock()
+		ids, stopped := d.stoppedIDs(), d.stopped
+		d.mutex.Unlock()
+		if len(ids) > 0 {
+			return ids[0], nil
+		}
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+func (d *Debugger) stoppedIDs() []int {
+	var ids []int
+	for id, r := range d.routines {
+		if r.frame != nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// Continue resumes a stopped goroutine.
+func (d *Debugger) Continue(id int) error { return d.resume(id, stepNone) }
+
+// Next resumes a stopped goroutine until the next statement, stepping over calls.
+func (d *Debugger) Next(id int) error { return d.resume(id, stepOver) }
+
+// StepIn resumes a stopped goroutine until the next statement, stepping into calls.
+func (d *Debugger) StepIn(id int) error { return d.resume(id, stepIn) }
+
+// StepOut resumes a stopped goroutine until the return of the current function.
+func (d *Debugger) StepOut(id int) error { return d.resume(id, stepOut) }
+
+// Pause requests a running goroutine to stop at its next statement.
+func (d *Debugger) Pause(id int) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	r, ok := d.routines[id]
+	if !ok {
+		return fmt.Errorf("unknown goroutine %d", id)
+	}
+	r.pause = true
+	return nil
+}
+
+// Frames returns the call stack of a stopped goroutine, innermost frame first.
+func (d *Debugger) Frames(id int) ([]*DebugFrame, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	r, ok := d.routines[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown goroutine %d", id)
+	}
+	if r.frame == nil {
+		return nil, fmt.Errorf("goroutine %d is not stopped", id)
+	}
+	var frames []*DebugFrame
+	for f := r.frame; f != nil && f.debug != nil; f = f.debug.caller {
+		if f.debug.node == nil {
+			continue
+		}
+		frames = append(frames, &DebugFrame{d: d, f: f, node: f.debug.node, def: f.debug.def})
+	}
+	return frames, nil
+}
+
+// Close detaches the debugger: all breakpoints are cleared and stopped
+// goroutines are resumed.
+func (d *Debugger) Close() {
+	d.mutex.Lock()
+	d.closed = true
+	var stopped []*debugRoutine
+	for _, r := range d.routines {
+		if r.frame != nil {
+			r.frame = nil
+			stopped = append(stopped, r)
+		}
+	}
+	d.mutex.Unlock()
+	for _, r := range stopped {
+		r.resume <- struct{}{}
+	}
+}
+
+func (d *Debugger) resume(id int, mode stepMode) error {
+	d.mutex.Lock()
+	r, ok := d.routines[id]
+	if !ok {
+		d.mutex.Unlock()
+		return fmt.Errorf("unknown goroutine %d", id)
+	}
+	if r.frame == nil {
+		d.mutex.Unlock()
+		return fmt.Errorf("goroutine %d is not stopped", id)
+	}
+	r.mode, r.depth, r.frame = mode, r.frame.debug.depth, nil
+	d.mutex.Unlock()
+	r.resume <- struct{}{}
+	return nil
+}
+
+func (d *Debugger) newRoutine() *debugRoutine {
+	d.mutex.Lock()
+	d.lastID++
+	r := &debugRoutine{id: d.lastID, resume: make(chan struct{}, 1)}
+	d.routines[r.id] = r
+	d.mutex.Unlock()
+	return r
+}
+
+// enter sets the debugging state of frame f, called from frame caller, or
+// started in a new goroutine.
+func (d *Debugger) enter(f, caller *frame, def *node, goroutine bool) {
+	var cd *frameDebug
+	if caller != nil {
+		cd = caller.debug
+	}
+	if cd == nil || goroutine {
+		r := d.newRoutine()
+		f.debug = &frameDebug{routine: r, def: def, entry: true}
+		d.events(&DebugEvent{Reason: DebugGoroutineStart, Routine: r.id})
+		return
+	}
+	f.debug = &frameDebug{routine: cd.routine, caller: caller, def: def, depth: cd.depth + 1}
+}
+
+// exit removes routine r once completed.
+func (d *Debugger) exit(r *debugRoutine) {
+	d.mutex.Lock()
+	delete(d.routines, r.id)
+	d.mutex.Unlock()
+	d.events(&DebugEvent{Reason: DebugGoroutineExit, Routine: r.id})
+}
+
+// wrap instruments the exec function of node n, to track execution and
+// stop at statements.
+func (d *Debugger) wrap(n *node) {
+	exec := n.exec
+	if exec == nil {
+		return
+	}
+	if !isStopPoint(n) {
+		n.exec = func(f *frame) bltn {
+			if f.debug != nil {
+				f.debug.node = n
+			}
+			return exec(f)
+		}
+		return
+	}
+	pos := n.interp.fset.Position(n.pos)
+	file := d.absPath(pos.Filename)
+	n.exec = func(f *frame) bltn {
+		if f.debug != nil {
+			f.debug.node = n
+			d.stop(f, file, pos.Line)
+		}
+		return exec(f)
+	}
+}
+
+// stop suspends the goroutine of frame f if a stop condition is met at
+// the given file and line.
+func (d *Debugger) stop(f *frame, file string, line int) {
+	fd := f.debug
+	r := fd.routine
+	var reason DebugEventReason
+
+	d.mutex.Lock()
+	switch {
+	case d.closed:
+	case r.pause:
+		reason = DebugPause
+	case r.entry:
+		reason = DebugEntry
+	case d.breakpoints[file][line]:
+		reason = DebugBreakpoint
+	case r.mode == stepIn,
+		r.mode == stepOver && fd.depth <= r.depth,
+		r.mode == stepOut && fd.depth < r.depth:
+		reason = DebugStep
+	}
+	if reason == 0 {
+		d.mutex.Unlock()
+		return
+	}
+	r.pause, r.entry, r.mode, r.frame = false, false, stepNone, f
+	close(d.stopped)
+	d.stopped = make(chan struct{})
+	d.mutex.Unlock()
+
+	d.events(&DebugEvent{Reason: reason, Routine: r.id})
+
+	// Wait for resume, unless execution is canceled
+	cases := []reflect.SelectCase{{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(r.resume)}, f.done}
+	if chosen, _, _ := reflect.Select(cases); chosen != 0 {
+		d.mutex.Lock()
+		r.frame = nil
+		select {
+		case <-r.resume:
+		default:
+		}
+		d.mutex.Unlock()
+	}
+}
+
+// absPath returns the absolute file name of a source file.
+func (d *Debugger) absPath(name string) string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if p, ok := d.files[name]; ok {
+		return p
+	}
+	p := absPath(name)
+	d.files[name] = p
+	return p
+}
+
+func absPath(name string) string {
+	if p, err := filepath.Abs(name); err == nil {
+		return p
+	}
+	return filepath.Clean(name)
+}
+
+// isStopPoint returns true if execution of node n starts a statement, or
+// the condition of a loop.
+func isStopPoint(n *node) bool { return stopStmt(n) != nil }
+
+// stopStmt returns the statement starting at node n, or nil if n is not a
+// stop point.
+func stopStmt(n *node) *node {
+	for s := n; s.anc != nil; s = s.anc {
+		var stmt bool
+		switch a := s.anc; a.kind {
+		case blockStmt, caseBody, commClause, commClauseDefault:
+			stmt = true
+		case forStmt1, forStmt3:
+			stmt = s == a.child[0]
+		case forStmt2, forStmt4:
+			stmt = s == a.child[1]
+		case funcDecl, funcLit:
+			return nil
+		}
+		if stmt {
+			if s.start != n {
+				return nil
+			}
+			return s
+		}
+	}
+	return nil
+}
+
+// Name returns the name of the function of the frame.
+func (df *DebugFrame) Name() string {
+	switch {
+	case df.def == nil:
+		return "global"
+	case df.def.kind == funcLit:
+		return "func literal"
+	case isMethod(df.def):
+		r := df.def.child[0].child[0].lastChild()
+		if r.kind == starExpr {
+			return "(*" + r.child[0].ident + ")." + df.def.child[1].ident
+		}
+		return r.ident + "." + df.def.child[1].ident
+	}
+	return df.def.child[1].ident
+}
+
+// Position returns the source position of the statement being executed.
+func (df *DebugFrame) Position() token.Position { return df.d.interp.fset.Position(df.node.pos) }
+
+// Locals returns the variables of the frame function in scope at the
+// current position, including the variables captured by a closure.
+func (df *DebugFrame) Locals() []*DebugVariable {
+	var vars []*DebugVariable
+	seen := map[string]bool{}
+	f, level := df.f, -1
+	for n := df.node; n != nil; n = n.anc {
+		sc := n.scope
+		if sc == nil {
+			continue
+		}
+		if sc.global {
+			break
+		}
+		if level < 0 {
+			level = sc.level
+		}
+		for ; level > sc.level && f != nil; level-- {
+			f = f.anc
+		}
+		vars = append(vars, df.d.variables(sc, f, seen)...)
+		if n.kind == funcDecl {
+			break
+		}
+	}
+	return vars
+}
+
+// Globals returns the global variables of the package of the frame.
+func (df *DebugFrame) Globals() []*DebugVariable {
+	root := df.node
+	for root.anc != nil {
+		root = root.anc
+	}
+	sc, _ := df.d.interp.initScopePkg(root)
+	return df.d.variables(sc, df.d.interp.frame, map[string]bool{})
+}
+
+// variables returns the variables of scope sc stored in frame f, in name
+// order, excluding names already seen.
+func (d *Debugger) variables(sc *scope, f *frame, seen map[string]bool) []*DebugVariable {
+	var names []string
+	for name, sym := range sc.sym {
+		if sym.kind == varSym && sym.index >= 0 && sym.index < len(f.data) && !seen[name] && name != "_" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	vars := make([]*DebugVariable, len(names))
+	for i, name := range names {
+		seen[name] = true
+		vars[i] = &DebugVariable{Name: name, Value: d.value(f.data[sc.sym[name].index])}
+	}
+	return vars
+}
+
+// value returns v, with interpreted functions converted to runtime functions.
+func (d *Debugger) value(v reflect.Value) reflect.Value {
+	if v.IsValid() && v.CanInterface() {
+		if n, ok := v.Interface().(*node); ok && n != nil {
+			return genFunctionWrapper(n)(d.interp.frame)
+		}
+	}
+	return v
+}
+
+// Eval evaluates an expression in the scope of the frame. Supported
+// expressions are made of variables, literals, field selectors, index and
+// pointer indirections, unary and binary operators, and len or cap calls.
+func (df *DebugFrame) Eval(expr string) (reflect.Value, error) {
+	e, err := parser.ParseExpr(expr)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	vars := map[string]reflect.Value{}
+	for _, v := range append(df.Locals(), df.Globals()...) {
+		if _, ok := vars[v.Name]; !ok {
+			vars[v.Name] = v.Value
+		}
+	}
+	v, err := evalExpr(e, vars)
+	return v.value, err
+}
+
+// debugValue is the result of an expression evaluated by a debugger.
+type debugValue struct {
+	value   reflect.Value
+	untyped bool // value comes from a literal, its type is a default one
+}
+
+var errDebugExpr = errors.New("unsupported expression")
+
+func evalExpr(e ast.Expr, vars map[string]reflect.Value) (debugValue, error) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		c := constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		switch e.Kind {
+		case token.INT:
+			return debugValue{constValue(c, reflect.TypeOf(0)), true}, nil
+		case token.FLOAT:
+			return debugValue{constValue(c, reflect.TypeOf(0.0)), true}, nil
+		case token.CHAR:
+			return debugValue{constValue(c, reflect.TypeOf('a')), true}, nil
+		case token.STRING:
+			return debugValue{constValue(c, reflect.TypeOf("")), true}, nil
+		}
+
+	case *ast.Ident:
+		if v, ok := vars[e.Name]; ok {
+			return debugValue{value: v}, nil
+		}
+		switch e.Name {
+		case "true", "false":
+			return debugValue{reflect.ValueOf(e.Name == "true"), true}, nil
+		case "nil":
+			return debugValue{untyped: true}, nil
+		}
+		return debugValue{}, fmt.Errorf("undefined: %s", e.Name)
+
+	case *ast.ParenExpr:
+		return evalExpr(e.X, vars)
+
+	case *ast.StarExpr:
+		x, err := evalExpr(e.X, vars)
+		if err != nil {
+			return x, err
+		}
+		v := indirect(x.value)
+		if v.Kind() != reflect.Ptr {
+			return debugValue{}, fmt.Errorf("invalid indirect of %s", typeName(v))
+		}
+		if v.IsNil() {
+			return debugValue{}, errors.New("nil pointer dereference")
+		}
+		return debugValue{value: v.Elem()}, nil
+
+	case *ast.SelectorExpr:
+		x, err := evalExpr(e.X, vars)
+		if err != nil {
+			return x, err
+		}
+		v := indirect(x.value)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = indirect(v.Elem())
+		}
+		if v.Kind() != reflect.Struct {
+			return debugValue{}, fmt.Errorf("%s has no field %s", typeName(v), e.Sel.Name)
+		}
+		f := v.FieldByName(e.Sel.Name)
+		if !f.IsValid() {
+			return debugValue{}, fmt.Errorf("%s has no field %s", typeName(v), e.Sel.Name)
+		}
+		return debugValue{value: f}, nil
+
+	case *ast.IndexExpr:
+		x, err := evalExpr(e.X, vars)
+		if err != nil {
+			return x, err
+		}
+		k, err := evalExpr(e.Index, vars)
+		if err != nil {
+			return k, err
+		}
+		v := indirect(x.value)
+		switch v.Kind() {
+		case reflect.Map:
+			key, err := convertTo(k, v.Type().Key())
+			if err != nil {
+				return debugValue{}, err
+			}
+			r := v.MapIndex(key)
+			if !r.IsValid() {
+				r = reflect.Zero(v.Type().Elem())
+			}
+			return debugValue{value: r}, nil
+		case reflect.Array, reflect.Slice, reflect.String:
+			i, err := convertTo(k, reflect.TypeOf(0))
+			if err != nil {
+				return debugValue{}, err
+			}
+			if j := int(i.Int()); j < 0 || j >= v.Len() {
+				return debugValue{}, fmt.Errorf("index out of range [%d] with length %d", j, v.Len())
+			}
+			return debugValue{value: v.Index(int(i.Int()))}, nil
+		}
+		return debugValue{}, fmt.Errorf("cannot index %s", typeName(v))
+
+	case *ast.CallExpr:
+		f, ok := e.Fun.(*ast.Ident)
+		if !ok || (f.Name != "len" && f.Name != "cap") || len(e.Args) != 1 {
+			break
+		}
+		x, err := evalExpr(e.Args[0], vars)
+		if err != nil {
+			return x, err
+		}
+		v := indirect(x.value)
+		switch {
+		case f.Name == "len" && (v.Kind() == reflect.Map || v.Kind() == reflect.String),
+			v.Kind() == reflect.Array, v.Kind() == reflect.Chan, v.Kind() == reflect.Slice:
+			if f.Name == "len" {
+				return debugValue{value: reflect.ValueOf(v.Len())}, nil
+			}
+			return debugValue{value: reflect.ValueOf(v.Cap())}, nil
+		}
+		return debugValue{}, fmt.Errorf("invalid argument %s for %s", typeName(v), f.Name)
+
+	case *ast.UnaryExpr:
+		x, err := evalExpr(e.X, vars)
+		if err != nil {
+			return x, err
+		}
+		c, ok := valueConst(indirect(x.value))
+		if !ok {
+			return debugValue{}, fmt.Errorf("invalid operation: %s %s", e.Op, typeName(x.value))
+		}
+		t := indirect(x.value).Type()
+		return debugValue{constValue(constant.UnaryOp(e.Op, c, 0), t), x.untyped}, nil
+
+	case *ast.BinaryExpr:
+		x, err := evalExpr(e.X, vars)
+		if err != nil {
+			return x, err
+		}
+		y, err := evalExpr(e.Y, vars)
+		if err != nil {
+			return y, err
+		}
+		return binaryOp(e.Op, x, y)
+	}
+	return debugValue{}, errDebugExpr
+}
+
+func binaryOp(op token.Token, x, y debugValue) (debugValue, error) {
+	// Convert untyped operand to the type of the other one
+	if x.untyped && !y.untyped && y.value.IsValid() {
+		if v, err := convertTo(x, indirect(y.value).Type()); err == nil {
+			x = debugValue{value: v}
+		}
+	} else if y.untyped && !x.untyped && x.value.IsValid() {
+		if v, err := convertTo(y, indirect(x.value).Type()); err == nil {
+			y = debugValue{value: v}
+		}
+	}
+
+	if !x.value.IsValid() || !y.value.IsValid() {
+		// Comparison to nil
+		v := x.value
+		if !v.IsValid() {
+			v = y.value
+		}
+		if v = indirect(v); (op == token.EQL || op == token.NEQ) && isNillable(v) {
+			return debugValue{value: reflect.ValueOf(v.IsNil() == (op == token.EQL))}, nil
+		}
+		return debugValue{}, fmt.Errorf("invalid operation: mismatched nil comparison")
+	}
+
+	xc, ok1 := valueConst(indirect(x.value))
+	yc, ok2 := valueConst(indirect(y.value))
+	if !ok1 || !ok2 || xc.Kind() != yc.Kind() && !isNumericConst(xc, yc) {
+		return debugValue{}, fmt.Errorf("invalid operation: %s %s %s", typeName(x.value), op, typeName(y.value))
+	}
+
+	t := indirect(x.value).Type()
+	isInt := t.Kind() >= reflect.Int && t.Kind() <= reflect.Uintptr
+	if !isValidOp(op, xc.Kind(), isInt) {
+		return debugValue{}, fmt.Errorf("invalid operation: operator %s not defined on %s", op, typeName(x.value))
+	}
+	switch op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return debugValue{value: reflect.ValueOf(constant.Compare(xc, op, yc))}, nil
+	case token.LAND, token.LOR:
+		b := constant.BoolVal(xc)
+		if op == token.LAND {
+			b = b && constant.BoolVal(yc)
+		} else {
+			b = b || constant.BoolVal(yc)
+		}
+		return debugValue{reflect.ValueOf(b), x.untyped && y.untyped}, nil
+	case token.SHL, token.SHR:
+		s, ok := constant.Uint64Val(constant.ToInt(yc))
+		if !ok {
+			return debugValue{}, fmt.Errorf("invalid shift count %s", yc)
+		}
+		return debugValue{constValue(constant.Shift(xc, op, uint(s)), t), x.untyped}, nil
+	case token.QUO, token.REM:
+		if constant.Sign(yc) == 0 {
+			return debugValue{}, errors.New("division by zero")
+		}
+		if op == token.QUO && isInt {
+			// Force integer division
+			op = token.QUO_ASSIGN
+		}
+	}
+	return debugValue{constValue(constant.BinaryOp(xc, op, yc), t), x.untyped && y.untyped}, nil
+}
+
+// isValidOp returns true if binary operator op applies to operands of kind k.
+func isValidOp(op token.Token, k constant.Kind, isInt bool) bool {
+	switch op {
+	case token.EQL, token.NEQ:
+		return true
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return k != constant.Bool
+	case token.LAND, token.LOR:
+		return k == constant.Bool
+	case token.ADD:
+		return k != constant.Bool
+	case token.SUB, token.MUL, token.QUO:
+		return k == constant.Int || k == constant.Float
+	}
+	return isInt
+}
+
+// indirect returns the concrete value of v if v is an interface.
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+func isNillable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
+		return true
+	}
+	return false
+}
+
+func isNumericConst(x, y constant.Value) bool {
+	return (x.Kind() == constant.Int || x.Kind() == constant.Float) && (y.Kind() == constant.Int || y.Kind() == constant.Float)
+}
+
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	return v.Type().String()
+}
+
+// convertTo returns the value of x converted to type t.
+func convertTo(x debugValue, t reflect.Type) (reflect.Value, error) {
+	v := indirect(x.value)
+	if !v.IsValid() {
+		return reflect.Zero(t), nil
+	}
+	if v.Type().AssignableTo(t) {
+		return v, nil
+	}
+	if x.untyped {
+		if c, ok := valueConst(v); ok {
+			if r := constValue(c, t); r.IsValid() {
+				return r, nil
+			}
+		}
+	}
+	if v.Type().ConvertibleTo(t) && v.Kind() != reflect.String {
+		return v.Convert(t), nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot use %s as %s", typeName(v), t)
+}
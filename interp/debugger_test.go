@@ -0,0 +1,239 @@
+package interp_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+const debugSrc = `package main
+
+import "fmt"
+
+type point struct{ X, Y int }
+
+func add(a, b int) int {
+	c := a + b
+	return c
+}
+
+func main() {
+	p := point{1, 2}
+	s := add(p.X, p.Y)
+	for i := 0; i < 2; i++ {
+		s += i
+	}
+	c := make(chan bool)
+	go func() {
+		s++
+		c <- true
+	}()
+	<-c
+	fmt.Println(s)
+}
+`
+
+type debugSession struct {
+	t      *testing.T
+	d      *interp.Debugger
+	events chan *interp.DebugEvent
+	done   chan error
+}
+
+func newDebugSession(t *testing.T, options interp.DebugOptions, breakpoints ...int) *debugSession {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Name = "debug.go"
+	s := &debugSession{t: t, events: make(chan *interp.DebugEvent, 10), done: make(chan error, 1)}
+	s.d = i.Debug(options, func(e *interp.DebugEvent) { s.events <- e })
+	s.d.SetBreakpoints("debug.go", breakpoints)
+	go func() {
+		_, err := i.Eval(debugSrc)
+		s.done <- err
+	}()
+	return s
+}
+
+// wait waits for a stop event, and checks its reason and line.
+func (s *debugSession) wait(reason interp.DebugEventReason, line int) []*interp.DebugFrame {
+	s.t.Helper()
+	for {
+		select {
+		case e := <-s.events:
+			if e.Reason == interp.DebugGoroutineStart || e.Reason == interp.DebugGoroutineExit {
+				continue
+			}
+			if e.Reason != reason {
+				s.t.Fatalf("got event %v, want %v", e.Reason, reason)
+			}
+			frames, err := s.d.Frames(e.Routine)
+			if err != nil {
+				s.t.Fatal(err)
+			}
+			if l := frames[0].Position().Line; l != line {
+				s.t.Fatalf("stopped at line %d, want %d", l, line)
+			}
+			return frames
+		case err := <-s.done:
+			s.t.Fatalf("program terminated before stop (err: %v)", err)
+		case <-time.After(5 * time.Second):
+			s.t.Fatal("timeout waiting for debugger event")
+		}
+	}
+}
+
+func (s *debugSession) eval(f *interp.DebugFrame, expr, want string) {
+	s.t.Helper()
+	v, err := f.Eval(expr)
+	if err != nil {
+		s.t.Fatalf("%s: %v", expr, err)
+	}
+	if got := fmt.Sprint(v); got != want {
+		s.t.Fatalf("%s: got %s, want %s", expr, got, want)
+	}
+}
+
+func (s *debugSession) end() {
+	s.t.Helper()
+	select {
+	case err := <-s.done:
+		if err != nil {
+			s.t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		s.t.Fatal("timeout waiting for program termination")
+	}
+}
+
+func TestDebuggerBreakpoint(t *testing.T) {
+	s := newDebugSession(t, interp.DebugOptions{}, 8)
+	frames := s.wait(interp.DebugBreakpoint, 8)
+	if len(frames) != 2 || frames[0].Name() != "add" || frames[1].Name() != "main" {
+		t.Fatalf("unexpected frames %v", frames)
+	}
+	if l := frames[1].Position().Line; l != 14 {
+		t.Fatalf("caller at line %d, want 14", l)
+	}
+	locals := frames[0].Locals()
+	if len(locals) != 3 || locals[0].Name != "c" || locals[1].Name != "a" || locals[2].Name != "b" {
+		t.Fatalf("unexpected locals %v", locals)
+	}
+	s.eval(frames[0], "a + b*10", "21")
+	s.eval(frames[0], "a == 1 && b > a", "true")
+	s.eval(frames[1], "p.Y", "2")
+	if _, err := frames[0].Eval("p"); err == nil {
+		t.Fatal("expected an error for an undefined variable")
+	}
+
+	routine := s.d.Routines()[0]
+	if err := s.d.Next(routine); err != nil {
+		t.Fatal(err)
+	}
+	frames = s.wait(interp.DebugStep, 9)
+	s.eval(frames[0], "c", "3")
+
+	s.d.SetBreakpoints("debug.go", []int{16})
+	if err := s.d.StepOut(routine); err != nil {
+		t.Fatal(err)
+	}
+	frames = s.wait(interp.DebugStep, 15)
+	s.eval(frames[0], "s", "3")
+
+	for n := 0; n < 2; n++ {
+		if err := s.d.Continue(routine); err != nil {
+			t.Fatal(err)
+		}
+		frames = s.wait(interp.DebugBreakpoint, 16)
+		s.eval(frames[0], "i", fmt.Sprint(n))
+	}
+	s.d.SetBreakpoints("debug.go", nil)
+	if err := s.d.Continue(routine); err != nil {
+		t.Fatal(err)
+	}
+	s.end()
+}
+
+func TestDebuggerStepIn(t *testing.T) {
+	s := newDebugSession(t, interp.DebugOptions{StopOnEntry: true})
+	s.wait(interp.DebugEntry, 13)
+	routine := s.d.Routines()[0]
+	for _, line := range []int{14, 8} {
+		if err := s.d.StepIn(routine); err != nil {
+			t.Fatal(err)
+		}
+		s.wait(interp.DebugStep, line)
+	}
+	if err := s.d.Continue(routine + 1); err == nil {
+		t.Fatal("expected an error for an unknown goroutine")
+	}
+	s.d.Close()
+	s.end()
+}
+
+func TestDebuggerGoroutine(t *testing.T) {
+	s := newDebugSession(t, interp.DebugOptions{}, 20)
+	frames := s.wait(interp.DebugBreakpoint, 20)
+	if len(frames) != 1 || frames[0].Name() != "func literal" {
+		t.Fatalf("unexpected frames %v", frames)
+	}
+	s.eval(frames[0], "s", "4")
+	if ids := s.d.Routines(); len(ids) != 2 {
+		t.Fatalf("got goroutines %v, want 2", ids)
+	}
+	s.d.Close()
+	s.end()
+}
+
+func TestDebuggerWait(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Name = "debug.go"
+	d := i.Debug(interp.DebugOptions{}, nil)
+	d.SetBreakpoint("debug.go", 8)
+	d.SetBreakpoint("debug.go", 16)
+	done := make(chan error, 1)
+	go func() {
+		_, err := i.Eval(debugSrc)
+		done <- err
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	id, err := d.Wait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := d.Stopped(); len(ids) != 1 || ids[0] != id {
+		t.Fatalf("got stopped goroutines %v, want [%d]", ids, id)
+	}
+	frames, err := d.Frames(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := frames[0].Position().Line; l != 8 {
+		t.Fatalf("stopped at line %d, want 8", l)
+	}
+	if locals := frames[0].Locals(); len(locals) != 3 || fmt.Sprint(locals[1].Value) != "1" {
+		t.Fatalf("unexpected locals %v", locals)
+	}
+
+	d.ClearBreakpoint("debug.go", 16)
+	if err := d.Continue(id); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-ctx.Done():
+		t.Fatal("timeout waiting for program termination")
+	}
+	if ids := d.Stopped(); len(ids) != 0 {
+		t.Fatalf("got stopped goroutines %v", ids)
+	}
+}
@@ -0,0 +1,411 @@
+package interp
+
+import (
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SourceDump returns a standalone main package reproducing the session: the
+// sources evaluated so far, in order, merged in a single Go program.
+//
+// Declarations are kept at package level, the last declaration of a name
+// replacing the previous ones. Statements are moved in the main function, in
+// their order of evaluation, and the body of the main function of a source
+// file is inlined there as a block. As the variables of the session are
+// global, the variables defined by statements or declared by a line of input
+// are declared at package level, with their current type, and assigned in
+// the main function. Expressions evaluated only to display their value are
+// dropped. Only the imports used by the program are kept.
+//
+// An error is returned, with the unformatted program, if the result is not
+// valid Go.
+func (interp *Interpreter) SourceDump() (string, error) {
+	if err := interp.lock(); err != nil {
+		return "", err
+	}
+	defer interp.unlock()
+	d := dump{interp: interp, vars: map[string]bool{}}
+	for _, s := range interp.sources {
+		d.add(s.Src)
+	}
+	return d.program()
+}
+
+// A dumpDecl is a package level declaration of a session dump.
+type dumpDecl struct {
+	names []string // declared names, as "T.M" for a method
+	text  string
+}
+
+// dump is the program reconstructed from the sources of a session.
+type dump struct {
+	interp  *Interpreter
+	decls   []dumpDecl
+	vars    map[string]bool // variables declared at package level
+	varDecl []string        // declarations of the session variables, in order
+	body    []string        // statements of the main function
+	imports []string        // blank and dot imports
+}
+
+// add adds the declarations and statements of the source src to the dump.
+// Sources are parsed as the interpreter does, in a pseudo package or function
+// if they do not start with a package clause.
+func (d *dump) add(src string) {
+	if strings.HasPrefix(src, "#!") {
+		src = "//" + src[2:]
+	}
+	isFile, inFunc := false, false
+	switch leadToken(src) {
+	case token.PACKAGE:
+		isFile = true
+	case token.CONST, token.FUNC, token.IMPORT, token.TYPE, token.VAR:
+		src = "package main\n" + src
+	default:
+		inFunc = true
+		src = "package main; func main() {" + src + "}"
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return // evaluated sources were parsed without error
+	}
+	text := func(n ast.Node) string {
+		start := n.Pos()
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if n.Doc != nil {
+				start = n.Doc.Pos()
+			}
+		case *ast.GenDecl:
+			if n.Doc != nil {
+				start = n.Doc.Pos()
+			}
+		}
+		return src[fset.Position(start).Offset:fset.Position(n.End()).Offset]
+	}
+
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			switch {
+			case inFunc:
+				for _, stmt := range decl.Body.List {
+					d.addStmt(stmt, text)
+				}
+			case decl.Name.Name == "main" && decl.Recv == nil:
+				d.body = append(d.body, text(decl.Body))
+			default:
+				d.decls = append(d.decls, dumpDecl{[]string{declName(decl)}, text(decl)})
+			}
+
+		case *ast.GenDecl:
+			switch {
+			case decl.Tok == token.IMPORT:
+				for _, spec := range decl.Specs {
+					if s := spec.(*ast.ImportSpec); s.Name != nil && (s.Name.Name == "_" || s.Name.Name == ".") {
+						d.imports = append(d.imports, text(s))
+					}
+				}
+			case decl.Tok == token.VAR && !isFile:
+				d.addVars(decl, text)
+			default:
+				d.decls = append(d.decls, dumpDecl{specNames(decl), text(decl)})
+			}
+		}
+	}
+}
+
+// addStmt adds the statement stmt of a line of input to the main function.
+func (d *dump) addStmt(stmt ast.Stmt, text func(ast.Node) string) {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		if !d.isStmt(s.X) {
+			return // the value was only displayed
+		}
+
+	case *ast.AssignStmt:
+		if s.Tok != token.DEFINE {
+			break
+		}
+		var names []*ast.Ident
+		for _, e := range s.Lhs {
+			if id, ok := e.(*ast.Ident); ok {
+				names = append(names, id)
+			}
+		}
+		if d.declareVars(names, nil, text) {
+			t, i := text(s), s.TokPos-s.Pos()
+			d.body = append(d.body, t[:i]+"="+t[i+2:])
+			return
+		}
+
+	case *ast.DeclStmt:
+		if g := s.Decl.(*ast.GenDecl); g.Tok == token.VAR {
+			d.addVars(g, text)
+		} else {
+			d.decls = append(d.decls, dumpDecl{specNames(g), text(g)})
+		}
+		return
+	}
+	d.body = append(d.body, text(stmt))
+}
+
+// addVars declares at package level the variables of the declaration g, of
+// a line of input, and assigns their initial value in the main function.
+func (d *dump) addVars(g *ast.GenDecl, text func(ast.Node) string) {
+	for _, spec := range g.Specs {
+		s := spec.(*ast.ValueSpec)
+		if !d.declareVars(s.Names, s.Type, text) {
+			d.body = append(d.body, "var "+text(s))
+			continue
+		}
+		if len(s.Values) == 0 {
+			continue
+		}
+		lhs := make([]string, len(s.Names))
+		for i, id := range s.Names {
+			lhs[i] = id.Name
+		}
+		rhs := make([]string, len(s.Values))
+		for i, v := range s.Values {
+			rhs[i] = text(v)
+		}
+		d.body = append(d.body, strings.Join(lhs, ", ")+" = "+strings.Join(rhs, ", "))
+	}
+}
+
+// declareVars declares at package level the session variables names, of
+// type typ if not nil, or else of the type of the global variable. It returns
+// false if the type of a variable is unknown.
+func (d *dump) declareVars(names []*ast.Ident, typ ast.Expr, text func(ast.Node) string) bool {
+	var decls []string
+	sc := d.interp.scopes[mainID]
+	for _, id := range names {
+		if id.Name == "_" || d.vars[id.Name] {
+			continue
+		}
+		t := ""
+		if typ != nil {
+			t = text(typ)
+		} else if sc != nil {
+			if sym := sc.sym[id.Name]; sym != nil && sym.kind == varSym {
+				t = identType(sym.typ)
+			}
+		}
+		if t == "" {
+			return false
+		}
+		decls = append(decls, id.Name+" "+t)
+	}
+	for _, decl := range decls {
+		d.vars[decl[:strings.Index(decl, " ")]] = true
+	}
+	d.varDecl = append(d.varDecl, decls...)
+	return true
+}
+
+// isStmt returns true if the expression e is valid as a statement: a receive
+// operation, or a call of a function or of a builtin allowed in statement
+// context. Other expressions, and conversions, are not.
+func (d *dump) isStmt(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return d.isStmt(e.X)
+	case *ast.UnaryExpr:
+		return e.Op == token.ARROW
+	case *ast.CallExpr:
+		return !d.isType(e.Fun)
+	}
+	return false
+}
+
+// isType returns true if the expression e denotes a type, or a builtin function
+// not allowed in statement context.
+func (d *dump) isType(e ast.Expr) bool {
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return d.isType(e.X)
+	case *ast.Ident:
+		sc := d.interp.scopes[mainID]
+		if sc == nil {
+			sc = d.interp.universe
+		}
+		sym, _, ok := sc.lookup(e.Name)
+		if !ok {
+			return false
+		}
+		switch sym.kind {
+		case typeSym:
+			return true
+		case bltnSym:
+			switch e.Name {
+			case "clear", "close", "copy", "delete", "panic", "print", "println", "recover":
+				return false
+			}
+			return true
+		}
+	case *ast.SelectorExpr:
+		id, ok := e.X.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		if sc := d.interp.scopes[mainID]; sc != nil {
+			if sym := sc.sym[id.Name]; sym != nil && sym.kind == pkgSym {
+				if sym.typ.cat == binPkgT {
+					return isBinType(d.interp.binSymbols(sym.path)[e.Sel.Name])
+				}
+				if s := d.interp.scopes[sym.path]; s != nil && s.sym[e.Sel.Name] != nil {
+					return s.sym[e.Sel.Name].kind == typeSym
+				}
+			}
+		}
+	case *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.InterfaceType, *ast.StructType, *ast.StarExpr:
+		return true
+	}
+	return false
+}
+
+// program returns the formatted source of the dump.
+func (d *dump) program() (string, error) {
+	var b strings.Builder
+	b.WriteString("package main\n\n")
+	switch len(d.varDecl) {
+	case 0:
+	case 1:
+		b.WriteString("var " + d.varDecl[0] + "\n\n")
+	default:
+		b.WriteString("var (\n" + strings.Join(d.varDecl, "\n") + "\n)\n\n")
+	}
+	seen := map[string]bool{}
+	keep := make([]bool, len(d.decls))
+	for i := len(d.decls) - 1; i >= 0; i-- {
+		keep[i] = true
+		for _, name := range d.decls[i].names {
+			if seen[name] {
+				keep[i] = false
+			}
+		}
+		for _, name := range d.decls[i].names {
+			if name != "_" && name != "init" {
+				seen[name] = true
+			}
+		}
+	}
+	for i, decl := range d.decls {
+		if keep[i] {
+			b.WriteString(decl.text + "\n\n")
+		}
+	}
+	b.WriteString("func main() {\n")
+	for _, s := range d.body {
+		b.WriteString(s + "\n")
+	}
+	b.WriteString("}\n")
+
+	src := d.addImports(b.String())
+	out, err := format.Source([]byte(src))
+	if err != nil {
+		return src, err
+	}
+	return string(out), nil
+}
+
+// addImports returns the program src with the imports of the packages it
+// refers to, and the blank and dot imports of the session.
+func (d *dump) addImports(src string) string {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return src
+	}
+	pkgs := map[string]string{}
+	for _, s := range d.interp.mainSymbols(pkgSym) {
+		pkgs[s.name] = s.sym.path
+	}
+	used := map[string]bool{}
+	for _, id := range f.Unresolved {
+		used[id.Name] = true
+	}
+	imports := map[string]bool{}
+	for _, s := range d.imports {
+		imports[s] = true
+	}
+	for name, p := range pkgs {
+		if !used[name] {
+			continue
+		}
+		if path.Base(p) == name {
+			imports[strconv.Quote(p)] = true
+		} else {
+			imports[name+" "+strconv.Quote(p)] = true
+		}
+	}
+	if len(imports) == 0 {
+		return src
+	}
+	specs := make([]string, 0, len(imports))
+	for s := range imports {
+		specs = append(specs, s)
+	}
+	sort.Slice(specs, func(i, j int) bool { return importPath(specs[i]) < importPath(specs[j]) })
+	return "package main\n\nimport (\n" + strings.Join(specs, "\n") + "\n)\n" + strings.TrimPrefix(src, "package main\n")
+}
+
+// importPath returns the quoted path of the import specification s.
+func importPath(s string) string { return s[strings.Index(s, `"`):] }
+
+// declName returns the name of the function declaration f, as "T.M" for a
+// method of type T.
+func declName(f *ast.FuncDecl) string {
+	if f.Recv == nil || len(f.Recv.List) == 0 {
+		return f.Name.Name
+	}
+	t := f.Recv.List[0].Type
+	for {
+		switch e := t.(type) {
+		case *ast.StarExpr:
+			t = e.X
+			continue
+		case *ast.IndexExpr:
+			t = e.X
+			continue
+		case *ast.IndexListExpr:
+			t = e.X
+			continue
+		case *ast.Ident:
+			return e.Name + "." + f.Name.Name
+		}
+		return f.Name.Name
+	}
+}
+
+// specNames returns the names declared by the type, const or var declaration g.
+func specNames(g *ast.GenDecl) []string {
+	var names []string
+	for _, spec := range g.Specs {
+		switch s := spec.(type) {
+		case *ast.TypeSpec:
+			names = append(names, s.Name.Name)
+		case *ast.ValueSpec:
+			for _, id := range s.Names {
+				names = append(names, id.Name)
+			}
+		}
+	}
+	return names
+}
+
+// leadToken returns the first token of src.
+func leadToken(src string) token.Token {
+	var s scanner.Scanner
+	file := token.NewFileSet().AddFile("", -1, len(src))
+	s.Init(file, []byte(src), nil, 0)
+	_, tok, _ := s.Scan()
+	return tok
+}
@@ -0,0 +1,259 @@
+package interp
+
+import (
+	"crypto/sha256"
+	"embed"
+	"fmt"
+	"go/ast"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// embedDirective is the prefix of a go:embed directive comment.
+const embedDirective = "//go:embed"
+
+var embedFSType = reflect.TypeOf(embed.FS{})
+
+// embedPatterns returns the patterns of the go:embed directives in comment
+// group doc, or nil if there is none.
+func embedPatterns(doc *ast.CommentGroup) ([]string, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	var patterns []string
+	for _, c := range doc.List {
+		if c.Text == embedDirective {
+			return nil, fmt.Errorf("usage: %s pattern...", embedDirective)
+		}
+		if !strings.HasPrefix(c.Text, embedDirective+" ") && !strings.HasPrefix(c.Text, embedDirective+"\t") {
+			continue
+		}
+		args := strings.TrimSpace(c.Text[len(embedDirective):])
+		for args != "" {
+			var arg string
+			switch args[0] {
+			case '"', '`':
+				i := strings.IndexByte(args[1:], args[0])
+				if i < 0 {
+					return nil, fmt.Errorf("invalid quoted string in go:embed: %s", args)
+				}
+				s, err := strconv.Unquote(args[:i+2])
+				if err != nil {
+					return nil, fmt.Errorf("invalid quoted string in go:embed: %s", args[:i+2])
+				}
+				arg, args = s, args[i+2:]
+			default:
+				i := strings.IndexAny(args, " \t")
+				if i < 0 {
+					i = len(args)
+				}
+				arg, args = args[:i], args[i:]
+			}
+			patterns = append(patterns, arg)
+			args = strings.TrimLeft(args, " \t")
+		}
+	}
+	return patterns, nil
+}
+
+// embedValue returns the value of the var declared by n, of type t, with
+// the files matching patterns, relative to the directory of the file of n.
+func (interp *Interpreter) embedValue(n *node, t reflect.Type, patterns []string) (reflect.Value, error) {
+	isFS := t == embedFSType
+	if !isFS && t.Kind() != reflect.String && (t.Kind() != reflect.Slice || t.Elem().Kind() != reflect.Uint8) {
+		return reflect.Value{}, n.cfgErrorf("go:embed cannot apply to var of type %s", t)
+	}
+
+	dir := filepath.Dir(interp.fset.Position(n.pos).Filename)
+	files := map[string]string{} // embedded files content, indexed by slash separated name
+	for _, pattern := range patterns {
+		all := strings.HasPrefix(pattern, "all:")
+		glob := strings.TrimPrefix(pattern, "all:")
+		if _, err := path.Match(glob, ""); err != nil || glob == "." || !fs.ValidPath(glob) {
+			return reflect.Value{}, n.cfgErrorf("pattern %s: invalid pattern syntax", pattern)
+		}
+		matches := interp.embedGlob(dir, glob)
+		count := len(files)
+		for _, m := range matches {
+			if err := interp.embedFiles(dir, m, all, files); err != nil {
+				return reflect.Value{}, n.cfgErrorf("pattern %s: %v", pattern, err)
+			}
+		}
+		if len(matches) == 0 {
+			return reflect.Value{}, n.cfgErrorf("pattern %s: no matching files found", pattern)
+		}
+		if len(files) == count {
+			return reflect.Value{}, n.cfgErrorf("pattern %s: cannot embed directory %s: contains no embeddable files", pattern, matches[0])
+		}
+	}
+
+	if !isFS {
+		if len(files) != 1 || len(patterns) != 1 {
+			return reflect.Value{}, n.cfgErrorf("invalid go:embed: multiple files for type %s", t)
+		}
+		for _, data := range files {
+			if t.Kind() == reflect.String {
+				return reflect.ValueOf(data).Convert(t), nil
+			}
+			return reflect.ValueOf([]byte(data)).Convert(t), nil
+		}
+	}
+	v, err := embedFS(files)
+	if err != nil {
+		return reflect.Value{}, n.cfgErrorf("%v", err)
+	}
+	return v, nil
+}
+
+// embedGlob returns the slash separated names of the files and directories
+// in directory dir matching pattern glob.
+func (interp *Interpreter) embedGlob(dir, glob string) []string {
+	matches := []string{""}
+	for _, elem := range strings.Split(glob, "/") {
+		var next []string
+		for _, m := range matches {
+			entries, err := fs.ReadDir(interp.srcFS(), filepath.Join(dir, filepath.FromSlash(m)))
+			if err != nil {
+				continue
+			}
+			for _, e := range entries {
+				if ok, _ := path.Match(elem, e.Name()); ok {
+					next = append(next, path.Join(m, e.Name()))
+				}
+			}
+		}
+		matches = next
+	}
+	return matches
+}
+
+// embedFiles reads the file name of directory dir into files, or the files
+// of the tree of name if it is a directory, with the exception of files
+// starting with '.' or '_' unless all is set, and of other modules.
+func (interp *Interpreter) embedFiles(dir, name string, all bool, files map[string]string) error {
+	root := filepath.Join(dir, filepath.FromSlash(name))
+	return fs.WalkDir(interp.srcFS(), root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if p != root && !all && (strings.HasPrefix(d.Name(), ".") || strings.HasPrefix(d.Name(), "_")) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			if _, err := fs.Stat(interp.srcFS(), filepath.Join(p, "go.mod")); err == nil {
+				if p == root {
+					return fmt.Errorf("cannot embed directory %s: in different module", rel)
+				}
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return fmt.Errorf("cannot embed irregular file %s", rel)
+		}
+		b, err := fs.ReadFile(interp.srcFS(), p)
+		if err != nil {
+			return err
+		}
+		files[rel] = string(b)
+		return nil
+	})
+}
+
+// embedFS returns an embed.FS value holding files. The layout of embed.FS,
+// known by the Go compiler, is a pointer to a sorted list of files.
+func embedFS(files map[string]string) (reflect.Value, error) {
+	v := reflect.New(embedFSType).Elem()
+	if v.NumField() != 1 || v.Field(0).Kind() != reflect.Ptr || v.Field(0).Type().Elem().Kind() != reflect.Slice {
+		return reflect.Value{}, fmt.Errorf("go:embed: unsupported embed.FS layout")
+	}
+	ft := v.Field(0).Type().Elem().Elem()
+	if ft.Kind() != reflect.Struct || ft.NumField() != 3 || ft.Field(0).Type.Kind() != reflect.String ||
+		ft.Field(1).Type.Kind() != reflect.String || ft.Field(2).Type != reflect.TypeOf([16]byte{}) {
+		return reflect.Value{}, fmt.Errorf("go:embed: unsupported embed.FS layout")
+	}
+
+	// Directories are listed with a trailing slash, after their parent
+	names := map[string]bool{}
+	for name := range files {
+		names[name] = true
+		for d := path.Dir(name); d != "."; d = path.Dir(d) {
+			names[d+"/"] = true
+		}
+	}
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		di, ei := splitEmbedName(list[i])
+		dj, ej := splitEmbedName(list[j])
+		return di < dj || di == dj && ei < ej
+	})
+
+	l := reflect.MakeSlice(v.Field(0).Type().Elem(), len(list), len(list))
+	for i, name := range list {
+		f := l.Index(i)
+		setField(f.Field(0), reflect.ValueOf(name))
+		if data, ok := files[name]; ok {
+			var h [16]byte
+			sum := sha256.Sum256([]byte(data))
+			copy(h[:], sum[:])
+			setField(f.Field(1), reflect.ValueOf(data))
+			setField(f.Field(2), reflect.ValueOf(h))
+		}
+	}
+	p := reflect.New(l.Type())
+	p.Elem().Set(l)
+	setField(v.Field(0), p)
+	return v, nil
+}
+
+// splitEmbedName returns the directory and the element of an embed.FS file name.
+func splitEmbedName(name string) (string, string) {
+	name = strings.TrimSuffix(name, "/")
+	i := strings.LastIndexByte(name, '/')
+	if i < 0 {
+		return ".", name
+	}
+	return name[:i], name[i+1:]
+}
+
+// setField sets the unexported field f, which must be addressable, to v.
+func setField(f, v reflect.Value) {
+	reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem().Set(v)
+}
+
+// embedVar sets the var declared by n to its embedded value, in n.rval.
+func embedVar(n *node) {
+	next := getExec(n.tnext)
+	i := n.child[0].findex
+	typ := n.child[0].typ.TypeOf()
+	val := n.rval
+
+	n.exec = func(f *frame) bltn {
+		v := reflect.New(typ).Elem()
+		if typ.Kind() == reflect.Slice {
+			// Each evaluation gets its own copy of embedded bytes
+			v.Set(reflect.AppendSlice(reflect.MakeSlice(typ, 0, val.Len()), val))
+		} else {
+			v.Set(val)
+		}
+		f.data[i] = v
+		return next
+	}
+}
@@ -0,0 +1,95 @@
+package interp
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+)
+
+// environ is the environment of interpreted code, isolated from the one of
+// the process, as set by Options.Env. It is shared by the goroutines of
+// interpreted code.
+type environ struct {
+	mutex sync.Mutex
+	vars  map[string]string
+}
+
+func newEnviron(env []string) *environ {
+	e := &environ{}
+	e.reset(env)
+	return e
+}
+
+// reset sets the variables to the ones of env, in the form "key=value".
+// The last value of a duplicate key is retained, as by exec.Cmd.
+func (e *environ) reset(env []string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.vars = make(map[string]string, len(env))
+	for _, kv := range env {
+		if i := strings.IndexByte(kv, '='); i > 0 {
+			e.vars[kv[:i]] = kv[i+1:]
+		}
+	}
+}
+
+func (e *environ) lookupEnv(key string) (string, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	v, ok := e.vars[key]
+	return v, ok
+}
+
+func (e *environ) getenv(key string) string {
+	v, _ := e.lookupEnv(key)
+	return v
+}
+
+func (e *environ) setenv(key, value string) error {
+	if key == "" || strings.ContainsAny(key, "=\x00") || strings.ContainsRune(value, 0) {
+		return os.NewSyscallError("setenv", syscall.EINVAL)
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.vars[key] = value
+	return nil
+}
+
+func (e *environ) unsetenv(key string) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	delete(e.vars, key)
+	return nil
+}
+
+func (e *environ) clearenv() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.vars = map[string]string{}
+}
+
+// environ returns the variables in the form "key=value", sorted by key.
+func (e *environ) environ() []string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	env := make([]string, 0, len(e.vars))
+	for k, v := range e.vars {
+		env = append(env, k+"="+v)
+	}
+	sort.Strings(env)
+	return env
+}
+
+func (e *environ) expandEnv(s string) string { return os.Expand(s, e.getenv) }
+
+// Environ returns a copy of the environment of interpreted code, in the
+// form "key=value", including the changes made by interpreted code. It is
+// the environment of the process if Options.Env is nil.
+func (interp *Interpreter) Environ() []string {
+	if interp.env == nil {
+		return os.Environ()
+	}
+	return interp.env.environ()
+}
@@ -0,0 +1,330 @@
+package interp
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"reflect"
+	"strings"
+)
+
+// Phase is the evaluation phase in which an error occurred.
+type Phase int
+
+// Evaluation phases
+const (
+	ScanPhase  Phase = iota // lexical scanning of the source
+	ParsePhase              // parsing of the source
+	TypePhase               // type checking and compilation
+	RunPhase                // execution
+	VetPhase                // suspicious constructs, reported by Check
+)
+
+var phaseNames = [...]string{
+	ScanPhase:  "scan",
+	ParsePhase: "parse",
+	TypePhase:  "type",
+	RunPhase:   "run",
+	VetPhase:   "vet",
+}
+
+func (p Phase) String() string {
+	if p < 0 || int(p) >= len(phaseNames) {
+		return "Phase(" + fmt.Sprint(int(p)) + ")"
+	}
+	return phaseNames[p]
+}
+
+// An Error is an error returned by Eval, Compile or Execute, located in the
+// interpreted source. Errors which are not related to the source, such as
+// ErrMemoryLimit or the cancelation of a context, are returned as is.
+type Error struct {
+	Phase Phase          // evaluation phase
+	Pos   token.Position // position of the error, invalid if unknown
+	Msg   string         // message, without the position
+
+	// Source is the line of source at Pos, if known, and Notes the
+	// suggestions and the types involved in the error, such as
+	// "did you mean Println?", printed after the line by Excerpt.
+	Source string
+	Notes  []string
+
+	// For a runtime panic, Value is the value given to panic, and Stack
+	// the interpreted frames unwound by the panic, innermost first.
+	Value interface{}
+	Stack []StackFrame
+
+	err error // underlying error, or nil
+	col int   // column of Pos in Source
+}
+
+// A StackFrame is a frame of interpreted code, at the time of a panic.
+type StackFrame struct {
+	Function string         // qualified function name, such as main.(*T).m
+	Pos      token.Position // position of the panic or of the pending call
+}
+
+func (e *Error) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+	if e.Pos.IsValid() {
+		return e.Pos.String() + ": " + e.Msg
+	}
+	return e.Msg
+}
+
+// Unwrap returns the underlying error, such as the scanner.ErrorList of a
+// syntax error, or the error value given to panic.
+func (e *Error) Unwrap() error {
+	if e.err != nil {
+		return e.err
+	}
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Excerpt returns the source line of the error, indented by a tab, with a
+// caret under the column of the error, followed by its notes, one per line,
+// or an empty string if the error has neither source nor notes.
+func (e *Error) Excerpt() string {
+	var b strings.Builder
+	if e.Source != "" {
+		b.WriteString("\t" + e.Source + "\n\t")
+		for i, r := range e.Source {
+			if i >= e.col-1 {
+				break
+			}
+			if r != '\t' {
+				r = ' '
+			}
+			b.WriteRune(r)
+		}
+		b.WriteString("^\n")
+	}
+	for _, s := range e.Notes {
+		b.WriteString("\t" + s + "\n")
+	}
+	return b.String()
+}
+
+// StackTrace returns the stack of interpreted frames, formatted as a Go
+// goroutine trace, or an empty string if the error has no stack.
+func (e *Error) StackTrace() string { return formatStack(e.Stack) }
+
+// formatStack returns the frames of stack, one function name per line
+// followed by its indented position.
+func formatStack(stack []StackFrame) string {
+	var b strings.Builder
+	for _, f := range stack {
+		fmt.Fprintf(&b, "%s(...)\n\t%s\n", f.Function, f.Pos)
+	}
+	return b.String()
+}
+
+// An ErrorList is a list of errors located in the source, in the order they
+// are found. All the compilation errors of a source are returned by Eval and
+// Compile as an ErrorList if the AllErrors option is set.
+type ErrorList []*Error
+
+// Error returns the errors, one per line.
+func (l ErrorList) Error() string {
+	s := make([]string, len(l))
+	for i, e := range l {
+		s[i] = e.Error()
+	}
+	return strings.Join(s, "\n")
+}
+
+// append appends err to the list, flattening an ErrorList, and the
+// scanner.ErrorList of a syntax error in one error per syntax error.
+func (l ErrorList) append(err error) ErrorList {
+	if el, ok := err.(ErrorList); ok {
+		return append(l, el...)
+	}
+	e, ok := newError(TypePhase, err).(*Error)
+	if !ok {
+		return append(l, &Error{Phase: TypePhase, Msg: err.Error()})
+	}
+	if sl, ok := e.err.(scanner.ErrorList); ok {
+		for i, se := range sl {
+			l = append(l, &Error{Phase: e.Phase, Pos: se.Pos, Msg: se.Msg})
+			if i == 0 {
+				l[len(l)-1].Source, l[len(l)-1].col = e.Source, e.col
+			}
+		}
+		return l
+	}
+	return append(l, e)
+}
+
+// newError returns err as an *Error, in the given phase if not already
+// located. Errors not related to the source are returned unchanged.
+func newError(phase Phase, err error) error {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case *Error, ErrorList:
+		return e
+	case scanner.ErrorList:
+		if len(e) == 0 {
+			return err
+		}
+		return &Error{Phase: phase, Pos: e[0].Pos, Msg: e[0].Msg, err: e}
+	}
+	if err == ErrMemoryLimit || err == ErrStepLimit {
+		return err
+	}
+	return &Error{Phase: phase, Msg: err.Error()}
+}
+
+// syntaxError returns the error of parsing text, in the scan phase if text
+// is not lexically valid.
+func syntaxError(name string, text srcText, err error) error {
+	phase := ParsePhase
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	s.Init(fset.AddFile(name, fset.Base(), len(text.src)), []byte(text.src), func(token.Position, string) { phase = ScanPhase }, 0)
+	for {
+		if _, tok, _ := s.Scan(); tok == token.EOF {
+			break
+		}
+	}
+	err = newError(phase, err)
+	if e, ok := err.(*Error); ok {
+		e.Source, e.col = text.line(e.Pos)
+	}
+	return err
+}
+
+// A srcText is a parsed source text, kept to quote it in errors.
+type srcText struct {
+	src        string // parsed source
+	head, tail int    // length of the text added by Eval before and after the source
+}
+
+// line returns the line of the source at pos, without the text added by
+// Eval, and the column of pos in this line. It returns an empty line if
+// pos is not in the source.
+func (t srcText) line(pos token.Position) (string, int) {
+	if !pos.IsValid() || pos.Offset < t.head || pos.Offset > len(t.src)-t.tail {
+		return "", 0
+	}
+	src := t.src[:len(t.src)-t.tail]
+	start := strings.LastIndexByte(src[:pos.Offset], '\n') + 1
+	if start < t.head {
+		start = t.head
+	}
+	end := len(src)
+	if i := strings.IndexByte(src[pos.Offset:], '\n'); i >= 0 {
+		end = pos.Offset + i
+	}
+	return strings.TrimRight(src[start:end], " \t\r"), pos.Offset - start + 1
+}
+
+// quote sets the source line of e, located at pos.
+func (interp *Interpreter) quote(e *Error, pos token.Pos) {
+	if t, ok := interp.texts[interp.fset.File(pos)]; ok {
+		e.Source, e.col = t.line(e.Pos)
+	}
+}
+
+// note returns err with the note formatted according to format appended,
+// if err is an *Error.
+func note(err error, format string, a ...interface{}) error {
+	if e, ok := err.(*Error); ok {
+		e.Notes = append(e.Notes, fmt.Sprintf(format, a...))
+	}
+	return err
+}
+
+// A runtimeError is a run-time panic of interpreted code detected by the
+// interpreter, such as a negative shift count. As the run-time panics of
+// compiled code, it implements the runtime.Error interface.
+type runtimeError string
+
+func (e runtimeError) Error() string { return "runtime error: " + string(e) }
+
+// RuntimeError marks e as a runtime.Error.
+func (runtimeError) RuntimeError() {}
+
+// A typeAssertionError is the run-time panic of a failed type assertion. As
+// the one of compiled code, it implements the runtime.Error interface.
+type typeAssertionError string
+
+func (e typeAssertionError) Error() string { return "interface conversion: " + string(e) }
+
+// RuntimeError marks e as a runtime.Error.
+func (typeAssertionError) RuntimeError() {}
+
+// panicTrace is the value of a panic of interpreted code, recording the
+// interpreted frames it has unwound.
+type panicTrace struct {
+	value interface{}  // value of the panic
+	node  *node        // node executing in the frame being unwound, or nil
+	stack []StackFrame // unwound frames, innermost first
+	at    *node        // node of the panic, in the innermost frame
+}
+
+// String returns the panic value, followed by the interpreted frames unwound
+// so far. It is printed by the Go runtime if the panic escapes the
+// interpreter unrecovered, i.e. in an interpreted goroutine or in a function
+// called from binary code, and by binary code formatting a recovered value.
+func (t *panicTrace) String() string {
+	s := fmt.Sprint(t.panicValue())
+	if len(t.stack) > 0 {
+		s += "\n\ninterpreted stack:\n" + strings.TrimSuffix(formatStack(t.stack), "\n")
+	}
+	return s
+}
+
+// unwind records frame f, in which n is the first executed node, and
+// prepares the trace for the calling frame.
+func (t *panicTrace) unwind(n *node, f *frame) {
+	def := n
+	for def != nil && def.kind != funcDecl && def.kind != funcLit {
+		def = def.anc
+	}
+	pos := t.node
+	if pos == nil {
+		// The panic is raised by, or unwinds through, a binary function
+		pos = f.node
+	}
+	if pos == nil {
+		pos = n
+	}
+	if t.at == nil {
+		t.at = pos
+	}
+	t.stack = append(t.stack, StackFrame{Function: funcName(def, n), Pos: n.interp.fset.Position(pos.pos)})
+	t.node = f.caller
+}
+
+// panicValue returns the value given to panic, as seen by binary code.
+func (t *panicTrace) panicValue() interface{} {
+	v := t.value
+	if r, ok := v.(reflect.Value); ok {
+		v = nil
+		if r.IsValid() && r.CanInterface() {
+			v = r.Interface()
+		}
+	}
+	if vi, ok := v.(valueInterface); ok {
+		v = nil
+		if vi.value.IsValid() && vi.value.CanInterface() {
+			v = vi.value.Interface()
+		}
+	}
+	return v
+}
+
+// error returns the runtime error corresponding to the trace.
+func (t *panicTrace) error() *Error {
+	v := t.panicValue()
+	e := &Error{Phase: RunPhase, Msg: fmt.Sprintf("panic: %v", v), Value: v, Stack: t.stack}
+	if len(t.stack) > 0 {
+		e.Pos = t.stack[0].Pos
+		t.at.interp.quote(e, t.at.pos)
+	}
+	return e
+}
@@ -0,0 +1,121 @@
+package interp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// formatter is the value passed to binary code, such as fmt.Println, for a
+// value of an interpreted type with a String method, which its runtime type
+// lacks. It is formatted by the method with the verbs using it in fmt.
+type formatter struct {
+	value reflect.Value // interpreted value
+	str   func() string // String or Error method of value
+}
+
+// Format implements fmt.Formatter.
+func (f formatter) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('#') {
+			break
+		}
+		fallthrough
+	case 's', 'q', 'x', 'X':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), f.str())
+		return
+	}
+	fmt.Fprintf(s, fmt.FormatString(s, verb), f.value.Interface())
+}
+
+// errorValue is the value passed to binary code for a value of an
+// interpreted type implementing error. It keeps the interpreted value,
+// which errors.As may assign to a target of its type.
+type errorValue struct{ formatter }
+
+// Error implements error.
+func (e errorValue) Error() string { return e.str() }
+
+// formatMethod returns the name of the interpreted method of type t used to
+// format its values, Error or String, or an empty string if none.
+func formatMethod(t *itype) string {
+	for _, name := range []string{"Error", "String"} {
+		m, _ := t.lookupMethod(name)
+		if m == nil || m.typ == nil || len(m.typ.arg) != 0 || len(m.typ.ret) != 1 || m.typ.ret[0].TypeOf() != stringType {
+			continue
+		}
+		if ok, _ := t.inMethodSet(name, false); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+// genFormatValue returns the value of n passed to a variadic interface{}
+// parameter of a binary function, such as fmt.Println, which formats it by
+// its interpreted Error or String method, if any.
+func genFormatValue(n *node) func(*frame) reflect.Value {
+	if n.typ.cat == interfaceT {
+		// The dynamic type of an interpreted interface is only known at run time
+		value := genValue(n)
+		binValue := genValueInterfaceValue(n)
+		return func(f *frame) reflect.Value {
+			vi, ok := value(f).Interface().(valueInterface)
+			if !ok || vi.node == nil || !vi.value.IsValid() || formatMethod(vi.node.typ) == "" {
+				return binValue(f)
+			}
+			nod := &node{kind: rvalueExpr, rval: vi.value, typ: vi.node.typ, interp: n.interp}
+			return genFormatValue(nod)(f)
+		}
+	}
+	switch formatMethod(n.typ) {
+	case "Error":
+		return genInterfaceWrapper(n, errorType)
+	case "String":
+		value := genValue(n)
+		wrap := genInterfaceWrapper(n, stringerType)
+		return func(f *frame) reflect.Value {
+			w := wrap(f)
+			if w.Kind() == reflect.Interface {
+				// A nil interface value
+				return w
+			}
+			return reflect.ValueOf(formatter{value: value(f), str: w.Interface().(fmt.Stringer).String})
+		}
+	}
+	return genInterfaceWrapper(n, emptyInterfaceType)
+}
+
+// errorsAs replaces errors.As for interpreted code. The target may also be
+// a pointer to an interpreted type implementing error, set to the first
+// interpreted value of its type in the chain of err.
+func errorsAs(err error, target interface{}) bool {
+	t := reflect.TypeOf(target)
+	if t == nil || t.Kind() != reflect.Ptr || reflect.ValueOf(target).IsNil() || t.Elem().Kind() == reflect.Interface || t.Elem().Implements(errorType) {
+		return errors.As(err, target)
+	}
+	for err != nil {
+		if e, ok := err.(errorValue); ok && e.value.Type().AssignableTo(t.Elem()) {
+			reflect.ValueOf(target).Elem().Set(e.value)
+			return true
+		}
+		if x, ok := err.(interface{ As(interface{}) bool }); ok && x.As(target) {
+			return true
+		}
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			err = x.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, err := range x.Unwrap() {
+				if errorsAs(err, target) {
+					return true
+				}
+			}
+			return false
+		default:
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package interp
+
+import (
+	"reflect"
+	"sync"
+)
+
+// The frames of function calls are reused once the functions return, to
+// reduce allocations in programs making many calls, such as recursive ones.
+// A frame is pooled with its value slice, in the pool of the size class of
+// its capacity: the class c holds frames of 1<<c values. Larger frames are
+// not pooled.
+const frameClasses = 8
+
+var framePools [frameClasses]sync.Pool
+
+// frameClass returns the size class of frames of length values, or -1 if
+// they are not pooled.
+func frameClass(length int) int {
+	for c := 0; c < frameClasses; c++ {
+		if length <= 1<<uint(c) {
+			return c
+		}
+	}
+	return -1
+}
+
+// getFrame returns a frame of length elements, as newFrame, reused from the
+// pool of its size class if possible. The frame must be released by
+// putFrame.
+func getFrame(anc *frame, length int, id uint64) *frame {
+	c := frameClass(length)
+	if c < 0 {
+		return newFrame(anc, length, id)
+	}
+	f, _ := framePools[c].Get().(*frame)
+	if f == nil {
+		f = &frame{data: make([]reflect.Value, 0, 1<<uint(c))}
+	}
+	f.anc, f.data, f.id = anc, f.data[:length], id
+	if anc != nil {
+		f.done = anc.done
+	}
+	return f
+}
+
+// putFrame clears the frame f, obtained from getFrame, and returns it to
+// its pool. The frame must not be used afterwards.
+func putFrame(f *frame) {
+	c := frameClass(cap(f.data))
+	if c < 0 || cap(f.data) != 1<<uint(c) {
+		return
+	}
+	data := f.data
+	for i := range data {
+		data[i] = reflect.Value{}
+	}
+	*f = frame{data: data[:0]}
+	framePools[c].Put(f)
+}
+
+// reusableFrame returns true if the frame of function def can be reused once
+// the function returns, i.e. if no value created by its body keeps a
+// reference to the frame: a function literal, a goroutine, a method value
+// not called immediately, or the yield function of a range over function.
+func reusableFrame(def *node) bool {
+	body := def.lastChild()
+	if body == nil {
+		return false
+	}
+	reuse := true
+	body.Walk(func(n *node) bool {
+		switch {
+		case !reuse:
+		case n.kind == funcLit || n.kind == goStmt || isGen(n, rangeFunc):
+			reuse = false
+		case isGen(n, getMethod) || isGen(n, getMethodByName):
+			reuse = n.anc.kind == callExpr && childPos(n) == 0
+		}
+		return reuse
+	}, nil)
+	return reuse
+}
+
+// reuseFrames returns true if the frames of calls to function def can be
+// reused, which is not the case if they are instrumented.
+func (interp *Interpreter) reuseFrames(def *node) bool {
+	return def.reuse && interp.debugger == nil && interp.profiler == nil && interp.racer == nil && interp.tracer == nil && interp.stats == nil
+}
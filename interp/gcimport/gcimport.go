@@ -0,0 +1,225 @@
+/*
+Package gcimport imports compiled packages in the interpreter at runtime.
+
+The symbols of a package are read from its export data, produced by the gc
+compiler in the build cache, and their wrappers are generated as by goexports,
+then built as a Go plugin which is loaded in the process. There is then no need
+to generate the wrappers of third-party packages and to build them with the
+interpreter program:
+
+	imp := &gcimport.Importer{}
+	i := interp.New(interp.Options{ImportBinary: imp.Import})
+
+The go command must be available at runtime, with the toolchain which built the
+program, and the program must support plugins, which requires cgo and is limited
+to some platforms. The packages used by both the program and a plugin must be of
+the same version and built with the same flags, or the plugin can not be loaded:
+plugins are built with the race detector if the program is.
+
+The packages of the main module, which are developed, and the packages which can
+not be built are not imported, so they are interpreted from their source files.
+*/
+package gcimport
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"plugin"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/containous/yaegi/internal/extract"
+	"github.com/containous/yaegi/interp"
+)
+
+// symbolsFile declares the symbols of a plugin, set by the generated wrappers.
+const symbolsFile = `package main
+
+import "reflect"
+
+// Symbols stores the map of the plugin symbols per package
+var Symbols = map[string]map[string]reflect.Value{}
+`
+
+// Importer imports compiled packages. The zero value is ready to use.
+type Importer struct {
+	// Dir is the directory where the go command is run, whose go.mod file
+	// resolves the import paths. If empty, the current directory is used.
+	Dir string
+	// CacheDir is the directory where plugins are built and kept. If empty,
+	// the yaegi directory of the user cache directory is used.
+	CacheDir string
+
+	mutex   sync.Mutex
+	exports map[string]interp.Exports // imported packages, or nil if interpreted
+}
+
+// listPkg is a package listed by the go command.
+type listPkg struct {
+	path, export, name, err string
+	main                    bool
+}
+
+// Import returns the symbols of the compiled package of import path, or nil
+// if the package must be interpreted. It can be set as the ImportBinary
+// option of the interpreter.
+func (imp *Importer) Import(path string) (interp.Exports, error) {
+	imp.mutex.Lock()
+	defer imp.mutex.Unlock()
+
+	if e, ok := imp.exports[path]; ok {
+		return e, nil
+	}
+	e, err := imp.load(path)
+	if err != nil {
+		return nil, err
+	}
+	if imp.exports == nil {
+		imp.exports = map[string]interp.Exports{}
+	}
+	imp.exports[path] = e
+	return e, nil
+}
+
+// load builds and loads the plugin of the package of import path.
+func (imp *Importer) load(path string) (interp.Exports, error) {
+	pkgs, err := imp.list(path)
+	if err != nil {
+		return nil, err
+	}
+	exportFiles := map[string]string{}
+	var pkg *listPkg
+	for _, p := range pkgs {
+		exportFiles[p.path] = p.export
+		if p.path == path {
+			pkg = p
+		}
+	}
+	if pkg == nil || pkg.err != "" || pkg.export == "" || pkg.main || pkg.name == "main" {
+		return nil, nil
+	}
+
+	dir, err := imp.pluginDir(pkg)
+	if err != nil {
+		return nil, err
+	}
+	file := filepath.Join(dir, "symbols.so")
+	if _, err := os.Stat(file); err != nil {
+		if err := imp.build(pkg, exportFiles, dir, file); err != nil {
+			return nil, err
+		}
+	}
+
+	p, err := plugin.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	s, err := p.Lookup("Symbols")
+	if err != nil {
+		return nil, err
+	}
+	symbols, ok := s.(*map[string]map[string]reflect.Value)
+	if !ok {
+		return nil, fmt.Errorf("invalid symbols in plugin %s", file)
+	}
+	return interp.Exports{path: (*symbols)[path]}, nil
+}
+
+// list returns the package of import path and its dependencies, with their
+// export data compiled in the build cache.
+func (imp *Importer) list(path string) ([]*listPkg, error) {
+	format := "{{.ImportPath}}\t{{.Export}}\t{{.Name}}\t{{with .Module}}{{.Main}}{{end}}\t{{with .Error}}{{.Err}}{{end}}"
+	cmd := exec.Command("go", append(buildFlags("list", "-e", "-export", "-deps", "-f", format), path)...)
+	cmd.Dir = imp.Dir
+	out, err := cmd.Output()
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		return nil, err
+	}
+
+	// The packages which can not be built have errors, or are omitted
+	var pkgs []*listPkg
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		f := strings.SplitN(scanner.Text(), "\t", 5)
+		if len(f) < 5 {
+			continue
+		}
+		pkgs = append(pkgs, &listPkg{path: f[0], export: f[1], name: f[2], main: f[3] == "true", err: f[4]})
+	}
+	return pkgs, scanner.Err()
+}
+
+// buildFlags returns the arguments args of the go command, followed by the
+// flags building packages as the program, so that its plugins can be loaded.
+func buildFlags(args ...string) []string {
+	if raceEnabled {
+		args = append(args, "-race")
+	}
+	return args
+}
+
+// pluginDir returns the directory of the plugin of package pkg, in the
+// cache. The export data file name identifies the compiled package.
+func (imp *Importer) pluginDir(pkg *listPkg) (string, error) {
+	cache := imp.CacheDir
+	if cache == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return "", err
+		}
+		cache = filepath.Join(dir, "yaegi", "gcimport")
+	}
+	sum := sha256.Sum256([]byte(runtime.Version() + "\n" + pkg.path + "\n" + pkg.export))
+	return filepath.Join(cache, fmt.Sprintf("%x", sum[:16])), nil
+}
+
+// build generates the wrappers of the package pkg from its export data, and
+// builds them in the plugin file of directory dir.
+func (imp *Importer) build(pkg *listPkg, exportFiles map[string]string, dir, file string) error {
+	lookup := func(path string) (io.ReadCloser, error) {
+		f, ok := exportFiles[path]
+		if !ok || f == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(f)
+	}
+	p, err := importer.ForCompiler(token.NewFileSet(), "gc", lookup).Import(pkg.path)
+	if err != nil {
+		return err
+	}
+	src, err := extract.Generate("main", pkg.path, p)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	files := []string{filepath.Join(dir, "wrappers.go"), filepath.Join(dir, "symbols.go")}
+	if err := ioutil.WriteFile(files[0], src, 0644); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(files[1], []byte(symbolsFile), 0644); err != nil {
+		return err
+	}
+
+	// The plugin is renamed once built, so it is never loaded incomplete
+	tmp := file + ".tmp"
+	cmd := exec.Command("go", append(buildFlags("build", "-buildmode=plugin", "-o", tmp), files...)...)
+	cmd.Dir = imp.Dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("building plugin of %s: %v: %s", pkg.path, err, out)
+	}
+	return os.Rename(tmp, file)
+}
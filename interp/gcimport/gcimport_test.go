@@ -0,0 +1,39 @@
+package gcimport
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/containous/yaegi/interp"
+)
+
+func TestImport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building plugins is slow")
+	}
+	dir, err := ioutil.TempDir("", "gcimport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	imp := &Importer{CacheDir: dir}
+	i := interp.New(interp.Options{ImportBinary: imp.Import})
+	if _, err := i.Eval(`import "path"`); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`path.Join("a", "b")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.Interface().(string); s != "a/b" {
+		t.Fatalf("got %q, want a/b", s)
+	}
+
+	// Packages of the main module are interpreted
+	e, err := imp.Import("github.com/containous/yaegi/internal/extract")
+	if err != nil || e != nil {
+		t.Fatalf("got %v, %v, want nil", e, err)
+	}
+}
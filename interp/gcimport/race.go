@@ -0,0 +1,8 @@
+//go:build race
+// +build race
+
+package gcimport
+
+// raceEnabled is true if the program is built with the race detector, so
+// must be its plugins.
+const raceEnabled = true
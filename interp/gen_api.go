@@ -0,0 +1,114 @@
+//go:build ignore
+// +build ignore
+
+// gen_api generates api.go, which records the Go versions introducing the
+// packages and symbols of the standard library, from the API files of the
+// Go distribution. Platform dependent symbols, methods and fields are ignored.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+)
+
+// apiLine matches the package and the name of an API feature, a package level
+// symbol if kind is not empty.
+var apiLine = regexp.MustCompile(`^pkg ([^ ,]+), (?:(const|func|type|var) ([A-Za-z0-9_]+)(.*)|.*)$`)
+
+// field matches the fields and methods of struct and interface types.
+var field = regexp.MustCompile(`^(\[[^]]*\])? (struct|interface),`)
+
+func main() {
+	dir := filepath.Join(runtime.GOROOT(), "api")
+	old, _, _, err := readAPI(filepath.Join(dir, "go1.txt"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	versions := map[string]int{}
+	for minor := 1; ; minor++ {
+		_, pkgs, syms, err := readAPI(filepath.Join(dir, fmt.Sprintf("go1.%d.txt", minor)))
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, k := range append(pkgs, syms...) {
+			if _, ok := versions[k]; !ok && !old[k] {
+				versions[k] = minor
+			}
+		}
+		for _, p := range pkgs {
+			old[p] = true
+		}
+	}
+
+	keys := make([]string, 0, len(versions))
+	for k := range versions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b bytes.Buffer
+	b.WriteString(`// Code generated by 'go run gen_api.go'. DO NOT EDIT.
+
+package interp
+
+// apiVersions stores the minor version of Go introducing the packages and the
+// package level symbols of the standard library, indexed by path or path.Name,
+// if after Go 1.0.
+var apiVersions = map[string]int{
+`)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%q: %d,\n", k, versions[k])
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("api.go", src, 0666); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readAPI returns the packages, as a set and a list, and the package level
+// symbols, as path.Name, of an API file.
+func readAPI(name string) (map[string]bool, []string, []string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	defer f.Close()
+
+	set := map[string]bool{}
+	var pkgs, syms []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := apiLine.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			// Platform dependent features are qualified by the platform
+			continue
+		}
+		if !set[m[1]] {
+			set[m[1]] = true
+			pkgs = append(pkgs, m[1])
+		}
+		if m[2] == "" || m[2] == "type" && field.MatchString(m[4]) {
+			continue
+		}
+		syms = append(syms, m[1]+"."+m[3])
+	}
+	return set, pkgs, syms, scanner.Err()
+}
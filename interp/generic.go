@@ -0,0 +1,852 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Generic functions and types are not compiled. They are kept as templates
+// in the AST, and instantiated on demand, for each distinct set of type
+// arguments. An instance is a copy of the template AST, where type parameters
+// refer to symbols bound to type arguments in the package scope. Instances
+// are processed by GTA at creation, and their CFG is generated once the
+// CFG of the code which instantiates them is complete.
+
+// genericParams returns the type parameter list node of a generic function
+// or type declaration, or nil if not generic.
+func genericParams(n *node) *node {
+	switch n.kind {
+	case funcDecl:
+		if t := n.child[2]; len(t.child) > 0 && t.child[0].kind == typeParams {
+			return t.child[0]
+		}
+	case typeSpec:
+		if len(n.child) > 2 && n.child[1].kind == typeParams {
+			return n.child[1]
+		}
+	}
+	return nil
+}
+
+// genericRecv returns the receiver type expression of a method of a generic
+// type, or nil if n is not such a method.
+func genericRecv(n *node) *node {
+	if n.kind != funcDecl || len(n.child[0].child) == 0 {
+		return nil
+	}
+	t := n.child[0].child[0].lastChild()
+	if t.kind == starExpr {
+		t = t.child[0]
+	}
+	if t.kind == indexExpr {
+		return t
+	}
+	return nil
+}
+
+// isGeneric returns true if n is a generic function or a method of a generic type.
+func isGeneric(n *node) bool { return genericParams(n) != nil || genericRecv(n) != nil }
+
+// typeParamList returns the names and constraint expressions of type parameters.
+func typeParamList(n *node) (names []string, constraints []*node) {
+	for _, f := range n.child {
+		c := f.lastChild()
+		for _, p := range f.child[:len(f.child)-1] {
+			names = append(names, p.ident)
+			constraints = append(constraints, c)
+		}
+	}
+	return names, constraints
+}
+
+// isPartialCallee returns true if n is the explicit instantiation of the generic
+// function g in a call expression, with some type arguments left to infer.
+func isPartialCallee(g *itype, n *node) bool {
+	if g.node.kind != funcDecl || n.anc.kind != callExpr || n.anc.child[0] != n {
+		return false
+	}
+	names, _ := typeParamList(genericParams(g.node))
+	return len(n.child)-1 < len(names)
+}
+
+// instantiateNode returns the symbol of generic g instantiated with the type arguments
+// expressions in args.
+func (interp *Interpreter) instantiateNode(sc *scope, g *itype, args []*node, n *node) (*symbol, string, error) {
+	types := make([]*itype, len(args))
+	for i, c := range args {
+		t := c.typ
+		if t == nil {
+			var err error
+			if t, err = nodeType(interp, sc, c); err != nil {
+				return nil, "", err
+			}
+		}
+		types[i] = t
+	}
+	return interp.instantiate(g, types, n)
+}
+
+// instantiate returns the symbol of generic g instantiated with type arguments types.
+// The instance is created if it does not exist yet. Node n is used to report errors.
+func (interp *Interpreter) instantiate(g *itype, types []*itype, n *node) (*symbol, string, error) {
+	sc := g.scope
+	names, constraints := typeParamList(genericParams(g.node))
+	if len(types) != len(names) {
+		return nil, "", n.cfgErrorf("got %d type arguments but %s has %d type parameters", len(types), g.name, len(names))
+	}
+
+	name := instanceName(g.name, types)
+	if sym, ok := sc.sym[name]; ok {
+		return sym, name, nil
+	}
+
+	// Constraints may refer to type parameters, which are bound in a block scope
+	csc := sc.pushBloc()
+	for i, p := range names {
+		csc.sym[p] = &symbol{kind: typeSym, typ: types[i]}
+	}
+	for i, t := range types {
+		if !satisfies(interp, csc, constraints[i], t) {
+			return nil, "", n.cfgErrorf("%s does not satisfy %s", typeString(t), constraintString(constraints[i]))
+		}
+	}
+
+	// Bind type parameters to type arguments
+	rename := map[string]string{}
+	for i, p := range names {
+		if p == "_" {
+			continue
+		}
+		bound := name + "." + p
+		sc.sym[bound] = &symbol{kind: typeSym, typ: types[i]}
+		rename[p] = bound
+	}
+
+	root := interp.genericRoot(sc, g.node.pos)
+	switch g.node.kind {
+	case funcDecl:
+		inst := interp.cloneNode(g.node, root, rename)
+		inst.child[2].child = inst.child[2].child[1:] // remove type parameters
+		inst.child[1].ident = name
+		root.child = append(root.child, inst)
+		if err := interp.gta(root, g.pkgPath); err != nil {
+			return nil, "", err
+		}
+		interp.generic = append(interp.generic, root)
+
+	case typeSpec:
+		decl := interp.genNode(root, typeDecl, g.node.pos)
+		inst := interp.cloneNode(g.node, decl, rename)
+		inst.child = append(inst.child[:1], inst.child[2:]...) // remove type parameters
+		inst.child[0].ident = name
+		decl.child = append(decl.child, inst)
+
+		// Pre-declare the type, to allow recursive definitions
+		t := &itype{name: name, pkgPath: g.pkgPath, node: inst.child[0], scope: sc, incomplete: true}
+		sc.sym[name] = &symbol{kind: typeSym, typ: t}
+		if err := interp.gta(root, g.pkgPath); err != nil {
+			return nil, "", err
+		}
+		if isAlias(g.node) {
+			// A generic alias denotes the aliased type, which is not an instance
+			break
+		}
+		t = sc.sym[name].typ
+		t.generic, t.targs = g, types
+		for _, m := range g.method {
+			if err := interp.instantiateMethod(m, t); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	return sc.sym[name], name, nil
+}
+
+// instantiateMethod creates the method m of generic type for the instantiated type t.
+func (interp *Interpreter) instantiateMethod(m *node, t *itype) error {
+	g := t.generic
+	names, _ := typeParamList(genericParams(g.node))
+	rename := map[string]string{}
+	for i, c := range genericRecv(m).child[1:] {
+		if c.ident != "_" && i < len(names) {
+			rename[c.ident] = t.name + "." + names[i]
+		}
+	}
+
+	root := interp.genericRoot(g.scope, m.pos)
+	inst := interp.cloneNode(m, root, rename)
+	root.child = append(root.child, inst)
+
+	// Replace the generic receiver type by the instantiated type
+	r := genericRecv(inst)
+	r.kind, r.ident, r.child, r.action, r.gen = identExpr, t.name, nil, aNop, nop
+
+	if err := interp.gta(root, g.pkgPath); err != nil {
+		return err
+	}
+	interp.generic = append(interp.generic, root)
+	return nil
+}
+
+// addGenericMethod registers method n to generic type expressed by r, and
+// creates it for all existing instances of the generic type.
+func (interp *Interpreter) addGenericMethod(sc *scope, n, r *node, rpath string) error {
+	typeName := r.child[0].ident
+	n.ident = n.child[1].ident
+	sym := sc.sym[typeName]
+	if sym == nil {
+		// Generic type is not declared yet
+		sym = &symbol{kind: typeSym, typ: &itype{cat: genericT, name: typeName, pkgPath: rpath, node: r.child[0], scope: sc, incomplete: true}}
+		sc.sym[typeName] = sym
+	}
+	g := sym.typ
+	g.method = append(g.method, n)
+	if g.incomplete {
+		return nil
+	}
+	for _, s := range sc.sym {
+		if s.kind == typeSym && s.typ != nil && s.typ.generic == g {
+			if err := interp.instantiateMethod(n, s.typ); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// inferCall returns the instance of the generic function called in call
+// expression n, where type arguments are inferred from the call arguments.
+func (interp *Interpreter) inferCall(sc *scope, n *node) (*symbol, error) {
+	fun := n.child[0]
+	g, err := nodeType(interp, sc, fun)
+	if err != nil {
+		return nil, err
+	}
+	names, constraints := typeParamList(genericParams(g.node))
+	index := map[string]int{}
+	for i, name := range names {
+		index[name] = i
+	}
+	types := make([]*itype, len(names))
+
+	if fun.kind == indexExpr {
+		// Type arguments partially set by explicit instantiation
+		for i, c := range fun.child[1:] {
+			if types[i], err = nodeType(interp, sc, c); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var params []*node
+	for _, f := range g.node.child[2].child[1].child {
+		for i := 0; i < len(f.child)-1 || i == 0; i++ {
+			params = append(params, f.lastChild())
+		}
+	}
+
+	// Typed arguments are unified first, then untyped constants with their default type
+	for _, untyped := range []bool{false, true} {
+		for i, a := range n.child[1:] {
+			var p *node
+			switch l := len(params); {
+			case i < l:
+				p = params[i]
+			case l > 0 && params[l-1].kind == ellipsisExpr:
+				p = params[l-1]
+			default:
+				continue
+			}
+			if p.kind == ellipsisExpr {
+				p = p.child[0]
+			}
+			t := a.typ
+			if t == nil {
+				if t, err = nodeType(interp, sc, a); err != nil {
+					return nil, err
+				}
+			}
+			if t.untyped != untyped || t.cat == nilT {
+				continue
+			}
+			if t.untyped {
+				t = interp.defaultType(t)
+			}
+			unify(p, t, index, types)
+		}
+	}
+
+	// Type parameters set by core type constraints such as ~[]E are inferred
+	// from the type arguments of their constraint
+	for i, c := range constraints {
+		if types[i] != nil && c.kind == unaryExpr {
+			unify(c.child[0], types[i], index, types)
+		}
+	}
+
+	for i, t := range types {
+		if t == nil {
+			return nil, n.cfgErrorf("cannot infer %s", names[i])
+		}
+	}
+
+	sym, _, err := interp.instantiate(g, types, n)
+	return sym, err
+}
+
+// unify matches the parameter type expression p of a generic function against the
+// argument type t, and sets the types of type parameters referenced in p.
+func unify(p *node, t *itype, index map[string]int, types []*itype) {
+	if t == nil {
+		return
+	}
+	if t.cat == aliasT && p.kind != identExpr {
+		t = t.val
+	}
+	var rt reflect.Type
+	if t.cat == valueT {
+		rt = t.rtype
+	}
+
+	switch p.kind {
+	case identExpr:
+		if i, ok := index[p.ident]; ok && types[i] == nil {
+			types[i] = t
+		}
+
+	case arrayType:
+		e := p.lastChild()
+		switch {
+		case t.cat == arrayT:
+			unify(e, t.val, index, types)
+		case rt != nil && (rt.Kind() == reflect.Slice || rt.Kind() == reflect.Array):
+			unify(e, &itype{cat: valueT, rtype: rt.Elem()}, index, types)
+		}
+
+	case chanType, chanTypeRecv, chanTypeSend:
+		switch {
+		case t.cat == chanT || t.cat == chanRecvT || t.cat == chanSendT:
+			unify(p.child[0], t.val, index, types)
+		case rt != nil && rt.Kind() == reflect.Chan:
+			unify(p.child[0], &itype{cat: valueT, rtype: rt.Elem()}, index, types)
+		}
+
+	case mapType:
+		switch {
+		case t.cat == mapT:
+			unify(p.child[0], t.key, index, types)
+			unify(p.child[1], t.val, index, types)
+		case rt != nil && rt.Kind() == reflect.Map:
+			unify(p.child[0], &itype{cat: valueT, rtype: rt.Key()}, index, types)
+			unify(p.child[1], &itype{cat: valueT, rtype: rt.Elem()}, index, types)
+		}
+
+	case starExpr:
+		switch {
+		case t.cat == ptrT:
+			unify(p.child[0], t.val, index, types)
+		case rt != nil && rt.Kind() == reflect.Ptr:
+			unify(p.child[0], &itype{cat: valueT, rtype: rt.Elem()}, index, types)
+		}
+
+	case funcType:
+		var in, out []*node
+		for _, f := range p.child[0].child {
+			for i := 0; i < len(f.child)-1 || i == 0; i++ {
+				in = append(in, f.lastChild())
+			}
+		}
+		if len(p.child) > 1 {
+			for _, f := range p.child[1].child {
+				for i := 0; i < len(f.child)-1 || i == 0; i++ {
+					out = append(out, f.lastChild())
+				}
+			}
+		}
+		switch {
+		case t.cat == funcT:
+			for i := 0; i < len(in) && i < len(t.arg); i++ {
+				unify(in[i], t.arg[i], index, types)
+			}
+			for i := 0; i < len(out) && i < len(t.ret); i++ {
+				unify(out[i], t.ret[i], index, types)
+			}
+		case rt != nil && rt.Kind() == reflect.Func:
+			for i := 0; i < len(in) && i < rt.NumIn(); i++ {
+				unify(in[i], &itype{cat: valueT, rtype: rt.In(i)}, index, types)
+			}
+			for i := 0; i < len(out) && i < rt.NumOut(); i++ {
+				unify(out[i], &itype{cat: valueT, rtype: rt.Out(i)}, index, types)
+			}
+		}
+
+	case indexExpr:
+		// Argument of an instantiated generic type
+		if t.cat == ptrT {
+			t = t.val
+		}
+		g := p.child[0]
+		if g.kind == selectorExpr {
+			g = g.child[1]
+		}
+		if t.generic != nil && t.generic.name == g.ident {
+			for i, c := range p.child[1:] {
+				if i < len(t.targs) {
+					unify(c, t.targs[i], index, types)
+				}
+			}
+			break
+		}
+		// Argument of another type, matched against the underlying type
+		// of the generic type, such as a function literal for iter.Seq[E]
+		if u := genericUnderlying(p); u != nil {
+			unify(u, t, index, types)
+		}
+
+	case parenExpr, ellipsisExpr:
+		unify(p.child[0], t, index, types)
+	}
+}
+
+// genericUnderlying returns a copy of the underlying type expression of the
+// generic type instantiated in p, where type parameters are replaced by the
+// type arguments of p, or nil if they are not identifiers.
+func genericUnderlying(p *node) *node {
+	g, pkgName := p.child[0], ""
+	switch g.kind {
+	case selectorExpr:
+		pkgName, g = g.child[0].ident, g.child[1]
+	case identExpr:
+		for a := p.anc; a != nil; a = a.anc {
+			if a.kind == fileStmt {
+				pkgName = a.child[0].ident
+			}
+		}
+	default:
+		return nil
+	}
+	sc, ok := p.interp.scopes[pkgName]
+	if !ok {
+		return nil
+	}
+	sym := sc.sym[g.ident]
+	if sym == nil || sym.kind != typeSym || sym.typ == nil || sym.typ.cat != genericT || sym.typ.node.kind != typeSpec {
+		return nil
+	}
+	names, _ := typeParamList(genericParams(sym.typ.node))
+	if len(names) != len(p.child)-1 {
+		return nil
+	}
+	rename := map[string]string{}
+	for i, c := range p.child[1:] {
+		if c.kind != identExpr {
+			return nil
+		}
+		rename[names[i]] = c.ident
+	}
+	return p.interp.cloneNode(sym.typ.node.lastChild(), p, rename)
+}
+
+// defaultType returns the default type of an untyped constant type.
+func (interp *Interpreter) defaultType(t *itype) *itype {
+	if sym, ok := interp.universe.sym[t.name]; ok && sym.kind == typeSym {
+		return sym.typ
+	}
+	return t
+}
+
+// satisfies returns true if type t satisfies the constraint expression c.
+func satisfies(interp *Interpreter, sc *scope, c *node, t *itype) bool {
+	switch c.kind {
+	case identExpr:
+		switch c.ident {
+		case "any":
+			return true
+		case "comparable":
+			return t.TypeOf().Comparable()
+		}
+		ct := sc.getType(c.ident)
+		if ct == nil {
+			return false
+		}
+		return satisfiesType(interp, ct, t)
+
+	case interfaceType:
+		for _, f := range c.child[0].child {
+			if len(f.child) == 1 {
+				// Embedded constraint or type set element
+				if !satisfies(interp, sc, f.child[0], t) {
+					return false
+				}
+				continue
+			}
+			name := f.child[0].ident
+			if m, _ := t.lookupMethod(name); m != nil {
+				continue
+			}
+			if _, _, ok := t.lookupBinMethod(name); !ok {
+				return false
+			}
+		}
+		return true
+
+	case binaryExpr:
+		// Union of terms
+		return satisfies(interp, sc, c.child[0], t) || satisfies(interp, sc, c.child[1], t)
+
+	case unaryExpr:
+		// Term ~T: all types whose underlying type is T
+		ct, err := nodeType(interp, sc, c.child[0])
+		if err != nil {
+			return false
+		}
+		return identicalUnderlying(t.TypeOf(), ct.TypeOf())
+
+	case parenExpr:
+		return satisfies(interp, sc, c.child[0], t)
+	}
+
+	ct, err := nodeType(interp, sc, c)
+	if err != nil {
+		return false
+	}
+	return satisfiesType(interp, ct, t)
+}
+
+// satisfiesType returns true if type t satisfies the constraint type ct: an
+// interface implemented by t, or a type identical to t.
+func satisfiesType(interp *Interpreter, ct, t *itype) bool {
+	switch {
+	case ct.cat == interfaceT && ct.node != nil && ct.node.kind == interfaceType:
+		return satisfies(interp, ct.scope, ct.node, t)
+	case ct.cat == errorT:
+		if m, _ := t.lookupMethod("Error"); m != nil {
+			return true
+		}
+		return t.TypeOf().Implements(ct.TypeOf())
+	case ct.cat == valueT && ct.rtype.Kind() == reflect.Interface:
+		return t.TypeOf().Implements(ct.rtype)
+	}
+	return identical(ct, t)
+}
+
+// identicalUnderlying returns true if the reflection types t1 and t2 have
+// identical underlying types, which are the ones of their kind for basic types,
+// and composed of identical types otherwise. As the defined types of
+// interpreted code are not named by reflection, except by a tag on the first
+// field of structs, that tag is ignored.
+func identicalUnderlying(t1, t2 reflect.Type) bool {
+	if t1 == t2 {
+		return true
+	}
+	if t1.Kind() != t2.Kind() {
+		return false
+	}
+	switch t1.Kind() {
+	case reflect.Array:
+		return t1.Len() == t2.Len() && t1.Elem() == t2.Elem()
+	case reflect.Chan:
+		return t1.ChanDir() == t2.ChanDir() && t1.Elem() == t2.Elem()
+	case reflect.Func:
+		if t1.NumIn() != t2.NumIn() || t1.NumOut() != t2.NumOut() || t1.IsVariadic() != t2.IsVariadic() {
+			return false
+		}
+		for i := 0; i < t1.NumIn(); i++ {
+			if t1.In(i) != t2.In(i) {
+				return false
+			}
+		}
+		for i := 0; i < t1.NumOut(); i++ {
+			if t1.Out(i) != t2.Out(i) {
+				return false
+			}
+		}
+	case reflect.Interface:
+		if t1.NumMethod() != t2.NumMethod() {
+			return false
+		}
+		for i := 0; i < t1.NumMethod(); i++ {
+			m1, m2 := t1.Method(i), t2.Method(i)
+			if m1.Name != m2.Name || m1.PkgPath != m2.PkgPath || m1.Type != m2.Type {
+				return false
+			}
+		}
+	case reflect.Map:
+		return t1.Key() == t2.Key() && t1.Elem() == t2.Elem()
+	case reflect.Ptr, reflect.Slice:
+		return t1.Elem() == t2.Elem()
+	case reflect.Struct:
+		if t1.NumField() != t2.NumField() {
+			return false
+		}
+		for i := 0; i < t1.NumField(); i++ {
+			f1, f2 := t1.Field(i), t2.Field(i)
+			if f1.Name != f2.Name || f1.PkgPath != f2.PkgPath || f1.Type != f2.Type || f1.Anonymous != f2.Anonymous ||
+				untaggedName(f1.Tag) != untaggedName(f2.Tag) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// untaggedName returns the struct field tag without the type name recorded
+// for interpreted defined types.
+func untaggedName(tag reflect.StructTag) string {
+	v, ok := tag.Lookup(typeNameTag)
+	if !ok {
+		return string(tag)
+	}
+	return strings.TrimSpace(strings.Replace(string(tag), typeNameTag+`:"`+v+`"`, "", 1))
+}
+
+// identical returns true if types t1 and t2 are identical.
+func identical(t1, t2 *itype) bool {
+	if t1.name != "" || t2.name != "" {
+		return t1.id() == t2.id()
+	}
+	return t1.TypeOf() == t2.TypeOf()
+}
+
+// isTypeSetElem returns true if the interface element f is a type set element,
+// i.e. a type, a ~T term or a union of terms, rather than a method or an
+// embedded interface.
+func isTypeSetElem(sc *scope, f *node) bool {
+	if len(f.child) != 1 {
+		return false
+	}
+	switch c := f.child[0]; c.kind {
+	case binaryExpr, unaryExpr:
+		return true
+	case identExpr:
+		if c.ident == "any" || c.ident == "comparable" {
+			return false
+		}
+		t := sc.getType(c.ident)
+		return t != nil && !t.incomplete && t.cat != interfaceT && t.cat != errorT &&
+			!(t.cat == valueT && t.rtype.Kind() == reflect.Interface)
+	}
+	return false
+}
+
+// setGenericRef replaces the reference to a generic in node n by a reference
+// to its instance sym of the given name.
+func setGenericRef(n *node, sym *symbol, name string) {
+	n.typ = sym.typ
+	if sym.kind == typeSym {
+		if len(n.child) > 0 && n.child[0].kind == selectorExpr {
+			// Instance of an imported type: keep the package qualifier
+			sel := n.child[0]
+			sel.child[1].ident = name
+			n.kind, n.child, n.action, n.gen = selectorExpr, sel.child, sel.action, sel.gen
+			for _, c := range n.child {
+				c.anc = n
+			}
+			return
+		}
+		n.kind, n.ident, n.child, n.action, n.gen = identExpr, name, nil, aNop, nop
+		n.sym = sym
+		n.start = n
+		return
+	}
+	n.val = sym.node
+	n.findex = -1
+	if n.sym != nil {
+		n.sym = sym
+	}
+	if n.kind == indexExpr {
+		n.kind, n.ident, n.child, n.action, n.gen = identExpr, name, nil, aNop, nop
+		n.start = n
+	}
+}
+
+// compileGeneric generates the CFG of generic instances created since last call.
+// All instances are compiled before generating their execution closures, as
+// instances may call other instances created in the meantime.
+func (interp *Interpreter) compileGeneric() error {
+	var roots []*node
+	for len(interp.generic) > 0 {
+		root := interp.generic[0]
+		interp.generic = interp.generic[1:]
+		syncTypes(interp.scopes[root.child[0].ident], interp.universe)
+		if _, err := interp.cfg(root); err != nil {
+			interp.generic = nil
+			return err
+		}
+		roots = append(roots, root)
+	}
+	for _, root := range roots {
+		interp.optimize(root)
+	}
+	for _, root := range roots {
+		if err := genRun(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// genericRoot returns a new file node in the package of scope sc, to hold
+// generic instances.
+func (interp *Interpreter) genericRoot(sc *scope, pos token.Pos) *node {
+	pkgName := mainID
+	for k, s := range interp.scopes {
+		if s == sc {
+			pkgName = k
+			break
+		}
+	}
+	syncTypes(sc, interp.universe)
+	root := interp.genNode(nil, fileStmt, pos)
+	interp.genNode(root, identExpr, pos).ident = pkgName
+	return root
+}
+
+// syncTypes updates the global frame layout of package scope sc from the universe.
+// The layout is shared by all package scopes, but only propagated to the universe
+// at scope exit, so it may be outdated in a package other than the current one.
+func syncTypes(sc, universe *scope) {
+	if len(sc.types) < len(universe.types) {
+		sc.types = universe.types
+	}
+}
+
+// genNode returns a new node of kind k, added to ancestor anc if not nil.
+func (interp *Interpreter) genNode(anc *node, k nkind, pos token.Pos) *node {
+	interp.nindex++
+	var i interface{}
+	n := &node{anc: anc, interp: interp, index: interp.nindex, pos: pos, kind: k, action: aNop, val: &i, gen: nop}
+	n.start = n
+	if anc != nil {
+		anc.child = append(anc.child, n)
+	}
+	return n
+}
+
+// cloneNode returns a copy of node subtree nod, attached to anc, where
+// identifiers present in rename are replaced, except the names of fields,
+// methods and composite literal keys, which never denote type parameters.
+func (interp *Interpreter) cloneNode(nod, anc *node, rename map[string]string) *node {
+	interp.nindex++
+	var i interface{}
+	n := *nod
+	n.index = interp.nindex
+	n.anc = anc
+	n.start = &n
+	n.val = &i
+	n.typ = nil
+	n.child = nil
+	if r, ok := rename[n.ident]; ok && n.kind == identExpr && !isMemberName(nod) {
+		n.ident = r
+	}
+	for _, c := range nod.child {
+		n.child = append(n.child, interp.cloneNode(c, &n, rename))
+	}
+	return &n
+}
+
+// isMemberName returns true if identifier n is the name of a field or a
+// method, in a selector or a declaration, or the key of a composite literal.
+func isMemberName(n *node) bool {
+	a := n.anc
+	if a == nil {
+		return false
+	}
+	switch a.kind {
+	case selectorExpr:
+		return a.child[0] != n
+	case fieldExpr:
+		// Names are followed by the type, then by the tag, if any
+		for _, c := range a.child[childPos(n)+1:] {
+			if c.kind != basicLit {
+				return true
+			}
+		}
+	case keyValueExpr:
+		return a.child[0] == n && a.anc.kind == compositeLitExpr
+	}
+	return false
+}
+
+// instanceName returns the name of the generic name instantiated with types.
+func instanceName(name string, types []*itype) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = typeString(t)
+	}
+	return name + "[" + strings.Join(s, ",") + "]"
+}
+
+// typeString returns a string representation of type t, as in Go source.
+func typeString(t *itype) string {
+	if t.cat == valueT {
+		return t.rtype.String()
+	}
+	if t.name != "" {
+		return t.name
+	}
+	switch t.cat {
+	case arrayT:
+		if t.size > 0 {
+			return "[" + strconv.Itoa(t.size) + "]" + typeString(t.val)
+		}
+		return "[]" + typeString(t.val)
+	case chanT:
+		return "chan " + typeString(t.val)
+	case chanRecvT:
+		return "<-chan " + typeString(t.val)
+	case chanSendT:
+		return "chan<- " + typeString(t.val)
+	case funcT:
+		s := "func(" + typeList(t.arg) + ")"
+		switch len(t.ret) {
+		case 0:
+		case 1:
+			s += " " + typeString(t.ret[0])
+		default:
+			s += " (" + typeList(t.ret) + ")"
+		}
+		return s
+	case interfaceT:
+		return "interface{}"
+	case mapT:
+		return "map[" + typeString(t.key) + "]" + typeString(t.val)
+	case ptrT:
+		return "*" + typeString(t.val)
+	case structT:
+		s := make([]string, len(t.field))
+		for i, f := range t.field {
+			s[i] = f.name + " " + typeString(f.typ)
+		}
+		return "struct{" + strings.Join(s, "; ") + "}"
+	}
+	return t.cat.String()
+}
+
+func typeList(types []*itype) string {
+	s := make([]string, len(types))
+	for i, t := range types {
+		s[i] = typeString(t)
+	}
+	return strings.Join(s, ", ")
+}
+
+// constraintString returns a string representation of constraint expression c.
+func constraintString(c *node) string {
+	switch c.kind {
+	case identExpr:
+		return c.ident
+	case binaryExpr:
+		return constraintString(c.child[0]) + " | " + constraintString(c.child[1])
+	case unaryExpr:
+		return "~" + constraintString(c.child[0])
+	case selectorExpr:
+		return c.child[0].ident + "." + c.child[1].ident
+	case arrayType:
+		return "[]" + constraintString(c.lastChild())
+	}
+	return "interface"
+}
@@ -2,6 +2,7 @@ package interp
 
 import (
 	"path"
+	"path/filepath"
 	"reflect"
 )
 
@@ -15,6 +16,11 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 	var iotaValue int
 
 	root.Walk(func(n *node) bool {
+		if err != nil && n.anc == root && root.anc == nil && interp.allErrors {
+			// Resume at the next top level declaration or statement
+			interp.errs = append(interp.errs, err)
+			err = nil
+		}
 		if err != nil {
 			return false
 		}
@@ -28,6 +34,9 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 			}
 
 		case defineStmt:
+			if isLoop(n.anc) {
+				return false // variables of a for statement, declared by cfg in the loop scope
+			}
 			var atyp *itype
 			if n.nleft+n.nright < len(n.child) {
 				if atyp, err = nodeType(interp, sc, n.child[n.nleft]); err != nil {
@@ -50,7 +59,7 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 					}
 					val = src.rval
 				}
-				var index int
+				index := -1 // allocated by cfg if the type is not yet complete
 				if !typ.incomplete {
 					if typ.cat == nilT {
 						err = n.cfgErrorf("use of untyped nil")
@@ -58,9 +67,12 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 					}
 					index = sc.add(typ)
 				}
-				sc.sym[dest.ident] = &symbol{kind: varSym, global: true, index: index, typ: typ, rval: val}
+				sc.sym[dest.ident] = &symbol{kind: varSym, global: true, index: index, typ: typ, rval: val, decl: dest.pos}
 				if n.anc.kind == constDecl {
 					sc.sym[dest.ident].kind = constSym
+					if atyp == nil {
+						sc.sym[dest.ident].cval = src.cval
+					}
 					iotaValue++
 				}
 			}
@@ -74,11 +86,36 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 			//err = n.cfgError("global ValueSpec not implemented")
 
 		case funcDecl:
+			if genericParams(n) != nil {
+				// Generic function, instantiated when used
+				name := n.child[1].ident
+				n.typ = &itype{cat: genericT, name: name, pkgPath: rpath, node: n, scope: sc}
+				sc.sym[name] = &symbol{kind: funcSym, typ: n.typ, node: n, index: -1}
+				return false
+			}
+			if r := genericRecv(n); r != nil {
+				// Method of a generic type, instantiated with the type
+				err = interp.addGenericMethod(sc, n, r, rpath)
+				return false
+			}
 			if n.typ, err = nodeType(interp, sc, n.child[2]); err != nil {
 				return false
 			}
-			if !isMethod(n) {
-				sc.sym[n.child[1].ident] = &symbol{kind: funcSym, typ: n.typ, node: n, index: -1}
+			if name := n.child[1].ident; !isMethod(n) {
+				if sym := sc.sym[name]; name != "init" && name != "_" && sym != nil && sym.kind == funcSym && sym.node != n && sym.node.kind == funcDecl && !isGeneric(sym.node) {
+					if sym.node.start == sym.node {
+						// The previous declaration is not compiled yet, it is part of the same compilation
+						err = n.child[1].cfgErrorf("%s redeclared in this block", name)
+						return false
+					}
+					if identical(sym.node.typ, n.typ) {
+						// The function of a previous evaluation is redefined with the same
+						// signature. Its node, referenced by compiled code and values, is
+						// kept and updated with the new definition once compiled.
+						return false
+					}
+				}
+				sc.sym[name] = &symbol{kind: funcSym, typ: n.typ, node: n, index: -1}
 			}
 			if len(n.child[0].child) > 0 {
 				// function is a method, add it to the related type
@@ -124,9 +161,43 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 				ipath = n.child[0].rval.String()
 				name = path.Base(ipath)
 			}
-			if interp.binPkg[ipath] != nil {
+			if ipath == "unsafe" && !interp.unsafePkg {
+				err = n.cfgErrorf("import %q not allowed", ipath)
+				return false
+			}
+			if ipath == "C" {
+				err = n.cfgErrorf("import %q: cgo is not supported", ipath)
+				return false
+			}
+			if interp.binSymbols(ipath) == nil {
+				if values := interp.srcFallback(rpath, ipath); values != nil {
+					// The source package can not be interpreted, its binary fallback is used instead
+					interp.Use(Exports{ipath: values})
+				}
+			}
+			if interp.binSymbols(ipath) == nil && interp.importBin != nil {
+				// The compiled package, if available, is used instead of the source files
+				var exports Exports
+				if exports, err = interp.importBin(ipath); err != nil {
+					err = n.cfgErrorf("import %q: %v", ipath, err)
+					return false
+				}
+				interp.Use(exports)
+			}
+			if interp.binSymbols(ipath) != nil {
+				if m := interp.newerAPI(ipath); m > 0 {
+					err = n.cfgErrorf("import %q requires go1.%d", ipath, m)
+					return false
+				}
+				if !interp.allowedPkg(ipath) {
+					err = n.cfgErrorf("import %q not allowed", ipath)
+					return false
+				}
 				if name == "." {
-					for n, v := range interp.binPkg[ipath] {
+					for n, v := range interp.binSymbols(ipath) {
+						if !interp.allowedSym(ipath, n) {
+							continue
+						}
 						typ := v.Type()
 						if isBinType(v) {
 							typ = typ.Elem()
@@ -137,32 +208,34 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 					sc.sym[name] = &symbol{kind: pkgSym, typ: &itype{cat: binPkgT}, path: ipath}
 				}
 			} else {
-				// TODO: make sure we do not import a src package more than once
-				err = interp.importSrcFile(rpath, ipath, name)
+				if m := interp.newerAPI(ipath); m > 0 && stdSrcDir(ipath) != "" {
+					err = n.cfgErrorf("import %q requires go1.%d", ipath, m)
+					return false
+				}
+				from := filepath.Dir(interp.fset.Position(n.pos).Filename)
+				err = interp.importSrcFile(rpath, ipath, name, from)
 				sc.types = interp.universe.types
 				sc.sym[name] = &symbol{kind: pkgSym, typ: &itype{cat: srcPkgT}, path: ipath}
 			}
 
 		case typeSpec:
 			typeName := n.child[0].ident
-			var typ *itype
-			if typ, err = nodeType(interp, sc, n.child[1]); err != nil {
+			if genericParams(n) != nil {
+				// Generic type, instantiated when used
+				if isAlias(n) {
+					if err = interp.checkGenericAlias(n); err != nil {
+						return false
+					}
+				}
+				n.typ = &itype{cat: genericT, name: typeName, pkgPath: rpath, node: n, scope: sc}
+				if sym := sc.sym[typeName]; sym != nil && sym.typ != nil {
+					// Type may already be declared for a receiver in a method function
+					n.typ.method = sym.typ.method
+				}
+				sc.sym[typeName] = &symbol{kind: typeSym, typ: n.typ, decl: n.child[0].pos}
 				return false
 			}
-			if n.child[1].kind == identExpr {
-				n.typ = &itype{cat: aliasT, val: typ, name: typeName, pkgPath: rpath}
-			} else {
-				n.typ = typ
-				n.typ.name = typeName
-				n.typ.pkgPath = rpath
-			}
-			// Type may already be declared for a receiver in a method function
-			if sc.sym[typeName] == nil {
-				sc.sym[typeName] = &symbol{kind: typeSym}
-			} else {
-				n.typ.method = append(n.typ.method, sc.sym[typeName].typ.method...)
-			}
-			sc.sym[typeName].typ = n.typ
+			err = interp.declType(sc, n, rpath)
 			return false
 		}
 		return true
@@ -173,3 +246,59 @@ func (interp *Interpreter) gta(root *node, rpath string) error {
 	}
 	return err
 }
+
+// declType declares in scope sc the type of the type specification n, in the
+// package of import path rpath.
+func (interp *Interpreter) declType(sc *scope, n *node, rpath string) error {
+	typeName := n.child[0].ident
+	typ, err := nodeType(interp, sc, n.child[1])
+	if err != nil {
+		return err
+	}
+	if isAlias(n) {
+		// The alias denotes the aliased type itself
+		if sym := sc.sym[typeName]; sym != nil && sym.typ != nil {
+			// Methods may already be declared with the alias as receiver
+			typ.method = append(typ.method, sym.typ.method...)
+		}
+		n.typ = typ
+		sc.sym[typeName] = &symbol{kind: typeSym, typ: typ, decl: n.child[0].pos}
+		return nil
+	}
+	if n.child[1].kind == identExpr {
+		n.typ = &itype{cat: aliasT, val: typ, name: typeName, pkgPath: rpath}
+	} else {
+		n.typ = typ
+		n.typ.name = typeName
+		n.typ.pkgPath = rpath
+	}
+	// Type may already be declared for a receiver in a method function
+	if sc.sym[typeName] == nil {
+		sc.sym[typeName] = &symbol{kind: typeSym}
+	} else {
+		n.typ.method = append(n.typ.method, sc.sym[typeName].typ.method...)
+	}
+	sc.sym[typeName].typ = n.typ
+	sc.sym[typeName].decl = n.child[0].pos
+	return nil
+}
+
+// checkGenericAlias returns an error if the generic alias declaration n is not
+// supported by the language version, or if it aliases one of its type parameters.
+func (interp *Interpreter) checkGenericAlias(n *node) error {
+	if interp.langVersion() < 24 {
+		return n.cfgErrorf("generic type alias requires go1.24 or later")
+	}
+	if t := n.lastChild(); t.kind == identExpr {
+		names, _ := typeParamList(genericParams(n))
+		for _, name := range names {
+			if name == t.ident {
+				return t.cfgErrorf("cannot use type parameter declared in alias declaration as RHS")
+			}
+		}
+	}
+	return nil
+}
+
+// isAlias returns true if the type specification n is an alias declaration.
+func isAlias(n *node) bool { return n.kind == typeSpec && n.action == aAssign }
@@ -0,0 +1,306 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// An Ident describes an identifier of a source analyzed by Inspect.
+type Ident struct {
+	Name string         // name of the identifier
+	Pos  token.Position // position of the identifier
+	Kind string         // kind of the designated object: "const", "var", "func", "type", "package", or empty if unknown
+	Type string         // type of the designated object, in Go syntax, or empty if unknown
+	Path string         // import path of a package, or of the package of a binary symbol, or empty
+	Decl token.Position // position of the declaration, invalid for a binary or predeclared object
+	Doc  string         // doc comment of a binary package or symbol, set by RegisterPackageDoc, or empty
+}
+
+// Inspect compiles Go code represented as a string, as Check, and returns its
+// identifiers in source order, with their kind, type and declaration, and the
+// errors found. Identifiers are described as far as compilation succeeded,
+// including the symbols of binary packages loaded by Use.
+func (interp *Interpreter) Inspect(src string) ([]*Ident, []error) {
+	if err := interp.lock(); err != nil {
+		return nil, []error{err}
+	}
+	defer interp.unlock()
+	root, errs := interp.check(src)
+	if root == nil {
+		return nil, errs
+	}
+	return interp.idents(root), errs
+}
+
+// idents returns the identifiers of the compiled AST root, in source order.
+func (interp *Interpreter) idents(root *node) []*Ident {
+	decls := map[*symbol]token.Pos{}
+	var res []*Ident
+
+	root.Walk(func(n *node) bool {
+		if n.anc != nil && isSelector(n.anc) && n.anc.child[1] == n {
+			return false // described with the selector expression
+		}
+		if isSelector(n) {
+			res = append(res, interp.selectorIdent(n, decls))
+			return true
+		}
+		switch n.kind {
+		case identExpr:
+			if n.ident == "" || n.ident == "_" || n.anc != nil && n.anc.kind == fileStmt {
+				break
+			}
+			id := &Ident{Name: n.ident, Pos: interp.fset.Position(n.pos)}
+			var sym *symbol
+			if isDeclIdent(n) {
+				if sym = interp.declSym(n); sym != nil {
+					if _, ok := decls[sym]; !ok {
+						decls[sym] = n.pos
+					}
+				}
+				id.Decl = id.Pos
+			} else {
+				sym = n.sym
+				if sym == nil {
+					sym = interp.lookupSym(n)
+				}
+				if sym != nil {
+					id.Decl = interp.declPos(sym, decls)
+				}
+			}
+			if sym != nil {
+				id.Kind, id.Path = symKindName(sym), sym.path
+				if sym.kind == pkgSym && sym.path == "" {
+					id.Path = n.ident
+				}
+				if sym.kind == pkgSym && sym.typ != nil && sym.typ.cat == binPkgT {
+					id.Doc = interp.binSymbolDoc(id.Path, "")
+				}
+				if sym.kind != pkgSym {
+					id.Type = identType(sym.typ)
+				}
+			}
+			if id.Type == "" && (sym == nil || sym.kind != pkgSym) {
+				id.Type = identType(n.typ)
+			}
+			res = append(res, id)
+		}
+		return true
+	}, nil)
+
+	// Selected identifiers are described before the selector operand
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Pos.Offset < res[j].Pos.Offset })
+	return res
+}
+
+// isSelector returns true if n is a selector expression, possibly resolved
+// as a binary package symbol by the CFG.
+func isSelector(n *node) bool {
+	switch n.kind {
+	case selectorExpr, rtypeExpr, rvalueExpr:
+		return len(n.child) == 2 && n.child[1].kind == identExpr
+	}
+	return false
+}
+
+// selectorIdent returns the description of the selected identifier of the
+// selector expression n.
+func (interp *Interpreter) selectorIdent(n *node, decls map[*symbol]token.Pos) *Ident {
+	sel := n.child[1]
+	id := &Ident{Name: sel.ident, Pos: interp.fset.Position(sel.pos), Type: identType(n.typ)}
+	x := n.child[0]
+	xsym := x.sym
+	if xsym == nil && x.kind == identExpr {
+		xsym = interp.lookupSym(x)
+	}
+	switch {
+	case xsym != nil && xsym.kind == pkgSym && xsym.typ != nil && xsym.typ.cat == binPkgT:
+		id.Path = xsym.path
+		v, ok := interp.binSymbols(xsym.path)[sel.ident]
+		if !ok {
+			break
+		}
+		id.Doc = interp.binSymbolDoc(xsym.path, sel.ident)
+		switch {
+		case isBinType(v):
+			id.Kind, id.Type = "type", v.Type().Elem().String()
+		case v.Kind() == reflect.Func:
+			id.Kind, id.Type = "func", v.Type().String()
+		case v.CanAddr():
+			id.Kind, id.Type = "var", v.Type().String()
+		default:
+			id.Kind, id.Type = "const", v.Type().String()
+		}
+	case n.sym != nil:
+		// Symbol of a source package
+		id.Kind, id.Type, id.Path = symKindName(n.sym), identType(n.sym.typ), xsym.path
+		id.Decl = interp.declPos(n.sym, decls)
+	default:
+		// Field or method
+		if m, ok := n.val.(*node); ok && m != nil && m.kind == funcDecl {
+			id.Kind, id.Decl = "func", interp.fset.Position(m.child[1].pos)
+			break
+		}
+		if n.typ == nil || id.Type == "" {
+			break
+		}
+		id.Kind = "var"
+		if t := n.typ; t.cat == funcT || t.cat == valueT && t.rtype.Kind() == reflect.Func {
+			id.Kind = "func"
+		}
+		if t, xt := n.typ.rtype, x.typ; t != nil && t.Kind() == reflect.Func && t.NumIn() > 0 && xt != nil && xt.cat == valueT &&
+			(t.In(0) == xt.rtype || t.In(0) == reflect.PtrTo(xt.rtype)) {
+			// The method of a binary type has its receiver as first argument
+			in := make([]reflect.Type, t.NumIn()-1)
+			for i := range in {
+				in[i] = t.In(i + 1)
+			}
+			out := make([]reflect.Type, t.NumOut())
+			for i := range out {
+				out[i] = t.Out(i)
+			}
+			id.Type = reflect.FuncOf(in, out, t.IsVariadic()).String()
+		}
+	}
+	return id
+}
+
+// declPos returns the position of the declaration of symbol s, or an
+// invalid position if unknown.
+func (interp *Interpreter) declPos(s *symbol, decls map[*symbol]token.Pos) token.Position {
+	if p, ok := decls[s]; ok {
+		return interp.fset.Position(p)
+	}
+	if s.node != nil && s.node.kind == funcDecl {
+		return interp.fset.Position(s.node.child[1].pos)
+	}
+	return token.Position{}
+}
+
+// isDeclIdent returns true if the identifier n declares a symbol.
+func isDeclIdent(n *node) bool {
+	a := n.anc
+	if a == nil {
+		return false
+	}
+	i := childPos(n)
+	switch a.kind {
+	case funcDecl:
+		return i == 1
+	case typeSpec:
+		return i == 0
+	case importSpec:
+		return i == 0 && len(a.child) == 2
+	case defineStmt, defineXStmt, valueSpec:
+		return i < a.nleft
+	case fieldExpr:
+		// Parameters, results and receivers, but not struct fields
+		return i < len(a.child)-1 && a.anc != nil && a.anc.kind == fieldList && a.anc.anc != nil && a.anc.anc.kind != structType && a.anc.anc.kind != interfaceType
+	case rangeStmt:
+		return i < len(a.child)-2
+	}
+	return false
+}
+
+// scopeOf returns the scope in which the identifier n is resolved.
+func (interp *Interpreter) scopeOf(n *node) *scope {
+	a := n.anc
+	if a != nil && a.kind == funcDecl && childPos(n) == 1 {
+		a = a.anc // the function name is declared out of the function scope
+	}
+	for ; a != nil; a = a.anc {
+		if a.scope != nil {
+			return a.scope
+		}
+	}
+	for a = n; a.anc != nil; a = a.anc {
+	}
+	if a.kind == fileStmt && len(a.child) > 0 {
+		// The first child of a file is the package name
+		if sc, ok := interp.scopes[a.child[0].ident]; ok {
+			return sc
+		}
+	}
+	if sc, ok := interp.scopes[mainID]; ok {
+		return sc
+	}
+	return interp.universe
+}
+
+// declSym returns the symbol declared by the identifier n, or nil.
+func (interp *Interpreter) declSym(n *node) *symbol {
+	if s, ok := interp.scopeOf(n).sym[n.ident]; ok {
+		return s
+	}
+	return interp.lookupSym(n)
+}
+
+// lookupSym returns the symbol designated by the identifier n, or nil.
+func (interp *Interpreter) lookupSym(n *node) *symbol {
+	sym, _, ok := interp.scopeOf(n).lookup(n.ident)
+	if !ok {
+		return nil
+	}
+	return sym
+}
+
+// symKindName returns the name of the kind of symbol s, as in Ident.
+func symKindName(s *symbol) string {
+	switch s.kind {
+	case binSym:
+		switch {
+		case s.rval.IsValid() && s.rval.Kind() == reflect.Func:
+			return "func"
+		case s.rval.IsValid():
+			return "var"
+		}
+		return "type"
+	case bltnSym, funcSym:
+		return "func"
+	case constSym:
+		return "const"
+	case pkgSym:
+		return "package"
+	case typeSym:
+		return "type"
+	case varSym:
+		return "var"
+	}
+	return ""
+}
+
+// identType returns the type t in Go syntax, or an empty string if t is
+// unknown.
+func identType(t *itype) string {
+	switch {
+	case t == nil, t.incomplete, t.cat == nilT, t.cat == builtinT, t.cat == valueT && t.rtype == nil:
+		return ""
+	case t.variadic:
+		e := *t
+		e.variadic = false
+		return "..." + identType(&e)
+	case t.cat == funcT && t.name == "":
+		// Function types are formatted here, for variadic parameters
+		s := "func(" + identTypes(t.arg) + ")"
+		switch len(t.ret) {
+		case 0:
+		case 1:
+			s += " " + identType(t.ret[0])
+		default:
+			s += " (" + identTypes(t.ret) + ")"
+		}
+		return s
+	}
+	return typeString(t)
+}
+
+// identTypes returns the comma separated list of types l, as identType.
+func identTypes(l []*itype) string {
+	s := make([]string, len(l))
+	for i, t := range l {
+		s[i] = identType(t)
+	}
+	return strings.Join(s, ", ")
+}
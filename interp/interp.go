@@ -1,14 +1,21 @@
 package interp
 
 import (
-	"bufio"
-	"fmt"
+	"context"
+	"errors"
 	"go/build"
-	"go/scanner"
+	"go/constant"
 	"go/token"
+	"io"
+	"io/fs"
 	"os"
+	"path"
+	"path/filepath"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // Interpreter node structure for AST and CFG
@@ -28,6 +35,7 @@ type node struct {
 	kind   nkind          // kind of node
 	pos    token.Pos      // position in source code, relative to fset
 	sym    *symbol        // associated symbol
+	scope  *scope         // scope opened by a block node, or nil
 	typ    *itype         // type of value in frame, or nil
 	recv   *receiver      // method receiver node for call, or nil
 	types  []reflect.Type // frame types, used by function literals only
@@ -36,7 +44,10 @@ type node struct {
 	gen    bltnGenerator  // generator function to produce above bltn
 	val    interface{}    // static generic value (CFG execution)
 	rval   reflect.Value  // reflection value to let runtime access interpreter (CFG)
+	cval   constant.Value // exact value of a constant expression, or nil
 	ident  string         // set if node is a var or func
+	reuse  bool           // true if the frame of a function definition can be reused on return
+	once   *sync.Once     // generation of the function body on first call, or nil
 }
 
 // receiver stores method receiver object access path
@@ -48,33 +59,144 @@ type receiver struct {
 
 // frame contains values for the current execution level (a function context)
 type frame struct {
-	anc       *frame            // ancestor frame (global space)
-	data      []reflect.Value   // values
-	deferred  [][]reflect.Value // defer stack
-	recovered interface{}       // to handle panic recover
+	anc       *frame             // ancestor frame (global space)
+	data      []reflect.Value    // values
+	deferred  [][]reflect.Value  // defer stack
+	recovered interface{}        // to handle panic recover
+	deferrer  *frame             // frame running the function as a deferred call, or nil
+	caller    *node              // call node in the calling frame, or nil
+	node      *node              // call node of the binary function running in the frame, or nil
+	id        uint64             // run identifier, execution stops if different from interpreter one
+	done      reflect.SelectCase // for cancelation of channel operations
+	debug     *frameDebug        // debugging state, or nil
+	profile   *frameProfile      // profiling state, or nil
+	race      *frameRace         // race detection state, or nil
+	trace     *frameTrace        // tracing state, or nil
+	stats     *frameStats        // statistics state, or nil
 }
 
+// newFrame returns a new frame of length elements, inheriting the
+// cancelation channel of the ancestor frame.
+func newFrame(anc *frame, length int, id uint64) *frame {
+	f := &frame{anc: anc, data: make([]reflect.Value, length), id: id}
+	if anc != nil {
+		f.done = anc.done
+	}
+	return f
+}
+
+func (f *frame) runid() uint64      { return atomic.LoadUint64(&f.id) }
+func (f *frame) setrunid(id uint64) { atomic.StoreUint64(&f.id, id) }
+
 // Exports stores the map of external values per package
 type Exports map[string]map[string]reflect.Value
 
 // opt stores interpreter options
 type opt struct {
-	astDot  bool          // display AST graph (debug)
-	cfgDot  bool          // display CFG graph (debug)
-	noRun   bool          // compile, but do not run
-	context build.Context // build context: GOPATH, build constraints
+	astDot     bool            // display AST graph (debug)
+	cfgDot     bool            // display CFG graph (debug)
+	noRun      bool            // compile, but do not run
+	context    build.Context   // build context: GOPATH, build constraints
+	filesystem fs.FS           // filesystem used to load source files
+	modCache   string          // module cache directory
+	download   bool            // download missing modules
+	allowed    map[string]bool // allowed binary packages, or nil if all are allowed
+	denied     map[string]bool // denied binary packages and symbols
+	maxMemory  int64           // maximum memory allocated by an evaluation, or 0 if unlimited
+	maxSteps   int64           // maximum number of steps executed by an evaluation, or 0 if unlimited
+	countSteps bool            // count the steps executed, for maxSteps or metrics
+	seed       int64           // seed of the order of map iterations and select choices, or 0 if random
+	coverFile  string          // coverage profile written after each evaluation, or empty
+	goMinor    int             // minor version of the Go API exposed by binary packages, or 0 if all
+	optErr     error           // error of an invalid option, returned by the evaluations
+	allErrors  bool            // resume compilation after an error, to return all errors
+	vet        bool            // report suspicious constructs, as go vet
+	autoImport bool            // import binary packages used without import declaration
+	unsafePkg  bool            // allow the import of package unsafe
+	eager      bool            // generate the execution of function bodies at compilation
+	env        []string        // initial environment of interpreted code, or nil for the process one
+
+	onReload  func(pkgPath string, old map[string]reflect.Value) // called by ReloadPath, or nil
+	importBin func(path string) (Exports, error)                 // imports binary packages not loaded by Use, or nil
+	importSrc func(path string) (fs.FS, string, error)           // resolves the source packages of imports, or nil
 }
 
 // Interpreter contains global resources and state
+//
+// An Interpreter is safe for concurrent use by multiple goroutines. The
+// evaluations, by Eval, EvalMulti, EvalWithContext, EvalPath, Compile,
+// Execute, Check, Inspect, Test, ReloadPath and the REPL, are serialized:
+// one runs at a time, including the execution of its code, and the others
+// wait for it to return. The methods reading or resetting the interpreter
+// state, such as Symbols, GetFunc, Snapshot, RestoreSnapshot, SourceDump and
+// Reset, are serialized with evaluations too. If called by binary code
+// called by interpreted code during an evaluation, which would wait for
+// itself, they return ErrReentrant instead, Symbols returns nil and Reset
+// panics. Only the goroutine running the evaluation is detected, not the
+// goroutines started by interpreted code.
+//
+// The functions obtained from an interpreter, by GetFunc, Symbols or as the
+// value of an evaluation, may be called concurrently between evaluations,
+// and run concurrently with each other and with the goroutines started by
+// interpreted code, as the goroutines of a Go program: the accesses to the
+// package variables they share must be synchronized by the interpreted code.
+// As an evaluation may allocate again the storage of package variables, they
+// should not run during an evaluation. Stop, NumGoroutine and the methods
+// loading binary symbols, such as Use, may be called at any time.
 type Interpreter struct {
 	Name string // program name
 	opt
-	frame    *frame            // program data storage during execution
-	nindex   int               // next node index
-	fset     *token.FileSet    // fileset to locate node in source code
-	universe *scope            // interpreter global level scope
-	scopes   map[string]*scope // package level scopes, indexed by package name
-	binPkg   Exports           // runtime binary values used in interpreter
+	frame    *frame                                     // program data storage during execution
+	nindex   int                                        // next node index
+	fset     *token.FileSet                             // fileset to locate node in source code
+	universe *scope                                     // interpreter global level scope
+	scopes   map[string]*scope                          // package level scopes, indexed by package name
+	modules  map[string]*modFile                        // parsed go.mod files, indexed by module directory
+	srcPkg   map[string]string                          // scope names of imported source packages, indexed by import path
+	srcDirs  map[string]*srcDir                         // imported source packages, indexed by directory
+	loadFS   fs.FS                                      // filesystem of the source package being loaded, if not the interpreter one
+	parsed   map[string]*parsedSrc                      // source files prefetched while loading a source, by file name
+	progDir  string                                     // directory of the program loaded by EvalPath or Test, or empty
+	binPkg   Exports                                    // runtime binary values used in interpreter
+	lazyPkg  map[string]func() map[string]reflect.Value // binary packages loaded when first used, indexed by import path
+	binDoc   map[string]map[string]string               // documentation of binary packages, indexed by import path and symbol name, or "" for the package
+	fallback Exports                                    // binary values of source packages which can not be interpreted
+	generic  []*node                                    // instantiated generic declarations, pending CFG
+	embeds   map[*node][]string                         // patterns of go:embed directives, indexed by var spec, pending CFG
+	sources  []source                                   // executed sources, in order, replayed by RestoreSnapshot
+	texts    map[*token.File]srcText                    // parsed source texts, quoted in errors
+	debugger *Debugger                                  // debugger controlling execution, or nil
+	profiler *profiler                                  // profiler sampling execution, or nil
+	racer    *racer                                     // data race detector, or nil
+	tracer   *tracer                                    // tracer of execution events, or nil
+	stats    *stats                                     // statistics of function calls, or nil
+	cover    *coverage                                  // coverage counters, or nil
+	metrics  *metrics                                   // metrics of evaluations, or nil
+	env      *environ                                   // environment of interpreted code, or nil for the process one
+	errs     []error                                    // compilation errors collected in allErrors mode
+
+	stdin, stdout, stderr *stream                    // standard streams of interpreted code
+	printer               func(reflect.Value) string // formats the results of Repl, or nil
+
+	id     uint64        // current run identifier, incremented at each stop
+	memory int64         // memory allocated by the current evaluation
+	steps  int64         // steps remaining in the current evaluation
+	mutex  sync.RWMutex  // protects done
+	done   chan struct{} // closed to cancel pending channel operations
+
+	rmutex    sync.Mutex // protects nroutines
+	rdone     *sync.Cond // signaled when a goroutine terminates
+	nroutines int        // number of running goroutines started by go statements
+
+	emutex sync.Mutex   // serializes evaluations
+	eowner int64        // id of the goroutine holding emutex, or 0
+	ctxRun *ctxRun      // run of the evaluation started by runWithContext, or nil
+	pmutex sync.RWMutex // protects binPkg, lazyPkg and binDoc
+	gmutex sync.Mutex   // serializes the generation of function bodies on first call
+
+	tmutex   sync.Mutex                    // protects rtypes and wrappers
+	rtypes   map[reflect.Type]*reflectType // reflection types returned by TypeOf, indexed by runtime type
+	wrappers map[reflect.Type]reflect.Type // wrapper types of interfaces without their own, or nil
 }
 
 const (
@@ -119,8 +241,157 @@ func (n *node) Walk(in func(n *node) bool, out func(n *node)) {
 type Options struct {
 	// GoPath sets GOPATH for the interpreter
 	GoPath string
-	// BuildTags sets build constraints for the interpreter
+	// GoModCache sets the module cache directory, from which the modules
+	// required by the go.mod file of the program are loaded. If empty,
+	// GoPath/pkg/mod is used.
+	GoModCache string
+	// AutoDownload downloads the required modules missing from the module
+	// cache, with the go mod download command, before interpreting them.
+	// It is ignored if SourcecodeFS is set.
+	AutoDownload bool
+	// OnReload, if not nil, is called by ReloadPath once a package is reloaded,
+	// with the package path and the previous values of its package level
+	// variables, indexed by name, so a program state can be migrated.
+	OnReload func(pkgPath string, old map[string]reflect.Value)
+	// ImportBinary, if not nil, is called to import a package not loaded by
+	// Use, before looking for its source files. It returns the symbols of
+	// the compiled package, which are then loaded by Use, or nil to
+	// interpret the source files. Package interp/gcimport provides such a
+	// function for the packages of the build cache.
+	ImportBinary func(path string) (Exports, error)
+	// ImportResolver, if not nil, is called to resolve the import of a source
+	// package, of import path not relative, instead of looking for it in the
+	// module, vendor or GOPATH directories. It returns the filesystem and the
+	// directory in that filesystem of the package files, which can then be
+	// fetched from a database, an artifact store or decrypted on the fly. If
+	// the filesystem is nil, the import is resolved as usual. The imports of
+	// the package are also resolved by ImportResolver.
+	ImportResolver func(path string) (fs.FS, string, error)
+	// AllErrors resumes compilation after a type error at the next top level
+	// declaration or statement, so the compilation errors of a source are
+	// all returned by Eval and Compile, as an ErrorList, instead of the first
+	// one. Errors may then result from previous ones.
+	AllErrors bool
+	// AutoImport imports implicitly the binary packages used without import
+	// declaration, as goimports: an undefined identifier used as a package
+	// name in a selector, such as strings in strings.ToUpper, designates the
+	// package of that name loaded by Use. If several packages have the same
+	// name, the one of shortest import path is chosen.
+	AutoImport bool
+	// BuildTags sets the build tags considered satisfied, as the -tags flag
+	// of go build, so the source files guarded by build constraints on them
+	// are included or excluded.
 	BuildTags []string
+	// GoBuildContext sets the target platform of the source files, to
+	// interpret or analyze them independently of the host: its GOOS, GOARCH,
+	// build and release tags select the files by their name suffixes and
+	// build constraints. Its empty fields are those of the host, its GOPATH is
+	// ignored for GoPath, and BuildTags, if set, replaces its build tags.
+	// Binary packages, such as runtime, remain the ones of the host.
+	GoBuildContext build.Context
+	// GoVersion, if not empty, restricts the standard library to the API of
+	// a Go release, such as "1.20", and sets the matching release build tags.
+	// Packages and package level symbols introduced by later releases can not
+	// be used, but the methods and fields added to existing types are kept.
+	// It is also the language version, which otherwise is the one of the go
+	// directive of the go.mod file of the module enclosing a program loaded
+	// from a package directory or a file, as by EvalPath or with Name set to
+	// its path, or else of the host: the min, max and clear builtins require
+	// 1.21, and from 1.22, each iteration of a for statement has its own
+	// variables, as captured by closures. If the version is invalid, the
+	// evaluations return an error.
+	GoVersion string
+	// SourcecodeFS sets the filesystem used to load source code, for
+	// GOPATH packages, imports and EvalPath. If nil, the OS filesystem is used.
+	SourcecodeFS fs.FS
+	// AllowedPackages restricts the binary packages which can be imported by
+	// interpreted code to the given import paths. If nil, all packages loaded
+	// by Use are allowed.
+	AllowedPackages []string
+	// DeniedSymbols lists the binary symbols which can not be used by interpreted
+	// code, in the form "path.Name", such as "os.Exit". An import path denies
+	// the whole package.
+	DeniedSymbols []string
+	// Unrestricted disables the sandbox: AllowedPackages and DeniedSymbols are ignored.
+	Unrestricted bool
+	// AllowUnsafe allows interpreted code to import package unsafe, which is
+	// otherwise denied, even if its symbols are loaded by Use. Sizeof, Alignof
+	// and Offsetof are then computed from the runtime layout of interpreted
+	// types, and unsafe.Pointer can be converted from and to pointers and
+	// uintptr values.
+	AllowUnsafe bool
+	// MaxMemory limits the memory, in bytes, allocated by interpreted code
+	// in an evaluation for slices, maps, channels, strings, new values,
+	// conversions between strings and slices, function values and the frames
+	// of function calls. An evaluation exceeding it is aborted with
+	// ErrMemoryLimit. If 0, the memory is not limited. The memory allocated
+	// by the functions and methods of binary packages, as strings.Repeat, is
+	// not accounted.
+	MaxMemory int64
+	// MaxSteps limits the number of steps, that is node executions, of
+	// interpreted code in an evaluation, so an infinite loop terminates even
+	// without a context deadline. An evaluation exceeding it is aborted with
+	// ErrStepLimit. If 0, the number of steps is not limited.
+	MaxSteps int64
+	// Seed, if not 0, makes the iteration order of maps and the choice among
+	// the ready cases of a select statement deterministic, as a function of
+	// the seed, so that a program depending on them inadvertently behaves the
+	// same for a given seed, and differently for another one. Map keys are
+	// ordered by value, or by their formatting if not numbers nor strings.
+	// The order remains random across concurrent goroutines executing the
+	// same statement, and for cases becoming ready while a select blocks.
+	Seed int64
+	// Stdin, Stdout and Stderr set the standard input, output and error of
+	// interpreted code, as Redirect does. If nil, the streams of the process
+	// are used.
+	Stdin          io.Reader
+	Stdout, Stderr io.Writer
+	// Env, if not nil, is the environment of interpreted code, in the form
+	// "key=value", as returned by os.Environ. The environment functions of
+	// the os package, such as Getenv, Setenv and Environ, then operate on a
+	// copy of it, isolated from the process environment and from the other
+	// interpreters. If a key is duplicated, its last value is used. The
+	// commands created by exec.Command and exec.CommandContext run in it,
+	// but their executable is looked up in the PATH of the process, and the
+	// exec.Cmd values created otherwise with a nil Env inherit the process
+	// environment. If nil, interpreted code uses the process environment.
+	Env []string
+	// DetectRaces enables the detection of data races between interpreted
+	// goroutines. Accesses to interpreted variables are checked against the
+	// happens-before relation established by go statements, channel
+	// operations and the methods of sync types, so races are reported even
+	// if the racing accesses do not overlap in time. Each race is reported
+	// once on the standard error of interpreted code, with the stacks of
+	// both accesses. Only code executed by the interpreter is checked.
+	DetectRaces bool
+	// Cover enables the counting of the executions of the basic blocks of
+	// interpreted source files, excluding test files, as go test -cover.
+	// The result is given by Coverage and WriteCoverProfile.
+	Cover bool
+	// CoverProfile, if not empty, enables Cover, and sets the file to which
+	// the coverage profile is written after each evaluation, in the format
+	// of go test -coverprofile. The count of a block starting with a loop
+	// is the number of evaluations of the loop condition.
+	CoverProfile string
+	// Tracer, if not nil, receives the events of the execution of code
+	// compiled afterwards: statements, entries in and exits of functions,
+	// and assignments of variables.
+	Tracer Tracer
+	// CollectStats enables the collection of the number of calls, the time
+	// and the memory allocations of interpreted functions, returned by
+	// Stats, at a lower cost than profiling.
+	CollectStats bool
+	// CollectMetrics enables the counting of the steps, goroutines, memory
+	// allocations and time of evaluations, returned by Metrics, TotalMetrics
+	// and WriteMetrics.
+	CollectMetrics bool
+	// EagerCompile generates the execution of all function bodies at
+	// compilation. Otherwise, the body of a function is generated when the
+	// function is first called, so loading a large package to call a few of
+	// its functions does not pay for the others. Bodies are always generated
+	// at compilation if the code is instrumented, for the coverage, the race
+	// detector, the debugger, the profiler or the tracer.
+	EagerCompile bool
 }
 
 // New returns a new interpreter
@@ -130,14 +401,102 @@ func New(options Options) *Interpreter {
 		fset:     token.NewFileSet(),
 		universe: initUniverse(),
 		scopes:   map[string]*scope{},
+		modules:  map[string]*modFile{},
+		srcPkg:   map[string]string{},
+		embeds:   map[*node][]string{},
+		texts:    map[*token.File]srcText{},
+		srcDirs:  map[string]*srcDir{},
 		binPkg:   Exports{"": map[string]reflect.Value{"_error": reflect.ValueOf((*_error)(nil))}},
+		lazyPkg:  map[string]func() map[string]reflect.Value{},
+		binDoc:   map[string]map[string]string{},
+		fallback: Exports{},
 		frame:    &frame{data: []reflect.Value{}},
+		done:     make(chan struct{}),
+		stdin:    newStream(&os.Stdin),
+		stdout:   newStream(&os.Stdout),
+		stderr:   newStream(&os.Stderr),
 	}
 
+	i.frame.id, i.frame.done = i.runState()
+	i.rdone = sync.NewCond(&i.rmutex)
+
+	i.opt.context = buildContext(options.GoBuildContext)
 	i.opt.context.GOPATH = options.GoPath
+	i.opt.modCache = options.GoModCache
+	i.opt.download = options.AutoDownload && options.SourcecodeFS == nil
+	i.opt.onReload = options.OnReload
+	i.opt.importBin = options.ImportBinary
+	i.opt.importSrc = options.ImportResolver
+	i.opt.allErrors = options.AllErrors
+	i.opt.autoImport = options.AutoImport
+	i.opt.unsafePkg = options.AllowUnsafe
+	if options.AllowUnsafe {
+		i.binPkg["unsafe"] = unsafeSymbols()
+	}
+	if i.opt.modCache == "" {
+		// The module cache is in the first GOPATH directory
+		i.opt.modCache = filepath.Join(strings.Split(options.GoPath, string(filepath.ListSeparator))[0], "pkg", "mod")
+	}
 	if len(options.BuildTags) > 0 {
 		i.opt.context.BuildTags = options.BuildTags
 	}
+	if options.GoVersion != "" {
+		if m, err := parseGoVersion(options.GoVersion); err != nil {
+			i.opt.optErr = err
+		} else {
+			i.opt.goMinor = m
+			if tags := i.opt.context.ReleaseTags; m < len(tags) {
+				i.opt.context.ReleaseTags = tags[:m:m]
+			}
+		}
+	}
+	if !options.Unrestricted {
+		if options.AllowedPackages != nil {
+			i.opt.allowed = map[string]bool{}
+			for _, p := range options.AllowedPackages {
+				i.opt.allowed[p] = true
+			}
+		}
+		i.opt.denied = map[string]bool{}
+		for _, s := range options.DeniedSymbols {
+			i.opt.denied[s] = true
+		}
+	}
+	i.opt.maxMemory = options.MaxMemory
+	i.opt.maxSteps = options.MaxSteps
+	i.opt.seed = options.Seed
+	i.opt.eager = options.EagerCompile
+	i.Redirect(options.Stdin, options.Stdout, options.Stderr)
+	if options.Env != nil {
+		i.opt.env = options.Env
+		i.env = newEnviron(options.Env)
+	}
+	if options.DetectRaces {
+		i.racer = newRacer(func(s string) { _, _ = io.WriteString(i.stderr, s) })
+		i.racer.mutex.Lock()
+		i.frame.race = &frameRace{routine: i.racer.newRoutine(nil, nil, nil)}
+		i.racer.mutex.Unlock()
+	}
+	if options.CollectStats {
+		i.stats = newStats()
+	}
+	if options.CollectMetrics {
+		i.metrics = &metrics{}
+	}
+	i.opt.countSteps = i.maxSteps > 0 || i.metrics != nil
+	if options.Tracer != nil {
+		i.tracer = newTracer(options.Tracer)
+		i.frame.trace = &frameTrace{routine: 1}
+	}
+	if options.Cover || options.CoverProfile != "" {
+		i.cover = &coverage{starts: map[*node][]*coverBlock{}}
+		i.opt.coverFile = options.CoverProfile
+	}
+	if options.SourcecodeFS != nil {
+		i.opt.filesystem = options.SourcecodeFS
+	} else {
+		i.opt.filesystem = realFS{}
+	}
 
 	// AstDot activates AST graph display for the interpreter
 	i.opt.astDot, _ = strconv.ParseBool(os.Getenv("YAEGI_AST_DOT"))
@@ -151,6 +510,62 @@ func New(options Options) *Interpreter {
 	return &i
 }
 
+// Reset clears the state defined by interpreted code, as if the interpreter
+// was just created by New: the global scope, the imported source packages
+// and the package variables are discarded, and the goroutines started by go
+// statements are stopped. The options, the binary symbols loaded by Use and
+// the interface wrappers are retained, so the interpreter can be reused at
+// a lower cost than a new one. Reset stops the running evaluation, and waits
+// for it to return. It panics with ErrReentrant if called by the running
+// evaluation, as from a host function called by interpreted code.
+func (interp *Interpreter) Reset() {
+	if interp.reentrant() {
+		panic(ErrReentrant)
+	}
+	// The running evaluation holds emutex until it returns, so it is stopped
+	// first, and the goroutines started since are stopped once locked
+	interp.Stop()
+	if err := interp.lock(); err != nil {
+		panic(err)
+	}
+	defer interp.unlock()
+	interp.Stop()
+
+	interp.Name = ""
+	interp.nindex = 0
+	interp.fset = token.NewFileSet()
+	interp.universe = initUniverse()
+	interp.scopes = map[string]*scope{}
+	interp.modules = map[string]*modFile{}
+	interp.srcPkg = map[string]string{}
+	interp.embeds = map[*node][]string{}
+	interp.texts = map[*token.File]srcText{}
+	interp.srcDirs = map[string]*srcDir{}
+	interp.progDir = ""
+	interp.generic = nil
+	interp.sources = nil
+	interp.errs = nil
+	interp.frame = &frame{data: []reflect.Value{}}
+	interp.frame.id, interp.frame.done = interp.runState()
+	if r := interp.racer; r != nil {
+		interp.racer = newRacer(r.report)
+		interp.racer.mutex.Lock()
+		interp.frame.race = &frameRace{routine: interp.racer.newRoutine(nil, nil, nil)}
+		interp.racer.mutex.Unlock()
+	}
+	if interp.cover != nil {
+		interp.cover = &coverage{starts: map[*node][]*coverBlock{}}
+	}
+	if interp.env != nil {
+		interp.env.reset(interp.opt.env)
+	}
+
+	// Reflection types of interpreted types are discarded with them
+	interp.tmutex.Lock()
+	interp.rtypes = nil
+	interp.tmutex.Unlock()
+}
+
 func initUniverse() *scope {
 	sc := &scope{global: true, sym: map[string]*symbol{
 		// predefined Go types
@@ -166,6 +581,8 @@ func initUniverse() *scope {
 		"int16":       {kind: typeSym, typ: &itype{cat: int16T, name: "int16"}},
 		"int32":       {kind: typeSym, typ: &itype{cat: int32T, name: "int32"}},
 		"int64":       {kind: typeSym, typ: &itype{cat: int64T, name: "int64"}},
+		"any":         {kind: typeSym, typ: &itype{cat: interfaceT}},
+		"comparable":  {kind: typeSym, typ: &itype{cat: interfaceT}},
 		"interface{}": {kind: typeSym, typ: &itype{cat: interfaceT}},
 		"rune":        {kind: typeSym, typ: &itype{cat: runeT, name: "rune"}},
 		"string":      {kind: typeSym, typ: &itype{cat: stringT, name: "string"}},
@@ -177,9 +594,9 @@ func initUniverse() *scope {
 		"uintptr":     {kind: typeSym, typ: &itype{cat: uintptrT, name: "uintptr"}},
 
 		// predefined Go constants
-		"false": {kind: constSym, typ: &itype{cat: boolT, name: "bool"}, rval: reflect.ValueOf(false)},
-		"true":  {kind: constSym, typ: &itype{cat: boolT, name: "bool"}, rval: reflect.ValueOf(true)},
-		"iota":  {kind: constSym, typ: &itype{cat: intT}},
+		"false": {kind: constSym, typ: &itype{cat: boolT, name: "bool"}, rval: reflect.ValueOf(false), cval: constant.MakeBool(false)},
+		"true":  {kind: constSym, typ: &itype{cat: boolT, name: "bool"}, rval: reflect.ValueOf(true), cval: constant.MakeBool(true)},
+		"iota":  {kind: constSym, typ: &itype{cat: intT, untyped: true}},
 
 		// predefined Go zero value
 		"nil": {typ: &itype{cat: nilT, untyped: true}},
@@ -187,6 +604,7 @@ func initUniverse() *scope {
 		// predefined Go builtins
 		"append":  {kind: bltnSym, builtin: _append},
 		"cap":     {kind: bltnSym, builtin: _cap},
+		"clear":   {kind: bltnSym, builtin: _clear},
 		"close":   {kind: bltnSym, builtin: _close},
 		"complex": {kind: bltnSym, builtin: _complex},
 		"imag":    {kind: bltnSym, builtin: _imag},
@@ -194,6 +612,8 @@ func initUniverse() *scope {
 		"delete":  {kind: bltnSym, builtin: _delete},
 		"len":     {kind: bltnSym, builtin: _len},
 		"make":    {kind: bltnSym, builtin: _make},
+		"max":     {kind: bltnSym, builtin: _max},
+		"min":     {kind: bltnSym, builtin: _min},
 		"new":     {kind: bltnSym, builtin: _new},
 		"panic":   {kind: bltnSym, builtin: _panic},
 		"print":   {kind: bltnSym, builtin: _print},
@@ -226,33 +646,124 @@ func (interp *Interpreter) main() *node {
 	return nil
 }
 
+// Program is the result of the compilation of a Go source, ready to be
+// executed one or more times by the interpreter which compiled it.
+type Program struct {
+	root      *node   // root node of the compiled source, or nil if there is nothing to run
+	initNodes []*node // init functions and main, to run after the root node
+	src       *source // compiled source, recorded at first execution, or nil
+}
+
 // Eval evaluates Go code represented as a string. It returns a map on
 // current interpreted package exported symbols
+//
+// Errors located in the source, including panics of the interpreted code,
+// are returned as an *Error, giving the phase and the position of the
+// error, and the interpreted stack of a panic.
 func (interp *Interpreter) Eval(src string) (reflect.Value, error) {
-	var res reflect.Value
+	if err := interp.lock(); err != nil {
+		return reflect.Value{}, err
+	}
+	defer interp.unlock()
+	return firstResult(interp.evalMulti(src))
+}
+
+// EvalMulti evaluates Go code represented as a string, as Eval, and returns
+// all the results of the last evaluated expression, such as the values
+// returned by a function call with multiple results. The error is returned
+// distinctly, and not as a result, if the expression is a call returning
+// an error.
+func (interp *Interpreter) EvalMulti(src string) ([]reflect.Value, error) {
+	if err := interp.lock(); err != nil {
+		return nil, err
+	}
+	defer interp.unlock()
+	return interp.evalMulti(src)
+}
+
+// evalMulti evaluates src as EvalMulti, in an evaluation already serialized.
+func (interp *Interpreter) evalMulti(src string) ([]reflect.Value, error) {
+	_, p, err := interp.compile(src)
+	if err != nil || interp.noRun {
+		return nil, err
+	}
+	return interp.execute(p)
+}
+
+// firstResult returns the first of the results res of an evaluation, as
+// returned by Eval.
+func firstResult(res []reflect.Value, err error) (reflect.Value, error) {
+	if err != nil || len(res) == 0 {
+		return reflect.Value{}, err
+	}
+	return res[0], nil
+}
 
-	// Parse source to AST
-	pkgName, root, err := interp.ast(src, interp.Name)
-	if err != nil || root == nil {
-		return res, err
+// Compile parses and compiles Go code represented as a string, without
+// running it. The returned program is executed with Execute, so the
+// parsing and compilation cost is paid only once for a source evaluated
+// repeatedly.
+func (interp *Interpreter) Compile(src string) (*Program, error) {
+	if err := interp.lock(); err != nil {
+		return nil, err
 	}
+	defer interp.unlock()
+	_, p, err := interp.compile(src)
+	return p, err
+}
+
+// compile compiles src as Compile, and also returns the AST root of src,
+// even if compilation fails after parsing.
+func (interp *Interpreter) compile(src string) (*node, *Program, error) {
+	return interp.compileParsed(interp.parse(src, interp.Name))
+}
+
+// compileParsed compiles the source parsed as compile.
+func (interp *Interpreter) compileParsed(parsed *parsedSrc) (root *node, p *Program, err error) {
+	if interp.allErrors {
+		interp.errs = nil
+		defer func() {
+			if r := recover(); r != nil {
+				// Incomplete types, resulting from previous errors, are reported by panics
+				e, ok := r.(*Error)
+				if !ok {
+					panic(r)
+				}
+				p, err = nil, interp.compileError(e)
+			}
+		}()
+	}
+
+	// Generate AST of parsed source
+	pkgName, root, err := interp.astOf(parsed)
+	if err != nil {
+		return nil, nil, interp.compileError(err)
+	}
+	if root == nil {
+		return nil, &Program{}, nil
+	}
+	defer interp.prefetch(pkgName, []*parsedSrc{parsed})()
 
 	if interp.astDot {
 		root.astDot(dotX(), interp.Name)
 		if interp.noRun {
-			return res, err
+			return root, &Program{}, nil
 		}
 	}
 
 	// Global type analysis
-	if err = interp.gta(root, pkgName); err != nil {
-		return res, err
+	if err = interp.gta(root, pkgName); err != nil || interp.errs != nil {
+		return root, nil, interp.compileError(err)
 	}
 
 	// Annotate AST with CFG infos
 	initNodes, err := interp.cfg(root)
-	if err != nil {
-		return res, err
+	if err != nil || interp.errs != nil {
+		return root, nil, interp.compileError(err)
+	}
+	interp.optimize(root)
+	if err = interp.compileGeneric(); err != nil || interp.errs != nil {
+		return root, nil, interp.compileError(err)
 	}
 
 	// Add main to list of functions to run, after all inits
@@ -274,79 +785,677 @@ func (interp *Interpreter) Eval(src string) (reflect.Value, error) {
 	}
 
 	if interp.noRun {
-		return res, err
+		return root, &Program{}, nil
 	}
 
-	// Execute CFG
+	// Generate closures for execution
 	if err = genRun(root); err != nil {
-		return res, err
+		return root, nil, interp.compileError(err)
+	}
+	return root, &Program{root: root, initNodes: initNodes, src: &source{Name: interp.Name, Src: parsed.buf}}, nil
+}
+
+// compileError returns the error of a compilation, preceded in allErrors
+// mode by the errors collected so far, in an ErrorList.
+func (interp *Interpreter) compileError(err error) error {
+	if !interp.allErrors {
+		return newError(TypePhase, err)
+	}
+	var l ErrorList
+	for _, e := range interp.errs {
+		l = l.append(e)
+	}
+	if err != nil {
+		l = l.append(err)
+	}
+	interp.errs = nil
+	return l
+}
+
+// Check parses and compiles Go code represented as a string, as Compile,
+// but never runs it, and returns all the errors found, as the AllErrors
+// option, and in VetPhase the suspicious constructs reported by go vet,
+// such as the conversion of an integer to a string. As the declarations of src are added to the interpreter, Check
+// is normally called on a new interpreter.
+func (interp *Interpreter) Check(src string) []error {
+	if err := interp.lock(); err != nil {
+		return []error{err}
+	}
+	defer interp.unlock()
+	_, errs := interp.check(src)
+	return errs
+}
+
+// check compiles src as Check, and returns its AST root, or nil if src can
+// not be parsed, and its errors.
+func (interp *Interpreter) check(src string) (*node, []error) {
+	allErrors, noRun, vet := interp.allErrors, interp.noRun, interp.vet
+	interp.allErrors, interp.noRun, interp.vet = true, true, true
+	defer func() { interp.allErrors, interp.noRun, interp.vet = allErrors, noRun, vet }()
+
+	root, _, err := interp.compile(src)
+	l, ok := err.(ErrorList)
+	if !ok {
+		if err != nil {
+			return root, []error{err}
+		}
+		return root, nil
+	}
+	errs := make([]error, len(l))
+	for i, e := range l {
+		errs[i] = e
+	}
+	return root, errs
+}
+
+// Execute runs a program compiled by Compile. It returns the value of
+// the last evaluated expression, as Eval.
+func (interp *Interpreter) Execute(p *Program) (reflect.Value, error) {
+	if err := interp.lock(); err != nil {
+		return reflect.Value{}, err
+	}
+	defer interp.unlock()
+	return firstResult(interp.execute(p))
+}
+
+// execute runs a compiled program, and returns all the values of the last
+// evaluated expression, as EvalMulti.
+func (interp *Interpreter) execute(p *Program) (res []reflect.Value, err error) {
+	if p.root == nil {
+		return nil, nil
 	}
+
+	if p.src != nil {
+		interp.sources = append(interp.sources, *p.src)
+		p.src = nil
+	}
+
+	interp.startRun()
+	defer func() {
+		interp.flushOutput()
+		if r := recover(); r != nil {
+			res, err = nil, runError(r)
+		}
+		if m := interp.metrics; m != nil {
+			m.end()
+		}
+		if interp.coverFile != "" {
+			if e := interp.writeCoverFile(); e != nil && err == nil {
+				err = e
+			}
+		}
+	}()
+
+	// Execute CFG
 	interp.resizeFrame()
-	interp.run(root, nil)
+	interp.run(p.root, nil)
 
-	for _, n := range initNodes {
+	for _, n := range p.initNodes {
 		interp.run(n, interp.frame)
 	}
-	v := genValue(root)
-	res = v(interp.frame)
+	if err := interp.runExceeded(); err != nil {
+		// The results of an aborted execution are not set
+		return nil, err
+	}
+	values := []func(*frame) reflect.Value{genValue(p.root)}
+	switch c, nout := resultCall(p.root); {
+	case c != nil && nout == 0:
+		// A call of a function without result has no value
+		values = nil
+	case nout > 1:
+		for i := 1; i < nout; i++ {
+			values = append(values, valueGenerator(c, c.findex+i))
+		}
+	}
+	res = make([]reflect.Value, len(values))
+	for i, v := range values {
+		res[i] = v(interp.frame)
 
-	// If result is an interpreter node, wrap it in a runtime callable function
-	if res.IsValid() {
-		if n, ok := res.Interface().(*node); ok {
-			res = genFunctionWrapper(n)(interp.frame)
+		// If result is an interpreter node, wrap it in a runtime callable function
+		if res[i].IsValid() {
+			if n, ok := res[i].Interface().(*node); ok {
+				res[i] = genFunctionWrapper(n)(interp.frame)
+			}
 		}
 	}
+	return res, nil
+}
 
-	return res, err
+// resultCall returns the function call ending the root node n, and its
+// number of results, or nil if n ends with another expression or statement.
+// The results of a call are stored in consecutive frame entries.
+func resultCall(n *node) (*node, int) {
+	for (n.kind == blockStmt || n.kind == exprStmt) && len(n.child) > 0 {
+		n = n.lastChild()
+	}
+	if n.kind != callExpr || isBuiltinCall(n) || len(n.child) == 0 || n.child[0].typ == nil {
+		return nil, 0
+	}
+	nout := -1
+	switch t := n.child[0].typ; {
+	case t.cat == valueT && t.rtype != nil && t.rtype.Kind() == reflect.Func:
+		nout = t.rtype.NumOut()
+	case t.cat == funcT:
+		nout = len(t.ret)
+	}
+	if nout < 0 || nout > 0 && n.findex < 0 {
+		return nil, 0
+	}
+	return n, nout
 }
 
-// getWrapper returns the wrapper type of the corresponding interface, or nil if not found
-func (interp *Interpreter) getWrapper(t reflect.Type) reflect.Type {
-	if p, ok := interp.binPkg[t.PkgPath()]; ok {
-		return p["_"+t.Name()].Type().Elem()
+// startRun resets the budget of an evaluation, and attaches the global
+// frame to the current run, in case of previous stop.
+func (interp *Interpreter) startRun() {
+	atomic.StoreInt64(&interp.memory, 0)
+	atomic.StoreInt64(&interp.steps, interp.maxSteps)
+	if m := interp.metrics; m != nil {
+		m.start()
+	}
+
+	id, done := interp.runState()
+	if r := interp.ctxRun; r != nil {
+		// The run may already be stopped by its context
+		id, done = r.id, r.done
+	}
+	interp.frame.setrunid(id)
+	interp.frame.done = done
+
+	interp.stdin.refresh()
+	interp.stdout.refresh()
+	interp.stderr.refresh()
+
+	if r := interp.racer; r != nil {
+		// The evaluation may run in a different goroutine than the previous one
+		r.bind(interp.frame.race.routine)
+	}
+}
+
+// runExceeded returns the error of an evaluation stopped by its budget, or nil.
+func (interp *Interpreter) runExceeded() error {
+	if interp.memoryExceeded() {
+		return ErrMemoryLimit
+	}
+	if interp.stepExceeded() {
+		return ErrStepLimit
+	}
+	return nil
+}
+
+// runError returns the panic r of interpreted code as an error, with its
+// stack. Other panics are propagated.
+func runError(r interface{}) error {
+	t, ok := r.(*panicTrace)
+	if !ok {
+		panic(r)
+	}
+	return t.error()
+}
+
+// EvalWithContext evaluates Go code represented as a string, as Eval.
+// If the context is canceled or times out before the end of evaluation,
+// the execution is stopped and ctx.Err() is returned. Interpreted
+// goroutines started during evaluation are also stopped. Blocking calls
+// performed in binary code (i.e. time.Sleep) are not interrupted, but
+// execution stops as soon as they return.
+func (interp *Interpreter) EvalWithContext(ctx context.Context, src string) (reflect.Value, error) {
+	res, err := interp.runWithContext(ctx, func() ([]reflect.Value, error) {
+		v, err := firstResult(interp.evalMulti(src))
+		return []reflect.Value{v}, err
+	})
+	if res == nil {
+		return reflect.Value{}, err
+	}
+	return res[0], err
+}
+
+// evalResult is the result of an evaluation run by runWithContext.
+type evalResult struct {
+	res []reflect.Value
+	err error
+	p   interface{} // panic of the interpreter, forwarded to the caller goroutine
+}
+
+// ctxRun is the run of an evaluation started by runWithContext. Its state
+// is captured once the evaluation is serialized, so a cancelation occurring
+// before the start of execution stops it.
+type ctxRun struct {
+	mutex    sync.Mutex
+	running  bool               // the evaluation holds emutex
+	canceled bool               // the context is done
+	id       uint64             // run identifier of the evaluation
+	done     reflect.SelectCase // cancelation case of the evaluation
+}
+
+// ErrReentrant is returned by the methods of an interpreter serializing
+// evaluations, such as Eval or Symbols, if called by its running evaluation,
+// as from a host function called by interpreted code, instead of waiting
+// for the evaluation to return.
+var ErrReentrant = errors.New("interpreter called by its running evaluation")
+
+// lock serializes an evaluation, or returns ErrReentrant if the calling
+// goroutine runs an evaluation already, as lock would deadlock.
+func (interp *Interpreter) lock() error {
+	id := goroutineID()
+	if atomic.LoadInt64(&interp.eowner) == id {
+		return ErrReentrant
 	}
+	interp.emutex.Lock()
+	atomic.StoreInt64(&interp.eowner, id)
 	return nil
 }
 
+// unlock ends an evaluation serialized by lock.
+func (interp *Interpreter) unlock() {
+	atomic.StoreInt64(&interp.eowner, 0)
+	interp.emutex.Unlock()
+}
+
+// reentrant returns true if the calling goroutine runs an evaluation.
+func (interp *Interpreter) reentrant() bool {
+	return atomic.LoadInt64(&interp.eowner) == goroutineID()
+}
+
+// runWithContext runs the evaluation f in a goroutine, serialized with other
+// evaluations, and returns its results. f must not lock emutex. If ctx is
+// done before f returns, the execution is stopped and ctx.Err() is returned
+// at once. The results of f are then discarded.
+func (interp *Interpreter) runWithContext(ctx context.Context, f func() ([]reflect.Value, error)) ([]reflect.Value, error) {
+	if interp.reentrant() {
+		return nil, ErrReentrant
+	}
+	done := make(chan evalResult, 1)
+	run := &ctxRun{}
+
+	go func() {
+		var r evalResult
+		defer func() {
+			r.p = recover()
+			done <- r
+		}()
+		if r.err = interp.lock(); r.err != nil {
+			return
+		}
+		defer interp.unlock()
+		run.mutex.Lock()
+		if run.canceled {
+			run.mutex.Unlock()
+			return
+		}
+		run.id, run.done = interp.runState()
+		run.running = true
+		run.mutex.Unlock()
+		interp.ctxRun = run
+		defer func() {
+			interp.ctxRun = nil
+			run.mutex.Lock()
+			run.running = false
+			run.mutex.Unlock()
+		}()
+		r.res, r.err = f()
+	}()
+
+	var r evalResult
+	select {
+	case <-ctx.Done():
+		run.mutex.Lock()
+		run.canceled = true
+		if run.running {
+			interp.stop()
+		}
+		run.mutex.Unlock()
+		return nil, ctx.Err()
+	case r = <-done:
+	}
+	if r.p != nil {
+		panic(r.p)
+	}
+	return r.res, r.err
+}
+
+// stop terminates all running frames and pending channel operations
+// of the current run. The interpreter state is preserved, so evaluation
+// can be resumed by a next call to Eval.
+func (interp *Interpreter) stop() {
+	interp.mutex.Lock()
+	atomic.AddUint64(&interp.id, 1)
+	close(interp.done)
+	interp.done = make(chan struct{})
+	interp.mutex.Unlock()
+}
+
+// Stop stops the execution of interpreted code, in the running evaluation
+// and in all the goroutines started by go statements, and waits for these
+// goroutines to terminate. The deferred calls of stopped functions are run.
+// Goroutines blocked in binary code, i.e. in time.Sleep, terminate once the
+// call returns. The interpreter state is preserved, so evaluation can be
+// resumed by a next call to Eval.
+func (interp *Interpreter) Stop() {
+	interp.stop()
+
+	interp.rmutex.Lock()
+	defer interp.rmutex.Unlock()
+	for interp.nroutines > 0 {
+		interp.rdone.Wait()
+	}
+}
+
+// NumGoroutine returns the number of running goroutines started by go
+// statements of interpreted code.
+func (interp *Interpreter) NumGoroutine() int {
+	interp.rmutex.Lock()
+	defer interp.rmutex.Unlock()
+	return interp.nroutines
+}
+
+// goroutine calls f in a new goroutine, registered until f returns.
+func (interp *Interpreter) goroutine(f func()) {
+	interp.rmutex.Lock()
+	interp.nroutines++
+	interp.rmutex.Unlock()
+	if m := interp.metrics; m != nil {
+		atomic.AddInt64(&m.run.goroutines, 1)
+	}
+
+	go func() {
+		defer func() {
+			interp.rmutex.Lock()
+			interp.nroutines--
+			interp.rmutex.Unlock()
+			interp.rdone.Broadcast()
+		}()
+		f()
+	}()
+}
+
+func (interp *Interpreter) runid() uint64 { return atomic.LoadUint64(&interp.id) }
+
+// runState returns the current run identifier and the related cancelation case
+func (interp *Interpreter) runState() (uint64, reflect.SelectCase) {
+	interp.mutex.RLock()
+	defer interp.mutex.RUnlock()
+	return interp.runid(), reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(interp.done)}
+}
+
+// EvalPath evaluates Go code located at path. The source file is read
+// from the interpreter filesystem, as set by Options.SourcecodeFS.
+//
+// If path is a directory, all the files of its package are loaded, then its
+// init functions and its main function are run, as by "go run". Imported
+// source packages are resolved from this directory, each package is loaded
+// once whatever the number of packages importing it, and internal packages
+// may only be imported from their parent tree.
+func (interp *Interpreter) EvalPath(path string) (reflect.Value, error) {
+	if err := interp.lock(); err != nil {
+		return reflect.Value{}, err
+	}
+	defer interp.unlock()
+	info, err := fs.Stat(interp.filesystem, path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if info.IsDir() {
+		return reflect.Value{}, interp.evalDir(path)
+	}
+	b, err := fs.ReadFile(interp.filesystem, path)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	interp.Name = path
+	interp.progDir = ""
+	return firstResult(interp.evalMulti(string(b)))
+}
+
+// getWrapper returns the wrapper type of the corresponding interface, or nil if not found.
+// If the interface has no wrapper of its own, as an anonymous interface or an interface
+// of a package exported without wrappers, the smallest wrapper implementing it is used.
+func (interp *Interpreter) getWrapper(t reflect.Type) reflect.Type {
+	if p := interp.binSymbols(t.PkgPath()); p != nil {
+		if w, ok := p["_"+t.Name()]; ok && w.Type().Elem().Implements(t) {
+			return w.Type().Elem()
+		}
+	}
+
+	interp.tmutex.Lock()
+	defer interp.tmutex.Unlock()
+
+	if w, ok := interp.wrappers[t]; ok {
+		return w
+	}
+	res := interp.smallestWrapper(t)
+	if res == nil && interp.loadAll() {
+		// The wrapper may be in a package not yet loaded
+		res = interp.smallestWrapper(t)
+	}
+	if interp.wrappers == nil {
+		interp.wrappers = map[reflect.Type]reflect.Type{}
+	}
+	interp.wrappers[t] = res
+	return res
+}
+
+// smallestWrapper returns the wrapper with the fewest methods implementing
+// the interface t, among the loaded binary packages, or nil.
+func (interp *Interpreter) smallestWrapper(t reflect.Type) reflect.Type {
+	interp.pmutex.RLock()
+	defer interp.pmutex.RUnlock()
+
+	var res reflect.Type
+	var resName string
+	for path, p := range interp.binPkg {
+		for name, v := range p {
+			if !strings.HasPrefix(name, "_") || v.Kind() != reflect.Ptr || !v.IsNil() {
+				continue
+			}
+			w := v.Type().Elem()
+			if w.Kind() != reflect.Struct || !w.Implements(t) {
+				continue
+			}
+			// Prefer the wrapper with the fewest methods, then the first by name, for reproducibility
+			if wname := path + "." + name; res == nil || w.NumField() < res.NumField() || w.NumField() == res.NumField() && wname < resName {
+				res, resName = w, wname
+			}
+		}
+	}
+	return res
+}
+
 // Use loads binary runtime symbols in the interpreter context so
 // they can be used in interpreted code
 func (interp *Interpreter) Use(values Exports) {
+	interp.pmutex.Lock()
+	defer interp.pmutex.Unlock()
+
 	for k, v := range values {
+		if k == "unsafe" && interp.unsafePkg {
+			// Package unsafe is already provided, with its emulated functions
+			continue
+		}
+		if stdioPkg[k] {
+			// The symbols redefined for the standard streams are built when used
+			v := v
+			delete(interp.binPkg, k)
+			interp.lazyPkg[k] = func() map[string]reflect.Value { return v }
+			continue
+		}
+		delete(interp.lazyPkg, k)
 		interp.binPkg[k] = v
 	}
 }
 
-// Repl performs a Read-Eval-Print-Loop on input file descriptor.
-// Results are printed on output.
-func (interp *Interpreter) Repl(in, out *os.File) {
-	s := bufio.NewScanner(in)
-	prompt := getPrompt(in, out)
-	prompt()
-	src := ""
-	for s.Scan() {
-		src += s.Text() + "\n"
-		if v, err := interp.Eval(src); err != nil {
-			switch err.(type) {
-			case scanner.ErrorList:
-				// Early failure in the scanner: the source is incomplete
-				// and no AST could be produced, neither compiled / run.
-				// Get one more line, and retry
-				continue
-			default:
-				fmt.Fprintln(out, err)
-			}
-		} else if v.IsValid() {
-			fmt.Fprintln(out, v)
+// UseLazy registers binary runtime symbols as Use, but the symbols of a
+// package are only built, by the function of its import path, when the
+// package is first imported or used. It reduces the cost of an interpreter
+// when many packages are available, but few are used.
+func (interp *Interpreter) UseLazy(loaders map[string]func() map[string]reflect.Value) {
+	interp.pmutex.Lock()
+	defer interp.pmutex.Unlock()
+
+	for k, load := range loaders {
+		if k == "unsafe" && interp.unsafePkg {
+			continue
 		}
-		src = ""
-		prompt()
+		delete(interp.binPkg, k)
+		interp.lazyPkg[k] = load
+	}
+}
+
+// RegisterPackage makes the binary symbols, indexed by name, available to
+// interpreted code as the package of import path, as Use, so that a host
+// application exposes its own API, such as "myapp/api". Types are given as
+// nil pointers, as in Exports, and the package name is the last element of
+// path. The symbols are copied, and replace those of a previous package of
+// the same path.
+func (interp *Interpreter) RegisterPackage(path string, symbols map[string]reflect.Value) {
+	values := make(map[string]reflect.Value, len(symbols))
+	for name, v := range symbols {
+		values[name] = v
+	}
+	interp.Use(Exports{path: values})
+}
+
+// RegisterPackageDoc sets the doc comments of the binary package of import
+// path, doc, and of its symbols, indexed by name, or by T.M for the method M
+// of type T. They are returned by Inspect and Doc, and displayed by editors
+// through the language server.
+func (interp *Interpreter) RegisterPackageDoc(path, doc string, symbols map[string]string) {
+	interp.pmutex.Lock()
+	defer interp.pmutex.Unlock()
+
+	docs := map[string]string{"": doc}
+	for name, d := range symbols {
+		docs[name] = d
+	}
+	interp.binDoc[path] = docs
+}
+
+// binSymbolDoc returns the documentation of the symbol name of binary
+// package path, or of the package if name is empty.
+func (interp *Interpreter) binSymbolDoc(path, name string) string {
+	interp.pmutex.RLock()
+	defer interp.pmutex.RUnlock()
+	return interp.binDoc[path][name]
+}
+
+// binSymbols returns the binary symbols of package path, loading them if
+// they are registered by UseLazy, or nil if the package is not available.
+func (interp *Interpreter) binSymbols(path string) map[string]reflect.Value {
+	interp.pmutex.RLock()
+	p, ok := interp.binPkg[path]
+	interp.pmutex.RUnlock()
+	if ok {
+		return p
 	}
+
+	interp.pmutex.Lock()
+	defer interp.pmutex.Unlock()
+	return interp.loadPkg(path)
 }
 
-// getPrompt returns a function which prints a prompt only if input is a terminal
-func getPrompt(in, out *os.File) func() {
-	if stat, err := in.Stat(); err == nil && stat.Mode()&os.ModeCharDevice != 0 {
-		return func() { fmt.Fprint(out, "> ") }
+// loadPkg returns the binary symbols of package path, loading them if
+// necessary. It must be called with pmutex locked.
+func (interp *Interpreter) loadPkg(path string) map[string]reflect.Value {
+	if p, ok := interp.binPkg[path]; ok {
+		return p
+	}
+	load, ok := interp.lazyPkg[path]
+	if !ok {
+		return nil
+	}
+	delete(interp.lazyPkg, path)
+	p := interp.stdioSymbols(path, load())
+	interp.binPkg[path] = p
+	return p
+}
+
+// loadAll loads all the binary packages registered by UseLazy, and returns
+// true if there was any.
+func (interp *Interpreter) loadAll() bool {
+	interp.pmutex.Lock()
+	defer interp.pmutex.Unlock()
+
+	loaded := len(interp.lazyPkg) > 0
+	for path := range interp.lazyPkg {
+		interp.loadPkg(path)
+	}
+	return loaded
+}
+
+// binPaths returns the import paths of the available binary packages,
+// loaded or not.
+func (interp *Interpreter) binPaths() []string {
+	interp.pmutex.RLock()
+	defer interp.pmutex.RUnlock()
+
+	paths := make([]string, 0, len(interp.binPkg)+len(interp.lazyPkg))
+	for p := range interp.binPkg {
+		paths = append(paths, p)
+	}
+	for p := range interp.lazyPkg {
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+// UseFallback registers binary runtime symbols, used in interpreted code in
+// place of the source packages of the same import paths which can not be
+// interpreted, because they require cgo or assembly, or can not be found.
+func (interp *Interpreter) UseFallback(values Exports) {
+	for k, v := range values {
+		interp.fallback[k] = v
+	}
+}
+
+// allowedPkg returns true if the binary package path can be imported.
+func (interp *Interpreter) allowedPkg(path string) bool {
+	if path == "unsafe" && !interp.unsafePkg {
+		return false
+	}
+	return (interp.allowed == nil || interp.allowed[path]) && !interp.denied[path] && interp.newerAPI(path) == 0
+}
+
+// allowedSym returns true if the symbol name of binary package path can be used.
+func (interp *Interpreter) allowedSym(path, name string) bool {
+	return !interp.denied[path+"."+name] && interp.newerAPI(path+"."+name) == 0
+}
+
+// implicitImport imports the binary package of name in the package
+// scope of sc, if the AutoImport option is set and name is undefined.
+func (interp *Interpreter) implicitImport(sc *scope, name string) {
+	if !interp.autoImport || name == "_" {
+		return
+	}
+	if _, _, ok := sc.lookup(name); ok {
+		return
+	}
+	var ipath string
+	for _, p := range interp.binPaths() {
+		if p == "" || path.Base(p) != name || !interp.allowedPkg(p) {
+			continue
+		}
+		if ipath == "" || len(p) < len(ipath) || len(p) == len(ipath) && p < ipath {
+			ipath = p
+		}
+	}
+	if ipath == "" {
+		return
+	}
+	for sc.anc != nil && sc.anc != interp.universe {
+		sc = sc.anc
+	}
+	if sc != interp.universe {
+		sc.sym[name] = &symbol{kind: pkgSym, typ: &itype{cat: binPkgT}, path: ipath}
+	}
+}
+
+//go:generate go run gen_api.go
+
+// newerAPI returns the minor version of Go introducing the standard library
+// package or symbol s, in the form "path.Name", if later than the GoVersion
+// option, or 0.
+func (interp *Interpreter) newerAPI(s string) int {
+	if m := apiVersions[s]; interp.goMinor > 0 && m > interp.goMinor {
+		return m
 	}
-	return func() {}
+	return 0
 }
@@ -1,6 +1,9 @@
 package interp_test
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -34,13 +37,33 @@ func TestInterpConsistencyBuild(t *testing.T) {
 			file.Name() == "export1.go" || // non-main package
 			file.Name() == "export0.go" || // non-main package
 			file.Name() == "io0.go" || // use random number
+			file.Name() == "import3.go" || // relative import, not supported in module mode
+			file.Name() == "import4.go" || // relative import, not supported in module mode
+			file.Name() == "alias3.go" || // expect error
 			file.Name() == "op1.go" || // expect error
+			file.Name() == "op4.go" || // expect error
+			file.Name() == "method26.go" || // expect error
+			file.Name() == "method27.go" || // expect error
+			file.Name() == "method28.go" || // expect error
 			file.Name() == "bltn0.go" || // expect error
+			file.Name() == "chan11.go" || // expect error
+			file.Name() == "chan12.go" || // expect error
+			file.Name() == "fun7.go" || // expect error
+			file.Name() == "generic4.go" || // expect error
+			file.Name() == "generic7.go" || // expect error
+			file.Name() == "label3.go" || // expect error
+			file.Name() == "label4.go" || // expect error
+			file.Name() == "iter2.go" || // expect error
+			file.Name() == "label5.go" || // expect error
+			file.Name() == "label6.go" || // expect error
 			file.Name() == "method16.go" || // private struct field
+			file.Name() == "min1.go" || // expect error
+			file.Name() == "range5.go" || // expect error
 			file.Name() == "switch8.go" || // expect error
 			file.Name() == "switch9.go" || // expect error
 			file.Name() == "switch13.go" || // expect error
 			file.Name() == "switch19.go" || // expect error
+			file.Name() == "type13.go" || // expect error
 			file.Name() == "time0.go" || // display time (similar to random number)
 			file.Name() == "factor.go" || // bench
 			file.Name() == "fib.go" || // bench
@@ -62,62 +85,179 @@ func TestInterpConsistencyBuild(t *testing.T) {
 
 		file := file
 		t.Run(file.Name(), func(t *testing.T) {
-			filePath := filepath.Join(baseDir, file.Name())
+			testConsistency(t, dir, filepath.Join(baseDir, file.Name()))
+		})
+	}
+}
 
-			src, err := ioutil.ReadFile(filePath)
-			if err != nil {
-				t.Fatal(err)
-			}
+// testConsistency checks that the output of the program in file filePath is
+// the same when interpreted and when compiled, in directory dir.
+func testConsistency(t *testing.T, dir, filePath string) {
+	src, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
 
-			// catch stdout
-			backupStdout := os.Stdout
-			defer func() {
-				os.Stdout = backupStdout
-			}()
-			r, w, _ := os.Pipe()
-			os.Stdout = w
+	// catch stdout
+	backupStdout := os.Stdout
+	defer func() {
+		os.Stdout = backupStdout
+	}()
+	r, w, _ := os.Pipe()
+	os.Stdout = w
 
-			i := interp.New(interp.Options{})
-			i.Name = filePath
-			i.Use(stdlib.Symbols)
-			i.Use(interp.Symbols)
+	i := interp.New(interp.Options{})
+	i.Name = filePath
+	i.Use(stdlib.Symbols)
+	i.Use(interp.Symbols)
 
-			_, err = i.Eval(string(src))
-			if err != nil {
-				t.Fatal(err)
-			}
+	_, err = i.Eval(string(src))
+	if err != nil {
+		t.Fatal(err)
+	}
 
-			// read stdout
-			if err = w.Close(); err != nil {
-				t.Fatal(err)
-			}
-			outInterp, err := ioutil.ReadAll(r)
-			if err != nil {
-				t.Fatal(err)
-			}
+	// read stdout
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	outInterp, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
 
-			// restore Stdout
-			os.Stdout = backupStdout
+	// restore Stdout
+	os.Stdout = backupStdout
 
-			bin := filepath.Join(dir, strings.TrimSuffix(file.Name(), ".go"))
+	bin := filepath.Join(dir, strings.TrimSuffix(filepath.Base(filePath), ".go"))
 
-			cmdBuild := exec.Command("go", "build", "-o", bin, filePath)
-			outBuild, err := cmdBuild.CombinedOutput()
-			if err != nil {
-				t.Log(string(outBuild))
-				t.Fatal(err)
-			}
+	cmdBuild := exec.Command("go", "build", "-o", bin, filePath)
+	outBuild, err := cmdBuild.CombinedOutput()
+	if err != nil {
+		t.Log(string(outBuild))
+		t.Fatal(err)
+	}
 
-			cmd := exec.Command(bin)
-			outRun, err := cmd.CombinedOutput()
-			if err != nil {
-				t.Log(string(outRun))
-				t.Fatal(err)
+	cmd := exec.Command(bin)
+	outRun, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Log(string(outRun))
+		t.Fatal(err)
+	}
+
+	if string(outInterp) != string(outRun) {
+		t.Errorf("\nGot: %q,\n want: %q", string(outInterp), string(outRun))
+	}
+}
+
+// arithTypes are the integer types of the arithmetic consistency tests, with
+// operand values including the limits of each type.
+var arithTypes = []struct {
+	name   string
+	values []string
+}{
+	{"int8", []string{"127", "-128", "-7", "3", "-1"}},
+	{"int16", []string{"32767", "-32768", "-7", "3"}},
+	{"int32", []string{"2147483647", "-2147483648", "-7", "3"}},
+	{"int64", []string{"9223372036854775807", "-9223372036854775808", "-7", "3"}},
+	{"int", []string{"9223372036854775807", "-9223372036854775808", "-7", "3"}},
+	{"uint8", []string{"255", "0", "7", "3"}},
+	{"uint16", []string{"65535", "7", "3"}},
+	{"uint32", []string{"4294967295", "7", "3"}},
+	{"uint64", []string{"18446744073709551615", "7", "3"}},
+	{"uint", []string{"18446744073709551615", "7", "3"}},
+	{"uintptr", []string{"18446744073709551615", "7", "3"}},
+}
+
+// arithPrograms generate the bodies of the main functions of the arithmetic
+// consistency tests.
+var arithPrograms = map[string]func(w io.Writer){
+	"ops": func(w io.Writer) {
+		ops := []string{"+", "-", "*", "/", "%", "&", "|", "^", "&^"}
+		for _, typ := range arithTypes {
+			for _, a := range typ.values {
+				for _, b := range typ.values {
+					for _, op := range ops {
+						if (a == "0" || b == "0") && (op == "/" || op == "%") {
+							continue
+						}
+						fmt.Fprintf(w, "\t{\n\t\tvar a, b %s = %s, %s\n", typ.name, a, b)
+						fmt.Fprintf(w, "\t\tfmt.Println(%q, a %s b, a %s 3, 3 %s a)\n", typ.name+" "+a+" "+op+" "+b, op, op, op)
+						fmt.Fprintf(w, "\t\ta %s= b\n\t\tfmt.Println(a)\n\t}\n", op)
+					}
+				}
+				fmt.Fprintf(w, "\t{\n\t\tvar a %s = %s\n\t\tfmt.Println(-a, ^a, +a)\n", typ.name, a)
+				fmt.Fprintf(w, "\t\ta++\n\t\tfmt.Println(a)\n\t\ta -= 2\n\t\ta--\n\t\tfmt.Println(a)\n\t}\n")
+			}
+		}
+	},
+	"shifts": func(w io.Writer) {
+		counts := []string{"0", "1", "7", "8", "15", "16", "31", "32", "63", "64", "65", "200"}
+		for _, typ := range arithTypes {
+			for _, a := range typ.values {
+				for _, c := range counts {
+					for _, ct := range []string{"uint", "uint8", "int", "int16"} {
+						fmt.Fprintf(w, "\t{\n\t\tvar a %s = %s\n\t\tvar s %s = %s\n", typ.name, a, ct, c)
+						fmt.Fprintf(w, "\t\tfmt.Println(a<<s, a>>s, a<<%s, a>>%s)\n", c, c)
+						fmt.Fprintf(w, "\t\tvar b %s = 1<<s - 1\n\t\ta <<= s\n\t\tfmt.Println(a, b)\n\t}\n", typ.name)
+					}
+				}
+			}
+		}
+	},
+	"conversions": func(w io.Writer) {
+		floats := []string{"1.5", "-1.5", "2.9", "-2.9", "255.9", "-128.5", "65535.5", "4294967296.5", "1e10", "-1e10", "9.3e18", "-9.3e18", "1e19"}
+		for _, typ := range arithTypes {
+			for _, to := range arithTypes {
+				for _, a := range typ.values {
+					fmt.Fprintf(w, "\t{\n\t\tvar a %s = %s\n\t\tfmt.Println(%s(a), float32(a), float64(a))\n\t}\n", typ.name, a, to.name)
+				}
+			}
+			for _, f := range floats {
+				if typ.name[0] == 'u' && f[0] == '-' {
+					continue // implementation-specific in compiled code
+				}
+				fmt.Fprintf(w, "\t{\n\t\tvar f = %s\n\t\tvar g float32 = %s\n", f, f)
+				fmt.Fprintf(w, "\t\tfmt.Println(%s(f), %s(g))\n\t}\n", typ.name, typ.name)
+			}
+		}
+	},
+	"panics": func(w io.Writer) {
+		for _, typ := range arithTypes {
+			fmt.Fprintf(w, "\ttry(func() {\n\t\tvar a, b %s = 1, 0\n\t\tfmt.Println(a / b)\n\t})\n", typ.name)
+			fmt.Fprintf(w, "\ttry(func() {\n\t\tvar a, b %s = 1, 0\n\t\ta %%= b\n\t})\n", typ.name)
+			fmt.Fprintf(w, "\ttry(func() {\n\t\tvar a %s = 1\n\t\ts := -1\n\t\tfmt.Println(a << s)\n\t})\n", typ.name)
+			fmt.Fprintf(w, "\ttry(func() {\n\t\tvar a %s = 1\n\t\tvar s int8 = -3\n\t\ta >>= s\n\t})\n", typ.name)
+			if min := typ.values[1]; typ.name[0] == 'i' {
+				fmt.Fprintf(w, "\t{\n\t\tvar a, b %s = %s, -1\n\t\tfmt.Println(a/b, a%%b, -a, a*b)\n\t}\n", typ.name, min)
 			}
+		}
+	},
+}
+
+func TestInterpConsistencyArith(t *testing.T) {
+	if testing.Short() {
+		t.Skip("short mode")
+	}
+	dir, err := ioutil.TempDir("", "yaegi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
 
-			if string(outInterp) != string(outRun) {
-				t.Errorf("\nGot: %q,\n want: %q", string(outInterp), string(outRun))
+	for name, body := range arithPrograms {
+		name, body := name, body
+		t.Run(name, func(t *testing.T) {
+			var b bytes.Buffer
+			b.WriteString("package main\n\nimport \"fmt\"\n\n")
+			b.WriteString("func try(f func()) {\n\tdefer func() { fmt.Println(recover()) }()\n\tf()\n}\n\n")
+			b.WriteString("func main() {\n")
+			body(&b)
+			b.WriteString("}\n")
+			filePath := filepath.Join(dir, "arith_"+name+".go")
+			if err := ioutil.WriteFile(filePath, b.Bytes(), 0600); err != nil {
+				t.Fatal(err)
 			}
+			testConsistency(t, dir, filePath)
 		})
 	}
 }
@@ -131,11 +271,94 @@ func TestInterpErrorConsistency(t *testing.T) {
 		{
 			fileName:       "op1.go",
 			expectedInterp: "5:2: illegal operand types for '+=' operator",
-			expectedExec:   "5:4: constant 1.3 truncated to integer",
+			expectedExec:   "5:7: 1.3 (untyped float constant) truncated to int",
+		},
+		{
+			fileName:       "op4.go",
+			expectedInterp: "7:10: invalid operation: main.T cannot be compared",
+			expectedExec:   "7:10: invalid operation: a == b (struct containing func() cannot be compared)",
+		},
+		{
+			fileName:       "method26.go",
+			expectedInterp: "11:12: cannot use t (variable of type T) as I value in variable declaration: T does not implement I (method M has pointer receiver)",
+			expectedExec:   "11:12: cannot use t (variable of struct type T) as I value in variable declaration: T does not implement I (method M has pointer receiver)",
+		},
+		{
+			fileName:       "method27.go",
+			expectedInterp: "10:15: cannot use value of type W as io.Writer value in argument to fmt.Fprintln: W does not implement io.Writer (method Write has pointer receiver)",
+			expectedExec:   "10:15: cannot use W{} (value of struct type W) as io.Writer value in argument to fmt.Fprintln: W does not implement io.Writer (method Write has pointer receiver)",
+		},
+		{
+			fileName:       "method28.go",
+			expectedInterp: "10:9: cannot use value of type T as I value in return statement: T does not implement I (method M has pointer receiver)",
+			expectedExec:   "10:9: cannot use T{} (value of struct type T) as I value in return statement: T does not implement I (method M has pointer receiver)",
+		},
+		{
+			fileName:       "alias3.go",
+			expectedInterp: "3:18: cannot use type parameter declared in alias declaration as RHS",
+			expectedExec:   "3:18: cannot use type parameter declared in alias declaration as RHS",
 		},
 		{
 			fileName:       "bltn0.go",
 			expectedInterp: "4:7: use of builtin println not in function call",
+			expectedExec:   "4:7: println (built-in) must be called",
+		},
+		{
+			fileName:       "chan11.go",
+			expectedInterp: "5:2: invalid operation: cannot send to receive-only channel",
+		},
+		{
+			fileName:       "chan12.go",
+			expectedInterp: "5:12: invalid operation: cannot receive from send-only channel",
+		},
+		{
+			fileName:       "fun7.go",
+			expectedInterp: "5:6: f redeclared in this block",
+		},
+		{
+			fileName:       "generic4.go",
+			expectedInterp: "10:10: string does not satisfy Number",
+			expectedExec:   "10:16: string does not satisfy Number",
+		},
+		{
+			fileName:       "generic7.go",
+			expectedInterp: "9:10: []string does not satisfy ~[]byte",
+			expectedExec:   "9:13: in call to Len, S (type []string) does not satisfy ~[]byte",
+		},
+		{
+			fileName:       "iter2.go",
+			expectedInterp: "5:7: cannot use generic type Seq without instantiation",
+			expectedExec:   "5:7: cannot use generic type iter.Seq[V any] without instantiation",
+		},
+		{
+			fileName:       "label3.go",
+			expectedInterp: "4:7: goto L jumps over declaration of x",
+			expectedExec:   "4:7: goto L jumps over declaration of x",
+		},
+		{
+			fileName:       "label4.go",
+			expectedInterp: "4:7: goto L jumps into block starting at",
+			expectedExec:   "4:7: goto L jumps into block starting at",
+		},
+		{
+			fileName:       "label5.go",
+			expectedInterp: "5:9: break label not defined: L",
+			expectedExec:   "5:9: break label not defined: L",
+		},
+		{
+			fileName:       "label6.go",
+			expectedInterp: "7:12: invalid continue label L",
+			expectedExec:   "7:12: invalid continue label L",
+		},
+		{
+			fileName:       "min1.go",
+			expectedInterp: "6:17: invalid argument: mismatched types int (previous argument) and f (variable of type float64)",
+			expectedExec:   "6:17: invalid argument: mismatched types int (previous argument) and float64 (type of f)",
+		},
+		{
+			fileName:       "range5.go",
+			expectedInterp: "6:9: range over func(func(int) bool) permits only one iteration variable",
+			expectedExec:   "6:9: range over seq (value of type func(yield func(int) bool)) permits only one iteration variable",
 		},
 		{
 			fileName:       "switch8.go",
@@ -149,13 +372,18 @@ func TestInterpErrorConsistency(t *testing.T) {
 		},
 		{
 			fileName:       "switch13.go",
-			expectedInterp: "9:2: i is not a type",
-			expectedExec:   "9:2: i (type interface {}) is not a type",
+			expectedInterp: "9:7: i is not a type",
+			expectedExec:   "9:7: i (local variable) is not a type",
 		},
 		{
 			fileName:       "switch19.go",
 			expectedInterp: "37:2: duplicate case Bir in type switch",
-			expectedExec:   "37:2: duplicate case Bir in type switch",
+			expectedExec:   "37:7: duplicate case Bir in type switch",
+		},
+		{
+			fileName:       "type13.go",
+			expectedInterp: "7:18: cannot use c (variable of type Celsius) as float64 value in variable declaration",
+			expectedExec:   "7:18: cannot use c (variable of float64 type Celsius) as float64 value in variable declaration",
 		},
 	}
 
@@ -1,16 +1,30 @@
 package interp_test
 
 import (
+	"context"
 	"fmt"
+	"go/token"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/containous/yaegi/interp"
 	"github.com/containous/yaegi/stdlib"
+	"github.com/containous/yaegi/stdlib/minimal"
+	"github.com/containous/yaegi/stdlib/sandboxnet"
+	"github.com/containous/yaegi/stdlib/unsafe"
 )
 
 func init() { log.SetFlags(log.Lshortfile) }
@@ -39,8 +53,8 @@ func TestEvalArithmetic(t *testing.T) {
 		{desc: "sub_FI", src: "7.2 - 3", res: "4.2"},
 		{desc: "sub_IF", src: "7 - 3.2", res: "3.8"},
 		{desc: "mul_II", src: "2 * 3", res: "6"},
-		{desc: "mul_FI", src: "2.2 * 3", res: "6.6000000000000005"},
-		{desc: "mul_IF", src: "3 * 2.2", res: "6.6000000000000005"},
+		{desc: "mul_FI", src: "2.2 * 3", res: "6.6"},
+		{desc: "mul_IF", src: "3 * 2.2", res: "6.6"},
 		{desc: "rem_FI", src: "8.0 % 4", err: "1:28: illegal operand types for '%' operator"},
 		{desc: "shl_II", src: "1 << 8", res: "256"},
 		{desc: "shl_IN", src: "1 << -1", err: "1:28: illegal operand types for '<<' operator"},
@@ -100,6 +114,26 @@ func TestEvalFunc(t *testing.T) {
 	})
 }
 
+func TestEvalRedefine(t *testing.T) {
+	i := interp.New(interp.Options{})
+	eval(t, i, `func f() int { return 1 }`)
+	eval(t, i, `func g() int { return f() + 10 }`)
+	eval(t, i, `h := func() int { return f() + 20 }`)
+	eval(t, i, `var fs = []func() int{f}`)
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, `func f() int { return 2 }`) }, src: "f()", res: "2"},
+		{src: "g()", res: "12"},
+		{src: "h()", res: "22"},
+		{src: "fs[0]()", res: "2"},
+		{pre: func() { eval(t, i, `func r(n int) int { if n == 0 { return 0 }; return r(n-1) + 1 }`) }, src: "r(3)", res: "3"},
+		{pre: func() { eval(t, i, `func r(n int) int { if n == 0 { return 0 }; return r(n-1) + 2 }`) }, src: "r(3)", res: "6"},
+		// A new signature defines a new function, the previous one is kept by existing code
+		{pre: func() { eval(t, i, `func f() string { return "new" }`) }, src: "f()", res: "new"},
+		{src: "g()", res: "12"},
+		{src: "func k() {}; func k() {}", err: "1:32: k redeclared in this block"},
+	})
+}
+
 func TestEvalImport(t *testing.T) {
 	i := interp.New(interp.Options{})
 	i.Use(stdlib.Symbols)
@@ -108,6 +142,58 @@ func TestEvalImport(t *testing.T) {
 	})
 }
 
+func TestEvalAutoImport(t *testing.T) {
+	i := interp.New(interp.Options{AutoImport: true})
+	i.Use(stdlib.Symbols)
+	runTests(t, i, []testCase{
+		{desc: "func", src: `strings.ToUpper("a")`, res: "A"},
+		{desc: "type", pre: func() { eval(t, i, `func f(b *bytes.Buffer) int { return b.Len() }`) }, src: `f(bytes.NewBufferString("ab"))`, res: "2"},
+		{desc: "shortest path", src: `rand.New(rand.NewSource(1)).Intn(1)`, res: "0"},
+		{desc: "shadowed", pre: func() { eval(t, i, `type P struct{ Base int }; func g() int { path := P{3}; return path.Base }`) }, src: "g()", res: "3"},
+		{desc: "unknown", src: `nopkg.F()`, err: "1:28: undefined: nopkg"},
+	})
+
+	i = interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	if _, err := i.Eval(`strings.ToUpper("a")`); err == nil || !strings.Contains(err.Error(), "undefined: strings") {
+		t.Errorf("got %v, want undefined: strings", err)
+	}
+}
+
+func TestEvalMulti(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `import "strconv"`)
+	eval(t, i, `func f() (int, string, error) { return 1, "a", nil }`)
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{src: `strconv.Atoi("12")`, want: "[12 <nil>]"},
+		{src: `strconv.Atoi("x")`, want: `[0 strconv.Atoi: parsing "x": invalid syntax]`},
+		{src: "f()", want: "[1 a <nil>]"},
+		{src: "1 + 2", want: "[3]"},
+	}
+	for _, test := range tests {
+		res, err := i.EvalMulti(test.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var values []interface{}
+		for _, v := range res {
+			values = append(values, v.Interface())
+		}
+		if got := fmt.Sprint(values); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.src, got, test.want)
+		}
+	}
+
+	if _, err := i.EvalMulti(`strconv.Atoi()`); err == nil {
+		t.Error("got no error")
+	}
+}
+
 func TestEvalNil(t *testing.T) {
 	i := interp.New(interp.Options{})
 	i.Use(stdlib.Symbols)
@@ -153,6 +239,31 @@ func TestEvalNil(t *testing.T) {
 			src: "Hello()",
 			res: "<nil>",
 		},
+		{
+			desc: "return nil pointer as error",
+			pre: func() {
+				eval(t, i, `
+					package baz
+
+					type E struct{}
+
+					func (e *E) Error() string { return "E" }
+
+					func Get() error {
+						var e *E
+						return e
+					}
+				`)
+				v := eval(t, i, `baz.Get`)
+				fn, ok := v.Interface().(func() error)
+				if !ok {
+					t.Fatal("conversion failed")
+				}
+				if err := fn(); err == nil || err.Error() != "E" {
+					t.Fatalf("got %v, want non nil error E", err)
+				}
+			},
+		},
 	})
 }
 
@@ -253,6 +364,7 @@ func TestEvalCompositeBin0(t *testing.T) {
 	i.Use(stdlib.Symbols)
 	eval(t, i, `
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"time"
@@ -288,6 +400,27 @@ func TestEvalComparison(t *testing.T) {
 	})
 }
 
+func TestEvalConstant(t *testing.T) {
+	i := interp.New(interp.Options{})
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, "const c = 1 << 100 >> 98") }, src: "c", res: "4"},
+		{pre: func() { eval(t, i, "const big = 1 << 100") }, src: "big / (1 << 90)", res: "1024"},
+		{src: "float64(big)", res: "1.2676506002282294e+30"},
+		{src: "0.1 + 0.2 == 0.3", res: "true"},
+		{src: "1e400 / 1e390", res: "1e+10"},
+		{src: "(2.5 + 1i) * (2.5 + 1i)", res: "(5.25+5i)"},
+		{src: "uint64(1<<64 - 1)", res: "18446744073709551615"},
+		{src: "7 / 2", res: "3"},
+		{src: "7 / 2.0", res: "3.5"},
+		{src: "a := big", err: "1:33: constant 1267650600228229401496703205376 overflows int"},
+		{src: "var b int8 = 1000", err: "1:27: constant 1000 overflows int8"},
+		{src: "var d int = 2.5", err: "1:26: constant 2.5 truncated to integer"},
+		{src: "e := 1 / 0", err: "1:37: invalid operation: division by zero"},
+		{src: "real(big * 1i) / big", res: "0"},
+		{src: "imag(complex(1e400, 1e-400) * 1e400)", res: "1"},
+	})
+}
+
 func TestEvalCompositeArray(t *testing.T) {
 	i := interp.New(interp.Options{})
 	runTests(t, i, []testCase{
@@ -299,8 +432,11 @@ func TestEvalUnary(t *testing.T) {
 	i := interp.New(interp.Options{})
 	runTests(t, i, []testCase{
 		{src: "a := -1", res: "-1"},
-		{src: "b := +1", res: "1", skip: "BUG"},
+		{src: "b := +1", res: "1"},
 		{src: "c := !false", res: "true"},
+		{src: "d := ^0", res: "-1"},
+		{pre: func() { eval(t, i, "var e uint8 = 3") }, src: "^e", res: "252"},
+		{src: "^1.5", err: "1:28: illegal operand type for '^' operator"},
 	})
 }
 
@@ -368,46 +504,2060 @@ func TestEvalChan(t *testing.T) {
 	})
 }
 
-func runTests(t *testing.T, i *interp.Interpreter, tests []testCase) {
-	for _, test := range tests {
-		t.Run(test.desc, func(t *testing.T) {
-			if test.skip != "" {
-				t.Skip(test.skip)
+func TestEvalGeneric(t *testing.T) {
+	i := interp.New(interp.Options{})
+	eval(t, i, `func Id[T any](v T) T { return v }`)
+	eval(t, i, `type Box[T any] struct{ V T }`)
+	eval(t, i, `func (b Box[T]) Get() T { return b.V }`)
+	runTests(t, i, []testCase{
+		{src: "Id(3)", res: "3"},
+		{src: `Id("hello")`, res: "hello"},
+		{src: "Id[float64](2)", res: "2"},
+		{src: `Box[string]{"foo"}.Get()`, res: "foo"},
+		{src: "Box[int]{Id(4)}.V", res: "4"},
+		{src: "Id[int, int](2)", err: "1:28: got 2 type arguments but Id has 1 type parameters"},
+	})
+}
+
+func TestEvalCompile(t *testing.T) {
+	i := interp.New(interp.Options{})
+	eval(t, i, "var n int")
+	p, err := i.Compile("n++; n * 10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []int{10, 20, 30} {
+		res, err := i.Execute(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Interface(); got != want {
+			t.Fatalf("got %v, want %d", got, want)
+		}
+	}
+	if _, err := i.Compile("n + undefined"); err == nil {
+		t.Fatal("expected a compilation error")
+	}
+}
+
+func TestEvalEagerCompile(t *testing.T) {
+	for _, eager := range []bool{false, true} {
+		t.Run(fmt.Sprint("eager=", eager), func(t *testing.T) {
+			i := interp.New(interp.Options{EagerCompile: eager})
+			runTests(t, i, []testCase{
+				{pre: func() { eval(t, i, "func f() int { return 1 }; func g() int { return f() + 10 }") }, src: "g()", res: "11"},
+				{pre: func() { eval(t, i, "func f() int { return 2 }") }, src: "g()", res: "12"},
+				{pre: func() { eval(t, i, "func h() func() int { n := 0; return func() int { n++; return n } }") }, src: "c := h(); c(); c()", res: "2"},
+				{pre: func() { eval(t, i, "func r() int { c := make(chan int); go func() { c <- f() * 100 }(); return <-c }") }, src: "r()", res: "200"},
+			})
+			v := eval(t, i, "g")
+			if got := v.Interface().(func() int)(); got != 12 {
+				t.Errorf("got %d, want 12", got)
 			}
-			if test.pre != nil {
-				test.pre()
+		})
+	}
+}
+
+func TestEvalConcurrent(t *testing.T) {
+	i := interp.New(interp.Options{})
+	eval(t, i, "func sq(x int) int { return x * x }")
+
+	const n = 20
+	funcs := make([]func() int, n)
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for k := 0; k < n; k++ {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			if _, err := i.Eval(fmt.Sprintf("var v%d = %d", k, k)); err != nil {
+				errs <- err
+				return
 			}
-			if test.src != "" {
-				assertEval(t, i, test.src, test.err, test.res)
+			if _, err := i.Eval(fmt.Sprintf("func f%d() int { return sq(v%d) }", k, k)); err != nil {
+				errs <- err
+				return
 			}
-		})
+			v, err := i.Eval(fmt.Sprintf("f%d()", k))
+			if err != nil {
+				errs <- err
+				return
+			}
+			if got := int(v.Int()); got != k*k {
+				errs <- fmt.Errorf("f%d: got %d, want %d", k, got, k*k)
+				return
+			}
+			errs <- i.GetFunc(fmt.Sprintf("f%d", k), &funcs[k])
+		}(k)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Functions obtained from the interpreter run concurrently
+	res := make([]int, n)
+	for k := 0; k < n; k++ {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				res[k] += funcs[k]()
+			}
+		}(k)
+	}
+	wg.Wait()
+	for k, r := range res {
+		if r != 100*k*k {
+			t.Errorf("f%d: got %d, want %d", k, r, 100*k*k)
+		}
 	}
 }
 
-func eval(t *testing.T, i *interp.Interpreter, src string) reflect.Value {
-	t.Helper()
-	res, err := i.Eval(src)
+func TestEvalReentrant(t *testing.T) {
+	i := interp.New(interp.Options{})
+	var errs []error
+	var syms map[string]reflect.Value
+	i.Use(interp.Exports{"ext": {"Call": reflect.ValueOf(func() {
+		// Called by interpreted code during an evaluation, which holds the interpreter
+		_, err := i.Eval("1")
+		errs = append(errs, err)
+		_, err = i.EvalWithContext(context.Background(), "1")
+		errs = append(errs, err)
+		syms = i.Symbols("main")
+	})}})
+
+	eval(t, i, `import "ext"`)
+	done := make(chan error, 1)
+	go func() {
+		_, err := i.Eval("ext.Call()")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("reentrant evaluation is blocked")
+	}
+	if len(errs) != 2 || errs[0] != interp.ErrReentrant || errs[1] != interp.ErrReentrant || syms != nil {
+		t.Errorf("got errors %v and symbols %v, want %v", errs, syms, interp.ErrReentrant)
+	}
+
+	// The interpreter is released by the evaluation
+	if res := eval(t, i, "1 + 2"); res.Int() != 3 {
+		t.Errorf("got %v, want 3", res)
+	}
+}
+
+func TestTemplateInit(t *testing.T) {
+	var inits int32
+	tmpl, err := interp.NewTemplate(interp.Options{}, func(i *interp.Interpreter) error {
+		i.Use(interp.Exports{"ext": {"Init": reflect.ValueOf(func() { atomic.AddInt32(&inits, 1) })}})
+		_, err := i.Eval(`
+import "ext"
+
+var v = []int{1}
+
+func init() { ext.Init() }
+`)
+		return err
+	})
 	if err != nil {
 		t.Fatal(err)
 	}
-	return res
+
+	// The init function runs again in each interpreter, which has its own globals
+	for k := 1; k <= 2; k++ {
+		i, err := tmpl.New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := atomic.LoadInt32(&inits); n != int32(1+k) {
+			t.Errorf("got %d init calls, want %d", n, 1+k)
+		}
+		if res := eval(t, i, "v = append(v, 2); len(v)"); res.Int() != 2 {
+			t.Errorf("got %v elements, want 2", res)
+		}
+	}
 }
 
-func assertEval(t *testing.T, i *interp.Interpreter, src, expectedError, expectedRes string) {
-	res, err := i.Eval(src)
+func TestTemplate(t *testing.T) {
+	tmpl, err := interp.NewTemplate(interp.Options{}, func(i *interp.Interpreter) error {
+		i.Use(stdlib.Symbols)
+		if _, err := i.Eval(`
+package main
 
-	if expectedError != "" {
-		if err == nil || !strings.Contains(err.Error(), expectedError) {
-			t.Fatalf("got %v, want %s", err, expectedError)
+import "fmt"
+
+var count int
+
+func init() { count = 10 }
+
+func Inc() int { count++; return count }
+
+func Hello(s string) { fmt.Println("hello", s, count) }
+`); err != nil {
+			return err
 		}
-		return
+		_, err := i.Eval("x := Inc()")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 10
+	errs := make(chan error, n)
+	for k := 0; k < n; k++ {
+		go func(k int) {
+			i, err := tmpl.New()
+			if err != nil {
+				errs <- err
+				return
+			}
+			for j := 0; j < k; j++ {
+				if _, err := i.Eval("Inc()"); err != nil {
+					errs <- err
+					return
+				}
+			}
+			var out string
+			out, _ = i.CaptureOutput(func() { _, err = i.Eval(fmt.Sprintf("Hello(%q)", fmt.Sprint(k))) })
+			if err != nil {
+				errs <- err
+				return
+			}
+			if want := fmt.Sprintf("hello %d %d\n", k, 11+k); out != want {
+				errs <- fmt.Errorf("got %q, want %q", out, want)
+				return
+			}
+			if _, err := i.Eval("undefined()"); err == nil || !strings.Contains(err.Error(), "1:28: undefined: undefined") {
+				errs <- fmt.Errorf("got error %v", err)
+				return
+			}
+			errs <- nil
+		}(k)
 	}
+	for k := 0; k < n; k++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestEvalMemoryLimit(t *testing.T) {
+	i := interp.New(interp.Options{MaxMemory: 1 << 20})
+	runTests(t, i, []testCase{
+		{src: "len(make([]byte, 1000))", res: "1000"},
+		{src: "make([]int, 1 << 40)", err: interp.ErrMemoryLimit.Error()},
+		{src: `s := ""; for i := 0; i < 1 << 20; i++ { s += "abc" }; len(s)`, err: interp.ErrMemoryLimit.Error()},
+		{src: "a := []int{}; for i := 0; i < 1 << 20; i++ { a = append(a, i) }; len(a)", err: interp.ErrMemoryLimit.Error()},
+		{src: "m := map[int]int{}; for i := 0; i < 1 << 20; i++ { m[i] = i }; len(m)", err: interp.ErrMemoryLimit.Error()},
+		{src: "a := []int{}; for i := 0; i < 1000; i++ { a = append(a, i) }; len(a)", res: "1000"},
+		{src: `s := "abcdefgh"; n := 0; for i := 0; i < 1 << 20; i++ { n += len([]byte(s)) }; n`, err: interp.ErrMemoryLimit.Error()},
+		{src: `b := []byte("abcdefgh"); n := 0; for i := 0; i < 1 << 20; i++ { n += len(string(b)) }; n`, err: interp.ErrMemoryLimit.Error()},
+		{src: `s := "abcdefgh"; n := 0; for i := 0; i < 1000; i++ { n += len([]rune(s)) }; n`, res: "8000"},
+		{pre: func() { eval(t, i, "func lit(k int) { for i := 0; i < k; i++ { f := func() {}; _ = f } }") }, src: "lit(1 << 20)", err: interp.ErrMemoryLimit.Error()},
+		{pre: func() { eval(t, i, "func one() int { return 1 }") }, src: "n := 0; for i := 0; i < 1 << 20; i++ { n += one() }; n", err: interp.ErrMemoryLimit.Error()},
+		{src: "n := 0; for i := 0; i < 1000; i++ { n += one() }; n", res: "1000"},
+	})
+}
+
+func TestEvalStepLimit(t *testing.T) {
+	i := interp.New(interp.Options{MaxSteps: 10000})
+	runTests(t, i, []testCase{
+		{src: "n := 0; for i := 0; i < 100; i++ { n += i }; n", res: "4950"},
+		{src: "for {}", err: interp.ErrStepLimit.Error()},
+		{pre: func() { eval(t, i, "func loop() { for {} }") }, src: "loop()", err: interp.ErrStepLimit.Error()},
+		{src: "m := 0; for i := 0; i < 1000; i++ { m++ }; m", res: "1000"},
+	})
+
+	// The limit is exceeded by the first evaluation of a fresh interpreter
+	i = interp.New(interp.Options{MaxSteps: 10000})
+	runTests(t, i, []testCase{
+		{src: "for {}", err: interp.ErrStepLimit.Error()},
+		{src: "1 + 2", res: "3"},
+	})
+}
+
+func TestEvalMetrics(t *testing.T) {
+	i := interp.New(interp.Options{CollectMetrics: true})
+	eval(t, i, "s := make([]int, 1000); c := make(chan int); for k := 0; k < 3; k++ { go func() { c <- 1 }() }; <-c + <-c + <-c + len(s)")
+	m := i.Metrics()
+	if m.Steps == 0 || m.Goroutines != 3 || m.Alloc < 8000 || m.Time <= 0 {
+		t.Errorf("got metrics %+v", m)
+	}
+	eval(t, i, "n := 0; for k := 0; k < 100; k++ { n += k }; n")
+	m2 := i.Metrics()
+	if m2.Steps <= 100 || m2.Goroutines != 0 || m2.Alloc != 0 {
+		t.Errorf("got metrics %+v", m2)
+	}
+	if total := i.TotalMetrics(); total.Steps != m.Steps+m2.Steps || total.Goroutines != 3 || total.Alloc != m.Alloc {
+		t.Errorf("got total metrics %+v, want the sum of %+v and %+v", total, m, m2)
+	}
+
+	var b strings.Builder
+	if err := i.WriteMetrics(&b); err != nil {
+		t.Fatal(err)
+	}
+	if want := "# TYPE yaegi_goroutines_total counter\nyaegi_goroutines_total 3\n"; !strings.Contains(b.String(), want) {
+		t.Errorf("got %q, want it to contain %q", b.String(), want)
+	}
+	if err := interp.New(interp.Options{}).WriteMetrics(&b); err == nil {
+		t.Error("got no error for disabled metrics")
+	}
+}
+
+func TestEvalSandbox(t *testing.T) {
+	i := interp.New(interp.Options{AllowedPackages: []string{"fmt", "strings"}, DeniedSymbols: []string{"strings.Repeat"}})
+	i.Use(stdlib.Symbols)
+	runTests(t, i, []testCase{
+		{src: `import "os/exec"`, err: `1:21: import "os/exec" not allowed`},
+		{pre: func() { eval(t, i, `import "strings"`) }, src: `strings.ToUpper("a")`, res: "A"},
+		{src: `strings.Repeat("a", 2)`, err: "1:28: use of strings.Repeat not allowed"},
+		{src: `f := strings.Repeat; f`, err: "1:33: use of strings.Repeat not allowed"},
+	})
+
+	i = interp.New(interp.Options{AllowedPackages: []string{"fmt"}, Unrestricted: true})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `import "os/exec"`)
+}
+
+func TestEvalSandboxNet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { fmt.Fprint(w, "hello ", r.Method) }))
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "http://")
 
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(sandboxnet.New(sandboxnet.Options{Policy: sandboxnet.AllowHosts(host)}).Symbols())
+	eval(t, i, `
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+)
+
+func get(url string) string {
+	req, err := http.NewRequest(http.MethodPut, url, http.NoBody)
 	if err != nil {
-		t.Fatalf("got an error %v", err)
+		return err.Error()
 	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err.Error()
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	return string(b)
+}
 
-	if fmt.Sprintf("%v", res) != expectedRes {
-		t.Fatalf("got %v, want %s", res, expectedRes)
+func dial(address string) string {
+	c, err := net.Dial("tcp", address)
+	if err != nil {
+		return err.Error()
+	}
+	c.Close()
+	return "connected"
+}
+`)
+	runTests(t, i, []testCase{
+		{src: `get("` + srv.URL + `")`, res: "hello PUT"},
+		{src: `get("http://example.com/")`, res: `Put "http://example.com/": dial tcp: connection not allowed`},
+		{src: `dial("` + host + `")`, res: "connected"},
+		{src: `dial("127.0.0.1:1")`, res: "dial tcp: connection not allowed"},
+	})
+
+	// The requests allowed by the policy are made by the transport of the host
+	i = interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(sandboxnet.New(sandboxnet.Options{
+		Policy: sandboxnet.AllowHosts("example.com:80"),
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusTeapot, Body: http.NoBody, Request: r}, nil
+		}),
+	}).Symbols())
+	eval(t, i, `import "net/http"`)
+	runTests(t, i, []testCase{
+		{src: `_, err := http.Get("http://example.com:8080/"); err.Error()`, res: `Get "http://example.com:8080/": dial tcp: connection not allowed`},
+		{src: `r, _ := http.Get("http://example.com/"); r.StatusCode`, res: "418"},
+	})
+
+	// The functions of the other packages dialing by themselves are removed
+	eval(t, i, `import (
+	"crypto/tls"
+	"net/rpc"
+	"net/smtp"
+	"net/textproto"
+)`)
+	runTests(t, i, []testCase{
+		{src: `tls.Dial("tcp", "127.0.0.1:443", nil)`, err: `1:28: package tls "crypto/tls" has no symbol Dial`},
+		{src: `rpc.Dial("tcp", "127.0.0.1:1234")`, err: `1:28: package rpc "net/rpc" has no symbol Dial`},
+		{src: `smtp.SendMail("127.0.0.1:25", nil, "a", nil, nil)`, err: `1:28: package smtp "net/smtp" has no symbol SendMail`},
+		{src: `textproto.Dial("tcp", "127.0.0.1:25")`, err: `1:28: package textproto "net/textproto" has no symbol Dial`},
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestEvalEnv(t *testing.T) {
+	i := interp.New(interp.Options{Env: []string{"HOME=/home/script", "A=1", "A=2"}})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `import "os"`)
+	runTests(t, i, []testCase{
+		{src: `os.Getenv("A")`, res: "2"},
+		{src: `os.Setenv("B", "3"); os.ExpandEnv("$HOME/$B")`, res: "/home/script/3"},
+		{src: `_, ok := os.LookupEnv("PATH"); ok`, res: "false"},
+		{src: `os.Unsetenv("HOME"); os.Environ()`, res: "[A=2 B=3]"},
+		{src: `os.Setenv("C=", "x")`, res: "setenv: invalid argument"},
+	})
+	eval(t, i, `import "os/exec"`)
+	runTests(t, i, []testCase{
+		{src: `exec.Command("env").Env`, res: "[A=2 B=3]"},
+	})
+	if _, err := exec.LookPath("sh"); err == nil {
+		eval(t, i, `func run(s string) string { out, _ := exec.Command("sh", "-c", s).Output(); return string(out) }`)
+		runTests(t, i, []testCase{
+			{src: `run("echo $A$HOME")`, res: "2\n"},
+		})
+	}
+	if _, ok := os.LookupEnv("B"); ok {
+		t.Error("process environment modified")
+	}
+	if env := i.Environ(); !reflect.DeepEqual(env, []string{"A=2", "B=3"}) {
+		t.Errorf("got %v, want %v", env, []string{"A=2", "B=3"})
+	}
+
+	// Reset restores the initial environment
+	i.Reset()
+	if env := i.Environ(); !reflect.DeepEqual(env, []string{"A=2", "HOME=/home/script"}) {
+		t.Errorf("got %v, want %v", env, []string{"A=2", "HOME=/home/script"})
+	}
+}
+
+func TestEvalUnsafe(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(unsafe.Symbols)
+	runTests(t, i, []testCase{
+		{src: `import "unsafe"`, err: `1:21: import "unsafe" not allowed`},
+	})
+
+	i = interp.New(interp.Options{AllowUnsafe: true})
+	i.Use(unsafe.Symbols)
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, `import "unsafe"`) }, src: `unsafe.Sizeof(int32(1))`, res: "4"},
+		{pre: func() { eval(t, i, `type T struct{ a bool; b int64; E }; type E struct{ c, d int16 }`) }, src: `unsafe.Sizeof(T{})`, res: "24"},
+		{src: `unsafe.Alignof(T{}.b)`, res: "8"},
+		{src: `unsafe.Offsetof(T{}.b)`, res: "8"},
+		{src: `unsafe.Offsetof(T{}.d)`, res: "18"},
+		{src: `unsafe.Offsetof(T{}.E)`, res: "16"},
+		{pre: func() { eval(t, i, `const n = unsafe.Sizeof(int16(0))`) }, src: `len([n]int64{})`, res: "2"},
+		{pre: func() { eval(t, i, `var x int64 = 1`) }, src: `*(*int64)(unsafe.Pointer(&x)) = 2; x`, res: "2"},
+		{src: `p := unsafe.Pointer(&x); (*int64)(unsafe.Pointer(uintptr(p))) == &x`, res: "true"},
+		{pre: func() { eval(t, i, `var f float64 = 1`) }, src: `*(*uint64)(unsafe.Pointer(&f))`, res: "4607182418800017408"},
+	})
+}
+
+func TestEvalGoVersion(t *testing.T) {
+	i := interp.New(interp.Options{GoVersion: "1.8"})
+	i.Use(stdlib.Symbols)
+	runTests(t, i, []testCase{
+		{src: `import "math/bits"`, err: `1:21: import "math/bits" requires go1.9`},
+		{pre: func() { eval(t, i, `import "strings"`) }, src: `strings.Contains("ab", "b")`, res: "true"},
+		{pre: func() { eval(t, i, `import "os"`) }, src: `os.UserCacheDir()`, err: "1:28: os.UserCacheDir requires go1.11"},
+		{src: `var b strings.Builder`, err: "1:20: strings.Builder requires go1.10"},
+		{src: `import "slices"`, err: `1:21: import "slices" requires go1.21`},
+	})
+
+	i = interp.New(interp.Options{GoVersion: "go1.9.2"})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `import "math/bits"`)
+
+	// An invalid version is reported by evaluations
+	i = interp.New(interp.Options{GoVersion: "1.x"})
+	if _, err := i.Eval("1"); err == nil || err.Error() != `invalid Go version: "1.x"` {
+		t.Errorf("got %v, want invalid Go version", err)
+	}
+	if _, err := i.Compile("1"); err == nil || err.Error() != `invalid Go version: "1.x"` {
+		t.Errorf("got %v, want invalid Go version", err)
+	}
+}
+
+func TestEvalLoopVar(t *testing.T) {
+	src := `func loops() (r []int) {
+		var fs []func() int
+		var ps []*int
+		for i := 0; i < 3; i++ {
+			fs = append(fs, func() int { return i })
+			ps = append(ps, &i)
+		}
+		for _, s := range []string{"a", "bc"} {
+			fs = append(fs, func() int { return len(s) })
+		}
+		for k, f := range fs {
+			r = append(r, f())
+			if k < len(ps) {
+				r = append(r, *ps[k])
+			}
+		}
+		return r
+	}`
+	for version, want := range map[string]string{"1.21": "[3 3 3 3 3 3 2 2]", "1.22": "[0 0 1 1 2 2 1 2]"} {
+		i := interp.New(interp.Options{GoVersion: version})
+		eval(t, i, src)
+		if res := eval(t, i, "loops()"); fmt.Sprint(res) != want {
+			t.Errorf("go%s: got %v, want %s", version, res, want)
+		}
+	}
+}
+
+func TestEvalLoopVarGlobal(t *testing.T) {
+	for version, want := range map[string]string{"1.21": "[3 3 3 2 2]", "1.22": "[0 1 2 1 2]"} {
+		i := interp.New(interp.Options{GoVersion: version})
+		eval(t, i, `var fs []func() int`)
+		eval(t, i, `var ps []*int`)
+		eval(t, i, `for i := 0; i < 3; i++ { fs = append(fs, func() int { return i }); ps = append(ps, &i) }`)
+		eval(t, i, `for _, s := range []string{"a", "bc"} { fs = append(fs, func() int { return len(s) }) }`)
+		if res := eval(t, i, `r := []int{}; for _, f := range fs { r = append(r, f()) }; r`); fmt.Sprint(res) != want {
+			t.Errorf("go%s: got %v, want %s", version, res, want)
+		}
+		if res := eval(t, i, `*ps[0] + *ps[2]`); version == "1.22" && res.Int() != 2 {
+			t.Errorf("go%s: got %v, want 2", version, res)
+		}
+		// Variables of a for statement are not declared in the global scope
+		if _, err := i.Eval("i"); err == nil || !strings.Contains(err.Error(), "undefined: i") {
+			t.Errorf("go%s: got %v, want undefined: i", version, err)
+		}
+	}
+}
+
+func TestEvalBuiltinVersion(t *testing.T) {
+	i := interp.New(interp.Options{GoVersion: "1.20"})
+	for _, src := range []string{"min(1, 2)", "max(1, 2)", "clear([]int{})", "var v = min(1, 2)"} {
+		name := strings.TrimPrefix(src[:strings.Index(src, "(")], "var v = ")
+		if _, err := i.Eval(src); err == nil || !strings.Contains(err.Error(), name+" requires go1.21 or later") {
+			t.Errorf("%s: got %v, want %s requires go1.21 or later", src, err, name)
+		}
+	}
+	i = interp.New(interp.Options{GoVersion: "1.21"})
+	if res := eval(t, i, "min(3, 1.5) + max(1, 2)"); fmt.Sprint(res) != "3.5" {
+		t.Errorf("got %v, want 3.5", res)
+	}
+	if res := eval(t, i, "x := min(1, 2); x"); res.Kind() != reflect.Int || res.Int() != 1 {
+		t.Errorf("got %v of kind %s, want 1 of kind int", res, res.Kind())
+	}
+}
+
+func TestEvalRangeFuncVersion(t *testing.T) {
+	decl, src := "func seq(yield func(int) bool) { yield(1) }", "n := 0; for i := range seq { n += i }; n"
+	i := interp.New(interp.Options{GoVersion: "1.22"})
+	eval(t, i, decl)
+	if _, err := i.Eval(src); err == nil || !strings.Contains(err.Error(), "range over func(func(int) bool) requires go1.23 or later") {
+		t.Errorf("got %v, want range over func(func(int) bool) requires go1.23 or later", err)
+	}
+	i = interp.New(interp.Options{GoVersion: "1.23"})
+	eval(t, i, decl)
+	if res := eval(t, i, src); fmt.Sprint(res) != "1" {
+		t.Errorf("got %v, want 1", res)
+	}
+}
+
+func TestEvalAlias(t *testing.T) {
+	i := interp.New(interp.Options{GoVersion: "1.23"})
+	i.Use(stdlib.Symbols)
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, `import "time"`); eval(t, i, "type D = time.Duration") }, src: "D(90) * time.Second", res: "1m30s"},
+		{pre: func() { eval(t, i, "type F = float64; var f F = 1.5") }, src: "g := float64(0); g = f; g", res: "1.5"},
+		{pre: func() { eval(t, i, "type C float64; var c C = 2") }, src: "var h float64 = c", err: "1:30: cannot use c (variable of type C) as float64 value in variable declaration"},
+		{src: "type S[T any] = []T", err: "1:19: generic type alias requires go1.24 or later"},
+	})
+	i = interp.New(interp.Options{GoVersion: "1.24"})
+	eval(t, i, "type S[T any] = []T")
+	if res := eval(t, i, "len(S[int]{1, 2})"); fmt.Sprint(res) != "2" {
+		t.Errorf("got %v, want 2", res)
+	}
+}
+
+func TestEvalRangeFuncBinary(t *testing.T) {
+	var stopped int
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{
+		"ext": {
+			"Words": reflect.ValueOf(func(yield func(int, string) bool) {
+				for k, w := range []string{"a", "b", "c"} {
+					if !yield(k, w) {
+						stopped = k
+						return
+					}
+				}
+			}),
+		},
+	})
+	eval(t, i, `import "ext"`)
+	eval(t, i, `func words() (r []string) {
+		for k, w := range ext.Words {
+			if k == 1 {
+				continue
+			}
+			r = append(r, w)
+		}
+		for _, w := range ext.Words {
+			if w == "b" {
+				break
+			}
+			r = append(r, w)
+		}
+		return r
+	}`)
+	if res := eval(t, i, "words()"); fmt.Sprint(res) != "[a c a]" || stopped != 1 {
+		t.Errorf("got %v, stopped at %d, want [a c a], stopped at 1", res, stopped)
+	}
+}
+
+func TestEvalAllErrors(t *testing.T) {
+	i := interp.New(interp.Options{AllErrors: true})
+	_, err := i.Eval("package main\nfunc f() { a := undef }\nfunc g() { b := 1 + \"a\" }\nfunc main() {}\n")
+	l, ok := err.(interp.ErrorList)
+	if !ok || len(l) != 2 {
+		t.Fatalf("got %#v, want an ErrorList of 2 errors", err)
+	}
+	if l[1].Phase != interp.TypePhase || l[1].Pos.Line != 3 {
+		t.Errorf("got phase %v at %v, want type at line 3", l[1].Phase, l[1].Pos)
+	}
+	want := "2:17: undefined: undef\n3:17: illegal operand types for '+' operator"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err, want)
+	}
+
+	// The interpreter is still usable after errors
+	if res := eval(t, i, "1 + 2"); res.Interface() != 3 {
+		t.Errorf("got %v, want 3", res)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		desc, src string
+		errs      []string
+	}{
+		{desc: "valid", src: "package main\nfunc main() { println(1) }\n"},
+		{desc: "syntax", src: "package main\nfunc main() {\n\tx := 1 +\n\t)\n}\nfunc g( {\n", errs: []string{
+			"4:2: expected operand, found ')'",
+			"6:11: expected ';', found 'EOF'",
+		}},
+		{desc: "type", src: "package main\nfunc f() { a := undef }\nfunc main() { println(1 + \"a\") }\n", errs: []string{
+			"2:17: undefined: undef",
+			"3:23: illegal operand types for '+' operator",
+		}},
+		{desc: "vet", src: "package main\ntype T int\nfunc main() { var i T; var r rune; println(string(i), string(r), string(65)) }\n", errs: []string{
+			"3:44: conversion from T (int) to string yields a string of one rune, not a string of digits",
+			"3:66: conversion from untyped int to string yields a string of one rune, not a string of digits",
+		}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			i := interp.New(interp.Options{})
+			var errs []string
+			for _, err := range i.Check(test.src) {
+				errs = append(errs, err.Error())
+			}
+			if !reflect.DeepEqual(errs, test.errs) {
+				t.Errorf("got %q, want %q", errs, test.errs)
+			}
+		})
+	}
+
+	// The checked code is not run
+	i := interp.New(interp.Options{})
+	if errs := i.Check(`package main; func main() { panic("run") }`); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	src := "package main\nimport \"strings\"\nfunc f(s string) int { x := strings.ToUpper(s); return len(x) }\nfunc main() { f(undef) }\n"
+	idents, errs := i.Inspect(src)
+	if len(errs) != 1 || errs[0].Error() != "4:17: undefined: undef" {
+		t.Errorf("got errors %v", errs)
+	}
+
+	var got []string
+	for _, id := range idents {
+		s := fmt.Sprintf("%d:%d %s %s %q %s", id.Pos.Line, id.Pos.Column, id.Name, id.Kind, id.Type, id.Path)
+		if id.Decl.IsValid() {
+			s += fmt.Sprintf(" %d:%d", id.Decl.Line, id.Decl.Column)
+		}
+		got = append(got, s)
+	}
+	want := []string{
+		`3:6 f func "func(string) int"  3:6`,
+		`3:8 s var "string"  3:8`,
+		`3:10 string type "string" `,
+		`3:18 int type "int" `,
+		`3:24 x var "string"  3:24`,
+		`3:29 strings package "" strings`,
+		`3:37 ToUpper func "func(string) string" strings`,
+		`3:45 s var "string"  3:8`,
+		`3:56 len func "" `,
+		`3:60 x var "string"  3:24`,
+		`4:6 main func "func()"  4:6`,
+		`4:15 f func "func(string) int"  3:6`,
+		`4:17 undef  "" `,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestEvalWithContext(t *testing.T) {
+	tests := []testCase{
+		{desc: "for loop", src: `func f() { for {} }`},
+		{desc: "chan receive", src: `func f() { c := make(chan int); <-c }`},
+		{desc: "chan send", src: `func f() { c := make(chan int); c <- 1 }`},
+		{desc: "select", src: `func f() { c := make(chan int); select { case <-c: } }`},
+		{desc: "range chan", src: `func f() { c := make(chan int); for range c {} }`},
+		{desc: "goroutine", src: `func f() { c := make(chan int); go func() { for {} }(); <-c }`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			i := interp.New(interp.Options{})
+			if _, err := i.Eval(test.src); err != nil {
+				t.Fatal(err)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			_, err := i.EvalWithContext(ctx, "f()")
+			if err != context.DeadlineExceeded {
+				t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+			}
+
+			// The interpreter remains usable after cancelation
+			res, err := i.EvalWithContext(context.Background(), "1 + 2")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprintf("%v", res) != "3" {
+				t.Fatalf("got %v, want 3", res)
+			}
+		})
+	}
+}
+
+func TestEvalWithContextCanceled(t *testing.T) {
+	// An evaluation canceled before the start of its execution is stopped
+	i := interp.New(interp.Options{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := i.Eval(`func f() { for {} }`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.EvalWithContext(ctx, "f()"); err != context.Canceled {
+		t.Fatalf("got %v, want %v", err, context.Canceled)
+	}
+
+	// The canceled evaluation, if started, must not hold the interpreter
+	time.Sleep(50 * time.Millisecond)
+	done := make(chan error, 1)
+	go func() {
+		_, err := i.Eval("1 + 2")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("got Eval blocked by canceled evaluations")
+	}
+}
+
+func TestEvalWithContextDeadline(t *testing.T) {
+	// EvalWithContext returns at the deadline, even if the evaluation is
+	// blocked in a binary call
+	var deferred int32
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{"ext": {
+		"Sleep":    reflect.ValueOf(func() { time.Sleep(2 * time.Second) }),
+		"Deferred": reflect.ValueOf(func() { atomic.AddInt32(&deferred, 1) }),
+	}})
+	eval(t, i, `import "ext"`)
+	eval(t, i, `func f() { defer ext.Deferred(); ext.Sleep() }`)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := i.EvalWithContext(ctx, "f()"); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("got EvalWithContext returning after %v", d)
+	}
+	if n := atomic.LoadInt32(&deferred); n != 0 {
+		t.Fatalf("got %d deferred calls before the end of the binary call, want 0", n)
+	}
+}
+
+func TestEvalStop(t *testing.T) {
+	var started, deferred int32
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{
+		"ext": {
+			"Started":  reflect.ValueOf(func() { atomic.AddInt32(&started, 1) }),
+			"Deferred": reflect.ValueOf(func() { atomic.AddInt32(&deferred, 1) }),
+		},
+	})
+	eval(t, i, `import "ext"`)
+	eval(t, i, `
+func loop()   { defer ext.Deferred(); ext.Started(); for {} }
+func recv()   { defer ext.Deferred(); ext.Started(); c := make(chan int); <-c }
+func choose() { defer ext.Deferred(); ext.Started(); c := make(chan int); select { case c <- 1: } }
+func spawn()  { go loop(); go recv() }`)
+	eval(t, i, `go choose(); spawn()`)
+
+	if n := i.NumGoroutine(); n != 3 {
+		t.Fatalf("got %d goroutines, want 3", n)
+	}
+	for start := time.Now(); atomic.LoadInt32(&started) != 3; time.Sleep(time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatalf("got %d started goroutines, want 3", atomic.LoadInt32(&started))
+		}
+	}
+	i.Stop()
+	if n := i.NumGoroutine(); n != 0 {
+		t.Fatalf("got %d goroutines after stop, want 0", n)
+	}
+	if n := atomic.LoadInt32(&deferred); n != 3 {
+		t.Fatalf("got %d deferred calls, want 3", n)
+	}
+
+	// The interpreter remains usable after stop
+	if res := eval(t, i, "1 + 2"); fmt.Sprintf("%v", res) != "3" {
+		t.Fatalf("got %v, want 3", res)
+	}
+}
+
+func TestEvalRace(t *testing.T) {
+	tests := []struct {
+		desc, src string
+		races     int
+	}{
+		{desc: "write write", races: 1, src: `
+func main() {
+	x, done := 0, make(chan bool)
+	go func() { x = 1; done <- true }()
+	x = 2
+	<-done
+}`},
+		{desc: "field", races: 1, src: `
+type T struct{ a, b int }
+
+var t T
+
+func main() {
+	done := make(chan bool)
+	go func() { t.a = 1; done <- true }()
+	if t.a+t.b > 1 {
+		panic("unexpected")
+	}
+	<-done
+}`},
+		{desc: "channel", src: `
+func main() {
+	x, done := 0, make(chan bool)
+	go func() { x = 1; done <- true }()
+	<-done
+	x = 2
+}`},
+		{desc: "mutex", src: `
+import "sync"
+
+type T struct {
+	mu sync.Mutex
+	n  int
+}
+
+func main() {
+	var t T
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			t.mu.Lock()
+			t.n++
+			t.mu.Unlock()
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+	if t.n != 3 {
+		panic(t.n)
+	}
+}`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			var stderr strings.Builder
+			i := interp.New(interp.Options{DetectRaces: true, Stderr: &stderr})
+			i.Use(stdlib.Symbols)
+			eval(t, i, "package main\n"+test.src)
+			s := stderr.String()
+			if n := strings.Count(s, "WARNING: DATA RACE"); n != test.races {
+				t.Fatalf("got %d races, want %d:\n%s", n, test.races, s)
+			}
+			if test.races > 0 && !(strings.Contains(s, "by main goroutine:") && strings.Contains(s, "by goroutine 2:") && strings.Contains(s, "Goroutine 2 created at:")) {
+				t.Errorf("got report without both accesses:\n%s", s)
+			}
+		})
+	}
+}
+
+func TestEvalTypeOf(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `
+import "strconv"
+
+type T struct {
+	A int `+"`json:\"a\"`"+`
+	B int
+}
+
+func (t T) Sum() int { return t.A + t.B }
+
+func (t T) Format(prefix string) string { return prefix + strconv.Itoa(t.A) }
+
+func (t *T) Scale(k int) { t.A *= k; t.B *= k }
+
+type U struct {
+	A int
+	B int
+}
+`)
+	t1 := eval(t, i, "T{A: 1, B: 2}").Interface()
+	u1 := eval(t, i, "U{A: 1, B: 2}").Interface()
+	p1 := eval(t, i, "&T{A: 3, B: 4}").Interface()
+
+	if reflect.TypeOf(t1) == reflect.TypeOf(u1) {
+		t.Fatal("got identical runtime types for T and U")
+	}
+
+	typ := i.TypeOf(t1)
+	if typ.Name() != "T" || typ.String() != "main.T" || typ.Kind() != reflect.Struct {
+		t.Fatalf("got type %q named %q of kind %v", typ.String(), typ.Name(), typ.Kind())
+	}
+	if f, _ := typ.FieldByName("A"); typ.NumField() != 2 || typ.Field(0).Tag != `json:"a"` || f.Tag != `json:"a"` || typ.Field(1).Tag != "" {
+		t.Errorf("got fields %v and %v", typ.Field(0), typ.Field(1))
+	}
+	if typ.NumMethod() != 2 || typ.Method(0).Name != "Format" || typ.Method(1).Name != "Sum" {
+		t.Fatalf("got %d methods", typ.NumMethod())
+	}
+	if r := typ.Method(1).Func.Call([]reflect.Value{reflect.ValueOf(t1)}); r[0].Int() != 3 {
+		t.Errorf("got Sum %v, want 3", r[0])
+	}
+	m, ok := typ.MethodByName("Format")
+	if !ok {
+		t.Fatal("method Format not found")
+	}
+	if r := m.Func.Call([]reflect.Value{reflect.ValueOf(t1), reflect.ValueOf("A=")}); r[0].String() != "A=1" {
+		t.Errorf("got Format %v, want A=1", r[0])
+	}
+
+	ptyp := i.TypeOf(p1)
+	if ptyp.String() != "*main.T" || ptyp.NumMethod() != 3 || ptyp.Elem() != typ {
+		t.Fatalf("got pointer type %q with %d methods", ptyp.String(), ptyp.NumMethod())
+	}
+	m, _ = ptyp.MethodByName("Scale")
+	m.Func.Call([]reflect.Value{reflect.ValueOf(p1), reflect.ValueOf(10)})
+	if r := reflect.ValueOf(p1).Elem().Field(0).Int(); r != 30 {
+		t.Errorf("got A %d after Scale, want 30", r)
+	}
+
+	registry := map[reflect.Type]string{typ: "T", i.TypeOf(u1): "U"}
+	if registry[i.TypeOf(eval(t, i, "T{}").Interface())] != "T" || registry[i.TypeOf(u1)] != "U" || len(registry) != 2 {
+		t.Errorf("got registry %v", registry)
+	}
+}
+
+func TestEvalTypeOfEmpty(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	eval(t, i, `
+import (
+	"fmt"
+	"reflect"
+)
+
+type A struct{}
+
+type B struct{}
+
+func (B) Name() string { return "B" }
+
+func newB() B { return struct{}{} }
+
+var registry = map[reflect.Type]string{reflect.TypeOf(A{}): "A", reflect.TypeOf(B{}): "B"}
+`)
+	res := eval(t, i, `fmt.Sprintf("%d %s %s %v %s", len(registry), registry[reflect.TypeOf(A{})], registry[reflect.TypeOf(newB())], A{}, newB().Name())`)
+	if s := res.String(); s != "2 A B {} B" {
+		t.Errorf("got %q, want %q", s, "2 A B {} B")
+	}
+
+	a, b := eval(t, i, "A{}").Interface(), eval(t, i, "B{}").Interface()
+	registry := map[reflect.Type]string{i.TypeOf(a): "A", i.TypeOf(b): "B"}
+	if len(registry) != 2 || registry[i.TypeOf(b)] != "B" || i.TypeOf(b).NumMethod() != 1 || i.TypeOf(b).NumField() != 0 {
+		t.Errorf("got registry %v", registry)
+	}
+}
+
+// closer is a host interface exported without wrapper.
+type closer interface {
+	Close() error
+	Read(p []byte) (int, error)
+}
+
+// joiner is a host interface with a variadic method.
+type joiner interface {
+	Join(sep string, elems ...string) string
+}
+
+// _ext_joiner is an interface wrapper for joiner type.
+type _ext_joiner struct {
+	WJoin func(sep string, elems ...string) string
+}
+
+func (W _ext_joiner) Join(sep string, elems ...string) string { return W.WJoin(sep, elems...) }
+
+func TestEvalInterfaceWrapper(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(interp.Exports{"ext": {
+		"Describe": reflect.ValueOf(func(s interface{ String() string }) string { return "<" + s.String() + ">" }),
+		"Drain": reflect.ValueOf(func(c closer) (int, error) {
+			n, err := c.Read(make([]byte, 8))
+			if err != nil {
+				return n, err
+			}
+			return n, c.Close()
+		}),
+		"Join":    reflect.ValueOf(func(j joiner) string { return j.Join("-", "a", "b") }),
+		"_joiner": reflect.ValueOf((*_ext_joiner)(nil)),
+	}})
+	eval(t, i, `
+import (
+	"errors"
+	"ext"
+	"strings"
+)
+
+type T struct{ name string }
+
+func (t T) String() string { return t.name }
+
+type J struct{}
+
+func (J) Join(sep string, elems ...string) string { return strings.Join(elems, sep) }
+
+type R struct{ closed bool }
+
+func (r *R) Read(p []byte) (int, error) { return copy(p, "hello"), nil }
+
+func (r *R) Close() error {
+	if r.closed {
+		return errors.New("already closed")
+	}
+	r.closed = true
+	return nil
+}
+
+var r = &R{}
+
+func drain() int {
+	n, err := ext.Drain(r)
+	if err != nil {
+		return -1
+	}
+	return n
+}
+`)
+	runTests(t, i, []testCase{
+		{desc: "anonymous", src: `ext.Describe(T{"foo"})`, res: "<foo>"},
+		{desc: "no wrapper", src: `drain()`, res: "5"},
+		{desc: "pointer receiver", src: `r.closed`, res: "true"},
+		{desc: "variadic method", src: `ext.Join(J{})`, res: "a-b"},
+	})
+}
+
+func runTests(t *testing.T, i *interp.Interpreter, tests []testCase) {
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if test.skip != "" {
+				t.Skip(test.skip)
+			}
+			if test.pre != nil {
+				test.pre()
+			}
+			if test.src != "" {
+				assertEval(t, i, test.src, test.err, test.res)
+			}
+		})
+	}
+}
+
+func eval(t *testing.T, i *interp.Interpreter, src string) reflect.Value {
+	t.Helper()
+	res, err := i.Eval(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func assertEval(t *testing.T, i *interp.Interpreter, src, expectedError, expectedRes string) {
+	res, err := i.Eval(src)
+
+	if expectedError != "" {
+		if err == nil || !strings.Contains(err.Error(), expectedError) {
+			t.Fatalf("got %v, want %s", err, expectedError)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("got an error %v", err)
+	}
+
+	if fmt.Sprintf("%v", res) != expectedRes {
+		t.Fatalf("got %v, want %s", res, expectedRes)
+	}
+}
+
+func TestEvalSymbols(t *testing.T) {
+	mfs := fstest.MapFS{
+		"src/guthib.com/foo/bar/bar.go": &fstest.MapFile{Data: []byte(`package bar
+
+func Hello(s string) string { return "hello " + s }
+`)},
+	}
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	_, err := i.Eval(`package main
+
+import "guthib.com/foo/bar"
+
+type T struct{ A int }
+
+const C = 3
+
+var V = bar.Hello("v")
+
+func Add(a, b int) int { return a + b }
+
+func sub(a, b int) int { return a - b }
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syms := i.Symbols("main")
+	var names []string
+	for name := range syms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if s := strings.Join(names, " "); s != "Add C T V" {
+		t.Fatalf("got symbols %q, want %q", s, "Add C T V")
+	}
+	if c := syms["C"].Interface(); c != 3 {
+		t.Errorf("got C = %v, want 3", c)
+	}
+	if k := syms["T"].Type().Elem().Kind(); k != reflect.Struct {
+		t.Errorf("got T kind %v, want struct", k)
+	}
+	syms["V"].SetString("set by host")
+	assertEval(t, i, "V", "", "set by host")
+	if i.Symbols("guthib.com/foo/bar")["Hello"].Kind() != reflect.Func {
+		t.Error("Hello not found in guthib.com/foo/bar")
+	}
+	if i.Symbols("missing") != nil {
+		t.Error("got symbols of a missing package")
+	}
+
+	var add func(int, int) int
+	if err := i.GetFunc("Add", &add); err != nil {
+		t.Fatal(err)
+	}
+	if r := add(1, 2); r != 3 {
+		t.Errorf("got Add(1, 2) = %d, want 3", r)
+	}
+	var hello func(string) string
+	if err := i.GetFunc("guthib.com/foo/bar.Hello", &hello); err != nil {
+		t.Fatal(err)
+	}
+	if r := hello("host"); r != "hello host" {
+		t.Errorf("got Hello(host) = %q, want %q", r, "hello host")
+	}
+
+	for _, test := range []struct {
+		name  string
+		fptr  interface{}
+		error string
+	}{
+		{"Add", &hello, "cannot use Add (type func(int, int) int) as type func(string) string"},
+		{"main.sub", &add, ""},
+		{"V", &add, "V is not a function"},
+		{"Missing", &add, "Missing not found"},
+		{"missing.Add", &add, "package missing not found"},
+		{"Add", add, "func(int, int) int is not a pointer to a function"},
+	} {
+		err := i.GetFunc(test.name, test.fptr)
+		if test.error == "" && err != nil || test.error != "" && (err == nil || err.Error() != test.error) {
+			t.Errorf("GetFunc(%s): got error %v, want %q", test.name, err, test.error)
+		}
+	}
+	if r := add(3, 2); r != 1 {
+		t.Errorf("got sub(3, 2) = %d, want 1", r)
+	}
+}
+
+func TestEvalSnapshot(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	for _, src := range []string{
+		`import "strings"`,
+		`type point struct{ x, y int }`,
+		`func (p point) sum() int { return p.x + p.y }`,
+		`var p = point{1, 2}`,
+		`n := 0`,
+		`n++; p.x = 10`,
+		`var up = strings.ToUpper`,
+		`s := up("a")`,
+		`var V = 1`,
+	} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// V is only set by the host, its value is not given by a replay
+	i.Symbols("main")["V"].SetInt(5)
+	b, err := i.Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	j := interp.New(interp.Options{})
+	j.Use(stdlib.Symbols)
+	if err := j.RestoreSnapshot(b); err != nil {
+		t.Fatal(err)
+	}
+	assertEval(t, j, "p.sum()", "", "12")
+	assertEval(t, j, "n", "", "1")
+	assertEval(t, j, "s + up(`b`)", "", "AB")
+	assertEval(t, j, "V", "", "5")
+
+	if err := j.RestoreSnapshot([]byte("junk")); err == nil || !strings.HasPrefix(err.Error(), "invalid snapshot") {
+		t.Errorf("got error %v, want invalid snapshot", err)
+	}
+}
+
+func TestEvalReset(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	for _, src := range []string{
+		`import "strings"`,
+		`type T struct{ s string }`,
+		`var v = T{strings.ToUpper("a")}`,
+		`func f() string { return v.s }`,
+		`go func() { select {} }()`,
+	} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	i.Reset()
+	if n := i.NumGoroutine(); n != 0 {
+		t.Errorf("got %d goroutines, want 0", n)
+	}
+	for _, src := range []string{"v", "f()", "T{}", "strings.ToUpper"} {
+		if _, err := i.Eval(src); err == nil || !strings.Contains(err.Error(), "undefined") {
+			t.Errorf("%s: got error %v, want undefined", src, err)
+		}
+	}
+	// Binary symbols are retained
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, `import "strings"`) }, src: `strings.Repeat("a", 2)`, res: "aa"},
+		{pre: func() { eval(t, i, `type T int`) }, src: "T(3) + 1", res: "4"},
+		{pre: func() { eval(t, i, `func f() int { return 2 }`) }, src: "f()", res: "2"},
+	})
+}
+
+func TestEvalResetRunning(t *testing.T) {
+	var started int32
+	i := interp.New(interp.Options{})
+	i.Use(interp.Exports{"ext": {"Started": reflect.ValueOf(func() { atomic.AddInt32(&started, 1) })}})
+	eval(t, i, `import "ext"`)
+	done := make(chan error, 1)
+	go func() {
+		_, err := i.Eval("ext.Started(); for {}")
+		done <- err
+	}()
+	for start := time.Now(); atomic.LoadInt32(&started) == 0; time.Sleep(time.Millisecond) {
+		if time.Since(start) > time.Second {
+			t.Fatal("evaluation not started")
+		}
+	}
+
+	reset := make(chan struct{})
+	go func() { i.Reset(); close(reset) }()
+	select {
+	case <-reset:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Reset did not return during a running evaluation")
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the evaluation was not stopped by Reset")
+	}
+	if _, err := i.Eval("ext.Started()"); err == nil || !strings.Contains(err.Error(), "undefined") {
+		t.Errorf("got error %v after Reset, want undefined", err)
+	}
+}
+
+func TestPool(t *testing.T) {
+	created := 0
+	p := interp.NewPool(func() *interp.Interpreter {
+		created++
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		return i
+	})
+	for k := 0; k < 3; k++ {
+		i := p.Get()
+		if _, err := i.Eval(fmt.Sprintf(`import "strconv"; var x = strconv.Itoa(%d)`, k)); err != nil {
+			t.Fatal(err)
+		}
+		res, err := i.Eval("x")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := res.String(); s != fmt.Sprint(k) {
+			t.Errorf("got %s, want %d", s, k)
+		}
+		p.Put(i)
+	}
+	if created == 0 {
+		t.Error("no interpreter created")
+	}
+}
+
+func TestEvalUseLazy(t *testing.T) {
+	loaded := map[string]int{}
+	loaders := map[string]func() map[string]reflect.Value{}
+	for _, path := range []string{"bufio", "fmt", "io", "strings"} {
+		path := path
+		loaders[path] = func() map[string]reflect.Value {
+			loaded[path]++
+			return stdlib.Symbols[path]
+		}
+	}
+	var out strings.Builder
+	i := interp.New(interp.Options{Stdout: &out})
+	i.UseLazy(loaders)
+	if len(loaded) != 0 {
+		t.Fatalf("got packages loaded %v, want none", loaded)
+	}
+	eval(t, i, `import ("bufio"; "fmt"; "strings")`)
+	eval(t, i, `type R struct{ s string }`)
+	eval(t, i, `func (r *R) Read(b []byte) (int, error) { n := copy(b, r.s); r.s = r.s[n:]; if n == 0 { return 0, fmt.Errorf("EOF") }; return n, nil }`)
+	eval(t, i, `func read() string { s, _ := bufio.NewReader(&R{"ab\ncd"}).ReadString('\n'); return strings.ToUpper(s) }`)
+	eval(t, i, `fmt.Print(read())`)
+	if s := out.String(); s != "AB\n" {
+		t.Errorf("got output %q, want %q", s, "AB\n")
+	}
+	// Package io is loaded for the wrapper of io.Reader, but not imported
+	if want := map[string]int{"bufio": 1, "fmt": 1, "io": 1, "strings": 1}; !reflect.DeepEqual(loaded, want) {
+		t.Errorf("got packages loaded %v, want %v", loaded, want)
+	}
+	if _, err := i.Eval(`io.EOF`); err == nil || !strings.Contains(err.Error(), "undefined") {
+		t.Errorf("got error %v, want undefined", err)
+	}
+}
+
+func TestEvalMinimal(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(minimal.Symbols)
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, `import ("fmt"; "strings")`) }, src: `fmt.Sprint(strings.Count("abab", "b"))`, res: "2"},
+		{src: `import "net/http"`, err: "unable to find source related to: \"net/http\""},
+	})
+	if _, ok := stdlib.Symbols["fmt"]; !ok {
+		t.Error("missing fmt in stdlib symbols")
+	}
+}
+
+func TestEvalSeed(t *testing.T) {
+	src := `
+func order() string {
+	s := ""
+	m := map[int]bool{}
+	for i := 0; i < 20; i++ {
+		m[i] = true
+	}
+	for k, v := range m {
+		if v {
+			s += string(rune('a' + k))
+		}
+	}
+	a, b := make(chan int, 10), make(chan int, 10)
+	for i := 0; i < 10; i++ {
+		a <- 1
+		b <- 2
+	}
+	for i := 0; i < 10; i++ {
+		select {
+		case v := <-a:
+			s += string(rune('0' + v))
+		case v := <-b:
+			s += string(rune('0' + v))
+		}
+	}
+	return s
+}`
+	run := func(seed int64) string {
+		i := interp.New(interp.Options{Seed: seed})
+		eval(t, i, src)
+		return eval(t, i, "order() + order()").String()
+	}
+	s := run(1)
+	if s[:30] == s[30:] {
+		t.Errorf("got the same order twice: %s", s)
+	}
+	if s1 := run(1); s1 != s {
+		t.Errorf("got %s, then %s with the same seed", s, s1)
+	}
+	if s2 := run(2); s2 == s {
+		t.Errorf("got %s with seeds 1 and 2", s)
+	}
+}
+
+// eventTracer records the events of a Tracer.
+type eventTracer struct {
+	mutex  sync.Mutex
+	events []string
+}
+
+func (e *eventTracer) add(format string, args ...interface{}) {
+	e.mutex.Lock()
+	e.events = append(e.events, fmt.Sprintf(format, args...))
+	e.mutex.Unlock()
+}
+
+func (e *eventTracer) Statement(r int, pos token.Position) {
+	e.add("%d stmt %d", r, pos.Line)
+}
+
+func (e *eventTracer) Enter(r int, function string, pos token.Position) {
+	e.add("%d enter %s %d", r, function, pos.Line)
+}
+
+func (e *eventTracer) Exit(r int, function string) {
+	e.add("%d exit %s", r, function)
+}
+
+func (e *eventTracer) Assign(r int, name string, pos token.Position, v reflect.Value) {
+	e.add("%d assign %s=%v %d", r, name, v, pos.Line)
+}
+
+func TestTracer(t *testing.T) {
+	e := &eventTracer{}
+	i := interp.New(interp.Options{Tracer: e})
+	eval(t, i, `package main
+
+func add(a, b int) int {
+	c := a + b
+	return c
+}
+
+func main() {
+	x := 1
+	x++
+	y, _ := add(x, 3), 0
+	done := make(chan int)
+	go func() { done <- y }()
+	x = <-done
+}`)
+	expected := []string{
+		"1 enter main.main 8",
+		"1 stmt 9", "1 assign x=1 9",
+		"1 stmt 10", "1 assign x=2 10",
+		"1 stmt 11", "1 enter main.add 3",
+		"1 stmt 4", "1 assign c=5 4",
+		"1 stmt 5", "1 exit main.add",
+		"1 assign y=5 11",
+		"1 stmt 12",
+	}
+	if got := e.events[:len(expected)]; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got %q, want %q", got, expected)
+	}
+	// Events of the goroutine are interleaved with the ones of main
+	var routine2 []string
+	for _, ev := range e.events {
+		if strings.HasPrefix(ev, "2 ") {
+			routine2 = append(routine2, ev)
+		}
+	}
+	expected = []string{"2 enter main.main.func1 13", "2 stmt 13", "2 exit main.main.func1"}
+	if !reflect.DeepEqual(routine2, expected) {
+		t.Fatalf("got %q, want %q", routine2, expected)
+	}
+	if last := e.events[len(e.events)-2:]; last[0] != "1 assign x=5 14" || last[1] != "1 exit main.main" {
+		t.Fatalf("got %q", last)
+	}
+}
+
+func TestStats(t *testing.T) {
+	i := interp.New(interp.Options{CollectStats: true})
+	eval(t, i, `
+func fill(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func sum(n int) int {
+	t := 0
+	for _, v := range fill(n) {
+		t += v
+	}
+	return t
+}`)
+	eval(t, i, "sum(1000) + sum(10)")
+
+	stats := map[string]interp.FuncStats{}
+	for _, s := range i.Stats() {
+		stats[s.Function] = s
+	}
+	fill, sum := stats["main.fill"], stats["main.sum"]
+	if fill.Calls != 2 || sum.Calls != 2 {
+		t.Fatalf("got %d calls of fill and %d of sum, want 2", fill.Calls, sum.Calls)
+	}
+	if sum.Time < fill.Time || fill.Time == 0 {
+		t.Errorf("got time %v for sum, %v for fill", sum.Time, fill.Time)
+	}
+	// The frames of the calls of fill are allocated by sum
+	if size := int64(reflect.TypeOf(0).Size()); fill.Alloc != 1010*size || sum.Alloc <= 0 || sum.Alloc >= fill.Alloc {
+		t.Errorf("got allocations %d for fill, %d for sum, want %d and the frames of fill", fill.Alloc, sum.Alloc, 1010*size)
+	}
+	if s := interp.New(interp.Options{}).Stats(); s != nil {
+		t.Errorf("got %v without CollectStats", s)
+	}
+}
+
+type apiConfig struct{ Name string }
+
+func TestRegisterPackage(t *testing.T) {
+	symbols := map[string]reflect.Value{
+		"Version": reflect.ValueOf(func() string { return "1.2" }),
+		"Sum":     reflect.ValueOf(func(a ...int) int { return len(a) }),
+		"Config":  reflect.ValueOf((*apiConfig)(nil)),
+	}
+	i := interp.New(interp.Options{})
+	i.RegisterPackage("myapp/api", symbols)
+	i.RegisterPackageDoc("myapp/api", "Package api is the API of myapp.", map[string]string{"Version": "Version returns the version of myapp."})
+	delete(symbols, "Version")
+
+	runTests(t, i, []testCase{
+		{pre: func() { eval(t, i, `import "myapp/api"`) }, src: "api.Version()", res: "1.2"},
+		{src: "api.Sum(1, 2)", res: "2"},
+		{src: `api.Config{Name: "a"}.Name`, res: "a"},
+		{src: "api.Version(1)", err: "1:28: too many arguments in call to api.Version"},
+		{src: "api.Undef()", err: `1:28: package api "myapp/api" has no symbol Undef`},
+	})
+	if _, names := i.Complete(`import "myapp/`); !reflect.DeepEqual(names, []string{`myapp/api"`}) {
+		t.Errorf("got import completion %v", names)
+	}
+	if _, names := i.Complete("api.Ve"); !reflect.DeepEqual(names, []string{"Version"}) {
+		t.Errorf("got completion %v", names)
+	}
+
+	idents, _ := i.Inspect("package main\nimport \"myapp/api\"\nvar v = api.Version()\n")
+	var docs []string
+	for _, id := range idents {
+		docs = append(docs, id.Name+": "+id.Doc)
+	}
+	want := []string{"v: ", "api: Package api is the API of myapp.", "Version: Version returns the version of myapp."}
+	if !reflect.DeepEqual(docs, want) {
+		t.Errorf("got docs %q, want %q", docs, want)
+	}
+}
+
+func TestEvalDoc(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.RegisterPackageDoc("strings", "Package strings manipulates strings.", map[string]string{"ToUpper": "ToUpper maps s to upper case.", "Builder.Len": "Len returns the length."})
+	if _, err := i.Eval(`import "strings"
+
+// T is a type.
+type T struct{ X int }
+
+// Len returns n.
+func (t T) Len(n int) int { return n }
+
+// F is a function.
+func F(a int, b string) error { return nil }
+
+// V is a variable.
+var V = 2.5
+
+const (
+	// C is a constant.
+	C = 3
+)`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name, kind, sig, doc string
+	}{
+		{name: "strings", kind: "package", sig: "package strings", doc: "Package strings manipulates strings."},
+		{name: "strings.ToUpper", kind: "func", sig: "func ToUpper(string) string", doc: "ToUpper maps s to upper case."},
+		{name: "strings.Builder.Len", kind: "method", sig: "func (*Builder) Len() int", doc: "Len returns the length."},
+		{name: "time.Second", kind: "const", sig: "const Second time.Duration = 1000000000"},
+		{name: "os.Args", kind: "var", sig: "var Args []string"},
+		{name: "encoding/json.Marshal", kind: "func", sig: "func Marshal(interface {}) ([]uint8, error)"},
+		{name: "io.Reader", kind: "type", sig: "type Reader interface { Read([]uint8) (int, error) }"},
+		{name: "T", kind: "type", sig: "type T struct{ X int }", doc: "T is a type.\n"},
+		{name: "T.Len", kind: "method", sig: "func (t T) Len(n int) int", doc: "Len returns n.\n"},
+		{name: "F", kind: "func", sig: "func F(a int, b string) error", doc: "F is a function.\n"},
+		{name: "V", kind: "var", sig: "var V float64", doc: "V is a variable.\n"},
+		{name: "C", kind: "const", sig: "const C = 3", doc: "C is a constant.\n"},
+	} {
+		s, err := i.Doc(test.name)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if s.Kind != test.kind || s.Signature != test.sig || s.Doc != test.doc {
+			t.Errorf("%s: got %s %q %q, want %s %q %q", test.name, s.Kind, s.Signature, s.Doc, test.kind, test.sig, test.doc)
+		}
+	}
+	if _, err := i.Doc("strings.Nope"); err == nil {
+		t.Error("got no error for an undefined symbol")
+	}
+
+	var names []string
+	for _, s := range i.PackageSymbols("main") {
+		names = append(names, s.Name)
+	}
+	if s := strings.Join(names, " "); s != "C F T V" {
+		t.Errorf("got main symbols %s", s)
+	}
+	var found int
+	for _, p := range i.Packages() {
+		if p.Path == "strings" && p.Binary && p.Doc != "" || p.Path == "main" && !p.Binary {
+			found++
+		}
+	}
+	if found != 2 {
+		t.Errorf("got packages %v", i.Packages())
+	}
+}
+
+func TestEvalSourceDump(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	for _, src := range []string{
+		`import "fmt"`,
+		`import "strings"`,
+		"x := 2",
+		"x",
+		`len("abc")`,
+		`var y, z = x + 1, "s"`,
+		"// T is a type.\ntype T struct{ A int }",
+		"func f() int { return 1 }",
+		"func f() int { return 2 }",
+		"t := T{f()}",
+		"fmt.Println(t.A+y, strings.ToUpper(z))",
+		"package main\nfunc main() { a := 1; _ = a }",
+	} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := `package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	x int
+	y int
+	z string
+	t T
+)
+
+// T is a type.
+type T struct{ A int }
+
+func f() int { return 2 }
+
+func main() {
+	x = 2
+	y, z = x+1, "s"
+	t = T{f()}
+	fmt.Println(t.A+y, strings.ToUpper(z))
+	{
+		a := 1
+		_ = a
+	}
+}
+`
+	src, err := i.SourceDump()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if src != want {
+		t.Errorf("got %q, want %q", src, want)
+	}
+}
+
+func TestEvalError(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	if _, err := i.Eval(`import "errors"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval("func f(n int) {\n\tif n == 0 {\n\t\tpanic(errors.New(\"boom\"))\n\t}\n\tf(n - 1)\n}"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		src   string
+		phase interp.Phase
+		pos   string
+		msg   string
+	}{
+		{src: "a := `x", phase: interp.ScanPhase, pos: "1:33", msg: "raw string literal not terminated"},
+		{src: "a := )", phase: interp.ParsePhase, pos: "1:33", msg: "expected operand, found ')'"},
+		{src: "a := 1 + \"s\"", phase: interp.TypePhase, pos: "1:33", msg: "illegal operand types for '+' operator"},
+		{src: "f(1)", phase: interp.RunPhase, pos: "3:3", msg: "panic: boom"},
+	} {
+		_, err := i.Eval(test.src)
+		e, ok := err.(*interp.Error)
+		if !ok {
+			t.Errorf("%s: got error %#v, want *interp.Error", test.src, err)
+			continue
+		}
+		if e.Phase != test.phase || e.Pos.String() != test.pos || e.Msg != test.msg {
+			t.Errorf("%s: got %v error %q at %s, want %v error %q at %s", test.src, e.Phase, e.Msg, e.Pos, test.phase, test.msg, test.pos)
+		}
+	}
+
+	_, err := i.Eval("f(1)")
+	e := err.(*interp.Error)
+	var stack []string
+	for _, f := range e.Stack {
+		stack = append(stack, fmt.Sprintf("%s %d", f.Function, f.Pos.Line))
+	}
+	if s := strings.Join(stack, ", "); s != "main.f 3, main.f 5, main.init 1" {
+		t.Errorf("got stack %s", s)
+	}
+	if err, ok := e.Value.(error); !ok || err.Error() != "boom" {
+		t.Errorf("got panic value %#v", e.Value)
+	}
+}
+
+func TestEvalErrorExcerpt(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	if _, err := i.Eval("import \"fmt\"\ntype T struct{ Name string }\nfunc g() {\n\tpanic(\"boom\")\n}"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		src, want string
+	}{
+		{src: "a := `x", want: "\ta := `x\n\t     ^\n"},
+		{src: "count := 1; println(cuont)", want: "\tcount := 1; println(cuont)\n\t                    ^\n\tdid you mean count?\n"},
+		{src: "fmt.Prinln(1)", want: "\tfmt.Prinln(1)\n\t^\n\tdid you mean Println?\n"},
+		{src: "t := T{}\nprintln(t.name)", want: "\tprintln(t.name)\n\t        ^\n\tdid you mean Name?\n"},
+		{src: "x := 1\nprintln(x + \"a\")", want: "\tprintln(x + \"a\")\n\t        ^\n\tleft operand: x (variable of type int)\n\tright operand: \"a\" (untyped string constant)\n"},
+		{src: "g()", want: "\t\tpanic(\"boom\")\n\t\t^\n"},
+		{src: "println(zzz)", want: "\tprintln(zzz)\n\t        ^\n"},
+	} {
+		_, err := i.Eval(test.src)
+		e, ok := err.(*interp.Error)
+		if !ok {
+			t.Errorf("%s: got error %#v, want *interp.Error", test.src, err)
+			continue
+		}
+		if s := e.Excerpt(); s != test.want {
+			t.Errorf("%s: got excerpt %q, want %q", test.src, s, test.want)
+		}
+	}
+}
+
+func TestEvalPanicStack(t *testing.T) {
+	i := interp.New(interp.Options{})
+	if _, err := i.Eval("func g() {\n\tpanic(\"boom\")\n}\nfunc F() { g() }"); err != nil {
+		t.Fatal(err)
+	}
+	v, err := i.Eval("F")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A panic escaping to binary code carries the interpreted frames
+	r := func() (r interface{}) {
+		defer func() { r = recover() }()
+		v.Interface().(func())()
+		return nil
+	}()
+	want := "boom\n\ninterpreted stack:\nmain.g(...)\n\t2:2\nmain.F(...)\n\t4:12"
+	if s := fmt.Sprint(r); s != want {
+		t.Errorf("got panic %q, want %q", s, want)
+	}
+}
+
+func TestEvalPanicStackBinary(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	src := `package main
+
+import (
+	"sort"
+	"strings"
+)
+
+func m(r rune) rune {
+	panic("boom")
+}
+
+func b(i, j int) bool {
+	strings.Map(m, "ab")
+	return i < j
+}
+
+func a(s []int) {
+	sort.Slice(s, func(i, j int) bool {
+		return b(s[i], s[j])
+	})
+}
+
+func main() { a([]int{2, 1}) }
+`
+	_, err := i.Eval(src)
+	e, ok := err.(*interp.Error)
+	if !ok {
+		t.Fatalf("got error %v, want *interp.Error", err)
+	}
+
+	// Each frame is at the line reported by gc, including the frames calling
+	// back interpreted functions through a binary function
+	var stack []string
+	for _, f := range e.Stack {
+		stack = append(stack, fmt.Sprintf("%s %d", f.Function, f.Pos.Line))
+	}
+	want := "main.m 9, main.b 13, main.a.func1 19, main.a 18, main.main 23"
+	if s := strings.Join(stack, ", "); s != want {
+		t.Errorf("got stack %s, want %s", s, want)
+	}
+}
+
+func TestEvalStdio(t *testing.T) {
+	var out strings.Builder
+	i := interp.New(interp.Options{Stdin: strings.NewReader("21\n"), Stdout: &out})
+	i.Use(stdlib.Symbols)
+	if _, err := i.Eval(`import ("fmt"; "log"; "os"; "sync")`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Eval(`n := 0; fmt.Scan(&n); os.Stdout.WriteString("a"); fmt.Println(n * 2); println("b")`); err != nil {
+		t.Fatal(err)
+	}
+	if s := out.String(); s != "a42\nb\n" {
+		t.Errorf("got output %q, want %q", s, "a42\nb\n")
+	}
+
+	stdout, stderr := i.CaptureOutput(func() {
+		if _, err := i.Eval(`
+wg := &sync.WaitGroup{}
+wg.Add(1)
+go func() { defer wg.Done(); fmt.Print("c") }()
+wg.Wait()
+log.SetFlags(0)
+log.Print("d")
+fmt.Fprint(os.Stderr, "e")`); err != nil {
+			t.Error(err)
+		}
+	})
+	if stdout != "c" || stderr != "d\ne" {
+		t.Errorf("got captured output %q and error %q, want %q and %q", stdout, stderr, "c", "d\ne")
+	}
+	if _, err := i.Eval(`fmt.Print("f")`); err != nil {
+		t.Fatal(err)
+	}
+	if s := out.String(); s != "a42\nb\nf" {
+		t.Errorf("got output %q after capture, want %q", s, "a42\nb\nf")
+	}
+}
+
+func TestEvalCover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	profile := filepath.Join(dir, "c.out")
+
+	i := interp.New(interp.Options{CoverProfile: profile})
+	i.Name = filepath.Join(dir, "cover.go")
+	eval(t, i, `package main
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func main() { abs(1) }
+`)
+	if c := i.Coverage(); c != 0.75 {
+		t.Errorf("got coverage %v, want 0.75", c)
+	}
+	b, err := ioutil.ReadFile(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Replace(`mode: count
+F:3.21,4.11 1 1
+F:7.2,7.10 1 1
+F:4.11,6.3 1 0
+F:10.13,10.23 1 1
+`, "F", i.Name, -1)
+	if string(b) != want {
+		t.Errorf("got profile:\n%s\nwant:\n%s", b, want)
+	}
+}
+
+func TestInterpreterTest(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	tests, benchmarks, err := i.Test("../_test/tst")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tests) != 2 || tests[0].Name != "TestAdd" || tests[1].Name != "TestSkip" {
+		t.Fatalf("unexpected tests %v", tests)
+	}
+	if len(benchmarks) != 1 || benchmarks[0].Name != "BenchmarkAdd" {
+		t.Fatalf("unexpected benchmarks %v", benchmarks)
+	}
+	for _, test := range tests {
+		t.Run(test.Name, test.F)
+	}
+}
+
+func TestInterpreterTestLog(t *testing.T) {
+	if os.Getenv("YAEGI_TEST_LOG") == "1" {
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		tests, _, err := i.Test("../_test/tstlog")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, test := range tests {
+			t.Run(test.Name, test.F)
+		}
+		return
+	}
+
+	// The output of the tests is the one of a test binary, run with the
+	// interpreted tests as subtests
+	cmd := exec.Command(os.Args[0], "-test.run=^TestInterpreterTestLog$", "-test.v")
+	cmd.Env = append(os.Environ(), "YAEGI_TEST_LOG=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v: %s", err, out)
+	}
+	// The messages are at the positions of the interpreted calls, or of the
+	// callers of helpers
+	for _, want := range []string{"tstlog_test.go:6: logged\n", "tstlog_test.go:7: logged 2\n", "tstlog_test.go:8: got 1, want 1\n"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("got output:\n%s\nwant %q", out, want)
+		}
+	}
+}
+
+func BenchmarkBinaryOp(b *testing.B) {
+	benchmarks := []struct {
+		name, src string
+	}{
+		{"int", `func f(n int) int { s := 0; for k := 0; k < n; k++ { s = s + k*2 - 1 }; return s }`},
+		{"int64", `func f(n int) int { var s int64; for k := int64(0); k < int64(n); k++ { s = s + k*2 - 1 }; return int(s) }`},
+		{"float64", `func f(n int) int { s, x := 0.0, 1.5; for k := 0; k < n; k++ { s = s + x*2 - 1 }; return int(s) }`},
+		{"string", `func f(n int) int { a, b, s := "ab", "cd", ""; for k := 0; k < n; k++ { s = a + b; s = s + a }; return len(s) }`},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			i := interp.New(interp.Options{})
+			if _, err := i.Eval(bm.src); err != nil {
+				b.Fatal(err)
+			}
+			v, err := i.Eval("f")
+			if err != nil {
+				b.Fatal(err)
+			}
+			f := v.Interface().(func(int) int)
+			b.ResetTimer()
+			f(b.N)
+		})
+	}
+}
+
+func BenchmarkCall(b *testing.B) {
+	benchmarks := []struct {
+		name, src string
+	}{
+		{"recursive", `func fib(n int) int { if n < 2 { return n }; return fib(n-1) + fib(n-2) }; func f(n int) int { return fib(15) }`},
+		{"method", `type T struct{ a int }; func (t *T) inc(k int) int { t.a += k; return t.a }; func f(n int) int { t := &T{}; for k := 0; k < 1000; k++ { t.inc(k) }; return t.a }`},
+		{"struct", `type P struct{ x, y int }; func add(a, b P) P { return P{a.x + b.x, a.y + b.y} }; func f(n int) int { s := P{}; for k := 0; k < 1000; k++ { s = add(s, P{k, n}) }; return s.x }`},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			i := interp.New(interp.Options{})
+			if _, err := i.Eval(bm.src); err != nil {
+				b.Fatal(err)
+			}
+			v, err := i.Eval("f")
+			if err != nil {
+				b.Fatal(err)
+			}
+			f := v.Interface().(func(int) int)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for k := 0; k < b.N; k++ {
+				f(k)
+			}
+		})
 	}
 }
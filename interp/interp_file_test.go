@@ -1,3 +1,8 @@
+// The seed of math/rand is set by rand.Seed in interpreted programs, such as
+// _test/import4.go, as in the programs of modules before go1.24.
+
+//go:debug randseednop=0
+
 package interp_test
 
 import (
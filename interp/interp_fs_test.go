@@ -0,0 +1,849 @@
+package interp_test
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"go/build"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+	"github.com/containous/yaegi/stdlib/sandboxfs"
+)
+
+func TestEvalPathFS(t *testing.T) {
+	mfs := fstest.MapFS{
+		"main.go": &fstest.MapFile{Data: []byte(`package main
+
+import "guthib.com/foo/bar"
+
+var Greeting = bar.Hello("fs")
+`)},
+		"src/guthib.com/foo/bar/bar.go": &fstest.MapFile{Data: []byte(`package bar
+
+func Hello(s string) string { return "hello " + s }
+`)},
+		"src/guthib.com/foo/bar/bar_test.go": &fstest.MapFile{Data: []byte(`package bar
+
+func broken( {
+`)},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("main.go"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval("Greeting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.String(); s != "hello fs" {
+		t.Fatalf("got %q, want %q", s, "hello fs")
+	}
+
+	if _, err := i.EvalPath("missing.go"); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func TestEvalPathFallback(t *testing.T) {
+	mfs := fstest.MapFS{
+		"main.go": &fstest.MapFile{Data: []byte(`package main
+
+import (
+	"guthib.com/foo/bar"
+	"guthib.com/foo/baz"
+)
+
+var Greeting = bar.Hello("fs") + baz.Sum(1, 2)
+`)},
+		"src/guthib.com/foo/bar/bar.go": &fstest.MapFile{Data: []byte(`package bar
+
+// #include <stdlib.h>
+import "C"
+
+func Hello(s string) string { return "hello " + s }
+`)},
+		"src/guthib.com/foo/bar/bar_nocgo.go": &fstest.MapFile{Data: []byte(`//go:build ignore
+
+package bar
+
+import "C"
+`)},
+		"src/guthib.com/foo/baz/baz.go": &fstest.MapFile{Data: []byte(`package baz
+
+func Sum(a, b int) string
+`)},
+		"src/guthib.com/foo/baz/sum.s": &fstest.MapFile{Data: []byte(`// Copyright notice.
+
+#include "textflag.h"
+`)},
+		"src/guthib.com/foo/baz/sum_generic.s": &fstest.MapFile{Data: []byte(`//go:build ignore
+
+#include "textflag.h"
+`)},
+	}
+	fallback := interp.Exports{
+		"guthib.com/foo/bar": {"Hello": reflect.ValueOf(func(s string) string { return "hi " + s })},
+		"guthib.com/foo/baz": {"Sum": reflect.ValueOf(func(a, b int) string { return fmt.Sprint(" ", a+b) })},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	_, err := i.EvalPath("main.go")
+	if want := `import "guthib.com/foo/bar": cgo is not supported, required by bar.go`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("got error %v, want %q", err, want)
+	}
+
+	i = interp.New(interp.Options{SourcecodeFS: mfs})
+	i.UseFallback(interp.Exports{"guthib.com/foo/bar": fallback["guthib.com/foo/bar"]})
+	_, err = i.EvalPath("main.go")
+	if want := `import "guthib.com/foo/baz": assembly is not supported, required by sum.s`; err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("got error %v, want %q", err, want)
+	}
+
+	i = interp.New(interp.Options{SourcecodeFS: mfs})
+	i.UseFallback(fallback)
+	if _, err = i.EvalPath("main.go"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval("Greeting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.String(); s != "hi fs 3" {
+		t.Fatalf("got %q, want %q", s, "hi fs 3")
+	}
+
+	if _, err = i.Eval(`import "C"`); err == nil || !strings.Contains(err.Error(), `import "C": cgo is not supported`) {
+		t.Fatalf("got error %v, want cgo is not supported", err)
+	}
+}
+
+func TestEvalPathTarget(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/main.go":       &fstest.MapFile{Data: []byte("package main\n\nvar Target string\n\nfunc main() { Target = goos + goarch }\n")},
+		"app/os_linux.go":   &fstest.MapFile{Data: []byte("package main\n\nconst goos = \"linux\"\n")},
+		"app/os_windows.go": &fstest.MapFile{Data: []byte("package main\n\nconst goos = \"windows\"\n")},
+		"app/arch.go":       &fstest.MapFile{Data: []byte("//go:build !arm64\n\npackage main\n\nconst goarch = \"/other\"\n")},
+		"app/arch_arm64.go": &fstest.MapFile{Data: []byte("package main\n\nconst goarch = \"/arm64\"\n")},
+	}
+
+	for _, test := range []struct{ goos, goarch, res string }{
+		{"linux", "arm64", "linux/arm64"},
+		{"windows", "arm64", "windows/arm64"},
+		{"windows", "386", "windows/other"},
+	} {
+		ctx := build.Context{GOOS: test.goos, GOARCH: test.goarch}
+		i := interp.New(interp.Options{SourcecodeFS: mfs, GoBuildContext: ctx})
+		if _, err := i.EvalPath("app"); err != nil {
+			t.Fatal(err)
+		}
+		if s := fmt.Sprint(i.Symbols("main")["Target"]); s != test.res {
+			t.Errorf("got %s, want %s", s, test.res)
+		}
+	}
+}
+
+func TestEvalPathBuildTags(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/main.go":  &fstest.MapFile{Data: []byte("package main\n\nvar Mode = \"default\"\n\nfunc main() { Mode += extra }\n")},
+		"app/integ.go": &fstest.MapFile{Data: []byte("//go:build integration && foo\n\npackage main\n\nfunc init() { Mode = \"integration\" }\n")},
+		"app/foo.go":   &fstest.MapFile{Data: []byte("// +build foo\n\npackage main\n\nconst extra = \"/foo\"\n")},
+		"app/nofoo.go": &fstest.MapFile{Data: []byte("// +build !foo\n\npackage main\n\nconst extra = \"\"\n")},
+	}
+
+	for _, test := range []struct {
+		tags []string
+		res  string
+	}{
+		{nil, "default"},
+		{[]string{"foo"}, "default/foo"},
+		{[]string{"integration", "foo"}, "integration/foo"},
+	} {
+		i := interp.New(interp.Options{SourcecodeFS: mfs, BuildTags: test.tags})
+		if _, err := i.EvalPath("app"); err != nil {
+			t.Fatal(err)
+		}
+		if s := fmt.Sprint(i.Symbols("main")["Mode"]); s != test.res {
+			t.Errorf("tags %v: got %s, want %s", test.tags, s, test.res)
+		}
+	}
+}
+
+func TestEvalPathShebang(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/main.go":    &fstest.MapFile{Data: []byte("#!/usr/bin/env yaegi\npackage main\n\nimport \"./lib\"\n\nvar Greeting = lib.Hello()\n")},
+		"app/lib/lib.go": &fstest.MapFile{Data: []byte("#!/usr/bin/env yaegi\npackage lib\n\nfunc Hello() string { return \"hello\" }\n")},
+		"bad/main.go":    &fstest.MapFile{Data: []byte("#!/usr/bin/env yaegi\npackage main\n\nfunc main() {\n\tundefinedFn()\n}\n")},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("app/main.go"); err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(i.Symbols("main")["Greeting"]); s != "hello" {
+		t.Errorf("got %s, want hello", s)
+	}
+
+	// Line numbers are not shifted by the shebang line
+	i = interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("bad"); err == nil || !strings.HasSuffix(err.Error(), "main.go:5:2: undefined: undefinedFn") {
+		t.Errorf("got error %v, want undefined at line 5", err)
+	}
+}
+
+func TestEvalPathVendor(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/main.go": &fstest.MapFile{Data: []byte(`package main
+
+import "guthib.com/foo/bar"
+
+var Greeting = bar.Hello()
+`)},
+		"app/vendor/guthib.com/foo/bar/bar.go": &fstest.MapFile{Data: []byte(`package bar
+
+import "guthib.com/foo/baz"
+
+func Hello() string { return "vendored " + baz.Name }
+`)},
+		"app/vendor/guthib.com/foo/baz/baz.go": &fstest.MapFile{Data: []byte("package baz\n\nconst Name = \"baz\"\n")},
+		"src/guthib.com/foo/bar/bar.go":        &fstest.MapFile{Data: []byte("package bar\n\nfunc Hello() string { return \"gopath\" }\n")},
+		"src/guthib.com/foo/baz/baz.go":        &fstest.MapFile{Data: []byte("package baz\n\nconst Name = \"gopath\"\n")},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("app/main.go"); err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(i.Symbols("main")["Greeting"]); s != "vendored baz" {
+		t.Errorf("got %s, want vendored baz", s)
+	}
+}
+
+func TestEvalImportResolver(t *testing.T) {
+	mfs := fstest.MapFS{
+		"main.go": &fstest.MapFile{Data: []byte(`package main
+
+import (
+	"db/greet"
+	"guthib.com/foo/bar"
+)
+
+var Greeting = greet.Hello(bar.Name)
+`)},
+		"src/guthib.com/foo/bar/bar.go": &fstest.MapFile{Data: []byte("package bar\n\nconst Name = \"gopath\"\n")},
+	}
+	// Each package of the database is in its own filesystem, at its root
+	db := map[string]fstest.MapFS{
+		"db/greet": {"greet.go": &fstest.MapFile{Data: []byte(`package greet
+
+import "db/format"
+
+func Hello(s string) string { return format.Join("hello", s) }
+`)}},
+		"db/format": {"format.go": &fstest.MapFile{Data: []byte("package format\n\nfunc Join(a, b string) string { return a + \" \" + b }\n")}},
+	}
+	var resolved []string
+	resolver := func(path string) (fs.FS, string, error) {
+		resolved = append(resolved, path)
+		if path == "db/missing" {
+			return nil, "", errors.New("not in database")
+		}
+		if fsys, ok := db[path]; ok {
+			return fsys, ".", nil
+		}
+		return nil, "", nil
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs, ImportResolver: resolver})
+	if _, err := i.EvalPath("main.go"); err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(i.Symbols("main")["Greeting"]); s != "hello gopath" {
+		t.Errorf("got %s, want hello gopath", s)
+	}
+	if s := fmt.Sprint(resolved); s != "[db/greet db/format guthib.com/foo/bar]" {
+		t.Errorf("got resolved imports %s", s)
+	}
+
+	want := `import "db/missing": not in database`
+	if _, err := i.Eval(`import "db/missing"`); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("got error %v, want %s", err, want)
+	}
+}
+
+func TestEvalPathGoDirective(t *testing.T) {
+	main := []byte(`package main
+
+var Result []int
+
+func main() {
+	var fs []func()
+	for i := 0; i < 3; i++ {
+		fs = append(fs, func() { Result = append(Result, i) })
+	}
+	for _, f := range fs {
+		f()
+	}
+}
+`)
+	mfs := fstest.MapFS{
+		"old/go.mod":  &fstest.MapFile{Data: []byte("module old\n\ngo 1.21\n")},
+		"old/main.go": &fstest.MapFile{Data: main},
+		"new/go.mod":  &fstest.MapFile{Data: []byte("module new\n\ngo 1.22.0\n")},
+		"new/main.go": &fstest.MapFile{Data: main},
+	}
+
+	// The variables of for statements are distinct at each iteration from
+	// go1.22, for a program loaded from a package directory or a file
+	for path, want := range map[string]string{
+		"old": "[3 3 3]", "new": "[0 1 2]", "old/main.go": "[3 3 3]", "new/main.go": "[0 1 2]",
+	} {
+		i := interp.New(interp.Options{SourcecodeFS: mfs})
+		if _, err := i.EvalPath(path); err != nil {
+			t.Fatal(err)
+		}
+		if s := fmt.Sprint(i.Symbols("main")["Result"]); s != want {
+			t.Errorf("%s: got %s, want %s", path, s, want)
+		}
+	}
+}
+
+func TestEvalPathDir(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/go.mod": &fstest.MapFile{Data: []byte("module example.com/app\n")},
+		"app/main.go": &fstest.MapFile{Data: []byte(`package main
+
+import (
+	"example.com/app/a"
+	bb "example.com/app/b"
+)
+
+var Result []int
+
+func main() { Result = append(Result, a.Next(), bb.Next(), start) }
+`)},
+		"app/start.go": &fstest.MapFile{Data: []byte("package main\n\nvar start = 1\n")},
+		"app/a/a.go": &fstest.MapFile{Data: []byte(`package a
+
+import "example.com/app/internal/counter"
+
+func Next() int { counter.N++; return counter.N }
+`)},
+		"app/b/b.go": &fstest.MapFile{Data: []byte(`package b
+
+import c "example.com/app/internal/counter"
+
+func Next() int { c.N++; return c.N }
+`)},
+		"app/internal/counter/counter.go": &fstest.MapFile{Data: []byte(`package counter
+
+var N = 0
+
+func init() { N += 10 }
+`)},
+		"other/go.mod":  &fstest.MapFile{Data: []byte("module other\n\nrequire example.com/app v0.0.0\n\nreplace example.com/app => ../app\n")},
+		"other/main.go": &fstest.MapFile{Data: []byte("package main\n\nimport \"example.com/app/internal/counter\"\n\nfunc main() { counter.N++ }\n")},
+		"cycle/go.mod":  &fstest.MapFile{Data: []byte("module cycle\n")},
+		"cycle/main.go": &fstest.MapFile{Data: []byte("package main\n\nimport \"cycle/p\"\n\nfunc main() { p.P() }\n")},
+		"cycle/p/p.go":  &fstest.MapFile{Data: []byte("package p\n\nimport \"cycle/q\"\n\nfunc P() { q.Q() }\n")},
+		"cycle/q/q.go":  &fstest.MapFile{Data: []byte("package q\n\nimport \"cycle/p\"\n\nfunc Q() { p.P() }\n")},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("app"); err != nil {
+		t.Fatal(err)
+	}
+	// The internal package is shared by a and b, and initialized once
+	if s := fmt.Sprint(i.Symbols("main")["Result"]); s != "[11 12 1]" {
+		t.Errorf("got %s, want [11 12 1]", s)
+	}
+
+	for dir, want := range map[string]string{
+		"other": "use of internal package example.com/app/internal/counter not allowed",
+		"cycle": "import cycle not allowed: cycle/p",
+	} {
+		i := interp.New(interp.Options{SourcecodeFS: mfs})
+		if _, err := i.EvalPath(dir); err == nil || err.Error() != want {
+			t.Errorf("%s: got error %v, want %s", dir, err, want)
+		}
+	}
+}
+
+func TestEvalPathImportGraph(t *testing.T) {
+	// Packages p0 to p9 import all the previous ones, parsed concurrently
+	mfs := fstest.MapFS{"app/go.mod": &fstest.MapFile{Data: []byte("module app\n")}}
+	main := "package main\n\nimport \"app/p9\"\n\nvar Result int\n\nfunc main() { Result = p9.F() }\n"
+	mfs["app/main.go"] = &fstest.MapFile{Data: []byte(main)}
+	for k := 0; k < 10; k++ {
+		src := fmt.Sprintf("package p%d\n\n", k)
+		sum := "1"
+		for j := 0; j < k; j++ {
+			src += fmt.Sprintf("import \"app/p%d\"\n", j)
+			sum += fmt.Sprintf(" + p%d.F()", j)
+		}
+		src += "\nfunc F() int { return " + sum + " }\n"
+		mfs[fmt.Sprintf("app/p%d/p.go", k)] = &fstest.MapFile{Data: []byte(src)}
+	}
+	mfs["bad/go.mod"] = &fstest.MapFile{Data: []byte("module bad\n")}
+	mfs["bad/main.go"] = &fstest.MapFile{Data: []byte("package main\n\nimport \"bad/p\"\n\nfunc main() { p.P() }\n")}
+	mfs["bad/p/p.go"] = &fstest.MapFile{Data: []byte("package p\n\nfunc P( {}\n")}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("app"); err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(i.Symbols("main")["Result"]); s != "512" {
+		t.Errorf("got %s, want 512", s)
+	}
+
+	i = interp.New(interp.Options{SourcecodeFS: mfs})
+	if _, err := i.EvalPath("bad"); err == nil || !strings.Contains(err.Error(), "bad/p/p.go:3:9") {
+		t.Errorf("got error %v, want a syntax error in bad/p/p.go", err)
+	}
+}
+
+func TestEvalEmbed(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/main.go": &fstest.MapFile{Data: []byte(`package main
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed hello.txt
+var s string
+
+//go:embed hello.txt
+var b []byte
+
+//go:embed static
+var content embed.FS
+
+var Result string
+
+func main() {
+	d, _ := content.ReadFile("static/a/x.txt")
+	entries, _ := fs.ReadDir(content, "static")
+	r := s + "|" + string(b) + "|" + string(d)
+	for _, e := range entries {
+		r += "|" + e.Name()
+	}
+	Result = r
+}
+`)},
+		"app/hello.txt":      &fstest.MapFile{Data: []byte("hello")},
+		"app/static/a/x.txt": &fstest.MapFile{Data: []byte("x")},
+		"app/static/b.txt":   &fstest.MapFile{Data: []byte("b")},
+		"app/static/.hidden": &fstest.MapFile{Data: []byte("hidden")},
+		"bad/main.go":        &fstest.MapFile{Data: []byte("package main\n\nimport _ \"embed\"\n\n//go:embed *.txt\nvar s string\n\nfunc main() {}\n")},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs})
+	i.Use(stdlib.Symbols)
+	if _, err := i.EvalPath("app/main.go"); err != nil {
+		t.Fatal(err)
+	}
+	if s := fmt.Sprint(i.Symbols("main")["Result"]); s != "hello|hello|x|a|b.txt" {
+		t.Errorf("got %s, want hello|hello|x|a|b.txt", s)
+	}
+
+	i = interp.New(interp.Options{SourcecodeFS: mfs})
+	i.Use(stdlib.Symbols)
+	if _, err := i.EvalPath("bad/main.go"); err == nil || !strings.Contains(err.Error(), "pattern *.txt: no matching files found") {
+		t.Errorf("got error %v, want no matching files", err)
+	}
+}
+
+func TestEvalPathModule(t *testing.T) {
+	mfs := fstest.MapFS{
+		"app/go.mod": &fstest.MapFile{Data: []byte(`module example.com/app
+
+go 1.16
+
+require (
+	guthib.com/Foo/bar v1.2.0 // indirect
+	guthib.com/baz v0.1.0
+	"guthib.com/qux" v1.0.0
+)
+
+replace guthib.com/baz => ../baz
+
+replace guthib.com/qux v1.0.0 => guthib.com/quux v1.1.0
+`)},
+		"app/main.go": &fstest.MapFile{Data: []byte(`package main
+
+import (
+	"example.com/app/util"
+	"guthib.com/Foo/bar"
+	"guthib.com/baz"
+	"guthib.com/qux"
+)
+
+var Result = util.Name + bar.Name + baz.Name + qux.Name
+`)},
+		"app/util/util.go": &fstest.MapFile{Data: []byte(`package util
+
+const Name = "util "
+`)},
+		"baz/baz.go": &fstest.MapFile{Data: []byte(`package baz
+
+const Name = "baz "
+`)},
+		"cache/guthib.com/!foo/bar@v1.2.0/go.mod": &fstest.MapFile{Data: []byte(`module guthib.com/Foo/bar
+
+require guthib.com/dep v1.1.0
+`)},
+		"cache/guthib.com/!foo/bar@v1.2.0/bar.go": &fstest.MapFile{Data: []byte(`package bar
+
+import "guthib.com/dep/sub"
+
+const Name = "bar " + sub.Name
+`)},
+		"cache/guthib.com/dep@v1.1.0/sub/sub.go": &fstest.MapFile{Data: []byte(`package sub
+
+const Name = "sub "
+`)},
+		"cache/guthib.com/quux@v1.1.0/quux.go": &fstest.MapFile{Data: []byte(`package quux
+
+const Name = "quux"
+`)},
+	}
+
+	i := interp.New(interp.Options{SourcecodeFS: mfs, GoModCache: "cache"})
+	if _, err := i.EvalPath("app/main.go"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval("Result")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, want := res.String(), "util bar sub baz quux"; s != want {
+		t.Fatalf("got %q, want %q", s, want)
+	}
+}
+
+func TestEvalPathModuleDownload(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go command not found")
+	}
+	tmp, err := ioutil.TempDir("", "download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	// Serve the missing module from a file module proxy
+	files := map[string]string{
+		"proxy/guthib.com/dl/@v/list":        "v1.0.0\n",
+		"proxy/guthib.com/dl/@v/v1.0.0.info": `{"Version":"v1.0.0"}`,
+		"proxy/guthib.com/dl/@v/v1.0.0.mod":  "module guthib.com/dl\n",
+		"app/go.mod":                         "module example.com/app\n\nrequire guthib.com/dl v1.0.0\n",
+		"app/main.go":                        "package main\n\nimport \"guthib.com/dl\"\n\nvar Result = dl.Name\n",
+	}
+	for name, data := range files {
+		name = filepath.Join(tmp, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(name, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f, err := os.Create(filepath.Join(tmp, "proxy/guthib.com/dl/@v/v1.0.0.zip"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for name, data := range map[string]string{"go.mod": "module guthib.com/dl\n", "dl.go": "package dl\n\nconst Name = \"downloaded\"\n"} {
+		w, err := zw.Create("guthib.com/dl@v1.0.0/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for k, v := range map[string]string{
+		"GOPROXY": "file://" + filepath.ToSlash(filepath.Join(tmp, "proxy")),
+		"GOSUMDB": "off",
+		"GOFLAGS": "-modcacherw",
+	} {
+		defer os.Setenv(k, os.Getenv(k))
+		os.Setenv(k, v)
+	}
+
+	cache := filepath.Join(tmp, "cache")
+	i := interp.New(interp.Options{GoModCache: cache})
+	if _, err := i.EvalPath(filepath.Join(tmp, "app", "main.go")); err == nil {
+		t.Fatal("expected an error for a missing module")
+	}
+
+	i = interp.New(interp.Options{GoModCache: cache, AutoDownload: true})
+	if _, err := i.EvalPath(filepath.Join(tmp, "app", "main.go")); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval("Result")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, want := res.String(), "downloaded"; s != want {
+		t.Fatalf("got %q, want %q", s, want)
+	}
+}
+
+func TestReloadPath(t *testing.T) {
+	mfs := fstest.MapFS{
+		"main.go": &fstest.MapFile{Data: []byte(`package main
+
+import "guthib.com/foo/bar"
+
+type T struct{ A int }
+
+func (t *T) Get() int { return t.A }
+
+var P = &T{A: 2}
+
+var Count int
+
+var Name = "v1"
+
+func Hello() string {
+	Count++
+	return bar.Greet() + " " + Name
+}
+
+func Get() int { return P.Get() }
+`)},
+		"src/guthib.com/foo/bar/bar.go": &fstest.MapFile{Data: []byte(`package bar
+
+func Greet() string { return "hello" }
+`)},
+	}
+
+	var reloaded []string
+	i := interp.New(interp.Options{SourcecodeFS: mfs, OnReload: func(pkgPath string, old map[string]reflect.Value) {
+		reloaded = append(reloaded, pkgPath)
+		if pkgPath == "main" && old["Count"].Int() != 1 {
+			t.Errorf("got previous Count %v, want 1", old["Count"])
+		}
+	}})
+	if _, err := i.EvalPath("main.go"); err != nil {
+		t.Fatal(err)
+	}
+	var hello func() string
+	var get func() int
+	if err := i.GetFunc("Hello", &hello); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.GetFunc("Get", &get); err != nil {
+		t.Fatal(err)
+	}
+	if s := hello(); s != "hello v1" {
+		t.Fatalf("got %q, want %q", s, "hello v1")
+	}
+
+	// Name changes type, so it is initialized again, Count keeps its value
+	mfs["main.go"] = &fstest.MapFile{Data: []byte(`package main
+
+import (
+	"strconv"
+
+	"guthib.com/foo/bar"
+)
+
+type T struct{ A int }
+
+func (t *T) Get() int { return 10 * t.A }
+
+var P = &T{A: 3}
+
+var Count int
+
+var Name = 2
+
+func Hello() string {
+	Count++
+	return bar.Greet() + " v" + strconv.Itoa(Name) + " " + strconv.Itoa(Count)
+}
+
+func Get() int { return P.Get() }
+`)}
+	i.Use(stdlib.Symbols)
+	if err := i.ReloadPath("main.go"); err != nil {
+		t.Fatal(err)
+	}
+	if s := hello(); s != "hello v2 2" {
+		t.Errorf("got %q, want %q", s, "hello v2 2")
+	}
+	if n := get(); n != 20 {
+		t.Errorf("got %d, want %d", n, 20)
+	}
+
+	mfs["src/guthib.com/foo/bar/bar.go"] = &fstest.MapFile{Data: []byte(`package bar
+
+func Greet() string { return "hi" }
+`)}
+	if err := i.ReloadPath("src/guthib.com/foo/bar"); err != nil {
+		t.Fatal(err)
+	}
+	if s := hello(); s != "hi v2 3" {
+		t.Errorf("got %q, want %q", s, "hi v2 3")
+	}
+	if s := strings.Join(reloaded, " "); s != "main guthib.com/foo/bar" {
+		t.Errorf("got reloaded packages %q, want %q", s, "main guthib.com/foo/bar")
+	}
+
+	// A compilation error leaves the package unchanged
+	mfs["main.go"] = &fstest.MapFile{Data: []byte("package main\n\nfunc Hello() string { return undefined }\n")}
+	if err := i.ReloadPath("main.go"); err == nil {
+		t.Error("expected a compilation error")
+	}
+	if s := hello(); s != "hi v2 4" {
+		t.Errorf("got %q, want %q", s, "hi v2 4")
+	}
+}
+
+func TestSandboxFS(t *testing.T) {
+	base := fstest.MapFS{
+		"etc/conf":   &fstest.MapFile{Data: []byte("base")},
+		"data/a.txt": &fstest.MapFile{Data: []byte("A")},
+	}
+	fsys := sandboxfs.New(base)
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(fsys.Symbols())
+	_, err := i.Eval(`
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func run() string {
+	b, err := os.ReadFile("/etc/conf")
+	if err != nil {
+		return err.Error()
+	}
+	if err := os.WriteFile("data/b.txt", []byte("B"), 0644); err != nil {
+		return err.Error()
+	}
+	if err := os.MkdirAll("x/y", 0755); err != nil {
+		return err.Error()
+	}
+	if err := os.Remove("data/a.txt"); err != nil {
+		return err.Error()
+	}
+	names, _ := filepath.Glob("data/*")
+	var walked []string
+	filepath.Walk("/x", func(p string, info os.FileInfo, err error) error {
+		walked = append(walked, p)
+		return nil
+	})
+	_, err = os.Stat("/data/a.txt")
+	return fmt.Sprint(string(b), names, walked, os.IsNotExist(err))
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval("run()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, want := res.String(), "base[data/b.txt] [/x /x/y] true"; s != want {
+		t.Fatalf("got %q, want %q", s, want)
+	}
+
+	// The changes are visible to the host, and the base is left unmodified
+	if b, err := fs.ReadFile(fsys, "data/b.txt"); err != nil || string(b) != "B" {
+		t.Errorf("got %q, %v, want %q", b, err, "B")
+	}
+	if _, err := fsys.Stat("data/a.txt"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("got %v, want %v", err, fs.ErrNotExist)
+	}
+	if _, ok := base["data/a.txt"]; !ok || len(base) != 2 {
+		t.Errorf("base was modified: %v", base)
+	}
+}
+
+func TestSandboxFSEnv(t *testing.T) {
+	fsys := sandboxfs.New(fstest.MapFS{})
+	i := interp.New(interp.Options{Env: []string{"A=1"}})
+	i.Use(stdlib.Symbols)
+	i.Use(fsys.Symbols())
+	eval(t, i, `import "os"`)
+	runTests(t, i, []testCase{
+		{src: `os.Getenv("A")`, res: "1"},
+		{src: `os.Setenv("B", "2"); os.Environ()`, res: "[A=1 B=2]"},
+		{src: `os.WriteFile(os.ExpandEnv("/$B.txt"), nil, 0644); _, err := os.Stat("/2.txt"); err`, res: "<nil>"},
+	})
+	if _, ok := os.LookupEnv("B"); ok {
+		t.Error("process environment modified")
+	}
+}
+
+func TestSandboxFSHostPackages(t *testing.T) {
+	fsys := sandboxfs.New(fstest.MapFS{"main.go": &fstest.MapFile{Data: []byte("package main")}})
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Use(fsys.Symbols())
+
+	// go/parser reads the virtual files
+	_, err := i.Eval(`
+import (
+	"go/parser"
+	"go/token"
+)
+
+func parse(name string) string {
+	f, err := parser.ParseFile(token.NewFileSet(), name, nil, 0)
+	if err != nil {
+		return err.Error()
+	}
+	return f.Name.Name
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval(`parse("main.go") + " " + parse("/etc/passwd")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, want := res.String(), "main open /etc/passwd: file does not exist"; s != want {
+		t.Errorf("got %q, want %q", s, want)
+	}
+
+	// The functions reaching the host are not defined
+	for _, src := range []string{
+		`import "os/exec"; func f() { exec.Command("ls") }`,
+		`import "text/template"; func f() { template.New("t").ParseFiles("/etc/passwd") }`,
+		`import "html/template"; func f() { template.ParseGlob("/etc/*") }`,
+		`import "archive/zip"; func f() { zip.OpenReader("/tmp/a.zip") }`,
+	} {
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		i.Use(fsys.Symbols())
+		if _, err := i.Eval(src); err == nil || !strings.Contains(err.Error(), "has no symbol") {
+			t.Errorf("%s: got %v, want an undefined symbol", src, err)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package jupyter
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"reflect"
+
+	"github.com/containous/yaegi/interp"
+)
+
+// Data is a value displayed by a notebook, in one or more representations
+// indexed by MIME type, such as "text/html". Binary representations are
+// encoded in base64.
+type Data map[string]interface{}
+
+// HTML returns the data displaying an HTML document.
+func HTML(s string) Data { return Data{"text/html": s} }
+
+// Markdown returns the data displaying a Markdown document.
+func Markdown(s string) Data { return Data{"text/markdown": s} }
+
+// SVG returns the data displaying an SVG image.
+func SVG(s string) Data { return Data{"image/svg+xml": s} }
+
+// PNG returns the data displaying a PNG encoded image.
+func PNG(b []byte) Data { return Data{"image/png": base64.StdEncoding.EncodeToString(b)} }
+
+// JPEG returns the data displaying a JPEG encoded image.
+func JPEG(b []byte) Data { return Data{"image/jpeg": base64.StdEncoding.EncodeToString(b)} }
+
+// JSON returns the data displaying v as a JSON document, which the notebook
+// lets explore.
+func JSON(v interface{}) Data { return Data{"application/json": v} }
+
+// Symbols exposes the functions building rich display data to interpreted
+// code, as package github.com/containous/yaegi/interp/jupyter.
+var Symbols = interp.Exports{
+	"github.com/containous/yaegi/interp/jupyter": {
+		"HTML":     reflect.ValueOf(HTML),
+		"JPEG":     reflect.ValueOf(JPEG),
+		"JSON":     reflect.ValueOf(JSON),
+		"Markdown": reflect.ValueOf(Markdown),
+		"PNG":      reflect.ValueOf(PNG),
+		"SVG":      reflect.ValueOf(SVG),
+
+		"Data": reflect.ValueOf((*Data)(nil)),
+	},
+}
+
+// display returns the data displaying the value v: as is for a Data value,
+// as a PNG image for an image.Image, and formatted as text otherwise.
+func display(v reflect.Value) Data {
+	var x interface{} = v
+	if v.CanInterface() {
+		x = v.Interface()
+	}
+	switch x := x.(type) {
+	case Data:
+		return x
+	case image.Image:
+		var b bytes.Buffer
+		if err := png.Encode(&b, x); err == nil {
+			d := PNG(b.Bytes())
+			d["text/plain"] = fmt.Sprintf("%T %v", x, x.Bounds())
+			return d
+		}
+	}
+	return Data{"text/plain": fmt.Sprint(x)}
+}
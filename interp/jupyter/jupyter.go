@@ -0,0 +1,205 @@
+// Package jupyter implements a Jupyter kernel for the interpreter.
+//
+// Jupyter notebooks, and other frontends such as nteract, run cells of code
+// in a kernel process, with the messaging protocol specified at
+// https://jupyter-client.readthedocs.io/en/stable/messaging.html, on top of
+// ZeroMQ sockets described by a connection file.
+//
+// A Server evaluates the cells of a session in an interpreter, at global
+// level in an implicit main package, as in the REPL: definitions persist
+// from one cell to the next, and may be redefined by a later cell. Values
+// of the cells ending with an expression are displayed, in rich formats for
+// images and for the Data values returned by HTML, Markdown, SVG, PNG,
+// JPEG and JSON.
+package jupyter
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// protocolVersion is the version of the messaging protocol.
+const protocolVersion = "5.3"
+
+// delimiter separates the routing identities from the message frames.
+var delimiter = []byte("<IDS|MSG>")
+
+// Connection is the content of a connection file, written by the client
+// to describe the sockets of the kernel.
+type Connection struct {
+	Transport       string `json:"transport"`
+	IP              string `json:"ip"`
+	ShellPort       int    `json:"shell_port"`
+	ControlPort     int    `json:"control_port"`
+	StdinPort       int    `json:"stdin_port"`
+	IOPubPort       int    `json:"iopub_port"`
+	HBPort          int    `json:"hb_port"`
+	Key             string `json:"key"`
+	SignatureScheme string `json:"signature_scheme"`
+}
+
+// header is a message header.
+type header struct {
+	MsgID    string `json:"msg_id"`
+	Session  string `json:"session"`
+	Username string `json:"username"`
+	Date     string `json:"date"`
+	MsgType  string `json:"msg_type"`
+	Version  string `json:"version"`
+}
+
+// message is a message of the Jupyter protocol.
+type message struct {
+	ids      [][]byte        // routing identities of the sender
+	Header   header          // header
+	Parent   json.RawMessage // header of the parent message, or {}
+	Metadata json.RawMessage // metadata, or {}
+	Content  json.RawMessage // content, depending on the message type
+}
+
+// newID returns a new unique identifier, formatted as a UUID.
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	h := hex.EncodeToString(b)
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// sign returns the HMAC-SHA256 signature of the frames with key, or an
+// empty string if key is empty.
+func sign(key []byte, frames ...[]byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	h := hmac.New(sha256.New, key)
+	for _, f := range frames {
+		h.Write(f)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// decode returns the message received in frames, after checking its
+// signature with key.
+func decode(key []byte, frames [][]byte) (*message, error) {
+	i := 0
+	for i < len(frames) && !bytes.Equal(frames[i], delimiter) {
+		i++
+	}
+	if len(frames) < i+6 {
+		return nil, errors.New("invalid message")
+	}
+	f := frames[i+1:]
+	if !hmac.Equal([]byte(sign(key, f[1], f[2], f[3], f[4])), f[0]) {
+		return nil, errors.New("invalid message signature")
+	}
+	m := &message{ids: frames[:i], Parent: f[2], Metadata: f[3], Content: f[4]}
+	if err := json.Unmarshal(f[1], &m.Header); err != nil {
+		return nil, fmt.Errorf("invalid message header: %v", err)
+	}
+	return m, nil
+}
+
+// encode returns the frames of a message of type typ, with content, in reply
+// to parent, from session. For an IOPub message, ids is the topic.
+func encode(key []byte, session, typ string, parent *message, content interface{}, ids ...[]byte) ([][]byte, error) {
+	h, err := json.Marshal(&header{
+		MsgID:    newID(),
+		Session:  session,
+		Username: "kernel",
+		Date:     time.Now().UTC().Format(time.RFC3339Nano),
+		MsgType:  typ,
+		Version:  protocolVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	p := []byte("{}")
+	if parent != nil {
+		if p, err = json.Marshal(&parent.Header); err != nil {
+			return nil, err
+		}
+	}
+	c, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	meta := []byte("{}")
+	frames := append([][]byte{}, ids...)
+	return append(frames, delimiter, []byte(sign(key, h, p, meta, c)), h, p, meta, c), nil
+}
+
+// kernelInfo is the content of a kernel_info_reply.
+type kernelInfo struct {
+	Status                string       `json:"status"`
+	ProtocolVersion       string       `json:"protocol_version"`
+	Implementation        string       `json:"implementation"`
+	ImplementationVersion string       `json:"implementation_version"`
+	LanguageInfo          languageInfo `json:"language_info"`
+	Banner                string       `json:"banner"`
+}
+
+type languageInfo struct {
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	Mimetype      string `json:"mimetype"`
+	FileExtension string `json:"file_extension"`
+}
+
+// executeRequest is the content of an execute_request.
+type executeRequest struct {
+	Code         string `json:"code"`
+	Silent       bool   `json:"silent"`
+	StoreHistory bool   `json:"store_history"`
+}
+
+// executeReply is the content of an execute_reply.
+type executeReply struct {
+	Status          string                 `json:"status"`
+	ExecutionCount  int                    `json:"execution_count"`
+	UserExpressions map[string]interface{} `json:"user_expressions,omitempty"`
+	errorContent
+}
+
+// errorContent is the content of an error, and the error part of a reply.
+type errorContent struct {
+	Name      string   `json:"ename,omitempty"`
+	Value     string   `json:"evalue,omitempty"`
+	Traceback []string `json:"traceback,omitempty"`
+}
+
+// executeResult is the content of an execute_result.
+type executeResult struct {
+	ExecutionCount int                    `json:"execution_count"`
+	Data           Data                   `json:"data"`
+	Metadata       map[string]interface{} `json:"metadata"`
+}
+
+// completeRequest is the content of a complete_request.
+type completeRequest struct {
+	Code      string `json:"code"`
+	CursorPos int    `json:"cursor_pos"`
+}
+
+// completeReply is the content of a complete_reply.
+type completeReply struct {
+	Status      string                 `json:"status"`
+	Matches     []string               `json:"matches"`
+	CursorStart int                    `json:"cursor_start"`
+	CursorEnd   int                    `json:"cursor_end"`
+	Metadata    map[string]interface{} `json:"metadata"`
+}
+
+// shutdownRequest is the content of a shutdown_request and of its reply.
+type shutdownRequest struct {
+	Status  string `json:"status,omitempty"`
+	Restart bool   `json:"restart"`
+}
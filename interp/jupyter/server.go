@@ -0,0 +1,498 @@
+package jupyter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"net"
+	"os"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/containous/yaegi/interp"
+)
+
+// syncMark is written on an output pipe to wait until the output written
+// before it is forwarded.
+const syncMark = "\x00yaegi-jupyter-sync\x00"
+
+// A Server is a Jupyter kernel, serving a session on the sockets of a
+// connection.
+type Server struct {
+	key       []byte
+	session   string
+	newInterp func() *interp.Interpreter
+
+	shell, control, stdin, iopub, hb *socket
+
+	quit     chan struct{} // closed on shutdown
+	quitOnce sync.Once
+
+	mutex  sync.Mutex // protects fields below, accessed from the control and output goroutines
+	interp *interp.Interpreter
+	count  int                // execution counter
+	parent *message           // request handled on the shell socket, parent of output streams
+	cancel context.CancelFunc // cancels the running execution, or nil
+
+	// Output redirection, set by CaptureOutput
+	outputs []*output
+}
+
+// output is a redirected output stream.
+type output struct {
+	name   string        // stream name, stdout or stderr
+	w      *os.File      // pipe replacing the stream
+	synced chan struct{} // receives when a sync mark is read
+}
+
+// NewServer returns a kernel listening on the sockets of connection c.
+// Sockets of port 0 listen on a free port, which is set in c. Function
+// newInterp returns the interpreter of the session, at start and at each
+// restart.
+func NewServer(c *Connection, newInterp func() *interp.Interpreter) (*Server, error) {
+	if c.Transport != "" && c.Transport != "tcp" {
+		return nil, fmt.Errorf("unsupported transport %s", c.Transport)
+	}
+	if c.Key != "" && c.SignatureScheme != "hmac-sha256" {
+		return nil, fmt.Errorf("unsupported signature scheme %s", c.SignatureScheme)
+	}
+	s := &Server{key: []byte(c.Key), session: newID(), newInterp: newInterp, quit: make(chan struct{})}
+
+	var err error
+	for _, sock := range []struct {
+		s    **socket
+		typ  string
+		port *int
+	}{
+		{&s.shell, "ROUTER", &c.ShellPort},
+		{&s.control, "ROUTER", &c.ControlPort},
+		{&s.stdin, "ROUTER", &c.StdinPort},
+		{&s.iopub, "PUB", &c.IOPubPort},
+		{&s.hb, "REP", &c.HBPort},
+	} {
+		if *sock.s, err = listen(sock.typ, net.JoinHostPort(c.IP, strconv.Itoa(*sock.port))); err != nil {
+			s.close()
+			return nil, err
+		}
+		*sock.port = (*sock.s).port()
+	}
+	s.interp = s.start()
+	return s, nil
+}
+
+// start returns a new interpreter for the session.
+func (s *Server) start() *interp.Interpreter {
+	i := s.newInterp()
+	i.Use(Symbols)
+	return i
+}
+
+// close closes the sockets.
+func (s *Server) close() {
+	for _, sock := range []*socket{s.shell, s.control, s.stdin, s.iopub, s.hb} {
+		if sock != nil {
+			_ = sock.close()
+		}
+	}
+}
+
+// CaptureOutput redirects the standard output and error of the process to
+// stream messages, displayed by the client in the output of cells.
+func (s *Server) CaptureOutput() error {
+	for _, f := range []struct {
+		file **os.File
+		name string
+	}{{&os.Stdout, "stdout"}, {&os.Stderr, "stderr"}} {
+		r, w, err := os.Pipe()
+		if err != nil {
+			return err
+		}
+		o := &output{name: f.name, w: w, synced: make(chan struct{})}
+		*f.file = w
+		s.outputs = append(s.outputs, o)
+		go s.forward(o, r)
+	}
+	return nil
+}
+
+// forward sends the output read from r as stream messages.
+func (s *Server) forward(o *output, r *os.File) {
+	mark := []byte(syncMark)
+	var buf []byte
+	b := make([]byte, 4096)
+	for {
+		n, err := r.Read(b)
+		buf = append(buf, b[:n]...)
+		for {
+			i := bytes.Index(buf, mark)
+			if i < 0 {
+				break
+			}
+			s.stream(o.name, buf[:i])
+			buf = buf[i+len(mark):]
+			o.synced <- struct{}{}
+		}
+		// Keep the beginning of a mark, if any, until next read
+		keep := 0
+		for k := len(mark) - 1; k > 0; k-- {
+			if bytes.HasSuffix(buf, mark[:k]) {
+				keep = k
+				break
+			}
+		}
+		s.stream(o.name, buf[:len(buf)-keep])
+		buf = buf[len(buf)-keep:]
+		if err != nil {
+			return
+		}
+	}
+}
+
+// flushOutput returns once the output written so far is forwarded.
+func (s *Server) flushOutput() {
+	for _, o := range s.outputs {
+		if _, err := o.w.WriteString(syncMark); err == nil {
+			<-o.synced
+		}
+	}
+}
+
+// stream publishes a stream message with output b.
+func (s *Server) stream(name string, b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	s.mutex.Lock()
+	parent := s.parent
+	s.mutex.Unlock()
+	s.publish("stream", parent, map[string]string{"name": name, "text": string(b)})
+}
+
+// Serve handles the client requests until a shutdown request.
+func (s *Server) Serve() error {
+	defer s.close()
+	s.publish("status", nil, map[string]string{"execution_state": "starting"})
+
+	go func() {
+		for {
+			select {
+			case frames := <-s.control.recv:
+				s.handle(s.control, frames)
+			case <-s.stdin.recv:
+				// No input is requested
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case frames := <-s.shell.recv:
+			s.handle(s.shell, frames)
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// Interrupt stops the execution of the current cell, if any.
+func (s *Server) Interrupt() {
+	s.mutex.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mutex.Unlock()
+}
+
+// handle processes a request received on socket sock.
+func (s *Server) handle(sock *socket, frames [][]byte) {
+	m, err := decode(s.key, frames)
+	if err != nil {
+		// Invalid messages are ignored
+		return
+	}
+	s.publish("status", m, map[string]string{"execution_state": "busy"})
+	defer s.publish("status", m, map[string]string{"execution_state": "idle"})
+
+	switch m.Header.MsgType {
+	case "kernel_info_request":
+		s.reply(sock, m, "kernel_info_reply", &kernelInfo{
+			Status:                "ok",
+			ProtocolVersion:       protocolVersion,
+			Implementation:        "yaegi",
+			ImplementationVersion: version(),
+			LanguageInfo: languageInfo{
+				Name:          "go",
+				Version:       strings.TrimPrefix(runtime.Version(), "go"),
+				Mimetype:      "text/x-go",
+				FileExtension: ".go",
+			},
+			Banner: "Yaegi, Go interpreter",
+		})
+
+	case "execute_request":
+		s.execute(sock, m)
+
+	case "complete_request":
+		req := &completeRequest{}
+		_ = json.Unmarshal(m.Content, req)
+		s.reply(sock, m, "complete_reply", s.complete(req))
+
+	case "is_complete_request":
+		s.reply(sock, m, "is_complete_reply", map[string]string{"status": "unknown"})
+
+	case "inspect_request":
+		s.reply(sock, m, "inspect_reply", map[string]interface{}{"status": "ok", "found": false, "data": Data{}, "metadata": Data{}})
+
+	case "history_request":
+		s.reply(sock, m, "history_reply", map[string]interface{}{"status": "ok", "history": []interface{}{}})
+
+	case "comm_info_request":
+		s.reply(sock, m, "comm_info_reply", map[string]interface{}{"status": "ok", "comms": map[string]interface{}{}})
+
+	case "interrupt_request":
+		s.Interrupt()
+		s.reply(sock, m, "interrupt_reply", map[string]string{"status": "ok"})
+
+	case "shutdown_request":
+		req := &shutdownRequest{}
+		_ = json.Unmarshal(m.Content, req)
+		s.Interrupt()
+		s.reply(sock, m, "shutdown_reply", &shutdownRequest{Status: "ok", Restart: req.Restart})
+		if req.Restart {
+			i := s.start()
+			s.mutex.Lock()
+			s.interp, s.count = i, 0
+			s.mutex.Unlock()
+			return
+		}
+		s.quitOnce.Do(func() { close(s.quit) })
+	}
+}
+
+// execute runs the code of an execute request.
+func (s *Server) execute(sock *socket, m *message) {
+	req := &executeRequest{}
+	if err := json.Unmarshal(m.Content, req); err != nil {
+		s.reply(sock, m, "execute_reply", &executeReply{Status: "error", errorContent: errorContent{Name: "error", Value: err.Error()}})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.mutex.Lock()
+	if !req.Silent && req.StoreHistory {
+		s.count++
+	}
+	i, count := s.interp, s.count
+	s.parent, s.cancel = m, cancel
+	s.mutex.Unlock()
+
+	if !req.Silent {
+		s.publish("execute_input", m, map[string]interface{}{"code": req.Code, "execution_count": count})
+	}
+	v, err := eval(ctx, i, "In["+strconv.Itoa(count)+"]", req.Code)
+	s.flushOutput()
+	s.mutex.Lock()
+	s.cancel = nil
+	s.mutex.Unlock()
+
+	if err != nil {
+		e := errorContent{Name: "error", Value: err.Error(), Traceback: strings.Split(err.Error(), "\n")}
+		if ie, ok := err.(*interp.Error); ok && len(ie.Stack) > 0 {
+			e.Name = "panic"
+			e.Traceback = append(e.Traceback, strings.Split(strings.TrimSuffix(ie.StackTrace(), "\n"), "\n")...)
+		}
+		if errors.Is(err, context.Canceled) {
+			e = errorContent{Name: "interrupt", Value: "execution interrupted", Traceback: []string{"execution interrupted"}}
+		}
+		if !req.Silent {
+			s.publish("error", m, &e)
+		}
+		s.reply(sock, m, "execute_reply", &executeReply{Status: "error", ExecutionCount: count, errorContent: e})
+		return
+	}
+	if v.IsValid() && !req.Silent && endsWithExpr(req.Code) {
+		s.publish("execute_result", m, &executeResult{ExecutionCount: count, Data: display(v), Metadata: map[string]interface{}{}})
+	}
+	s.reply(sock, m, "execute_reply", &executeReply{Status: "ok", ExecutionCount: count, UserExpressions: map[string]interface{}{}})
+}
+
+// eval evaluates the code of a cell named name in interpreter i, until ctx
+// is canceled. A panic of the interpreted code is returned as an error.
+func eval(ctx context.Context, i *interp.Interpreter, name, code string) (v reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	i.Name = name
+	for _, src := range split(code) {
+		if v, err = i.EvalWithContext(ctx, src); err != nil {
+			break
+		}
+	}
+	return v, err
+}
+
+// statements returns the offsets in code of its top level statements and
+// declarations. Headers of for, if and switch statements are split, as
+// their statements are not distinguished.
+func statements(code string) []int {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	s.Init(fset.AddFile("", fset.Base(), len(code)), []byte(code), nil, 0)
+	var offsets []int
+	start, depth := -1, 0
+	for {
+		pos, tok, _ := s.Scan()
+		if tok == token.EOF {
+			return offsets
+		}
+		if start < 0 && tok != token.SEMICOLON {
+			start = fset.Position(pos).Offset
+			offsets = append(offsets, start)
+		}
+		switch tok {
+		case token.LBRACE, token.LPAREN, token.LBRACK:
+			depth++
+		case token.RBRACE, token.RPAREN, token.RBRACK:
+			depth--
+		case token.SEMICOLON:
+			if depth == 0 {
+				start = -1
+			}
+		}
+	}
+}
+
+// split splits the code of a cell into sources made of either declarations
+// or statements, as expected by the interpreter. Each source is prefixed by
+// the blanks preserving its position in the cell.
+func split(code string) []string {
+	var srcs []string
+	offsets := statements(code)
+	from, decl := 0, false
+	for k, off := range offsets {
+		d := isDecl(code[off:])
+		if k > 0 && d != decl {
+			srcs = append(srcs, blank(code[:from])+code[from:off])
+			from = off
+		}
+		decl = d
+	}
+	return append(srcs, blank(code[:from])+code[from:])
+}
+
+// isDecl reports whether src starts with a declaration.
+func isDecl(src string) bool {
+	for _, kw := range []string{"const", "func", "import", "type", "var", "package"} {
+		if strings.HasPrefix(src, kw) && !isIdentRune(src[len(kw):]) {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdentRune(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// blank returns s with all characters except newlines replaced by spaces.
+func blank(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' {
+			return r
+		}
+		return ' '
+	}, s)
+}
+
+// endsWithExpr reports whether the last statement of code is an expression,
+// whose value is displayed.
+func endsWithExpr(code string) bool {
+	offsets := statements(code)
+	if len(offsets) == 0 {
+		return false
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", "package p; func _() {\n"+code[offsets[len(offsets)-1]:]+"\n}", 0)
+	if err != nil {
+		return false
+	}
+	body := f.Decls[0].(*ast.FuncDecl).Body.List
+	if len(body) != 1 {
+		return false
+	}
+	_, ok := body[0].(*ast.ExprStmt)
+	return ok
+}
+
+// complete returns the completions at the cursor position of a request.
+func (s *Server) complete(req *completeRequest) *completeReply {
+	// The cursor position is given in unicode code points
+	pos := len(req.Code)
+	for k := range req.Code {
+		if req.CursorPos == 0 {
+			pos = k
+			break
+		}
+		req.CursorPos--
+	}
+	line := req.Code[strings.LastIndex(req.Code[:pos], "\n")+1 : pos]
+
+	s.mutex.Lock()
+	i := s.interp
+	s.mutex.Unlock()
+	start, names := i.Complete(line)
+	if names == nil {
+		names = []string{}
+	}
+	end := utf8.RuneCountInString(req.Code[:pos])
+	return &completeReply{
+		Status:      "ok",
+		Matches:     names,
+		CursorStart: end - utf8.RuneCountInString(line[start:]),
+		CursorEnd:   end,
+		Metadata:    map[string]interface{}{},
+	}
+}
+
+// reply sends a reply to request m on socket sock.
+func (s *Server) reply(sock *socket, m *message, typ string, content interface{}) {
+	if frames, err := encode(s.key, s.session, typ, m, content, m.ids...); err == nil {
+		sock.send(frames)
+	}
+}
+
+// publish broadcasts a message on the IOPub socket.
+func (s *Server) publish(typ string, parent *message, content interface{}) {
+	if frames, err := encode(s.key, s.session, typ, parent, content, []byte(typ)); err == nil {
+		s.iopub.send(frames)
+	}
+}
+
+// version returns the version of the interpreter module, or "devel".
+func version() string {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, m := range append([]*debug.Module{&bi.Main}, bi.Deps...) {
+			if m.Path == "github.com/containous/yaegi" && m.Version != "" && m.Version != "(devel)" {
+				return strings.TrimPrefix(m.Version, "v")
+			}
+		}
+	}
+	return "devel"
+}
@@ -0,0 +1,263 @@
+package jupyter
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+type client struct {
+	t                         *testing.T
+	key                       []byte
+	shell, control, iopub, hb *zconn
+	pub                       chan *message
+}
+
+func newClient(t *testing.T) (*client, chan error) {
+	c := &Connection{IP: "127.0.0.1", Key: "secret", SignatureScheme: "hmac-sha256"}
+	s, err := NewServer(c, func() *interp.Interpreter {
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		return i
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- s.Serve() }()
+
+	cl := &client{t: t, key: []byte(c.Key), pub: make(chan *message, 100)}
+	cl.shell = cl.dial("DEALER", c.ShellPort)
+	cl.control = cl.dial("DEALER", c.ControlPort)
+	cl.hb = cl.dial("REQ", c.HBPort)
+	cl.iopub = cl.dial("SUB", c.IOPubPort)
+	go func() {
+		for {
+			frames, err := cl.iopub.readMsg()
+			if err != nil {
+				close(cl.pub)
+				return
+			}
+			m, err := decode(cl.key, frames[1:])
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			cl.pub <- m
+		}
+	}()
+
+	// Wait for the subscription to be effective, as Jupyter clients do
+	for {
+		cl.request(cl.shell, "kernel_info_request", struct{}{})
+		select {
+		case m := <-cl.pub:
+			if m.Header.MsgType == "status" {
+				return cl, done
+			}
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (c *client) dial(typ string, port int) *zconn {
+	conn, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)))
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	z, _, err := handshake(conn, typ)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return z
+}
+
+// request sends a request, and returns its header.
+func (c *client) request(z *zconn, typ string, content interface{}) *message {
+	frames, err := encode(c.key, "test", typ, nil, content)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := z.writeMsg(frames); err != nil {
+		c.t.Fatal(err)
+	}
+	m, _ := decode(c.key, frames)
+	return m
+}
+
+// reply returns the content of the reply to a request, of type typ.
+func (c *client) reply(z *zconn, typ string, content interface{}) {
+	for {
+		frames, err := z.readMsg()
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		m, err := decode(c.key, frames)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+		if m.Header.MsgType == typ {
+			if err := json.Unmarshal(m.Content, content); err != nil {
+				c.t.Fatal(err)
+			}
+			return
+		}
+	}
+}
+
+// published returns the content of the next IOPub message of type typ,
+// in reply to req.
+func (c *client) published(req *message, typ string) map[string]interface{} {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case m := <-c.pub:
+			var parent header
+			_ = json.Unmarshal(m.Parent, &parent)
+			if m.Header.MsgType != typ || parent.MsgID != req.Header.MsgID {
+				continue
+			}
+			content := map[string]interface{}{}
+			if err := json.Unmarshal(m.Content, &content); err != nil {
+				c.t.Fatal(err)
+			}
+			return content
+		case <-timeout:
+			c.t.Fatalf("no %s message", typ)
+		}
+	}
+}
+
+func TestServer(t *testing.T) {
+	c, done := newClient(t)
+
+	info := &kernelInfo{}
+	c.reply(c.shell, "kernel_info_reply", info)
+	if info.Implementation != "yaegi" || info.LanguageInfo.Name != "go" {
+		t.Errorf("got kernel info %+v", info)
+	}
+
+	if err := c.hb.writeMsg([][]byte{nil, []byte("ping")}); err != nil {
+		t.Fatal(err)
+	}
+	if msg, err := c.hb.readMsg(); err != nil || len(msg) != 2 || string(msg[1]) != "ping" {
+		t.Errorf("got heartbeat %q, %v", msg, err)
+	}
+
+	for _, test := range []struct {
+		code, mime, data, error string
+	}{
+		{code: `import "fmt"`},
+		{code: "x := 2\nfmt.Sprint(x * 21)", mime: "text/plain", data: "42"},
+		{code: "func f() int { return x }"},
+		{code: "f()", mime: "text/plain", data: "2"},
+		{code: "import \"github.com/containous/yaegi/interp/jupyter\"\njupyter.HTML(`<b>hi</b>`)", mime: "text/html", data: "<b>hi</b>"},
+		{code: "z := 1\ny + z", error: "In[6]:2:1: undefined: y"},
+	} {
+		req := c.request(c.shell, "execute_request", &executeRequest{Code: test.code, StoreHistory: true})
+		reply := &executeReply{}
+		c.reply(c.shell, "execute_reply", reply)
+		if test.error != "" {
+			if reply.Status != "error" || reply.Value != test.error {
+				t.Errorf("%s: got reply %+v, want error %q", test.code, reply, test.error)
+			}
+			continue
+		}
+		if reply.Status != "ok" {
+			t.Fatalf("%s: got reply %+v", test.code, reply)
+		}
+		if test.mime != "" {
+			data := c.published(req, "execute_result")["data"].(map[string]interface{})
+			if data[test.mime] != test.data {
+				t.Errorf("%s: got data %v, want %s %q", test.code, data, test.mime, test.data)
+			}
+		}
+	}
+
+	complete := &completeReply{}
+	c.request(c.shell, "complete_request", &completeRequest{Code: "a := 1\nfmt.Sprin", CursorPos: 16})
+	c.reply(c.shell, "complete_reply", complete)
+	if len(complete.Matches) != 3 || complete.Matches[0] != "Sprint" || complete.CursorStart != 11 || complete.CursorEnd != 16 {
+		t.Errorf("got completion %+v", complete)
+	}
+
+	c.request(c.shell, "execute_request", &executeRequest{Code: "for {}", StoreHistory: true})
+	time.Sleep(100 * time.Millisecond)
+	c.request(c.control, "interrupt_request", struct{}{})
+	c.reply(c.control, "interrupt_reply", &struct{}{})
+	reply := &executeReply{}
+	c.reply(c.shell, "execute_reply", reply)
+	if reply.Status != "error" || reply.Name != "interrupt" {
+		t.Errorf("got reply %+v, want interrupt", reply)
+	}
+
+	c.request(c.control, "shutdown_request", &shutdownRequest{Restart: true})
+	c.reply(c.control, "shutdown_reply", &shutdownRequest{})
+	c.request(c.shell, "execute_request", &executeRequest{Code: "x", StoreHistory: true})
+	c.reply(c.shell, "execute_reply", reply)
+	if reply.Status != "error" || reply.ExecutionCount != 1 {
+		t.Errorf("got reply %+v after restart, want error", reply)
+	}
+
+	c.request(c.control, "shutdown_request", &shutdownRequest{})
+	c.reply(c.control, "shutdown_reply", &shutdownRequest{})
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Error(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("server not stopped by shutdown")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	for _, test := range []struct {
+		code string
+		want []string
+	}{
+		{"1 + 2", []string{"1 + 2"}},
+		{"import \"fmt\"\nfmt.Println(1)", []string{"import \"fmt\"\n", "            \nfmt.Println(1)"}},
+		{"x := 1; type T int; var y T", []string{"x := 1; ", "        type T int; var y T"}},
+		{"for i := 0; i < 3; i++ {\n}\nfunc f() {}", []string{"for i := 0; i < 3; i++ {\n}\n", "                        \n \nfunc f() {}"}},
+		{"variable := 1", []string{"variable := 1"}},
+	} {
+		got := split(test.code)
+		if len(got) != len(test.want) {
+			t.Errorf("split(%q): got %q, want %q", test.code, got, test.want)
+			continue
+		}
+		for k := range got {
+			if got[k] != test.want[k] {
+				t.Errorf("split(%q): got %q, want %q", test.code, got, test.want)
+				break
+			}
+		}
+	}
+}
+
+func TestEndsWithExpr(t *testing.T) {
+	for _, test := range []struct {
+		code string
+		want bool
+	}{
+		{"1 + 2", true},
+		{"x := 1", false},
+		{"import \"fmt\"\nfmt.Sprint(1)\n", true},
+		{"func f() int {\n\treturn 1\n}", false},
+		{"func f() int { return 1 }\nf()", true},
+		{"func() int { return 1 }()", true},
+		{"for i := 0; i < 3; i++ {\n}", false},
+		{"type T struct{ a int }\nT{1}", true},
+		{"", false},
+	} {
+		if got := endsWithExpr(test.code); got != test.want {
+			t.Errorf("endsWithExpr(%q): got %v, want %v", test.code, got, test.want)
+		}
+	}
+}
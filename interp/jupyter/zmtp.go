@@ -0,0 +1,321 @@
+package jupyter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+)
+
+// ZMTP 3.0 (https://rfc.zeromq.org/spec/23/), the ZeroMQ message transport
+// protocol used by Jupyter, is implemented for the sockets of a kernel: a
+// ROUTER, PUB or REP socket listening for peers, with the NULL security
+// mechanism.
+
+// Frame flags
+const (
+	flagMore    = 0x01
+	flagLong    = 0x02
+	flagCommand = 0x04
+)
+
+// maxFrameSize is the maximum size of a received frame.
+const maxFrameSize = 1 << 30
+
+// greeting returns the ZMTP greeting of a peer, announcing version 3.0 and
+// the NULL mechanism.
+func greeting() []byte {
+	g := make([]byte, 64)
+	g[0], g[9] = 0xff, 0x7f
+	g[10] = 3
+	copy(g[12:32], "NULL")
+	return g
+}
+
+// zconn is a ZMTP connection, after the handshake.
+type zconn struct {
+	conn  net.Conn
+	r     *bufio.Reader
+	mutex sync.Mutex // protects writes
+}
+
+// handshake performs the ZMTP handshake on conn, for a socket of type
+// typ, and returns the connection and the properties of the peer.
+func handshake(conn net.Conn, typ string) (*zconn, map[string]string, error) {
+	z := &zconn{conn: conn, r: bufio.NewReader(conn)}
+	if _, err := conn.Write(greeting()); err != nil {
+		return nil, nil, err
+	}
+	g := make([]byte, 64)
+	if _, err := io.ReadFull(z.r, g); err != nil {
+		return nil, nil, err
+	}
+	if g[0] != 0xff || g[9]&1 != 1 {
+		return nil, nil, errors.New("zmtp: invalid greeting")
+	}
+	if g[10] < 3 {
+		return nil, nil, fmt.Errorf("zmtp: unsupported version %d.%d", g[10], g[11])
+	}
+	if m := string(bytes.TrimRight(g[12:32], "\x00")); m != "NULL" {
+		return nil, nil, fmt.Errorf("zmtp: unsupported mechanism %s", m)
+	}
+
+	if err := z.writeFrame(flagCommand, command("READY", "Socket-Type", typ)); err != nil {
+		return nil, nil, err
+	}
+	flags, b, err := z.readFrame()
+	if err != nil {
+		return nil, nil, err
+	}
+	name, props, err := parseCommand(b)
+	if err != nil || flags&flagCommand == 0 || name != "READY" {
+		return nil, nil, errors.New("zmtp: invalid handshake")
+	}
+	return z, props, err
+}
+
+// command returns the body of a command frame, with properties given as
+// name, value pairs.
+func command(name string, props ...string) []byte {
+	b := append([]byte{byte(len(name))}, name...)
+	for i := 0; i+1 < len(props); i += 2 {
+		b = append(b, byte(len(props[i])))
+		b = append(b, props[i]...)
+		var n [4]byte
+		binary.BigEndian.PutUint32(n[:], uint32(len(props[i+1])))
+		b = append(append(b, n[:]...), props[i+1]...)
+	}
+	return b
+}
+
+// parseCommand returns the name and the properties of a command frame. The
+// properties of commands other than READY are not parsed.
+func parseCommand(b []byte) (string, map[string]string, error) {
+	if len(b) == 0 || len(b) < 1+int(b[0]) {
+		return "", nil, errors.New("zmtp: invalid command")
+	}
+	name, b := string(b[1:1+b[0]]), b[1+b[0]:]
+	props := map[string]string{}
+	if name != "READY" {
+		return name, props, nil
+	}
+	for len(b) > 0 {
+		n := int(b[0])
+		if len(b) < 1+n+4 {
+			return "", nil, errors.New("zmtp: invalid property")
+		}
+		key := string(b[1 : 1+n])
+		m := int(binary.BigEndian.Uint32(b[1+n:]))
+		b = b[1+n+4:]
+		if len(b) < m {
+			return "", nil, errors.New("zmtp: invalid property")
+		}
+		props[key], b = string(b[:m]), b[m:]
+	}
+	return name, props, nil
+}
+
+// readFrame returns the flags and the body of the next frame.
+func (z *zconn) readFrame() (byte, []byte, error) {
+	flags, err := z.r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	var size uint64
+	if flags&flagLong != 0 {
+		var b [8]byte
+		if _, err := io.ReadFull(z.r, b[:]); err != nil {
+			return 0, nil, err
+		}
+		size = binary.BigEndian.Uint64(b[:])
+	} else {
+		s, err := z.r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		size = uint64(s)
+	}
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("zmtp: frame too large: %d bytes", size)
+	}
+	b := make([]byte, size)
+	if _, err := io.ReadFull(z.r, b); err != nil {
+		return 0, nil, err
+	}
+	return flags, b, nil
+}
+
+// writeFrame writes a frame. It must be called with the mutex held, or
+// during the handshake.
+func (z *zconn) writeFrame(flags byte, b []byte) error {
+	hdr := []byte{flags, byte(len(b))}
+	if len(b) > 255 {
+		hdr = make([]byte, 9)
+		hdr[0] = flags | flagLong
+		binary.BigEndian.PutUint64(hdr[1:], uint64(len(b)))
+	}
+	if _, err := z.conn.Write(append(hdr, b...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// readMsg returns the frames of the next message. Commands received meanwhile
+// are handled: PING is answered by PONG, others are ignored.
+func (z *zconn) readMsg() ([][]byte, error) {
+	var msg [][]byte
+	for {
+		flags, b, err := z.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if flags&flagCommand != 0 {
+			if name, _, err := parseCommand(b); err == nil && name == "PING" && len(b) >= 7 {
+				// Reply with the context following the name and the TTL
+				if err := z.writeCommand(append(command("PONG"), b[7:]...)); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		msg = append(msg, b)
+		if flags&flagMore == 0 {
+			return msg, nil
+		}
+	}
+}
+
+// writeCommand writes a command frame.
+func (z *zconn) writeCommand(b []byte) error {
+	z.mutex.Lock()
+	defer z.mutex.Unlock()
+	return z.writeFrame(flagCommand, b)
+}
+
+// writeMsg writes a message made of frames.
+func (z *zconn) writeMsg(frames [][]byte) error {
+	z.mutex.Lock()
+	defer z.mutex.Unlock()
+	for i, f := range frames {
+		var flags byte
+		if i < len(frames)-1 {
+			flags = flagMore
+		}
+		if err := z.writeFrame(flags, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// A socket is a ZMTP socket listening for peers. For a ROUTER socket,
+// received messages are delivered on channel recv, prefixed by the identity
+// of the sending peer, and sent messages are routed to the peer identified by
+// their first frame. A PUB socket sends messages to all its peers, and a REP
+// socket echoes back the received messages, as required by Jupyter heartbeat.
+type socket struct {
+	typ      string
+	listener net.Listener
+	recv     chan [][]byte
+
+	mutex sync.Mutex        // protects fields below
+	peers map[string]*zconn // connected peers, indexed by identity
+	next  uint32            // number used to generate the next identity
+}
+
+// listen returns a socket of type typ listening on TCP address addr.
+func listen(typ, addr string) (*socket, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &socket{typ: typ, listener: l, recv: make(chan [][]byte), peers: map[string]*zconn{}}
+	go s.accept()
+	return s, nil
+}
+
+// port returns the TCP port on which the socket listens.
+func (s *socket) port() int { return s.listener.Addr().(*net.TCPAddr).Port }
+
+func (s *socket) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.serve(conn)
+	}
+}
+
+// serve handles the messages received from a peer.
+func (s *socket) serve(conn net.Conn) {
+	defer conn.Close()
+	z, props, err := handshake(conn, s.typ)
+	if err != nil {
+		return
+	}
+
+	s.mutex.Lock()
+	id := props["Identity"]
+	if _, ok := s.peers[id]; ok || id == "" {
+		// Generate an identity as libzmq does, with a leading zero byte
+		s.next++
+		b := make([]byte, 5)
+		binary.BigEndian.PutUint32(b[1:], s.next)
+		id = string(b)
+	}
+	s.peers[id] = z
+	s.mutex.Unlock()
+	defer func() {
+		s.mutex.Lock()
+		delete(s.peers, id)
+		s.mutex.Unlock()
+	}()
+
+	for {
+		msg, err := z.readMsg()
+		if err != nil {
+			return
+		}
+		switch s.typ {
+		case "ROUTER":
+			s.recv <- append([][]byte{[]byte(id)}, msg...)
+		case "REP":
+			if err := z.writeMsg(msg); err != nil {
+				return
+			}
+		}
+		// Messages received by a PUB socket are subscriptions, and all
+		// messages are sent to all peers.
+	}
+}
+
+// send sends a message to the peer identified by its first frame for a
+// ROUTER socket, or to all peers for a PUB socket. Messages to unknown or
+// disconnected peers are dropped.
+func (s *socket) send(msg [][]byte) {
+	s.mutex.Lock()
+	var peers []*zconn
+	if s.typ == "ROUTER" {
+		if len(msg) > 0 && s.peers[string(msg[0])] != nil {
+			peers = append(peers, s.peers[string(msg[0])])
+			msg = msg[1:]
+		}
+	} else {
+		for _, z := range s.peers {
+			peers = append(peers, z)
+		}
+	}
+	s.mutex.Unlock()
+
+	for _, z := range peers {
+		_ = z.writeMsg(msg)
+	}
+}
+
+// close stops listening for new peers.
+func (s *socket) close() error { return s.listener.Close() }
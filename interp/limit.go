@@ -0,0 +1,168 @@
+package interp
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"sync/atomic"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// Errors returned by an evaluation exceeding its budget.
+var (
+	// ErrMemoryLimit is returned by an evaluation which allocates more memory
+	// than allowed by Options.MaxMemory.
+	ErrMemoryLimit = errors.New("memory limit exceeded")
+
+	// ErrStepLimit is returned by an evaluation which executes more steps
+	// than allowed by Options.MaxSteps.
+	ErrStepLimit = errors.New("step limit exceeded")
+)
+
+// Sizes of the structures allocated by the interpreter for the calls and
+// the function values of interpreted code.
+var (
+	frameSize = unsafe.Sizeof(frame{})
+	nodeSize  = unsafe.Sizeof(node{})
+	valueSize = unsafe.Sizeof(reflect.Value{})
+)
+
+// accounting returns true if the allocations of interpreted code in frame f
+// are accounted, for the memory budget, the statistics or the metrics.
+func (interp *Interpreter) accounting(f *frame) bool {
+	return interp.maxMemory != 0 || f.stats != nil || interp.metrics != nil
+}
+
+// alloc accounts n objects of the given size allocated by interpreted code
+// in frame f. If the memory budget is exceeded, the current evaluation is
+// stopped and false is returned, in which case the allocation must not be
+// performed.
+func (interp *Interpreter) alloc(f *frame, n int, size uintptr) bool {
+	if !interp.accounting(f) || n <= 0 || size == 0 {
+		return true
+	}
+	total := int64(math.MaxInt64)
+	if uint64(n) <= math.MaxInt64/uint64(size) {
+		total = int64(n) * int64(size)
+	}
+	if f.stats != nil {
+		atomic.AddInt64(&f.stats.fn.alloc, total)
+	}
+	if m := interp.metrics; m != nil {
+		atomic.AddInt64(&m.run.alloc, total)
+	}
+	if interp.maxMemory == 0 {
+		return true
+	}
+	if total <= interp.maxMemory {
+		for {
+			m := atomic.LoadInt64(&interp.memory)
+			if m > interp.maxMemory-total {
+				break
+			}
+			if atomic.CompareAndSwapInt64(&interp.memory, m, m+total) {
+				return true
+			}
+		}
+	}
+	if atomic.SwapInt64(&interp.memory, math.MaxInt64) != math.MaxInt64 {
+		interp.stop()
+	}
+	return false
+}
+
+// memoryExceeded returns true if the memory budget of the current
+// evaluation has been exceeded.
+func (interp *Interpreter) memoryExceeded() bool {
+	return interp.maxMemory > 0 && atomic.LoadInt64(&interp.memory) > interp.maxMemory
+}
+
+// allocAppend accounts the memory allocated in frame f by appending n
+// elements to slice s, if its capacity must grow.
+func (interp *Interpreter) allocAppend(f *frame, s reflect.Value, n int) bool {
+	l := s.Len() + n
+	if l <= s.Cap() {
+		return true
+	}
+	// Estimate the new capacity, as computed by the runtime
+	c := 2 * s.Cap()
+	if c < l {
+		c = l
+	}
+	return interp.alloc(f, c, s.Type().Elem().Size())
+}
+
+// allocFrame accounts the frame allocated in frame f for a call of function
+// def, with its values.
+func (interp *Interpreter) allocFrame(f *frame, def *node) bool {
+	if !interp.accounting(f) {
+		return true
+	}
+	size := frameSize + uintptr(len(def.types))*valueSize
+	for _, t := range def.types {
+		size += t.Size()
+	}
+	return interp.alloc(f, 1, size)
+}
+
+// allocClosure accounts the function value created in frame f by a function
+// literal or a method value, with the n values of f copied for a closure
+// capturing the variables of a loop iteration.
+func (interp *Interpreter) allocClosure(f *frame, n int) bool {
+	return interp.alloc(f, 1, nodeSize+frameSize+uintptr(n)*valueSize)
+}
+
+// allocConvert accounts the memory allocated in frame f by the conversion of
+// v to type t, from a string to a slice of bytes or runes, or the reverse.
+func (interp *Interpreter) allocConvert(f *frame, v reflect.Value, t reflect.Type) bool {
+	if !interp.accounting(f) {
+		return true
+	}
+	switch vk, tk := v.Kind(), t.Kind(); {
+	case vk == reflect.String && tk == reflect.Slice:
+		if t.Elem().Kind() == reflect.Int32 {
+			return interp.alloc(f, utf8.RuneCountInString(v.String()), t.Elem().Size())
+		}
+		return interp.alloc(f, v.Len(), 1)
+	case vk == reflect.Slice && tk == reflect.String:
+		if v.Type().Elem().Kind() == reflect.Int32 {
+			n := 0
+			for i := 0; i < v.Len(); i++ {
+				if l := utf8.RuneLen(rune(v.Index(i).Int())); l > 0 {
+					n += l
+				} else {
+					n += len(string(utf8.RuneError))
+				}
+			}
+			return interp.alloc(f, n, 1)
+		}
+		return interp.alloc(f, v.Len(), 1)
+	}
+	return true
+}
+
+// step accounts the execution of a node by interpreted code. If the step
+// budget is exceeded, the current evaluation is stopped and false is returned.
+func (interp *Interpreter) step() bool {
+	if m := interp.metrics; m != nil {
+		atomic.AddInt64(&m.run.steps, 1)
+		if interp.maxSteps == 0 {
+			return true
+		}
+	}
+	r := atomic.AddInt64(&interp.steps, -1)
+	if r >= 0 {
+		return true
+	}
+	if r == -1 {
+		interp.stop()
+	}
+	return false
+}
+
+// stepExceeded returns true if the step budget of the current evaluation
+// has been exceeded.
+func (interp *Interpreter) stepExceeded() bool {
+	return interp.maxSteps > 0 && atomic.LoadInt64(&interp.steps) < 0
+}
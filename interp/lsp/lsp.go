@@ -0,0 +1,235 @@
+// Package lsp implements a Language Server Protocol server for interpreted
+// scripts.
+//
+// The Language Server Protocol is the JSON-RPC based protocol used by editors
+// to provide language features. It is specified at
+// https://microsoft.github.io/language-server-protocol/specification.
+//
+// A Server analyzes the open documents with the interpreter, without running
+// them, and provides their diagnostics, the hover information and the
+// definition of their identifiers, and the completion of identifiers and
+// selectors. As the symbols of the binary packages loaded in the interpreter
+// by Use are known, host packages are supported as well as source packages.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"sync"
+)
+
+// message is a JSON-RPC request, response or notification.
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+}
+
+// responseError is the error of a response.
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error codes of responses.
+const (
+	methodNotFound = -32601
+	invalidParams  = -32602
+	internalError  = -32603
+)
+
+// conn reads and writes protocol messages, which are made of a header with
+// a Content-Length field, followed by a JSON content.
+type conn struct {
+	r *textproto.Reader
+
+	mutex sync.Mutex // protects w
+	w     io.Writer
+}
+
+func newConn(rw io.ReadWriter) *conn {
+	return &conn{r: textproto.NewReader(bufio.NewReader(rw)), w: rw}
+}
+
+// read decodes the next message in v.
+func (c *conn) read(v interface{}) error {
+	h, err := c.r.ReadMIMEHeader()
+	if err != nil {
+		return err
+	}
+	length, err := strconv.Atoi(h.Get("Content-Length"))
+	if err != nil || length < 0 {
+		return errors.New("invalid Content-Length header")
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(c.r.R, b); err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// respond sends the response to the request of identifier id. A non nil
+// err makes it an error response.
+func (c *conn) respond(id json.RawMessage, result interface{}, err error) error {
+	m := &message{JSONRPC: "2.0", ID: id, Result: result}
+	if err != nil {
+		code := internalError
+		if e, ok := err.(*responseError); ok {
+			code = e.Code
+		}
+		m.Result, m.Error = nil, &responseError{Code: code, Message: err.Error()}
+	} else if result == nil {
+		// A successful response has a result, even if null
+		m.Result = json.RawMessage("null")
+	}
+	return c.write(m)
+}
+
+// notify sends a notification.
+func (c *conn) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(&message{JSONRPC: "2.0", Method: method, Params: b})
+}
+
+// write sends a message.
+func (c *conn) write(m interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, err = fmt.Fprintf(c.w, "Content-Length: %d\r\n\r\n", len(b)); err != nil {
+		return err
+	}
+	_, err = c.w.Write(b)
+	return err
+}
+
+func (e *responseError) Error() string { return e.Message }
+
+// Parameters and results of the protocol, limited to the fields in use.
+
+type initializeResult struct {
+	Capabilities serverCapabilities `json:"capabilities"`
+	ServerInfo   serverInfo         `json:"serverInfo"`
+}
+
+type serverCapabilities struct {
+	TextDocumentSync   int                `json:"textDocumentSync"`
+	HoverProvider      bool               `json:"hoverProvider"`
+	DefinitionProvider bool               `json:"definitionProvider"`
+	CompletionProvider *completionOptions `json:"completionProvider,omitempty"`
+}
+
+type completionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters"`
+}
+
+type serverInfo struct {
+	Name string `json:"name"`
+}
+
+// syncFull is the synchronization of documents by sending their full content.
+const syncFull = 1
+
+type position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type textRange struct {
+	Start position `json:"start"`
+	End   position `json:"end"`
+}
+
+type location struct {
+	URI   string    `json:"uri"`
+	Range textRange `json:"range"`
+}
+
+type textDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+type textDocumentItem struct {
+	URI  string `json:"uri"`
+	Text string `json:"text"`
+}
+
+type didOpenParams struct {
+	TextDocument textDocumentItem `json:"textDocument"`
+}
+
+type didChangeParams struct {
+	TextDocument   textDocumentIdentifier `json:"textDocument"`
+	ContentChanges []struct {
+		Text string `json:"text"`
+	} `json:"contentChanges"`
+}
+
+type didCloseParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+}
+
+type textDocumentPositionParams struct {
+	TextDocument textDocumentIdentifier `json:"textDocument"`
+	Position     position               `json:"position"`
+}
+
+// Severity of diagnostics.
+const severityError = 1
+
+type diagnostic struct {
+	Range    textRange `json:"range"`
+	Severity int       `json:"severity"`
+	Source   string    `json:"source"`
+	Message  string    `json:"message"`
+}
+
+type publishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Diagnostics []diagnostic `json:"diagnostics"`
+}
+
+type markupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type hover struct {
+	Contents markupContent `json:"contents"`
+	Range    textRange     `json:"range"`
+}
+
+// Kinds of completion items.
+const (
+	completionFunction = 3
+	completionVariable = 6
+	completionClass    = 7
+	completionModule   = 9
+	completionKeyword  = 14
+	completionConstant = 21
+)
+
+type completionItem struct {
+	Label  string `json:"label"`
+	Kind   int    `json:"kind,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type completionList struct {
+	IsIncomplete bool             `json:"isIncomplete"`
+	Items        []completionItem `json:"items"`
+}
@@ -0,0 +1,367 @@
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/containous/yaegi/interp"
+)
+
+// A Server handles a language server session on a connection.
+type Server struct {
+	conn      *conn
+	newInterp func() *interp.Interpreter
+	docs      map[string]*document // open documents, indexed by URI
+}
+
+// A document is an open document, with the result of its last analysis.
+type document struct {
+	uri    string
+	path   string
+	text   string
+	interp *interp.Interpreter // interpreter of the last successful analysis
+	idents []*interp.Ident     // identifiers of the last successful analysis
+	lines  []string            // text of the last successful analysis, split in lines
+}
+
+// NewServer returns a language server for a session on rw. Function newInterp
+// returns the interpreter used to analyze a document, after each change.
+func NewServer(rw io.ReadWriter, newInterp func() *interp.Interpreter) *Server {
+	return &Server{conn: newConn(rw), newInterp: newInterp, docs: map[string]*document{}}
+}
+
+// Serve handles client messages until an exit notification or the end of the
+// connection.
+func (s *Server) Serve() error {
+	for {
+		m := &message{}
+		err := s.conn.read(m)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if m.Method == "exit" {
+			return nil
+		}
+		result, err := s.handle(m)
+		if m.ID == nil {
+			// Notifications have no response
+			continue
+		}
+		if err := s.conn.respond(m.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+// handle processes a request or a notification, and returns the result of a
+// request.
+func (s *Server) handle(m *message) (interface{}, error) {
+	switch m.Method {
+	case "initialize":
+		return &initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   syncFull,
+				HoverProvider:      true,
+				DefinitionProvider: true,
+				CompletionProvider: &completionOptions{TriggerCharacters: []string{"."}},
+			},
+			ServerInfo: serverInfo{Name: "yaegi"},
+		}, nil
+
+	case "shutdown":
+		return nil, nil
+
+	case "textDocument/didOpen":
+		p := &didOpenParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			return nil, err
+		}
+		d := &document{uri: p.TextDocument.URI, path: uriPath(p.TextDocument.URI), text: p.TextDocument.Text}
+		s.docs[d.uri] = d
+		return nil, s.analyze(d)
+
+	case "textDocument/didChange":
+		p := &didChangeParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			return nil, err
+		}
+		d, ok := s.docs[p.TextDocument.URI]
+		if !ok || len(p.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// With full synchronization, the last change is the whole content
+		d.text = p.ContentChanges[len(p.ContentChanges)-1].Text
+		return nil, s.analyze(d)
+
+	case "textDocument/didClose":
+		p := &didCloseParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			return nil, err
+		}
+		delete(s.docs, p.TextDocument.URI)
+		return nil, s.conn.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{URI: p.TextDocument.URI, Diagnostics: []diagnostic{}})
+
+	case "textDocument/hover":
+		d, pos, err := s.position(m.Params)
+		if d == nil {
+			return nil, err
+		}
+		return d.hover(pos), nil
+
+	case "textDocument/definition":
+		d, pos, err := s.position(m.Params)
+		if d == nil {
+			return nil, err
+		}
+		return d.definition(pos), nil
+
+	case "textDocument/completion":
+		d, pos, err := s.position(m.Params)
+		if d == nil {
+			return nil, err
+		}
+		return d.completion(pos), nil
+	}
+
+	if m.ID != nil {
+		return nil, &responseError{Code: methodNotFound, Message: "method not found: " + m.Method}
+	}
+	return nil, nil // notifications, such as initialized, are ignored
+}
+
+// position returns the open document and the position of the request params,
+// or a nil document if unknown.
+func (s *Server) position(params json.RawMessage) (*document, position, error) {
+	p := &textDocumentPositionParams{}
+	if err := json.Unmarshal(params, p); err != nil {
+		return nil, position{}, &responseError{Code: invalidParams, Message: err.Error()}
+	}
+	d := s.docs[p.TextDocument.URI]
+	if d == nil || d.interp == nil {
+		return nil, p.Position, nil
+	}
+	return d, p.Position, nil
+}
+
+// analyze inspects the text of document d with a new interpreter, and
+// publishes its diagnostics.
+func (s *Server) analyze(d *document) error {
+	i := s.newInterp()
+	i.Name = d.path
+	idents, errs := inspect(i, d.text)
+	if idents != nil {
+		// The program is parsed, its identifiers are valid
+		d.interp, d.idents, d.lines = i, idents, strings.Split(d.text, "\n")
+	}
+
+	lines := strings.Split(d.text, "\n")
+	diags := []diagnostic{}
+	for _, err := range errs {
+		diag := diagnostic{Severity: severityError, Source: "yaegi", Message: err.Error()}
+		if e, ok := err.(*interp.Error); ok && e.Pos.IsValid() && (e.Pos.Filename == "" || e.Pos.Filename == d.path) {
+			diag.Message = e.Msg
+			diag.Range.Start = toPosition(lines, e.Pos.Line, e.Pos.Column)
+			diag.Range.End = diag.Range.Start
+		}
+		diags = append(diags, diag)
+	}
+	return s.conn.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{URI: d.uri, Diagnostics: diags})
+}
+
+// inspect returns the identifiers and the errors of src, as Inspect. A panic
+// of the interpreter is returned as an error.
+func inspect(i *interp.Interpreter, src string) (idents []*interp.Ident, errs []error) {
+	defer func() {
+		if r := recover(); r != nil {
+			idents, errs = nil, []error{fmt.Errorf("internal error: %v", r)}
+		}
+	}()
+	return i.Inspect(src)
+}
+
+// ident returns the identifier at position pos, or nil.
+func (d *document) ident(pos position) *interp.Ident {
+	line, col := fromPosition(d.lines, pos)
+	for _, id := range d.idents {
+		if id.Pos.Line == line && id.Pos.Column <= col && col <= id.Pos.Column+len(id.Name) && (id.Pos.Filename == "" || id.Pos.Filename == d.path) {
+			return id
+		}
+	}
+	return nil
+}
+
+func (d *document) identRange(id *interp.Ident) textRange {
+	start := toPosition(d.lines, id.Pos.Line, id.Pos.Column)
+	return textRange{Start: start, End: toPosition(d.lines, id.Pos.Line, id.Pos.Column+len(id.Name))}
+}
+
+// hover returns the description of the identifier at position pos, as a
+// Go declaration, or nil.
+func (d *document) hover(pos position) *hover {
+	id := d.ident(pos)
+	if id == nil || id.Kind == "" && id.Type == "" {
+		return nil
+	}
+	name := id.Name
+	if id.Path != "" && id.Kind != "package" {
+		name = path.Base(id.Path) + "." + name
+	}
+	var s string
+	switch id.Kind {
+	case "package":
+		s = fmt.Sprintf("package %s (%q)", id.Name, id.Path)
+	case "func":
+		s = "func " + name + strings.TrimPrefix(id.Type, "func")
+	case "type":
+		s = "type " + name
+		if id.Type != "" && id.Type != name && id.Type != id.Name {
+			s += " " + id.Type
+		}
+	case "":
+		s = name + " " + id.Type
+	default:
+		s = id.Kind + " " + name + " " + id.Type
+	}
+	value := "```go\n" + strings.TrimSpace(s) + "\n```"
+	if id.Doc != "" {
+		value += "\n\n" + id.Doc
+	}
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: value},
+		Range:    d.identRange(id),
+	}
+}
+
+// definition returns the location of the declaration of the identifier at
+// position pos, or nil.
+func (d *document) definition(pos position) *location {
+	id := d.ident(pos)
+	if id == nil || !id.Decl.IsValid() {
+		return nil
+	}
+	loc := &location{URI: d.uri}
+	lines := d.lines
+	if id.Decl.Filename != "" && id.Decl.Filename != d.path {
+		// Declared in another file, with a position in bytes
+		loc.URI, lines = "file://"+id.Decl.Filename, nil
+	}
+	loc.Range.Start = toPosition(lines, id.Decl.Line, id.Decl.Column)
+	loc.Range.End = toPosition(lines, id.Decl.Line, id.Decl.Column+len(id.Name))
+	return loc
+}
+
+// completion returns the completion items of the identifier or selector
+// ending at position pos.
+func (d *document) completion(pos position) *completionList {
+	line, col := fromPosition(d.lines, pos)
+	prefix := ""
+	if line > 0 && line <= len(d.lines) {
+		prefix = d.lines[line-1]
+		if col-1 < len(prefix) {
+			prefix = prefix[:col-1]
+		}
+	}
+	start, names := d.interp.Complete(prefix)
+	word := prefix[start:]
+	selector := start > 0 && prefix[start-1] == '.'
+
+	// The kinds of the names are taken from the identifiers of the document
+	kinds := map[string]*interp.Ident{}
+	for _, id := range d.idents {
+		if _, ok := kinds[id.Name]; !ok && id.Kind != "" {
+			kinds[id.Name] = id
+		}
+		if !selector && id.Decl == id.Pos && strings.HasPrefix(id.Name, word) {
+			// Local declarations are not visible to the interpreter scope
+			names = append(names, id.Name)
+		}
+	}
+	sort.Strings(names)
+
+	res := &completionList{Items: []completionItem{}}
+	for i, name := range names {
+		if i > 0 && name == names[i-1] {
+			continue
+		}
+		item := completionItem{Label: name}
+		if token.Lookup(name).IsKeyword() {
+			item.Kind = completionKeyword
+		} else if id := kinds[name]; id != nil && !selector {
+			item.Kind, item.Detail = completionKind(id.Kind), id.Type
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res
+}
+
+// completionKind returns the kind of completion item of an identifier kind.
+func completionKind(kind string) int {
+	switch kind {
+	case "const":
+		return completionConstant
+	case "func":
+		return completionFunction
+	case "package":
+		return completionModule
+	case "type":
+		return completionClass
+	case "var":
+		return completionVariable
+	}
+	return 0
+}
+
+// uriPath returns the file path of a file URI, or the URI itself.
+func uriPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return u.Path
+}
+
+// toPosition returns the protocol position of the 1-based line and byte
+// column in lines. The protocol counts characters in UTF-16 code units. If
+// lines is nil, the column is kept in bytes.
+func toPosition(lines []string, line, col int) position {
+	p := position{Line: line - 1, Character: col - 1}
+	if line < 1 || line > len(lines) {
+		return p
+	}
+	s := lines[line-1]
+	if col-1 < len(s) {
+		s = s[:col-1]
+	}
+	p.Character = 0
+	for _, r := range s {
+		p.Character += len(utf16.Encode([]rune{r}))
+	}
+	return p
+}
+
+// fromPosition returns the 1-based line and byte column of protocol position
+// p in lines.
+func fromPosition(lines []string, p position) (line, col int) {
+	line, col = p.Line+1, 1
+	if p.Line < 0 || p.Line >= len(lines) {
+		return line, col
+	}
+	s := lines[p.Line]
+	for n := 0; n < p.Character && col-1 < len(s); {
+		r, size := utf8.DecodeRuneInString(s[col-1:])
+		n += len(utf16.Encode([]rune{r}))
+		col += size
+	}
+	return line, col
+}
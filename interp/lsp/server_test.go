@@ -0,0 +1,175 @@
+package lsp
+
+import (
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+const (
+	testURI     = "file:///tmp/test.go"
+	testProgram = `package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	s := strings.ToUpper("hello")
+	fmt.Println(s, undef)
+}
+`
+)
+
+type client struct {
+	t    *testing.T
+	conn *conn
+	id   int
+	msgs chan *message
+}
+
+func newClient(t *testing.T) *client {
+	c1, c2 := net.Pipe()
+	s := NewServer(c1, func() *interp.Interpreter {
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		i.RegisterPackageDoc("strings", "", map[string]string{"ToUpper": "ToUpper returns s with all letters mapped to upper case."})
+		return i
+	})
+	go func() { _ = s.Serve() }()
+
+	c := &client{t: t, conn: newConn(c2), msgs: make(chan *message, 100)}
+	go func() {
+		for {
+			m := &message{}
+			if err := c.conn.read(m); err != nil {
+				close(c.msgs)
+				return
+			}
+			c.msgs <- m
+		}
+	}()
+	return c
+}
+
+// request sends a request and decodes its result in result.
+func (c *client) request(method string, params interface{}, result interface{}) {
+	c.t.Helper()
+	c.id++
+	id := json.RawMessage(strconv.Itoa(c.id))
+	if err := c.conn.write(map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}); err != nil {
+		c.t.Fatal(err)
+	}
+	m := c.wait(func(m *message) bool { return string(m.ID) == string(id) })
+	if m.Error != nil {
+		c.t.Fatalf("%s: %s", method, m.Error.Message)
+	}
+	b, _ := json.Marshal(m.Result)
+	if err := json.Unmarshal(b, result); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// notification sends a notification.
+func (c *client) notification(method string, params interface{}) {
+	c.t.Helper()
+	if err := c.conn.write(map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// wait returns the first received message matching f.
+func (c *client) wait(f func(*message) bool) *message {
+	c.t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case m, ok := <-c.msgs:
+			if !ok {
+				c.t.Fatal("connection closed")
+			}
+			if f(m) {
+				return m
+			}
+		case <-timeout:
+			c.t.Fatal("timeout")
+		}
+	}
+}
+
+func at(line, character int) map[string]interface{} {
+	return map[string]interface{}{
+		"textDocument": map[string]string{"uri": testURI},
+		"position":     position{Line: line, Character: character},
+	}
+}
+
+func TestServer(t *testing.T) {
+	c := newClient(t)
+
+	var init initializeResult
+	c.request("initialize", map[string]interface{}{}, &init)
+	if !init.Capabilities.HoverProvider || init.Capabilities.TextDocumentSync != syncFull {
+		t.Fatalf("unexpected capabilities: %+v", init.Capabilities)
+	}
+	c.notification("initialized", map[string]interface{}{})
+
+	c.notification("textDocument/didOpen", map[string]interface{}{"textDocument": textDocumentItem{URI: testURI, Text: testProgram}})
+	m := c.wait(func(m *message) bool { return m.Method == "textDocument/publishDiagnostics" })
+	var diags publishDiagnosticsParams
+	if err := json.Unmarshal(m.Params, &diags); err != nil {
+		t.Fatal(err)
+	}
+	if len(diags.Diagnostics) != 1 || diags.Diagnostics[0].Message != "undefined: undef" || diags.Diagnostics[0].Range.Start != (position{Line: 9, Character: 16}) {
+		t.Fatalf("unexpected diagnostics: %+v", diags.Diagnostics)
+	}
+
+	t.Run("hover", func(t *testing.T) {
+		var h hover
+		c.request("textDocument/hover", at(8, 16), &h)
+		if want := "```go\nfunc strings.ToUpper(string) string\n```\n\nToUpper returns s with all letters mapped to upper case."; h.Contents.Value != want {
+			t.Fatalf("got %q, want %q", h.Contents.Value, want)
+		}
+		c.request("textDocument/hover", at(9, 13), &h)
+		if want := "```go\nvar s string\n```"; h.Contents.Value != want {
+			t.Fatalf("got %q, want %q", h.Contents.Value, want)
+		}
+	})
+
+	t.Run("definition", func(t *testing.T) {
+		var loc location
+		c.request("textDocument/definition", at(9, 13), &loc)
+		if want := (location{URI: testURI, Range: textRange{Start: position{8, 1}, End: position{8, 2}}}); loc != want {
+			t.Fatalf("got %+v, want %+v", loc, want)
+		}
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		const text = "package main\n\nimport \"strings\"\n\nfunc main() {\n\tstrings.ToU\n}\n"
+		c.notification("textDocument/didChange", map[string]interface{}{
+			"textDocument":   textDocumentIdentifier{URI: testURI},
+			"contentChanges": []map[string]string{{"text": text}},
+		})
+		c.wait(func(m *message) bool { return m.Method == "textDocument/publishDiagnostics" })
+		var l completionList
+		c.request("textDocument/completion", at(5, 12), &l)
+		var labels []string
+		for _, item := range l.Items {
+			labels = append(labels, item.Label)
+		}
+		if got := strings.Join(labels, " "); got != "ToUpper ToUpperSpecial" {
+			t.Fatalf("got %q", got)
+		}
+	})
+
+	var res interface{}
+	c.request("shutdown", nil, &res)
+	c.notification("exit", nil)
+}
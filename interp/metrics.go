@@ -0,0 +1,116 @@
+package interp
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics are the resources consumed by interpreted code, so that a host
+// running the scripts of tenants can bill or limit them.
+type Metrics struct {
+	Steps      int64         // number of steps, that is node executions, as limited by MaxSteps
+	Goroutines int64         // number of goroutines started by go statements
+	Alloc      int64         // estimated number of bytes allocated, as limited by MaxMemory
+	Time       time.Duration // elapsed time of the evaluations
+}
+
+// metrics collects the metrics of the evaluations of an interpreter.
+type metrics struct {
+	mutex sync.Mutex // protects total, serializes its updates and its reads
+	run   counters   // current evaluation, updated atomically
+	total counters   // previous evaluations
+	begin time.Time  // start of the current evaluation
+}
+
+// counters are the counted metrics.
+type counters struct {
+	steps, goroutines, alloc, nanos int64
+}
+
+func (c *counters) metrics() Metrics {
+	return Metrics{
+		Steps:      atomic.LoadInt64(&c.steps),
+		Goroutines: atomic.LoadInt64(&c.goroutines),
+		Alloc:      atomic.LoadInt64(&c.alloc),
+		Time:       time.Duration(atomic.LoadInt64(&c.nanos)),
+	}
+}
+
+// start moves the counters of the previous evaluation to the total, and
+// starts an evaluation.
+func (m *metrics) start() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.total.steps += atomic.SwapInt64(&m.run.steps, 0)
+	m.total.goroutines += atomic.SwapInt64(&m.run.goroutines, 0)
+	m.total.alloc += atomic.SwapInt64(&m.run.alloc, 0)
+	m.total.nanos += atomic.SwapInt64(&m.run.nanos, 0)
+	m.begin = time.Now()
+}
+
+// end records the time of the current evaluation.
+func (m *metrics) end() {
+	atomic.StoreInt64(&m.run.nanos, int64(time.Since(m.begin)))
+}
+
+// Metrics returns the metrics of the last evaluation, if enabled by the
+// CollectMetrics option, or zero metrics otherwise. The goroutines started
+// by an evaluation and still running are accounted in it until the next
+// evaluation starts, then in the next one.
+func (interp *Interpreter) Metrics() Metrics {
+	if interp.metrics == nil {
+		return Metrics{}
+	}
+	return interp.metrics.run.metrics()
+}
+
+// TotalMetrics returns the metrics of all the evaluations since the
+// creation of the interpreter, including the running one, if enabled by
+// the CollectMetrics option, or zero metrics otherwise. The total metrics
+// never decrease, and may be read at any time.
+func (interp *Interpreter) TotalMetrics() Metrics {
+	m := interp.metrics
+	if m == nil {
+		return Metrics{}
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	run := m.run.metrics()
+	return Metrics{
+		Steps:      m.total.steps + run.Steps,
+		Goroutines: m.total.goroutines + run.Goroutines,
+		Alloc:      m.total.alloc + run.Alloc,
+		Time:       time.Duration(m.total.nanos) + run.Time,
+	}
+}
+
+// WriteMetrics writes to w the total metrics of the interpreter as counters,
+// in the text exposition format of Prometheus, so they may be served to a
+// Prometheus server by a HTTP handler. With the Prometheus client library,
+// the metrics are rather collected by counter functions registered once, as:
+//
+//	prometheus.NewCounterFunc(prometheus.CounterOpts{Name: "yaegi_steps_total"},
+//		func() float64 { return float64(i.TotalMetrics().Steps) })
+func (interp *Interpreter) WriteMetrics(w io.Writer) error {
+	if interp.metrics == nil {
+		return fmt.Errorf("metrics are not enabled")
+	}
+	m := interp.TotalMetrics()
+	for _, c := range []struct {
+		name, help string
+		value      interface{}
+	}{
+		{"yaegi_steps_total", "Steps executed by interpreted code.", m.Steps},
+		{"yaegi_goroutines_total", "Goroutines started by interpreted code.", m.Goroutines},
+		{"yaegi_alloc_bytes_total", "Estimated bytes allocated by interpreted code.", m.Alloc},
+		{"yaegi_eval_seconds_total", "Elapsed time of evaluations.", m.Time.Seconds()},
+	} {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n%s %v\n", c.name, c.help, c.name, c.name, c.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
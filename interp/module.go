@@ -0,0 +1,396 @@
+package interp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// modFile stores the content of a go.mod file, and the state of the module
+// build list.
+type modFile struct {
+	dir     string                // module root directory
+	path    string                // module path
+	goVer   string                // Go language version of the go directive, or empty
+	require map[string]string     // required module versions, indexed by module path
+	replace map[string]modVersion // replacements, indexed by module path or path@version
+	vendor  bool                  // dependencies are loaded from the vendor directory
+	loaded  map[string]bool       // dependency modules whose requirements are merged
+}
+
+// modVersion is a module path and version, or a directory if version is empty.
+type modVersion struct {
+	path, version string
+}
+
+// parseModFile parses the content of the go.mod file of directory dir.
+// Only the module, go, require and replace directives are considered.
+func parseModFile(dir string, data []byte) (*modFile, error) {
+	m := &modFile{dir: dir, require: map[string]string{}, replace: map[string]modVersion{}, loaded: map[string]bool{}}
+	name := filepath.Join(dir, "go.mod")
+	var block string // directive of the current block, if any
+
+	for i, line := range strings.Split(string(data), "\n") {
+		if j := strings.Index(line, "//"); j >= 0 {
+			line = line[:j]
+		}
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		switch {
+		case block != "" && f[0] == ")":
+			block = ""
+			continue
+		case block == "" && len(f) == 2 && f[1] == "(":
+			block = f[0]
+			continue
+		case block != "":
+			f = append([]string{block}, f...)
+		}
+		for k, s := range f {
+			if s[0] == '"' || s[0] == '`' {
+				u, err := strconv.Unquote(s)
+				if err != nil {
+					return nil, fmt.Errorf("%s:%d: invalid quoted string %s", name, i+1, s)
+				}
+				f[k] = u
+			}
+		}
+
+		switch f[0] {
+		case "module":
+			if len(f) != 2 {
+				return nil, fmt.Errorf("%s:%d: usage: module module/path", name, i+1)
+			}
+			m.path = f[1]
+		case "go":
+			if len(f) != 2 {
+				return nil, fmt.Errorf("%s:%d: usage: go 1.23", name, i+1)
+			}
+			m.goVer = f[1]
+		case "require":
+			if len(f) != 3 {
+				return nil, fmt.Errorf("%s:%d: usage: require module/path v1.2.3", name, i+1)
+			}
+			m.require[f[1]] = f[2]
+		case "replace":
+			// replace module/path [v1.2.3] => other/module [v1.4.5], or => ./dir
+			var from, to []string
+			for k, s := range f {
+				if s == "=>" {
+					from, to = f[1:k], f[k+1:]
+					break
+				}
+			}
+			if len(from) < 1 || len(from) > 2 || len(to) < 1 || len(to) > 2 {
+				return nil, fmt.Errorf("%s:%d: usage: replace module/path [v1.2.3] => other/module v1.4.5 | ../local/directory", name, i+1)
+			}
+			key := from[0]
+			if len(from) == 2 {
+				key += "@" + from[1]
+			}
+			r := modVersion{path: to[0]}
+			if len(to) == 2 {
+				r.version = to[1]
+			}
+			m.replace[key] = r
+		}
+	}
+	if m.path == "" {
+		return nil, fmt.Errorf("%s: no module directive", name)
+	}
+	return m, nil
+}
+
+// mainModule returns the module containing the directory of the program,
+// or nil if there is none.
+func (interp *Interpreter) mainModule() (*modFile, error) {
+	dir := interp.dir()
+	if _, ok := interp.filesystem.(realFS); ok {
+		if d, err := filepath.Abs(dir); err == nil {
+			dir = d
+		}
+	}
+	for {
+		if m, ok := interp.modules[dir]; ok {
+			return m, nil
+		}
+		data, err := fs.ReadFile(interp.filesystem, filepath.Join(dir, "go.mod"))
+		if err == nil {
+			m, err := parseModFile(dir, data)
+			if err != nil {
+				return nil, err
+			}
+			_, err = fs.Stat(interp.filesystem, filepath.Join(dir, "vendor", "modules.txt"))
+			m.vendor = err == nil
+			interp.modules[dir] = m
+			return m, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// langVersion returns the minor version of the Go language of the program:
+// the one of the GoVersion option, or else, as with the go command, of the go
+// directive of the main module for a program loaded from a package directory
+// or a file, named by Name, or else of the host.
+func (interp *Interpreter) langVersion() int {
+	minor := interp.goMinor
+	if minor == 0 {
+		minor = goMinorVersion(interp.context)
+		if interp.progDir == "" && interp.Name == "" {
+			return minor
+		}
+		if m, err := interp.mainModule(); err == nil && m != nil && m.goVer != "" {
+			minor, _ = parseGoVersion(m.goVer)
+		}
+	}
+	return minor
+}
+
+// perIterationLoopVars returns true if the variables declared by for
+// statements are distinct at each iteration, as from Go 1.22.
+func (interp *Interpreter) perIterationLoopVars() bool {
+	return interp.langVersion() >= 22
+}
+
+// moduleDir returns the directory of the package of import path ipath,
+// resolved from the build list of the main module, or an empty string if
+// there is no main module or ipath is not provided by a required module.
+func (interp *Interpreter) moduleDir(ipath string) (string, error) {
+	m, err := interp.mainModule()
+	if err != nil || m == nil {
+		return "", err
+	}
+	if sub, ok := pathIn(ipath, m.path); ok {
+		return filepath.Join(m.dir, filepath.FromSlash(sub)), nil
+	}
+
+	// Find the required module with the longest matching path
+	var mpath, sub string
+	for p := range m.require {
+		if s, ok := pathIn(ipath, p); ok && len(p) > len(mpath) {
+			mpath, sub = p, s
+		}
+	}
+	if mpath == "" {
+		return "", nil
+	}
+	if m.vendor {
+		return filepath.Join(m.dir, "vendor", filepath.FromSlash(ipath)), nil
+	}
+
+	mv := modVersion{path: mpath, version: m.require[mpath]}
+	if r, ok := m.replace[mpath+"@"+mv.version]; ok {
+		mv = r
+	} else if r, ok := m.replace[mpath]; ok {
+		mv = r
+	}
+
+	var dir string
+	if mv.version == "" {
+		// Replacement by a local directory
+		dir = filepath.FromSlash(mv.path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(m.dir, dir)
+		}
+	} else {
+		mp, err := escapeModPath(mv.path)
+		if err != nil {
+			return "", err
+		}
+		mver, err := escapeModPath(mv.version)
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(interp.modCache, filepath.FromSlash(mp)+"@"+mver)
+		if _, err := fs.Stat(interp.filesystem, dir); err != nil {
+			if !interp.download {
+				return "", fmt.Errorf("module %s@%s not found in module cache %s", mv.path, mv.version, interp.modCache)
+			}
+			if dir, err = interp.downloadModule(mv); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	if !m.loaded[dir] {
+		m.loaded[dir] = true
+		if err := m.merge(interp.filesystem, dir); err != nil {
+			return "", err
+		}
+	}
+	return filepath.Join(dir, filepath.FromSlash(sub)), nil
+}
+
+// downloadModule downloads module mv in the module cache, and returns its
+// directory.
+func (interp *Interpreter) downloadModule(mv modVersion) (string, error) {
+	modCache, err := filepath.Abs(interp.modCache)
+	if err != nil {
+		return "", err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("go", "mod", "download", "-json", mv.path+"@"+mv.version)
+	// Run outside of any module, so no go.mod or go.sum file is modified
+	cmd.Dir = os.TempDir()
+	cmd.Env = append(os.Environ(), "GOMODCACHE="+modCache)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	err = cmd.Run()
+
+	// On failure, the error is reported in the JSON output if any
+	var res struct{ Dir, Error string }
+	if jerr := json.Unmarshal(stdout.Bytes(), &res); jerr == nil && res.Error != "" {
+		return "", fmt.Errorf("download module %s@%s: %s", mv.path, mv.version, res.Error)
+	}
+	if err != nil {
+		return "", fmt.Errorf("download module %s@%s: %v: %s", mv.path, mv.version, err, strings.TrimSpace(stderr.String()))
+	}
+	if res.Dir == "" {
+		return "", fmt.Errorf("download module %s@%s: no directory", mv.path, mv.version)
+	}
+	return res.Dir, nil
+}
+
+// merge adds the requirements of the dependency module in directory dir to
+// the build list of m, keeping the highest required versions as the go
+// command does. Modules without go.mod file have no requirements.
+func (m *modFile) merge(filesystem fs.FS, dir string) error {
+	data, err := fs.ReadFile(filesystem, filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return nil
+	}
+	dep, err := parseModFile(dir, data)
+	if err != nil {
+		return err
+	}
+	for p, v := range dep.require {
+		if w, ok := m.require[p]; !ok || compareVersion(v, w) > 0 {
+			m.require[p] = v
+		}
+	}
+	return nil
+}
+
+// pathIn returns the path of ipath relative to module path mpath, and true
+// if ipath is provided by the module.
+func pathIn(ipath, mpath string) (string, bool) {
+	switch {
+	case ipath == mpath:
+		return "", true
+	case strings.HasPrefix(ipath, mpath+"/"):
+		return ipath[len(mpath)+1:], true
+	}
+	return "", false
+}
+
+// escapeModPath returns a module path or version escaped for the module
+// cache, where upper case letters are replaced by "!" followed by the
+// lower case letter.
+func escapeModPath(s string) (string, error) {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '!' || r >= unicode.MaxASCII:
+			return "", fmt.Errorf("invalid module path or version %q", s)
+		case 'A' <= r && r <= 'Z':
+			sb.WriteByte('!')
+			sb.WriteRune(unicode.ToLower(r))
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String(), nil
+}
+
+// compareVersion returns -1, 0 or 1 whether semantic version v is lower,
+// equal or greater than w. Build metadata, such as "+incompatible", is ignored.
+func compareVersion(v, w string) int {
+	vn, vpre := splitVersion(v)
+	wn, wpre := splitVersion(w)
+	for i := 0; i < 3; i++ {
+		if c := compareNum(vn[i], wn[i]); c != 0 {
+			return c
+		}
+	}
+
+	// A pre-release version has a lower precedence than the release
+	switch {
+	case vpre == wpre:
+		return 0
+	case vpre == "":
+		return 1
+	case wpre == "":
+		return -1
+	}
+	vf, wf := strings.Split(vpre, "."), strings.Split(wpre, ".")
+	for i := 0; i < len(vf) && i < len(wf); i++ {
+		if vf[i] == wf[i] {
+			continue
+		}
+		vnum, wnum := isNum(vf[i]), isNum(wf[i])
+		switch {
+		case vnum && wnum:
+			return compareNum(vf[i], wf[i])
+		case vnum:
+			return -1
+		case wnum:
+			return 1
+		case vf[i] < wf[i]:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return compareNum(strconv.Itoa(len(vf)), strconv.Itoa(len(wf)))
+}
+
+// splitVersion returns the major, minor and patch numbers, and the
+// pre-release part of a semantic version such as "v1.2.3-pre+build".
+func splitVersion(v string) ([3]string, string) {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		v = v[:i]
+	}
+	var pre string
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		v, pre = v[:i], v[i+1:]
+	}
+	n := [3]string{"0", "0", "0"}
+	for i, s := range strings.SplitN(v, ".", 3) {
+		n[i] = s
+	}
+	return n, pre
+}
+
+// compareNum compares decimal numbers without leading zeros.
+func compareNum(x, y string) int {
+	switch {
+	case len(x) < len(y), len(x) == len(y) && x < y:
+		return -1
+	case x == y:
+		return 0
+	}
+	return 1
+}
+
+func isNum(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
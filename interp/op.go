@@ -12,6 +12,108 @@ func add(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.String:
+			switch {
+			case i0 < 0:
+				s0 := c0.rval.String()
+				n.exec = func(f *frame) bltn {
+					s1 := f.data[i1].String()
+					if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+						return nil
+					}
+					f.data[d].SetString(s0 + s1)
+					return next
+				}
+			case i1 < 0:
+				s1 := c1.rval.String()
+				n.exec = func(f *frame) bltn {
+					s0 := f.data[i0].String()
+					if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+						return nil
+					}
+					f.data[d].SetString(s0 + s1)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					s0, s1 := f.data[i0].String(), f.data[i1].String()
+					if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+						return nil
+					}
+					f.data[d].SetString(s0 + s1)
+					return next
+				}
+			}
+			return
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i + f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() + j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() + f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i + f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() + j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() + f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		case reflect.Float32, reflect.Float64:
+			switch {
+			case i0 < 0:
+				i := vFloat(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(i + f.data[i1].Float())
+					return next
+				}
+			case i1 < 0:
+				j := vFloat(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() + j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() + f.data[i1].Float())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.String:
 		switch {
@@ -19,21 +121,33 @@ func add(n *node) {
 			s0 := c0.rval.String()
 			v1 := genValue(c1)
 			n.exec = func(f *frame) bltn {
-				dest(f).SetString(s0 + v1(f).String())
+				s1 := v1(f).String()
+				if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+					return nil
+				}
+				dest(f).SetString(s0 + s1)
 				return next
 			}
 		case c1.rval.IsValid():
 			v0 := genValue(c0)
 			s1 := c1.rval.String()
 			n.exec = func(f *frame) bltn {
-				dest(f).SetString(v0(f).String() + s1)
+				s0 := v0(f).String()
+				if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+					return nil
+				}
+				dest(f).SetString(s0 + s1)
 				return next
 			}
 		default:
 			v0 := genValue(c0)
 			v1 := genValue(c1)
 			n.exec = func(f *frame) bltn {
-				dest(f).SetString(v0(f).String() + v1(f).String())
+				s0, s1 := v0(f).String(), v1(f).String()
+				if !n.interp.alloc(f, len(s0)+len(s1), 1) {
+					return nil
+				}
+				dest(f).SetString(s0 + s1)
 				return next
 			}
 		}
@@ -172,6 +286,54 @@ func and(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i & f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() & j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() & f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i & f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() & j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() & f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -250,6 +412,54 @@ func andNot(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i &^ f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() &^ j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() &^ f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i &^ f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() &^ j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() &^ f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -328,6 +538,75 @@ func mul(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i * f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() * j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() * f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i * f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() * j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() * f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		case reflect.Float32, reflect.Float64:
+			switch {
+			case i0 < 0:
+				i := vFloat(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(i * f.data[i1].Float())
+					return next
+				}
+			case i1 < 0:
+				j := vFloat(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() * j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() * f.data[i1].Float())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -462,6 +741,54 @@ func or(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i | f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() | j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() | f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i | f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() | j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() | f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -540,6 +867,75 @@ func quo(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i / f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() / j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() / f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i / f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() / j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() / f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		case reflect.Float32, reflect.Float64:
+			switch {
+			case i0 < 0:
+				i := vFloat(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(i / f.data[i1].Float())
+					return next
+				}
+			case i1 < 0:
+				j := vFloat(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() / j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() / f.data[i1].Float())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -674,6 +1070,54 @@ func rem(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i % f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() % j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() % f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i % f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() % j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() % f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -757,7 +1201,7 @@ func shl(n *node) {
 		switch {
 		case c0.rval.IsValid():
 			i := vInt(c0.rval)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, j := v1(f)
 				dest(f).SetInt(i << j)
@@ -773,7 +1217,7 @@ func shl(n *node) {
 			}
 		default:
 			v0 := genValueInt(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, i := v0(f)
 				_, j := v1(f)
@@ -785,7 +1229,7 @@ func shl(n *node) {
 		switch {
 		case c0.rval.IsValid():
 			i := vUint(c0.rval)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, j := v1(f)
 				dest(f).SetUint(i << j)
@@ -801,7 +1245,7 @@ func shl(n *node) {
 			}
 		default:
 			v0 := genValueUint(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, i := v0(f)
 				_, j := v1(f)
@@ -835,7 +1279,7 @@ func shr(n *node) {
 		switch {
 		case c0.rval.IsValid():
 			i := vInt(c0.rval)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, j := v1(f)
 				dest(f).SetInt(i >> j)
@@ -851,7 +1295,7 @@ func shr(n *node) {
 			}
 		default:
 			v0 := genValueInt(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, i := v0(f)
 				_, j := v1(f)
@@ -863,7 +1307,7 @@ func shr(n *node) {
 		switch {
 		case c0.rval.IsValid():
 			i := vUint(c0.rval)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, j := v1(f)
 				dest(f).SetUint(i >> j)
@@ -879,7 +1323,7 @@ func shr(n *node) {
 			}
 		default:
 			v0 := genValueUint(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				_, i := v0(f)
 				_, j := v1(f)
@@ -908,6 +1352,75 @@ func sub(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i - f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() - j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() - f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i - f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() - j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() - f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		case reflect.Float32, reflect.Float64:
+			switch {
+			case i0 < 0:
+				i := vFloat(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(i - f.data[i1].Float())
+					return next
+				}
+			case i1 < 0:
+				j := vFloat(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() - j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetFloat(f.data[i0].Float() - f.data[i1].Float())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -1042,6 +1555,54 @@ func xor(n *node) {
 	typ := n.typ.TypeOf()
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) && isFrameOperand(c0, i0, typ) && isFrameOperand(c1, i1, typ) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch {
+			case i0 < 0:
+				i := vInt(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(i ^ f.data[i1].Int())
+					return next
+				}
+			case i1 < 0:
+				j := vInt(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() ^ j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetInt(f.data[i0].Int() ^ f.data[i1].Int())
+					return next
+				}
+			}
+			return
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			switch {
+			case i0 < 0:
+				i := vUint(c0.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(i ^ f.data[i1].Uint())
+					return next
+				}
+			case i1 < 0:
+				j := vUint(c1.rval)
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() ^ j)
+					return next
+				}
+			default:
+				n.exec = func(f *frame) bltn {
+					f.data[d].SetUint(f.data[i0].Uint() ^ f.data[i1].Uint())
+					return next
+				}
+			}
+			return
+		}
+	}
+
 	switch typ.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		switch {
@@ -1128,6 +1689,9 @@ func addAssign(n *node) {
 			v1 := c1.rval.String()
 			n.exec = func(f *frame) bltn {
 				v, s := v0(f)
+				if !n.interp.alloc(f, len(s)+len(v1), 1) {
+					return nil
+				}
 				v.SetString(s + v1)
 				return next
 			}
@@ -1171,7 +1735,11 @@ func addAssign(n *node) {
 			v1 := genValue(c1)
 			n.exec = func(f *frame) bltn {
 				v, s := v0(f)
-				v.SetString(s + v1(f).String())
+				s1 := v1(f).String()
+				if !n.interp.alloc(f, len(s)+len(s1), 1) {
+					return nil
+				}
+				v.SetString(s + s1)
 				return next
 			}
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -1595,7 +2163,7 @@ func shlAssign(n *node) {
 		switch typ.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			v0 := genValueInt(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				v, i := v0(f)
 				_, j := v1(f)
@@ -1604,7 +2172,7 @@ func shlAssign(n *node) {
 			}
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 			v0 := genValueUint(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				v, i := v0(f)
 				_, j := v1(f)
@@ -1643,7 +2211,7 @@ func shrAssign(n *node) {
 		switch typ.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			v0 := genValueInt(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				v, i := v0(f)
 				_, j := v1(f)
@@ -1652,7 +2220,7 @@ func shrAssign(n *node) {
 			}
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 			v0 := genValueUint(c0)
-			v1 := genValueUint(c1)
+			v1 := genValueShift(c1)
 			n.exec = func(f *frame) bltn {
 				v, i := v0(f)
 				_, j := v1(f)
@@ -1804,7 +2372,7 @@ func dec(n *node) {
 			v.SetInt(i - 1)
 			return next
 		}
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		v0 := genValueUint(n.child[0])
 		n.exec = func(f *frame) bltn {
 			v, i := v0(f)
@@ -1840,7 +2408,7 @@ func inc(n *node) {
 			v.SetInt(i + 1)
 			return next
 		}
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
 		v0 := genValueUint(n.child[0])
 		n.exec = func(f *frame) bltn {
 			v, i := v0(f)
@@ -1869,7 +2437,152 @@ func equal(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				switch {
+				case i0 < 0:
+					s0 := vInt(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 == f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 == f.data[i1].Int())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vInt(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() == s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() == s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() == f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() == f.data[i1].Int())
+							return tnext
+						}
+					}
+				}
+				return
+			case reflect.Float32, reflect.Float64:
+				switch {
+				case i0 < 0:
+					s0 := vFloat(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 == f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 == f.data[i1].Float())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vFloat(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() == s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() == s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() == f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() == f.data[i1].Float())
+							return tnext
+						}
+					}
+				}
+				return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
+	case isInterface(c0.typ) || isInterface(c1.typ):
+		v0 := genCompareKey(c0)
+		v1 := genCompareKey(c1)
+		if n.fnext != nil {
+			fnext := getExec(n.fnext)
+			n.exec = func(f *frame) bltn {
+				if v0(f).equal(v1(f)) {
+					dest(f).SetBool(true)
+					return tnext
+				}
+				dest(f).SetBool(false)
+				return fnext
+			}
+		} else {
+			n.exec = func(f *frame) bltn {
+				dest(f).SetBool(v0(f).equal(v1(f)))
+				return tnext
+			}
+		}
 	case isString(t0) || isString(t1):
 		switch {
 		case c0.rval.IsValid():
@@ -2298,6 +3011,132 @@ func greater(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				switch {
+				case i0 < 0:
+					s0 := vInt(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 > f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 > f.data[i1].Int())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vInt(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() > s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() > s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() > f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() > f.data[i1].Int())
+							return tnext
+						}
+					}
+				}
+				return
+			case reflect.Float32, reflect.Float64:
+				switch {
+				case i0 < 0:
+					s0 := vFloat(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 > f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 > f.data[i1].Float())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vFloat(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() > s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() > s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() > f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() > f.data[i1].Float())
+							return tnext
+						}
+					}
+				}
+				return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
 	case isString(t0) || isString(t1):
 		switch {
@@ -2587,6 +3426,132 @@ func greaterEqual(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				switch {
+				case i0 < 0:
+					s0 := vInt(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 >= f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 >= f.data[i1].Int())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vInt(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() >= s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() >= s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() >= f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() >= f.data[i1].Int())
+							return tnext
+						}
+					}
+				}
+				return
+			case reflect.Float32, reflect.Float64:
+				switch {
+				case i0 < 0:
+					s0 := vFloat(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 >= f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 >= f.data[i1].Float())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vFloat(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() >= s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() >= s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() >= f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() >= f.data[i1].Float())
+							return tnext
+						}
+					}
+				}
+				return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
 	case isString(t0) || isString(t1):
 		switch {
@@ -2876,6 +3841,132 @@ func lower(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				switch {
+				case i0 < 0:
+					s0 := vInt(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 < f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 < f.data[i1].Int())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vInt(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() < s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() < s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() < f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() < f.data[i1].Int())
+							return tnext
+						}
+					}
+				}
+				return
+			case reflect.Float32, reflect.Float64:
+				switch {
+				case i0 < 0:
+					s0 := vFloat(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 < f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 < f.data[i1].Float())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vFloat(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() < s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() < s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() < f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() < f.data[i1].Float())
+							return tnext
+						}
+					}
+				}
+				return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
 	case isString(t0) || isString(t1):
 		switch {
@@ -3165,6 +4256,132 @@ func lowerEqual(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				switch {
+				case i0 < 0:
+					s0 := vInt(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 <= f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 <= f.data[i1].Int())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vInt(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() <= s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() <= s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() <= f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() <= f.data[i1].Int())
+							return tnext
+						}
+					}
+				}
+				return
+			case reflect.Float32, reflect.Float64:
+				switch {
+				case i0 < 0:
+					s0 := vFloat(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 <= f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 <= f.data[i1].Float())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vFloat(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() <= s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() <= s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() <= f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() <= f.data[i1].Float())
+							return tnext
+						}
+					}
+				}
+				return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
 	case isString(t0) || isString(t1):
 		switch {
@@ -3454,7 +4671,152 @@ func notEqual(n *node) {
 	dest := genValue(n)
 	c0, c1 := n.child[0], n.child[1]
 
+	if d, i0, i1 := frameIndex(n), frameIndex(c0), frameIndex(c1); d >= 0 && (i0 >= 0 || i1 >= 0) {
+		// Fast path: the operands and the result are accessed directly in the local frame
+		t := c0.typ.TypeOf()
+		if i0 < 0 {
+			t = c1.typ.TypeOf()
+		}
+		if isFrameOperand(c0, i0, t) && isFrameOperand(c1, i1, t) {
+			switch t.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				switch {
+				case i0 < 0:
+					s0 := vInt(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 != f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 != f.data[i1].Int())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vInt(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() != s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() != s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Int() != f.data[i1].Int() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Int() != f.data[i1].Int())
+							return tnext
+						}
+					}
+				}
+				return
+			case reflect.Float32, reflect.Float64:
+				switch {
+				case i0 < 0:
+					s0 := vFloat(c0.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if s0 != f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(s0 != f.data[i1].Float())
+							return tnext
+						}
+					}
+				case i1 < 0:
+					s1 := vFloat(c1.rval)
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() != s1 {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() != s1)
+							return tnext
+						}
+					}
+				default:
+					if n.fnext != nil {
+						fnext := getExec(n.fnext)
+						n.exec = func(f *frame) bltn {
+							if f.data[i0].Float() != f.data[i1].Float() {
+								f.data[d].SetBool(true)
+								return tnext
+							}
+							f.data[d].SetBool(false)
+							return fnext
+						}
+					} else {
+						n.exec = func(f *frame) bltn {
+							f.data[d].SetBool(f.data[i0].Float() != f.data[i1].Float())
+							return tnext
+						}
+					}
+				}
+				return
+			}
+		}
+	}
+
 	switch t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf(); {
+	case isInterface(c0.typ) || isInterface(c1.typ):
+		v0 := genCompareKey(c0)
+		v1 := genCompareKey(c1)
+		if n.fnext != nil {
+			fnext := getExec(n.fnext)
+			n.exec = func(f *frame) bltn {
+				if !v0(f).equal(v1(f)) {
+					dest(f).SetBool(true)
+					return tnext
+				}
+				dest(f).SetBool(false)
+				return fnext
+			}
+		} else {
+			n.exec = func(f *frame) bltn {
+				dest(f).SetBool(!v0(f).equal(v1(f)))
+				return tnext
+			}
+		}
 	case isString(t0) || isString(t1):
 		switch {
 		case c0.rval.IsValid():
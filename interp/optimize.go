@@ -0,0 +1,135 @@
+package interp
+
+import (
+	"go/constant"
+	"reflect"
+)
+
+// optimize rewrites the CFG of root, once annotated and before the generation
+// of its execution closures, to reduce the number of nodes executed:
+//
+//   - The operands of a constant expression are not evaluated, its value
+//     being computed at compilation.
+//   - A constant condition jumps directly to the branch taken. The other
+//     branch, such as the code conditioned by "const debug = false", is
+//     unreachable, and its closures are never generated.
+//   - An assignment of a binary operation, whose result is already stored in
+//     the destination, does nothing.
+//   - The nodes doing nothing at run time, such as identifiers, literals,
+//     and the exits of blocks and statements, are bypassed: their
+//     predecessors are chained directly to their successors.
+//
+// The CFG is left unchanged if the nodes are instrumented, for the coverage,
+// the race detector, the debugger, the profiler or the tracer.
+func (interp *Interpreter) optimize(root *node) {
+	if interp.noRun || interp.cover != nil || interp.racer != nil || interp.debugger != nil || interp.profiler != nil || interp.tracer != nil {
+		return
+	}
+
+	var nodes []*node
+	skip := map[*node]*node{} // entry points of constant expressions, to the expressions
+	root.Walk(func(n *node) bool {
+		if n.kind == funcDecl && isGeneric(n) {
+			return false // generic template, not compiled
+		}
+		nodes = append(nodes, n)
+		if n.exec != nil || !isConstExpr(n) {
+			return true
+		}
+		if n.start != nil && n.start != n {
+			skip[n.start] = n
+		}
+		if n.fnext != nil && n.cval.Kind() == constant.Bool && (isGen(n, nop) || isGen(n, branch) || isGen(n, paren)) {
+			if !constant.BoolVal(n.cval) {
+				n.tnext = n.fnext
+			}
+			n.fnext = nil
+			n.gen = nop
+		}
+		return false
+	}, nil)
+
+	for _, n := range nodes {
+		if n.exec == nil && isDirectAssign(n) {
+			n.gen = nop
+		}
+	}
+
+	// next returns the node to execute in place of n. The last node of a
+	// false branch is kept, as a nil false branch denotes a node which does
+	// not branch.
+	next := func(n *node, branch bool) *node {
+		seen := map[*node]bool{}
+		for n != nil && !seen[n] {
+			seen[n] = true
+			if c := skip[n]; c != nil {
+				n = c
+				continue
+			}
+			if !isBypassed(n) || branch && n.tnext == nil {
+				break
+			}
+			n = n.tnext
+		}
+		return n
+	}
+	for _, n := range nodes {
+		if n.exec == nil {
+			n.tnext, n.fnext = next(n.tnext, false), next(n.fnext, true)
+		}
+	}
+}
+
+// isConstExpr returns true if the node n is a constant expression.
+func isConstExpr(n *node) bool {
+	switch n.kind {
+	case basicLit, binaryExpr, callExpr, identExpr, parenExpr, selectorExpr, unaryExpr:
+		return n.cval != nil && n.rval.IsValid()
+	}
+	return false
+}
+
+// isDirectAssign returns true if the node n assigns the result of a binary
+// operation to a variable, where the operation has already stored it.
+func isDirectAssign(n *node) bool {
+	if n.kind != assignStmt || n.action != aAssign || n.nleft != 1 || len(n.child) != 2 || !isGen(n, assign) {
+		return false
+	}
+	dest, src := n.child[0], n.child[1]
+	return src.kind == binaryExpr && !src.rval.IsValid() && dest.kind == identExpr && dest.ident != "_" &&
+		src.findex == dest.findex && src.level == dest.level && src.typ == dest.typ &&
+		dest.typ != nil && !isInterface(dest.typ) && dest.typ.TypeOf().Kind() != reflect.Interface
+}
+
+// isBypassed returns true if the node n does nothing at run time, and can be
+// skipped by its predecessors in the CFG.
+func isBypassed(n *node) bool {
+	if n.exec != nil || n.fnext != nil {
+		return false
+	}
+	switch n.kind {
+	case parenExpr:
+		return isGen(n, paren)
+	case returnStmt:
+		// The result of a binary operation is already stored in the frame
+		// by the operation, as in _return.
+		return isGen(n, _return) && (len(n.child) == 0 || len(n.child) == 1 && n.child[0].kind == binaryExpr && !n.child[0].rval.IsValid())
+	case blockStmt:
+		// The body of a range over function loop is chained to its exit
+		// when generated.
+		if n.anc != nil && isGen(n.anc, rangeFunc) {
+			return false
+		}
+	case assignStmt, basicLit, binaryExpr, callExpr, caseBody, declStmt, defineStmt, exprStmt, fallthroughtStmt,
+		forStmt0, forStmt1, forStmt2, forStmt3, forStmt3a, forStmt4, identExpr, ifStmt0, ifStmt1, ifStmt2, ifStmt3,
+		labeledStmt, selectorExpr, switchIfStmt, switchStmt, unaryExpr:
+	default:
+		return false
+	}
+	return isGen(n, nop)
+}
+
+// isGen returns true if g is the generator of the execution closure of n.
+func isGen(n *node, g bltnGenerator) bool {
+	return n.gen != nil && reflect.ValueOf(n.gen).Pointer() == reflect.ValueOf(g).Pointer()
+}
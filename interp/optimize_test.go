@@ -0,0 +1,55 @@
+package interp
+
+import "testing"
+
+func TestOptimize(t *testing.T) {
+	i := New(Options{})
+	root, p, err := i.compile(`const debug = false
+
+func sum(n int) int {
+	s := 0
+	for k := 0; k < n; k++ {
+		s += k
+		if debug {
+			println(k)
+		}
+	}
+	return s
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := i.Execute(p); err != nil {
+		t.Fatal(err)
+	}
+	res, err := i.Eval("sum(10)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := res.Int(); s != 45 {
+		t.Fatalf("got %d, want 45", s)
+	}
+
+	var cond, call *node
+	root.Walk(func(n *node) bool {
+		switch {
+		case n.kind == forStmt4:
+			cond = n.child[1]
+		case n.kind == callExpr && n.child[0].ident == "println":
+			call = n
+		}
+		return true
+	}, nil)
+	if call.exec != nil {
+		t.Error("unreachable call generated")
+	}
+	// The loop executes the condition, the body assignment and the post
+	// statement only.
+	var kinds []nkind
+	for n := cond.tnext; n != cond && len(kinds) < 10; n = n.tnext {
+		kinds = append(kinds, n.kind)
+	}
+	if len(kinds) != 2 || kinds[0] != assignStmt || kinds[1] != incDecStmt {
+		t.Errorf("got loop %v, want [assignStmt incDecStmt]", kinds)
+	}
+}
@@ -0,0 +1,367 @@
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A Package describes a package which can be imported by interpreted code,
+// as returned by Packages.
+type Package struct {
+	Path   string // import path
+	Name   string // package name
+	Binary bool   // true for a package of binary symbols loaded by Use, false for an interpreted one
+	Doc    string // doc comment of a binary package, set by RegisterPackageDoc, or empty
+}
+
+// A Symbol describes a package level symbol, a method or a package, as
+// returned by PackageSymbols and Doc.
+type Symbol struct {
+	Name      string // name of the symbol, as T.M for a method
+	Path      string // import path of the package of the symbol
+	Kind      string // "const", "var", "func", "type", "method" or "package"
+	Signature string // declaration of the symbol in Go syntax, without body, such as "func Println(a ...interface {}) (int, error)"
+	Doc       string // doc comment, or empty if not available
+}
+
+// Packages returns the packages loaded by the interpreter, binary and
+// interpreted, sorted by import path. The main package is included once
+// evaluated. The binary packages registered by UseLazy are listed without
+// being loaded.
+func (interp *Interpreter) Packages() []Package {
+	var pkgs []Package
+	for _, p := range interp.binPaths() {
+		if p == "" || !interp.allowedPkg(p) {
+			continue
+		}
+		pkgs = append(pkgs, Package{Path: p, Name: path.Base(p), Binary: true, Doc: interp.binSymbolDoc(p, "")})
+	}
+	for p, name := range interp.srcPkg {
+		if _, ok := interp.scopes[name]; ok && interp.binSymbols(p) == nil {
+			pkgs = append(pkgs, Package{Path: p, Name: path.Base(p)})
+		}
+	}
+	if _, ok := interp.scopes[mainID]; ok {
+		pkgs = append(pkgs, Package{Path: mainID, Name: mainID})
+	}
+	sort.Slice(pkgs, func(i, j int) bool { return pkgs[i].Path < pkgs[j].Path })
+	return pkgs
+}
+
+// PackageSymbols returns the exported symbols of the package of import path,
+// or all the symbols declared in the main package, sorted by name, or nil
+// if the package is not loaded. The symbols of a binary package registered by
+// UseLazy are loaded.
+func (interp *Interpreter) PackageSymbols(path string) []Symbol {
+	var syms []Symbol
+	if values := interp.binSymbols(path); values != nil {
+		for name, v := range values {
+			if canExport(name) && interp.allowedSym(path, name) {
+				syms = append(syms, interp.binSymbol(path, name, v))
+			}
+		}
+	} else if sc := interp.pkgScope(path); sc != nil {
+		for name, sym := range sc.sym {
+			if sym.kind == pkgSym || sym.kind == bltnSym || name == "_" || path != mainID && !canExport(name) {
+				continue
+			}
+			syms = append(syms, interp.srcSymbol(path, name, sym))
+		}
+	} else {
+		return nil
+	}
+	sort.Slice(syms, func(i, j int) bool { return syms[i].Name < syms[j].Name })
+	return syms
+}
+
+// Doc returns the description of the package, package level symbol or
+// method designated by name, as "fmt", "fmt.Println", "strings.Builder.Len"
+// or "encoding/json.Marshal". A package is designated by its import path, or
+// by its name if imported by the main package. A name without package, such
+// as "f" or "T.m", designates a symbol of the main package.
+func (interp *Interpreter) Doc(name string) (Symbol, error) {
+	if p, ok := interp.docPkg(name); ok {
+		return Symbol{Name: path.Base(p), Path: p, Kind: "package", Signature: "package " + path.Base(p), Doc: interp.binSymbolDoc(p, "")}, nil
+	}
+
+	pkgPath, sel := mainID, name
+	slash := strings.LastIndex(name, "/")
+	if i := strings.Index(name[slash+1:], "."); i >= 0 {
+		if p, ok := interp.docPkg(name[:slash+1+i]); ok {
+			pkgPath, sel = p, name[slash+2+i:]
+		}
+	}
+	symName, method := sel, ""
+	if i := strings.Index(sel, "."); i >= 0 {
+		symName, method = sel[:i], sel[i+1:]
+	}
+
+	if values := interp.binSymbols(pkgPath); values != nil {
+		v, ok := values[symName]
+		if !ok || !interp.allowedSym(pkgPath, symName) {
+			return Symbol{}, fmt.Errorf("no symbol %s in package %s", sel, pkgPath)
+		}
+		if method == "" {
+			return interp.binSymbol(pkgPath, symName, v), nil
+		}
+		if s, ok := interp.binMethod(pkgPath, symName, method, v); ok {
+			return s, nil
+		}
+		return Symbol{}, fmt.Errorf("no symbol %s in package %s", sel, pkgPath)
+	}
+	sc := interp.pkgScope(pkgPath)
+	if sc == nil {
+		return Symbol{}, fmt.Errorf("package %s not found", pkgPath)
+	}
+	sym, ok := sc.sym[symName]
+	if !ok || sym.kind == pkgSym || sym.kind == bltnSym {
+		return Symbol{}, fmt.Errorf("no symbol %s in package %s", sel, pkgPath)
+	}
+	if method == "" {
+		return interp.srcSymbol(pkgPath, symName, sym), nil
+	}
+	if sym.kind == typeSym && sym.typ != nil {
+		if m, _ := sym.typ.lookupMethod(method); m != nil && m.kind == funcDecl {
+			s := Symbol{Name: sel, Path: pkgPath, Kind: "method"}
+			s.Signature, s.Doc = interp.declDoc(m.child[1].pos)
+			return s, nil
+		}
+	}
+	return Symbol{}, fmt.Errorf("no symbol %s in package %s", sel, pkgPath)
+}
+
+// docPkg returns the import path of the package designated by name in Doc,
+// and true, or false if name does not designate a loaded package.
+func (interp *Interpreter) docPkg(name string) (string, bool) {
+	if interp.binSymbols(name) != nil || name != mainID && interp.pkgScope(name) != nil {
+		return name, true
+	}
+	if sc := interp.scopes[mainID]; sc != nil {
+		if sym := sc.sym[name]; sym != nil && sym.kind == pkgSym {
+			return sym.path, true
+		}
+	}
+	return "", false
+}
+
+// binSymbol returns the description of the symbol name of binary package
+// path, of value v.
+func (interp *Interpreter) binSymbol(path, name string, v reflect.Value) Symbol {
+	s := Symbol{Name: name, Path: path, Doc: interp.binSymbolDoc(path, name)}
+	switch t := v.Type(); {
+	case isBinType(v):
+		s.Kind, s.Signature = "type", "type "+name+" "+underlyingString(t.Elem())
+	case v.Kind() == reflect.Func && !v.CanAddr():
+		s.Kind, s.Signature = "func", "func "+name+strings.TrimPrefix(t.String(), "func")
+	case v.CanAddr():
+		s.Kind, s.Signature = "var", "var "+name+" "+t.String()
+	default:
+		s.Kind, s.Signature = "const", "const "+name+" "+t.String()
+		if isBinFloatConst(v) {
+			s.Signature = "const " + name
+		}
+		if c, ok := valueConst(v); ok {
+			s.Signature += " = " + constString(c)
+		}
+	}
+	return s
+}
+
+// binMethod returns the description of the method of the binary type name
+// of package path, given by its nil pointer v, and true, or false if the type
+// has no such method.
+func (interp *Interpreter) binMethod(path, name, method string, v reflect.Value) (Symbol, bool) {
+	if !isBinType(v) {
+		return Symbol{}, false
+	}
+	t, recv := v.Type().Elem(), name
+	m, ok := t.MethodByName(method)
+	if !ok && t.Kind() != reflect.Interface {
+		m, ok = reflect.PtrTo(t).MethodByName(method)
+		recv = "*" + name
+	}
+	if !ok {
+		return Symbol{}, false
+	}
+	s := Symbol{Name: name + "." + method, Path: path, Kind: "method", Doc: interp.binSymbolDoc(path, name+"."+method)}
+	if t.Kind() == reflect.Interface {
+		// The methods of an interface type have no receiver argument
+		s.Signature = "func (" + recv + ") " + method + strings.TrimPrefix(m.Type.String(), "func")
+		return s, true
+	}
+	in := make([]reflect.Type, m.Type.NumIn()-1)
+	for i := range in {
+		in[i] = m.Type.In(i + 1)
+	}
+	out := make([]reflect.Type, m.Type.NumOut())
+	for i := range out {
+		out[i] = m.Type.Out(i)
+	}
+	ft := reflect.FuncOf(in, out, m.Type.IsVariadic())
+	s.Signature = "func (" + recv + ") " + method + strings.TrimPrefix(ft.String(), "func")
+	return s, true
+}
+
+// underlyingString returns the representation in Go syntax of the underlying
+// type of the runtime type t.
+func underlyingString(t reflect.Type) string {
+	if t.Name() == "" {
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Array:
+		return reflect.ArrayOf(t.Len(), t.Elem()).String()
+	case reflect.Chan:
+		return reflect.ChanOf(t.ChanDir(), t.Elem()).String()
+	case reflect.Func:
+		in := make([]reflect.Type, t.NumIn())
+		for i := range in {
+			in[i] = t.In(i)
+		}
+		out := make([]reflect.Type, t.NumOut())
+		for i := range out {
+			out[i] = t.Out(i)
+		}
+		return reflect.FuncOf(in, out, t.IsVariadic()).String()
+	case reflect.Interface:
+		var methods []string
+		for i := 0; i < t.NumMethod(); i++ {
+			m := t.Method(i)
+			methods = append(methods, m.Name+strings.TrimPrefix(m.Type.String(), "func"))
+		}
+		if len(methods) == 0 {
+			return "interface {}"
+		}
+		return "interface { " + strings.Join(methods, "; ") + " }"
+	case reflect.Map:
+		return reflect.MapOf(t.Key(), t.Elem()).String()
+	case reflect.Ptr:
+		return reflect.PtrTo(t.Elem()).String()
+	case reflect.Slice:
+		return reflect.SliceOf(t.Elem()).String()
+	case reflect.Struct:
+		var fields []string
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.PkgPath == "" {
+				fields = append(fields, f.Name+" "+f.Type.String())
+			}
+		}
+		if len(fields) < t.NumField() {
+			fields = append(fields, "// contains filtered or unexported fields")
+		}
+		if len(fields) == 0 {
+			return "struct {}"
+		}
+		return "struct { " + strings.Join(fields, "; ") + " }"
+	}
+	return t.Kind().String()
+}
+
+// srcSymbol returns the description of the symbol sym, of name, declared in
+// the interpreted package of import path.
+func (interp *Interpreter) srcSymbol(path, name string, sym *symbol) Symbol {
+	s := Symbol{Name: name, Path: path, Kind: symKindName(sym)}
+	decl := sym.decl
+	if sym.kind == funcSym && sym.node != nil && sym.node.kind == funcDecl {
+		decl = sym.node.child[1].pos
+	}
+	s.Signature, s.Doc = interp.declDoc(decl)
+	if s.Signature != "" {
+		return s
+	}
+	s.Signature = s.Kind + " " + name
+	if t := identType(sym.typ); t != "" && !(sym.kind == constSym && sym.typ.untyped) {
+		s.Signature += " " + t
+	}
+	if sym.kind == constSym && sym.cval != nil {
+		s.Signature += " = " + constString(sym.cval)
+	}
+	return s
+}
+
+// constString returns the representation of the constant value c in Go
+// syntax, a float being formatted as the nearest float64.
+func constString(c constant.Value) string {
+	if c.Kind() == constant.Float {
+		f, _ := constant.Float64Val(c)
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	}
+	return c.ExactString()
+}
+
+// declDoc returns the doc comment of the declaration of the identifier at pos
+// in the interpreted source, and for a function or a type, its declaration
+// without body. The source is parsed again, with its comments.
+func (interp *Interpreter) declDoc(pos token.Pos) (sig, doc string) {
+	tf := interp.fset.File(pos)
+	if tf == nil {
+		return "", ""
+	}
+	text, ok := interp.texts[tf]
+	if !ok {
+		return "", ""
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, tf.Name(), text.src, parser.ParseComments)
+	if err != nil {
+		return "", ""
+	}
+	offset := tf.Offset(pos)
+	at := func(id *ast.Ident) bool { return fset.Position(id.Pos()).Offset == offset }
+	format := func(n interface{}) string {
+		var b bytes.Buffer
+		if err := printer.Fprint(&b, fset, n); err != nil {
+			return ""
+		}
+		return b.String()
+	}
+
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if at(d.Name) {
+				fd := *d
+				fd.Doc, fd.Body = nil, nil
+				return format(&fd), d.Doc.Text()
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if at(spec.Name) {
+						ts := *spec
+						ts.Doc, ts.Comment = nil, nil
+						return "type " + format(&ts), specDoc(d, spec.Doc)
+					}
+				case *ast.ValueSpec:
+					for _, id := range spec.Names {
+						if at(id) {
+							return "", specDoc(d, spec.Doc)
+						}
+					}
+				}
+			}
+		}
+	}
+	return "", ""
+}
+
+// specDoc returns the text of doc, the doc comment of a specification of
+// the declaration d, or the one of d if d declares a single specification
+// without parentheses.
+func specDoc(d *ast.GenDecl, doc *ast.CommentGroup) string {
+	if doc == nil && !d.Lparen.IsValid() {
+		doc = d.Doc
+	}
+	return doc.Text()
+}
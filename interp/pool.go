@@ -0,0 +1,37 @@
+package interp
+
+import "sync"
+
+// Pool is a set of interpreters which can be reused to evaluate many short
+// independent programs, without paying for each of them the cost of
+// creating an interpreter and loading its binary symbols. A Pool is safe
+// for concurrent use.
+type Pool struct {
+	pool sync.Pool
+}
+
+// NewPool returns a pool of interpreters created by newInterp when the pool
+// is empty. Function newInterp sets the options of an interpreter and loads
+// its binary symbols, as in:
+//
+//	p := interp.NewPool(func() *interp.Interpreter {
+//		i := interp.New(interp.Options{})
+//		i.Use(stdlib.Symbols)
+//		return i
+//	})
+func NewPool(newInterp func() *Interpreter) *Pool {
+	p := &Pool{}
+	p.pool.New = func() interface{} { return newInterp() }
+	return p
+}
+
+// Get returns an interpreter of the pool, in the state of a new one, or a
+// new interpreter if the pool is empty.
+func (p *Pool) Get() *Interpreter { return p.pool.Get().(*Interpreter) }
+
+// Put resets the interpreter i, which must not be used afterwards, and
+// returns it to the pool.
+func (p *Pool) Put(i *Interpreter) {
+	i.Reset()
+	p.pool.Put(i)
+}
@@ -0,0 +1,143 @@
+package interp
+
+import (
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// srcFile is a source file to parse, in the package of path rPath.
+type srcFile struct {
+	name  string // file name
+	src   string // file content
+	rPath string // effective package path, from which imports are resolved
+}
+
+// parseFiles parses the source files concurrently, and returns the parsed
+// sources in the same order. Files already parsed by prefetch are not
+// parsed again.
+func (interp *Interpreter) parseFiles(files []srcFile) []*parsedSrc {
+	parsed := make([]*parsedSrc, len(files))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i, file := range files {
+		if p := interp.parsed[file.name]; p != nil && p.buf == file.src {
+			delete(interp.parsed, file.name)
+			parsed[i] = p
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, file srcFile) {
+			defer func() { <-sem; wg.Done() }()
+			parsed[i] = interp.parse(file.src, file.name)
+		}(i, file)
+	}
+	wg.Wait()
+	return parsed
+}
+
+// prefetch parses concurrently the source packages imported by the files
+// parsed, and by their own imports, so they are found already parsed when
+// imported. The files are in the package of path rPath, or of their package
+// name if empty, as in gta. The imports are resolved in the order of the
+// import graph, one level at a time, the packages of a level being parsed
+// concurrently. Resolution errors are ignored here, and reported when the
+// packages are actually imported.
+//
+// Only the top level source being loaded prefetches its imports, which
+// remain parsed until it is compiled. It returns a function to call once
+// the source is compiled.
+func (interp *Interpreter) prefetch(rPath string, parsed []*parsedSrc) func() {
+	if interp.parsed != nil {
+		return func() {}
+	}
+	interp.parsed = map[string]*parsedSrc{}
+	done := func() { interp.parsed = nil }
+
+	for _, p := range parsed {
+		if rPath == "" && p.file != nil {
+			rPath = p.file.Name.Name
+		}
+	}
+	seen := map[string]bool{}
+	rPaths := make([]string, len(parsed))
+	for i := range rPaths {
+		rPaths[i] = rPath
+	}
+	for len(parsed) > 0 {
+		var files []srcFile
+		for i, p := range parsed {
+			if p.file == nil {
+				continue
+			}
+			for _, s := range p.file.Imports {
+				path, err := strconv.Unquote(s.Path.Value)
+				if err != nil || seen[path] {
+					continue
+				}
+				seen[path] = true
+				files = append(files, interp.prefetchFiles(rPaths[i], path)...)
+			}
+		}
+		parsed = interp.parseFiles(files)
+		rPaths = rPaths[:0]
+		for i, p := range parsed {
+			interp.parsed[p.name] = p
+			rPaths = append(rPaths, files[i].rPath)
+		}
+	}
+	return done
+}
+
+// prefetchFiles returns the source files of the package of import path,
+// imported from the package rPath, if it is imported from source, as decided
+// by gta, and not loaded yet. Packages which may be imported otherwise than
+// by the interpreter filesystem or the standard library sources, when an
+// import resolver or a binary importer is set, are not prefetched.
+func (interp *Interpreter) prefetchFiles(rPath, path string) []srcFile {
+	switch {
+	case path == "C" || path == "unsafe":
+		return nil
+	case interp.importBin != nil || interp.fallback[path] != nil:
+		return nil
+	case interp.importSrc != nil && !isPathRelative(path):
+		return nil
+	case interp.binSymbols(path) != nil:
+		return nil
+	}
+	fsys, dir, rPath, err := interp.resolveImport(rPath, path)
+	if err != nil {
+		return nil
+	}
+	key := dir
+	if fsys != nil {
+		key = "import " + path
+	} else {
+		fsys = interp.filesystem
+	}
+	if _, ok := interp.srcDirs[key]; ok {
+		return nil
+	}
+	rPath = effectivePkg(rPath, path)
+
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil
+	}
+	var files []srcFile
+	for _, e := range entries {
+		if skipFile(interp.context, e.Name()) {
+			continue
+		}
+		name := filepath.Join(dir, e.Name())
+		buf, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			continue
+		}
+		files = append(files, srcFile{name: name, src: string(buf), rPath: rPath})
+	}
+	return files
+}
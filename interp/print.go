@@ -0,0 +1,269 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A Printer formats values in a Go like syntax, as the results displayed by
+// Repl. Map entries are sorted by key, cycles of references are detected,
+// and values implementing error or fmt.Stringer are formatted by their
+// method.
+type Printer struct {
+	MaxDepth   int  // maximum depth of nested values, or 0 if unlimited
+	MaxWidth   int  // maximum number of elements of a composite value or of bytes of a string, or 0 if unlimited
+	Unexported bool // format unexported struct fields
+}
+
+// defaultPrinter formats the results of Repl, unless replaced by SetPrinter.
+var defaultPrinter = &Printer{MaxDepth: 10, MaxWidth: 100}
+
+// SetPrinter sets the function formatting the results displayed by Repl. If
+// f is nil, results are formatted by a Printer with default limits.
+func (interp *Interpreter) SetPrinter(f func(reflect.Value) string) {
+	interp.printer = f
+}
+
+// Sprint returns the formatted value v.
+func (p *Printer) Sprint(v reflect.Value) string {
+	s := &printState{Printer: p, visited: map[visit]bool{}}
+	s.print(v, 0)
+	return s.String()
+}
+
+// printState is the state of a value formatting.
+type printState struct {
+	*Printer
+	strings.Builder
+	visited map[visit]bool // references being formatted, to detect cycles
+}
+
+// visit is a reference to a value, of a pointer, a map or a slice.
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+var (
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+func (s *printState) print(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		s.WriteString("nil")
+		return
+	}
+	if v.Type() == valueInterfaceType && v.CanInterface() {
+		// Interface value of interpreted code
+		s.print(v.Interface().(valueInterface).value, depth)
+		return
+	}
+	if s.method(v) {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			s.WriteString("nil")
+			return
+		}
+		if v.Kind() == reflect.Ptr && v.Elem().Kind() != reflect.Struct && v.Elem().Kind() != reflect.Array {
+			// Pointers to scalars are formatted as addresses
+			break
+		}
+		k := visit{v.Pointer(), v.Type()}
+		if s.visited[k] {
+			s.WriteString("<cycle>")
+			return
+		}
+		s.visited[k] = true
+		defer delete(s.visited, k)
+	}
+
+	if s.MaxDepth > 0 && depth > s.MaxDepth {
+		switch v.Kind() {
+		case reflect.Array, reflect.Map, reflect.Ptr, reflect.Slice, reflect.Struct:
+			s.WriteString("...")
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		str := v.String()
+		if s.MaxWidth > 0 && len(str) > s.MaxWidth {
+			s.WriteString(strconv.Quote(str[:s.MaxWidth]) + "...")
+			return
+		}
+		s.WriteString(strconv.Quote(str))
+
+	case reflect.Interface:
+		s.print(v.Elem(), depth)
+
+	case reflect.Ptr:
+		if k := v.Elem().Kind(); k == reflect.Struct || k == reflect.Array {
+			s.WriteString("&")
+			s.print(v.Elem(), depth+1)
+			return
+		}
+		fmt.Fprintf(s, "(%s)(%#x)", v.Type(), v.Pointer())
+
+	case reflect.Array, reflect.Slice:
+		s.WriteString("[")
+		n := v.Len()
+		for i := 0; i < n; i++ {
+			if i > 0 {
+				s.WriteString(", ")
+			}
+			if s.MaxWidth > 0 && i == s.MaxWidth {
+				fmt.Fprintf(s, "... +%d more", n-i)
+				break
+			}
+			s.print(v.Index(i), depth+1)
+		}
+		s.WriteString("]")
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return lessValue(keys[i], keys[j]) })
+		s.WriteString("map[")
+		for i, k := range keys {
+			if i > 0 {
+				s.WriteString(", ")
+			}
+			if s.MaxWidth > 0 && i == s.MaxWidth {
+				fmt.Fprintf(s, "... +%d more", len(keys)-i)
+				break
+			}
+			s.print(k, depth+1)
+			s.WriteString(": ")
+			s.print(v.MapIndex(k), depth+1)
+		}
+		s.WriteString("]")
+
+	case reflect.Struct:
+		if t := v.Type(); t.Name() != "" {
+			s.WriteString(t.String())
+		}
+		s.WriteString("{")
+		n := 0
+		for i := 0; i < v.NumField(); i++ {
+			name, exported := printFieldName(v.Type(), i)
+			if !exported && !s.Unexported || name == "_" {
+				continue
+			}
+			if n > 0 {
+				s.WriteString(", ")
+			}
+			if s.MaxWidth > 0 && n == s.MaxWidth {
+				s.WriteString("...")
+				break
+			}
+			n++
+			s.WriteString(name + ": ")
+			s.print(v.Field(i), depth+1)
+		}
+		s.WriteString("}")
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			s.WriteString("nil")
+			return
+		}
+		fmt.Fprintf(s, "(%s)(%#x)", v.Type(), v.Pointer())
+
+	default:
+		// Booleans and numbers
+		fmt.Fprint(s, scalarOf(v))
+	}
+}
+
+// printFieldName returns the name of field i of struct type t, and true if it is
+// exported. The unexported fields of interpreted types are renamed by
+// exportName in their runtime type, which is not named.
+func printFieldName(t reflect.Type, i int) (string, bool) {
+	f := t.Field(i)
+	if f.PkgPath != "" {
+		return f.Name, false
+	}
+	if t.Name() == "" && len(f.Name) > 1 && f.Name[0] == 'X' && !canExport(f.Name[1:]) {
+		return f.Name[1:], false
+	}
+	return f.Name, true
+}
+
+// method formats v with its Error or String method, if any, and returns
+// true if done.
+func (s *printState) method(v reflect.Value) (done bool) {
+	if !v.CanInterface() || v.Kind() == reflect.Ptr && v.IsNil() {
+		return false
+	}
+	t := v.Type()
+	if !t.Implements(errorType) && !t.Implements(stringerType) {
+		return false
+	}
+	defer func() {
+		// A method panicking is ignored, as by package fmt
+		if r := recover(); r != nil {
+			done = false
+		}
+	}()
+	var str string
+	switch x := v.Interface().(type) {
+	case error:
+		str = x.Error()
+	case fmt.Stringer:
+		str = x.String()
+	}
+	s.WriteString(str)
+	return true
+}
+
+// scalarOf returns the value of the boolean or number v, including for
+// an unexported struct field.
+func scalarOf(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint()
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return v.Complex()
+	}
+	return v.Kind().String()
+}
+
+// lessValue returns true if the map key a is ordered before b: numbers and
+// strings are compared by value, and other keys by their formatting.
+func lessValue(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	if b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if a.Kind() == b.Kind() {
+		switch a.Kind() {
+		case reflect.Bool:
+			return !a.Bool() && b.Bool()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return a.Int() < b.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return a.Uint() < b.Uint()
+		case reflect.Float32, reflect.Float64:
+			return a.Float() < b.Float()
+		case reflect.String:
+			return a.String() < b.String()
+		}
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
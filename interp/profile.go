@@ -0,0 +1,197 @@
+package interp
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// A ProfileFrame is a function frame of a sampled goroutine.
+type ProfileFrame struct {
+	Function string // function name, qualified by its package name
+	File     string // source file name
+	Line     int    // line being executed
+	FuncLine int    // line of function declaration, or 0 at global level
+}
+
+// profiler samples the call stacks of interpreted goroutines.
+type profiler struct {
+	tick   uint64               // incremented every period
+	sample func([]ProfileFrame) // called with the stack of a sampled goroutine
+	names  atomic.Value         // map[*node]string of function names, copied on write
+	done   chan struct{}        // closed to stop ticks
+}
+
+// profileRoutine stores the profiling state of a goroutine.
+type profileRoutine struct {
+	tick uint64 // tick of last sample
+}
+
+// frameProfile stores the profiling state of a frame.
+type frameProfile struct {
+	routine *profileRoutine
+	caller  *frame // calling frame, or nil
+	def     *node  // function definition, or nil at global level
+	node    *node  // node being executed
+}
+
+// Profile starts sampling the call stacks of interpreted goroutines, and
+// returns a function to stop it. Every period, each goroutine executing
+// interpreted code calls sample with its call stack, innermost frame first.
+// Goroutines which are blocked or running binary code are not sampled
+// until they execute interpreted code again, so samples measure the time
+// spent in interpreted code. Only code compiled afterwards is sampled, so
+// Profile must be called prior to Eval. Function sample is called
+// concurrently from interpreted goroutines.
+func (interp *Interpreter) Profile(period time.Duration, sample func([]ProfileFrame)) (stop func()) {
+	p := &profiler{sample: sample, done: make(chan struct{})}
+	p.names.Store(map[*node]string{})
+	interp.frame.profile = &frameProfile{routine: &profileRoutine{}}
+	interp.profiler = p
+
+	ticker := time.NewTicker(period)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				atomic.AddUint64(&p.tick, 1)
+			case <-p.done:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+	return func() { close(p.done) }
+}
+
+// enter sets the profiling state of frame f, called from frame caller, or
+// started in a new goroutine.
+func (p *profiler) enter(f, caller *frame, def *node, goroutine bool) {
+	var cp *frameProfile
+	if caller != nil {
+		cp = caller.profile
+	}
+	if cp == nil || goroutine {
+		r := &profileRoutine{tick: atomic.LoadUint64(&p.tick)}
+		f.profile = &frameProfile{routine: r, def: def}
+		return
+	}
+	f.profile = &frameProfile{routine: cp.routine, caller: caller, def: def}
+}
+
+// wrap instruments the exec function of node n, to track execution and
+// sample the goroutine stack once per tick.
+func (p *profiler) wrap(n *node) {
+	exec := n.exec
+	if exec == nil {
+		return
+	}
+	n.exec = func(f *frame) bltn {
+		if fp := f.profile; fp != nil {
+			fp.node = n
+			if t := atomic.LoadUint64(&p.tick); t != fp.routine.tick {
+				fp.routine.tick = t
+				p.sample(p.stack(f))
+			}
+		}
+		return exec(f)
+	}
+}
+
+// stack returns the call stack of frame f, innermost frame first. The
+// global frame is only reported when executing global code.
+func (p *profiler) stack(f *frame) []ProfileFrame {
+	var frames []ProfileFrame
+	for ; f != nil && f.profile != nil; f = f.profile.caller {
+		fp := f.profile
+		if fp.node == nil || fp.def == nil && len(frames) > 0 {
+			continue
+		}
+		pos := fp.node.interp.fset.Position(fp.node.pos)
+		pf := ProfileFrame{Function: p.funcName(fp.def, fp.node), File: pos.Filename, Line: pos.Line}
+		if fp.def != nil {
+			pf.FuncLine = fp.node.interp.fset.Position(fp.def.pos).Line
+		}
+		frames = append(frames, pf)
+	}
+	return frames
+}
+
+// funcName returns the qualified name of function def, as funcName, with
+// names computed once per function.
+func (p *profiler) funcName(def, n *node) string {
+	key := def
+	if key == nil {
+		key = rootNode(n)
+	}
+	names := p.names.Load().(map[*node]string)
+	if name, ok := names[key]; ok {
+		return name
+	}
+	name := funcName(def, n)
+
+	// Maps are copied as the function may be called concurrently
+	m := make(map[*node]string, len(names)+1)
+	for k, v := range names {
+		m[k] = v
+	}
+	m[key] = name
+	p.names.Store(m)
+	return name
+}
+
+// funcName returns the qualified name of function def, or of the package
+// initialization if def is nil, with n a node of the function.
+func funcName(def, n *node) string {
+	switch {
+	case def == nil:
+		return filePkgName(rootNode(n)) + ".init"
+	case def.kind == funcLit:
+		// Function literals are named by their rank in the enclosing
+		// function declaration, or in the file at global level
+		a := def.anc
+		for a.anc != nil && a.kind != funcDecl {
+			a = a.anc
+		}
+		i, found := 0, false
+		a.Walk(func(c *node) bool {
+			if found {
+				return false
+			}
+			if c.kind == funcLit {
+				i++
+			}
+			found = c == def
+			return !found
+		}, nil)
+		prefix := filePkgName(rootNode(def)) + ".init"
+		if a.kind == funcDecl {
+			prefix = funcName(a, a)
+		}
+		return prefix + ".func" + strconv.Itoa(i)
+	case isMethod(def):
+		r := def.child[0].child[0].lastChild()
+		if r.kind == starExpr {
+			return filePkgName(rootNode(def)) + ".(*" + r.child[0].ident + ")." + def.child[1].ident
+		}
+		return filePkgName(rootNode(def)) + "." + r.ident + "." + def.child[1].ident
+	default:
+		return filePkgName(rootNode(def)) + "." + def.child[1].ident
+	}
+}
+
+// rootNode returns the root node of the AST containing n.
+func rootNode(n *node) *node {
+	for n.anc != nil {
+		n = n.anc
+	}
+	return n
+}
+
+// filePkgName returns the package name of the file node n.
+func filePkgName(n *node) string {
+	if len(n.child) > 0 && n.child[0].kind == identExpr {
+		return n.child[0].ident
+	}
+	return mainID
+}
@@ -0,0 +1,220 @@
+// Package profile writes CPU profiles of interpreted code in the pprof format.
+//
+// A profile attributes the time spent in interpreted code to interpreted
+// functions and source lines, rather than to the functions of the
+// interpreter itself. It is obtained by sampling the call stacks of
+// interpreted goroutines, and can be analyzed with go tool pprof:
+//
+//	p := profile.Start(i, f)
+//	_, err := i.Eval(src)
+//	p.Stop()
+//
+// As a profile is built from the interpreted code compiled after Start,
+// Start must be called prior to Eval. Time spent in binary code called from
+// interpreted code, such as the standard library, is not measured.
+package profile
+
+import (
+	"compress/gzip"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+)
+
+// Period is the sampling period of profiles.
+const Period = 10 * time.Millisecond
+
+// A Profiler collects a profile of an interpreter.
+type Profiler struct {
+	w     io.Writer
+	stop  func()
+	start time.Time
+
+	mutex   sync.Mutex         // protects samples, updated from interpreter goroutines
+	samples map[string]*sample // samples, indexed by call stack
+	keys    []string           // samples keys, in order of first occurrence
+}
+
+// sample is a call stack and the number of times it was sampled.
+type sample struct {
+	stack []interp.ProfileFrame
+	count int64
+}
+
+// Start starts profiling interpreter i. The profile is written to w by Stop.
+func Start(i *interp.Interpreter, w io.Writer) *Profiler {
+	p := &Profiler{w: w, start: time.Now(), samples: map[string]*sample{}}
+	p.stop = i.Profile(Period, p.add)
+	return p
+}
+
+// Stop stops profiling and writes the profile, as a gzip compressed
+// protocol buffer. Samples which occur afterwards are ignored.
+func (p *Profiler) Stop() error {
+	p.stop()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	data := p.encode(time.Since(p.start))
+	p.samples = nil
+
+	zw := gzip.NewWriter(p.w)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// add records a sample of call stack.
+func (p *Profiler) add(stack []interp.ProfileFrame) {
+	var sb strings.Builder
+	for _, f := range stack {
+		sb.WriteString(f.Function)
+		sb.WriteByte(0)
+		sb.WriteString(f.File)
+		sb.WriteByte(0)
+		sb.WriteString(strconv.Itoa(f.Line))
+		sb.WriteByte(0)
+	}
+	key := sb.String()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.samples == nil {
+		return
+	}
+	s, ok := p.samples[key]
+	if !ok {
+		s = &sample{stack: stack}
+		p.samples[key] = s
+		p.keys = append(p.keys, key)
+	}
+	s.count++
+}
+
+// Field numbers of the profile.proto messages, as defined in
+// https://github.com/google/pprof/blob/master/proto/profile.proto.
+const (
+	profileSampleType    = 1
+	profileSample        = 2
+	profileLocation      = 4
+	profileFunction      = 5
+	profileStringTable   = 6
+	profileTimeNanos     = 9
+	profileDurationNanos = 10
+	profilePeriodType    = 11
+	profilePeriod        = 12
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+	lineLine       = 2
+
+	functionID         = 1
+	functionName       = 2
+	functionSystemName = 3
+	functionFilename   = 4
+	functionStartLine  = 5
+)
+
+// function identifies a function of a profile.
+type function struct {
+	name, file string
+	line       int
+}
+
+// location identifies a source line of a profile.
+type location struct {
+	fn   int64
+	line int
+}
+
+// encode returns the profile, lasting duration, as a protocol buffer.
+func (p *Profiler) encode(duration time.Duration) []byte {
+	strs := []string{""}
+	strIndex := map[string]int64{"": 0}
+	str := func(s string) int64 {
+		i, ok := strIndex[s]
+		if !ok {
+			i = int64(len(strs))
+			strs = append(strs, s)
+			strIndex[s] = i
+		}
+		return i
+	}
+
+	var b buffer
+	valueType := func(tag int, typ, unit string) {
+		b.message(tag, func(b *buffer) {
+			b.int64(valueTypeType, str(typ))
+			b.int64(valueTypeUnit, str(unit))
+		})
+	}
+	valueType(profileSampleType, "samples", "count")
+	valueType(profileSampleType, "cpu", "nanoseconds")
+
+	// Functions and locations are numbered from 1, in order of occurrence
+	functions := map[function]int64{}
+	locations := map[location]int64{}
+	var fb, lb buffer
+	for _, k := range p.keys {
+		s := p.samples[k]
+		ids := make([]int64, len(s.stack))
+		for i, f := range s.stack {
+			fn := function{f.Function, f.File, f.FuncLine}
+			fid, ok := functions[fn]
+			if !ok {
+				fid = int64(len(functions) + 1)
+				functions[fn] = fid
+				fb.message(profileFunction, func(b *buffer) {
+					b.int64(functionID, fid)
+					b.int64(functionName, str(fn.name))
+					b.int64(functionSystemName, str(fn.name))
+					b.int64(functionFilename, str(fn.file))
+					b.int64(functionStartLine, int64(fn.line))
+				})
+			}
+			loc := location{fid, f.Line}
+			lid, ok := locations[loc]
+			if !ok {
+				lid = int64(len(locations) + 1)
+				locations[loc] = lid
+				lb.message(profileLocation, func(b *buffer) {
+					b.int64(locationID, lid)
+					b.message(locationLine, func(b *buffer) {
+						b.int64(lineFunctionID, loc.fn)
+						b.int64(lineLine, int64(loc.line))
+					})
+				})
+			}
+			ids[i] = lid
+		}
+		b.message(profileSample, func(b *buffer) {
+			b.packed(sampleLocationID, ids)
+			b.packed(sampleValue, []int64{s.count, s.count * int64(Period)})
+		})
+	}
+	b.data = append(b.data, lb.data...)
+	b.data = append(b.data, fb.data...)
+
+	b.int64(profileTimeNanos, p.start.UnixNano())
+	b.int64(profileDurationNanos, int64(duration))
+	valueType(profilePeriodType, "cpu", "nanoseconds")
+	b.int64(profilePeriod, int64(Period))
+
+	// The string table is complete once all messages are encoded
+	for _, s := range strs {
+		b.string(profileStringTable, s)
+	}
+	return b.data
+}
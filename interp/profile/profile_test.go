@@ -0,0 +1,96 @@
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+const testProgram = `package main
+
+import "time"
+
+type T struct{}
+
+func (t *T) spin(d time.Duration) {
+	start := time.Now()
+	for time.Since(start) < d {
+	}
+}
+
+func main() {
+	f := func() { (&T{}).spin(100 * time.Millisecond) }
+	f()
+}
+`
+
+func TestProfile(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Name = "spin.go"
+
+	var buf bytes.Buffer
+	p := Start(i, &buf)
+	if _, err := i.Eval(testProgram); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"main.(*T).spin", "main.main.func1", "spin.go", "cpu", "nanoseconds"} {
+		if !bytes.Contains(data, []byte(s)) {
+			t.Errorf("profile does not contain %q", s)
+		}
+	}
+}
+
+func TestProfileStack(t *testing.T) {
+	i := interp.New(interp.Options{})
+	i.Use(stdlib.Symbols)
+	i.Name = "spin.go"
+
+	var mutex sync.Mutex
+	var stack []interp.ProfileFrame
+	stop := i.Profile(time.Millisecond, func(s []interp.ProfileFrame) {
+		mutex.Lock()
+		if stack == nil && s[0].Function == "main.(*T).spin" {
+			stack = s
+		}
+		mutex.Unlock()
+	})
+	defer stop()
+	if _, err := i.Eval(testProgram); err != nil {
+		t.Fatal(err)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(stack) > 0 {
+		// The innermost frame may be at the loop condition or body
+		stack[0].Line = 0
+	}
+	expected := []interp.ProfileFrame{
+		{Function: "main.(*T).spin", File: "spin.go", FuncLine: 7},
+		{Function: "main.main.func1", File: "spin.go", Line: 14, FuncLine: 14},
+		{Function: "main.main", File: "spin.go", Line: 15, FuncLine: 13},
+	}
+	if !reflect.DeepEqual(stack, expected) {
+		t.Errorf("got %+v, want %+v", stack, expected)
+	}
+}
@@ -0,0 +1,57 @@
+package profile
+
+// buffer encodes protocol buffer messages.
+type buffer struct {
+	data []byte
+}
+
+// Wire types of protocol buffer fields.
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+func (b *buffer) varint(x uint64) {
+	for x >= 0x80 {
+		b.data = append(b.data, byte(x)|0x80)
+		x >>= 7
+	}
+	b.data = append(b.data, byte(x))
+}
+
+func (b *buffer) key(tag, wire int) { b.varint(uint64(tag)<<3 | uint64(wire)) }
+
+// int64 encodes an integer field, omitted if zero as in proto3.
+func (b *buffer) int64(tag int, x int64) {
+	if x == 0 {
+		return
+	}
+	b.key(tag, wireVarint)
+	b.varint(uint64(x))
+}
+
+func (b *buffer) string(tag int, s string) {
+	b.key(tag, wireBytes)
+	b.varint(uint64(len(s)))
+	b.data = append(b.data, s...)
+}
+
+// packed encodes a repeated integer field.
+func (b *buffer) packed(tag int, xs []int64) {
+	var p buffer
+	for _, x := range xs {
+		p.varint(uint64(x))
+	}
+	b.key(tag, wireBytes)
+	b.varint(uint64(len(p.data)))
+	b.data = append(b.data, p.data...)
+}
+
+// message encodes an embedded message field, whose fields are encoded by f.
+func (b *buffer) message(tag int, f func(*buffer)) {
+	var m buffer
+	f(&m)
+	b.key(tag, wireBytes)
+	b.varint(uint64(len(m.data)))
+	b.data = append(b.data, m.data...)
+}
@@ -0,0 +1,590 @@
+package interp
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// racer detects data races between interpreted goroutines, that is accesses
+// to a same variable, one of them at least being a write, which are not
+// ordered by a happens-before relation. The relation is tracked with vector
+// clocks, from go statements, channel operations and calls to sync
+// primitives, so a race is detected whatever the goroutines scheduling.
+type racer struct {
+	mutex    sync.Mutex
+	lastID   int                    // last allocated goroutine id
+	routines map[int64]*raceRoutine // goroutines running interpreted code, by runtime id
+	objs     map[uintptr]vclock     // clocks of channels and sync objects, by address
+	bin      vclock                 // clock released by calls to binary code
+	reported map[[2]*node]bool      // reported pairs of accessing nodes
+	report   func(string)           // called with the report of each race
+}
+
+// vclock is a vector clock, indexed by goroutine id.
+type vclock []uint64
+
+// raceRoutine stores the race detection state of a goroutine.
+type raceRoutine struct {
+	id      int
+	goid    int64  // runtime goroutine id, or 0 if not known yet
+	clock   vclock // clock of the goroutine, clock[id] is its current epoch
+	node    *node  // go statement which created the goroutine, or nil
+	created *frame // frame executing node
+}
+
+// frameRace stores the race detection state of a frame.
+type frameRace struct {
+	routine *raceRoutine
+	caller  *frame                      // calling frame, or nil
+	entry   bool                        // frame is the entry point of routine
+	vars    map[int]map[string]*raceVar // accesses to the frame variables, by index and field path
+}
+
+// raceAccess is an access to a variable by node n in frame f.
+type raceAccess struct {
+	routine *raceRoutine
+	epoch   uint64 // epoch of routine at the time of access
+	write   bool
+	n       *node
+	f       *frame
+}
+
+// raceLoc is a variable or one of its fields, designated by a field path
+// such as ".a.b", or by an empty path for the whole variable.
+type raceLoc struct {
+	v    *node // identifier of the variable
+	path string
+}
+
+// raceVar stores the accesses to a location which can race with a next one.
+type raceVar struct {
+	write *raceAccess  // last write, or nil
+	reads []raceAccess // reads since the last write, one per goroutine
+}
+
+// raceChan is a channel operand of a node.
+type raceChan struct {
+	value func(*frame) reflect.Value
+	send  bool // send or close operation, receive otherwise
+}
+
+// Synchronization of sync methods, as a release before the call and an
+// acquire after it.
+const (
+	raceRelease = 1 << iota
+	raceAcquire
+)
+
+var raceSyncMethods = map[reflect.Type]map[string]int{
+	reflect.TypeOf(sync.Mutex{}): {"Lock": raceAcquire, "TryLock": raceAcquire, "Unlock": raceRelease},
+	reflect.TypeOf(sync.RWMutex{}): {
+		"Lock": raceAcquire, "RLock": raceAcquire, "TryLock": raceAcquire, "TryRLock": raceAcquire,
+		"Unlock": raceRelease, "RUnlock": raceRelease,
+	},
+	reflect.TypeOf(sync.WaitGroup{}): {"Add": raceRelease, "Done": raceRelease, "Wait": raceAcquire},
+	reflect.TypeOf(sync.Once{}):      {"Do": raceRelease | raceAcquire},
+	reflect.TypeOf(sync.Cond{}):      {"Broadcast": raceRelease, "Signal": raceRelease, "Wait": raceAcquire},
+}
+
+func newRacer(report func(string)) *racer {
+	return &racer{
+		routines: map[int64]*raceRoutine{},
+		objs:     map[uintptr]vclock{},
+		reported: map[[2]*node]bool{},
+		report:   report,
+	}
+}
+
+// get returns the epoch of goroutine id in c.
+func (c vclock) get(id int) uint64 {
+	if id < len(c) {
+		return c[id]
+	}
+	return 0
+}
+
+// join returns c updated with the epochs of d which are greater.
+func (c vclock) join(d vclock) vclock {
+	for len(c) < len(d) {
+		c = append(c, 0)
+	}
+	for i, e := range d {
+		if e > c[i] {
+			c[i] = e
+		}
+	}
+	return c
+}
+
+// newRoutine returns a new goroutine, created by node n in frame f of
+// goroutine parent, or nil. It must be called with r.mutex held.
+func (r *racer) newRoutine(parent *raceRoutine, n *node, f *frame) *raceRoutine {
+	r.lastID++
+	rt := &raceRoutine{id: r.lastID, node: n, created: f}
+	if parent != nil {
+		rt.clock = rt.clock.join(parent.clock)
+		parent.clock[parent.id]++
+	}
+	for len(rt.clock) <= rt.id {
+		rt.clock = append(rt.clock, 0)
+	}
+	rt.clock[rt.id] = 1
+	return rt
+}
+
+// enter sets the race detection state of frame f, called from frame caller
+// by node call, or started in a new goroutine. A frame without caller is
+// entered from binary code, in the goroutine calling it if it runs
+// interpreted code, or else in a new goroutine ordered after the calls to
+// binary code.
+func (r *racer) enter(f, caller *frame, call *node, goroutine bool) {
+	var cr *frameRace
+	if caller != nil {
+		cr = caller.race
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	switch {
+	case cr == nil:
+		goid := goroutineID()
+		if rt := r.routines[goid]; rt != nil {
+			f.race = &frameRace{routine: rt}
+			return
+		}
+		rt := r.newRoutine(nil, nil, nil)
+		rt.clock = rt.clock.join(r.bin)
+		rt.goid = goid
+		r.routines[goid] = rt
+		f.race = &frameRace{routine: rt, entry: true}
+	case goroutine:
+		f.race = &frameRace{routine: r.newRoutine(cr.routine, call, caller), entry: true}
+	default:
+		f.race = &frameRace{routine: cr.routine, caller: caller}
+	}
+}
+
+// bind records that routine rt runs in the current goroutine, so it is
+// found by frames entered from binary code.
+func (r *racer) bind(rt *raceRoutine) {
+	goid := goroutineID()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if rt.goid != 0 && r.routines[rt.goid] == rt {
+		delete(r.routines, rt.goid)
+	}
+	rt.goid = goid
+	r.routines[goid] = rt
+}
+
+// exit removes routine rt once completed.
+func (r *racer) exit(rt *raceRoutine) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.routines[rt.goid] == rt {
+		delete(r.routines, rt.goid)
+	}
+}
+
+// goroutineID returns the runtime id of the current goroutine.
+func goroutineID() int64 {
+	b := make([]byte, 64)
+	b = bytes.TrimPrefix(b[:runtime.Stack(b, false)], []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseInt(string(b), 10, 64)
+	return id
+}
+
+// wrap instruments the exec function of node n, to record its accesses to
+// variables and its synchronizations.
+func (r *racer) wrap(n *node) {
+	exec := n.exec
+	if exec == nil {
+		return
+	}
+	reads, writes := raceVars(n)
+	chans := raceChans(n)
+	obj, op := raceSync(n)
+	bin := isBinCall(n)
+	if len(reads) == 0 && len(writes) == 0 && len(chans) == 0 && !bin && n.kind != deferStmt {
+		return
+	}
+
+	n.exec = func(f *frame) bltn {
+		fr := f.race
+		if fr == nil {
+			return exec(f)
+		}
+		rt := fr.routine
+		if rt.goid == 0 {
+			r.bind(rt)
+		}
+		for _, l := range reads {
+			r.access(rt, f, n, l, false)
+		}
+		for _, l := range writes {
+			r.access(rt, f, n, l, true)
+		}
+
+		var objs []uintptr
+		if len(chans) > 0 || bin {
+			objs = make([]uintptr, len(chans))
+			r.mutex.Lock()
+			for i, c := range chans {
+				ch := c.value(f)
+				if ch.Kind() != reflect.Chan || ch.IsNil() {
+					continue
+				}
+				// A receive from an unbuffered channel also happens before
+				// the completion of the send
+				objs[i] = ch.Pointer()
+				if c.send || ch.Cap() == 0 {
+					r.release(rt, objs[i])
+				}
+			}
+			if bin {
+				r.bin = r.bin.join(rt.clock)
+				if obj != nil && op&raceRelease != 0 {
+					r.release(rt, obj(f))
+				}
+				rt.clock[rt.id]++
+			}
+			r.mutex.Unlock()
+		}
+
+		next := exec(f)
+
+		if len(objs) > 0 || obj != nil && op&raceAcquire != 0 {
+			r.mutex.Lock()
+			for i, c := range chans {
+				if objs[i] != 0 && (!c.send || c.value(f).Cap() == 0) {
+					r.acquire(rt, objs[i])
+				}
+			}
+			if obj != nil && op&raceAcquire != 0 {
+				r.acquire(rt, obj(f))
+			}
+			r.mutex.Unlock()
+		}
+		return next
+	}
+}
+
+// release makes the past of routine rt happen before a next acquire of
+// object p. It must be called with r.mutex held.
+func (r *racer) release(rt *raceRoutine, p uintptr) {
+	if p == 0 {
+		return
+	}
+	r.objs[p] = r.objs[p].join(rt.clock)
+	rt.clock[rt.id]++
+}
+
+// acquire makes the releases of object p happen before the future of
+// routine rt. It must be called with r.mutex held.
+func (r *racer) acquire(rt *raceRoutine, p uintptr) {
+	if p == 0 {
+		return
+	}
+	rt.clock = rt.clock.join(r.objs[p])
+}
+
+// access records the access to location l by node n in frame f of routine
+// rt, and reports the previous accesses racing with it. Accesses to a
+// field race with accesses to the enclosing fields or variable.
+func (r *racer) access(rt *raceRoutine, f *frame, n *node, l raceLoc, write bool) {
+	v, vf := l.v, f
+	if v.sym.global {
+		vf = n.interp.frame
+	} else {
+		for level := v.level; level > 0 && vf != nil; level-- {
+			vf = vf.anc
+		}
+	}
+	if vf == nil || vf.race == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	cur := raceAccess{routine: rt, epoch: rt.clock[rt.id], write: write, n: n, f: f}
+	fields := vf.race.variable(v.sym.index)
+	for path, vr := range fields {
+		if !racePathOverlap(path, l.path) {
+			continue
+		}
+		if w := vr.write; w != nil && !rt.after(w) {
+			r.race(v.ident+path, cur, *w)
+		}
+		if !write {
+			continue
+		}
+		for _, a := range vr.reads {
+			if !rt.after(&a) {
+				r.race(v.ident+path, cur, a)
+			}
+		}
+	}
+
+	vr := fields[l.path]
+	if vr == nil {
+		vr = &raceVar{}
+		fields[l.path] = vr
+	}
+	if write {
+		vr.write, vr.reads = &cur, nil
+		return
+	}
+	for i, a := range vr.reads {
+		if a.routine == rt {
+			vr.reads[i] = cur
+			return
+		}
+	}
+	vr.reads = append(vr.reads, cur)
+}
+
+// racePathOverlap returns true if field paths p and q designate a same
+// field or enclosing fields.
+func racePathOverlap(p, q string) bool {
+	if len(p) > len(q) {
+		p, q = q, p
+	}
+	return p == q || strings.HasPrefix(q, p+".")
+}
+
+// variable returns the accesses to the variable at index i of the frame,
+// by field path. It must be called with racer.mutex held.
+func (fr *frameRace) variable(i int) map[string]*raceVar {
+	if fr.vars == nil {
+		fr.vars = map[int]map[string]*raceVar{}
+	}
+	fields := fr.vars[i]
+	if fields == nil {
+		fields = map[string]*raceVar{}
+		fr.vars[i] = fields
+	}
+	return fields
+}
+
+// after returns true if access a happens before the current epoch of rt.
+func (rt *raceRoutine) after(a *raceAccess) bool {
+	return a.routine == rt || a.epoch <= rt.clock.get(a.routine.id)
+}
+
+// race reports a race on location name between access cur and a previous
+// one, once per pair of accessing nodes. It must be called with r.mutex held.
+func (r *racer) race(name string, cur, prev raceAccess) {
+	key := [2]*node{cur.n, prev.n}
+	if r.reported[key] {
+		return
+	}
+	r.reported[key] = true
+
+	var b strings.Builder
+	b.WriteString("==================\nWARNING: DATA RACE\n")
+	op, prevOp := "Read", "read"
+	if cur.write {
+		op = "Write"
+	}
+	if prev.write {
+		prevOp = "write"
+	}
+	fmt.Fprintf(&b, "%s of %s by %s:\n%s\n", op, name, cur.routine, formatStack(raceStack(cur.n, cur.f)))
+	fmt.Fprintf(&b, "Previous %s of %s by %s:\n%s", prevOp, name, prev.routine, formatStack(raceStack(prev.n, prev.f)))
+	for _, rt := range []*raceRoutine{cur.routine, prev.routine} {
+		if rt.node != nil {
+			fmt.Fprintf(&b, "\nGoroutine %d created at:\n%s", rt.id, formatStack(raceStack(rt.node, rt.created)))
+		}
+	}
+	b.WriteString("==================\n")
+	r.report(b.String())
+}
+
+func (rt *raceRoutine) String() string {
+	if rt.id == 1 {
+		return "main goroutine"
+	}
+	return "goroutine " + strconv.Itoa(rt.id)
+}
+
+// raceStack returns the interpreted frames of the goroutine executing node
+// n in frame f, innermost first.
+func raceStack(n *node, f *frame) []StackFrame {
+	var stack []StackFrame
+	for n != nil && f != nil && f.race != nil {
+		def := n
+		for def != nil && def.kind != funcDecl && def.kind != funcLit {
+			def = def.anc
+		}
+		stack = append(stack, StackFrame{Function: funcName(def, n), Pos: n.interp.fset.Position(n.pos)})
+		n, f = f.caller, f.race.caller
+	}
+	return stack
+}
+
+// raceVars returns the locations read and written by node n. The fields
+// of a struct variable are distinct locations, the elements of an array
+// variable are part of the array location.
+func raceVars(n *node) (reads, writes []raceLoc) {
+	isVar := func(c *node) bool {
+		return c.kind == identExpr && c.sym != nil && c.sym.kind == varSym && c.sym.index >= 0
+	}
+	// loc returns the location of a variable, or of its field or element c
+	loc := func(c *node) (raceLoc, bool) {
+		var path string
+		for c.kind == selectorExpr || c.kind == indexExpr {
+			x := c.child[0]
+			if x.typ == nil || x.typ.TypeOf() == nil {
+				return raceLoc{}, false
+			}
+			switch k := x.typ.TypeOf().Kind(); {
+			case k == reflect.Struct && c.kind == selectorExpr:
+				path = "." + c.child[1].ident + path
+			case k == reflect.Array:
+				path = ""
+			default:
+				return raceLoc{}, false
+			}
+			c = x
+		}
+		return raceLoc{v: c, path: path}, isVar(c)
+	}
+
+	var lhs []*node
+	children := n.child
+	switch n.kind {
+	case assignStmt, assignXStmt:
+		lhs, children = n.child[:n.nleft], n.child[n.nleft:]
+	case defineStmt, defineXStmt:
+		// Defined variables are new, only the right hand side is read
+		children = n.child[n.nleft:]
+	case incDecStmt:
+		lhs, children = n.child, nil
+	case rangeStmt:
+		if len(n.child) < 2 {
+			return nil, nil
+		}
+		children = n.child[len(n.child)-2 : len(n.child)-1]
+	case selectorExpr:
+		if isAssignTarget(n) {
+			// The field is written by the assignment
+			return nil, nil
+		}
+		if l, ok := loc(n); ok {
+			return []raceLoc{l}, nil
+		}
+	case identExpr:
+		children = []*node{n}
+	case funcDecl, funcLit, funcType:
+		return nil, nil
+	}
+	if n.action == aAddr {
+		return nil, nil
+	}
+
+	for _, c := range lhs {
+		if l, ok := loc(c); ok {
+			writes = append(writes, l)
+			continue
+		}
+		// Write through a pointer, slice or map, read its variable
+		for ; c.kind == selectorExpr || c.kind == indexExpr || c.kind == starExpr; c = c.child[0] {
+			if c.kind == indexExpr && isVar(c.child[1]) {
+				reads = append(reads, raceLoc{v: c.child[1]})
+			}
+		}
+		if isVar(c) {
+			reads = append(reads, raceLoc{v: c})
+		}
+	}
+	for _, c := range children {
+		if isVar(c) {
+			reads = append(reads, raceLoc{v: c})
+		}
+	}
+	return reads, writes
+}
+
+// isAssignTarget returns true if n is assigned by its ancestor node.
+func isAssignTarget(n *node) bool {
+	a := n.anc
+	switch a.kind {
+	case assignStmt, assignXStmt, defineStmt, defineXStmt:
+		for _, c := range a.child[:a.nleft] {
+			if c == n {
+				return true
+			}
+		}
+	case incDecStmt:
+		return a.child[0] == n
+	}
+	return false
+}
+
+// raceChans returns the channel operands of node n.
+func raceChans(n *node) []raceChan {
+	switch {
+	case n.kind == sendStmt:
+		return []raceChan{{genValue(n.child[0]), true}}
+	case n.action == aRecv:
+		return []raceChan{{genValue(n.child[0]), false}}
+	case isBuiltinCall(n) && n.child[0].ident == "close" && len(n.child) == 2:
+		return []raceChan{{genValue(n.child[1]), true}}
+	case n.kind == rangeStmt && len(n.child) >= 2:
+		if c := n.child[len(n.child)-2]; c.typ != nil && c.typ.TypeOf() != nil && c.typ.TypeOf().Kind() == reflect.Chan {
+			return []raceChan{{genValue(c), false}}
+		}
+	case n.kind == blockStmt && n.anc != nil && n.anc.kind == selectStmt:
+		var chans []raceChan
+		for _, c := range n.child {
+			if ops := commOperands(c); len(ops) > 0 {
+				chans = append(chans, raceChan{genValue(ops[0]), len(ops) == 2})
+			}
+		}
+		return chans
+	}
+	return nil
+}
+
+// raceSync returns the address of the sync object of a call to one of its
+// methods by node n, and the synchronization of the method.
+func raceSync(n *node) (obj func(*frame) uintptr, op int) {
+	if !isBinCall(n) || n.child[0].kind != selectorExpr {
+		return nil, 0
+	}
+	recv := n.child[0].child[0]
+	switch recv.kind {
+	case identExpr, selectorExpr:
+	default:
+		// The receiver is only evaluated once, by the call
+		return nil, 0
+	}
+	if recv.typ == nil || recv.typ.TypeOf() == nil {
+		return nil, 0
+	}
+	t := recv.typ.TypeOf()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if op = raceSyncMethods[t][n.child[0].child[1].ident]; op == 0 {
+		return nil, 0
+	}
+	value := genValue(recv)
+	return func(f *frame) uintptr {
+		switch v := value(f); {
+		case v.Kind() == reflect.Ptr:
+			return v.Pointer()
+		case v.CanAddr():
+			return v.Addr().Pointer()
+		}
+		return 0
+	}, op
+}
@@ -0,0 +1,222 @@
+package interp
+
+import (
+	"reflect"
+	"sort"
+)
+
+// reflectType is the reflection type of a defined struct type of the
+// interpreter, or of a pointer to it, as returned by Interpreter.TypeOf.
+// It completes the underlying reflection type, which has no name and no
+// methods, with the name and the methods of the interpreted type.
+type reflectType struct {
+	reflect.Type
+	interp  *Interpreter
+	typ     *itype
+	ptr     bool
+	methods []reflect.Method
+}
+
+// TypeOf returns the reflection type of v. If v is a value of a defined
+// struct type of the interpreter, or a pointer to it, the returned type has
+// the name, package path and methods of the interpreted type, and its
+// methods can be called through Method(i).Func, with the receiver as first
+// argument. Otherwise, it returns reflect.TypeOf(v).
+//
+// The returned types are identical for values of the same type, and can be
+// used as map keys. Note that reflect.ValueOf(v).Method still ignores the
+// methods of interpreted types.
+func (interp *Interpreter) TypeOf(v interface{}) reflect.Type {
+	rt := reflect.TypeOf(v)
+	if rt == nil {
+		return nil
+	}
+
+	interp.tmutex.Lock()
+	defer interp.tmutex.Unlock()
+
+	if t, ok := interp.rtypes[rt]; ok {
+		return t
+	}
+	st, ptr := rt, false
+	if st.Kind() == reflect.Ptr {
+		st, ptr = st.Elem(), true
+	}
+	t := interp.lookupStructType(st)
+	if t == nil {
+		return rt
+	}
+
+	res := &reflectType{Type: rt, interp: interp, typ: t, ptr: ptr}
+	var names []string
+	for _, m := range t.method {
+		if !ptr && defRecvType(m).cat == ptrT {
+			// Methods with a pointer receiver are not in the method set of a value
+			continue
+		}
+		names = append(names, m.ident)
+	}
+	sort.Strings(names)
+	for i, name := range names {
+		res.methods = append(res.methods, interp.reflectMethod(res, t.getMethod(name), i))
+	}
+
+	if interp.rtypes == nil {
+		interp.rtypes = map[reflect.Type]*reflectType{}
+	}
+	interp.rtypes[rt] = res
+	return res
+}
+
+// lookupStructType returns the defined interpreter type whose reflection
+// type is rt, or nil if not found.
+func (interp *Interpreter) lookupStructType(rt reflect.Type) *itype {
+	if rt.Kind() != reflect.Struct || rt.NumField() == 0 || rt.Field(0).Tag.Get(typeNameTag) == "" {
+		return nil
+	}
+	for _, sc := range interp.scopes {
+		for _, sym := range sc.sym {
+			if sym.kind == typeSym && sym.typ != nil && sym.typ.cat == structT && sym.typ.rtype == rt {
+				return sym.typ
+			}
+		}
+	}
+	return nil
+}
+
+// reflectMethod returns the reflection method of index i of type rt, for
+// the interpreted method definition m, callable from the runtime.
+func (interp *Interpreter) reflectMethod(rt *reflectType, m *node, i int) reflect.Method {
+	mt := m.typ.TypeOf()
+	in := []reflect.Type{rt.Type}
+	for j := 0; j < mt.NumIn(); j++ {
+		in = append(in, mt.In(j))
+	}
+	out := make([]reflect.Type, mt.NumOut())
+	for j := range out {
+		out[j] = mt.Out(j)
+	}
+	ft := reflect.FuncOf(in, out, mt.IsVariadic())
+
+	recvType := rt.typ
+	if rt.ptr {
+		recvType = &itype{cat: ptrT, val: rt.typ}
+	}
+	fun := reflect.MakeFunc(ft, func(args []reflect.Value) []reflect.Value {
+		// The receiver is given by a value node, as in genInterfaceWrapper
+		nod := *m
+		nod.recv = &receiver{node: &node{kind: rvalueExpr, rval: args[0], typ: recvType, interp: interp}}
+		f := genFunctionWrapper(&nod)(interp.frame)
+		if mt.IsVariadic() {
+			return f.CallSlice(args[1:])
+		}
+		return f.Call(args[1:])
+	})
+	return reflect.Method{Name: m.ident, Type: ft, Func: fun, Index: i}
+}
+
+// Name returns the name of the interpreted type, or an empty string for a pointer.
+func (t *reflectType) Name() string {
+	if t.ptr {
+		return ""
+	}
+	return t.typ.name
+}
+
+// PkgPath returns the package path of the interpreted type, or an empty string for a pointer.
+func (t *reflectType) PkgPath() string {
+	if t.ptr {
+		return ""
+	}
+	return t.typ.pkgPath
+}
+
+// String returns the qualified name of the interpreted type.
+func (t *reflectType) String() string {
+	s := t.typ.id()
+	if t.ptr {
+		return "*" + s
+	}
+	return s
+}
+
+// Elem returns the element type of a pointer to the interpreted type.
+func (t *reflectType) Elem() reflect.Type {
+	if t.ptr {
+		return t.interp.TypeOf(reflect.Zero(t.Type.Elem()).Interface())
+	}
+	return t.Type.Elem()
+}
+
+// NumMethod returns the number of methods in the method set of the interpreted type.
+func (t *reflectType) NumMethod() int { return len(t.methods) }
+
+// Method returns the i'th method of the interpreted type, in sorted order.
+func (t *reflectType) Method(i int) reflect.Method { return t.methods[i] }
+
+// MethodByName returns the method of the interpreted type with the given name.
+func (t *reflectType) MethodByName(name string) (reflect.Method, bool) {
+	for _, m := range t.methods {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return reflect.Method{}, false
+}
+
+// NumField returns the number of fields of the interpreted struct type.
+func (t *reflectType) NumField() int {
+	if isEmptyStruct(t.Type) {
+		return 0
+	}
+	return t.Type.NumField()
+}
+
+// Field returns the i'th field of the interpreted struct type, with the tag
+// of its declaration.
+func (t *reflectType) Field(i int) reflect.StructField {
+	if isEmptyStruct(t.Type) {
+		panic("reflect: Field index out of bounds")
+	}
+	return userField(t.Type.Field(i))
+}
+
+// FieldByIndex returns the nested field of the interpreted struct type
+// corresponding to index, with the tag of its declaration.
+func (t *reflectType) FieldByIndex(index []int) reflect.StructField {
+	return userField(t.Type.FieldByIndex(index))
+}
+
+// FieldByName returns the field of the interpreted struct type with the
+// given name, with the tag of its declaration.
+func (t *reflectType) FieldByName(name string) (reflect.StructField, bool) {
+	f, ok := t.Type.FieldByName(name)
+	return userField(f), ok
+}
+
+// FieldByNameFunc returns the field of the interpreted struct type with a
+// name satisfying match, with the tag of its declaration.
+func (t *reflectType) FieldByNameFunc(match func(string) bool) (reflect.StructField, bool) {
+	f, ok := t.Type.FieldByNameFunc(match)
+	return userField(f), ok
+}
+
+// userField returns the struct field f with the tag of its declaration,
+// without the name of its defined type recorded by the interpreter.
+func userField(f reflect.StructField) reflect.StructField {
+	f.Tag = reflect.StructTag(untaggedName(f.Tag))
+	return f
+}
+
+// userFields returns v, a reflect.StructField or a slice of them, with
+// the tags of their declaration.
+func userFields(v reflect.Value) reflect.Value {
+	if v.Kind() != reflect.Slice {
+		return reflect.ValueOf(userField(v.Interface().(reflect.StructField)))
+	}
+	fields := make([]reflect.StructField, v.Len())
+	for i := range fields {
+		fields[i] = userField(v.Index(i).Interface().(reflect.StructField))
+	}
+	return reflect.ValueOf(fields)
+}
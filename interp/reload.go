@@ -0,0 +1,156 @@
+package interp
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"reflect"
+)
+
+// ReloadPath re-evaluates the changed source of a package, given by the path
+// of its directory or of one of its files, in the live interpreter.
+//
+// If path is in the directory of an imported source package, the whole
+// package is reloaded. Otherwise path is a file, as given to EvalPath, which
+// is evaluated as the complete source of its package.
+//
+// The functions and methods whose signature is unchanged are rebound in
+// place, so they are reloaded for the interpreted code calling them and
+// for the host functions obtained by GetFunc or Symbols. The package level
+// variables whose type is unchanged keep their value, others are set by
+// their new initializer. Functions init and main are not run again. Once
+// the package is reloaded, the function set by Options.OnReload is called.
+//
+// ReloadPath waits for the running evaluation to return, but must not be
+// called while functions or goroutines of the interpreted code are running.
+func (interp *Interpreter) ReloadPath(path string) error {
+	if err := interp.lock(); err != nil {
+		return err
+	}
+	defer interp.unlock()
+	info, err := fs.Stat(interp.filesystem, path)
+	if err != nil {
+		return err
+	}
+	dir := path
+	if !info.IsDir() {
+		dir = filepath.Dir(path)
+	}
+
+	if d, ok := interp.srcDirs[filepath.Clean(dir)]; ok {
+		return interp.reload(d.path, d.name, func() error {
+			// The package is compiled in the scope of its name, then
+			// renamed to its alias, which may hide another package
+			prev, hidden := interp.scopes[d.pkgName]
+			if d.pkgName != d.name {
+				interp.scopes[d.pkgName] = interp.scopes[d.name]
+			}
+			_, err := interp.loadSrcDir(dir, d.rPath, d.name, loadReload)
+			if hidden && d.pkgName != d.name {
+				interp.scopes[d.pkgName] = prev
+			}
+			return err
+		})
+	}
+	if info.IsDir() {
+		return fmt.Errorf("package in %s is not loaded", path)
+	}
+
+	b, err := fs.ReadFile(interp.filesystem, path)
+	if err != nil {
+		return err
+	}
+	pkgName := mainID
+	if f, err := parser.ParseFile(token.NewFileSet(), path, b, parser.PackageClauseOnly); err == nil {
+		pkgName = f.Name.Name
+	}
+	return interp.reload(pkgName, pkgName, func() error {
+		interp.Name = path
+		_, p, err := interp.compile(string(b))
+		if err != nil {
+			return err
+		}
+		// Run the global initializers only
+		if _, err = interp.execute(&Program{root: p.root}); err != nil {
+			return err
+		}
+		// A snapshot replays the reloaded source in place of the previous one
+		for k, src := range interp.sources {
+			if src.Name == path {
+				interp.sources[k].Src = string(b)
+			}
+		}
+		return nil
+	})
+}
+
+// reload calls load to compile and run the package of pkgPath in a new
+// scope name, then rebinds the functions and variables of the package
+// previous scope.
+func (interp *Interpreter) reload(pkgPath, name string, load func() error) error {
+	old := interp.scopes[name]
+	if old == nil {
+		old = &scope{sym: map[string]*symbol{}}
+	}
+
+	// Save the values of variables, as the frame may be resized
+	values := map[string]reflect.Value{}
+	for n, sym := range old.sym {
+		if sym.kind == varSym && sym.index >= 0 && sym.index < len(interp.frame.data) {
+			v := interp.frame.data[sym.index]
+			values[n] = reflect.New(v.Type()).Elem()
+			values[n].Set(v)
+		}
+	}
+
+	interp.scopes[name] = interp.universe.pushBloc()
+	if err := load(); err != nil {
+		interp.scopes[name] = old
+		return err
+	}
+
+	for n, sym := range interp.scopes[name].sym {
+		o, ok := old.sym[n]
+		if !ok || o.kind != sym.kind || o.typ == nil || sym.typ == nil {
+			continue
+		}
+		switch sym.kind {
+		case varSym:
+			if v, ok := values[n]; ok && sym.index < len(interp.frame.data) && v.Type() == interp.frame.data[sym.index].Type() {
+				interp.frame.data[sym.index].Set(v)
+			}
+		case funcSym:
+			rebind(o.node, sym.node)
+		case typeSym:
+			if o.typ.cat == genericT || sym.typ.cat == genericT || o.typ.TypeOf() != sym.typ.TypeOf() {
+				continue
+			}
+			for _, m := range sym.typ.method {
+				for _, om := range o.typ.method {
+					if om.ident == m.ident {
+						rebind(om, m)
+					}
+				}
+			}
+		}
+	}
+
+	if interp.onReload != nil {
+		interp.onReload(pkgPath, values)
+	}
+	return nil
+}
+
+// rebind replaces in place the definition of function old by the one of
+// function def, if their signatures are identical.
+func rebind(old, def *node) {
+	if old == nil || def == nil || old == def || old.typ == nil || def.typ == nil {
+		return
+	}
+	if old.typ.cat != funcT || def.typ.cat != funcT || old.typ.TypeOf() != def.typ.TypeOf() {
+		return
+	}
+	*old = *def
+}
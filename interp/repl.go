@@ -0,0 +1,717 @@
+package interp
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Repl performs a Read-Eval-Print-Loop on input file descriptor.
+// Results are printed on output. If input and output are terminals, lines
+// are edited interactively, with a history persisted in $HOME/.yaegi_history,
+// and completion of package names, identifiers and fields on tab.
+func (interp *Interpreter) Repl(in, out *os.File) {
+	_ = interp.repl(context.Background(), newLineReader(in, out, interp.Complete), out, REPLOptions{})
+}
+
+// REPLOptions are the options of an interactive session run by REPL.
+type REPLOptions struct {
+	Prompt         string // prompt of a new input, "> " if empty
+	ContinuePrompt string // prompt of the next lines of an incomplete input, "... " if empty
+	NoPrompt       bool   // do not display prompts
+
+	// Format formats the results of evaluations. If nil, the function set
+	// by SetPrinter is used.
+	Format func(reflect.Value) string
+
+	// Interrupt, if not nil, receives the interruptions of the session, as
+	// on Ctrl-C: the pending input is discarded, or the running evaluation
+	// is stopped.
+	Interrupt <-chan struct{}
+}
+
+// REPL performs a Read-Eval-Print-Loop on the input stream in, as Repl, for
+// applications embedding an interactive session, over a network connection
+// for example. Prompts, results and errors are written on out, the output of
+// interpreted code on the interpreter Stdout and Stderr. Lines are read
+// as is, without editing. Lines starting with a colon are meta-commands,
+// listed by ":help", such as ":doc name" printing the documentation of a
+// package or symbol, as returned by Doc. REPL returns nil at the end of in,
+// or ctx.Err() if ctx is done, in which case the running evaluation is
+// stopped. Input is read by a goroutine, which returns once the pending read
+// returns.
+func (interp *Interpreter) REPL(ctx context.Context, in io.Reader, out io.Writer, opt REPLOptions) error {
+	r := &streamReader{ctx: ctx, out: out, noPrompt: opt.NoPrompt, interrupt: opt.Interrupt, lines: make(chan string)}
+	go r.read(in)
+	return interp.repl(ctx, r, out, opt)
+}
+
+// repl runs the Read-Eval-Print-Loop of Repl and REPL, on lines read by r.
+func (interp *Interpreter) repl(ctx context.Context, r lineReader, out io.Writer, opt REPLOptions) error {
+	prompt, cont := opt.Prompt, opt.ContinuePrompt
+	if prompt == "" {
+		prompt = "> "
+	}
+	if cont == "" {
+		cont = "... "
+	}
+	src := ""
+	for {
+		p := prompt
+		if src != "" {
+			p = cont
+		}
+		line, err := r.readLine(p)
+		if err == errInterrupt {
+			src = ""
+			continue
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if src == "" && isCommand(line) {
+			if err := interp.command(ctx, out, opt, line); err != nil {
+				return err
+			}
+			continue
+		}
+		src += line + "\n"
+		if incomplete(src) {
+			// Brackets are not balanced yet, get one more line
+			continue
+		}
+		res, err := interp.replEval(ctx, src, opt.Interrupt)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if e, ok := err.(*Error); ok && e.Phase <= ParsePhase {
+			// Early failure in the parser: the source is incomplete
+			// and no AST could be produced, neither compiled / run.
+			// Get one more line, and retry
+			continue
+		}
+		interp.printEval(out, opt, res, err)
+		src = ""
+	}
+}
+
+// printDoc prints on out the declaration and the doc comment of the package
+// or symbol name, as returned by Doc, with the doc comment indented.
+func (interp *Interpreter) printDoc(out io.Writer, name string) {
+	s, err := interp.Doc(name)
+	if err != nil {
+		fmt.Fprintln(out, err)
+		return
+	}
+	fmt.Fprintln(out, s.Signature)
+	if s.Doc != "" {
+		fmt.Fprint(out, "    "+strings.Replace(strings.TrimSuffix(s.Doc, "\n"), "\n", "\n    ", -1)+"\n")
+	}
+}
+
+// replEval evaluates src as EvalMulti. The evaluation is stopped if ctx
+// is done, or if interrupt receives, in which case errInterrupt is returned.
+func (interp *Interpreter) replEval(ctx context.Context, src string, interrupt <-chan struct{}) ([]reflect.Value, error) {
+	if ctx.Done() == nil && interrupt == nil {
+		return interp.replEvalMulti(src)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	interrupted := make(chan bool, 1)
+	go func() {
+		select {
+		case <-interrupt:
+			interrupted <- true
+			cancel()
+		case <-ctx.Done():
+			interrupted <- false
+		}
+	}()
+
+	res, err := interp.runWithContext(ctx, func() ([]reflect.Value, error) { return interp.replEvalMulti(src) })
+	cancel()
+	if <-interrupted {
+		return nil, errInterrupt
+	}
+	return res, err
+}
+
+// incomplete returns true if src contains unbalanced opening brackets.
+func incomplete(src string) bool {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	s.Init(fset.AddFile("", fset.Base(), len(src)), []byte(src), nil, 0)
+	depth := 0
+	for {
+		_, tok, _ := s.Scan()
+		switch tok {
+		case token.EOF:
+			return depth > 0
+		case token.LBRACE, token.LPAREN, token.LBRACK:
+			depth++
+		case token.RBRACE, token.RPAREN, token.RBRACK:
+			depth--
+		}
+	}
+}
+
+// errInterrupt is returned when the input of a line is interrupted.
+var errInterrupt = errors.New("interrupt")
+
+// lineReader reads input lines, after displaying a prompt.
+type lineReader interface {
+	readLine(prompt string) (string, error)
+}
+
+// newLineReader returns a line editor if in and out are terminals, or
+// a plain line reader otherwise.
+func newLineReader(in, out *os.File, complete func(string) (int, []string)) lineReader {
+	if isTerminal(in) && isTerminal(out) {
+		e := &lineEditor{in: in, r: bufio.NewReader(in), out: out, complete: complete}
+		if home := os.Getenv("HOME"); home != "" {
+			e.historyFile = filepath.Join(home, ".yaegi_history")
+			e.loadHistory()
+		}
+		return e
+	}
+	stat, err := in.Stat()
+	return &scanReader{s: bufio.NewScanner(in), out: out, prompt: err == nil && stat.Mode()&os.ModeCharDevice != 0}
+}
+
+// scanReader reads lines with a scanner. The prompt is displayed only if
+// input is a terminal.
+type scanReader struct {
+	s      *bufio.Scanner
+	out    io.Writer
+	prompt bool
+}
+
+func (r *scanReader) readLine(prompt string) (string, error) {
+	if r.prompt {
+		fmt.Fprint(r.out, prompt)
+	}
+	if !r.s.Scan() {
+		if err := r.s.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return r.s.Text(), nil
+}
+
+// replEvalMulti evaluates src as EvalMulti, in an evaluation already
+// serialized, but returns no result if src ends with a declaration, which
+// has no value to display.
+func (interp *Interpreter) replEvalMulti(src string) ([]reflect.Value, error) {
+	root, p, err := interp.compile(src)
+	if err != nil || interp.noRun {
+		return nil, err
+	}
+	res, err := interp.execute(p)
+	if root != nil && len(root.child) > 0 {
+		switch root.lastChild().kind {
+		case constDecl, funcDecl, importDecl, typeDecl, varDecl:
+			return nil, err
+		}
+	}
+	return res, err
+}
+
+// streamReader reads lines from an input stream for REPL. Reading is
+// interrupted when ctx is done, or when interrupt receives.
+type streamReader struct {
+	ctx       context.Context
+	out       io.Writer
+	noPrompt  bool
+	interrupt <-chan struct{}
+	lines     chan string
+	err       error // set when lines is closed
+}
+
+// read sends the lines of in to r.lines, until the end of in.
+func (r *streamReader) read(in io.Reader) {
+	br := bufio.NewReader(in)
+	for {
+		line, err := br.ReadString('\n')
+		if line != "" {
+			select {
+			case r.lines <- strings.TrimRight(line, "\r\n"):
+			case <-r.ctx.Done():
+				return
+			}
+		}
+		if err != nil {
+			r.err = err
+			close(r.lines)
+			return
+		}
+	}
+}
+
+func (r *streamReader) readLine(prompt string) (string, error) {
+	if !r.noPrompt {
+		fmt.Fprint(r.out, prompt)
+	}
+	select {
+	case line, ok := <-r.lines:
+		if !ok {
+			return "", r.err
+		}
+		return line, nil
+	case <-r.interrupt:
+		fmt.Fprintln(r.out)
+		return "", errInterrupt
+	case <-r.ctx.Done():
+		return "", r.ctx.Err()
+	}
+}
+
+// maxHistory is the maximum number of lines kept in history.
+const maxHistory = 1000
+
+// lineEditor reads lines from a terminal in raw mode, with emacs style
+// editing keys, history and completion.
+type lineEditor struct {
+	in          *os.File
+	r           *bufio.Reader
+	out         io.Writer
+	complete    func(string) (int, []string)
+	history     []string
+	historyFile string // file where history is persisted, or ""
+}
+
+func (e *lineEditor) loadHistory() {
+	b, err := ioutil.ReadFile(e.historyFile)
+	if err != nil {
+		return
+	}
+	e.history = strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	if len(e.history) > maxHistory {
+		e.history = e.history[len(e.history)-maxHistory:]
+	}
+}
+
+func (e *lineEditor) addHistory(line string) {
+	if strings.TrimSpace(line) == "" || len(e.history) > 0 && e.history[len(e.history)-1] == line {
+		return
+	}
+	e.history = append(e.history, line)
+	if e.historyFile == "" {
+		return
+	}
+	f, err := os.OpenFile(e.historyFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(f, line)
+	f.Close()
+}
+
+func (e *lineEditor) readLine(prompt string) (string, error) {
+	restore, err := makeRaw(e.in)
+	if err != nil {
+		return "", err
+	}
+	defer restore()
+
+	var buf []rune // line being edited
+	pos := 0       // cursor position in buf
+	h := len(e.history)
+	saved := "" // line being edited when browsing history
+
+	setLine := func(s string) {
+		buf = []rune(s)
+		pos = len(buf)
+	}
+	browse := func(i int) {
+		if i < 0 || i > len(e.history) {
+			return
+		}
+		if h == len(e.history) {
+			saved = string(buf)
+		}
+		h = i
+		if h == len(e.history) {
+			setLine(saved)
+		} else {
+			setLine(e.history[h])
+		}
+	}
+
+	fmt.Fprint(e.out, prompt)
+	for {
+		c, _, err := e.r.ReadRune()
+		if err != nil {
+			return "", err
+		}
+		switch c {
+		case '\r', '\n':
+			fmt.Fprint(e.out, "\r\n")
+			line := string(buf)
+			e.addHistory(line)
+			return line, nil
+		case 3: // Ctrl-C
+			fmt.Fprint(e.out, "^C\r\n")
+			return "", errInterrupt
+		case 4: // Ctrl-D
+			if len(buf) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				return "", io.EOF
+			}
+			if pos < len(buf) {
+				buf = append(buf[:pos], buf[pos+1:]...)
+			}
+		case 127, 8: // Backspace
+			if pos > 0 {
+				buf = append(buf[:pos-1], buf[pos:]...)
+				pos--
+			}
+		case 1: // Ctrl-A
+			pos = 0
+		case 5: // Ctrl-E
+			pos = len(buf)
+		case 2: // Ctrl-B
+			if pos > 0 {
+				pos--
+			}
+		case 6: // Ctrl-F
+			if pos < len(buf) {
+				pos++
+			}
+		case 11: // Ctrl-K
+			buf = buf[:pos]
+		case 21: // Ctrl-U
+			buf = buf[pos:]
+			pos = 0
+		case 16: // Ctrl-P
+			browse(h - 1)
+		case 14: // Ctrl-N
+			browse(h + 1)
+		case '\t':
+			if !e.completeLine(prompt, &buf, &pos) {
+				continue
+			}
+		case 27: // Escape sequence
+			switch e.escape() {
+			case 'A':
+				browse(h - 1)
+			case 'B':
+				browse(h + 1)
+			case 'C':
+				if pos < len(buf) {
+					pos++
+				}
+			case 'D':
+				if pos > 0 {
+					pos--
+				}
+			case 'H':
+				pos = 0
+			case 'F':
+				pos = len(buf)
+			case '~':
+				if pos < len(buf) {
+					buf = append(buf[:pos], buf[pos+1:]...)
+				}
+			}
+		default:
+			if !unicode.IsPrint(c) {
+				continue
+			}
+			buf = append(buf[:pos], append([]rune{c}, buf[pos:]...)...)
+			pos++
+		}
+		e.refresh(prompt, buf, pos)
+	}
+}
+
+// escape reads an escape sequence and returns its final character, with
+// Home, End and Delete keys mapped respectively to 'H', 'F' and '~'.
+func (e *lineEditor) escape() rune {
+	c, _, _ := e.r.ReadRune()
+	if c != '[' && c != 'O' {
+		return 0
+	}
+	c, _, _ = e.r.ReadRune()
+	if c < '0' || c > '9' {
+		return c
+	}
+	n := c
+	for c >= '0' && c <= '9' || c == ';' {
+		c, _, _ = e.r.ReadRune()
+	}
+	if c != '~' {
+		// Key with modifiers, such as "\x1b[1;5C"
+		return c
+	}
+	switch n {
+	case '1', '7':
+		return 'H'
+	case '4', '8':
+		return 'F'
+	case '3':
+		return '~'
+	}
+	return 0
+}
+
+// refresh redraws the line being edited, and positions the cursor.
+func (e *lineEditor) refresh(prompt string, buf []rune, pos int) {
+	fmt.Fprintf(e.out, "\r%s%s\x1b[K", prompt, string(buf))
+	if n := len(buf) - pos; n > 0 {
+		fmt.Fprintf(e.out, "\x1b[%dD", n)
+	}
+}
+
+// completeLine completes the word before the cursor. If there is nothing
+// to insert, candidates are listed, or a bell is rung. It returns false if
+// the line does not need to be redrawn.
+func (e *lineEditor) completeLine(prompt string, buf *[]rune, pos *int) bool {
+	line := string((*buf)[:*pos])
+	start, candidates := e.complete(line)
+	if len(candidates) == 0 {
+		fmt.Fprint(e.out, "\a")
+		return false
+	}
+	word := line[start:]
+	prefix := candidates[0]
+	for _, c := range candidates[1:] {
+		for !strings.HasPrefix(c, prefix) {
+			_, size := utf8.DecodeLastRuneInString(prefix)
+			prefix = prefix[:len(prefix)-size]
+		}
+	}
+	if len(prefix) > len(word) {
+		insert := []rune(prefix[len(word):])
+		*buf = append((*buf)[:*pos], append(insert, (*buf)[*pos:]...)...)
+		*pos += len(insert)
+		return true
+	}
+	if len(candidates) > 1 {
+		fmt.Fprintf(e.out, "\r\n%s\r\n", strings.Join(candidates, "  "))
+		fmt.Fprint(e.out, prompt)
+		return true
+	}
+	return false
+}
+
+// Complete returns the candidates completing the identifier, selector,
+// import path or meta-command which ends line, as on tab in the REPL, and the position in
+// line where the completed part starts.
+func (interp *Interpreter) Complete(line string) (int, []string) {
+	if i := strings.LastIndex(line, `"`); i >= 0 && strings.Count(line, `"`)%2 == 1 {
+		// Complete an import path
+		if !strings.HasPrefix(strings.TrimSpace(line), "import") {
+			return 0, nil
+		}
+		prefix := line[i+1:]
+		var names []string
+		for _, path := range interp.binPaths() {
+			if path != "" && strings.HasPrefix(path, prefix) && interp.allowedPkg(path) {
+				names = append(names, path)
+			}
+		}
+		sort.Strings(names)
+		if len(names) == 1 {
+			names[0] += `"`
+		}
+		return i + 1, names
+	}
+
+	if isCommand(line) && !strings.ContainsAny(strings.TrimSpace(line), " \t") {
+		// Complete a meta-command name
+		var names []string
+		for _, c := range replCommands {
+			names = append(names, strings.Fields(c.usage)[0])
+		}
+		start := strings.Index(line, ":")
+		return start, filterNames(names, line[start:])
+	}
+
+	start := len(line)
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(line[:start])
+		if r != '.' && r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		start -= size
+	}
+	expr := line[start:]
+	if r, _ := utf8.DecodeRuneInString(expr); unicode.IsDigit(r) {
+		return 0, nil
+	}
+
+	dot := strings.LastIndex(expr, ".")
+	if dot < 0 {
+		return start, filterNames(interp.scopeNames(), expr)
+	}
+	return start + dot + 1, filterNames(interp.selectorNames(strings.Split(expr[:dot], ".")), expr[dot+1:])
+}
+
+// filterNames returns the sorted unique names starting with prefix. Names
+// starting with an underscore are skipped, unless prefix does.
+func filterNames(names []string, prefix string) []string {
+	seen := map[string]bool{}
+	var res []string
+	for _, name := range names {
+		if seen[name] || !strings.HasPrefix(name, prefix) || name == "" || name[0] == '_' && !strings.HasPrefix(prefix, "_") {
+			continue
+		}
+		seen[name] = true
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// scopeNames returns the keywords and the identifiers visible at REPL level.
+func (interp *Interpreter) scopeNames() []string {
+	var names []string
+	for tok := token.BREAK; tok <= token.VAR; tok++ {
+		if tok.IsKeyword() {
+			names = append(names, tok.String())
+		}
+	}
+	for sc := interp.scopes[mainID]; sc != nil; sc = sc.anc {
+		for name := range sc.sym {
+			names = append(names, name)
+		}
+	}
+	for name := range interp.universe.sym {
+		names = append(names, name)
+	}
+	return names
+}
+
+// selectorNames returns the names which can be selected from the expression
+// formed by the selector path, such as the symbols of a package or the fields
+// and methods of a variable.
+func (interp *Interpreter) selectorNames(path []string) []string {
+	sc := interp.universe
+	if s, ok := interp.scopes[mainID]; ok {
+		sc = s
+	}
+	sym, _, ok := sc.lookup(path[0])
+	if !ok {
+		return nil
+	}
+
+	var names []string
+	if sym.kind == pkgSym {
+		if len(path) > 1 {
+			return nil
+		}
+		switch sym.typ.cat {
+		case binPkgT:
+			for name := range interp.binSymbols(sym.path) {
+				if interp.allowedSym(sym.path, name) {
+					names = append(names, name)
+				}
+			}
+		case srcPkgT:
+			if s, ok := interp.scopes[path[0]]; ok {
+				for name, ssym := range s.sym {
+					if canExport(name) && ssym.kind != pkgSym {
+						names = append(names, name)
+					}
+				}
+			}
+		}
+		return names
+	}
+
+	t := sym.typ
+	for _, name := range path[1:] {
+		if t = fieldType(t, name); t == nil {
+			return nil
+		}
+	}
+	return memberNames(t)
+}
+
+// derefType returns the type pointed to or aliased by t, if any.
+func derefType(t *itype) *itype {
+	for t != nil && (t.cat == ptrT || t.cat == aliasT) {
+		t = t.val
+	}
+	return t
+}
+
+// fieldType returns the type of the field name of type t, or nil.
+func fieldType(t *itype, name string) *itype {
+	if t = derefType(t); t == nil {
+		return nil
+	}
+	switch t.cat {
+	case structT:
+		for _, f := range t.field {
+			if f.name == name {
+				return f.typ
+			}
+		}
+	case valueT:
+		rt := t.rtype
+		if rt.Kind() == reflect.Ptr {
+			rt = rt.Elem()
+		}
+		if rt.Kind() == reflect.Struct {
+			if f, ok := rt.FieldByName(name); ok {
+				return &itype{cat: valueT, rtype: f.Type}
+			}
+		}
+	}
+	return nil
+}
+
+// memberNames returns the names of fields and methods of type t.
+func memberNames(t *itype) []string {
+	if t = derefType(t); t == nil {
+		return nil
+	}
+	var names []string
+	for _, m := range t.method {
+		names = append(names, m.ident)
+	}
+	switch t.cat {
+	case structT, interfaceT:
+		for _, f := range t.field {
+			names = append(names, f.name)
+		}
+	case valueT:
+		rt := t.rtype
+		for i := 0; i < rt.NumMethod(); i++ {
+			names = append(names, rt.Method(i).Name)
+		}
+		if rt.Kind() == reflect.Ptr {
+			rt = rt.Elem()
+		} else if rt.Kind() != reflect.Interface {
+			pt := reflect.PtrTo(rt)
+			for i := 0; i < pt.NumMethod(); i++ {
+				names = append(names, pt.Method(i).Name)
+			}
+		}
+		if rt.Kind() == reflect.Struct {
+			for i := 0; i < rt.NumField(); i++ {
+				if f := rt.Field(i); f.PkgPath == "" {
+					names = append(names, f.Name)
+				}
+			}
+		}
+	}
+	return names
+}
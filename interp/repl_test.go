@@ -0,0 +1,240 @@
+package interp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIncomplete(t *testing.T) {
+	testCases := []struct {
+		src      string
+		expected bool
+	}{
+		{src: "a := 1\n", expected: false},
+		{src: "func f() {\n", expected: true},
+		{src: "a := []int{1,\n2}\n", expected: false},
+		{src: "f(1,\n", expected: true},
+		{src: "s := \"{\"\n", expected: false},
+		{src: "// {\n", expected: false},
+	}
+
+	for _, test := range testCases {
+		if got := incomplete(test.src); got != test.expected {
+			t.Errorf("incomplete(%q): got %v, want %v", test.src, got, test.expected)
+		}
+	}
+}
+
+func TestComplete(t *testing.T) {
+	i := New(Options{})
+	i.Use(Exports{"strings": {
+		"ToLower": reflect.ValueOf(strings.ToLower),
+		"ToUpper": reflect.ValueOf(strings.ToUpper),
+		"Builder": reflect.ValueOf((*strings.Builder)(nil)),
+	}})
+	for _, src := range []string{
+		`import "strings"`,
+		"type T struct{ Name string; Age int }",
+		`func (t T) Hello() string { return "hello " + t.Name }`,
+		"var v T",
+		"var b strings.Builder",
+	} {
+		if _, err := i.Eval(src); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		line     string
+		start    int
+		expected []string
+	}{
+		{line: `import "str`, start: 8, expected: []string{`strings"`}},
+		{line: "strings.To", start: 8, expected: []string{"ToLower", "ToUpper"}},
+		{line: "le", start: 0, expected: []string{"len"}},
+		{line: "x := va", start: 5, expected: []string{"var"}},
+		{line: "v.", start: 2, expected: []string{"Age", "Hello", "Name"}},
+		{line: "println(v.N", start: 10, expected: []string{"Name"}},
+		{line: "b.WriteS", start: 2, expected: []string{"WriteString"}},
+		{line: "v.Name.x", start: 7, expected: nil},
+		{line: "1.", start: 0, expected: nil},
+	}
+
+	for _, test := range testCases {
+		start, names := i.Complete(test.line)
+		if start != test.start || !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("complete(%q): got %d %v, want %d %v", test.line, start, names, test.start, test.expected)
+		}
+	}
+}
+
+func TestREPL(t *testing.T) {
+	i := New(Options{})
+	var out strings.Builder
+	in := strings.NewReader("a := 2\nfunc f(x int) int {\nreturn x * a\n}\nf(3)\n\"x\"\nb +\n")
+	err := i.REPL(context.Background(), in, &out, REPLOptions{
+		Prompt:         "$ ",
+		ContinuePrompt: "| ",
+		Format:         func(v reflect.Value) string { return fmt.Sprintf("<%v>", v) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "$ <2>\n$ | | $ <6>\n$ <x>\n$ | "
+	if got := out.String(); got != want {
+		t.Errorf("got output %q, want %q", got, want)
+	}
+
+	// An interruption stops the running evaluation
+	started := make(chan struct{})
+	i.Use(Exports{"loop": {"Started": reflect.ValueOf(func() { close(started) })}})
+	r, w := io.Pipe()
+	interrupt := make(chan struct{})
+	out.Reset()
+	done := make(chan error)
+	go func() {
+		done <- i.REPL(context.Background(), r, &out, REPLOptions{NoPrompt: true, Interrupt: interrupt})
+	}()
+	fmt.Fprintln(w, `import "loop"`)
+	fmt.Fprintln(w, "func f() { loop.Started(); for {} }")
+	fmt.Fprintln(w, "f()")
+	<-started
+	interrupt <- struct{}{}
+	fmt.Fprintln(w, "1 + 1")
+	w.Close()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.String(), "interrupt\n2\n"; got != want {
+		t.Errorf("got output %q, want %q", got, want)
+	}
+
+	// The session ends when its context is canceled
+	r, _ = io.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { done <- i.REPL(ctx, r, ioutil.Discard, REPLOptions{}) }()
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+
+	// Canceling the context stops the running evaluation
+	started = make(chan struct{})
+	r, w = io.Pipe()
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() { done <- i.REPL(ctx, r, ioutil.Discard, REPLOptions{NoPrompt: true}) }()
+	fmt.Fprintln(w, "f()")
+	<-started
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	w.Close()
+}
+
+func TestREPLCommands(t *testing.T) {
+	dir, err := ioutil.TempDir("", "yaegi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "f.go")
+	if err := ioutil.WriteFile(file, []byte("func g() int { return 4 }\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	i := New(Options{})
+	i.Use(Exports{"strings": {"ToUpper": reflect.ValueOf(strings.ToUpper)}})
+	var out strings.Builder
+	in := strings.NewReader(strings.Join([]string{
+		`import "strings"`,
+		"x := 2",
+		"type T struct{ A int }",
+		"func f() {}",
+		"f()",
+		":imports",
+		":vars",
+		":types",
+		":doc",
+		":load " + file,
+		"g()",
+		":save " + filepath.Join(dir, "s.go"),
+		":bogus",
+		":reset",
+		":vars",
+	}, "\n") + "\n")
+	if err := i.REPL(context.Background(), in, &out, REPLOptions{NoPrompt: true}); err != nil {
+		t.Fatal(err)
+	}
+	want := "2\n\"strings\"\nx int = 2\ntype T struct{ A int }\nusage: :doc name\n4\nunknown command :bogus, see :help\n"
+	if got := out.String(); got != want {
+		t.Errorf("got output %q, want %q", got, want)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(dir, "s.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "package main\n\nvar x int\n\ntype T struct{ A int }\n\nfunc f() {}\n\nfunc g() int { return 4 }\n\nfunc main() {\n\tx = 2\n\tf()\n\tg()\n}\n"
+	if string(b) != want {
+		t.Errorf("got session %q, want %q", b, want)
+	}
+
+	if _, names := i.Complete(":ty"); !reflect.DeepEqual(names, []string{":types"}) {
+		t.Errorf("got completion %v", names)
+	}
+}
+
+func TestPrinter(t *testing.T) {
+	type node struct {
+		Name string
+		next *node
+		Kids []*node
+	}
+	cyclic := &node{Name: "a"}
+	cyclic.Kids = []*node{cyclic}
+
+	i := New(Options{})
+	eval := func(src string) reflect.Value {
+		v, err := i.Eval(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	eval("type T struct{ A int; b string; C []string }")
+	eval("func newT() interface{} { return &T{A: 2} }")
+	p := &Printer{MaxDepth: 3, MaxWidth: 3}
+
+	testCases := []struct {
+		printer *Printer
+		value   reflect.Value
+		want    string
+	}{
+		{printer: p, value: reflect.ValueOf("hi"), want: `"hi"`},
+		{printer: p, value: reflect.ValueOf("hello world"), want: `"hel"...`},
+		{printer: p, value: reflect.ValueOf([]int{1, 2, 3, 4, 5}), want: "[1, 2, 3, ... +2 more]"},
+		{printer: p, value: reflect.ValueOf(map[string]int{"c": 3, "a": 1, "b": 2}), want: `map["a": 1, "b": 2, "c": 3]`},
+		{printer: p, value: reflect.ValueOf(map[int]bool{10: true, 9: false}), want: "map[9: false, 10: true]"},
+		{printer: p, value: reflect.ValueOf(cyclic), want: `&interp.node{Name: "a", Kids: [<cycle>]}`},
+		{printer: &Printer{Unexported: true}, value: reflect.ValueOf(cyclic), want: `&interp.node{Name: "a", next: nil, Kids: [<cycle>]}`},
+		{printer: p, value: reflect.ValueOf([][][][][]int{{{{{1}}}}}), want: "[[[[...]]]]"},
+		{printer: p, value: reflect.ValueOf(errors.New("boom")), want: "boom"},
+		{printer: p, value: reflect.ValueOf([]interface{}{nil, 1.5, true}), want: "[nil, 1.5, true]"},
+		{printer: p, value: eval(`T{1, "x", []string{"y"}}`), want: `{A: 1, C: ["y"]}`},
+		{printer: p, value: eval("newT()"), want: `&{A: 2, C: nil}`},
+	}
+
+	for _, test := range testCases {
+		if got := test.printer.Sprint(test.value); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Package rpcserver implements a JSON-RPC 2.0 service, to drive interpreters
+// remotely from other processes, possibly written in other languages.
+//
+// Messages are JSON objects, as specified at https://www.jsonrpc.org/specification,
+// sent one after the other on a stream connection, such as a TCP connection.
+// Each client session has its own interpreter, created by the function given
+// to NewServer. The methods of the service are:
+//
+//	session.new   {}                         -> {"session": id}
+//	session.close {"session": id}            -> null
+//	eval          {"session": id, "src": s}  -> {"value": v, "type": t}
+//	compile       {"session": id, "src": s}  -> null
+//	symbols       {"session": id, "path": p} -> [{"name": n, "kind": k, "type": t}]
+//	cancel        {"id": request id}         -> null
+//
+// Eval evaluates src, and returns the formatted value and type of its last
+// expression, if any. Compile only compiles src, to check it. Symbols returns
+// the exported symbols of the interpreted package of import path p, such as
+// "main", with their kind, "func", "var" or "type". A running evaluation is
+// stopped by a cancel request, with the identifier of its request.
+// Evaluations of a session are run one at a time, in the order of requests.
+//
+// The output of interpreted code is streamed to the client by output
+// notifications, with the parameters {"session": id, "stream": s, "text": t},
+// where s is "stdout" or "stderr".
+package rpcserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// message is a JSON-RPC request, response or notification.
+type message struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+}
+
+// responseError is the error of a response.
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error codes of responses.
+const (
+	evalError      = -32000
+	methodNotFound = -32601
+	invalidParams  = -32602
+)
+
+func (e *responseError) Error() string { return e.Message }
+
+// conn reads and writes protocol messages, as consecutive JSON values.
+type conn struct {
+	dec *json.Decoder
+
+	mutex sync.Mutex // protects w
+	w     io.Writer
+}
+
+func newConn(rw io.ReadWriter) *conn {
+	return &conn{dec: json.NewDecoder(bufio.NewReader(rw)), w: rw}
+}
+
+// read decodes the next message in v.
+func (c *conn) read(v interface{}) error {
+	return c.dec.Decode(v)
+}
+
+// respond sends the response to the request of identifier id. A non nil
+// err makes it an error response.
+func (c *conn) respond(id json.RawMessage, result interface{}, err error) error {
+	m := &message{JSONRPC: "2.0", ID: id, Result: result}
+	if err != nil {
+		code := evalError
+		if e, ok := err.(*responseError); ok {
+			code = e.Code
+		}
+		m.Result, m.Error = nil, &responseError{Code: code, Message: err.Error()}
+	} else if result == nil {
+		// A successful response has a result, even if null
+		m.Result = json.RawMessage("null")
+	}
+	return c.write(m)
+}
+
+// notify sends a notification.
+func (c *conn) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	return c.write(&message{JSONRPC: "2.0", Method: method, Params: b})
+}
+
+// write sends a message, followed by a newline.
+func (c *conn) write(m interface{}) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, err = c.w.Write(append(b, '\n'))
+	return err
+}
+
+// Parameters and results of the methods.
+
+type sessionParams struct {
+	Session int `json:"session"`
+}
+
+type sessionResult struct {
+	Session int `json:"session"`
+}
+
+type srcParams struct {
+	Session int    `json:"session"`
+	Src     string `json:"src"`
+}
+
+type evalResult struct {
+	Value string `json:"value,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+type symbolsParams struct {
+	Session int    `json:"session"`
+	Path    string `json:"path"`
+}
+
+type symbol struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	Type string `json:"type"`
+}
+
+type cancelParams struct {
+	ID json.RawMessage `json:"id"`
+}
+
+type outputParams struct {
+	Session int    `json:"session"`
+	Stream  string `json:"stream"`
+	Text    string `json:"text"`
+}
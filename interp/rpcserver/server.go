@@ -0,0 +1,214 @@
+package rpcserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/containous/yaegi/interp"
+)
+
+// A Server handles the sessions of a client on a connection.
+type Server struct {
+	conn      *conn
+	newInterp func() *interp.Interpreter
+	printer   *interp.Printer
+
+	mutex    sync.Mutex                    // protects sessions, running and next
+	sessions map[int]*session              // open sessions, indexed by identifier
+	running  map[string]context.CancelFunc // cancel functions of pending evaluations, indexed by request identifier
+	next     int                           // identifier of the next session
+
+	wg sync.WaitGroup // pending requests
+}
+
+// A session is an interpreter of a client.
+type session struct {
+	interp *interp.Interpreter
+	last   chan struct{} // closed at the end of the last queued request
+}
+
+// NewServer returns a server for the sessions of a client on rw. Function
+// newInterp returns the interpreter of a new session. Its standard output
+// and error are redirected to output notifications.
+func NewServer(rw io.ReadWriter, newInterp func() *interp.Interpreter) *Server {
+	return &Server{
+		conn:      newConn(rw),
+		newInterp: newInterp,
+		printer:   &interp.Printer{MaxDepth: 10, MaxWidth: 100},
+		sessions:  map[int]*session{},
+		running:   map[string]context.CancelFunc{},
+		next:      1,
+	}
+}
+
+// Serve handles client requests until the end of the connection. Pending
+// evaluations are then stopped.
+func (s *Server) Serve() error {
+	defer func() {
+		s.mutex.Lock()
+		for _, cancel := range s.running {
+			cancel()
+		}
+		s.mutex.Unlock()
+		s.wg.Wait()
+	}()
+	for {
+		m := &message{}
+		err := s.conn.read(m)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := s.handle(m); err != nil {
+			return err
+		}
+	}
+}
+
+// handle processes a request. Requests on a session are queued, and
+// processed in order in their own goroutine, so that the connection is
+// still read, for cancel requests.
+func (s *Server) handle(m *message) error {
+	switch m.Method {
+	case "session.new":
+		i := s.newInterp()
+		s.mutex.Lock()
+		id := s.next
+		s.next++
+		last := make(chan struct{})
+		close(last)
+		s.sessions[id] = &session{interp: i, last: last}
+		s.mutex.Unlock()
+		i.Redirect(nil, &output{s, id, "stdout"}, &output{s, id, "stderr"})
+		return s.conn.respond(m.ID, &sessionResult{Session: id}, nil)
+
+	case "cancel":
+		p := &cancelParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			return s.conn.respond(m.ID, nil, &responseError{Code: invalidParams, Message: err.Error()})
+		}
+		s.mutex.Lock()
+		if cancel, ok := s.running[string(p.ID)]; ok {
+			cancel()
+		}
+		s.mutex.Unlock()
+		return s.conn.respond(m.ID, nil, nil)
+
+	case "session.close", "eval", "compile", "symbols":
+		p := &srcParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			return s.conn.respond(m.ID, nil, &responseError{Code: invalidParams, Message: err.Error()})
+		}
+		s.mutex.Lock()
+		sess, ok := s.sessions[p.Session]
+		if !ok {
+			s.mutex.Unlock()
+			return s.conn.respond(m.ID, nil, &responseError{Code: invalidParams, Message: fmt.Sprintf("unknown session %d", p.Session)})
+		}
+		if m.Method == "session.close" {
+			delete(s.sessions, p.Session)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		id := string(m.ID)
+		s.running[id] = cancel
+		prev, done := sess.last, make(chan struct{})
+		sess.last = done
+		s.mutex.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer close(done)
+			<-prev
+			result, err := s.process(ctx, sess.interp, m)
+			s.mutex.Lock()
+			delete(s.running, id)
+			s.mutex.Unlock()
+			cancel()
+			_ = s.conn.respond(m.ID, result, err)
+		}()
+		return nil
+
+	default:
+		return s.conn.respond(m.ID, nil, &responseError{Code: methodNotFound, Message: "method not found: " + m.Method})
+	}
+}
+
+// process runs a request on the interpreter i of a session, and returns
+// its result.
+func (s *Server) process(ctx context.Context, i *interp.Interpreter, m *message) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		// The request was canceled while queued
+		return nil, err
+	}
+	switch m.Method {
+	case "session.close":
+		i.Stop()
+		return nil, nil
+
+	case "eval":
+		p := &srcParams{}
+		_ = json.Unmarshal(m.Params, p)
+		v, err := i.EvalWithContext(ctx, p.Src)
+		if err != nil {
+			return nil, err
+		}
+		if !v.IsValid() {
+			return &evalResult{}, nil
+		}
+		return &evalResult{Value: s.printer.Sprint(v), Type: v.Type().String()}, nil
+
+	case "compile":
+		p := &srcParams{}
+		_ = json.Unmarshal(m.Params, p)
+		_, err := i.Compile(p.Src)
+		return nil, err
+
+	case "symbols":
+		p := &symbolsParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			return nil, &responseError{Code: invalidParams, Message: err.Error()}
+		}
+		syms := []symbol{}
+		for name, v := range i.Symbols(p.Path) {
+			syms = append(syms, newSymbol(i, name, v))
+		}
+		sort.Slice(syms, func(a, b int) bool { return syms[a].Name < syms[b].Name })
+		return syms, nil
+	}
+	return nil, nil
+}
+
+// newSymbol returns the description of the symbol name of value v, as
+// returned by Interpreter.Symbols of i. Interpreted struct types are
+// described by their name.
+func newSymbol(i *interp.Interpreter, name string, v reflect.Value) symbol {
+	switch {
+	case v.CanSet():
+		return symbol{Name: name, Kind: "var", Type: i.TypeOf(v.Addr().Interface()).Elem().String()}
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return symbol{Name: name, Kind: "type", Type: i.TypeOf(v.Interface()).Elem().String()}
+	}
+	return symbol{Name: name, Kind: "func", Type: v.Type().String()}
+}
+
+// output streams the output of a session as notifications.
+type output struct {
+	server  *Server
+	session int
+	stream  string
+}
+
+func (o *output) Write(b []byte) (int, error) {
+	if err := o.server.conn.notify("output", &outputParams{Session: o.session, Stream: o.stream, Text: string(b)}); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
@@ -0,0 +1,176 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containous/yaegi/interp"
+	"github.com/containous/yaegi/stdlib"
+)
+
+type client struct {
+	t    *testing.T
+	conn *conn
+	id   int
+	msgs chan *message
+}
+
+func newClient(t *testing.T) *client {
+	c1, c2 := net.Pipe()
+	s := NewServer(c1, func() *interp.Interpreter {
+		i := interp.New(interp.Options{})
+		i.Use(stdlib.Symbols)
+		return i
+	})
+	go func() { _ = s.Serve() }()
+	t.Cleanup(func() { c2.Close() })
+
+	c := &client{t: t, conn: newConn(c2), msgs: make(chan *message, 100)}
+	go func() {
+		for {
+			m := &message{}
+			if err := c.conn.read(m); err != nil {
+				close(c.msgs)
+				return
+			}
+			c.msgs <- m
+		}
+	}()
+	return c
+}
+
+// send sends a request, and returns its identifier.
+func (c *client) send(method string, params interface{}) string {
+	c.t.Helper()
+	c.id++
+	b, err := json.Marshal(params)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := c.conn.write(&message{JSONRPC: "2.0", ID: json.RawMessage(strconv.Itoa(c.id)), Method: method, Params: b}); err != nil {
+		c.t.Fatal(err)
+	}
+	return strconv.Itoa(c.id)
+}
+
+// wait returns the response to the request id, decoding its result in
+// result, or its error. The notifications received meanwhile are passed
+// to notify, if not nil.
+func (c *client) wait(id string, result interface{}, notify func(*message)) *responseError {
+	c.t.Helper()
+	for {
+		select {
+		case m, ok := <-c.msgs:
+			if !ok {
+				c.t.Fatal("connection closed")
+			}
+			if m.ID == nil {
+				if notify != nil {
+					notify(m)
+				}
+				continue
+			}
+			if string(m.ID) != id {
+				continue
+			}
+			if m.Error != nil {
+				return m.Error
+			}
+			if result != nil {
+				b, _ := json.Marshal(m.Result)
+				if err := json.Unmarshal(b, result); err != nil {
+					c.t.Fatal(err)
+				}
+			}
+			return nil
+		case <-time.After(5 * time.Second):
+			c.t.Fatal("timeout")
+		}
+	}
+}
+
+// call sends a request and waits for its successful response.
+func (c *client) call(method string, params, result interface{}, notify func(*message)) {
+	c.t.Helper()
+	if err := c.wait(c.send(method, params), result, notify); err != nil {
+		c.t.Fatalf("%s: %v", method, err)
+	}
+}
+
+func TestServer(t *testing.T) {
+	c := newClient(t)
+	sess := &sessionResult{}
+	c.call("session.new", struct{}{}, sess, nil)
+	other := &sessionResult{}
+	c.call("session.new", struct{}{}, other, nil)
+	if sess.Session == other.Session {
+		t.Fatalf("got the same session %d twice", sess.Session)
+	}
+
+	res := &evalResult{}
+	c.call("eval", &srcParams{Session: sess.Session, Src: "a := 40; a + 2"}, res, nil)
+	if res.Value != "42" || res.Type != "int" {
+		t.Errorf("got %+v, want 42 of type int", res)
+	}
+	if err := c.wait(c.send("eval", &srcParams{Session: other.Session, Src: "a"}), nil, nil); err == nil || !strings.Contains(err.Message, "undefined: a") {
+		t.Errorf("got error %v in other session, want undefined", err)
+	}
+
+	// Output is streamed by notifications
+	var out strings.Builder
+	c.call("eval", &srcParams{Session: sess.Session, Src: `import "fmt"`}, nil, nil)
+	c.call("eval", &srcParams{Session: sess.Session, Src: `fmt.Println("hello")`}, nil, func(m *message) {
+		p := &outputParams{}
+		if err := json.Unmarshal(m.Params, p); err != nil {
+			t.Fatal(err)
+		}
+		if m.Method != "output" || p.Session != sess.Session || p.Stream != "stdout" {
+			t.Errorf("got notification %s %+v", m.Method, p)
+		}
+		out.WriteString(p.Text)
+	})
+	if out.String() != "hello\n" {
+		t.Errorf("got output %q, want %q", out.String(), "hello\n")
+	}
+
+	if err := c.wait(c.send("compile", &srcParams{Session: sess.Session, Src: "b + 1"}), nil, nil); err == nil || err.Code != evalError {
+		t.Errorf("got compile error %v, want undefined", err)
+	}
+	c.call("eval", &srcParams{Session: sess.Session, Src: "type T struct{}; func F() {}; var V int; var W T; var X error"}, nil, nil)
+	var syms []symbol
+	c.call("symbols", &symbolsParams{Session: sess.Session, Path: "main"}, &syms, nil)
+	want := []symbol{{"F", "func", "func()"}, {"T", "type", "main.T"}, {"V", "var", "int"}, {"W", "var", "main.T"}, {"X", "var", "error"}}
+	for _, s := range syms {
+		if s.Name == "a" {
+			continue
+		}
+		if len(want) == 0 || !reflect.DeepEqual(s, want[0]) {
+			t.Fatalf("got symbols %v, want %v", syms, want)
+		}
+		want = want[1:]
+	}
+
+	// A running evaluation is canceled
+	id := c.send("eval", &srcParams{Session: sess.Session, Src: "for {}"})
+	c.call("cancel", &cancelParams{ID: json.RawMessage(id)}, nil, nil)
+	if err := c.wait(id, nil, nil); err == nil || err.Message != "context canceled" {
+		t.Errorf("got error %v, want context canceled", err)
+	}
+	c.call("eval", &srcParams{Session: sess.Session, Src: "a"}, res, nil)
+	if res.Value != "40" {
+		t.Errorf("got %s after cancel, want 40", res.Value)
+	}
+
+	c.call("session.close", &sessionParams{Session: sess.Session}, nil, nil)
+	if err := c.wait(c.send("eval", &srcParams{Session: sess.Session, Src: "a"}), nil, nil); err == nil || err.Code != invalidParams {
+		t.Errorf("got error %v on closed session, want invalid params", err)
+	}
+	if err := c.wait(c.send("unknown", struct{}{}), nil, nil); err == nil || err.Code != methodNotFound {
+		t.Errorf("got error %v, want method not found", err)
+	}
+}
@@ -5,7 +5,11 @@ package interp
 import (
 	"fmt"
 	"log"
+	"math"
+	"path"
 	"reflect"
+	"sync/atomic"
+	"unicode/utf8"
 )
 
 // bltn type defines functions which run at CFG execution
@@ -14,57 +18,66 @@ type bltn func(f *frame) bltn
 // bltnGenerator type defines a builtin generator function
 type bltnGenerator func(n *node)
 
-var builtin = [...]bltnGenerator{
-	aNop:          nop,
-	aAddr:         addr,
-	aAssign:       assign,
-	aAdd:          add,
-	aAddAssign:    addAssign,
-	aAnd:          and,
-	aAndAssign:    andAssign,
-	aAndNot:       andNot,
-	aAndNotAssign: andNotAssign,
-	aCall:         call,
-	aCase:         _case,
-	aCompositeLit: arrayLit,
-	aDec:          dec,
-	aDefer:        _defer,
-	aEqual:        equal,
-	aGetFunc:      getFunc,
-	aGreater:      greater,
-	aGreaterEqual: greaterEqual,
-	aInc:          inc,
-	aLand:         land,
-	aLor:          lor,
-	aLower:        lower,
-	aLowerEqual:   lowerEqual,
-	aMul:          mul,
-	aMulAssign:    mulAssign,
-	aNegate:       negate,
-	aNot:          not,
-	aNotEqual:     notEqual,
-	aOr:           or,
-	aOrAssign:     orAssign,
-	aQuo:          quo,
-	aQuoAssign:    quoAssign,
-	aRange:        _range,
-	aRecv:         recv,
-	aRem:          rem,
-	aRemAssign:    remAssign,
-	aReturn:       _return,
-	aSend:         send,
-	aShl:          shl,
-	aShlAssign:    shlAssign,
-	aShr:          shr,
-	aShrAssign:    shrAssign,
-	aSlice:        slice,
-	aSlice0:       slice0,
-	aStar:         deref,
-	aSub:          sub,
-	aSubAssign:    subAssign,
-	aTypeAssert:   typeAssert,
-	aXor:          xor,
-	aXorAssign:    xorAssign,
+// builtin maps actions to their generator. It is set in init to avoid an
+// initialization loop, as generators indirectly refer to the AST builder.
+var builtin [len(actions)]bltnGenerator
+
+func init() {
+	builtin = [...]bltnGenerator{
+		aNop:          nop,
+		aAddr:         addr,
+		aAssign:       assign,
+		aAdd:          add,
+		aAddAssign:    addAssign,
+		aAnd:          and,
+		aAndAssign:    andAssign,
+		aAndNot:       andNot,
+		aAndNotAssign: andNotAssign,
+		aBitNot:       bitNot,
+		aCall:         call,
+		aCallSlice:    call,
+		aCase:         _case,
+		aCompositeLit: arrayLit,
+		aDec:          dec,
+		aDefer:        _defer,
+		aEqual:        equal,
+		aGetFunc:      getFunc,
+		aGreater:      greater,
+		aGreaterEqual: greaterEqual,
+		aInc:          inc,
+		aLand:         land,
+		aLor:          lor,
+		aLower:        lower,
+		aLowerEqual:   lowerEqual,
+		aMul:          mul,
+		aMulAssign:    mulAssign,
+		aNegate:       negate,
+		aNot:          not,
+		aNotEqual:     notEqual,
+		aOr:           or,
+		aOrAssign:     orAssign,
+		aPos:          pos,
+		aQuo:          quo,
+		aQuoAssign:    quoAssign,
+		aRange:        _range,
+		aRecv:         recv,
+		aRem:          rem,
+		aRemAssign:    remAssign,
+		aReturn:       _return,
+		aSend:         send,
+		aShl:          shl,
+		aShlAssign:    shlAssign,
+		aShr:          shr,
+		aShrAssign:    shrAssign,
+		aSlice:        slice,
+		aSlice0:       slice0,
+		aStar:         deref,
+		aSub:          sub,
+		aSubAssign:    subAssign,
+		aTypeAssert:   typeAssert,
+		aXor:          xor,
+		aXorAssign:    xorAssign,
+	}
 }
 
 type valueInterface struct {
@@ -72,11 +85,15 @@ type valueInterface struct {
 	value reflect.Value
 }
 
-var floatType, complexType reflect.Type
+var floatType, complexType, stringType, emptyInterfaceType, valueInterfaceType, structFieldType reflect.Type
 
 func init() {
 	floatType = reflect.ValueOf(0.0).Type()
 	complexType = reflect.ValueOf(complex(0, 0)).Type()
+	stringType = reflect.TypeOf("")
+	emptyInterfaceType = reflect.TypeOf((*interface{})(nil)).Elem()
+	valueInterfaceType = reflect.TypeOf(valueInterface{})
+	structFieldType = reflect.TypeOf(reflect.StructField{})
 }
 
 func (interp *Interpreter) run(n *node, cf *frame) {
@@ -84,13 +101,29 @@ func (interp *Interpreter) run(n *node, cf *frame) {
 	if cf == nil {
 		f = interp.frame
 	} else {
-		f = &frame{anc: cf, data: make([]reflect.Value, len(n.types))}
+		f = newFrame(cf, len(n.types), cf.runid())
+		if d := interp.debugger; d != nil {
+			d.enter(f, cf, n, false)
+		}
+		if p := interp.profiler; p != nil {
+			p.enter(f, cf, n, false)
+		}
+		if r := interp.racer; r != nil {
+			r.enter(f, cf, nil, false)
+		}
+		if t := interp.tracer; t != nil {
+			t.enter(f, cf, n, false)
+		}
 	}
 
 	for i, t := range n.types {
 		f.data[i] = reflect.New(t).Elem()
 	}
-	runCfg(n.start, f)
+	start := n.start
+	if n.kind == funcDecl {
+		start = funcBody(n)
+	}
+	runCfg(start, f)
 }
 
 // Functions set to run during execution of CFG
@@ -98,27 +131,84 @@ func (interp *Interpreter) run(n *node, cf *frame) {
 // runCfg executes a node AST by walking its CFG and running node builtin at each step
 func runCfg(n *node, f *frame) {
 	defer func() {
-		f.recovered = recover()
+		r := recover()
+		t, ok := r.(*panicTrace)
+		if ok {
+			r = t.value
+		} else if r != nil {
+			t = &panicTrace{value: r}
+		}
+		f.recovered = r
 		for _, val := range f.deferred {
-			val[0].Call(val[1:])
+			// The panic of a deferred call replaces the current one
+			if p := runDeferred(val); p != nil {
+				t, f.recovered = p, p.value
+			}
+		}
+		if f.debug != nil && f.debug.entry {
+			n.interp.debugger.exit(f.debug.routine)
+		}
+		if f.race != nil && f.race.entry {
+			n.interp.racer.exit(f.race.routine)
+		}
+		if f.trace != nil {
+			n.interp.tracer.exit(f)
+		}
+		if f.stats != nil {
+			f.stats.exit()
 		}
 		if f.recovered != nil {
-			fmt.Println(n.cfgErrorf("panic"))
-			panic(f.recovered)
+			t.unwind(n, f)
+			panic(t)
 		}
 	}()
 
-	for exec := n.exec; exec != nil; {
+	execCfg(n, f)
+}
+
+// execCfg walks the CFG from node n in frame f, until its end, without the
+// function exit handling of runCfg.
+func execCfg(n *node, f *frame) {
+	for exec := n.exec; exec != nil && f.runid() == n.interp.runid(); {
+		if n.interp.countSteps && !n.interp.step() {
+			break
+		}
 		exec = exec(f)
 	}
 }
 
+// runDeferred runs a deferred call, and returns the trace of its panic if
+// any, or nil.
+func runDeferred(val []reflect.Value) (t *panicTrace) {
+	defer func() {
+		r := recover()
+		if p, ok := r.(*panicTrace); ok {
+			t = p
+		} else if r != nil {
+			t = &panicTrace{value: r}
+		}
+	}()
+	val[0].Call(val[1:])
+	return nil
+}
+
 func typeAssert(n *node) {
 	value := genValue(n.child[0])
 	i := n.findex
 	next := getExec(n.tnext)
+	typ := n.child[1].typ
+	ityp := n.child[0].typ
 
 	switch {
+	case n.child[0].typ.cat == valueT && !isInterface(typ):
+		n.exec = func(f *frame) bltn {
+			v := toValueInterface(value(f))
+			if !assertType(v, typ) {
+				panic(assertionError(v, ityp, typ))
+			}
+			f.data[i].Set(v.value)
+			return next
+		}
 	case n.child[0].typ.cat == valueT:
 		n.exec = func(f *frame) bltn {
 			f.data[i].Set(value(f).Elem())
@@ -126,13 +216,29 @@ func typeAssert(n *node) {
 		}
 	case n.child[1].typ.cat == interfaceT:
 		n.exec = func(f *frame) bltn {
-			v := value(f).Interface().(valueInterface)
+			v, _ := value(f).Interface().(valueInterface)
+			if !assertType(v, typ) {
+				panic(assertionError(v, ityp, typ))
+			}
 			f.data[i] = reflect.ValueOf(valueInterface{v.node, v.value})
 			return next
 		}
+	case isInterface(typ):
+		wrap := genInterfaceWrapper(n.child[0], typ.TypeOf())
+		n.exec = func(f *frame) bltn {
+			v, _ := value(f).Interface().(valueInterface)
+			if !assertType(v, typ) {
+				panic(assertionError(v, ityp, typ))
+			}
+			f.data[i].Set(wrap(f))
+			return next
+		}
 	default:
 		n.exec = func(f *frame) bltn {
-			v := value(f).Interface().(valueInterface)
+			v, _ := value(f).Interface().(valueInterface)
+			if !assertType(v, typ) {
+				panic(assertionError(v, ityp, typ))
+			}
 			f.data[i].Set(v.value)
 			return next
 		}
@@ -144,8 +250,21 @@ func typeAssert2(n *node) {
 	value0 := genValue(n.anc.child[0]) // returned result
 	value1 := genValue(n.anc.child[1]) // returned status
 	next := getExec(n.tnext)
+	typ := n.child[1].typ
 
 	switch {
+	case n.child[0].typ.cat == valueT && !isInterface(typ):
+		n.exec = func(f *frame) bltn {
+			v := toValueInterface(value(f))
+			ok := assertType(v, typ)
+			if d := value0(f); ok {
+				d.Set(v.value)
+			} else {
+				d.Set(reflect.Zero(d.Type()))
+			}
+			value1(f).SetBool(ok)
+			return next
+		}
 	case n.child[0].typ.cat == valueT:
 		n.exec = func(f *frame) bltn {
 			if value(f).IsValid() && !value(f).IsNil() {
@@ -156,26 +275,203 @@ func typeAssert2(n *node) {
 		}
 	case n.child[1].typ.cat == interfaceT:
 		n.exec = func(f *frame) bltn {
-			v, ok := value(f).Interface().(valueInterface)
+			v, _ := value(f).Interface().(valueInterface)
+			ok := assertType(v, typ)
+			if !ok {
+				v = valueInterface{}
+			}
 			value0(f).Set(reflect.ValueOf(valueInterface{v.node, v.value}))
 			value1(f).SetBool(ok)
 			return next
 		}
+	case isInterface(typ):
+		wrap := genInterfaceWrapper(n.child[0], typ.TypeOf())
+		n.exec = func(f *frame) bltn {
+			v, _ := value(f).Interface().(valueInterface)
+			ok := assertType(v, typ)
+			if d := value0(f); ok {
+				d.Set(wrap(f))
+			} else {
+				d.Set(reflect.Zero(d.Type()))
+			}
+			value1(f).SetBool(ok)
+			return next
+		}
 	default:
 		n.exec = func(f *frame) bltn {
-			v, ok := value(f).Interface().(valueInterface)
-			value0(f).Set(v.value)
+			v, _ := value(f).Interface().(valueInterface)
+			ok := assertType(v, typ)
+			if d := value0(f); ok {
+				d.Set(v.value)
+			} else {
+				d.Set(reflect.Zero(d.Type()))
+			}
 			value1(f).SetBool(ok)
 			return next
 		}
 	}
 }
 
+// toValueInterface returns the interface value held by v, the value of an
+// interpreted or binary interface. Its value is not itself an interface.
+func toValueInterface(v reflect.Value) valueInterface {
+	if v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return valueInterface{}
+	}
+	vi, ok := v.Interface().(valueInterface)
+	if !ok {
+		// Binary interface value
+		return valueInterface{&node{typ: &itype{cat: valueT, rtype: v.Type()}}, v}
+	}
+	if vi.value.IsValid() && vi.value.Kind() == reflect.Interface {
+		vi.value = vi.value.Elem()
+	}
+	return vi
+}
+
+// dynamicType returns the type of the value held by interface value v, or
+// nil if v is nil.
+func dynamicType(v valueInterface) *itype {
+	if v.node != nil && v.node.typ != nil && v.node.typ.cat != valueT && v.node.typ.cat != nilT && !isInterface(v.node.typ) {
+		return v.node.typ
+	}
+	r := v.value
+	if r.IsValid() && r.Kind() == reflect.Interface {
+		r = r.Elem()
+	}
+	if !r.IsValid() {
+		return nil
+	}
+	return &itype{cat: valueT, rtype: r.Type()}
+}
+
+// assertType returns true if interface value v holds a value of type t, or
+// of a type implementing t if t is an interface type.
+func assertType(v valueInterface, t *itype) bool {
+	dt := dynamicType(v)
+	switch {
+	case dt == nil:
+		return false
+	case isInterface(t):
+		return dt.implements(t)
+	}
+	return identicalTypes(dt, t)
+}
+
+// identicalTypes returns true if types t0 and t1 are identical.
+func identicalTypes(t0, t1 *itype) bool {
+	if t0.cat != valueT && t1.cat != valueT && (isDefinedType(t0) || isDefinedType(t1)) {
+		return t0.id() == t1.id()
+	}
+	return t0.TypeOf() == t1.TypeOf()
+}
+
+// A compareKey is the form of a value in an equality comparison involving
+// interface values: its dynamic type and value.
+type compareKey struct {
+	typ   *itype        // dynamic type, nil for a nil interface value
+	value reflect.Value // dynamic value
+}
+
+// equal returns true if k and o hold identical types and equal values. As in
+// compiled code, it panics if the identical types are not comparable.
+func (k compareKey) equal(o compareKey) bool {
+	switch {
+	case k.typ == nil || o.typ == nil:
+		return k.typ == nil && o.typ == nil
+	case !identicalTypes(k.typ, o.typ):
+		return false
+	case !k.typ.TypeOf().Comparable():
+		panic(runtimeError("comparing uncomparable type " + runtimeTypeName(k.typ)))
+	}
+	return k.value.Interface() == o.value.Interface()
+}
+
+// genCompareKey returns a function computing the compareKey of the value of n.
+func genCompareKey(n *node) func(*frame) compareKey {
+	value := genValue(n)
+
+	if !isInterface(n.typ) {
+		t := n.typ
+		return func(f *frame) compareKey { return compareKey{t, value(f)} }
+	}
+	return func(f *frame) compareKey {
+		vi := toValueInterface(value(f))
+		return compareKey{dynamicType(vi), vi.value}
+	}
+}
+
+// isDefinedType returns true if t is a type defined by a declaration, or a
+// pointer to such a type.
+func isDefinedType(t *itype) bool {
+	switch t.cat {
+	case ptrT:
+		return isDefinedType(t.val)
+	case aliasT, arrayT, chanT, funcT, interfaceT, mapT, structT:
+		return t.name != ""
+	}
+	return false
+}
+
+// missingMethod returns the name of a method of interface type it which is
+// not in the method set of type t, or an empty string if t implements it. The
+// boolean result is true if the method is declared with a pointer receiver,
+// and is in the method set of *t only.
+func missingMethod(t, it *itype) (string, bool) {
+	for _, name := range it.methodNames() {
+		if ok, ptrRecv := t.inMethodSet(name, false); !ok {
+			return name, ptrRecv
+		}
+	}
+	return "", false
+}
+
+// assertionError returns the panic value of the failed assertion to type t
+// of interface value v, of type it.
+func assertionError(v valueInterface, it, t *itype) error {
+	dt := dynamicType(v)
+	switch {
+	case dt == nil:
+		return typeAssertionError(fmt.Sprintf("%s is nil, not %s", runtimeTypeName(it), runtimeTypeName(t)))
+	case isInterface(t):
+		name, _ := missingMethod(dt, t)
+		return typeAssertionError(fmt.Sprintf("%s is not %s: missing method %s", runtimeTypeName(dt), runtimeTypeName(t), name))
+	}
+	return typeAssertionError(fmt.Sprintf("%s is %s, not %s", runtimeTypeName(it), runtimeTypeName(dt), runtimeTypeName(t)))
+}
+
+// runtimeTypeName returns the name of type t, as printed by the runtime.
+func runtimeTypeName(t *itype) string {
+	switch {
+	case t.cat == ptrT:
+		return "*" + runtimeTypeName(t.val)
+	case t.cat == interfaceT && t.name == "":
+		return "interface {}"
+	case t.cat != valueT && t.name != "" && t.pkgPath != "":
+		return path.Base(t.pkgPath) + "." + t.name
+	}
+	return typeString(t)
+}
+
 func convert(n *node) {
+	next := getExec(n.tnext)
+	if n.rval.IsValid() {
+		// Constant conversion, computed at compile time
+		v := n.rval
+		dest := valueGenerator(n, n.findex)
+		n.exec = func(f *frame) bltn {
+			dest(f).Set(v)
+			return next
+		}
+		return
+	}
+
 	dest := genValue(n)
 	c := n.child[1]
 	typ := n.child[0].typ.TypeOf()
-	next := getExec(n.tnext)
 
 	if c.kind == basicLit && !c.rval.IsValid() { // convert nil to type
 		n.exec = func(f *frame) bltn {
@@ -185,19 +481,95 @@ func convert(n *node) {
 		return
 	}
 
+	if c.typ.cat == funcT && n.typ.cat == funcT {
+		// Interpreted functions are stored as nodes, whatever their type
+		value := genValue(c)
+		n.exec = func(f *frame) bltn {
+			dest(f).Set(value(f))
+			return next
+		}
+		return
+	}
+
 	var value func(*frame) reflect.Value
-	if c.typ.cat == funcT {
+	switch {
+	case n.typ.cat == interfaceT:
+		value = genValueInterface(c)
+	case isInterface(n.typ):
+		value = genInterfaceWrapper(c, typ)
+	case c.typ.cat == funcT:
 		value = genFunctionWrapper(c)
-	} else {
+	default:
 		value = genValue(c)
 	}
 
+	if isInterface(n.typ) {
+		// Conversion to interface: the value implements it
+		n.exec = func(f *frame) bltn {
+			dest(f).Set(value(f))
+			return next
+		}
+		return
+	}
+
+	if isUnsafeConversion(c.typ.TypeOf(), typ) {
+		n.exec = func(f *frame) bltn {
+			dest(f).Set(convertUnsafe(value(f), typ))
+			return next
+		}
+		return
+	}
+
+	if isFloat(c.typ.TypeOf()) && isInt(typ) {
+		n.exec = func(f *frame) bltn {
+			dest(f).Set(convertFloatInt(value(f).Float(), typ))
+			return next
+		}
+		return
+	}
+
 	n.exec = func(f *frame) bltn {
-		dest(f).Set(value(f).Convert(typ))
+		v := value(f)
+		if !n.interp.allocConvert(f, v, typ) {
+			return nil
+		}
+		dest(f).Set(v.Convert(typ))
 		return next
 	}
 }
 
+// convertFloatInt returns float x converted to integer type t, as in compiled
+// code. Reflect converts through int64 first, which gives other results for
+// values out of the range of t.
+func convertFloatInt(x float64, t reflect.Type) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Int:
+		v.SetInt(int64(int(x)))
+	case reflect.Int8:
+		v.SetInt(int64(int8(x)))
+	case reflect.Int16:
+		v.SetInt(int64(int16(x)))
+	case reflect.Int32:
+		v.SetInt(int64(int32(x)))
+	case reflect.Int64:
+		v.SetInt(int64(x))
+	case reflect.Uint:
+		v.SetUint(uint64(uint(x)))
+	case reflect.Uint8:
+		v.SetUint(uint64(uint8(x)))
+	case reflect.Uint16:
+		v.SetUint(uint64(uint16(x)))
+	case reflect.Uint32:
+		v.SetUint(uint64(uint32(x)))
+	case reflect.Uint64:
+		v.SetUint(uint64(x))
+	case reflect.Uintptr:
+		v.SetUint(uint64(uintptr(x)))
+	}
+	return v
+}
+
 func isRecursiveStruct(t *itype) bool {
 	if t.cat == structT && t.rtype.Kind() == reflect.Interface {
 		return true
@@ -208,6 +580,26 @@ func isRecursiveStruct(t *itype) bool {
 	return false
 }
 
+// isDistinctStruct returns true if the struct types src and dest have
+// distinct reflection types, such as an unnamed and a defined struct type
+// with identical fields.
+func isDistinctStruct(src, dest *itype) bool {
+	if src == nil || dest == nil || src.cat != structT || dest.cat != structT {
+		return false
+	}
+	st, dt := src.TypeOf(), dest.TypeOf()
+	return st != dt && (st.ConvertibleTo(dt) || isEmptyStruct(st) && isEmptyStruct(dt))
+}
+
+// isEmptyStruct returns true if t is the reflection type of a struct type
+// without fields, which has the blank field recording its name if defined.
+func isEmptyStruct(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	return t.NumField() == 0 || t.NumField() == 1 && t.Field(0).Name == "_" && t.Field(0).Tag.Get(typeNameTag) != ""
+}
+
 func assign(n *node) {
 	next := getExec(n.tnext)
 	dvalue := make([]func(*frame) reflect.Value, n.nleft)
@@ -223,8 +615,8 @@ func assign(n *node) {
 		switch {
 		case dest.typ.cat == interfaceT:
 			svalue[i] = genValueInterface(src)
-		case dest.typ.cat == valueT && dest.typ.rtype.Kind() == reflect.Interface:
-			svalue[i] = genInterfaceWrapper(src, dest.typ.rtype)
+		case dest.typ.cat == valueT && dest.typ.rtype.Kind() == reflect.Interface, dest.typ.cat == errorT:
+			svalue[i] = genInterfaceWrapper(src, dest.typ.TypeOf())
 		case dest.typ.cat == valueT && src.typ.cat == funcT:
 			svalue[i] = genFunctionWrapper(src)
 		case src.kind == basicLit && src.val == nil:
@@ -232,6 +624,9 @@ func assign(n *node) {
 			svalue[i] = func(*frame) reflect.Value { return reflect.New(t).Elem() }
 		case isRecursiveStruct(dest.typ):
 			svalue[i] = genValueInterfacePtr(src)
+		case isDistinctStruct(src.typ, dest.typ):
+			// Assignable struct types, with distinct reflection types
+			svalue[i] = genValueAs(src, dest.typ.TypeOf())
 		default:
 			svalue[i] = genValue(src)
 		}
@@ -249,8 +644,15 @@ func assign(n *node) {
 
 	if n.nleft == 1 {
 		if s, d, i := svalue[0], dvalue[0], ivalue[0]; i != nil {
+			mtype := n.child[0].child[0].typ.TypeOf()
+			size := mtype.Key().Size() + mtype.Elem().Size()
 			n.exec = func(f *frame) bltn {
-				d(f).SetMapIndex(i(f), s(f))
+				m := d(f)
+				l := m.Len()
+				m.SetMapIndex(i(f), s(f))
+				if m.Len() > l && !n.interp.alloc(f, 1, size) {
+					return nil
+				}
 				return next
 			}
 		} else {
@@ -260,29 +662,15 @@ func assign(n *node) {
 			}
 		}
 	} else {
-		types := make([]reflect.Type, n.nright)
-		for i := range types {
-			var t reflect.Type
-			switch typ := n.child[sbase+i].typ; typ.cat {
-			case funcT:
-				t = reflect.TypeOf((*node)(nil))
-			case interfaceT:
-				t = reflect.TypeOf((*valueInterface)(nil)).Elem()
-			default:
-				t = typ.TypeOf()
-			}
-			//types[i] = n.child[sbase+i].typ.TypeOf()
-			types[i] = t
-		}
-
 		// To handle swap in multi-assign:
 		// evaluate and copy all values in assign right hand side into temporary
 		// then evaluate assign left hand side and copy temporary into it
 		n.exec = func(f *frame) bltn {
 			t := make([]reflect.Value, len(svalue))
 			for i, s := range svalue {
-				t[i] = reflect.New(types[i]).Elem()
-				t[i].Set(s(f))
+				v := s(f)
+				t[i] = reflect.New(v.Type()).Elem()
+				t[i].Set(v)
 			}
 			for i, d := range dvalue {
 				if j := ivalue[i]; j != nil {
@@ -352,17 +740,19 @@ func deref(n *node) {
 func _print(n *node) {
 	child := n.child[1:]
 	next := getExec(n.tnext)
+	stdout := n.interp.stdout
 	values := make([]func(*frame) reflect.Value, len(child))
 	for i, c := range child {
 		values[i] = genValue(c)
 	}
 
 	n.exec = func(f *frame) bltn {
+		w := stdout.writer()
 		for i, value := range values {
 			if i > 0 {
-				fmt.Printf(" ")
+				fmt.Fprint(w, " ")
 			}
-			fmt.Printf("%v", value(f))
+			fmt.Fprintf(w, "%v", value(f))
 		}
 		return next
 	}
@@ -371,19 +761,21 @@ func _print(n *node) {
 func _println(n *node) {
 	child := n.child[1:]
 	next := getExec(n.tnext)
+	stdout := n.interp.stdout
 	values := make([]func(*frame) reflect.Value, len(child))
 	for i, c := range child {
 		values[i] = genValue(c)
 	}
 
 	n.exec = func(f *frame) bltn {
+		w := stdout.writer()
 		for i, value := range values {
 			if i > 0 {
-				fmt.Printf(" ")
+				fmt.Fprint(w, " ")
 			}
-			fmt.Printf("%v", value(f))
+			fmt.Fprintf(w, "%v", value(f))
 		}
-		fmt.Println("")
+		fmt.Fprintln(w)
 		return next
 	}
 }
@@ -391,85 +783,135 @@ func _println(n *node) {
 func _recover(n *node) {
 	tnext := getExec(n.tnext)
 	dest := genValue(n)
-	var err error
-	nilErr := reflect.ValueOf(valueInterface{n, reflect.ValueOf(&err).Elem()})
+	nilInterface := reflect.ValueOf(valueInterface{})
 
+	// Only a function called directly as deferred, by a panicking frame,
+	// recovers from the panic
 	n.exec = func(f *frame) bltn {
-		if f.anc.recovered == nil {
-			dest(f).Set(nilErr)
+		if d := f.deferrer; d == nil || d.recovered == nil {
+			dest(f).Set(nilInterface)
 		} else {
-			dest(f).Set(reflect.ValueOf(valueInterface{n, reflect.ValueOf(f.anc.recovered)}))
-			f.anc.recovered = nil
+			v, ok := d.recovered.(reflect.Value) // panic of interpreted code
+			if !ok {
+				r := reflect.ValueOf(d.recovered)
+				v = reflect.ValueOf(valueInterface{&node{typ: &itype{cat: valueT, rtype: r.Type()}}, r})
+			}
+			dest(f).Set(v)
+			d.recovered = nil
 		}
 		return tnext
 	}
 }
 
 func _panic(n *node) {
-	value := genValue(n.child[1])
+	value := genValueInterface(n.child[1])
 
 	n.exec = func(f *frame) bltn {
-		panic(value(f))
+		panic(&panicTrace{value: value(f), node: n})
 	}
 }
 
+// genFunctionWrapper returns a runtime function invoking interpreted function
+// n. The receiver of a method is evaluated with the function.
 func genFunctionWrapper(n *node) func(*frame) reflect.Value {
+	wrap := genDeferredWrapper(n)
+	return func(f *frame) reflect.Value { return wrap(f, nil) }
+}
+
+// genDeferredWrapper returns a runtime function invoking interpreted function
+// n, as genFunctionWrapper, as a deferred call of frame d if not nil: the
+// function may then recover the panic of d.
+func genDeferredWrapper(n *node) func(f, d *frame) reflect.Value {
 	var def *node
 	var ok bool
 	if def, ok = n.val.(*node); !ok {
 		return genValueAsFunctionWrapper(n)
 	}
-	setExec(def.child[3].start)
-	start := def.child[3].start
+	if def.child[3].once == nil {
+		setExec(def.child[3].start)
+	}
 	numRet := len(def.typ.ret)
 	var rcvr func(*frame) reflect.Value
 
 	if n.recv != nil {
-		if n.recv.node.typ.cat != defRecvType(def).cat {
-			rcvr = genValueRecvIndirect(n)
-		} else {
-			rcvr = genValueRecv(n)
-		}
+		rcvr = genValueRecv(n)
 	}
 
-	return func(f *frame) reflect.Value {
+	return func(f, d *frame) reflect.Value {
 		if n.frame != nil { // Use closure context if defined
 			f = n.frame
+		} else {
+			f = closureFrame(def, f)
+		}
+		var recv reflect.Value
+		if rcvr != nil {
+			recv = methodReceiver(rcvr(f), def.types[numRet])
 		}
 		return reflect.MakeFunc(n.typ.TypeOf(), func(in []reflect.Value) []reflect.Value {
 			// Allocate and init local frame. All values to be settable and addressable.
-			fr := frame{anc: f, data: make([]reflect.Value, len(def.types))}
-			d := fr.data
+			// The frame is attached to the current run, as the function may be invoked from runtime.
+			// If the memory budget is exceeded, the run is stopped and the body
+			// is not executed
+			def.interp.allocFrame(f, def)
+			id, done := def.interp.runState()
+			reuse := def.interp.reuseFrames(def)
+			var fr *frame
+			if reuse {
+				fr = getFrame(f, len(def.types), id)
+			} else {
+				fr = newFrame(f, len(def.types), id)
+			}
+			fr.done = done
+			fr.deferrer = d
+			if d := def.interp.debugger; d != nil {
+				// The function may be invoked from any goroutine of the runtime
+				d.enter(fr, nil, def, true)
+			}
+			if p := def.interp.profiler; p != nil {
+				p.enter(fr, nil, def, true)
+			}
+			if r := def.interp.racer; r != nil {
+				r.enter(fr, nil, nil, true)
+			}
+			if t := def.interp.tracer; t != nil {
+				t.enter(fr, nil, def, true)
+			}
+			if s := def.interp.stats; s != nil {
+				s.enter(fr, def)
+			}
 			for i, t := range def.types {
-				d[i] = reflect.New(t).Elem()
+				fr.data[i] = reflect.New(t).Elem()
 			}
 
 			// Copy method receiver as first argument, if defined
+			args := fr.data[numRet:]
 			if rcvr != nil {
-				src, dest := rcvr(f), d[numRet]
-				if src.Type().Kind() != dest.Type().Kind() {
-					dest.Set(src.Addr())
-				} else {
-					dest.Set(src)
+				if !recv.IsValid() {
+					// Value method called through a nil pointer
+					panic(runtimeError("invalid memory address or nil pointer dereference"))
 				}
-				d = d[numRet+1:]
-			} else {
-				d = d[numRet:]
+				args[0].Set(recv)
+				args = args[1:]
 			}
 
 			// Copy function input arguments in local frame
 			for i, arg := range in {
 				if def.typ.arg[i].cat == interfaceT {
-					d[i].Set(reflect.ValueOf(valueInterface{value: arg.Elem()}))
+					args[i].Set(reflect.ValueOf(valueInterface{value: arg.Elem()}))
 				} else {
-					d[i].Set(arg)
+					args[i].Set(arg)
 				}
 			}
 
-			// Interpreter code execution
-			runCfg(start, &fr)
+			// Interpreter code execution. The function body is not captured,
+			// as the definition may be replaced by ReloadPath
+			runCfg(funcBody(def), fr)
 
 			result := fr.data[:numRet]
+			if reuse {
+				result = append([]reflect.Value(nil), result...)
+				putFrame(fr)
+			}
 			for i, r := range result {
 				if v, ok := r.Interface().(*node); ok {
 					result[i] = genFunctionWrapper(v)(f)
@@ -485,18 +927,63 @@ func genFunctionWrapper(n *node) func(*frame) reflect.Value {
 	}
 }
 
+// methodReceiver returns the receiver of a method value, of type t, from
+// src, possibly an embedded field, dereferenced or addressed as required by
+// the method. The receiver is copied, as it is evaluated with the method
+// value.
+func methodReceiver(src reflect.Value, t reflect.Type) reflect.Value {
+	switch {
+	case src.Kind() == t.Kind():
+	case src.Kind() == reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Value{}
+		}
+		src = src.Elem()
+	default:
+		src = src.Addr()
+	}
+	v := reflect.New(t).Elem()
+	v.Set(src)
+	return v
+}
+
 func genInterfaceWrapper(n *node, typ reflect.Type) func(*frame) reflect.Value {
 	value := genValue(n)
 	if typ == nil || typ.Kind() != reflect.Interface || typ.NumMethod() == 0 || n.typ.cat == valueT {
 		return value
 	}
+	if n.typ.cat == ptrT && n.typ.val.cat == valueT {
+		// Pointer to a binary type, which has binary methods only
+		return value
+	}
+	if n.typ.cat == interfaceT {
+		// The dynamic value of an interpreted interface is only known at run time
+		return func(f *frame) reflect.Value {
+			vi, ok := value(f).Interface().(valueInterface)
+			if !ok || vi.node == nil {
+				return reflect.New(typ).Elem()
+			}
+			if vi.value.Type().Implements(typ) {
+				// Binary values need no wrapper
+				return vi.value
+			}
+			nod := &node{kind: rvalueExpr, rval: vi.value, typ: vi.node.typ, interp: n.interp}
+			return genInterfaceWrapper(nod, typ)(f)
+		}
+	}
 	if nt := n.typ.TypeOf(); nt != nil && nt.Kind() == reflect.Interface {
 		return value
 	}
+	wrap := n.interp.getWrapper(typ)
+	if wrap == nil {
+		log.Println(n.cfgErrorf("genInterfaceWrapper error, no wrapper for %s", typ))
+		return value
+	}
 	mn := typ.NumMethod()
 	names := make([]string, mn)
 	methods := make([]*node, mn)
 	indexes := make([][]int, mn)
+	fields := make([]int, mn)
 	for i := 0; i < mn; i++ {
 		names[i] = typ.Method(i).Name
 		methods[i], indexes[i] = n.typ.lookupMethod(names[i])
@@ -504,22 +991,35 @@ func genInterfaceWrapper(n *node, typ reflect.Type) func(*frame) reflect.Value {
 			// interpreted method not found, look for binary method, possibly embedded
 			_, indexes[i], _ = n.typ.lookupBinMethod(names[i])
 		}
+		// The wrapper may implement more methods than typ, its fields are set by name
+		sf, ok := wrap.FieldByName("W" + names[i])
+		if !ok {
+			log.Println(n.cfgErrorf("genInterfaceWrapper error, no field for method %s in %s", names[i], wrap))
+			return value
+		}
+		fields[i] = sf.Index[0]
 	}
-	wrap := n.interp.getWrapper(typ)
 
+	isNil, isError := n.typ.cat == nilT, typ == errorType
 	return func(f *frame) reflect.Value {
 		v := value(f)
-		switch v.Kind() {
-		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice:
-			if v.IsNil() {
-				return reflect.New(typ).Elem()
-			}
+		if isNil || !v.IsValid() || v.Kind() == reflect.Interface && v.IsNil() {
+			// A nil interface value, whereas a nil pointer of a concrete
+			// type gives a non nil interface value
+			return reflect.New(typ).Elem()
 		}
 		w := reflect.New(wrap).Elem()
 		for i, m := range methods {
 			if m == nil {
-				if r := v.FieldByIndex(indexes[i]).MethodByName(names[i]); r.IsValid() {
-					w.Field(i).Set(v.FieldByIndex(indexes[i]).MethodByName(names[i]))
+				if v.Kind() == reflect.Ptr && v.IsNil() {
+					continue // the promoted method panics when called
+				}
+				fv := reflect.Indirect(v).FieldByIndex(indexes[i])
+				if fv.Kind() == reflect.Interface && fv.IsNil() {
+					continue // the method of the nil embedded interface panics when called
+				}
+				if r := methodByName(fv, names[i]); r.IsValid() {
+					w.Field(fields[i]).Set(r)
 				} else {
 					log.Println(n.cfgErrorf("genInterfaceWrapper error, no method %s", names[i]))
 				}
@@ -527,50 +1027,84 @@ func genInterfaceWrapper(n *node, typ reflect.Type) func(*frame) reflect.Value {
 			}
 			nod := *m
 			nod.recv = &receiver{n, v, indexes[i]}
-			w.Field(i).Set(genFunctionWrapper(&nod)(f))
+			w.Field(fields[i]).Set(genFunctionWrapper(&nod)(f))
+		}
+		if isError {
+			// The interpreted value is kept for errors.As
+			return reflect.ValueOf(errorValue{formatter{value: v, str: w.Interface().(error).Error}})
 		}
 		return w
 	}
 }
 
+// methodByName returns the method name of binary value v, possibly with a
+// pointer receiver if v is addressable, or an invalid value if not found.
+func methodByName(v reflect.Value, name string) reflect.Value {
+	m := v.MethodByName(name)
+	if !m.IsValid() && v.CanAddr() {
+		m = v.Addr().MethodByName(name)
+	}
+	return m
+}
+
+// binMethod returns the binary method name of value v of type t, possibly
+// promoted from an embedded binary field.
+func binMethod(v reflect.Value, t *itype, name string) reflect.Value {
+	if m := methodByName(v, name); m.IsValid() {
+		return m
+	}
+	if _, index, ok := t.lookupBinMethod(name); ok && len(index) > 0 {
+		return methodByName(reflect.Indirect(v).FieldByIndex(index), name)
+	}
+	return reflect.Value{}
+}
+
 func _defer(n *node) {
 	tnext := getExec(n.tnext)
 	values := make([]func(*frame) reflect.Value, len(n.child[0].child))
 	var method func(*frame) reflect.Value
 
 	for i, c := range n.child[0].child {
-		if c.typ.cat == funcT {
+		switch {
+		case i == 0 && c.typ.cat == funcT:
+			// The deferred function may recover the panic of the frame
+			wrap := genDeferredWrapper(c)
+			values[i] = func(f *frame) reflect.Value { return wrap(f, f) }
+		case c.typ.cat == funcT:
 			values[i] = genFunctionWrapper(c)
-		} else {
+		default:
 			if c.recv != nil {
 				// defer a method on a binary obj
 				mi := c.val.(int)
 				m := genValue(c.child[0])
 				method = func(f *frame) reflect.Value { return m(f).Method(mi) }
+				if t := c.child[0].typ.TypeOf(); t.Kind() != reflect.Ptr {
+					if _, ok := t.MethodByName(c.child[1].ident); !ok {
+						// Method with a pointer receiver, on an addressable value
+						method = func(f *frame) reflect.Value { return m(f).Addr().Method(mi) }
+					}
+				}
 			}
 			values[i] = genValue(c)
 		}
 	}
-
 	if method != nil {
-		n.exec = func(f *frame) bltn {
-			val := make([]reflect.Value, len(values))
-			val[0] = method(f)
-			for i, v := range values[1:] {
-				val[i+1] = v(f)
-			}
-			f.deferred = append([][]reflect.Value{val}, f.deferred...)
-			return tnext
-		}
-	} else {
-		n.exec = func(f *frame) bltn {
-			val := make([]reflect.Value, len(values))
-			for i, v := range values {
-				val[i] = v(f)
+		values[0] = method
+	}
+
+	// The function value and arguments are evaluated when the defer statement
+	// executes, and arguments are copied from the frame
+	n.exec = func(f *frame) bltn {
+		val := make([]reflect.Value, len(values))
+		for i, v := range values {
+			if val[i] = v(f); i > 0 && val[i].CanSet() {
+				a := reflect.New(val[i].Type()).Elem()
+				a.Set(val[i])
+				val[i] = a
 			}
-			f.deferred = append([][]reflect.Value{val}, f.deferred...)
-			return tnext
 		}
+		f.deferred = append([][]reflect.Value{val}, f.deferred...)
+		return tnext
 	}
 }
 
@@ -597,21 +1131,19 @@ func call(n *node) {
 	// compute input argument value functions
 	for i, c := range child {
 		switch {
-		case isBinCall(c):
+		case len(child) == 1 && isBinCall(c) && c.child[0].typ.rtype.NumOut() > 1:
 			// Handle nested function calls: pass returned values as arguments
-			numOut := c.child[0].typ.rtype.NumOut()
-			for j := 0; j < numOut; j++ {
-				ind := c.findex + j
-				values = append(values, func(f *frame) reflect.Value { return f.data[ind] })
+			rt := c.child[0].typ.rtype
+			for j := 0; j < rt.NumOut(); j++ {
+				values = append(values, genResultArg(n, c.findex+j, &itype{cat: valueT, rtype: rt.Out(j)}, j))
 			}
-		case isRegularCall(c):
+		case len(child) == 1 && isRegularCall(c) && len(c.child[0].typ.ret) > 1:
 			// Arguments are return values of a nested function call
-			for j := range c.child[0].typ.ret {
-				ind := c.findex + j
-				values = append(values, func(f *frame) reflect.Value { return f.data[ind] })
+			for j, t := range c.child[0].typ.ret {
+				values = append(values, genResultArg(n, c.findex+j, t, j))
 			}
 		default:
-			if c.kind == basicLit {
+			if c.kind == basicLit || c.cval != nil && c.typ.untyped {
 				var argType reflect.Type
 				if variadic >= 0 && i >= variadic {
 					argType = n.child[0].typ.arg[variadic].TypeOf()
@@ -620,14 +1152,37 @@ func call(n *node) {
 				}
 				convertLiteralValue(c, argType)
 			}
-			if len(n.child[0].typ.arg) > i && n.child[0].typ.arg[i].cat == interfaceT {
+			var arg *itype
+			switch {
+			case variadic >= 0 && i >= variadic:
+				if n.action != aCallSlice {
+					arg = n.child[0].typ.arg[variadic]
+				}
+			case len(n.child[0].typ.arg) > i:
+				arg = n.child[0].typ.arg[i]
+			}
+			switch {
+			case arg == nil:
+				values = append(values, genValue(c))
+			case arg.cat == interfaceT:
 				values = append(values, genValueInterface(c))
-			} else {
+			case arg.cat == valueT && arg.rtype.Kind() == reflect.Interface:
+				values = append(values, genInterfaceWrapper(c, arg.rtype))
+			case isDistinctStruct(c.typ, arg):
+				// Assignable struct types, with distinct reflection types
+				values = append(values, genValueAs(c, arg.TypeOf()))
+			default:
 				values = append(values, genValue(c))
 			}
 		}
 	}
 
+	// Index of variadic argument in values and in callee frame, after receiver
+	ivariadic := variadic
+	if method && variadic >= 0 {
+		ivariadic++
+	}
+
 	rtypes := n.child[0].typ.ret
 	rvalues := make([]func(*frame) reflect.Value, len(rtypes))
 	switch n.anc.kind {
@@ -640,25 +1195,81 @@ func call(n *node) {
 		}
 	default:
 		for i := range rtypes {
-			j := n.findex + i
-			rvalues[i] = func(f *frame) reflect.Value { return f.data[j] }
+			// The results may be stored in the variable of an enclosing frame
+			rvalues[i] = valueGenerator(n, n.findex+i)
 		}
 	}
 
 	n.exec = func(f *frame) bltn {
-		def := value(f).Interface().(*node)
-		anc := f
+		def, ok := value(f).Interface().(*node)
+		if !ok {
+			// Method of a binary value held by an interpreted interface
+			fv, args := value(f), values
+			if method {
+				args = values[1:]
+			}
+			in := binCallArgs(n, f, fv.Type(), args)
+			if goroutine {
+				n.interp.goroutine(func() { callBinValue(n, f, fv, in, nil) })
+				return tnext
+			}
+			callBinValue(n, f, fv, in, rvalues)
+			if fnext != nil && !rvalues[0](f).Bool() {
+				return fnext
+			}
+			return tnext
+		}
+		anc := closureFrame(def, f)
 		// Get closure frame context (if any)
 		if def.frame != nil {
 			anc = def.frame
 		}
-		nf := frame{anc: anc, data: make([]reflect.Value, len(def.types))}
+		if !def.interp.allocFrame(f, def) {
+			return nil
+		}
+		reuse := def.interp.reuseFrames(def)
+		var nf *frame
+		if reuse {
+			nf = getFrame(anc, len(def.types), f.runid())
+		} else {
+			nf = newFrame(anc, len(def.types), f.runid())
+		}
+		nf.done = f.done
+		if !goroutine {
+			nf.caller = n
+		}
+		if d := def.interp.debugger; d != nil {
+			d.enter(nf, f, def, goroutine)
+		}
+		if p := def.interp.profiler; p != nil {
+			p.enter(nf, f, def, goroutine)
+		}
+		if r := def.interp.racer; r != nil {
+			r.enter(nf, f, n, goroutine)
+		}
+		if t := def.interp.tracer; t != nil {
+			t.enter(nf, f, def, goroutine)
+		}
+		if s := def.interp.stats; s != nil {
+			s.enter(nf, def)
+		}
 		var vararg reflect.Value
 
-		// Init return values
+		// Init return values. They are copied to their destination on return,
+		// as the callee may read the variables where the results are stored.
+		var rets []reflect.Value
+		var retsBuf [4]reflect.Value
 		for i, v := range rvalues {
 			if v != nil {
-				nf.data[i] = v(f)
+				if rets == nil {
+					if len(rvalues) <= len(retsBuf) {
+						rets = retsBuf[:len(rvalues)]
+					} else {
+						rets = make([]reflect.Value, len(rvalues))
+					}
+				}
+				rets[i] = v(f)
+				nf.data[i] = reflect.New(rets[i].Type()).Elem()
 			} else {
 				nf.data[i] = reflect.New(def.types[i]).Elem()
 			}
@@ -671,7 +1282,7 @@ func call(n *node) {
 
 		// Init variadic argument vector
 		if variadic >= 0 {
-			vararg = nf.data[numRet+variadic]
+			vararg = nf.data[numRet+ivariadic]
 		}
 
 		// Copy input parameters from caller
@@ -704,7 +1315,12 @@ func call(n *node) {
 				} else {
 					d.Set(src)
 				}
-			case variadic >= 0 && i >= variadic:
+			case variadic >= 0 && i >= ivariadic:
+				if n.action == aCallSlice {
+					// The slice is passed as is
+					vararg.Set(convertSlice(n.interp, f, v(f), vararg.Type()))
+					break
+				}
 				vararg.Set(reflect.Append(vararg, v(f)))
 			default:
 				dest[i].Set(v(f))
@@ -713,16 +1329,160 @@ func call(n *node) {
 
 		// Execute function body
 		if goroutine {
-			go runCfg(def.child[3].start, &nf)
+			n.interp.goroutine(func() {
+				runCfg(funcBody(def), nf)
+				if reuse {
+					putFrame(nf)
+				}
+			})
 			return tnext
 		}
-		runCfg(def.child[3].start, &nf)
+		runCfg(funcBody(def), nf)
+		for i, r := range rets {
+			if r.IsValid() {
+				r.Set(nf.data[i])
+			}
+		}
 
 		// Handle branching according to boolean result
+		next := tnext
 		if fnext != nil && !nf.data[0].Bool() {
-			return fnext
+			next = fnext
 		}
-		return tnext
+		if reuse {
+			putFrame(nf)
+		}
+		return next
+	}
+}
+
+// binCallArgs returns the arguments of the call n of binary function type
+// ft, in place of an interpreted function, computed by values.
+func binCallArgs(n *node, f *frame, ft reflect.Type, values []func(*frame) reflect.Value) []reflect.Value {
+	variadic := -1
+	if ft.IsVariadic() {
+		variadic = ft.NumIn() - 1
+	}
+	in := make([]reflect.Value, len(values))
+	for i, v := range values {
+		a := v(f)
+		if vi, ok := a.Interface().(valueInterface); ok {
+			a = vi.value
+		}
+		if !a.IsValid() {
+			pt := ft.In(pindex(i, variadic))
+			if variadic >= 0 && i >= variadic && n.action != aCallSlice {
+				pt = pt.Elem()
+			}
+			a = reflect.Zero(pt)
+		} else if n.action == aCallSlice && i == len(values)-1 {
+			a = convertSlice(n.interp, f, a, ft.In(variadic))
+		}
+		in[i] = a
+	}
+	return in
+}
+
+// callBinValue calls the binary function fv with arguments in, in place of
+// the interpreted function of call n, and sets its results with rvalues.
+func callBinValue(n *node, f *frame, fv reflect.Value, in []reflect.Value, rvalues []func(*frame) reflect.Value) {
+	var out []reflect.Value
+	f.node = n
+	if n.action == aCallSlice {
+		out = fv.CallSlice(in)
+	} else {
+		out = fv.Call(in)
+	}
+	f.node = nil
+	for i, v := range rvalues {
+		if v == nil {
+			continue
+		}
+		if d := v(f); d.Type() == valueInterfaceType {
+			d.Set(reflect.ValueOf(valueInterface{&node{typ: &itype{cat: valueT, rtype: out[i].Type()}}, out[i]}))
+		} else {
+			d.Set(out[i])
+		}
+	}
+}
+
+// convertSlice returns the slice v as a slice of type t, with the same
+// elements. A binary slice of interfaces and an interpreted slice of interface
+// values are converted to each other, elements being copied in a new slice
+// accounted by interp in frame f.
+func convertSlice(interp *Interpreter, f *frame, v reflect.Value, t reflect.Type) reflect.Value {
+	ve, te := v.Type().Elem(), t.Elem()
+	if v.Type() == t || ve != valueInterfaceType && te != valueInterfaceType {
+		return v
+	}
+	if v.IsNil() {
+		return reflect.Zero(t)
+	}
+	if !interp.alloc(f, v.Len(), te.Size()) {
+		return reflect.Zero(t)
+	}
+	s := reflect.MakeSlice(t, v.Len(), v.Len())
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i)
+		if te == valueInterfaceType {
+			e = reflect.ValueOf(toValueInterface(e))
+		} else if e = sliceElem(f, e, te); !e.IsValid() {
+			continue
+		}
+		s.Index(i).Set(e)
+	}
+	return s
+}
+
+// sliceElem returns the element value v, as stored in a slice of element type
+// t: an interpreted interface value is converted to its binary value if t is
+// not an interpreted interface type.
+func sliceElem(f *frame, v reflect.Value, t reflect.Type) reflect.Value {
+	if t == valueInterfaceType {
+		return v
+	}
+	if vi, ok := v.Interface().(valueInterface); ok {
+		if v = binaryValue(f, vi); !v.IsValid() {
+			return reflect.Zero(t)
+		}
+	}
+	return v
+}
+
+// genCallSliceArg returns a generator of the value of slice n, passed as the
+// variadic argument of type t of a binary function. The elements of the slice
+// of an interpreted interface type are converted to binary values.
+func genCallSliceArg(n *node, t reflect.Type) func(*frame) reflect.Value {
+	value := genValue(n)
+	if n.typ.cat != arrayT || n.typ.val.cat != interfaceT {
+		return value
+	}
+	et := t.Elem()
+	return func(f *frame) reflect.Value {
+		v := value(f)
+		if v.IsNil() {
+			return reflect.Zero(t)
+		}
+		s := reflect.MakeSlice(t, v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i)
+			vi, ok := e.Interface().(valueInterface)
+			if !ok {
+				// Slice declared without value, holding binary values
+				s.Index(i).Set(e)
+				continue
+			}
+			e = binaryValue(f, vi)
+			switch {
+			case !e.IsValid():
+				continue
+			case et.NumMethod() > 0 && !e.Type().Implements(et):
+				nod := &node{kind: rvalueExpr, rval: e, typ: vi.node.typ, interp: n.interp}
+				e = genInterfaceWrapper(nod, et)(f)
+			}
+			s.Index(i).Set(e)
+		}
+		return s
 	}
 }
 
@@ -734,6 +1494,17 @@ func pindex(i, variadic int) int {
 	return variadic
 }
 
+// genResultArg returns a function returning the value at index ind in frame,
+// of type t, a result of a nested call passed as argument i of call n.
+func genResultArg(n *node, ind int, t *itype, i int) func(*frame) reflect.Value {
+	value := func(f *frame) reflect.Value { return f.data[ind] }
+	if i >= len(n.child[0].typ.arg) || n.child[0].typ.arg[i].cat != interfaceT || isInterface(t) {
+		return value
+	}
+	nod := &node{typ: t}
+	return func(f *frame) reflect.Value { return reflect.ValueOf(valueInterface{nod, value(f)}) }
+}
+
 // Call a function from a bin import, accessible through reflect
 func callBin(n *node) {
 	tnext := getExec(n.tnext)
@@ -748,13 +1519,17 @@ func callBin(n *node) {
 	}
 	// method signature obtained from reflect.Type include receiver as 1st arg, except for interface types
 	rcvrOffset := 0
-	if recv := n.child[0].recv; recv != nil && recv.node.typ.TypeOf().Kind() != reflect.Interface {
+	if recv := n.child[0].recv; recv != nil && recv.node != n.child[0] && recv.node.typ.TypeOf().Kind() != reflect.Interface {
+		// An array or map element is its own receiver, unlike a method
 		rcvrOffset = 1
 	}
 
 	for i, c := range child {
-		defType := funcType.In(pindex(i, variadic))
+		defType := funcType.In(pindex(i+rcvrOffset, variadic))
 		switch {
+		case n.action == aCallSlice && i == len(child)-1:
+			// The slice is passed as the variadic argument
+			values = append(values, genCallSliceArg(c, defType))
 		case isBinCall(c):
 			// Handle nested function calls: pass returned values as arguments
 			numOut := c.child[0].typ.rtype.NumOut()
@@ -764,15 +1539,36 @@ func callBin(n *node) {
 			}
 		case isRegularCall(c):
 			// Handle nested function calls: pass returned values as arguments
-			for j := range c.child[0].typ.ret {
+			for j, t := range c.child[0].typ.ret {
 				ind := c.findex + j
+				if t.cat == funcT {
+					// Returned interpreted functions are wrapped in runtime functions
+					rtype := t.TypeOf()
+					values = append(values, func(f *frame) reflect.Value {
+						if v := f.data[ind]; !v.IsNil() {
+							return genFunctionWrapper(v.Interface().(*node))(f)
+						}
+						return reflect.Zero(rtype)
+					})
+					continue
+				}
+				if t.cat == interfaceT {
+					// Returned interpreted interfaces are passed by their concrete value
+					values = append(values, func(f *frame) reflect.Value {
+						if v := f.data[ind].Interface().(valueInterface).value; v.IsValid() {
+							return v
+						}
+						return reflect.Zero(emptyInterfaceType)
+					})
+					continue
+				}
 				values = append(values, func(f *frame) reflect.Value { return f.data[ind] })
 			}
 		default:
-			if c.kind == basicLit {
+			if c.kind == basicLit || c.cval != nil && c.typ.untyped {
 				// Convert literal value (untyped) to function argument type (if not an interface{})
 				var argType reflect.Type
-				if variadic >= 0 && i >= variadic {
+				if variadic >= 0 && i+rcvrOffset >= variadic {
 					argType = funcType.In(variadic).Elem()
 				} else {
 					argType = funcType.In(i + rcvrOffset)
@@ -782,11 +1578,18 @@ func callBin(n *node) {
 					c.val = reflect.Zero(argType)
 				}
 			}
-			switch c.typ.cat {
-			case funcT:
+			switch {
+			case c.typ.cat == funcT:
 				values = append(values, genFunctionWrapper(c))
-			case interfaceT:
-				values = append(values, genValueInterfaceValue(c))
+			case variadic >= 0 && i+rcvrOffset >= variadic && defType.Elem() == emptyInterfaceType:
+				// Formatted by its interpreted methods, as by fmt.Println
+				values = append(values, genFormatValue(c))
+			case c.typ.cat == interfaceT:
+				if defType.Kind() == reflect.Interface && defType.NumMethod() > 0 {
+					values = append(values, genInterfaceWrapper(c, defType))
+				} else {
+					values = append(values, genValueInterfaceValue(c))
+				}
 			default:
 				//values = append(values, genValue(c))
 				values = append(values, genInterfaceWrapper(c, defType))
@@ -794,6 +1597,28 @@ func callBin(n *node) {
 		}
 	}
 	l := len(values)
+	rcall := reflect.Value.Call
+	if n.action == aCallSlice {
+		rcall = reflect.Value.CallSlice
+	}
+	// Struct fields returned by reflection have the tags of their declaration
+	var fieldOut []int
+	for i := 0; i < funcType.NumOut(); i++ {
+		if t := funcType.Out(i); t == structFieldType || t == reflect.SliceOf(structFieldType) {
+			fieldOut = append(fieldOut, i)
+		}
+	}
+	// call records n as the running call of frame f, for the panic traces
+	// of interpreted functions called back by the binary function
+	call := func(f *frame, fv reflect.Value, in []reflect.Value) []reflect.Value {
+		f.node = n
+		out := rcall(fv, in)
+		f.node = nil
+		for _, i := range fieldOut {
+			out[i] = userFields(out[i])
+		}
+		return out
+	}
 
 	switch {
 	case n.anc.kind == goStmt:
@@ -803,7 +1628,7 @@ func callBin(n *node) {
 			for i, v := range values {
 				in[i] = v(f)
 			}
-			go value(f).Call(in)
+			go rcall(value(f), in)
 			return tnext
 		}
 	case fnext != nil:
@@ -813,15 +1638,17 @@ func callBin(n *node) {
 			for i, v := range values {
 				in[i] = v(f)
 			}
-			res := value(f).Call(in)
+			res := call(f, value(f), in)
 			if res[0].Bool() {
 				return tnext
 			}
 			return fnext
 		}
 	default:
-		switch n.anc.kind {
-		case defineStmt, assignStmt, defineXStmt, assignXStmt:
+		switch a := n.anc; {
+		case a.kind == defineStmt && a.nleft == 1 && !isInterfaceConv(a.child[0], n), a.kind == assignStmt && a.action == aAssign && a.nleft == 1 && !isMapEntry(a.child[0]) && !isInterfaceConv(a.child[0], n), a.kind == defineXStmt, a.kind == assignXStmt:
+			// Results are stored in destinations, except a single result converted
+			// to an interface value by assign
 			rvalues := make([]func(*frame) reflect.Value, funcType.NumOut())
 			for i := range rvalues {
 				c := n.anc.child[i]
@@ -834,10 +1661,16 @@ func callBin(n *node) {
 				for i, v := range values {
 					in[i] = v(f)
 				}
-				out := value(f).Call(in)
+				out := call(f, value(f), in)
 				for i, v := range rvalues {
-					if v != nil {
-						v(f).Set(out[i])
+					if v == nil {
+						continue
+					}
+					if d := v(f); d.Type() == valueInterfaceType {
+						// Binary result stored in an interpreted interface
+						d.Set(reflect.ValueOf(toValueInterface(out[i])))
+					} else {
+						d.Set(out[i])
 					}
 				}
 				return tnext
@@ -848,7 +1681,7 @@ func callBin(n *node) {
 				for i, v := range values {
 					in[i] = v(f)
 				}
-				out := value(f).Call(in)
+				out := call(f, value(f), in)
 				copy(f.data[n.findex:], out)
 				return tnext
 			}
@@ -863,6 +1696,13 @@ func getIndexBinMethod(n *node) {
 	value := genValue(n.child[0])
 	next := getExec(n.tnext)
 
+	if log := genTestLog(n, n.child[0].typ.TypeOf()); log != nil {
+		n.exec = func(f *frame) bltn {
+			f.data[i] = log(f, value(f))
+			return next
+		}
+		return
+	}
 	n.exec = func(f *frame) bltn {
 		// Can not use .Set() because dest type contains the receiver and source not
 		//dest(f).Set(value(f).Method(m))
@@ -877,6 +1717,13 @@ func getIndexBinPtrMethod(n *node) {
 	value := genValue(n.child[0])
 	next := getExec(n.tnext)
 
+	if log := genTestLog(n, reflect.PtrTo(n.child[0].typ.TypeOf())); log != nil {
+		n.exec = func(f *frame) bltn {
+			f.data[i] = log(f, value(f).Addr())
+			return next
+		}
+		return
+	}
 	n.exec = func(f *frame) bltn {
 		// Can not use .Set() because dest type contains the receiver and source not
 		f.data[i] = value(f).Addr().Method(m)
@@ -887,7 +1734,7 @@ func getIndexBinPtrMethod(n *node) {
 // getIndexArray returns array value from index
 func getIndexArray(n *node) {
 	tnext := getExec(n.tnext)
-	value0 := genValue(n.child[0]) // array
+	value0 := genValueArray(n.child[0]) // array
 
 	if n.child[1].rval.IsValid() { // constant array index
 		ai := int(vInt(n.child[1].rval))
@@ -937,6 +1784,11 @@ func getIndexMap(n *node) {
 	value0 := genValue(n.child[0]) // map
 	tnext := getExec(n.tnext)
 	z := reflect.New(n.child[0].typ.TypeOf().Elem()).Elem()
+	convertLiteralValue(n.child[1], n.child[0].typ.TypeOf().Key())
+	elem := genMapElem(n)
+	if n.typ.cat == interfaceT {
+		z = reflect.ValueOf(valueInterface{})
+	}
 
 	if n.child[1].rval.IsValid() { // constant map index
 		mi := n.child[1].rval
@@ -952,7 +1804,7 @@ func getIndexMap(n *node) {
 		} else {
 			n.exec = func(f *frame) bltn {
 				if v := value0(f).MapIndex(mi); v.IsValid() {
-					dest(f).Set(v)
+					dest(f).Set(elem(v))
 				} else {
 					dest(f).Set(z)
 				}
@@ -973,7 +1825,7 @@ func getIndexMap(n *node) {
 		} else {
 			n.exec = func(f *frame) bltn {
 				if v := value0(f).MapIndex(value1(f)); v.IsValid() {
-					dest(f).Set(v)
+					dest(f).Set(elem(v))
 				} else {
 					dest(f).Set(z)
 				}
@@ -983,19 +1835,63 @@ func getIndexMap(n *node) {
 	}
 }
 
+// genMapElem returns a function converting a value of the map indexed by n
+// to the value of n. The values of interface type, stored in maps as binary
+// interface values, are converted to interpreter interface values.
+func genMapElem(n *node) func(reflect.Value) reflect.Value {
+	if n.typ.cat != interfaceT {
+		return func(v reflect.Value) reflect.Value { return v }
+	}
+	return func(v reflect.Value) reflect.Value { return reflect.ValueOf(toValueInterface(v)) }
+}
+
+// storeMapEntry returns a generator of the operation of gen, which updates
+// the values of the map entries dest read by getIndexMap, followed by the
+// store of the updated values in their maps, as a map entry is not
+// addressable.
+func storeMapEntry(gen bltnGenerator, dest []*node) bltnGenerator {
+	return func(n *node) {
+		gen(n)
+		exec := n.exec
+		var stores []func(*frame)
+		for _, d := range dest {
+			if !isMapEntry(d) {
+				continue
+			}
+			convertLiteralValue(d.child[1], d.child[0].typ.TypeOf().Key())
+			m := genValue(d.child[0])
+			k := genValue(d.child[1])
+			if d.child[1].typ.cat == interfaceT {
+				k = genValueInterface(d.child[1])
+			}
+			v := genValue(d)
+			stores = append(stores, func(f *frame) { m(f).SetMapIndex(k(f), v(f)) })
+		}
+		n.exec = func(f *frame) bltn {
+			next := exec(f)
+			for _, store := range stores {
+				store(f)
+			}
+			return next
+		}
+	}
+}
+
 // getIndexMap2 retrieves map value from index and set status
 func getIndexMap2(n *node) {
 	dest := genValue(n.anc.child[0])   // result
 	value0 := genValue(n.child[0])     // map
 	value2 := genValue(n.anc.child[1]) // status
 	next := getExec(n.tnext)
+	convertLiteralValue(n.child[1], n.child[0].typ.TypeOf().Key())
+	elem := genMapElem(n)
 
 	if n.child[1].rval.IsValid() { // constant map index
 		mi := n.child[1].rval
 		n.exec = func(f *frame) bltn {
 			v := value0(f).MapIndex(mi)
 			if v.IsValid() {
-				dest(f).Set(v)
+				dest(f).Set(elem(v))
 			}
 			value2(f).SetBool(v.IsValid())
 			return next
@@ -1005,7 +1901,7 @@ func getIndexMap2(n *node) {
 		n.exec = func(f *frame) bltn {
 			v := value0(f).MapIndex(value1(f))
 			if v.IsValid() {
-				dest(f).Set(v)
+				dest(f).Set(elem(v))
 			}
 			value2(f).SetBool(v.IsValid())
 			return next
@@ -1018,7 +1914,14 @@ func getFunc(n *node) {
 	next := getExec(n.tnext)
 
 	n.exec = func(f *frame) bltn {
-		fr := *f
+		var captured int
+		if n.scope != nil && n.scope.captures {
+			captured = len(f.data)
+		}
+		if !n.interp.allocClosure(f, captured) {
+			return nil
+		}
+		fr := *closureFrame(n, f)
 		nod := *n
 		nod.val = &nod
 		nod.frame = &fr
@@ -1027,11 +1930,26 @@ func getFunc(n *node) {
 	}
 }
 
+// closureFrame returns the frame of function literal def created in frame f:
+// a function capturing variables of a loop body keeps the ones of the current
+// iteration, which are renewed in f at the end of the iteration.
+func closureFrame(def *node, f *frame) *frame {
+	if def.scope == nil || !def.scope.captures {
+		return f
+	}
+	fr := *f
+	fr.data = append([]reflect.Value(nil), f.data...)
+	return &fr
+}
+
 func getMethod(n *node) {
 	i := n.findex
 	next := getExec(n.tnext)
 
 	n.exec = func(f *frame) bltn {
+		if !n.interp.allocClosure(f, 0) {
+			return nil
+		}
 		fr := *f
 		nod := *(n.val.(*node))
 		nod.val = &nod
@@ -1050,7 +1968,18 @@ func getMethodByName(n *node) {
 
 	n.exec = func(f *frame) bltn {
 		val := value0(f).Interface().(valueInterface)
+		if val.node == nil {
+			panic(runtimeError("invalid memory address or nil pointer dereference"))
+		}
 		m, li := val.node.typ.lookupMethod(name)
+		if m == nil {
+			// Method of a binary value, called by reflection
+			f.data[i] = binMethod(val.value, val.node.typ, name)
+			return next
+		}
+		if !n.interp.allocClosure(f, 0) {
+			return nil
+		}
 		fr := *f
 		nod := *m
 		nod.val = &nod
@@ -1061,6 +1990,20 @@ func getMethodByName(n *node) {
 	}
 }
 
+// getBinMethodByName gets the method of a binary interface embedded in an
+// interpreted interface, from the dynamic value wrapped in the binary interface.
+func getBinMethodByName(n *node) {
+	next := getExec(n.tnext)
+	wrap := genInterfaceWrapper(n.child[0], n.child[0].typ.fieldSeq(n.val.([]int)).TypeOf())
+	name := n.child[1].ident
+	i := n.findex
+
+	n.exec = func(f *frame) bltn {
+		f.data[i] = wrap(f).MethodByName(name)
+		return next
+	}
+}
+
 func getIndexSeq(n *node) {
 	value := genValue(n.child[0])
 	index := n.val.([]int)
@@ -1138,16 +2081,31 @@ func getIndexSeqMethod(n *node) {
 	i := n.findex
 	next := getExec(n.tnext)
 
-	if n.child[0].typ.TypeOf().Kind() == reflect.Ptr {
-		n.exec = func(f *frame) bltn {
-			f.data[i] = value(f).Elem().FieldByIndex(fi).Method(mi)
-			return next
+	rt := n.child[0].typ.TypeOf()
+	isPtr := rt.Kind() == reflect.Ptr
+	if isPtr {
+		rt = rt.Elem()
+	}
+	// A method with a pointer receiver of an embedded binary value applies to
+	// the address of the field.
+	var addr bool
+	if len(fi) > 0 {
+		ft := rt.FieldByIndex(fi).Type
+		_, ok := ft.MethodByName(n.child[1].ident)
+		addr = !ok && ft.Kind() != reflect.Ptr
+	}
+
+	n.exec = func(f *frame) bltn {
+		v := value(f)
+		if isPtr {
+			v = v.Elem()
 		}
-	} else {
-		n.exec = func(f *frame) bltn {
-			f.data[i] = value(f).FieldByIndex(fi).Method(mi)
-			return next
+		v = v.FieldByIndex(fi)
+		if addr {
+			v = v.Addr()
 		}
+		f.data[i] = v.Method(mi)
+		return next
 	}
 }
 
@@ -1162,6 +2120,11 @@ func negate(n *node) {
 			dest(f).SetInt(-value(f).Int())
 			return next
 		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n.exec = func(f *frame) bltn {
+			dest(f).SetUint(-value(f).Uint())
+			return next
+		}
 	case reflect.Float32, reflect.Float64:
 		n.exec = func(f *frame) bltn {
 			dest(f).SetFloat(-value(f).Float())
@@ -1175,6 +2138,36 @@ func negate(n *node) {
 	}
 }
 
+func bitNot(n *node) {
+	dest := genValue(n)
+	value := genValue(n.child[0])
+	next := getExec(n.tnext)
+
+	switch n.typ.TypeOf().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n.exec = func(f *frame) bltn {
+			dest(f).SetInt(^value(f).Int())
+			return next
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n.exec = func(f *frame) bltn {
+			dest(f).SetUint(^value(f).Uint())
+			return next
+		}
+	}
+}
+
+func pos(n *node) {
+	dest := genValue(n)
+	value := genValue(n.child[0])
+	next := getExec(n.tnext)
+
+	n.exec = func(f *frame) bltn {
+		dest(f).Set(value(f))
+		return next
+	}
+}
+
 func land(n *node) {
 	value0 := genValue(n.child[0])
 	value1 := genValue(n.child[1])
@@ -1231,6 +2224,16 @@ func nop(n *node) {
 	}
 }
 
+// paren generates a parenthesized expression, which branches on its value if
+// it is a condition.
+func paren(n *node) {
+	if n.fnext != nil {
+		branch(n)
+		return
+	}
+	nop(n)
+}
+
 func branch(n *node) {
 	tnext := getExec(n.tnext)
 	fnext := getExec(n.fnext)
@@ -1255,6 +2258,15 @@ func _return(n *node) {
 			values[i] = genInterfaceWrapper(c, t.TypeOf())
 		case interfaceT:
 			values[i] = genValueInterface(c)
+		case valueT:
+			values[i] = genInterfaceWrapper(c, t.rtype)
+		case structT:
+			if isDistinctStruct(c.typ, t) {
+				// Assignable struct types, with distinct reflection types
+				values[i] = genValueAs(c, t.TypeOf())
+				break
+			}
+			values[i] = genValue(c)
 		default:
 			values[i] = genValue(c)
 		}
@@ -1264,7 +2276,8 @@ func _return(n *node) {
 	case 0:
 		n.exec = func(f *frame) bltn { return next }
 	case 1:
-		if child[0].kind == binaryExpr {
+		if child[0].kind == binaryExpr && !child[0].rval.IsValid() {
+			// Result is already set in frame by the expression
 			n.exec = func(f *frame) bltn { return next }
 		} else {
 			v := values[0]
@@ -1304,29 +2317,44 @@ func arrayLit(n *node) {
 	var max, prev int
 
 	for i, c := range child {
+		elem := c
 		if c.kind == keyValueExpr {
-			convertLiteralValue(c.child[1], rtype)
-			values[i] = genValue(c.child[1])
+			elem = c.child[1]
 			index[i] = int(c.child[0].rval.Int())
 		} else {
-			convertLiteralValue(c, rtype)
-			values[i] = genValue(c)
 			index[i] = prev
 		}
+		convertLiteralValue(elem, rtype)
+		if n.typ.val.cat == interfaceT {
+			// Elements of interpreted interface type are stored as interface values
+			values[i] = genValueInterface(elem)
+		} else {
+			values[i] = genValueLitElem(elem, rtype)
+		}
 		prev = index[i] + 1
 		if prev > max {
 			max = prev
 		}
 	}
 
-	var a reflect.Value
-	if n.typ.size > 0 {
-		a, _ = n.typ.zero()
-	} else {
-		a = reflect.MakeSlice(n.typ.TypeOf(), max, max)
+	var alen int
+	if n.typ.size == 0 {
+		alen = max
 	}
+	typ := n.typ.frameType()
+	size := rtype.Size()
 
 	n.exec = func(f *frame) bltn {
+		if !n.interp.alloc(f, alen, size) {
+			return nil
+		}
+		// Each evaluation of the literal allocates a new array
+		var a reflect.Value
+		if n.typ.size > 0 {
+			a = reflect.New(typ).Elem()
+		} else {
+			a = reflect.MakeSlice(typ, max, max)
+		}
 		for i, v := range values {
 			a.Index(index[i]).Set(v(f))
 		}
@@ -1348,11 +2376,16 @@ func mapLit(n *node) {
 	for i, c := range child {
 		convertLiteralValue(c.child[0], n.typ.key.TypeOf())
 		convertLiteralValue(c.child[1], n.typ.val.TypeOf())
-		keys[i] = genValue(c.child[0])
-		values[i] = genValue(c.child[1])
+		keys[i] = genValueLitElem(c.child[0], n.typ.key.TypeOf())
+		values[i] = genValueLitElem(c.child[1], n.typ.val.TypeOf())
 	}
 
+	size := typ.Key().Size() + typ.Elem().Size()
+
 	n.exec = func(f *frame) bltn {
+		if !n.interp.alloc(f, len(keys), size) {
+			return nil
+		}
 		m := reflect.MakeMap(typ)
 		for i, k := range keys {
 			m.SetMapIndex(k(f), values[i](f))
@@ -1375,11 +2408,16 @@ func compositeBinMap(n *node) {
 	for i, c := range child {
 		convertLiteralValue(c.child[0], typ.Key())
 		convertLiteralValue(c.child[1], typ.Elem())
-		keys[i] = genValue(c.child[0])
-		values[i] = genValue(c.child[1])
+		keys[i] = genValueLitElem(c.child[0], typ.Key())
+		values[i] = genValueLitElem(c.child[1], typ.Elem())
 	}
 
+	size := typ.Key().Size() + typ.Elem().Size()
+
 	n.exec = func(f *frame) bltn {
+		if !n.interp.alloc(f, len(keys), size) {
+			return nil
+		}
 		m := reflect.MakeMap(typ)
 		for i, k := range keys {
 			m.SetMapIndex(k(f), values[i](f))
@@ -1468,7 +2506,8 @@ func compositeSparse(n *node) {
 	}
 
 	values := make(map[int]func(*frame) reflect.Value)
-	a, _ := n.typ.zero()
+	z, _ := n.typ.zero()
+	typ := z.Type()
 	for _, c := range child {
 		c1 := c.child[1]
 		field := n.typ.fieldIndex(c.child[0].ident)
@@ -1481,6 +2520,7 @@ func compositeSparse(n *node) {
 	}
 
 	n.exec = func(f *frame) bltn {
+		a := reflect.New(typ).Elem()
 		for i, v := range values {
 			a.Field(i).Set(v(f))
 		}
@@ -1493,30 +2533,38 @@ func empty(n *node) {}
 
 func _range(n *node) {
 	index0 := n.child[0].findex // array index location in frame
+	index2 := n.findex          // array location in frame, evaluated once
 	fnext := getExec(n.fnext)
 	tnext := getExec(n.tnext)
 
+	o := n.child[len(n.child)-2]
+	value := genValue(o) // array
+	array := func(f *frame) reflect.Value { return f.data[index2] }
+	length := func(f *frame) int { return f.data[index2].Len() }
+	if at := arrayPtrElem(o.typ); at != nil {
+		// The length of an array pointer is the one of its type, even if nil
+		l := at.TypeOf().Len()
+		array = func(f *frame) reflect.Value { return f.data[index2].Elem() }
+		length = func(*frame) int { return l }
+	}
+
 	if len(n.child) == 4 {
-		index1 := n.child[1].findex   // array value location in frame
-		value := genValue(n.child[2]) // array
+		index1 := n.child[1].findex // array value location in frame
 		n.exec = func(f *frame) bltn {
-			a := value(f)
 			v0 := f.data[index0]
 			v0.SetInt(v0.Int() + 1)
 			i := int(v0.Int())
-			if i >= a.Len() {
+			if i >= length(f) {
 				return fnext
 			}
-			f.data[index1].Set(a.Index(i))
+			f.data[index1].Set(array(f).Index(i))
 			return tnext
 		}
 	} else {
-		value := genValue(n.child[1]) // array
 		n.exec = func(f *frame) bltn {
-			a := value(f)
 			v0 := f.data[index0]
 			v0.SetInt(v0.Int() + 1)
-			if int(v0.Int()) >= a.Len() {
+			if int(v0.Int()) >= length(f) {
 				return fnext
 			}
 			return tnext
@@ -1526,6 +2574,51 @@ func _range(n *node) {
 	// Init sequence
 	next := n.exec
 	n.child[0].exec = func(f *frame) bltn {
+		f.data[index2].Set(value(f))
+		f.data[index0].SetInt(-1)
+		return next
+	}
+}
+
+// rangeString iterates over the runes of a string, the index being the byte
+// offset of the current rune, and an invalid UTF-8 byte decoded as one
+// utf8.RuneError rune.
+func rangeString(n *node) {
+	index0 := n.child[0].findex // string index location in frame
+	index2 := n.findex          // string location in frame, evaluated once
+	fnext := getExec(n.fnext)
+	tnext := getExec(n.tnext)
+
+	index1 := -1 // rune location in frame
+	if len(n.child) == 4 {
+		index1 = n.child[1].findex
+	}
+	value := genValue(n.child[len(n.child)-2])
+	n.exec = func(f *frame) bltn {
+		s := f.data[index2].String()
+		v0 := f.data[index0]
+		i := int(v0.Int())
+		if i < 0 {
+			i = 0
+		} else {
+			_, w := utf8.DecodeRuneInString(s[i:])
+			i += w
+		}
+		if i >= len(s) {
+			return fnext
+		}
+		v0.SetInt(int64(i))
+		if index1 >= 0 {
+			r, _ := utf8.DecodeRuneInString(s[i:])
+			f.data[index1].SetInt(int64(r))
+		}
+		return tnext
+	}
+
+	// Init sequence
+	next := n.exec
+	n.child[0].exec = func(f *frame) bltn {
+		f.data[index2].Set(value(f))
 		f.data[index0].SetInt(-1)
 		return next
 	}
@@ -1538,7 +2631,10 @@ func rangeChan(n *node) {
 	tnext := getExec(n.tnext)
 
 	n.exec = func(f *frame) bltn {
-		v, ok := value(f).Recv()
+		v, ok, stopped := chanRecv(f, value(f))
+		if stopped {
+			return nil
+		}
 		if !ok {
 			return fnext
 		}
@@ -1547,36 +2643,190 @@ func rangeChan(n *node) {
 	}
 }
 
+// mapRange is the state of a range loop over a map, stored in the frame of
+// the loop: the keys of the map, taken at the first iteration, and the
+// position of the current key. Entries added during the iterations are not
+// produced, and entries deleted before being reached are skipped.
+type mapRange struct {
+	m    reflect.Value
+	keys []reflect.Value
+	i    int
+}
+
+var mapRangeType = reflect.TypeOf((*mapRange)(nil))
+
 func rangeMap(n *node) {
-	index0 := n.child[0].findex   // array index location in frame
-	index1 := n.child[1].findex   // array value location in frame
-	value := genValue(n.child[2]) // array
+	index0 := n.child[0].findex // map key location in frame
+	index1 := -1                // map value location in frame
+	index2 := n.findex          // map range state location in frame
+	key, elem := genMapElem(n.child[0]), genMapElem(n.child[0])
+	if len(n.child) == 4 {
+		index1 = n.child[1].findex
+		elem = genMapElem(n.child[1])
+	}
+	value := genValue(n.child[len(n.child)-2]) // map
 	fnext := getExec(n.fnext)
 	tnext := getExec(n.tnext)
-	// TODO: move i and keys to frame
-	var i int
-	var keys []reflect.Value
+	var count int64 // executions of the range statement, when seeded
 
 	n.exec = func(f *frame) bltn {
-		a := value(f)
-		i++
-		if i >= a.Len() {
-			return fnext
+		r := f.data[index2].Interface().(*mapRange)
+		for r.i++; r.i < len(r.keys); r.i++ {
+			k := r.keys[r.i]
+			v := r.m.MapIndex(k)
+			if !v.IsValid() {
+				// Deleted entry
+				continue
+			}
+			f.data[index0].Set(key(k))
+			if index1 >= 0 {
+				f.data[index1].Set(elem(v))
+			}
+			return tnext
 		}
-		f.data[index0].Set(keys[i])
-		f.data[index1].Set(a.MapIndex(keys[i]))
-		return tnext
+		return fnext
 	}
 
 	// Init sequence
 	next := n.exec
 	n.child[0].exec = func(f *frame) bltn {
-		keys = value(f).MapKeys()
-		i = -1
+		m := value(f)
+		keys := m.MapKeys()
+		if n.interp.seed != 0 {
+			n.interp.shuffleKeys(n, atomic.AddInt64(&count, 1), keys)
+		}
+		f.data[index2].Set(reflect.ValueOf(&mapRange{m: m, keys: keys, i: -1}))
 		return next
 	}
 }
 
+// States of a range over function loop, other than the index of the branch
+// statement exiting the loop body.
+const (
+	rangeFuncNext   = -1 // the loop body completed, the iteration can continue
+	rangeFuncReturn = -2 // the loop body is running, or returned
+)
+
+// rangeFunc runs the range over function loop n: the loop body is run by the
+// yield function passed to the iterator. A branch statement exiting the body
+// makes yield return false, and is completed once the iterator returns.
+func rangeFunc(n *node) {
+	var k, o, body *node
+	if len(n.child) == 4 {
+		k, o, body = n.child[0], n.child[2], n.child[3]
+	} else {
+		k, o, body = n.child[0], n.child[1], n.child[2]
+	}
+	yt := rangeYield(o.typ)
+	index0 := n.findex     // iteration state location in frame
+	index1 := n.findex + 1 // yield function location in frame
+	nargs := len(yt.arg)
+	if yt.cat == valueT {
+		nargs = yt.rtype.NumIn()
+	}
+	var vars []int // iteration variables locations in frame
+	if nargs > 0 {
+		vars = append(vars, k.findex)
+	}
+	if nargs > 1 && len(n.child) == 4 {
+		vars = append(vars, n.child[1].findex)
+	}
+	fnext := getExec(n.fnext)
+
+	// Branch statements exiting the body stop the iteration, and go to
+	// their target after the iterator has returned.
+	var exits []*node
+	body.Walk(func(c *node) bool {
+		switch c.kind {
+		case funcLit:
+			return false
+		case breakStmt, continueStmt, gotoStmt:
+			if c.tnext != nil && !isAncestor(body, c.tnext) {
+				i := int64(len(exits))
+				exits = append(exits, c.tnext)
+				c.tnext = &node{interp: n.interp, pos: c.pos, exec: func(f *frame) bltn {
+					f.data[index0].SetInt(i)
+					return nil
+				}}
+			}
+		}
+		return true
+	}, nil)
+	body.tnext = &node{interp: n.interp, pos: body.pos, exec: func(f *frame) bltn {
+		f.data[index0].SetInt(rangeFuncNext)
+		return nil
+	}}
+	setExec(body.start)
+	next := make([]bltn, len(exits))
+	for i, t := range exits {
+		next[i] = getExec(t)
+	}
+
+	// run runs the loop body in frame f for the iteration values args, and
+	// returns true if the iteration can continue.
+	run := func(f *frame, args []reflect.Value) bool {
+		state := f.data[index0]
+		if state.Int() != rangeFuncNext {
+			panic(runtimeError("range function continued iteration after function for loop body returned false"))
+		}
+		for i, j := range vars {
+			f.data[j].Set(args[i])
+		}
+		state.SetInt(rangeFuncReturn)
+		execCfg(body.start, f)
+		return state.Int() == rangeFuncNext
+	}
+
+	var iterate func(f *frame)
+	if yt.cat == valueT {
+		// Binary iterator, the yield function is a runtime function
+		value := genValue(o)
+		rt := yt.rtype.Out(0)
+		iterate = func(f *frame) {
+			yield := reflect.MakeFunc(yt.rtype, func(in []reflect.Value) []reflect.Value {
+				return []reflect.Value{reflect.ValueOf(run(f, in)).Convert(rt)}
+			})
+			value(f).Call([]reflect.Value{yield})
+		}
+	} else {
+		// Interpreted iterator, the yield function is a function literal
+		// of the loop frame, called as iterator argument
+		def := &node{kind: funcLit, anc: n, pos: n.pos, interp: n.interp, typ: yt}
+		def.types = []reflect.Type{yt.ret[0].frameType()}
+		for _, t := range yt.arg {
+			def.types = append(def.types, t.frameType())
+		}
+		start := &node{interp: n.interp, pos: body.pos}
+		start.exec = func(nf *frame) bltn {
+			nf.data[0].SetBool(run(nf.anc, nf.data[1:]))
+			return nil
+		}
+		def.child = []*node{nil, nil, nil, {start: start}}
+		y := &node{kind: identExpr, ident: "yield", pos: n.pos, interp: n.interp, typ: yt, findex: index1}
+		c := &node{kind: callExpr, action: aCall, anc: n, pos: o.pos, interp: n.interp, child: []*node{o, y}, findex: -1}
+		call(c)
+		iterate = func(f *frame) {
+			yield := *def
+			yield.frame = f
+			f.data[index1] = reflect.ValueOf(&yield)
+			c.exec(f)
+		}
+	}
+
+	n.exec = func(f *frame) bltn {
+		state := f.data[index0]
+		state.SetInt(rangeFuncNext)
+		iterate(f)
+		switch i := state.Int(); {
+		case i >= 0:
+			return next[i]
+		case i == rangeFuncReturn:
+			return nil
+		}
+		return fnext
+	}
+}
+
 func _case(n *node) {
 	tnext := getExec(n.tnext)
 
@@ -1588,81 +2838,53 @@ func _case(n *node) {
 		for i := range types {
 			types[i] = n.child[i].typ
 		}
-		srcValue := genValue(sn.child[1].lastChild().child[0])
-		if len(sn.child[1].child) == 2 {
-			// assign in switch guard
-			destValue := genValue(n.lastChild().child[0])
-			switch len(types) {
-			case 0:
-				// default clause: assign var to interface value
-				n.exec = func(f *frame) bltn {
-					destValue(f).Set(srcValue(f))
-					return tnext
-				}
-			case 1:
-				// match against 1 type: assign var to concrete value
-				typ := types[0]
-				n.exec = func(f *frame) bltn {
-					v := srcValue(f)
-					if !v.IsValid() {
-						// match zero value against nil
-						if typ.cat == nilT {
-							return tnext
-						}
-						return fnext
-					}
-					if t := v.Type(); t.Kind() == reflect.Interface {
-						if typ.cat == nilT && v.IsNil() {
-							return tnext
-						}
-						if typ.TypeOf().String() == t.String() {
-							destValue(f).Set(v.Elem())
-							return tnext
-						}
-						return fnext
-					}
-					vi := v.Interface().(valueInterface)
-					if vi.node == nil {
-						if typ.cat == nilT {
-							return tnext
-						}
-						return fnext
-					}
-					if vi.node.typ.id() == typ.id() {
-						destValue(f).Set(vi.value)
-						return tnext
-					}
-					return fnext
-				}
-			default:
-				// match against multiple types: assign var to interface value
-				n.exec = func(f *frame) bltn {
-					val := srcValue(f)
-					vid := val.Interface().(valueInterface).node.typ.id()
-					for _, typ := range types {
-						if vid == typ.id() {
-							destValue(f).Set(val)
-							return tnext
-						}
-					}
-					return fnext
+		src := sn.child[1].lastChild().child[0]
+		srcValue := genValue(src)
+		match := func(v valueInterface) bool {
+			if len(types) == 0 {
+				return true // default clause
+			}
+			for _, typ := range types {
+				if typ.cat == nilT && dynamicType(v) == nil || assertType(v, typ) {
+					return true
 				}
 			}
-		} else {
+			return false
+		}
+		if len(sn.child[1].child) < 2 {
 			// no assign in switch guard
-			if len(n.child) <= 1 {
-				n.exec = func(f *frame) bltn { return tnext }
-			} else {
-				n.exec = func(f *frame) bltn {
-					vtyp := srcValue(f).Interface().(valueInterface).node.typ
-					for _, typ := range types {
-						if vtyp.id() == typ.id() {
-							return tnext
-						}
-					}
-					return fnext
+			n.exec = func(f *frame) bltn {
+				if match(toValueInterface(srcValue(f))) {
+					return tnext
 				}
+				return fnext
+			}
+			break
+		}
+
+		// assign in switch guard: the var has the matched type if it is the
+		// only one listed in the clause, or the type of the guard otherwise
+		dest := n.lastChild().child[0]
+		destValue := genValue(dest)
+		var set func(f *frame, v valueInterface)
+		switch typ := dest.typ; {
+		case typ.cat == interfaceT:
+			set = func(f *frame, v valueInterface) { destValue(f).Set(reflect.ValueOf(v)) }
+		case typ == src.typ:
+			set = func(f *frame, v valueInterface) { destValue(f).Set(srcValue(f)) }
+		case isInterface(typ):
+			wrap := genInterfaceWrapper(src, typ.TypeOf())
+			set = func(f *frame, v valueInterface) { destValue(f).Set(wrap(f)) }
+		default:
+			set = func(f *frame, v valueInterface) { destValue(f).Set(v.value) }
+		}
+		n.exec = func(f *frame) bltn {
+			v := toValueInterface(srcValue(f))
+			if !match(v) {
+				return fnext
 			}
+			set(f, v)
+			return tnext
 		}
 
 	case len(n.child) <= 1: // default clause
@@ -1696,12 +2918,19 @@ func appendSlice(n *node) {
 	if isString(n.child[2].typ.TypeOf()) {
 		typ := reflect.TypeOf([]byte{})
 		n.exec = func(f *frame) bltn {
+			if !n.interp.allocAppend(f, value(f), value0(f).Len()) {
+				return nil
+			}
 			dest(f).Set(reflect.AppendSlice(value(f), value0(f).Convert(typ)))
 			return next
 		}
 	} else {
 		n.exec = func(f *frame) bltn {
-			dest(f).Set(reflect.AppendSlice(value(f), value0(f)))
+			v, v0 := value(f), value0(f)
+			if !n.interp.allocAppend(f, v, v0.Len()) {
+				return nil
+			}
+			dest(f).Set(reflect.AppendSlice(v, convertSlice(n.interp, f, v0, v.Type())))
 			return next
 		}
 	}
@@ -1712,43 +2941,57 @@ func _append(n *node) {
 	dest := genValue(n)
 	value := genValue(n.child[1])
 	next := getExec(n.tnext)
+	recursive := n.typ.val != nil && isRecursiveStruct(n.typ.val)
 
-	if len(n.child) > 3 {
+	if len(n.child) != 3 {
 		args := n.child[2:]
 		l := len(args)
 		values := make([]func(*frame) reflect.Value, l)
 		for i, arg := range args {
 			switch {
-			case isRecursiveStruct(n.typ.val):
+			case recursive:
 				values[i] = genValueInterfacePtr(arg)
+			case n.typ.val.cat == interfaceT:
+				values[i] = genValueInterface(arg)
 			case arg.typ.untyped:
 				values[i] = genValueAs(arg, n.child[1].typ.TypeOf().Elem())
 			default:
-				values[i] = genValue(arg)
+				values[i] = genValueElem(arg)
 			}
 		}
 
 		n.exec = func(f *frame) bltn {
+			s := value(f)
+			et := s.Type().Elem()
 			sl := make([]reflect.Value, l)
 			for i, v := range values {
-				sl[i] = v(f)
+				sl[i] = sliceElem(f, v(f), et)
+			}
+			if !n.interp.allocAppend(f, s, l) {
+				return nil
 			}
-			dest(f).Set(reflect.Append(value(f), sl...))
+			dest(f).Set(reflect.Append(s, sl...))
 			return next
 		}
 	} else {
 		var value0 func(*frame) reflect.Value
 		switch {
-		case isRecursiveStruct(n.typ.val):
+		case recursive:
 			value0 = genValueInterfacePtr(n.child[2])
+		case n.typ.val.cat == interfaceT:
+			value0 = genValueInterface(n.child[2])
 		case n.child[2].typ.untyped:
 			value0 = genValueAs(n.child[2], n.child[1].typ.TypeOf().Elem())
 		default:
-			value0 = genValue(n.child[2])
+			value0 = genValueElem(n.child[2])
 		}
 
 		n.exec = func(f *frame) bltn {
-			dest(f).Set(reflect.Append(value(f), value0(f)))
+			s := value(f)
+			if !n.interp.allocAppend(f, s, 1) {
+				return nil
+			}
+			dest(f).Set(reflect.Append(s, sliceElem(f, value0(f), s.Type().Elem())))
 			return next
 		}
 	}
@@ -1759,6 +3002,15 @@ func _cap(n *node) {
 	value := genValue(n.child[1])
 	next := getExec(n.tnext)
 
+	if at := arrayPtrElem(n.child[1].typ); at != nil {
+		// The capacity of an array pointer is the length of its type, even if nil
+		c := int64(at.TypeOf().Len())
+		n.exec = func(f *frame) bltn {
+			dest(f).SetInt(c)
+			return next
+		}
+		return
+	}
 	n.exec = func(f *frame) bltn {
 		dest(f).SetInt(int64(value(f).Cap()))
 		return next
@@ -1789,8 +3041,15 @@ func _close(n *node) {
 
 func _complex(n *node) {
 	i := n.findex
-	convertLiteralValue(n.child[1], floatType)
-	convertLiteralValue(n.child[2], floatType)
+	t := floatType
+	if n.typ.TypeOf().Kind() == reflect.Complex64 {
+		t = reflect.TypeOf(float32(0))
+	}
+	for _, c := range n.child[1:] {
+		if c.typ.untyped {
+			convertLiteralValue(c, t)
+		}
+	}
 	value0 := genValue(n.child[1])
 	value1 := genValue(n.child[2])
 	next := getExec(n.tnext)
@@ -1803,7 +3062,9 @@ func _complex(n *node) {
 
 func _imag(n *node) {
 	i := n.findex
-	convertLiteralValue(n.child[1], complexType)
+	if n.child[1].typ.untyped {
+		convertLiteralValue(n.child[1], complexType)
+	}
 	value := genValue(n.child[1])
 	next := getExec(n.tnext)
 
@@ -1815,7 +3076,9 @@ func _imag(n *node) {
 
 func _real(n *node) {
 	i := n.findex
-	convertLiteralValue(n.child[1], complexType)
+	if n.child[1].typ.untyped {
+		convertLiteralValue(n.child[1], complexType)
+	}
 	value := genValue(n.child[1])
 	next := getExec(n.tnext)
 
@@ -1837,23 +3100,118 @@ func _delete(n *node) {
 	}
 }
 
+// _clear deletes all the entries of a map, or sets all the elements of a
+// slice to zero. Map entries of NaN keys, which can not be looked up, are
+// kept.
+func _clear(n *node) {
+	value := genValue(n.child[1])
+	next := getExec(n.tnext)
+
+	if n.child[1].typ.TypeOf().Kind() == reflect.Map {
+		var z reflect.Value
+		n.exec = func(f *frame) bltn {
+			m := value(f)
+			for _, k := range m.MapKeys() {
+				m.SetMapIndex(k, z)
+			}
+			return next
+		}
+		return
+	}
+	n.exec = func(f *frame) bltn {
+		s := value(f)
+		z := reflect.Zero(s.Type().Elem())
+		for i := 0; i < s.Len(); i++ {
+			s.Index(i).Set(z)
+		}
+		return next
+	}
+}
+
 func _len(n *node) {
 	i := n.findex
 	value := genValue(n.child[1])
 	next := getExec(n.tnext)
 
+	if at := arrayPtrElem(n.child[1].typ); at != nil {
+		// The length of an array pointer is the one of its type, even if nil
+		l := int64(at.TypeOf().Len())
+		n.exec = func(f *frame) bltn {
+			f.data[i].SetInt(l)
+			return next
+		}
+		return
+	}
 	n.exec = func(f *frame) bltn {
 		f.data[i].SetInt(int64(value(f).Len()))
 		return next
 	}
 }
 
+func _max(n *node) { minMax(n, true) }
+
+func _min(n *node) { minMax(n, false) }
+
+// minMax generates the call n to the min builtin, or to the max builtin if
+// max is true. As in compiled code, a NaN argument gives NaN, and negative
+// zero is less than positive zero.
+func minMax(n *node, max bool) {
+	i := n.findex
+	t := n.typ.TypeOf()
+	var values []func(*frame) reflect.Value
+	for _, c := range n.child[1:] {
+		if !c.typ.untyped {
+			values = append(values, genValue(c))
+			continue
+		}
+		convertLiteralValue(c, t)
+		values = append(values, genValueAs(c, t))
+	}
+	next := getExec(n.tnext)
+
+	// better returns true if the result is a rather than b
+	var better func(a, b reflect.Value) bool
+	switch {
+	case isInt(t) && !isUint(t):
+		better = func(a, b reflect.Value) bool { return a.Int() < b.Int() != max && a.Int() != b.Int() }
+	case isUint(t):
+		better = func(a, b reflect.Value) bool { return a.Uint() < b.Uint() != max && a.Uint() != b.Uint() }
+	case isFloat(t):
+		better = func(a, b reflect.Value) bool {
+			x, y := a.Float(), b.Float()
+			switch {
+			case math.IsNaN(x) || math.IsNaN(y):
+				return math.IsNaN(x)
+			case x == y:
+				return x == 0 && math.Signbit(x) != max
+			}
+			return x < y != max
+		}
+	default:
+		better = func(a, b reflect.Value) bool { return a.String() < b.String() != max && a.String() != b.String() }
+	}
+
+	n.exec = func(f *frame) bltn {
+		r := values[0](f)
+		for _, value := range values[1:] {
+			if v := value(f); better(v, r) {
+				r = v
+			}
+		}
+		f.data[i].Set(r)
+		return next
+	}
+}
+
 func _new(n *node) {
 	dest := genValue(n)
 	next := getExec(n.tnext)
 	typ := n.child[1].typ.TypeOf()
 
 	n.exec = func(f *frame) bltn {
+		if !n.interp.alloc(f, 1, typ.Size()) {
+			return nil
+		}
 		dest(f).Set(reflect.New(typ))
 		return next
 	}
@@ -1873,13 +3231,20 @@ func _make(n *node) {
 		case 3:
 			n.exec = func(f *frame) bltn {
 				len := int(value(f).Int())
+				if !n.interp.alloc(f, len, typ.Elem().Size()) {
+					return nil
+				}
 				dest(f).Set(reflect.MakeSlice(typ, len, len))
 				return next
 			}
 		case 4:
 			value1 := genValue(n.child[3])
 			n.exec = func(f *frame) bltn {
-				dest(f).Set(reflect.MakeSlice(typ, int(value(f).Int()), int(value1(f).Int())))
+				cap := int(value1(f).Int())
+				if !n.interp.alloc(f, cap, typ.Elem().Size()) {
+					return nil
+				}
+				dest(f).Set(reflect.MakeSlice(typ, int(value(f).Int()), cap))
 				return next
 			}
 		}
@@ -1894,7 +3259,11 @@ func _make(n *node) {
 		case 3:
 			value := genValue(n.child[2])
 			n.exec = func(f *frame) bltn {
-				dest(f).Set(reflect.MakeChan(typ, int(value(f).Int())))
+				size := int(value(f).Int())
+				if !n.interp.alloc(f, size, typ.Elem().Size()) {
+					return nil
+				}
+				dest(f).Set(reflect.MakeChan(typ, size))
 				return next
 			}
 		}
@@ -1908,20 +3277,46 @@ func _make(n *node) {
 			}
 		case 3:
 			value := genValue(n.child[2])
+			size := typ.Key().Size() + typ.Elem().Size()
 			n.exec = func(f *frame) bltn {
-				dest(f).Set(reflect.MakeMapWithSize(typ, int(value(f).Int())))
+				l := int(value(f).Int())
+				if !n.interp.alloc(f, l, size) {
+					return nil
+				}
+				dest(f).Set(reflect.MakeMapWithSize(typ, l))
 				return next
 			}
 		}
 	}
 }
 
+// renew allocates new values for the variables of a loop body captured by
+// function literals, at the end of each iteration. The new values are copies
+// of the current ones, so the variables of a for statement, distinct at each
+// iteration, are initialized from the previous iteration.
+func renew(n *node) {
+	next := getExec(n.tnext)
+	index := make([]int, len(n.scope.iter))
+	for i, s := range n.scope.iter {
+		index[i] = s.index
+	}
+
+	n.exec = func(f *frame) bltn {
+		for _, i := range index {
+			v := reflect.New(f.data[i].Type()).Elem()
+			v.Set(f.data[i])
+			f.data[i] = v
+		}
+		return next
+	}
+}
+
 func reset(n *node) {
 	next := getExec(n.tnext)
 
 	switch l := len(n.child) - 1; l {
 	case 1:
-		typ := n.child[0].typ.TypeOf()
+		typ := zeroType(n.child[0].typ)
 		i := n.child[0].findex
 		n.exec = func(f *frame) bltn {
 			f.data[i] = reflect.New(typ).Elem()
@@ -1930,7 +3325,7 @@ func reset(n *node) {
 	case 2:
 		c0, c1 := n.child[0], n.child[1]
 		i0, i1 := c0.findex, c1.findex
-		t0, t1 := c0.typ.TypeOf(), c1.typ.TypeOf()
+		t0, t1 := zeroType(c0.typ), zeroType(c1.typ)
 		n.exec = func(f *frame) bltn {
 			f.data[i0] = reflect.New(t0).Elem()
 			f.data[i1] = reflect.New(t1).Elem()
@@ -1941,7 +3336,7 @@ func reset(n *node) {
 		index := make([]int, l)
 		for i, c := range n.child[:l] {
 			index[i] = c.findex
-			types[i] = c.typ.TypeOf()
+			types[i] = zeroType(c.typ)
 		}
 		n.exec = func(f *frame) bltn {
 			for i, ind := range index {
@@ -1952,6 +3347,15 @@ func reset(n *node) {
 	}
 }
 
+// zeroType returns the type of the zero value stored in a frame for a
+// variable of type t: interpreted functions are stored as nodes.
+func zeroType(t *itype) reflect.Type {
+	if t.cat == funcT {
+		return t.frameType()
+	}
+	return t.TypeOf()
+}
+
 // recv reads from a channel
 func recv(n *node) {
 	value := genValue(n.child[0])
@@ -1960,7 +3364,11 @@ func recv(n *node) {
 	if n.fnext != nil {
 		fnext := getExec(n.fnext)
 		n.exec = func(f *frame) bltn {
-			if v, _ := value(f).Recv(); v.Bool() {
+			v, _, stopped := chanRecv(f, value(f))
+			if stopped {
+				return nil
+			}
+			if v.Bool() {
 				return tnext
 			}
 			return fnext
@@ -1968,7 +3376,11 @@ func recv(n *node) {
 	} else {
 		i := n.findex
 		n.exec = func(f *frame) bltn {
-			f.data[i], _ = value(f).Recv()
+			v, _, stopped := chanRecv(f, value(f))
+			if stopped {
+				return nil
+			}
+			f.data[i] = v
 			return tnext
 		}
 	}
@@ -1981,17 +3393,27 @@ func recv2(n *node) {
 	tnext := getExec(n.tnext)
 
 	n.exec = func(f *frame) bltn {
-		v, ok := vchan(f).Recv()
-		vres(f).Set(v)
+		v, ok, stopped := chanRecv(f, vchan(f))
+		if stopped {
+			return nil
+		}
+		setRecv(vres(f), v)
 		vok(f).SetBool(ok)
 		return tnext
 	}
 }
 
 func convertLiteralValue(n *node, t reflect.Type) {
-	if n.kind != basicLit || t == nil || t.Kind() == reflect.Interface {
+	if n.kind != basicLit && n.cval == nil || t == nil || t.Kind() == reflect.Interface {
 		return
 	}
+	if n.cval != nil {
+		// Convert the exact value of constant
+		if v := constValue(n.cval, t); v.IsValid() {
+			n.rval = v
+			return
+		}
+	}
 	if n.rval.IsValid() {
 		n.rval = n.rval.Convert(t)
 	} else {
@@ -2003,15 +3425,38 @@ func convertLiteralValue(n *node, t reflect.Type) {
 func send(n *node) {
 	next := getExec(n.tnext)
 	value0 := genValue(n.child[0]) // channel
-	convertLiteralValue(n.child[1], n.child[0].typ.val.TypeOf())
-	value1 := genValue(n.child[1]) // value to send
+	elem := chanElemType(n.child[0].typ)
+	convertLiteralValue(n.child[1], elem.TypeOf())
+	var value1 func(*frame) reflect.Value // value to send
+	if elem.cat == interfaceT && n.child[1].typ.cat != interfaceT {
+		value1 = genValueInterface(n.child[1])
+	} else {
+		value1 = genValueElem(n.child[1])
+	}
 
 	n.exec = func(f *frame) bltn {
-		value0(f).Send(value1(f))
+		if chanSend(f, value0(f), value1(f)) {
+			return nil
+		}
 		return next
 	}
 }
 
+// chanRecv receives a value from channel ch. If the run of frame f
+// is stopped before completion, the operation is abandoned and stopped
+// is set to true.
+func chanRecv(f *frame, ch reflect.Value) (v reflect.Value, ok, stopped bool) {
+	i, v, ok := reflect.Select([]reflect.SelectCase{f.done, {Dir: reflect.SelectRecv, Chan: ch}})
+	return v, ok, i == 0
+}
+
+// chanSend sends value v to channel ch. It returns true if the run of
+// frame f is stopped before completion of the operation.
+func chanSend(f *frame, ch, v reflect.Value) (stopped bool) {
+	i, _, _ := reflect.Select([]reflect.SelectCase{f.done, {Dir: reflect.SelectSend, Chan: ch, Send: v}})
+	return i == 0
+}
+
 func clauseChanDir(n *node) (*node, *node, *node, reflect.SelectDir) {
 	dir := reflect.SelectDefault
 	var nod, assigned, ok *node
@@ -2050,27 +3495,43 @@ func _select(n *node) {
 	chanValues := make([]func(*frame) reflect.Value, nbClause)
 	assignedValues := make([]func(*frame) reflect.Value, nbClause)
 	okValues := make([]func(*frame) reflect.Value, nbClause)
-	cases := make([]reflect.SelectCase, nbClause)
+	cases := make([]reflect.SelectCase, nbClause+1) // last case is for cancelation
 
+	var count int64 // executions of the select statement, when seeded
+
+	// Comm clauses may loop back to the select, which is not generated yet
+	n.exec = func(f *frame) bltn { return n.exec(f) }
 	for i := 0; i < nbClause; i++ {
-		if len(n.child[i].child) > 1 {
-			clause[i] = getExec(n.child[i].child[1].start)
-			chans[i], assigned[i], ok[i], cases[i].Dir = clauseChanDir(n.child[i])
-			chanValues[i] = genValue(chans[i])
-			if assigned[i] != nil {
+		clause[i] = getExec(n.child[i].start)
+		if n.child[i].kind == commClauseDefault {
+			cases[i].Dir = reflect.SelectDefault
+			continue
+		}
+		chans[i], assigned[i], ok[i], cases[i].Dir = clauseChanDir(n.child[i])
+		chanValues[i] = genValue(chans[i])
+		if assigned[i] == nil {
+			continue
+		}
+		if cases[i].Dir == reflect.SelectSend {
+			elem := chanElemType(chans[i].typ)
+			convertLiteralValue(assigned[i], elem.TypeOf())
+			if elem.cat == interfaceT && assigned[i].typ.cat != interfaceT {
+				assignedValues[i] = genValueInterface(assigned[i])
+			} else {
 				assignedValues[i] = genValue(assigned[i])
 			}
-			if ok[i] != nil {
-				okValues[i] = genValue(ok[i])
-			}
 		} else {
-			clause[i] = getExec(n.child[i].child[0].start)
-			cases[i].Dir = reflect.SelectDefault
+			assignedValues[i] = genValue(assigned[i])
+		}
+		if ok[i] != nil {
+			okValues[i] = genValue(ok[i])
 		}
 	}
 
 	n.exec = func(f *frame) bltn {
-		for i := range cases {
+		cases := append([]reflect.SelectCase(nil), cases...)
+		cases[nbClause] = f.done
+		for i := range cases[:nbClause] {
 			switch cases[i].Dir {
 			case reflect.SelectRecv:
 				cases[i].Chan = chanValues[i](f)
@@ -2081,9 +3542,19 @@ func _select(n *node) {
 				// Keep zero values for comm clause
 			}
 		}
-		j, v, s := reflect.Select(cases)
+		var j int
+		var v reflect.Value
+		var s bool
+		if n.interp.seed != 0 {
+			j, v, s = n.interp.seededSelect(n, atomic.AddInt64(&count, 1), cases)
+		} else {
+			j, v, s = reflect.Select(cases)
+		}
+		if j == nbClause {
+			return nil
+		}
 		if cases[j].Dir == reflect.SelectRecv && assignedValues[j] != nil {
-			assignedValues[j](f).Set(v)
+			setRecv(assignedValues[j](f), v)
 			if ok[j] != nil {
 				okValues[j](f).SetBool(s)
 			}
@@ -2092,12 +3563,26 @@ func _select(n *node) {
 	}
 }
 
+// setRecv sets dest to the value v received from a channel. A channel of
+// interpreted interface holds valueInterface values, stored as is in
+// variables of the interface type.
+func setRecv(dest, v reflect.Value) {
+	if dest.Type() == valueInterfaceType && v.Type() != valueInterfaceType {
+		if v.IsNil() {
+			v = reflect.New(valueInterfaceType).Elem()
+		} else {
+			v = v.Elem()
+		}
+	}
+	dest.Set(v)
+}
+
 // slice expression: array[low:high:max]
 func slice(n *node) {
 	i := n.findex
 	next := getExec(n.tnext)
-	value0 := genValue(n.child[0]) // array
-	value1 := genValue(n.child[1]) // low (if 2 or 3 args) or high (if 1 arg)
+	value0 := genValueArray(n.child[0]) // array
+	value1 := genValue(n.child[1])      // low (if 2 or 3 args) or high (if 1 arg)
 
 	switch len(n.child) {
 	case 2:
@@ -2130,7 +3615,7 @@ func slice(n *node) {
 func slice0(n *node) {
 	i := n.findex
 	next := getExec(n.tnext)
-	value0 := genValue(n.child[0])
+	value0 := genValueArray(n.child[0])
 
 	switch len(n.child) {
 	case 1:
@@ -2164,7 +3649,7 @@ func isNil(n *node) {
 	if n.fnext != nil {
 		fnext := getExec(n.fnext)
 		n.exec = func(f *frame) bltn {
-			if value(f).IsNil() {
+			if isNilValue(value(f)) {
 				return tnext
 			}
 			return fnext
@@ -2172,12 +3657,22 @@ func isNil(n *node) {
 	} else {
 		i := n.findex
 		n.exec = func(f *frame) bltn {
-			f.data[i].SetBool(value(f).IsNil())
+			f.data[i].SetBool(isNilValue(value(f)))
 			return tnext
 		}
 	}
 }
 
+// isNilValue returns true if v is nil, v being possibly an interface value
+// of the interpreter, of nil dynamic value when it holds a nil interface.
+func isNilValue(v reflect.Value) bool {
+	if v.Kind() == reflect.Struct && v.Type() == valueInterfaceType {
+		vi := v.Interface().(valueInterface)
+		return !vi.value.IsValid() || vi.value.Kind() == reflect.Interface && vi.value.IsNil()
+	}
+	return v.IsNil()
+}
+
 func isNotNil(n *node) {
 	value := genValue(n.child[0])
 	tnext := getExec(n.tnext)
@@ -2185,7 +3680,7 @@ func isNotNil(n *node) {
 	if n.fnext != nil {
 		fnext := getExec(n.fnext)
 		n.exec = func(f *frame) bltn {
-			if value(f).IsNil() {
+			if isNilValue(value(f)) {
 				return fnext
 			}
 			return tnext
@@ -2193,7 +3688,7 @@ func isNotNil(n *node) {
 	} else {
 		i := n.findex
 		n.exec = func(f *frame) bltn {
-			f.data[i].SetBool(!value(f).IsNil())
+			f.data[i].SetBool(!isNilValue(value(f)))
 			return tnext
 		}
 	}
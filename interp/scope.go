@@ -1,6 +1,8 @@
 package interp
 
 import (
+	"go/constant"
+	"go/token"
 	"log"
 	"reflect"
 	"strconv"
@@ -45,16 +47,18 @@ func (k sKind) String() string {
 // label, builtin or binary object. Symbols are defined within a scope.
 type symbol struct {
 	kind      sKind
-	typ       *itype        // Type of value
-	node      *node         // Node value if index is negative
-	from      []*node       // list of nodes jumping to node if kind is label, or nil
-	recv      *receiver     // receiver node value, if sym refers to a method
-	index     int           // index of value in frame or -1
-	rval      reflect.Value // default value (used for constants)
-	path      string        // package path if typ.cat is SrcPkgT or BinPkgT
-	builtin   bltnGenerator // Builtin function or nil
-	global    bool          // true if symbol is defined in global space
-	recursive bool          // true if symbol is a recursive type definition
+	typ       *itype         // Type of value
+	node      *node          // Node value if index is negative
+	from      []*node        // list of nodes jumping to node if kind is label, or nil
+	recv      *receiver      // receiver node value, if sym refers to a method
+	index     int            // index of value in frame or -1
+	rval      reflect.Value  // default value (used for constants)
+	cval      constant.Value // exact value of a constant, or nil
+	path      string         // package path if typ.cat is SrcPkgT or BinPkgT
+	builtin   bltnGenerator  // Builtin function or nil
+	global    bool           // true if symbol is defined in global space
+	recursive bool           // true if symbol is a recursive type definition
+	decl      token.Pos      // position of the identifier declaring a package level symbol, or NoPos
 	// TODO: implement constant checking
 	//constant bool             // true if symbol value is constant
 }
@@ -76,12 +80,16 @@ type symbol struct {
 // execution to the index in frame, created exactly from the types layout.
 //
 type scope struct {
-	anc    *scope             // Ancestor upper scope
-	def    *node              // function definition node this scope belongs to, or nil
-	types  []reflect.Type     // Frame layout, may be shared by same level scopes
-	level  int                // Frame level: number of frame indirections to access var during execution
-	sym    map[string]*symbol // Map of symbols defined in this current scope
-	global bool               // true if scope refers to global space (single frame for universe and package level scopes)
+	anc      *scope             // Ancestor upper scope
+	def      *node              // function definition node this scope belongs to, or nil
+	types    []reflect.Type     // Frame layout, may be shared by same level scopes
+	level    int                // Frame level: number of frame indirections to access var during execution
+	sym      map[string]*symbol // Map of symbols defined in this current scope
+	global   bool               // true if scope refers to global space (single frame for universe and package level scopes)
+	loop     bool               // true if scope is the body of a loop
+	iter     []*symbol          // variables of a loop body captured by function literals, renewed at each iteration
+	body     *scope             // loop body renewing the variables declared by the for statement of scope, or nil
+	captures bool               // true if the function literal captures variables of a loop body
 }
 
 // push creates a new scope and chain it to the current one
@@ -125,9 +133,90 @@ func (s *scope) lookup(ident string) (*symbol, int, bool) {
 	return nil, 0, false
 }
 
+// capture records that the variable sym of name ident, found at level indirections from
+// the current scope, is captured by a function literal. Each iteration of a
+// loop declares distinct variables in its body, kept by the closures created
+// during the iteration.
+func (s *scope) capture(ident string, sym *symbol, level int) {
+	if level == 0 || sym.global || sym.kind != varSym {
+		return
+	}
+	d := s
+	for d != nil && d.sym[ident] != sym {
+		d = d.anc
+	}
+	if d == nil {
+		return
+	}
+	found := d.renewForVar(sym)
+	for b := d; b != nil && b.level == d.level; b = b.anc {
+		if b.loop && !containsSymbol(b.iter, sym) {
+			b.iter = append(b.iter, sym)
+		}
+		found = found || b.loop
+	}
+	if !found {
+		return
+	}
+	// Mark the function literal created in the frame of the variable
+	for s.anc.level > d.level {
+		s = s.anc
+	}
+	s.captures = true
+}
+
+// addressOf records that the address of the variable sym of name ident is
+// taken, so a variable declared by a for statement is distinct at each iteration.
+func (s *scope) addressOf(ident string, sym *symbol) {
+	for s != nil && s.sym[ident] != sym {
+		s = s.anc
+	}
+	if s != nil {
+		s.renewForVar(sym)
+	}
+}
+
+// renewForVar renews the variable sym declared by the for statement of
+// scope s at each iteration, and returns true, or returns false if the
+// variables of s are not renewed.
+func (s *scope) renewForVar(sym *symbol) bool {
+	b := s.body
+	if b == nil {
+		return false
+	}
+	if !containsSymbol(b.iter, sym) {
+		b.iter = append(b.iter, sym)
+	}
+	return true
+}
+
+func containsSymbol(syms []*symbol, sym *symbol) bool {
+	for _, s := range syms {
+		if s == sym {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *scope) rangeChanType(n *node) *itype {
-	if sym, _, found := s.lookup(n.child[1].ident); found {
-		if t := sym.typ; len(n.child) == 3 && t != nil && t.cat == chanT {
+	if len(n.child) != 3 {
+		return nil
+	}
+	t := n.child[1].typ
+	if c := n.child[1]; c.kind == identExpr {
+		if sym, _, found := s.lookup(c.ident); found {
+			t = sym.typ
+		}
+	}
+	if t == nil {
+		return nil
+	}
+	switch t.cat {
+	case chanT, chanRecvT, chanSendT:
+		return t
+	case valueT:
+		if t.rtype.Kind() == reflect.Chan {
 			return t
 		}
 	}
@@ -171,5 +260,6 @@ func (interp *Interpreter) initScopePkg(n *node) (*scope, string) {
 	}
 
 	sc = interp.scopes[pkgName]
+	syncTypes(sc, interp.universe)
 	return sc, pkgName
 }
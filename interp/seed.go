@@ -0,0 +1,50 @@
+package interp
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+)
+
+// seededRand returns the pseudo-random generator of the k-th execution of
+// node n, determined by the seed of the interpreter.
+func (interp *Interpreter) seededRand(n *node, k int64) *rand.Rand {
+	return rand.New(rand.NewSource(interp.seed ^ int64(n.index)<<32 ^ k))
+}
+
+// shuffleKeys orders the keys of a map iterated by the k-th execution of the
+// range statement n, as a function of the seed.
+func (interp *Interpreter) shuffleKeys(n *node, k int64, keys []reflect.Value) {
+	sort.Slice(keys, func(i, j int) bool { return lessValue(keys[i], keys[j]) })
+	r := interp.seededRand(n, k)
+	r.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+}
+
+// seededSelect performs the k-th execution of the select statement n, as
+// reflect.Select, but chooses among the ready cases as a function of the
+// seed: they are tried in turn without blocking, in a seeded order. If none
+// is ready, the default case is chosen, if any, or the select blocks.
+func (interp *Interpreter) seededSelect(n *node, k int64, cases []reflect.SelectCase) (int, reflect.Value, bool) {
+	def := -1
+	var order []int
+	for i, c := range cases {
+		switch {
+		case c.Dir == reflect.SelectDefault:
+			def = i
+		case c.Chan.IsValid():
+			order = append(order, i)
+		}
+	}
+	r := interp.seededRand(n, k)
+	r.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] })
+	for _, i := range order {
+		try := []reflect.SelectCase{cases[i], {Dir: reflect.SelectDefault}}
+		if j, v, ok := reflect.Select(try); j == 0 {
+			return i, v, ok
+		}
+	}
+	if def >= 0 {
+		return def, reflect.Value{}, false
+	}
+	return reflect.Select(cases)
+}
@@ -0,0 +1,137 @@
+package interp
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// snapshotVersion is the version of the snapshot encoding.
+const snapshotVersion = 1
+
+// source is a source code executed by the interpreter.
+type source struct {
+	Name string // program name, as Interpreter.Name
+	Src  string
+}
+
+// snapshot is the encoded state of an interpreter.
+type snapshot struct {
+	Version int
+	Sources []source      // executed sources, in order
+	Vars    []snapshotVar // values of package level variables
+}
+
+// snapshotVar is the value of a package level variable, encoded by gob.
+type snapshotVar struct {
+	Scope, Name string
+	Value       []byte
+}
+
+// Snapshot returns the serialized state of the interpreter: the sources
+// executed so far, which define the types, functions and variables of
+// the global scope and packages, and the current values of the package
+// level variables. The state is restored by RestoreSnapshot, possibly in
+// another process.
+//
+// Variable values are encoded with encoding/gob, so functions, channels
+// and interfaces holding unregistered types are not saved, and pointers
+// are flattened.
+func (interp *Interpreter) Snapshot() ([]byte, error) {
+	if err := interp.lock(); err != nil {
+		return nil, err
+	}
+	defer interp.unlock()
+	s := snapshot{Version: snapshotVersion, Sources: interp.sources}
+
+	names := make([]string, 0, len(interp.scopes))
+	for name := range interp.scopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, scope := range names {
+		sc := interp.scopes[scope]
+		vars := make([]string, 0, len(sc.sym))
+		for name, sym := range sc.sym {
+			if sym.kind == varSym && sym.index >= 0 && sym.index < len(interp.frame.data) {
+				vars = append(vars, name)
+			}
+		}
+		sort.Strings(vars)
+		for _, name := range vars {
+			v := interp.frame.data[sc.sym[name].index]
+			if !v.IsValid() {
+				continue
+			}
+			var b bytes.Buffer
+			if err := gob.NewEncoder(&b).EncodeValue(v); err != nil {
+				// Not encodable, the value is set again by its initializer
+				continue
+			}
+			s.Vars = append(s.Vars, snapshotVar{Scope: scope, Name: name, Value: b.Bytes()})
+		}
+	}
+
+	var b bytes.Buffer
+	if err := gob.NewEncoder(&b).Encode(s); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// RestoreSnapshot restores in the interpreter the state serialized by
+// Snapshot. It is intended for a new interpreter, created with the same
+// options and binary symbols as the one of the snapshot.
+//
+// The sources are compiled again, and their global statements and variable
+// initializers are run, but not the init and main functions. Then variables
+// are set to their saved value, if their type is unchanged.
+func (interp *Interpreter) RestoreSnapshot(data []byte) error {
+	if err := interp.lock(); err != nil {
+		return err
+	}
+	defer interp.unlock()
+	var s snapshot
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return fmt.Errorf("invalid snapshot: %v", err)
+	}
+	if s.Version != snapshotVersion {
+		return fmt.Errorf("unsupported snapshot version %d", s.Version)
+	}
+
+	name := interp.Name
+	defer func() { interp.Name = name }()
+	for _, src := range s.Sources {
+		interp.Name = src.Name
+		_, p, err := interp.compile(src.Src)
+		if err != nil {
+			return err
+		}
+		if _, err := interp.execute(&Program{root: p.root, src: p.src}); err != nil {
+			return err
+		}
+	}
+
+	for _, sv := range s.Vars {
+		sc, ok := interp.scopes[sv.Scope]
+		if !ok {
+			continue
+		}
+		sym, ok := sc.sym[sv.Name]
+		if !ok || sym.kind != varSym || sym.index < 0 || sym.index >= len(interp.frame.data) {
+			continue
+		}
+		v := interp.frame.data[sym.index]
+		if !v.IsValid() || !v.CanSet() {
+			continue
+		}
+		x := reflect.New(v.Type())
+		if err := gob.NewDecoder(bytes.NewReader(sv.Value)).DecodeValue(x); err != nil {
+			continue
+		}
+		v.Set(x.Elem())
+	}
+	return nil
+}
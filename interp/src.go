@@ -2,33 +2,331 @@ package interp
 
 import (
 	"fmt"
-	"io/ioutil"
+	"go/parser"
+	"go/token"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 )
 
-func (interp *Interpreter) importSrcFile(rPath, path, alias string) error {
+// realFS complies with the fs.FS interface, using the OS filesystem.
+// Contrary to os.DirFS, it accepts absolute and relative paths.
+type realFS struct{}
+
+// Open implements fs.FS.
+func (realFS) Open(name string) (fs.File, error) { return os.Open(name) }
+
+// importSrcFile loads the source package of import path, imported under
+// alias from a file in directory from. A package is loaded once, further
+// imports share its scope.
+func (interp *Interpreter) importSrcFile(rPath, path, alias, from string) error {
+	fsys, dir, rPath, err := interp.resolveImport(rPath, path)
+	if err != nil {
+		return err
+	}
+	key := dir
+	if fsys != nil {
+		// The package is not in the interpreter filesystem
+		key = "import " + path
+	} else if !interp.internalAllowed(path, dir, from) {
+		return fmt.Errorf("use of internal package %s not allowed", path)
+	}
+	if d, ok := interp.srcDirs[key]; ok {
+		if d == nil {
+			return fmt.Errorf("import cycle not allowed: %s", path)
+		}
+		if alias != "" && alias != d.name {
+			interp.scopes[alias] = interp.scopes[d.name]
+		}
+		interp.srcPkg[path] = d.name
+		return nil
+	}
+	if err = interp.srcSupported(fsys, dir, path); err != nil {
+		return err
+	}
+
+	// The package is registered while loading, to detect import cycles
+	interp.srcDirs[key] = nil
+	rPath = effectivePkg(rPath, path)
+	defer func(prev fs.FS) { interp.loadFS = prev }(interp.loadFS)
+	interp.loadFS = fsys
+	pkgName, err := interp.loadSrcDir(dir, rPath, alias, loadImport)
+	if err != nil {
+		delete(interp.srcDirs, key)
+		return err
+	}
+	name := pkgName
+	if alias != "" {
+		name = alias
+	}
+	interp.srcPkg[path] = name
+	interp.srcDirs[key] = &srcDir{path: path, rPath: rPath, pkgName: pkgName, name: name}
+	return nil
+}
+
+// resolveImport returns the filesystem and the directory of the source
+// package of import path, imported from the package rPath, and the root of
+// its dependencies. The filesystem is the one returned by ImportResolver,
+// stdSrc for the standard library packages interpreted from source, or nil
+// for the interpreter filesystem.
+func (interp *Interpreter) resolveImport(rPath, path string) (fs.FS, string, string, error) {
+	if interp.importSrc != nil && !isPathRelative(path) {
+		fsys, dir, err := interp.importSrc(path)
+		if err != nil {
+			return nil, "", "", fmt.Errorf("import %q: %v", path, err)
+		}
+		if fsys != nil {
+			return fsys, filepath.Clean(dir), "", nil
+		}
+	}
+	if dir := stdSrcDir(path); dir != "" {
+		return stdSrc, dir, "", nil
+	}
+	dir, rPath, err := interp.srcPkgDir(rPath, path)
+	return nil, dir, rPath, err
+}
+
+// srcFS returns the filesystem of the source package being loaded.
+func (interp *Interpreter) srcFS() fs.FS {
+	if interp.loadFS != nil {
+		return interp.loadFS
+	}
+	return interp.filesystem
+}
+
+// srcPkgDir returns the directory of the source package of import path,
+// imported from the package rPath, and the root of its dependencies.
+func (interp *Interpreter) srcPkgDir(rPath, path string) (string, string, error) {
 	var dir string
 	var err error
 
 	// For relative import paths in the form "./xxx" or "../xxx", the initial
 	// base path is the directory of the interpreter input file, or "." if no file
 	// was provided.
-	// In all other cases, absolute import paths are resolved from the GOPATH
-	// and the nested "vendor" directories.
+	// Absolute import paths are resolved from the go.mod file of the program,
+	// if any, for the packages of the main module and of its requirements.
+	// Otherwise, they are resolved from the "vendor" directory of the
+	// program, if the package is there. In all other cases, absolute import
+	// paths are resolved from the GOPATH and the nested "vendor" directories.
 	if isPathRelative(path) {
 		if rPath == "main" {
 			rPath = "."
 		}
-		dir = filepath.Join(filepath.Dir(interp.Name), rPath, path)
-	} else if dir, rPath, err = pkgDir(interp.context.GOPATH, rPath, path); err != nil {
-		return err
+		dir = filepath.Join(interp.dir(), rPath, path)
+	} else if dir, err = interp.moduleDir(path); err != nil {
+		return "", "", err
+	} else if dir != "" {
+		rPath = ""
+	} else if dir = interp.vendorDir(path); dir != "" {
+		rPath = ""
+	} else if dir, rPath, err = pkgDir(interp.filesystem, interp.context.GOPATH, rPath, path); err != nil {
+		return "", "", err
+	}
+	return filepath.Clean(dir), rPath, nil
+}
+
+// vendorDir returns the directory of the package of import path in the
+// vendor directory of the program, or an empty string if the package is not
+// vendored there, or if the program is in a module, which has its own
+// vendoring rules. As with "go mod vendor", vendored packages are resolved
+// from the same, flat, directory.
+func (interp *Interpreter) vendorDir(path string) string {
+	if m, err := interp.mainModule(); err != nil || m != nil {
+		return ""
+	}
+	dir := filepath.Join(interp.dir(), "vendor", filepath.FromSlash(path))
+	if info, err := fs.Stat(interp.filesystem, dir); err != nil || !info.IsDir() {
+		return ""
 	}
+	return dir
+}
 
-	files, err := ioutil.ReadDir(dir)
+// srcSupported returns an error naming the files of the source package of
+// import path, in directory dir of fsys, or of the interpreter filesystem if
+// nil, which can not be interpreted because they require cgo or assembly.
+// Files excluded by build constraints are ignored.
+func (interp *Interpreter) srcSupported(fsys fs.FS, dir, path string) error {
+	if fsys == nil {
+		fsys = interp.filesystem
+	}
+	files, err := fs.ReadDir(fsys, dir)
 	if err != nil {
-		return err
+		// The error is reported when loading the package
+		return nil
+	}
+	var cgoFiles, asmFiles []string
+	for _, file := range files {
+		name := file.Name()
+		ext := filepath.Ext(name)
+		isAsm := ext == ".s" || ext == ".S"
+		if file.IsDir() || !isAsm && ext != ".go" || skipFile(interp.context, strings.TrimSuffix(name, ext)+".go") {
+			continue
+		}
+		buf, err := fs.ReadFile(fsys, filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		src := string(buf)
+		if isAsm {
+			// The build constraints of an assembly file are checked as in a Go file
+			src = asmHeader(src) + "package p\n"
+		}
+		if !interp.buildOk(interp.context, name, src) {
+			continue
+		}
+		if isAsm {
+			asmFiles = append(asmFiles, name)
+		} else if importsC(interp.fset, name, src) {
+			cgoFiles = append(cgoFiles, name)
+		}
+	}
+	switch {
+	case cgoFiles != nil:
+		return fmt.Errorf("import %q: cgo is not supported, required by %s", path, strings.Join(cgoFiles, ", "))
+	case asmFiles != nil:
+		return fmt.Errorf("import %q: assembly is not supported, required by %s", path, strings.Join(asmFiles, ", "))
+	}
+	return nil
+}
+
+// asmHeader returns the leading comment lines of assembly source src,
+// where its build constraints are.
+func asmHeader(src string) string {
+	var b strings.Builder
+	for _, line := range strings.SplitAfter(src, "\n") {
+		if l := strings.TrimSpace(line); l != "" && !strings.HasPrefix(l, "//") {
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// importsC returns true if the Go source src imports the "C" pseudo package.
+func importsC(fset *token.FileSet, name, src string) bool {
+	f, err := parser.ParseFile(fset, name, src, parser.ImportsOnly)
+	if err != nil {
+		return false
+	}
+	for _, s := range f.Imports {
+		if s.Path.Value == `"C"` {
+			return true
+		}
+	}
+	return false
+}
+
+// srcFallback returns the binary fallback registered by UseFallback for
+// import path, if its source package, imported from the package rPath,
+// can not be interpreted.
+func (interp *Interpreter) srcFallback(rPath, path string) map[string]reflect.Value {
+	values := interp.fallback[path]
+	if values == nil {
+		return nil
+	}
+	fsys, dir, _, err := interp.resolveImport(rPath, path)
+	if err == nil && interp.srcSupported(fsys, dir, path) == nil {
+		return nil
+	}
+	return values
+}
+
+// internalAllowed returns true if the package of import path, in directory
+// dir, may be imported from directory from: a path containing an internal
+// element is only importable from the tree rooted at the parent of internal.
+func (interp *Interpreter) internalAllowed(path, dir, from string) bool {
+	elems := strings.Split(filepath.ToSlash(path), "/")
+	i := len(elems) - 1
+	for i >= 0 && elems[i] != "internal" {
+		i--
+	}
+	if i < 0 {
+		return true
+	}
+	parent := dir
+	for k := i; k < len(elems); k++ {
+		parent = filepath.Dir(parent)
+	}
+	if _, ok := interp.filesystem.(realFS); ok {
+		parent, _ = filepath.Abs(parent)
+		from, _ = filepath.Abs(from)
+	}
+	rel, err := filepath.Rel(parent, from)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// dir returns the directory of the program, from which relative imports
+// and the main module are resolved.
+func (interp *Interpreter) dir() string {
+	if interp.progDir != "" {
+		return interp.progDir
+	}
+	return filepath.Dir(interp.Name)
+}
+
+// evalDir loads the package in directory dir as the program, and runs it.
+func (interp *Interpreter) evalDir(dir string) (err error) {
+	dir = filepath.Clean(dir)
+	interp.progDir = dir
+	interp.startRun()
+	defer func() {
+		interp.flushOutput()
+		if r := recover(); r != nil {
+			err = runError(r)
+		}
+		if m := interp.metrics; m != nil {
+			m.end()
+		}
+		if err != nil {
+			delete(interp.srcDirs, dir)
+		}
+	}()
+
+	interp.srcDirs[dir] = nil
+	pkgName, err := interp.loadSrcDir(dir, "", "", loadImport)
+	if err != nil {
+		return newError(TypePhase, err)
+	}
+	if pkgName == "" {
+		return fmt.Errorf("no Go files in %s", dir)
+	}
+	interp.srcDirs[dir] = &srcDir{path: pkgName, pkgName: pkgName, name: pkgName}
+	return interp.runExceeded()
+}
+
+// srcDir describes a source package loaded from a directory.
+type srcDir struct {
+	path    string // import path
+	rPath   string // effective package path
+	pkgName string // package name
+	name    string // scope name
+}
+
+// loadMode defines how a package is loaded by loadSrcDir.
+type loadMode int
+
+const (
+	loadImport loadMode = iota // run init functions, and main
+	loadTest                   // also load test files, and do not run main
+	loadReload                 // do not run init functions, nor main
+)
+
+// loadSrcDir parses, compiles and runs the package in directory dir, under
+// the package path rPath and the scope alias. If rPath or alias are empty,
+// the package name is used instead. In test mode, test files of the package
+// are also loaded. It returns the package name.
+func (interp *Interpreter) loadSrcDir(dir, rPath, alias string, mode loadMode) (string, error) {
+	files, err := fs.ReadDir(interp.srcFS(), dir)
+	if err != nil {
+		return "", err
+	}
+	if interp.allErrors {
+		// The errors of the package are returned apart from the errors of the importing source
+		errs := interp.errs
+		interp.errs = nil
+		defer func() { interp.errs = errs }()
 	}
 
 	var initNodes []*node
@@ -37,50 +335,91 @@ func (interp *Interpreter) importSrcFile(rPath, path, alias string) error {
 	var root *node
 	var pkgName string
 
-	// Parse source files
+	// Read source files, and parse them concurrently
+	var srcs []srcFile
+	var tests []bool
 	for _, file := range files {
 		name := file.Name()
-		if skipFile(interp.context, name) {
+		isTest := mode == loadTest && isTestFile(interp.context, name)
+		if skipFile(interp.context, name) && !isTest {
 			continue
 		}
 
 		name = filepath.Join(dir, name)
 		var buf []byte
-		if buf, err = ioutil.ReadFile(name); err != nil {
-			return err
+		if buf, err = fs.ReadFile(interp.srcFS(), name); err != nil {
+			return "", err
 		}
+		srcs = append(srcs, srcFile{name: name, src: string(buf)})
+		tests = append(tests, isTest)
+	}
+	parsed := interp.parseFiles(srcs)
+	defer interp.prefetch(rPath, parsed)()
 
+	for i, p := range parsed {
+		isTest := tests[i]
 		var pname string
-		if pname, root, err = interp.ast(string(buf), name); err != nil {
-			return err
+		if pname, root, err = interp.astOf(p); err != nil {
+			if interp.allErrors {
+				return "", interp.compileError(err)
+			}
+			return "", err
 		}
 		if root == nil {
 			continue
 		}
+		if isTest && strings.HasSuffix(pname, "_test") {
+			// External test packages are not supported
+			continue
+		}
 		if pkgName == "" {
 			pkgName = pname
 		} else if pkgName != pname {
-			return fmt.Errorf("found packages %s and %s in %s", pkgName, pname, dir)
+			return "", fmt.Errorf("found packages %s and %s in %s", pkgName, pname, dir)
 		}
 		rootNodes = append(rootNodes, root)
 
-		subRPath := effectivePkg(rPath, path)
+		subRPath := rPath
+		if subRPath == "" {
+			subRPath = pkgName
+		}
 		if err = interp.gta(root, subRPath); err != nil {
-			return err
+			if !interp.allErrors {
+				return "", err
+			}
+			interp.errs = append(interp.errs, err)
 		}
 	}
+	if interp.errs != nil {
+		return "", interp.compileError(nil)
+	}
 
 	// Generate control flow graphs
 	for _, root := range rootNodes {
 		var nodes []*node
 		if nodes, err = interp.cfg(root); err != nil {
-			return err
+			if !interp.allErrors {
+				return "", err
+			}
+			interp.errs = append(interp.errs, err)
 		}
 		initNodes = append(initNodes, nodes...)
 	}
+	if interp.errs != nil {
+		return "", interp.compileError(nil)
+	}
+	for _, root := range rootNodes {
+		interp.optimize(root)
+	}
+	if err = interp.compileGeneric(); err != nil || interp.errs != nil {
+		if interp.allErrors {
+			return "", interp.compileError(err)
+		}
+		return "", err
+	}
 
 	// Rename imported pkgName to alias if they are different
-	if pkgName != alias {
+	if alias != "" && pkgName != alias {
 		interp.scopes[alias] = interp.scopes[pkgName]
 		delete(interp.scopes, pkgName)
 	}
@@ -90,36 +429,46 @@ func (interp *Interpreter) importSrcFile(rPath, path, alias string) error {
 	// Once all package sources have been parsed, execute entry points then init functions
 	for _, n := range rootNodes {
 		if err = genRun(n); err != nil {
-			return err
+			return "", err
+		}
+		if !interp.noRun {
+			interp.run(n, nil)
 		}
-		interp.run(n, nil)
+	}
+	if interp.noRun {
+		return pkgName, nil
 	}
 
-	// Add main to list of functions to run, after all inits
-	if m := interp.main(); m != nil {
-		initNodes = append(initNodes, m)
+	switch mode {
+	case loadImport:
+		// Add main to list of functions to run, after all inits
+		if m := interp.main(); m != nil {
+			initNodes = append(initNodes, m)
+		}
+	case loadReload:
+		initNodes = nil
 	}
 
 	for _, n := range initNodes {
 		interp.run(n, interp.frame)
 	}
 
-	return nil
+	return pkgName, nil
 }
 
 // pkgDir returns the absolute path in filesystem for a package given its name and
 // the root of the subtree dependencies.
-func pkgDir(goPath string, root, path string) (string, string, error) {
+func pkgDir(filesystem fs.FS, goPath string, root, path string) (string, string, error) {
 	rPath := filepath.Join(root, "vendor")
 	dir := filepath.Join(goPath, "src", rPath, path)
 
-	if _, err := os.Stat(dir); err == nil {
+	if _, err := fs.Stat(filesystem, dir); err == nil {
 		return dir, rPath, nil // found!
 	}
 
 	dir = filepath.Join(goPath, "src", effectivePkg(root, path))
 
-	if _, err := os.Stat(dir); err == nil {
+	if _, err := fs.Stat(filesystem, dir); err == nil {
 		return dir, root, nil // found!
 	}
 
@@ -127,7 +476,7 @@ func pkgDir(goPath string, root, path string) (string, string, error) {
 		return "", "", fmt.Errorf("unable to find source related to: %q", path)
 	}
 
-	return pkgDir(goPath, previousRoot(root), path)
+	return pkgDir(filesystem, goPath, previousRoot(root), path)
 }
 
 // Find the previous source root. (vendor > vendor > ... > GOPATH)
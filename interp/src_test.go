@@ -172,7 +172,7 @@ func Test_pkgDir(t *testing.T) {
 				}
 			}
 
-			dir, rPath, err := pkgDir(goPath, test.root, test.path)
+			dir, rPath, err := pkgDir(realFS{}, goPath, test.root, test.path)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -224,3 +224,25 @@ func Test_previousRoot(t *testing.T) {
 		})
 	}
 }
+
+func Test_compareVersion(t *testing.T) {
+	testCases := []struct {
+		v, w     string
+		expected int
+	}{
+		{"v1.2.3", "v1.2.3", 0},
+		{"v1.2.3", "v1.10.0", -1},
+		{"v2.0.0+incompatible", "v1.9.9", 1},
+		{"v1.0.0-rc.1", "v1.0.0", -1},
+		{"v1.0.0-alpha.2", "v1.0.0-alpha.10", -1},
+		{"v1.0.0-alpha.beta", "v1.0.0-alpha.1", 1},
+		{"v1.0.0-alpha", "v1.0.0-alpha.1", -1},
+		{"v0.0.0-20190101000000-abcdef123456", "v0.0.0-20180101000000-abcdef123456", 1},
+	}
+
+	for _, test := range testCases {
+		if c := compareVersion(test.v, test.w); c != test.expected {
+			t.Errorf("compareVersion(%q, %q): got %d, want %d", test.v, test.w, c, test.expected)
+		}
+	}
+}
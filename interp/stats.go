@@ -0,0 +1,97 @@
+package interp
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// FuncStats are the execution statistics of an interpreted function.
+type FuncStats struct {
+	Function string        // function name, qualified by its package name
+	Calls    int64         // number of calls
+	Time     time.Duration // cumulative time of the calls, including callees
+	Alloc    int64         // estimated number of bytes allocated, excluding callees
+}
+
+// stats collects the execution statistics of functions.
+type stats struct {
+	mutex sync.Mutex
+	defs  map[*node]*funcStats  // statistics, indexed by function definition
+	names map[string]*funcStats // statistics, indexed by function name
+}
+
+// funcStats are the statistics of a function, updated atomically.
+type funcStats struct {
+	name  string
+	calls int64
+	nanos int64
+	alloc int64
+}
+
+// frameStats stores the statistics state of a frame.
+type frameStats struct {
+	fn    *funcStats
+	start time.Time
+}
+
+func newStats() *stats {
+	return &stats{defs: map[*node]*funcStats{}, names: map[string]*funcStats{}}
+}
+
+// Stats returns the statistics of the interpreted functions called since
+// the creation of the interpreter, by decreasing time, if enabled by the
+// CollectStats option, or nil otherwise. The time of a recursive call is
+// also counted in the ones of its callers. Functions are identified by
+// name, so the statistics of a function redefined in the REPL include the
+// calls of its previous definitions.
+func (interp *Interpreter) Stats() []FuncStats {
+	s := interp.stats
+	if s == nil {
+		return nil
+	}
+	s.mutex.Lock()
+	res := make([]FuncStats, 0, len(s.names))
+	for _, fs := range s.names {
+		res = append(res, FuncStats{
+			Function: fs.name,
+			Calls:    atomic.LoadInt64(&fs.calls),
+			Time:     time.Duration(atomic.LoadInt64(&fs.nanos)),
+			Alloc:    atomic.LoadInt64(&fs.alloc),
+		})
+	}
+	s.mutex.Unlock()
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Time != res[j].Time {
+			return res[i].Time > res[j].Time
+		}
+		return res[i].Function < res[j].Function
+	})
+	return res
+}
+
+// enter sets the statistics state of frame f, for a call of function def.
+func (s *stats) enter(f *frame, def *node) {
+	if def == nil || def.kind != funcDecl && def.kind != funcLit {
+		return
+	}
+	s.mutex.Lock()
+	fs, ok := s.defs[def]
+	if !ok {
+		name := funcName(def, def)
+		if fs = s.names[name]; fs == nil {
+			fs = &funcStats{name: name}
+			s.names[name] = fs
+		}
+		s.defs[def] = fs
+	}
+	s.mutex.Unlock()
+	atomic.AddInt64(&fs.calls, 1)
+	f.stats = &frameStats{fn: fs, start: time.Now()}
+}
+
+// exit accounts the time of the call of frame f.
+func (fs *frameStats) exit() {
+	atomic.AddInt64(&fs.fn.nanos, int64(time.Since(fs.start)))
+}
@@ -0,0 +1,358 @@
+package interp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// stdioSyncMark is written on an output pipe to wait until the output
+// written before it is forwarded.
+const stdioSyncMark = "\x00yaegi-stdio-sync\x00"
+
+// stream is a standard stream of interpreted code. Interpreted code sees
+// it as the file of the os package variable, such as os.Stdout. An input
+// or output which is not a file is connected to the file through a pipe.
+type stream struct {
+	mutex sync.Mutex
+	file  *os.File  // os package variable seen by interpreted code
+	proc  **os.File // stream of the process, used if not redirected
+	r     io.Reader // redirected input, or nil
+	w     io.Writer // redirected output, or nil
+	pipe  *os.File  // write end of the output pipe, or nil
+	fwd   *forward  // forwarder of the output pipe, or nil
+}
+
+// forward copies the output read from a pipe to a writer. It does not
+// reference the write end of the pipe, so the pipe is closed, and the
+// forwarding goroutine terminated, once the interpreter is garbage collected.
+type forward struct {
+	mutex  sync.Mutex
+	w      io.Writer     // destination of the output
+	synced chan struct{} // receives when a sync mark is read
+}
+
+func newStream(proc **os.File) *stream { return &stream{file: *proc, proc: proc} }
+
+// redirectInput sets the input of s to r, or to the process stream if r
+// is nil, and returns a function restoring the previous input.
+func (s *stream) redirectInput(r io.Reader) (restore func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	prevR, prevFile := s.r, s.file
+	s.r = r
+	switch f, ok := r.(*os.File); {
+	case r == nil:
+		s.file = *s.proc
+	case ok:
+		s.file = f
+	default:
+		// If the pipe can not be created, only fmt functions read from r
+		s.file = *s.proc
+		if pr, pw, err := os.Pipe(); err == nil {
+			go func() {
+				_, _ = io.Copy(pw, r)
+				_ = pw.Close()
+			}()
+			s.file = pr
+		}
+	}
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.r, s.file = prevR, prevFile
+	}
+}
+
+// redirectOutput sets the output of s to w, or to the process stream if w
+// is nil, and returns a function restoring the previous output. The output
+// pending in the pipe is forwarded before each change.
+func (s *stream) redirectOutput(w io.Writer) (restore func()) {
+	s.flush()
+	s.mutex.Lock()
+	prevW := s.w
+	s.setOutput(w)
+	s.mutex.Unlock()
+
+	return func() {
+		s.flush()
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.setOutput(prevW)
+	}
+}
+
+// setOutput sets the output of s to w. It must be called with s.mutex held.
+func (s *stream) setOutput(w io.Writer) {
+	s.w = w
+	switch f, ok := w.(*os.File); {
+	case w == nil:
+		s.file = *s.proc
+	case ok:
+		s.file = f
+	default:
+		if s.pipe == nil {
+			pr, pw, err := os.Pipe()
+			if err != nil {
+				// Only fmt and log functions write to w
+				s.file = *s.proc
+				return
+			}
+			s.pipe, s.fwd = pw, &forward{synced: make(chan struct{})}
+			go s.fwd.run(pr)
+		}
+		s.fwd.mutex.Lock()
+		s.fwd.w = w
+		s.fwd.mutex.Unlock()
+		s.file = s.pipe
+	}
+}
+
+// reader returns the reader used by interpreted code.
+func (s *stream) reader() io.Reader {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.r == nil {
+		return *s.proc
+	}
+	if _, ok := s.r.(*os.File); !ok && s.file == *s.proc {
+		return s.r
+	}
+	return s.file
+}
+
+// writer returns the writer used by interpreted code.
+func (s *stream) writer() io.Writer {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.w == nil {
+		return *s.proc
+	}
+	if _, ok := s.w.(*os.File); !ok && s.pipe == nil {
+		return s.w
+	}
+	return s.file
+}
+
+// Write writes to the writer used by interpreted code.
+func (s *stream) Write(b []byte) (int, error) { return s.writer().Write(b) }
+
+// refresh follows the process stream if s is not redirected, as the
+// process may have changed it since the interpreter creation.
+func (s *stream) refresh() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.r == nil && s.w == nil {
+		s.file = *s.proc
+	}
+}
+
+// flush returns once the output written so far in the pipe is forwarded.
+func (s *stream) flush() {
+	s.mutex.Lock()
+	pipe, fwd := s.pipe, s.fwd
+	s.mutex.Unlock()
+	if pipe == nil {
+		return
+	}
+	if _, err := pipe.WriteString(stdioSyncMark); err == nil {
+		<-fwd.synced
+	}
+}
+
+// run forwards the output read from r, until r is closed.
+func (fw *forward) run(r *os.File) {
+	mark := []byte(stdioSyncMark)
+	var buf []byte
+	b := make([]byte, 4096)
+	for {
+		n, err := r.Read(b)
+		buf = append(buf, b[:n]...)
+		for {
+			i := bytes.Index(buf, mark)
+			if i < 0 {
+				break
+			}
+			fw.write(buf[:i])
+			buf = buf[i+len(mark):]
+			fw.synced <- struct{}{}
+		}
+		// Keep the beginning of a mark, if any, until next read
+		keep := 0
+		for k := len(mark) - 1; k > 0; k-- {
+			if bytes.HasSuffix(buf, mark[:k]) {
+				keep = k
+				break
+			}
+		}
+		fw.write(buf[:len(buf)-keep])
+		buf = buf[len(buf)-keep:]
+		if err != nil {
+			_ = r.Close()
+			return
+		}
+	}
+}
+
+func (fw *forward) write(b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	fw.mutex.Lock()
+	defer fw.mutex.Unlock()
+	_, _ = fw.w.Write(b)
+}
+
+// Redirect sets the standard input, output and error of interpreted code,
+// until the returned function is called to restore the previous ones.
+// A nil argument leaves the corresponding stream unchanged. Redirection
+// applies to os.Stdin, os.Stdout and os.Stderr, and to the fmt and log
+// functions using them, as seen by interpreted code, including in
+// goroutines. Binary code called by interpreted code is not affected.
+func (interp *Interpreter) Redirect(stdin io.Reader, stdout, stderr io.Writer) (restore func()) {
+	var restores []func()
+	if stdin != nil {
+		restores = append(restores, interp.stdin.redirectInput(stdin))
+	}
+	if stdout != nil {
+		restores = append(restores, interp.stdout.redirectOutput(stdout))
+	}
+	if stderr != nil {
+		restores = append(restores, interp.stderr.redirectOutput(stderr))
+	}
+	return func() {
+		for _, r := range restores {
+			r()
+		}
+	}
+}
+
+// CaptureOutput calls f, typically to evaluate code, and returns the
+// standard output and error written meanwhile by interpreted code.
+func (interp *Interpreter) CaptureOutput(f func()) (stdout, stderr string) {
+	var o, e strings.Builder
+	restore := interp.Redirect(nil, &o, &e)
+	func() {
+		defer restore()
+		f()
+	}()
+	return o.String(), e.String()
+}
+
+// flushOutput returns once the output written so far by interpreted code
+// is forwarded to the redirected outputs.
+func (interp *Interpreter) flushOutput() {
+	interp.stdout.flush()
+	interp.stderr.flush()
+}
+
+// stdioPkg contains the binary packages redefined by stdioSymbols.
+var stdioPkg = map[string]bool{"errors": true, "fmt": true, "log": true, "os": true, "os/exec": true}
+
+// stdioSymbols returns the symbols of binary package path, redefined to
+// use the standard streams and the environment of the interpreter, or to
+// handle the values of interpreted types, or values if the package does not
+// use them. Values are not modified, as they may be shared by
+// other interpreters.
+func (interp *Interpreter) stdioSymbols(path string, values map[string]reflect.Value) map[string]reflect.Value {
+	var redef, env map[string]reflect.Value
+	switch path {
+	case "errors":
+		redef = map[string]reflect.Value{"As": reflect.ValueOf(errorsAs)}
+	case "fmt":
+		stdin, stdout := interp.stdin, interp.stdout
+		redef = map[string]reflect.Value{
+			"Print": reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fprint(stdout.writer(), a...) }),
+			"Printf": reflect.ValueOf(func(format string, a ...interface{}) (int, error) {
+				return fmt.Fprintf(stdout.writer(), format, a...)
+			}),
+			"Println": reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fprintln(stdout.writer(), a...) }),
+			"Scan":    reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fscan(stdin.reader(), a...) }),
+			"Scanf": reflect.ValueOf(func(format string, a ...interface{}) (int, error) {
+				return fmt.Fscanf(stdin.reader(), format, a...)
+			}),
+			"Scanln": reflect.ValueOf(func(a ...interface{}) (int, error) { return fmt.Fscanln(stdin.reader(), a...) }),
+		}
+	case "log":
+		l := log.New(interp.stderr, "", log.LstdFlags)
+		redef = map[string]reflect.Value{
+			"Fatal":     reflect.ValueOf(l.Fatal),
+			"Fatalf":    reflect.ValueOf(l.Fatalf),
+			"Fatalln":   reflect.ValueOf(l.Fatalln),
+			"Flags":     reflect.ValueOf(l.Flags),
+			"Output":    reflect.ValueOf(func(calldepth int, s string) error { return l.Output(calldepth+1, s) }),
+			"Panic":     reflect.ValueOf(l.Panic),
+			"Panicf":    reflect.ValueOf(l.Panicf),
+			"Panicln":   reflect.ValueOf(l.Panicln),
+			"Prefix":    reflect.ValueOf(l.Prefix),
+			"Print":     reflect.ValueOf(l.Print),
+			"Printf":    reflect.ValueOf(l.Printf),
+			"Println":   reflect.ValueOf(l.Println),
+			"SetFlags":  reflect.ValueOf(l.SetFlags),
+			"SetOutput": reflect.ValueOf(l.SetOutput),
+			"SetPrefix": reflect.ValueOf(l.SetPrefix),
+			"Writer":    reflect.ValueOf(l.Writer),
+			"Default":   reflect.ValueOf(func() *log.Logger { return l }),
+		}
+	case "os":
+		redef = map[string]reflect.Value{
+			"Stdin":  reflect.ValueOf(&interp.stdin.file).Elem(),
+			"Stdout": reflect.ValueOf(&interp.stdout.file).Elem(),
+			"Stderr": reflect.ValueOf(&interp.stderr.file).Elem(),
+		}
+		if e := interp.env; e != nil {
+			// Defined even if the package does not provide them, as the
+			// os package of sandboxfs
+			env = map[string]reflect.Value{
+				"Clearenv":  reflect.ValueOf(e.clearenv),
+				"Environ":   reflect.ValueOf(e.environ),
+				"ExpandEnv": reflect.ValueOf(e.expandEnv),
+				"Getenv":    reflect.ValueOf(e.getenv),
+				"LookupEnv": reflect.ValueOf(e.lookupEnv),
+				"Setenv":    reflect.ValueOf(e.setenv),
+				"Unsetenv":  reflect.ValueOf(e.unsetenv),
+			}
+		}
+	case "os/exec":
+		e := interp.env
+		if e == nil {
+			return values
+		}
+		// The commands run in the environment of interpreted code, as of
+		// their creation, in place of the one of the process
+		redef = map[string]reflect.Value{
+			"Command": reflect.ValueOf(func(name string, arg ...string) *exec.Cmd {
+				cmd := exec.Command(name, arg...)
+				cmd.Env = e.environ()
+				return cmd
+			}),
+			"CommandContext": reflect.ValueOf(func(ctx context.Context, name string, arg ...string) *exec.Cmd {
+				cmd := exec.CommandContext(ctx, name, arg...)
+				cmd.Env = e.environ()
+				return cmd
+			}),
+		}
+	default:
+		return values
+	}
+
+	m := make(map[string]reflect.Value, len(values))
+	for name, v := range values {
+		if r, ok := redef[name]; ok {
+			v = r
+		}
+		m[name] = v
+	}
+	for name, v := range env {
+		m[name] = v
+	}
+	return m
+}
@@ -0,0 +1,837 @@
+package interp
+
+import (
+	"io/fs"
+	"testing/fstest"
+)
+
+// stdSrc holds the source of the standard library packages which can not be
+// exported as binary symbols, because they declare generic types or
+// functions. They are interpreted when imported.
+var stdSrc = fstest.MapFS{
+	"cmp/cmp.go":       {Data: []byte(cmpSrc)},
+	"iter/iter.go":     {Data: []byte(iterSrc)},
+	"maps/maps.go":     {Data: []byte(mapsSrc)},
+	"slices/slices.go": {Data: []byte(slicesSrc)},
+}
+
+// stdSrcDir returns the directory in stdSrc of the package of import path,
+// or an empty string if the package source is not there.
+func stdSrcDir(path string) string {
+	if info, err := fs.Stat(stdSrc, path); err != nil || !info.IsDir() {
+		return ""
+	}
+	return path
+}
+
+// cmpSrc is the source of package cmp.
+const cmpSrc = `// Package cmp provides types and functions related to comparing ordered
+// values.
+package cmp
+
+// Ordered is a constraint that permits any ordered type: any type that
+// supports the operators < <= >= >.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Less reports whether x is less than y. A NaN is less than any non-NaN.
+func Less[T Ordered](x, y T) bool {
+	return (isNaN(x) && !isNaN(y)) || x < y
+}
+
+// Compare returns -1 if x is less than y, 0 if x equals y, and +1 if x is
+// greater than y. A NaN is less than any non-NaN, and equal to a NaN.
+func Compare[T Ordered](x, y T) int {
+	xNaN := isNaN(x)
+	yNaN := isNaN(y)
+	if xNaN {
+		if yNaN {
+			return 0
+		}
+		return -1
+	}
+	if yNaN {
+		return +1
+	}
+	if x < y {
+		return -1
+	}
+	if x > y {
+		return +1
+	}
+	return 0
+}
+
+// isNaN reports whether x is a NaN without requiring the math package.
+func isNaN[T Ordered](x T) bool {
+	return x != x
+}
+
+// Or returns the first of its arguments that is not equal to the zero value.
+// If no argument is non-zero, it returns the zero value.
+func Or[T comparable](vals ...T) T {
+	var zero T
+	for _, v := range vals {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
+`
+
+// iterSrc is the source of package iter. Pull iterators run the push
+// iterator in a goroutine.
+const iterSrc = `// Package iter provides basic definitions related to iterators over
+// sequences.
+package iter
+
+// Seq is an iterator over sequences of individual values.
+type Seq[V any] func(yield func(V) bool)
+
+// Seq2 is an iterator over sequences of pairs of values.
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// Pull converts the push iterator seq to a pull iterator: next returns the
+// next value and true, or the zero value and false at the end of the
+// sequence, and stop ends the iteration.
+func Pull[V any](seq Seq[V]) (func() (V, bool), func()) {
+	req := make(chan bool)
+	vals := make(chan V)
+	started, done := false, false
+	next := func() (V, bool) {
+		var v V
+		if done {
+			return v, false
+		}
+		if !started {
+			started = true
+			go func() {
+				if <-req {
+					seq(func(v V) bool {
+						vals <- v
+						return <-req
+					})
+				}
+				close(vals)
+			}()
+		}
+		req <- true
+		v, ok := <-vals
+		done = !ok
+		return v, ok
+	}
+	stop := func() {
+		if done {
+			return
+		}
+		done = true
+		if started {
+			req <- false
+			for range vals {
+			}
+		}
+	}
+	return next, stop
+}
+
+// Pull2 converts the push iterator seq to a pull iterator: next returns the
+// next pair of values and true, or zero values and false at the end of the
+// sequence, and stop ends the iteration.
+func Pull2[K, V any](seq Seq2[K, V]) (func() (K, V, bool), func()) {
+	req := make(chan bool)
+	keys := make(chan K)
+	vals := make(chan V)
+	started, done := false, false
+	next := func() (K, V, bool) {
+		var k K
+		var v V
+		if done {
+			return k, v, false
+		}
+		if !started {
+			started = true
+			go func() {
+				if <-req {
+					seq(func(k K, v V) bool {
+						keys <- k
+						vals <- v
+						return <-req
+					})
+				}
+				close(keys)
+			}()
+		}
+		req <- true
+		k, ok := <-keys
+		if ok {
+			v = <-vals
+		}
+		done = !ok
+		return k, v, ok
+	}
+	stop := func() {
+		if done {
+			return
+		}
+		done = true
+		if started {
+			req <- false
+			for range keys {
+			}
+		}
+	}
+	return next, stop
+}
+`
+
+// mapsSrc is the source of package maps.
+const mapsSrc = `// Package maps defines various functions useful with maps of any type.
+package maps
+
+import "iter"
+
+// Equal reports whether two maps contain the same key/value pairs.
+// Values are compared using ==.
+func Equal[M1, M2 ~map[K]V, K, V comparable](m1 M1, m2 M2) bool {
+	if len(m1) != len(m2) {
+		return false
+	}
+	for k, v1 := range m1 {
+		if v2, ok := m2[k]; !ok || v1 != v2 {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc is like Equal, but compares values using eq.
+// Keys are still compared with ==.
+func EqualFunc[M1 ~map[K]V1, M2 ~map[K]V2, K comparable, V1, V2 any](m1 M1, m2 M2, eq func(V1, V2) bool) bool {
+	if len(m1) != len(m2) {
+		return false
+	}
+	for k, v1 := range m1 {
+		if v2, ok := m2[k]; !ok || !eq(v1, v2) {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of m. This is a shallow clone:
+// the new keys and values are set using ordinary assignment.
+func Clone[M ~map[K]V, K comparable, V any](m M) M {
+	if m == nil {
+		return nil
+	}
+	r := make(M, len(m))
+	for k, v := range m {
+		r[k] = v
+	}
+	return r
+}
+
+// Copy copies all key/value pairs in src adding them to dst.
+// When a key in src is already present in dst,
+// the value in dst will be overwritten by the value associated
+// with the key in src.
+func Copy[M1 ~map[K]V, M2 ~map[K]V, K comparable, V any](dst M1, src M2) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+// DeleteFunc deletes any key/value pairs from m for which del returns true.
+func DeleteFunc[M ~map[K]V, K comparable, V any](m M, del func(K, V) bool) {
+	for k, v := range m {
+		if del(k, v) {
+			delete(m, k)
+		}
+	}
+}
+
+// All returns an iterator over key-value pairs from m.
+// The iteration order is not specified and is not guaranteed
+// to be the same from one call to the next.
+func All[Map ~map[K]V, K comparable, V any](m Map) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over keys in m.
+// The iteration order is not specified and is not guaranteed
+// to be the same from one call to the next.
+func Keys[Map ~map[K]V, K comparable, V any](m Map) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over values in m.
+// The iteration order is not specified and is not guaranteed
+// to be the same from one call to the next.
+func Values[Map ~map[K]V, K comparable, V any](m Map) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Insert adds the key-value pairs from seq to m.
+// If a key in seq already exists in m, its value will be overwritten.
+func Insert[Map ~map[K]V, K comparable, V any](m Map, seq iter.Seq2[K, V]) {
+	for k, v := range seq {
+		m[k] = v
+	}
+}
+
+// Collect collects key-value pairs from seq into a new map
+// and returns it.
+func Collect[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	m := make(map[K]V)
+	for k, v := range seq {
+		m[k] = v
+	}
+	return m
+}
+`
+
+// slicesSrc is the source of package slices. Sorting functions are stable.
+const slicesSrc = `// Package slices defines various functions useful with slices of any type.
+package slices
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Equal reports whether two slices are equal: the same length and all
+// elements equal.
+func Equal[S ~[]E, E comparable](s1, s2 S) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// EqualFunc reports whether two slices are equal using an equality
+// function on each pair of elements.
+func EqualFunc[S1 ~[]E1, S2 ~[]E2, E1, E2 any](s1 S1, s2 S2, eq func(E1, E2) bool) bool {
+	if len(s1) != len(s2) {
+		return false
+	}
+	for i, v1 := range s1 {
+		if !eq(v1, s2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Compare compares the elements of s1 and s2, using cmp.Compare on each
+// pair of elements.
+func Compare[S ~[]E, E cmp.Ordered](s1, s2 S) int {
+	for i, v1 := range s1 {
+		if i >= len(s2) {
+			return +1
+		}
+		if c := cmp.Compare(v1, s2[i]); c != 0 {
+			return c
+		}
+	}
+	if len(s1) < len(s2) {
+		return -1
+	}
+	return 0
+}
+
+// CompareFunc is like Compare but uses a custom comparison function on
+// each pair of elements.
+func CompareFunc[S1 ~[]E1, S2 ~[]E2, E1, E2 any](s1 S1, s2 S2, cmp func(E1, E2) int) int {
+	for i, v1 := range s1 {
+		if i >= len(s2) {
+			return +1
+		}
+		if c := cmp(v1, s2[i]); c != 0 {
+			return c
+		}
+	}
+	if len(s1) < len(s2) {
+		return -1
+	}
+	return 0
+}
+
+// Index returns the index of the first occurrence of v in s, or -1 if not
+// present.
+func Index[S ~[]E, E comparable](s S, v E) int {
+	for i := range s {
+		if v == s[i] {
+			return i
+		}
+	}
+	return -1
+}
+
+// IndexFunc returns the first index i satisfying f(s[i]), or -1 if none
+// do.
+func IndexFunc[S ~[]E, E any](s S, f func(E) bool) int {
+	for i := range s {
+		if f(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether v is present in s.
+func Contains[S ~[]E, E comparable](s S, v E) bool {
+	return Index(s, v) >= 0
+}
+
+// ContainsFunc reports whether at least one element e of s satisfies f(e).
+func ContainsFunc[S ~[]E, E any](s S, f func(E) bool) bool {
+	return IndexFunc(s, f) >= 0
+}
+
+// Insert inserts the values v... into s at index i, returning the modified
+// slice.
+func Insert[S ~[]E, E any](s S, i int, v ...E) S {
+	_ = s[i:] // bounds check
+	r := make(S, 0, len(s)+len(v))
+	r = append(r, s[:i]...)
+	r = append(r, v...)
+	return append(r, s[i:]...)
+}
+
+// Delete removes the elements s[i:j] from s, returning the modified slice.
+// The elements s[len(s)-(j-i):len(s)] are zeroed.
+func Delete[S ~[]E, E any](s S, i, j int) S {
+	_ = s[i:j:len(s)] // bounds check
+	if i == j {
+		return s
+	}
+	oldlen := len(s)
+	s = append(s[:i], s[j:]...)
+	zero(s[len(s):oldlen])
+	return s
+}
+
+// DeleteFunc removes any elements from s for which del returns true,
+// returning the modified slice.
+func DeleteFunc[S ~[]E, E any](s S, del func(E) bool) S {
+	i := IndexFunc(s, del)
+	if i == -1 {
+		return s
+	}
+	for j := i + 1; j < len(s); j++ {
+		if v := s[j]; !del(v) {
+			s[i] = v
+			i++
+		}
+	}
+	zero(s[i:])
+	return s[:i]
+}
+
+// Replace replaces the elements s[i:j] by the given v, and returns the
+// modified slice.
+func Replace[S ~[]E, E any](s S, i, j int, v ...E) S {
+	_ = s[i:j] // bounds check
+	tail := Clone(s[j:])
+	oldlen := len(s)
+	s = append(append(s[:i], v...), tail...)
+	if len(s) < oldlen {
+		zero(s[len(s):oldlen])
+	}
+	return s
+}
+
+// Clone returns a copy of the slice. The elements are copied using
+// assignment, so this is a shallow clone.
+func Clone[S ~[]E, E any](s S) S {
+	if s == nil {
+		return nil
+	}
+	return append(S{}, s...)
+}
+
+// Compact replaces consecutive runs of equal elements with a single copy.
+func Compact[S ~[]E, E comparable](s S) S {
+	if len(s) < 2 {
+		return s
+	}
+	i := 1
+	for k := 1; k < len(s); k++ {
+		if s[k] != s[k-1] {
+			if i != k {
+				s[i] = s[k]
+			}
+			i++
+		}
+	}
+	zero(s[i:])
+	return s[:i]
+}
+
+// CompactFunc is like Compact but uses an equality function to compare
+// elements.
+func CompactFunc[S ~[]E, E any](s S, eq func(E, E) bool) S {
+	if len(s) < 2 {
+		return s
+	}
+	i := 1
+	for k := 1; k < len(s); k++ {
+		if !eq(s[k], s[k-1]) {
+			if i != k {
+				s[i] = s[k]
+			}
+			i++
+		}
+	}
+	zero(s[i:])
+	return s[:i]
+}
+
+// Grow increases the slice's capacity, if necessary, to guarantee space
+// for another n elements.
+func Grow[S ~[]E, E any](s S, n int) S {
+	if n < 0 {
+		panic("cannot be negative")
+	}
+	if n -= cap(s) - len(s); n > 0 {
+		s = append(s[:cap(s)], make([]E, n)...)[:len(s)]
+	}
+	return s
+}
+
+// Clip removes unused capacity from the slice, returning s[:len(s):len(s)].
+func Clip[S ~[]E, E any](s S) S {
+	return s[:len(s):len(s)]
+}
+
+// Reverse reverses the elements of the slice in place.
+func Reverse[S ~[]E, E any](s S) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Concat returns a new slice concatenating the passed in slices.
+func Concat[S ~[]E, E any](slices ...S) S {
+	size := 0
+	for _, s := range slices {
+		size += len(s)
+		if size < 0 {
+			panic("len out of range")
+		}
+	}
+	newslice := Grow[S](nil, size)
+	for _, s := range slices {
+		newslice = append(newslice, s...)
+	}
+	return newslice
+}
+
+// Repeat returns a new slice that repeats the provided slice the given
+// number of times.
+func Repeat[S ~[]E, E any](x S, count int) S {
+	if count < 0 {
+		panic("cannot be negative")
+	}
+	newslice := make(S, 0, len(x)*count)
+	for i := 0; i < count; i++ {
+		newslice = append(newslice, x...)
+	}
+	return newslice
+}
+
+// Sort sorts a slice of any ordered type in ascending order.
+func Sort[S ~[]E, E cmp.Ordered](x S) {
+	SortFunc(x, cmp.Compare[E])
+}
+
+// SortFunc sorts the slice x in ascending order as determined by the cmp
+// function. This sort is not guaranteed to be stable.
+func SortFunc[S ~[]E, E any](x S, cmp func(a, b E) int) {
+	SortStableFunc(x, cmp)
+}
+
+// SortStableFunc sorts the slice x while keeping the original order of
+// equal elements, using cmp to compare elements in the same way as
+// SortFunc.
+func SortStableFunc[S ~[]E, E any](x S, cmp func(a, b E) int) {
+	if len(x) < 2 {
+		return
+	}
+	// Insertion sort of small blocks, then merge of blocks of growing size
+	const blockSize = 16
+	for a := 0; a < len(x); a += blockSize {
+		b := minInt(a+blockSize, len(x))
+		for i := a + 1; i < b; i++ {
+			for j := i; j > a && cmp(x[j], x[j-1]) < 0; j-- {
+				x[j], x[j-1] = x[j-1], x[j]
+			}
+		}
+	}
+	buf := make(S, len(x))
+	for size := blockSize; size < len(x); size *= 2 {
+		for a := 0; a < len(x)-size; a += 2 * size {
+			m, b := a+size, minInt(a+2*size, len(x))
+			copy(buf[a:b], x[a:b])
+			i, j := a, m
+			for k := a; k < b; k++ {
+				if i < m && (j >= b || cmp(buf[j], buf[i]) >= 0) {
+					x[k] = buf[i]
+					i++
+				} else {
+					x[k] = buf[j]
+					j++
+				}
+			}
+		}
+	}
+}
+
+// IsSorted reports whether x is sorted in ascending order.
+func IsSorted[S ~[]E, E cmp.Ordered](x S) bool {
+	for i := len(x) - 1; i > 0; i-- {
+		if cmp.Less(x[i], x[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSortedFunc reports whether x is sorted in ascending order, with cmp as
+// the comparison function as defined by SortFunc.
+func IsSortedFunc[S ~[]E, E any](x S, cmp func(a, b E) int) bool {
+	for i := len(x) - 1; i > 0; i-- {
+		if cmp(x[i], x[i-1]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Min returns the minimal value in x. It panics if x is empty. For
+// floating-point numbers, Min propagates NaNs.
+func Min[S ~[]E, E cmp.Ordered](x S) E {
+	if len(x) < 1 {
+		panic("slices.Min: empty list")
+	}
+	m := x[0]
+	for i := 1; i < len(x); i++ {
+		if v := x[i]; v != v || v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// MinFunc returns the minimal value in x, using cmp to compare elements.
+// It panics if x is empty.
+func MinFunc[S ~[]E, E any](x S, cmp func(a, b E) int) E {
+	if len(x) < 1 {
+		panic("slices.MinFunc: empty list")
+	}
+	m := x[0]
+	for i := 1; i < len(x); i++ {
+		if cmp(x[i], m) < 0 {
+			m = x[i]
+		}
+	}
+	return m
+}
+
+// Max returns the maximal value in x. It panics if x is empty. For
+// floating-point numbers, Max propagates NaNs.
+func Max[S ~[]E, E cmp.Ordered](x S) E {
+	if len(x) < 1 {
+		panic("slices.Max: empty list")
+	}
+	m := x[0]
+	for i := 1; i < len(x); i++ {
+		if v := x[i]; v != v || v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+// MaxFunc returns the maximal value in x, using cmp to compare elements.
+// It panics if x is empty.
+func MaxFunc[S ~[]E, E any](x S, cmp func(a, b E) int) E {
+	if len(x) < 1 {
+		panic("slices.MaxFunc: empty list")
+	}
+	m := x[0]
+	for i := 1; i < len(x); i++ {
+		if cmp(x[i], m) > 0 {
+			m = x[i]
+		}
+	}
+	return m
+}
+
+// BinarySearch searches for target in a sorted slice and returns the
+// earliest position where target is found, or the position where target
+// would appear in the sort order, and whether the target is found.
+func BinarySearch[S ~[]E, E cmp.Ordered](x S, target E) (int, bool) {
+	n := len(x)
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if cmp.Less(x[h], target) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i, i < n && (x[i] == target || (x[i] != x[i] && target != target))
+}
+
+// BinarySearchFunc works like BinarySearch, but uses a custom comparison
+// function.
+func BinarySearchFunc[S ~[]E, E, T any](x S, target T, cmp func(E, T) int) (int, bool) {
+	n := len(x)
+	i, j := 0, n
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if cmp(x[h], target) < 0 {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	return i, i < n && cmp(x[i], target) == 0
+}
+
+// All returns an iterator over index-value pairs in the slice in the usual
+// order.
+func All[Slice ~[]E, E any](s Slice) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i, v := range s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs in the slice,
+// traversing it backward with descending indices.
+func Backward[Slice ~[]E, E any](s Slice) iter.Seq2[int, E] {
+	return func(yield func(int, E) bool) {
+		for i := len(s) - 1; i >= 0; i-- {
+			if !yield(i, s[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator that yields the slice elements in order.
+func Values[Slice ~[]E, E any](s Slice) iter.Seq[E] {
+	return func(yield func(E) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// AppendSeq appends the values from seq to the slice and returns the
+// extended slice.
+func AppendSeq[Slice ~[]E, E any](s Slice, seq iter.Seq[E]) Slice {
+	for v := range seq {
+		s = append(s, v)
+	}
+	return s
+}
+
+// Collect collects values from seq into a new slice and returns it.
+func Collect[E any](seq iter.Seq[E]) []E {
+	return AppendSeq([]E(nil), seq)
+}
+
+// Sorted collects values from seq into a new slice, sorts the slice, and
+// returns it.
+func Sorted[E cmp.Ordered](seq iter.Seq[E]) []E {
+	s := Collect(seq)
+	Sort(s)
+	return s
+}
+
+// SortedFunc collects values from seq into a new slice, sorts the slice
+// using the comparison function, and returns it.
+func SortedFunc[E any](seq iter.Seq[E], cmp func(E, E) int) []E {
+	s := Collect(seq)
+	SortFunc(s, cmp)
+	return s
+}
+
+// SortedStableFunc collects values from seq into a new slice, sorts the
+// slice while keeping the original order of equal elements, using the
+// comparison function to compare elements, and returns it.
+func SortedStableFunc[E any](seq iter.Seq[E], cmp func(E, E) int) []E {
+	s := Collect(seq)
+	SortStableFunc(s, cmp)
+	return s
+}
+
+// Chunk returns an iterator over consecutive sub-slices of up to n
+// elements of s.
+func Chunk[Slice ~[]E, E any](s Slice, n int) iter.Seq[Slice] {
+	if n < 1 {
+		panic("cannot be less than 1")
+	}
+	return func(yield func(Slice) bool) {
+		for i := 0; i < len(s); i += n {
+			end := minInt(n, len(s[i:]))
+			if !yield(s[i : i+end : i+end]) {
+				return
+			}
+		}
+	}
+}
+
+// zero sets the elements of s to their zero value.
+func zero[S ~[]E, E any](s S) {
+	var z E
+	for i := range s {
+		s[i] = z
+	}
+}
+
+// minInt returns the smaller of a and b.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+`
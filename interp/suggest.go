@@ -0,0 +1,172 @@
+package interp
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// suggest returns err with a "did you mean" note naming the closest of names
+// to the undefined name, if any is close enough to be a misspelling.
+func suggest(err error, name string, names []string) error {
+	if s := closest(name, names); s != "" {
+		return note(err, "did you mean %s?", s)
+	}
+	return err
+}
+
+// closest returns the name of names closest to name, by edit distance
+// ignoring case, or an empty string if none is within a third of the length
+// of name. Ties are broken in lexical order.
+func closest(name string, names []string) string {
+	max := len(name) / 3
+	if max < 1 {
+		max = 1
+	}
+	sort.Strings(names)
+	best, dmin := "", max+1
+	for _, s := range names {
+		if s == name || s == "_" {
+			continue
+		}
+		if d := editDistance(strings.ToLower(name), strings.ToLower(s)); d < dmin {
+			best, dmin = s, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the edit distance between a and b, the number of
+// single byte insertions, deletions, substitutions or transpositions of
+// adjacent bytes changing a into b.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = minInt(d[i-1][j]+1, minInt(d[i][j-1]+1, d[i-1][j-1]+cost))
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// names returns the names of the symbols visible in scope sc.
+func (sc *scope) names() []string {
+	var names []string
+	seen := map[string]bool{}
+	for s := sc; s != nil; s = s.anc {
+		for name := range s.sym {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// selectorNames returns the names of the fields and methods of type t, and
+// of its embedded fields.
+func selectorNames(t *itype) []string {
+	var names []string
+	seen := map[*itype]bool{}
+	var add func(t *itype)
+	add = func(t *itype) {
+		if t == nil || seen[t] {
+			return
+		}
+		seen[t] = true
+		for _, m := range t.method {
+			names = append(names, methodName(m))
+		}
+		switch t.cat {
+		case ptrT, aliasT:
+			add(t.val)
+		case valueT, errorT:
+			names = append(names, rtypeNames(t.rtype)...)
+		}
+		for _, f := range t.field {
+			names = append(names, f.name)
+			if f.embed {
+				add(f.typ)
+			}
+		}
+	}
+	add(t)
+	return names
+}
+
+// methodName returns the name of the method m, a method declaration or the
+// method node of an interface type.
+func methodName(m *node) string {
+	if m.ident == "" && m.kind == funcDecl {
+		return m.child[1].ident
+	}
+	return m.ident
+}
+
+// rtypeNames returns the names of the methods and fields of the runtime type
+// rt, including the methods of its pointer type.
+func rtypeNames(rt reflect.Type) []string {
+	if rt == nil {
+		return nil
+	}
+	var names []string
+	if rt.Kind() != reflect.Ptr && rt.Kind() != reflect.Interface {
+		rt = reflect.PtrTo(rt)
+	}
+	for i := 0; i < rt.NumMethod(); i++ {
+		names = append(names, rt.Method(i).Name)
+	}
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt.Kind() == reflect.Struct {
+		for i := 0; i < rt.NumField(); i++ {
+			names = append(names, rt.Field(i).Name)
+		}
+	}
+	return names
+}
+
+// exportedNames returns the exported names of the symbols of a binary package.
+func exportedNames(syms map[string]reflect.Value) []string {
+	var names []string
+	for name := range syms {
+		if canExport(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// exportedSyms returns the exported names of the symbols of a source package.
+func exportedSyms(syms map[string]*symbol) []string {
+	var names []string
+	for name := range syms {
+		if canExport(name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
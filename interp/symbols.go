@@ -0,0 +1,112 @@
+package interp
+
+import (
+	"fmt"
+	"go/ast"
+	"reflect"
+	"strings"
+)
+
+// Symbols returns the exported symbols of the interpreted package of import
+// path pkgPath, such as "main", indexed by name, or nil if the package is
+// not loaded. As for Exports, functions are callable values, variables are
+// addressable values which can be set, and types are represented by a nil
+// pointer to the type. Generic functions and types are not returned.
+// Values of variables are only defined once the package is evaluated. It
+// returns nil if called by the running evaluation, as Eval returns ErrReentrant.
+func (interp *Interpreter) Symbols(pkgPath string) map[string]reflect.Value {
+	if err := interp.lock(); err != nil {
+		return nil
+	}
+	defer interp.unlock()
+	sc := interp.pkgScope(pkgPath)
+	if sc == nil {
+		return nil
+	}
+
+	syms := map[string]reflect.Value{}
+	for name, sym := range sc.sym {
+		if !ast.IsExported(name) || sym.typ == nil || sym.typ.cat == genericT {
+			continue
+		}
+		if v := interp.symbolValue(sym); v.IsValid() {
+			syms[name] = v
+		}
+	}
+	return syms
+}
+
+// pkgScope returns the scope of the interpreted package of import path
+// pkgPath, or nil.
+func (interp *Interpreter) pkgScope(pkgPath string) *scope {
+	if name, ok := interp.srcPkg[pkgPath]; ok {
+		return interp.scopes[name]
+	}
+	return interp.scopes[pkgPath]
+}
+
+// symbolValue returns the runtime value of a package level symbol, or an
+// invalid value.
+func (interp *Interpreter) symbolValue(sym *symbol) reflect.Value {
+	switch sym.kind {
+	case constSym:
+		if sym.rval.IsValid() {
+			if t := sym.typ.TypeOf(); t != nil && sym.rval.Type().ConvertibleTo(t) {
+				return sym.rval.Convert(t)
+			}
+			return sym.rval
+		}
+		fallthrough
+	case varSym:
+		if sym.index >= 0 && sym.index < len(interp.frame.data) {
+			return interp.frame.data[sym.index]
+		}
+	case funcSym:
+		if sym.node != nil && sym.typ.cat == funcT {
+			return genFunctionWrapper(sym.node)(interp.frame)
+		}
+	case typeSym:
+		if t := sym.typ.TypeOf(); t != nil {
+			return reflect.Zero(reflect.PtrTo(t))
+		}
+	}
+	return reflect.Value{}
+}
+
+// GetFunc binds the interpreted function name to the function pointed by
+// fptr, such as a pointer to a func(int) int variable. The name is qualified
+// by the package path, as in "github.com/foo/bar.Hello", or defaults to the
+// main package. An error is returned if the function does not exist, or if
+// its signature differs from the type of the function pointed by fptr.
+func (interp *Interpreter) GetFunc(name string, fptr interface{}) error {
+	if err := interp.lock(); err != nil {
+		return err
+	}
+	defer interp.unlock()
+	p := reflect.ValueOf(fptr)
+	if p.Kind() != reflect.Ptr || p.IsNil() || p.Elem().Kind() != reflect.Func {
+		return fmt.Errorf("%T is not a pointer to a function", fptr)
+	}
+
+	pkgPath, fname := mainID, name
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		pkgPath, fname = name[:i], name[i+1:]
+	}
+	sc := interp.pkgScope(pkgPath)
+	if sc == nil {
+		return fmt.Errorf("package %s not found", pkgPath)
+	}
+	sym, ok := sc.sym[fname]
+	if !ok {
+		return fmt.Errorf("%s not found", name)
+	}
+	if sym.kind != funcSym || sym.node == nil || sym.typ.cat != funcT {
+		return fmt.Errorf("%s is not a function", name)
+	}
+	v := interp.symbolValue(sym)
+	if t := p.Elem().Type(); !v.Type().AssignableTo(t) {
+		return fmt.Errorf("cannot use %s (type %v) as type %v", name, v.Type(), t)
+	}
+	p.Elem().Set(v)
+	return nil
+}
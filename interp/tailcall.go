@@ -0,0 +1,95 @@
+package interp
+
+import "reflect"
+
+// markTailCalls sets the generator of the direct recursive calls of function
+// def in tail position, as in "return f(n - 1)", to tailCall: the function is
+// run again in the frame of the current call, instead of a new frame, so
+// the depth of the tail recursion is not limited by memory.
+//
+// The frame is reused only if nothing created by the body keeps a reference
+// to it, and if the function has no deferred calls, which must run once the
+// callee has returned. Methods and variadic functions are not concerned.
+func markTailCalls(def *node) {
+	if !def.reuse || isMethod(def) {
+		return
+	}
+	if args := def.typ.arg; len(args) > 0 && args[len(args)-1].variadic {
+		return
+	}
+	var calls []*node
+	deferred := false
+	def.child[3].Walk(func(n *node) bool {
+		switch n.kind {
+		case deferStmt:
+			deferred = true
+		case returnStmt:
+			if len(n.child) == 1 && isTailCall(n.child[0], def) {
+				calls = append(calls, n.child[0])
+			}
+		}
+		return !deferred
+	}, nil)
+	if deferred {
+		return
+	}
+	for _, c := range calls {
+		c.gen = tailCall
+	}
+}
+
+// isTailCall returns true if n is a direct call of function def, with an
+// argument per parameter.
+func isTailCall(n, def *node) bool {
+	if n.kind != callExpr || n.action != aCall || n.child[0].kind != identExpr || n.child[0].val != def {
+		return false
+	}
+	return len(n.child)-1 == len(def.typ.arg)
+}
+
+// tailCall generates the tail recursive call n: the frame of the running
+// function is initialized again, as for a new call, with the arguments of n,
+// and the function body is executed from its start in this frame. The
+// values of the previous iteration are not reused, as their address may have
+// been taken. If frames are instrumented, n is a regular call.
+func tailCall(n *node) {
+	call(n)
+	regular := n.exec
+	def := n.child[0].val.(*node)
+	numRet := len(def.typ.ret)
+
+	values := make([]func(*frame) reflect.Value, len(def.typ.arg))
+	for i, c := range n.child[1:] {
+		switch arg := def.typ.arg[i]; {
+		case arg.cat == interfaceT:
+			values[i] = genValueInterface(c)
+		case arg.cat == valueT && arg.rtype.Kind() == reflect.Interface:
+			values[i] = genInterfaceWrapper(c, arg.rtype)
+		default:
+			values[i] = genValue(c)
+		}
+	}
+
+	n.exec = func(f *frame) bltn {
+		if !def.interp.reuseFrames(def) {
+			return regular(f)
+		}
+		var argsBuf [4]reflect.Value
+		args := argsBuf[:0]
+		for _, v := range values {
+			args = append(args, v(f))
+		}
+		for i := 0; i < numRet; i++ {
+			f.data[i] = reflect.New(f.data[i].Type()).Elem()
+		}
+		for i, t := range def.types[numRet:] {
+			f.data[numRet+i] = reflect.New(t).Elem()
+		}
+		for i, a := range args {
+			f.data[numRet+i].Set(a)
+		}
+		// The function body is not captured, as the definition may be
+		// replaced by ReloadPath
+		return def.child[3].start.exec
+	}
+}
@@ -0,0 +1,137 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+)
+
+// A Template is the read-only base of interpreters which are created from
+// it at a low cost, such as one for each request of a server running the
+// scripts of its tenants. It holds the binary symbols loaded by Use, and the
+// sources evaluated by Eval, typically the API of the host application,
+// when it is built by NewTemplate.
+//
+// The interpreters created by New share the binary symbols and the parsed
+// sources of the template, which are never modified, but each one has its
+// own globals, isolated from the others: as compiled code is bound to the
+// globals of its interpreter, the sources are compiled again, without being
+// parsed, and executed again as by the template. The package level variables
+// are thus initialized, and the init functions run, again in each
+// interpreter, with their side effects, such as calls to host functions.
+// Function bodies are only generated when first called, unless
+// Options.EagerCompile is set, so an interpreter pays for the functions it
+// calls only.
+//
+// A Template is safe for concurrent use.
+type Template struct {
+	options  Options
+	name     string                                     // program name, as Interpreter.Name
+	binPkg   Exports                                    // binary packages, except the standard streams ones
+	lazyPkg  map[string]func() map[string]reflect.Value // binary packages loaded on first use
+	binDoc   map[string]map[string]string               // doc comments of binary packages
+	fallback Exports                                    // fallbacks of source packages
+	files    []templateFile                             // parsed sources, in evaluation order
+}
+
+// templateFile is a source parsed by a template, and its location in the
+// file set of the template.
+type templateFile struct {
+	parsed     *parsedSrc
+	base, size int
+}
+
+// NewTemplate returns a template of interpreters having the options, set up
+// by setup, which loads binary symbols and evaluates sources, as in:
+//
+//	t, err := interp.NewTemplate(interp.Options{}, func(i *interp.Interpreter) error {
+//		i.Use(stdlib.Symbols)
+//		_, err := i.Eval(hostAPI)
+//		return err
+//	})
+//
+// The error of setup is returned.
+func NewTemplate(options Options, setup func(*Interpreter) error) (*Template, error) {
+	i := New(options)
+	if err := setup(i); err != nil {
+		return nil, err
+	}
+	i.Stop()
+
+	i.emutex.Lock()
+	defer i.emutex.Unlock()
+
+	t := &Template{options: options, name: i.Name, fallback: Exports{}}
+	i.pmutex.RLock()
+	t.binPkg = make(Exports, len(i.binPkg))
+	t.lazyPkg = make(map[string]func() map[string]reflect.Value, len(i.lazyPkg))
+	for path, values := range i.binPkg {
+		if stdioPkg[path] {
+			// Redefined again for the standard streams of each interpreter
+			values := values
+			t.lazyPkg[path] = func() map[string]reflect.Value { return values }
+			continue
+		}
+		t.binPkg[path] = values
+	}
+	for path, load := range i.lazyPkg {
+		t.lazyPkg[path] = load
+	}
+	t.binDoc = make(map[string]map[string]string, len(i.binDoc))
+	for path, doc := range i.binDoc {
+		t.binDoc[path] = doc
+	}
+	i.pmutex.RUnlock()
+	for path, values := range i.fallback {
+		t.fallback[path] = values
+	}
+
+	for _, src := range i.sources {
+		p := i.parse(src.Src, src.Name)
+		if p.file == nil {
+			continue
+		}
+		f := i.fset.File(p.file.Package)
+		t.files = append(t.files, templateFile{parsed: p, base: f.Base(), size: f.Size()})
+	}
+	return t, nil
+}
+
+// New returns a new interpreter in the state of the template, by compiling
+// and executing again the template sources, or the error of their execution.
+func (t *Template) New() (*Interpreter, error) {
+	i := New(t.options)
+
+	i.pmutex.Lock()
+	for path, values := range t.binPkg {
+		i.binPkg[path] = values
+	}
+	for path, load := range t.lazyPkg {
+		i.lazyPkg[path] = load
+	}
+	for path, doc := range t.binDoc {
+		i.binDoc[path] = doc
+	}
+	i.pmutex.Unlock()
+	for path, values := range t.fallback {
+		i.fallback[path] = values
+	}
+
+	// The positions of the parsed sources are those of the template
+	i.fset = token.NewFileSet()
+	for _, f := range t.files {
+		i.fset.AddFile(f.parsed.name, f.base, f.size).SetLinesForContent([]byte(f.parsed.text.src))
+	}
+
+	for _, f := range t.files {
+		i.Name = f.parsed.name
+		_, p, err := i.compileParsed(f.parsed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := i.execute(p); err != nil {
+			return nil, err
+		}
+	}
+	i.Name = t.name
+	return i, nil
+}
@@ -0,0 +1,15 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package interp
+
+import (
+	"errors"
+	"os"
+)
+
+// isTerminal returns true if f is a terminal. Terminals are not supported
+// on this platform.
+func isTerminal(f *os.File) bool { return false }
+
+func makeRaw(f *os.File) (func(), error) { return nil, errors.New("terminal not supported") }
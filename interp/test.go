@@ -0,0 +1,168 @@
+package interp
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Test loads the package in directory dir, including its test files, and
+// returns its tests and benchmarks, to be run by testing.RunTests and
+// testing.RunBenchmarks. Files of an external test package, with a "_test"
+// suffix, are ignored. The TestMain function is not supported. The messages
+// of the tests, as logged by t.Errorf, are prefixed by the position in the
+// interpreted source of the call, or of the caller of a function calling
+// t.Helper.
+func (interp *Interpreter) Test(dir string) ([]testing.InternalTest, []testing.InternalBenchmark, error) {
+	if err := interp.lock(); err != nil {
+		return nil, nil, err
+	}
+	defer interp.unlock()
+	interp.progDir = dir
+	pkgName, err := interp.loadSrcDir(dir, "", "", loadTest)
+	if err != nil {
+		return nil, nil, err
+	}
+	sc, ok := interp.scopes[pkgName]
+	if !ok {
+		return nil, nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	if sym, ok := sc.sym["TestMain"]; ok && sym.kind == funcSym {
+		return nil, nil, fmt.Errorf("%s: TestMain is not supported", dir)
+	}
+
+	// Functions are returned in source order, as by go test
+	var funcs []*symbol
+	for _, sym := range sc.sym {
+		if sym.kind == funcSym && sym.node != nil && sym.typ.cat == funcT {
+			funcs = append(funcs, sym)
+		}
+	}
+	// Files are parsed concurrently, their positions are not ordered
+	sort.Slice(funcs, func(i, j int) bool {
+		pi, pj := interp.fset.Position(funcs[i].node.pos), interp.fset.Position(funcs[j].node.pos)
+		return pi.Filename < pj.Filename || pi.Filename == pj.Filename && pi.Offset < pj.Offset
+	})
+
+	var tests []testing.InternalTest
+	var benchmarks []testing.InternalBenchmark
+	for _, sym := range funcs {
+		name := sym.node.child[1].ident
+		switch {
+		case isTestFunc(name, "Test") && hasTestArg(sym.typ, reflect.TypeOf((*testing.T)(nil))):
+			f := genFunctionWrapper(sym.node)(interp.frame).Interface().(func(*testing.T))
+			tests = append(tests, testing.InternalTest{Name: name, F: f})
+		case isTestFunc(name, "Benchmark") && hasTestArg(sym.typ, reflect.TypeOf((*testing.B)(nil))):
+			f := genFunctionWrapper(sym.node)(interp.frame).Interface().(func(*testing.B))
+			benchmarks = append(benchmarks, testing.InternalBenchmark{Name: name, F: f})
+		}
+	}
+	return tests, benchmarks, nil
+}
+
+// isTestFunc returns true if name is a test function name with the given
+// prefix, such as "TestXxx", where Xxx does not start with a lower case letter.
+func isTestFunc(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	if len(name) == len(prefix) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(name[len(prefix):])
+	return !unicode.IsLower(r)
+}
+
+// hasTestArg returns true if the function type t has a single parameter
+// of type arg and no result.
+func hasTestArg(t *itype, arg reflect.Type) bool {
+	return len(t.arg) == 1 && len(t.ret) == 0 && t.arg[0].TypeOf() == arg
+}
+
+var tbType = reflect.TypeOf((*testing.TB)(nil)).Elem()
+
+// genTestLog returns, for node n selecting a logging method of a binary
+// receiver of type rt implementing testing.TB, such as t.Errorf, a function
+// returning the method of receiver v in frame f, which prints the position
+// of the interpreted call, as the testing package does for compiled code,
+// in place of the one of the interpreter. The caller is reported instead if
+// the enclosing function calls Helper. It returns nil for other methods.
+func genTestLog(n *node, rt reflect.Type) func(f *frame, v reflect.Value) reflect.Value {
+	name := n.child[1].ident
+	switch name {
+	case "Error", "Errorf", "Fatal", "Fatalf", "Log", "Logf", "Skip", "Skipf":
+	default:
+		return nil
+	}
+	if rt == nil || !rt.Implements(tbType) {
+		return nil
+	}
+	helper := callsHelper(n)
+	return func(f *frame, v reflect.Value) reflect.Value {
+		tb := v.Interface().(testing.TB)
+		c := n
+		if helper && f.caller != nil {
+			c = f.caller
+		}
+		p := c.interp.fset.Position(c.pos)
+		prefix := filepath.Base(p.Filename) + ":" + strconv.Itoa(p.Line) + ": "
+		log := func(s string) {
+			if !strings.HasSuffix(s, "\n") {
+				s += "\n"
+			}
+			_, _ = io.WriteString(tb.Output(), prefix+s)
+		}
+		var fn interface{}
+		switch name {
+		case "Error":
+			fn = func(args ...interface{}) { log(fmt.Sprintln(args...)); tb.Fail() }
+		case "Errorf":
+			fn = func(format string, args ...interface{}) { log(fmt.Sprintf(format, args...)); tb.Fail() }
+		case "Fatal":
+			fn = func(args ...interface{}) { log(fmt.Sprintln(args...)); tb.FailNow() }
+		case "Fatalf":
+			fn = func(format string, args ...interface{}) { log(fmt.Sprintf(format, args...)); tb.FailNow() }
+		case "Log":
+			fn = func(args ...interface{}) { log(fmt.Sprintln(args...)) }
+		case "Logf":
+			fn = func(format string, args ...interface{}) { log(fmt.Sprintf(format, args...)) }
+		case "Skip":
+			fn = func(args ...interface{}) { log(fmt.Sprintln(args...)); tb.SkipNow() }
+		case "Skipf":
+			fn = func(format string, args ...interface{}) { log(fmt.Sprintf(format, args...)); tb.SkipNow() }
+		}
+		return reflect.ValueOf(fn)
+	}
+}
+
+// callsHelper returns true if the function enclosing node n calls the Helper
+// method of a testing.TB value.
+func callsHelper(n *node) bool {
+	def := n
+	for def != nil && def.kind != funcDecl && def.kind != funcLit {
+		def = def.anc
+	}
+	if def == nil {
+		return false
+	}
+	found := false
+	def.lastChild().Walk(func(c *node) bool {
+		if found || c.kind == funcLit {
+			return false
+		}
+		if c.kind == callExpr && c.child[0].kind == selectorExpr && c.child[0].child[1].ident == "Helper" {
+			if t := c.child[0].child[0].typ; t != nil && t.TypeOf() != nil && t.TypeOf().Implements(tbType) {
+				found = true
+			}
+		}
+		return true
+	}, nil)
+	return found
+}
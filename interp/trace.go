@@ -0,0 +1,136 @@
+package interp
+
+import (
+	"go/token"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// A Tracer receives the events of the execution of interpreted code, when
+// set by the Tracer option. Its methods are called by the goroutine
+// executing the code, which waits for their return, so they can implement
+// step debuggers, audit logs or progress reports. They must be safe for
+// concurrent use if the program starts goroutines. Events identify the
+// interpreted goroutine by a routine number, 1 for the goroutine of Eval,
+// then incremented for each started goroutine, and for each call of an
+// interpreted function from binary code.
+type Tracer interface {
+	// Statement is called before the execution of the statement at pos.
+	Statement(routine int, pos token.Position)
+	// Enter is called on entry in the interpreted function, qualified by
+	// its package name, as "main.f" or "main.(*T).M", declared at pos.
+	Enter(routine int, function string, pos token.Position)
+	// Exit is called when the function returns, or exits on panic.
+	Exit(routine int, function string)
+	// Assign is called after the assignment of value to the variable name,
+	// declared or assigned by the statement at pos.
+	Assign(routine int, name string, pos token.Position, value reflect.Value)
+}
+
+// tracer reports execution events to a Tracer.
+type tracer struct {
+	Tracer
+	lastID int32    // last allocated routine number
+	names  sync.Map // function names, indexed by function definition node
+}
+
+// frameTrace stores the tracing state of a frame.
+type frameTrace struct {
+	routine int
+	def     *node // function definition, or nil at global level
+	name    string
+}
+
+func newTracer(t Tracer) *tracer { return &tracer{Tracer: t, lastID: 1} }
+
+// enter sets the tracing state of frame f, called from frame caller, or
+// started in a new goroutine, and reports the entry in function def.
+func (t *tracer) enter(f, caller *frame, def *node, goroutine bool) {
+	var routine int
+	if caller != nil && caller.trace != nil && !goroutine {
+		routine = caller.trace.routine
+	} else {
+		routine = int(atomic.AddInt32(&t.lastID, 1))
+	}
+	f.trace = &frameTrace{routine: routine, def: def}
+	if def == nil || def.kind != funcDecl && def.kind != funcLit {
+		return
+	}
+	f.trace.name = t.funcName(def)
+	t.Enter(routine, f.trace.name, def.interp.fset.Position(def.pos))
+}
+
+// exit reports the exit of the function of frame f.
+func (t *tracer) exit(f *frame) {
+	if ft := f.trace; ft != nil && ft.name != "" {
+		t.Exit(ft.routine, ft.name)
+	}
+}
+
+// funcName returns the qualified name of function def, computed once.
+func (t *tracer) funcName(def *node) string {
+	if name, ok := t.names.Load(def); ok {
+		return name.(string)
+	}
+	name := funcName(def, def)
+	t.names.Store(def, name)
+	return name
+}
+
+// wrap instruments the exec function of node n, to report the statements
+// starting at n, and the variables assigned by n.
+func (t *tracer) wrap(n *node) {
+	exec := n.exec
+	if exec == nil {
+		return
+	}
+	if s := stopStmt(n); s != nil {
+		pos := n.interp.fset.Position(s.pos)
+		next := exec
+		exec = func(f *frame) bltn {
+			t.Statement(f.traceRoutine(), pos)
+			return next(f)
+		}
+		n.exec = exec
+	}
+
+	var lhs []*node
+	switch n.kind {
+	case assignStmt, assignXStmt, defineStmt, defineXStmt:
+		lhs = n.child[:n.nleft]
+	case incDecStmt:
+		lhs = n.child[:1]
+	}
+	type assigned struct {
+		name  string
+		value func(*frame) reflect.Value
+	}
+	var vars []assigned
+	for _, c := range lhs {
+		if c.kind != identExpr || c.ident == "_" || c.sym != nil && c.sym.kind != varSym {
+			continue
+		}
+		vars = append(vars, assigned{c.ident, genValue(c)})
+	}
+	if len(vars) == 0 {
+		return
+	}
+	pos := n.interp.fset.Position(n.pos)
+	n.exec = func(f *frame) bltn {
+		next := exec(f)
+		r := f.traceRoutine()
+		for _, v := range vars {
+			t.Assign(r, v.name, pos, v.value(f))
+		}
+		return next
+	}
+}
+
+// traceRoutine returns the routine number of frame f.
+func (f *frame) traceRoutine() int {
+	if f.trace == nil {
+		return 1
+	}
+	return f.trace.routine
+}
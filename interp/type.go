@@ -3,6 +3,7 @@ package interp
 import (
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // tcat defines interpreter type categories
@@ -19,12 +20,15 @@ const (
 	builtinT
 	byteT
 	chanT
+	chanRecvT
+	chanSendT
 	complex64T
 	complex128T
 	errorT
 	float32T
 	float64T
 	funcT
+	genericT
 	interfaceT
 	intT
 	int8T
@@ -57,12 +61,15 @@ var cats = [...]string{
 	boolT:       "boolT",
 	builtinT:    "builtinT",
 	chanT:       "chanT",
+	chanRecvT:   "chanRecvT",
+	chanSendT:   "chanSendT",
 	complex64T:  "complex64T",
 	complex128T: "complex128T",
 	errorT:      "errorT",
 	float32T:    "float32",
 	float64T:    "float64T",
 	funcT:       "funcT",
+	genericT:    "genericT",
 	interfaceT:  "interfaceT",
 	intT:        "intT",
 	int8T:       "int8T",
@@ -91,6 +98,13 @@ func (c tcat) String() string {
 	return "Cat(" + strconv.Itoa(int(c)) + ")"
 }
 
+// typeNameTag is the key of the tag recording the qualified name of a
+// defined struct type, such as "main.T", on the first field of its
+// reflection type. A defined struct type without fields is given a blank
+// field of type string for this tag, so its values are still printed as
+// "{}" by fmt with the %v verb.
+const typeNameTag = "yaegi"
+
 // structField type defines a field in a struct
 type structField struct {
 	name  string
@@ -118,6 +132,8 @@ type itype struct {
 	sizedef    bool          // true if array size is computed from type definition
 	node       *node         // root AST node of type definition
 	scope      *scope        // type declaration scope (in case of re-parse incomplete type)
+	generic    *itype        // generic type of an instantiated type, or nil
+	targs      []*itype      // type arguments of an instantiated type, or nil
 }
 
 // nodeType returns a type definition for the corresponding AST subtree
@@ -157,6 +173,9 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 						} else {
 							t.incomplete = true
 						}
+					} else if sym.rval.IsValid() && (isInt(sym.rval.Type()) || isUint(sym.rval.Type())) {
+						// constant of another integer type, such as the result of unsafe.Sizeof
+						t.size = int(vInt(sym.rval))
 					} else {
 						t.incomplete = true
 					}
@@ -213,6 +232,11 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 				t.name = "int"
 			}
 			t.untyped = true
+		case uint:
+			// shift count, converted by a previous call
+			t.cat = uintT
+			t.name = "uint"
+			t.untyped = true
 		case rune:
 			t.cat = runeT
 			t.name = "rune"
@@ -227,6 +251,9 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 
 	case unaryExpr:
 		t, err = nodeType(interp, sc, n.child[0])
+		if err == nil && n.action == aRecv {
+			t = chanElemType(t)
+		}
 
 	case binaryExpr:
 		if a := n.anc; a.kind == defineStmt && len(a.child) > a.nleft+a.nright {
@@ -235,22 +262,43 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			if t, err = nodeType(interp, sc, n.child[0]); err != nil {
 				return nil, err
 			}
-			if t.untyped {
-				var t1 *itype
-				t1, err = nodeType(interp, sc, n.child[1])
-				if !(t1.untyped && isInt(t1.TypeOf()) && isFloat(t.TypeOf())) {
-					t = t1
+			switch n.action {
+			case aShl, aShr:
+				// The type of a shift is the one of its left operand
+			case aEqual, aNotEqual, aGreater, aGreaterEqual, aLower, aLowerEqual:
+				t = sc.getType("bool")
+			default:
+				if t.untyped {
+					var t1 *itype
+					t1, err = nodeType(interp, sc, n.child[1])
+					if !(t1.untyped && untypedRank(t1) < untypedRank(t)) {
+						t = t1
+					}
 				}
 			}
 		}
 
 	case callExpr:
+		if c0 := n.child[0]; c0.kind == identExpr {
+			if sym, _, found := sc.lookup(c0.ident); found && sym.kind == bltnSym {
+				return builtinType(interp, sc, n)
+			}
+		}
 		if t, err = nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
+		if t.cat == genericT {
+			// Infer type arguments of a generic function from call arguments
+			var sym *symbol
+			if sym, err = interp.inferCall(sc, n); err != nil {
+				return nil, err
+			}
+			t = sym.typ
+		}
 		switch t.cat {
 		case valueT:
-			if t.rtype.NumOut() == 1 {
+			// A conversion to a binary non func type keeps the type t
+			if t.rtype.Kind() == reflect.Func && t.rtype.NumOut() == 1 {
 				t = &itype{cat: valueT, rtype: t.rtype.Out(0)}
 			}
 		default:
@@ -262,17 +310,27 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 	case compositeLitExpr:
 		t, err = nodeType(interp, sc, n.child[0])
 
-	case chanType:
-		t.cat = chanT
+	case chanType, chanTypeRecv, chanTypeSend:
+		switch n.kind {
+		case chanTypeRecv:
+			t.cat = chanRecvT
+		case chanTypeSend:
+			t.cat = chanSendT
+		default:
+			t.cat = chanT
+		}
 		if t.val, err = nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
 		t.incomplete = t.val.incomplete
 
 	case ellipsisExpr:
-		if t, err = nodeType(interp, sc, n.child[0]); err != nil {
+		var et *itype
+		if et, err = nodeType(interp, sc, n.child[0]); err != nil {
 			return nil, err
 		}
+		// The element type may be shared by other symbols, and is not modified
+		*t = *et
 		t.variadic = true
 
 	case funcLit:
@@ -332,9 +390,45 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			sc.sym[n.ident] = &symbol{kind: typeSym, typ: t}
 		}
 
+	case indexExpr:
+		// Instantiation of a generic type or function
+		var g *itype
+		if g, err = nodeType(interp, sc, n.child[0]); err != nil {
+			return nil, err
+		}
+		if g.incomplete {
+			// Generic type is not yet declared, re-parse later
+			t.incomplete = true
+			break
+		}
+		if g.cat != genericT {
+			err = n.cfgErrorf("%s is not a generic type", n.child[0].ident)
+			break
+		}
+		if isPartialCallee(g, n) {
+			// Missing type arguments are inferred in call expression
+			t = g
+			break
+		}
+		sym, name, err2 := interp.instantiateNode(sc, g, n.child[1:], n)
+		if err2 != nil {
+			return nil, err2
+		}
+		if sym.kind != typeSym {
+			t = sym.typ
+			break
+		}
+		// Replace generic expression by a reference to the instantiated type
+		setGenericRef(n, sym, name)
+		t, err = nodeType(interp, sc, n)
+
 	case interfaceType:
 		t.cat = interfaceT
 		for _, field := range n.child[0].child {
+			if isTypeSetElem(sc, field) {
+				// Type set elements only apply to constraints, checked at instantiation
+				continue
+			}
 			if len(field.child) == 1 {
 				typ, err := nodeType(interp, sc, field.child[0])
 				if err != nil {
@@ -367,6 +461,7 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 
 	case selectorExpr:
 		pkg, name := n.child[0].ident, n.child[1].ident
+		interp.implicitImport(sc, pkg)
 		if sym, _, found := sc.lookup(pkg); found {
 			if sym.typ == nil {
 				t.incomplete = true
@@ -374,8 +469,16 @@ func nodeType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
 			}
 			switch sym.typ.cat {
 			case binPkgT:
-				pkg := interp.binPkg[sym.path]
+				pkg := interp.binSymbols(sym.path)
 				if v, ok := pkg[name]; ok {
+					if m := interp.newerAPI(sym.path + "." + name); m > 0 {
+						err = n.cfgErrorf("%s.%s requires go1.%d", sym.path, name, m)
+						break
+					}
+					if !interp.allowedSym(sym.path, name) {
+						err = n.cfgErrorf("use of %s.%s not allowed", sym.path, name)
+						break
+					}
 					t.cat = valueT
 					t.rtype = v.Type()
 					if isBinType(v) {
@@ -580,6 +683,9 @@ func (t *itype) lookupField(name string) []int {
 	if fi := t.fieldIndex(name); fi >= 0 {
 		return []int{fi}
 	}
+	if t.cat == ptrT {
+		return t.val.lookupField(name)
+	}
 
 	for i, f := range t.field {
 		switch f.typ.cat {
@@ -653,11 +759,25 @@ func (t *itype) lookupBinMethod(name string) (reflect.Method, []int, bool) {
 	m, ok := t.TypeOf().MethodByName(name)
 	if !ok {
 		for i, f := range t.field {
-			if f.embed {
-				if m2, index2, ok2 := f.typ.lookupBinMethod(name); ok2 {
-					index = append([]int{i}, index2...)
-					return m2, index, ok2
+			if !f.embed {
+				continue
+			}
+			if ft := f.typ; ft.cat == valueT || ft.cat == ptrT && ft.val.cat == valueT {
+				// Embedded binary field, whose methods with a pointer receiver
+				// are promoted as well, and apply to its address
+				rt := ft.TypeOf()
+				m2, ok2 := rt.MethodByName(name)
+				if !ok2 && rt.Kind() != reflect.Ptr && rt.Kind() != reflect.Interface {
+					m2, ok2 = reflect.PtrTo(rt).MethodByName(name)
+				}
+				if ok2 {
+					return m2, []int{i}, true
 				}
+				continue
+			}
+			if m2, index2, ok2 := f.typ.lookupBinMethod(name); ok2 {
+				index = append([]int{i}, index2...)
+				return m2, index, ok2
 			}
 		}
 	}
@@ -693,6 +813,10 @@ func (t *itype) TypeOf() reflect.Type {
 		}
 	case chanT:
 		t.rtype = reflect.ChanOf(reflect.BothDir, t.val.TypeOf())
+	case chanRecvT:
+		t.rtype = reflect.ChanOf(reflect.RecvDir, t.val.TypeOf())
+	case chanSendT:
+		t.rtype = reflect.ChanOf(reflect.SendDir, t.val.TypeOf())
 	case errorT:
 		t.rtype = reflect.TypeOf(new(error)).Elem()
 	case funcT:
@@ -704,7 +828,12 @@ func (t *itype) TypeOf() reflect.Type {
 		for i, v := range t.ret {
 			out[i] = v.TypeOf()
 		}
-		t.rtype = reflect.FuncOf(in, out, false)
+		variadic := len(t.arg) > 0 && t.arg[len(t.arg)-1].variadic
+		if variadic {
+			// The type of a variadic argument is the type of its elements
+			in[len(in)-1] = reflect.SliceOf(in[len(in)-1])
+		}
+		t.rtype = reflect.FuncOf(in, out, variadic)
 	case interfaceT:
 		t.rtype = reflect.TypeOf(new(interface{})).Elem()
 	case mapT:
@@ -713,10 +842,26 @@ func (t *itype) TypeOf() reflect.Type {
 		t.rtype = reflect.PtrTo(t.val.TypeOf())
 	case structT:
 		var fields []reflect.StructField
-		for _, f := range t.field {
+		for i, f := range t.field {
 			field := reflect.StructField{Name: exportName(f.name), Type: f.typ.TypeOf(), Tag: reflect.StructTag(f.tag)}
+			if f.embed && isEmbeddable(field.Type) {
+				// Embedded field, promoted by reflection users such as encoding/json
+				field.Anonymous = true
+			}
+			if i == 0 && t.name != "" {
+				// Record the type name, so defined types have distinct reflection types
+				field.Tag = reflect.StructTag(strings.TrimSpace(f.tag + " " + typeNameTag + `:"` + t.id() + `"`))
+			}
 			fields = append(fields, field)
 		}
+		if len(fields) == 0 && t.name != "" {
+			// Record the type name, so empty defined types have distinct reflection types
+			pkgPath := t.pkgPath
+			if pkgPath == "" {
+				pkgPath = mainID
+			}
+			fields = append(fields, reflect.StructField{Name: "_", PkgPath: pkgPath, Type: stringType, Tag: reflect.StructTag(typeNameTag + `:"` + t.id() + `"`)})
+		}
 		t.rtype = reflect.StructOf(fields)
 	default:
 		if z, _ := t.zero(); z.IsValid() {
@@ -726,14 +871,29 @@ func (t *itype) TypeOf() reflect.Type {
 	return t.rtype
 }
 
+// isEmbeddable returns true if a field of reflection type t can be embedded
+// in a struct type created by reflect.StructOf, which does not support
+// promoted methods.
+func isEmbeddable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() != reflect.Ptr && t.Kind() != reflect.Interface && t.NumMethod() == 0 && reflect.PtrTo(t).NumMethod() == 0
+}
+
 func (t *itype) frameType() reflect.Type {
 	var r reflect.Type
 	switch t.cat {
 	case arrayT:
+		elem := t.val.frameType()
+		if t.val.cat == funcT {
+			// Functions are stored as runtime functions in arrays and slices
+			elem = t.val.TypeOf()
+		}
 		if t.size > 0 {
-			r = reflect.ArrayOf(t.size, t.val.frameType())
+			r = reflect.ArrayOf(t.size, elem)
 		} else {
-			r = reflect.SliceOf(t.val.frameType())
+			r = reflect.SliceOf(elem)
 		}
 	//case ChanT:
 	//	r = reflect.ChanOf(reflect.BothDir, t.val.frameType())
@@ -763,12 +923,93 @@ func (t *itype) frameType() reflect.Type {
 	return r
 }
 
+// implements returns true if the method set of type t includes all the
+// methods of interface type it.
 func (t *itype) implements(it *itype) bool {
+	name, _ := missingMethod(t, it)
+	return name == ""
+}
+
+// methodNames returns the names of the methods of interface type t, including
+// the ones of embedded interfaces.
+func (t *itype) methodNames() []string {
+	switch t.cat {
+	case aliasT:
+		return t.val.methodNames()
+	case errorT:
+		return []string{"Error"}
+	case interfaceT:
+		var names []string
+		for _, f := range t.field {
+			if f.embed {
+				names = append(names, f.typ.methodNames()...)
+			} else {
+				names = append(names, f.name)
+			}
+		}
+		return names
+	}
+	rt := t.TypeOf()
+	if rt == nil || rt.Kind() != reflect.Interface {
+		return nil
+	}
+	names := make([]string, rt.NumMethod())
+	for i := range names {
+		names[i] = rt.Method(i).Name
+	}
+	return names
+}
+
+// inMethodSet returns true if the method name is in the method set of type t,
+// where addressable is true for the method set of *t. Otherwise, ptrRecv is
+// true if the method exists with a pointer receiver, which is only in the
+// method set of *t.
+func (t *itype) inMethodSet(name string, addressable bool) (ok, ptrRecv bool) {
+	switch t.cat {
+	case ptrT:
+		return t.val.inMethodSet(name, true)
+	case errorT, interfaceT:
+		return contains(t.methodNames(), name), false
+	}
+	if m := t.getMethod(name); m != nil {
+		if r := defRecvType(m); r != nil && r.cat == ptrT && !addressable {
+			return false, true
+		}
+		return true, false
+	}
+	if t.cat == aliasT {
+		return t.val.inMethodSet(name, addressable)
+	}
 	if t.cat == valueT {
-		return t.TypeOf().Implements(it.TypeOf())
+		rt := t.TypeOf()
+		if rt.Kind() == reflect.Interface {
+			_, ok = rt.MethodByName(name)
+			return ok, false
+		}
+		if rt.Kind() != reflect.Ptr && addressable {
+			rt = reflect.PtrTo(rt)
+		}
+		if _, ok = rt.MethodByName(name); ok {
+			return true, false
+		}
+		if rt.Kind() != reflect.Ptr {
+			_, ptrRecv = reflect.PtrTo(rt).MethodByName(name)
+		}
+		return false, ptrRecv
 	}
-	// TODO: implement method check for interpreted types
-	return true
+	for _, f := range t.field {
+		if !f.embed {
+			continue
+		}
+		// The promoted methods of an embedded field are in the method set of
+		// the struct, those of *field as well if the struct is addressable.
+		ok, p := f.typ.inMethodSet(name, addressable)
+		if ok {
+			return true, false
+		}
+		ptrRecv = ptrRecv || p
+	}
+	return false, ptrRecv
 }
 
 func defRecvType(n *node) *itype {
@@ -781,6 +1022,146 @@ func defRecvType(n *node) *itype {
 	return nil
 }
 
+// builtinType returns the type of the value of a call n to a builtin function.
+func builtinType(interp *Interpreter, sc *scope, n *node) (*itype, error) {
+	args := make([]*itype, len(n.child)-1)
+	for i, c := range n.child[1:] {
+		t, err := nodeType(interp, sc, c)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = t
+	}
+	switch name := n.child[0].ident; {
+	case name == "append" && len(args) > 0:
+		return args[0], nil
+	case name == "cap", name == "copy", name == "len":
+		return sc.getType("int"), nil
+	case name == "complex" && len(args) == 2:
+		return complexBuiltinType(sc, n, args[0], args[1])
+	case (name == "real" || name == "imag") && len(args) == 1:
+		return partBuiltinType(sc, n, args[0])
+	case name == "max", name == "min":
+		return minMaxType(n, args)
+	case name == "make" && len(args) > 0:
+		return args[0], nil
+	case name == "new" && len(args) == 1:
+		return &itype{cat: ptrT, val: args[0]}, nil
+	case name == "recover":
+		return sc.getType("interface{}"), nil
+	}
+	return nil, n.cfgErrorf("%s (no value) used as value", n.child[0].ident)
+}
+
+// complexBuiltinType returns the type of a call n to the complex builtin,
+// from the types t0 and t1 of its real and imaginary parts.
+func complexBuiltinType(sc *scope, n *node, t0, t1 *itype) (*itype, error) {
+	switch r0, r1 := t0.TypeOf(), t1.TypeOf(); {
+	case t0.untyped && isNumber(r0) && t1.untyped && isNumber(r1):
+		return &itype{cat: valueT, rtype: complexType, untyped: true}, nil
+	case isFloat32(r0) && isFloat32(r1):
+		return sc.getType("complex64"), nil
+	case isFloat64(r0) && isFloat64(r1):
+		return sc.getType("complex128"), nil
+	case t0.untyped && isFloat32(r1) || t1.untyped && isFloat32(r0):
+		return sc.getType("complex64"), nil
+	case t0.untyped && isFloat64(r1) || t1.untyped && isFloat64(r0):
+		return sc.getType("complex128"), nil
+	default:
+		return nil, n.cfgErrorf("invalid types %s and %s", r0.Kind(), r1.Kind())
+	}
+}
+
+// partBuiltinType returns the type of a call n to the real or imag builtin,
+// from the type t of its complex argument.
+func partBuiltinType(sc *scope, n *node, t *itype) (*itype, error) {
+	switch k := t.TypeOf().Kind(); {
+	case k == reflect.Complex64:
+		return sc.getType("float32"), nil
+	case k == reflect.Complex128:
+		return sc.getType("float64"), nil
+	case t.untyped && isNumber(t.TypeOf()):
+		return &itype{cat: valueT, rtype: floatType, untyped: true}, nil
+	default:
+		return nil, n.cfgErrorf("invalid complex type %s", k)
+	}
+}
+
+// minMaxBuiltinType returns the type of a call n to the min or max builtin,
+// as minMaxType, to which its untyped constant arguments are converted.
+func minMaxBuiltinType(n *node) (*itype, error) {
+	args := make([]*itype, len(n.child)-1)
+	for i, c := range n.child[1:] {
+		args[i] = c.typ
+	}
+	typ, err := minMaxType(n, args)
+	if err != nil || typ.untyped {
+		return typ, err
+	}
+	for _, c := range n.child[1:] {
+		if c.typ.untyped && c.cval != nil {
+			if err := convertConst(c, typ); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return typ, nil
+}
+
+// minMaxType returns the type of a call n to the min or max builtin, from the
+// types args of its ordered arguments: the one of the typed arguments, which
+// must be identical, or else the untyped type of highest rank.
+func minMaxType(n *node, args []*itype) (*itype, error) {
+	if len(args) == 0 {
+		return nil, n.cfgErrorf("not enough arguments for %s() (expected 1, found 0)", n.child[0].ident)
+	}
+	var typ *itype
+	for i, t := range args {
+		c := n.child[i+1]
+		if rt := t.TypeOf(); rt == nil || !isInt(rt) && !isFloat(rt) && !isString(rt) {
+			return nil, c.cfgErrorf("invalid argument: %s cannot be ordered", valueString(c))
+		}
+		switch {
+		case t.untyped:
+		case typ == nil:
+			typ = t
+		case typ.id() != t.id():
+			return nil, c.cfgErrorf("invalid argument: mismatched types %s (previous argument) and %s", typeString(typ), valueString(c))
+		}
+	}
+	if typ != nil {
+		return typ, nil
+	}
+	for i, t := range args {
+		switch {
+		case typ == nil:
+			typ = t
+		case isString(t.TypeOf()) != isString(typ.TypeOf()):
+			c := n.child[i+1]
+			return nil, c.cfgErrorf("invalid argument: mismatched types %s (previous argument) and %s", typeString(typ), valueString(c))
+		case untypedRank(t) > untypedRank(typ):
+			typ = t
+		}
+	}
+	return typ, nil
+}
+
+// untypedRank returns the rank of an untyped numeric type t: the type of an
+// operation on untyped operands is the one of the operand of highest rank.
+func untypedRank(t *itype) int {
+	switch rt := t.TypeOf(); {
+	case t.cat == runeT:
+		return 2
+	case isInt(rt):
+		return 1
+	case isFloat(rt):
+		return 3
+	case isComplex(rt):
+		return 4
+	}
+	return 0
+}
+
 func isShiftOperand(n *node) bool {
 	switch n.anc.action {
 	case aShl, aShr, aShlAssign, aShrAssign:
@@ -789,10 +1170,95 @@ func isShiftOperand(n *node) bool {
 	return false
 }
 
-func isInterface(t *itype) bool { return t.cat == interfaceT || t.TypeOf().Kind() == reflect.Interface }
+// rangeYield returns the type of the yield function of t, or nil if t is not
+// a function that can be ranged over, of the form func(yield func(K, V) bool)
+// with at most 2 yield parameters.
+func rangeYield(t *itype) *itype {
+	for t.cat == aliasT {
+		t = t.val
+	}
+	switch t.cat {
+	case valueT:
+		rt := t.rtype
+		if rt.Kind() != reflect.Func || rt.NumIn() != 1 || rt.NumOut() != 0 {
+			return nil
+		}
+		yt := rt.In(0)
+		if yt.Kind() != reflect.Func || yt.IsVariadic() || yt.NumIn() > 2 || yt.NumOut() != 1 || yt.Out(0).Kind() != reflect.Bool {
+			return nil
+		}
+		return &itype{cat: valueT, rtype: yt}
+	case funcT:
+		if len(t.arg) != 1 || len(t.ret) != 0 {
+			return nil
+		}
+		yt := t.arg[0]
+		for yt.cat == aliasT {
+			yt = yt.val
+		}
+		if yt.cat != funcT || len(yt.arg) > 2 || len(yt.arg) > 0 && yt.arg[len(yt.arg)-1].variadic || len(yt.ret) != 1 || yt.ret[0].TypeOf().Kind() != reflect.Bool {
+			return nil
+		}
+		return yt
+	}
+	return nil
+}
+
+// yieldArg returns the type of the parameter i of the yield function yt.
+func yieldArg(yt *itype, i int) *itype {
+	if yt.cat == valueT {
+		return &itype{cat: valueT, rtype: yt.rtype.In(i)}
+	}
+	return yt.arg[i]
+}
+
+// arrayPtrElem returns the array type pointed to by t, or nil if t is not a
+// pointer to an array.
+func arrayPtrElem(t *itype) *itype {
+	for t.cat == aliasT {
+		t = t.val
+	}
+	switch t.cat {
+	case valueT:
+		if t.rtype.Kind() == reflect.Ptr && t.rtype.Elem().Kind() == reflect.Array {
+			return &itype{cat: valueT, rtype: t.rtype.Elem()}
+		}
+	case ptrT:
+		if t.val.TypeOf().Kind() == reflect.Array {
+			return t.val
+		}
+	}
+	return nil
+}
+
+// isNamed returns true if t is a predeclared or a defined type.
+func isNamed(t *itype) bool {
+	if t.cat == valueT {
+		return t.rtype.Name() != ""
+	}
+	return t.name != ""
+}
+
+func isInterface(t *itype) bool {
+	if t.cat == interfaceT {
+		return true
+	}
+	rt := t.TypeOf()
+	return rt != nil && rt.Kind() == reflect.Interface
+}
 
 func isStruct(t *itype) bool { return t.TypeOf().Kind() == reflect.Struct }
 
+func isChan(t *itype) bool { return t.TypeOf().Kind() == reflect.Chan }
+
+func isRecvChan(t *itype) bool {
+	return t != nil && isChan(t) && t.TypeOf().ChanDir() == reflect.RecvDir
+}
+
+func isSendChan(t *itype) bool {
+	return t != nil && isChan(t) && t.TypeOf().ChanDir() == reflect.SendDir
+}
+
 func isInt(t reflect.Type) bool {
 	switch t.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
@@ -0,0 +1,123 @@
+package interp
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// unsafeSymbols returns the symbols of package unsafe, importable by
+// interpreted code if the AllowUnsafe option is set. Calls of Sizeof, Alignof
+// and Offsetof are evaluated at compilation, as constants, and their values
+// are not called.
+func unsafeSymbols() map[string]reflect.Value {
+	return map[string]reflect.Value{
+		"Pointer":  reflect.ValueOf((*unsafe.Pointer)(nil)),
+		"Sizeof":   reflect.ValueOf(func(interface{}) uintptr { panic("unsafe.Sizeof must be called") }),
+		"Alignof":  reflect.ValueOf(func(interface{}) uintptr { panic("unsafe.Alignof must be called") }),
+		"Offsetof": reflect.ValueOf(func(interface{}) uintptr { panic("unsafe.Offsetof must be called") }),
+	}
+}
+
+// unsafeFunc returns the name of the unsafe function called by n, or an
+// empty string if n is not a call of unsafe.Sizeof, Alignof or Offsetof.
+func unsafeFunc(n *node) string {
+	c := n.child[0]
+	if n.kind != callExpr || c.kind != rvalueExpr || len(c.child) != 2 || c.child[0].sym == nil || c.child[0].sym.path != "unsafe" {
+		return ""
+	}
+	switch name := c.child[1].ident; name {
+	case "Sizeof", "Alignof", "Offsetof":
+		return name
+	}
+	return ""
+}
+
+// unsafeCall computes the constant result of the call n of an unsafe
+// function, from the runtime type of its argument.
+func unsafeCall(sc *scope, n *node, name string) error {
+	if len(n.child) != 2 {
+		return n.cfgErrorf("wrong number of arguments for unsafe.%s", name)
+	}
+	c := n.child[1]
+	if c.typ == nil || c.typ.cat == nilT || c.isType(sc) {
+		return c.cfgErrorf("invalid argument for unsafe.%s", name)
+	}
+	var v uintptr
+	switch name {
+	case "Sizeof":
+		v = c.typ.TypeOf().Size()
+	case "Alignof":
+		v = uintptr(c.typ.TypeOf().Align())
+	case "Offsetof":
+		var err error
+		if v, err = fieldOffset(c); err != nil {
+			return err
+		}
+	}
+	// The call is replaced by its result, and its argument is not evaluated
+	n.kind = basicLit
+	n.typ = sc.getType("uintptr")
+	n.rval = reflect.ValueOf(v)
+	n.gen = nop
+	n.findex = -1
+	return nil
+}
+
+// fieldOffset returns the offset of the struct field selected by n in its
+// struct, including through embedded fields which are not pointers.
+func fieldOffset(n *node) (uintptr, error) {
+	index, ok := n.val.([]int)
+	if n.kind != selectorExpr || !ok || len(index) == 0 || n.recv != nil {
+		return 0, n.cfgErrorf("invalid argument for unsafe.Offsetof: not a selector expression")
+	}
+	t := n.child[0].typ.TypeOf()
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	var offset uintptr
+	for i, fi := range index {
+		if t.Kind() == reflect.Ptr && i > 0 {
+			return 0, n.cfgErrorf("invalid expression unsafe.Offsetof: selector implies indirection of embedded field")
+		}
+		if t.Kind() != reflect.Struct || fi >= t.NumField() {
+			return 0, n.cfgErrorf("invalid argument for unsafe.Offsetof: not a struct field")
+		}
+		f := t.Field(fi)
+		offset += f.Offset
+		t = f.Type
+		if i == len(index)-1 {
+			// Unexported fields of interpreted structs are renamed in their runtime type
+			if name := n.child[1].ident; f.Name != name && f.Name != "X"+name {
+				return 0, n.cfgErrorf("invalid argument for unsafe.Offsetof: %s is a method value", name)
+			}
+		}
+	}
+	return offset, nil
+}
+
+// isUnsafeConversion returns true if a conversion between types src and
+// dest involves unsafe.Pointer, and is not supported by reflect.
+func isUnsafeConversion(src, dest reflect.Type) bool {
+	return src.Kind() != dest.Kind() && (src.Kind() == reflect.UnsafePointer || dest.Kind() == reflect.UnsafePointer)
+}
+
+// convertUnsafe converts v to type t, as permitted by package unsafe: from a
+// pointer or an uintptr to unsafe.Pointer, and from unsafe.Pointer to a
+// pointer or an uintptr.
+func convertUnsafe(v reflect.Value, t reflect.Type) reflect.Value {
+	switch {
+	case v.Kind() == reflect.UnsafePointer && t.Kind() == reflect.Ptr:
+		if v.IsNil() {
+			return reflect.Zero(t)
+		}
+		return reflect.NewAt(t.Elem(), unsafe.Pointer(v.Pointer())).Convert(t)
+	case v.Kind() == reflect.UnsafePointer && t.Kind() == reflect.Uintptr:
+		return reflect.ValueOf(v.Pointer()).Convert(t)
+	case v.Kind() == reflect.Ptr && t.Kind() == reflect.UnsafePointer:
+		return reflect.ValueOf(unsafe.Pointer(v.Pointer())).Convert(t)
+	case v.Kind() == reflect.Uintptr && t.Kind() == reflect.UnsafePointer:
+		u := uintptr(v.Uint())
+		return reflect.ValueOf(*(*unsafe.Pointer)(unsafe.Pointer(&u))).Convert(t)
+	}
+	return v.Convert(t)
+}
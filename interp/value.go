@@ -22,11 +22,6 @@ func valueGenerator(n *node, i int) func(*frame) reflect.Value {
 	}
 }
 
-func genValueRecvIndirect(n *node) func(*frame) reflect.Value {
-	v := genValueRecv(n)
-	return func(f *frame) reflect.Value { return v(f).Elem() }
-}
-
 func genValueRecv(n *node) func(*frame) reflect.Value {
 	v := genValue(n.recv.node)
 	fi := n.recv.index
@@ -44,14 +39,53 @@ func genValueRecv(n *node) func(*frame) reflect.Value {
 	}
 }
 
-func genValueAsFunctionWrapper(n *node) func(*frame) reflect.Value {
+func genValueAsFunctionWrapper(n *node) func(*frame, *frame) reflect.Value {
 	v := genValue(n)
+	return func(f, d *frame) reflect.Value {
+		return genDeferredWrapper(v(f).Interface().(*node))(f, d)
+	}
+}
+
+// genValueElem returns the value of n, stored as an element of a runtime
+// array, slice, map or channel: an interpreted function, including a
+// method value, is wrapped in a runtime function.
+func genValueElem(n *node) func(*frame) reflect.Value {
+	if n.typ != nil && n.typ.cat == funcT {
+		return genFunctionWrapper(n)
+	}
+	return genValue(n)
+}
+
+// genValueArray returns a generator of the array, slice, string or map value
+// of node n, the array being dereferenced if n is a pointer to an array.
+func genValueArray(n *node) func(*frame) reflect.Value {
+	value := genValue(n)
+	if arrayPtrElem(n.typ) == nil {
+		return value
+	}
+	return func(f *frame) reflect.Value { return value(f).Elem() }
+}
+
+// genValueLitElem returns a generator of the value of element n of a
+// composite literal, of type t. The literal of an elided &T is allocated at
+// each evaluation, and its address is returned.
+func genValueLitElem(n *node, t reflect.Type) func(*frame) reflect.Value {
+	if n.kind != compositeLitExpr || t.Kind() != reflect.Ptr || n.typ.TypeOf().Kind() == reflect.Ptr {
+		return genValueElem(n)
+	}
+	value := genValue(n)
 	return func(f *frame) reflect.Value {
-		return genFunctionWrapper(v(f).Interface().(*node))(f)
+		p := reflect.New(t.Elem())
+		p.Elem().Set(value(f))
+		return p
 	}
 }
 
 func genValueAs(n *node, t reflect.Type) func(*frame) reflect.Value {
+	if isEmptyStruct(t) {
+		// Empty struct types are not convertible by reflection if defined
+		return func(*frame) reflect.Value { return reflect.New(t).Elem() }
+	}
 	v := genValue(n)
 	return func(f *frame) reflect.Value {
 		return v(f).Convert(t)
@@ -107,6 +141,36 @@ func genValue(n *node) func(*frame) reflect.Value {
 	}
 }
 
+// frameIndex returns the index of the value of node n in the local frame, as
+// accessed by genValue, or a negative index if the value is not in the local
+// frame, such as a constant, a global variable or a variable of an enclosing
+// function.
+func frameIndex(n *node) int {
+	switch n.kind {
+	case basicLit, funcDecl, rvalueExpr:
+		return -1
+	}
+	if n.rval.IsValid() || n.level != 0 {
+		return -1
+	}
+	if n.sym != nil {
+		if n.sym.global {
+			return -1
+		}
+		return n.sym.index
+	}
+	return n.findex
+}
+
+// isFrameOperand returns true if the operand n, of frame index i, is either a
+// constant or a value of the local frame of the same kind as type t.
+func isFrameOperand(n *node, i int, t reflect.Type) bool {
+	if i < 0 {
+		return n.rval.IsValid()
+	}
+	return n.typ.TypeOf().Kind() == t.Kind()
+}
+
 func genValueInterfacePtr(n *node) func(*frame) reflect.Value {
 	value := genValue(n)
 	it := reflect.TypeOf((*interface{})(nil)).Elem()
@@ -122,7 +186,12 @@ func genValueInterface(n *node) func(*frame) reflect.Value {
 	value := genValue(n)
 
 	return func(f *frame) reflect.Value {
-		return reflect.ValueOf(valueInterface{n, value(f)})
+		v := value(f)
+		if v.IsValid() && v.Type() == valueInterfaceType {
+			// Already an interface value
+			return v
+		}
+		return reflect.ValueOf(valueInterface{n, v})
 	}
 }
 
@@ -130,10 +199,34 @@ func genValueInterfaceValue(n *node) func(*frame) reflect.Value {
 	value := genValue(n)
 
 	return func(f *frame) reflect.Value {
-		return value(f).Interface().(valueInterface).value
+		v := value(f)
+		if !v.IsValid() {
+			return reflect.Zero(emptyInterfaceType)
+		}
+		vi, ok := v.Interface().(valueInterface)
+		if !ok {
+			// Variable declared without value, holding a binary value
+			return v
+		}
+		if v = binaryValue(f, vi); v.IsValid() {
+			return v
+		}
+		return reflect.Zero(emptyInterfaceType)
 	}
 }
 
+// binaryValue returns the value held by interface value vi, as passed to
+// binary code: interpreted functions are wrapped in runtime functions.
+func binaryValue(f *frame, vi valueInterface) reflect.Value {
+	if !vi.value.IsValid() || vi.node == nil || vi.node.typ.cat != funcT {
+		return vi.value
+	}
+	if def, ok := vi.value.Interface().(*node); ok {
+		return genFunctionWrapper(def)(f)
+	}
+	return vi.value
+}
+
 func vInt(v reflect.Value) (i int64) {
 	switch v.Type().Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
@@ -198,6 +291,23 @@ func genValueInt(n *node) func(*frame) (reflect.Value, int64) {
 	return nil
 }
 
+// genValueShift returns the value of the shift count n as an unsigned integer.
+// A negative signed count panics, as in compiled code.
+func genValueShift(n *node) func(*frame) (reflect.Value, uint64) {
+	if isUint(n.typ.TypeOf()) {
+		return genValueUint(n)
+	}
+	value := genValueInt(n)
+
+	return func(f *frame) (reflect.Value, uint64) {
+		v, i := value(f)
+		if i < 0 {
+			panic(runtimeError("negative shift amount"))
+		}
+		return v, uint64(i)
+	}
+}
+
 func genValueUint(n *node) func(*frame) (reflect.Value, uint64) {
 	value := genValue(n)
 
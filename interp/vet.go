@@ -0,0 +1,47 @@
+package interp
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// vetf records a suspicious construct at node n, reported by Check in
+// VetPhase, as go vet. It does not prevent the compilation of the code.
+func (interp *Interpreter) vetf(n *node, format string, a ...interface{}) {
+	if !interp.vet {
+		return
+	}
+	e := &Error{Phase: VetPhase, Pos: interp.fset.Position(n.pos), Msg: fmt.Sprintf(format, a...)}
+	interp.quote(e, n.pos)
+	interp.errs = append(interp.errs, e)
+}
+
+// vetStringConversion reports the conversion n of an integer to a string,
+// which yields a string of one rune, unless the integer is a rune or a byte.
+func (interp *Interpreter) vetStringConversion(n *node) {
+	t0, t1 := n.child[0].typ, n.child[1].typ
+	if t1 == nil || !isString(t0.TypeOf()) || !isInt(t1.TypeOf()) {
+		return
+	}
+	switch t1.TypeOf().Kind() {
+	case reflect.Int32, reflect.Uint8:
+		return
+	}
+	interp.vetf(n, "conversion from %s to %s yields a string of one rune, not a string of digits", vetTypeName(t1), vetTypeName(t0))
+}
+
+// vetTypeName returns the name of type t as printed by go vet, a named type
+// being followed by its underlying type.
+func vetTypeName(t *itype) string {
+	rt := t.TypeOf()
+	kind := rt.Kind().String()
+	switch {
+	case t.untyped:
+		return "untyped " + kind
+	case t.cat == aliasT:
+		return t.name + " (" + kind + ")"
+	case t.cat == valueT && rt.PkgPath() != "":
+		return rt.Name() + " (" + kind + ")"
+	}
+	return kind
+}
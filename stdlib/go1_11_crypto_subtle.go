@@ -1,27 +0,0 @@
-// +build go1.11,!go1.12
-
-package stdlib
-
-// Code generated by 'goexports crypto/subtle'. DO NOT EDIT.
-
-import (
-	"crypto/subtle"
-	"reflect"
-)
-
-func init() {
-	Symbols["crypto/subtle"] = map[string]reflect.Value{
-		// function, constant and variable definitions
-		"ConstantTimeByteEq":   reflect.ValueOf(subtle.ConstantTimeByteEq),
-		"ConstantTimeCompare":  reflect.ValueOf(subtle.ConstantTimeCompare),
-		"ConstantTimeCopy":     reflect.ValueOf(subtle.ConstantTimeCopy),
-		"ConstantTimeEq":       reflect.ValueOf(subtle.ConstantTimeEq),
-		"ConstantTimeLessOrEq": reflect.ValueOf(subtle.ConstantTimeLessOrEq),
-		"ConstantTimeSelect":   reflect.ValueOf(subtle.ConstantTimeSelect),
-
-		// type definitions
-
-		// interface wrapper definitions
-
-	}
-}
@@ -1,22 +0,0 @@
-// +build go1.12,!go1.13
-
-package stdlib
-
-// Code generated by 'goexports errors'. DO NOT EDIT.
-
-import (
-	"errors"
-	"reflect"
-)
-
-func init() {
-	Symbols["errors"] = map[string]reflect.Value{
-		// function, constant and variable definitions
-		"New": reflect.ValueOf(errors.New),
-
-		// type definitions
-
-		// interface wrapper definitions
-
-	}
-}
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_nocompress
+// +build go1.26,!go1.27,!yaegi_nocompress
 
 package stdlib
 
@@ -18,7 +19,9 @@ func init() {
 		"NewWriter": reflect.ValueOf(lzw.NewWriter),
 
 		// type definitions
-		"Order": reflect.ValueOf((*lzw.Order)(nil)),
+		"Order":  reflect.ValueOf((*lzw.Order)(nil)),
+		"Reader": reflect.ValueOf((*lzw.Reader)(nil)),
+		"Writer": reflect.ValueOf((*lzw.Writer)(nil)),
 
 		// interface wrapper definitions
 
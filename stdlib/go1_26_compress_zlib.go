@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_nocompress
+// +build go1.26,!go1.27,!yaegi_nocompress
 
 package stdlib
 
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_nocrypto
+// +build go1.26,!go1.27,!yaegi_nocrypto
 
 package stdlib
 
@@ -33,19 +34,26 @@ func init() {
 		"SHA512":       reflect.ValueOf(crypto.SHA512),
 		"SHA512_224":   reflect.ValueOf(crypto.SHA512_224),
 		"SHA512_256":   reflect.ValueOf(crypto.SHA512_256),
+		"SignMessage":  reflect.ValueOf(crypto.SignMessage),
 
 		// type definitions
+		"Decapsulator":  reflect.ValueOf((*crypto.Decapsulator)(nil)),
 		"Decrypter":     reflect.ValueOf((*crypto.Decrypter)(nil)),
 		"DecrypterOpts": reflect.ValueOf((*crypto.DecrypterOpts)(nil)),
+		"Encapsulator":  reflect.ValueOf((*crypto.Encapsulator)(nil)),
 		"Hash":          reflect.ValueOf((*crypto.Hash)(nil)),
+		"MessageSigner": reflect.ValueOf((*crypto.MessageSigner)(nil)),
 		"PrivateKey":    reflect.ValueOf((*crypto.PrivateKey)(nil)),
 		"PublicKey":     reflect.ValueOf((*crypto.PublicKey)(nil)),
 		"Signer":        reflect.ValueOf((*crypto.Signer)(nil)),
 		"SignerOpts":    reflect.ValueOf((*crypto.SignerOpts)(nil)),
 
 		// interface wrapper definitions
+		"_Decapsulator":  reflect.ValueOf((*_crypto_Decapsulator)(nil)),
 		"_Decrypter":     reflect.ValueOf((*_crypto_Decrypter)(nil)),
 		"_DecrypterOpts": reflect.ValueOf((*_crypto_DecrypterOpts)(nil)),
+		"_Encapsulator":  reflect.ValueOf((*_crypto_Encapsulator)(nil)),
+		"_MessageSigner": reflect.ValueOf((*_crypto_MessageSigner)(nil)),
 		"_PrivateKey":    reflect.ValueOf((*_crypto_PrivateKey)(nil)),
 		"_PublicKey":     reflect.ValueOf((*_crypto_PublicKey)(nil)),
 		"_Signer":        reflect.ValueOf((*_crypto_Signer)(nil)),
@@ -53,6 +61,17 @@ func init() {
 	}
 }
 
+// _crypto_Decapsulator is an interface wrapper for Decapsulator type
+type _crypto_Decapsulator struct {
+	WDecapsulate  func(ciphertext []byte) (sharedKey []byte, err error)
+	WEncapsulator func() crypto.Encapsulator
+}
+
+func (W _crypto_Decapsulator) Decapsulate(ciphertext []byte) (sharedKey []byte, err error) {
+	return W.WDecapsulate(ciphertext)
+}
+func (W _crypto_Decapsulator) Encapsulator() crypto.Encapsulator { return W.WEncapsulator() }
+
 // _crypto_Decrypter is an interface wrapper for Decrypter type
 type _crypto_Decrypter struct {
 	WDecrypt func(rand io.Reader, msg []byte, opts crypto.DecrypterOpts) (plaintext []byte, err error)
@@ -68,6 +87,32 @@ func (W _crypto_Decrypter) Public() crypto.PublicKey { return W.WPublic() }
 type _crypto_DecrypterOpts struct {
 }
 
+// _crypto_Encapsulator is an interface wrapper for Encapsulator type
+type _crypto_Encapsulator struct {
+	WBytes       func() []byte
+	WEncapsulate func() (sharedKey []byte, ciphertext []byte)
+}
+
+func (W _crypto_Encapsulator) Bytes() []byte { return W.WBytes() }
+func (W _crypto_Encapsulator) Encapsulate() (sharedKey []byte, ciphertext []byte) {
+	return W.WEncapsulate()
+}
+
+// _crypto_MessageSigner is an interface wrapper for MessageSigner type
+type _crypto_MessageSigner struct {
+	WPublic      func() crypto.PublicKey
+	WSign        func(rand io.Reader, digest []byte, opts crypto.SignerOpts) (signature []byte, err error)
+	WSignMessage func(rand io.Reader, msg []byte, opts crypto.SignerOpts) (signature []byte, err error)
+}
+
+func (W _crypto_MessageSigner) Public() crypto.PublicKey { return W.WPublic() }
+func (W _crypto_MessageSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	return W.WSign(rand, digest, opts)
+}
+func (W _crypto_MessageSigner) SignMessage(rand io.Reader, msg []byte, opts crypto.SignerOpts) (signature []byte, err error) {
+	return W.WSignMessage(rand, msg, opts)
+}
+
 // _crypto_PrivateKey is an interface wrapper for PrivateKey type
 type _crypto_PrivateKey struct {
 }
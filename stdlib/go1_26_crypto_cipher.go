@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_nocrypto
+// +build go1.26,!go1.27,!yaegi_nocrypto
 
 package stdlib
 
@@ -12,15 +13,16 @@ import (
 func init() {
 	Symbols["crypto/cipher"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"NewCBCDecrypter":     reflect.ValueOf(cipher.NewCBCDecrypter),
-		"NewCBCEncrypter":     reflect.ValueOf(cipher.NewCBCEncrypter),
-		"NewCFBDecrypter":     reflect.ValueOf(cipher.NewCFBDecrypter),
-		"NewCFBEncrypter":     reflect.ValueOf(cipher.NewCFBEncrypter),
-		"NewCTR":              reflect.ValueOf(cipher.NewCTR),
-		"NewGCM":              reflect.ValueOf(cipher.NewGCM),
-		"NewGCMWithNonceSize": reflect.ValueOf(cipher.NewGCMWithNonceSize),
-		"NewGCMWithTagSize":   reflect.ValueOf(cipher.NewGCMWithTagSize),
-		"NewOFB":              reflect.ValueOf(cipher.NewOFB),
+		"NewCBCDecrypter":       reflect.ValueOf(cipher.NewCBCDecrypter),
+		"NewCBCEncrypter":       reflect.ValueOf(cipher.NewCBCEncrypter),
+		"NewCFBDecrypter":       reflect.ValueOf(cipher.NewCFBDecrypter),
+		"NewCFBEncrypter":       reflect.ValueOf(cipher.NewCFBEncrypter),
+		"NewCTR":                reflect.ValueOf(cipher.NewCTR),
+		"NewGCM":                reflect.ValueOf(cipher.NewGCM),
+		"NewGCMWithNonceSize":   reflect.ValueOf(cipher.NewGCMWithNonceSize),
+		"NewGCMWithRandomNonce": reflect.ValueOf(cipher.NewGCMWithRandomNonce),
+		"NewGCMWithTagSize":     reflect.ValueOf(cipher.NewGCMWithTagSize),
+		"NewOFB":                reflect.ValueOf(cipher.NewOFB),
 
 		// type definitions
 		"AEAD":         reflect.ValueOf((*cipher.AEAD)(nil)),
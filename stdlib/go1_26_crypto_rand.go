@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_nocrypto
+// +build go1.26,!go1.27,!yaegi_nocrypto
 
 package stdlib
 
@@ -16,6 +17,7 @@ func init() {
 		"Prime":  reflect.ValueOf(rand.Prime),
 		"Read":   reflect.ValueOf(rand.Read),
 		"Reader": reflect.ValueOf(&rand.Reader).Elem(),
+		"Text":   reflect.ValueOf(rand.Text),
 
 		// type definitions
 
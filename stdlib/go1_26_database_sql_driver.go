@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_nodatabase
+// +build go1.26,!go1.27,!yaegi_nodatabase
 
 package stdlib
 
@@ -57,6 +58,7 @@ func init() {
 		"StmtQueryContext":               reflect.ValueOf((*driver.StmtQueryContext)(nil)),
 		"Tx":                             reflect.ValueOf((*driver.Tx)(nil)),
 		"TxOptions":                      reflect.ValueOf((*driver.TxOptions)(nil)),
+		"Validator":                      reflect.ValueOf((*driver.Validator)(nil)),
 		"Value":                          reflect.ValueOf((*driver.Value)(nil)),
 		"ValueConverter":                 reflect.ValueOf((*driver.ValueConverter)(nil)),
 		"Valuer":                         reflect.ValueOf((*driver.Valuer)(nil)),
@@ -88,6 +90,7 @@ func init() {
 		"_StmtExecContext":                reflect.ValueOf((*_database_sql_driver_StmtExecContext)(nil)),
 		"_StmtQueryContext":               reflect.ValueOf((*_database_sql_driver_StmtQueryContext)(nil)),
 		"_Tx":                             reflect.ValueOf((*_database_sql_driver_Tx)(nil)),
+		"_Validator":                      reflect.ValueOf((*_database_sql_driver_Validator)(nil)),
 		"_Value":                          reflect.ValueOf((*_database_sql_driver_Value)(nil)),
 		"_ValueConverter":                 reflect.ValueOf((*_database_sql_driver_ValueConverter)(nil)),
 		"_Valuer":                         reflect.ValueOf((*_database_sql_driver_Valuer)(nil)),
@@ -327,15 +330,11 @@ type _database_sql_driver_RowsNextResultSet struct {
 	WNextResultSet    func() error
 }
 
-func (W _database_sql_driver_RowsNextResultSet) Close() error      { return W.WClose() }
-func (W _database_sql_driver_RowsNextResultSet) Columns() []string { return W.WColumns() }
-func (W _database_sql_driver_RowsNextResultSet) HasNextResultSet() bool {
-	return W.WHasNextResultSet()
-}
-func (W _database_sql_driver_RowsNextResultSet) Next(dest []driver.Value) error {
-	return W.WNext(dest)
-}
-func (W _database_sql_driver_RowsNextResultSet) NextResultSet() error { return W.WNextResultSet() }
+func (W _database_sql_driver_RowsNextResultSet) Close() error                   { return W.WClose() }
+func (W _database_sql_driver_RowsNextResultSet) Columns() []string              { return W.WColumns() }
+func (W _database_sql_driver_RowsNextResultSet) HasNextResultSet() bool         { return W.WHasNextResultSet() }
+func (W _database_sql_driver_RowsNextResultSet) Next(dest []driver.Value) error { return W.WNext(dest) }
+func (W _database_sql_driver_RowsNextResultSet) NextResultSet() error           { return W.WNextResultSet() }
 
 // _database_sql_driver_SessionResetter is an interface wrapper for SessionResetter type
 type _database_sql_driver_SessionResetter struct {
@@ -390,16 +389,23 @@ type _database_sql_driver_Tx struct {
 func (W _database_sql_driver_Tx) Commit() error   { return W.WCommit() }
 func (W _database_sql_driver_Tx) Rollback() error { return W.WRollback() }
 
+// _database_sql_driver_Validator is an interface wrapper for Validator type
+type _database_sql_driver_Validator struct {
+	WIsValid func() bool
+}
+
+func (W _database_sql_driver_Validator) IsValid() bool { return W.WIsValid() }
+
 // _database_sql_driver_Value is an interface wrapper for Value type
 type _database_sql_driver_Value struct {
 }
 
 // _database_sql_driver_ValueConverter is an interface wrapper for ValueConverter type
 type _database_sql_driver_ValueConverter struct {
-	WConvertValue func(v interface{}) (driver.Value, error)
+	WConvertValue func(v any) (driver.Value, error)
 }
 
-func (W _database_sql_driver_ValueConverter) ConvertValue(v interface{}) (driver.Value, error) {
+func (W _database_sql_driver_ValueConverter) ConvertValue(v any) (driver.Value, error) {
 	return W.WConvertValue(v)
 }
 
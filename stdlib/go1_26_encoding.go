@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_noencoding
+// +build go1.26,!go1.27,!yaegi_noencoding
 
 package stdlib
 
@@ -14,25 +15,38 @@ func init() {
 		// function, constant and variable definitions
 
 		// type definitions
+		"BinaryAppender":    reflect.ValueOf((*encoding.BinaryAppender)(nil)),
 		"BinaryMarshaler":   reflect.ValueOf((*encoding.BinaryMarshaler)(nil)),
 		"BinaryUnmarshaler": reflect.ValueOf((*encoding.BinaryUnmarshaler)(nil)),
+		"TextAppender":      reflect.ValueOf((*encoding.TextAppender)(nil)),
 		"TextMarshaler":     reflect.ValueOf((*encoding.TextMarshaler)(nil)),
 		"TextUnmarshaler":   reflect.ValueOf((*encoding.TextUnmarshaler)(nil)),
 
 		// interface wrapper definitions
+		"_BinaryAppender":    reflect.ValueOf((*_encoding_BinaryAppender)(nil)),
 		"_BinaryMarshaler":   reflect.ValueOf((*_encoding_BinaryMarshaler)(nil)),
 		"_BinaryUnmarshaler": reflect.ValueOf((*_encoding_BinaryUnmarshaler)(nil)),
+		"_TextAppender":      reflect.ValueOf((*_encoding_TextAppender)(nil)),
 		"_TextMarshaler":     reflect.ValueOf((*_encoding_TextMarshaler)(nil)),
 		"_TextUnmarshaler":   reflect.ValueOf((*_encoding_TextUnmarshaler)(nil)),
 	}
 }
 
+// _encoding_BinaryAppender is an interface wrapper for BinaryAppender type
+type _encoding_BinaryAppender struct {
+	WAppendBinary func(b []byte) ([]byte, error)
+}
+
+func (W _encoding_BinaryAppender) AppendBinary(b []byte) ([]byte, error) { return W.WAppendBinary(b) }
+
 // _encoding_BinaryMarshaler is an interface wrapper for BinaryMarshaler type
 type _encoding_BinaryMarshaler struct {
 	WMarshalBinary func() (data []byte, err error)
 }
 
-func (W _encoding_BinaryMarshaler) MarshalBinary() (data []byte, err error) { return W.WMarshalBinary() }
+func (W _encoding_BinaryMarshaler) MarshalBinary() (data []byte, err error) {
+	return W.WMarshalBinary()
+}
 
 // _encoding_BinaryUnmarshaler is an interface wrapper for BinaryUnmarshaler type
 type _encoding_BinaryUnmarshaler struct {
@@ -43,6 +57,13 @@ func (W _encoding_BinaryUnmarshaler) UnmarshalBinary(data []byte) error {
 	return W.WUnmarshalBinary(data)
 }
 
+// _encoding_TextAppender is an interface wrapper for TextAppender type
+type _encoding_TextAppender struct {
+	WAppendText func(b []byte) ([]byte, error)
+}
+
+func (W _encoding_TextAppender) AppendText(b []byte) ([]byte, error) { return W.WAppendText(b) }
+
 // _encoding_TextMarshaler is an interface wrapper for TextMarshaler type
 type _encoding_TextMarshaler struct {
 	WMarshalText func() (text []byte, err error)
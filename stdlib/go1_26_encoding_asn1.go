@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_noencoding
+// +build go1.26,!go1.27,!yaegi_noencoding
 
 package stdlib
 
@@ -20,6 +21,7 @@ func init() {
 		"MarshalWithParams":    reflect.ValueOf(asn1.MarshalWithParams),
 		"NullBytes":            reflect.ValueOf(&asn1.NullBytes).Elem(),
 		"NullRawValue":         reflect.ValueOf(&asn1.NullRawValue).Elem(),
+		"TagBMPString":         reflect.ValueOf(asn1.TagBMPString),
 		"TagBitString":         reflect.ValueOf(asn1.TagBitString),
 		"TagBoolean":           reflect.ValueOf(asn1.TagBoolean),
 		"TagEnum":              reflect.ValueOf(asn1.TagEnum),
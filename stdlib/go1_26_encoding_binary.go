@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_noencoding
+// +build go1.26,!go1.27,!yaegi_noencoding
 
 package stdlib
 
@@ -12,11 +13,17 @@ import (
 func init() {
 	Symbols["encoding/binary"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"Append":         reflect.ValueOf(binary.Append),
+		"AppendUvarint":  reflect.ValueOf(binary.AppendUvarint),
+		"AppendVarint":   reflect.ValueOf(binary.AppendVarint),
 		"BigEndian":      reflect.ValueOf(&binary.BigEndian).Elem(),
+		"Decode":         reflect.ValueOf(binary.Decode),
+		"Encode":         reflect.ValueOf(binary.Encode),
 		"LittleEndian":   reflect.ValueOf(&binary.LittleEndian).Elem(),
 		"MaxVarintLen16": reflect.ValueOf(binary.MaxVarintLen16),
 		"MaxVarintLen32": reflect.ValueOf(binary.MaxVarintLen32),
 		"MaxVarintLen64": reflect.ValueOf(binary.MaxVarintLen64),
+		"NativeEndian":   reflect.ValueOf(&binary.NativeEndian).Elem(),
 		"PutUvarint":     reflect.ValueOf(binary.PutUvarint),
 		"PutVarint":      reflect.ValueOf(binary.PutVarint),
 		"Read":           reflect.ValueOf(binary.Read),
@@ -28,13 +35,34 @@ func init() {
 		"Write":          reflect.ValueOf(binary.Write),
 
 		// type definitions
-		"ByteOrder": reflect.ValueOf((*binary.ByteOrder)(nil)),
+		"AppendByteOrder": reflect.ValueOf((*binary.AppendByteOrder)(nil)),
+		"ByteOrder":       reflect.ValueOf((*binary.ByteOrder)(nil)),
 
 		// interface wrapper definitions
-		"_ByteOrder": reflect.ValueOf((*_encoding_binary_ByteOrder)(nil)),
+		"_AppendByteOrder": reflect.ValueOf((*_encoding_binary_AppendByteOrder)(nil)),
+		"_ByteOrder":       reflect.ValueOf((*_encoding_binary_ByteOrder)(nil)),
 	}
 }
 
+// _encoding_binary_AppendByteOrder is an interface wrapper for AppendByteOrder type
+type _encoding_binary_AppendByteOrder struct {
+	WAppendUint16 func(a0 []byte, a1 uint16) []byte
+	WAppendUint32 func(a0 []byte, a1 uint32) []byte
+	WAppendUint64 func(a0 []byte, a1 uint64) []byte
+	WString       func() string
+}
+
+func (W _encoding_binary_AppendByteOrder) AppendUint16(a0 []byte, a1 uint16) []byte {
+	return W.WAppendUint16(a0, a1)
+}
+func (W _encoding_binary_AppendByteOrder) AppendUint32(a0 []byte, a1 uint32) []byte {
+	return W.WAppendUint32(a0, a1)
+}
+func (W _encoding_binary_AppendByteOrder) AppendUint64(a0 []byte, a1 uint64) []byte {
+	return W.WAppendUint64(a0, a1)
+}
+func (W _encoding_binary_AppendByteOrder) String() string { return W.WString() }
+
 // _encoding_binary_ByteOrder is an interface wrapper for ByteOrder type
 type _encoding_binary_ByteOrder struct {
 	WPutUint16 func(a0 []byte, a1 uint16)
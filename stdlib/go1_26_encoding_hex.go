@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_noencoding
+// +build go1.26,!go1.27,!yaegi_noencoding
 
 package stdlib
 
@@ -12,6 +13,8 @@ import (
 func init() {
 	Symbols["encoding/hex"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"AppendDecode":   reflect.ValueOf(hex.AppendDecode),
+		"AppendEncode":   reflect.ValueOf(hex.AppendEncode),
 		"Decode":         reflect.ValueOf(hex.Decode),
 		"DecodeString":   reflect.ValueOf(hex.DecodeString),
 		"DecodedLen":     reflect.ValueOf(hex.DecodedLen),
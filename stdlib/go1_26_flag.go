@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -15,6 +16,7 @@ func init() {
 		"Arg":             reflect.ValueOf(flag.Arg),
 		"Args":            reflect.ValueOf(flag.Args),
 		"Bool":            reflect.ValueOf(flag.Bool),
+		"BoolFunc":        reflect.ValueOf(flag.BoolFunc),
 		"BoolVar":         reflect.ValueOf(flag.BoolVar),
 		"CommandLine":     reflect.ValueOf(&flag.CommandLine).Elem(),
 		"ContinueOnError": reflect.ValueOf(flag.ContinueOnError),
@@ -24,6 +26,7 @@ func init() {
 		"ExitOnError":     reflect.ValueOf(flag.ExitOnError),
 		"Float64":         reflect.ValueOf(flag.Float64),
 		"Float64Var":      reflect.ValueOf(flag.Float64Var),
+		"Func":            reflect.ValueOf(flag.Func),
 		"Int":             reflect.ValueOf(flag.Int),
 		"Int64":           reflect.ValueOf(flag.Int64),
 		"Int64Var":        reflect.ValueOf(flag.Int64Var),
@@ -39,6 +42,7 @@ func init() {
 		"Set":             reflect.ValueOf(flag.Set),
 		"String":          reflect.ValueOf(flag.String),
 		"StringVar":       reflect.ValueOf(flag.StringVar),
+		"TextVar":         reflect.ValueOf(flag.TextVar),
 		"Uint":            reflect.ValueOf(flag.Uint),
 		"Uint64":          reflect.ValueOf(flag.Uint64),
 		"Uint64Var":       reflect.ValueOf(flag.Uint64Var),
@@ -64,12 +68,12 @@ func init() {
 
 // _flag_Getter is an interface wrapper for Getter type
 type _flag_Getter struct {
-	WGet    func() interface{}
+	WGet    func() any
 	WSet    func(a0 string) error
 	WString func() string
 }
 
-func (W _flag_Getter) Get() interface{}    { return W.WGet() }
+func (W _flag_Getter) Get() any            { return W.WGet() }
 func (W _flag_Getter) Set(a0 string) error { return W.WSet(a0) }
 func (W _flag_Getter) String() string      { return W.WString() }
 
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -26,6 +27,7 @@ func init() {
 		"Fun":                        reflect.ValueOf(ast.Fun),
 		"Inspect":                    reflect.ValueOf(ast.Inspect),
 		"IsExported":                 reflect.ValueOf(ast.IsExported),
+		"IsGenerated":                reflect.ValueOf(ast.IsGenerated),
 		"Lbl":                        reflect.ValueOf(ast.Lbl),
 		"MergePackageFiles":          reflect.ValueOf(ast.MergePackageFiles),
 		"NewCommentMap":              reflect.ValueOf(ast.NewCommentMap),
@@ -35,12 +37,16 @@ func init() {
 		"NewScope":                   reflect.ValueOf(ast.NewScope),
 		"NotNilFilter":               reflect.ValueOf(ast.NotNilFilter),
 		"PackageExports":             reflect.ValueOf(ast.PackageExports),
+		"ParseDirective":             reflect.ValueOf(ast.ParseDirective),
 		"Pkg":                        reflect.ValueOf(ast.Pkg),
+		"Preorder":                   reflect.ValueOf(ast.Preorder),
+		"PreorderStack":              reflect.ValueOf(ast.PreorderStack),
 		"Print":                      reflect.ValueOf(ast.Print),
 		"RECV":                       reflect.ValueOf(ast.RECV),
 		"SEND":                       reflect.ValueOf(ast.SEND),
 		"SortImports":                reflect.ValueOf(ast.SortImports),
 		"Typ":                        reflect.ValueOf(ast.Typ),
+		"Unparen":                    reflect.ValueOf(ast.Unparen),
 		"Var":                        reflect.ValueOf(ast.Var),
 		"Walk":                       reflect.ValueOf(ast.Walk),
 
@@ -66,6 +72,8 @@ func init() {
 		"Decl":           reflect.ValueOf((*ast.Decl)(nil)),
 		"DeclStmt":       reflect.ValueOf((*ast.DeclStmt)(nil)),
 		"DeferStmt":      reflect.ValueOf((*ast.DeferStmt)(nil)),
+		"Directive":      reflect.ValueOf((*ast.Directive)(nil)),
+		"DirectiveArg":   reflect.ValueOf((*ast.DirectiveArg)(nil)),
 		"Ellipsis":       reflect.ValueOf((*ast.Ellipsis)(nil)),
 		"EmptyStmt":      reflect.ValueOf((*ast.EmptyStmt)(nil)),
 		"Expr":           reflect.ValueOf((*ast.Expr)(nil)),
@@ -87,6 +95,7 @@ func init() {
 		"Importer":       reflect.ValueOf((*ast.Importer)(nil)),
 		"IncDecStmt":     reflect.ValueOf((*ast.IncDecStmt)(nil)),
 		"IndexExpr":      reflect.ValueOf((*ast.IndexExpr)(nil)),
+		"IndexListExpr":  reflect.ValueOf((*ast.IndexListExpr)(nil)),
 		"InterfaceType":  reflect.ValueOf((*ast.InterfaceType)(nil)),
 		"KeyValueExpr":   reflect.ValueOf((*ast.KeyValueExpr)(nil)),
 		"LabeledStmt":    reflect.ValueOf((*ast.LabeledStmt)(nil)),
@@ -128,6 +137,7 @@ func init() {
 
 // _go_ast_Decl is an interface wrapper for Decl type
 type _go_ast_Decl struct {
+	ast.Decl
 	WEnd func() token.Pos
 	WPos func() token.Pos
 }
@@ -137,6 +147,7 @@ func (W _go_ast_Decl) Pos() token.Pos { return W.WPos() }
 
 // _go_ast_Expr is an interface wrapper for Expr type
 type _go_ast_Expr struct {
+	ast.Expr
 	WEnd func() token.Pos
 	WPos func() token.Pos
 }
@@ -155,6 +166,7 @@ func (W _go_ast_Node) Pos() token.Pos { return W.WPos() }
 
 // _go_ast_Spec is an interface wrapper for Spec type
 type _go_ast_Spec struct {
+	ast.Spec
 	WEnd func() token.Pos
 	WPos func() token.Pos
 }
@@ -164,6 +176,7 @@ func (W _go_ast_Spec) Pos() token.Pos { return W.WPos() }
 
 // _go_ast_Stmt is an interface wrapper for Stmt type
 type _go_ast_Stmt struct {
+	ast.Stmt
 	WEnd func() token.Pos
 	WPos func() token.Pos
 }
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -18,6 +19,7 @@ func init() {
 		"IllegalPrefixes": reflect.ValueOf(&doc.IllegalPrefixes).Elem(),
 		"IsPredeclared":   reflect.ValueOf(doc.IsPredeclared),
 		"New":             reflect.ValueOf(doc.New),
+		"NewFromFiles":    reflect.ValueOf(doc.NewFromFiles),
 		"PreserveAST":     reflect.ValueOf(doc.PreserveAST),
 		"Synopsis":        reflect.ValueOf(doc.Synopsis),
 		"ToHTML":          reflect.ValueOf(doc.ToHTML),
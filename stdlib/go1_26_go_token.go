@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -54,6 +55,9 @@ func init() {
 		"INC":            reflect.ValueOf(token.INC),
 		"INT":            reflect.ValueOf(token.INT),
 		"INTERFACE":      reflect.ValueOf(token.INTERFACE),
+		"IsExported":     reflect.ValueOf(token.IsExported),
+		"IsIdentifier":   reflect.ValueOf(token.IsIdentifier),
+		"IsKeyword":      reflect.ValueOf(token.IsKeyword),
 		"LAND":           reflect.ValueOf(token.LAND),
 		"LBRACE":         reflect.ValueOf(token.LBRACE),
 		"LBRACK":         reflect.ValueOf(token.LBRACK),
@@ -94,6 +98,7 @@ func init() {
 		"SUB":            reflect.ValueOf(token.SUB),
 		"SUB_ASSIGN":     reflect.ValueOf(token.SUB_ASSIGN),
 		"SWITCH":         reflect.ValueOf(token.SWITCH),
+		"TILDE":          reflect.ValueOf(token.TILDE),
 		"TYPE":           reflect.ValueOf(token.TYPE),
 		"UnaryPrec":      reflect.ValueOf(token.UnaryPrec),
 		"VAR":            reflect.ValueOf(token.VAR),
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_noimage
+// +build go1.26,!go1.27,!yaegi_noimage
 
 package stdlib
 
@@ -21,15 +22,17 @@ func init() {
 		"Src":            reflect.ValueOf(draw.Src),
 
 		// type definitions
-		"Drawer":    reflect.ValueOf((*draw.Drawer)(nil)),
-		"Image":     reflect.ValueOf((*draw.Image)(nil)),
-		"Op":        reflect.ValueOf((*draw.Op)(nil)),
-		"Quantizer": reflect.ValueOf((*draw.Quantizer)(nil)),
+		"Drawer":      reflect.ValueOf((*draw.Drawer)(nil)),
+		"Image":       reflect.ValueOf((*draw.Image)(nil)),
+		"Op":          reflect.ValueOf((*draw.Op)(nil)),
+		"Quantizer":   reflect.ValueOf((*draw.Quantizer)(nil)),
+		"RGBA64Image": reflect.ValueOf((*draw.RGBA64Image)(nil)),
 
 		// interface wrapper definitions
-		"_Drawer":    reflect.ValueOf((*_image_draw_Drawer)(nil)),
-		"_Image":     reflect.ValueOf((*_image_draw_Image)(nil)),
-		"_Quantizer": reflect.ValueOf((*_image_draw_Quantizer)(nil)),
+		"_Drawer":      reflect.ValueOf((*_image_draw_Drawer)(nil)),
+		"_Image":       reflect.ValueOf((*_image_draw_Image)(nil)),
+		"_Quantizer":   reflect.ValueOf((*_image_draw_Quantizer)(nil)),
+		"_RGBA64Image": reflect.ValueOf((*_image_draw_RGBA64Image)(nil)),
 	}
 }
 
@@ -63,3 +66,20 @@ type _image_draw_Quantizer struct {
 func (W _image_draw_Quantizer) Quantize(p color.Palette, m image.Image) color.Palette {
 	return W.WQuantize(p, m)
 }
+
+// _image_draw_RGBA64Image is an interface wrapper for RGBA64Image type
+type _image_draw_RGBA64Image struct {
+	WAt         func(x int, y int) color.Color
+	WBounds     func() image.Rectangle
+	WColorModel func() color.Model
+	WRGBA64At   func(x int, y int) color.RGBA64
+	WSet        func(x int, y int, c color.Color)
+	WSetRGBA64  func(x int, y int, c color.RGBA64)
+}
+
+func (W _image_draw_RGBA64Image) At(x int, y int) color.Color            { return W.WAt(x, y) }
+func (W _image_draw_RGBA64Image) Bounds() image.Rectangle                { return W.WBounds() }
+func (W _image_draw_RGBA64Image) ColorModel() color.Model                { return W.WColorModel() }
+func (W _image_draw_RGBA64Image) RGBA64At(x int, y int) color.RGBA64     { return W.WRGBA64At(x, y) }
+func (W _image_draw_RGBA64Image) Set(x int, y int, c color.Color)        { W.WSet(x, y, c) }
+func (W _image_draw_RGBA64Image) SetRGBA64(x int, y int, c color.RGBA64) { W.WSetRGBA64(x, y, c) }
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12,!windows,!nacl,!plan9
+//go:build go1.26 && !go1.27 && !windows && !nacl && !plan9 && !yaegi_nonet
+// +build go1.26,!go1.27,!windows,!nacl,!plan9,!yaegi_nonet
 
 package stdlib
 
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_nomime
+// +build go1.26,!go1.27,!yaegi_nomime
 
 package stdlib
 
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_nonet
+// +build go1.26,!go1.27,!yaegi_nonet
 
 package stdlib
 
@@ -6,16 +7,17 @@ package stdlib
 
 import (
 	"bufio"
+	"io/fs"
 	"net"
 	"net/http"
 	"net/url"
-	"os"
 	"reflect"
 )
 
 func init() {
 	Symbols["net/http"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"AllowQuerySemicolons":                reflect.ValueOf(http.AllowQuerySemicolons),
 		"CanonicalHeaderKey":                  reflect.ValueOf(http.CanonicalHeaderKey),
 		"DefaultClient":                       reflect.ValueOf(&http.DefaultClient).Elem(),
 		"DefaultMaxHeaderBytes":               reflect.ValueOf(http.DefaultMaxHeaderBytes),
@@ -38,6 +40,7 @@ func init() {
 		"ErrNoLocation":                       reflect.ValueOf(&http.ErrNoLocation).Elem(),
 		"ErrNotMultipart":                     reflect.ValueOf(&http.ErrNotMultipart).Elem(),
 		"ErrNotSupported":                     reflect.ValueOf(&http.ErrNotSupported).Elem(),
+		"ErrSchemeMismatch":                   reflect.ValueOf(&http.ErrSchemeMismatch).Elem(),
 		"ErrServerClosed":                     reflect.ValueOf(&http.ErrServerClosed).Elem(),
 		"ErrShortBody":                        reflect.ValueOf(&http.ErrShortBody).Elem(),
 		"ErrSkipAltProtocol":                  reflect.ValueOf(&http.ErrSkipAltProtocol).Elem(),
@@ -45,7 +48,9 @@ func init() {
 		"ErrUseLastResponse":                  reflect.ValueOf(&http.ErrUseLastResponse).Elem(),
 		"ErrWriteAfterFlush":                  reflect.ValueOf(&http.ErrWriteAfterFlush).Elem(),
 		"Error":                               reflect.ValueOf(http.Error),
+		"FS":                                  reflect.ValueOf(http.FS),
 		"FileServer":                          reflect.ValueOf(http.FileServer),
+		"FileServerFS":                        reflect.ValueOf(http.FileServerFS),
 		"Get":                                 reflect.ValueOf(http.Get),
 		"Handle":                              reflect.ValueOf(http.Handle),
 		"HandleFunc":                          reflect.ValueOf(http.HandleFunc),
@@ -53,6 +58,7 @@ func init() {
 		"ListenAndServe":                      reflect.ValueOf(http.ListenAndServe),
 		"ListenAndServeTLS":                   reflect.ValueOf(http.ListenAndServeTLS),
 		"LocalAddrContextKey":                 reflect.ValueOf(&http.LocalAddrContextKey).Elem(),
+		"MaxBytesHandler":                     reflect.ValueOf(http.MaxBytesHandler),
 		"MaxBytesReader":                      reflect.ValueOf(http.MaxBytesReader),
 		"MethodConnect":                       reflect.ValueOf(http.MethodConnect),
 		"MethodDelete":                        reflect.ValueOf(http.MethodDelete),
@@ -63,13 +69,19 @@ func init() {
 		"MethodPost":                          reflect.ValueOf(http.MethodPost),
 		"MethodPut":                           reflect.ValueOf(http.MethodPut),
 		"MethodTrace":                         reflect.ValueOf(http.MethodTrace),
+		"NewCrossOriginProtection":            reflect.ValueOf(http.NewCrossOriginProtection),
 		"NewFileTransport":                    reflect.ValueOf(http.NewFileTransport),
+		"NewFileTransportFS":                  reflect.ValueOf(http.NewFileTransportFS),
 		"NewRequest":                          reflect.ValueOf(http.NewRequest),
+		"NewRequestWithContext":               reflect.ValueOf(http.NewRequestWithContext),
+		"NewResponseController":               reflect.ValueOf(http.NewResponseController),
 		"NewServeMux":                         reflect.ValueOf(http.NewServeMux),
 		"NoBody":                              reflect.ValueOf(&http.NoBody).Elem(),
 		"NotFound":                            reflect.ValueOf(http.NotFound),
 		"NotFoundHandler":                     reflect.ValueOf(http.NotFoundHandler),
+		"ParseCookie":                         reflect.ValueOf(http.ParseCookie),
 		"ParseHTTPVersion":                    reflect.ValueOf(http.ParseHTTPVersion),
+		"ParseSetCookie":                      reflect.ValueOf(http.ParseSetCookie),
 		"ParseTime":                           reflect.ValueOf(http.ParseTime),
 		"Post":                                reflect.ValueOf(http.Post),
 		"PostForm":                            reflect.ValueOf(http.PostForm),
@@ -81,10 +93,12 @@ func init() {
 		"RedirectHandler":                     reflect.ValueOf(http.RedirectHandler),
 		"SameSiteDefaultMode":                 reflect.ValueOf(http.SameSiteDefaultMode),
 		"SameSiteLaxMode":                     reflect.ValueOf(http.SameSiteLaxMode),
+		"SameSiteNoneMode":                    reflect.ValueOf(http.SameSiteNoneMode),
 		"SameSiteStrictMode":                  reflect.ValueOf(http.SameSiteStrictMode),
 		"Serve":                               reflect.ValueOf(http.Serve),
 		"ServeContent":                        reflect.ValueOf(http.ServeContent),
 		"ServeFile":                           reflect.ValueOf(http.ServeFile),
+		"ServeFileFS":                         reflect.ValueOf(http.ServeFileFS),
 		"ServeTLS":                            reflect.ValueOf(http.ServeTLS),
 		"ServerContextKey":                    reflect.ValueOf(&http.ServerContextKey).Elem(),
 		"SetCookie":                           reflect.ValueOf(http.SetCookie),
@@ -100,6 +114,7 @@ func init() {
 		"StatusConflict":                      reflect.ValueOf(http.StatusConflict),
 		"StatusContinue":                      reflect.ValueOf(http.StatusContinue),
 		"StatusCreated":                       reflect.ValueOf(http.StatusCreated),
+		"StatusEarlyHints":                    reflect.ValueOf(http.StatusEarlyHints),
 		"StatusExpectationFailed":             reflect.ValueOf(http.StatusExpectationFailed),
 		"StatusFailedDependency":              reflect.ValueOf(http.StatusFailedDependency),
 		"StatusForbidden":                     reflect.ValueOf(http.StatusForbidden),
@@ -161,30 +176,36 @@ func init() {
 		"TrailerPrefix":                       reflect.ValueOf(http.TrailerPrefix),
 
 		// type definitions
-		"Client":         reflect.ValueOf((*http.Client)(nil)),
-		"CloseNotifier":  reflect.ValueOf((*http.CloseNotifier)(nil)),
-		"ConnState":      reflect.ValueOf((*http.ConnState)(nil)),
-		"Cookie":         reflect.ValueOf((*http.Cookie)(nil)),
-		"CookieJar":      reflect.ValueOf((*http.CookieJar)(nil)),
-		"Dir":            reflect.ValueOf((*http.Dir)(nil)),
-		"File":           reflect.ValueOf((*http.File)(nil)),
-		"FileSystem":     reflect.ValueOf((*http.FileSystem)(nil)),
-		"Flusher":        reflect.ValueOf((*http.Flusher)(nil)),
-		"Handler":        reflect.ValueOf((*http.Handler)(nil)),
-		"HandlerFunc":    reflect.ValueOf((*http.HandlerFunc)(nil)),
-		"Header":         reflect.ValueOf((*http.Header)(nil)),
-		"Hijacker":       reflect.ValueOf((*http.Hijacker)(nil)),
-		"ProtocolError":  reflect.ValueOf((*http.ProtocolError)(nil)),
-		"PushOptions":    reflect.ValueOf((*http.PushOptions)(nil)),
-		"Pusher":         reflect.ValueOf((*http.Pusher)(nil)),
-		"Request":        reflect.ValueOf((*http.Request)(nil)),
-		"Response":       reflect.ValueOf((*http.Response)(nil)),
-		"ResponseWriter": reflect.ValueOf((*http.ResponseWriter)(nil)),
-		"RoundTripper":   reflect.ValueOf((*http.RoundTripper)(nil)),
-		"SameSite":       reflect.ValueOf((*http.SameSite)(nil)),
-		"ServeMux":       reflect.ValueOf((*http.ServeMux)(nil)),
-		"Server":         reflect.ValueOf((*http.Server)(nil)),
-		"Transport":      reflect.ValueOf((*http.Transport)(nil)),
+		"Client":                reflect.ValueOf((*http.Client)(nil)),
+		"ClientConn":            reflect.ValueOf((*http.ClientConn)(nil)),
+		"CloseNotifier":         reflect.ValueOf((*http.CloseNotifier)(nil)),
+		"ConnState":             reflect.ValueOf((*http.ConnState)(nil)),
+		"Cookie":                reflect.ValueOf((*http.Cookie)(nil)),
+		"CookieJar":             reflect.ValueOf((*http.CookieJar)(nil)),
+		"CrossOriginProtection": reflect.ValueOf((*http.CrossOriginProtection)(nil)),
+		"Dir":                   reflect.ValueOf((*http.Dir)(nil)),
+		"File":                  reflect.ValueOf((*http.File)(nil)),
+		"FileSystem":            reflect.ValueOf((*http.FileSystem)(nil)),
+		"Flusher":               reflect.ValueOf((*http.Flusher)(nil)),
+		"HTTP2Config":           reflect.ValueOf((*http.HTTP2Config)(nil)),
+		"Handler":               reflect.ValueOf((*http.Handler)(nil)),
+		"HandlerFunc":           reflect.ValueOf((*http.HandlerFunc)(nil)),
+		"Header":                reflect.ValueOf((*http.Header)(nil)),
+		"Hijacker":              reflect.ValueOf((*http.Hijacker)(nil)),
+		"MaxBytesError":         reflect.ValueOf((*http.MaxBytesError)(nil)),
+		"ProtocolError":         reflect.ValueOf((*http.ProtocolError)(nil)),
+		"Protocols":             reflect.ValueOf((*http.Protocols)(nil)),
+		"PushOptions":           reflect.ValueOf((*http.PushOptions)(nil)),
+		"Pusher":                reflect.ValueOf((*http.Pusher)(nil)),
+		"Request":               reflect.ValueOf((*http.Request)(nil)),
+		"Response":              reflect.ValueOf((*http.Response)(nil)),
+		"ResponseController":    reflect.ValueOf((*http.ResponseController)(nil)),
+		"ResponseWriter":        reflect.ValueOf((*http.ResponseWriter)(nil)),
+		"RoundTripper":          reflect.ValueOf((*http.RoundTripper)(nil)),
+		"SameSite":              reflect.ValueOf((*http.SameSite)(nil)),
+		"ServeMux":              reflect.ValueOf((*http.ServeMux)(nil)),
+		"Server":                reflect.ValueOf((*http.Server)(nil)),
+		"Transport":             reflect.ValueOf((*http.Transport)(nil)),
 
 		// interface wrapper definitions
 		"_CloseNotifier":  reflect.ValueOf((*_net_http_CloseNotifier)(nil)),
@@ -213,25 +234,25 @@ type _net_http_CookieJar struct {
 	WSetCookies func(u *url.URL, cookies []*http.Cookie)
 }
 
-func (W _net_http_CookieJar) Cookies(u *url.URL) []*http.Cookie             { return W.WCookies(u) }
-func (W _net_http_CookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) { W.WSetCookies(u, cookies) }
+func (W _net_http_CookieJar) Cookies(u *url.URL) []*http.Cookie { return W.WCookies(u) }
+func (W _net_http_CookieJar) SetCookies(u *url.URL, cookies []*http.Cookie) {
+	W.WSetCookies(u, cookies)
+}
 
 // _net_http_File is an interface wrapper for File type
 type _net_http_File struct {
 	WClose   func() error
 	WRead    func(p []byte) (n int, err error)
-	WReaddir func(count int) ([]os.FileInfo, error)
+	WReaddir func(count int) ([]fs.FileInfo, error)
 	WSeek    func(offset int64, whence int) (int64, error)
-	WStat    func() (os.FileInfo, error)
+	WStat    func() (fs.FileInfo, error)
 }
 
-func (W _net_http_File) Close() error                             { return W.WClose() }
-func (W _net_http_File) Read(p []byte) (n int, err error)         { return W.WRead(p) }
-func (W _net_http_File) Readdir(count int) ([]os.FileInfo, error) { return W.WReaddir(count) }
-func (W _net_http_File) Seek(offset int64, whence int) (int64, error) {
-	return W.WSeek(offset, whence)
-}
-func (W _net_http_File) Stat() (os.FileInfo, error) { return W.WStat() }
+func (W _net_http_File) Close() error                                 { return W.WClose() }
+func (W _net_http_File) Read(p []byte) (n int, err error)             { return W.WRead(p) }
+func (W _net_http_File) Readdir(count int) ([]fs.FileInfo, error)     { return W.WReaddir(count) }
+func (W _net_http_File) Seek(offset int64, whence int) (int64, error) { return W.WSeek(offset, whence) }
+func (W _net_http_File) Stat() (fs.FileInfo, error)                   { return W.WStat() }
 
 // _net_http_FileSystem is an interface wrapper for FileSystem type
 type _net_http_FileSystem struct {
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27 && !yaegi_nonet
+// +build go1.26,!go1.27,!yaegi_nonet
 
 package stdlib
 
@@ -29,6 +30,7 @@ func init() {
 		// type definitions
 		"BufferPool":   reflect.ValueOf((*httputil.BufferPool)(nil)),
 		"ClientConn":   reflect.ValueOf((*httputil.ClientConn)(nil)),
+		"ProxyRequest": reflect.ValueOf((*httputil.ProxyRequest)(nil)),
 		"ReverseProxy": reflect.ValueOf((*httputil.ReverseProxy)(nil)),
 		"ServerConn":   reflect.ValueOf((*httputil.ServerConn)(nil)),
 
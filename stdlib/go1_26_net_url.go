@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27 && !yaegi_nonet
+// +build go1.26,!go1.27,!yaegi_nonet
 
 package stdlib
 
@@ -12,6 +13,7 @@ import (
 func init() {
 	Symbols["net/url"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"JoinPath":        reflect.ValueOf(url.JoinPath),
 		"Parse":           reflect.ValueOf(url.Parse),
 		"ParseQuery":      reflect.ValueOf(url.ParseQuery),
 		"ParseRequestURI": reflect.ValueOf(url.ParseRequestURI),
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -14,7 +15,9 @@ func init() {
 		// function, constant and variable definitions
 		"Command":        reflect.ValueOf(exec.Command),
 		"CommandContext": reflect.ValueOf(exec.CommandContext),
+		"ErrDot":         reflect.ValueOf(&exec.ErrDot).Elem(),
 		"ErrNotFound":    reflect.ValueOf(&exec.ErrNotFound).Elem(),
+		"ErrWaitDelay":   reflect.ValueOf(&exec.ErrWaitDelay).Elem(),
 		"LookPath":       reflect.ValueOf(exec.LookPath),
 
 		// type definitions
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -41,6 +42,7 @@ func init() {
 		"SetBlockProfileRate":     reflect.ValueOf(runtime.SetBlockProfileRate),
 		"SetCPUProfileRate":       reflect.ValueOf(runtime.SetCPUProfileRate),
 		"SetCgoTraceback":         reflect.ValueOf(runtime.SetCgoTraceback),
+		"SetDefaultGOMAXPROCS":    reflect.ValueOf(runtime.SetDefaultGOMAXPROCS),
 		"SetFinalizer":            reflect.ValueOf(runtime.SetFinalizer),
 		"SetMutexProfileFraction": reflect.ValueOf(runtime.SetMutexProfileFraction),
 		"Stack":                   reflect.ValueOf(runtime.Stack),
@@ -52,12 +54,15 @@ func init() {
 
 		// type definitions
 		"BlockProfileRecord": reflect.ValueOf((*runtime.BlockProfileRecord)(nil)),
+		"Cleanup":            reflect.ValueOf((*runtime.Cleanup)(nil)),
 		"Error":              reflect.ValueOf((*runtime.Error)(nil)),
 		"Frame":              reflect.ValueOf((*runtime.Frame)(nil)),
 		"Frames":             reflect.ValueOf((*runtime.Frames)(nil)),
 		"Func":               reflect.ValueOf((*runtime.Func)(nil)),
 		"MemProfileRecord":   reflect.ValueOf((*runtime.MemProfileRecord)(nil)),
 		"MemStats":           reflect.ValueOf((*runtime.MemStats)(nil)),
+		"PanicNilError":      reflect.ValueOf((*runtime.PanicNilError)(nil)),
+		"Pinner":             reflect.ValueOf((*runtime.Pinner)(nil)),
 		"StackRecord":        reflect.ValueOf((*runtime.StackRecord)(nil)),
 		"TypeAssertionError": reflect.ValueOf((*runtime.TypeAssertionError)(nil)),
 
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package stdlib
 
@@ -21,6 +22,7 @@ func init() {
 		"JSEscaper":        reflect.ValueOf(template.JSEscaper),
 		"Must":             reflect.ValueOf(template.Must),
 		"New":              reflect.ValueOf(template.New),
+		"ParseFS":          reflect.ValueOf(template.ParseFS),
 		"ParseFiles":       reflect.ValueOf(template.ParseFiles),
 		"ParseGlob":        reflect.ValueOf(template.ParseGlob),
 		"URLQueryEscaper":  reflect.ValueOf(template.URLQueryEscaper),
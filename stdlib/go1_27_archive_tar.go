@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_noarchive
+// +build go1.27,!go1.28,!yaegi_noarchive
 
 package stdlib
 
@@ -6,7 +7,9 @@ package stdlib
 
 import (
 	"archive/tar"
+	"io/fs"
 	"reflect"
+	"time"
 )
 
 func init() {
@@ -14,6 +17,7 @@ func init() {
 		// function, constant and variable definitions
 		"ErrFieldTooLong":    reflect.ValueOf(&tar.ErrFieldTooLong).Elem(),
 		"ErrHeader":          reflect.ValueOf(&tar.ErrHeader).Elem(),
+		"ErrInsecurePath":    reflect.ValueOf(&tar.ErrInsecurePath).Elem(),
 		"ErrWriteAfterClose": reflect.ValueOf(&tar.ErrWriteAfterClose).Elem(),
 		"ErrWriteTooLong":    reflect.ValueOf(&tar.ErrWriteTooLong).Elem(),
 		"FileInfoHeader":     reflect.ValueOf(tar.FileInfoHeader),
@@ -39,12 +43,34 @@ func init() {
 		"TypeXHeader":        reflect.ValueOf(tar.TypeXHeader),
 
 		// type definitions
-		"Format": reflect.ValueOf((*tar.Format)(nil)),
-		"Header": reflect.ValueOf((*tar.Header)(nil)),
-		"Reader": reflect.ValueOf((*tar.Reader)(nil)),
-		"Writer": reflect.ValueOf((*tar.Writer)(nil)),
+		"FileInfoNames": reflect.ValueOf((*tar.FileInfoNames)(nil)),
+		"Format":        reflect.ValueOf((*tar.Format)(nil)),
+		"Header":        reflect.ValueOf((*tar.Header)(nil)),
+		"Reader":        reflect.ValueOf((*tar.Reader)(nil)),
+		"Writer":        reflect.ValueOf((*tar.Writer)(nil)),
 
 		// interface wrapper definitions
-
+		"_FileInfoNames": reflect.ValueOf((*_archive_tar_FileInfoNames)(nil)),
 	}
 }
+
+// _archive_tar_FileInfoNames is an interface wrapper for FileInfoNames type
+type _archive_tar_FileInfoNames struct {
+	WGname   func() (string, error)
+	WIsDir   func() bool
+	WModTime func() time.Time
+	WMode    func() fs.FileMode
+	WName    func() string
+	WSize    func() int64
+	WSys     func() any
+	WUname   func() (string, error)
+}
+
+func (W _archive_tar_FileInfoNames) Gname() (string, error) { return W.WGname() }
+func (W _archive_tar_FileInfoNames) IsDir() bool            { return W.WIsDir() }
+func (W _archive_tar_FileInfoNames) ModTime() time.Time     { return W.WModTime() }
+func (W _archive_tar_FileInfoNames) Mode() fs.FileMode      { return W.WMode() }
+func (W _archive_tar_FileInfoNames) Name() string           { return W.WName() }
+func (W _archive_tar_FileInfoNames) Size() int64            { return W.WSize() }
+func (W _archive_tar_FileInfoNames) Sys() any               { return W.WSys() }
+func (W _archive_tar_FileInfoNames) Uname() (string, error) { return W.WUname() }
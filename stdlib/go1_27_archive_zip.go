@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_noarchive
+// +build go1.27,!go1.28,!yaegi_noarchive
 
 package stdlib
 
@@ -16,6 +17,7 @@ func init() {
 		"ErrAlgorithm":         reflect.ValueOf(&zip.ErrAlgorithm).Elem(),
 		"ErrChecksum":          reflect.ValueOf(&zip.ErrChecksum).Elem(),
 		"ErrFormat":            reflect.ValueOf(&zip.ErrFormat).Elem(),
+		"ErrInsecurePath":      reflect.ValueOf(&zip.ErrInsecurePath).Elem(),
 		"FileInfoHeader":       reflect.ValueOf(zip.FileInfoHeader),
 		"NewReader":            reflect.ValueOf(zip.NewReader),
 		"NewWriter":            reflect.ValueOf(zip.NewWriter),
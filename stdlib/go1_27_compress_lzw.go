@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nocompress
+// +build go1.27,!go1.28,!yaegi_nocompress
 
 package stdlib
 
@@ -18,7 +19,9 @@ func init() {
 		"NewWriter": reflect.ValueOf(lzw.NewWriter),
 
 		// type definitions
-		"Order": reflect.ValueOf((*lzw.Order)(nil)),
+		"Order":  reflect.ValueOf((*lzw.Order)(nil)),
+		"Reader": reflect.ValueOf((*lzw.Reader)(nil)),
+		"Writer": reflect.ValueOf((*lzw.Writer)(nil)),
 
 		// interface wrapper definitions
 
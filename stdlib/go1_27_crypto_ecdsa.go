@@ -0,0 +1,31 @@
+//go:build go1.27 && !go1.28 && !yaegi_nocrypto
+// +build go1.27,!go1.28,!yaegi_nocrypto
+
+package stdlib
+
+// Code generated by 'goexports crypto/ecdsa'. DO NOT EDIT.
+
+import (
+	"crypto/ecdsa"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/ecdsa"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"GenerateKey":                reflect.ValueOf(ecdsa.GenerateKey),
+		"ParseRawPrivateKey":         reflect.ValueOf(ecdsa.ParseRawPrivateKey),
+		"ParseUncompressedPublicKey": reflect.ValueOf(ecdsa.ParseUncompressedPublicKey),
+		"Sign":                       reflect.ValueOf(ecdsa.Sign),
+		"SignASN1":                   reflect.ValueOf(ecdsa.SignASN1),
+		"Verify":                     reflect.ValueOf(ecdsa.Verify),
+		"VerifyASN1":                 reflect.ValueOf(ecdsa.VerifyASN1),
+
+		// type definitions
+		"PrivateKey": reflect.ValueOf((*ecdsa.PrivateKey)(nil)),
+		"PublicKey":  reflect.ValueOf((*ecdsa.PublicKey)(nil)),
+
+		// interface wrapper definitions
+
+	}
+}
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_nocrypto
+// +build go1.27,!go1.28,!yaegi_nocrypto
 
 package stdlib
 
@@ -13,13 +14,15 @@ import (
 func init() {
 	Symbols["crypto/elliptic"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"GenerateKey": reflect.ValueOf(elliptic.GenerateKey),
-		"Marshal":     reflect.ValueOf(elliptic.Marshal),
-		"P224":        reflect.ValueOf(elliptic.P224),
-		"P256":        reflect.ValueOf(elliptic.P256),
-		"P384":        reflect.ValueOf(elliptic.P384),
-		"P521":        reflect.ValueOf(elliptic.P521),
-		"Unmarshal":   reflect.ValueOf(elliptic.Unmarshal),
+		"GenerateKey":         reflect.ValueOf(elliptic.GenerateKey),
+		"Marshal":             reflect.ValueOf(elliptic.Marshal),
+		"MarshalCompressed":   reflect.ValueOf(elliptic.MarshalCompressed),
+		"P224":                reflect.ValueOf(elliptic.P224),
+		"P256":                reflect.ValueOf(elliptic.P256),
+		"P384":                reflect.ValueOf(elliptic.P384),
+		"P521":                reflect.ValueOf(elliptic.P521),
+		"Unmarshal":           reflect.ValueOf(elliptic.Unmarshal),
+		"UnmarshalCompressed": reflect.ValueOf(elliptic.UnmarshalCompressed),
 
 		// type definitions
 		"Curve":       reflect.ValueOf((*elliptic.Curve)(nil)),
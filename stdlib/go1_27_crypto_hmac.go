@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nocrypto
+// +build go1.27,!go1.28,!yaegi_nocrypto
 
 package stdlib
 
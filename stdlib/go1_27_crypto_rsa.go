@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nocrypto
+// +build go1.27,!go1.28,!yaegi_nocrypto
 
 package stdlib
 
@@ -16,6 +17,7 @@ func init() {
 		"DecryptPKCS1v15":           reflect.ValueOf(rsa.DecryptPKCS1v15),
 		"DecryptPKCS1v15SessionKey": reflect.ValueOf(rsa.DecryptPKCS1v15SessionKey),
 		"EncryptOAEP":               reflect.ValueOf(rsa.EncryptOAEP),
+		"EncryptOAEPWithOptions":    reflect.ValueOf(rsa.EncryptOAEPWithOptions),
 		"EncryptPKCS1v15":           reflect.ValueOf(rsa.EncryptPKCS1v15),
 		"ErrDecryption":             reflect.ValueOf(&rsa.ErrDecryption).Elem(),
 		"ErrMessageTooLong":         reflect.ValueOf(&rsa.ErrMessageTooLong).Elem(),
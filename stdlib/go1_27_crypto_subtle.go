@@ -0,0 +1,30 @@
+//go:build go1.27 && !go1.28 && !yaegi_nocrypto
+// +build go1.27,!go1.28,!yaegi_nocrypto
+
+package stdlib
+
+// Code generated by 'goexports crypto/subtle'. DO NOT EDIT.
+
+import (
+	"crypto/subtle"
+	"reflect"
+)
+
+func init() {
+	Symbols["crypto/subtle"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"ConstantTimeByteEq":        reflect.ValueOf(subtle.ConstantTimeByteEq),
+		"ConstantTimeCompare":       reflect.ValueOf(subtle.ConstantTimeCompare),
+		"ConstantTimeCopy":          reflect.ValueOf(subtle.ConstantTimeCopy),
+		"ConstantTimeEq":            reflect.ValueOf(subtle.ConstantTimeEq),
+		"ConstantTimeLessOrEq":      reflect.ValueOf(subtle.ConstantTimeLessOrEq),
+		"ConstantTimeSelect":        reflect.ValueOf(subtle.ConstantTimeSelect),
+		"WithDataIndependentTiming": reflect.ValueOf(subtle.WithDataIndependentTiming),
+		"XORBytes":                  reflect.ValueOf(subtle.XORBytes),
+
+		// type definitions
+
+		// interface wrapper definitions
+
+	}
+}
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nocrypto
+// +build go1.27,!go1.28,!yaegi_nocrypto
 
 package stdlib
 
@@ -12,29 +13,31 @@ import (
 func init() {
 	Symbols["crypto/x509"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"CANotAuthorizedForExtKeyUsage":             reflect.ValueOf(x509.CANotAuthorizedForExtKeyUsage),
-		"CANotAuthorizedForThisName":                reflect.ValueOf(x509.CANotAuthorizedForThisName),
-		"CreateCertificate":                         reflect.ValueOf(x509.CreateCertificate),
-		"CreateCertificateRequest":                  reflect.ValueOf(x509.CreateCertificateRequest),
-		"DSA":                                       reflect.ValueOf(x509.DSA),
-		"DSAWithSHA1":                               reflect.ValueOf(x509.DSAWithSHA1),
-		"DSAWithSHA256":                             reflect.ValueOf(x509.DSAWithSHA256),
-		"DecryptPEMBlock":                           reflect.ValueOf(x509.DecryptPEMBlock),
-		"ECDSA":                                     reflect.ValueOf(x509.ECDSA),
-		"ECDSAWithSHA1":                             reflect.ValueOf(x509.ECDSAWithSHA1),
-		"ECDSAWithSHA256":                           reflect.ValueOf(x509.ECDSAWithSHA256),
-		"ECDSAWithSHA384":                           reflect.ValueOf(x509.ECDSAWithSHA384),
-		"ECDSAWithSHA512":                           reflect.ValueOf(x509.ECDSAWithSHA512),
-		"EncryptPEMBlock":                           reflect.ValueOf(x509.EncryptPEMBlock),
-		"ErrUnsupportedAlgorithm":                   reflect.ValueOf(&x509.ErrUnsupportedAlgorithm).Elem(),
-		"Expired":                                   reflect.ValueOf(x509.Expired),
-		"ExtKeyUsageAny":                            reflect.ValueOf(x509.ExtKeyUsageAny),
-		"ExtKeyUsageClientAuth":                     reflect.ValueOf(x509.ExtKeyUsageClientAuth),
-		"ExtKeyUsageCodeSigning":                    reflect.ValueOf(x509.ExtKeyUsageCodeSigning),
-		"ExtKeyUsageEmailProtection":                reflect.ValueOf(x509.ExtKeyUsageEmailProtection),
-		"ExtKeyUsageIPSECEndSystem":                 reflect.ValueOf(x509.ExtKeyUsageIPSECEndSystem),
-		"ExtKeyUsageIPSECTunnel":                    reflect.ValueOf(x509.ExtKeyUsageIPSECTunnel),
-		"ExtKeyUsageIPSECUser":                      reflect.ValueOf(x509.ExtKeyUsageIPSECUser),
+		"CANotAuthorizedForExtKeyUsage": reflect.ValueOf(x509.CANotAuthorizedForExtKeyUsage),
+		"CANotAuthorizedForThisName":    reflect.ValueOf(x509.CANotAuthorizedForThisName),
+		"CreateCertificate":             reflect.ValueOf(x509.CreateCertificate),
+		"CreateCertificateRequest":      reflect.ValueOf(x509.CreateCertificateRequest),
+		"CreateRevocationList":          reflect.ValueOf(x509.CreateRevocationList),
+		"DSA":                           reflect.ValueOf(x509.DSA),
+		"DSAWithSHA1":                   reflect.ValueOf(x509.DSAWithSHA1),
+		"DSAWithSHA256":                 reflect.ValueOf(x509.DSAWithSHA256),
+		"DecryptPEMBlock":               reflect.ValueOf(x509.DecryptPEMBlock),
+		"ECDSA":                         reflect.ValueOf(x509.ECDSA),
+		"ECDSAWithSHA1":                 reflect.ValueOf(x509.ECDSAWithSHA1),
+		"ECDSAWithSHA256":               reflect.ValueOf(x509.ECDSAWithSHA256),
+		"ECDSAWithSHA384":               reflect.ValueOf(x509.ECDSAWithSHA384),
+		"ECDSAWithSHA512":               reflect.ValueOf(x509.ECDSAWithSHA512),
+		"Ed25519":                       reflect.ValueOf(x509.Ed25519),
+		"EncryptPEMBlock":               reflect.ValueOf(x509.EncryptPEMBlock),
+		"ErrUnsupportedAlgorithm":       reflect.ValueOf(&x509.ErrUnsupportedAlgorithm).Elem(),
+		"Expired":                       reflect.ValueOf(x509.Expired),
+		"ExtKeyUsageAny":                reflect.ValueOf(x509.ExtKeyUsageAny),
+		"ExtKeyUsageClientAuth":         reflect.ValueOf(x509.ExtKeyUsageClientAuth),
+		"ExtKeyUsageCodeSigning":        reflect.ValueOf(x509.ExtKeyUsageCodeSigning),
+		"ExtKeyUsageEmailProtection":    reflect.ValueOf(x509.ExtKeyUsageEmailProtection),
+		"ExtKeyUsageIPSECEndSystem":     reflect.ValueOf(x509.ExtKeyUsageIPSECEndSystem),
+		"ExtKeyUsageIPSECTunnel":        reflect.ValueOf(x509.ExtKeyUsageIPSECTunnel),
+		"ExtKeyUsageIPSECUser":          reflect.ValueOf(x509.ExtKeyUsageIPSECUser),
 		"ExtKeyUsageMicrosoftCommercialCodeSigning": reflect.ValueOf(x509.ExtKeyUsageMicrosoftCommercialCodeSigning),
 		"ExtKeyUsageMicrosoftKernelCodeSigning":     reflect.ValueOf(x509.ExtKeyUsageMicrosoftKernelCodeSigning),
 		"ExtKeyUsageMicrosoftServerGatedCrypto":     reflect.ValueOf(x509.ExtKeyUsageMicrosoftServerGatedCrypto),
@@ -56,6 +59,10 @@ func init() {
 		"KeyUsageKeyEncipherment":                   reflect.ValueOf(x509.KeyUsageKeyEncipherment),
 		"MD2WithRSA":                                reflect.ValueOf(x509.MD2WithRSA),
 		"MD5WithRSA":                                reflect.ValueOf(x509.MD5WithRSA),
+		"MLDSA":                                     reflect.ValueOf(x509.MLDSA),
+		"MLDSA44":                                   reflect.ValueOf(x509.MLDSA44),
+		"MLDSA65":                                   reflect.ValueOf(x509.MLDSA65),
+		"MLDSA87":                                   reflect.ValueOf(x509.MLDSA87),
 		"MarshalECPrivateKey":                       reflect.ValueOf(x509.MarshalECPrivateKey),
 		"MarshalPKCS1PrivateKey":                    reflect.ValueOf(x509.MarshalPKCS1PrivateKey),
 		"MarshalPKCS1PublicKey":                     reflect.ValueOf(x509.MarshalPKCS1PublicKey),
@@ -64,7 +71,10 @@ func init() {
 		"NameConstraintsWithoutSANs":                reflect.ValueOf(x509.NameConstraintsWithoutSANs),
 		"NameMismatch":                              reflect.ValueOf(x509.NameMismatch),
 		"NewCertPool":                               reflect.ValueOf(x509.NewCertPool),
+		"NoValidChains":                             reflect.ValueOf(x509.NoValidChains),
 		"NotAuthorizedToSign":                       reflect.ValueOf(x509.NotAuthorizedToSign),
+		"OIDFromASN1OID":                            reflect.ValueOf(x509.OIDFromASN1OID),
+		"OIDFromInts":                               reflect.ValueOf(x509.OIDFromInts),
 		"PEMCipher3DES":                             reflect.ValueOf(x509.PEMCipher3DES),
 		"PEMCipherAES128":                           reflect.ValueOf(x509.PEMCipherAES128),
 		"PEMCipherAES192":                           reflect.ValueOf(x509.PEMCipherAES192),
@@ -76,10 +86,13 @@ func init() {
 		"ParseCertificates":                         reflect.ValueOf(x509.ParseCertificates),
 		"ParseDERCRL":                               reflect.ValueOf(x509.ParseDERCRL),
 		"ParseECPrivateKey":                         reflect.ValueOf(x509.ParseECPrivateKey),
+		"ParseOID":                                  reflect.ValueOf(x509.ParseOID),
 		"ParsePKCS1PrivateKey":                      reflect.ValueOf(x509.ParsePKCS1PrivateKey),
 		"ParsePKCS1PublicKey":                       reflect.ValueOf(x509.ParsePKCS1PublicKey),
 		"ParsePKCS8PrivateKey":                      reflect.ValueOf(x509.ParsePKCS8PrivateKey),
 		"ParsePKIXPublicKey":                        reflect.ValueOf(x509.ParsePKIXPublicKey),
+		"ParseRevocationList":                       reflect.ValueOf(x509.ParseRevocationList),
+		"PureEd25519":                               reflect.ValueOf(x509.PureEd25519),
 		"RSA":                                       reflect.ValueOf(x509.RSA),
 		"SHA1WithRSA":                               reflect.ValueOf(x509.SHA1WithRSA),
 		"SHA256WithRSA":                             reflect.ValueOf(x509.SHA256WithRSA),
@@ -88,6 +101,7 @@ func init() {
 		"SHA384WithRSAPSS":                          reflect.ValueOf(x509.SHA384WithRSAPSS),
 		"SHA512WithRSA":                             reflect.ValueOf(x509.SHA512WithRSA),
 		"SHA512WithRSAPSS":                          reflect.ValueOf(x509.SHA512WithRSAPSS),
+		"SetFallbackRoots":                          reflect.ValueOf(x509.SetFallbackRoots),
 		"SystemCertPool":                            reflect.ValueOf(x509.SystemCertPool),
 		"TooManyConstraints":                        reflect.ValueOf(x509.TooManyConstraints),
 		"TooManyIntermediates":                      reflect.ValueOf(x509.TooManyIntermediates),
@@ -106,8 +120,12 @@ func init() {
 		"InsecureAlgorithmError":     reflect.ValueOf((*x509.InsecureAlgorithmError)(nil)),
 		"InvalidReason":              reflect.ValueOf((*x509.InvalidReason)(nil)),
 		"KeyUsage":                   reflect.ValueOf((*x509.KeyUsage)(nil)),
+		"OID":                        reflect.ValueOf((*x509.OID)(nil)),
 		"PEMCipher":                  reflect.ValueOf((*x509.PEMCipher)(nil)),
+		"PolicyMapping":              reflect.ValueOf((*x509.PolicyMapping)(nil)),
 		"PublicKeyAlgorithm":         reflect.ValueOf((*x509.PublicKeyAlgorithm)(nil)),
+		"RevocationList":             reflect.ValueOf((*x509.RevocationList)(nil)),
+		"RevocationListEntry":        reflect.ValueOf((*x509.RevocationListEntry)(nil)),
 		"SignatureAlgorithm":         reflect.ValueOf((*x509.SignatureAlgorithm)(nil)),
 		"SystemRootsError":           reflect.ValueOf((*x509.SystemRootsError)(nil)),
 		"UnhandledCriticalExtension": reflect.ValueOf((*x509.UnhandledCriticalExtension)(nil)),
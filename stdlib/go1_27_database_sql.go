@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_nodatabase
+// +build go1.27,!go1.28,!yaegi_nodatabase
 
 package stdlib
 
@@ -12,6 +13,7 @@ import (
 func init() {
 	Symbols["database/sql"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"ConvertAssign":        reflect.ValueOf(sql.ConvertAssign),
 		"Drivers":              reflect.ValueOf(sql.Drivers),
 		"ErrConnDone":          reflect.ValueOf(&sql.ErrConnDone).Elem(),
 		"ErrNoRows":            reflect.ValueOf(&sql.ErrNoRows).Elem(),
@@ -37,9 +39,13 @@ func init() {
 		"IsolationLevel": reflect.ValueOf((*sql.IsolationLevel)(nil)),
 		"NamedArg":       reflect.ValueOf((*sql.NamedArg)(nil)),
 		"NullBool":       reflect.ValueOf((*sql.NullBool)(nil)),
+		"NullByte":       reflect.ValueOf((*sql.NullByte)(nil)),
 		"NullFloat64":    reflect.ValueOf((*sql.NullFloat64)(nil)),
+		"NullInt16":      reflect.ValueOf((*sql.NullInt16)(nil)),
+		"NullInt32":      reflect.ValueOf((*sql.NullInt32)(nil)),
 		"NullInt64":      reflect.ValueOf((*sql.NullInt64)(nil)),
 		"NullString":     reflect.ValueOf((*sql.NullString)(nil)),
+		"NullTime":       reflect.ValueOf((*sql.NullTime)(nil)),
 		"Out":            reflect.ValueOf((*sql.Out)(nil)),
 		"RawBytes":       reflect.ValueOf((*sql.RawBytes)(nil)),
 		"Result":         reflect.ValueOf((*sql.Result)(nil)),
@@ -67,7 +73,7 @@ func (W _database_sql_Result) RowsAffected() (int64, error) { return W.WRowsAffe
 
 // _database_sql_Scanner is an interface wrapper for Scanner type
 type _database_sql_Scanner struct {
-	WScan func(src interface{}) error
+	WScan func(src any) error
 }
 
-func (W _database_sql_Scanner) Scan(src interface{}) error { return W.WScan(src) }
+func (W _database_sql_Scanner) Scan(src any) error { return W.WScan(src) }
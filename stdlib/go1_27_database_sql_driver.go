@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_nodatabase
+// +build go1.27,!go1.28,!yaegi_nodatabase
 
 package stdlib
 
@@ -45,18 +46,21 @@ func init() {
 		"Result":                         reflect.ValueOf((*driver.Result)(nil)),
 		"Rows":                           reflect.ValueOf((*driver.Rows)(nil)),
 		"RowsAffected":                   reflect.ValueOf((*driver.RowsAffected)(nil)),
+		"RowsColumnScanner":              reflect.ValueOf((*driver.RowsColumnScanner)(nil)),
 		"RowsColumnTypeDatabaseTypeName": reflect.ValueOf((*driver.RowsColumnTypeDatabaseTypeName)(nil)),
 		"RowsColumnTypeLength":           reflect.ValueOf((*driver.RowsColumnTypeLength)(nil)),
 		"RowsColumnTypeNullable":         reflect.ValueOf((*driver.RowsColumnTypeNullable)(nil)),
 		"RowsColumnTypePrecisionScale":   reflect.ValueOf((*driver.RowsColumnTypePrecisionScale)(nil)),
 		"RowsColumnTypeScanType":         reflect.ValueOf((*driver.RowsColumnTypeScanType)(nil)),
 		"RowsNextResultSet":              reflect.ValueOf((*driver.RowsNextResultSet)(nil)),
+		"ScanContext":                    reflect.ValueOf((*driver.ScanContext)(nil)),
 		"SessionResetter":                reflect.ValueOf((*driver.SessionResetter)(nil)),
 		"Stmt":                           reflect.ValueOf((*driver.Stmt)(nil)),
 		"StmtExecContext":                reflect.ValueOf((*driver.StmtExecContext)(nil)),
 		"StmtQueryContext":               reflect.ValueOf((*driver.StmtQueryContext)(nil)),
 		"Tx":                             reflect.ValueOf((*driver.Tx)(nil)),
 		"TxOptions":                      reflect.ValueOf((*driver.TxOptions)(nil)),
+		"Validator":                      reflect.ValueOf((*driver.Validator)(nil)),
 		"Value":                          reflect.ValueOf((*driver.Value)(nil)),
 		"ValueConverter":                 reflect.ValueOf((*driver.ValueConverter)(nil)),
 		"Valuer":                         reflect.ValueOf((*driver.Valuer)(nil)),
@@ -77,6 +81,7 @@ func init() {
 		"_QueryerContext":                 reflect.ValueOf((*_database_sql_driver_QueryerContext)(nil)),
 		"_Result":                         reflect.ValueOf((*_database_sql_driver_Result)(nil)),
 		"_Rows":                           reflect.ValueOf((*_database_sql_driver_Rows)(nil)),
+		"_RowsColumnScanner":              reflect.ValueOf((*_database_sql_driver_RowsColumnScanner)(nil)),
 		"_RowsColumnTypeDatabaseTypeName": reflect.ValueOf((*_database_sql_driver_RowsColumnTypeDatabaseTypeName)(nil)),
 		"_RowsColumnTypeLength":           reflect.ValueOf((*_database_sql_driver_RowsColumnTypeLength)(nil)),
 		"_RowsColumnTypeNullable":         reflect.ValueOf((*_database_sql_driver_RowsColumnTypeNullable)(nil)),
@@ -88,6 +93,7 @@ func init() {
 		"_StmtExecContext":                reflect.ValueOf((*_database_sql_driver_StmtExecContext)(nil)),
 		"_StmtQueryContext":               reflect.ValueOf((*_database_sql_driver_StmtQueryContext)(nil)),
 		"_Tx":                             reflect.ValueOf((*_database_sql_driver_Tx)(nil)),
+		"_Validator":                      reflect.ValueOf((*_database_sql_driver_Validator)(nil)),
 		"_Value":                          reflect.ValueOf((*_database_sql_driver_Value)(nil)),
 		"_ValueConverter":                 reflect.ValueOf((*_database_sql_driver_ValueConverter)(nil)),
 		"_Valuer":                         reflect.ValueOf((*_database_sql_driver_Valuer)(nil)),
@@ -233,6 +239,23 @@ func (W _database_sql_driver_Rows) Close() error                   { return W.WC
 func (W _database_sql_driver_Rows) Columns() []string              { return W.WColumns() }
 func (W _database_sql_driver_Rows) Next(dest []driver.Value) error { return W.WNext(dest) }
 
+// _database_sql_driver_RowsColumnScanner is an interface wrapper for RowsColumnScanner type
+type _database_sql_driver_RowsColumnScanner struct {
+	WClose      func() error
+	WColumns    func() []string
+	WNext       func(dest []driver.Value) error
+	WNextRow    func() error
+	WScanColumn func(scanCtx driver.ScanContext, index int, dest any) error
+}
+
+func (W _database_sql_driver_RowsColumnScanner) Close() error                   { return W.WClose() }
+func (W _database_sql_driver_RowsColumnScanner) Columns() []string              { return W.WColumns() }
+func (W _database_sql_driver_RowsColumnScanner) Next(dest []driver.Value) error { return W.WNext(dest) }
+func (W _database_sql_driver_RowsColumnScanner) NextRow() error                 { return W.WNextRow() }
+func (W _database_sql_driver_RowsColumnScanner) ScanColumn(scanCtx driver.ScanContext, index int, dest any) error {
+	return W.WScanColumn(scanCtx, index, dest)
+}
+
 // _database_sql_driver_RowsColumnTypeDatabaseTypeName is an interface wrapper for RowsColumnTypeDatabaseTypeName type
 type _database_sql_driver_RowsColumnTypeDatabaseTypeName struct {
 	WClose                      func() error
@@ -327,15 +350,11 @@ type _database_sql_driver_RowsNextResultSet struct {
 	WNextResultSet    func() error
 }
 
-func (W _database_sql_driver_RowsNextResultSet) Close() error      { return W.WClose() }
-func (W _database_sql_driver_RowsNextResultSet) Columns() []string { return W.WColumns() }
-func (W _database_sql_driver_RowsNextResultSet) HasNextResultSet() bool {
-	return W.WHasNextResultSet()
-}
-func (W _database_sql_driver_RowsNextResultSet) Next(dest []driver.Value) error {
-	return W.WNext(dest)
-}
-func (W _database_sql_driver_RowsNextResultSet) NextResultSet() error { return W.WNextResultSet() }
+func (W _database_sql_driver_RowsNextResultSet) Close() error                   { return W.WClose() }
+func (W _database_sql_driver_RowsNextResultSet) Columns() []string              { return W.WColumns() }
+func (W _database_sql_driver_RowsNextResultSet) HasNextResultSet() bool         { return W.WHasNextResultSet() }
+func (W _database_sql_driver_RowsNextResultSet) Next(dest []driver.Value) error { return W.WNext(dest) }
+func (W _database_sql_driver_RowsNextResultSet) NextResultSet() error           { return W.WNextResultSet() }
 
 // _database_sql_driver_SessionResetter is an interface wrapper for SessionResetter type
 type _database_sql_driver_SessionResetter struct {
@@ -390,16 +409,23 @@ type _database_sql_driver_Tx struct {
 func (W _database_sql_driver_Tx) Commit() error   { return W.WCommit() }
 func (W _database_sql_driver_Tx) Rollback() error { return W.WRollback() }
 
+// _database_sql_driver_Validator is an interface wrapper for Validator type
+type _database_sql_driver_Validator struct {
+	WIsValid func() bool
+}
+
+func (W _database_sql_driver_Validator) IsValid() bool { return W.WIsValid() }
+
 // _database_sql_driver_Value is an interface wrapper for Value type
 type _database_sql_driver_Value struct {
 }
 
 // _database_sql_driver_ValueConverter is an interface wrapper for ValueConverter type
 type _database_sql_driver_ValueConverter struct {
-	WConvertValue func(v interface{}) (driver.Value, error)
+	WConvertValue func(v any) (driver.Value, error)
 }
 
-func (W _database_sql_driver_ValueConverter) ConvertValue(v interface{}) (driver.Value, error) {
+func (W _database_sql_driver_ValueConverter) ConvertValue(v any) (driver.Value, error) {
 	return W.WConvertValue(v)
 }
 
@@ -0,0 +1,23 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+// Code generated by 'goexports embed'. DO NOT EDIT.
+
+import (
+	"embed"
+	"reflect"
+)
+
+func init() {
+	Symbols["embed"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+
+		// type definitions
+		"FS": reflect.ValueOf((*embed.FS)(nil)),
+
+		// interface wrapper definitions
+
+	}
+}
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_noencoding
+// +build go1.27,!go1.28,!yaegi_noencoding
 
 package stdlib
 
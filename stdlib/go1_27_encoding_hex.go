@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_noencoding
+// +build go1.27,!go1.28,!yaegi_noencoding
 
 package stdlib
 
@@ -12,6 +13,8 @@ import (
 func init() {
 	Symbols["encoding/hex"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"AppendDecode":   reflect.ValueOf(hex.AppendDecode),
+		"AppendEncode":   reflect.ValueOf(hex.AppendEncode),
 		"Decode":         reflect.ValueOf(hex.Decode),
 		"DecodeString":   reflect.ValueOf(hex.DecodeString),
 		"DecodedLen":     reflect.ValueOf(hex.DecodedLen),
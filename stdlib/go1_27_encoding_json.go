@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_noencoding
+// +build go1.27,!go1.28,!yaegi_noencoding
 
 package stdlib
 
@@ -12,15 +13,28 @@ import (
 func init() {
 	Symbols["encoding/json"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"Compact":       reflect.ValueOf(json.Compact),
-		"HTMLEscape":    reflect.ValueOf(json.HTMLEscape),
-		"Indent":        reflect.ValueOf(json.Indent),
-		"Marshal":       reflect.ValueOf(json.Marshal),
-		"MarshalIndent": reflect.ValueOf(json.MarshalIndent),
-		"NewDecoder":    reflect.ValueOf(json.NewDecoder),
-		"NewEncoder":    reflect.ValueOf(json.NewEncoder),
-		"Unmarshal":     reflect.ValueOf(json.Unmarshal),
-		"Valid":         reflect.ValueOf(json.Valid),
+		"CallMethodsWithLegacySemantics":  reflect.ValueOf(json.CallMethodsWithLegacySemantics),
+		"Compact":                         reflect.ValueOf(json.Compact),
+		"DefaultOptionsV1":                reflect.ValueOf(json.DefaultOptionsV1),
+		"FormatByteArrayAsArray":          reflect.ValueOf(json.FormatByteArrayAsArray),
+		"FormatBytesWithLegacySemantics":  reflect.ValueOf(json.FormatBytesWithLegacySemantics),
+		"FormatDurationAsNano":            reflect.ValueOf(json.FormatDurationAsNano),
+		"HTMLEscape":                      reflect.ValueOf(json.HTMLEscape),
+		"Indent":                          reflect.ValueOf(json.Indent),
+		"Marshal":                         reflect.ValueOf(json.Marshal),
+		"MarshalIndent":                   reflect.ValueOf(json.MarshalIndent),
+		"MatchCaseSensitiveDelimiter":     reflect.ValueOf(json.MatchCaseSensitiveDelimiter),
+		"MergeWithLegacySemantics":        reflect.ValueOf(json.MergeWithLegacySemantics),
+		"NewDecoder":                      reflect.ValueOf(json.NewDecoder),
+		"NewEncoder":                      reflect.ValueOf(json.NewEncoder),
+		"OmitEmptyWithLegacySemantics":    reflect.ValueOf(json.OmitEmptyWithLegacySemantics),
+		"ParseBytesWithLooseRFC4648":      reflect.ValueOf(json.ParseBytesWithLooseRFC4648),
+		"ParseTimeWithLooseRFC3339":       reflect.ValueOf(json.ParseTimeWithLooseRFC3339),
+		"ReportErrorsWithLegacySemantics": reflect.ValueOf(json.ReportErrorsWithLegacySemantics),
+		"StringifyWithLegacySemantics":    reflect.ValueOf(json.StringifyWithLegacySemantics),
+		"Unmarshal":                       reflect.ValueOf(json.Unmarshal),
+		"UnmarshalArrayFromAnyLength":     reflect.ValueOf(json.UnmarshalArrayFromAnyLength),
+		"Valid":                           reflect.ValueOf(json.Valid),
 
 		// type definitions
 		"Decoder":               reflect.ValueOf((*json.Decoder)(nil)),
@@ -31,6 +45,7 @@ func init() {
 		"Marshaler":             reflect.ValueOf((*json.Marshaler)(nil)),
 		"MarshalerError":        reflect.ValueOf((*json.MarshalerError)(nil)),
 		"Number":                reflect.ValueOf((*json.Number)(nil)),
+		"Options":               reflect.ValueOf((*json.Options)(nil)),
 		"RawMessage":            reflect.ValueOf((*json.RawMessage)(nil)),
 		"SyntaxError":           reflect.ValueOf((*json.SyntaxError)(nil)),
 		"Token":                 reflect.ValueOf((*json.Token)(nil)),
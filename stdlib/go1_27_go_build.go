@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -25,6 +26,7 @@ func init() {
 
 		// type definitions
 		"Context":              reflect.ValueOf((*build.Context)(nil)),
+		"Directive":            reflect.ValueOf((*build.Directive)(nil)),
 		"ImportMode":           reflect.ValueOf((*build.ImportMode)(nil)),
 		"MultiplePackageError": reflect.ValueOf((*build.MultiplePackageError)(nil)),
 		"NoGoError":            reflect.ValueOf((*build.NoGoError)(nil)),
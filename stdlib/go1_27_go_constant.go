@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -26,6 +27,7 @@ func init() {
 		"Imag":            reflect.ValueOf(constant.Imag),
 		"Int":             reflect.ValueOf(constant.Int),
 		"Int64Val":        reflect.ValueOf(constant.Int64Val),
+		"Make":            reflect.ValueOf(constant.Make),
 		"MakeBool":        reflect.ValueOf(constant.MakeBool),
 		"MakeFloat64":     reflect.ValueOf(constant.MakeFloat64),
 		"MakeFromBytes":   reflect.ValueOf(constant.MakeFromBytes),
@@ -40,6 +42,7 @@ func init() {
 		"Shift":           reflect.ValueOf(constant.Shift),
 		"Sign":            reflect.ValueOf(constant.Sign),
 		"String":          reflect.ValueOf(constant.String),
+		"StringLen":       reflect.ValueOf(constant.StringLen),
 		"StringVal":       reflect.ValueOf(constant.StringVal),
 		"ToComplex":       reflect.ValueOf(constant.ToComplex),
 		"ToFloat":         reflect.ValueOf(constant.ToFloat),
@@ -47,6 +50,7 @@ func init() {
 		"Uint64Val":       reflect.ValueOf(constant.Uint64Val),
 		"UnaryOp":         reflect.ValueOf(constant.UnaryOp),
 		"Unknown":         reflect.ValueOf(constant.Unknown),
+		"Val":             reflect.ValueOf(constant.Val),
 
 		// type definitions
 		"Kind":  reflect.ValueOf((*constant.Kind)(nil)),
@@ -59,6 +63,7 @@ func init() {
 
 // _go_constant_Value is an interface wrapper for Value type
 type _go_constant_Value struct {
+	constant.Value
 	WExactString func() string
 	WKind        func() constant.Kind
 	WString      func() string
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -18,6 +19,8 @@ func init() {
 		"IllegalPrefixes": reflect.ValueOf(&doc.IllegalPrefixes).Elem(),
 		"IsPredeclared":   reflect.ValueOf(doc.IsPredeclared),
 		"New":             reflect.ValueOf(doc.New),
+		"NewFromFiles":    reflect.ValueOf(doc.NewFromFiles),
+		"PreserveAST":     reflect.ValueOf(doc.PreserveAST),
 		"Synopsis":        reflect.ValueOf(doc.Synopsis),
 		"ToHTML":          reflect.ValueOf(doc.ToHTML),
 		"ToText":          reflect.ValueOf(doc.ToText),
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -12,8 +13,9 @@ import (
 func init() {
 	Symbols["go/importer"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"Default": reflect.ValueOf(importer.Default),
-		"For":     reflect.ValueOf(importer.For),
+		"Default":     reflect.ValueOf(importer.Default),
+		"For":         reflect.ValueOf(importer.For),
+		"ForCompiler": reflect.ValueOf(importer.ForCompiler),
 
 		// type definitions
 		"Lookup": reflect.ValueOf((*importer.Lookup)(nil)),
@@ -0,0 +1,35 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package stdlib
+
+// Code generated by 'goexports go/parser'. DO NOT EDIT.
+
+import (
+	"go/parser"
+	"reflect"
+)
+
+func init() {
+	Symbols["go/parser"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AllErrors":            reflect.ValueOf(parser.AllErrors),
+		"DeclarationErrors":    reflect.ValueOf(parser.DeclarationErrors),
+		"ImportsOnly":          reflect.ValueOf(parser.ImportsOnly),
+		"PackageClauseOnly":    reflect.ValueOf(parser.PackageClauseOnly),
+		"ParseComments":        reflect.ValueOf(parser.ParseComments),
+		"ParseDir":             reflect.ValueOf(parser.ParseDir),
+		"ParseExpr":            reflect.ValueOf(parser.ParseExpr),
+		"ParseExprFrom":        reflect.ValueOf(parser.ParseExprFrom),
+		"ParseFile":            reflect.ValueOf(parser.ParseFile),
+		"SkipObjectResolution": reflect.ValueOf(parser.SkipObjectResolution),
+		"SpuriousErrors":       reflect.ValueOf(parser.SpuriousErrors),
+		"Trace":                reflect.ValueOf(parser.Trace),
+
+		// type definitions
+		"Mode": reflect.ValueOf((*parser.Mode)(nil)),
+
+		// interface wrapper definitions
+
+	}
+}
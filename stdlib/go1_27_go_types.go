@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -17,6 +18,7 @@ func init() {
 		"AssignableTo":            reflect.ValueOf(types.AssignableTo),
 		"Bool":                    reflect.ValueOf(types.Bool),
 		"Byte":                    reflect.ValueOf(types.Byte),
+		"CheckExpr":               reflect.ValueOf(types.CheckExpr),
 		"Comparable":              reflect.ValueOf(types.Comparable),
 		"Complex128":              reflect.ValueOf(types.Complex128),
 		"Complex64":               reflect.ValueOf(types.Complex64),
@@ -26,12 +28,14 @@ func init() {
 		"Eval":                    reflect.ValueOf(types.Eval),
 		"ExprString":              reflect.ValueOf(types.ExprString),
 		"FieldVal":                reflect.ValueOf(types.FieldVal),
+		"FieldVar":                reflect.ValueOf(types.FieldVar),
 		"Float32":                 reflect.ValueOf(types.Float32),
 		"Float64":                 reflect.ValueOf(types.Float64),
 		"Id":                      reflect.ValueOf(types.Id),
 		"Identical":               reflect.ValueOf(types.Identical),
 		"IdenticalIgnoreTags":     reflect.ValueOf(types.IdenticalIgnoreTags),
 		"Implements":              reflect.ValueOf(types.Implements),
+		"Instantiate":             reflect.ValueOf(types.Instantiate),
 		"Int":                     reflect.ValueOf(types.Int),
 		"Int16":                   reflect.ValueOf(types.Int16),
 		"Int32":                   reflect.ValueOf(types.Int32),
@@ -49,14 +53,18 @@ func init() {
 		"IsString":                reflect.ValueOf(types.IsString),
 		"IsUnsigned":              reflect.ValueOf(types.IsUnsigned),
 		"IsUntyped":               reflect.ValueOf(types.IsUntyped),
+		"LocalVar":                reflect.ValueOf(types.LocalVar),
 		"LookupFieldOrMethod":     reflect.ValueOf(types.LookupFieldOrMethod),
+		"LookupSelection":         reflect.ValueOf(types.LookupSelection),
 		"MethodExpr":              reflect.ValueOf(types.MethodExpr),
 		"MethodVal":               reflect.ValueOf(types.MethodVal),
 		"MissingMethod":           reflect.ValueOf(types.MissingMethod),
+		"NewAlias":                reflect.ValueOf(types.NewAlias),
 		"NewArray":                reflect.ValueOf(types.NewArray),
 		"NewChan":                 reflect.ValueOf(types.NewChan),
 		"NewChecker":              reflect.ValueOf(types.NewChecker),
 		"NewConst":                reflect.ValueOf(types.NewConst),
+		"NewContext":              reflect.ValueOf(types.NewContext),
 		"NewField":                reflect.ValueOf(types.NewField),
 		"NewFunc":                 reflect.ValueOf(types.NewFunc),
 		"NewInterface":            reflect.ValueOf(types.NewInterface),
@@ -71,15 +79,24 @@ func init() {
 		"NewPointer":              reflect.ValueOf(types.NewPointer),
 		"NewScope":                reflect.ValueOf(types.NewScope),
 		"NewSignature":            reflect.ValueOf(types.NewSignature),
+		"NewSignatureType":        reflect.ValueOf(types.NewSignatureType),
 		"NewSlice":                reflect.ValueOf(types.NewSlice),
 		"NewStruct":               reflect.ValueOf(types.NewStruct),
+		"NewTerm":                 reflect.ValueOf(types.NewTerm),
 		"NewTuple":                reflect.ValueOf(types.NewTuple),
 		"NewTypeName":             reflect.ValueOf(types.NewTypeName),
+		"NewTypeParam":            reflect.ValueOf(types.NewTypeParam),
+		"NewUnion":                reflect.ValueOf(types.NewUnion),
 		"NewVar":                  reflect.ValueOf(types.NewVar),
 		"ObjectString":            reflect.ValueOf(types.ObjectString),
+		"PackageVar":              reflect.ValueOf(types.PackageVar),
+		"ParamVar":                reflect.ValueOf(types.ParamVar),
 		"RecvOnly":                reflect.ValueOf(types.RecvOnly),
+		"RecvVar":                 reflect.ValueOf(types.RecvVar),
 		"RelativeTo":              reflect.ValueOf(types.RelativeTo),
+		"ResultVar":               reflect.ValueOf(types.ResultVar),
 		"Rune":                    reflect.ValueOf(types.Rune),
+		"Satisfies":               reflect.ValueOf(types.Satisfies),
 		"SelectionString":         reflect.ValueOf(types.SelectionString),
 		"SendOnly":                reflect.ValueOf(types.SendOnly),
 		"SendRecv":                reflect.ValueOf(types.SendRecv),
@@ -93,6 +110,7 @@ func init() {
 		"Uint64":                  reflect.ValueOf(types.Uint64),
 		"Uint8":                   reflect.ValueOf(types.Uint8),
 		"Uintptr":                 reflect.ValueOf(types.Uintptr),
+		"Unalias":                 reflect.ValueOf(types.Unalias),
 		"Universe":                reflect.ValueOf(&types.Universe).Elem(),
 		"Unsafe":                  reflect.ValueOf(&types.Unsafe).Elem(),
 		"UnsafePointer":           reflect.ValueOf(types.UnsafePointer),
@@ -108,47 +126,59 @@ func init() {
 		"WriteType":               reflect.ValueOf(types.WriteType),
 
 		// type definitions
-		"Array":         reflect.ValueOf((*types.Array)(nil)),
-		"Basic":         reflect.ValueOf((*types.Basic)(nil)),
-		"BasicInfo":     reflect.ValueOf((*types.BasicInfo)(nil)),
-		"BasicKind":     reflect.ValueOf((*types.BasicKind)(nil)),
-		"Builtin":       reflect.ValueOf((*types.Builtin)(nil)),
-		"Chan":          reflect.ValueOf((*types.Chan)(nil)),
-		"ChanDir":       reflect.ValueOf((*types.ChanDir)(nil)),
-		"Checker":       reflect.ValueOf((*types.Checker)(nil)),
-		"Config":        reflect.ValueOf((*types.Config)(nil)),
-		"Const":         reflect.ValueOf((*types.Const)(nil)),
-		"Error":         reflect.ValueOf((*types.Error)(nil)),
-		"Func":          reflect.ValueOf((*types.Func)(nil)),
-		"ImportMode":    reflect.ValueOf((*types.ImportMode)(nil)),
-		"Importer":      reflect.ValueOf((*types.Importer)(nil)),
-		"ImporterFrom":  reflect.ValueOf((*types.ImporterFrom)(nil)),
-		"Info":          reflect.ValueOf((*types.Info)(nil)),
-		"Initializer":   reflect.ValueOf((*types.Initializer)(nil)),
-		"Interface":     reflect.ValueOf((*types.Interface)(nil)),
-		"Label":         reflect.ValueOf((*types.Label)(nil)),
-		"Map":           reflect.ValueOf((*types.Map)(nil)),
-		"MethodSet":     reflect.ValueOf((*types.MethodSet)(nil)),
-		"Named":         reflect.ValueOf((*types.Named)(nil)),
-		"Nil":           reflect.ValueOf((*types.Nil)(nil)),
-		"Object":        reflect.ValueOf((*types.Object)(nil)),
-		"Package":       reflect.ValueOf((*types.Package)(nil)),
-		"PkgName":       reflect.ValueOf((*types.PkgName)(nil)),
-		"Pointer":       reflect.ValueOf((*types.Pointer)(nil)),
-		"Qualifier":     reflect.ValueOf((*types.Qualifier)(nil)),
-		"Scope":         reflect.ValueOf((*types.Scope)(nil)),
-		"Selection":     reflect.ValueOf((*types.Selection)(nil)),
-		"SelectionKind": reflect.ValueOf((*types.SelectionKind)(nil)),
-		"Signature":     reflect.ValueOf((*types.Signature)(nil)),
-		"Sizes":         reflect.ValueOf((*types.Sizes)(nil)),
-		"Slice":         reflect.ValueOf((*types.Slice)(nil)),
-		"StdSizes":      reflect.ValueOf((*types.StdSizes)(nil)),
-		"Struct":        reflect.ValueOf((*types.Struct)(nil)),
-		"Tuple":         reflect.ValueOf((*types.Tuple)(nil)),
-		"Type":          reflect.ValueOf((*types.Type)(nil)),
-		"TypeAndValue":  reflect.ValueOf((*types.TypeAndValue)(nil)),
-		"TypeName":      reflect.ValueOf((*types.TypeName)(nil)),
-		"Var":           reflect.ValueOf((*types.Var)(nil)),
+		"Alias":            reflect.ValueOf((*types.Alias)(nil)),
+		"ArgumentError":    reflect.ValueOf((*types.ArgumentError)(nil)),
+		"Array":            reflect.ValueOf((*types.Array)(nil)),
+		"Basic":            reflect.ValueOf((*types.Basic)(nil)),
+		"BasicInfo":        reflect.ValueOf((*types.BasicInfo)(nil)),
+		"BasicKind":        reflect.ValueOf((*types.BasicKind)(nil)),
+		"Builtin":          reflect.ValueOf((*types.Builtin)(nil)),
+		"Chan":             reflect.ValueOf((*types.Chan)(nil)),
+		"ChanDir":          reflect.ValueOf((*types.ChanDir)(nil)),
+		"Checker":          reflect.ValueOf((*types.Checker)(nil)),
+		"Config":           reflect.ValueOf((*types.Config)(nil)),
+		"Const":            reflect.ValueOf((*types.Const)(nil)),
+		"Context":          reflect.ValueOf((*types.Context)(nil)),
+		"Error":            reflect.ValueOf((*types.Error)(nil)),
+		"Func":             reflect.ValueOf((*types.Func)(nil)),
+		"Hasher":           reflect.ValueOf((*types.Hasher)(nil)),
+		"HasherIgnoreTags": reflect.ValueOf((*types.HasherIgnoreTags)(nil)),
+		"ImportMode":       reflect.ValueOf((*types.ImportMode)(nil)),
+		"Importer":         reflect.ValueOf((*types.Importer)(nil)),
+		"ImporterFrom":     reflect.ValueOf((*types.ImporterFrom)(nil)),
+		"Info":             reflect.ValueOf((*types.Info)(nil)),
+		"Initializer":      reflect.ValueOf((*types.Initializer)(nil)),
+		"Instance":         reflect.ValueOf((*types.Instance)(nil)),
+		"Interface":        reflect.ValueOf((*types.Interface)(nil)),
+		"Label":            reflect.ValueOf((*types.Label)(nil)),
+		"Map":              reflect.ValueOf((*types.Map)(nil)),
+		"MethodSet":        reflect.ValueOf((*types.MethodSet)(nil)),
+		"Named":            reflect.ValueOf((*types.Named)(nil)),
+		"Nil":              reflect.ValueOf((*types.Nil)(nil)),
+		"Object":           reflect.ValueOf((*types.Object)(nil)),
+		"Package":          reflect.ValueOf((*types.Package)(nil)),
+		"PkgName":          reflect.ValueOf((*types.PkgName)(nil)),
+		"Pointer":          reflect.ValueOf((*types.Pointer)(nil)),
+		"Qualifier":        reflect.ValueOf((*types.Qualifier)(nil)),
+		"Scope":            reflect.ValueOf((*types.Scope)(nil)),
+		"Selection":        reflect.ValueOf((*types.Selection)(nil)),
+		"SelectionKind":    reflect.ValueOf((*types.SelectionKind)(nil)),
+		"Signature":        reflect.ValueOf((*types.Signature)(nil)),
+		"Sizes":            reflect.ValueOf((*types.Sizes)(nil)),
+		"Slice":            reflect.ValueOf((*types.Slice)(nil)),
+		"StdSizes":         reflect.ValueOf((*types.StdSizes)(nil)),
+		"Struct":           reflect.ValueOf((*types.Struct)(nil)),
+		"Term":             reflect.ValueOf((*types.Term)(nil)),
+		"Tuple":            reflect.ValueOf((*types.Tuple)(nil)),
+		"Type":             reflect.ValueOf((*types.Type)(nil)),
+		"TypeAndValue":     reflect.ValueOf((*types.TypeAndValue)(nil)),
+		"TypeList":         reflect.ValueOf((*types.TypeList)(nil)),
+		"TypeName":         reflect.ValueOf((*types.TypeName)(nil)),
+		"TypeParam":        reflect.ValueOf((*types.TypeParam)(nil)),
+		"TypeParamList":    reflect.ValueOf((*types.TypeParamList)(nil)),
+		"Union":            reflect.ValueOf((*types.Union)(nil)),
+		"Var":              reflect.ValueOf((*types.Var)(nil)),
+		"VarKind":          reflect.ValueOf((*types.VarKind)(nil)),
 
 		// interface wrapper definitions
 		"_Importer":     reflect.ValueOf((*_go_types_Importer)(nil)),
@@ -179,6 +209,7 @@ func (W _go_types_ImporterFrom) ImportFrom(path string, dir string, mode types.I
 
 // _go_types_Object is an interface wrapper for Object type
 type _go_types_Object struct {
+	types.Object
 	WExported func() bool
 	WId       func() string
 	WName     func() string
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_nohtml
+// +build go1.27,!go1.28,!yaegi_nohtml
 
 package stdlib
 
@@ -16,6 +17,7 @@ func init() {
 		"ErrBadHTML":           reflect.ValueOf(template.ErrBadHTML),
 		"ErrBranchEnd":         reflect.ValueOf(template.ErrBranchEnd),
 		"ErrEndContext":        reflect.ValueOf(template.ErrEndContext),
+		"ErrJSTemplate":        reflect.ValueOf(template.ErrJSTemplate),
 		"ErrNoSuchTemplate":    reflect.ValueOf(template.ErrNoSuchTemplate),
 		"ErrOutputContext":     reflect.ValueOf(template.ErrOutputContext),
 		"ErrPartialCharset":    reflect.ValueOf(template.ErrPartialCharset),
@@ -33,6 +35,7 @@ func init() {
 		"Must":                 reflect.ValueOf(template.Must),
 		"New":                  reflect.ValueOf(template.New),
 		"OK":                   reflect.ValueOf(template.OK),
+		"ParseFS":              reflect.ValueOf(template.ParseFS),
 		"ParseFiles":           reflect.ValueOf(template.ParseFiles),
 		"ParseGlob":            reflect.ValueOf(template.ParseGlob),
 		"URLQueryEscaper":      reflect.ValueOf(template.URLQueryEscaper),
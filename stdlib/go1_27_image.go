@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28 && !yaegi_noimage
+// +build go1.27,!go1.28,!yaegi_noimage
 
 package stdlib
 
@@ -61,6 +62,7 @@ func init() {
 		"Point":               reflect.ValueOf((*image.Point)(nil)),
 		"RGBA":                reflect.ValueOf((*image.RGBA)(nil)),
 		"RGBA64":              reflect.ValueOf((*image.RGBA64)(nil)),
+		"RGBA64Image":         reflect.ValueOf((*image.RGBA64Image)(nil)),
 		"Rectangle":           reflect.ValueOf((*image.Rectangle)(nil)),
 		"Uniform":             reflect.ValueOf((*image.Uniform)(nil)),
 		"YCbCr":               reflect.ValueOf((*image.YCbCr)(nil)),
@@ -69,6 +71,7 @@ func init() {
 		// interface wrapper definitions
 		"_Image":         reflect.ValueOf((*_image_Image)(nil)),
 		"_PalettedImage": reflect.ValueOf((*_image_PalettedImage)(nil)),
+		"_RGBA64Image":   reflect.ValueOf((*_image_RGBA64Image)(nil)),
 	}
 }
 
@@ -95,3 +98,16 @@ func (W _image_PalettedImage) At(x int, y int) color.Color     { return W.WAt(x,
 func (W _image_PalettedImage) Bounds() image.Rectangle         { return W.WBounds() }
 func (W _image_PalettedImage) ColorIndexAt(x int, y int) uint8 { return W.WColorIndexAt(x, y) }
 func (W _image_PalettedImage) ColorModel() color.Model         { return W.WColorModel() }
+
+// _image_RGBA64Image is an interface wrapper for RGBA64Image type
+type _image_RGBA64Image struct {
+	WAt         func(x int, y int) color.Color
+	WBounds     func() image.Rectangle
+	WColorModel func() color.Model
+	WRGBA64At   func(x int, y int) color.RGBA64
+}
+
+func (W _image_RGBA64Image) At(x int, y int) color.Color        { return W.WAt(x, y) }
+func (W _image_RGBA64Image) Bounds() image.Rectangle            { return W.WBounds() }
+func (W _image_RGBA64Image) ColorModel() color.Model            { return W.WColorModel() }
+func (W _image_RGBA64Image) RGBA64At(x int, y int) color.RGBA64 { return W.WRGBA64At(x, y) }
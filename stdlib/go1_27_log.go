@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -12,6 +13,7 @@ import (
 func init() {
 	Symbols["log"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"Default":       reflect.ValueOf(log.Default),
 		"Fatal":         reflect.ValueOf(log.Fatal),
 		"Fatalf":        reflect.ValueOf(log.Fatalf),
 		"Fatalln":       reflect.ValueOf(log.Fatalln),
@@ -20,6 +22,7 @@ func init() {
 		"Ldate":         reflect.ValueOf(log.Ldate),
 		"Llongfile":     reflect.ValueOf(log.Llongfile),
 		"Lmicroseconds": reflect.ValueOf(log.Lmicroseconds),
+		"Lmsgprefix":    reflect.ValueOf(log.Lmsgprefix),
 		"Lshortfile":    reflect.ValueOf(log.Lshortfile),
 		"LstdFlags":     reflect.ValueOf(log.LstdFlags),
 		"Ltime":         reflect.ValueOf(log.Ltime),
@@ -35,6 +38,7 @@ func init() {
 		"SetFlags":      reflect.ValueOf(log.SetFlags),
 		"SetOutput":     reflect.ValueOf(log.SetOutput),
 		"SetPrefix":     reflect.ValueOf(log.SetPrefix),
+		"Writer":        reflect.ValueOf(log.Writer),
 
 		// type definitions
 		"Logger": reflect.ValueOf((*log.Logger)(nil)),
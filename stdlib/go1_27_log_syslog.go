@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13,!windows,!nacl,!plan9
+//go:build go1.27 && !go1.28 && !windows && !nacl && !plan9 && !yaegi_nonet
+// +build go1.27,!go1.28,!windows,!nacl,!plan9,!yaegi_nonet
 
 package stdlib
 
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -15,7 +16,9 @@ func init() {
 		"Above":         reflect.ValueOf(big.Above),
 		"AwayFromZero":  reflect.ValueOf(big.AwayFromZero),
 		"Below":         reflect.ValueOf(big.Below),
+		"Ceil":          reflect.ValueOf(big.Ceil),
 		"Exact":         reflect.ValueOf(big.Exact),
+		"Floor":         reflect.ValueOf(big.Floor),
 		"Jacobi":        reflect.ValueOf(big.Jacobi),
 		"MaxBase":       reflect.ValueOf(big.MaxBase),
 		"MaxExp":        reflect.ValueOf(big.MaxExp),
@@ -25,11 +28,13 @@ func init() {
 		"NewInt":        reflect.ValueOf(big.NewInt),
 		"NewRat":        reflect.ValueOf(big.NewRat),
 		"ParseFloat":    reflect.ValueOf(big.ParseFloat),
+		"Round":         reflect.ValueOf(big.Round),
 		"ToNearestAway": reflect.ValueOf(big.ToNearestAway),
 		"ToNearestEven": reflect.ValueOf(big.ToNearestEven),
 		"ToNegativeInf": reflect.ValueOf(big.ToNegativeInf),
 		"ToPositiveInf": reflect.ValueOf(big.ToPositiveInf),
 		"ToZero":        reflect.ValueOf(big.ToZero),
+		"Trunc":         reflect.ValueOf(big.Trunc),
 
 		// type definitions
 		"Accuracy":     reflect.ValueOf((*big.Accuracy)(nil)),
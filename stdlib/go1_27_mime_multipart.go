@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nomime
+// +build go1.27,!go1.28,!yaegi_nomime
 
 package stdlib
 
@@ -12,9 +13,10 @@ import (
 func init() {
 	Symbols["mime/multipart"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"ErrMessageTooLarge": reflect.ValueOf(&multipart.ErrMessageTooLarge).Elem(),
-		"NewReader":          reflect.ValueOf(multipart.NewReader),
-		"NewWriter":          reflect.ValueOf(multipart.NewWriter),
+		"ErrMessageTooLarge":     reflect.ValueOf(&multipart.ErrMessageTooLarge).Elem(),
+		"FileContentDisposition": reflect.ValueOf(multipart.FileContentDisposition),
+		"NewReader":              reflect.ValueOf(multipart.NewReader),
+		"NewWriter":              reflect.ValueOf(multipart.NewWriter),
 
 		// type definitions
 		"File":       reflect.ValueOf((*multipart.File)(nil)),
@@ -37,9 +39,11 @@ type _mime_multipart_File struct {
 	WSeek   func(offset int64, whence int) (int64, error)
 }
 
-func (W _mime_multipart_File) Close() error                                  { return W.WClose() }
-func (W _mime_multipart_File) Read(p []byte) (n int, err error)              { return W.WRead(p) }
-func (W _mime_multipart_File) ReadAt(p []byte, off int64) (n int, err error) { return W.WReadAt(p, off) }
+func (W _mime_multipart_File) Close() error                     { return W.WClose() }
+func (W _mime_multipart_File) Read(p []byte) (n int, err error) { return W.WRead(p) }
+func (W _mime_multipart_File) ReadAt(p []byte, off int64) (n int, err error) {
+	return W.WReadAt(p, off)
+}
 func (W _mime_multipart_File) Seek(offset int64, whence int) (int64, error) {
 	return W.WSeek(offset, whence)
 }
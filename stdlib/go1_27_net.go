@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nonet
+// +build go1.27,!go1.28,!yaegi_nonet
 
 package stdlib
 
@@ -21,6 +22,7 @@ func init() {
 		"DialTimeout":                reflect.ValueOf(net.DialTimeout),
 		"DialUDP":                    reflect.ValueOf(net.DialUDP),
 		"DialUnix":                   reflect.ValueOf(net.DialUnix),
+		"ErrClosed":                  reflect.ValueOf(&net.ErrClosed).Elem(),
 		"ErrWriteToConnected":        reflect.ValueOf(&net.ErrWriteToConnected).Elem(),
 		"FileConn":                   reflect.ValueOf(net.FileConn),
 		"FileListener":               reflect.ValueOf(net.FileListener),
@@ -29,6 +31,7 @@ func init() {
 		"FlagLoopback":               reflect.ValueOf(net.FlagLoopback),
 		"FlagMulticast":              reflect.ValueOf(net.FlagMulticast),
 		"FlagPointToPoint":           reflect.ValueOf(net.FlagPointToPoint),
+		"FlagRunning":                reflect.ValueOf(net.FlagRunning),
 		"FlagUp":                     reflect.ValueOf(net.FlagUp),
 		"IPv4":                       reflect.ValueOf(net.IPv4),
 		"IPv4Mask":                   reflect.ValueOf(net.IPv4Mask),
@@ -75,6 +78,8 @@ func init() {
 		"ResolveUDPAddr":             reflect.ValueOf(net.ResolveUDPAddr),
 		"ResolveUnixAddr":            reflect.ValueOf(net.ResolveUnixAddr),
 		"SplitHostPort":              reflect.ValueOf(net.SplitHostPort),
+		"TCPAddrFromAddrPort":        reflect.ValueOf(net.TCPAddrFromAddrPort),
+		"UDPAddrFromAddrPort":        reflect.ValueOf(net.UDPAddrFromAddrPort),
 
 		// type definitions
 		"Addr":                reflect.ValueOf((*net.Addr)(nil)),
@@ -94,6 +99,7 @@ func init() {
 		"IPNet":               reflect.ValueOf((*net.IPNet)(nil)),
 		"Interface":           reflect.ValueOf((*net.Interface)(nil)),
 		"InvalidAddrError":    reflect.ValueOf((*net.InvalidAddrError)(nil)),
+		"KeepAliveConfig":     reflect.ValueOf((*net.KeepAliveConfig)(nil)),
 		"ListenConfig":        reflect.ValueOf((*net.ListenConfig)(nil)),
 		"Listener":            reflect.ValueOf((*net.Listener)(nil)),
 		"MX":                  reflect.ValueOf((*net.MX)(nil)),
@@ -0,0 +1,32 @@
+//go:build go1.27 && !go1.28 && !yaegi_nonet
+// +build go1.27,!go1.28,!yaegi_nonet
+
+package stdlib
+
+// Code generated by 'goexports net/http/httptest'. DO NOT EDIT.
+
+import (
+	"net/http/httptest"
+	"reflect"
+)
+
+func init() {
+	Symbols["net/http/httptest"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"DefaultRemoteAddr":     reflect.ValueOf(httptest.DefaultRemoteAddr),
+		"NewRecorder":           reflect.ValueOf(httptest.NewRecorder),
+		"NewRequest":            reflect.ValueOf(httptest.NewRequest),
+		"NewRequestWithContext": reflect.ValueOf(httptest.NewRequestWithContext),
+		"NewServer":             reflect.ValueOf(httptest.NewServer),
+		"NewTLSServer":          reflect.ValueOf(httptest.NewTLSServer),
+		"NewTestServer":         reflect.ValueOf(httptest.NewTestServer),
+		"NewUnstartedServer":    reflect.ValueOf(httptest.NewUnstartedServer),
+
+		// type definitions
+		"ResponseRecorder": reflect.ValueOf((*httptest.ResponseRecorder)(nil)),
+		"Server":           reflect.ValueOf((*httptest.Server)(nil)),
+
+		// interface wrapper definitions
+
+	}
+}
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28 && !yaegi_nonet
+// +build go1.27,!go1.28,!yaegi_nonet
 
 package stdlib
 
@@ -12,6 +13,7 @@ import (
 func init() {
 	Symbols["net/url"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"JoinPath":        reflect.ValueOf(url.JoinPath),
 		"Parse":           reflect.ValueOf(url.Parse),
 		"ParseQuery":      reflect.ValueOf(url.ParseQuery),
 		"ParseRequestURI": reflect.ValueOf(url.ParseRequestURI),
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -14,7 +15,9 @@ func init() {
 		// function, constant and variable definitions
 		"Command":        reflect.ValueOf(exec.Command),
 		"CommandContext": reflect.ValueOf(exec.CommandContext),
+		"ErrDot":         reflect.ValueOf(&exec.ErrDot).Elem(),
 		"ErrNotFound":    reflect.ValueOf(&exec.ErrNotFound).Elem(),
+		"ErrWaitDelay":   reflect.ValueOf(&exec.ErrWaitDelay).Elem(),
 		"LookPath":       reflect.ValueOf(exec.LookPath),
 
 		// type definitions
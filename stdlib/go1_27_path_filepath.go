@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -23,17 +24,21 @@ func init() {
 		"Glob":          reflect.ValueOf(filepath.Glob),
 		"HasPrefix":     reflect.ValueOf(filepath.HasPrefix),
 		"IsAbs":         reflect.ValueOf(filepath.IsAbs),
+		"IsLocal":       reflect.ValueOf(filepath.IsLocal),
 		"Join":          reflect.ValueOf(filepath.Join),
 		"ListSeparator": reflect.ValueOf(filepath.ListSeparator),
+		"Localize":      reflect.ValueOf(filepath.Localize),
 		"Match":         reflect.ValueOf(filepath.Match),
 		"Rel":           reflect.ValueOf(filepath.Rel),
 		"Separator":     reflect.ValueOf(filepath.Separator),
+		"SkipAll":       reflect.ValueOf(&filepath.SkipAll).Elem(),
 		"SkipDir":       reflect.ValueOf(&filepath.SkipDir).Elem(),
 		"Split":         reflect.ValueOf(filepath.Split),
 		"SplitList":     reflect.ValueOf(filepath.SplitList),
 		"ToSlash":       reflect.ValueOf(filepath.ToSlash),
 		"VolumeName":    reflect.ValueOf(filepath.VolumeName),
 		"Walk":          reflect.ValueOf(filepath.Walk),
+		"WalkDir":       reflect.ValueOf(filepath.WalkDir),
 
 		// type definitions
 		"WalkFunc": reflect.ValueOf((*filepath.WalkFunc)(nil)),
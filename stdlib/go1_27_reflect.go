@@ -1,10 +1,12 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
 // Code generated by 'goexports reflect'. DO NOT EDIT.
 
 import (
+	"iter"
 	"reflect"
 )
 
@@ -44,6 +46,8 @@ func init() {
 		"MapOf":           reflect.ValueOf(reflect.MapOf),
 		"New":             reflect.ValueOf(reflect.New),
 		"NewAt":           reflect.ValueOf(reflect.NewAt),
+		"Pointer":         reflect.ValueOf(reflect.Pointer),
+		"PointerTo":       reflect.ValueOf(reflect.PointerTo),
 		"Ptr":             reflect.ValueOf(reflect.Ptr),
 		"PtrTo":           reflect.ValueOf(reflect.PtrTo),
 		"RecvDir":         reflect.ValueOf(reflect.RecvDir),
@@ -53,6 +57,7 @@ func init() {
 		"SelectSend":      reflect.ValueOf(reflect.SelectSend),
 		"SendDir":         reflect.ValueOf(reflect.SendDir),
 		"Slice":           reflect.ValueOf(reflect.Slice),
+		"SliceAt":         reflect.ValueOf(reflect.SliceAt),
 		"SliceOf":         reflect.ValueOf(reflect.SliceOf),
 		"String":          reflect.ValueOf(reflect.String),
 		"Struct":          reflect.ValueOf(reflect.Struct),
@@ -67,11 +72,13 @@ func init() {
 		"Uintptr":         reflect.ValueOf(reflect.Uintptr),
 		"UnsafePointer":   reflect.ValueOf(reflect.UnsafePointer),
 		"ValueOf":         reflect.ValueOf(reflect.ValueOf),
+		"VisibleFields":   reflect.ValueOf(reflect.VisibleFields),
 		"Zero":            reflect.ValueOf(reflect.Zero),
 
 		// type definitions
 		"ChanDir":      reflect.ValueOf((*reflect.ChanDir)(nil)),
 		"Kind":         reflect.ValueOf((*reflect.Kind)(nil)),
+		"MapIter":      reflect.ValueOf((*reflect.MapIter)(nil)),
 		"Method":       reflect.ValueOf((*reflect.Method)(nil)),
 		"SelectCase":   reflect.ValueOf((*reflect.SelectCase)(nil)),
 		"SelectDir":    reflect.ValueOf((*reflect.SelectDir)(nil)),
@@ -90,9 +97,12 @@ func init() {
 
 // _reflect_Type is an interface wrapper for Type type
 type _reflect_Type struct {
+	reflect.Type
 	WAlign           func() int
 	WAssignableTo    func(u reflect.Type) bool
 	WBits            func() int
+	WCanSeq          func() bool
+	WCanSeq2         func() bool
 	WChanDir         func() reflect.ChanDir
 	WComparable      func() bool
 	WConvertibleTo   func(u reflect.Type) bool
@@ -102,20 +112,28 @@ type _reflect_Type struct {
 	WFieldByIndex    func(index []int) reflect.StructField
 	WFieldByName     func(name string) (reflect.StructField, bool)
 	WFieldByNameFunc func(match func(string) bool) (reflect.StructField, bool)
+	WFields          func() iter.Seq[reflect.StructField]
 	WImplements      func(u reflect.Type) bool
 	WIn              func(i int) reflect.Type
+	WIns             func() iter.Seq[reflect.Type]
 	WIsVariadic      func() bool
 	WKey             func() reflect.Type
 	WKind            func() reflect.Kind
 	WLen             func() int
 	WMethod          func(a0 int) reflect.Method
 	WMethodByName    func(a0 string) (reflect.Method, bool)
+	WMethods         func() iter.Seq[reflect.Method]
 	WName            func() string
 	WNumField        func() int
 	WNumIn           func() int
 	WNumMethod       func() int
 	WNumOut          func() int
 	WOut             func(i int) reflect.Type
+	WOuts            func() iter.Seq[reflect.Type]
+	WOverflowComplex func(x complex128) bool
+	WOverflowFloat   func(x float64) bool
+	WOverflowInt     func(x int64) bool
+	WOverflowUint    func(x uint64) bool
 	WPkgPath         func() string
 	WSize            func() uintptr
 	WString          func() string
@@ -124,6 +142,8 @@ type _reflect_Type struct {
 func (W _reflect_Type) Align() int                                   { return W.WAlign() }
 func (W _reflect_Type) AssignableTo(u reflect.Type) bool             { return W.WAssignableTo(u) }
 func (W _reflect_Type) Bits() int                                    { return W.WBits() }
+func (W _reflect_Type) CanSeq() bool                                 { return W.WCanSeq() }
+func (W _reflect_Type) CanSeq2() bool                                { return W.WCanSeq2() }
 func (W _reflect_Type) ChanDir() reflect.ChanDir                     { return W.WChanDir() }
 func (W _reflect_Type) Comparable() bool                             { return W.WComparable() }
 func (W _reflect_Type) ConvertibleTo(u reflect.Type) bool            { return W.WConvertibleTo(u) }
@@ -137,20 +157,28 @@ func (W _reflect_Type) FieldByName(name string) (reflect.StructField, bool) {
 func (W _reflect_Type) FieldByNameFunc(match func(string) bool) (reflect.StructField, bool) {
 	return W.WFieldByNameFunc(match)
 }
+func (W _reflect_Type) Fields() iter.Seq[reflect.StructField]         { return W.WFields() }
 func (W _reflect_Type) Implements(u reflect.Type) bool                { return W.WImplements(u) }
 func (W _reflect_Type) In(i int) reflect.Type                         { return W.WIn(i) }
+func (W _reflect_Type) Ins() iter.Seq[reflect.Type]                   { return W.WIns() }
 func (W _reflect_Type) IsVariadic() bool                              { return W.WIsVariadic() }
 func (W _reflect_Type) Key() reflect.Type                             { return W.WKey() }
 func (W _reflect_Type) Kind() reflect.Kind                            { return W.WKind() }
 func (W _reflect_Type) Len() int                                      { return W.WLen() }
 func (W _reflect_Type) Method(a0 int) reflect.Method                  { return W.WMethod(a0) }
 func (W _reflect_Type) MethodByName(a0 string) (reflect.Method, bool) { return W.WMethodByName(a0) }
+func (W _reflect_Type) Methods() iter.Seq[reflect.Method]             { return W.WMethods() }
 func (W _reflect_Type) Name() string                                  { return W.WName() }
 func (W _reflect_Type) NumField() int                                 { return W.WNumField() }
 func (W _reflect_Type) NumIn() int                                    { return W.WNumIn() }
 func (W _reflect_Type) NumMethod() int                                { return W.WNumMethod() }
 func (W _reflect_Type) NumOut() int                                   { return W.WNumOut() }
 func (W _reflect_Type) Out(i int) reflect.Type                        { return W.WOut(i) }
+func (W _reflect_Type) Outs() iter.Seq[reflect.Type]                  { return W.WOuts() }
+func (W _reflect_Type) OverflowComplex(x complex128) bool             { return W.WOverflowComplex(x) }
+func (W _reflect_Type) OverflowFloat(x float64) bool                  { return W.WOverflowFloat(x) }
+func (W _reflect_Type) OverflowInt(x int64) bool                      { return W.WOverflowInt(x) }
+func (W _reflect_Type) OverflowUint(x uint64) bool                    { return W.WOverflowUint(x) }
 func (W _reflect_Type) PkgPath() string                               { return W.WPkgPath() }
 func (W _reflect_Type) Size() uintptr                                 { return W.WSize() }
 func (W _reflect_Type) String() string                                { return W.WString() }
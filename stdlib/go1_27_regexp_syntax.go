@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -31,9 +32,11 @@ func init() {
 		"ErrInvalidRepeatOp":       reflect.ValueOf(syntax.ErrInvalidRepeatOp),
 		"ErrInvalidRepeatSize":     reflect.ValueOf(syntax.ErrInvalidRepeatSize),
 		"ErrInvalidUTF8":           reflect.ValueOf(syntax.ErrInvalidUTF8),
+		"ErrLarge":                 reflect.ValueOf(syntax.ErrLarge),
 		"ErrMissingBracket":        reflect.ValueOf(syntax.ErrMissingBracket),
 		"ErrMissingParen":          reflect.ValueOf(syntax.ErrMissingParen),
 		"ErrMissingRepeatArgument": reflect.ValueOf(syntax.ErrMissingRepeatArgument),
+		"ErrNestingDepth":          reflect.ValueOf(syntax.ErrNestingDepth),
 		"ErrTrailingBackslash":     reflect.ValueOf(syntax.ErrTrailingBackslash),
 		"ErrUnexpectedParen":       reflect.ValueOf(syntax.ErrUnexpectedParen),
 		"FoldCase":                 reflect.ValueOf(syntax.FoldCase),
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package stdlib
 
@@ -17,8 +18,11 @@ func init() {
 		"NewIdentifier":  reflect.ValueOf(parse.NewIdentifier),
 		"NodeAction":     reflect.ValueOf(parse.NodeAction),
 		"NodeBool":       reflect.ValueOf(parse.NodeBool),
+		"NodeBreak":      reflect.ValueOf(parse.NodeBreak),
 		"NodeChain":      reflect.ValueOf(parse.NodeChain),
 		"NodeCommand":    reflect.ValueOf(parse.NodeCommand),
+		"NodeComment":    reflect.ValueOf(parse.NodeComment),
+		"NodeContinue":   reflect.ValueOf(parse.NodeContinue),
 		"NodeDot":        reflect.ValueOf(parse.NodeDot),
 		"NodeField":      reflect.ValueOf(parse.NodeField),
 		"NodeIdentifier": reflect.ValueOf(parse.NodeIdentifier),
@@ -34,18 +38,24 @@ func init() {
 		"NodeVariable":   reflect.ValueOf(parse.NodeVariable),
 		"NodeWith":       reflect.ValueOf(parse.NodeWith),
 		"Parse":          reflect.ValueOf(parse.Parse),
+		"ParseComments":  reflect.ValueOf(parse.ParseComments),
+		"SkipFuncCheck":  reflect.ValueOf(parse.SkipFuncCheck),
 
 		// type definitions
 		"ActionNode":     reflect.ValueOf((*parse.ActionNode)(nil)),
 		"BoolNode":       reflect.ValueOf((*parse.BoolNode)(nil)),
 		"BranchNode":     reflect.ValueOf((*parse.BranchNode)(nil)),
+		"BreakNode":      reflect.ValueOf((*parse.BreakNode)(nil)),
 		"ChainNode":      reflect.ValueOf((*parse.ChainNode)(nil)),
 		"CommandNode":    reflect.ValueOf((*parse.CommandNode)(nil)),
+		"CommentNode":    reflect.ValueOf((*parse.CommentNode)(nil)),
+		"ContinueNode":   reflect.ValueOf((*parse.ContinueNode)(nil)),
 		"DotNode":        reflect.ValueOf((*parse.DotNode)(nil)),
 		"FieldNode":      reflect.ValueOf((*parse.FieldNode)(nil)),
 		"IdentifierNode": reflect.ValueOf((*parse.IdentifierNode)(nil)),
 		"IfNode":         reflect.ValueOf((*parse.IfNode)(nil)),
 		"ListNode":       reflect.ValueOf((*parse.ListNode)(nil)),
+		"Mode":           reflect.ValueOf((*parse.Mode)(nil)),
 		"NilNode":        reflect.ValueOf((*parse.NilNode)(nil)),
 		"Node":           reflect.ValueOf((*parse.Node)(nil)),
 		"NodeType":       reflect.ValueOf((*parse.NodeType)(nil)),
@@ -67,6 +77,7 @@ func init() {
 
 // _text_template_parse_Node is an interface wrapper for Node type
 type _text_template_parse_Node struct {
+	parse.Node
 	WCopy     func() parse.Node
 	WPosition func() parse.Pos
 	WString   func() string
@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports container/list'. DO NOT EDIT.
 
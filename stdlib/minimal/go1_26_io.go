@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports io'. DO NOT EDIT.
 
@@ -15,6 +16,7 @@ func init() {
 		"Copy":             reflect.ValueOf(io.Copy),
 		"CopyBuffer":       reflect.ValueOf(io.CopyBuffer),
 		"CopyN":            reflect.ValueOf(io.CopyN),
+		"Discard":          reflect.ValueOf(&io.Discard).Elem(),
 		"EOF":              reflect.ValueOf(&io.EOF).Elem(),
 		"ErrClosedPipe":    reflect.ValueOf(&io.ErrClosedPipe).Elem(),
 		"ErrNoProgress":    reflect.ValueOf(&io.ErrNoProgress).Elem(),
@@ -24,8 +26,11 @@ func init() {
 		"LimitReader":      reflect.ValueOf(io.LimitReader),
 		"MultiReader":      reflect.ValueOf(io.MultiReader),
 		"MultiWriter":      reflect.ValueOf(io.MultiWriter),
+		"NewOffsetWriter":  reflect.ValueOf(io.NewOffsetWriter),
 		"NewSectionReader": reflect.ValueOf(io.NewSectionReader),
+		"NopCloser":        reflect.ValueOf(io.NopCloser),
 		"Pipe":             reflect.ValueOf(io.Pipe),
+		"ReadAll":          reflect.ValueOf(io.ReadAll),
 		"ReadAtLeast":      reflect.ValueOf(io.ReadAtLeast),
 		"ReadFull":         reflect.ValueOf(io.ReadFull),
 		"SeekCurrent":      reflect.ValueOf(io.SeekCurrent),
@@ -40,9 +45,11 @@ func init() {
 		"ByteWriter":      reflect.ValueOf((*io.ByteWriter)(nil)),
 		"Closer":          reflect.ValueOf((*io.Closer)(nil)),
 		"LimitedReader":   reflect.ValueOf((*io.LimitedReader)(nil)),
+		"OffsetWriter":    reflect.ValueOf((*io.OffsetWriter)(nil)),
 		"PipeReader":      reflect.ValueOf((*io.PipeReader)(nil)),
 		"PipeWriter":      reflect.ValueOf((*io.PipeWriter)(nil)),
 		"ReadCloser":      reflect.ValueOf((*io.ReadCloser)(nil)),
+		"ReadSeekCloser":  reflect.ValueOf((*io.ReadSeekCloser)(nil)),
 		"ReadSeeker":      reflect.ValueOf((*io.ReadSeeker)(nil)),
 		"ReadWriteCloser": reflect.ValueOf((*io.ReadWriteCloser)(nil)),
 		"ReadWriteSeeker": reflect.ValueOf((*io.ReadWriteSeeker)(nil)),
@@ -67,6 +74,7 @@ func init() {
 		"_ByteWriter":      reflect.ValueOf((*_io_ByteWriter)(nil)),
 		"_Closer":          reflect.ValueOf((*_io_Closer)(nil)),
 		"_ReadCloser":      reflect.ValueOf((*_io_ReadCloser)(nil)),
+		"_ReadSeekCloser":  reflect.ValueOf((*_io_ReadSeekCloser)(nil)),
 		"_ReadSeeker":      reflect.ValueOf((*_io_ReadSeeker)(nil)),
 		"_ReadWriteCloser": reflect.ValueOf((*_io_ReadWriteCloser)(nil)),
 		"_ReadWriteSeeker": reflect.ValueOf((*_io_ReadWriteSeeker)(nil)),
@@ -125,16 +133,27 @@ type _io_ReadCloser struct {
 func (W _io_ReadCloser) Close() error                     { return W.WClose() }
 func (W _io_ReadCloser) Read(p []byte) (n int, err error) { return W.WRead(p) }
 
+// _io_ReadSeekCloser is an interface wrapper for ReadSeekCloser type
+type _io_ReadSeekCloser struct {
+	WClose func() error
+	WRead  func(p []byte) (n int, err error)
+	WSeek  func(offset int64, whence int) (int64, error)
+}
+
+func (W _io_ReadSeekCloser) Close() error                     { return W.WClose() }
+func (W _io_ReadSeekCloser) Read(p []byte) (n int, err error) { return W.WRead(p) }
+func (W _io_ReadSeekCloser) Seek(offset int64, whence int) (int64, error) {
+	return W.WSeek(offset, whence)
+}
+
 // _io_ReadSeeker is an interface wrapper for ReadSeeker type
 type _io_ReadSeeker struct {
 	WRead func(p []byte) (n int, err error)
 	WSeek func(offset int64, whence int) (int64, error)
 }
 
-func (W _io_ReadSeeker) Read(p []byte) (n int, err error) { return W.WRead(p) }
-func (W _io_ReadSeeker) Seek(offset int64, whence int) (int64, error) {
-	return W.WSeek(offset, whence)
-}
+func (W _io_ReadSeeker) Read(p []byte) (n int, err error)             { return W.WRead(p) }
+func (W _io_ReadSeeker) Seek(offset int64, whence int) (int64, error) { return W.WSeek(offset, whence) }
 
 // _io_ReadWriteCloser is an interface wrapper for ReadWriteCloser type
 type _io_ReadWriteCloser struct {
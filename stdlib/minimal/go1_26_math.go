@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports math'. DO NOT EDIT.
 
@@ -34,6 +35,7 @@ func init() {
 		"Exp":                    reflect.ValueOf(math.Exp),
 		"Exp2":                   reflect.ValueOf(math.Exp2),
 		"Expm1":                  reflect.ValueOf(math.Expm1),
+		"FMA":                    reflect.ValueOf(math.FMA),
 		"Float32bits":            reflect.ValueOf(math.Float32bits),
 		"Float32frombits":        reflect.ValueOf(math.Float32frombits),
 		"Float64bits":            reflect.ValueOf(math.Float64bits),
@@ -63,15 +65,18 @@ func init() {
 		"Max":                    reflect.ValueOf(math.Max),
 		"MaxFloat32":             reflect.ValueOf(math.MaxFloat32),
 		"MaxFloat64":             reflect.ValueOf(math.MaxFloat64),
+		"MaxInt":                 reflect.ValueOf(int64(math.MaxInt)),
 		"MaxInt16":               reflect.ValueOf(math.MaxInt16),
 		"MaxInt32":               reflect.ValueOf(math.MaxInt32),
 		"MaxInt64":               reflect.ValueOf(int64(math.MaxInt64)),
 		"MaxInt8":                reflect.ValueOf(math.MaxInt8),
+		"MaxUint":                reflect.ValueOf(uint64(math.MaxUint)),
 		"MaxUint16":              reflect.ValueOf(math.MaxUint16),
 		"MaxUint32":              reflect.ValueOf(uint32(math.MaxUint32)),
 		"MaxUint64":              reflect.ValueOf(uint64(math.MaxUint64)),
 		"MaxUint8":               reflect.ValueOf(math.MaxUint8),
 		"Min":                    reflect.ValueOf(math.Min),
+		"MinInt":                 reflect.ValueOf(int64(math.MinInt)),
 		"MinInt16":               reflect.ValueOf(math.MinInt16),
 		"MinInt32":               reflect.ValueOf(math.MinInt32),
 		"MinInt64":               reflect.ValueOf(int64(math.MinInt64)),
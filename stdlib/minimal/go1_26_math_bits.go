@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports math/bits'. DO NOT EDIT.
 
@@ -36,6 +37,9 @@ func init() {
 		"OnesCount32":     reflect.ValueOf(bits.OnesCount32),
 		"OnesCount64":     reflect.ValueOf(bits.OnesCount64),
 		"OnesCount8":      reflect.ValueOf(bits.OnesCount8),
+		"Rem":             reflect.ValueOf(bits.Rem),
+		"Rem32":           reflect.ValueOf(bits.Rem32),
+		"Rem64":           reflect.ValueOf(bits.Rem64),
 		"Reverse":         reflect.ValueOf(bits.Reverse),
 		"Reverse16":       reflect.ValueOf(bits.Reverse16),
 		"Reverse32":       reflect.ValueOf(bits.Reverse32),
@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports sort'. DO NOT EDIT.
 
@@ -12,6 +13,7 @@ import (
 func init() {
 	Symbols["sort"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"Find":              reflect.ValueOf(sort.Find),
 		"Float64s":          reflect.ValueOf(sort.Float64s),
 		"Float64sAreSorted": reflect.ValueOf(sort.Float64sAreSorted),
 		"Ints":              reflect.ValueOf(sort.Ints),
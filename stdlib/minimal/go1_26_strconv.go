@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports strconv'. DO NOT EDIT.
 
@@ -27,6 +28,7 @@ func init() {
 		"ErrRange":                 reflect.ValueOf(&strconv.ErrRange).Elem(),
 		"ErrSyntax":                reflect.ValueOf(&strconv.ErrSyntax).Elem(),
 		"FormatBool":               reflect.ValueOf(strconv.FormatBool),
+		"FormatComplex":            reflect.ValueOf(strconv.FormatComplex),
 		"FormatFloat":              reflect.ValueOf(strconv.FormatFloat),
 		"FormatInt":                reflect.ValueOf(strconv.FormatInt),
 		"FormatUint":               reflect.ValueOf(strconv.FormatUint),
@@ -35,6 +37,7 @@ func init() {
 		"IsPrint":                  reflect.ValueOf(strconv.IsPrint),
 		"Itoa":                     reflect.ValueOf(strconv.Itoa),
 		"ParseBool":                reflect.ValueOf(strconv.ParseBool),
+		"ParseComplex":             reflect.ValueOf(strconv.ParseComplex),
 		"ParseFloat":               reflect.ValueOf(strconv.ParseFloat),
 		"ParseInt":                 reflect.ValueOf(strconv.ParseInt),
 		"ParseUint":                reflect.ValueOf(strconv.ParseUint),
@@ -44,6 +47,7 @@ func init() {
 		"QuoteRuneToGraphic":       reflect.ValueOf(strconv.QuoteRuneToGraphic),
 		"QuoteToASCII":             reflect.ValueOf(strconv.QuoteToASCII),
 		"QuoteToGraphic":           reflect.ValueOf(strconv.QuoteToGraphic),
+		"QuotedPrefix":             reflect.ValueOf(strconv.QuotedPrefix),
 		"Unquote":                  reflect.ValueOf(strconv.Unquote),
 		"UnquoteChar":              reflect.ValueOf(strconv.UnquoteChar),
 
@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports strings'. DO NOT EDIT.
 
@@ -12,14 +13,21 @@ import (
 func init() {
 	Symbols["strings"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"Clone":          reflect.ValueOf(strings.Clone),
 		"Compare":        reflect.ValueOf(strings.Compare),
 		"Contains":       reflect.ValueOf(strings.Contains),
 		"ContainsAny":    reflect.ValueOf(strings.ContainsAny),
+		"ContainsFunc":   reflect.ValueOf(strings.ContainsFunc),
 		"ContainsRune":   reflect.ValueOf(strings.ContainsRune),
 		"Count":          reflect.ValueOf(strings.Count),
+		"Cut":            reflect.ValueOf(strings.Cut),
+		"CutPrefix":      reflect.ValueOf(strings.CutPrefix),
+		"CutSuffix":      reflect.ValueOf(strings.CutSuffix),
 		"EqualFold":      reflect.ValueOf(strings.EqualFold),
 		"Fields":         reflect.ValueOf(strings.Fields),
 		"FieldsFunc":     reflect.ValueOf(strings.FieldsFunc),
+		"FieldsFuncSeq":  reflect.ValueOf(strings.FieldsFuncSeq),
+		"FieldsSeq":      reflect.ValueOf(strings.FieldsSeq),
 		"HasPrefix":      reflect.ValueOf(strings.HasPrefix),
 		"HasSuffix":      reflect.ValueOf(strings.HasSuffix),
 		"Index":          reflect.ValueOf(strings.Index),
@@ -32,6 +40,7 @@ func init() {
 		"LastIndexAny":   reflect.ValueOf(strings.LastIndexAny),
 		"LastIndexByte":  reflect.ValueOf(strings.LastIndexByte),
 		"LastIndexFunc":  reflect.ValueOf(strings.LastIndexFunc),
+		"Lines":          reflect.ValueOf(strings.Lines),
 		"Map":            reflect.ValueOf(strings.Map),
 		"NewReader":      reflect.ValueOf(strings.NewReader),
 		"NewReplacer":    reflect.ValueOf(strings.NewReplacer),
@@ -41,7 +50,9 @@ func init() {
 		"Split":          reflect.ValueOf(strings.Split),
 		"SplitAfter":     reflect.ValueOf(strings.SplitAfter),
 		"SplitAfterN":    reflect.ValueOf(strings.SplitAfterN),
+		"SplitAfterSeq":  reflect.ValueOf(strings.SplitAfterSeq),
 		"SplitN":         reflect.ValueOf(strings.SplitN),
+		"SplitSeq":       reflect.ValueOf(strings.SplitSeq),
 		"Title":          reflect.ValueOf(strings.Title),
 		"ToLower":        reflect.ValueOf(strings.ToLower),
 		"ToLowerSpecial": reflect.ValueOf(strings.ToLowerSpecial),
@@ -49,6 +60,7 @@ func init() {
 		"ToTitleSpecial": reflect.ValueOf(strings.ToTitleSpecial),
 		"ToUpper":        reflect.ValueOf(strings.ToUpper),
 		"ToUpperSpecial": reflect.ValueOf(strings.ToUpperSpecial),
+		"ToValidUTF8":    reflect.ValueOf(strings.ToValidUTF8),
 		"Trim":           reflect.ValueOf(strings.Trim),
 		"TrimFunc":       reflect.ValueOf(strings.TrimFunc),
 		"TrimLeft":       reflect.ValueOf(strings.TrimLeft),
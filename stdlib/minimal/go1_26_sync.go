@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports sync'. DO NOT EDIT.
 
@@ -12,7 +13,8 @@ import (
 func init() {
 	Symbols["sync"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"NewCond": reflect.ValueOf(sync.NewCond),
+		"NewCond":  reflect.ValueOf(sync.NewCond),
+		"OnceFunc": reflect.ValueOf(sync.OnceFunc),
 
 		// type definitions
 		"Cond":      reflect.ValueOf((*sync.Cond)(nil)),
@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports time'. DO NOT EDIT.
 
@@ -18,6 +19,8 @@ func init() {
 		"April":                  reflect.ValueOf(time.April),
 		"August":                 reflect.ValueOf(time.August),
 		"Date":                   reflect.ValueOf(time.Date),
+		"DateOnly":               reflect.ValueOf(time.DateOnly),
+		"DateTime":               reflect.ValueOf(time.DateTime),
 		"December":               reflect.ValueOf(time.December),
 		"February":               reflect.ValueOf(time.February),
 		"FixedZone":              reflect.ValueOf(time.FixedZone),
@@ -27,6 +30,7 @@ func init() {
 		"July":                   reflect.ValueOf(time.July),
 		"June":                   reflect.ValueOf(time.June),
 		"Kitchen":                reflect.ValueOf(time.Kitchen),
+		"Layout":                 reflect.ValueOf(time.Layout),
 		"LoadLocation":           reflect.ValueOf(time.LoadLocation),
 		"LoadLocationFromTZData": reflect.ValueOf(time.LoadLocationFromTZData),
 		"Local":                  reflect.ValueOf(&time.Local).Elem(),
@@ -65,10 +69,13 @@ func init() {
 		"Sunday":                 reflect.ValueOf(time.Sunday),
 		"Thursday":               reflect.ValueOf(time.Thursday),
 		"Tick":                   reflect.ValueOf(time.Tick),
+		"TimeOnly":               reflect.ValueOf(time.TimeOnly),
 		"Tuesday":                reflect.ValueOf(time.Tuesday),
 		"UTC":                    reflect.ValueOf(&time.UTC).Elem(),
 		"Unix":                   reflect.ValueOf(time.Unix),
 		"UnixDate":               reflect.ValueOf(time.UnixDate),
+		"UnixMicro":              reflect.ValueOf(time.UnixMicro),
+		"UnixMilli":              reflect.ValueOf(time.UnixMilli),
 		"Until":                  reflect.ValueOf(time.Until),
 		"Wednesday":              reflect.ValueOf(time.Wednesday),
 
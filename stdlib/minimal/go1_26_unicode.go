@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports unicode'. DO NOT EDIT.
 
@@ -37,18 +38,22 @@ func init() {
 		"Carian":                             reflect.ValueOf(&unicode.Carian).Elem(),
 		"CaseRanges":                         reflect.ValueOf(&unicode.CaseRanges).Elem(),
 		"Categories":                         reflect.ValueOf(&unicode.Categories).Elem(),
+		"CategoryAliases":                    reflect.ValueOf(&unicode.CategoryAliases).Elem(),
 		"Caucasian_Albanian":                 reflect.ValueOf(&unicode.Caucasian_Albanian).Elem(),
 		"Cc":                                 reflect.ValueOf(&unicode.Cc).Elem(),
 		"Cf":                                 reflect.ValueOf(&unicode.Cf).Elem(),
 		"Chakma":                             reflect.ValueOf(&unicode.Chakma).Elem(),
 		"Cham":                               reflect.ValueOf(&unicode.Cham).Elem(),
 		"Cherokee":                           reflect.ValueOf(&unicode.Cherokee).Elem(),
+		"Chorasmian":                         reflect.ValueOf(&unicode.Chorasmian).Elem(),
+		"Cn":                                 reflect.ValueOf(&unicode.Cn).Elem(),
 		"Co":                                 reflect.ValueOf(&unicode.Co).Elem(),
 		"Common":                             reflect.ValueOf(&unicode.Common).Elem(),
 		"Coptic":                             reflect.ValueOf(&unicode.Coptic).Elem(),
 		"Cs":                                 reflect.ValueOf(&unicode.Cs).Elem(),
 		"Cuneiform":                          reflect.ValueOf(&unicode.Cuneiform).Elem(),
 		"Cypriot":                            reflect.ValueOf(&unicode.Cypriot).Elem(),
+		"Cypro_Minoan":                       reflect.ValueOf(&unicode.Cypro_Minoan).Elem(),
 		"Cyrillic":                           reflect.ValueOf(&unicode.Cyrillic).Elem(),
 		"Dash":                               reflect.ValueOf(&unicode.Dash).Elem(),
 		"Deprecated":                         reflect.ValueOf(&unicode.Deprecated).Elem(),
@@ -56,9 +61,12 @@ func init() {
 		"Devanagari":                         reflect.ValueOf(&unicode.Devanagari).Elem(),
 		"Diacritic":                          reflect.ValueOf(&unicode.Diacritic).Elem(),
 		"Digit":                              reflect.ValueOf(&unicode.Digit).Elem(),
+		"Dives_Akuru":                        reflect.ValueOf(&unicode.Dives_Akuru).Elem(),
+		"Dogra":                              reflect.ValueOf(&unicode.Dogra).Elem(),
 		"Duployan":                           reflect.ValueOf(&unicode.Duployan).Elem(),
 		"Egyptian_Hieroglyphs":               reflect.ValueOf(&unicode.Egyptian_Hieroglyphs).Elem(),
 		"Elbasan":                            reflect.ValueOf(&unicode.Elbasan).Elem(),
+		"Elymaic":                            reflect.ValueOf(&unicode.Elymaic).Elem(),
 		"Ethiopic":                           reflect.ValueOf(&unicode.Ethiopic).Elem(),
 		"Extender":                           reflect.ValueOf(&unicode.Extender).Elem(),
 		"FoldCategory":                       reflect.ValueOf(&unicode.FoldCategory).Elem(),
@@ -70,9 +78,11 @@ func init() {
 		"GraphicRanges":                      reflect.ValueOf(&unicode.GraphicRanges).Elem(),
 		"Greek":                              reflect.ValueOf(&unicode.Greek).Elem(),
 		"Gujarati":                           reflect.ValueOf(&unicode.Gujarati).Elem(),
+		"Gunjala_Gondi":                      reflect.ValueOf(&unicode.Gunjala_Gondi).Elem(),
 		"Gurmukhi":                           reflect.ValueOf(&unicode.Gurmukhi).Elem(),
 		"Han":                                reflect.ValueOf(&unicode.Han).Elem(),
 		"Hangul":                             reflect.ValueOf(&unicode.Hangul).Elem(),
+		"Hanifi_Rohingya":                    reflect.ValueOf(&unicode.Hanifi_Rohingya).Elem(),
 		"Hanunoo":                            reflect.ValueOf(&unicode.Hanunoo).Elem(),
 		"Hatran":                             reflect.ValueOf(&unicode.Hatran).Elem(),
 		"Hebrew":                             reflect.ValueOf(&unicode.Hebrew).Elem(),
@@ -107,12 +117,15 @@ func init() {
 		"Kaithi":                             reflect.ValueOf(&unicode.Kaithi).Elem(),
 		"Kannada":                            reflect.ValueOf(&unicode.Kannada).Elem(),
 		"Katakana":                           reflect.ValueOf(&unicode.Katakana).Elem(),
+		"Kawi":                               reflect.ValueOf(&unicode.Kawi).Elem(),
 		"Kayah_Li":                           reflect.ValueOf(&unicode.Kayah_Li).Elem(),
 		"Kharoshthi":                         reflect.ValueOf(&unicode.Kharoshthi).Elem(),
+		"Khitan_Small_Script":                reflect.ValueOf(&unicode.Khitan_Small_Script).Elem(),
 		"Khmer":                              reflect.ValueOf(&unicode.Khmer).Elem(),
 		"Khojki":                             reflect.ValueOf(&unicode.Khojki).Elem(),
 		"Khudawadi":                          reflect.ValueOf(&unicode.Khudawadi).Elem(),
 		"L":                                  reflect.ValueOf(&unicode.L).Elem(),
+		"LC":                                 reflect.ValueOf(&unicode.LC).Elem(),
 		"Lao":                                reflect.ValueOf(&unicode.Lao).Elem(),
 		"Latin":                              reflect.ValueOf(&unicode.Latin).Elem(),
 		"Lepcha":                             reflect.ValueOf(&unicode.Lepcha).Elem(),
@@ -133,6 +146,7 @@ func init() {
 		"Lydian":                             reflect.ValueOf(&unicode.Lydian).Elem(),
 		"M":                                  reflect.ValueOf(&unicode.M).Elem(),
 		"Mahajani":                           reflect.ValueOf(&unicode.Mahajani).Elem(),
+		"Makasar":                            reflect.ValueOf(&unicode.Makasar).Elem(),
 		"Malayalam":                          reflect.ValueOf(&unicode.Malayalam).Elem(),
 		"Mandaic":                            reflect.ValueOf(&unicode.Mandaic).Elem(),
 		"Manichaean":                         reflect.ValueOf(&unicode.Manichaean).Elem(),
@@ -145,6 +159,7 @@ func init() {
 		"MaxRune":                            reflect.ValueOf(unicode.MaxRune),
 		"Mc":                                 reflect.ValueOf(&unicode.Mc).Elem(),
 		"Me":                                 reflect.ValueOf(&unicode.Me).Elem(),
+		"Medefaidrin":                        reflect.ValueOf(&unicode.Medefaidrin).Elem(),
 		"Meetei_Mayek":                       reflect.ValueOf(&unicode.Meetei_Mayek).Elem(),
 		"Mende_Kikakui":                      reflect.ValueOf(&unicode.Mende_Kikakui).Elem(),
 		"Meroitic_Cursive":                   reflect.ValueOf(&unicode.Meroitic_Cursive).Elem(),
@@ -158,6 +173,8 @@ func init() {
 		"Myanmar":                            reflect.ValueOf(&unicode.Myanmar).Elem(),
 		"N":                                  reflect.ValueOf(&unicode.N).Elem(),
 		"Nabataean":                          reflect.ValueOf(&unicode.Nabataean).Elem(),
+		"Nag_Mundari":                        reflect.ValueOf(&unicode.Nag_Mundari).Elem(),
+		"Nandinagari":                        reflect.ValueOf(&unicode.Nandinagari).Elem(),
 		"Nd":                                 reflect.ValueOf(&unicode.Nd).Elem(),
 		"New_Tai_Lue":                        reflect.ValueOf(&unicode.New_Tai_Lue).Elem(),
 		"Newa":                               reflect.ValueOf(&unicode.Newa).Elem(),
@@ -167,6 +184,7 @@ func init() {
 		"Noncharacter_Code_Point":            reflect.ValueOf(&unicode.Noncharacter_Code_Point).Elem(),
 		"Number":                             reflect.ValueOf(&unicode.Number).Elem(),
 		"Nushu":                              reflect.ValueOf(&unicode.Nushu).Elem(),
+		"Nyiakeng_Puachue_Hmong":             reflect.ValueOf(&unicode.Nyiakeng_Puachue_Hmong).Elem(),
 		"Ogham":                              reflect.ValueOf(&unicode.Ogham).Elem(),
 		"Ol_Chiki":                           reflect.ValueOf(&unicode.Ol_Chiki).Elem(),
 		"Old_Hungarian":                      reflect.ValueOf(&unicode.Old_Hungarian).Elem(),
@@ -174,8 +192,10 @@ func init() {
 		"Old_North_Arabian":                  reflect.ValueOf(&unicode.Old_North_Arabian).Elem(),
 		"Old_Permic":                         reflect.ValueOf(&unicode.Old_Permic).Elem(),
 		"Old_Persian":                        reflect.ValueOf(&unicode.Old_Persian).Elem(),
+		"Old_Sogdian":                        reflect.ValueOf(&unicode.Old_Sogdian).Elem(),
 		"Old_South_Arabian":                  reflect.ValueOf(&unicode.Old_South_Arabian).Elem(),
 		"Old_Turkic":                         reflect.ValueOf(&unicode.Old_Turkic).Elem(),
+		"Old_Uyghur":                         reflect.ValueOf(&unicode.Old_Uyghur).Elem(),
 		"Oriya":                              reflect.ValueOf(&unicode.Oriya).Elem(),
 		"Osage":                              reflect.ValueOf(&unicode.Osage).Elem(),
 		"Osmanya":                            reflect.ValueOf(&unicode.Osmanya).Elem(),
@@ -231,6 +251,7 @@ func init() {
 		"Sm":                                 reflect.ValueOf(&unicode.Sm).Elem(),
 		"So":                                 reflect.ValueOf(&unicode.So).Elem(),
 		"Soft_Dotted":                        reflect.ValueOf(&unicode.Soft_Dotted).Elem(),
+		"Sogdian":                            reflect.ValueOf(&unicode.Sogdian).Elem(),
 		"Sora_Sompeng":                       reflect.ValueOf(&unicode.Sora_Sompeng).Elem(),
 		"Soyombo":                            reflect.ValueOf(&unicode.Soyombo).Elem(),
 		"Space":                              reflect.ValueOf(&unicode.Space).Elem(),
@@ -245,6 +266,7 @@ func init() {
 		"Tai_Viet":                           reflect.ValueOf(&unicode.Tai_Viet).Elem(),
 		"Takri":                              reflect.ValueOf(&unicode.Takri).Elem(),
 		"Tamil":                              reflect.ValueOf(&unicode.Tamil).Elem(),
+		"Tangsa":                             reflect.ValueOf(&unicode.Tangsa).Elem(),
 		"Tangut":                             reflect.ValueOf(&unicode.Tangut).Elem(),
 		"Telugu":                             reflect.ValueOf(&unicode.Telugu).Elem(),
 		"Terminal_Punctuation":               reflect.ValueOf(&unicode.Terminal_Punctuation).Elem(),
@@ -259,6 +281,7 @@ func init() {
 		"ToLower":                            reflect.ValueOf(unicode.ToLower),
 		"ToTitle":                            reflect.ValueOf(unicode.ToTitle),
 		"ToUpper":                            reflect.ValueOf(unicode.ToUpper),
+		"Toto":                               reflect.ValueOf(&unicode.Toto).Elem(),
 		"TurkishCase":                        reflect.ValueOf(&unicode.TurkishCase).Elem(),
 		"Ugaritic":                           reflect.ValueOf(&unicode.Ugaritic).Elem(),
 		"Unified_Ideograph":                  reflect.ValueOf(&unicode.Unified_Ideograph).Elem(),
@@ -268,8 +291,11 @@ func init() {
 		"Vai":                                reflect.ValueOf(&unicode.Vai).Elem(),
 		"Variation_Selector":                 reflect.ValueOf(&unicode.Variation_Selector).Elem(),
 		"Version":                            reflect.ValueOf(unicode.Version),
+		"Vithkuqi":                           reflect.ValueOf(&unicode.Vithkuqi).Elem(),
+		"Wancho":                             reflect.ValueOf(&unicode.Wancho).Elem(),
 		"Warang_Citi":                        reflect.ValueOf(&unicode.Warang_Citi).Elem(),
 		"White_Space":                        reflect.ValueOf(&unicode.White_Space).Elem(),
+		"Yezidi":                             reflect.ValueOf(&unicode.Yezidi).Elem(),
 		"Yi":                                 reflect.ValueOf(&unicode.Yi).Elem(),
 		"Z":                                  reflect.ValueOf(&unicode.Z).Elem(),
 		"Zanabazar_Square":                   reflect.ValueOf(&unicode.Zanabazar_Square).Elem(),
@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports unicode/utf16'. DO NOT EDIT.
 
@@ -12,11 +13,13 @@ import (
 func init() {
 	Symbols["unicode/utf16"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"AppendRune":  reflect.ValueOf(utf16.AppendRune),
 		"Decode":      reflect.ValueOf(utf16.Decode),
 		"DecodeRune":  reflect.ValueOf(utf16.DecodeRune),
 		"Encode":      reflect.ValueOf(utf16.Encode),
 		"EncodeRune":  reflect.ValueOf(utf16.EncodeRune),
 		"IsSurrogate": reflect.ValueOf(utf16.IsSurrogate),
+		"RuneLen":     reflect.ValueOf(utf16.RuneLen),
 
 		// type definitions
 
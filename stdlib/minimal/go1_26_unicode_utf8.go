@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports unicode/utf8'. DO NOT EDIT.
 
@@ -12,6 +13,7 @@ import (
 func init() {
 	Symbols["unicode/utf8"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"AppendRune":             reflect.ValueOf(utf8.AppendRune),
 		"DecodeLastRune":         reflect.ValueOf(utf8.DecodeLastRune),
 		"DecodeLastRuneInString": reflect.ValueOf(utf8.DecodeLastRuneInString),
 		"DecodeRune":             reflect.ValueOf(utf8.DecodeRune),
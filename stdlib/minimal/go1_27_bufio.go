@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports bufio'. DO NOT EDIT.
 
@@ -13,6 +14,7 @@ func init() {
 	Symbols["bufio"] = map[string]reflect.Value{
 		// function, constant and variable definitions
 		"ErrAdvanceTooFar":     reflect.ValueOf(&bufio.ErrAdvanceTooFar).Elem(),
+		"ErrBadReadCount":      reflect.ValueOf(&bufio.ErrBadReadCount).Elem(),
 		"ErrBufferFull":        reflect.ValueOf(&bufio.ErrBufferFull).Elem(),
 		"ErrFinalToken":        reflect.ValueOf(&bufio.ErrFinalToken).Elem(),
 		"ErrInvalidUnreadByte": reflect.ValueOf(&bufio.ErrInvalidUnreadByte).Elem(),
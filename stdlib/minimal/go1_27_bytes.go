@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports bytes'. DO NOT EDIT.
 
@@ -12,16 +13,24 @@ import (
 func init() {
 	Symbols["bytes"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"Clone":           reflect.ValueOf(bytes.Clone),
 		"Compare":         reflect.ValueOf(bytes.Compare),
 		"Contains":        reflect.ValueOf(bytes.Contains),
 		"ContainsAny":     reflect.ValueOf(bytes.ContainsAny),
+		"ContainsFunc":    reflect.ValueOf(bytes.ContainsFunc),
 		"ContainsRune":    reflect.ValueOf(bytes.ContainsRune),
 		"Count":           reflect.ValueOf(bytes.Count),
+		"Cut":             reflect.ValueOf(bytes.Cut),
+		"CutLast":         reflect.ValueOf(bytes.CutLast),
+		"CutPrefix":       reflect.ValueOf(bytes.CutPrefix),
+		"CutSuffix":       reflect.ValueOf(bytes.CutSuffix),
 		"Equal":           reflect.ValueOf(bytes.Equal),
 		"EqualFold":       reflect.ValueOf(bytes.EqualFold),
 		"ErrTooLarge":     reflect.ValueOf(&bytes.ErrTooLarge).Elem(),
 		"Fields":          reflect.ValueOf(bytes.Fields),
 		"FieldsFunc":      reflect.ValueOf(bytes.FieldsFunc),
+		"FieldsFuncSeq":   reflect.ValueOf(bytes.FieldsFuncSeq),
+		"FieldsSeq":       reflect.ValueOf(bytes.FieldsSeq),
 		"HasPrefix":       reflect.ValueOf(bytes.HasPrefix),
 		"HasSuffix":       reflect.ValueOf(bytes.HasSuffix),
 		"Index":           reflect.ValueOf(bytes.Index),
@@ -34,6 +43,7 @@ func init() {
 		"LastIndexAny":    reflect.ValueOf(bytes.LastIndexAny),
 		"LastIndexByte":   reflect.ValueOf(bytes.LastIndexByte),
 		"LastIndexFunc":   reflect.ValueOf(bytes.LastIndexFunc),
+		"Lines":           reflect.ValueOf(bytes.Lines),
 		"Map":             reflect.ValueOf(bytes.Map),
 		"MinRead":         reflect.ValueOf(bytes.MinRead),
 		"NewBuffer":       reflect.ValueOf(bytes.NewBuffer),
@@ -41,11 +51,14 @@ func init() {
 		"NewReader":       reflect.ValueOf(bytes.NewReader),
 		"Repeat":          reflect.ValueOf(bytes.Repeat),
 		"Replace":         reflect.ValueOf(bytes.Replace),
+		"ReplaceAll":      reflect.ValueOf(bytes.ReplaceAll),
 		"Runes":           reflect.ValueOf(bytes.Runes),
 		"Split":           reflect.ValueOf(bytes.Split),
 		"SplitAfter":      reflect.ValueOf(bytes.SplitAfter),
 		"SplitAfterN":     reflect.ValueOf(bytes.SplitAfterN),
+		"SplitAfterSeq":   reflect.ValueOf(bytes.SplitAfterSeq),
 		"SplitN":          reflect.ValueOf(bytes.SplitN),
+		"SplitSeq":        reflect.ValueOf(bytes.SplitSeq),
 		"Title":           reflect.ValueOf(bytes.Title),
 		"ToLower":         reflect.ValueOf(bytes.ToLower),
 		"ToLowerSpecial":  reflect.ValueOf(bytes.ToLowerSpecial),
@@ -53,6 +66,7 @@ func init() {
 		"ToTitleSpecial":  reflect.ValueOf(bytes.ToTitleSpecial),
 		"ToUpper":         reflect.ValueOf(bytes.ToUpper),
 		"ToUpperSpecial":  reflect.ValueOf(bytes.ToUpperSpecial),
+		"ToValidUTF8":     reflect.ValueOf(bytes.ToValidUTF8),
 		"Trim":            reflect.ValueOf(bytes.Trim),
 		"TrimFunc":        reflect.ValueOf(bytes.TrimFunc),
 		"TrimLeft":        reflect.ValueOf(bytes.TrimLeft),
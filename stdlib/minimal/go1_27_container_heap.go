@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports container/heap'. DO NOT EDIT.
 
@@ -30,13 +31,13 @@ func init() {
 type _container_heap_Interface struct {
 	WLen  func() int
 	WLess func(i int, j int) bool
-	WPop  func() interface{}
-	WPush func(x interface{})
+	WPop  func() any
+	WPush func(x any)
 	WSwap func(i int, j int)
 }
 
 func (W _container_heap_Interface) Len() int               { return W.WLen() }
 func (W _container_heap_Interface) Less(i int, j int) bool { return W.WLess(i, j) }
-func (W _container_heap_Interface) Pop() interface{}       { return W.WPop() }
-func (W _container_heap_Interface) Push(x interface{})     { W.WPush(x) }
+func (W _container_heap_Interface) Pop() any               { return W.WPop() }
+func (W _container_heap_Interface) Push(x any)             { W.WPush(x) }
 func (W _container_heap_Interface) Swap(i int, j int)      { W.WSwap(i, j) }
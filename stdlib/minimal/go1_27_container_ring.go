@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports container/ring'. DO NOT EDIT.
 
@@ -0,0 +1,28 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package minimal
+
+// Code generated by 'goexports errors'. DO NOT EDIT.
+
+import (
+	"errors"
+	"reflect"
+)
+
+func init() {
+	Symbols["errors"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"As":             reflect.ValueOf(errors.As),
+		"ErrUnsupported": reflect.ValueOf(&errors.ErrUnsupported).Elem(),
+		"Is":             reflect.ValueOf(errors.Is),
+		"Join":           reflect.ValueOf(errors.Join),
+		"New":            reflect.ValueOf(errors.New),
+		"Unwrap":         reflect.ValueOf(errors.Unwrap),
+
+		// type definitions
+
+		// interface wrapper definitions
+
+	}
+}
@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports fmt'. DO NOT EDIT.
 
@@ -12,25 +13,29 @@ import (
 func init() {
 	Symbols["fmt"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"Errorf":   reflect.ValueOf(fmt.Errorf),
-		"Fprint":   reflect.ValueOf(fmt.Fprint),
-		"Fprintf":  reflect.ValueOf(fmt.Fprintf),
-		"Fprintln": reflect.ValueOf(fmt.Fprintln),
-		"Fscan":    reflect.ValueOf(fmt.Fscan),
-		"Fscanf":   reflect.ValueOf(fmt.Fscanf),
-		"Fscanln":  reflect.ValueOf(fmt.Fscanln),
-		"Print":    reflect.ValueOf(fmt.Print),
-		"Printf":   reflect.ValueOf(fmt.Printf),
-		"Println":  reflect.ValueOf(fmt.Println),
-		"Scan":     reflect.ValueOf(fmt.Scan),
-		"Scanf":    reflect.ValueOf(fmt.Scanf),
-		"Scanln":   reflect.ValueOf(fmt.Scanln),
-		"Sprint":   reflect.ValueOf(fmt.Sprint),
-		"Sprintf":  reflect.ValueOf(fmt.Sprintf),
-		"Sprintln": reflect.ValueOf(fmt.Sprintln),
-		"Sscan":    reflect.ValueOf(fmt.Sscan),
-		"Sscanf":   reflect.ValueOf(fmt.Sscanf),
-		"Sscanln":  reflect.ValueOf(fmt.Sscanln),
+		"Append":       reflect.ValueOf(fmt.Append),
+		"Appendf":      reflect.ValueOf(fmt.Appendf),
+		"Appendln":     reflect.ValueOf(fmt.Appendln),
+		"Errorf":       reflect.ValueOf(fmt.Errorf),
+		"FormatString": reflect.ValueOf(fmt.FormatString),
+		"Fprint":       reflect.ValueOf(fmt.Fprint),
+		"Fprintf":      reflect.ValueOf(fmt.Fprintf),
+		"Fprintln":     reflect.ValueOf(fmt.Fprintln),
+		"Fscan":        reflect.ValueOf(fmt.Fscan),
+		"Fscanf":       reflect.ValueOf(fmt.Fscanf),
+		"Fscanln":      reflect.ValueOf(fmt.Fscanln),
+		"Print":        reflect.ValueOf(fmt.Print),
+		"Printf":       reflect.ValueOf(fmt.Printf),
+		"Println":      reflect.ValueOf(fmt.Println),
+		"Scan":         reflect.ValueOf(fmt.Scan),
+		"Scanf":        reflect.ValueOf(fmt.Scanf),
+		"Scanln":       reflect.ValueOf(fmt.Scanln),
+		"Sprint":       reflect.ValueOf(fmt.Sprint),
+		"Sprintf":      reflect.ValueOf(fmt.Sprintf),
+		"Sprintln":     reflect.ValueOf(fmt.Sprintln),
+		"Sscan":        reflect.ValueOf(fmt.Sscan),
+		"Sscanf":       reflect.ValueOf(fmt.Sscanf),
+		"Sscanln":      reflect.ValueOf(fmt.Sscanln),
 
 		// type definitions
 		"Formatter":  reflect.ValueOf((*fmt.Formatter)(nil)),
@@ -52,10 +57,10 @@ func init() {
 
 // _fmt_Formatter is an interface wrapper for Formatter type
 type _fmt_Formatter struct {
-	WFormat func(f fmt.State, c rune)
+	WFormat func(f fmt.State, verb rune)
 }
 
-func (W _fmt_Formatter) Format(f fmt.State, c rune) { W.WFormat(f, c) }
+func (W _fmt_Formatter) Format(f fmt.State, verb rune) { W.WFormat(f, verb) }
 
 // _fmt_GoStringer is an interface wrapper for GoStringer type
 type _fmt_GoStringer struct {
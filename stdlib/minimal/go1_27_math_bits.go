@@ -1,6 +1,7 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports math/bits'. DO NOT EDIT.
 
@@ -12,6 +13,12 @@ import (
 func init() {
 	Symbols["math/bits"] = map[string]reflect.Value{
 		// function, constant and variable definitions
+		"Add":             reflect.ValueOf(bits.Add),
+		"Add32":           reflect.ValueOf(bits.Add32),
+		"Add64":           reflect.ValueOf(bits.Add64),
+		"Div":             reflect.ValueOf(bits.Div),
+		"Div32":           reflect.ValueOf(bits.Div32),
+		"Div64":           reflect.ValueOf(bits.Div64),
 		"LeadingZeros":    reflect.ValueOf(bits.LeadingZeros),
 		"LeadingZeros16":  reflect.ValueOf(bits.LeadingZeros16),
 		"LeadingZeros32":  reflect.ValueOf(bits.LeadingZeros32),
@@ -22,11 +29,17 @@ func init() {
 		"Len32":           reflect.ValueOf(bits.Len32),
 		"Len64":           reflect.ValueOf(bits.Len64),
 		"Len8":            reflect.ValueOf(bits.Len8),
+		"Mul":             reflect.ValueOf(bits.Mul),
+		"Mul32":           reflect.ValueOf(bits.Mul32),
+		"Mul64":           reflect.ValueOf(bits.Mul64),
 		"OnesCount":       reflect.ValueOf(bits.OnesCount),
 		"OnesCount16":     reflect.ValueOf(bits.OnesCount16),
 		"OnesCount32":     reflect.ValueOf(bits.OnesCount32),
 		"OnesCount64":     reflect.ValueOf(bits.OnesCount64),
 		"OnesCount8":      reflect.ValueOf(bits.OnesCount8),
+		"Rem":             reflect.ValueOf(bits.Rem),
+		"Rem32":           reflect.ValueOf(bits.Rem32),
+		"Rem64":           reflect.ValueOf(bits.Rem64),
 		"Reverse":         reflect.ValueOf(bits.Reverse),
 		"Reverse16":       reflect.ValueOf(bits.Reverse16),
 		"Reverse32":       reflect.ValueOf(bits.Reverse32),
@@ -41,6 +54,9 @@ func init() {
 		"RotateLeft32":    reflect.ValueOf(bits.RotateLeft32),
 		"RotateLeft64":    reflect.ValueOf(bits.RotateLeft64),
 		"RotateLeft8":     reflect.ValueOf(bits.RotateLeft8),
+		"Sub":             reflect.ValueOf(bits.Sub),
+		"Sub32":           reflect.ValueOf(bits.Sub32),
+		"Sub64":           reflect.ValueOf(bits.Sub64),
 		"TrailingZeros":   reflect.ValueOf(bits.TrailingZeros),
 		"TrailingZeros16": reflect.ValueOf(bits.TrailingZeros16),
 		"TrailingZeros32": reflect.ValueOf(bits.TrailingZeros32),
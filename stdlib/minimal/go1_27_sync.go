@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports sync'. DO NOT EDIT.
 
@@ -12,7 +13,8 @@ import (
 func init() {
 	Symbols["sync"] = map[string]reflect.Value{
 		// function, constant and variable definitions
-		"NewCond": reflect.ValueOf(sync.NewCond),
+		"NewCond":  reflect.ValueOf(sync.NewCond),
+		"OnceFunc": reflect.ValueOf(sync.OnceFunc),
 
 		// type definitions
 		"Cond":      reflect.ValueOf((*sync.Cond)(nil)),
@@ -1,6 +1,7 @@
-// +build go1.12,!go1.13
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
-package stdlib
+package minimal
 
 // Code generated by 'goexports sync/atomic'. DO NOT EDIT.
 
@@ -17,6 +18,11 @@ func init() {
 		"AddUint32":             reflect.ValueOf(atomic.AddUint32),
 		"AddUint64":             reflect.ValueOf(atomic.AddUint64),
 		"AddUintptr":            reflect.ValueOf(atomic.AddUintptr),
+		"AndInt32":              reflect.ValueOf(atomic.AndInt32),
+		"AndInt64":              reflect.ValueOf(atomic.AndInt64),
+		"AndUint32":             reflect.ValueOf(atomic.AndUint32),
+		"AndUint64":             reflect.ValueOf(atomic.AndUint64),
+		"AndUintptr":            reflect.ValueOf(atomic.AndUintptr),
 		"CompareAndSwapInt32":   reflect.ValueOf(atomic.CompareAndSwapInt32),
 		"CompareAndSwapInt64":   reflect.ValueOf(atomic.CompareAndSwapInt64),
 		"CompareAndSwapPointer": reflect.ValueOf(atomic.CompareAndSwapPointer),
@@ -29,6 +35,11 @@ func init() {
 		"LoadUint32":            reflect.ValueOf(atomic.LoadUint32),
 		"LoadUint64":            reflect.ValueOf(atomic.LoadUint64),
 		"LoadUintptr":           reflect.ValueOf(atomic.LoadUintptr),
+		"OrInt32":               reflect.ValueOf(atomic.OrInt32),
+		"OrInt64":               reflect.ValueOf(atomic.OrInt64),
+		"OrUint32":              reflect.ValueOf(atomic.OrUint32),
+		"OrUint64":              reflect.ValueOf(atomic.OrUint64),
+		"OrUintptr":             reflect.ValueOf(atomic.OrUintptr),
 		"StoreInt32":            reflect.ValueOf(atomic.StoreInt32),
 		"StoreInt64":            reflect.ValueOf(atomic.StoreInt64),
 		"StorePointer":          reflect.ValueOf(atomic.StorePointer),
@@ -43,7 +54,13 @@ func init() {
 		"SwapUintptr":           reflect.ValueOf(atomic.SwapUintptr),
 
 		// type definitions
-		"Value": reflect.ValueOf((*atomic.Value)(nil)),
+		"Bool":    reflect.ValueOf((*atomic.Bool)(nil)),
+		"Int32":   reflect.ValueOf((*atomic.Int32)(nil)),
+		"Int64":   reflect.ValueOf((*atomic.Int64)(nil)),
+		"Uint32":  reflect.ValueOf((*atomic.Uint32)(nil)),
+		"Uint64":  reflect.ValueOf((*atomic.Uint64)(nil)),
+		"Uintptr": reflect.ValueOf((*atomic.Uintptr)(nil)),
+		"Value":   reflect.ValueOf((*atomic.Value)(nil)),
 
 		// interface wrapper definitions
 
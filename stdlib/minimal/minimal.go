@@ -0,0 +1,24 @@
+//go:build go1.26 && !go1.28
+// +build go1.26,!go1.28
+
+// Package minimal provides the symbols of a small set of standard packages,
+// formatting, strings and numbers, containers, synchronization and time,
+// for embedders which do not need the whole standard library of package
+// stdlib, which includes them.
+package minimal
+
+import "reflect"
+
+// Symbols stores the map of minimal standard library symbols per package
+var Symbols = map[string]map[string]reflect.Value{}
+
+func init() {
+	Symbols["github.com/containous/yaegi/stdlib/minimal"] = map[string]reflect.Value{
+		"Symbols": reflect.ValueOf(Symbols),
+	}
+}
+
+//go:generate ../../cmd/goexports/goexports bufio bytes container/heap container/list container/ring
+//go:generate ../../cmd/goexports/goexports context errors fmt io math math/bits math/cmplx math/rand
+//go:generate ../../cmd/goexports/goexports path sort strconv strings sync sync/atomic
+//go:generate ../../cmd/goexports/goexports time unicode unicode/utf16 unicode/utf8
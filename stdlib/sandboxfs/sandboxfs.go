@@ -0,0 +1,775 @@
+// Package sandboxfs provides the symbols of the os, io/ioutil and path/filepath
+// packages for interpreted code whose files are virtual: the files are read
+// from a filesystem provided by the host, and written in a memory overlay,
+// so interpreted code reads and writes files without access to the files of
+// the process.
+//
+// The symbols are loaded after those of the standard library, which they
+// replace, as in:
+//
+//	fsys := sandboxfs.New(os.DirFS("/srv/data"))
+//	i := interp.New(interp.Options{})
+//	i.Use(stdlib.Symbols)
+//	i.Use(fsys.Symbols())
+//
+// Paths are slash or OS separated, relative to the root of the filesystem,
+// which is also the working directory. Only the functions of the os package
+// operating on files, the errors, constants and types are provided: the
+// functions giving access to the process or the system are not. The os.File
+// type of interpreted code is File, so the standard streams, os.Stdin,
+// os.Stdout and os.Stderr, can not be assigned to a variable of type *os.File.
+//
+// The other packages of the standard library reading files of the host are
+// replaced as well: go/parser.ParseFile reads the files of the filesystem,
+// and the functions opening files by name, as archive/zip.OpenReader and
+// crypto/tls.LoadX509KeyPair, are removed. The packages giving access to
+// the files or programs of the host through the methods of their types, as
+// text/template and html/template with ParseFiles, and os/exec and os/user,
+// are empty. The network, and net/http whose Dir and ServeFile read the
+// files of the host, are left to the sandboxnet package, which provides
+// neither. The data of the system read by the
+// standard library, as time zones or root certificates, the temporary files
+// of large mime/multipart forms, the directories of testing.T.TempDir, and
+// the files opened by database/sql drivers of the host remain on the host.
+package sandboxfs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// FS is a filesystem reading the files of a base filesystem, and writing
+// files in memory. A file written or removed is hidden in the base
+// filesystem, which is never modified. The files of FS, including the ones
+// written by interpreted code, can be read by the host, as an fs.FS. FS is
+// safe for concurrent use.
+type FS struct {
+	base    fs.FS
+	mutex   sync.Mutex          // protects files, removed, seq and the content of files
+	files   map[string]*memFile // files and directories written, indexed by name
+	removed map[string]bool     // files and directories removed from the base filesystem
+	seq     int                 // sequence of temporary file names
+}
+
+// memFile is a file or a directory written in memory.
+type memFile struct {
+	data    []byte
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+// New returns a filesystem reading the files of base, or an empty one if
+// base is nil.
+func New(base fs.FS) *FS {
+	return &FS{base: base, files: map[string]*memFile{}, removed: map[string]bool{}}
+}
+
+// clean returns the name in the filesystem of the file of path name, which
+// is relative to the root.
+func clean(name string) string {
+	name = path.Clean("/" + filepath.ToSlash(name))
+	if name == "/" {
+		return "."
+	}
+	return name[1:]
+}
+
+// Open opens the file name for reading, as fs.FS.
+func (fsys *FS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	return fsys.openFile(name, os.O_RDONLY, 0)
+}
+
+// Stat returns the description of the file name, as fs.StatFS.
+func (fsys *FS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+	return fsys.stat(name)
+}
+
+// ReadDir returns the entries of the directory name, sorted by name, as
+// fs.ReadDirFS.
+func (fsys *FS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+	return fsys.readDir(name)
+}
+
+// hidden returns true if the file name of the base filesystem, or one of
+// its parent directories, is removed.
+func (fsys *FS) hidden(name string) bool {
+	for {
+		if fsys.removed[name] {
+			return true
+		}
+		if name == "." {
+			return false
+		}
+		name = path.Dir(name)
+	}
+}
+
+// lookup returns the description of the file name, and its content if it is
+// written in memory. It must be called with mutex locked.
+func (fsys *FS) lookup(name string) (fs.FileInfo, *memFile, error) {
+	if f := fsys.files[name]; f != nil {
+		return fileInfo{name: path.Base(name), f: f}, f, nil
+	}
+	if fsys.base == nil {
+		if name == "." {
+			return fileInfo{name: ".", f: &memFile{mode: fs.ModeDir | 0777}}, nil, nil
+		}
+		return nil, nil, fs.ErrNotExist
+	}
+	if fsys.hidden(name) {
+		return nil, nil, fs.ErrNotExist
+	}
+	info, err := fs.Stat(fsys.base, name)
+	if err != nil {
+		var e *fs.PathError
+		if errors.As(err, &e) {
+			err = e.Err
+		}
+		return nil, nil, err
+	}
+	return info, nil, nil
+}
+
+// stat returns the description of the file of path name.
+func (fsys *FS) stat(name string) (fs.FileInfo, error) {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	info, _, err := fsys.lookup(clean(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+	return info, nil
+}
+
+// parentDir returns an error if the parent directory of the file name does
+// not exist. It must be called with mutex locked.
+func (fsys *FS) parentDir(name string) error {
+	info, _, err := fsys.lookup(path.Dir(name))
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return syscall.ENOTDIR
+	}
+	return nil
+}
+
+// entries returns the entries of the directory name, sorted by name. It
+// must be called with mutex locked.
+func (fsys *FS) entries(name string) ([]fs.DirEntry, error) {
+	info, _, err := fsys.lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, syscall.ENOTDIR
+	}
+	var res []fs.DirEntry
+	if fsys.base != nil && !fsys.hidden(name) {
+		// The directory may exist also in memory, once created again
+		base, _ := fs.ReadDir(fsys.base, name)
+		for _, e := range base {
+			child := path.Join(name, e.Name())
+			if fsys.files[child] == nil && !fsys.removed[child] {
+				res = append(res, e)
+			}
+		}
+	}
+	for child, f := range fsys.files {
+		if child != "." && path.Dir(child) == name {
+			res = append(res, fs.FileInfoToDirEntry(fileInfo{name: path.Base(child), f: f}))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name() < res[j].Name() })
+	return res, nil
+}
+
+// readDir returns the entries of the directory of path name, sorted by name.
+func (fsys *FS) readDir(name string) ([]fs.DirEntry, error) {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	entries, err := fsys.entries(clean(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	return entries, nil
+}
+
+// copyUp returns the content in memory of the file name, of description
+// info, copied from the base filesystem if necessary to be written. It
+// must be called with mutex locked.
+func (fsys *FS) copyUp(name string, info fs.FileInfo) (*memFile, error) {
+	if f := fsys.files[name]; f != nil {
+		return f, nil
+	}
+	f := &memFile{mode: info.Mode(), modTime: info.ModTime()}
+	if !info.IsDir() {
+		data, err := fs.ReadFile(fsys.base, name)
+		if err != nil {
+			return nil, err
+		}
+		f.data = data
+	}
+	fsys.files[name] = f
+	return f, nil
+}
+
+// openFile opens the file of path name, as os.OpenFile.
+func (fsys *FS) openFile(name string, flag int, perm fs.FileMode) (*File, error) {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+
+	p := clean(name)
+	info, f, err := fsys.lookup(p)
+	switch {
+	case err == nil && flag&(os.O_CREATE|os.O_EXCL) == os.O_CREATE|os.O_EXCL:
+		err = fs.ErrExist
+	case errors.Is(err, fs.ErrNotExist) && flag&os.O_CREATE != 0:
+		if err = fsys.parentDir(p); err == nil {
+			f = &memFile{mode: perm & fs.ModePerm, modTime: time.Now()}
+			fsys.files[p] = f
+			info = fileInfo{name: path.Base(p), f: f}
+		}
+	case err == nil && info.IsDir() && writable(flag):
+		err = syscall.EISDIR
+	case err == nil && writable(flag):
+		if f, err = fsys.copyUp(p, info); err == nil && flag&os.O_TRUNC != 0 {
+			f.data, f.modTime = nil, time.Now()
+		}
+	}
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	file := &File{fsys: fsys, name: name, path: p, flag: flag, mem: f, dir: info.IsDir()}
+	if f == nil && !file.dir {
+		if file.base, err = fsys.base.Open(p); err != nil {
+			return nil, err
+		}
+	}
+	return file, nil
+}
+
+// writable returns true if flag opens a file for writing.
+func writable(flag int) bool {
+	return flag&(os.O_WRONLY|os.O_RDWR) != 0
+}
+
+// mkdir creates the directory of path name, as os.Mkdir.
+func (fsys *FS) mkdir(name string, perm fs.FileMode) error {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	if err := fsys.create(clean(name), fs.ModeDir|perm&fs.ModePerm); err != nil {
+		return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+	}
+	return nil
+}
+
+// mkdirAll creates the directory of path name, and its missing parents, as
+// os.MkdirAll.
+func (fsys *FS) mkdirAll(name string, perm fs.FileMode) error {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	p := clean(name)
+	var dirs []string
+	for d := p; d != "."; d = path.Dir(d) {
+		info, _, err := fsys.lookup(d)
+		if err == nil {
+			if !info.IsDir() {
+				return &fs.PathError{Op: "mkdir", Path: name, Err: syscall.ENOTDIR}
+			}
+			break
+		}
+		dirs = append(dirs, d)
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := fsys.create(dirs[i], fs.ModeDir|perm&fs.ModePerm); err != nil {
+			return &fs.PathError{Op: "mkdir", Path: name, Err: err}
+		}
+	}
+	return nil
+}
+
+// create creates in memory the file name, of the given mode, which must not
+// exist. It must be called with mutex locked.
+func (fsys *FS) create(name string, mode fs.FileMode) error {
+	if _, _, err := fsys.lookup(name); err == nil {
+		return fs.ErrExist
+	}
+	if err := fsys.parentDir(name); err != nil {
+		return err
+	}
+	fsys.files[name] = &memFile{mode: mode, modTime: time.Now()}
+	return nil
+}
+
+// remove removes the file or empty directory of path name, as os.Remove.
+func (fsys *FS) remove(name string) error {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	p := clean(name)
+	info, _, err := fsys.lookup(p)
+	if err == nil && p == "." {
+		err = syscall.EBUSY
+	}
+	if err == nil && info.IsDir() {
+		if entries, _ := fsys.entries(p); len(entries) > 0 {
+			err = syscall.ENOTEMPTY
+		}
+	}
+	if err != nil {
+		return &fs.PathError{Op: "remove", Path: name, Err: err}
+	}
+	fsys.delete(p)
+	return nil
+}
+
+// removeAll removes the file or directory of path name and its content, as
+// os.RemoveAll.
+func (fsys *FS) removeAll(name string) error {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	p := clean(name)
+	if p == "." {
+		return &fs.PathError{Op: "unlinkat", Path: name, Err: syscall.EBUSY}
+	}
+	if _, _, err := fsys.lookup(p); err == nil {
+		fsys.delete(p)
+	}
+	return nil
+}
+
+// delete deletes the file or directory name and its content. It must be
+// called with mutex locked.
+func (fsys *FS) delete(name string) {
+	prefix := name + "/"
+	for n := range fsys.files {
+		if n == name || strings.HasPrefix(n, prefix) {
+			delete(fsys.files, n)
+		}
+	}
+	for n := range fsys.removed {
+		if strings.HasPrefix(n, prefix) {
+			delete(fsys.removed, n)
+		}
+	}
+	if fsys.base != nil {
+		fsys.removed[name] = true
+	}
+}
+
+// rename renames the file or directory of path oldname to newname, as
+// os.Rename. The content of a directory is moved with it.
+func (fsys *FS) rename(oldname, newname string) error {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	op, np := clean(oldname), clean(newname)
+	info, _, err := fsys.lookup(op)
+	switch {
+	case err != nil:
+	case op == "." || np == "." || strings.HasPrefix(np, op+"/"):
+		err = syscall.EINVAL
+	case op == np:
+		return nil
+	default:
+		if to, _, e := fsys.lookup(np); e == nil && (to.IsDir() || info.IsDir()) {
+			err = syscall.EEXIST
+		} else {
+			err = fsys.parentDir(np)
+		}
+	}
+	if err != nil {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: err}
+	}
+
+	moved := map[string]*memFile{}
+	var move func(name string, info fs.FileInfo) error
+	move = func(name string, info fs.FileInfo) error {
+		f, err := fsys.copyUp(name, info)
+		if err != nil {
+			return err
+		}
+		moved[np+name[len(op):]] = f
+		if !info.IsDir() {
+			return nil
+		}
+		entries, err := fsys.entries(name)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			info, err := e.Info()
+			if err != nil {
+				return err
+			}
+			if err := move(path.Join(name, e.Name()), info); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := move(op, info); err != nil {
+		return &os.LinkError{Op: "rename", Old: oldname, New: newname, Err: err}
+	}
+	fsys.delete(op)
+	fsys.delete(np)
+	delete(fsys.removed, np)
+	for n, f := range moved {
+		fsys.files[n] = f
+	}
+	if fsys.base != nil {
+		// The base files of the new directory are hidden by the moved ones
+		fsys.removed[np] = true
+	}
+	return nil
+}
+
+// update sets the mode or the modification time of the file of path name,
+// as os.Chmod and os.Chtimes.
+func (fsys *FS) update(op, name string, set func(f *memFile)) error {
+	fsys.mutex.Lock()
+	defer fsys.mutex.Unlock()
+	p := clean(name)
+	info, f, err := fsys.lookup(p)
+	if err == nil && f == nil {
+		f, err = fsys.copyUp(p, info)
+	}
+	if err != nil {
+		return &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	set(f)
+	return nil
+}
+
+// tempName returns the name of a new temporary file in dir, made of
+// pattern, whose last "*" is replaced by a sequence number, or the default
+// temporary directory if dir is empty, which is created if necessary. It
+// must be called with mutex locked.
+func (fsys *FS) tempName(dir, pattern string) (string, error) {
+	if dir == "" {
+		dir = tempDir
+		if _, _, err := fsys.lookup(clean(dir)); err != nil {
+			if err := fsys.create(clean(dir), fs.ModeDir|0777); err != nil {
+				return "", err
+			}
+		}
+	}
+	if strings.ContainsRune(pattern, '/') || strings.ContainsRune(pattern, filepath.Separator) {
+		return "", errors.New("pattern contains path separator")
+	}
+	prefix, suffix := pattern, ""
+	if i := strings.LastIndex(pattern, "*"); i >= 0 {
+		prefix, suffix = pattern[:i], pattern[i+1:]
+	}
+	for {
+		fsys.seq++
+		name := filepath.Join(dir, prefix+itoa(fsys.seq)+suffix)
+		if _, _, err := fsys.lookup(clean(name)); err != nil {
+			return name, nil
+		}
+	}
+}
+
+// tempDir is the default directory of temporary files.
+const tempDir = "/tmp"
+
+var errUnsupported = errors.New("operation not supported")
+
+func itoa(n int) string {
+	var b [20]byte
+	i := len(b)
+	for {
+		i--
+		b[i] = byte('0' + n%10)
+		if n /= 10; n == 0 {
+			return string(b[i:])
+		}
+	}
+}
+
+// fileInfo describes a file written in memory.
+type fileInfo struct {
+	name string
+	f    *memFile
+}
+
+func (fi fileInfo) Name() string       { return fi.name }
+func (fi fileInfo) Size() int64        { return int64(len(fi.f.data)) }
+func (fi fileInfo) Mode() fs.FileMode  { return fi.f.mode }
+func (fi fileInfo) ModTime() time.Time { return fi.f.modTime }
+func (fi fileInfo) IsDir() bool        { return fi.f.mode.IsDir() }
+func (fi fileInfo) Sys() interface{}   { return nil }
+
+// File is an open file of FS. It is the os.File type of interpreted code.
+type File struct {
+	fsys  *FS
+	name  string        // name given at opening
+	path  string        // name in the filesystem
+	flag  int           // flags of opening
+	mem   *memFile      // content in memory, or nil
+	base  fs.File       // file of the base filesystem, if not in memory
+	dir   bool          // true if the file is a directory
+	off   int64         // offset of the next read or write in memory
+	dirs  []fs.DirEntry // entries not yet returned by ReadDir
+	read  bool          // true if the directory entries are read
+	close bool          // true once closed
+}
+
+// Name returns the name of the file as given to open it.
+func (f *File) Name() string { return f.name }
+
+func (f *File) check(op string, write bool) error {
+	switch {
+	case f == nil:
+		return fs.ErrInvalid
+	case f.close:
+		return &fs.PathError{Op: op, Path: f.name, Err: fs.ErrClosed}
+	case f.dir && op != "readdir" && op != "stat" && op != "close":
+		return &fs.PathError{Op: op, Path: f.name, Err: syscall.EISDIR}
+	case write && !writable(f.flag):
+		return &fs.PathError{Op: op, Path: f.name, Err: syscall.EBADF}
+	}
+	return nil
+}
+
+// Read reads up to len(b) bytes from the file.
+func (f *File) Read(b []byte) (int, error) {
+	if err := f.check("read", false); err != nil {
+		return 0, err
+	}
+	if f.base != nil {
+		return f.base.Read(b)
+	}
+	f.fsys.mutex.Lock()
+	defer f.fsys.mutex.Unlock()
+	if f.off >= int64(len(f.mem.data)) {
+		return 0, io.EOF
+	}
+	n := copy(b, f.mem.data[f.off:])
+	f.off += int64(n)
+	return n, nil
+}
+
+// ReadAt reads len(b) bytes from the file, starting at offset off.
+func (f *File) ReadAt(b []byte, off int64) (int, error) {
+	if err := f.check("read", false); err != nil {
+		return 0, err
+	}
+	if off < 0 {
+		return 0, &fs.PathError{Op: "readat", Path: f.name, Err: errors.New("negative offset")}
+	}
+	if f.base != nil {
+		r, ok := f.base.(io.ReaderAt)
+		if !ok {
+			return 0, &fs.PathError{Op: "readat", Path: f.name, Err: errUnsupported}
+		}
+		return r.ReadAt(b, off)
+	}
+	f.fsys.mutex.Lock()
+	defer f.fsys.mutex.Unlock()
+	if off >= int64(len(f.mem.data)) {
+		return 0, io.EOF
+	}
+	n := copy(b, f.mem.data[off:])
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Write writes len(b) bytes to the file.
+func (f *File) Write(b []byte) (int, error) {
+	if err := f.check("write", true); err != nil {
+		return 0, err
+	}
+	f.fsys.mutex.Lock()
+	defer f.fsys.mutex.Unlock()
+	if f.flag&os.O_APPEND != 0 {
+		f.off = int64(len(f.mem.data))
+	}
+	f.writeAt(b, f.off)
+	f.off += int64(len(b))
+	return len(b), nil
+}
+
+// WriteAt writes len(b) bytes to the file, starting at offset off.
+func (f *File) WriteAt(b []byte, off int64) (int, error) {
+	if err := f.check("write", true); err != nil {
+		return 0, err
+	}
+	if off < 0 {
+		return 0, &fs.PathError{Op: "writeat", Path: f.name, Err: errors.New("negative offset")}
+	}
+	if f.flag&os.O_APPEND != 0 {
+		return 0, errors.New("os: invalid use of WriteAt on file opened with O_APPEND")
+	}
+	f.fsys.mutex.Lock()
+	defer f.fsys.mutex.Unlock()
+	f.writeAt(b, off)
+	return len(b), nil
+}
+
+// writeAt writes b at offset off, extending the file if necessary. It must
+// be called with mutex locked.
+func (f *File) writeAt(b []byte, off int64) {
+	if end := off + int64(len(b)); end > int64(len(f.mem.data)) {
+		if end > int64(cap(f.mem.data)) {
+			data := make([]byte, end, 2*end)
+			copy(data, f.mem.data)
+			f.mem.data = data
+		}
+		f.mem.data = f.mem.data[:end]
+	}
+	copy(f.mem.data[off:], b)
+	f.mem.modTime = time.Now()
+}
+
+// WriteString writes the string s to the file.
+func (f *File) WriteString(s string) (int, error) { return f.Write([]byte(s)) }
+
+// Seek sets the offset of the next read or write.
+func (f *File) Seek(offset int64, whence int) (int64, error) {
+	if err := f.check("seek", false); err != nil {
+		return 0, err
+	}
+	if f.base != nil {
+		s, ok := f.base.(io.Seeker)
+		if !ok {
+			return 0, &fs.PathError{Op: "seek", Path: f.name, Err: errUnsupported}
+		}
+		return s.Seek(offset, whence)
+	}
+	f.fsys.mutex.Lock()
+	defer f.fsys.mutex.Unlock()
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.off
+	case io.SeekEnd:
+		offset += int64(len(f.mem.data))
+	}
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.name, Err: syscall.EINVAL}
+	}
+	f.off = offset
+	return offset, nil
+}
+
+// Truncate changes the size of the file.
+func (f *File) Truncate(size int64) error {
+	if err := f.check("truncate", true); err != nil {
+		return err
+	}
+	if size < 0 {
+		return &fs.PathError{Op: "truncate", Path: f.name, Err: syscall.EINVAL}
+	}
+	f.fsys.mutex.Lock()
+	defer f.fsys.mutex.Unlock()
+	if size <= int64(len(f.mem.data)) {
+		f.mem.data = f.mem.data[:size]
+		f.mem.modTime = time.Now()
+		return nil
+	}
+	f.writeAt(make([]byte, size-int64(len(f.mem.data))), int64(len(f.mem.data)))
+	return nil
+}
+
+// Sync commits the content of the file, which is always done.
+func (f *File) Sync() error { return f.check("sync", false) }
+
+// Stat returns the description of the file.
+func (f *File) Stat() (fs.FileInfo, error) {
+	if err := f.check("stat", false); err != nil {
+		return nil, err
+	}
+	if f.base != nil {
+		return f.base.Stat()
+	}
+	if f.mem != nil {
+		f.fsys.mutex.Lock()
+		defer f.fsys.mutex.Unlock()
+		return fileInfo{name: path.Base(f.path), f: f.mem}, nil
+	}
+	return f.fsys.stat(f.path)
+}
+
+// ReadDir reads the entries of the directory, as os.File.ReadDir.
+func (f *File) ReadDir(n int) ([]fs.DirEntry, error) {
+	if err := f.check("readdir", false); err != nil {
+		return nil, err
+	}
+	if !f.dir {
+		return nil, &fs.PathError{Op: "readdir", Path: f.name, Err: syscall.ENOTDIR}
+	}
+	if !f.read {
+		entries, err := f.fsys.readDir(f.path)
+		if err != nil {
+			return nil, err
+		}
+		f.dirs, f.read = entries, true
+	}
+	if n <= 0 || n > len(f.dirs) {
+		if n > 0 && len(f.dirs) == 0 {
+			return nil, io.EOF
+		}
+		n = len(f.dirs)
+	}
+	res := f.dirs[:n:n]
+	f.dirs = f.dirs[n:]
+	return res, nil
+}
+
+// Readdir reads the descriptions of the entries of the directory, as
+// os.File.Readdir.
+func (f *File) Readdir(n int) ([]fs.FileInfo, error) {
+	entries, err := f.ReadDir(n)
+	infos := make([]fs.FileInfo, 0, len(entries))
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil {
+			return infos, err
+		}
+		infos = append(infos, info)
+	}
+	return infos, err
+}
+
+// Readdirnames reads the names of the entries of the directory, as
+// os.File.Readdirnames.
+func (f *File) Readdirnames(n int) ([]string, error) {
+	entries, err := f.ReadDir(n)
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name()
+	}
+	return names, err
+}
+
+// Close closes the file.
+func (f *File) Close() error {
+	if err := f.check("close", false); err != nil {
+		return err
+	}
+	f.close = true
+	if f.base != nil {
+		return f.base.Close()
+	}
+	return nil
+}
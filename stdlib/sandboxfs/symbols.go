@@ -0,0 +1,323 @@
+package sandboxfs
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/containous/yaegi/stdlib"
+)
+
+// hostPkg lists the packages reading the files of the host, or running its
+// programs, even through the methods of their types, which are replaced by
+// empty packages.
+var hostPkg = []string{
+	"go/build", "go/importer", "html/template", "net/http/cgi", "os/exec", "os/user",
+	"text/template",
+}
+
+// Symbols returns the symbols of the os, io/ioutil and path/filepath
+// packages operating on the files of fsys, and of the other packages of the
+// standard library without their functions operating on the files of the
+// host, to be loaded by Use.
+func (fsys *FS) Symbols() map[string]map[string]reflect.Value {
+	symbols := map[string]map[string]reflect.Value{
+		"os":            fsys.osSymbols(),
+		"io/ioutil":     fsys.ioutilSymbols(),
+		"path/filepath": fsys.filepathSymbols(),
+		"archive/zip":   without(stdlib.Symbols["archive/zip"], "OpenReader"),
+		// The same symbols are removed by sandboxnet, so both can be loaded
+		"crypto/tls":    without(stdlib.Symbols["crypto/tls"], "Dial", "DialWithDialer", "Dialer", "Listen", "LoadX509KeyPair"),
+		"go/parser":     without(stdlib.Symbols["go/parser"], "ParseDir"),
+		"runtime/debug": without(stdlib.Symbols["runtime/debug"], "WriteHeapDump"),
+	}
+	symbols["go/parser"]["ParseFile"] = reflect.ValueOf(fsys.parseFile)
+	for _, pkg := range hostPkg {
+		symbols[pkg] = map[string]reflect.Value{}
+	}
+	return symbols
+}
+
+// without returns a copy of the symbols of a package, except names.
+func without(symbols map[string]reflect.Value, names ...string) map[string]reflect.Value {
+	m := make(map[string]reflect.Value, len(symbols))
+	for k, v := range symbols {
+		m[k] = v
+	}
+	for _, name := range names {
+		delete(m, name)
+	}
+	return m
+}
+
+func (fsys *FS) osSymbols() map[string]reflect.Value {
+	return map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Chmod": reflect.ValueOf(func(name string, mode os.FileMode) error {
+			return fsys.update("chmod", name, func(f *memFile) { f.mode = f.mode&^fs.ModePerm | mode&fs.ModePerm })
+		}),
+		"Chtimes": reflect.ValueOf(func(name string, atime, mtime time.Time) error {
+			return fsys.update("chtimes", name, func(f *memFile) { f.modTime = mtime })
+		}),
+		"Create": reflect.ValueOf(func(name string) (*File, error) {
+			return fsys.openFile(name, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
+		}),
+		"CreateTemp": reflect.ValueOf(fsys.createTemp),
+		"DevNull":    reflect.ValueOf(os.DevNull),
+		"DirFS": reflect.ValueOf(func(dir string) fs.FS {
+			sub, err := fs.Sub(fsys, clean(dir))
+			if err != nil {
+				return fsys
+			}
+			return sub
+		}),
+		"ErrClosed":         reflect.ValueOf(&os.ErrClosed).Elem(),
+		"ErrExist":          reflect.ValueOf(&os.ErrExist).Elem(),
+		"ErrInvalid":        reflect.ValueOf(&os.ErrInvalid).Elem(),
+		"ErrNotExist":       reflect.ValueOf(&os.ErrNotExist).Elem(),
+		"ErrPermission":     reflect.ValueOf(&os.ErrPermission).Elem(),
+		"Getwd":             reflect.ValueOf(func() (string, error) { return "/", nil }),
+		"IsExist":           reflect.ValueOf(os.IsExist),
+		"IsNotExist":        reflect.ValueOf(os.IsNotExist),
+		"IsPathSeparator":   reflect.ValueOf(os.IsPathSeparator),
+		"IsPermission":      reflect.ValueOf(os.IsPermission),
+		"Lstat":             reflect.ValueOf(fsys.stat),
+		"Mkdir":             reflect.ValueOf(fsys.mkdir),
+		"MkdirAll":          reflect.ValueOf(fsys.mkdirAll),
+		"MkdirTemp":         reflect.ValueOf(fsys.mkdirTemp),
+		"ModeAppend":        reflect.ValueOf(os.ModeAppend),
+		"ModeCharDevice":    reflect.ValueOf(os.ModeCharDevice),
+		"ModeDevice":        reflect.ValueOf(os.ModeDevice),
+		"ModeDir":           reflect.ValueOf(os.ModeDir),
+		"ModeExclusive":     reflect.ValueOf(os.ModeExclusive),
+		"ModeIrregular":     reflect.ValueOf(os.ModeIrregular),
+		"ModeNamedPipe":     reflect.ValueOf(os.ModeNamedPipe),
+		"ModePerm":          reflect.ValueOf(os.ModePerm),
+		"ModeSetgid":        reflect.ValueOf(os.ModeSetgid),
+		"ModeSetuid":        reflect.ValueOf(os.ModeSetuid),
+		"ModeSocket":        reflect.ValueOf(os.ModeSocket),
+		"ModeSticky":        reflect.ValueOf(os.ModeSticky),
+		"ModeSymlink":       reflect.ValueOf(os.ModeSymlink),
+		"ModeTemporary":     reflect.ValueOf(os.ModeTemporary),
+		"ModeType":          reflect.ValueOf(os.ModeType),
+		"O_APPEND":          reflect.ValueOf(os.O_APPEND),
+		"O_CREATE":          reflect.ValueOf(os.O_CREATE),
+		"O_EXCL":            reflect.ValueOf(os.O_EXCL),
+		"O_RDONLY":          reflect.ValueOf(os.O_RDONLY),
+		"O_RDWR":            reflect.ValueOf(os.O_RDWR),
+		"O_SYNC":            reflect.ValueOf(os.O_SYNC),
+		"O_TRUNC":           reflect.ValueOf(os.O_TRUNC),
+		"O_WRONLY":          reflect.ValueOf(os.O_WRONLY),
+		"Open":              reflect.ValueOf(func(name string) (*File, error) { return fsys.openFile(name, os.O_RDONLY, 0) }),
+		"OpenFile":          reflect.ValueOf(fsys.openFile),
+		"PathListSeparator": reflect.ValueOf(os.PathListSeparator),
+		"PathSeparator":     reflect.ValueOf(os.PathSeparator),
+		"ReadDir":           reflect.ValueOf(fsys.readDir),
+		"ReadFile":          reflect.ValueOf(fsys.readFile),
+		"Remove":            reflect.ValueOf(fsys.remove),
+		"RemoveAll":         reflect.ValueOf(fsys.removeAll),
+		"Rename":            reflect.ValueOf(fsys.rename),
+		"SameFile":          reflect.ValueOf(os.SameFile),
+		"Stat":              reflect.ValueOf(fsys.stat),
+		"Stderr":            reflect.ValueOf(&os.Stderr).Elem(),
+		"Stdin":             reflect.ValueOf(&os.Stdin).Elem(),
+		"Stdout":            reflect.ValueOf(&os.Stdout).Elem(),
+		"TempDir":           reflect.ValueOf(func() string { return tempDir }),
+		"Truncate": reflect.ValueOf(func(name string, size int64) error {
+			f, err := fsys.openFile(name, os.O_WRONLY, 0)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			return f.Truncate(size)
+		}),
+		"WriteFile": reflect.ValueOf(fsys.writeFile),
+
+		// type definitions
+		"DirEntry":  reflect.ValueOf((*os.DirEntry)(nil)),
+		"File":      reflect.ValueOf((*File)(nil)),
+		"FileInfo":  reflect.ValueOf((*os.FileInfo)(nil)),
+		"FileMode":  reflect.ValueOf((*os.FileMode)(nil)),
+		"LinkError": reflect.ValueOf((*os.LinkError)(nil)),
+		"PathError": reflect.ValueOf((*os.PathError)(nil)),
+	}
+}
+
+func (fsys *FS) ioutilSymbols() map[string]reflect.Value {
+	return map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Discard":   reflect.ValueOf(&ioutil.Discard).Elem(),
+		"NopCloser": reflect.ValueOf(ioutil.NopCloser),
+		"ReadAll":   reflect.ValueOf(ioutil.ReadAll),
+		"ReadDir":   reflect.ValueOf(fsys.readDirInfo),
+		"ReadFile":  reflect.ValueOf(fsys.readFile),
+		"TempDir":   reflect.ValueOf(fsys.mkdirTemp),
+		"TempFile":  reflect.ValueOf(fsys.createTemp),
+		"WriteFile": reflect.ValueOf(fsys.writeFile),
+	}
+}
+
+func (fsys *FS) filepathSymbols() map[string]reflect.Value {
+	return map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Abs": reflect.ValueOf(func(name string) (string, error) {
+			if filepath.IsAbs(name) {
+				return filepath.Clean(name), nil
+			}
+			return filepath.Join(string(filepath.Separator), name), nil
+		}),
+		"Base":          reflect.ValueOf(filepath.Base),
+		"Clean":         reflect.ValueOf(filepath.Clean),
+		"Dir":           reflect.ValueOf(filepath.Dir),
+		"ErrBadPattern": reflect.ValueOf(&filepath.ErrBadPattern).Elem(),
+		"EvalSymlinks": reflect.ValueOf(func(name string) (string, error) {
+			if _, err := fsys.stat(name); err != nil {
+				return "", err
+			}
+			return filepath.Clean(name), nil
+		}),
+		"Ext":           reflect.ValueOf(filepath.Ext),
+		"FromSlash":     reflect.ValueOf(filepath.FromSlash),
+		"Glob":          reflect.ValueOf(fsys.glob),
+		"HasPrefix":     reflect.ValueOf(filepath.HasPrefix),
+		"IsAbs":         reflect.ValueOf(filepath.IsAbs),
+		"Join":          reflect.ValueOf(filepath.Join),
+		"ListSeparator": reflect.ValueOf(filepath.ListSeparator),
+		"Match":         reflect.ValueOf(filepath.Match),
+		"Rel":           reflect.ValueOf(filepath.Rel),
+		"Separator":     reflect.ValueOf(filepath.Separator),
+		"SkipDir":       reflect.ValueOf(&filepath.SkipDir).Elem(),
+		"Split":         reflect.ValueOf(filepath.Split),
+		"SplitList":     reflect.ValueOf(filepath.SplitList),
+		"ToSlash":       reflect.ValueOf(filepath.ToSlash),
+		"VolumeName":    reflect.ValueOf(filepath.VolumeName),
+		"Walk":          reflect.ValueOf(fsys.walk),
+		"WalkDir":       reflect.ValueOf(fsys.walkDir),
+
+		// type definitions
+		"WalkFunc": reflect.ValueOf((*filepath.WalkFunc)(nil)),
+	}
+}
+
+// parseFile parses the source of a Go file, read from the file of path
+// filename if src is nil, as parser.ParseFile.
+func (fsys *FS) parseFile(fset *token.FileSet, filename string, src interface{}, mode parser.Mode) (*ast.File, error) {
+	if src == nil {
+		b, err := fsys.readFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		src = b
+	}
+	return parser.ParseFile(fset, filename, src, mode)
+}
+
+// readFile returns the content of the file of path name, as os.ReadFile.
+func (fsys *FS) readFile(name string) ([]byte, error) {
+	f, err := fsys.openFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+// writeFile writes data to the file of path name, as os.WriteFile.
+func (fsys *FS) writeFile(name string, data []byte, perm os.FileMode) error {
+	f, err := fsys.openFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	return err
+}
+
+// readDirInfo returns the descriptions of the entries of the directory of
+// path name, sorted by name, as ioutil.ReadDir.
+func (fsys *FS) readDirInfo(name string) ([]os.FileInfo, error) {
+	f, err := fsys.openFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return f.Readdir(-1)
+}
+
+// createTemp creates a new temporary file, as os.CreateTemp.
+func (fsys *FS) createTemp(dir, pattern string) (*File, error) {
+	fsys.mutex.Lock()
+	name, err := fsys.tempName(dir, pattern)
+	fsys.mutex.Unlock()
+	if err != nil {
+		return nil, &os.PathError{Op: "createtemp", Path: pattern, Err: err}
+	}
+	return fsys.openFile(name, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+}
+
+// mkdirTemp creates a new temporary directory, as os.MkdirTemp.
+func (fsys *FS) mkdirTemp(dir, pattern string) (string, error) {
+	fsys.mutex.Lock()
+	name, err := fsys.tempName(dir, pattern)
+	fsys.mutex.Unlock()
+	if err != nil {
+		return "", &os.PathError{Op: "mkdirtemp", Path: pattern, Err: err}
+	}
+	return name, fsys.mkdir(name, 0700)
+}
+
+// glob returns the names of the files matching pattern, as filepath.Glob.
+func (fsys *FS) glob(pattern string) ([]string, error) {
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	names, err := fs.Glob(fsys, clean(pattern))
+	if err != nil {
+		return nil, err
+	}
+	abs := filepath.IsAbs(pattern) || strings.HasPrefix(pattern, "/")
+	for i, name := range names {
+		if abs {
+			name = "/" + name
+		}
+		names[i] = filepath.FromSlash(name)
+	}
+	return names, nil
+}
+
+// walk walks the file tree of path root, as filepath.Walk.
+func (fsys *FS) walk(root string, fn filepath.WalkFunc) error {
+	return fsys.walkDir(root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fn(name, nil, err)
+		}
+		info, err := d.Info()
+		return fn(name, info, err)
+	})
+}
+
+// walkDir walks the file tree of path root, as filepath.WalkDir.
+func (fsys *FS) walkDir(root string, fn fs.WalkDirFunc) error {
+	croot := clean(root)
+	return fs.WalkDir(fsys, croot, func(name string, d fs.DirEntry, err error) error {
+		switch {
+		case name == croot:
+			name = root
+		case croot == ".":
+			name = filepath.Join(root, filepath.FromSlash(name))
+		default:
+			name = filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(name, croot+"/")))
+		}
+		return fn(name, d, err)
+	})
+}
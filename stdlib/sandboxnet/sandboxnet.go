@@ -0,0 +1,229 @@
+// Package sandboxnet provides the symbols of the net and net/http packages
+// for interpreted code whose connections are controlled by the host: each
+// outbound connection is allowed or denied by a policy, so the host grants
+// scripts a limited network access, to the API of a given service for
+// example.
+//
+// The symbols are loaded after those of the standard library, which they
+// replace, as in:
+//
+//	n := sandboxnet.New(sandboxnet.Options{Policy: sandboxnet.AllowHosts("api.example.com:443")})
+//	i := interp.New(interp.Options{})
+//	i.Use(stdlib.Symbols)
+//	i.Use(n.Symbols())
+//
+// Only the clients are provided: the functions listening for connections,
+// resolving names, and the types configuring their own dialers or
+// transports, as net.Dialer and http.Transport, are not. The http.Client
+// type of interpreted code is Client, which is only obtained from
+// http.DefaultClient.
+//
+// The functions of the other packages of the standard library dialing by
+// themselves, as crypto/tls.Dial, net/rpc.Dial, net/smtp.Dial,
+// net/textproto.Dial or log/syslog.Dial, or making requests with the
+// transport of the host, as httputil.ReverseProxy and the servers and
+// clients of net/http/httptest, are removed: their clients are created on
+// connections from net.Dial instead. The connections made by the programs
+// of os/exec, or by database/sql drivers of the host, are not controlled:
+// sandboxfs removes os/exec.
+package sandboxnet
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ErrDenied is the error of the connections denied by AllowHosts, or by the
+// default policy.
+var ErrDenied = errors.New("connection not allowed")
+
+// A Policy returns a non nil error to deny a connection on network, as
+// "tcp" or "udp", to address, as "host:port". The host is the one given by
+// interpreted code, before it is resolved. A Policy is called concurrently
+// by the goroutines of interpreted code.
+type Policy func(network, address string) error
+
+// AllowHosts returns a policy allowing only the connections to the hosts,
+// given as "host" for any port, or as "host:port".
+func AllowHosts(hosts ...string) Policy {
+	allowed := make(map[string]bool, len(hosts))
+	for _, h := range hosts {
+		allowed[strings.ToLower(h)] = true
+	}
+	return func(network, address string) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return ErrDenied
+		}
+		if address = strings.ToLower(address); allowed[address] || allowed[strings.ToLower(host)] {
+			return nil
+		}
+		return ErrDenied
+	}
+}
+
+// Options are the options of a network.
+type Options struct {
+	// Policy allows or denies the connections. If nil, all connections
+	// are denied.
+	Policy Policy
+
+	// Transport, if not nil, performs the HTTP requests allowed by the
+	// policy, in place of a transport dialing through the policy. It is
+	// the way to set a proxy, TLS settings, or to serve requests without
+	// network access, in tests for example.
+	Transport http.RoundTripper
+
+	// Timeout, if not zero, limits the time of dials, and of HTTP
+	// requests, including reading their response body.
+	Timeout time.Duration
+}
+
+// Net is a network whose connections are allowed by a policy. Net is safe
+// for concurrent use.
+type Net struct {
+	policy Policy
+	dialer net.Dialer
+	client *Client
+}
+
+// New returns a network having the options.
+func New(options Options) *Net {
+	n := &Net{policy: options.Policy, dialer: net.Dialer{Timeout: options.Timeout}}
+	if n.policy == nil {
+		n.policy = func(string, string) error { return ErrDenied }
+	}
+	rt := options.Transport
+	if rt == nil {
+		// No proxy from the environment, it would be the address dialed
+		rt = &http.Transport{
+			DialContext:           n.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: time.Second,
+		}
+	}
+	n.client = &Client{client: &http.Client{Transport: &transport{n, rt}, Timeout: options.Timeout}}
+	return n
+}
+
+// check returns the error of the policy for a connection to address.
+func (n *Net) check(network, address string) error {
+	if err := n.policy(network, address); err != nil {
+		return &net.OpError{Op: "dial", Net: network, Err: err}
+	}
+	return nil
+}
+
+// DialContext connects to address on network, if allowed by the policy, as
+// net.Dialer.DialContext.
+func (n *Net) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	if err := n.check(network, address); err != nil {
+		return nil, err
+	}
+	return n.dialer.DialContext(ctx, network, address)
+}
+
+// Client returns the HTTP client of the network, which is http.DefaultClient
+// of interpreted code.
+func (n *Net) Client() *Client { return n.client }
+
+// transport checks the requests, including the redirected ones, before
+// their round trip by rt.
+type transport struct {
+	net *Net
+	rt  http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	port := req.URL.Port()
+	if port == "" {
+		port = "80"
+		if req.URL.Scheme == "https" {
+			port = "443"
+		}
+	}
+	if err := t.net.check("tcp", net.JoinHostPort(req.URL.Hostname(), port)); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.rt.RoundTrip(req)
+}
+
+// Client is the HTTP client of interpreted code, which makes the requests
+// allowed by the policy of its network, with the methods of http.Client.
+// The zero value denies all requests.
+type Client struct {
+	client *http.Client
+}
+
+func (c *Client) get() (*http.Client, error) {
+	if c == nil || c.client == nil {
+		return nil, ErrDenied
+	}
+	return c.client, nil
+}
+
+// Do sends a HTTP request and returns its response, as http.Client.Do.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	hc, err := c.get()
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return hc.Do(req)
+}
+
+// Get issues a GET to rawURL, as http.Client.Get.
+func (c *Client) Get(rawURL string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
+
+// Head issues a HEAD to rawURL, as http.Client.Head.
+func (c *Client) Head(rawURL string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodHead, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.Do(req)
+}
+
+// Post issues a POST to rawURL, as http.Client.Post.
+func (c *Client) Post(rawURL, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	return c.Do(req)
+}
+
+// PostForm issues a POST to rawURL, with data URL-encoded as the request body,
+// as http.Client.PostForm.
+func (c *Client) PostForm(rawURL string, data url.Values) (*http.Response, error) {
+	return c.Post(rawURL, "application/x-www-form-urlencoded", strings.NewReader(data.Encode()))
+}
+
+// CloseIdleConnections closes the idle connections, as
+// http.Client.CloseIdleConnections.
+func (c *Client) CloseIdleConnections() {
+	if hc, err := c.get(); err == nil {
+		hc.CloseIdleConnections()
+	}
+}
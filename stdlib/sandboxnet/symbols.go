@@ -0,0 +1,185 @@
+package sandboxnet
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/containous/yaegi/stdlib"
+)
+
+// Symbols returns the symbols of the net and net/http packages making the
+// connections allowed by the policy of n, and of the other packages of the
+// standard library without their functions connecting to the network or
+// using the transport of the host, to be loaded by Use.
+func (n *Net) Symbols() map[string]map[string]reflect.Value {
+	return map[string]map[string]reflect.Value{
+		"net":      n.netSymbols(),
+		"net/http": n.httpSymbols(),
+		// The same symbols are removed by sandboxfs, so both can be loaded
+		"crypto/tls":        without(stdlib.Symbols["crypto/tls"], "Dial", "DialWithDialer", "Dialer", "Listen", "LoadX509KeyPair"),
+		"log/syslog":        without(stdlib.Symbols["log/syslog"], "Dial", "New", "NewLogger"),
+		"net/http/fcgi":     without(stdlib.Symbols["net/http/fcgi"], "Serve"),
+		"net/http/httptest": without(stdlib.Symbols["net/http/httptest"], "NewServer", "NewTLSServer", "NewTestServer", "NewUnstartedServer", "Server"),
+		"net/http/httputil": without(stdlib.Symbols["net/http/httputil"], "NewSingleHostReverseProxy", "ReverseProxy"),
+		"net/rpc":           without(stdlib.Symbols["net/rpc"], "Accept", "Dial", "DialHTTP", "DialHTTPPath"),
+		"net/rpc/jsonrpc":   without(stdlib.Symbols["net/rpc/jsonrpc"], "Dial"),
+		"net/smtp":          without(stdlib.Symbols["net/smtp"], "Dial", "SendMail"),
+		"net/textproto":     without(stdlib.Symbols["net/textproto"], "Dial"),
+	}
+}
+
+// without returns a copy of the symbols of a package, except names.
+func without(symbols map[string]reflect.Value, names ...string) map[string]reflect.Value {
+	m := make(map[string]reflect.Value, len(symbols))
+	for k, v := range symbols {
+		m[k] = v
+	}
+	for _, name := range names {
+		delete(m, name)
+	}
+	return m
+}
+
+func (n *Net) netSymbols() map[string]reflect.Value {
+	return map[string]reflect.Value{
+		// function, constant and variable definitions
+		"Dial":          reflect.ValueOf(n.dial),
+		"DialTimeout":   reflect.ValueOf(n.dialTimeout),
+		"ErrClosed":     reflect.ValueOf(&net.ErrClosed).Elem(),
+		"IPv4":          reflect.ValueOf(net.IPv4),
+		"IPv4Mask":      reflect.ValueOf(net.IPv4Mask),
+		"IPv4len":       reflect.ValueOf(net.IPv4len),
+		"IPv6len":       reflect.ValueOf(net.IPv6len),
+		"JoinHostPort":  reflect.ValueOf(net.JoinHostPort),
+		"ParseCIDR":     reflect.ValueOf(net.ParseCIDR),
+		"ParseIP":       reflect.ValueOf(net.ParseIP),
+		"SplitHostPort": reflect.ValueOf(net.SplitHostPort),
+
+		// type definitions
+		"Addr":      reflect.ValueOf((*net.Addr)(nil)),
+		"AddrError": reflect.ValueOf((*net.AddrError)(nil)),
+		"Conn":      reflect.ValueOf((*net.Conn)(nil)),
+		"Error":     reflect.ValueOf((*net.Error)(nil)),
+		"IP":        reflect.ValueOf((*net.IP)(nil)),
+		"IPMask":    reflect.ValueOf((*net.IPMask)(nil)),
+		"IPNet":     reflect.ValueOf((*net.IPNet)(nil)),
+		"OpError":   reflect.ValueOf((*net.OpError)(nil)),
+		"TCPAddr":   reflect.ValueOf((*net.TCPAddr)(nil)),
+		"UDPAddr":   reflect.ValueOf((*net.UDPAddr)(nil)),
+	}
+}
+
+func (n *Net) httpSymbols() map[string]reflect.Value {
+	return map[string]reflect.Value{
+		// function, constant and variable definitions
+		"CanonicalHeaderKey":                  reflect.ValueOf(http.CanonicalHeaderKey),
+		"DefaultClient":                       reflect.ValueOf(&n.client).Elem(),
+		"DetectContentType":                   reflect.ValueOf(http.DetectContentType),
+		"ErrNoCookie":                         reflect.ValueOf(&http.ErrNoCookie).Elem(),
+		"Get":                                 reflect.ValueOf(n.client.Get),
+		"Head":                                reflect.ValueOf(n.client.Head),
+		"MethodConnect":                       reflect.ValueOf(http.MethodConnect),
+		"MethodDelete":                        reflect.ValueOf(http.MethodDelete),
+		"MethodGet":                           reflect.ValueOf(http.MethodGet),
+		"MethodHead":                          reflect.ValueOf(http.MethodHead),
+		"MethodOptions":                       reflect.ValueOf(http.MethodOptions),
+		"MethodPatch":                         reflect.ValueOf(http.MethodPatch),
+		"MethodPost":                          reflect.ValueOf(http.MethodPost),
+		"MethodPut":                           reflect.ValueOf(http.MethodPut),
+		"MethodTrace":                         reflect.ValueOf(http.MethodTrace),
+		"NewRequest":                          reflect.ValueOf(http.NewRequest),
+		"NewRequestWithContext":               reflect.ValueOf(http.NewRequestWithContext),
+		"NoBody":                              reflect.ValueOf(&http.NoBody).Elem(),
+		"ParseHTTPVersion":                    reflect.ValueOf(http.ParseHTTPVersion),
+		"ParseTime":                           reflect.ValueOf(http.ParseTime),
+		"Post":                                reflect.ValueOf(n.client.Post),
+		"PostForm":                            reflect.ValueOf(n.client.PostForm),
+		"StatusAccepted":                      reflect.ValueOf(http.StatusAccepted),
+		"StatusAlreadyReported":               reflect.ValueOf(http.StatusAlreadyReported),
+		"StatusBadGateway":                    reflect.ValueOf(http.StatusBadGateway),
+		"StatusBadRequest":                    reflect.ValueOf(http.StatusBadRequest),
+		"StatusConflict":                      reflect.ValueOf(http.StatusConflict),
+		"StatusContinue":                      reflect.ValueOf(http.StatusContinue),
+		"StatusCreated":                       reflect.ValueOf(http.StatusCreated),
+		"StatusEarlyHints":                    reflect.ValueOf(http.StatusEarlyHints),
+		"StatusExpectationFailed":             reflect.ValueOf(http.StatusExpectationFailed),
+		"StatusFailedDependency":              reflect.ValueOf(http.StatusFailedDependency),
+		"StatusForbidden":                     reflect.ValueOf(http.StatusForbidden),
+		"StatusFound":                         reflect.ValueOf(http.StatusFound),
+		"StatusGatewayTimeout":                reflect.ValueOf(http.StatusGatewayTimeout),
+		"StatusGone":                          reflect.ValueOf(http.StatusGone),
+		"StatusHTTPVersionNotSupported":       reflect.ValueOf(http.StatusHTTPVersionNotSupported),
+		"StatusIMUsed":                        reflect.ValueOf(http.StatusIMUsed),
+		"StatusInsufficientStorage":           reflect.ValueOf(http.StatusInsufficientStorage),
+		"StatusInternalServerError":           reflect.ValueOf(http.StatusInternalServerError),
+		"StatusLengthRequired":                reflect.ValueOf(http.StatusLengthRequired),
+		"StatusLocked":                        reflect.ValueOf(http.StatusLocked),
+		"StatusLoopDetected":                  reflect.ValueOf(http.StatusLoopDetected),
+		"StatusMethodNotAllowed":              reflect.ValueOf(http.StatusMethodNotAllowed),
+		"StatusMisdirectedRequest":            reflect.ValueOf(http.StatusMisdirectedRequest),
+		"StatusMovedPermanently":              reflect.ValueOf(http.StatusMovedPermanently),
+		"StatusMultiStatus":                   reflect.ValueOf(http.StatusMultiStatus),
+		"StatusMultipleChoices":               reflect.ValueOf(http.StatusMultipleChoices),
+		"StatusNetworkAuthenticationRequired": reflect.ValueOf(http.StatusNetworkAuthenticationRequired),
+		"StatusNoContent":                     reflect.ValueOf(http.StatusNoContent),
+		"StatusNonAuthoritativeInfo":          reflect.ValueOf(http.StatusNonAuthoritativeInfo),
+		"StatusNotAcceptable":                 reflect.ValueOf(http.StatusNotAcceptable),
+		"StatusNotExtended":                   reflect.ValueOf(http.StatusNotExtended),
+		"StatusNotFound":                      reflect.ValueOf(http.StatusNotFound),
+		"StatusNotImplemented":                reflect.ValueOf(http.StatusNotImplemented),
+		"StatusNotModified":                   reflect.ValueOf(http.StatusNotModified),
+		"StatusOK":                            reflect.ValueOf(http.StatusOK),
+		"StatusPartialContent":                reflect.ValueOf(http.StatusPartialContent),
+		"StatusPaymentRequired":               reflect.ValueOf(http.StatusPaymentRequired),
+		"StatusPermanentRedirect":             reflect.ValueOf(http.StatusPermanentRedirect),
+		"StatusPreconditionFailed":            reflect.ValueOf(http.StatusPreconditionFailed),
+		"StatusPreconditionRequired":          reflect.ValueOf(http.StatusPreconditionRequired),
+		"StatusProcessing":                    reflect.ValueOf(http.StatusProcessing),
+		"StatusProxyAuthRequired":             reflect.ValueOf(http.StatusProxyAuthRequired),
+		"StatusRequestEntityTooLarge":         reflect.ValueOf(http.StatusRequestEntityTooLarge),
+		"StatusRequestHeaderFieldsTooLarge":   reflect.ValueOf(http.StatusRequestHeaderFieldsTooLarge),
+		"StatusRequestTimeout":                reflect.ValueOf(http.StatusRequestTimeout),
+		"StatusRequestURITooLong":             reflect.ValueOf(http.StatusRequestURITooLong),
+		"StatusRequestedRangeNotSatisfiable":  reflect.ValueOf(http.StatusRequestedRangeNotSatisfiable),
+		"StatusResetContent":                  reflect.ValueOf(http.StatusResetContent),
+		"StatusSeeOther":                      reflect.ValueOf(http.StatusSeeOther),
+		"StatusServiceUnavailable":            reflect.ValueOf(http.StatusServiceUnavailable),
+		"StatusSwitchingProtocols":            reflect.ValueOf(http.StatusSwitchingProtocols),
+		"StatusTeapot":                        reflect.ValueOf(http.StatusTeapot),
+		"StatusTemporaryRedirect":             reflect.ValueOf(http.StatusTemporaryRedirect),
+		"StatusText":                          reflect.ValueOf(http.StatusText),
+		"StatusTooEarly":                      reflect.ValueOf(http.StatusTooEarly),
+		"StatusTooManyRequests":               reflect.ValueOf(http.StatusTooManyRequests),
+		"StatusUnauthorized":                  reflect.ValueOf(http.StatusUnauthorized),
+		"StatusUnavailableForLegalReasons":    reflect.ValueOf(http.StatusUnavailableForLegalReasons),
+		"StatusUnprocessableEntity":           reflect.ValueOf(http.StatusUnprocessableEntity),
+		"StatusUnsupportedMediaType":          reflect.ValueOf(http.StatusUnsupportedMediaType),
+		"StatusUpgradeRequired":               reflect.ValueOf(http.StatusUpgradeRequired),
+		"StatusUseProxy":                      reflect.ValueOf(http.StatusUseProxy),
+		"StatusVariantAlsoNegotiates":         reflect.ValueOf(http.StatusVariantAlsoNegotiates),
+		"TimeFormat":                          reflect.ValueOf(http.TimeFormat),
+
+		// type definitions
+		"Client":   reflect.ValueOf((*Client)(nil)),
+		"Cookie":   reflect.ValueOf((*http.Cookie)(nil)),
+		"Header":   reflect.ValueOf((*http.Header)(nil)),
+		"Request":  reflect.ValueOf((*http.Request)(nil)),
+		"Response": reflect.ValueOf((*http.Response)(nil)),
+		"SameSite": reflect.ValueOf((*http.SameSite)(nil)),
+	}
+}
+
+// dial connects to address on network, as net.Dial.
+func (n *Net) dial(network, address string) (net.Conn, error) {
+	return n.DialContext(context.Background(), network, address)
+}
+
+// dialTimeout connects to address on network, as net.DialTimeout.
+func (n *Net) dialTimeout(network, address string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return n.DialContext(ctx, network, address)
+}
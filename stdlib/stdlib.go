@@ -1,8 +1,13 @@
-// +build go1.11,!go1.13
+//go:build go1.26 && !go1.28
+// +build go1.26,!go1.28
 
 package stdlib
 
-import "reflect"
+import (
+	"reflect"
+
+	"github.com/containous/yaegi/stdlib/minimal"
+)
 
 // Symbols variable stores the map of stdlib symbols per package
 var Symbols = map[string]map[string]reflect.Value{}
@@ -11,38 +16,47 @@ func init() {
 	Symbols["github.com/containous/yaegi/stdlib"] = map[string]reflect.Value{
 		"Symbols": reflect.ValueOf(Symbols),
 	}
+	for path, values := range minimal.Symbols {
+		Symbols[path] = values
+	}
 }
 
 // Provide access to go standard library (http://golang.org/pkg/)
+//
+// The symbols of the packages of package minimal are included. Groups of
+// large packages, by the first element of their import path, can be
+// excluded from a build with a yaegi_no<group> tag, as yaegi_nonet for net
+// and its subpackages, along with expvar, log/syslog and crypto/tls:
+// archive, compress, crypto, database, encoding, html, image, mime and net.
+//
+// The generic packages cmp, iter, maps and slices have no symbols here: they
+// are interpreted from source when imported.
 
 //go:generate ../cmd/goexports/goexports archive/tar archive/zip
-//go:generate ../cmd/goexports/goexports bufio bytes
 //go:generate ../cmd/goexports/goexports compress/bzip2 compress/flate compress/gzip compress/lzw compress/zlib
-//go:generate ../cmd/goexports/goexports container/heap container/list container/ring
-//go:generate ../cmd/goexports/goexports context crypto crypto/aes crypto/cipher crypto/des crypto/dsa
+//go:generate ../cmd/goexports/goexports crypto crypto/aes crypto/cipher crypto/des crypto/dsa
 //go:generate ../cmd/goexports/goexports crypto/ecdsa crypto/elliptic crypto/hmac crypto/md5 crypto/rand
 //go:generate ../cmd/goexports/goexports crypto/rc4 crypto/rsa crypto/sha1 crypto/sha256 crypto/sha512
 //go:generate ../cmd/goexports/goexports crypto/subtle crypto/tls crypto/x509 crypto/x509/pkix
 //go:generate ../cmd/goexports/goexports database/sql database/sql/driver
+//go:generate ../cmd/goexports/goexports embed
 //go:generate ../cmd/goexports/goexports encoding encoding/ascii85 encoding/asn1 encoding/base32
 //go:generate ../cmd/goexports/goexports encoding/base64 encoding/binary encoding/csv encoding/gob
 //go:generate ../cmd/goexports/goexports encoding/hex encoding/json encoding/pem encoding/xml
-//go:generate ../cmd/goexports/goexports errors expvar flag fmt
+//go:generate ../cmd/goexports/goexports expvar flag
 //go:generate ../cmd/goexports/goexports go/ast go/build go/constant go/doc go/format go/importer
 //go:generate ../cmd/goexports/goexports go/parser go/printer go/scanner go/token go/types
 //go:generate ../cmd/goexports/goexports hash hash/adler32 hash/crc32 hash/crc64 hash/fnv
 //go:generate ../cmd/goexports/goexports html html/template
 //go:generate ../cmd/goexports/goexports image image/color image/color/palette
 //go:generate ../cmd/goexports/goexports image/draw image/gif image/jpeg image/png
-//go:generate ../cmd/goexports/goexports index/suffixarray io io/ioutil log log/syslog
-//go:generate ../cmd/goexports/goexports math math/big math/bits math/cmplx math/rand
+//go:generate ../cmd/goexports/goexports index/suffixarray io/fs io/ioutil log log/slog log/syslog
+//go:generate ../cmd/goexports/goexports math/big
 //go:generate ../cmd/goexports/goexports mime mime/multipart mime/quotedprintable
 //go:generate ../cmd/goexports/goexports net net/http net/http/cgi net/http/cookiejar net/http/fcgi
 //go:generate ../cmd/goexports/goexports net/http/httptest net/http/httptrace net/http/httputil
 //go:generate ../cmd/goexports/goexports net/mail net/rpc net/rpc/jsonrpc net/smtp net/textproto net/url
 //go:generate ../cmd/goexports/goexports os os/exec os/signal os/user
-//go:generate ../cmd/goexports/goexports path path/filepath reflect regexp regexp/syntax
+//go:generate ../cmd/goexports/goexports path/filepath reflect regexp regexp/syntax
 //go:generate ../cmd/goexports/goexports runtime runtime/debug
-//go:generate ../cmd/goexports/goexports sort strconv strings sync sync/atomic
-//go:generate ../cmd/goexports/goexports text/scanner text/tabwriter text/template text/template/parse
-//go:generate ../cmd/goexports/goexports time unicode unicode/utf16 unicode/utf8
+//go:generate ../cmd/goexports/goexports testing text/scanner text/tabwriter text/template text/template/parse
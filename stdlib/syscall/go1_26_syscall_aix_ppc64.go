@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -28,6 +29,7 @@ func init() {
 		"AF_ISO":                        reflect.ValueOf(syscall.AF_ISO),
 		"AF_LAT":                        reflect.ValueOf(syscall.AF_LAT),
 		"AF_LINK":                       reflect.ValueOf(syscall.AF_LINK),
+		"AF_LOCAL":                      reflect.ValueOf(syscall.AF_LOCAL),
 		"AF_MAX":                        reflect.ValueOf(syscall.AF_MAX),
 		"AF_NDD":                        reflect.ValueOf(syscall.AF_NDD),
 		"AF_NETWARE":                    reflect.ValueOf(syscall.AF_NETWARE),
@@ -44,6 +46,7 @@ func init() {
 		"ARPHRD_ETHER":                  reflect.ValueOf(syscall.ARPHRD_ETHER),
 		"ARPHRD_FDDI":                   reflect.ValueOf(syscall.ARPHRD_FDDI),
 		"Accept":                        reflect.ValueOf(syscall.Accept),
+		"Access":                        reflect.ValueOf(syscall.Access),
 		"Acct":                          reflect.ValueOf(syscall.Acct),
 		"B0":                            reflect.ValueOf(syscall.B0),
 		"B110":                          reflect.ValueOf(syscall.B110),
@@ -61,17 +64,28 @@ func init() {
 		"B600":                          reflect.ValueOf(syscall.B600),
 		"B75":                           reflect.ValueOf(syscall.B75),
 		"B9600":                         reflect.ValueOf(syscall.B9600),
+		"BRKINT":                        reflect.ValueOf(syscall.BRKINT),
 		"Bind":                          reflect.ValueOf(syscall.Bind),
 		"BytePtrFromString":             reflect.ValueOf(syscall.BytePtrFromString),
 		"ByteSliceFromString":           reflect.ValueOf(syscall.ByteSliceFromString),
 		"CFLUSH":                        reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                        reflect.ValueOf(syscall.CLOCAL),
+		"CREAD":                         reflect.ValueOf(syscall.CREAD),
+		"CS5":                           reflect.ValueOf(syscall.CS5),
+		"CS6":                           reflect.ValueOf(syscall.CS6),
+		"CS7":                           reflect.ValueOf(syscall.CS7),
+		"CS8":                           reflect.ValueOf(syscall.CS8),
 		"CSIOCGIFCONF":                  reflect.ValueOf(syscall.CSIOCGIFCONF),
+		"CSIZE":                         reflect.ValueOf(syscall.CSIZE),
+		"CSMAP_DIR":                     reflect.ValueOf(syscall.CSMAP_DIR),
 		"CSTART":                        reflect.ValueOf(syscall.CSTART),
 		"CSTOP":                         reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                        reflect.ValueOf(syscall.CSTOPB),
 		"CSUSP":                         reflect.ValueOf(syscall.CSUSP),
 		"Chdir":                         reflect.ValueOf(syscall.Chdir),
 		"Chmod":                         reflect.ValueOf(syscall.Chmod),
 		"Chown":                         reflect.ValueOf(syscall.Chown),
+		"Chroot":                        reflect.ValueOf(syscall.Chroot),
 		"Clearenv":                      reflect.ValueOf(syscall.Clearenv),
 		"Close":                         reflect.ValueOf(syscall.Close),
 		"CloseOnExec":                   reflect.ValueOf(syscall.CloseOnExec),
@@ -79,6 +93,7 @@ func init() {
 		"CmsgSpace":                     reflect.ValueOf(syscall.CmsgSpace),
 		"Connect":                       reflect.ValueOf(syscall.Connect),
 		"Dup":                           reflect.ValueOf(syscall.Dup),
+		"Dup2":                          reflect.ValueOf(syscall.Dup2),
 		"E2BIG":                         reflect.ValueOf(syscall.E2BIG),
 		"EACCES":                        reflect.ValueOf(syscall.EACCES),
 		"EADDRINUSE":                    reflect.ValueOf(syscall.EADDRINUSE),
@@ -92,6 +107,12 @@ func init() {
 		"ECANCELED":                     reflect.ValueOf(syscall.ECANCELED),
 		"ECHILD":                        reflect.ValueOf(syscall.ECHILD),
 		"ECHO":                          reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                       reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                         reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                         reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                        reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                        reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                       reflect.ValueOf(syscall.ECHOPRT),
 		"ECHRNG":                        reflect.ValueOf(syscall.ECHRNG),
 		"ECH_ICMPID":                    reflect.ValueOf(syscall.ECH_ICMPID),
 		"ECLONEME":                      reflect.ValueOf(syscall.ECLONEME),
@@ -270,17 +291,23 @@ func init() {
 		"Getpeername":                   reflect.ValueOf(syscall.Getpeername),
 		"Getpid":                        reflect.ValueOf(syscall.Getpid),
 		"Getppid":                       reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                   reflect.ValueOf(syscall.Getpriority),
 		"Getrlimit":                     reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                     reflect.ValueOf(syscall.Getrusage),
 		"Getsockname":                   reflect.ValueOf(syscall.Getsockname),
 		"GetsockoptInt":                 reflect.ValueOf(syscall.GetsockoptInt),
 		"Gettimeofday":                  reflect.ValueOf(syscall.Gettimeofday),
 		"Getuid":                        reflect.ValueOf(syscall.Getuid),
 		"Getwd":                         reflect.ValueOf(syscall.Getwd),
+		"HUPCL":                         reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                        reflect.ValueOf(syscall.ICANON),
 		"ICMP6_FILTER":                  reflect.ValueOf(syscall.ICMP6_FILTER),
 		"ICMP6_SEC_SEND_DEL":            reflect.ValueOf(syscall.ICMP6_SEC_SEND_DEL),
 		"ICMP6_SEC_SEND_GET":            reflect.ValueOf(syscall.ICMP6_SEC_SEND_GET),
 		"ICMP6_SEC_SEND_SET":            reflect.ValueOf(syscall.ICMP6_SEC_SEND_SET),
 		"ICMP6_SEC_SEND_SET_CGA_ADDR":   reflect.ValueOf(syscall.ICMP6_SEC_SEND_SET_CGA_ADDR),
+		"ICRNL":                         reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                        reflect.ValueOf(syscall.IEXTEN),
 		"IFA_FIRSTALIAS":                reflect.ValueOf(syscall.IFA_FIRSTALIAS),
 		"IFA_ROUTE":                     reflect.ValueOf(syscall.IFA_ROUTE),
 		"IFF_64BIT":                     reflect.ValueOf(syscall.IFF_64BIT),
@@ -384,6 +411,12 @@ func init() {
 		"IFT_X25DDN":                    reflect.ValueOf(syscall.IFT_X25DDN),
 		"IFT_X25PLE":                    reflect.ValueOf(syscall.IFT_X25PLE),
 		"IFT_XETHER":                    reflect.ValueOf(syscall.IFT_XETHER),
+		"IGNBRK":                        reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                         reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                        reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                       reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                         reflect.ValueOf(syscall.INLCR),
+		"INPCK":                         reflect.ValueOf(syscall.INPCK),
 		"IN_CLASSA_HOST":                reflect.ValueOf(syscall.IN_CLASSA_HOST),
 		"IN_CLASSA_MAX":                 reflect.ValueOf(syscall.IN_CLASSA_MAX),
 		"IN_CLASSA_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
@@ -537,6 +570,11 @@ func init() {
 		"IP_TTL":                        reflect.ValueOf(syscall.IP_TTL),
 		"IP_UNBLOCK_SOURCE":             reflect.ValueOf(syscall.IP_UNBLOCK_SOURCE),
 		"IP_UNICAST_HOPS":               reflect.ValueOf(syscall.IP_UNICAST_HOPS),
+		"ISIG":                          reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                        reflect.ValueOf(syscall.ISTRIP),
+		"IXANY":                         reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                         reflect.ValueOf(syscall.IXOFF),
+		"IXON":                          reflect.ValueOf(syscall.IXON),
 		"I_FLUSH":                       reflect.ValueOf(syscall.I_FLUSH),
 		"ImplementsGetwd":               reflect.ValueOf(syscall.ImplementsGetwd),
 		"Kill":                          reflect.ValueOf(syscall.Kill),
@@ -592,9 +630,18 @@ func init() {
 		"Mknodat":                       reflect.ValueOf(syscall.Mknodat),
 		"Mmap":                          reflect.ValueOf(syscall.Mmap),
 		"Munmap":                        reflect.ValueOf(syscall.Munmap),
+		"NOFLSH":                        reflect.ValueOf(syscall.NOFLSH),
 		"NOFLUSH":                       reflect.ValueOf(uint32(syscall.NOFLUSH)),
 		"NsecToTimespec":                reflect.ValueOf(syscall.NsecToTimespec),
 		"NsecToTimeval":                 reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                         reflect.ValueOf(syscall.OCRNL),
+		"OFDEL":                         reflect.ValueOf(syscall.OFDEL),
+		"OFILL":                         reflect.ValueOf(syscall.OFILL),
+		"ONLCR":                         reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                        reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                         reflect.ValueOf(syscall.ONOCR),
+		"ONOEOT":                        reflect.ValueOf(syscall.ONOEOT),
+		"OPOST":                         reflect.ValueOf(syscall.OPOST),
 		"O_ACCMODE":                     reflect.ValueOf(syscall.O_ACCMODE),
 		"O_APPEND":                      reflect.ValueOf(syscall.O_APPEND),
 		"O_CIO":                         reflect.ValueOf(syscall.O_CIO),
@@ -631,6 +678,10 @@ func init() {
 		"O_WRONLY":                      reflect.ValueOf(syscall.O_WRONLY),
 		"Open":                          reflect.ValueOf(syscall.Open),
 		"Openat":                        reflect.ValueOf(syscall.Openat),
+		"PARENB":                        reflect.ValueOf(syscall.PARENB),
+		"PAREXT":                        reflect.ValueOf(syscall.PAREXT),
+		"PARMRK":                        reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                        reflect.ValueOf(syscall.PARODD),
 		"PENDIN":                        reflect.ValueOf(syscall.PENDIN),
 		"PRIO_PGRP":                     reflect.ValueOf(syscall.PRIO_PGRP),
 		"PRIO_PROCESS":                  reflect.ValueOf(syscall.PRIO_PROCESS),
@@ -1078,8 +1129,10 @@ func init() {
 		"Setgid":                        reflect.ValueOf(syscall.Setgid),
 		"Setgroups":                     reflect.ValueOf(syscall.Setgroups),
 		"Setpgid":                       reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                   reflect.ValueOf(syscall.Setpriority),
 		"Setregid":                      reflect.ValueOf(syscall.Setregid),
 		"Setreuid":                      reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                     reflect.ValueOf(syscall.Setrlimit),
 		"SetsockoptByte":                reflect.ValueOf(syscall.SetsockoptByte),
 		"SetsockoptICMPv6Filter":        reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
 		"SetsockoptIPMreq":              reflect.ValueOf(syscall.SetsockoptIPMreq),
@@ -1089,6 +1142,7 @@ func init() {
 		"SetsockoptLinger":              reflect.ValueOf(syscall.SetsockoptLinger),
 		"SetsockoptString":              reflect.ValueOf(syscall.SetsockoptString),
 		"SetsockoptTimeval":             reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Setuid":                        reflect.ValueOf(syscall.Setuid),
 		"Shutdown":                      reflect.ValueOf(syscall.Shutdown),
 		"SizeofCmsghdr":                 reflect.ValueOf(syscall.SizeofCmsghdr),
 		"SizeofICMPv6Filter":            reflect.ValueOf(syscall.SizeofICMPv6Filter),
@@ -1098,6 +1152,7 @@ func init() {
 		"SizeofLinger":                  reflect.ValueOf(syscall.SizeofLinger),
 		"SizeofMsghdr":                  reflect.ValueOf(syscall.SizeofMsghdr),
 		"SizeofSockaddrAny":             reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrDatalink":        reflect.ValueOf(syscall.SizeofSockaddrDatalink),
 		"SizeofSockaddrInet4":           reflect.ValueOf(syscall.SizeofSockaddrInet4),
 		"SizeofSockaddrInet6":           reflect.ValueOf(syscall.SizeofSockaddrInet6),
 		"SizeofSockaddrUnix":            reflect.ValueOf(syscall.SizeofSockaddrUnix),
@@ -1117,6 +1172,9 @@ func init() {
 		"Symlink":                       reflect.ValueOf(syscall.Symlink),
 		"Syscall":                       reflect.ValueOf(syscall.Syscall),
 		"Syscall6":                      reflect.ValueOf(syscall.Syscall6),
+		"TCIFLUSH":                      reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                     reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                      reflect.ValueOf(syscall.TCOFLUSH),
 		"TCP_24DAYS_WORTH_OF_SLOWTICKS": reflect.ValueOf(syscall.TCP_24DAYS_WORTH_OF_SLOWTICKS),
 		"TCP_ACLADD":                    reflect.ValueOf(syscall.TCP_ACLADD),
 		"TCP_ACLBIND":                   reflect.ValueOf(syscall.TCP_ACLBIND),
@@ -1157,6 +1215,7 @@ func init() {
 		"TCP_STDURG":                    reflect.ValueOf(syscall.TCP_STDURG),
 		"TCP_TIMESTAMP_OPTLEN":          reflect.ValueOf(syscall.TCP_TIMESTAMP_OPTLEN),
 		"TCP_UNSETPRIV":                 reflect.ValueOf(syscall.TCP_UNSETPRIV),
+		"TCSAFLUSH":                     reflect.ValueOf(syscall.TCSAFLUSH),
 		"TIOCCBRK":                      reflect.ValueOf(syscall.TIOCCBRK),
 		"TIOCCDTR":                      reflect.ValueOf(syscall.TIOCCDTR),
 		"TIOCCONS":                      reflect.ValueOf(uint64(syscall.TIOCCONS)),
@@ -1232,7 +1291,28 @@ func init() {
 		"Unsetenv":                      reflect.ValueOf(syscall.Unsetenv),
 		"Utimes":                        reflect.ValueOf(syscall.Utimes),
 		"UtimesNano":                    reflect.ValueOf(syscall.UtimesNano),
+		"VDISCRD":                       reflect.ValueOf(syscall.VDISCRD),
+		"VDSUSP":                        reflect.ValueOf(syscall.VDSUSP),
+		"VEOF":                          reflect.ValueOf(syscall.VEOF),
+		"VEOL":                          reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                         reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                        reflect.ValueOf(syscall.VERASE),
+		"VINTR":                         reflect.ValueOf(syscall.VINTR),
+		"VKILL":                         reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                        reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                          reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                         reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                      reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                        reflect.ValueOf(syscall.VSTART),
+		"VSTOP":                         reflect.ValueOf(syscall.VSTOP),
+		"VSTRT":                         reflect.ValueOf(syscall.VSTRT),
+		"VSUSP":                         reflect.ValueOf(syscall.VSUSP),
+		"VT0":                           reflect.ValueOf(syscall.VT0),
+		"VT1":                           reflect.ValueOf(syscall.VT1),
 		"VTDELAY":                       reflect.ValueOf(syscall.VTDELAY),
+		"VTDLY":                         reflect.ValueOf(syscall.VTDLY),
+		"VTIME":                         reflect.ValueOf(syscall.VTIME),
+		"VWERSE":                        reflect.ValueOf(syscall.VWERSE),
 		"WPARSTART":                     reflect.ValueOf(syscall.WPARSTART),
 		"WPARSTOP":                      reflect.ValueOf(syscall.WPARSTOP),
 		"WPARTTYNAME":                   reflect.ValueOf(syscall.WPARTTYNAME),
@@ -1258,6 +1338,7 @@ func init() {
 		"RawConn":              reflect.ValueOf((*syscall.RawConn)(nil)),
 		"RawSockaddr":          reflect.ValueOf((*syscall.RawSockaddr)(nil)),
 		"RawSockaddrAny":       reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrDatalink":  reflect.ValueOf((*syscall.RawSockaddrDatalink)(nil)),
 		"RawSockaddrInet4":     reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
 		"RawSockaddrInet6":     reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
 		"RawSockaddrUnix":      reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
@@ -1265,6 +1346,7 @@ func init() {
 		"Rusage":               reflect.ValueOf((*syscall.Rusage)(nil)),
 		"Signal":               reflect.ValueOf((*syscall.Signal)(nil)),
 		"Sockaddr":             reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrDatalink":     reflect.ValueOf((*syscall.SockaddrDatalink)(nil)),
 		"SockaddrInet4":        reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
 		"SockaddrInet6":        reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
 		"SockaddrUnix":         reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
@@ -1273,6 +1355,7 @@ func init() {
 		"Stat_t":               reflect.ValueOf((*syscall.Stat_t)(nil)),
 		"Statfs_t":             reflect.ValueOf((*syscall.Statfs_t)(nil)),
 		"SysProcAttr":          reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Termios":              reflect.ValueOf((*syscall.Termios)(nil)),
 		"Timespec":             reflect.ValueOf((*syscall.Timespec)(nil)),
 		"Timeval":              reflect.ValueOf((*syscall.Timeval)(nil)),
 		"Timeval32":            reflect.ValueOf((*syscall.Timeval32)(nil)),
@@ -1307,4 +1390,5 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
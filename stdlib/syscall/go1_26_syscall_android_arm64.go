@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -124,6 +125,8 @@ func init() {
 		"Access":                           reflect.ValueOf(syscall.Access),
 		"Acct":                             reflect.ValueOf(syscall.Acct),
 		"Adjtimex":                         reflect.ValueOf(syscall.Adjtimex),
+		"AllThreadsSyscall":                reflect.ValueOf(syscall.AllThreadsSyscall),
+		"AllThreadsSyscall6":               reflect.ValueOf(syscall.AllThreadsSyscall6),
 		"AttachLsf":                        reflect.ValueOf(syscall.AttachLsf),
 		"B0":                               reflect.ValueOf(syscall.B0),
 		"B1000000":                         reflect.ValueOf(syscall.B1000000),
@@ -207,18 +210,23 @@ func init() {
 		"CLOCAL":                           reflect.ValueOf(syscall.CLOCAL),
 		"CLONE_CHILD_CLEARTID":             reflect.ValueOf(syscall.CLONE_CHILD_CLEARTID),
 		"CLONE_CHILD_SETTID":               reflect.ValueOf(syscall.CLONE_CHILD_SETTID),
+		"CLONE_CLEAR_SIGHAND":              reflect.ValueOf(int64(syscall.CLONE_CLEAR_SIGHAND)),
 		"CLONE_DETACHED":                   reflect.ValueOf(syscall.CLONE_DETACHED),
 		"CLONE_FILES":                      reflect.ValueOf(syscall.CLONE_FILES),
 		"CLONE_FS":                         reflect.ValueOf(syscall.CLONE_FS),
+		"CLONE_INTO_CGROUP":                reflect.ValueOf(int64(syscall.CLONE_INTO_CGROUP)),
 		"CLONE_IO":                         reflect.ValueOf(uint32(syscall.CLONE_IO)),
+		"CLONE_NEWCGROUP":                  reflect.ValueOf(syscall.CLONE_NEWCGROUP),
 		"CLONE_NEWIPC":                     reflect.ValueOf(syscall.CLONE_NEWIPC),
 		"CLONE_NEWNET":                     reflect.ValueOf(syscall.CLONE_NEWNET),
 		"CLONE_NEWNS":                      reflect.ValueOf(syscall.CLONE_NEWNS),
 		"CLONE_NEWPID":                     reflect.ValueOf(syscall.CLONE_NEWPID),
+		"CLONE_NEWTIME":                    reflect.ValueOf(syscall.CLONE_NEWTIME),
 		"CLONE_NEWUSER":                    reflect.ValueOf(syscall.CLONE_NEWUSER),
 		"CLONE_NEWUTS":                     reflect.ValueOf(syscall.CLONE_NEWUTS),
 		"CLONE_PARENT":                     reflect.ValueOf(syscall.CLONE_PARENT),
 		"CLONE_PARENT_SETTID":              reflect.ValueOf(syscall.CLONE_PARENT_SETTID),
+		"CLONE_PIDFD":                      reflect.ValueOf(syscall.CLONE_PIDFD),
 		"CLONE_PTRACE":                     reflect.ValueOf(syscall.CLONE_PTRACE),
 		"CLONE_SETTLS":                     reflect.ValueOf(syscall.CLONE_SETTLS),
 		"CLONE_SIGHAND":                    reflect.ValueOf(syscall.CLONE_SIGHAND),
@@ -1719,11 +1727,9 @@ func init() {
 		"SYS_DELETE_MODULE":                reflect.ValueOf(syscall.SYS_DELETE_MODULE),
 		"SYS_DUP":                          reflect.ValueOf(syscall.SYS_DUP),
 		"SYS_DUP3":                         reflect.ValueOf(syscall.SYS_DUP3),
-		"SYS_EPOLL_CREATE":                 reflect.ValueOf(syscall.SYS_EPOLL_CREATE),
 		"SYS_EPOLL_CREATE1":                reflect.ValueOf(syscall.SYS_EPOLL_CREATE1),
 		"SYS_EPOLL_CTL":                    reflect.ValueOf(syscall.SYS_EPOLL_CTL),
 		"SYS_EPOLL_PWAIT":                  reflect.ValueOf(syscall.SYS_EPOLL_PWAIT),
-		"SYS_EPOLL_WAIT":                   reflect.ValueOf(syscall.SYS_EPOLL_WAIT),
 		"SYS_EVENTFD2":                     reflect.ValueOf(syscall.SYS_EVENTFD2),
 		"SYS_EXECVE":                       reflect.ValueOf(syscall.SYS_EXECVE),
 		"SYS_EXECVEAT":                     reflect.ValueOf(syscall.SYS_EXECVEAT),
@@ -1753,7 +1759,6 @@ func init() {
 		"SYS_FSYNC":                        reflect.ValueOf(syscall.SYS_FSYNC),
 		"SYS_FTRUNCATE":                    reflect.ValueOf(syscall.SYS_FTRUNCATE),
 		"SYS_FUTEX":                        reflect.ValueOf(syscall.SYS_FUTEX),
-		"SYS_FUTIMESAT":                    reflect.ValueOf(syscall.SYS_FUTIMESAT),
 		"SYS_GETCPU":                       reflect.ValueOf(syscall.SYS_GETCPU),
 		"SYS_GETCWD":                       reflect.ValueOf(syscall.SYS_GETCWD),
 		"SYS_GETDENTS64":                   reflect.ValueOf(syscall.SYS_GETDENTS64),
@@ -1764,7 +1769,6 @@ func init() {
 		"SYS_GETITIMER":                    reflect.ValueOf(syscall.SYS_GETITIMER),
 		"SYS_GETPEERNAME":                  reflect.ValueOf(syscall.SYS_GETPEERNAME),
 		"SYS_GETPGID":                      reflect.ValueOf(syscall.SYS_GETPGID),
-		"SYS_GETPGRP":                      reflect.ValueOf(syscall.SYS_GETPGRP),
 		"SYS_GETPID":                       reflect.ValueOf(syscall.SYS_GETPID),
 		"SYS_GETPPID":                      reflect.ValueOf(syscall.SYS_GETPPID),
 		"SYS_GETPRIORITY":                  reflect.ValueOf(syscall.SYS_GETPRIORITY),
@@ -1798,7 +1802,6 @@ func init() {
 		"SYS_KEXEC_LOAD":                   reflect.ValueOf(syscall.SYS_KEXEC_LOAD),
 		"SYS_KEYCTL":                       reflect.ValueOf(syscall.SYS_KEYCTL),
 		"SYS_KILL":                         reflect.ValueOf(syscall.SYS_KILL),
-		"SYS_LCHOWN":                       reflect.ValueOf(syscall.SYS_LCHOWN),
 		"SYS_LGETXATTR":                    reflect.ValueOf(syscall.SYS_LGETXATTR),
 		"SYS_LINKAT":                       reflect.ValueOf(syscall.SYS_LINKAT),
 		"SYS_LISTEN":                       reflect.ValueOf(syscall.SYS_LISTEN),
@@ -1841,7 +1844,6 @@ func init() {
 		"SYS_NFSSERVCTL":                   reflect.ValueOf(syscall.SYS_NFSSERVCTL),
 		"SYS_OPENAT":                       reflect.ValueOf(syscall.SYS_OPENAT),
 		"SYS_OPEN_BY_HANDLE_AT":            reflect.ValueOf(syscall.SYS_OPEN_BY_HANDLE_AT),
-		"SYS_PAUSE":                        reflect.ValueOf(syscall.SYS_PAUSE),
 		"SYS_PERF_EVENT_OPEN":              reflect.ValueOf(syscall.SYS_PERF_EVENT_OPEN),
 		"SYS_PERSONALITY":                  reflect.ValueOf(syscall.SYS_PERSONALITY),
 		"SYS_PIPE2":                        reflect.ValueOf(syscall.SYS_PIPE2),
@@ -1946,7 +1948,6 @@ func init() {
 		"SYS_SYSLOG":                       reflect.ValueOf(syscall.SYS_SYSLOG),
 		"SYS_TEE":                          reflect.ValueOf(syscall.SYS_TEE),
 		"SYS_TGKILL":                       reflect.ValueOf(syscall.SYS_TGKILL),
-		"SYS_TIME":                         reflect.ValueOf(syscall.SYS_TIME),
 		"SYS_TIMERFD_CREATE":               reflect.ValueOf(syscall.SYS_TIMERFD_CREATE),
 		"SYS_TIMERFD_GETTIME":              reflect.ValueOf(syscall.SYS_TIMERFD_GETTIME),
 		"SYS_TIMERFD_SETTIME":              reflect.ValueOf(syscall.SYS_TIMERFD_SETTIME),
@@ -1963,10 +1964,7 @@ func init() {
 		"SYS_UNAME":                        reflect.ValueOf(syscall.SYS_UNAME),
 		"SYS_UNLINKAT":                     reflect.ValueOf(syscall.SYS_UNLINKAT),
 		"SYS_UNSHARE":                      reflect.ValueOf(syscall.SYS_UNSHARE),
-		"SYS_USTAT":                        reflect.ValueOf(syscall.SYS_USTAT),
-		"SYS_UTIME":                        reflect.ValueOf(syscall.SYS_UTIME),
 		"SYS_UTIMENSAT":                    reflect.ValueOf(syscall.SYS_UTIMENSAT),
-		"SYS_UTIMES":                       reflect.ValueOf(syscall.SYS_UTIMES),
 		"SYS_VHANGUP":                      reflect.ValueOf(syscall.SYS_VHANGUP),
 		"SYS_VMSPLICE":                     reflect.ValueOf(syscall.SYS_VMSPLICE),
 		"SYS_WAIT4":                        reflect.ValueOf(syscall.SYS_WAIT4),
@@ -2009,7 +2007,9 @@ func init() {
 		"SetLsfPromisc":                    reflect.ValueOf(syscall.SetLsfPromisc),
 		"SetNonblock":                      reflect.ValueOf(syscall.SetNonblock),
 		"Setdomainname":                    reflect.ValueOf(syscall.Setdomainname),
+		"Setegid":                          reflect.ValueOf(syscall.Setegid),
 		"Setenv":                           reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                          reflect.ValueOf(syscall.Seteuid),
 		"Setfsgid":                         reflect.ValueOf(syscall.Setfsgid),
 		"Setfsuid":                         reflect.ValueOf(syscall.Setfsuid),
 		"Setgid":                           reflect.ValueOf(syscall.Setgid),
@@ -2236,7 +2236,6 @@ func init() {
 		"Unmount":                          reflect.ValueOf(syscall.Unmount),
 		"Unsetenv":                         reflect.ValueOf(syscall.Unsetenv),
 		"Unshare":                          reflect.ValueOf(syscall.Unshare),
-		"Ustat":                            reflect.ValueOf(syscall.Ustat),
 		"Utime":                            reflect.ValueOf(syscall.Utime),
 		"Utimes":                           reflect.ValueOf(syscall.Utimes),
 		"UtimesNano":                       reflect.ValueOf(syscall.UtimesNano),
@@ -2374,4 +2373,5 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
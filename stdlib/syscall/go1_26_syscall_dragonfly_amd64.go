@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -1039,6 +1040,7 @@ func init() {
 		"MAP_VPAGETABLE":                    reflect.ValueOf(syscall.MAP_VPAGETABLE),
 		"MCL_CURRENT":                       reflect.ValueOf(syscall.MCL_CURRENT),
 		"MCL_FUTURE":                        reflect.ValueOf(syscall.MCL_FUTURE),
+		"MSG_CMSG_CLOEXEC":                  reflect.ValueOf(syscall.MSG_CMSG_CLOEXEC),
 		"MSG_CTRUNC":                        reflect.ValueOf(syscall.MSG_CTRUNC),
 		"MSG_DONTROUTE":                     reflect.ValueOf(syscall.MSG_DONTROUTE),
 		"MSG_DONTWAIT":                      reflect.ValueOf(syscall.MSG_DONTWAIT),
@@ -1146,6 +1148,7 @@ func init() {
 		"ParseUnixRights":                   reflect.ValueOf(syscall.ParseUnixRights),
 		"Pathconf":                          reflect.ValueOf(syscall.Pathconf),
 		"Pipe":                              reflect.ValueOf(syscall.Pipe),
+		"Pipe2":                             reflect.ValueOf(syscall.Pipe2),
 		"Pread":                             reflect.ValueOf(syscall.Pread),
 		"Pwrite":                            reflect.ValueOf(syscall.Pwrite),
 		"RLIMIT_AS":                         reflect.ValueOf(syscall.RLIMIT_AS),
@@ -1555,6 +1558,7 @@ func init() {
 		"SYS_OPENBSD_POLL":                  reflect.ValueOf(syscall.SYS_OPENBSD_POLL),
 		"SYS_PATHCONF":                      reflect.ValueOf(syscall.SYS_PATHCONF),
 		"SYS_PIPE":                          reflect.ValueOf(syscall.SYS_PIPE),
+		"SYS_PIPE2":                         reflect.ValueOf(syscall.SYS_PIPE2),
 		"SYS_POLL":                          reflect.ValueOf(syscall.SYS_POLL),
 		"SYS_PROFIL":                        reflect.ValueOf(syscall.SYS_PROFIL),
 		"SYS_PSELECT":                       reflect.ValueOf(syscall.SYS_PSELECT),
@@ -1664,6 +1668,7 @@ func init() {
 		"SYS_VMSPACE_PWRITE":                reflect.ValueOf(syscall.SYS_VMSPACE_PWRITE),
 		"SYS_VQUOTACTL":                     reflect.ValueOf(syscall.SYS_VQUOTACTL),
 		"SYS_WAIT4":                         reflect.ValueOf(syscall.SYS_WAIT4),
+		"SYS_WAIT6":                         reflect.ValueOf(syscall.SYS_WAIT6),
 		"SYS_WRITE":                         reflect.ValueOf(syscall.SYS_WRITE),
 		"SYS_WRITEV":                        reflect.ValueOf(syscall.SYS_WRITEV),
 		"SYS_YIELD":                         reflect.ValueOf(syscall.SYS_YIELD),
@@ -1901,8 +1906,10 @@ func init() {
 		"VWERASE":                           reflect.ValueOf(syscall.VWERASE),
 		"WCONTINUED":                        reflect.ValueOf(syscall.WCONTINUED),
 		"WCOREFLAG":                         reflect.ValueOf(syscall.WCOREFLAG),
+		"WEXITED":                           reflect.ValueOf(syscall.WEXITED),
 		"WLINUXCLONE":                       reflect.ValueOf(uint32(syscall.WLINUXCLONE)),
 		"WNOHANG":                           reflect.ValueOf(syscall.WNOHANG),
+		"WNOWAIT":                           reflect.ValueOf(syscall.WNOWAIT),
 		"WSTOPPED":                          reflect.ValueOf(syscall.WSTOPPED),
 		"WUNTRACED":                         reflect.ValueOf(syscall.WUNTRACED),
 		"Wait4":                             reflect.ValueOf(syscall.Wait4),
@@ -1997,8 +2004,10 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
 type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
 }
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -1724,7 +1725,6 @@ func init() {
 		"SYS_LISTEN":                        reflect.ValueOf(syscall.SYS_LISTEN),
 		"SYS_LPATHCONF":                     reflect.ValueOf(syscall.SYS_LPATHCONF),
 		"SYS_LSEEK":                         reflect.ValueOf(syscall.SYS_LSEEK),
-		"SYS_LSTAT":                         reflect.ValueOf(syscall.SYS_LSTAT),
 		"SYS_LUTIMES":                       reflect.ValueOf(syscall.SYS_LUTIMES),
 		"SYS_MAC_SYSCALL":                   reflect.ValueOf(syscall.SYS_MAC_SYSCALL),
 		"SYS_MADVISE":                       reflect.ValueOf(syscall.SYS_MADVISE),
@@ -1855,7 +1855,6 @@ func init() {
 		"SYS_SOCKET":                        reflect.ValueOf(syscall.SYS_SOCKET),
 		"SYS_SOCKETPAIR":                    reflect.ValueOf(syscall.SYS_SOCKETPAIR),
 		"SYS_SSTK":                          reflect.ValueOf(syscall.SYS_SSTK),
-		"SYS_STAT":                          reflect.ValueOf(syscall.SYS_STAT),
 		"SYS_STATFS":                        reflect.ValueOf(syscall.SYS_STATFS),
 		"SYS_SWAPCONTEXT":                   reflect.ValueOf(syscall.SYS_SWAPCONTEXT),
 		"SYS_SWAPOFF":                       reflect.ValueOf(syscall.SYS_SWAPOFF),
@@ -2244,8 +2243,10 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
 type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
 }
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -0,0 +1,1511 @@
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_802":                        reflect.ValueOf(syscall.AF_802),
+		"AF_APPLETALK":                  reflect.ValueOf(syscall.AF_APPLETALK),
+		"AF_CCITT":                      reflect.ValueOf(syscall.AF_CCITT),
+		"AF_CHAOS":                      reflect.ValueOf(syscall.AF_CHAOS),
+		"AF_DATAKIT":                    reflect.ValueOf(syscall.AF_DATAKIT),
+		"AF_DECnet":                     reflect.ValueOf(syscall.AF_DECnet),
+		"AF_DLI":                        reflect.ValueOf(syscall.AF_DLI),
+		"AF_ECMA":                       reflect.ValueOf(syscall.AF_ECMA),
+		"AF_FILE":                       reflect.ValueOf(syscall.AF_FILE),
+		"AF_GOSIP":                      reflect.ValueOf(syscall.AF_GOSIP),
+		"AF_HYLINK":                     reflect.ValueOf(syscall.AF_HYLINK),
+		"AF_IMPLINK":                    reflect.ValueOf(syscall.AF_IMPLINK),
+		"AF_INET":                       reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                      reflect.ValueOf(syscall.AF_INET6),
+		"AF_INET_OFFLOAD":               reflect.ValueOf(syscall.AF_INET_OFFLOAD),
+		"AF_IPX":                        reflect.ValueOf(syscall.AF_IPX),
+		"AF_KEY":                        reflect.ValueOf(syscall.AF_KEY),
+		"AF_LAT":                        reflect.ValueOf(syscall.AF_LAT),
+		"AF_LINK":                       reflect.ValueOf(syscall.AF_LINK),
+		"AF_LOCAL":                      reflect.ValueOf(syscall.AF_LOCAL),
+		"AF_MAX":                        reflect.ValueOf(syscall.AF_MAX),
+		"AF_NBS":                        reflect.ValueOf(syscall.AF_NBS),
+		"AF_NCA":                        reflect.ValueOf(syscall.AF_NCA),
+		"AF_NIT":                        reflect.ValueOf(syscall.AF_NIT),
+		"AF_NS":                         reflect.ValueOf(syscall.AF_NS),
+		"AF_OSI":                        reflect.ValueOf(syscall.AF_OSI),
+		"AF_OSINET":                     reflect.ValueOf(syscall.AF_OSINET),
+		"AF_PACKET":                     reflect.ValueOf(syscall.AF_PACKET),
+		"AF_POLICY":                     reflect.ValueOf(syscall.AF_POLICY),
+		"AF_PUP":                        reflect.ValueOf(syscall.AF_PUP),
+		"AF_ROUTE":                      reflect.ValueOf(syscall.AF_ROUTE),
+		"AF_SNA":                        reflect.ValueOf(syscall.AF_SNA),
+		"AF_TRILL":                      reflect.ValueOf(syscall.AF_TRILL),
+		"AF_UNIX":                       reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                     reflect.ValueOf(syscall.AF_UNSPEC),
+		"AF_X25":                        reflect.ValueOf(syscall.AF_X25),
+		"ARPHRD_ARCNET":                 reflect.ValueOf(syscall.ARPHRD_ARCNET),
+		"ARPHRD_ATM":                    reflect.ValueOf(syscall.ARPHRD_ATM),
+		"ARPHRD_AX25":                   reflect.ValueOf(syscall.ARPHRD_AX25),
+		"ARPHRD_CHAOS":                  reflect.ValueOf(syscall.ARPHRD_CHAOS),
+		"ARPHRD_EETHER":                 reflect.ValueOf(syscall.ARPHRD_EETHER),
+		"ARPHRD_ETHER":                  reflect.ValueOf(syscall.ARPHRD_ETHER),
+		"ARPHRD_FC":                     reflect.ValueOf(syscall.ARPHRD_FC),
+		"ARPHRD_FRAME":                  reflect.ValueOf(syscall.ARPHRD_FRAME),
+		"ARPHRD_HDLC":                   reflect.ValueOf(syscall.ARPHRD_HDLC),
+		"ARPHRD_IB":                     reflect.ValueOf(syscall.ARPHRD_IB),
+		"ARPHRD_IEEE802":                reflect.ValueOf(syscall.ARPHRD_IEEE802),
+		"ARPHRD_IPATM":                  reflect.ValueOf(syscall.ARPHRD_IPATM),
+		"ARPHRD_METRICOM":               reflect.ValueOf(syscall.ARPHRD_METRICOM),
+		"ARPHRD_TUNNEL":                 reflect.ValueOf(syscall.ARPHRD_TUNNEL),
+		"Accept":                        reflect.ValueOf(syscall.Accept),
+		"Accept4":                       reflect.ValueOf(syscall.Accept4),
+		"Access":                        reflect.ValueOf(syscall.Access),
+		"Adjtime":                       reflect.ValueOf(syscall.Adjtime),
+		"B0":                            reflect.ValueOf(syscall.B0),
+		"B110":                          reflect.ValueOf(syscall.B110),
+		"B115200":                       reflect.ValueOf(syscall.B115200),
+		"B1200":                         reflect.ValueOf(syscall.B1200),
+		"B134":                          reflect.ValueOf(syscall.B134),
+		"B150":                          reflect.ValueOf(syscall.B150),
+		"B153600":                       reflect.ValueOf(syscall.B153600),
+		"B1800":                         reflect.ValueOf(syscall.B1800),
+		"B19200":                        reflect.ValueOf(syscall.B19200),
+		"B200":                          reflect.ValueOf(syscall.B200),
+		"B230400":                       reflect.ValueOf(syscall.B230400),
+		"B2400":                         reflect.ValueOf(syscall.B2400),
+		"B300":                          reflect.ValueOf(syscall.B300),
+		"B307200":                       reflect.ValueOf(syscall.B307200),
+		"B38400":                        reflect.ValueOf(syscall.B38400),
+		"B460800":                       reflect.ValueOf(syscall.B460800),
+		"B4800":                         reflect.ValueOf(syscall.B4800),
+		"B50":                           reflect.ValueOf(syscall.B50),
+		"B57600":                        reflect.ValueOf(syscall.B57600),
+		"B600":                          reflect.ValueOf(syscall.B600),
+		"B75":                           reflect.ValueOf(syscall.B75),
+		"B76800":                        reflect.ValueOf(syscall.B76800),
+		"B921600":                       reflect.ValueOf(syscall.B921600),
+		"B9600":                         reflect.ValueOf(syscall.B9600),
+		"BIOCFLUSH":                     reflect.ValueOf(syscall.BIOCFLUSH),
+		"BIOCGBLEN":                     reflect.ValueOf(syscall.BIOCGBLEN),
+		"BIOCGDLT":                      reflect.ValueOf(syscall.BIOCGDLT),
+		"BIOCGDLTLIST":                  reflect.ValueOf(syscall.BIOCGDLTLIST),
+		"BIOCGDLTLIST32":                reflect.ValueOf(syscall.BIOCGDLTLIST32),
+		"BIOCGETIF":                     reflect.ValueOf(syscall.BIOCGETIF),
+		"BIOCGETLIF":                    reflect.ValueOf(syscall.BIOCGETLIF),
+		"BIOCGHDRCMPLT":                 reflect.ValueOf(syscall.BIOCGHDRCMPLT),
+		"BIOCGRTIMEOUT":                 reflect.ValueOf(syscall.BIOCGRTIMEOUT),
+		"BIOCGRTIMEOUT32":               reflect.ValueOf(syscall.BIOCGRTIMEOUT32),
+		"BIOCGSEESENT":                  reflect.ValueOf(syscall.BIOCGSEESENT),
+		"BIOCGSTATS":                    reflect.ValueOf(syscall.BIOCGSTATS),
+		"BIOCGSTATSOLD":                 reflect.ValueOf(syscall.BIOCGSTATSOLD),
+		"BIOCIMMEDIATE":                 reflect.ValueOf(syscall.BIOCIMMEDIATE),
+		"BIOCPROMISC":                   reflect.ValueOf(syscall.BIOCPROMISC),
+		"BIOCSBLEN":                     reflect.ValueOf(syscall.BIOCSBLEN),
+		"BIOCSDLT":                      reflect.ValueOf(syscall.BIOCSDLT),
+		"BIOCSETF":                      reflect.ValueOf(syscall.BIOCSETF),
+		"BIOCSETF32":                    reflect.ValueOf(syscall.BIOCSETF32),
+		"BIOCSETIF":                     reflect.ValueOf(syscall.BIOCSETIF),
+		"BIOCSETLIF":                    reflect.ValueOf(syscall.BIOCSETLIF),
+		"BIOCSHDRCMPLT":                 reflect.ValueOf(syscall.BIOCSHDRCMPLT),
+		"BIOCSRTIMEOUT":                 reflect.ValueOf(syscall.BIOCSRTIMEOUT),
+		"BIOCSRTIMEOUT32":               reflect.ValueOf(syscall.BIOCSRTIMEOUT32),
+		"BIOCSSEESENT":                  reflect.ValueOf(syscall.BIOCSSEESENT),
+		"BIOCSTCPF":                     reflect.ValueOf(syscall.BIOCSTCPF),
+		"BIOCSUDPF":                     reflect.ValueOf(syscall.BIOCSUDPF),
+		"BIOCVERSION":                   reflect.ValueOf(syscall.BIOCVERSION),
+		"BPF_A":                         reflect.ValueOf(syscall.BPF_A),
+		"BPF_ABS":                       reflect.ValueOf(syscall.BPF_ABS),
+		"BPF_ADD":                       reflect.ValueOf(syscall.BPF_ADD),
+		"BPF_ALIGNMENT":                 reflect.ValueOf(syscall.BPF_ALIGNMENT),
+		"BPF_ALU":                       reflect.ValueOf(syscall.BPF_ALU),
+		"BPF_AND":                       reflect.ValueOf(syscall.BPF_AND),
+		"BPF_B":                         reflect.ValueOf(syscall.BPF_B),
+		"BPF_DFLTBUFSIZE":               reflect.ValueOf(syscall.BPF_DFLTBUFSIZE),
+		"BPF_DIV":                       reflect.ValueOf(syscall.BPF_DIV),
+		"BPF_H":                         reflect.ValueOf(syscall.BPF_H),
+		"BPF_IMM":                       reflect.ValueOf(syscall.BPF_IMM),
+		"BPF_IND":                       reflect.ValueOf(syscall.BPF_IND),
+		"BPF_JA":                        reflect.ValueOf(syscall.BPF_JA),
+		"BPF_JEQ":                       reflect.ValueOf(syscall.BPF_JEQ),
+		"BPF_JGE":                       reflect.ValueOf(syscall.BPF_JGE),
+		"BPF_JGT":                       reflect.ValueOf(syscall.BPF_JGT),
+		"BPF_JMP":                       reflect.ValueOf(syscall.BPF_JMP),
+		"BPF_JSET":                      reflect.ValueOf(syscall.BPF_JSET),
+		"BPF_K":                         reflect.ValueOf(syscall.BPF_K),
+		"BPF_LD":                        reflect.ValueOf(syscall.BPF_LD),
+		"BPF_LDX":                       reflect.ValueOf(syscall.BPF_LDX),
+		"BPF_LEN":                       reflect.ValueOf(syscall.BPF_LEN),
+		"BPF_LSH":                       reflect.ValueOf(syscall.BPF_LSH),
+		"BPF_MAJOR_VERSION":             reflect.ValueOf(syscall.BPF_MAJOR_VERSION),
+		"BPF_MAXBUFSIZE":                reflect.ValueOf(syscall.BPF_MAXBUFSIZE),
+		"BPF_MAXINSNS":                  reflect.ValueOf(syscall.BPF_MAXINSNS),
+		"BPF_MEM":                       reflect.ValueOf(syscall.BPF_MEM),
+		"BPF_MEMWORDS":                  reflect.ValueOf(syscall.BPF_MEMWORDS),
+		"BPF_MINBUFSIZE":                reflect.ValueOf(syscall.BPF_MINBUFSIZE),
+		"BPF_MINOR_VERSION":             reflect.ValueOf(syscall.BPF_MINOR_VERSION),
+		"BPF_MISC":                      reflect.ValueOf(syscall.BPF_MISC),
+		"BPF_MSH":                       reflect.ValueOf(syscall.BPF_MSH),
+		"BPF_MUL":                       reflect.ValueOf(syscall.BPF_MUL),
+		"BPF_NEG":                       reflect.ValueOf(syscall.BPF_NEG),
+		"BPF_OR":                        reflect.ValueOf(syscall.BPF_OR),
+		"BPF_RELEASE":                   reflect.ValueOf(syscall.BPF_RELEASE),
+		"BPF_RET":                       reflect.ValueOf(syscall.BPF_RET),
+		"BPF_RSH":                       reflect.ValueOf(syscall.BPF_RSH),
+		"BPF_ST":                        reflect.ValueOf(syscall.BPF_ST),
+		"BPF_STX":                       reflect.ValueOf(syscall.BPF_STX),
+		"BPF_SUB":                       reflect.ValueOf(syscall.BPF_SUB),
+		"BPF_TAX":                       reflect.ValueOf(syscall.BPF_TAX),
+		"BPF_TXA":                       reflect.ValueOf(syscall.BPF_TXA),
+		"BPF_W":                         reflect.ValueOf(syscall.BPF_W),
+		"BPF_X":                         reflect.ValueOf(syscall.BPF_X),
+		"BRKINT":                        reflect.ValueOf(syscall.BRKINT),
+		"Bind":                          reflect.ValueOf(syscall.Bind),
+		"BytePtrFromString":             reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":           reflect.ValueOf(syscall.ByteSliceFromString),
+		"CFLUSH":                        reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                        reflect.ValueOf(syscall.CLOCAL),
+		"CREAD":                         reflect.ValueOf(syscall.CREAD),
+		"CS5":                           reflect.ValueOf(syscall.CS5),
+		"CS6":                           reflect.ValueOf(syscall.CS6),
+		"CS7":                           reflect.ValueOf(syscall.CS7),
+		"CS8":                           reflect.ValueOf(syscall.CS8),
+		"CSIZE":                         reflect.ValueOf(syscall.CSIZE),
+		"CSTART":                        reflect.ValueOf(syscall.CSTART),
+		"CSTOP":                         reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                        reflect.ValueOf(syscall.CSTOPB),
+		"CSUSP":                         reflect.ValueOf(syscall.CSUSP),
+		"CSWTCH":                        reflect.ValueOf(syscall.CSWTCH),
+		"Chdir":                         reflect.ValueOf(syscall.Chdir),
+		"Chmod":                         reflect.ValueOf(syscall.Chmod),
+		"Chown":                         reflect.ValueOf(syscall.Chown),
+		"Chroot":                        reflect.ValueOf(syscall.Chroot),
+		"Clearenv":                      reflect.ValueOf(syscall.Clearenv),
+		"Close":                         reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                   reflect.ValueOf(syscall.CloseOnExec),
+		"CmsgLen":                       reflect.ValueOf(syscall.CmsgLen),
+		"CmsgSpace":                     reflect.ValueOf(syscall.CmsgSpace),
+		"Connect":                       reflect.ValueOf(syscall.Connect),
+		"DLT_AIRONET_HEADER":            reflect.ValueOf(syscall.DLT_AIRONET_HEADER),
+		"DLT_APPLE_IP_OVER_IEEE1394":    reflect.ValueOf(syscall.DLT_APPLE_IP_OVER_IEEE1394),
+		"DLT_ARCNET":                    reflect.ValueOf(syscall.DLT_ARCNET),
+		"DLT_ARCNET_LINUX":              reflect.ValueOf(syscall.DLT_ARCNET_LINUX),
+		"DLT_ATM_CLIP":                  reflect.ValueOf(syscall.DLT_ATM_CLIP),
+		"DLT_ATM_RFC1483":               reflect.ValueOf(syscall.DLT_ATM_RFC1483),
+		"DLT_AURORA":                    reflect.ValueOf(syscall.DLT_AURORA),
+		"DLT_AX25":                      reflect.ValueOf(syscall.DLT_AX25),
+		"DLT_BACNET_MS_TP":              reflect.ValueOf(syscall.DLT_BACNET_MS_TP),
+		"DLT_CHAOS":                     reflect.ValueOf(syscall.DLT_CHAOS),
+		"DLT_CISCO_IOS":                 reflect.ValueOf(syscall.DLT_CISCO_IOS),
+		"DLT_C_HDLC":                    reflect.ValueOf(syscall.DLT_C_HDLC),
+		"DLT_DOCSIS":                    reflect.ValueOf(syscall.DLT_DOCSIS),
+		"DLT_ECONET":                    reflect.ValueOf(syscall.DLT_ECONET),
+		"DLT_EN10MB":                    reflect.ValueOf(syscall.DLT_EN10MB),
+		"DLT_EN3MB":                     reflect.ValueOf(syscall.DLT_EN3MB),
+		"DLT_ENC":                       reflect.ValueOf(syscall.DLT_ENC),
+		"DLT_ERF_ETH":                   reflect.ValueOf(syscall.DLT_ERF_ETH),
+		"DLT_ERF_POS":                   reflect.ValueOf(syscall.DLT_ERF_POS),
+		"DLT_FDDI":                      reflect.ValueOf(syscall.DLT_FDDI),
+		"DLT_FRELAY":                    reflect.ValueOf(syscall.DLT_FRELAY),
+		"DLT_GCOM_SERIAL":               reflect.ValueOf(syscall.DLT_GCOM_SERIAL),
+		"DLT_GCOM_T1E1":                 reflect.ValueOf(syscall.DLT_GCOM_T1E1),
+		"DLT_GPF_F":                     reflect.ValueOf(syscall.DLT_GPF_F),
+		"DLT_GPF_T":                     reflect.ValueOf(syscall.DLT_GPF_T),
+		"DLT_GPRS_LLC":                  reflect.ValueOf(syscall.DLT_GPRS_LLC),
+		"DLT_HDLC":                      reflect.ValueOf(syscall.DLT_HDLC),
+		"DLT_HHDLC":                     reflect.ValueOf(syscall.DLT_HHDLC),
+		"DLT_HIPPI":                     reflect.ValueOf(syscall.DLT_HIPPI),
+		"DLT_IBM_SN":                    reflect.ValueOf(syscall.DLT_IBM_SN),
+		"DLT_IBM_SP":                    reflect.ValueOf(syscall.DLT_IBM_SP),
+		"DLT_IEEE802":                   reflect.ValueOf(syscall.DLT_IEEE802),
+		"DLT_IEEE802_11":                reflect.ValueOf(syscall.DLT_IEEE802_11),
+		"DLT_IEEE802_11_RADIO":          reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO),
+		"DLT_IEEE802_11_RADIO_AVS":      reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO_AVS),
+		"DLT_IPNET":                     reflect.ValueOf(syscall.DLT_IPNET),
+		"DLT_IPOIB":                     reflect.ValueOf(syscall.DLT_IPOIB),
+		"DLT_IP_OVER_FC":                reflect.ValueOf(syscall.DLT_IP_OVER_FC),
+		"DLT_JUNIPER_ATM1":              reflect.ValueOf(syscall.DLT_JUNIPER_ATM1),
+		"DLT_JUNIPER_ATM2":              reflect.ValueOf(syscall.DLT_JUNIPER_ATM2),
+		"DLT_JUNIPER_CHDLC":             reflect.ValueOf(syscall.DLT_JUNIPER_CHDLC),
+		"DLT_JUNIPER_ES":                reflect.ValueOf(syscall.DLT_JUNIPER_ES),
+		"DLT_JUNIPER_ETHER":             reflect.ValueOf(syscall.DLT_JUNIPER_ETHER),
+		"DLT_JUNIPER_FRELAY":            reflect.ValueOf(syscall.DLT_JUNIPER_FRELAY),
+		"DLT_JUNIPER_GGSN":              reflect.ValueOf(syscall.DLT_JUNIPER_GGSN),
+		"DLT_JUNIPER_MFR":               reflect.ValueOf(syscall.DLT_JUNIPER_MFR),
+		"DLT_JUNIPER_MLFR":              reflect.ValueOf(syscall.DLT_JUNIPER_MLFR),
+		"DLT_JUNIPER_MLPPP":             reflect.ValueOf(syscall.DLT_JUNIPER_MLPPP),
+		"DLT_JUNIPER_MONITOR":           reflect.ValueOf(syscall.DLT_JUNIPER_MONITOR),
+		"DLT_JUNIPER_PIC_PEER":          reflect.ValueOf(syscall.DLT_JUNIPER_PIC_PEER),
+		"DLT_JUNIPER_PPP":               reflect.ValueOf(syscall.DLT_JUNIPER_PPP),
+		"DLT_JUNIPER_PPPOE":             reflect.ValueOf(syscall.DLT_JUNIPER_PPPOE),
+		"DLT_JUNIPER_PPPOE_ATM":         reflect.ValueOf(syscall.DLT_JUNIPER_PPPOE_ATM),
+		"DLT_JUNIPER_SERVICES":          reflect.ValueOf(syscall.DLT_JUNIPER_SERVICES),
+		"DLT_LINUX_IRDA":                reflect.ValueOf(syscall.DLT_LINUX_IRDA),
+		"DLT_LINUX_LAPD":                reflect.ValueOf(syscall.DLT_LINUX_LAPD),
+		"DLT_LINUX_SLL":                 reflect.ValueOf(syscall.DLT_LINUX_SLL),
+		"DLT_LOOP":                      reflect.ValueOf(syscall.DLT_LOOP),
+		"DLT_LTALK":                     reflect.ValueOf(syscall.DLT_LTALK),
+		"DLT_MTP2":                      reflect.ValueOf(syscall.DLT_MTP2),
+		"DLT_MTP2_WITH_PHDR":            reflect.ValueOf(syscall.DLT_MTP2_WITH_PHDR),
+		"DLT_MTP3":                      reflect.ValueOf(syscall.DLT_MTP3),
+		"DLT_NULL":                      reflect.ValueOf(syscall.DLT_NULL),
+		"DLT_PCI_EXP":                   reflect.ValueOf(syscall.DLT_PCI_EXP),
+		"DLT_PFLOG":                     reflect.ValueOf(syscall.DLT_PFLOG),
+		"DLT_PFSYNC":                    reflect.ValueOf(syscall.DLT_PFSYNC),
+		"DLT_PPP":                       reflect.ValueOf(syscall.DLT_PPP),
+		"DLT_PPP_BSDOS":                 reflect.ValueOf(syscall.DLT_PPP_BSDOS),
+		"DLT_PPP_PPPD":                  reflect.ValueOf(syscall.DLT_PPP_PPPD),
+		"DLT_PRISM_HEADER":              reflect.ValueOf(syscall.DLT_PRISM_HEADER),
+		"DLT_PRONET":                    reflect.ValueOf(syscall.DLT_PRONET),
+		"DLT_RAW":                       reflect.ValueOf(syscall.DLT_RAW),
+		"DLT_RAWAF_MASK":                reflect.ValueOf(syscall.DLT_RAWAF_MASK),
+		"DLT_RIO":                       reflect.ValueOf(syscall.DLT_RIO),
+		"DLT_SCCP":                      reflect.ValueOf(syscall.DLT_SCCP),
+		"DLT_SLIP":                      reflect.ValueOf(syscall.DLT_SLIP),
+		"DLT_SLIP_BSDOS":                reflect.ValueOf(syscall.DLT_SLIP_BSDOS),
+		"DLT_SUNATM":                    reflect.ValueOf(syscall.DLT_SUNATM),
+		"DLT_SYMANTEC_FIREWALL":         reflect.ValueOf(syscall.DLT_SYMANTEC_FIREWALL),
+		"DLT_TZSP":                      reflect.ValueOf(syscall.DLT_TZSP),
+		"Dup":                           reflect.ValueOf(syscall.Dup),
+		"E2BIG":                         reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                        reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                    reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                 reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EADV":                          reflect.ValueOf(syscall.EADV),
+		"EAFNOSUPPORT":                  reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                        reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                      reflect.ValueOf(syscall.EALREADY),
+		"EBADE":                         reflect.ValueOf(syscall.EBADE),
+		"EBADF":                         reflect.ValueOf(syscall.EBADF),
+		"EBADFD":                        reflect.ValueOf(syscall.EBADFD),
+		"EBADMSG":                       reflect.ValueOf(syscall.EBADMSG),
+		"EBADR":                         reflect.ValueOf(syscall.EBADR),
+		"EBADRQC":                       reflect.ValueOf(syscall.EBADRQC),
+		"EBADSLT":                       reflect.ValueOf(syscall.EBADSLT),
+		"EBFONT":                        reflect.ValueOf(syscall.EBFONT),
+		"EBUSY":                         reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                     reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                        reflect.ValueOf(syscall.ECHILD),
+		"ECHO":                          reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                       reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                         reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                         reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                        reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                        reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                       reflect.ValueOf(syscall.ECHOPRT),
+		"ECHRNG":                        reflect.ValueOf(syscall.ECHRNG),
+		"ECOMM":                         reflect.ValueOf(syscall.ECOMM),
+		"ECONNABORTED":                  reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                  reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                    reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                       reflect.ValueOf(syscall.EDEADLK),
+		"EDEADLOCK":                     reflect.ValueOf(syscall.EDEADLOCK),
+		"EDESTADDRREQ":                  reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDOM":                          reflect.ValueOf(syscall.EDOM),
+		"EDQUOT":                        reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                        reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                        reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                         reflect.ValueOf(syscall.EFBIG),
+		"EHOSTDOWN":                     reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                  reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                         reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                        reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                   reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                         reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                        reflect.ValueOf(syscall.EINVAL),
+		"EIO":                           reflect.ValueOf(syscall.EIO),
+		"EISCONN":                       reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                        reflect.ValueOf(syscall.EISDIR),
+		"EL2HLT":                        reflect.ValueOf(syscall.EL2HLT),
+		"EL2NSYNC":                      reflect.ValueOf(syscall.EL2NSYNC),
+		"EL3HLT":                        reflect.ValueOf(syscall.EL3HLT),
+		"EL3RST":                        reflect.ValueOf(syscall.EL3RST),
+		"ELIBACC":                       reflect.ValueOf(syscall.ELIBACC),
+		"ELIBBAD":                       reflect.ValueOf(syscall.ELIBBAD),
+		"ELIBEXEC":                      reflect.ValueOf(syscall.ELIBEXEC),
+		"ELIBMAX":                       reflect.ValueOf(syscall.ELIBMAX),
+		"ELIBSCN":                       reflect.ValueOf(syscall.ELIBSCN),
+		"ELNRNG":                        reflect.ValueOf(syscall.ELNRNG),
+		"ELOCKUNMAPPED":                 reflect.ValueOf(syscall.ELOCKUNMAPPED),
+		"ELOOP":                         reflect.ValueOf(syscall.ELOOP),
+		"EMFILE":                        reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                        reflect.ValueOf(syscall.EMLINK),
+		"EMPTY_SET":                     reflect.ValueOf(syscall.EMPTY_SET),
+		"EMSGSIZE":                      reflect.ValueOf(syscall.EMSGSIZE),
+		"EMT_CPCOVF":                    reflect.ValueOf(syscall.EMT_CPCOVF),
+		"EMULTIHOP":                     reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                  reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENETDOWN":                      reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                     reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                   reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                        reflect.ValueOf(syscall.ENFILE),
+		"ENOANO":                        reflect.ValueOf(syscall.ENOANO),
+		"ENOBUFS":                       reflect.ValueOf(syscall.ENOBUFS),
+		"ENOCSI":                        reflect.ValueOf(syscall.ENOCSI),
+		"ENODATA":                       reflect.ValueOf(syscall.ENODATA),
+		"ENODEV":                        reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                        reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                       reflect.ValueOf(syscall.ENOEXEC),
+		"ENOLCK":                        reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                       reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEM":                        reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                        reflect.ValueOf(syscall.ENOMSG),
+		"ENONET":                        reflect.ValueOf(syscall.ENONET),
+		"ENOPKG":                        reflect.ValueOf(syscall.ENOPKG),
+		"ENOPROTOOPT":                   reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                        reflect.ValueOf(syscall.ENOSPC),
+		"ENOSR":                         reflect.ValueOf(syscall.ENOSR),
+		"ENOSTR":                        reflect.ValueOf(syscall.ENOSTR),
+		"ENOSYS":                        reflect.ValueOf(syscall.ENOSYS),
+		"ENOTACTIVE":                    reflect.ValueOf(syscall.ENOTACTIVE),
+		"ENOTBLK":                       reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCONN":                      reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                       reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                     reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTRECOVERABLE":               reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                      reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                       reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                        reflect.ValueOf(syscall.ENOTTY),
+		"ENOTUNIQ":                      reflect.ValueOf(syscall.ENOTUNIQ),
+		"ENXIO":                         reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                    reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                     reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                    reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                         reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                  reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                         reflect.ValueOf(syscall.EPIPE),
+		"EPROTO":                        reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":               reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                    reflect.ValueOf(syscall.EPROTOTYPE),
+		"EQUALITY_CHECK":                reflect.ValueOf(syscall.EQUALITY_CHECK),
+		"ERANGE":                        reflect.ValueOf(syscall.ERANGE),
+		"EREMCHG":                       reflect.ValueOf(syscall.EREMCHG),
+		"EREMOTE":                       reflect.ValueOf(syscall.EREMOTE),
+		"ERESTART":                      reflect.ValueOf(syscall.ERESTART),
+		"EROFS":                         reflect.ValueOf(syscall.EROFS),
+		"ESHUTDOWN":                     reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":               reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESPIPE":                        reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                         reflect.ValueOf(syscall.ESRCH),
+		"ESRMNT":                        reflect.ValueOf(syscall.ESRMNT),
+		"ESTALE":                        reflect.ValueOf(syscall.ESTALE),
+		"ESTRPIPE":                      reflect.ValueOf(syscall.ESTRPIPE),
+		"ETIME":                         reflect.ValueOf(syscall.ETIME),
+		"ETIMEDOUT":                     reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                  reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                       reflect.ValueOf(syscall.ETXTBSY),
+		"EUNATCH":                       reflect.ValueOf(syscall.EUNATCH),
+		"EUSERS":                        reflect.ValueOf(syscall.EUSERS),
+		"EWOULDBLOCK":                   reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EXDEV":                         reflect.ValueOf(syscall.EXDEV),
+		"EXFULL":                        reflect.ValueOf(syscall.EXFULL),
+		"EXTA":                          reflect.ValueOf(syscall.EXTA),
+		"EXTB":                          reflect.ValueOf(syscall.EXTB),
+		"Environ":                       reflect.ValueOf(syscall.Environ),
+		"Exec":                          reflect.ValueOf(syscall.Exec),
+		"Exit":                          reflect.ValueOf(syscall.Exit),
+		"FD_CLOEXEC":                    reflect.ValueOf(syscall.FD_CLOEXEC),
+		"FD_NFDBITS":                    reflect.ValueOf(syscall.FD_NFDBITS),
+		"FD_SETSIZE":                    reflect.ValueOf(syscall.FD_SETSIZE),
+		"FLUSHALL":                      reflect.ValueOf(syscall.FLUSHALL),
+		"FLUSHDATA":                     reflect.ValueOf(syscall.FLUSHDATA),
+		"FLUSHO":                        reflect.ValueOf(syscall.FLUSHO),
+		"F_ALLOCSP":                     reflect.ValueOf(syscall.F_ALLOCSP),
+		"F_ALLOCSP64":                   reflect.ValueOf(syscall.F_ALLOCSP64),
+		"F_BADFD":                       reflect.ValueOf(syscall.F_BADFD),
+		"F_BLKSIZE":                     reflect.ValueOf(syscall.F_BLKSIZE),
+		"F_BLOCKS":                      reflect.ValueOf(syscall.F_BLOCKS),
+		"F_CHKFL":                       reflect.ValueOf(syscall.F_CHKFL),
+		"F_COMPAT":                      reflect.ValueOf(syscall.F_COMPAT),
+		"F_DUP2FD":                      reflect.ValueOf(syscall.F_DUP2FD),
+		"F_DUP2FD_CLOEXEC":              reflect.ValueOf(syscall.F_DUP2FD_CLOEXEC),
+		"F_DUPFD":                       reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":               reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_FREESP":                      reflect.ValueOf(syscall.F_FREESP),
+		"F_FREESP64":                    reflect.ValueOf(syscall.F_FREESP64),
+		"F_GETFD":                       reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                       reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLK":                       reflect.ValueOf(syscall.F_GETLK),
+		"F_GETLK64":                     reflect.ValueOf(syscall.F_GETLK64),
+		"F_GETOWN":                      reflect.ValueOf(syscall.F_GETOWN),
+		"F_GETXFL":                      reflect.ValueOf(syscall.F_GETXFL),
+		"F_HASREMOTELOCKS":              reflect.ValueOf(syscall.F_HASREMOTELOCKS),
+		"F_ISSTREAM":                    reflect.ValueOf(syscall.F_ISSTREAM),
+		"F_MANDDNY":                     reflect.ValueOf(syscall.F_MANDDNY),
+		"F_MDACC":                       reflect.ValueOf(syscall.F_MDACC),
+		"F_NODNY":                       reflect.ValueOf(syscall.F_NODNY),
+		"F_NPRIV":                       reflect.ValueOf(syscall.F_NPRIV),
+		"F_PRIV":                        reflect.ValueOf(syscall.F_PRIV),
+		"F_QUOTACTL":                    reflect.ValueOf(syscall.F_QUOTACTL),
+		"F_RDACC":                       reflect.ValueOf(syscall.F_RDACC),
+		"F_RDDNY":                       reflect.ValueOf(syscall.F_RDDNY),
+		"F_RDLCK":                       reflect.ValueOf(syscall.F_RDLCK),
+		"F_REVOKE":                      reflect.ValueOf(syscall.F_REVOKE),
+		"F_RMACC":                       reflect.ValueOf(syscall.F_RMACC),
+		"F_RMDNY":                       reflect.ValueOf(syscall.F_RMDNY),
+		"F_RWACC":                       reflect.ValueOf(syscall.F_RWACC),
+		"F_RWDNY":                       reflect.ValueOf(syscall.F_RWDNY),
+		"F_SETFD":                       reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                       reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLK":                       reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLK64":                     reflect.ValueOf(syscall.F_SETLK64),
+		"F_SETLK64_NBMAND":              reflect.ValueOf(syscall.F_SETLK64_NBMAND),
+		"F_SETLKW":                      reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETLKW64":                    reflect.ValueOf(syscall.F_SETLKW64),
+		"F_SETLK_NBMAND":                reflect.ValueOf(syscall.F_SETLK_NBMAND),
+		"F_SETOWN":                      reflect.ValueOf(syscall.F_SETOWN),
+		"F_SHARE":                       reflect.ValueOf(syscall.F_SHARE),
+		"F_SHARE_NBMAND":                reflect.ValueOf(syscall.F_SHARE_NBMAND),
+		"F_UNLCK":                       reflect.ValueOf(syscall.F_UNLCK),
+		"F_UNLKSYS":                     reflect.ValueOf(syscall.F_UNLKSYS),
+		"F_UNSHARE":                     reflect.ValueOf(syscall.F_UNSHARE),
+		"F_WRACC":                       reflect.ValueOf(syscall.F_WRACC),
+		"F_WRDNY":                       reflect.ValueOf(syscall.F_WRDNY),
+		"F_WRLCK":                       reflect.ValueOf(syscall.F_WRLCK),
+		"Fchdir":                        reflect.ValueOf(syscall.Fchdir),
+		"Fchmod":                        reflect.ValueOf(syscall.Fchmod),
+		"Fchown":                        reflect.ValueOf(syscall.Fchown),
+		"FcntlFlock":                    reflect.ValueOf(syscall.FcntlFlock),
+		"Flock":                         reflect.ValueOf(syscall.Flock),
+		"ForkExec":                      reflect.ValueOf(syscall.ForkExec),
+		"ForkLock":                      reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"Fpathconf":                     reflect.ValueOf(syscall.Fpathconf),
+		"Fstat":                         reflect.ValueOf(syscall.Fstat),
+		"Fsync":                         reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                     reflect.ValueOf(syscall.Ftruncate),
+		"Getcwd":                        reflect.ValueOf(syscall.Getcwd),
+		"Getdents":                      reflect.ValueOf(syscall.Getdents),
+		"Getegid":                       reflect.ValueOf(syscall.Getegid),
+		"Getenv":                        reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                       reflect.ValueOf(syscall.Geteuid),
+		"Getexecname":                   reflect.ValueOf(syscall.Getexecname),
+		"Getgid":                        reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                     reflect.ValueOf(syscall.Getgroups),
+		"Gethostname":                   reflect.ValueOf(syscall.Gethostname),
+		"Getpagesize":                   reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                   reflect.ValueOf(syscall.Getpeername),
+		"Getpid":                        reflect.ValueOf(syscall.Getpid),
+		"Getppid":                       reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                   reflect.ValueOf(syscall.Getpriority),
+		"Getrlimit":                     reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                     reflect.ValueOf(syscall.Getrusage),
+		"Getsockname":                   reflect.ValueOf(syscall.Getsockname),
+		"GetsockoptInt":                 reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                  reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                        reflect.ValueOf(syscall.Getuid),
+		"Getwd":                         reflect.ValueOf(syscall.Getwd),
+		"HUPCL":                         reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                        reflect.ValueOf(syscall.ICANON),
+		"ICRNL":                         reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                        reflect.ValueOf(syscall.IEXTEN),
+		"IFF_ADDRCONF":                  reflect.ValueOf(syscall.IFF_ADDRCONF),
+		"IFF_ALLMULTI":                  reflect.ValueOf(syscall.IFF_ALLMULTI),
+		"IFF_ANYCAST":                   reflect.ValueOf(syscall.IFF_ANYCAST),
+		"IFF_BROADCAST":                 reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_CANTCHANGE":                reflect.ValueOf(int64(syscall.IFF_CANTCHANGE)),
+		"IFF_COS_ENABLED":               reflect.ValueOf(int64(syscall.IFF_COS_ENABLED)),
+		"IFF_DEBUG":                     reflect.ValueOf(syscall.IFF_DEBUG),
+		"IFF_DEPRECATED":                reflect.ValueOf(syscall.IFF_DEPRECATED),
+		"IFF_DHCPRUNNING":               reflect.ValueOf(syscall.IFF_DHCPRUNNING),
+		"IFF_DUPLICATE":                 reflect.ValueOf(int64(syscall.IFF_DUPLICATE)),
+		"IFF_FAILED":                    reflect.ValueOf(syscall.IFF_FAILED),
+		"IFF_FIXEDMTU":                  reflect.ValueOf(int64(syscall.IFF_FIXEDMTU)),
+		"IFF_INACTIVE":                  reflect.ValueOf(syscall.IFF_INACTIVE),
+		"IFF_INTELLIGENT":               reflect.ValueOf(syscall.IFF_INTELLIGENT),
+		"IFF_IPMP":                      reflect.ValueOf(int64(syscall.IFF_IPMP)),
+		"IFF_IPMP_CANTCHANGE":           reflect.ValueOf(syscall.IFF_IPMP_CANTCHANGE),
+		"IFF_IPMP_INVALID":              reflect.ValueOf(int64(syscall.IFF_IPMP_INVALID)),
+		"IFF_IPV4":                      reflect.ValueOf(syscall.IFF_IPV4),
+		"IFF_IPV6":                      reflect.ValueOf(syscall.IFF_IPV6),
+		"IFF_L3PROTECT":                 reflect.ValueOf(int64(syscall.IFF_L3PROTECT)),
+		"IFF_LOOPBACK":                  reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_MULTICAST":                 reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_MULTI_BCAST":               reflect.ValueOf(syscall.IFF_MULTI_BCAST),
+		"IFF_NOACCEPT":                  reflect.ValueOf(syscall.IFF_NOACCEPT),
+		"IFF_NOARP":                     reflect.ValueOf(syscall.IFF_NOARP),
+		"IFF_NOFAILOVER":                reflect.ValueOf(syscall.IFF_NOFAILOVER),
+		"IFF_NOLINKLOCAL":               reflect.ValueOf(int64(syscall.IFF_NOLINKLOCAL)),
+		"IFF_NOLOCAL":                   reflect.ValueOf(syscall.IFF_NOLOCAL),
+		"IFF_NONUD":                     reflect.ValueOf(syscall.IFF_NONUD),
+		"IFF_NORTEXCH":                  reflect.ValueOf(syscall.IFF_NORTEXCH),
+		"IFF_NOTRAILERS":                reflect.ValueOf(syscall.IFF_NOTRAILERS),
+		"IFF_NOXMIT":                    reflect.ValueOf(syscall.IFF_NOXMIT),
+		"IFF_OFFLINE":                   reflect.ValueOf(uint32(syscall.IFF_OFFLINE)),
+		"IFF_POINTOPOINT":               reflect.ValueOf(syscall.IFF_POINTOPOINT),
+		"IFF_PREFERRED":                 reflect.ValueOf(int64(syscall.IFF_PREFERRED)),
+		"IFF_PRIVATE":                   reflect.ValueOf(syscall.IFF_PRIVATE),
+		"IFF_PROMISC":                   reflect.ValueOf(syscall.IFF_PROMISC),
+		"IFF_ROUTER":                    reflect.ValueOf(syscall.IFF_ROUTER),
+		"IFF_RUNNING":                   reflect.ValueOf(syscall.IFF_RUNNING),
+		"IFF_STANDBY":                   reflect.ValueOf(syscall.IFF_STANDBY),
+		"IFF_TEMPORARY":                 reflect.ValueOf(int64(syscall.IFF_TEMPORARY)),
+		"IFF_UNNUMBERED":                reflect.ValueOf(syscall.IFF_UNNUMBERED),
+		"IFF_UP":                        reflect.ValueOf(syscall.IFF_UP),
+		"IFF_VIRTUAL":                   reflect.ValueOf(int64(syscall.IFF_VIRTUAL)),
+		"IFF_VRRP":                      reflect.ValueOf(int64(syscall.IFF_VRRP)),
+		"IFF_XRESOLV":                   reflect.ValueOf(int64(syscall.IFF_XRESOLV)),
+		"IFNAMSIZ":                      reflect.ValueOf(syscall.IFNAMSIZ),
+		"IFT_1822":                      reflect.ValueOf(syscall.IFT_1822),
+		"IFT_6TO4":                      reflect.ValueOf(syscall.IFT_6TO4),
+		"IFT_AAL5":                      reflect.ValueOf(syscall.IFT_AAL5),
+		"IFT_ARCNET":                    reflect.ValueOf(syscall.IFT_ARCNET),
+		"IFT_ARCNETPLUS":                reflect.ValueOf(syscall.IFT_ARCNETPLUS),
+		"IFT_ATM":                       reflect.ValueOf(syscall.IFT_ATM),
+		"IFT_CEPT":                      reflect.ValueOf(syscall.IFT_CEPT),
+		"IFT_DS3":                       reflect.ValueOf(syscall.IFT_DS3),
+		"IFT_EON":                       reflect.ValueOf(syscall.IFT_EON),
+		"IFT_ETHER":                     reflect.ValueOf(syscall.IFT_ETHER),
+		"IFT_FDDI":                      reflect.ValueOf(syscall.IFT_FDDI),
+		"IFT_FRELAY":                    reflect.ValueOf(syscall.IFT_FRELAY),
+		"IFT_FRELAYDCE":                 reflect.ValueOf(syscall.IFT_FRELAYDCE),
+		"IFT_HDH1822":                   reflect.ValueOf(syscall.IFT_HDH1822),
+		"IFT_HIPPI":                     reflect.ValueOf(syscall.IFT_HIPPI),
+		"IFT_HSSI":                      reflect.ValueOf(syscall.IFT_HSSI),
+		"IFT_HY":                        reflect.ValueOf(syscall.IFT_HY),
+		"IFT_IB":                        reflect.ValueOf(syscall.IFT_IB),
+		"IFT_IPV4":                      reflect.ValueOf(syscall.IFT_IPV4),
+		"IFT_IPV6":                      reflect.ValueOf(syscall.IFT_IPV6),
+		"IFT_ISDNBASIC":                 reflect.ValueOf(syscall.IFT_ISDNBASIC),
+		"IFT_ISDNPRIMARY":               reflect.ValueOf(syscall.IFT_ISDNPRIMARY),
+		"IFT_ISO88022LLC":               reflect.ValueOf(syscall.IFT_ISO88022LLC),
+		"IFT_ISO88023":                  reflect.ValueOf(syscall.IFT_ISO88023),
+		"IFT_ISO88024":                  reflect.ValueOf(syscall.IFT_ISO88024),
+		"IFT_ISO88025":                  reflect.ValueOf(syscall.IFT_ISO88025),
+		"IFT_ISO88026":                  reflect.ValueOf(syscall.IFT_ISO88026),
+		"IFT_LAPB":                      reflect.ValueOf(syscall.IFT_LAPB),
+		"IFT_LOCALTALK":                 reflect.ValueOf(syscall.IFT_LOCALTALK),
+		"IFT_LOOP":                      reflect.ValueOf(syscall.IFT_LOOP),
+		"IFT_MIOX25":                    reflect.ValueOf(syscall.IFT_MIOX25),
+		"IFT_MODEM":                     reflect.ValueOf(syscall.IFT_MODEM),
+		"IFT_NSIP":                      reflect.ValueOf(syscall.IFT_NSIP),
+		"IFT_OTHER":                     reflect.ValueOf(syscall.IFT_OTHER),
+		"IFT_P10":                       reflect.ValueOf(syscall.IFT_P10),
+		"IFT_P80":                       reflect.ValueOf(syscall.IFT_P80),
+		"IFT_PARA":                      reflect.ValueOf(syscall.IFT_PARA),
+		"IFT_PPP":                       reflect.ValueOf(syscall.IFT_PPP),
+		"IFT_PROPMUX":                   reflect.ValueOf(syscall.IFT_PROPMUX),
+		"IFT_PROPVIRTUAL":               reflect.ValueOf(syscall.IFT_PROPVIRTUAL),
+		"IFT_PTPSERIAL":                 reflect.ValueOf(syscall.IFT_PTPSERIAL),
+		"IFT_RS232":                     reflect.ValueOf(syscall.IFT_RS232),
+		"IFT_SDLC":                      reflect.ValueOf(syscall.IFT_SDLC),
+		"IFT_SIP":                       reflect.ValueOf(syscall.IFT_SIP),
+		"IFT_SLIP":                      reflect.ValueOf(syscall.IFT_SLIP),
+		"IFT_SMDSDXI":                   reflect.ValueOf(syscall.IFT_SMDSDXI),
+		"IFT_SMDSICIP":                  reflect.ValueOf(syscall.IFT_SMDSICIP),
+		"IFT_SONET":                     reflect.ValueOf(syscall.IFT_SONET),
+		"IFT_SONETPATH":                 reflect.ValueOf(syscall.IFT_SONETPATH),
+		"IFT_SONETVT":                   reflect.ValueOf(syscall.IFT_SONETVT),
+		"IFT_STARLAN":                   reflect.ValueOf(syscall.IFT_STARLAN),
+		"IFT_T1":                        reflect.ValueOf(syscall.IFT_T1),
+		"IFT_ULTRA":                     reflect.ValueOf(syscall.IFT_ULTRA),
+		"IFT_V35":                       reflect.ValueOf(syscall.IFT_V35),
+		"IFT_X25":                       reflect.ValueOf(syscall.IFT_X25),
+		"IFT_X25DDN":                    reflect.ValueOf(syscall.IFT_X25DDN),
+		"IFT_X25PLE":                    reflect.ValueOf(syscall.IFT_X25PLE),
+		"IFT_XETHER":                    reflect.ValueOf(syscall.IFT_XETHER),
+		"IGNBRK":                        reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                         reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                        reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                       reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                         reflect.ValueOf(syscall.INLCR),
+		"INPCK":                         reflect.ValueOf(syscall.INPCK),
+		"IN_AUTOCONF_MASK":              reflect.ValueOf(uint32(syscall.IN_AUTOCONF_MASK)),
+		"IN_AUTOCONF_NET":               reflect.ValueOf(uint32(syscall.IN_AUTOCONF_NET)),
+		"IN_CLASSA_HOST":                reflect.ValueOf(syscall.IN_CLASSA_HOST),
+		"IN_CLASSA_MAX":                 reflect.ValueOf(syscall.IN_CLASSA_MAX),
+		"IN_CLASSA_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
+		"IN_CLASSA_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSA_NSHIFT),
+		"IN_CLASSB_HOST":                reflect.ValueOf(syscall.IN_CLASSB_HOST),
+		"IN_CLASSB_MAX":                 reflect.ValueOf(syscall.IN_CLASSB_MAX),
+		"IN_CLASSB_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSB_NET)),
+		"IN_CLASSB_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSB_NSHIFT),
+		"IN_CLASSC_HOST":                reflect.ValueOf(syscall.IN_CLASSC_HOST),
+		"IN_CLASSC_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSC_NET)),
+		"IN_CLASSC_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSC_NSHIFT),
+		"IN_CLASSD_HOST":                reflect.ValueOf(syscall.IN_CLASSD_HOST),
+		"IN_CLASSD_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSD_NET)),
+		"IN_CLASSD_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSD_NSHIFT),
+		"IN_CLASSE_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSE_NET)),
+		"IN_LOOPBACKNET":                reflect.ValueOf(syscall.IN_LOOPBACKNET),
+		"IN_PRIVATE12_MASK":             reflect.ValueOf(uint32(syscall.IN_PRIVATE12_MASK)),
+		"IN_PRIVATE12_NET":              reflect.ValueOf(uint32(syscall.IN_PRIVATE12_NET)),
+		"IN_PRIVATE16_MASK":             reflect.ValueOf(uint32(syscall.IN_PRIVATE16_MASK)),
+		"IN_PRIVATE16_NET":              reflect.ValueOf(uint32(syscall.IN_PRIVATE16_NET)),
+		"IN_PRIVATE8_MASK":              reflect.ValueOf(uint32(syscall.IN_PRIVATE8_MASK)),
+		"IN_PRIVATE8_NET":               reflect.ValueOf(syscall.IN_PRIVATE8_NET),
+		"IPPROTO_AH":                    reflect.ValueOf(syscall.IPPROTO_AH),
+		"IPPROTO_DSTOPTS":               reflect.ValueOf(syscall.IPPROTO_DSTOPTS),
+		"IPPROTO_EGP":                   reflect.ValueOf(syscall.IPPROTO_EGP),
+		"IPPROTO_ENCAP":                 reflect.ValueOf(syscall.IPPROTO_ENCAP),
+		"IPPROTO_EON":                   reflect.ValueOf(syscall.IPPROTO_EON),
+		"IPPROTO_ESP":                   reflect.ValueOf(syscall.IPPROTO_ESP),
+		"IPPROTO_FRAGMENT":              reflect.ValueOf(syscall.IPPROTO_FRAGMENT),
+		"IPPROTO_GGP":                   reflect.ValueOf(syscall.IPPROTO_GGP),
+		"IPPROTO_HELLO":                 reflect.ValueOf(syscall.IPPROTO_HELLO),
+		"IPPROTO_HOPOPTS":               reflect.ValueOf(syscall.IPPROTO_HOPOPTS),
+		"IPPROTO_ICMP":                  reflect.ValueOf(syscall.IPPROTO_ICMP),
+		"IPPROTO_ICMPV6":                reflect.ValueOf(syscall.IPPROTO_ICMPV6),
+		"IPPROTO_IDP":                   reflect.ValueOf(syscall.IPPROTO_IDP),
+		"IPPROTO_IGMP":                  reflect.ValueOf(syscall.IPPROTO_IGMP),
+		"IPPROTO_IP":                    reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPV6":                  reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_MAX":                   reflect.ValueOf(syscall.IPPROTO_MAX),
+		"IPPROTO_ND":                    reflect.ValueOf(syscall.IPPROTO_ND),
+		"IPPROTO_NONE":                  reflect.ValueOf(syscall.IPPROTO_NONE),
+		"IPPROTO_OSPF":                  reflect.ValueOf(syscall.IPPROTO_OSPF),
+		"IPPROTO_PIM":                   reflect.ValueOf(syscall.IPPROTO_PIM),
+		"IPPROTO_PUP":                   reflect.ValueOf(syscall.IPPROTO_PUP),
+		"IPPROTO_RAW":                   reflect.ValueOf(syscall.IPPROTO_RAW),
+		"IPPROTO_ROUTING":               reflect.ValueOf(syscall.IPPROTO_ROUTING),
+		"IPPROTO_RSVP":                  reflect.ValueOf(syscall.IPPROTO_RSVP),
+		"IPPROTO_SCTP":                  reflect.ValueOf(syscall.IPPROTO_SCTP),
+		"IPPROTO_TCP":                   reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_UDP":                   reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPV6_ADD_MEMBERSHIP":           reflect.ValueOf(syscall.IPV6_ADD_MEMBERSHIP),
+		"IPV6_BOUND_IF":                 reflect.ValueOf(syscall.IPV6_BOUND_IF),
+		"IPV6_CHECKSUM":                 reflect.ValueOf(syscall.IPV6_CHECKSUM),
+		"IPV6_DONTFRAG":                 reflect.ValueOf(syscall.IPV6_DONTFRAG),
+		"IPV6_DROP_MEMBERSHIP":          reflect.ValueOf(syscall.IPV6_DROP_MEMBERSHIP),
+		"IPV6_DSTOPTS":                  reflect.ValueOf(syscall.IPV6_DSTOPTS),
+		"IPV6_FLOWINFO_FLOWLABEL":       reflect.ValueOf(uint32(syscall.IPV6_FLOWINFO_FLOWLABEL)),
+		"IPV6_FLOWINFO_TCLASS":          reflect.ValueOf(syscall.IPV6_FLOWINFO_TCLASS),
+		"IPV6_HOPLIMIT":                 reflect.ValueOf(syscall.IPV6_HOPLIMIT),
+		"IPV6_HOPOPTS":                  reflect.ValueOf(syscall.IPV6_HOPOPTS),
+		"IPV6_JOIN_GROUP":               reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_GROUP":              reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MULTICAST_HOPS":           reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":             reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":           reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_NEXTHOP":                  reflect.ValueOf(syscall.IPV6_NEXTHOP),
+		"IPV6_PAD1_OPT":                 reflect.ValueOf(syscall.IPV6_PAD1_OPT),
+		"IPV6_PATHMTU":                  reflect.ValueOf(syscall.IPV6_PATHMTU),
+		"IPV6_PKTINFO":                  reflect.ValueOf(syscall.IPV6_PKTINFO),
+		"IPV6_PREFER_SRC_CGA":           reflect.ValueOf(syscall.IPV6_PREFER_SRC_CGA),
+		"IPV6_PREFER_SRC_CGADEFAULT":    reflect.ValueOf(syscall.IPV6_PREFER_SRC_CGADEFAULT),
+		"IPV6_PREFER_SRC_CGAMASK":       reflect.ValueOf(syscall.IPV6_PREFER_SRC_CGAMASK),
+		"IPV6_PREFER_SRC_COA":           reflect.ValueOf(syscall.IPV6_PREFER_SRC_COA),
+		"IPV6_PREFER_SRC_DEFAULT":       reflect.ValueOf(syscall.IPV6_PREFER_SRC_DEFAULT),
+		"IPV6_PREFER_SRC_HOME":          reflect.ValueOf(syscall.IPV6_PREFER_SRC_HOME),
+		"IPV6_PREFER_SRC_MASK":          reflect.ValueOf(syscall.IPV6_PREFER_SRC_MASK),
+		"IPV6_PREFER_SRC_MIPDEFAULT":    reflect.ValueOf(syscall.IPV6_PREFER_SRC_MIPDEFAULT),
+		"IPV6_PREFER_SRC_MIPMASK":       reflect.ValueOf(syscall.IPV6_PREFER_SRC_MIPMASK),
+		"IPV6_PREFER_SRC_NONCGA":        reflect.ValueOf(syscall.IPV6_PREFER_SRC_NONCGA),
+		"IPV6_PREFER_SRC_PUBLIC":        reflect.ValueOf(syscall.IPV6_PREFER_SRC_PUBLIC),
+		"IPV6_PREFER_SRC_TMP":           reflect.ValueOf(syscall.IPV6_PREFER_SRC_TMP),
+		"IPV6_PREFER_SRC_TMPDEFAULT":    reflect.ValueOf(syscall.IPV6_PREFER_SRC_TMPDEFAULT),
+		"IPV6_PREFER_SRC_TMPMASK":       reflect.ValueOf(syscall.IPV6_PREFER_SRC_TMPMASK),
+		"IPV6_RECVDSTOPTS":              reflect.ValueOf(syscall.IPV6_RECVDSTOPTS),
+		"IPV6_RECVHOPLIMIT":             reflect.ValueOf(syscall.IPV6_RECVHOPLIMIT),
+		"IPV6_RECVHOPOPTS":              reflect.ValueOf(syscall.IPV6_RECVHOPOPTS),
+		"IPV6_RECVPATHMTU":              reflect.ValueOf(syscall.IPV6_RECVPATHMTU),
+		"IPV6_RECVPKTINFO":              reflect.ValueOf(syscall.IPV6_RECVPKTINFO),
+		"IPV6_RECVRTHDR":                reflect.ValueOf(syscall.IPV6_RECVRTHDR),
+		"IPV6_RECVRTHDRDSTOPTS":         reflect.ValueOf(syscall.IPV6_RECVRTHDRDSTOPTS),
+		"IPV6_RECVTCLASS":               reflect.ValueOf(syscall.IPV6_RECVTCLASS),
+		"IPV6_RTHDR":                    reflect.ValueOf(syscall.IPV6_RTHDR),
+		"IPV6_RTHDRDSTOPTS":             reflect.ValueOf(syscall.IPV6_RTHDRDSTOPTS),
+		"IPV6_RTHDR_TYPE_0":             reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_0),
+		"IPV6_SEC_OPT":                  reflect.ValueOf(syscall.IPV6_SEC_OPT),
+		"IPV6_SRC_PREFERENCES":          reflect.ValueOf(syscall.IPV6_SRC_PREFERENCES),
+		"IPV6_TCLASS":                   reflect.ValueOf(syscall.IPV6_TCLASS),
+		"IPV6_UNICAST_HOPS":             reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_UNSPEC_SRC":               reflect.ValueOf(syscall.IPV6_UNSPEC_SRC),
+		"IPV6_USE_MIN_MTU":              reflect.ValueOf(syscall.IPV6_USE_MIN_MTU),
+		"IPV6_V6ONLY":                   reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IP_ADD_MEMBERSHIP":             reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_ADD_SOURCE_MEMBERSHIP":      reflect.ValueOf(syscall.IP_ADD_SOURCE_MEMBERSHIP),
+		"IP_BLOCK_SOURCE":               reflect.ValueOf(syscall.IP_BLOCK_SOURCE),
+		"IP_BOUND_IF":                   reflect.ValueOf(syscall.IP_BOUND_IF),
+		"IP_BROADCAST":                  reflect.ValueOf(syscall.IP_BROADCAST),
+		"IP_BROADCAST_TTL":              reflect.ValueOf(syscall.IP_BROADCAST_TTL),
+		"IP_DEFAULT_MULTICAST_LOOP":     reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_LOOP),
+		"IP_DEFAULT_MULTICAST_TTL":      reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_TTL),
+		"IP_DF":                         reflect.ValueOf(syscall.IP_DF),
+		"IP_DHCPINIT_IF":                reflect.ValueOf(syscall.IP_DHCPINIT_IF),
+		"IP_DONTFRAG":                   reflect.ValueOf(syscall.IP_DONTFRAG),
+		"IP_DONTROUTE":                  reflect.ValueOf(syscall.IP_DONTROUTE),
+		"IP_DROP_MEMBERSHIP":            reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_DROP_SOURCE_MEMBERSHIP":     reflect.ValueOf(syscall.IP_DROP_SOURCE_MEMBERSHIP),
+		"IP_HDRINCL":                    reflect.ValueOf(syscall.IP_HDRINCL),
+		"IP_MAXPACKET":                  reflect.ValueOf(syscall.IP_MAXPACKET),
+		"IP_MF":                         reflect.ValueOf(syscall.IP_MF),
+		"IP_MSS":                        reflect.ValueOf(syscall.IP_MSS),
+		"IP_MULTICAST_IF":               reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_LOOP":             reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":              reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_NEXTHOP":                    reflect.ValueOf(syscall.IP_NEXTHOP),
+		"IP_OPTIONS":                    reflect.ValueOf(syscall.IP_OPTIONS),
+		"IP_PKTINFO":                    reflect.ValueOf(syscall.IP_PKTINFO),
+		"IP_RECVDSTADDR":                reflect.ValueOf(syscall.IP_RECVDSTADDR),
+		"IP_RECVIF":                     reflect.ValueOf(syscall.IP_RECVIF),
+		"IP_RECVOPTS":                   reflect.ValueOf(syscall.IP_RECVOPTS),
+		"IP_RECVPKTINFO":                reflect.ValueOf(syscall.IP_RECVPKTINFO),
+		"IP_RECVRETOPTS":                reflect.ValueOf(syscall.IP_RECVRETOPTS),
+		"IP_RECVSLLA":                   reflect.ValueOf(syscall.IP_RECVSLLA),
+		"IP_RECVTTL":                    reflect.ValueOf(syscall.IP_RECVTTL),
+		"IP_RETOPTS":                    reflect.ValueOf(syscall.IP_RETOPTS),
+		"IP_REUSEADDR":                  reflect.ValueOf(syscall.IP_REUSEADDR),
+		"IP_SEC_OPT":                    reflect.ValueOf(syscall.IP_SEC_OPT),
+		"IP_TOS":                        reflect.ValueOf(syscall.IP_TOS),
+		"IP_TTL":                        reflect.ValueOf(syscall.IP_TTL),
+		"IP_UNBLOCK_SOURCE":             reflect.ValueOf(syscall.IP_UNBLOCK_SOURCE),
+		"IP_UNSPEC_SRC":                 reflect.ValueOf(syscall.IP_UNSPEC_SRC),
+		"ISIG":                          reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                        reflect.ValueOf(syscall.ISTRIP),
+		"IXANY":                         reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                         reflect.ValueOf(syscall.IXOFF),
+		"IXON":                          reflect.ValueOf(syscall.IXON),
+		"ImplementsGetwd":               reflect.ValueOf(syscall.ImplementsGetwd),
+		"Kill":                          reflect.ValueOf(syscall.Kill),
+		"LOCK_EX":                       reflect.ValueOf(syscall.LOCK_EX),
+		"LOCK_NB":                       reflect.ValueOf(syscall.LOCK_NB),
+		"LOCK_SH":                       reflect.ValueOf(syscall.LOCK_SH),
+		"LOCK_UN":                       reflect.ValueOf(syscall.LOCK_UN),
+		"Lchown":                        reflect.ValueOf(syscall.Lchown),
+		"Link":                          reflect.ValueOf(syscall.Link),
+		"Listen":                        reflect.ValueOf(syscall.Listen),
+		"Lstat":                         reflect.ValueOf(syscall.Lstat),
+		"MADV_ACCESS_DEFAULT":           reflect.ValueOf(syscall.MADV_ACCESS_DEFAULT),
+		"MADV_ACCESS_LWP":               reflect.ValueOf(syscall.MADV_ACCESS_LWP),
+		"MADV_ACCESS_MANY":              reflect.ValueOf(syscall.MADV_ACCESS_MANY),
+		"MADV_DONTNEED":                 reflect.ValueOf(syscall.MADV_DONTNEED),
+		"MADV_FREE":                     reflect.ValueOf(syscall.MADV_FREE),
+		"MADV_NORMAL":                   reflect.ValueOf(syscall.MADV_NORMAL),
+		"MADV_RANDOM":                   reflect.ValueOf(syscall.MADV_RANDOM),
+		"MADV_SEQUENTIAL":               reflect.ValueOf(syscall.MADV_SEQUENTIAL),
+		"MADV_WILLNEED":                 reflect.ValueOf(syscall.MADV_WILLNEED),
+		"MAP_32BIT":                     reflect.ValueOf(syscall.MAP_32BIT),
+		"MAP_ALIGN":                     reflect.ValueOf(syscall.MAP_ALIGN),
+		"MAP_ANON":                      reflect.ValueOf(syscall.MAP_ANON),
+		"MAP_ANONYMOUS":                 reflect.ValueOf(syscall.MAP_ANONYMOUS),
+		"MAP_FILE":                      reflect.ValueOf(syscall.MAP_FILE),
+		"MAP_FIXED":                     reflect.ValueOf(syscall.MAP_FIXED),
+		"MAP_INITDATA":                  reflect.ValueOf(syscall.MAP_INITDATA),
+		"MAP_NORESERVE":                 reflect.ValueOf(syscall.MAP_NORESERVE),
+		"MAP_PRIVATE":                   reflect.ValueOf(syscall.MAP_PRIVATE),
+		"MAP_RENAME":                    reflect.ValueOf(syscall.MAP_RENAME),
+		"MAP_SHARED":                    reflect.ValueOf(syscall.MAP_SHARED),
+		"MAP_TEXT":                      reflect.ValueOf(syscall.MAP_TEXT),
+		"MAP_TYPE":                      reflect.ValueOf(syscall.MAP_TYPE),
+		"MCL_CURRENT":                   reflect.ValueOf(syscall.MCL_CURRENT),
+		"MCL_FUTURE":                    reflect.ValueOf(syscall.MCL_FUTURE),
+		"MSG_CTRUNC":                    reflect.ValueOf(syscall.MSG_CTRUNC),
+		"MSG_DONTROUTE":                 reflect.ValueOf(syscall.MSG_DONTROUTE),
+		"MSG_DONTWAIT":                  reflect.ValueOf(syscall.MSG_DONTWAIT),
+		"MSG_DUPCTRL":                   reflect.ValueOf(syscall.MSG_DUPCTRL),
+		"MSG_EOR":                       reflect.ValueOf(syscall.MSG_EOR),
+		"MSG_MAXIOVLEN":                 reflect.ValueOf(syscall.MSG_MAXIOVLEN),
+		"MSG_NOTIFICATION":              reflect.ValueOf(syscall.MSG_NOTIFICATION),
+		"MSG_OOB":                       reflect.ValueOf(syscall.MSG_OOB),
+		"MSG_PEEK":                      reflect.ValueOf(syscall.MSG_PEEK),
+		"MSG_TRUNC":                     reflect.ValueOf(syscall.MSG_TRUNC),
+		"MSG_WAITALL":                   reflect.ValueOf(syscall.MSG_WAITALL),
+		"MSG_XPG4_2":                    reflect.ValueOf(syscall.MSG_XPG4_2),
+		"MS_ASYNC":                      reflect.ValueOf(syscall.MS_ASYNC),
+		"MS_INVALIDATE":                 reflect.ValueOf(syscall.MS_INVALIDATE),
+		"MS_OLDSYNC":                    reflect.ValueOf(syscall.MS_OLDSYNC),
+		"MS_SYNC":                       reflect.ValueOf(syscall.MS_SYNC),
+		"M_FLUSH":                       reflect.ValueOf(syscall.M_FLUSH),
+		"Mkdir":                         reflect.ValueOf(syscall.Mkdir),
+		"Mknod":                         reflect.ValueOf(syscall.Mknod),
+		"Mmap":                          reflect.ValueOf(syscall.Mmap),
+		"Munmap":                        reflect.ValueOf(syscall.Munmap),
+		"NOFLSH":                        reflect.ValueOf(syscall.NOFLSH),
+		"Nanosleep":                     reflect.ValueOf(syscall.Nanosleep),
+		"NsecToTimespec":                reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                 reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                         reflect.ValueOf(syscall.OCRNL),
+		"OFDEL":                         reflect.ValueOf(syscall.OFDEL),
+		"OFILL":                         reflect.ValueOf(syscall.OFILL),
+		"ONLCR":                         reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                        reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                         reflect.ValueOf(syscall.ONOCR),
+		"OPENFAIL":                      reflect.ValueOf(syscall.OPENFAIL),
+		"OPOST":                         reflect.ValueOf(syscall.OPOST),
+		"O_ACCMODE":                     reflect.ValueOf(syscall.O_ACCMODE),
+		"O_APPEND":                      reflect.ValueOf(syscall.O_APPEND),
+		"O_CLOEXEC":                     reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                       reflect.ValueOf(syscall.O_CREAT),
+		"O_DIRECTORY":                   reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_DSYNC":                       reflect.ValueOf(syscall.O_DSYNC),
+		"O_EXCL":                        reflect.ValueOf(syscall.O_EXCL),
+		"O_EXEC":                        reflect.ValueOf(syscall.O_EXEC),
+		"O_LARGEFILE":                   reflect.ValueOf(syscall.O_LARGEFILE),
+		"O_NDELAY":                      reflect.ValueOf(syscall.O_NDELAY),
+		"O_NOCTTY":                      reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NOFOLLOW":                    reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_NOLINKS":                     reflect.ValueOf(syscall.O_NOLINKS),
+		"O_NONBLOCK":                    reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_RDONLY":                      reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                        reflect.ValueOf(syscall.O_RDWR),
+		"O_RSYNC":                       reflect.ValueOf(syscall.O_RSYNC),
+		"O_SEARCH":                      reflect.ValueOf(syscall.O_SEARCH),
+		"O_SIOCGIFCONF":                 reflect.ValueOf(syscall.O_SIOCGIFCONF),
+		"O_SIOCGLIFCONF":                reflect.ValueOf(syscall.O_SIOCGLIFCONF),
+		"O_SYNC":                        reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                       reflect.ValueOf(syscall.O_TRUNC),
+		"O_WRONLY":                      reflect.ValueOf(syscall.O_WRONLY),
+		"O_XATTR":                       reflect.ValueOf(syscall.O_XATTR),
+		"Open":                          reflect.ValueOf(syscall.Open),
+		"PARENB":                        reflect.ValueOf(syscall.PARENB),
+		"PAREXT":                        reflect.ValueOf(syscall.PAREXT),
+		"PARMRK":                        reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                        reflect.ValueOf(syscall.PARODD),
+		"PENDIN":                        reflect.ValueOf(syscall.PENDIN),
+		"PRIO_PGRP":                     reflect.ValueOf(syscall.PRIO_PGRP),
+		"PRIO_PROCESS":                  reflect.ValueOf(syscall.PRIO_PROCESS),
+		"PRIO_USER":                     reflect.ValueOf(syscall.PRIO_USER),
+		"PROT_EXEC":                     reflect.ValueOf(syscall.PROT_EXEC),
+		"PROT_NONE":                     reflect.ValueOf(syscall.PROT_NONE),
+		"PROT_READ":                     reflect.ValueOf(syscall.PROT_READ),
+		"PROT_WRITE":                    reflect.ValueOf(syscall.PROT_WRITE),
+		"ParseDirent":                   reflect.ValueOf(syscall.ParseDirent),
+		"ParseSocketControlMessage":     reflect.ValueOf(syscall.ParseSocketControlMessage),
+		"ParseUnixRights":               reflect.ValueOf(syscall.ParseUnixRights),
+		"PathMax":                       reflect.ValueOf(syscall.PathMax),
+		"Pathconf":                      reflect.ValueOf(syscall.Pathconf),
+		"Pipe":                          reflect.ValueOf(syscall.Pipe),
+		"Pipe2":                         reflect.ValueOf(syscall.Pipe2),
+		"Pread":                         reflect.ValueOf(syscall.Pread),
+		"Pwrite":                        reflect.ValueOf(syscall.Pwrite),
+		"RLIMIT_AS":                     reflect.ValueOf(syscall.RLIMIT_AS),
+		"RLIMIT_CORE":                   reflect.ValueOf(syscall.RLIMIT_CORE),
+		"RLIMIT_CPU":                    reflect.ValueOf(syscall.RLIMIT_CPU),
+		"RLIMIT_DATA":                   reflect.ValueOf(syscall.RLIMIT_DATA),
+		"RLIMIT_FSIZE":                  reflect.ValueOf(syscall.RLIMIT_FSIZE),
+		"RLIMIT_NOFILE":                 reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RLIMIT_STACK":                  reflect.ValueOf(syscall.RLIMIT_STACK),
+		"RLIM_INFINITY":                 reflect.ValueOf(syscall.RLIM_INFINITY),
+		"RTAX_AUTHOR":                   reflect.ValueOf(syscall.RTAX_AUTHOR),
+		"RTAX_BRD":                      reflect.ValueOf(syscall.RTAX_BRD),
+		"RTAX_DST":                      reflect.ValueOf(syscall.RTAX_DST),
+		"RTAX_GATEWAY":                  reflect.ValueOf(syscall.RTAX_GATEWAY),
+		"RTAX_GENMASK":                  reflect.ValueOf(syscall.RTAX_GENMASK),
+		"RTAX_IFA":                      reflect.ValueOf(syscall.RTAX_IFA),
+		"RTAX_IFP":                      reflect.ValueOf(syscall.RTAX_IFP),
+		"RTAX_MAX":                      reflect.ValueOf(syscall.RTAX_MAX),
+		"RTAX_NETMASK":                  reflect.ValueOf(syscall.RTAX_NETMASK),
+		"RTAX_SRC":                      reflect.ValueOf(syscall.RTAX_SRC),
+		"RTA_AUTHOR":                    reflect.ValueOf(syscall.RTA_AUTHOR),
+		"RTA_BRD":                       reflect.ValueOf(syscall.RTA_BRD),
+		"RTA_DST":                       reflect.ValueOf(syscall.RTA_DST),
+		"RTA_GATEWAY":                   reflect.ValueOf(syscall.RTA_GATEWAY),
+		"RTA_GENMASK":                   reflect.ValueOf(syscall.RTA_GENMASK),
+		"RTA_IFA":                       reflect.ValueOf(syscall.RTA_IFA),
+		"RTA_IFP":                       reflect.ValueOf(syscall.RTA_IFP),
+		"RTA_NETMASK":                   reflect.ValueOf(syscall.RTA_NETMASK),
+		"RTA_NUMBITS":                   reflect.ValueOf(syscall.RTA_NUMBITS),
+		"RTA_SRC":                       reflect.ValueOf(syscall.RTA_SRC),
+		"RTF_BLACKHOLE":                 reflect.ValueOf(syscall.RTF_BLACKHOLE),
+		"RTF_CLONING":                   reflect.ValueOf(syscall.RTF_CLONING),
+		"RTF_DONE":                      reflect.ValueOf(syscall.RTF_DONE),
+		"RTF_DYNAMIC":                   reflect.ValueOf(syscall.RTF_DYNAMIC),
+		"RTF_GATEWAY":                   reflect.ValueOf(syscall.RTF_GATEWAY),
+		"RTF_HOST":                      reflect.ValueOf(syscall.RTF_HOST),
+		"RTF_INDIRECT":                  reflect.ValueOf(syscall.RTF_INDIRECT),
+		"RTF_KERNEL":                    reflect.ValueOf(syscall.RTF_KERNEL),
+		"RTF_LLINFO":                    reflect.ValueOf(syscall.RTF_LLINFO),
+		"RTF_MASK":                      reflect.ValueOf(syscall.RTF_MASK),
+		"RTF_MODIFIED":                  reflect.ValueOf(syscall.RTF_MODIFIED),
+		"RTF_MULTIRT":                   reflect.ValueOf(syscall.RTF_MULTIRT),
+		"RTF_PRIVATE":                   reflect.ValueOf(syscall.RTF_PRIVATE),
+		"RTF_PROTO1":                    reflect.ValueOf(syscall.RTF_PROTO1),
+		"RTF_PROTO2":                    reflect.ValueOf(syscall.RTF_PROTO2),
+		"RTF_REJECT":                    reflect.ValueOf(syscall.RTF_REJECT),
+		"RTF_SETSRC":                    reflect.ValueOf(syscall.RTF_SETSRC),
+		"RTF_STATIC":                    reflect.ValueOf(syscall.RTF_STATIC),
+		"RTF_UP":                        reflect.ValueOf(syscall.RTF_UP),
+		"RTF_XRESOLVE":                  reflect.ValueOf(syscall.RTF_XRESOLVE),
+		"RTF_ZONE":                      reflect.ValueOf(syscall.RTF_ZONE),
+		"RTM_ADD":                       reflect.ValueOf(syscall.RTM_ADD),
+		"RTM_CHANGE":                    reflect.ValueOf(syscall.RTM_CHANGE),
+		"RTM_CHGADDR":                   reflect.ValueOf(syscall.RTM_CHGADDR),
+		"RTM_DELADDR":                   reflect.ValueOf(syscall.RTM_DELADDR),
+		"RTM_DELETE":                    reflect.ValueOf(syscall.RTM_DELETE),
+		"RTM_FREEADDR":                  reflect.ValueOf(syscall.RTM_FREEADDR),
+		"RTM_GET":                       reflect.ValueOf(syscall.RTM_GET),
+		"RTM_IFINFO":                    reflect.ValueOf(syscall.RTM_IFINFO),
+		"RTM_LOCK":                      reflect.ValueOf(syscall.RTM_LOCK),
+		"RTM_LOSING":                    reflect.ValueOf(syscall.RTM_LOSING),
+		"RTM_MISS":                      reflect.ValueOf(syscall.RTM_MISS),
+		"RTM_NEWADDR":                   reflect.ValueOf(syscall.RTM_NEWADDR),
+		"RTM_OLDADD":                    reflect.ValueOf(syscall.RTM_OLDADD),
+		"RTM_OLDDEL":                    reflect.ValueOf(syscall.RTM_OLDDEL),
+		"RTM_REDIRECT":                  reflect.ValueOf(syscall.RTM_REDIRECT),
+		"RTM_RESOLVE":                   reflect.ValueOf(syscall.RTM_RESOLVE),
+		"RTM_VERSION":                   reflect.ValueOf(syscall.RTM_VERSION),
+		"RTV_EXPIRE":                    reflect.ValueOf(syscall.RTV_EXPIRE),
+		"RTV_HOPCOUNT":                  reflect.ValueOf(syscall.RTV_HOPCOUNT),
+		"RTV_MTU":                       reflect.ValueOf(syscall.RTV_MTU),
+		"RTV_RPIPE":                     reflect.ValueOf(syscall.RTV_RPIPE),
+		"RTV_RTT":                       reflect.ValueOf(syscall.RTV_RTT),
+		"RTV_RTTVAR":                    reflect.ValueOf(syscall.RTV_RTTVAR),
+		"RTV_SPIPE":                     reflect.ValueOf(syscall.RTV_SPIPE),
+		"RTV_SSTHRESH":                  reflect.ValueOf(syscall.RTV_SSTHRESH),
+		"RT_AWARE":                      reflect.ValueOf(syscall.RT_AWARE),
+		"RUSAGE_CHILDREN":               reflect.ValueOf(syscall.RUSAGE_CHILDREN),
+		"RUSAGE_SELF":                   reflect.ValueOf(syscall.RUSAGE_SELF),
+		"RawSyscall":                    reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                   reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                          reflect.ValueOf(syscall.Read),
+		"ReadDirent":                    reflect.ValueOf(syscall.ReadDirent),
+		"Readlink":                      reflect.ValueOf(syscall.Readlink),
+		"Recvfrom":                      reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                       reflect.ValueOf(syscall.Recvmsg),
+		"Rename":                        reflect.ValueOf(syscall.Rename),
+		"Rmdir":                         reflect.ValueOf(syscall.Rmdir),
+		"SCM_RIGHTS":                    reflect.ValueOf(syscall.SCM_RIGHTS),
+		"SCM_TIMESTAMP":                 reflect.ValueOf(syscall.SCM_TIMESTAMP),
+		"SCM_UCRED":                     reflect.ValueOf(syscall.SCM_UCRED),
+		"SHUT_RD":                       reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                     reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                       reflect.ValueOf(syscall.SHUT_WR),
+		"SIG2STR_MAX":                   reflect.ValueOf(syscall.SIG2STR_MAX),
+		"SIGABRT":                       reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                       reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                        reflect.ValueOf(syscall.SIGBUS),
+		"SIGCANCEL":                     reflect.ValueOf(syscall.SIGCANCEL),
+		"SIGCHLD":                       reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCLD":                        reflect.ValueOf(syscall.SIGCLD),
+		"SIGCONT":                       reflect.ValueOf(syscall.SIGCONT),
+		"SIGEMT":                        reflect.ValueOf(syscall.SIGEMT),
+		"SIGFPE":                        reflect.ValueOf(syscall.SIGFPE),
+		"SIGFREEZE":                     reflect.ValueOf(syscall.SIGFREEZE),
+		"SIGHUP":                        reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                        reflect.ValueOf(syscall.SIGILL),
+		"SIGINT":                        reflect.ValueOf(syscall.SIGINT),
+		"SIGIO":                         reflect.ValueOf(syscall.SIGIO),
+		"SIGIOT":                        reflect.ValueOf(syscall.SIGIOT),
+		"SIGJVM1":                       reflect.ValueOf(syscall.SIGJVM1),
+		"SIGJVM2":                       reflect.ValueOf(syscall.SIGJVM2),
+		"SIGKILL":                       reflect.ValueOf(syscall.SIGKILL),
+		"SIGLOST":                       reflect.ValueOf(syscall.SIGLOST),
+		"SIGLWP":                        reflect.ValueOf(syscall.SIGLWP),
+		"SIGPIPE":                       reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPOLL":                       reflect.ValueOf(syscall.SIGPOLL),
+		"SIGPROF":                       reflect.ValueOf(syscall.SIGPROF),
+		"SIGPWR":                        reflect.ValueOf(syscall.SIGPWR),
+		"SIGQUIT":                       reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                       reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSTOP":                       reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                        reflect.ValueOf(syscall.SIGSYS),
+		"SIGTERM":                       reflect.ValueOf(syscall.SIGTERM),
+		"SIGTHAW":                       reflect.ValueOf(syscall.SIGTHAW),
+		"SIGTRAP":                       reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                       reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                       reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                       reflect.ValueOf(syscall.SIGTTOU),
+		"SIGURG":                        reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                       reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                       reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVTALRM":                     reflect.ValueOf(syscall.SIGVTALRM),
+		"SIGWAITING":                    reflect.ValueOf(syscall.SIGWAITING),
+		"SIGWINCH":                      reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                       reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                       reflect.ValueOf(syscall.SIGXFSZ),
+		"SIGXRES":                       reflect.ValueOf(syscall.SIGXRES),
+		"SIOCADDMULTI":                  reflect.ValueOf(syscall.SIOCADDMULTI),
+		"SIOCADDRT":                     reflect.ValueOf(syscall.SIOCADDRT),
+		"SIOCATMARK":                    reflect.ValueOf(syscall.SIOCATMARK),
+		"SIOCDARP":                      reflect.ValueOf(syscall.SIOCDARP),
+		"SIOCDELMULTI":                  reflect.ValueOf(syscall.SIOCDELMULTI),
+		"SIOCDELRT":                     reflect.ValueOf(syscall.SIOCDELRT),
+		"SIOCDIPSECONFIG":               reflect.ValueOf(syscall.SIOCDIPSECONFIG),
+		"SIOCDXARP":                     reflect.ValueOf(syscall.SIOCDXARP),
+		"SIOCFIPSECONFIG":               reflect.ValueOf(syscall.SIOCFIPSECONFIG),
+		"SIOCGARP":                      reflect.ValueOf(syscall.SIOCGARP),
+		"SIOCGDSTINFO":                  reflect.ValueOf(syscall.SIOCGDSTINFO),
+		"SIOCGENADDR":                   reflect.ValueOf(syscall.SIOCGENADDR),
+		"SIOCGENPSTATS":                 reflect.ValueOf(syscall.SIOCGENPSTATS),
+		"SIOCGETLSGCNT":                 reflect.ValueOf(syscall.SIOCGETLSGCNT),
+		"SIOCGETNAME":                   reflect.ValueOf(syscall.SIOCGETNAME),
+		"SIOCGETPEER":                   reflect.ValueOf(syscall.SIOCGETPEER),
+		"SIOCGETPROP":                   reflect.ValueOf(syscall.SIOCGETPROP),
+		"SIOCGETSGCNT":                  reflect.ValueOf(syscall.SIOCGETSGCNT),
+		"SIOCGETSYNC":                   reflect.ValueOf(syscall.SIOCGETSYNC),
+		"SIOCGETVIFCNT":                 reflect.ValueOf(syscall.SIOCGETVIFCNT),
+		"SIOCGHIWAT":                    reflect.ValueOf(syscall.SIOCGHIWAT),
+		"SIOCGIFADDR":                   reflect.ValueOf(syscall.SIOCGIFADDR),
+		"SIOCGIFBRDADDR":                reflect.ValueOf(syscall.SIOCGIFBRDADDR),
+		"SIOCGIFCONF":                   reflect.ValueOf(syscall.SIOCGIFCONF),
+		"SIOCGIFDSTADDR":                reflect.ValueOf(syscall.SIOCGIFDSTADDR),
+		"SIOCGIFFLAGS":                  reflect.ValueOf(syscall.SIOCGIFFLAGS),
+		"SIOCGIFHWADDR":                 reflect.ValueOf(syscall.SIOCGIFHWADDR),
+		"SIOCGIFINDEX":                  reflect.ValueOf(syscall.SIOCGIFINDEX),
+		"SIOCGIFMEM":                    reflect.ValueOf(syscall.SIOCGIFMEM),
+		"SIOCGIFMETRIC":                 reflect.ValueOf(syscall.SIOCGIFMETRIC),
+		"SIOCGIFMTU":                    reflect.ValueOf(syscall.SIOCGIFMTU),
+		"SIOCGIFMUXID":                  reflect.ValueOf(syscall.SIOCGIFMUXID),
+		"SIOCGIFNETMASK":                reflect.ValueOf(syscall.SIOCGIFNETMASK),
+		"SIOCGIFNUM":                    reflect.ValueOf(syscall.SIOCGIFNUM),
+		"SIOCGIP6ADDRPOLICY":            reflect.ValueOf(syscall.SIOCGIP6ADDRPOLICY),
+		"SIOCGIPMSFILTER":               reflect.ValueOf(syscall.SIOCGIPMSFILTER),
+		"SIOCGLIFADDR":                  reflect.ValueOf(syscall.SIOCGLIFADDR),
+		"SIOCGLIFBINDING":               reflect.ValueOf(syscall.SIOCGLIFBINDING),
+		"SIOCGLIFBRDADDR":               reflect.ValueOf(syscall.SIOCGLIFBRDADDR),
+		"SIOCGLIFCONF":                  reflect.ValueOf(syscall.SIOCGLIFCONF),
+		"SIOCGLIFDADSTATE":              reflect.ValueOf(syscall.SIOCGLIFDADSTATE),
+		"SIOCGLIFDSTADDR":               reflect.ValueOf(syscall.SIOCGLIFDSTADDR),
+		"SIOCGLIFFLAGS":                 reflect.ValueOf(syscall.SIOCGLIFFLAGS),
+		"SIOCGLIFGROUPINFO":             reflect.ValueOf(syscall.SIOCGLIFGROUPINFO),
+		"SIOCGLIFGROUPNAME":             reflect.ValueOf(syscall.SIOCGLIFGROUPNAME),
+		"SIOCGLIFHWADDR":                reflect.ValueOf(syscall.SIOCGLIFHWADDR),
+		"SIOCGLIFINDEX":                 reflect.ValueOf(syscall.SIOCGLIFINDEX),
+		"SIOCGLIFLNKINFO":               reflect.ValueOf(syscall.SIOCGLIFLNKINFO),
+		"SIOCGLIFMETRIC":                reflect.ValueOf(syscall.SIOCGLIFMETRIC),
+		"SIOCGLIFMTU":                   reflect.ValueOf(syscall.SIOCGLIFMTU),
+		"SIOCGLIFMUXID":                 reflect.ValueOf(syscall.SIOCGLIFMUXID),
+		"SIOCGLIFNETMASK":               reflect.ValueOf(syscall.SIOCGLIFNETMASK),
+		"SIOCGLIFNUM":                   reflect.ValueOf(syscall.SIOCGLIFNUM),
+		"SIOCGLIFSRCOF":                 reflect.ValueOf(syscall.SIOCGLIFSRCOF),
+		"SIOCGLIFSUBNET":                reflect.ValueOf(syscall.SIOCGLIFSUBNET),
+		"SIOCGLIFTOKEN":                 reflect.ValueOf(syscall.SIOCGLIFTOKEN),
+		"SIOCGLIFUSESRC":                reflect.ValueOf(syscall.SIOCGLIFUSESRC),
+		"SIOCGLIFZONE":                  reflect.ValueOf(syscall.SIOCGLIFZONE),
+		"SIOCGLOWAT":                    reflect.ValueOf(syscall.SIOCGLOWAT),
+		"SIOCGMSFILTER":                 reflect.ValueOf(syscall.SIOCGMSFILTER),
+		"SIOCGPGRP":                     reflect.ValueOf(syscall.SIOCGPGRP),
+		"SIOCGSTAMP":                    reflect.ValueOf(syscall.SIOCGSTAMP),
+		"SIOCGXARP":                     reflect.ValueOf(syscall.SIOCGXARP),
+		"SIOCIFDETACH":                  reflect.ValueOf(syscall.SIOCIFDETACH),
+		"SIOCILB":                       reflect.ValueOf(syscall.SIOCILB),
+		"SIOCLIFADDIF":                  reflect.ValueOf(syscall.SIOCLIFADDIF),
+		"SIOCLIFDELND":                  reflect.ValueOf(syscall.SIOCLIFDELND),
+		"SIOCLIFGETND":                  reflect.ValueOf(syscall.SIOCLIFGETND),
+		"SIOCLIFREMOVEIF":               reflect.ValueOf(syscall.SIOCLIFREMOVEIF),
+		"SIOCLIFSETND":                  reflect.ValueOf(syscall.SIOCLIFSETND),
+		"SIOCLIPSECONFIG":               reflect.ValueOf(syscall.SIOCLIPSECONFIG),
+		"SIOCLOWER":                     reflect.ValueOf(syscall.SIOCLOWER),
+		"SIOCSARP":                      reflect.ValueOf(syscall.SIOCSARP),
+		"SIOCSCTPGOPT":                  reflect.ValueOf(syscall.SIOCSCTPGOPT),
+		"SIOCSCTPPEELOFF":               reflect.ValueOf(syscall.SIOCSCTPPEELOFF),
+		"SIOCSCTPSOPT":                  reflect.ValueOf(syscall.SIOCSCTPSOPT),
+		"SIOCSENABLESDP":                reflect.ValueOf(syscall.SIOCSENABLESDP),
+		"SIOCSETPROP":                   reflect.ValueOf(syscall.SIOCSETPROP),
+		"SIOCSETSYNC":                   reflect.ValueOf(syscall.SIOCSETSYNC),
+		"SIOCSHIWAT":                    reflect.ValueOf(syscall.SIOCSHIWAT),
+		"SIOCSIFADDR":                   reflect.ValueOf(syscall.SIOCSIFADDR),
+		"SIOCSIFBRDADDR":                reflect.ValueOf(syscall.SIOCSIFBRDADDR),
+		"SIOCSIFDSTADDR":                reflect.ValueOf(syscall.SIOCSIFDSTADDR),
+		"SIOCSIFFLAGS":                  reflect.ValueOf(syscall.SIOCSIFFLAGS),
+		"SIOCSIFINDEX":                  reflect.ValueOf(syscall.SIOCSIFINDEX),
+		"SIOCSIFMEM":                    reflect.ValueOf(syscall.SIOCSIFMEM),
+		"SIOCSIFMETRIC":                 reflect.ValueOf(syscall.SIOCSIFMETRIC),
+		"SIOCSIFMTU":                    reflect.ValueOf(syscall.SIOCSIFMTU),
+		"SIOCSIFMUXID":                  reflect.ValueOf(syscall.SIOCSIFMUXID),
+		"SIOCSIFNAME":                   reflect.ValueOf(syscall.SIOCSIFNAME),
+		"SIOCSIFNETMASK":                reflect.ValueOf(syscall.SIOCSIFNETMASK),
+		"SIOCSIP6ADDRPOLICY":            reflect.ValueOf(syscall.SIOCSIP6ADDRPOLICY),
+		"SIOCSIPMSFILTER":               reflect.ValueOf(syscall.SIOCSIPMSFILTER),
+		"SIOCSIPSECONFIG":               reflect.ValueOf(syscall.SIOCSIPSECONFIG),
+		"SIOCSLGETREQ":                  reflect.ValueOf(syscall.SIOCSLGETREQ),
+		"SIOCSLIFADDR":                  reflect.ValueOf(syscall.SIOCSLIFADDR),
+		"SIOCSLIFBRDADDR":               reflect.ValueOf(syscall.SIOCSLIFBRDADDR),
+		"SIOCSLIFDSTADDR":               reflect.ValueOf(syscall.SIOCSLIFDSTADDR),
+		"SIOCSLIFFLAGS":                 reflect.ValueOf(syscall.SIOCSLIFFLAGS),
+		"SIOCSLIFGROUPNAME":             reflect.ValueOf(syscall.SIOCSLIFGROUPNAME),
+		"SIOCSLIFINDEX":                 reflect.ValueOf(syscall.SIOCSLIFINDEX),
+		"SIOCSLIFLNKINFO":               reflect.ValueOf(syscall.SIOCSLIFLNKINFO),
+		"SIOCSLIFMETRIC":                reflect.ValueOf(syscall.SIOCSLIFMETRIC),
+		"SIOCSLIFMTU":                   reflect.ValueOf(syscall.SIOCSLIFMTU),
+		"SIOCSLIFMUXID":                 reflect.ValueOf(syscall.SIOCSLIFMUXID),
+		"SIOCSLIFNAME":                  reflect.ValueOf(syscall.SIOCSLIFNAME),
+		"SIOCSLIFNETMASK":               reflect.ValueOf(syscall.SIOCSLIFNETMASK),
+		"SIOCSLIFPREFIX":                reflect.ValueOf(syscall.SIOCSLIFPREFIX),
+		"SIOCSLIFSUBNET":                reflect.ValueOf(syscall.SIOCSLIFSUBNET),
+		"SIOCSLIFTOKEN":                 reflect.ValueOf(syscall.SIOCSLIFTOKEN),
+		"SIOCSLIFUSESRC":                reflect.ValueOf(syscall.SIOCSLIFUSESRC),
+		"SIOCSLIFZONE":                  reflect.ValueOf(syscall.SIOCSLIFZONE),
+		"SIOCSLOWAT":                    reflect.ValueOf(syscall.SIOCSLOWAT),
+		"SIOCSLSTAT":                    reflect.ValueOf(syscall.SIOCSLSTAT),
+		"SIOCSMSFILTER":                 reflect.ValueOf(syscall.SIOCSMSFILTER),
+		"SIOCSPGRP":                     reflect.ValueOf(syscall.SIOCSPGRP),
+		"SIOCSPROMISC":                  reflect.ValueOf(syscall.SIOCSPROMISC),
+		"SIOCSQPTR":                     reflect.ValueOf(syscall.SIOCSQPTR),
+		"SIOCSSDSTATS":                  reflect.ValueOf(syscall.SIOCSSDSTATS),
+		"SIOCSSESTATS":                  reflect.ValueOf(syscall.SIOCSSESTATS),
+		"SIOCSXARP":                     reflect.ValueOf(syscall.SIOCSXARP),
+		"SIOCTMYADDR":                   reflect.ValueOf(syscall.SIOCTMYADDR),
+		"SIOCTMYSITE":                   reflect.ValueOf(syscall.SIOCTMYSITE),
+		"SIOCTONLINK":                   reflect.ValueOf(syscall.SIOCTONLINK),
+		"SIOCUPPER":                     reflect.ValueOf(syscall.SIOCUPPER),
+		"SIOCX25RCV":                    reflect.ValueOf(syscall.SIOCX25RCV),
+		"SIOCX25TBL":                    reflect.ValueOf(syscall.SIOCX25TBL),
+		"SIOCX25XMT":                    reflect.ValueOf(syscall.SIOCX25XMT),
+		"SIOCXPROTO":                    reflect.ValueOf(syscall.SIOCXPROTO),
+		"SOCK_CLOEXEC":                  reflect.ValueOf(syscall.SOCK_CLOEXEC),
+		"SOCK_DGRAM":                    reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_NDELAY":                   reflect.ValueOf(syscall.SOCK_NDELAY),
+		"SOCK_NONBLOCK":                 reflect.ValueOf(syscall.SOCK_NONBLOCK),
+		"SOCK_RAW":                      reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_RDM":                      reflect.ValueOf(syscall.SOCK_RDM),
+		"SOCK_SEQPACKET":                reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                   reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOCK_TYPE_MASK":                reflect.ValueOf(syscall.SOCK_TYPE_MASK),
+		"SOL_FILTER":                    reflect.ValueOf(syscall.SOL_FILTER),
+		"SOL_PACKET":                    reflect.ValueOf(syscall.SOL_PACKET),
+		"SOL_ROUTE":                     reflect.ValueOf(syscall.SOL_ROUTE),
+		"SOL_SOCKET":                    reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOMAXCONN":                     reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ACCEPTCONN":                 reflect.ValueOf(syscall.SO_ACCEPTCONN),
+		"SO_ALL":                        reflect.ValueOf(syscall.SO_ALL),
+		"SO_ALLZONES":                   reflect.ValueOf(syscall.SO_ALLZONES),
+		"SO_ANON_MLP":                   reflect.ValueOf(syscall.SO_ANON_MLP),
+		"SO_ATTACH_FILTER":              reflect.ValueOf(syscall.SO_ATTACH_FILTER),
+		"SO_BAND":                       reflect.ValueOf(syscall.SO_BAND),
+		"SO_BROADCAST":                  reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_COPYOPT":                    reflect.ValueOf(syscall.SO_COPYOPT),
+		"SO_DEBUG":                      reflect.ValueOf(syscall.SO_DEBUG),
+		"SO_DELIM":                      reflect.ValueOf(syscall.SO_DELIM),
+		"SO_DETACH_FILTER":              reflect.ValueOf(syscall.SO_DETACH_FILTER),
+		"SO_DGRAM_ERRIND":               reflect.ValueOf(syscall.SO_DGRAM_ERRIND),
+		"SO_DOMAIN":                     reflect.ValueOf(syscall.SO_DOMAIN),
+		"SO_DONTLINGER":                 reflect.ValueOf(syscall.SO_DONTLINGER),
+		"SO_DONTROUTE":                  reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_ERROPT":                     reflect.ValueOf(syscall.SO_ERROPT),
+		"SO_ERROR":                      reflect.ValueOf(syscall.SO_ERROR),
+		"SO_EXCLBIND":                   reflect.ValueOf(syscall.SO_EXCLBIND),
+		"SO_HIWAT":                      reflect.ValueOf(syscall.SO_HIWAT),
+		"SO_ISNTTY":                     reflect.ValueOf(syscall.SO_ISNTTY),
+		"SO_ISTTY":                      reflect.ValueOf(syscall.SO_ISTTY),
+		"SO_KEEPALIVE":                  reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_LINGER":                     reflect.ValueOf(syscall.SO_LINGER),
+		"SO_LOWAT":                      reflect.ValueOf(syscall.SO_LOWAT),
+		"SO_MAC_EXEMPT":                 reflect.ValueOf(syscall.SO_MAC_EXEMPT),
+		"SO_MAC_IMPLICIT":               reflect.ValueOf(syscall.SO_MAC_IMPLICIT),
+		"SO_MAXBLK":                     reflect.ValueOf(syscall.SO_MAXBLK),
+		"SO_MAXPSZ":                     reflect.ValueOf(syscall.SO_MAXPSZ),
+		"SO_MINPSZ":                     reflect.ValueOf(syscall.SO_MINPSZ),
+		"SO_MREADOFF":                   reflect.ValueOf(syscall.SO_MREADOFF),
+		"SO_MREADON":                    reflect.ValueOf(syscall.SO_MREADON),
+		"SO_NDELOFF":                    reflect.ValueOf(syscall.SO_NDELOFF),
+		"SO_NDELON":                     reflect.ValueOf(syscall.SO_NDELON),
+		"SO_NODELIM":                    reflect.ValueOf(syscall.SO_NODELIM),
+		"SO_OOBINLINE":                  reflect.ValueOf(syscall.SO_OOBINLINE),
+		"SO_PROTOTYPE":                  reflect.ValueOf(syscall.SO_PROTOTYPE),
+		"SO_RCVBUF":                     reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_RCVLOWAT":                   reflect.ValueOf(syscall.SO_RCVLOWAT),
+		"SO_RCVPSH":                     reflect.ValueOf(syscall.SO_RCVPSH),
+		"SO_RCVTIMEO":                   reflect.ValueOf(syscall.SO_RCVTIMEO),
+		"SO_READOPT":                    reflect.ValueOf(syscall.SO_READOPT),
+		"SO_RECVUCRED":                  reflect.ValueOf(syscall.SO_RECVUCRED),
+		"SO_REUSEADDR":                  reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_SECATTR":                    reflect.ValueOf(syscall.SO_SECATTR),
+		"SO_SNDBUF":                     reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_SNDLOWAT":                   reflect.ValueOf(syscall.SO_SNDLOWAT),
+		"SO_SNDTIMEO":                   reflect.ValueOf(syscall.SO_SNDTIMEO),
+		"SO_STRHOLD":                    reflect.ValueOf(syscall.SO_STRHOLD),
+		"SO_TAIL":                       reflect.ValueOf(syscall.SO_TAIL),
+		"SO_TIMESTAMP":                  reflect.ValueOf(syscall.SO_TIMESTAMP),
+		"SO_TONSTOP":                    reflect.ValueOf(syscall.SO_TONSTOP),
+		"SO_TOSTOP":                     reflect.ValueOf(syscall.SO_TOSTOP),
+		"SO_TYPE":                       reflect.ValueOf(syscall.SO_TYPE),
+		"SO_USELOOPBACK":                reflect.ValueOf(syscall.SO_USELOOPBACK),
+		"SO_VRRP":                       reflect.ValueOf(syscall.SO_VRRP),
+		"SO_WROFF":                      reflect.ValueOf(syscall.SO_WROFF),
+		"SYS_EXECVE":                    reflect.ValueOf(syscall.SYS_EXECVE),
+		"SYS_FCNTL":                     reflect.ValueOf(syscall.SYS_FCNTL),
+		"S_IFBLK":                       reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                       reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                       reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                       reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                       reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                        reflect.ValueOf(syscall.S_IFMT),
+		"S_IFREG":                       reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                      reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IRUSR":                       reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                       reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                       reflect.ValueOf(syscall.S_IRWXO),
+		"S_ISGID":                       reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                       reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                       reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWUSR":                       reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXUSR":                       reflect.ValueOf(syscall.S_IXUSR),
+		"Seek":                          reflect.ValueOf(syscall.Seek),
+		"Sendfile":                      reflect.ValueOf(syscall.Sendfile),
+		"Sendmsg":                       reflect.ValueOf(syscall.Sendmsg),
+		"SendmsgN":                      reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                        reflect.ValueOf(syscall.Sendto),
+		"SetNonblock":                   reflect.ValueOf(syscall.SetNonblock),
+		"Setegid":                       reflect.ValueOf(syscall.Setegid),
+		"Setenv":                        reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                       reflect.ValueOf(syscall.Seteuid),
+		"Setgid":                        reflect.ValueOf(syscall.Setgid),
+		"Setgroups":                     reflect.ValueOf(syscall.Setgroups),
+		"Setpgid":                       reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                   reflect.ValueOf(syscall.Setpriority),
+		"Setregid":                      reflect.ValueOf(syscall.Setregid),
+		"Setreuid":                      reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                     reflect.ValueOf(syscall.Setrlimit),
+		"Setsid":                        reflect.ValueOf(syscall.Setsid),
+		"SetsockoptByte":                reflect.ValueOf(syscall.SetsockoptByte),
+		"SetsockoptICMPv6Filter":        reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
+		"SetsockoptIPMreq":              reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPv6Mreq":            reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":           reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                 reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":              reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptString":              reflect.ValueOf(syscall.SetsockoptString),
+		"SetsockoptTimeval":             reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Setuid":                        reflect.ValueOf(syscall.Setuid),
+		"Shutdown":                      reflect.ValueOf(syscall.Shutdown),
+		"SizeofBpfHdr":                  reflect.ValueOf(syscall.SizeofBpfHdr),
+		"SizeofBpfInsn":                 reflect.ValueOf(syscall.SizeofBpfInsn),
+		"SizeofBpfProgram":              reflect.ValueOf(syscall.SizeofBpfProgram),
+		"SizeofBpfStat":                 reflect.ValueOf(syscall.SizeofBpfStat),
+		"SizeofBpfVersion":              reflect.ValueOf(syscall.SizeofBpfVersion),
+		"SizeofCmsghdr":                 reflect.ValueOf(syscall.SizeofCmsghdr),
+		"SizeofICMPv6Filter":            reflect.ValueOf(syscall.SizeofICMPv6Filter),
+		"SizeofIPMreq":                  reflect.ValueOf(syscall.SizeofIPMreq),
+		"SizeofIPv6MTUInfo":             reflect.ValueOf(syscall.SizeofIPv6MTUInfo),
+		"SizeofIPv6Mreq":                reflect.ValueOf(syscall.SizeofIPv6Mreq),
+		"SizeofIfData":                  reflect.ValueOf(syscall.SizeofIfData),
+		"SizeofIfMsghdr":                reflect.ValueOf(syscall.SizeofIfMsghdr),
+		"SizeofIfaMsghdr":               reflect.ValueOf(syscall.SizeofIfaMsghdr),
+		"SizeofInet6Pktinfo":            reflect.ValueOf(syscall.SizeofInet6Pktinfo),
+		"SizeofLinger":                  reflect.ValueOf(syscall.SizeofLinger),
+		"SizeofMsghdr":                  reflect.ValueOf(syscall.SizeofMsghdr),
+		"SizeofRtMetrics":               reflect.ValueOf(syscall.SizeofRtMetrics),
+		"SizeofRtMsghdr":                reflect.ValueOf(syscall.SizeofRtMsghdr),
+		"SizeofSockaddrAny":             reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrDatalink":        reflect.ValueOf(syscall.SizeofSockaddrDatalink),
+		"SizeofSockaddrInet4":           reflect.ValueOf(syscall.SizeofSockaddrInet4),
+		"SizeofSockaddrInet6":           reflect.ValueOf(syscall.SizeofSockaddrInet6),
+		"SizeofSockaddrUnix":            reflect.ValueOf(syscall.SizeofSockaddrUnix),
+		"SlicePtrFromStrings":           reflect.ValueOf(syscall.SlicePtrFromStrings),
+		"Socket":                        reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":             reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"Socketpair":                    reflect.ValueOf(syscall.Socketpair),
+		"StartProcess":                  reflect.ValueOf(syscall.StartProcess),
+		"Stat":                          reflect.ValueOf(syscall.Stat),
+		"Stderr":                        reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                         reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                        reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                 reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":               reflect.ValueOf(syscall.StringByteSlice),
+		"StringSlicePtr":                reflect.ValueOf(syscall.StringSlicePtr),
+		"Symlink":                       reflect.ValueOf(syscall.Symlink),
+		"Sync":                          reflect.ValueOf(syscall.Sync),
+		"Syscall":                       reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                      reflect.ValueOf(syscall.Syscall6),
+		"TCFLSH":                        reflect.ValueOf(syscall.TCFLSH),
+		"TCIFLUSH":                      reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                     reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                      reflect.ValueOf(syscall.TCOFLUSH),
+		"TCP_ABORT_THRESHOLD":           reflect.ValueOf(syscall.TCP_ABORT_THRESHOLD),
+		"TCP_ANONPRIVBIND":              reflect.ValueOf(syscall.TCP_ANONPRIVBIND),
+		"TCP_CONN_ABORT_THRESHOLD":      reflect.ValueOf(syscall.TCP_CONN_ABORT_THRESHOLD),
+		"TCP_CONN_NOTIFY_THRESHOLD":     reflect.ValueOf(syscall.TCP_CONN_NOTIFY_THRESHOLD),
+		"TCP_CORK":                      reflect.ValueOf(syscall.TCP_CORK),
+		"TCP_EXCLBIND":                  reflect.ValueOf(syscall.TCP_EXCLBIND),
+		"TCP_INIT_CWND":                 reflect.ValueOf(syscall.TCP_INIT_CWND),
+		"TCP_KEEPALIVE":                 reflect.ValueOf(syscall.TCP_KEEPALIVE),
+		"TCP_KEEPALIVE_ABORT_THRESHOLD": reflect.ValueOf(syscall.TCP_KEEPALIVE_ABORT_THRESHOLD),
+		"TCP_KEEPALIVE_THRESHOLD":       reflect.ValueOf(syscall.TCP_KEEPALIVE_THRESHOLD),
+		"TCP_KEEPCNT":                   reflect.ValueOf(syscall.TCP_KEEPCNT),
+		"TCP_KEEPIDLE":                  reflect.ValueOf(syscall.TCP_KEEPIDLE),
+		"TCP_KEEPINTVL":                 reflect.ValueOf(syscall.TCP_KEEPINTVL),
+		"TCP_LINGER2":                   reflect.ValueOf(syscall.TCP_LINGER2),
+		"TCP_MAXSEG":                    reflect.ValueOf(syscall.TCP_MAXSEG),
+		"TCP_MSS":                       reflect.ValueOf(syscall.TCP_MSS),
+		"TCP_NODELAY":                   reflect.ValueOf(syscall.TCP_NODELAY),
+		"TCP_NOTIFY_THRESHOLD":          reflect.ValueOf(syscall.TCP_NOTIFY_THRESHOLD),
+		"TCP_RECVDSTADDR":               reflect.ValueOf(syscall.TCP_RECVDSTADDR),
+		"TCP_RTO_INITIAL":               reflect.ValueOf(syscall.TCP_RTO_INITIAL),
+		"TCP_RTO_MAX":                   reflect.ValueOf(syscall.TCP_RTO_MAX),
+		"TCP_RTO_MIN":                   reflect.ValueOf(syscall.TCP_RTO_MIN),
+		"TCSAFLUSH":                     reflect.ValueOf(syscall.TCSAFLUSH),
+		"TIOC":                          reflect.ValueOf(syscall.TIOC),
+		"TIOCCBRK":                      reflect.ValueOf(syscall.TIOCCBRK),
+		"TIOCCDTR":                      reflect.ValueOf(syscall.TIOCCDTR),
+		"TIOCCILOOP":                    reflect.ValueOf(syscall.TIOCCILOOP),
+		"TIOCEXCL":                      reflect.ValueOf(syscall.TIOCEXCL),
+		"TIOCFLUSH":                     reflect.ValueOf(syscall.TIOCFLUSH),
+		"TIOCGETC":                      reflect.ValueOf(syscall.TIOCGETC),
+		"TIOCGETD":                      reflect.ValueOf(syscall.TIOCGETD),
+		"TIOCGETP":                      reflect.ValueOf(syscall.TIOCGETP),
+		"TIOCGLTC":                      reflect.ValueOf(syscall.TIOCGLTC),
+		"TIOCGPGRP":                     reflect.ValueOf(syscall.TIOCGPGRP),
+		"TIOCGPPS":                      reflect.ValueOf(syscall.TIOCGPPS),
+		"TIOCGPPSEV":                    reflect.ValueOf(syscall.TIOCGPPSEV),
+		"TIOCGSID":                      reflect.ValueOf(syscall.TIOCGSID),
+		"TIOCGSOFTCAR":                  reflect.ValueOf(syscall.TIOCGSOFTCAR),
+		"TIOCGWINSZ":                    reflect.ValueOf(syscall.TIOCGWINSZ),
+		"TIOCHPCL":                      reflect.ValueOf(syscall.TIOCHPCL),
+		"TIOCKBOF":                      reflect.ValueOf(syscall.TIOCKBOF),
+		"TIOCKBON":                      reflect.ValueOf(syscall.TIOCKBON),
+		"TIOCLBIC":                      reflect.ValueOf(syscall.TIOCLBIC),
+		"TIOCLBIS":                      reflect.ValueOf(syscall.TIOCLBIS),
+		"TIOCLGET":                      reflect.ValueOf(syscall.TIOCLGET),
+		"TIOCLSET":                      reflect.ValueOf(syscall.TIOCLSET),
+		"TIOCMBIC":                      reflect.ValueOf(syscall.TIOCMBIC),
+		"TIOCMBIS":                      reflect.ValueOf(syscall.TIOCMBIS),
+		"TIOCMGET":                      reflect.ValueOf(syscall.TIOCMGET),
+		"TIOCMSET":                      reflect.ValueOf(syscall.TIOCMSET),
+		"TIOCM_CAR":                     reflect.ValueOf(syscall.TIOCM_CAR),
+		"TIOCM_CD":                      reflect.ValueOf(syscall.TIOCM_CD),
+		"TIOCM_CTS":                     reflect.ValueOf(syscall.TIOCM_CTS),
+		"TIOCM_DSR":                     reflect.ValueOf(syscall.TIOCM_DSR),
+		"TIOCM_DTR":                     reflect.ValueOf(syscall.TIOCM_DTR),
+		"TIOCM_LE":                      reflect.ValueOf(syscall.TIOCM_LE),
+		"TIOCM_RI":                      reflect.ValueOf(syscall.TIOCM_RI),
+		"TIOCM_RNG":                     reflect.ValueOf(syscall.TIOCM_RNG),
+		"TIOCM_RTS":                     reflect.ValueOf(syscall.TIOCM_RTS),
+		"TIOCM_SR":                      reflect.ValueOf(syscall.TIOCM_SR),
+		"TIOCM_ST":                      reflect.ValueOf(syscall.TIOCM_ST),
+		"TIOCNOTTY":                     reflect.ValueOf(syscall.TIOCNOTTY),
+		"TIOCNXCL":                      reflect.ValueOf(syscall.TIOCNXCL),
+		"TIOCOUTQ":                      reflect.ValueOf(syscall.TIOCOUTQ),
+		"TIOCREMOTE":                    reflect.ValueOf(syscall.TIOCREMOTE),
+		"TIOCSBRK":                      reflect.ValueOf(syscall.TIOCSBRK),
+		"TIOCSCTTY":                     reflect.ValueOf(syscall.TIOCSCTTY),
+		"TIOCSDTR":                      reflect.ValueOf(syscall.TIOCSDTR),
+		"TIOCSETC":                      reflect.ValueOf(syscall.TIOCSETC),
+		"TIOCSETD":                      reflect.ValueOf(syscall.TIOCSETD),
+		"TIOCSETN":                      reflect.ValueOf(syscall.TIOCSETN),
+		"TIOCSETP":                      reflect.ValueOf(syscall.TIOCSETP),
+		"TIOCSIGNAL":                    reflect.ValueOf(syscall.TIOCSIGNAL),
+		"TIOCSILOOP":                    reflect.ValueOf(syscall.TIOCSILOOP),
+		"TIOCSLTC":                      reflect.ValueOf(syscall.TIOCSLTC),
+		"TIOCSPGRP":                     reflect.ValueOf(syscall.TIOCSPGRP),
+		"TIOCSPPS":                      reflect.ValueOf(syscall.TIOCSPPS),
+		"TIOCSSOFTCAR":                  reflect.ValueOf(syscall.TIOCSSOFTCAR),
+		"TIOCSTART":                     reflect.ValueOf(syscall.TIOCSTART),
+		"TIOCSTI":                       reflect.ValueOf(syscall.TIOCSTI),
+		"TIOCSTOP":                      reflect.ValueOf(syscall.TIOCSTOP),
+		"TIOCSWINSZ":                    reflect.ValueOf(syscall.TIOCSWINSZ),
+		"TOSTOP":                        reflect.ValueOf(syscall.TOSTOP),
+		"TimespecToNsec":                reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                 reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                      reflect.ValueOf(syscall.Truncate),
+		"Umask":                         reflect.ValueOf(syscall.Umask),
+		"UnixRights":                    reflect.ValueOf(syscall.UnixRights),
+		"Unlink":                        reflect.ValueOf(syscall.Unlink),
+		"Unsetenv":                      reflect.ValueOf(syscall.Unsetenv),
+		"Utimes":                        reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                    reflect.ValueOf(syscall.UtimesNano),
+		"VCEOF":                         reflect.ValueOf(syscall.VCEOF),
+		"VCEOL":                         reflect.ValueOf(syscall.VCEOL),
+		"VDISCARD":                      reflect.ValueOf(syscall.VDISCARD),
+		"VDSUSP":                        reflect.ValueOf(syscall.VDSUSP),
+		"VEOF":                          reflect.ValueOf(syscall.VEOF),
+		"VEOL":                          reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                         reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                        reflect.ValueOf(syscall.VERASE),
+		"VINTR":                         reflect.ValueOf(syscall.VINTR),
+		"VKILL":                         reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                        reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                          reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                         reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                      reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                        reflect.ValueOf(syscall.VSTART),
+		"VSTOP":                         reflect.ValueOf(syscall.VSTOP),
+		"VSUSP":                         reflect.ValueOf(syscall.VSUSP),
+		"VSWTCH":                        reflect.ValueOf(syscall.VSWTCH),
+		"VT0":                           reflect.ValueOf(syscall.VT0),
+		"VT1":                           reflect.ValueOf(syscall.VT1),
+		"VTDLY":                         reflect.ValueOf(syscall.VTDLY),
+		"VTIME":                         reflect.ValueOf(syscall.VTIME),
+		"VWERASE":                       reflect.ValueOf(syscall.VWERASE),
+		"WCONTFLG":                      reflect.ValueOf(syscall.WCONTFLG),
+		"WCONTINUED":                    reflect.ValueOf(syscall.WCONTINUED),
+		"WCOREFLG":                      reflect.ValueOf(syscall.WCOREFLG),
+		"WEXITED":                       reflect.ValueOf(syscall.WEXITED),
+		"WNOHANG":                       reflect.ValueOf(syscall.WNOHANG),
+		"WNOWAIT":                       reflect.ValueOf(syscall.WNOWAIT),
+		"WOPTMASK":                      reflect.ValueOf(syscall.WOPTMASK),
+		"WRAP":                          reflect.ValueOf(syscall.WRAP),
+		"WSIGMASK":                      reflect.ValueOf(syscall.WSIGMASK),
+		"WSTOPFLG":                      reflect.ValueOf(syscall.WSTOPFLG),
+		"WSTOPPED":                      reflect.ValueOf(syscall.WSTOPPED),
+		"WTRAPPED":                      reflect.ValueOf(syscall.WTRAPPED),
+		"WUNTRACED":                     reflect.ValueOf(syscall.WUNTRACED),
+		"Wait4":                         reflect.ValueOf(syscall.Wait4),
+		"Write":                         reflect.ValueOf(syscall.Write),
+
+		// type definitions
+		"BpfHdr":               reflect.ValueOf((*syscall.BpfHdr)(nil)),
+		"BpfInsn":              reflect.ValueOf((*syscall.BpfInsn)(nil)),
+		"BpfProgram":           reflect.ValueOf((*syscall.BpfProgram)(nil)),
+		"BpfStat":              reflect.ValueOf((*syscall.BpfStat)(nil)),
+		"BpfTimeval":           reflect.ValueOf((*syscall.BpfTimeval)(nil)),
+		"BpfVersion":           reflect.ValueOf((*syscall.BpfVersion)(nil)),
+		"Cmsghdr":              reflect.ValueOf((*syscall.Cmsghdr)(nil)),
+		"Conn":                 reflect.ValueOf((*syscall.Conn)(nil)),
+		"Credential":           reflect.ValueOf((*syscall.Credential)(nil)),
+		"Dirent":               reflect.ValueOf((*syscall.Dirent)(nil)),
+		"Errno":                reflect.ValueOf((*syscall.Errno)(nil)),
+		"FdSet":                reflect.ValueOf((*syscall.FdSet)(nil)),
+		"Flock_t":              reflect.ValueOf((*syscall.Flock_t)(nil)),
+		"ICMPv6Filter":         reflect.ValueOf((*syscall.ICMPv6Filter)(nil)),
+		"IPMreq":               reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPv6MTUInfo":          reflect.ValueOf((*syscall.IPv6MTUInfo)(nil)),
+		"IPv6Mreq":             reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"IfData":               reflect.ValueOf((*syscall.IfData)(nil)),
+		"IfMsghdr":             reflect.ValueOf((*syscall.IfMsghdr)(nil)),
+		"IfaMsghdr":            reflect.ValueOf((*syscall.IfaMsghdr)(nil)),
+		"Inet6Pktinfo":         reflect.ValueOf((*syscall.Inet6Pktinfo)(nil)),
+		"Iovec":                reflect.ValueOf((*syscall.Iovec)(nil)),
+		"Linger":               reflect.ValueOf((*syscall.Linger)(nil)),
+		"Msghdr":               reflect.ValueOf((*syscall.Msghdr)(nil)),
+		"ProcAttr":             reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"RawConn":              reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":          reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":       reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrDatalink":  reflect.ValueOf((*syscall.RawSockaddrDatalink)(nil)),
+		"RawSockaddrInet4":     reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":     reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":      reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rlimit":               reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"RtMetrics":            reflect.ValueOf((*syscall.RtMetrics)(nil)),
+		"RtMsghdr":             reflect.ValueOf((*syscall.RtMsghdr)(nil)),
+		"Rusage":               reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":               reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":             reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrDatalink":     reflect.ValueOf((*syscall.SockaddrDatalink)(nil)),
+		"SockaddrInet4":        reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":        reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":         reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"SocketControlMessage": reflect.ValueOf((*syscall.SocketControlMessage)(nil)),
+		"Stat_t":               reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"SysProcAttr":          reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Termios":              reflect.ValueOf((*syscall.Termios)(nil)),
+		"Timespec":             reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":              reflect.ValueOf((*syscall.Timeval)(nil)),
+		"Timeval32":            reflect.ValueOf((*syscall.Timeval32)(nil)),
+		"WaitStatus":           reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":     reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":  reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_Sockaddr": reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -116,6 +117,8 @@ func init() {
 		"Access":                           reflect.ValueOf(syscall.Access),
 		"Acct":                             reflect.ValueOf(syscall.Acct),
 		"Adjtimex":                         reflect.ValueOf(syscall.Adjtimex),
+		"AllThreadsSyscall":                reflect.ValueOf(syscall.AllThreadsSyscall),
+		"AllThreadsSyscall6":               reflect.ValueOf(syscall.AllThreadsSyscall6),
 		"AttachLsf":                        reflect.ValueOf(syscall.AttachLsf),
 		"B0":                               reflect.ValueOf(syscall.B0),
 		"B1000000":                         reflect.ValueOf(syscall.B1000000),
@@ -196,18 +199,23 @@ func init() {
 		"CLOCAL":                           reflect.ValueOf(syscall.CLOCAL),
 		"CLONE_CHILD_CLEARTID":             reflect.ValueOf(syscall.CLONE_CHILD_CLEARTID),
 		"CLONE_CHILD_SETTID":               reflect.ValueOf(syscall.CLONE_CHILD_SETTID),
+		"CLONE_CLEAR_SIGHAND":              reflect.ValueOf(int64(syscall.CLONE_CLEAR_SIGHAND)),
 		"CLONE_DETACHED":                   reflect.ValueOf(syscall.CLONE_DETACHED),
 		"CLONE_FILES":                      reflect.ValueOf(syscall.CLONE_FILES),
 		"CLONE_FS":                         reflect.ValueOf(syscall.CLONE_FS),
+		"CLONE_INTO_CGROUP":                reflect.ValueOf(int64(syscall.CLONE_INTO_CGROUP)),
 		"CLONE_IO":                         reflect.ValueOf(uint32(syscall.CLONE_IO)),
+		"CLONE_NEWCGROUP":                  reflect.ValueOf(syscall.CLONE_NEWCGROUP),
 		"CLONE_NEWIPC":                     reflect.ValueOf(syscall.CLONE_NEWIPC),
 		"CLONE_NEWNET":                     reflect.ValueOf(syscall.CLONE_NEWNET),
 		"CLONE_NEWNS":                      reflect.ValueOf(syscall.CLONE_NEWNS),
 		"CLONE_NEWPID":                     reflect.ValueOf(syscall.CLONE_NEWPID),
+		"CLONE_NEWTIME":                    reflect.ValueOf(syscall.CLONE_NEWTIME),
 		"CLONE_NEWUSER":                    reflect.ValueOf(syscall.CLONE_NEWUSER),
 		"CLONE_NEWUTS":                     reflect.ValueOf(syscall.CLONE_NEWUTS),
 		"CLONE_PARENT":                     reflect.ValueOf(syscall.CLONE_PARENT),
 		"CLONE_PARENT_SETTID":              reflect.ValueOf(syscall.CLONE_PARENT_SETTID),
+		"CLONE_PIDFD":                      reflect.ValueOf(syscall.CLONE_PIDFD),
 		"CLONE_PTRACE":                     reflect.ValueOf(syscall.CLONE_PTRACE),
 		"CLONE_SETTLS":                     reflect.ValueOf(syscall.CLONE_SETTLS),
 		"CLONE_SIGHAND":                    reflect.ValueOf(syscall.CLONE_SIGHAND),
@@ -1919,7 +1927,9 @@ func init() {
 		"SetLsfPromisc":                    reflect.ValueOf(syscall.SetLsfPromisc),
 		"SetNonblock":                      reflect.ValueOf(syscall.SetNonblock),
 		"Setdomainname":                    reflect.ValueOf(syscall.Setdomainname),
+		"Setegid":                          reflect.ValueOf(syscall.Setegid),
 		"Setenv":                           reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                          reflect.ValueOf(syscall.Seteuid),
 		"Setfsgid":                         reflect.ValueOf(syscall.Setfsgid),
 		"Setfsuid":                         reflect.ValueOf(syscall.Setfsuid),
 		"Setgid":                           reflect.ValueOf(syscall.Setgid),
@@ -2253,4 +2263,5 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -0,0 +1,2431 @@
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_ALG":                           reflect.ValueOf(syscall.AF_ALG),
+		"AF_APPLETALK":                     reflect.ValueOf(syscall.AF_APPLETALK),
+		"AF_ASH":                           reflect.ValueOf(syscall.AF_ASH),
+		"AF_ATMPVC":                        reflect.ValueOf(syscall.AF_ATMPVC),
+		"AF_ATMSVC":                        reflect.ValueOf(syscall.AF_ATMSVC),
+		"AF_AX25":                          reflect.ValueOf(syscall.AF_AX25),
+		"AF_BLUETOOTH":                     reflect.ValueOf(syscall.AF_BLUETOOTH),
+		"AF_BRIDGE":                        reflect.ValueOf(syscall.AF_BRIDGE),
+		"AF_CAIF":                          reflect.ValueOf(syscall.AF_CAIF),
+		"AF_CAN":                           reflect.ValueOf(syscall.AF_CAN),
+		"AF_DECnet":                        reflect.ValueOf(syscall.AF_DECnet),
+		"AF_ECONET":                        reflect.ValueOf(syscall.AF_ECONET),
+		"AF_FILE":                          reflect.ValueOf(syscall.AF_FILE),
+		"AF_IB":                            reflect.ValueOf(syscall.AF_IB),
+		"AF_IEEE802154":                    reflect.ValueOf(syscall.AF_IEEE802154),
+		"AF_INET":                          reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                         reflect.ValueOf(syscall.AF_INET6),
+		"AF_IPX":                           reflect.ValueOf(syscall.AF_IPX),
+		"AF_IRDA":                          reflect.ValueOf(syscall.AF_IRDA),
+		"AF_ISDN":                          reflect.ValueOf(syscall.AF_ISDN),
+		"AF_IUCV":                          reflect.ValueOf(syscall.AF_IUCV),
+		"AF_KCM":                           reflect.ValueOf(syscall.AF_KCM),
+		"AF_KEY":                           reflect.ValueOf(syscall.AF_KEY),
+		"AF_LLC":                           reflect.ValueOf(syscall.AF_LLC),
+		"AF_LOCAL":                         reflect.ValueOf(syscall.AF_LOCAL),
+		"AF_MAX":                           reflect.ValueOf(syscall.AF_MAX),
+		"AF_MPLS":                          reflect.ValueOf(syscall.AF_MPLS),
+		"AF_NETBEUI":                       reflect.ValueOf(syscall.AF_NETBEUI),
+		"AF_NETLINK":                       reflect.ValueOf(syscall.AF_NETLINK),
+		"AF_NETROM":                        reflect.ValueOf(syscall.AF_NETROM),
+		"AF_NFC":                           reflect.ValueOf(syscall.AF_NFC),
+		"AF_PACKET":                        reflect.ValueOf(syscall.AF_PACKET),
+		"AF_PHONET":                        reflect.ValueOf(syscall.AF_PHONET),
+		"AF_PPPOX":                         reflect.ValueOf(syscall.AF_PPPOX),
+		"AF_RDS":                           reflect.ValueOf(syscall.AF_RDS),
+		"AF_ROSE":                          reflect.ValueOf(syscall.AF_ROSE),
+		"AF_ROUTE":                         reflect.ValueOf(syscall.AF_ROUTE),
+		"AF_RXRPC":                         reflect.ValueOf(syscall.AF_RXRPC),
+		"AF_SECURITY":                      reflect.ValueOf(syscall.AF_SECURITY),
+		"AF_SNA":                           reflect.ValueOf(syscall.AF_SNA),
+		"AF_TIPC":                          reflect.ValueOf(syscall.AF_TIPC),
+		"AF_UNIX":                          reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                        reflect.ValueOf(syscall.AF_UNSPEC),
+		"AF_VSOCK":                         reflect.ValueOf(syscall.AF_VSOCK),
+		"AF_WANPIPE":                       reflect.ValueOf(syscall.AF_WANPIPE),
+		"AF_X25":                           reflect.ValueOf(syscall.AF_X25),
+		"ARPHRD_6LOWPAN":                   reflect.ValueOf(syscall.ARPHRD_6LOWPAN),
+		"ARPHRD_ADAPT":                     reflect.ValueOf(syscall.ARPHRD_ADAPT),
+		"ARPHRD_APPLETLK":                  reflect.ValueOf(syscall.ARPHRD_APPLETLK),
+		"ARPHRD_ARCNET":                    reflect.ValueOf(syscall.ARPHRD_ARCNET),
+		"ARPHRD_ASH":                       reflect.ValueOf(syscall.ARPHRD_ASH),
+		"ARPHRD_ATM":                       reflect.ValueOf(syscall.ARPHRD_ATM),
+		"ARPHRD_AX25":                      reflect.ValueOf(syscall.ARPHRD_AX25),
+		"ARPHRD_BIF":                       reflect.ValueOf(syscall.ARPHRD_BIF),
+		"ARPHRD_CAIF":                      reflect.ValueOf(syscall.ARPHRD_CAIF),
+		"ARPHRD_CAN":                       reflect.ValueOf(syscall.ARPHRD_CAN),
+		"ARPHRD_CHAOS":                     reflect.ValueOf(syscall.ARPHRD_CHAOS),
+		"ARPHRD_CISCO":                     reflect.ValueOf(syscall.ARPHRD_CISCO),
+		"ARPHRD_CSLIP":                     reflect.ValueOf(syscall.ARPHRD_CSLIP),
+		"ARPHRD_CSLIP6":                    reflect.ValueOf(syscall.ARPHRD_CSLIP6),
+		"ARPHRD_DDCMP":                     reflect.ValueOf(syscall.ARPHRD_DDCMP),
+		"ARPHRD_DLCI":                      reflect.ValueOf(syscall.ARPHRD_DLCI),
+		"ARPHRD_ECONET":                    reflect.ValueOf(syscall.ARPHRD_ECONET),
+		"ARPHRD_EETHER":                    reflect.ValueOf(syscall.ARPHRD_EETHER),
+		"ARPHRD_ETHER":                     reflect.ValueOf(syscall.ARPHRD_ETHER),
+		"ARPHRD_EUI64":                     reflect.ValueOf(syscall.ARPHRD_EUI64),
+		"ARPHRD_FCAL":                      reflect.ValueOf(syscall.ARPHRD_FCAL),
+		"ARPHRD_FCFABRIC":                  reflect.ValueOf(syscall.ARPHRD_FCFABRIC),
+		"ARPHRD_FCPL":                      reflect.ValueOf(syscall.ARPHRD_FCPL),
+		"ARPHRD_FCPP":                      reflect.ValueOf(syscall.ARPHRD_FCPP),
+		"ARPHRD_FDDI":                      reflect.ValueOf(syscall.ARPHRD_FDDI),
+		"ARPHRD_FRAD":                      reflect.ValueOf(syscall.ARPHRD_FRAD),
+		"ARPHRD_HDLC":                      reflect.ValueOf(syscall.ARPHRD_HDLC),
+		"ARPHRD_HIPPI":                     reflect.ValueOf(syscall.ARPHRD_HIPPI),
+		"ARPHRD_HWX25":                     reflect.ValueOf(syscall.ARPHRD_HWX25),
+		"ARPHRD_IEEE1394":                  reflect.ValueOf(syscall.ARPHRD_IEEE1394),
+		"ARPHRD_IEEE802":                   reflect.ValueOf(syscall.ARPHRD_IEEE802),
+		"ARPHRD_IEEE80211":                 reflect.ValueOf(syscall.ARPHRD_IEEE80211),
+		"ARPHRD_IEEE80211_PRISM":           reflect.ValueOf(syscall.ARPHRD_IEEE80211_PRISM),
+		"ARPHRD_IEEE80211_RADIOTAP":        reflect.ValueOf(syscall.ARPHRD_IEEE80211_RADIOTAP),
+		"ARPHRD_IEEE802154":                reflect.ValueOf(syscall.ARPHRD_IEEE802154),
+		"ARPHRD_IEEE802154_MONITOR":        reflect.ValueOf(syscall.ARPHRD_IEEE802154_MONITOR),
+		"ARPHRD_IEEE802_TR":                reflect.ValueOf(syscall.ARPHRD_IEEE802_TR),
+		"ARPHRD_INFINIBAND":                reflect.ValueOf(syscall.ARPHRD_INFINIBAND),
+		"ARPHRD_IP6GRE":                    reflect.ValueOf(syscall.ARPHRD_IP6GRE),
+		"ARPHRD_IPDDP":                     reflect.ValueOf(syscall.ARPHRD_IPDDP),
+		"ARPHRD_IPGRE":                     reflect.ValueOf(syscall.ARPHRD_IPGRE),
+		"ARPHRD_IRDA":                      reflect.ValueOf(syscall.ARPHRD_IRDA),
+		"ARPHRD_LAPB":                      reflect.ValueOf(syscall.ARPHRD_LAPB),
+		"ARPHRD_LOCALTLK":                  reflect.ValueOf(syscall.ARPHRD_LOCALTLK),
+		"ARPHRD_LOOPBACK":                  reflect.ValueOf(syscall.ARPHRD_LOOPBACK),
+		"ARPHRD_METRICOM":                  reflect.ValueOf(syscall.ARPHRD_METRICOM),
+		"ARPHRD_NETLINK":                   reflect.ValueOf(syscall.ARPHRD_NETLINK),
+		"ARPHRD_NETROM":                    reflect.ValueOf(syscall.ARPHRD_NETROM),
+		"ARPHRD_NONE":                      reflect.ValueOf(syscall.ARPHRD_NONE),
+		"ARPHRD_PHONET":                    reflect.ValueOf(syscall.ARPHRD_PHONET),
+		"ARPHRD_PHONET_PIPE":               reflect.ValueOf(syscall.ARPHRD_PHONET_PIPE),
+		"ARPHRD_PIMREG":                    reflect.ValueOf(syscall.ARPHRD_PIMREG),
+		"ARPHRD_PPP":                       reflect.ValueOf(syscall.ARPHRD_PPP),
+		"ARPHRD_PRONET":                    reflect.ValueOf(syscall.ARPHRD_PRONET),
+		"ARPHRD_RAWHDLC":                   reflect.ValueOf(syscall.ARPHRD_RAWHDLC),
+		"ARPHRD_ROSE":                      reflect.ValueOf(syscall.ARPHRD_ROSE),
+		"ARPHRD_RSRVD":                     reflect.ValueOf(syscall.ARPHRD_RSRVD),
+		"ARPHRD_SIT":                       reflect.ValueOf(syscall.ARPHRD_SIT),
+		"ARPHRD_SKIP":                      reflect.ValueOf(syscall.ARPHRD_SKIP),
+		"ARPHRD_SLIP":                      reflect.ValueOf(syscall.ARPHRD_SLIP),
+		"ARPHRD_SLIP6":                     reflect.ValueOf(syscall.ARPHRD_SLIP6),
+		"ARPHRD_TUNNEL":                    reflect.ValueOf(syscall.ARPHRD_TUNNEL),
+		"ARPHRD_TUNNEL6":                   reflect.ValueOf(syscall.ARPHRD_TUNNEL6),
+		"ARPHRD_VOID":                      reflect.ValueOf(syscall.ARPHRD_VOID),
+		"ARPHRD_X25":                       reflect.ValueOf(syscall.ARPHRD_X25),
+		"Accept":                           reflect.ValueOf(syscall.Accept),
+		"Accept4":                          reflect.ValueOf(syscall.Accept4),
+		"Access":                           reflect.ValueOf(syscall.Access),
+		"Acct":                             reflect.ValueOf(syscall.Acct),
+		"Adjtimex":                         reflect.ValueOf(syscall.Adjtimex),
+		"AllThreadsSyscall":                reflect.ValueOf(syscall.AllThreadsSyscall),
+		"AllThreadsSyscall6":               reflect.ValueOf(syscall.AllThreadsSyscall6),
+		"AttachLsf":                        reflect.ValueOf(syscall.AttachLsf),
+		"B0":                               reflect.ValueOf(syscall.B0),
+		"B1000000":                         reflect.ValueOf(syscall.B1000000),
+		"B110":                             reflect.ValueOf(syscall.B110),
+		"B115200":                          reflect.ValueOf(syscall.B115200),
+		"B1152000":                         reflect.ValueOf(syscall.B1152000),
+		"B1200":                            reflect.ValueOf(syscall.B1200),
+		"B134":                             reflect.ValueOf(syscall.B134),
+		"B150":                             reflect.ValueOf(syscall.B150),
+		"B1500000":                         reflect.ValueOf(syscall.B1500000),
+		"B1800":                            reflect.ValueOf(syscall.B1800),
+		"B19200":                           reflect.ValueOf(syscall.B19200),
+		"B200":                             reflect.ValueOf(syscall.B200),
+		"B2000000":                         reflect.ValueOf(syscall.B2000000),
+		"B230400":                          reflect.ValueOf(syscall.B230400),
+		"B2400":                            reflect.ValueOf(syscall.B2400),
+		"B2500000":                         reflect.ValueOf(syscall.B2500000),
+		"B300":                             reflect.ValueOf(syscall.B300),
+		"B3000000":                         reflect.ValueOf(syscall.B3000000),
+		"B3500000":                         reflect.ValueOf(syscall.B3500000),
+		"B38400":                           reflect.ValueOf(syscall.B38400),
+		"B4000000":                         reflect.ValueOf(syscall.B4000000),
+		"B460800":                          reflect.ValueOf(syscall.B460800),
+		"B4800":                            reflect.ValueOf(syscall.B4800),
+		"B50":                              reflect.ValueOf(syscall.B50),
+		"B500000":                          reflect.ValueOf(syscall.B500000),
+		"B57600":                           reflect.ValueOf(syscall.B57600),
+		"B576000":                          reflect.ValueOf(syscall.B576000),
+		"B600":                             reflect.ValueOf(syscall.B600),
+		"B75":                              reflect.ValueOf(syscall.B75),
+		"B921600":                          reflect.ValueOf(syscall.B921600),
+		"B9600":                            reflect.ValueOf(syscall.B9600),
+		"BPF_A":                            reflect.ValueOf(syscall.BPF_A),
+		"BPF_ABS":                          reflect.ValueOf(syscall.BPF_ABS),
+		"BPF_ADD":                          reflect.ValueOf(syscall.BPF_ADD),
+		"BPF_ALU":                          reflect.ValueOf(syscall.BPF_ALU),
+		"BPF_AND":                          reflect.ValueOf(syscall.BPF_AND),
+		"BPF_B":                            reflect.ValueOf(syscall.BPF_B),
+		"BPF_DIV":                          reflect.ValueOf(syscall.BPF_DIV),
+		"BPF_H":                            reflect.ValueOf(syscall.BPF_H),
+		"BPF_IMM":                          reflect.ValueOf(syscall.BPF_IMM),
+		"BPF_IND":                          reflect.ValueOf(syscall.BPF_IND),
+		"BPF_JA":                           reflect.ValueOf(syscall.BPF_JA),
+		"BPF_JEQ":                          reflect.ValueOf(syscall.BPF_JEQ),
+		"BPF_JGE":                          reflect.ValueOf(syscall.BPF_JGE),
+		"BPF_JGT":                          reflect.ValueOf(syscall.BPF_JGT),
+		"BPF_JMP":                          reflect.ValueOf(syscall.BPF_JMP),
+		"BPF_JSET":                         reflect.ValueOf(syscall.BPF_JSET),
+		"BPF_K":                            reflect.ValueOf(syscall.BPF_K),
+		"BPF_LD":                           reflect.ValueOf(syscall.BPF_LD),
+		"BPF_LDX":                          reflect.ValueOf(syscall.BPF_LDX),
+		"BPF_LEN":                          reflect.ValueOf(syscall.BPF_LEN),
+		"BPF_LL_OFF":                       reflect.ValueOf(syscall.BPF_LL_OFF),
+		"BPF_LSH":                          reflect.ValueOf(syscall.BPF_LSH),
+		"BPF_MAJOR_VERSION":                reflect.ValueOf(syscall.BPF_MAJOR_VERSION),
+		"BPF_MAXINSNS":                     reflect.ValueOf(syscall.BPF_MAXINSNS),
+		"BPF_MEM":                          reflect.ValueOf(syscall.BPF_MEM),
+		"BPF_MEMWORDS":                     reflect.ValueOf(syscall.BPF_MEMWORDS),
+		"BPF_MINOR_VERSION":                reflect.ValueOf(syscall.BPF_MINOR_VERSION),
+		"BPF_MISC":                         reflect.ValueOf(syscall.BPF_MISC),
+		"BPF_MOD":                          reflect.ValueOf(syscall.BPF_MOD),
+		"BPF_MSH":                          reflect.ValueOf(syscall.BPF_MSH),
+		"BPF_MUL":                          reflect.ValueOf(syscall.BPF_MUL),
+		"BPF_NEG":                          reflect.ValueOf(syscall.BPF_NEG),
+		"BPF_NET_OFF":                      reflect.ValueOf(syscall.BPF_NET_OFF),
+		"BPF_OR":                           reflect.ValueOf(syscall.BPF_OR),
+		"BPF_RET":                          reflect.ValueOf(syscall.BPF_RET),
+		"BPF_RSH":                          reflect.ValueOf(syscall.BPF_RSH),
+		"BPF_ST":                           reflect.ValueOf(syscall.BPF_ST),
+		"BPF_STX":                          reflect.ValueOf(syscall.BPF_STX),
+		"BPF_SUB":                          reflect.ValueOf(syscall.BPF_SUB),
+		"BPF_TAX":                          reflect.ValueOf(syscall.BPF_TAX),
+		"BPF_TXA":                          reflect.ValueOf(syscall.BPF_TXA),
+		"BPF_W":                            reflect.ValueOf(syscall.BPF_W),
+		"BPF_X":                            reflect.ValueOf(syscall.BPF_X),
+		"BPF_XOR":                          reflect.ValueOf(syscall.BPF_XOR),
+		"BRKINT":                           reflect.ValueOf(syscall.BRKINT),
+		"Bind":                             reflect.ValueOf(syscall.Bind),
+		"BindToDevice":                     reflect.ValueOf(syscall.BindToDevice),
+		"BytePtrFromString":                reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":              reflect.ValueOf(syscall.ByteSliceFromString),
+		"CFLUSH":                           reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                           reflect.ValueOf(syscall.CLOCAL),
+		"CLONE_CHILD_CLEARTID":             reflect.ValueOf(syscall.CLONE_CHILD_CLEARTID),
+		"CLONE_CHILD_SETTID":               reflect.ValueOf(syscall.CLONE_CHILD_SETTID),
+		"CLONE_CLEAR_SIGHAND":              reflect.ValueOf(int64(syscall.CLONE_CLEAR_SIGHAND)),
+		"CLONE_DETACHED":                   reflect.ValueOf(syscall.CLONE_DETACHED),
+		"CLONE_FILES":                      reflect.ValueOf(syscall.CLONE_FILES),
+		"CLONE_FS":                         reflect.ValueOf(syscall.CLONE_FS),
+		"CLONE_INTO_CGROUP":                reflect.ValueOf(int64(syscall.CLONE_INTO_CGROUP)),
+		"CLONE_IO":                         reflect.ValueOf(uint32(syscall.CLONE_IO)),
+		"CLONE_NEWCGROUP":                  reflect.ValueOf(syscall.CLONE_NEWCGROUP),
+		"CLONE_NEWIPC":                     reflect.ValueOf(syscall.CLONE_NEWIPC),
+		"CLONE_NEWNET":                     reflect.ValueOf(syscall.CLONE_NEWNET),
+		"CLONE_NEWNS":                      reflect.ValueOf(syscall.CLONE_NEWNS),
+		"CLONE_NEWPID":                     reflect.ValueOf(syscall.CLONE_NEWPID),
+		"CLONE_NEWTIME":                    reflect.ValueOf(syscall.CLONE_NEWTIME),
+		"CLONE_NEWUSER":                    reflect.ValueOf(syscall.CLONE_NEWUSER),
+		"CLONE_NEWUTS":                     reflect.ValueOf(syscall.CLONE_NEWUTS),
+		"CLONE_PARENT":                     reflect.ValueOf(syscall.CLONE_PARENT),
+		"CLONE_PARENT_SETTID":              reflect.ValueOf(syscall.CLONE_PARENT_SETTID),
+		"CLONE_PIDFD":                      reflect.ValueOf(syscall.CLONE_PIDFD),
+		"CLONE_PTRACE":                     reflect.ValueOf(syscall.CLONE_PTRACE),
+		"CLONE_SETTLS":                     reflect.ValueOf(syscall.CLONE_SETTLS),
+		"CLONE_SIGHAND":                    reflect.ValueOf(syscall.CLONE_SIGHAND),
+		"CLONE_SYSVSEM":                    reflect.ValueOf(syscall.CLONE_SYSVSEM),
+		"CLONE_THREAD":                     reflect.ValueOf(syscall.CLONE_THREAD),
+		"CLONE_UNTRACED":                   reflect.ValueOf(syscall.CLONE_UNTRACED),
+		"CLONE_VFORK":                      reflect.ValueOf(syscall.CLONE_VFORK),
+		"CLONE_VM":                         reflect.ValueOf(syscall.CLONE_VM),
+		"CREAD":                            reflect.ValueOf(syscall.CREAD),
+		"CS5":                              reflect.ValueOf(syscall.CS5),
+		"CS6":                              reflect.ValueOf(syscall.CS6),
+		"CS7":                              reflect.ValueOf(syscall.CS7),
+		"CS8":                              reflect.ValueOf(syscall.CS8),
+		"CSIGNAL":                          reflect.ValueOf(syscall.CSIGNAL),
+		"CSIZE":                            reflect.ValueOf(syscall.CSIZE),
+		"CSTART":                           reflect.ValueOf(syscall.CSTART),
+		"CSTATUS":                          reflect.ValueOf(syscall.CSTATUS),
+		"CSTOP":                            reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                           reflect.ValueOf(syscall.CSTOPB),
+		"CSUSP":                            reflect.ValueOf(syscall.CSUSP),
+		"Chdir":                            reflect.ValueOf(syscall.Chdir),
+		"Chmod":                            reflect.ValueOf(syscall.Chmod),
+		"Chown":                            reflect.ValueOf(syscall.Chown),
+		"Chroot":                           reflect.ValueOf(syscall.Chroot),
+		"Clearenv":                         reflect.ValueOf(syscall.Clearenv),
+		"Close":                            reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                      reflect.ValueOf(syscall.CloseOnExec),
+		"CmsgLen":                          reflect.ValueOf(syscall.CmsgLen),
+		"CmsgSpace":                        reflect.ValueOf(syscall.CmsgSpace),
+		"Connect":                          reflect.ValueOf(syscall.Connect),
+		"Creat":                            reflect.ValueOf(syscall.Creat),
+		"DT_BLK":                           reflect.ValueOf(syscall.DT_BLK),
+		"DT_CHR":                           reflect.ValueOf(syscall.DT_CHR),
+		"DT_DIR":                           reflect.ValueOf(syscall.DT_DIR),
+		"DT_FIFO":                          reflect.ValueOf(syscall.DT_FIFO),
+		"DT_LNK":                           reflect.ValueOf(syscall.DT_LNK),
+		"DT_REG":                           reflect.ValueOf(syscall.DT_REG),
+		"DT_SOCK":                          reflect.ValueOf(syscall.DT_SOCK),
+		"DT_UNKNOWN":                       reflect.ValueOf(syscall.DT_UNKNOWN),
+		"DT_WHT":                           reflect.ValueOf(syscall.DT_WHT),
+		"DetachLsf":                        reflect.ValueOf(syscall.DetachLsf),
+		"Dup":                              reflect.ValueOf(syscall.Dup),
+		"Dup3":                             reflect.ValueOf(syscall.Dup3),
+		"E2BIG":                            reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                           reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                       reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                    reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EADV":                             reflect.ValueOf(syscall.EADV),
+		"EAFNOSUPPORT":                     reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                           reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                         reflect.ValueOf(syscall.EALREADY),
+		"EBADE":                            reflect.ValueOf(syscall.EBADE),
+		"EBADF":                            reflect.ValueOf(syscall.EBADF),
+		"EBADFD":                           reflect.ValueOf(syscall.EBADFD),
+		"EBADMSG":                          reflect.ValueOf(syscall.EBADMSG),
+		"EBADR":                            reflect.ValueOf(syscall.EBADR),
+		"EBADRQC":                          reflect.ValueOf(syscall.EBADRQC),
+		"EBADSLT":                          reflect.ValueOf(syscall.EBADSLT),
+		"EBFONT":                           reflect.ValueOf(syscall.EBFONT),
+		"EBUSY":                            reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                        reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                           reflect.ValueOf(syscall.ECHILD),
+		"ECHO":                             reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                          reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                            reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                            reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                           reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                           reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                          reflect.ValueOf(syscall.ECHOPRT),
+		"ECHRNG":                           reflect.ValueOf(syscall.ECHRNG),
+		"ECOMM":                            reflect.ValueOf(syscall.ECOMM),
+		"ECONNABORTED":                     reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                     reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                       reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                          reflect.ValueOf(syscall.EDEADLK),
+		"EDEADLOCK":                        reflect.ValueOf(syscall.EDEADLOCK),
+		"EDESTADDRREQ":                     reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDOM":                             reflect.ValueOf(syscall.EDOM),
+		"EDOTDOT":                          reflect.ValueOf(syscall.EDOTDOT),
+		"EDQUOT":                           reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                           reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                           reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                            reflect.ValueOf(syscall.EFBIG),
+		"EHOSTDOWN":                        reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                     reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EHWPOISON":                        reflect.ValueOf(syscall.EHWPOISON),
+		"EIDRM":                            reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                           reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                      reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                            reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                           reflect.ValueOf(syscall.EINVAL),
+		"EIO":                              reflect.ValueOf(syscall.EIO),
+		"EISCONN":                          reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                           reflect.ValueOf(syscall.EISDIR),
+		"EISNAM":                           reflect.ValueOf(syscall.EISNAM),
+		"EKEYEXPIRED":                      reflect.ValueOf(syscall.EKEYEXPIRED),
+		"EKEYREJECTED":                     reflect.ValueOf(syscall.EKEYREJECTED),
+		"EKEYREVOKED":                      reflect.ValueOf(syscall.EKEYREVOKED),
+		"EL2HLT":                           reflect.ValueOf(syscall.EL2HLT),
+		"EL2NSYNC":                         reflect.ValueOf(syscall.EL2NSYNC),
+		"EL3HLT":                           reflect.ValueOf(syscall.EL3HLT),
+		"EL3RST":                           reflect.ValueOf(syscall.EL3RST),
+		"ELIBACC":                          reflect.ValueOf(syscall.ELIBACC),
+		"ELIBBAD":                          reflect.ValueOf(syscall.ELIBBAD),
+		"ELIBEXEC":                         reflect.ValueOf(syscall.ELIBEXEC),
+		"ELIBMAX":                          reflect.ValueOf(syscall.ELIBMAX),
+		"ELIBSCN":                          reflect.ValueOf(syscall.ELIBSCN),
+		"ELNRNG":                           reflect.ValueOf(syscall.ELNRNG),
+		"ELOOP":                            reflect.ValueOf(syscall.ELOOP),
+		"EMEDIUMTYPE":                      reflect.ValueOf(syscall.EMEDIUMTYPE),
+		"EMFILE":                           reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                           reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                         reflect.ValueOf(syscall.EMSGSIZE),
+		"EMULTIHOP":                        reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                     reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENAVAIL":                          reflect.ValueOf(syscall.ENAVAIL),
+		"ENCODING_DEFAULT":                 reflect.ValueOf(syscall.ENCODING_DEFAULT),
+		"ENCODING_FM_MARK":                 reflect.ValueOf(syscall.ENCODING_FM_MARK),
+		"ENCODING_FM_SPACE":                reflect.ValueOf(syscall.ENCODING_FM_SPACE),
+		"ENCODING_MANCHESTER":              reflect.ValueOf(syscall.ENCODING_MANCHESTER),
+		"ENCODING_NRZ":                     reflect.ValueOf(syscall.ENCODING_NRZ),
+		"ENCODING_NRZI":                    reflect.ValueOf(syscall.ENCODING_NRZI),
+		"ENETDOWN":                         reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                        reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                      reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                           reflect.ValueOf(syscall.ENFILE),
+		"ENOANO":                           reflect.ValueOf(syscall.ENOANO),
+		"ENOBUFS":                          reflect.ValueOf(syscall.ENOBUFS),
+		"ENOCSI":                           reflect.ValueOf(syscall.ENOCSI),
+		"ENODATA":                          reflect.ValueOf(syscall.ENODATA),
+		"ENODEV":                           reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                           reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                          reflect.ValueOf(syscall.ENOEXEC),
+		"ENOKEY":                           reflect.ValueOf(syscall.ENOKEY),
+		"ENOLCK":                           reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                          reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEDIUM":                        reflect.ValueOf(syscall.ENOMEDIUM),
+		"ENOMEM":                           reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                           reflect.ValueOf(syscall.ENOMSG),
+		"ENONET":                           reflect.ValueOf(syscall.ENONET),
+		"ENOPKG":                           reflect.ValueOf(syscall.ENOPKG),
+		"ENOPROTOOPT":                      reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                           reflect.ValueOf(syscall.ENOSPC),
+		"ENOSR":                            reflect.ValueOf(syscall.ENOSR),
+		"ENOSTR":                           reflect.ValueOf(syscall.ENOSTR),
+		"ENOSYS":                           reflect.ValueOf(syscall.ENOSYS),
+		"ENOTBLK":                          reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCONN":                         reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                          reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                        reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTNAM":                          reflect.ValueOf(syscall.ENOTNAM),
+		"ENOTRECOVERABLE":                  reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                         reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                          reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                           reflect.ValueOf(syscall.ENOTTY),
+		"ENOTUNIQ":                         reflect.ValueOf(syscall.ENOTUNIQ),
+		"ENXIO":                            reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                       reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                        reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                       reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                            reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                     reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                            reflect.ValueOf(syscall.EPIPE),
+		"EPOLLERR":                         reflect.ValueOf(syscall.EPOLLERR),
+		"EPOLLET":                          reflect.ValueOf(uint32(syscall.EPOLLET)),
+		"EPOLLEXCLUSIVE":                   reflect.ValueOf(syscall.EPOLLEXCLUSIVE),
+		"EPOLLHUP":                         reflect.ValueOf(syscall.EPOLLHUP),
+		"EPOLLIN":                          reflect.ValueOf(syscall.EPOLLIN),
+		"EPOLLMSG":                         reflect.ValueOf(syscall.EPOLLMSG),
+		"EPOLLONESHOT":                     reflect.ValueOf(syscall.EPOLLONESHOT),
+		"EPOLLOUT":                         reflect.ValueOf(syscall.EPOLLOUT),
+		"EPOLLPRI":                         reflect.ValueOf(syscall.EPOLLPRI),
+		"EPOLLRDBAND":                      reflect.ValueOf(syscall.EPOLLRDBAND),
+		"EPOLLRDHUP":                       reflect.ValueOf(syscall.EPOLLRDHUP),
+		"EPOLLRDNORM":                      reflect.ValueOf(syscall.EPOLLRDNORM),
+		"EPOLLWAKEUP":                      reflect.ValueOf(syscall.EPOLLWAKEUP),
+		"EPOLLWRBAND":                      reflect.ValueOf(syscall.EPOLLWRBAND),
+		"EPOLLWRNORM":                      reflect.ValueOf(syscall.EPOLLWRNORM),
+		"EPOLL_CLOEXEC":                    reflect.ValueOf(syscall.EPOLL_CLOEXEC),
+		"EPOLL_CTL_ADD":                    reflect.ValueOf(syscall.EPOLL_CTL_ADD),
+		"EPOLL_CTL_DEL":                    reflect.ValueOf(syscall.EPOLL_CTL_DEL),
+		"EPOLL_CTL_MOD":                    reflect.ValueOf(syscall.EPOLL_CTL_MOD),
+		"EPROTO":                           reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":                  reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                       reflect.ValueOf(syscall.EPROTOTYPE),
+		"ERANGE":                           reflect.ValueOf(syscall.ERANGE),
+		"EREMCHG":                          reflect.ValueOf(syscall.EREMCHG),
+		"EREMOTE":                          reflect.ValueOf(syscall.EREMOTE),
+		"EREMOTEIO":                        reflect.ValueOf(syscall.EREMOTEIO),
+		"ERESTART":                         reflect.ValueOf(syscall.ERESTART),
+		"ERFKILL":                          reflect.ValueOf(syscall.ERFKILL),
+		"EROFS":                            reflect.ValueOf(syscall.EROFS),
+		"ESHUTDOWN":                        reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":                  reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESPIPE":                           reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                            reflect.ValueOf(syscall.ESRCH),
+		"ESRMNT":                           reflect.ValueOf(syscall.ESRMNT),
+		"ESTALE":                           reflect.ValueOf(syscall.ESTALE),
+		"ESTRPIPE":                         reflect.ValueOf(syscall.ESTRPIPE),
+		"ETH_P_1588":                       reflect.ValueOf(syscall.ETH_P_1588),
+		"ETH_P_8021AD":                     reflect.ValueOf(syscall.ETH_P_8021AD),
+		"ETH_P_8021AH":                     reflect.ValueOf(syscall.ETH_P_8021AH),
+		"ETH_P_8021Q":                      reflect.ValueOf(syscall.ETH_P_8021Q),
+		"ETH_P_80221":                      reflect.ValueOf(syscall.ETH_P_80221),
+		"ETH_P_802_2":                      reflect.ValueOf(syscall.ETH_P_802_2),
+		"ETH_P_802_3":                      reflect.ValueOf(syscall.ETH_P_802_3),
+		"ETH_P_802_3_MIN":                  reflect.ValueOf(syscall.ETH_P_802_3_MIN),
+		"ETH_P_802_EX1":                    reflect.ValueOf(syscall.ETH_P_802_EX1),
+		"ETH_P_AARP":                       reflect.ValueOf(syscall.ETH_P_AARP),
+		"ETH_P_AF_IUCV":                    reflect.ValueOf(syscall.ETH_P_AF_IUCV),
+		"ETH_P_ALL":                        reflect.ValueOf(syscall.ETH_P_ALL),
+		"ETH_P_AOE":                        reflect.ValueOf(syscall.ETH_P_AOE),
+		"ETH_P_ARCNET":                     reflect.ValueOf(syscall.ETH_P_ARCNET),
+		"ETH_P_ARP":                        reflect.ValueOf(syscall.ETH_P_ARP),
+		"ETH_P_ATALK":                      reflect.ValueOf(syscall.ETH_P_ATALK),
+		"ETH_P_ATMFATE":                    reflect.ValueOf(syscall.ETH_P_ATMFATE),
+		"ETH_P_ATMMPOA":                    reflect.ValueOf(syscall.ETH_P_ATMMPOA),
+		"ETH_P_AX25":                       reflect.ValueOf(syscall.ETH_P_AX25),
+		"ETH_P_BATMAN":                     reflect.ValueOf(syscall.ETH_P_BATMAN),
+		"ETH_P_BPQ":                        reflect.ValueOf(syscall.ETH_P_BPQ),
+		"ETH_P_CAIF":                       reflect.ValueOf(syscall.ETH_P_CAIF),
+		"ETH_P_CAN":                        reflect.ValueOf(syscall.ETH_P_CAN),
+		"ETH_P_CANFD":                      reflect.ValueOf(syscall.ETH_P_CANFD),
+		"ETH_P_CONTROL":                    reflect.ValueOf(syscall.ETH_P_CONTROL),
+		"ETH_P_CUST":                       reflect.ValueOf(syscall.ETH_P_CUST),
+		"ETH_P_DDCMP":                      reflect.ValueOf(syscall.ETH_P_DDCMP),
+		"ETH_P_DEC":                        reflect.ValueOf(syscall.ETH_P_DEC),
+		"ETH_P_DIAG":                       reflect.ValueOf(syscall.ETH_P_DIAG),
+		"ETH_P_DNA_DL":                     reflect.ValueOf(syscall.ETH_P_DNA_DL),
+		"ETH_P_DNA_RC":                     reflect.ValueOf(syscall.ETH_P_DNA_RC),
+		"ETH_P_DNA_RT":                     reflect.ValueOf(syscall.ETH_P_DNA_RT),
+		"ETH_P_DSA":                        reflect.ValueOf(syscall.ETH_P_DSA),
+		"ETH_P_ECONET":                     reflect.ValueOf(syscall.ETH_P_ECONET),
+		"ETH_P_EDSA":                       reflect.ValueOf(syscall.ETH_P_EDSA),
+		"ETH_P_FCOE":                       reflect.ValueOf(syscall.ETH_P_FCOE),
+		"ETH_P_FIP":                        reflect.ValueOf(syscall.ETH_P_FIP),
+		"ETH_P_HDLC":                       reflect.ValueOf(syscall.ETH_P_HDLC),
+		"ETH_P_IEEE802154":                 reflect.ValueOf(syscall.ETH_P_IEEE802154),
+		"ETH_P_IEEEPUP":                    reflect.ValueOf(syscall.ETH_P_IEEEPUP),
+		"ETH_P_IEEEPUPAT":                  reflect.ValueOf(syscall.ETH_P_IEEEPUPAT),
+		"ETH_P_IP":                         reflect.ValueOf(syscall.ETH_P_IP),
+		"ETH_P_IPV6":                       reflect.ValueOf(syscall.ETH_P_IPV6),
+		"ETH_P_IPX":                        reflect.ValueOf(syscall.ETH_P_IPX),
+		"ETH_P_IRDA":                       reflect.ValueOf(syscall.ETH_P_IRDA),
+		"ETH_P_LAT":                        reflect.ValueOf(syscall.ETH_P_LAT),
+		"ETH_P_LINK_CTL":                   reflect.ValueOf(syscall.ETH_P_LINK_CTL),
+		"ETH_P_LOCALTALK":                  reflect.ValueOf(syscall.ETH_P_LOCALTALK),
+		"ETH_P_LOOP":                       reflect.ValueOf(syscall.ETH_P_LOOP),
+		"ETH_P_LOOPBACK":                   reflect.ValueOf(syscall.ETH_P_LOOPBACK),
+		"ETH_P_MOBITEX":                    reflect.ValueOf(syscall.ETH_P_MOBITEX),
+		"ETH_P_MPLS_MC":                    reflect.ValueOf(syscall.ETH_P_MPLS_MC),
+		"ETH_P_MPLS_UC":                    reflect.ValueOf(syscall.ETH_P_MPLS_UC),
+		"ETH_P_MVRP":                       reflect.ValueOf(syscall.ETH_P_MVRP),
+		"ETH_P_PAE":                        reflect.ValueOf(syscall.ETH_P_PAE),
+		"ETH_P_PAUSE":                      reflect.ValueOf(syscall.ETH_P_PAUSE),
+		"ETH_P_PHONET":                     reflect.ValueOf(syscall.ETH_P_PHONET),
+		"ETH_P_PPPTALK":                    reflect.ValueOf(syscall.ETH_P_PPPTALK),
+		"ETH_P_PPP_DISC":                   reflect.ValueOf(syscall.ETH_P_PPP_DISC),
+		"ETH_P_PPP_MP":                     reflect.ValueOf(syscall.ETH_P_PPP_MP),
+		"ETH_P_PPP_SES":                    reflect.ValueOf(syscall.ETH_P_PPP_SES),
+		"ETH_P_PRP":                        reflect.ValueOf(syscall.ETH_P_PRP),
+		"ETH_P_PUP":                        reflect.ValueOf(syscall.ETH_P_PUP),
+		"ETH_P_PUPAT":                      reflect.ValueOf(syscall.ETH_P_PUPAT),
+		"ETH_P_QINQ1":                      reflect.ValueOf(syscall.ETH_P_QINQ1),
+		"ETH_P_QINQ2":                      reflect.ValueOf(syscall.ETH_P_QINQ2),
+		"ETH_P_QINQ3":                      reflect.ValueOf(syscall.ETH_P_QINQ3),
+		"ETH_P_RARP":                       reflect.ValueOf(syscall.ETH_P_RARP),
+		"ETH_P_SCA":                        reflect.ValueOf(syscall.ETH_P_SCA),
+		"ETH_P_SLOW":                       reflect.ValueOf(syscall.ETH_P_SLOW),
+		"ETH_P_SNAP":                       reflect.ValueOf(syscall.ETH_P_SNAP),
+		"ETH_P_TDLS":                       reflect.ValueOf(syscall.ETH_P_TDLS),
+		"ETH_P_TEB":                        reflect.ValueOf(syscall.ETH_P_TEB),
+		"ETH_P_TIPC":                       reflect.ValueOf(syscall.ETH_P_TIPC),
+		"ETH_P_TRAILER":                    reflect.ValueOf(syscall.ETH_P_TRAILER),
+		"ETH_P_TR_802_2":                   reflect.ValueOf(syscall.ETH_P_TR_802_2),
+		"ETH_P_WAN_PPP":                    reflect.ValueOf(syscall.ETH_P_WAN_PPP),
+		"ETH_P_WCCP":                       reflect.ValueOf(syscall.ETH_P_WCCP),
+		"ETH_P_X25":                        reflect.ValueOf(syscall.ETH_P_X25),
+		"ETH_P_XDSA":                       reflect.ValueOf(syscall.ETH_P_XDSA),
+		"ETIME":                            reflect.ValueOf(syscall.ETIME),
+		"ETIMEDOUT":                        reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                     reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                          reflect.ValueOf(syscall.ETXTBSY),
+		"EUCLEAN":                          reflect.ValueOf(syscall.EUCLEAN),
+		"EUNATCH":                          reflect.ValueOf(syscall.EUNATCH),
+		"EUSERS":                           reflect.ValueOf(syscall.EUSERS),
+		"EWOULDBLOCK":                      reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EXDEV":                            reflect.ValueOf(syscall.EXDEV),
+		"EXFULL":                           reflect.ValueOf(syscall.EXFULL),
+		"EXTA":                             reflect.ValueOf(syscall.EXTA),
+		"EXTB":                             reflect.ValueOf(syscall.EXTB),
+		"EXTPROC":                          reflect.ValueOf(syscall.EXTPROC),
+		"Environ":                          reflect.ValueOf(syscall.Environ),
+		"EpollCreate":                      reflect.ValueOf(syscall.EpollCreate),
+		"EpollCreate1":                     reflect.ValueOf(syscall.EpollCreate1),
+		"EpollCtl":                         reflect.ValueOf(syscall.EpollCtl),
+		"EpollWait":                        reflect.ValueOf(syscall.EpollWait),
+		"Exec":                             reflect.ValueOf(syscall.Exec),
+		"Exit":                             reflect.ValueOf(syscall.Exit),
+		"FD_CLOEXEC":                       reflect.ValueOf(syscall.FD_CLOEXEC),
+		"FD_SETSIZE":                       reflect.ValueOf(syscall.FD_SETSIZE),
+		"FLUSHO":                           reflect.ValueOf(syscall.FLUSHO),
+		"F_DUPFD":                          reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":                  reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_EXLCK":                          reflect.ValueOf(syscall.F_EXLCK),
+		"F_GETFD":                          reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                          reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLEASE":                       reflect.ValueOf(syscall.F_GETLEASE),
+		"F_GETLK":                          reflect.ValueOf(syscall.F_GETLK),
+		"F_GETLK64":                        reflect.ValueOf(syscall.F_GETLK64),
+		"F_GETOWN":                         reflect.ValueOf(syscall.F_GETOWN),
+		"F_GETOWN_EX":                      reflect.ValueOf(syscall.F_GETOWN_EX),
+		"F_GETPIPE_SZ":                     reflect.ValueOf(syscall.F_GETPIPE_SZ),
+		"F_GETSIG":                         reflect.ValueOf(syscall.F_GETSIG),
+		"F_LOCK":                           reflect.ValueOf(syscall.F_LOCK),
+		"F_NOTIFY":                         reflect.ValueOf(syscall.F_NOTIFY),
+		"F_OFD_GETLK":                      reflect.ValueOf(syscall.F_OFD_GETLK),
+		"F_OFD_SETLK":                      reflect.ValueOf(syscall.F_OFD_SETLK),
+		"F_OFD_SETLKW":                     reflect.ValueOf(syscall.F_OFD_SETLKW),
+		"F_OK":                             reflect.ValueOf(syscall.F_OK),
+		"F_RDLCK":                          reflect.ValueOf(syscall.F_RDLCK),
+		"F_SETFD":                          reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                          reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLEASE":                       reflect.ValueOf(syscall.F_SETLEASE),
+		"F_SETLK":                          reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLK64":                        reflect.ValueOf(syscall.F_SETLK64),
+		"F_SETLKW":                         reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETLKW64":                       reflect.ValueOf(syscall.F_SETLKW64),
+		"F_SETOWN":                         reflect.ValueOf(syscall.F_SETOWN),
+		"F_SETOWN_EX":                      reflect.ValueOf(syscall.F_SETOWN_EX),
+		"F_SETPIPE_SZ":                     reflect.ValueOf(syscall.F_SETPIPE_SZ),
+		"F_SETSIG":                         reflect.ValueOf(syscall.F_SETSIG),
+		"F_SHLCK":                          reflect.ValueOf(syscall.F_SHLCK),
+		"F_TEST":                           reflect.ValueOf(syscall.F_TEST),
+		"F_TLOCK":                          reflect.ValueOf(syscall.F_TLOCK),
+		"F_ULOCK":                          reflect.ValueOf(syscall.F_ULOCK),
+		"F_UNLCK":                          reflect.ValueOf(syscall.F_UNLCK),
+		"F_WRLCK":                          reflect.ValueOf(syscall.F_WRLCK),
+		"Faccessat":                        reflect.ValueOf(syscall.Faccessat),
+		"Fallocate":                        reflect.ValueOf(syscall.Fallocate),
+		"Fchdir":                           reflect.ValueOf(syscall.Fchdir),
+		"Fchmod":                           reflect.ValueOf(syscall.Fchmod),
+		"Fchmodat":                         reflect.ValueOf(syscall.Fchmodat),
+		"Fchown":                           reflect.ValueOf(syscall.Fchown),
+		"Fchownat":                         reflect.ValueOf(syscall.Fchownat),
+		"FcntlFlock":                       reflect.ValueOf(syscall.FcntlFlock),
+		"Fdatasync":                        reflect.ValueOf(syscall.Fdatasync),
+		"Flock":                            reflect.ValueOf(syscall.Flock),
+		"ForkExec":                         reflect.ValueOf(syscall.ForkExec),
+		"ForkLock":                         reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"Fstat":                            reflect.ValueOf(syscall.Fstat),
+		"Fstatat":                          reflect.ValueOf(syscall.Fstatat),
+		"Fstatfs":                          reflect.ValueOf(syscall.Fstatfs),
+		"Fsync":                            reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                        reflect.ValueOf(syscall.Ftruncate),
+		"Futimes":                          reflect.ValueOf(syscall.Futimes),
+		"Futimesat":                        reflect.ValueOf(syscall.Futimesat),
+		"Getcwd":                           reflect.ValueOf(syscall.Getcwd),
+		"Getdents":                         reflect.ValueOf(syscall.Getdents),
+		"Getegid":                          reflect.ValueOf(syscall.Getegid),
+		"Getenv":                           reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                          reflect.ValueOf(syscall.Geteuid),
+		"Getgid":                           reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                        reflect.ValueOf(syscall.Getgroups),
+		"Getpagesize":                      reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                      reflect.ValueOf(syscall.Getpeername),
+		"Getpgid":                          reflect.ValueOf(syscall.Getpgid),
+		"Getpgrp":                          reflect.ValueOf(syscall.Getpgrp),
+		"Getpid":                           reflect.ValueOf(syscall.Getpid),
+		"Getppid":                          reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                      reflect.ValueOf(syscall.Getpriority),
+		"Getrlimit":                        reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                        reflect.ValueOf(syscall.Getrusage),
+		"Getsockname":                      reflect.ValueOf(syscall.Getsockname),
+		"GetsockoptICMPv6Filter":           reflect.ValueOf(syscall.GetsockoptICMPv6Filter),
+		"GetsockoptIPMreq":                 reflect.ValueOf(syscall.GetsockoptIPMreq),
+		"GetsockoptIPMreqn":                reflect.ValueOf(syscall.GetsockoptIPMreqn),
+		"GetsockoptIPv6MTUInfo":            reflect.ValueOf(syscall.GetsockoptIPv6MTUInfo),
+		"GetsockoptIPv6Mreq":               reflect.ValueOf(syscall.GetsockoptIPv6Mreq),
+		"GetsockoptInet4Addr":              reflect.ValueOf(syscall.GetsockoptInet4Addr),
+		"GetsockoptInt":                    reflect.ValueOf(syscall.GetsockoptInt),
+		"GetsockoptUcred":                  reflect.ValueOf(syscall.GetsockoptUcred),
+		"Gettid":                           reflect.ValueOf(syscall.Gettid),
+		"Gettimeofday":                     reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                           reflect.ValueOf(syscall.Getuid),
+		"Getwd":                            reflect.ValueOf(syscall.Getwd),
+		"Getxattr":                         reflect.ValueOf(syscall.Getxattr),
+		"HUPCL":                            reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                           reflect.ValueOf(syscall.ICANON),
+		"ICMPV6_FILTER":                    reflect.ValueOf(syscall.ICMPV6_FILTER),
+		"ICRNL":                            reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                           reflect.ValueOf(syscall.IEXTEN),
+		"IFA_ADDRESS":                      reflect.ValueOf(syscall.IFA_ADDRESS),
+		"IFA_ANYCAST":                      reflect.ValueOf(syscall.IFA_ANYCAST),
+		"IFA_BROADCAST":                    reflect.ValueOf(syscall.IFA_BROADCAST),
+		"IFA_CACHEINFO":                    reflect.ValueOf(syscall.IFA_CACHEINFO),
+		"IFA_F_DADFAILED":                  reflect.ValueOf(syscall.IFA_F_DADFAILED),
+		"IFA_F_DEPRECATED":                 reflect.ValueOf(syscall.IFA_F_DEPRECATED),
+		"IFA_F_HOMEADDRESS":                reflect.ValueOf(syscall.IFA_F_HOMEADDRESS),
+		"IFA_F_MANAGETEMPADDR":             reflect.ValueOf(syscall.IFA_F_MANAGETEMPADDR),
+		"IFA_F_MCAUTOJOIN":                 reflect.ValueOf(syscall.IFA_F_MCAUTOJOIN),
+		"IFA_F_NODAD":                      reflect.ValueOf(syscall.IFA_F_NODAD),
+		"IFA_F_NOPREFIXROUTE":              reflect.ValueOf(syscall.IFA_F_NOPREFIXROUTE),
+		"IFA_F_OPTIMISTIC":                 reflect.ValueOf(syscall.IFA_F_OPTIMISTIC),
+		"IFA_F_PERMANENT":                  reflect.ValueOf(syscall.IFA_F_PERMANENT),
+		"IFA_F_SECONDARY":                  reflect.ValueOf(syscall.IFA_F_SECONDARY),
+		"IFA_F_STABLE_PRIVACY":             reflect.ValueOf(syscall.IFA_F_STABLE_PRIVACY),
+		"IFA_F_TEMPORARY":                  reflect.ValueOf(syscall.IFA_F_TEMPORARY),
+		"IFA_F_TENTATIVE":                  reflect.ValueOf(syscall.IFA_F_TENTATIVE),
+		"IFA_LABEL":                        reflect.ValueOf(syscall.IFA_LABEL),
+		"IFA_LOCAL":                        reflect.ValueOf(syscall.IFA_LOCAL),
+		"IFA_MAX":                          reflect.ValueOf(syscall.IFA_MAX),
+		"IFA_MULTICAST":                    reflect.ValueOf(syscall.IFA_MULTICAST),
+		"IFA_UNSPEC":                       reflect.ValueOf(syscall.IFA_UNSPEC),
+		"IFF_ALLMULTI":                     reflect.ValueOf(syscall.IFF_ALLMULTI),
+		"IFF_ATTACH_QUEUE":                 reflect.ValueOf(syscall.IFF_ATTACH_QUEUE),
+		"IFF_AUTOMEDIA":                    reflect.ValueOf(syscall.IFF_AUTOMEDIA),
+		"IFF_BROADCAST":                    reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_DEBUG":                        reflect.ValueOf(syscall.IFF_DEBUG),
+		"IFF_DETACH_QUEUE":                 reflect.ValueOf(syscall.IFF_DETACH_QUEUE),
+		"IFF_DORMANT":                      reflect.ValueOf(syscall.IFF_DORMANT),
+		"IFF_DYNAMIC":                      reflect.ValueOf(syscall.IFF_DYNAMIC),
+		"IFF_ECHO":                         reflect.ValueOf(syscall.IFF_ECHO),
+		"IFF_LOOPBACK":                     reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_LOWER_UP":                     reflect.ValueOf(syscall.IFF_LOWER_UP),
+		"IFF_MASTER":                       reflect.ValueOf(syscall.IFF_MASTER),
+		"IFF_MULTICAST":                    reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_MULTI_QUEUE":                  reflect.ValueOf(syscall.IFF_MULTI_QUEUE),
+		"IFF_NOARP":                        reflect.ValueOf(syscall.IFF_NOARP),
+		"IFF_NOFILTER":                     reflect.ValueOf(syscall.IFF_NOFILTER),
+		"IFF_NOTRAILERS":                   reflect.ValueOf(syscall.IFF_NOTRAILERS),
+		"IFF_NO_PI":                        reflect.ValueOf(syscall.IFF_NO_PI),
+		"IFF_ONE_QUEUE":                    reflect.ValueOf(syscall.IFF_ONE_QUEUE),
+		"IFF_PERSIST":                      reflect.ValueOf(syscall.IFF_PERSIST),
+		"IFF_POINTOPOINT":                  reflect.ValueOf(syscall.IFF_POINTOPOINT),
+		"IFF_PORTSEL":                      reflect.ValueOf(syscall.IFF_PORTSEL),
+		"IFF_PROMISC":                      reflect.ValueOf(syscall.IFF_PROMISC),
+		"IFF_RUNNING":                      reflect.ValueOf(syscall.IFF_RUNNING),
+		"IFF_SLAVE":                        reflect.ValueOf(syscall.IFF_SLAVE),
+		"IFF_TAP":                          reflect.ValueOf(syscall.IFF_TAP),
+		"IFF_TUN":                          reflect.ValueOf(syscall.IFF_TUN),
+		"IFF_TUN_EXCL":                     reflect.ValueOf(syscall.IFF_TUN_EXCL),
+		"IFF_UP":                           reflect.ValueOf(syscall.IFF_UP),
+		"IFF_VNET_HDR":                     reflect.ValueOf(syscall.IFF_VNET_HDR),
+		"IFF_VOLATILE":                     reflect.ValueOf(syscall.IFF_VOLATILE),
+		"IFLA_ADDRESS":                     reflect.ValueOf(syscall.IFLA_ADDRESS),
+		"IFLA_BROADCAST":                   reflect.ValueOf(syscall.IFLA_BROADCAST),
+		"IFLA_COST":                        reflect.ValueOf(syscall.IFLA_COST),
+		"IFLA_IFALIAS":                     reflect.ValueOf(syscall.IFLA_IFALIAS),
+		"IFLA_IFNAME":                      reflect.ValueOf(syscall.IFLA_IFNAME),
+		"IFLA_LINK":                        reflect.ValueOf(syscall.IFLA_LINK),
+		"IFLA_LINKINFO":                    reflect.ValueOf(syscall.IFLA_LINKINFO),
+		"IFLA_LINKMODE":                    reflect.ValueOf(syscall.IFLA_LINKMODE),
+		"IFLA_MAP":                         reflect.ValueOf(syscall.IFLA_MAP),
+		"IFLA_MASTER":                      reflect.ValueOf(syscall.IFLA_MASTER),
+		"IFLA_MAX":                         reflect.ValueOf(syscall.IFLA_MAX),
+		"IFLA_MTU":                         reflect.ValueOf(syscall.IFLA_MTU),
+		"IFLA_NET_NS_PID":                  reflect.ValueOf(syscall.IFLA_NET_NS_PID),
+		"IFLA_OPERSTATE":                   reflect.ValueOf(syscall.IFLA_OPERSTATE),
+		"IFLA_PRIORITY":                    reflect.ValueOf(syscall.IFLA_PRIORITY),
+		"IFLA_PROTINFO":                    reflect.ValueOf(syscall.IFLA_PROTINFO),
+		"IFLA_QDISC":                       reflect.ValueOf(syscall.IFLA_QDISC),
+		"IFLA_STATS":                       reflect.ValueOf(syscall.IFLA_STATS),
+		"IFLA_TXQLEN":                      reflect.ValueOf(syscall.IFLA_TXQLEN),
+		"IFLA_UNSPEC":                      reflect.ValueOf(syscall.IFLA_UNSPEC),
+		"IFLA_WEIGHT":                      reflect.ValueOf(syscall.IFLA_WEIGHT),
+		"IFLA_WIRELESS":                    reflect.ValueOf(syscall.IFLA_WIRELESS),
+		"IFNAMSIZ":                         reflect.ValueOf(syscall.IFNAMSIZ),
+		"IGNBRK":                           reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                            reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                           reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                          reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                            reflect.ValueOf(syscall.INLCR),
+		"INPCK":                            reflect.ValueOf(syscall.INPCK),
+		"IN_ACCESS":                        reflect.ValueOf(syscall.IN_ACCESS),
+		"IN_ALL_EVENTS":                    reflect.ValueOf(syscall.IN_ALL_EVENTS),
+		"IN_ATTRIB":                        reflect.ValueOf(syscall.IN_ATTRIB),
+		"IN_CLASSA_HOST":                   reflect.ValueOf(syscall.IN_CLASSA_HOST),
+		"IN_CLASSA_MAX":                    reflect.ValueOf(syscall.IN_CLASSA_MAX),
+		"IN_CLASSA_NET":                    reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
+		"IN_CLASSA_NSHIFT":                 reflect.ValueOf(syscall.IN_CLASSA_NSHIFT),
+		"IN_CLASSB_HOST":                   reflect.ValueOf(syscall.IN_CLASSB_HOST),
+		"IN_CLASSB_MAX":                    reflect.ValueOf(syscall.IN_CLASSB_MAX),
+		"IN_CLASSB_NET":                    reflect.ValueOf(uint32(syscall.IN_CLASSB_NET)),
+		"IN_CLASSB_NSHIFT":                 reflect.ValueOf(syscall.IN_CLASSB_NSHIFT),
+		"IN_CLASSC_HOST":                   reflect.ValueOf(syscall.IN_CLASSC_HOST),
+		"IN_CLASSC_NET":                    reflect.ValueOf(uint32(syscall.IN_CLASSC_NET)),
+		"IN_CLASSC_NSHIFT":                 reflect.ValueOf(syscall.IN_CLASSC_NSHIFT),
+		"IN_CLOEXEC":                       reflect.ValueOf(syscall.IN_CLOEXEC),
+		"IN_CLOSE":                         reflect.ValueOf(syscall.IN_CLOSE),
+		"IN_CLOSE_NOWRITE":                 reflect.ValueOf(syscall.IN_CLOSE_NOWRITE),
+		"IN_CLOSE_WRITE":                   reflect.ValueOf(syscall.IN_CLOSE_WRITE),
+		"IN_CREATE":                        reflect.ValueOf(syscall.IN_CREATE),
+		"IN_DELETE":                        reflect.ValueOf(syscall.IN_DELETE),
+		"IN_DELETE_SELF":                   reflect.ValueOf(syscall.IN_DELETE_SELF),
+		"IN_DONT_FOLLOW":                   reflect.ValueOf(syscall.IN_DONT_FOLLOW),
+		"IN_EXCL_UNLINK":                   reflect.ValueOf(syscall.IN_EXCL_UNLINK),
+		"IN_IGNORED":                       reflect.ValueOf(syscall.IN_IGNORED),
+		"IN_ISDIR":                         reflect.ValueOf(syscall.IN_ISDIR),
+		"IN_LOOPBACKNET":                   reflect.ValueOf(syscall.IN_LOOPBACKNET),
+		"IN_MASK_ADD":                      reflect.ValueOf(syscall.IN_MASK_ADD),
+		"IN_MODIFY":                        reflect.ValueOf(syscall.IN_MODIFY),
+		"IN_MOVE":                          reflect.ValueOf(syscall.IN_MOVE),
+		"IN_MOVED_FROM":                    reflect.ValueOf(syscall.IN_MOVED_FROM),
+		"IN_MOVED_TO":                      reflect.ValueOf(syscall.IN_MOVED_TO),
+		"IN_MOVE_SELF":                     reflect.ValueOf(syscall.IN_MOVE_SELF),
+		"IN_NONBLOCK":                      reflect.ValueOf(syscall.IN_NONBLOCK),
+		"IN_ONESHOT":                       reflect.ValueOf(uint32(syscall.IN_ONESHOT)),
+		"IN_ONLYDIR":                       reflect.ValueOf(syscall.IN_ONLYDIR),
+		"IN_OPEN":                          reflect.ValueOf(syscall.IN_OPEN),
+		"IN_Q_OVERFLOW":                    reflect.ValueOf(syscall.IN_Q_OVERFLOW),
+		"IN_UNMOUNT":                       reflect.ValueOf(syscall.IN_UNMOUNT),
+		"IPPROTO_AH":                       reflect.ValueOf(syscall.IPPROTO_AH),
+		"IPPROTO_BEETPH":                   reflect.ValueOf(syscall.IPPROTO_BEETPH),
+		"IPPROTO_COMP":                     reflect.ValueOf(syscall.IPPROTO_COMP),
+		"IPPROTO_DCCP":                     reflect.ValueOf(syscall.IPPROTO_DCCP),
+		"IPPROTO_DSTOPTS":                  reflect.ValueOf(syscall.IPPROTO_DSTOPTS),
+		"IPPROTO_EGP":                      reflect.ValueOf(syscall.IPPROTO_EGP),
+		"IPPROTO_ENCAP":                    reflect.ValueOf(syscall.IPPROTO_ENCAP),
+		"IPPROTO_ESP":                      reflect.ValueOf(syscall.IPPROTO_ESP),
+		"IPPROTO_FRAGMENT":                 reflect.ValueOf(syscall.IPPROTO_FRAGMENT),
+		"IPPROTO_GRE":                      reflect.ValueOf(syscall.IPPROTO_GRE),
+		"IPPROTO_HOPOPTS":                  reflect.ValueOf(syscall.IPPROTO_HOPOPTS),
+		"IPPROTO_ICMP":                     reflect.ValueOf(syscall.IPPROTO_ICMP),
+		"IPPROTO_ICMPV6":                   reflect.ValueOf(syscall.IPPROTO_ICMPV6),
+		"IPPROTO_IDP":                      reflect.ValueOf(syscall.IPPROTO_IDP),
+		"IPPROTO_IGMP":                     reflect.ValueOf(syscall.IPPROTO_IGMP),
+		"IPPROTO_IP":                       reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPIP":                     reflect.ValueOf(syscall.IPPROTO_IPIP),
+		"IPPROTO_IPV6":                     reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_MH":                       reflect.ValueOf(syscall.IPPROTO_MH),
+		"IPPROTO_MPLS":                     reflect.ValueOf(syscall.IPPROTO_MPLS),
+		"IPPROTO_MTP":                      reflect.ValueOf(syscall.IPPROTO_MTP),
+		"IPPROTO_NONE":                     reflect.ValueOf(syscall.IPPROTO_NONE),
+		"IPPROTO_PIM":                      reflect.ValueOf(syscall.IPPROTO_PIM),
+		"IPPROTO_PUP":                      reflect.ValueOf(syscall.IPPROTO_PUP),
+		"IPPROTO_RAW":                      reflect.ValueOf(syscall.IPPROTO_RAW),
+		"IPPROTO_ROUTING":                  reflect.ValueOf(syscall.IPPROTO_ROUTING),
+		"IPPROTO_RSVP":                     reflect.ValueOf(syscall.IPPROTO_RSVP),
+		"IPPROTO_SCTP":                     reflect.ValueOf(syscall.IPPROTO_SCTP),
+		"IPPROTO_TCP":                      reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_TP":                       reflect.ValueOf(syscall.IPPROTO_TP),
+		"IPPROTO_UDP":                      reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPPROTO_UDPLITE":                  reflect.ValueOf(syscall.IPPROTO_UDPLITE),
+		"IPV6_2292DSTOPTS":                 reflect.ValueOf(syscall.IPV6_2292DSTOPTS),
+		"IPV6_2292HOPLIMIT":                reflect.ValueOf(syscall.IPV6_2292HOPLIMIT),
+		"IPV6_2292HOPOPTS":                 reflect.ValueOf(syscall.IPV6_2292HOPOPTS),
+		"IPV6_2292PKTINFO":                 reflect.ValueOf(syscall.IPV6_2292PKTINFO),
+		"IPV6_2292PKTOPTIONS":              reflect.ValueOf(syscall.IPV6_2292PKTOPTIONS),
+		"IPV6_2292RTHDR":                   reflect.ValueOf(syscall.IPV6_2292RTHDR),
+		"IPV6_ADDRFORM":                    reflect.ValueOf(syscall.IPV6_ADDRFORM),
+		"IPV6_ADD_MEMBERSHIP":              reflect.ValueOf(syscall.IPV6_ADD_MEMBERSHIP),
+		"IPV6_AUTHHDR":                     reflect.ValueOf(syscall.IPV6_AUTHHDR),
+		"IPV6_CHECKSUM":                    reflect.ValueOf(syscall.IPV6_CHECKSUM),
+		"IPV6_DONTFRAG":                    reflect.ValueOf(syscall.IPV6_DONTFRAG),
+		"IPV6_DROP_MEMBERSHIP":             reflect.ValueOf(syscall.IPV6_DROP_MEMBERSHIP),
+		"IPV6_DSTOPTS":                     reflect.ValueOf(syscall.IPV6_DSTOPTS),
+		"IPV6_HDRINCL":                     reflect.ValueOf(syscall.IPV6_HDRINCL),
+		"IPV6_HOPLIMIT":                    reflect.ValueOf(syscall.IPV6_HOPLIMIT),
+		"IPV6_HOPOPTS":                     reflect.ValueOf(syscall.IPV6_HOPOPTS),
+		"IPV6_IPSEC_POLICY":                reflect.ValueOf(syscall.IPV6_IPSEC_POLICY),
+		"IPV6_JOIN_ANYCAST":                reflect.ValueOf(syscall.IPV6_JOIN_ANYCAST),
+		"IPV6_JOIN_GROUP":                  reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_ANYCAST":               reflect.ValueOf(syscall.IPV6_LEAVE_ANYCAST),
+		"IPV6_LEAVE_GROUP":                 reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MTU":                         reflect.ValueOf(syscall.IPV6_MTU),
+		"IPV6_MTU_DISCOVER":                reflect.ValueOf(syscall.IPV6_MTU_DISCOVER),
+		"IPV6_MULTICAST_HOPS":              reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":                reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":              reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_NEXTHOP":                     reflect.ValueOf(syscall.IPV6_NEXTHOP),
+		"IPV6_PATHMTU":                     reflect.ValueOf(syscall.IPV6_PATHMTU),
+		"IPV6_PKTINFO":                     reflect.ValueOf(syscall.IPV6_PKTINFO),
+		"IPV6_PMTUDISC_DO":                 reflect.ValueOf(syscall.IPV6_PMTUDISC_DO),
+		"IPV6_PMTUDISC_DONT":               reflect.ValueOf(syscall.IPV6_PMTUDISC_DONT),
+		"IPV6_PMTUDISC_INTERFACE":          reflect.ValueOf(syscall.IPV6_PMTUDISC_INTERFACE),
+		"IPV6_PMTUDISC_OMIT":               reflect.ValueOf(syscall.IPV6_PMTUDISC_OMIT),
+		"IPV6_PMTUDISC_PROBE":              reflect.ValueOf(syscall.IPV6_PMTUDISC_PROBE),
+		"IPV6_PMTUDISC_WANT":               reflect.ValueOf(syscall.IPV6_PMTUDISC_WANT),
+		"IPV6_RECVDSTOPTS":                 reflect.ValueOf(syscall.IPV6_RECVDSTOPTS),
+		"IPV6_RECVERR":                     reflect.ValueOf(syscall.IPV6_RECVERR),
+		"IPV6_RECVHOPLIMIT":                reflect.ValueOf(syscall.IPV6_RECVHOPLIMIT),
+		"IPV6_RECVHOPOPTS":                 reflect.ValueOf(syscall.IPV6_RECVHOPOPTS),
+		"IPV6_RECVPATHMTU":                 reflect.ValueOf(syscall.IPV6_RECVPATHMTU),
+		"IPV6_RECVPKTINFO":                 reflect.ValueOf(syscall.IPV6_RECVPKTINFO),
+		"IPV6_RECVRTHDR":                   reflect.ValueOf(syscall.IPV6_RECVRTHDR),
+		"IPV6_RECVTCLASS":                  reflect.ValueOf(syscall.IPV6_RECVTCLASS),
+		"IPV6_ROUTER_ALERT":                reflect.ValueOf(syscall.IPV6_ROUTER_ALERT),
+		"IPV6_RTHDR":                       reflect.ValueOf(syscall.IPV6_RTHDR),
+		"IPV6_RTHDRDSTOPTS":                reflect.ValueOf(syscall.IPV6_RTHDRDSTOPTS),
+		"IPV6_RTHDR_LOOSE":                 reflect.ValueOf(syscall.IPV6_RTHDR_LOOSE),
+		"IPV6_RTHDR_STRICT":                reflect.ValueOf(syscall.IPV6_RTHDR_STRICT),
+		"IPV6_RTHDR_TYPE_0":                reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_0),
+		"IPV6_RXDSTOPTS":                   reflect.ValueOf(syscall.IPV6_RXDSTOPTS),
+		"IPV6_RXHOPOPTS":                   reflect.ValueOf(syscall.IPV6_RXHOPOPTS),
+		"IPV6_TCLASS":                      reflect.ValueOf(syscall.IPV6_TCLASS),
+		"IPV6_UNICAST_HOPS":                reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_V6ONLY":                      reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IPV6_XFRM_POLICY":                 reflect.ValueOf(syscall.IPV6_XFRM_POLICY),
+		"IP_ADD_MEMBERSHIP":                reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_ADD_SOURCE_MEMBERSHIP":         reflect.ValueOf(syscall.IP_ADD_SOURCE_MEMBERSHIP),
+		"IP_BIND_ADDRESS_NO_PORT":          reflect.ValueOf(syscall.IP_BIND_ADDRESS_NO_PORT),
+		"IP_BLOCK_SOURCE":                  reflect.ValueOf(syscall.IP_BLOCK_SOURCE),
+		"IP_CHECKSUM":                      reflect.ValueOf(syscall.IP_CHECKSUM),
+		"IP_DEFAULT_MULTICAST_LOOP":        reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_LOOP),
+		"IP_DEFAULT_MULTICAST_TTL":         reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_TTL),
+		"IP_DF":                            reflect.ValueOf(syscall.IP_DF),
+		"IP_DROP_MEMBERSHIP":               reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_DROP_SOURCE_MEMBERSHIP":        reflect.ValueOf(syscall.IP_DROP_SOURCE_MEMBERSHIP),
+		"IP_FREEBIND":                      reflect.ValueOf(syscall.IP_FREEBIND),
+		"IP_HDRINCL":                       reflect.ValueOf(syscall.IP_HDRINCL),
+		"IP_IPSEC_POLICY":                  reflect.ValueOf(syscall.IP_IPSEC_POLICY),
+		"IP_MAXPACKET":                     reflect.ValueOf(syscall.IP_MAXPACKET),
+		"IP_MAX_MEMBERSHIPS":               reflect.ValueOf(syscall.IP_MAX_MEMBERSHIPS),
+		"IP_MF":                            reflect.ValueOf(syscall.IP_MF),
+		"IP_MINTTL":                        reflect.ValueOf(syscall.IP_MINTTL),
+		"IP_MSFILTER":                      reflect.ValueOf(syscall.IP_MSFILTER),
+		"IP_MSS":                           reflect.ValueOf(syscall.IP_MSS),
+		"IP_MTU":                           reflect.ValueOf(syscall.IP_MTU),
+		"IP_MTU_DISCOVER":                  reflect.ValueOf(syscall.IP_MTU_DISCOVER),
+		"IP_MULTICAST_ALL":                 reflect.ValueOf(syscall.IP_MULTICAST_ALL),
+		"IP_MULTICAST_IF":                  reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_LOOP":                reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":                 reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_NODEFRAG":                      reflect.ValueOf(syscall.IP_NODEFRAG),
+		"IP_OFFMASK":                       reflect.ValueOf(syscall.IP_OFFMASK),
+		"IP_OPTIONS":                       reflect.ValueOf(syscall.IP_OPTIONS),
+		"IP_ORIGDSTADDR":                   reflect.ValueOf(syscall.IP_ORIGDSTADDR),
+		"IP_PASSSEC":                       reflect.ValueOf(syscall.IP_PASSSEC),
+		"IP_PKTINFO":                       reflect.ValueOf(syscall.IP_PKTINFO),
+		"IP_PKTOPTIONS":                    reflect.ValueOf(syscall.IP_PKTOPTIONS),
+		"IP_PMTUDISC":                      reflect.ValueOf(syscall.IP_PMTUDISC),
+		"IP_PMTUDISC_DO":                   reflect.ValueOf(syscall.IP_PMTUDISC_DO),
+		"IP_PMTUDISC_DONT":                 reflect.ValueOf(syscall.IP_PMTUDISC_DONT),
+		"IP_PMTUDISC_INTERFACE":            reflect.ValueOf(syscall.IP_PMTUDISC_INTERFACE),
+		"IP_PMTUDISC_OMIT":                 reflect.ValueOf(syscall.IP_PMTUDISC_OMIT),
+		"IP_PMTUDISC_PROBE":                reflect.ValueOf(syscall.IP_PMTUDISC_PROBE),
+		"IP_PMTUDISC_WANT":                 reflect.ValueOf(syscall.IP_PMTUDISC_WANT),
+		"IP_RECVERR":                       reflect.ValueOf(syscall.IP_RECVERR),
+		"IP_RECVOPTS":                      reflect.ValueOf(syscall.IP_RECVOPTS),
+		"IP_RECVORIGDSTADDR":               reflect.ValueOf(syscall.IP_RECVORIGDSTADDR),
+		"IP_RECVRETOPTS":                   reflect.ValueOf(syscall.IP_RECVRETOPTS),
+		"IP_RECVTOS":                       reflect.ValueOf(syscall.IP_RECVTOS),
+		"IP_RECVTTL":                       reflect.ValueOf(syscall.IP_RECVTTL),
+		"IP_RETOPTS":                       reflect.ValueOf(syscall.IP_RETOPTS),
+		"IP_RF":                            reflect.ValueOf(syscall.IP_RF),
+		"IP_ROUTER_ALERT":                  reflect.ValueOf(syscall.IP_ROUTER_ALERT),
+		"IP_TOS":                           reflect.ValueOf(syscall.IP_TOS),
+		"IP_TRANSPARENT":                   reflect.ValueOf(syscall.IP_TRANSPARENT),
+		"IP_TTL":                           reflect.ValueOf(syscall.IP_TTL),
+		"IP_UNBLOCK_SOURCE":                reflect.ValueOf(syscall.IP_UNBLOCK_SOURCE),
+		"IP_UNICAST_IF":                    reflect.ValueOf(syscall.IP_UNICAST_IF),
+		"IP_XFRM_POLICY":                   reflect.ValueOf(syscall.IP_XFRM_POLICY),
+		"ISIG":                             reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                           reflect.ValueOf(syscall.ISTRIP),
+		"IUCLC":                            reflect.ValueOf(syscall.IUCLC),
+		"IUTF8":                            reflect.ValueOf(syscall.IUTF8),
+		"IXANY":                            reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                            reflect.ValueOf(syscall.IXOFF),
+		"IXON":                             reflect.ValueOf(syscall.IXON),
+		"ImplementsGetwd":                  reflect.ValueOf(syscall.ImplementsGetwd),
+		"InotifyAddWatch":                  reflect.ValueOf(syscall.InotifyAddWatch),
+		"InotifyInit":                      reflect.ValueOf(syscall.InotifyInit),
+		"InotifyInit1":                     reflect.ValueOf(syscall.InotifyInit1),
+		"InotifyRmWatch":                   reflect.ValueOf(syscall.InotifyRmWatch),
+		"Kill":                             reflect.ValueOf(syscall.Kill),
+		"Klogctl":                          reflect.ValueOf(syscall.Klogctl),
+		"LINUX_REBOOT_CMD_CAD_OFF":         reflect.ValueOf(syscall.LINUX_REBOOT_CMD_CAD_OFF),
+		"LINUX_REBOOT_CMD_CAD_ON":          reflect.ValueOf(uint32(syscall.LINUX_REBOOT_CMD_CAD_ON)),
+		"LINUX_REBOOT_CMD_HALT":            reflect.ValueOf(uint32(syscall.LINUX_REBOOT_CMD_HALT)),
+		"LINUX_REBOOT_CMD_KEXEC":           reflect.ValueOf(syscall.LINUX_REBOOT_CMD_KEXEC),
+		"LINUX_REBOOT_CMD_POWER_OFF":       reflect.ValueOf(syscall.LINUX_REBOOT_CMD_POWER_OFF),
+		"LINUX_REBOOT_CMD_RESTART":         reflect.ValueOf(syscall.LINUX_REBOOT_CMD_RESTART),
+		"LINUX_REBOOT_CMD_RESTART2":        reflect.ValueOf(uint32(syscall.LINUX_REBOOT_CMD_RESTART2)),
+		"LINUX_REBOOT_CMD_SW_SUSPEND":      reflect.ValueOf(uint32(syscall.LINUX_REBOOT_CMD_SW_SUSPEND)),
+		"LINUX_REBOOT_MAGIC1":              reflect.ValueOf(uint32(syscall.LINUX_REBOOT_MAGIC1)),
+		"LINUX_REBOOT_MAGIC2":              reflect.ValueOf(syscall.LINUX_REBOOT_MAGIC2),
+		"LOCK_EX":                          reflect.ValueOf(syscall.LOCK_EX),
+		"LOCK_NB":                          reflect.ValueOf(syscall.LOCK_NB),
+		"LOCK_SH":                          reflect.ValueOf(syscall.LOCK_SH),
+		"LOCK_UN":                          reflect.ValueOf(syscall.LOCK_UN),
+		"Lchown":                           reflect.ValueOf(syscall.Lchown),
+		"Link":                             reflect.ValueOf(syscall.Link),
+		"Listen":                           reflect.ValueOf(syscall.Listen),
+		"Listxattr":                        reflect.ValueOf(syscall.Listxattr),
+		"LsfJump":                          reflect.ValueOf(syscall.LsfJump),
+		"LsfSocket":                        reflect.ValueOf(syscall.LsfSocket),
+		"LsfStmt":                          reflect.ValueOf(syscall.LsfStmt),
+		"Lstat":                            reflect.ValueOf(syscall.Lstat),
+		"MADV_DODUMP":                      reflect.ValueOf(syscall.MADV_DODUMP),
+		"MADV_DOFORK":                      reflect.ValueOf(syscall.MADV_DOFORK),
+		"MADV_DONTDUMP":                    reflect.ValueOf(syscall.MADV_DONTDUMP),
+		"MADV_DONTFORK":                    reflect.ValueOf(syscall.MADV_DONTFORK),
+		"MADV_DONTNEED":                    reflect.ValueOf(syscall.MADV_DONTNEED),
+		"MADV_FREE":                        reflect.ValueOf(syscall.MADV_FREE),
+		"MADV_HUGEPAGE":                    reflect.ValueOf(syscall.MADV_HUGEPAGE),
+		"MADV_HWPOISON":                    reflect.ValueOf(syscall.MADV_HWPOISON),
+		"MADV_MERGEABLE":                   reflect.ValueOf(syscall.MADV_MERGEABLE),
+		"MADV_NOHUGEPAGE":                  reflect.ValueOf(syscall.MADV_NOHUGEPAGE),
+		"MADV_NORMAL":                      reflect.ValueOf(syscall.MADV_NORMAL),
+		"MADV_RANDOM":                      reflect.ValueOf(syscall.MADV_RANDOM),
+		"MADV_REMOVE":                      reflect.ValueOf(syscall.MADV_REMOVE),
+		"MADV_SEQUENTIAL":                  reflect.ValueOf(syscall.MADV_SEQUENTIAL),
+		"MADV_UNMERGEABLE":                 reflect.ValueOf(syscall.MADV_UNMERGEABLE),
+		"MADV_WILLNEED":                    reflect.ValueOf(syscall.MADV_WILLNEED),
+		"MAP_ANON":                         reflect.ValueOf(syscall.MAP_ANON),
+		"MAP_ANONYMOUS":                    reflect.ValueOf(syscall.MAP_ANONYMOUS),
+		"MAP_DENYWRITE":                    reflect.ValueOf(syscall.MAP_DENYWRITE),
+		"MAP_EXECUTABLE":                   reflect.ValueOf(syscall.MAP_EXECUTABLE),
+		"MAP_FILE":                         reflect.ValueOf(syscall.MAP_FILE),
+		"MAP_FIXED":                        reflect.ValueOf(syscall.MAP_FIXED),
+		"MAP_GROWSDOWN":                    reflect.ValueOf(syscall.MAP_GROWSDOWN),
+		"MAP_HUGETLB":                      reflect.ValueOf(syscall.MAP_HUGETLB),
+		"MAP_HUGE_MASK":                    reflect.ValueOf(syscall.MAP_HUGE_MASK),
+		"MAP_HUGE_SHIFT":                   reflect.ValueOf(syscall.MAP_HUGE_SHIFT),
+		"MAP_LOCKED":                       reflect.ValueOf(syscall.MAP_LOCKED),
+		"MAP_NONBLOCK":                     reflect.ValueOf(syscall.MAP_NONBLOCK),
+		"MAP_NORESERVE":                    reflect.ValueOf(syscall.MAP_NORESERVE),
+		"MAP_POPULATE":                     reflect.ValueOf(syscall.MAP_POPULATE),
+		"MAP_PRIVATE":                      reflect.ValueOf(syscall.MAP_PRIVATE),
+		"MAP_SHARED":                       reflect.ValueOf(syscall.MAP_SHARED),
+		"MAP_STACK":                        reflect.ValueOf(syscall.MAP_STACK),
+		"MAP_TYPE":                         reflect.ValueOf(syscall.MAP_TYPE),
+		"MCL_CURRENT":                      reflect.ValueOf(syscall.MCL_CURRENT),
+		"MCL_FUTURE":                       reflect.ValueOf(syscall.MCL_FUTURE),
+		"MCL_ONFAULT":                      reflect.ValueOf(syscall.MCL_ONFAULT),
+		"MNT_DETACH":                       reflect.ValueOf(syscall.MNT_DETACH),
+		"MNT_EXPIRE":                       reflect.ValueOf(syscall.MNT_EXPIRE),
+		"MNT_FORCE":                        reflect.ValueOf(syscall.MNT_FORCE),
+		"MSG_BATCH":                        reflect.ValueOf(syscall.MSG_BATCH),
+		"MSG_CMSG_CLOEXEC":                 reflect.ValueOf(syscall.MSG_CMSG_CLOEXEC),
+		"MSG_CONFIRM":                      reflect.ValueOf(syscall.MSG_CONFIRM),
+		"MSG_CTRUNC":                       reflect.ValueOf(syscall.MSG_CTRUNC),
+		"MSG_DONTROUTE":                    reflect.ValueOf(syscall.MSG_DONTROUTE),
+		"MSG_DONTWAIT":                     reflect.ValueOf(syscall.MSG_DONTWAIT),
+		"MSG_EOR":                          reflect.ValueOf(syscall.MSG_EOR),
+		"MSG_ERRQUEUE":                     reflect.ValueOf(syscall.MSG_ERRQUEUE),
+		"MSG_FASTOPEN":                     reflect.ValueOf(syscall.MSG_FASTOPEN),
+		"MSG_FIN":                          reflect.ValueOf(syscall.MSG_FIN),
+		"MSG_MORE":                         reflect.ValueOf(syscall.MSG_MORE),
+		"MSG_NOSIGNAL":                     reflect.ValueOf(syscall.MSG_NOSIGNAL),
+		"MSG_OOB":                          reflect.ValueOf(syscall.MSG_OOB),
+		"MSG_PEEK":                         reflect.ValueOf(syscall.MSG_PEEK),
+		"MSG_PROXY":                        reflect.ValueOf(syscall.MSG_PROXY),
+		"MSG_RST":                          reflect.ValueOf(syscall.MSG_RST),
+		"MSG_SYN":                          reflect.ValueOf(syscall.MSG_SYN),
+		"MSG_TRUNC":                        reflect.ValueOf(syscall.MSG_TRUNC),
+		"MSG_TRYHARD":                      reflect.ValueOf(syscall.MSG_TRYHARD),
+		"MSG_WAITALL":                      reflect.ValueOf(syscall.MSG_WAITALL),
+		"MSG_WAITFORONE":                   reflect.ValueOf(syscall.MSG_WAITFORONE),
+		"MS_ACTIVE":                        reflect.ValueOf(syscall.MS_ACTIVE),
+		"MS_ASYNC":                         reflect.ValueOf(syscall.MS_ASYNC),
+		"MS_BIND":                          reflect.ValueOf(syscall.MS_BIND),
+		"MS_DIRSYNC":                       reflect.ValueOf(syscall.MS_DIRSYNC),
+		"MS_INVALIDATE":                    reflect.ValueOf(syscall.MS_INVALIDATE),
+		"MS_I_VERSION":                     reflect.ValueOf(syscall.MS_I_VERSION),
+		"MS_KERNMOUNT":                     reflect.ValueOf(syscall.MS_KERNMOUNT),
+		"MS_LAZYTIME":                      reflect.ValueOf(syscall.MS_LAZYTIME),
+		"MS_MANDLOCK":                      reflect.ValueOf(syscall.MS_MANDLOCK),
+		"MS_MGC_MSK":                       reflect.ValueOf(uint32(syscall.MS_MGC_MSK)),
+		"MS_MGC_VAL":                       reflect.ValueOf(uint32(syscall.MS_MGC_VAL)),
+		"MS_MOVE":                          reflect.ValueOf(syscall.MS_MOVE),
+		"MS_NOATIME":                       reflect.ValueOf(syscall.MS_NOATIME),
+		"MS_NODEV":                         reflect.ValueOf(syscall.MS_NODEV),
+		"MS_NODIRATIME":                    reflect.ValueOf(syscall.MS_NODIRATIME),
+		"MS_NOEXEC":                        reflect.ValueOf(syscall.MS_NOEXEC),
+		"MS_NOSUID":                        reflect.ValueOf(syscall.MS_NOSUID),
+		"MS_NOUSER":                        reflect.ValueOf(syscall.MS_NOUSER),
+		"MS_POSIXACL":                      reflect.ValueOf(syscall.MS_POSIXACL),
+		"MS_PRIVATE":                       reflect.ValueOf(syscall.MS_PRIVATE),
+		"MS_RDONLY":                        reflect.ValueOf(syscall.MS_RDONLY),
+		"MS_REC":                           reflect.ValueOf(syscall.MS_REC),
+		"MS_RELATIME":                      reflect.ValueOf(syscall.MS_RELATIME),
+		"MS_REMOUNT":                       reflect.ValueOf(syscall.MS_REMOUNT),
+		"MS_RMT_MASK":                      reflect.ValueOf(syscall.MS_RMT_MASK),
+		"MS_SHARED":                        reflect.ValueOf(syscall.MS_SHARED),
+		"MS_SILENT":                        reflect.ValueOf(syscall.MS_SILENT),
+		"MS_SLAVE":                         reflect.ValueOf(syscall.MS_SLAVE),
+		"MS_STRICTATIME":                   reflect.ValueOf(syscall.MS_STRICTATIME),
+		"MS_SYNC":                          reflect.ValueOf(syscall.MS_SYNC),
+		"MS_SYNCHRONOUS":                   reflect.ValueOf(syscall.MS_SYNCHRONOUS),
+		"MS_UNBINDABLE":                    reflect.ValueOf(syscall.MS_UNBINDABLE),
+		"Madvise":                          reflect.ValueOf(syscall.Madvise),
+		"Mkdir":                            reflect.ValueOf(syscall.Mkdir),
+		"Mkdirat":                          reflect.ValueOf(syscall.Mkdirat),
+		"Mkfifo":                           reflect.ValueOf(syscall.Mkfifo),
+		"Mknod":                            reflect.ValueOf(syscall.Mknod),
+		"Mknodat":                          reflect.ValueOf(syscall.Mknodat),
+		"Mlock":                            reflect.ValueOf(syscall.Mlock),
+		"Mlockall":                         reflect.ValueOf(syscall.Mlockall),
+		"Mmap":                             reflect.ValueOf(syscall.Mmap),
+		"Mount":                            reflect.ValueOf(syscall.Mount),
+		"Mprotect":                         reflect.ValueOf(syscall.Mprotect),
+		"Munlock":                          reflect.ValueOf(syscall.Munlock),
+		"Munlockall":                       reflect.ValueOf(syscall.Munlockall),
+		"Munmap":                           reflect.ValueOf(syscall.Munmap),
+		"NAME_MAX":                         reflect.ValueOf(syscall.NAME_MAX),
+		"NETLINK_ADD_MEMBERSHIP":           reflect.ValueOf(syscall.NETLINK_ADD_MEMBERSHIP),
+		"NETLINK_AUDIT":                    reflect.ValueOf(syscall.NETLINK_AUDIT),
+		"NETLINK_BROADCAST_ERROR":          reflect.ValueOf(syscall.NETLINK_BROADCAST_ERROR),
+		"NETLINK_CONNECTOR":                reflect.ValueOf(syscall.NETLINK_CONNECTOR),
+		"NETLINK_CRYPTO":                   reflect.ValueOf(syscall.NETLINK_CRYPTO),
+		"NETLINK_DNRTMSG":                  reflect.ValueOf(syscall.NETLINK_DNRTMSG),
+		"NETLINK_DROP_MEMBERSHIP":          reflect.ValueOf(syscall.NETLINK_DROP_MEMBERSHIP),
+		"NETLINK_ECRYPTFS":                 reflect.ValueOf(syscall.NETLINK_ECRYPTFS),
+		"NETLINK_FIB_LOOKUP":               reflect.ValueOf(syscall.NETLINK_FIB_LOOKUP),
+		"NETLINK_FIREWALL":                 reflect.ValueOf(syscall.NETLINK_FIREWALL),
+		"NETLINK_GENERIC":                  reflect.ValueOf(syscall.NETLINK_GENERIC),
+		"NETLINK_INET_DIAG":                reflect.ValueOf(syscall.NETLINK_INET_DIAG),
+		"NETLINK_IP6_FW":                   reflect.ValueOf(syscall.NETLINK_IP6_FW),
+		"NETLINK_ISCSI":                    reflect.ValueOf(syscall.NETLINK_ISCSI),
+		"NETLINK_KOBJECT_UEVENT":           reflect.ValueOf(syscall.NETLINK_KOBJECT_UEVENT),
+		"NETLINK_NETFILTER":                reflect.ValueOf(syscall.NETLINK_NETFILTER),
+		"NETLINK_NFLOG":                    reflect.ValueOf(syscall.NETLINK_NFLOG),
+		"NETLINK_NO_ENOBUFS":               reflect.ValueOf(syscall.NETLINK_NO_ENOBUFS),
+		"NETLINK_PKTINFO":                  reflect.ValueOf(syscall.NETLINK_PKTINFO),
+		"NETLINK_RDMA":                     reflect.ValueOf(syscall.NETLINK_RDMA),
+		"NETLINK_ROUTE":                    reflect.ValueOf(syscall.NETLINK_ROUTE),
+		"NETLINK_RX_RING":                  reflect.ValueOf(syscall.NETLINK_RX_RING),
+		"NETLINK_SCSITRANSPORT":            reflect.ValueOf(syscall.NETLINK_SCSITRANSPORT),
+		"NETLINK_SELINUX":                  reflect.ValueOf(syscall.NETLINK_SELINUX),
+		"NETLINK_SOCK_DIAG":                reflect.ValueOf(syscall.NETLINK_SOCK_DIAG),
+		"NETLINK_TX_RING":                  reflect.ValueOf(syscall.NETLINK_TX_RING),
+		"NETLINK_UNUSED":                   reflect.ValueOf(syscall.NETLINK_UNUSED),
+		"NETLINK_USERSOCK":                 reflect.ValueOf(syscall.NETLINK_USERSOCK),
+		"NETLINK_XFRM":                     reflect.ValueOf(syscall.NETLINK_XFRM),
+		"NLA_ALIGNTO":                      reflect.ValueOf(syscall.NLA_ALIGNTO),
+		"NLA_F_NESTED":                     reflect.ValueOf(syscall.NLA_F_NESTED),
+		"NLA_F_NET_BYTEORDER":              reflect.ValueOf(syscall.NLA_F_NET_BYTEORDER),
+		"NLA_HDRLEN":                       reflect.ValueOf(syscall.NLA_HDRLEN),
+		"NLMSG_ALIGNTO":                    reflect.ValueOf(syscall.NLMSG_ALIGNTO),
+		"NLMSG_DONE":                       reflect.ValueOf(syscall.NLMSG_DONE),
+		"NLMSG_ERROR":                      reflect.ValueOf(syscall.NLMSG_ERROR),
+		"NLMSG_HDRLEN":                     reflect.ValueOf(syscall.NLMSG_HDRLEN),
+		"NLMSG_MIN_TYPE":                   reflect.ValueOf(syscall.NLMSG_MIN_TYPE),
+		"NLMSG_NOOP":                       reflect.ValueOf(syscall.NLMSG_NOOP),
+		"NLMSG_OVERRUN":                    reflect.ValueOf(syscall.NLMSG_OVERRUN),
+		"NLM_F_ACK":                        reflect.ValueOf(syscall.NLM_F_ACK),
+		"NLM_F_APPEND":                     reflect.ValueOf(syscall.NLM_F_APPEND),
+		"NLM_F_ATOMIC":                     reflect.ValueOf(syscall.NLM_F_ATOMIC),
+		"NLM_F_CREATE":                     reflect.ValueOf(syscall.NLM_F_CREATE),
+		"NLM_F_DUMP":                       reflect.ValueOf(syscall.NLM_F_DUMP),
+		"NLM_F_DUMP_INTR":                  reflect.ValueOf(syscall.NLM_F_DUMP_INTR),
+		"NLM_F_ECHO":                       reflect.ValueOf(syscall.NLM_F_ECHO),
+		"NLM_F_EXCL":                       reflect.ValueOf(syscall.NLM_F_EXCL),
+		"NLM_F_MATCH":                      reflect.ValueOf(syscall.NLM_F_MATCH),
+		"NLM_F_MULTI":                      reflect.ValueOf(syscall.NLM_F_MULTI),
+		"NLM_F_REPLACE":                    reflect.ValueOf(syscall.NLM_F_REPLACE),
+		"NLM_F_REQUEST":                    reflect.ValueOf(syscall.NLM_F_REQUEST),
+		"NLM_F_ROOT":                       reflect.ValueOf(syscall.NLM_F_ROOT),
+		"NOFLSH":                           reflect.ValueOf(syscall.NOFLSH),
+		"Nanosleep":                        reflect.ValueOf(syscall.Nanosleep),
+		"NetlinkRIB":                       reflect.ValueOf(syscall.NetlinkRIB),
+		"NsecToTimespec":                   reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                    reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                            reflect.ValueOf(syscall.OCRNL),
+		"OFDEL":                            reflect.ValueOf(syscall.OFDEL),
+		"OFILL":                            reflect.ValueOf(syscall.OFILL),
+		"OLCUC":                            reflect.ValueOf(syscall.OLCUC),
+		"ONLCR":                            reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                           reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                            reflect.ValueOf(syscall.ONOCR),
+		"OPOST":                            reflect.ValueOf(syscall.OPOST),
+		"O_ACCMODE":                        reflect.ValueOf(syscall.O_ACCMODE),
+		"O_APPEND":                         reflect.ValueOf(syscall.O_APPEND),
+		"O_ASYNC":                          reflect.ValueOf(syscall.O_ASYNC),
+		"O_CLOEXEC":                        reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                          reflect.ValueOf(syscall.O_CREAT),
+		"O_DIRECT":                         reflect.ValueOf(syscall.O_DIRECT),
+		"O_DIRECTORY":                      reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_DSYNC":                          reflect.ValueOf(syscall.O_DSYNC),
+		"O_EXCL":                           reflect.ValueOf(syscall.O_EXCL),
+		"O_FSYNC":                          reflect.ValueOf(syscall.O_FSYNC),
+		"O_LARGEFILE":                      reflect.ValueOf(syscall.O_LARGEFILE),
+		"O_NDELAY":                         reflect.ValueOf(syscall.O_NDELAY),
+		"O_NOATIME":                        reflect.ValueOf(syscall.O_NOATIME),
+		"O_NOCTTY":                         reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NOFOLLOW":                       reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_NONBLOCK":                       reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_PATH":                           reflect.ValueOf(syscall.O_PATH),
+		"O_RDONLY":                         reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                           reflect.ValueOf(syscall.O_RDWR),
+		"O_RSYNC":                          reflect.ValueOf(syscall.O_RSYNC),
+		"O_SYNC":                           reflect.ValueOf(syscall.O_SYNC),
+		"O_TMPFILE":                        reflect.ValueOf(syscall.O_TMPFILE),
+		"O_TRUNC":                          reflect.ValueOf(syscall.O_TRUNC),
+		"O_WRONLY":                         reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                             reflect.ValueOf(syscall.Open),
+		"Openat":                           reflect.ValueOf(syscall.Openat),
+		"PACKET_ADD_MEMBERSHIP":            reflect.ValueOf(syscall.PACKET_ADD_MEMBERSHIP),
+		"PACKET_AUXDATA":                   reflect.ValueOf(syscall.PACKET_AUXDATA),
+		"PACKET_BROADCAST":                 reflect.ValueOf(syscall.PACKET_BROADCAST),
+		"PACKET_COPY_THRESH":               reflect.ValueOf(syscall.PACKET_COPY_THRESH),
+		"PACKET_DROP_MEMBERSHIP":           reflect.ValueOf(syscall.PACKET_DROP_MEMBERSHIP),
+		"PACKET_FANOUT":                    reflect.ValueOf(syscall.PACKET_FANOUT),
+		"PACKET_FANOUT_CPU":                reflect.ValueOf(syscall.PACKET_FANOUT_CPU),
+		"PACKET_FANOUT_FLAG_DEFRAG":        reflect.ValueOf(syscall.PACKET_FANOUT_FLAG_DEFRAG),
+		"PACKET_FANOUT_FLAG_ROLLOVER":      reflect.ValueOf(syscall.PACKET_FANOUT_FLAG_ROLLOVER),
+		"PACKET_FANOUT_HASH":               reflect.ValueOf(syscall.PACKET_FANOUT_HASH),
+		"PACKET_FANOUT_LB":                 reflect.ValueOf(syscall.PACKET_FANOUT_LB),
+		"PACKET_FANOUT_QM":                 reflect.ValueOf(syscall.PACKET_FANOUT_QM),
+		"PACKET_FANOUT_RND":                reflect.ValueOf(syscall.PACKET_FANOUT_RND),
+		"PACKET_FANOUT_ROLLOVER":           reflect.ValueOf(syscall.PACKET_FANOUT_ROLLOVER),
+		"PACKET_FASTROUTE":                 reflect.ValueOf(syscall.PACKET_FASTROUTE),
+		"PACKET_HDRLEN":                    reflect.ValueOf(syscall.PACKET_HDRLEN),
+		"PACKET_HOST":                      reflect.ValueOf(syscall.PACKET_HOST),
+		"PACKET_KERNEL":                    reflect.ValueOf(syscall.PACKET_KERNEL),
+		"PACKET_LOOPBACK":                  reflect.ValueOf(syscall.PACKET_LOOPBACK),
+		"PACKET_LOSS":                      reflect.ValueOf(syscall.PACKET_LOSS),
+		"PACKET_MR_ALLMULTI":               reflect.ValueOf(syscall.PACKET_MR_ALLMULTI),
+		"PACKET_MR_MULTICAST":              reflect.ValueOf(syscall.PACKET_MR_MULTICAST),
+		"PACKET_MR_PROMISC":                reflect.ValueOf(syscall.PACKET_MR_PROMISC),
+		"PACKET_MR_UNICAST":                reflect.ValueOf(syscall.PACKET_MR_UNICAST),
+		"PACKET_MULTICAST":                 reflect.ValueOf(syscall.PACKET_MULTICAST),
+		"PACKET_ORIGDEV":                   reflect.ValueOf(syscall.PACKET_ORIGDEV),
+		"PACKET_OTHERHOST":                 reflect.ValueOf(syscall.PACKET_OTHERHOST),
+		"PACKET_OUTGOING":                  reflect.ValueOf(syscall.PACKET_OUTGOING),
+		"PACKET_QDISC_BYPASS":              reflect.ValueOf(syscall.PACKET_QDISC_BYPASS),
+		"PACKET_RECV_OUTPUT":               reflect.ValueOf(syscall.PACKET_RECV_OUTPUT),
+		"PACKET_RESERVE":                   reflect.ValueOf(syscall.PACKET_RESERVE),
+		"PACKET_RX_RING":                   reflect.ValueOf(syscall.PACKET_RX_RING),
+		"PACKET_STATISTICS":                reflect.ValueOf(syscall.PACKET_STATISTICS),
+		"PACKET_TIMESTAMP":                 reflect.ValueOf(syscall.PACKET_TIMESTAMP),
+		"PACKET_TX_HAS_OFF":                reflect.ValueOf(syscall.PACKET_TX_HAS_OFF),
+		"PACKET_TX_RING":                   reflect.ValueOf(syscall.PACKET_TX_RING),
+		"PACKET_TX_TIMESTAMP":              reflect.ValueOf(syscall.PACKET_TX_TIMESTAMP),
+		"PACKET_USER":                      reflect.ValueOf(syscall.PACKET_USER),
+		"PACKET_VERSION":                   reflect.ValueOf(syscall.PACKET_VERSION),
+		"PACKET_VNET_HDR":                  reflect.ValueOf(syscall.PACKET_VNET_HDR),
+		"PARENB":                           reflect.ValueOf(syscall.PARENB),
+		"PARITY_CRC16_PR0":                 reflect.ValueOf(syscall.PARITY_CRC16_PR0),
+		"PARITY_CRC16_PR0_CCITT":           reflect.ValueOf(syscall.PARITY_CRC16_PR0_CCITT),
+		"PARITY_CRC16_PR1":                 reflect.ValueOf(syscall.PARITY_CRC16_PR1),
+		"PARITY_CRC16_PR1_CCITT":           reflect.ValueOf(syscall.PARITY_CRC16_PR1_CCITT),
+		"PARITY_CRC32_PR0_CCITT":           reflect.ValueOf(syscall.PARITY_CRC32_PR0_CCITT),
+		"PARITY_CRC32_PR1_CCITT":           reflect.ValueOf(syscall.PARITY_CRC32_PR1_CCITT),
+		"PARITY_DEFAULT":                   reflect.ValueOf(syscall.PARITY_DEFAULT),
+		"PARITY_NONE":                      reflect.ValueOf(syscall.PARITY_NONE),
+		"PARMRK":                           reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                           reflect.ValueOf(syscall.PARODD),
+		"PENDIN":                           reflect.ValueOf(syscall.PENDIN),
+		"PRIO_PGRP":                        reflect.ValueOf(syscall.PRIO_PGRP),
+		"PRIO_PROCESS":                     reflect.ValueOf(syscall.PRIO_PROCESS),
+		"PRIO_USER":                        reflect.ValueOf(syscall.PRIO_USER),
+		"PROT_EXEC":                        reflect.ValueOf(syscall.PROT_EXEC),
+		"PROT_GROWSDOWN":                   reflect.ValueOf(syscall.PROT_GROWSDOWN),
+		"PROT_GROWSUP":                     reflect.ValueOf(syscall.PROT_GROWSUP),
+		"PROT_NONE":                        reflect.ValueOf(syscall.PROT_NONE),
+		"PROT_READ":                        reflect.ValueOf(syscall.PROT_READ),
+		"PROT_WRITE":                       reflect.ValueOf(syscall.PROT_WRITE),
+		"PR_CAPBSET_DROP":                  reflect.ValueOf(syscall.PR_CAPBSET_DROP),
+		"PR_CAPBSET_READ":                  reflect.ValueOf(syscall.PR_CAPBSET_READ),
+		"PR_ENDIAN_BIG":                    reflect.ValueOf(syscall.PR_ENDIAN_BIG),
+		"PR_ENDIAN_LITTLE":                 reflect.ValueOf(syscall.PR_ENDIAN_LITTLE),
+		"PR_ENDIAN_PPC_LITTLE":             reflect.ValueOf(syscall.PR_ENDIAN_PPC_LITTLE),
+		"PR_FPEMU_NOPRINT":                 reflect.ValueOf(syscall.PR_FPEMU_NOPRINT),
+		"PR_FPEMU_SIGFPE":                  reflect.ValueOf(syscall.PR_FPEMU_SIGFPE),
+		"PR_FP_EXC_ASYNC":                  reflect.ValueOf(syscall.PR_FP_EXC_ASYNC),
+		"PR_FP_EXC_DISABLED":               reflect.ValueOf(syscall.PR_FP_EXC_DISABLED),
+		"PR_FP_EXC_DIV":                    reflect.ValueOf(syscall.PR_FP_EXC_DIV),
+		"PR_FP_EXC_INV":                    reflect.ValueOf(syscall.PR_FP_EXC_INV),
+		"PR_FP_EXC_NONRECOV":               reflect.ValueOf(syscall.PR_FP_EXC_NONRECOV),
+		"PR_FP_EXC_OVF":                    reflect.ValueOf(syscall.PR_FP_EXC_OVF),
+		"PR_FP_EXC_PRECISE":                reflect.ValueOf(syscall.PR_FP_EXC_PRECISE),
+		"PR_FP_EXC_RES":                    reflect.ValueOf(syscall.PR_FP_EXC_RES),
+		"PR_FP_EXC_SW_ENABLE":              reflect.ValueOf(syscall.PR_FP_EXC_SW_ENABLE),
+		"PR_FP_EXC_UND":                    reflect.ValueOf(syscall.PR_FP_EXC_UND),
+		"PR_FP_MODE_FR":                    reflect.ValueOf(syscall.PR_FP_MODE_FR),
+		"PR_FP_MODE_FRE":                   reflect.ValueOf(syscall.PR_FP_MODE_FRE),
+		"PR_GET_CHILD_SUBREAPER":           reflect.ValueOf(syscall.PR_GET_CHILD_SUBREAPER),
+		"PR_GET_DUMPABLE":                  reflect.ValueOf(syscall.PR_GET_DUMPABLE),
+		"PR_GET_ENDIAN":                    reflect.ValueOf(syscall.PR_GET_ENDIAN),
+		"PR_GET_FPEMU":                     reflect.ValueOf(syscall.PR_GET_FPEMU),
+		"PR_GET_FPEXC":                     reflect.ValueOf(syscall.PR_GET_FPEXC),
+		"PR_GET_FP_MODE":                   reflect.ValueOf(syscall.PR_GET_FP_MODE),
+		"PR_GET_KEEPCAPS":                  reflect.ValueOf(syscall.PR_GET_KEEPCAPS),
+		"PR_GET_NAME":                      reflect.ValueOf(syscall.PR_GET_NAME),
+		"PR_GET_NO_NEW_PRIVS":              reflect.ValueOf(syscall.PR_GET_NO_NEW_PRIVS),
+		"PR_GET_PDEATHSIG":                 reflect.ValueOf(syscall.PR_GET_PDEATHSIG),
+		"PR_GET_SECCOMP":                   reflect.ValueOf(syscall.PR_GET_SECCOMP),
+		"PR_GET_SECUREBITS":                reflect.ValueOf(syscall.PR_GET_SECUREBITS),
+		"PR_GET_THP_DISABLE":               reflect.ValueOf(syscall.PR_GET_THP_DISABLE),
+		"PR_GET_TID_ADDRESS":               reflect.ValueOf(syscall.PR_GET_TID_ADDRESS),
+		"PR_GET_TIMERSLACK":                reflect.ValueOf(syscall.PR_GET_TIMERSLACK),
+		"PR_GET_TIMING":                    reflect.ValueOf(syscall.PR_GET_TIMING),
+		"PR_GET_TSC":                       reflect.ValueOf(syscall.PR_GET_TSC),
+		"PR_GET_UNALIGN":                   reflect.ValueOf(syscall.PR_GET_UNALIGN),
+		"PR_MCE_KILL":                      reflect.ValueOf(syscall.PR_MCE_KILL),
+		"PR_MCE_KILL_CLEAR":                reflect.ValueOf(syscall.PR_MCE_KILL_CLEAR),
+		"PR_MCE_KILL_DEFAULT":              reflect.ValueOf(syscall.PR_MCE_KILL_DEFAULT),
+		"PR_MCE_KILL_EARLY":                reflect.ValueOf(syscall.PR_MCE_KILL_EARLY),
+		"PR_MCE_KILL_GET":                  reflect.ValueOf(syscall.PR_MCE_KILL_GET),
+		"PR_MCE_KILL_LATE":                 reflect.ValueOf(syscall.PR_MCE_KILL_LATE),
+		"PR_MCE_KILL_SET":                  reflect.ValueOf(syscall.PR_MCE_KILL_SET),
+		"PR_MPX_DISABLE_MANAGEMENT":        reflect.ValueOf(syscall.PR_MPX_DISABLE_MANAGEMENT),
+		"PR_MPX_ENABLE_MANAGEMENT":         reflect.ValueOf(syscall.PR_MPX_ENABLE_MANAGEMENT),
+		"PR_SET_CHILD_SUBREAPER":           reflect.ValueOf(syscall.PR_SET_CHILD_SUBREAPER),
+		"PR_SET_DUMPABLE":                  reflect.ValueOf(syscall.PR_SET_DUMPABLE),
+		"PR_SET_ENDIAN":                    reflect.ValueOf(syscall.PR_SET_ENDIAN),
+		"PR_SET_FPEMU":                     reflect.ValueOf(syscall.PR_SET_FPEMU),
+		"PR_SET_FPEXC":                     reflect.ValueOf(syscall.PR_SET_FPEXC),
+		"PR_SET_FP_MODE":                   reflect.ValueOf(syscall.PR_SET_FP_MODE),
+		"PR_SET_KEEPCAPS":                  reflect.ValueOf(syscall.PR_SET_KEEPCAPS),
+		"PR_SET_MM":                        reflect.ValueOf(syscall.PR_SET_MM),
+		"PR_SET_MM_ARG_END":                reflect.ValueOf(syscall.PR_SET_MM_ARG_END),
+		"PR_SET_MM_ARG_START":              reflect.ValueOf(syscall.PR_SET_MM_ARG_START),
+		"PR_SET_MM_AUXV":                   reflect.ValueOf(syscall.PR_SET_MM_AUXV),
+		"PR_SET_MM_BRK":                    reflect.ValueOf(syscall.PR_SET_MM_BRK),
+		"PR_SET_MM_END_CODE":               reflect.ValueOf(syscall.PR_SET_MM_END_CODE),
+		"PR_SET_MM_END_DATA":               reflect.ValueOf(syscall.PR_SET_MM_END_DATA),
+		"PR_SET_MM_ENV_END":                reflect.ValueOf(syscall.PR_SET_MM_ENV_END),
+		"PR_SET_MM_ENV_START":              reflect.ValueOf(syscall.PR_SET_MM_ENV_START),
+		"PR_SET_MM_EXE_FILE":               reflect.ValueOf(syscall.PR_SET_MM_EXE_FILE),
+		"PR_SET_MM_MAP":                    reflect.ValueOf(syscall.PR_SET_MM_MAP),
+		"PR_SET_MM_MAP_SIZE":               reflect.ValueOf(syscall.PR_SET_MM_MAP_SIZE),
+		"PR_SET_MM_START_BRK":              reflect.ValueOf(syscall.PR_SET_MM_START_BRK),
+		"PR_SET_MM_START_CODE":             reflect.ValueOf(syscall.PR_SET_MM_START_CODE),
+		"PR_SET_MM_START_DATA":             reflect.ValueOf(syscall.PR_SET_MM_START_DATA),
+		"PR_SET_MM_START_STACK":            reflect.ValueOf(syscall.PR_SET_MM_START_STACK),
+		"PR_SET_NAME":                      reflect.ValueOf(syscall.PR_SET_NAME),
+		"PR_SET_NO_NEW_PRIVS":              reflect.ValueOf(syscall.PR_SET_NO_NEW_PRIVS),
+		"PR_SET_PDEATHSIG":                 reflect.ValueOf(syscall.PR_SET_PDEATHSIG),
+		"PR_SET_PTRACER":                   reflect.ValueOf(syscall.PR_SET_PTRACER),
+		"PR_SET_PTRACER_ANY":               reflect.ValueOf(syscall.PR_SET_PTRACER_ANY),
+		"PR_SET_SECCOMP":                   reflect.ValueOf(syscall.PR_SET_SECCOMP),
+		"PR_SET_SECUREBITS":                reflect.ValueOf(syscall.PR_SET_SECUREBITS),
+		"PR_SET_THP_DISABLE":               reflect.ValueOf(syscall.PR_SET_THP_DISABLE),
+		"PR_SET_TIMERSLACK":                reflect.ValueOf(syscall.PR_SET_TIMERSLACK),
+		"PR_SET_TIMING":                    reflect.ValueOf(syscall.PR_SET_TIMING),
+		"PR_SET_TSC":                       reflect.ValueOf(syscall.PR_SET_TSC),
+		"PR_SET_UNALIGN":                   reflect.ValueOf(syscall.PR_SET_UNALIGN),
+		"PR_TASK_PERF_EVENTS_DISABLE":      reflect.ValueOf(syscall.PR_TASK_PERF_EVENTS_DISABLE),
+		"PR_TASK_PERF_EVENTS_ENABLE":       reflect.ValueOf(syscall.PR_TASK_PERF_EVENTS_ENABLE),
+		"PR_TIMING_STATISTICAL":            reflect.ValueOf(syscall.PR_TIMING_STATISTICAL),
+		"PR_TIMING_TIMESTAMP":              reflect.ValueOf(syscall.PR_TIMING_TIMESTAMP),
+		"PR_TSC_ENABLE":                    reflect.ValueOf(syscall.PR_TSC_ENABLE),
+		"PR_TSC_SIGSEGV":                   reflect.ValueOf(syscall.PR_TSC_SIGSEGV),
+		"PR_UNALIGN_NOPRINT":               reflect.ValueOf(syscall.PR_UNALIGN_NOPRINT),
+		"PR_UNALIGN_SIGBUS":                reflect.ValueOf(syscall.PR_UNALIGN_SIGBUS),
+		"PTRACE_ATTACH":                    reflect.ValueOf(syscall.PTRACE_ATTACH),
+		"PTRACE_CONT":                      reflect.ValueOf(syscall.PTRACE_CONT),
+		"PTRACE_DETACH":                    reflect.ValueOf(syscall.PTRACE_DETACH),
+		"PTRACE_EVENT_CLONE":               reflect.ValueOf(syscall.PTRACE_EVENT_CLONE),
+		"PTRACE_EVENT_EXEC":                reflect.ValueOf(syscall.PTRACE_EVENT_EXEC),
+		"PTRACE_EVENT_EXIT":                reflect.ValueOf(syscall.PTRACE_EVENT_EXIT),
+		"PTRACE_EVENT_FORK":                reflect.ValueOf(syscall.PTRACE_EVENT_FORK),
+		"PTRACE_EVENT_SECCOMP":             reflect.ValueOf(syscall.PTRACE_EVENT_SECCOMP),
+		"PTRACE_EVENT_STOP":                reflect.ValueOf(syscall.PTRACE_EVENT_STOP),
+		"PTRACE_EVENT_VFORK":               reflect.ValueOf(syscall.PTRACE_EVENT_VFORK),
+		"PTRACE_EVENT_VFORK_DONE":          reflect.ValueOf(syscall.PTRACE_EVENT_VFORK_DONE),
+		"PTRACE_GETEVENTMSG":               reflect.ValueOf(syscall.PTRACE_GETEVENTMSG),
+		"PTRACE_GETREGS":                   reflect.ValueOf(syscall.PTRACE_GETREGS),
+		"PTRACE_GETREGSET":                 reflect.ValueOf(syscall.PTRACE_GETREGSET),
+		"PTRACE_GETSIGINFO":                reflect.ValueOf(syscall.PTRACE_GETSIGINFO),
+		"PTRACE_GETSIGMASK":                reflect.ValueOf(syscall.PTRACE_GETSIGMASK),
+		"PTRACE_INTERRUPT":                 reflect.ValueOf(syscall.PTRACE_INTERRUPT),
+		"PTRACE_KILL":                      reflect.ValueOf(syscall.PTRACE_KILL),
+		"PTRACE_LISTEN":                    reflect.ValueOf(syscall.PTRACE_LISTEN),
+		"PTRACE_O_EXITKILL":                reflect.ValueOf(syscall.PTRACE_O_EXITKILL),
+		"PTRACE_O_MASK":                    reflect.ValueOf(syscall.PTRACE_O_MASK),
+		"PTRACE_O_TRACECLONE":              reflect.ValueOf(syscall.PTRACE_O_TRACECLONE),
+		"PTRACE_O_TRACEEXEC":               reflect.ValueOf(syscall.PTRACE_O_TRACEEXEC),
+		"PTRACE_O_TRACEEXIT":               reflect.ValueOf(syscall.PTRACE_O_TRACEEXIT),
+		"PTRACE_O_TRACEFORK":               reflect.ValueOf(syscall.PTRACE_O_TRACEFORK),
+		"PTRACE_O_TRACESECCOMP":            reflect.ValueOf(syscall.PTRACE_O_TRACESECCOMP),
+		"PTRACE_O_TRACESYSGOOD":            reflect.ValueOf(syscall.PTRACE_O_TRACESYSGOOD),
+		"PTRACE_O_TRACEVFORK":              reflect.ValueOf(syscall.PTRACE_O_TRACEVFORK),
+		"PTRACE_O_TRACEVFORKDONE":          reflect.ValueOf(syscall.PTRACE_O_TRACEVFORKDONE),
+		"PTRACE_PEEKDATA":                  reflect.ValueOf(syscall.PTRACE_PEEKDATA),
+		"PTRACE_PEEKSIGINFO":               reflect.ValueOf(syscall.PTRACE_PEEKSIGINFO),
+		"PTRACE_PEEKSIGINFO_SHARED":        reflect.ValueOf(syscall.PTRACE_PEEKSIGINFO_SHARED),
+		"PTRACE_PEEKTEXT":                  reflect.ValueOf(syscall.PTRACE_PEEKTEXT),
+		"PTRACE_PEEKUSR":                   reflect.ValueOf(syscall.PTRACE_PEEKUSR),
+		"PTRACE_POKEDATA":                  reflect.ValueOf(syscall.PTRACE_POKEDATA),
+		"PTRACE_POKETEXT":                  reflect.ValueOf(syscall.PTRACE_POKETEXT),
+		"PTRACE_POKEUSR":                   reflect.ValueOf(syscall.PTRACE_POKEUSR),
+		"PTRACE_SEIZE":                     reflect.ValueOf(syscall.PTRACE_SEIZE),
+		"PTRACE_SETOPTIONS":                reflect.ValueOf(syscall.PTRACE_SETOPTIONS),
+		"PTRACE_SETREGS":                   reflect.ValueOf(syscall.PTRACE_SETREGS),
+		"PTRACE_SETREGSET":                 reflect.ValueOf(syscall.PTRACE_SETREGSET),
+		"PTRACE_SETSIGINFO":                reflect.ValueOf(syscall.PTRACE_SETSIGINFO),
+		"PTRACE_SETSIGMASK":                reflect.ValueOf(syscall.PTRACE_SETSIGMASK),
+		"PTRACE_SINGLESTEP":                reflect.ValueOf(syscall.PTRACE_SINGLESTEP),
+		"PTRACE_SYSCALL":                   reflect.ValueOf(syscall.PTRACE_SYSCALL),
+		"PTRACE_TRACEME":                   reflect.ValueOf(syscall.PTRACE_TRACEME),
+		"ParseDirent":                      reflect.ValueOf(syscall.ParseDirent),
+		"ParseNetlinkMessage":              reflect.ValueOf(syscall.ParseNetlinkMessage),
+		"ParseNetlinkRouteAttr":            reflect.ValueOf(syscall.ParseNetlinkRouteAttr),
+		"ParseSocketControlMessage":        reflect.ValueOf(syscall.ParseSocketControlMessage),
+		"ParseUnixCredentials":             reflect.ValueOf(syscall.ParseUnixCredentials),
+		"ParseUnixRights":                  reflect.ValueOf(syscall.ParseUnixRights),
+		"PathMax":                          reflect.ValueOf(syscall.PathMax),
+		"Pause":                            reflect.ValueOf(syscall.Pause),
+		"Pipe":                             reflect.ValueOf(syscall.Pipe),
+		"Pipe2":                            reflect.ValueOf(syscall.Pipe2),
+		"PivotRoot":                        reflect.ValueOf(syscall.PivotRoot),
+		"Pread":                            reflect.ValueOf(syscall.Pread),
+		"PtraceAttach":                     reflect.ValueOf(syscall.PtraceAttach),
+		"PtraceCont":                       reflect.ValueOf(syscall.PtraceCont),
+		"PtraceDetach":                     reflect.ValueOf(syscall.PtraceDetach),
+		"PtraceGetEventMsg":                reflect.ValueOf(syscall.PtraceGetEventMsg),
+		"PtraceGetRegs":                    reflect.ValueOf(syscall.PtraceGetRegs),
+		"PtracePeekData":                   reflect.ValueOf(syscall.PtracePeekData),
+		"PtracePeekText":                   reflect.ValueOf(syscall.PtracePeekText),
+		"PtracePokeData":                   reflect.ValueOf(syscall.PtracePokeData),
+		"PtracePokeText":                   reflect.ValueOf(syscall.PtracePokeText),
+		"PtraceSetOptions":                 reflect.ValueOf(syscall.PtraceSetOptions),
+		"PtraceSetRegs":                    reflect.ValueOf(syscall.PtraceSetRegs),
+		"PtraceSingleStep":                 reflect.ValueOf(syscall.PtraceSingleStep),
+		"PtraceSyscall":                    reflect.ValueOf(syscall.PtraceSyscall),
+		"Pwrite":                           reflect.ValueOf(syscall.Pwrite),
+		"RLIMIT_AS":                        reflect.ValueOf(syscall.RLIMIT_AS),
+		"RLIMIT_CORE":                      reflect.ValueOf(syscall.RLIMIT_CORE),
+		"RLIMIT_CPU":                       reflect.ValueOf(syscall.RLIMIT_CPU),
+		"RLIMIT_DATA":                      reflect.ValueOf(syscall.RLIMIT_DATA),
+		"RLIMIT_FSIZE":                     reflect.ValueOf(syscall.RLIMIT_FSIZE),
+		"RLIMIT_NOFILE":                    reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RLIMIT_STACK":                     reflect.ValueOf(syscall.RLIMIT_STACK),
+		"RLIM_INFINITY":                    reflect.ValueOf(syscall.RLIM_INFINITY),
+		"RTAX_ADVMSS":                      reflect.ValueOf(syscall.RTAX_ADVMSS),
+		"RTAX_CC_ALGO":                     reflect.ValueOf(syscall.RTAX_CC_ALGO),
+		"RTAX_CWND":                        reflect.ValueOf(syscall.RTAX_CWND),
+		"RTAX_FEATURES":                    reflect.ValueOf(syscall.RTAX_FEATURES),
+		"RTAX_FEATURE_ALLFRAG":             reflect.ValueOf(syscall.RTAX_FEATURE_ALLFRAG),
+		"RTAX_FEATURE_ECN":                 reflect.ValueOf(syscall.RTAX_FEATURE_ECN),
+		"RTAX_FEATURE_SACK":                reflect.ValueOf(syscall.RTAX_FEATURE_SACK),
+		"RTAX_FEATURE_TIMESTAMP":           reflect.ValueOf(syscall.RTAX_FEATURE_TIMESTAMP),
+		"RTAX_HOPLIMIT":                    reflect.ValueOf(syscall.RTAX_HOPLIMIT),
+		"RTAX_INITCWND":                    reflect.ValueOf(syscall.RTAX_INITCWND),
+		"RTAX_INITRWND":                    reflect.ValueOf(syscall.RTAX_INITRWND),
+		"RTAX_LOCK":                        reflect.ValueOf(syscall.RTAX_LOCK),
+		"RTAX_MAX":                         reflect.ValueOf(syscall.RTAX_MAX),
+		"RTAX_MTU":                         reflect.ValueOf(syscall.RTAX_MTU),
+		"RTAX_QUICKACK":                    reflect.ValueOf(syscall.RTAX_QUICKACK),
+		"RTAX_REORDERING":                  reflect.ValueOf(syscall.RTAX_REORDERING),
+		"RTAX_RTO_MIN":                     reflect.ValueOf(syscall.RTAX_RTO_MIN),
+		"RTAX_RTT":                         reflect.ValueOf(syscall.RTAX_RTT),
+		"RTAX_RTTVAR":                      reflect.ValueOf(syscall.RTAX_RTTVAR),
+		"RTAX_SSTHRESH":                    reflect.ValueOf(syscall.RTAX_SSTHRESH),
+		"RTAX_UNSPEC":                      reflect.ValueOf(syscall.RTAX_UNSPEC),
+		"RTAX_WINDOW":                      reflect.ValueOf(syscall.RTAX_WINDOW),
+		"RTA_ALIGNTO":                      reflect.ValueOf(syscall.RTA_ALIGNTO),
+		"RTA_CACHEINFO":                    reflect.ValueOf(syscall.RTA_CACHEINFO),
+		"RTA_DST":                          reflect.ValueOf(syscall.RTA_DST),
+		"RTA_FLOW":                         reflect.ValueOf(syscall.RTA_FLOW),
+		"RTA_GATEWAY":                      reflect.ValueOf(syscall.RTA_GATEWAY),
+		"RTA_IIF":                          reflect.ValueOf(syscall.RTA_IIF),
+		"RTA_MAX":                          reflect.ValueOf(syscall.RTA_MAX),
+		"RTA_METRICS":                      reflect.ValueOf(syscall.RTA_METRICS),
+		"RTA_MULTIPATH":                    reflect.ValueOf(syscall.RTA_MULTIPATH),
+		"RTA_OIF":                          reflect.ValueOf(syscall.RTA_OIF),
+		"RTA_PREFSRC":                      reflect.ValueOf(syscall.RTA_PREFSRC),
+		"RTA_PRIORITY":                     reflect.ValueOf(syscall.RTA_PRIORITY),
+		"RTA_SRC":                          reflect.ValueOf(syscall.RTA_SRC),
+		"RTA_TABLE":                        reflect.ValueOf(syscall.RTA_TABLE),
+		"RTA_UNSPEC":                       reflect.ValueOf(syscall.RTA_UNSPEC),
+		"RTCF_DIRECTSRC":                   reflect.ValueOf(syscall.RTCF_DIRECTSRC),
+		"RTCF_DOREDIRECT":                  reflect.ValueOf(syscall.RTCF_DOREDIRECT),
+		"RTCF_LOG":                         reflect.ValueOf(syscall.RTCF_LOG),
+		"RTCF_MASQ":                        reflect.ValueOf(syscall.RTCF_MASQ),
+		"RTCF_NAT":                         reflect.ValueOf(syscall.RTCF_NAT),
+		"RTCF_VALVE":                       reflect.ValueOf(syscall.RTCF_VALVE),
+		"RTF_ADDRCLASSMASK":                reflect.ValueOf(uint32(syscall.RTF_ADDRCLASSMASK)),
+		"RTF_ADDRCONF":                     reflect.ValueOf(syscall.RTF_ADDRCONF),
+		"RTF_ALLONLINK":                    reflect.ValueOf(syscall.RTF_ALLONLINK),
+		"RTF_BROADCAST":                    reflect.ValueOf(syscall.RTF_BROADCAST),
+		"RTF_CACHE":                        reflect.ValueOf(syscall.RTF_CACHE),
+		"RTF_DEFAULT":                      reflect.ValueOf(syscall.RTF_DEFAULT),
+		"RTF_DYNAMIC":                      reflect.ValueOf(syscall.RTF_DYNAMIC),
+		"RTF_FLOW":                         reflect.ValueOf(syscall.RTF_FLOW),
+		"RTF_GATEWAY":                      reflect.ValueOf(syscall.RTF_GATEWAY),
+		"RTF_HOST":                         reflect.ValueOf(syscall.RTF_HOST),
+		"RTF_INTERFACE":                    reflect.ValueOf(syscall.RTF_INTERFACE),
+		"RTF_IRTT":                         reflect.ValueOf(syscall.RTF_IRTT),
+		"RTF_LINKRT":                       reflect.ValueOf(syscall.RTF_LINKRT),
+		"RTF_LOCAL":                        reflect.ValueOf(uint32(syscall.RTF_LOCAL)),
+		"RTF_MODIFIED":                     reflect.ValueOf(syscall.RTF_MODIFIED),
+		"RTF_MSS":                          reflect.ValueOf(syscall.RTF_MSS),
+		"RTF_MTU":                          reflect.ValueOf(syscall.RTF_MTU),
+		"RTF_MULTICAST":                    reflect.ValueOf(syscall.RTF_MULTICAST),
+		"RTF_NAT":                          reflect.ValueOf(syscall.RTF_NAT),
+		"RTF_NOFORWARD":                    reflect.ValueOf(syscall.RTF_NOFORWARD),
+		"RTF_NONEXTHOP":                    reflect.ValueOf(syscall.RTF_NONEXTHOP),
+		"RTF_NOPMTUDISC":                   reflect.ValueOf(syscall.RTF_NOPMTUDISC),
+		"RTF_POLICY":                       reflect.ValueOf(syscall.RTF_POLICY),
+		"RTF_REINSTATE":                    reflect.ValueOf(syscall.RTF_REINSTATE),
+		"RTF_REJECT":                       reflect.ValueOf(syscall.RTF_REJECT),
+		"RTF_STATIC":                       reflect.ValueOf(syscall.RTF_STATIC),
+		"RTF_THROW":                        reflect.ValueOf(syscall.RTF_THROW),
+		"RTF_UP":                           reflect.ValueOf(syscall.RTF_UP),
+		"RTF_WINDOW":                       reflect.ValueOf(syscall.RTF_WINDOW),
+		"RTF_XRESOLVE":                     reflect.ValueOf(syscall.RTF_XRESOLVE),
+		"RTM_BASE":                         reflect.ValueOf(syscall.RTM_BASE),
+		"RTM_DELACTION":                    reflect.ValueOf(syscall.RTM_DELACTION),
+		"RTM_DELADDR":                      reflect.ValueOf(syscall.RTM_DELADDR),
+		"RTM_DELADDRLABEL":                 reflect.ValueOf(syscall.RTM_DELADDRLABEL),
+		"RTM_DELLINK":                      reflect.ValueOf(syscall.RTM_DELLINK),
+		"RTM_DELMDB":                       reflect.ValueOf(syscall.RTM_DELMDB),
+		"RTM_DELNEIGH":                     reflect.ValueOf(syscall.RTM_DELNEIGH),
+		"RTM_DELNSID":                      reflect.ValueOf(syscall.RTM_DELNSID),
+		"RTM_DELQDISC":                     reflect.ValueOf(syscall.RTM_DELQDISC),
+		"RTM_DELROUTE":                     reflect.ValueOf(syscall.RTM_DELROUTE),
+		"RTM_DELRULE":                      reflect.ValueOf(syscall.RTM_DELRULE),
+		"RTM_DELTCLASS":                    reflect.ValueOf(syscall.RTM_DELTCLASS),
+		"RTM_DELTFILTER":                   reflect.ValueOf(syscall.RTM_DELTFILTER),
+		"RTM_F_CLONED":                     reflect.ValueOf(syscall.RTM_F_CLONED),
+		"RTM_F_EQUALIZE":                   reflect.ValueOf(syscall.RTM_F_EQUALIZE),
+		"RTM_F_NOTIFY":                     reflect.ValueOf(syscall.RTM_F_NOTIFY),
+		"RTM_F_PREFIX":                     reflect.ValueOf(syscall.RTM_F_PREFIX),
+		"RTM_GETACTION":                    reflect.ValueOf(syscall.RTM_GETACTION),
+		"RTM_GETADDR":                      reflect.ValueOf(syscall.RTM_GETADDR),
+		"RTM_GETADDRLABEL":                 reflect.ValueOf(syscall.RTM_GETADDRLABEL),
+		"RTM_GETANYCAST":                   reflect.ValueOf(syscall.RTM_GETANYCAST),
+		"RTM_GETDCB":                       reflect.ValueOf(syscall.RTM_GETDCB),
+		"RTM_GETLINK":                      reflect.ValueOf(syscall.RTM_GETLINK),
+		"RTM_GETMDB":                       reflect.ValueOf(syscall.RTM_GETMDB),
+		"RTM_GETMULTICAST":                 reflect.ValueOf(syscall.RTM_GETMULTICAST),
+		"RTM_GETNEIGH":                     reflect.ValueOf(syscall.RTM_GETNEIGH),
+		"RTM_GETNEIGHTBL":                  reflect.ValueOf(syscall.RTM_GETNEIGHTBL),
+		"RTM_GETNETCONF":                   reflect.ValueOf(syscall.RTM_GETNETCONF),
+		"RTM_GETNSID":                      reflect.ValueOf(syscall.RTM_GETNSID),
+		"RTM_GETQDISC":                     reflect.ValueOf(syscall.RTM_GETQDISC),
+		"RTM_GETROUTE":                     reflect.ValueOf(syscall.RTM_GETROUTE),
+		"RTM_GETRULE":                      reflect.ValueOf(syscall.RTM_GETRULE),
+		"RTM_GETTCLASS":                    reflect.ValueOf(syscall.RTM_GETTCLASS),
+		"RTM_GETTFILTER":                   reflect.ValueOf(syscall.RTM_GETTFILTER),
+		"RTM_MAX":                          reflect.ValueOf(syscall.RTM_MAX),
+		"RTM_NEWACTION":                    reflect.ValueOf(syscall.RTM_NEWACTION),
+		"RTM_NEWADDR":                      reflect.ValueOf(syscall.RTM_NEWADDR),
+		"RTM_NEWADDRLABEL":                 reflect.ValueOf(syscall.RTM_NEWADDRLABEL),
+		"RTM_NEWLINK":                      reflect.ValueOf(syscall.RTM_NEWLINK),
+		"RTM_NEWMDB":                       reflect.ValueOf(syscall.RTM_NEWMDB),
+		"RTM_NEWNDUSEROPT":                 reflect.ValueOf(syscall.RTM_NEWNDUSEROPT),
+		"RTM_NEWNEIGH":                     reflect.ValueOf(syscall.RTM_NEWNEIGH),
+		"RTM_NEWNEIGHTBL":                  reflect.ValueOf(syscall.RTM_NEWNEIGHTBL),
+		"RTM_NEWNETCONF":                   reflect.ValueOf(syscall.RTM_NEWNETCONF),
+		"RTM_NEWNSID":                      reflect.ValueOf(syscall.RTM_NEWNSID),
+		"RTM_NEWPREFIX":                    reflect.ValueOf(syscall.RTM_NEWPREFIX),
+		"RTM_NEWQDISC":                     reflect.ValueOf(syscall.RTM_NEWQDISC),
+		"RTM_NEWROUTE":                     reflect.ValueOf(syscall.RTM_NEWROUTE),
+		"RTM_NEWRULE":                      reflect.ValueOf(syscall.RTM_NEWRULE),
+		"RTM_NEWTCLASS":                    reflect.ValueOf(syscall.RTM_NEWTCLASS),
+		"RTM_NEWTFILTER":                   reflect.ValueOf(syscall.RTM_NEWTFILTER),
+		"RTM_NR_FAMILIES":                  reflect.ValueOf(syscall.RTM_NR_FAMILIES),
+		"RTM_NR_MSGTYPES":                  reflect.ValueOf(syscall.RTM_NR_MSGTYPES),
+		"RTM_SETDCB":                       reflect.ValueOf(syscall.RTM_SETDCB),
+		"RTM_SETLINK":                      reflect.ValueOf(syscall.RTM_SETLINK),
+		"RTM_SETNEIGHTBL":                  reflect.ValueOf(syscall.RTM_SETNEIGHTBL),
+		"RTNH_ALIGNTO":                     reflect.ValueOf(syscall.RTNH_ALIGNTO),
+		"RTNH_F_DEAD":                      reflect.ValueOf(syscall.RTNH_F_DEAD),
+		"RTNH_F_OFFLOAD":                   reflect.ValueOf(syscall.RTNH_F_OFFLOAD),
+		"RTNH_F_ONLINK":                    reflect.ValueOf(syscall.RTNH_F_ONLINK),
+		"RTNH_F_PERVASIVE":                 reflect.ValueOf(syscall.RTNH_F_PERVASIVE),
+		"RTNLGRP_IPV4_IFADDR":              reflect.ValueOf(syscall.RTNLGRP_IPV4_IFADDR),
+		"RTNLGRP_IPV4_MROUTE":              reflect.ValueOf(syscall.RTNLGRP_IPV4_MROUTE),
+		"RTNLGRP_IPV4_ROUTE":               reflect.ValueOf(syscall.RTNLGRP_IPV4_ROUTE),
+		"RTNLGRP_IPV4_RULE":                reflect.ValueOf(syscall.RTNLGRP_IPV4_RULE),
+		"RTNLGRP_IPV6_IFADDR":              reflect.ValueOf(syscall.RTNLGRP_IPV6_IFADDR),
+		"RTNLGRP_IPV6_IFINFO":              reflect.ValueOf(syscall.RTNLGRP_IPV6_IFINFO),
+		"RTNLGRP_IPV6_MROUTE":              reflect.ValueOf(syscall.RTNLGRP_IPV6_MROUTE),
+		"RTNLGRP_IPV6_PREFIX":              reflect.ValueOf(syscall.RTNLGRP_IPV6_PREFIX),
+		"RTNLGRP_IPV6_ROUTE":               reflect.ValueOf(syscall.RTNLGRP_IPV6_ROUTE),
+		"RTNLGRP_IPV6_RULE":                reflect.ValueOf(syscall.RTNLGRP_IPV6_RULE),
+		"RTNLGRP_LINK":                     reflect.ValueOf(syscall.RTNLGRP_LINK),
+		"RTNLGRP_ND_USEROPT":               reflect.ValueOf(syscall.RTNLGRP_ND_USEROPT),
+		"RTNLGRP_NEIGH":                    reflect.ValueOf(syscall.RTNLGRP_NEIGH),
+		"RTNLGRP_NONE":                     reflect.ValueOf(syscall.RTNLGRP_NONE),
+		"RTNLGRP_NOTIFY":                   reflect.ValueOf(syscall.RTNLGRP_NOTIFY),
+		"RTNLGRP_TC":                       reflect.ValueOf(syscall.RTNLGRP_TC),
+		"RTN_ANYCAST":                      reflect.ValueOf(syscall.RTN_ANYCAST),
+		"RTN_BLACKHOLE":                    reflect.ValueOf(syscall.RTN_BLACKHOLE),
+		"RTN_BROADCAST":                    reflect.ValueOf(syscall.RTN_BROADCAST),
+		"RTN_LOCAL":                        reflect.ValueOf(syscall.RTN_LOCAL),
+		"RTN_MAX":                          reflect.ValueOf(syscall.RTN_MAX),
+		"RTN_MULTICAST":                    reflect.ValueOf(syscall.RTN_MULTICAST),
+		"RTN_NAT":                          reflect.ValueOf(syscall.RTN_NAT),
+		"RTN_PROHIBIT":                     reflect.ValueOf(syscall.RTN_PROHIBIT),
+		"RTN_THROW":                        reflect.ValueOf(syscall.RTN_THROW),
+		"RTN_UNICAST":                      reflect.ValueOf(syscall.RTN_UNICAST),
+		"RTN_UNREACHABLE":                  reflect.ValueOf(syscall.RTN_UNREACHABLE),
+		"RTN_UNSPEC":                       reflect.ValueOf(syscall.RTN_UNSPEC),
+		"RTN_XRESOLVE":                     reflect.ValueOf(syscall.RTN_XRESOLVE),
+		"RTPROT_BABEL":                     reflect.ValueOf(syscall.RTPROT_BABEL),
+		"RTPROT_BIRD":                      reflect.ValueOf(syscall.RTPROT_BIRD),
+		"RTPROT_BOOT":                      reflect.ValueOf(syscall.RTPROT_BOOT),
+		"RTPROT_DHCP":                      reflect.ValueOf(syscall.RTPROT_DHCP),
+		"RTPROT_DNROUTED":                  reflect.ValueOf(syscall.RTPROT_DNROUTED),
+		"RTPROT_GATED":                     reflect.ValueOf(syscall.RTPROT_GATED),
+		"RTPROT_KERNEL":                    reflect.ValueOf(syscall.RTPROT_KERNEL),
+		"RTPROT_MROUTED":                   reflect.ValueOf(syscall.RTPROT_MROUTED),
+		"RTPROT_MRT":                       reflect.ValueOf(syscall.RTPROT_MRT),
+		"RTPROT_NTK":                       reflect.ValueOf(syscall.RTPROT_NTK),
+		"RTPROT_RA":                        reflect.ValueOf(syscall.RTPROT_RA),
+		"RTPROT_REDIRECT":                  reflect.ValueOf(syscall.RTPROT_REDIRECT),
+		"RTPROT_STATIC":                    reflect.ValueOf(syscall.RTPROT_STATIC),
+		"RTPROT_UNSPEC":                    reflect.ValueOf(syscall.RTPROT_UNSPEC),
+		"RTPROT_XORP":                      reflect.ValueOf(syscall.RTPROT_XORP),
+		"RTPROT_ZEBRA":                     reflect.ValueOf(syscall.RTPROT_ZEBRA),
+		"RT_CLASS_DEFAULT":                 reflect.ValueOf(syscall.RT_CLASS_DEFAULT),
+		"RT_CLASS_LOCAL":                   reflect.ValueOf(syscall.RT_CLASS_LOCAL),
+		"RT_CLASS_MAIN":                    reflect.ValueOf(syscall.RT_CLASS_MAIN),
+		"RT_CLASS_MAX":                     reflect.ValueOf(syscall.RT_CLASS_MAX),
+		"RT_CLASS_UNSPEC":                  reflect.ValueOf(syscall.RT_CLASS_UNSPEC),
+		"RT_SCOPE_HOST":                    reflect.ValueOf(syscall.RT_SCOPE_HOST),
+		"RT_SCOPE_LINK":                    reflect.ValueOf(syscall.RT_SCOPE_LINK),
+		"RT_SCOPE_NOWHERE":                 reflect.ValueOf(syscall.RT_SCOPE_NOWHERE),
+		"RT_SCOPE_SITE":                    reflect.ValueOf(syscall.RT_SCOPE_SITE),
+		"RT_SCOPE_UNIVERSE":                reflect.ValueOf(syscall.RT_SCOPE_UNIVERSE),
+		"RT_TABLE_COMPAT":                  reflect.ValueOf(syscall.RT_TABLE_COMPAT),
+		"RT_TABLE_DEFAULT":                 reflect.ValueOf(syscall.RT_TABLE_DEFAULT),
+		"RT_TABLE_LOCAL":                   reflect.ValueOf(syscall.RT_TABLE_LOCAL),
+		"RT_TABLE_MAIN":                    reflect.ValueOf(syscall.RT_TABLE_MAIN),
+		"RT_TABLE_MAX":                     reflect.ValueOf(uint32(syscall.RT_TABLE_MAX)),
+		"RT_TABLE_UNSPEC":                  reflect.ValueOf(syscall.RT_TABLE_UNSPEC),
+		"RUSAGE_CHILDREN":                  reflect.ValueOf(syscall.RUSAGE_CHILDREN),
+		"RUSAGE_SELF":                      reflect.ValueOf(syscall.RUSAGE_SELF),
+		"RUSAGE_THREAD":                    reflect.ValueOf(syscall.RUSAGE_THREAD),
+		"RawSyscall":                       reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                      reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                             reflect.ValueOf(syscall.Read),
+		"ReadDirent":                       reflect.ValueOf(syscall.ReadDirent),
+		"Readlink":                         reflect.ValueOf(syscall.Readlink),
+		"Reboot":                           reflect.ValueOf(syscall.Reboot),
+		"Recvfrom":                         reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                          reflect.ValueOf(syscall.Recvmsg),
+		"Removexattr":                      reflect.ValueOf(syscall.Removexattr),
+		"Rename":                           reflect.ValueOf(syscall.Rename),
+		"Renameat":                         reflect.ValueOf(syscall.Renameat),
+		"Rmdir":                            reflect.ValueOf(syscall.Rmdir),
+		"SCM_CREDENTIALS":                  reflect.ValueOf(syscall.SCM_CREDENTIALS),
+		"SCM_RIGHTS":                       reflect.ValueOf(syscall.SCM_RIGHTS),
+		"SCM_TIMESTAMP":                    reflect.ValueOf(syscall.SCM_TIMESTAMP),
+		"SCM_TIMESTAMPING":                 reflect.ValueOf(syscall.SCM_TIMESTAMPING),
+		"SCM_TIMESTAMPNS":                  reflect.ValueOf(syscall.SCM_TIMESTAMPNS),
+		"SCM_WIFI_STATUS":                  reflect.ValueOf(syscall.SCM_WIFI_STATUS),
+		"SHUT_RD":                          reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                        reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                          reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                          reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                          reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                           reflect.ValueOf(syscall.SIGBUS),
+		"SIGCHLD":                          reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCLD":                           reflect.ValueOf(syscall.SIGCLD),
+		"SIGCONT":                          reflect.ValueOf(syscall.SIGCONT),
+		"SIGFPE":                           reflect.ValueOf(syscall.SIGFPE),
+		"SIGHUP":                           reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                           reflect.ValueOf(syscall.SIGILL),
+		"SIGINT":                           reflect.ValueOf(syscall.SIGINT),
+		"SIGIO":                            reflect.ValueOf(syscall.SIGIO),
+		"SIGIOT":                           reflect.ValueOf(syscall.SIGIOT),
+		"SIGKILL":                          reflect.ValueOf(syscall.SIGKILL),
+		"SIGPIPE":                          reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPOLL":                          reflect.ValueOf(syscall.SIGPOLL),
+		"SIGPROF":                          reflect.ValueOf(syscall.SIGPROF),
+		"SIGPWR":                           reflect.ValueOf(syscall.SIGPWR),
+		"SIGQUIT":                          reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                          reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSTKFLT":                        reflect.ValueOf(syscall.SIGSTKFLT),
+		"SIGSTOP":                          reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                           reflect.ValueOf(syscall.SIGSYS),
+		"SIGTERM":                          reflect.ValueOf(syscall.SIGTERM),
+		"SIGTRAP":                          reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                          reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                          reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                          reflect.ValueOf(syscall.SIGTTOU),
+		"SIGUNUSED":                        reflect.ValueOf(syscall.SIGUNUSED),
+		"SIGURG":                           reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                          reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                          reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVTALRM":                        reflect.ValueOf(syscall.SIGVTALRM),
+		"SIGWINCH":                         reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                          reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                          reflect.ValueOf(syscall.SIGXFSZ),
+		"SIOCADDDLCI":                      reflect.ValueOf(syscall.SIOCADDDLCI),
+		"SIOCADDMULTI":                     reflect.ValueOf(syscall.SIOCADDMULTI),
+		"SIOCADDRT":                        reflect.ValueOf(syscall.SIOCADDRT),
+		"SIOCATMARK":                       reflect.ValueOf(syscall.SIOCATMARK),
+		"SIOCDARP":                         reflect.ValueOf(syscall.SIOCDARP),
+		"SIOCDELDLCI":                      reflect.ValueOf(syscall.SIOCDELDLCI),
+		"SIOCDELMULTI":                     reflect.ValueOf(syscall.SIOCDELMULTI),
+		"SIOCDELRT":                        reflect.ValueOf(syscall.SIOCDELRT),
+		"SIOCDEVPRIVATE":                   reflect.ValueOf(syscall.SIOCDEVPRIVATE),
+		"SIOCDIFADDR":                      reflect.ValueOf(syscall.SIOCDIFADDR),
+		"SIOCDRARP":                        reflect.ValueOf(syscall.SIOCDRARP),
+		"SIOCGARP":                         reflect.ValueOf(syscall.SIOCGARP),
+		"SIOCGIFADDR":                      reflect.ValueOf(syscall.SIOCGIFADDR),
+		"SIOCGIFBR":                        reflect.ValueOf(syscall.SIOCGIFBR),
+		"SIOCGIFBRDADDR":                   reflect.ValueOf(syscall.SIOCGIFBRDADDR),
+		"SIOCGIFCONF":                      reflect.ValueOf(syscall.SIOCGIFCONF),
+		"SIOCGIFCOUNT":                     reflect.ValueOf(syscall.SIOCGIFCOUNT),
+		"SIOCGIFDSTADDR":                   reflect.ValueOf(syscall.SIOCGIFDSTADDR),
+		"SIOCGIFENCAP":                     reflect.ValueOf(syscall.SIOCGIFENCAP),
+		"SIOCGIFFLAGS":                     reflect.ValueOf(syscall.SIOCGIFFLAGS),
+		"SIOCGIFHWADDR":                    reflect.ValueOf(syscall.SIOCGIFHWADDR),
+		"SIOCGIFINDEX":                     reflect.ValueOf(syscall.SIOCGIFINDEX),
+		"SIOCGIFMAP":                       reflect.ValueOf(syscall.SIOCGIFMAP),
+		"SIOCGIFMEM":                       reflect.ValueOf(syscall.SIOCGIFMEM),
+		"SIOCGIFMETRIC":                    reflect.ValueOf(syscall.SIOCGIFMETRIC),
+		"SIOCGIFMTU":                       reflect.ValueOf(syscall.SIOCGIFMTU),
+		"SIOCGIFNAME":                      reflect.ValueOf(syscall.SIOCGIFNAME),
+		"SIOCGIFNETMASK":                   reflect.ValueOf(syscall.SIOCGIFNETMASK),
+		"SIOCGIFPFLAGS":                    reflect.ValueOf(syscall.SIOCGIFPFLAGS),
+		"SIOCGIFSLAVE":                     reflect.ValueOf(syscall.SIOCGIFSLAVE),
+		"SIOCGIFTXQLEN":                    reflect.ValueOf(syscall.SIOCGIFTXQLEN),
+		"SIOCGPGRP":                        reflect.ValueOf(syscall.SIOCGPGRP),
+		"SIOCGRARP":                        reflect.ValueOf(syscall.SIOCGRARP),
+		"SIOCGSTAMP":                       reflect.ValueOf(syscall.SIOCGSTAMP),
+		"SIOCGSTAMPNS":                     reflect.ValueOf(syscall.SIOCGSTAMPNS),
+		"SIOCPROTOPRIVATE":                 reflect.ValueOf(syscall.SIOCPROTOPRIVATE),
+		"SIOCRTMSG":                        reflect.ValueOf(syscall.SIOCRTMSG),
+		"SIOCSARP":                         reflect.ValueOf(syscall.SIOCSARP),
+		"SIOCSIFADDR":                      reflect.ValueOf(syscall.SIOCSIFADDR),
+		"SIOCSIFBR":                        reflect.ValueOf(syscall.SIOCSIFBR),
+		"SIOCSIFBRDADDR":                   reflect.ValueOf(syscall.SIOCSIFBRDADDR),
+		"SIOCSIFDSTADDR":                   reflect.ValueOf(syscall.SIOCSIFDSTADDR),
+		"SIOCSIFENCAP":                     reflect.ValueOf(syscall.SIOCSIFENCAP),
+		"SIOCSIFFLAGS":                     reflect.ValueOf(syscall.SIOCSIFFLAGS),
+		"SIOCSIFHWADDR":                    reflect.ValueOf(syscall.SIOCSIFHWADDR),
+		"SIOCSIFHWBROADCAST":               reflect.ValueOf(syscall.SIOCSIFHWBROADCAST),
+		"SIOCSIFLINK":                      reflect.ValueOf(syscall.SIOCSIFLINK),
+		"SIOCSIFMAP":                       reflect.ValueOf(syscall.SIOCSIFMAP),
+		"SIOCSIFMEM":                       reflect.ValueOf(syscall.SIOCSIFMEM),
+		"SIOCSIFMETRIC":                    reflect.ValueOf(syscall.SIOCSIFMETRIC),
+		"SIOCSIFMTU":                       reflect.ValueOf(syscall.SIOCSIFMTU),
+		"SIOCSIFNAME":                      reflect.ValueOf(syscall.SIOCSIFNAME),
+		"SIOCSIFNETMASK":                   reflect.ValueOf(syscall.SIOCSIFNETMASK),
+		"SIOCSIFPFLAGS":                    reflect.ValueOf(syscall.SIOCSIFPFLAGS),
+		"SIOCSIFSLAVE":                     reflect.ValueOf(syscall.SIOCSIFSLAVE),
+		"SIOCSIFTXQLEN":                    reflect.ValueOf(syscall.SIOCSIFTXQLEN),
+		"SIOCSPGRP":                        reflect.ValueOf(syscall.SIOCSPGRP),
+		"SIOCSRARP":                        reflect.ValueOf(syscall.SIOCSRARP),
+		"SOCK_CLOEXEC":                     reflect.ValueOf(syscall.SOCK_CLOEXEC),
+		"SOCK_DCCP":                        reflect.ValueOf(syscall.SOCK_DCCP),
+		"SOCK_DGRAM":                       reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_NONBLOCK":                    reflect.ValueOf(syscall.SOCK_NONBLOCK),
+		"SOCK_PACKET":                      reflect.ValueOf(syscall.SOCK_PACKET),
+		"SOCK_RAW":                         reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_RDM":                         reflect.ValueOf(syscall.SOCK_RDM),
+		"SOCK_SEQPACKET":                   reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                      reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOL_AAL":                          reflect.ValueOf(syscall.SOL_AAL),
+		"SOL_ALG":                          reflect.ValueOf(syscall.SOL_ALG),
+		"SOL_ATM":                          reflect.ValueOf(syscall.SOL_ATM),
+		"SOL_BLUETOOTH":                    reflect.ValueOf(syscall.SOL_BLUETOOTH),
+		"SOL_CAIF":                         reflect.ValueOf(syscall.SOL_CAIF),
+		"SOL_DCCP":                         reflect.ValueOf(syscall.SOL_DCCP),
+		"SOL_DECNET":                       reflect.ValueOf(syscall.SOL_DECNET),
+		"SOL_ICMPV6":                       reflect.ValueOf(syscall.SOL_ICMPV6),
+		"SOL_IP":                           reflect.ValueOf(syscall.SOL_IP),
+		"SOL_IPV6":                         reflect.ValueOf(syscall.SOL_IPV6),
+		"SOL_IRDA":                         reflect.ValueOf(syscall.SOL_IRDA),
+		"SOL_IUCV":                         reflect.ValueOf(syscall.SOL_IUCV),
+		"SOL_KCM":                          reflect.ValueOf(syscall.SOL_KCM),
+		"SOL_LLC":                          reflect.ValueOf(syscall.SOL_LLC),
+		"SOL_NETBEUI":                      reflect.ValueOf(syscall.SOL_NETBEUI),
+		"SOL_NETLINK":                      reflect.ValueOf(syscall.SOL_NETLINK),
+		"SOL_NFC":                          reflect.ValueOf(syscall.SOL_NFC),
+		"SOL_PACKET":                       reflect.ValueOf(syscall.SOL_PACKET),
+		"SOL_PNPIPE":                       reflect.ValueOf(syscall.SOL_PNPIPE),
+		"SOL_PPPOL2TP":                     reflect.ValueOf(syscall.SOL_PPPOL2TP),
+		"SOL_RAW":                          reflect.ValueOf(syscall.SOL_RAW),
+		"SOL_RDS":                          reflect.ValueOf(syscall.SOL_RDS),
+		"SOL_RXRPC":                        reflect.ValueOf(syscall.SOL_RXRPC),
+		"SOL_SOCKET":                       reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOL_TCP":                          reflect.ValueOf(syscall.SOL_TCP),
+		"SOL_TIPC":                         reflect.ValueOf(syscall.SOL_TIPC),
+		"SOL_X25":                          reflect.ValueOf(syscall.SOL_X25),
+		"SOMAXCONN":                        reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ACCEPTCONN":                    reflect.ValueOf(syscall.SO_ACCEPTCONN),
+		"SO_ATTACH_BPF":                    reflect.ValueOf(syscall.SO_ATTACH_BPF),
+		"SO_ATTACH_FILTER":                 reflect.ValueOf(syscall.SO_ATTACH_FILTER),
+		"SO_BINDTODEVICE":                  reflect.ValueOf(syscall.SO_BINDTODEVICE),
+		"SO_BPF_EXTENSIONS":                reflect.ValueOf(syscall.SO_BPF_EXTENSIONS),
+		"SO_BROADCAST":                     reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_BSDCOMPAT":                     reflect.ValueOf(syscall.SO_BSDCOMPAT),
+		"SO_BUSY_POLL":                     reflect.ValueOf(syscall.SO_BUSY_POLL),
+		"SO_DEBUG":                         reflect.ValueOf(syscall.SO_DEBUG),
+		"SO_DETACH_BPF":                    reflect.ValueOf(syscall.SO_DETACH_BPF),
+		"SO_DETACH_FILTER":                 reflect.ValueOf(syscall.SO_DETACH_FILTER),
+		"SO_DOMAIN":                        reflect.ValueOf(syscall.SO_DOMAIN),
+		"SO_DONTROUTE":                     reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_ERROR":                         reflect.ValueOf(syscall.SO_ERROR),
+		"SO_GET_FILTER":                    reflect.ValueOf(syscall.SO_GET_FILTER),
+		"SO_INCOMING_CPU":                  reflect.ValueOf(syscall.SO_INCOMING_CPU),
+		"SO_KEEPALIVE":                     reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_LINGER":                        reflect.ValueOf(syscall.SO_LINGER),
+		"SO_LOCK_FILTER":                   reflect.ValueOf(syscall.SO_LOCK_FILTER),
+		"SO_MARK":                          reflect.ValueOf(syscall.SO_MARK),
+		"SO_MAX_PACING_RATE":               reflect.ValueOf(syscall.SO_MAX_PACING_RATE),
+		"SO_NOFCS":                         reflect.ValueOf(syscall.SO_NOFCS),
+		"SO_NO_CHECK":                      reflect.ValueOf(syscall.SO_NO_CHECK),
+		"SO_OOBINLINE":                     reflect.ValueOf(syscall.SO_OOBINLINE),
+		"SO_PASSCRED":                      reflect.ValueOf(syscall.SO_PASSCRED),
+		"SO_PASSSEC":                       reflect.ValueOf(syscall.SO_PASSSEC),
+		"SO_PEEK_OFF":                      reflect.ValueOf(syscall.SO_PEEK_OFF),
+		"SO_PEERCRED":                      reflect.ValueOf(syscall.SO_PEERCRED),
+		"SO_PEERNAME":                      reflect.ValueOf(syscall.SO_PEERNAME),
+		"SO_PEERSEC":                       reflect.ValueOf(syscall.SO_PEERSEC),
+		"SO_PRIORITY":                      reflect.ValueOf(syscall.SO_PRIORITY),
+		"SO_PROTOCOL":                      reflect.ValueOf(syscall.SO_PROTOCOL),
+		"SO_RCVBUF":                        reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_RCVBUFFORCE":                   reflect.ValueOf(syscall.SO_RCVBUFFORCE),
+		"SO_RCVLOWAT":                      reflect.ValueOf(syscall.SO_RCVLOWAT),
+		"SO_RCVTIMEO":                      reflect.ValueOf(syscall.SO_RCVTIMEO),
+		"SO_REUSEADDR":                     reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_REUSEPORT":                     reflect.ValueOf(syscall.SO_REUSEPORT),
+		"SO_RXQ_OVFL":                      reflect.ValueOf(syscall.SO_RXQ_OVFL),
+		"SO_SECURITY_AUTHENTICATION":       reflect.ValueOf(syscall.SO_SECURITY_AUTHENTICATION),
+		"SO_SECURITY_ENCRYPTION_NETWORK":   reflect.ValueOf(syscall.SO_SECURITY_ENCRYPTION_NETWORK),
+		"SO_SECURITY_ENCRYPTION_TRANSPORT": reflect.ValueOf(syscall.SO_SECURITY_ENCRYPTION_TRANSPORT),
+		"SO_SELECT_ERR_QUEUE":              reflect.ValueOf(syscall.SO_SELECT_ERR_QUEUE),
+		"SO_SNDBUF":                        reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_SNDBUFFORCE":                   reflect.ValueOf(syscall.SO_SNDBUFFORCE),
+		"SO_SNDLOWAT":                      reflect.ValueOf(syscall.SO_SNDLOWAT),
+		"SO_SNDTIMEO":                      reflect.ValueOf(syscall.SO_SNDTIMEO),
+		"SO_TIMESTAMP":                     reflect.ValueOf(syscall.SO_TIMESTAMP),
+		"SO_TIMESTAMPING":                  reflect.ValueOf(syscall.SO_TIMESTAMPING),
+		"SO_TIMESTAMPNS":                   reflect.ValueOf(syscall.SO_TIMESTAMPNS),
+		"SO_TYPE":                          reflect.ValueOf(syscall.SO_TYPE),
+		"SO_WIFI_STATUS":                   reflect.ValueOf(syscall.SO_WIFI_STATUS),
+		"SYS_ACCEPT":                       reflect.ValueOf(syscall.SYS_ACCEPT),
+		"SYS_ACCEPT4":                      reflect.ValueOf(syscall.SYS_ACCEPT4),
+		"SYS_ACCT":                         reflect.ValueOf(syscall.SYS_ACCT),
+		"SYS_ADD_KEY":                      reflect.ValueOf(syscall.SYS_ADD_KEY),
+		"SYS_ADJTIMEX":                     reflect.ValueOf(syscall.SYS_ADJTIMEX),
+		"SYS_ARCH_SPECIFIC_SYSCALL":        reflect.ValueOf(syscall.SYS_ARCH_SPECIFIC_SYSCALL),
+		"SYS_BIND":                         reflect.ValueOf(syscall.SYS_BIND),
+		"SYS_BPF":                          reflect.ValueOf(syscall.SYS_BPF),
+		"SYS_BRK":                          reflect.ValueOf(syscall.SYS_BRK),
+		"SYS_CAPGET":                       reflect.ValueOf(syscall.SYS_CAPGET),
+		"SYS_CAPSET":                       reflect.ValueOf(syscall.SYS_CAPSET),
+		"SYS_CHDIR":                        reflect.ValueOf(syscall.SYS_CHDIR),
+		"SYS_CHROOT":                       reflect.ValueOf(syscall.SYS_CHROOT),
+		"SYS_CLOCK_ADJTIME":                reflect.ValueOf(syscall.SYS_CLOCK_ADJTIME),
+		"SYS_CLOCK_GETRES":                 reflect.ValueOf(syscall.SYS_CLOCK_GETRES),
+		"SYS_CLOCK_GETTIME":                reflect.ValueOf(syscall.SYS_CLOCK_GETTIME),
+		"SYS_CLOCK_NANOSLEEP":              reflect.ValueOf(syscall.SYS_CLOCK_NANOSLEEP),
+		"SYS_CLOCK_SETTIME":                reflect.ValueOf(syscall.SYS_CLOCK_SETTIME),
+		"SYS_CLONE":                        reflect.ValueOf(syscall.SYS_CLONE),
+		"SYS_CLOSE":                        reflect.ValueOf(syscall.SYS_CLOSE),
+		"SYS_CONNECT":                      reflect.ValueOf(syscall.SYS_CONNECT),
+		"SYS_DELETE_MODULE":                reflect.ValueOf(syscall.SYS_DELETE_MODULE),
+		"SYS_DUP":                          reflect.ValueOf(syscall.SYS_DUP),
+		"SYS_DUP3":                         reflect.ValueOf(syscall.SYS_DUP3),
+		"SYS_EPOLL_CREATE1":                reflect.ValueOf(syscall.SYS_EPOLL_CREATE1),
+		"SYS_EPOLL_CTL":                    reflect.ValueOf(syscall.SYS_EPOLL_CTL),
+		"SYS_EPOLL_PWAIT":                  reflect.ValueOf(syscall.SYS_EPOLL_PWAIT),
+		"SYS_EVENTFD2":                     reflect.ValueOf(syscall.SYS_EVENTFD2),
+		"SYS_EXECVE":                       reflect.ValueOf(syscall.SYS_EXECVE),
+		"SYS_EXECVEAT":                     reflect.ValueOf(syscall.SYS_EXECVEAT),
+		"SYS_EXIT":                         reflect.ValueOf(syscall.SYS_EXIT),
+		"SYS_EXIT_GROUP":                   reflect.ValueOf(syscall.SYS_EXIT_GROUP),
+		"SYS_FACCESSAT":                    reflect.ValueOf(syscall.SYS_FACCESSAT),
+		"SYS_FADVISE64":                    reflect.ValueOf(syscall.SYS_FADVISE64),
+		"SYS_FALLOCATE":                    reflect.ValueOf(syscall.SYS_FALLOCATE),
+		"SYS_FANOTIFY_INIT":                reflect.ValueOf(syscall.SYS_FANOTIFY_INIT),
+		"SYS_FANOTIFY_MARK":                reflect.ValueOf(syscall.SYS_FANOTIFY_MARK),
+		"SYS_FCHDIR":                       reflect.ValueOf(syscall.SYS_FCHDIR),
+		"SYS_FCHMOD":                       reflect.ValueOf(syscall.SYS_FCHMOD),
+		"SYS_FCHMODAT":                     reflect.ValueOf(syscall.SYS_FCHMODAT),
+		"SYS_FCHOWN":                       reflect.ValueOf(syscall.SYS_FCHOWN),
+		"SYS_FCHOWNAT":                     reflect.ValueOf(syscall.SYS_FCHOWNAT),
+		"SYS_FCNTL":                        reflect.ValueOf(syscall.SYS_FCNTL),
+		"SYS_FDATASYNC":                    reflect.ValueOf(syscall.SYS_FDATASYNC),
+		"SYS_FGETXATTR":                    reflect.ValueOf(syscall.SYS_FGETXATTR),
+		"SYS_FINIT_MODULE":                 reflect.ValueOf(syscall.SYS_FINIT_MODULE),
+		"SYS_FLISTXATTR":                   reflect.ValueOf(syscall.SYS_FLISTXATTR),
+		"SYS_FLOCK":                        reflect.ValueOf(syscall.SYS_FLOCK),
+		"SYS_FREMOVEXATTR":                 reflect.ValueOf(syscall.SYS_FREMOVEXATTR),
+		"SYS_FSETXATTR":                    reflect.ValueOf(syscall.SYS_FSETXATTR),
+		"SYS_FSTAT":                        reflect.ValueOf(syscall.SYS_FSTAT),
+		"SYS_FSTATAT":                      reflect.ValueOf(syscall.SYS_FSTATAT),
+		"SYS_FSTATFS":                      reflect.ValueOf(syscall.SYS_FSTATFS),
+		"SYS_FSYNC":                        reflect.ValueOf(syscall.SYS_FSYNC),
+		"SYS_FTRUNCATE":                    reflect.ValueOf(syscall.SYS_FTRUNCATE),
+		"SYS_FUTEX":                        reflect.ValueOf(syscall.SYS_FUTEX),
+		"SYS_GETCPU":                       reflect.ValueOf(syscall.SYS_GETCPU),
+		"SYS_GETCWD":                       reflect.ValueOf(syscall.SYS_GETCWD),
+		"SYS_GETDENTS64":                   reflect.ValueOf(syscall.SYS_GETDENTS64),
+		"SYS_GETEGID":                      reflect.ValueOf(syscall.SYS_GETEGID),
+		"SYS_GETEUID":                      reflect.ValueOf(syscall.SYS_GETEUID),
+		"SYS_GETGID":                       reflect.ValueOf(syscall.SYS_GETGID),
+		"SYS_GETGROUPS":                    reflect.ValueOf(syscall.SYS_GETGROUPS),
+		"SYS_GETITIMER":                    reflect.ValueOf(syscall.SYS_GETITIMER),
+		"SYS_GETPEERNAME":                  reflect.ValueOf(syscall.SYS_GETPEERNAME),
+		"SYS_GETPGID":                      reflect.ValueOf(syscall.SYS_GETPGID),
+		"SYS_GETPID":                       reflect.ValueOf(syscall.SYS_GETPID),
+		"SYS_GETPPID":                      reflect.ValueOf(syscall.SYS_GETPPID),
+		"SYS_GETPRIORITY":                  reflect.ValueOf(syscall.SYS_GETPRIORITY),
+		"SYS_GETRANDOM":                    reflect.ValueOf(syscall.SYS_GETRANDOM),
+		"SYS_GETRESGID":                    reflect.ValueOf(syscall.SYS_GETRESGID),
+		"SYS_GETRESUID":                    reflect.ValueOf(syscall.SYS_GETRESUID),
+		"SYS_GETRLIMIT":                    reflect.ValueOf(syscall.SYS_GETRLIMIT),
+		"SYS_GETRUSAGE":                    reflect.ValueOf(syscall.SYS_GETRUSAGE),
+		"SYS_GETSID":                       reflect.ValueOf(syscall.SYS_GETSID),
+		"SYS_GETSOCKNAME":                  reflect.ValueOf(syscall.SYS_GETSOCKNAME),
+		"SYS_GETSOCKOPT":                   reflect.ValueOf(syscall.SYS_GETSOCKOPT),
+		"SYS_GETTID":                       reflect.ValueOf(syscall.SYS_GETTID),
+		"SYS_GETTIMEOFDAY":                 reflect.ValueOf(syscall.SYS_GETTIMEOFDAY),
+		"SYS_GETUID":                       reflect.ValueOf(syscall.SYS_GETUID),
+		"SYS_GETXATTR":                     reflect.ValueOf(syscall.SYS_GETXATTR),
+		"SYS_GET_MEMPOLICY":                reflect.ValueOf(syscall.SYS_GET_MEMPOLICY),
+		"SYS_GET_ROBUST_LIST":              reflect.ValueOf(syscall.SYS_GET_ROBUST_LIST),
+		"SYS_INIT_MODULE":                  reflect.ValueOf(syscall.SYS_INIT_MODULE),
+		"SYS_INOTIFY_ADD_WATCH":            reflect.ValueOf(syscall.SYS_INOTIFY_ADD_WATCH),
+		"SYS_INOTIFY_INIT1":                reflect.ValueOf(syscall.SYS_INOTIFY_INIT1),
+		"SYS_INOTIFY_RM_WATCH":             reflect.ValueOf(syscall.SYS_INOTIFY_RM_WATCH),
+		"SYS_IOCTL":                        reflect.ValueOf(syscall.SYS_IOCTL),
+		"SYS_IOPRIO_GET":                   reflect.ValueOf(syscall.SYS_IOPRIO_GET),
+		"SYS_IOPRIO_SET":                   reflect.ValueOf(syscall.SYS_IOPRIO_SET),
+		"SYS_IO_CANCEL":                    reflect.ValueOf(syscall.SYS_IO_CANCEL),
+		"SYS_IO_DESTROY":                   reflect.ValueOf(syscall.SYS_IO_DESTROY),
+		"SYS_IO_GETEVENTS":                 reflect.ValueOf(syscall.SYS_IO_GETEVENTS),
+		"SYS_IO_SETUP":                     reflect.ValueOf(syscall.SYS_IO_SETUP),
+		"SYS_IO_SUBMIT":                    reflect.ValueOf(syscall.SYS_IO_SUBMIT),
+		"SYS_KCMP":                         reflect.ValueOf(syscall.SYS_KCMP),
+		"SYS_KEXEC_LOAD":                   reflect.ValueOf(syscall.SYS_KEXEC_LOAD),
+		"SYS_KEYCTL":                       reflect.ValueOf(syscall.SYS_KEYCTL),
+		"SYS_KILL":                         reflect.ValueOf(syscall.SYS_KILL),
+		"SYS_LGETXATTR":                    reflect.ValueOf(syscall.SYS_LGETXATTR),
+		"SYS_LINKAT":                       reflect.ValueOf(syscall.SYS_LINKAT),
+		"SYS_LISTEN":                       reflect.ValueOf(syscall.SYS_LISTEN),
+		"SYS_LISTXATTR":                    reflect.ValueOf(syscall.SYS_LISTXATTR),
+		"SYS_LLISTXATTR":                   reflect.ValueOf(syscall.SYS_LLISTXATTR),
+		"SYS_LOOKUP_DCOOKIE":               reflect.ValueOf(syscall.SYS_LOOKUP_DCOOKIE),
+		"SYS_LREMOVEXATTR":                 reflect.ValueOf(syscall.SYS_LREMOVEXATTR),
+		"SYS_LSEEK":                        reflect.ValueOf(syscall.SYS_LSEEK),
+		"SYS_LSETXATTR":                    reflect.ValueOf(syscall.SYS_LSETXATTR),
+		"SYS_MADVISE":                      reflect.ValueOf(syscall.SYS_MADVISE),
+		"SYS_MBIND":                        reflect.ValueOf(syscall.SYS_MBIND),
+		"SYS_MEMFD_CREATE":                 reflect.ValueOf(syscall.SYS_MEMFD_CREATE),
+		"SYS_MIGRATE_PAGES":                reflect.ValueOf(syscall.SYS_MIGRATE_PAGES),
+		"SYS_MINCORE":                      reflect.ValueOf(syscall.SYS_MINCORE),
+		"SYS_MKDIRAT":                      reflect.ValueOf(syscall.SYS_MKDIRAT),
+		"SYS_MKNODAT":                      reflect.ValueOf(syscall.SYS_MKNODAT),
+		"SYS_MLOCK":                        reflect.ValueOf(syscall.SYS_MLOCK),
+		"SYS_MLOCKALL":                     reflect.ValueOf(syscall.SYS_MLOCKALL),
+		"SYS_MMAP":                         reflect.ValueOf(syscall.SYS_MMAP),
+		"SYS_MOUNT":                        reflect.ValueOf(syscall.SYS_MOUNT),
+		"SYS_MOVE_PAGES":                   reflect.ValueOf(syscall.SYS_MOVE_PAGES),
+		"SYS_MPROTECT":                     reflect.ValueOf(syscall.SYS_MPROTECT),
+		"SYS_MQ_GETSETATTR":                reflect.ValueOf(syscall.SYS_MQ_GETSETATTR),
+		"SYS_MQ_NOTIFY":                    reflect.ValueOf(syscall.SYS_MQ_NOTIFY),
+		"SYS_MQ_OPEN":                      reflect.ValueOf(syscall.SYS_MQ_OPEN),
+		"SYS_MQ_TIMEDRECEIVE":              reflect.ValueOf(syscall.SYS_MQ_TIMEDRECEIVE),
+		"SYS_MQ_TIMEDSEND":                 reflect.ValueOf(syscall.SYS_MQ_TIMEDSEND),
+		"SYS_MQ_UNLINK":                    reflect.ValueOf(syscall.SYS_MQ_UNLINK),
+		"SYS_MREMAP":                       reflect.ValueOf(syscall.SYS_MREMAP),
+		"SYS_MSGCTL":                       reflect.ValueOf(syscall.SYS_MSGCTL),
+		"SYS_MSGGET":                       reflect.ValueOf(syscall.SYS_MSGGET),
+		"SYS_MSGRCV":                       reflect.ValueOf(syscall.SYS_MSGRCV),
+		"SYS_MSGSND":                       reflect.ValueOf(syscall.SYS_MSGSND),
+		"SYS_MSYNC":                        reflect.ValueOf(syscall.SYS_MSYNC),
+		"SYS_MUNLOCK":                      reflect.ValueOf(syscall.SYS_MUNLOCK),
+		"SYS_MUNLOCKALL":                   reflect.ValueOf(syscall.SYS_MUNLOCKALL),
+		"SYS_MUNMAP":                       reflect.ValueOf(syscall.SYS_MUNMAP),
+		"SYS_NAME_TO_HANDLE_AT":            reflect.ValueOf(syscall.SYS_NAME_TO_HANDLE_AT),
+		"SYS_NANOSLEEP":                    reflect.ValueOf(syscall.SYS_NANOSLEEP),
+		"SYS_NFSSERVCTL":                   reflect.ValueOf(syscall.SYS_NFSSERVCTL),
+		"SYS_OPENAT":                       reflect.ValueOf(syscall.SYS_OPENAT),
+		"SYS_OPEN_BY_HANDLE_AT":            reflect.ValueOf(syscall.SYS_OPEN_BY_HANDLE_AT),
+		"SYS_PERF_EVENT_OPEN":              reflect.ValueOf(syscall.SYS_PERF_EVENT_OPEN),
+		"SYS_PERSONALITY":                  reflect.ValueOf(syscall.SYS_PERSONALITY),
+		"SYS_PIPE2":                        reflect.ValueOf(syscall.SYS_PIPE2),
+		"SYS_PIVOT_ROOT":                   reflect.ValueOf(syscall.SYS_PIVOT_ROOT),
+		"SYS_PPOLL":                        reflect.ValueOf(syscall.SYS_PPOLL),
+		"SYS_PRCTL":                        reflect.ValueOf(syscall.SYS_PRCTL),
+		"SYS_PREAD64":                      reflect.ValueOf(syscall.SYS_PREAD64),
+		"SYS_PREADV":                       reflect.ValueOf(syscall.SYS_PREADV),
+		"SYS_PRLIMIT64":                    reflect.ValueOf(syscall.SYS_PRLIMIT64),
+		"SYS_PROCESS_VM_READV":             reflect.ValueOf(syscall.SYS_PROCESS_VM_READV),
+		"SYS_PROCESS_VM_WRITEV":            reflect.ValueOf(syscall.SYS_PROCESS_VM_WRITEV),
+		"SYS_PSELECT6":                     reflect.ValueOf(syscall.SYS_PSELECT6),
+		"SYS_PTRACE":                       reflect.ValueOf(syscall.SYS_PTRACE),
+		"SYS_PWRITE64":                     reflect.ValueOf(syscall.SYS_PWRITE64),
+		"SYS_PWRITEV":                      reflect.ValueOf(syscall.SYS_PWRITEV),
+		"SYS_QUOTACTL":                     reflect.ValueOf(syscall.SYS_QUOTACTL),
+		"SYS_READ":                         reflect.ValueOf(syscall.SYS_READ),
+		"SYS_READAHEAD":                    reflect.ValueOf(syscall.SYS_READAHEAD),
+		"SYS_READLINKAT":                   reflect.ValueOf(syscall.SYS_READLINKAT),
+		"SYS_READV":                        reflect.ValueOf(syscall.SYS_READV),
+		"SYS_REBOOT":                       reflect.ValueOf(syscall.SYS_REBOOT),
+		"SYS_RECVFROM":                     reflect.ValueOf(syscall.SYS_RECVFROM),
+		"SYS_RECVMMSG":                     reflect.ValueOf(syscall.SYS_RECVMMSG),
+		"SYS_RECVMSG":                      reflect.ValueOf(syscall.SYS_RECVMSG),
+		"SYS_REMAP_FILE_PAGES":             reflect.ValueOf(syscall.SYS_REMAP_FILE_PAGES),
+		"SYS_REMOVEXATTR":                  reflect.ValueOf(syscall.SYS_REMOVEXATTR),
+		"SYS_RENAMEAT2":                    reflect.ValueOf(syscall.SYS_RENAMEAT2),
+		"SYS_REQUEST_KEY":                  reflect.ValueOf(syscall.SYS_REQUEST_KEY),
+		"SYS_RESTART_SYSCALL":              reflect.ValueOf(syscall.SYS_RESTART_SYSCALL),
+		"SYS_RT_SIGACTION":                 reflect.ValueOf(syscall.SYS_RT_SIGACTION),
+		"SYS_RT_SIGPENDING":                reflect.ValueOf(syscall.SYS_RT_SIGPENDING),
+		"SYS_RT_SIGPROCMASK":               reflect.ValueOf(syscall.SYS_RT_SIGPROCMASK),
+		"SYS_RT_SIGQUEUEINFO":              reflect.ValueOf(syscall.SYS_RT_SIGQUEUEINFO),
+		"SYS_RT_SIGRETURN":                 reflect.ValueOf(syscall.SYS_RT_SIGRETURN),
+		"SYS_RT_SIGSUSPEND":                reflect.ValueOf(syscall.SYS_RT_SIGSUSPEND),
+		"SYS_RT_SIGTIMEDWAIT":              reflect.ValueOf(syscall.SYS_RT_SIGTIMEDWAIT),
+		"SYS_RT_TGSIGQUEUEINFO":            reflect.ValueOf(syscall.SYS_RT_TGSIGQUEUEINFO),
+		"SYS_SCHED_GETAFFINITY":            reflect.ValueOf(syscall.SYS_SCHED_GETAFFINITY),
+		"SYS_SCHED_GETATTR":                reflect.ValueOf(syscall.SYS_SCHED_GETATTR),
+		"SYS_SCHED_GETPARAM":               reflect.ValueOf(syscall.SYS_SCHED_GETPARAM),
+		"SYS_SCHED_GETSCHEDULER":           reflect.ValueOf(syscall.SYS_SCHED_GETSCHEDULER),
+		"SYS_SCHED_GET_PRIORITY_MAX":       reflect.ValueOf(syscall.SYS_SCHED_GET_PRIORITY_MAX),
+		"SYS_SCHED_GET_PRIORITY_MIN":       reflect.ValueOf(syscall.SYS_SCHED_GET_PRIORITY_MIN),
+		"SYS_SCHED_RR_GET_INTERVAL":        reflect.ValueOf(syscall.SYS_SCHED_RR_GET_INTERVAL),
+		"SYS_SCHED_SETAFFINITY":            reflect.ValueOf(syscall.SYS_SCHED_SETAFFINITY),
+		"SYS_SCHED_SETATTR":                reflect.ValueOf(syscall.SYS_SCHED_SETATTR),
+		"SYS_SCHED_SETPARAM":               reflect.ValueOf(syscall.SYS_SCHED_SETPARAM),
+		"SYS_SCHED_SETSCHEDULER":           reflect.ValueOf(syscall.SYS_SCHED_SETSCHEDULER),
+		"SYS_SCHED_YIELD":                  reflect.ValueOf(syscall.SYS_SCHED_YIELD),
+		"SYS_SECCOMP":                      reflect.ValueOf(syscall.SYS_SECCOMP),
+		"SYS_SEMCTL":                       reflect.ValueOf(syscall.SYS_SEMCTL),
+		"SYS_SEMGET":                       reflect.ValueOf(syscall.SYS_SEMGET),
+		"SYS_SEMOP":                        reflect.ValueOf(syscall.SYS_SEMOP),
+		"SYS_SEMTIMEDOP":                   reflect.ValueOf(syscall.SYS_SEMTIMEDOP),
+		"SYS_SENDFILE":                     reflect.ValueOf(syscall.SYS_SENDFILE),
+		"SYS_SENDMMSG":                     reflect.ValueOf(syscall.SYS_SENDMMSG),
+		"SYS_SENDMSG":                      reflect.ValueOf(syscall.SYS_SENDMSG),
+		"SYS_SENDTO":                       reflect.ValueOf(syscall.SYS_SENDTO),
+		"SYS_SETDOMAINNAME":                reflect.ValueOf(syscall.SYS_SETDOMAINNAME),
+		"SYS_SETFSGID":                     reflect.ValueOf(syscall.SYS_SETFSGID),
+		"SYS_SETFSUID":                     reflect.ValueOf(syscall.SYS_SETFSUID),
+		"SYS_SETGID":                       reflect.ValueOf(syscall.SYS_SETGID),
+		"SYS_SETGROUPS":                    reflect.ValueOf(syscall.SYS_SETGROUPS),
+		"SYS_SETHOSTNAME":                  reflect.ValueOf(syscall.SYS_SETHOSTNAME),
+		"SYS_SETITIMER":                    reflect.ValueOf(syscall.SYS_SETITIMER),
+		"SYS_SETNS":                        reflect.ValueOf(syscall.SYS_SETNS),
+		"SYS_SETPGID":                      reflect.ValueOf(syscall.SYS_SETPGID),
+		"SYS_SETPRIORITY":                  reflect.ValueOf(syscall.SYS_SETPRIORITY),
+		"SYS_SETREGID":                     reflect.ValueOf(syscall.SYS_SETREGID),
+		"SYS_SETRESGID":                    reflect.ValueOf(syscall.SYS_SETRESGID),
+		"SYS_SETRESUID":                    reflect.ValueOf(syscall.SYS_SETRESUID),
+		"SYS_SETREUID":                     reflect.ValueOf(syscall.SYS_SETREUID),
+		"SYS_SETRLIMIT":                    reflect.ValueOf(syscall.SYS_SETRLIMIT),
+		"SYS_SETSID":                       reflect.ValueOf(syscall.SYS_SETSID),
+		"SYS_SETSOCKOPT":                   reflect.ValueOf(syscall.SYS_SETSOCKOPT),
+		"SYS_SETTIMEOFDAY":                 reflect.ValueOf(syscall.SYS_SETTIMEOFDAY),
+		"SYS_SETUID":                       reflect.ValueOf(syscall.SYS_SETUID),
+		"SYS_SETXATTR":                     reflect.ValueOf(syscall.SYS_SETXATTR),
+		"SYS_SET_MEMPOLICY":                reflect.ValueOf(syscall.SYS_SET_MEMPOLICY),
+		"SYS_SET_ROBUST_LIST":              reflect.ValueOf(syscall.SYS_SET_ROBUST_LIST),
+		"SYS_SET_TID_ADDRESS":              reflect.ValueOf(syscall.SYS_SET_TID_ADDRESS),
+		"SYS_SHMAT":                        reflect.ValueOf(syscall.SYS_SHMAT),
+		"SYS_SHMCTL":                       reflect.ValueOf(syscall.SYS_SHMCTL),
+		"SYS_SHMDT":                        reflect.ValueOf(syscall.SYS_SHMDT),
+		"SYS_SHMGET":                       reflect.ValueOf(syscall.SYS_SHMGET),
+		"SYS_SHUTDOWN":                     reflect.ValueOf(syscall.SYS_SHUTDOWN),
+		"SYS_SIGALTSTACK":                  reflect.ValueOf(syscall.SYS_SIGALTSTACK),
+		"SYS_SIGNALFD4":                    reflect.ValueOf(syscall.SYS_SIGNALFD4),
+		"SYS_SOCKET":                       reflect.ValueOf(syscall.SYS_SOCKET),
+		"SYS_SOCKETPAIR":                   reflect.ValueOf(syscall.SYS_SOCKETPAIR),
+		"SYS_SPLICE":                       reflect.ValueOf(syscall.SYS_SPLICE),
+		"SYS_STATFS":                       reflect.ValueOf(syscall.SYS_STATFS),
+		"SYS_SWAPOFF":                      reflect.ValueOf(syscall.SYS_SWAPOFF),
+		"SYS_SWAPON":                       reflect.ValueOf(syscall.SYS_SWAPON),
+		"SYS_SYMLINKAT":                    reflect.ValueOf(syscall.SYS_SYMLINKAT),
+		"SYS_SYNC":                         reflect.ValueOf(syscall.SYS_SYNC),
+		"SYS_SYNCFS":                       reflect.ValueOf(syscall.SYS_SYNCFS),
+		"SYS_SYNC_FILE_RANGE":              reflect.ValueOf(syscall.SYS_SYNC_FILE_RANGE),
+		"SYS_SYSINFO":                      reflect.ValueOf(syscall.SYS_SYSINFO),
+		"SYS_SYSLOG":                       reflect.ValueOf(syscall.SYS_SYSLOG),
+		"SYS_TEE":                          reflect.ValueOf(syscall.SYS_TEE),
+		"SYS_TGKILL":                       reflect.ValueOf(syscall.SYS_TGKILL),
+		"SYS_TIMERFD_CREATE":               reflect.ValueOf(syscall.SYS_TIMERFD_CREATE),
+		"SYS_TIMERFD_GETTIME":              reflect.ValueOf(syscall.SYS_TIMERFD_GETTIME),
+		"SYS_TIMERFD_SETTIME":              reflect.ValueOf(syscall.SYS_TIMERFD_SETTIME),
+		"SYS_TIMER_CREATE":                 reflect.ValueOf(syscall.SYS_TIMER_CREATE),
+		"SYS_TIMER_DELETE":                 reflect.ValueOf(syscall.SYS_TIMER_DELETE),
+		"SYS_TIMER_GETOVERRUN":             reflect.ValueOf(syscall.SYS_TIMER_GETOVERRUN),
+		"SYS_TIMER_GETTIME":                reflect.ValueOf(syscall.SYS_TIMER_GETTIME),
+		"SYS_TIMER_SETTIME":                reflect.ValueOf(syscall.SYS_TIMER_SETTIME),
+		"SYS_TIMES":                        reflect.ValueOf(syscall.SYS_TIMES),
+		"SYS_TKILL":                        reflect.ValueOf(syscall.SYS_TKILL),
+		"SYS_TRUNCATE":                     reflect.ValueOf(syscall.SYS_TRUNCATE),
+		"SYS_UMASK":                        reflect.ValueOf(syscall.SYS_UMASK),
+		"SYS_UMOUNT2":                      reflect.ValueOf(syscall.SYS_UMOUNT2),
+		"SYS_UNAME":                        reflect.ValueOf(syscall.SYS_UNAME),
+		"SYS_UNLINKAT":                     reflect.ValueOf(syscall.SYS_UNLINKAT),
+		"SYS_UNSHARE":                      reflect.ValueOf(syscall.SYS_UNSHARE),
+		"SYS_UTIMENSAT":                    reflect.ValueOf(syscall.SYS_UTIMENSAT),
+		"SYS_VHANGUP":                      reflect.ValueOf(syscall.SYS_VHANGUP),
+		"SYS_VMSPLICE":                     reflect.ValueOf(syscall.SYS_VMSPLICE),
+		"SYS_WAIT4":                        reflect.ValueOf(syscall.SYS_WAIT4),
+		"SYS_WAITID":                       reflect.ValueOf(syscall.SYS_WAITID),
+		"SYS_WRITE":                        reflect.ValueOf(syscall.SYS_WRITE),
+		"SYS_WRITEV":                       reflect.ValueOf(syscall.SYS_WRITEV),
+		"S_BLKSIZE":                        reflect.ValueOf(syscall.S_BLKSIZE),
+		"S_IEXEC":                          reflect.ValueOf(syscall.S_IEXEC),
+		"S_IFBLK":                          reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                          reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                          reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                          reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                          reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                           reflect.ValueOf(syscall.S_IFMT),
+		"S_IFREG":                          reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                         reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IREAD":                          reflect.ValueOf(syscall.S_IREAD),
+		"S_IRGRP":                          reflect.ValueOf(syscall.S_IRGRP),
+		"S_IROTH":                          reflect.ValueOf(syscall.S_IROTH),
+		"S_IRUSR":                          reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                          reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                          reflect.ValueOf(syscall.S_IRWXO),
+		"S_IRWXU":                          reflect.ValueOf(syscall.S_IRWXU),
+		"S_ISGID":                          reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                          reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                          reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWGRP":                          reflect.ValueOf(syscall.S_IWGRP),
+		"S_IWOTH":                          reflect.ValueOf(syscall.S_IWOTH),
+		"S_IWRITE":                         reflect.ValueOf(syscall.S_IWRITE),
+		"S_IWUSR":                          reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXGRP":                          reflect.ValueOf(syscall.S_IXGRP),
+		"S_IXOTH":                          reflect.ValueOf(syscall.S_IXOTH),
+		"S_IXUSR":                          reflect.ValueOf(syscall.S_IXUSR),
+		"Seek":                             reflect.ValueOf(syscall.Seek),
+		"Select":                           reflect.ValueOf(syscall.Select),
+		"Sendfile":                         reflect.ValueOf(syscall.Sendfile),
+		"Sendmsg":                          reflect.ValueOf(syscall.Sendmsg),
+		"SendmsgN":                         reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                           reflect.ValueOf(syscall.Sendto),
+		"SetLsfPromisc":                    reflect.ValueOf(syscall.SetLsfPromisc),
+		"SetNonblock":                      reflect.ValueOf(syscall.SetNonblock),
+		"Setdomainname":                    reflect.ValueOf(syscall.Setdomainname),
+		"Setegid":                          reflect.ValueOf(syscall.Setegid),
+		"Setenv":                           reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                          reflect.ValueOf(syscall.Seteuid),
+		"Setfsgid":                         reflect.ValueOf(syscall.Setfsgid),
+		"Setfsuid":                         reflect.ValueOf(syscall.Setfsuid),
+		"Setgid":                           reflect.ValueOf(syscall.Setgid),
+		"Setgroups":                        reflect.ValueOf(syscall.Setgroups),
+		"Sethostname":                      reflect.ValueOf(syscall.Sethostname),
+		"Setpgid":                          reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                      reflect.ValueOf(syscall.Setpriority),
+		"Setregid":                         reflect.ValueOf(syscall.Setregid),
+		"Setresgid":                        reflect.ValueOf(syscall.Setresgid),
+		"Setresuid":                        reflect.ValueOf(syscall.Setresuid),
+		"Setreuid":                         reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                        reflect.ValueOf(syscall.Setrlimit),
+		"Setsid":                           reflect.ValueOf(syscall.Setsid),
+		"SetsockoptByte":                   reflect.ValueOf(syscall.SetsockoptByte),
+		"SetsockoptICMPv6Filter":           reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
+		"SetsockoptIPMreq":                 reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPMreqn":                reflect.ValueOf(syscall.SetsockoptIPMreqn),
+		"SetsockoptIPv6Mreq":               reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":              reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                    reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":                 reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptString":                 reflect.ValueOf(syscall.SetsockoptString),
+		"SetsockoptTimeval":                reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Settimeofday":                     reflect.ValueOf(syscall.Settimeofday),
+		"Setuid":                           reflect.ValueOf(syscall.Setuid),
+		"Setxattr":                         reflect.ValueOf(syscall.Setxattr),
+		"Shutdown":                         reflect.ValueOf(syscall.Shutdown),
+		"SizeofCmsghdr":                    reflect.ValueOf(syscall.SizeofCmsghdr),
+		"SizeofICMPv6Filter":               reflect.ValueOf(syscall.SizeofICMPv6Filter),
+		"SizeofIPMreq":                     reflect.ValueOf(syscall.SizeofIPMreq),
+		"SizeofIPMreqn":                    reflect.ValueOf(syscall.SizeofIPMreqn),
+		"SizeofIPv6MTUInfo":                reflect.ValueOf(syscall.SizeofIPv6MTUInfo),
+		"SizeofIPv6Mreq":                   reflect.ValueOf(syscall.SizeofIPv6Mreq),
+		"SizeofIfAddrmsg":                  reflect.ValueOf(syscall.SizeofIfAddrmsg),
+		"SizeofIfInfomsg":                  reflect.ValueOf(syscall.SizeofIfInfomsg),
+		"SizeofInet4Pktinfo":               reflect.ValueOf(syscall.SizeofInet4Pktinfo),
+		"SizeofInet6Pktinfo":               reflect.ValueOf(syscall.SizeofInet6Pktinfo),
+		"SizeofInotifyEvent":               reflect.ValueOf(syscall.SizeofInotifyEvent),
+		"SizeofLinger":                     reflect.ValueOf(syscall.SizeofLinger),
+		"SizeofMsghdr":                     reflect.ValueOf(syscall.SizeofMsghdr),
+		"SizeofNlAttr":                     reflect.ValueOf(syscall.SizeofNlAttr),
+		"SizeofNlMsgerr":                   reflect.ValueOf(syscall.SizeofNlMsgerr),
+		"SizeofNlMsghdr":                   reflect.ValueOf(syscall.SizeofNlMsghdr),
+		"SizeofRtAttr":                     reflect.ValueOf(syscall.SizeofRtAttr),
+		"SizeofRtGenmsg":                   reflect.ValueOf(syscall.SizeofRtGenmsg),
+		"SizeofRtMsg":                      reflect.ValueOf(syscall.SizeofRtMsg),
+		"SizeofRtNexthop":                  reflect.ValueOf(syscall.SizeofRtNexthop),
+		"SizeofSockFilter":                 reflect.ValueOf(syscall.SizeofSockFilter),
+		"SizeofSockFprog":                  reflect.ValueOf(syscall.SizeofSockFprog),
+		"SizeofSockaddrAny":                reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrInet4":              reflect.ValueOf(syscall.SizeofSockaddrInet4),
+		"SizeofSockaddrInet6":              reflect.ValueOf(syscall.SizeofSockaddrInet6),
+		"SizeofSockaddrLinklayer":          reflect.ValueOf(syscall.SizeofSockaddrLinklayer),
+		"SizeofSockaddrNetlink":            reflect.ValueOf(syscall.SizeofSockaddrNetlink),
+		"SizeofSockaddrUnix":               reflect.ValueOf(syscall.SizeofSockaddrUnix),
+		"SizeofTCPInfo":                    reflect.ValueOf(syscall.SizeofTCPInfo),
+		"SizeofUcred":                      reflect.ValueOf(syscall.SizeofUcred),
+		"SlicePtrFromStrings":              reflect.ValueOf(syscall.SlicePtrFromStrings),
+		"Socket":                           reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":                reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"Socketpair":                       reflect.ValueOf(syscall.Socketpair),
+		"Splice":                           reflect.ValueOf(syscall.Splice),
+		"StartProcess":                     reflect.ValueOf(syscall.StartProcess),
+		"Stat":                             reflect.ValueOf(syscall.Stat),
+		"Statfs":                           reflect.ValueOf(syscall.Statfs),
+		"Stderr":                           reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                            reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                           reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                    reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":                  reflect.ValueOf(syscall.StringByteSlice),
+		"StringSlicePtr":                   reflect.ValueOf(syscall.StringSlicePtr),
+		"Symlink":                          reflect.ValueOf(syscall.Symlink),
+		"Sync":                             reflect.ValueOf(syscall.Sync),
+		"SyncFileRange":                    reflect.ValueOf(syscall.SyncFileRange),
+		"Syscall":                          reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                         reflect.ValueOf(syscall.Syscall6),
+		"Sysinfo":                          reflect.ValueOf(syscall.Sysinfo),
+		"TCFLSH":                           reflect.ValueOf(syscall.TCFLSH),
+		"TCGETS":                           reflect.ValueOf(syscall.TCGETS),
+		"TCIFLUSH":                         reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                        reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                         reflect.ValueOf(syscall.TCOFLUSH),
+		"TCP_CC_INFO":                      reflect.ValueOf(syscall.TCP_CC_INFO),
+		"TCP_CONGESTION":                   reflect.ValueOf(syscall.TCP_CONGESTION),
+		"TCP_COOKIE_IN_ALWAYS":             reflect.ValueOf(syscall.TCP_COOKIE_IN_ALWAYS),
+		"TCP_COOKIE_MAX":                   reflect.ValueOf(syscall.TCP_COOKIE_MAX),
+		"TCP_COOKIE_MIN":                   reflect.ValueOf(syscall.TCP_COOKIE_MIN),
+		"TCP_COOKIE_OUT_NEVER":             reflect.ValueOf(syscall.TCP_COOKIE_OUT_NEVER),
+		"TCP_COOKIE_PAIR_SIZE":             reflect.ValueOf(syscall.TCP_COOKIE_PAIR_SIZE),
+		"TCP_COOKIE_TRANSACTIONS":          reflect.ValueOf(syscall.TCP_COOKIE_TRANSACTIONS),
+		"TCP_CORK":                         reflect.ValueOf(syscall.TCP_CORK),
+		"TCP_DEFER_ACCEPT":                 reflect.ValueOf(syscall.TCP_DEFER_ACCEPT),
+		"TCP_FASTOPEN":                     reflect.ValueOf(syscall.TCP_FASTOPEN),
+		"TCP_INFO":                         reflect.ValueOf(syscall.TCP_INFO),
+		"TCP_KEEPCNT":                      reflect.ValueOf(syscall.TCP_KEEPCNT),
+		"TCP_KEEPIDLE":                     reflect.ValueOf(syscall.TCP_KEEPIDLE),
+		"TCP_KEEPINTVL":                    reflect.ValueOf(syscall.TCP_KEEPINTVL),
+		"TCP_LINGER2":                      reflect.ValueOf(syscall.TCP_LINGER2),
+		"TCP_MAXSEG":                       reflect.ValueOf(syscall.TCP_MAXSEG),
+		"TCP_MAXWIN":                       reflect.ValueOf(syscall.TCP_MAXWIN),
+		"TCP_MAX_WINSHIFT":                 reflect.ValueOf(syscall.TCP_MAX_WINSHIFT),
+		"TCP_MD5SIG":                       reflect.ValueOf(syscall.TCP_MD5SIG),
+		"TCP_MD5SIG_MAXKEYLEN":             reflect.ValueOf(syscall.TCP_MD5SIG_MAXKEYLEN),
+		"TCP_MSS":                          reflect.ValueOf(syscall.TCP_MSS),
+		"TCP_MSS_DEFAULT":                  reflect.ValueOf(syscall.TCP_MSS_DEFAULT),
+		"TCP_MSS_DESIRED":                  reflect.ValueOf(syscall.TCP_MSS_DESIRED),
+		"TCP_NODELAY":                      reflect.ValueOf(syscall.TCP_NODELAY),
+		"TCP_NOTSENT_LOWAT":                reflect.ValueOf(syscall.TCP_NOTSENT_LOWAT),
+		"TCP_QUEUE_SEQ":                    reflect.ValueOf(syscall.TCP_QUEUE_SEQ),
+		"TCP_QUICKACK":                     reflect.ValueOf(syscall.TCP_QUICKACK),
+		"TCP_REPAIR":                       reflect.ValueOf(syscall.TCP_REPAIR),
+		"TCP_REPAIR_OPTIONS":               reflect.ValueOf(syscall.TCP_REPAIR_OPTIONS),
+		"TCP_REPAIR_QUEUE":                 reflect.ValueOf(syscall.TCP_REPAIR_QUEUE),
+		"TCP_SAVED_SYN":                    reflect.ValueOf(syscall.TCP_SAVED_SYN),
+		"TCP_SAVE_SYN":                     reflect.ValueOf(syscall.TCP_SAVE_SYN),
+		"TCP_SYNCNT":                       reflect.ValueOf(syscall.TCP_SYNCNT),
+		"TCP_S_DATA_IN":                    reflect.ValueOf(syscall.TCP_S_DATA_IN),
+		"TCP_S_DATA_OUT":                   reflect.ValueOf(syscall.TCP_S_DATA_OUT),
+		"TCP_THIN_DUPACK":                  reflect.ValueOf(syscall.TCP_THIN_DUPACK),
+		"TCP_THIN_LINEAR_TIMEOUTS":         reflect.ValueOf(syscall.TCP_THIN_LINEAR_TIMEOUTS),
+		"TCP_TIMESTAMP":                    reflect.ValueOf(syscall.TCP_TIMESTAMP),
+		"TCP_USER_TIMEOUT":                 reflect.ValueOf(syscall.TCP_USER_TIMEOUT),
+		"TCP_WINDOW_CLAMP":                 reflect.ValueOf(syscall.TCP_WINDOW_CLAMP),
+		"TCSAFLUSH":                        reflect.ValueOf(syscall.TCSAFLUSH),
+		"TCSETS":                           reflect.ValueOf(syscall.TCSETS),
+		"TIOCCBRK":                         reflect.ValueOf(syscall.TIOCCBRK),
+		"TIOCCONS":                         reflect.ValueOf(syscall.TIOCCONS),
+		"TIOCEXCL":                         reflect.ValueOf(syscall.TIOCEXCL),
+		"TIOCGDEV":                         reflect.ValueOf(uint32(syscall.TIOCGDEV)),
+		"TIOCGETD":                         reflect.ValueOf(syscall.TIOCGETD),
+		"TIOCGEXCL":                        reflect.ValueOf(uint32(syscall.TIOCGEXCL)),
+		"TIOCGICOUNT":                      reflect.ValueOf(syscall.TIOCGICOUNT),
+		"TIOCGLCKTRMIOS":                   reflect.ValueOf(syscall.TIOCGLCKTRMIOS),
+		"TIOCGPGRP":                        reflect.ValueOf(syscall.TIOCGPGRP),
+		"TIOCGPKT":                         reflect.ValueOf(uint32(syscall.TIOCGPKT)),
+		"TIOCGPTLCK":                       reflect.ValueOf(uint32(syscall.TIOCGPTLCK)),
+		"TIOCGPTN":                         reflect.ValueOf(uint32(syscall.TIOCGPTN)),
+		"TIOCGRS485":                       reflect.ValueOf(syscall.TIOCGRS485),
+		"TIOCGSERIAL":                      reflect.ValueOf(syscall.TIOCGSERIAL),
+		"TIOCGSID":                         reflect.ValueOf(syscall.TIOCGSID),
+		"TIOCGSOFTCAR":                     reflect.ValueOf(syscall.TIOCGSOFTCAR),
+		"TIOCGWINSZ":                       reflect.ValueOf(syscall.TIOCGWINSZ),
+		"TIOCINQ":                          reflect.ValueOf(syscall.TIOCINQ),
+		"TIOCLINUX":                        reflect.ValueOf(syscall.TIOCLINUX),
+		"TIOCMBIC":                         reflect.ValueOf(syscall.TIOCMBIC),
+		"TIOCMBIS":                         reflect.ValueOf(syscall.TIOCMBIS),
+		"TIOCMGET":                         reflect.ValueOf(syscall.TIOCMGET),
+		"TIOCMIWAIT":                       reflect.ValueOf(syscall.TIOCMIWAIT),
+		"TIOCMSET":                         reflect.ValueOf(syscall.TIOCMSET),
+		"TIOCM_CAR":                        reflect.ValueOf(syscall.TIOCM_CAR),
+		"TIOCM_CD":                         reflect.ValueOf(syscall.TIOCM_CD),
+		"TIOCM_CTS":                        reflect.ValueOf(syscall.TIOCM_CTS),
+		"TIOCM_DSR":                        reflect.ValueOf(syscall.TIOCM_DSR),
+		"TIOCM_DTR":                        reflect.ValueOf(syscall.TIOCM_DTR),
+		"TIOCM_LE":                         reflect.ValueOf(syscall.TIOCM_LE),
+		"TIOCM_RI":                         reflect.ValueOf(syscall.TIOCM_RI),
+		"TIOCM_RNG":                        reflect.ValueOf(syscall.TIOCM_RNG),
+		"TIOCM_RTS":                        reflect.ValueOf(syscall.TIOCM_RTS),
+		"TIOCM_SR":                         reflect.ValueOf(syscall.TIOCM_SR),
+		"TIOCM_ST":                         reflect.ValueOf(syscall.TIOCM_ST),
+		"TIOCNOTTY":                        reflect.ValueOf(syscall.TIOCNOTTY),
+		"TIOCNXCL":                         reflect.ValueOf(syscall.TIOCNXCL),
+		"TIOCOUTQ":                         reflect.ValueOf(syscall.TIOCOUTQ),
+		"TIOCPKT":                          reflect.ValueOf(syscall.TIOCPKT),
+		"TIOCPKT_DATA":                     reflect.ValueOf(syscall.TIOCPKT_DATA),
+		"TIOCPKT_DOSTOP":                   reflect.ValueOf(syscall.TIOCPKT_DOSTOP),
+		"TIOCPKT_FLUSHREAD":                reflect.ValueOf(syscall.TIOCPKT_FLUSHREAD),
+		"TIOCPKT_FLUSHWRITE":               reflect.ValueOf(syscall.TIOCPKT_FLUSHWRITE),
+		"TIOCPKT_IOCTL":                    reflect.ValueOf(syscall.TIOCPKT_IOCTL),
+		"TIOCPKT_NOSTOP":                   reflect.ValueOf(syscall.TIOCPKT_NOSTOP),
+		"TIOCPKT_START":                    reflect.ValueOf(syscall.TIOCPKT_START),
+		"TIOCPKT_STOP":                     reflect.ValueOf(syscall.TIOCPKT_STOP),
+		"TIOCSBRK":                         reflect.ValueOf(syscall.TIOCSBRK),
+		"TIOCSCTTY":                        reflect.ValueOf(syscall.TIOCSCTTY),
+		"TIOCSERCONFIG":                    reflect.ValueOf(syscall.TIOCSERCONFIG),
+		"TIOCSERGETLSR":                    reflect.ValueOf(syscall.TIOCSERGETLSR),
+		"TIOCSERGETMULTI":                  reflect.ValueOf(syscall.TIOCSERGETMULTI),
+		"TIOCSERGSTRUCT":                   reflect.ValueOf(syscall.TIOCSERGSTRUCT),
+		"TIOCSERGWILD":                     reflect.ValueOf(syscall.TIOCSERGWILD),
+		"TIOCSERSETMULTI":                  reflect.ValueOf(syscall.TIOCSERSETMULTI),
+		"TIOCSERSWILD":                     reflect.ValueOf(syscall.TIOCSERSWILD),
+		"TIOCSER_TEMT":                     reflect.ValueOf(syscall.TIOCSER_TEMT),
+		"TIOCSETD":                         reflect.ValueOf(syscall.TIOCSETD),
+		"TIOCSIG":                          reflect.ValueOf(syscall.TIOCSIG),
+		"TIOCSLCKTRMIOS":                   reflect.ValueOf(syscall.TIOCSLCKTRMIOS),
+		"TIOCSPGRP":                        reflect.ValueOf(syscall.TIOCSPGRP),
+		"TIOCSPTLCK":                       reflect.ValueOf(syscall.TIOCSPTLCK),
+		"TIOCSRS485":                       reflect.ValueOf(syscall.TIOCSRS485),
+		"TIOCSSERIAL":                      reflect.ValueOf(syscall.TIOCSSERIAL),
+		"TIOCSSOFTCAR":                     reflect.ValueOf(syscall.TIOCSSOFTCAR),
+		"TIOCSTI":                          reflect.ValueOf(syscall.TIOCSTI),
+		"TIOCSWINSZ":                       reflect.ValueOf(syscall.TIOCSWINSZ),
+		"TIOCVHANGUP":                      reflect.ValueOf(syscall.TIOCVHANGUP),
+		"TOSTOP":                           reflect.ValueOf(syscall.TOSTOP),
+		"TUNATTACHFILTER":                  reflect.ValueOf(syscall.TUNATTACHFILTER),
+		"TUNDETACHFILTER":                  reflect.ValueOf(syscall.TUNDETACHFILTER),
+		"TUNGETFEATURES":                   reflect.ValueOf(uint32(syscall.TUNGETFEATURES)),
+		"TUNGETFILTER":                     reflect.ValueOf(uint32(syscall.TUNGETFILTER)),
+		"TUNGETIFF":                        reflect.ValueOf(uint32(syscall.TUNGETIFF)),
+		"TUNGETSNDBUF":                     reflect.ValueOf(uint32(syscall.TUNGETSNDBUF)),
+		"TUNGETVNETHDRSZ":                  reflect.ValueOf(uint32(syscall.TUNGETVNETHDRSZ)),
+		"TUNGETVNETLE":                     reflect.ValueOf(uint32(syscall.TUNGETVNETLE)),
+		"TUNSETDEBUG":                      reflect.ValueOf(syscall.TUNSETDEBUG),
+		"TUNSETGROUP":                      reflect.ValueOf(syscall.TUNSETGROUP),
+		"TUNSETIFF":                        reflect.ValueOf(syscall.TUNSETIFF),
+		"TUNSETIFINDEX":                    reflect.ValueOf(syscall.TUNSETIFINDEX),
+		"TUNSETLINK":                       reflect.ValueOf(syscall.TUNSETLINK),
+		"TUNSETNOCSUM":                     reflect.ValueOf(syscall.TUNSETNOCSUM),
+		"TUNSETOFFLOAD":                    reflect.ValueOf(syscall.TUNSETOFFLOAD),
+		"TUNSETOWNER":                      reflect.ValueOf(syscall.TUNSETOWNER),
+		"TUNSETPERSIST":                    reflect.ValueOf(syscall.TUNSETPERSIST),
+		"TUNSETQUEUE":                      reflect.ValueOf(syscall.TUNSETQUEUE),
+		"TUNSETSNDBUF":                     reflect.ValueOf(syscall.TUNSETSNDBUF),
+		"TUNSETTXFILTER":                   reflect.ValueOf(syscall.TUNSETTXFILTER),
+		"TUNSETVNETHDRSZ":                  reflect.ValueOf(syscall.TUNSETVNETHDRSZ),
+		"TUNSETVNETLE":                     reflect.ValueOf(syscall.TUNSETVNETLE),
+		"Tee":                              reflect.ValueOf(syscall.Tee),
+		"Tgkill":                           reflect.ValueOf(syscall.Tgkill),
+		"Time":                             reflect.ValueOf(syscall.Time),
+		"Times":                            reflect.ValueOf(syscall.Times),
+		"TimespecToNsec":                   reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                    reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                         reflect.ValueOf(syscall.Truncate),
+		"Umask":                            reflect.ValueOf(syscall.Umask),
+		"Uname":                            reflect.ValueOf(syscall.Uname),
+		"UnixCredentials":                  reflect.ValueOf(syscall.UnixCredentials),
+		"UnixRights":                       reflect.ValueOf(syscall.UnixRights),
+		"Unlink":                           reflect.ValueOf(syscall.Unlink),
+		"Unlinkat":                         reflect.ValueOf(syscall.Unlinkat),
+		"Unmount":                          reflect.ValueOf(syscall.Unmount),
+		"Unsetenv":                         reflect.ValueOf(syscall.Unsetenv),
+		"Unshare":                          reflect.ValueOf(syscall.Unshare),
+		"Utime":                            reflect.ValueOf(syscall.Utime),
+		"Utimes":                           reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                       reflect.ValueOf(syscall.UtimesNano),
+		"VDISCARD":                         reflect.ValueOf(syscall.VDISCARD),
+		"VEOF":                             reflect.ValueOf(syscall.VEOF),
+		"VEOL":                             reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                            reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                           reflect.ValueOf(syscall.VERASE),
+		"VINTR":                            reflect.ValueOf(syscall.VINTR),
+		"VKILL":                            reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                           reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                             reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                            reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                         reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                           reflect.ValueOf(syscall.VSTART),
+		"VSTOP":                            reflect.ValueOf(syscall.VSTOP),
+		"VSUSP":                            reflect.ValueOf(syscall.VSUSP),
+		"VSWTC":                            reflect.ValueOf(syscall.VSWTC),
+		"VT0":                              reflect.ValueOf(syscall.VT0),
+		"VT1":                              reflect.ValueOf(syscall.VT1),
+		"VTDLY":                            reflect.ValueOf(syscall.VTDLY),
+		"VTIME":                            reflect.ValueOf(syscall.VTIME),
+		"VWERASE":                          reflect.ValueOf(syscall.VWERASE),
+		"WALL":                             reflect.ValueOf(syscall.WALL),
+		"WCLONE":                           reflect.ValueOf(uint32(syscall.WCLONE)),
+		"WCONTINUED":                       reflect.ValueOf(syscall.WCONTINUED),
+		"WEXITED":                          reflect.ValueOf(syscall.WEXITED),
+		"WNOHANG":                          reflect.ValueOf(syscall.WNOHANG),
+		"WNOTHREAD":                        reflect.ValueOf(syscall.WNOTHREAD),
+		"WNOWAIT":                          reflect.ValueOf(syscall.WNOWAIT),
+		"WORDSIZE":                         reflect.ValueOf(syscall.WORDSIZE),
+		"WSTOPPED":                         reflect.ValueOf(syscall.WSTOPPED),
+		"WUNTRACED":                        reflect.ValueOf(syscall.WUNTRACED),
+		"Wait4":                            reflect.ValueOf(syscall.Wait4),
+		"Write":                            reflect.ValueOf(syscall.Write),
+		"XCASE":                            reflect.ValueOf(syscall.XCASE),
+
+		// type definitions
+		"Cmsghdr":              reflect.ValueOf((*syscall.Cmsghdr)(nil)),
+		"Conn":                 reflect.ValueOf((*syscall.Conn)(nil)),
+		"Credential":           reflect.ValueOf((*syscall.Credential)(nil)),
+		"Dirent":               reflect.ValueOf((*syscall.Dirent)(nil)),
+		"EpollEvent":           reflect.ValueOf((*syscall.EpollEvent)(nil)),
+		"Errno":                reflect.ValueOf((*syscall.Errno)(nil)),
+		"FdSet":                reflect.ValueOf((*syscall.FdSet)(nil)),
+		"Flock_t":              reflect.ValueOf((*syscall.Flock_t)(nil)),
+		"Fsid":                 reflect.ValueOf((*syscall.Fsid)(nil)),
+		"ICMPv6Filter":         reflect.ValueOf((*syscall.ICMPv6Filter)(nil)),
+		"IPMreq":               reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPMreqn":              reflect.ValueOf((*syscall.IPMreqn)(nil)),
+		"IPv6MTUInfo":          reflect.ValueOf((*syscall.IPv6MTUInfo)(nil)),
+		"IPv6Mreq":             reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"IfAddrmsg":            reflect.ValueOf((*syscall.IfAddrmsg)(nil)),
+		"IfInfomsg":            reflect.ValueOf((*syscall.IfInfomsg)(nil)),
+		"Inet4Pktinfo":         reflect.ValueOf((*syscall.Inet4Pktinfo)(nil)),
+		"Inet6Pktinfo":         reflect.ValueOf((*syscall.Inet6Pktinfo)(nil)),
+		"InotifyEvent":         reflect.ValueOf((*syscall.InotifyEvent)(nil)),
+		"Iovec":                reflect.ValueOf((*syscall.Iovec)(nil)),
+		"Linger":               reflect.ValueOf((*syscall.Linger)(nil)),
+		"Msghdr":               reflect.ValueOf((*syscall.Msghdr)(nil)),
+		"NetlinkMessage":       reflect.ValueOf((*syscall.NetlinkMessage)(nil)),
+		"NetlinkRouteAttr":     reflect.ValueOf((*syscall.NetlinkRouteAttr)(nil)),
+		"NetlinkRouteRequest":  reflect.ValueOf((*syscall.NetlinkRouteRequest)(nil)),
+		"NlAttr":               reflect.ValueOf((*syscall.NlAttr)(nil)),
+		"NlMsgerr":             reflect.ValueOf((*syscall.NlMsgerr)(nil)),
+		"NlMsghdr":             reflect.ValueOf((*syscall.NlMsghdr)(nil)),
+		"ProcAttr":             reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"PtraceRegs":           reflect.ValueOf((*syscall.PtraceRegs)(nil)),
+		"RawConn":              reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":          reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":       reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrInet4":     reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":     reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrLinklayer": reflect.ValueOf((*syscall.RawSockaddrLinklayer)(nil)),
+		"RawSockaddrNetlink":   reflect.ValueOf((*syscall.RawSockaddrNetlink)(nil)),
+		"RawSockaddrUnix":      reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rlimit":               reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"RtAttr":               reflect.ValueOf((*syscall.RtAttr)(nil)),
+		"RtGenmsg":             reflect.ValueOf((*syscall.RtGenmsg)(nil)),
+		"RtMsg":                reflect.ValueOf((*syscall.RtMsg)(nil)),
+		"RtNexthop":            reflect.ValueOf((*syscall.RtNexthop)(nil)),
+		"Rusage":               reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":               reflect.ValueOf((*syscall.Signal)(nil)),
+		"SockFilter":           reflect.ValueOf((*syscall.SockFilter)(nil)),
+		"SockFprog":            reflect.ValueOf((*syscall.SockFprog)(nil)),
+		"Sockaddr":             reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrInet4":        reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":        reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrLinklayer":    reflect.ValueOf((*syscall.SockaddrLinklayer)(nil)),
+		"SockaddrNetlink":      reflect.ValueOf((*syscall.SockaddrNetlink)(nil)),
+		"SockaddrUnix":         reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"SocketControlMessage": reflect.ValueOf((*syscall.SocketControlMessage)(nil)),
+		"Stat_t":               reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"Statfs_t":             reflect.ValueOf((*syscall.Statfs_t)(nil)),
+		"SysProcAttr":          reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"SysProcIDMap":         reflect.ValueOf((*syscall.SysProcIDMap)(nil)),
+		"Sysinfo_t":            reflect.ValueOf((*syscall.Sysinfo_t)(nil)),
+		"TCPInfo":              reflect.ValueOf((*syscall.TCPInfo)(nil)),
+		"Termios":              reflect.ValueOf((*syscall.Termios)(nil)),
+		"Time_t":               reflect.ValueOf((*syscall.Time_t)(nil)),
+		"Timespec":             reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":              reflect.ValueOf((*syscall.Timeval)(nil)),
+		"Timex":                reflect.ValueOf((*syscall.Timex)(nil)),
+		"Tms":                  reflect.ValueOf((*syscall.Tms)(nil)),
+		"Ucred":                reflect.ValueOf((*syscall.Ucred)(nil)),
+		"Ustat_t":              reflect.ValueOf((*syscall.Ustat_t)(nil)),
+		"Utimbuf":              reflect.ValueOf((*syscall.Utimbuf)(nil)),
+		"Utsname":              reflect.ValueOf((*syscall.Utsname)(nil)),
+		"WaitStatus":           reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":     reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":  reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_Sockaddr": reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
@@ -1,4 +1,5 @@
-// +build go1.12,!go1.13
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -232,6 +233,7 @@ func init() {
 		"EALREADY":                          reflect.ValueOf(syscall.EALREADY),
 		"EAUTH":                             reflect.ValueOf(syscall.EAUTH),
 		"EBADF":                             reflect.ValueOf(syscall.EBADF),
+		"EBADMSG":                           reflect.ValueOf(syscall.EBADMSG),
 		"EBADRPC":                           reflect.ValueOf(syscall.EBADRPC),
 		"EBUSY":                             reflect.ValueOf(syscall.EBUSY),
 		"ECANCELED":                         reflect.ValueOf(syscall.ECANCELED),
@@ -297,12 +299,14 @@ func init() {
 		"ENOTCONN":                          reflect.ValueOf(syscall.ENOTCONN),
 		"ENOTDIR":                           reflect.ValueOf(syscall.ENOTDIR),
 		"ENOTEMPTY":                         reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTRECOVERABLE":                   reflect.ValueOf(syscall.ENOTRECOVERABLE),
 		"ENOTSOCK":                          reflect.ValueOf(syscall.ENOTSOCK),
 		"ENOTSUP":                           reflect.ValueOf(syscall.ENOTSUP),
 		"ENOTTY":                            reflect.ValueOf(syscall.ENOTTY),
 		"ENXIO":                             reflect.ValueOf(syscall.ENXIO),
 		"EOPNOTSUPP":                        reflect.ValueOf(syscall.EOPNOTSUPP),
 		"EOVERFLOW":                         reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                        reflect.ValueOf(syscall.EOWNERDEAD),
 		"EPERM":                             reflect.ValueOf(syscall.EPERM),
 		"EPFNOSUPPORT":                      reflect.ValueOf(syscall.EPFNOSUPPORT),
 		"EPIPE":                             reflect.ValueOf(syscall.EPIPE),
@@ -310,6 +314,7 @@ func init() {
 		"EPROCUNAVAIL":                      reflect.ValueOf(syscall.EPROCUNAVAIL),
 		"EPROGMISMATCH":                     reflect.ValueOf(syscall.EPROGMISMATCH),
 		"EPROGUNAVAIL":                      reflect.ValueOf(syscall.EPROGUNAVAIL),
+		"EPROTO":                            reflect.ValueOf(syscall.EPROTO),
 		"EPROTONOSUPPORT":                   reflect.ValueOf(syscall.EPROTONOSUPPORT),
 		"EPROTOTYPE":                        reflect.ValueOf(syscall.EPROTOTYPE),
 		"ERANGE":                            reflect.ValueOf(syscall.ERANGE),
@@ -1070,6 +1075,7 @@ func init() {
 		"MCL_CURRENT":                       reflect.ValueOf(syscall.MCL_CURRENT),
 		"MCL_FUTURE":                        reflect.ValueOf(syscall.MCL_FUTURE),
 		"MSG_BCAST":                         reflect.ValueOf(syscall.MSG_BCAST),
+		"MSG_CMSG_CLOEXEC":                  reflect.ValueOf(syscall.MSG_CMSG_CLOEXEC),
 		"MSG_CTRUNC":                        reflect.ValueOf(syscall.MSG_CTRUNC),
 		"MSG_DONTROUTE":                     reflect.ValueOf(syscall.MSG_DONTROUTE),
 		"MSG_DONTWAIT":                      reflect.ValueOf(syscall.MSG_DONTWAIT),
@@ -1963,8 +1969,10 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
 type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
 }
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -0,0 +1,2104 @@
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_APPLETALK":                      reflect.ValueOf(syscall.AF_APPLETALK),
+		"AF_BLUETOOTH":                      reflect.ValueOf(syscall.AF_BLUETOOTH),
+		"AF_CCITT":                          reflect.ValueOf(syscall.AF_CCITT),
+		"AF_CHAOS":                          reflect.ValueOf(syscall.AF_CHAOS),
+		"AF_CNT":                            reflect.ValueOf(syscall.AF_CNT),
+		"AF_COIP":                           reflect.ValueOf(syscall.AF_COIP),
+		"AF_DATAKIT":                        reflect.ValueOf(syscall.AF_DATAKIT),
+		"AF_DECnet":                         reflect.ValueOf(syscall.AF_DECnet),
+		"AF_DLI":                            reflect.ValueOf(syscall.AF_DLI),
+		"AF_E164":                           reflect.ValueOf(syscall.AF_E164),
+		"AF_ECMA":                           reflect.ValueOf(syscall.AF_ECMA),
+		"AF_ENCAP":                          reflect.ValueOf(syscall.AF_ENCAP),
+		"AF_HYLINK":                         reflect.ValueOf(syscall.AF_HYLINK),
+		"AF_IMPLINK":                        reflect.ValueOf(syscall.AF_IMPLINK),
+		"AF_INET":                           reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                          reflect.ValueOf(syscall.AF_INET6),
+		"AF_IPX":                            reflect.ValueOf(syscall.AF_IPX),
+		"AF_ISDN":                           reflect.ValueOf(syscall.AF_ISDN),
+		"AF_ISO":                            reflect.ValueOf(syscall.AF_ISO),
+		"AF_KEY":                            reflect.ValueOf(syscall.AF_KEY),
+		"AF_LAT":                            reflect.ValueOf(syscall.AF_LAT),
+		"AF_LINK":                           reflect.ValueOf(syscall.AF_LINK),
+		"AF_LOCAL":                          reflect.ValueOf(syscall.AF_LOCAL),
+		"AF_MAX":                            reflect.ValueOf(syscall.AF_MAX),
+		"AF_MPLS":                           reflect.ValueOf(syscall.AF_MPLS),
+		"AF_NATM":                           reflect.ValueOf(syscall.AF_NATM),
+		"AF_NS":                             reflect.ValueOf(syscall.AF_NS),
+		"AF_OSI":                            reflect.ValueOf(syscall.AF_OSI),
+		"AF_PUP":                            reflect.ValueOf(syscall.AF_PUP),
+		"AF_ROUTE":                          reflect.ValueOf(syscall.AF_ROUTE),
+		"AF_SIP":                            reflect.ValueOf(syscall.AF_SIP),
+		"AF_SNA":                            reflect.ValueOf(syscall.AF_SNA),
+		"AF_UNIX":                           reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                         reflect.ValueOf(syscall.AF_UNSPEC),
+		"ARPHRD_ETHER":                      reflect.ValueOf(syscall.ARPHRD_ETHER),
+		"ARPHRD_FRELAY":                     reflect.ValueOf(syscall.ARPHRD_FRELAY),
+		"ARPHRD_IEEE1394":                   reflect.ValueOf(syscall.ARPHRD_IEEE1394),
+		"ARPHRD_IEEE802":                    reflect.ValueOf(syscall.ARPHRD_IEEE802),
+		"Accept":                            reflect.ValueOf(syscall.Accept),
+		"Accept4":                           reflect.ValueOf(syscall.Accept4),
+		"Access":                            reflect.ValueOf(syscall.Access),
+		"Adjtime":                           reflect.ValueOf(syscall.Adjtime),
+		"B0":                                reflect.ValueOf(syscall.B0),
+		"B110":                              reflect.ValueOf(syscall.B110),
+		"B115200":                           reflect.ValueOf(syscall.B115200),
+		"B1200":                             reflect.ValueOf(syscall.B1200),
+		"B134":                              reflect.ValueOf(syscall.B134),
+		"B14400":                            reflect.ValueOf(syscall.B14400),
+		"B150":                              reflect.ValueOf(syscall.B150),
+		"B1800":                             reflect.ValueOf(syscall.B1800),
+		"B19200":                            reflect.ValueOf(syscall.B19200),
+		"B200":                              reflect.ValueOf(syscall.B200),
+		"B230400":                           reflect.ValueOf(syscall.B230400),
+		"B2400":                             reflect.ValueOf(syscall.B2400),
+		"B28800":                            reflect.ValueOf(syscall.B28800),
+		"B300":                              reflect.ValueOf(syscall.B300),
+		"B38400":                            reflect.ValueOf(syscall.B38400),
+		"B4800":                             reflect.ValueOf(syscall.B4800),
+		"B50":                               reflect.ValueOf(syscall.B50),
+		"B57600":                            reflect.ValueOf(syscall.B57600),
+		"B600":                              reflect.ValueOf(syscall.B600),
+		"B7200":                             reflect.ValueOf(syscall.B7200),
+		"B75":                               reflect.ValueOf(syscall.B75),
+		"B76800":                            reflect.ValueOf(syscall.B76800),
+		"B9600":                             reflect.ValueOf(syscall.B9600),
+		"BIOCFLUSH":                         reflect.ValueOf(syscall.BIOCFLUSH),
+		"BIOCGBLEN":                         reflect.ValueOf(syscall.BIOCGBLEN),
+		"BIOCGDIRFILT":                      reflect.ValueOf(syscall.BIOCGDIRFILT),
+		"BIOCGDLT":                          reflect.ValueOf(syscall.BIOCGDLT),
+		"BIOCGDLTLIST":                      reflect.ValueOf(uint32(syscall.BIOCGDLTLIST)),
+		"BIOCGETIF":                         reflect.ValueOf(syscall.BIOCGETIF),
+		"BIOCGFILDROP":                      reflect.ValueOf(syscall.BIOCGFILDROP),
+		"BIOCGHDRCMPLT":                     reflect.ValueOf(syscall.BIOCGHDRCMPLT),
+		"BIOCGRSIG":                         reflect.ValueOf(syscall.BIOCGRSIG),
+		"BIOCGRTIMEOUT":                     reflect.ValueOf(syscall.BIOCGRTIMEOUT),
+		"BIOCGSTATS":                        reflect.ValueOf(syscall.BIOCGSTATS),
+		"BIOCIMMEDIATE":                     reflect.ValueOf(uint32(syscall.BIOCIMMEDIATE)),
+		"BIOCLOCK":                          reflect.ValueOf(syscall.BIOCLOCK),
+		"BIOCPROMISC":                       reflect.ValueOf(syscall.BIOCPROMISC),
+		"BIOCSBLEN":                         reflect.ValueOf(uint32(syscall.BIOCSBLEN)),
+		"BIOCSDIRFILT":                      reflect.ValueOf(uint32(syscall.BIOCSDIRFILT)),
+		"BIOCSDLT":                          reflect.ValueOf(uint32(syscall.BIOCSDLT)),
+		"BIOCSETF":                          reflect.ValueOf(uint32(syscall.BIOCSETF)),
+		"BIOCSETIF":                         reflect.ValueOf(uint32(syscall.BIOCSETIF)),
+		"BIOCSETWF":                         reflect.ValueOf(uint32(syscall.BIOCSETWF)),
+		"BIOCSFILDROP":                      reflect.ValueOf(uint32(syscall.BIOCSFILDROP)),
+		"BIOCSHDRCMPLT":                     reflect.ValueOf(uint32(syscall.BIOCSHDRCMPLT)),
+		"BIOCSRSIG":                         reflect.ValueOf(uint32(syscall.BIOCSRSIG)),
+		"BIOCSRTIMEOUT":                     reflect.ValueOf(uint32(syscall.BIOCSRTIMEOUT)),
+		"BIOCVERSION":                       reflect.ValueOf(syscall.BIOCVERSION),
+		"BPF_A":                             reflect.ValueOf(syscall.BPF_A),
+		"BPF_ABS":                           reflect.ValueOf(syscall.BPF_ABS),
+		"BPF_ADD":                           reflect.ValueOf(syscall.BPF_ADD),
+		"BPF_ALIGNMENT":                     reflect.ValueOf(syscall.BPF_ALIGNMENT),
+		"BPF_ALU":                           reflect.ValueOf(syscall.BPF_ALU),
+		"BPF_AND":                           reflect.ValueOf(syscall.BPF_AND),
+		"BPF_B":                             reflect.ValueOf(syscall.BPF_B),
+		"BPF_DIRECTION_IN":                  reflect.ValueOf(syscall.BPF_DIRECTION_IN),
+		"BPF_DIRECTION_OUT":                 reflect.ValueOf(syscall.BPF_DIRECTION_OUT),
+		"BPF_DIV":                           reflect.ValueOf(syscall.BPF_DIV),
+		"BPF_FILDROP_CAPTURE":               reflect.ValueOf(syscall.BPF_FILDROP_CAPTURE),
+		"BPF_FILDROP_DROP":                  reflect.ValueOf(syscall.BPF_FILDROP_DROP),
+		"BPF_FILDROP_PASS":                  reflect.ValueOf(syscall.BPF_FILDROP_PASS),
+		"BPF_F_DIR_IN":                      reflect.ValueOf(syscall.BPF_F_DIR_IN),
+		"BPF_F_DIR_MASK":                    reflect.ValueOf(syscall.BPF_F_DIR_MASK),
+		"BPF_F_DIR_OUT":                     reflect.ValueOf(syscall.BPF_F_DIR_OUT),
+		"BPF_F_DIR_SHIFT":                   reflect.ValueOf(syscall.BPF_F_DIR_SHIFT),
+		"BPF_F_FLOWID":                      reflect.ValueOf(syscall.BPF_F_FLOWID),
+		"BPF_F_PRI_MASK":                    reflect.ValueOf(syscall.BPF_F_PRI_MASK),
+		"BPF_H":                             reflect.ValueOf(syscall.BPF_H),
+		"BPF_IMM":                           reflect.ValueOf(syscall.BPF_IMM),
+		"BPF_IND":                           reflect.ValueOf(syscall.BPF_IND),
+		"BPF_JA":                            reflect.ValueOf(syscall.BPF_JA),
+		"BPF_JEQ":                           reflect.ValueOf(syscall.BPF_JEQ),
+		"BPF_JGE":                           reflect.ValueOf(syscall.BPF_JGE),
+		"BPF_JGT":                           reflect.ValueOf(syscall.BPF_JGT),
+		"BPF_JMP":                           reflect.ValueOf(syscall.BPF_JMP),
+		"BPF_JSET":                          reflect.ValueOf(syscall.BPF_JSET),
+		"BPF_K":                             reflect.ValueOf(syscall.BPF_K),
+		"BPF_LD":                            reflect.ValueOf(syscall.BPF_LD),
+		"BPF_LDX":                           reflect.ValueOf(syscall.BPF_LDX),
+		"BPF_LEN":                           reflect.ValueOf(syscall.BPF_LEN),
+		"BPF_LSH":                           reflect.ValueOf(syscall.BPF_LSH),
+		"BPF_MAJOR_VERSION":                 reflect.ValueOf(syscall.BPF_MAJOR_VERSION),
+		"BPF_MAXBUFSIZE":                    reflect.ValueOf(syscall.BPF_MAXBUFSIZE),
+		"BPF_MAXINSNS":                      reflect.ValueOf(syscall.BPF_MAXINSNS),
+		"BPF_MEM":                           reflect.ValueOf(syscall.BPF_MEM),
+		"BPF_MEMWORDS":                      reflect.ValueOf(syscall.BPF_MEMWORDS),
+		"BPF_MINBUFSIZE":                    reflect.ValueOf(syscall.BPF_MINBUFSIZE),
+		"BPF_MINOR_VERSION":                 reflect.ValueOf(syscall.BPF_MINOR_VERSION),
+		"BPF_MISC":                          reflect.ValueOf(syscall.BPF_MISC),
+		"BPF_MSH":                           reflect.ValueOf(syscall.BPF_MSH),
+		"BPF_MUL":                           reflect.ValueOf(syscall.BPF_MUL),
+		"BPF_NEG":                           reflect.ValueOf(syscall.BPF_NEG),
+		"BPF_OR":                            reflect.ValueOf(syscall.BPF_OR),
+		"BPF_RELEASE":                       reflect.ValueOf(syscall.BPF_RELEASE),
+		"BPF_RET":                           reflect.ValueOf(syscall.BPF_RET),
+		"BPF_RND":                           reflect.ValueOf(syscall.BPF_RND),
+		"BPF_RSH":                           reflect.ValueOf(syscall.BPF_RSH),
+		"BPF_ST":                            reflect.ValueOf(syscall.BPF_ST),
+		"BPF_STX":                           reflect.ValueOf(syscall.BPF_STX),
+		"BPF_SUB":                           reflect.ValueOf(syscall.BPF_SUB),
+		"BPF_TAX":                           reflect.ValueOf(syscall.BPF_TAX),
+		"BPF_TXA":                           reflect.ValueOf(syscall.BPF_TXA),
+		"BPF_W":                             reflect.ValueOf(syscall.BPF_W),
+		"BPF_X":                             reflect.ValueOf(syscall.BPF_X),
+		"BRKINT":                            reflect.ValueOf(syscall.BRKINT),
+		"Bind":                              reflect.ValueOf(syscall.Bind),
+		"BpfBuflen":                         reflect.ValueOf(syscall.BpfBuflen),
+		"BpfDatalink":                       reflect.ValueOf(syscall.BpfDatalink),
+		"BpfHeadercmpl":                     reflect.ValueOf(syscall.BpfHeadercmpl),
+		"BpfInterface":                      reflect.ValueOf(syscall.BpfInterface),
+		"BpfJump":                           reflect.ValueOf(syscall.BpfJump),
+		"BpfStats":                          reflect.ValueOf(syscall.BpfStats),
+		"BpfStmt":                           reflect.ValueOf(syscall.BpfStmt),
+		"BpfTimeout":                        reflect.ValueOf(syscall.BpfTimeout),
+		"BytePtrFromString":                 reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":               reflect.ValueOf(syscall.ByteSliceFromString),
+		"CFLUSH":                            reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                            reflect.ValueOf(syscall.CLOCAL),
+		"CREAD":                             reflect.ValueOf(syscall.CREAD),
+		"CS5":                               reflect.ValueOf(syscall.CS5),
+		"CS6":                               reflect.ValueOf(syscall.CS6),
+		"CS7":                               reflect.ValueOf(syscall.CS7),
+		"CS8":                               reflect.ValueOf(syscall.CS8),
+		"CSIZE":                             reflect.ValueOf(syscall.CSIZE),
+		"CSTART":                            reflect.ValueOf(syscall.CSTART),
+		"CSTATUS":                           reflect.ValueOf(syscall.CSTATUS),
+		"CSTOP":                             reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                            reflect.ValueOf(syscall.CSTOPB),
+		"CSUSP":                             reflect.ValueOf(syscall.CSUSP),
+		"CTL_MAXNAME":                       reflect.ValueOf(syscall.CTL_MAXNAME),
+		"CTL_NET":                           reflect.ValueOf(syscall.CTL_NET),
+		"Chdir":                             reflect.ValueOf(syscall.Chdir),
+		"CheckBpfVersion":                   reflect.ValueOf(syscall.CheckBpfVersion),
+		"Chflags":                           reflect.ValueOf(syscall.Chflags),
+		"Chmod":                             reflect.ValueOf(syscall.Chmod),
+		"Chown":                             reflect.ValueOf(syscall.Chown),
+		"Chroot":                            reflect.ValueOf(syscall.Chroot),
+		"Clearenv":                          reflect.ValueOf(syscall.Clearenv),
+		"Close":                             reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                       reflect.ValueOf(syscall.CloseOnExec),
+		"CmsgLen":                           reflect.ValueOf(syscall.CmsgLen),
+		"CmsgSpace":                         reflect.ValueOf(syscall.CmsgSpace),
+		"Connect":                           reflect.ValueOf(syscall.Connect),
+		"DIOCOSFPFLUSH":                     reflect.ValueOf(syscall.DIOCOSFPFLUSH),
+		"DLT_ARCNET":                        reflect.ValueOf(syscall.DLT_ARCNET),
+		"DLT_ATM_RFC1483":                   reflect.ValueOf(syscall.DLT_ATM_RFC1483),
+		"DLT_AX25":                          reflect.ValueOf(syscall.DLT_AX25),
+		"DLT_CHAOS":                         reflect.ValueOf(syscall.DLT_CHAOS),
+		"DLT_C_HDLC":                        reflect.ValueOf(syscall.DLT_C_HDLC),
+		"DLT_EN10MB":                        reflect.ValueOf(syscall.DLT_EN10MB),
+		"DLT_EN3MB":                         reflect.ValueOf(syscall.DLT_EN3MB),
+		"DLT_ENC":                           reflect.ValueOf(syscall.DLT_ENC),
+		"DLT_FDDI":                          reflect.ValueOf(syscall.DLT_FDDI),
+		"DLT_IEEE802":                       reflect.ValueOf(syscall.DLT_IEEE802),
+		"DLT_IEEE802_11":                    reflect.ValueOf(syscall.DLT_IEEE802_11),
+		"DLT_IEEE802_11_RADIO":              reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO),
+		"DLT_LOOP":                          reflect.ValueOf(syscall.DLT_LOOP),
+		"DLT_MPLS":                          reflect.ValueOf(syscall.DLT_MPLS),
+		"DLT_NULL":                          reflect.ValueOf(syscall.DLT_NULL),
+		"DLT_OPENFLOW":                      reflect.ValueOf(syscall.DLT_OPENFLOW),
+		"DLT_PFLOG":                         reflect.ValueOf(syscall.DLT_PFLOG),
+		"DLT_PFSYNC":                        reflect.ValueOf(syscall.DLT_PFSYNC),
+		"DLT_PPP":                           reflect.ValueOf(syscall.DLT_PPP),
+		"DLT_PPP_BSDOS":                     reflect.ValueOf(syscall.DLT_PPP_BSDOS),
+		"DLT_PPP_ETHER":                     reflect.ValueOf(syscall.DLT_PPP_ETHER),
+		"DLT_PPP_SERIAL":                    reflect.ValueOf(syscall.DLT_PPP_SERIAL),
+		"DLT_PRONET":                        reflect.ValueOf(syscall.DLT_PRONET),
+		"DLT_RAW":                           reflect.ValueOf(syscall.DLT_RAW),
+		"DLT_SLIP":                          reflect.ValueOf(syscall.DLT_SLIP),
+		"DLT_SLIP_BSDOS":                    reflect.ValueOf(syscall.DLT_SLIP_BSDOS),
+		"DLT_USBPCAP":                       reflect.ValueOf(syscall.DLT_USBPCAP),
+		"DLT_USER0":                         reflect.ValueOf(syscall.DLT_USER0),
+		"DLT_USER1":                         reflect.ValueOf(syscall.DLT_USER1),
+		"DLT_USER10":                        reflect.ValueOf(syscall.DLT_USER10),
+		"DLT_USER11":                        reflect.ValueOf(syscall.DLT_USER11),
+		"DLT_USER12":                        reflect.ValueOf(syscall.DLT_USER12),
+		"DLT_USER13":                        reflect.ValueOf(syscall.DLT_USER13),
+		"DLT_USER14":                        reflect.ValueOf(syscall.DLT_USER14),
+		"DLT_USER15":                        reflect.ValueOf(syscall.DLT_USER15),
+		"DLT_USER2":                         reflect.ValueOf(syscall.DLT_USER2),
+		"DLT_USER3":                         reflect.ValueOf(syscall.DLT_USER3),
+		"DLT_USER4":                         reflect.ValueOf(syscall.DLT_USER4),
+		"DLT_USER5":                         reflect.ValueOf(syscall.DLT_USER5),
+		"DLT_USER6":                         reflect.ValueOf(syscall.DLT_USER6),
+		"DLT_USER7":                         reflect.ValueOf(syscall.DLT_USER7),
+		"DLT_USER8":                         reflect.ValueOf(syscall.DLT_USER8),
+		"DLT_USER9":                         reflect.ValueOf(syscall.DLT_USER9),
+		"DT_BLK":                            reflect.ValueOf(syscall.DT_BLK),
+		"DT_CHR":                            reflect.ValueOf(syscall.DT_CHR),
+		"DT_DIR":                            reflect.ValueOf(syscall.DT_DIR),
+		"DT_FIFO":                           reflect.ValueOf(syscall.DT_FIFO),
+		"DT_LNK":                            reflect.ValueOf(syscall.DT_LNK),
+		"DT_REG":                            reflect.ValueOf(syscall.DT_REG),
+		"DT_SOCK":                           reflect.ValueOf(syscall.DT_SOCK),
+		"DT_UNKNOWN":                        reflect.ValueOf(syscall.DT_UNKNOWN),
+		"Dup":                               reflect.ValueOf(syscall.Dup),
+		"Dup2":                              reflect.ValueOf(syscall.Dup2),
+		"E2BIG":                             reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                            reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                        reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                     reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EAFNOSUPPORT":                      reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                            reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                          reflect.ValueOf(syscall.EALREADY),
+		"EAUTH":                             reflect.ValueOf(syscall.EAUTH),
+		"EBADF":                             reflect.ValueOf(syscall.EBADF),
+		"EBADMSG":                           reflect.ValueOf(syscall.EBADMSG),
+		"EBADRPC":                           reflect.ValueOf(syscall.EBADRPC),
+		"EBUSY":                             reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                         reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                            reflect.ValueOf(syscall.ECHILD),
+		"ECHO":                              reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                           reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                             reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                             reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                            reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                            reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                           reflect.ValueOf(syscall.ECHOPRT),
+		"ECONNABORTED":                      reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                      reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                        reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                           reflect.ValueOf(syscall.EDEADLK),
+		"EDESTADDRREQ":                      reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDOM":                              reflect.ValueOf(syscall.EDOM),
+		"EDQUOT":                            reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                            reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                            reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                             reflect.ValueOf(syscall.EFBIG),
+		"EFTYPE":                            reflect.ValueOf(syscall.EFTYPE),
+		"EHOSTDOWN":                         reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                      reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                             reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                            reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                       reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                             reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                            reflect.ValueOf(syscall.EINVAL),
+		"EIO":                               reflect.ValueOf(syscall.EIO),
+		"EIPSEC":                            reflect.ValueOf(syscall.EIPSEC),
+		"EISCONN":                           reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                            reflect.ValueOf(syscall.EISDIR),
+		"ELAST":                             reflect.ValueOf(syscall.ELAST),
+		"ELOOP":                             reflect.ValueOf(syscall.ELOOP),
+		"EMEDIUMTYPE":                       reflect.ValueOf(syscall.EMEDIUMTYPE),
+		"EMFILE":                            reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                            reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                          reflect.ValueOf(syscall.EMSGSIZE),
+		"EMT_TAGOVF":                        reflect.ValueOf(syscall.EMT_TAGOVF),
+		"EMUL_ENABLED":                      reflect.ValueOf(syscall.EMUL_ENABLED),
+		"EMUL_NATIVE":                       reflect.ValueOf(syscall.EMUL_NATIVE),
+		"ENAMETOOLONG":                      reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENDRUNDISC":                        reflect.ValueOf(syscall.ENDRUNDISC),
+		"ENEEDAUTH":                         reflect.ValueOf(syscall.ENEEDAUTH),
+		"ENETDOWN":                          reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                         reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                       reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                            reflect.ValueOf(syscall.ENFILE),
+		"ENOATTR":                           reflect.ValueOf(syscall.ENOATTR),
+		"ENOBUFS":                           reflect.ValueOf(syscall.ENOBUFS),
+		"ENODEV":                            reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                            reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                           reflect.ValueOf(syscall.ENOEXEC),
+		"ENOLCK":                            reflect.ValueOf(syscall.ENOLCK),
+		"ENOMEDIUM":                         reflect.ValueOf(syscall.ENOMEDIUM),
+		"ENOMEM":                            reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                            reflect.ValueOf(syscall.ENOMSG),
+		"ENOPROTOOPT":                       reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                            reflect.ValueOf(syscall.ENOSPC),
+		"ENOSYS":                            reflect.ValueOf(syscall.ENOSYS),
+		"ENOTBLK":                           reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCONN":                          reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                           reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                         reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTRECOVERABLE":                   reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                          reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                           reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                            reflect.ValueOf(syscall.ENOTTY),
+		"ENXIO":                             reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                        reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                         reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                        reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                             reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                      reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                             reflect.ValueOf(syscall.EPIPE),
+		"EPROCLIM":                          reflect.ValueOf(syscall.EPROCLIM),
+		"EPROCUNAVAIL":                      reflect.ValueOf(syscall.EPROCUNAVAIL),
+		"EPROGMISMATCH":                     reflect.ValueOf(syscall.EPROGMISMATCH),
+		"EPROGUNAVAIL":                      reflect.ValueOf(syscall.EPROGUNAVAIL),
+		"EPROTO":                            reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":                   reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                        reflect.ValueOf(syscall.EPROTOTYPE),
+		"ERANGE":                            reflect.ValueOf(syscall.ERANGE),
+		"EREMOTE":                           reflect.ValueOf(syscall.EREMOTE),
+		"EROFS":                             reflect.ValueOf(syscall.EROFS),
+		"ERPCMISMATCH":                      reflect.ValueOf(syscall.ERPCMISMATCH),
+		"ESHUTDOWN":                         reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":                   reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESPIPE":                            reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                             reflect.ValueOf(syscall.ESRCH),
+		"ESTALE":                            reflect.ValueOf(syscall.ESTALE),
+		"ETH64_8021_RSVD_MASK":              reflect.ValueOf(int64(syscall.ETH64_8021_RSVD_MASK)),
+		"ETH64_8021_RSVD_PREFIX":            reflect.ValueOf(int64(syscall.ETH64_8021_RSVD_PREFIX)),
+		"ETHERMIN":                          reflect.ValueOf(syscall.ETHERMIN),
+		"ETHERMTU":                          reflect.ValueOf(syscall.ETHERMTU),
+		"ETHERTYPE_8023":                    reflect.ValueOf(syscall.ETHERTYPE_8023),
+		"ETHERTYPE_AARP":                    reflect.ValueOf(syscall.ETHERTYPE_AARP),
+		"ETHERTYPE_ACCTON":                  reflect.ValueOf(syscall.ETHERTYPE_ACCTON),
+		"ETHERTYPE_AEONIC":                  reflect.ValueOf(syscall.ETHERTYPE_AEONIC),
+		"ETHERTYPE_ALPHA":                   reflect.ValueOf(syscall.ETHERTYPE_ALPHA),
+		"ETHERTYPE_AMBER":                   reflect.ValueOf(syscall.ETHERTYPE_AMBER),
+		"ETHERTYPE_AMOEBA":                  reflect.ValueOf(syscall.ETHERTYPE_AMOEBA),
+		"ETHERTYPE_AOE":                     reflect.ValueOf(syscall.ETHERTYPE_AOE),
+		"ETHERTYPE_APOLLO":                  reflect.ValueOf(syscall.ETHERTYPE_APOLLO),
+		"ETHERTYPE_APOLLODOMAIN":            reflect.ValueOf(syscall.ETHERTYPE_APOLLODOMAIN),
+		"ETHERTYPE_APPLETALK":               reflect.ValueOf(syscall.ETHERTYPE_APPLETALK),
+		"ETHERTYPE_APPLITEK":                reflect.ValueOf(syscall.ETHERTYPE_APPLITEK),
+		"ETHERTYPE_ARGONAUT":                reflect.ValueOf(syscall.ETHERTYPE_ARGONAUT),
+		"ETHERTYPE_ARP":                     reflect.ValueOf(syscall.ETHERTYPE_ARP),
+		"ETHERTYPE_AT":                      reflect.ValueOf(syscall.ETHERTYPE_AT),
+		"ETHERTYPE_ATALK":                   reflect.ValueOf(syscall.ETHERTYPE_ATALK),
+		"ETHERTYPE_ATOMIC":                  reflect.ValueOf(syscall.ETHERTYPE_ATOMIC),
+		"ETHERTYPE_ATT":                     reflect.ValueOf(syscall.ETHERTYPE_ATT),
+		"ETHERTYPE_ATTSTANFORD":             reflect.ValueOf(syscall.ETHERTYPE_ATTSTANFORD),
+		"ETHERTYPE_AUTOPHON":                reflect.ValueOf(syscall.ETHERTYPE_AUTOPHON),
+		"ETHERTYPE_AXIS":                    reflect.ValueOf(syscall.ETHERTYPE_AXIS),
+		"ETHERTYPE_BCLOOP":                  reflect.ValueOf(syscall.ETHERTYPE_BCLOOP),
+		"ETHERTYPE_BOFL":                    reflect.ValueOf(syscall.ETHERTYPE_BOFL),
+		"ETHERTYPE_CABLETRON":               reflect.ValueOf(syscall.ETHERTYPE_CABLETRON),
+		"ETHERTYPE_CHAOS":                   reflect.ValueOf(syscall.ETHERTYPE_CHAOS),
+		"ETHERTYPE_COMDESIGN":               reflect.ValueOf(syscall.ETHERTYPE_COMDESIGN),
+		"ETHERTYPE_COMPUGRAPHIC":            reflect.ValueOf(syscall.ETHERTYPE_COMPUGRAPHIC),
+		"ETHERTYPE_COUNTERPOINT":            reflect.ValueOf(syscall.ETHERTYPE_COUNTERPOINT),
+		"ETHERTYPE_CRONUS":                  reflect.ValueOf(syscall.ETHERTYPE_CRONUS),
+		"ETHERTYPE_CRONUSVLN":               reflect.ValueOf(syscall.ETHERTYPE_CRONUSVLN),
+		"ETHERTYPE_DCA":                     reflect.ValueOf(syscall.ETHERTYPE_DCA),
+		"ETHERTYPE_DDE":                     reflect.ValueOf(syscall.ETHERTYPE_DDE),
+		"ETHERTYPE_DEBNI":                   reflect.ValueOf(syscall.ETHERTYPE_DEBNI),
+		"ETHERTYPE_DECAM":                   reflect.ValueOf(syscall.ETHERTYPE_DECAM),
+		"ETHERTYPE_DECCUST":                 reflect.ValueOf(syscall.ETHERTYPE_DECCUST),
+		"ETHERTYPE_DECDIAG":                 reflect.ValueOf(syscall.ETHERTYPE_DECDIAG),
+		"ETHERTYPE_DECDNS":                  reflect.ValueOf(syscall.ETHERTYPE_DECDNS),
+		"ETHERTYPE_DECDTS":                  reflect.ValueOf(syscall.ETHERTYPE_DECDTS),
+		"ETHERTYPE_DECEXPER":                reflect.ValueOf(syscall.ETHERTYPE_DECEXPER),
+		"ETHERTYPE_DECLAST":                 reflect.ValueOf(syscall.ETHERTYPE_DECLAST),
+		"ETHERTYPE_DECLTM":                  reflect.ValueOf(syscall.ETHERTYPE_DECLTM),
+		"ETHERTYPE_DECMUMPS":                reflect.ValueOf(syscall.ETHERTYPE_DECMUMPS),
+		"ETHERTYPE_DECNETBIOS":              reflect.ValueOf(syscall.ETHERTYPE_DECNETBIOS),
+		"ETHERTYPE_DELTACON":                reflect.ValueOf(syscall.ETHERTYPE_DELTACON),
+		"ETHERTYPE_DIDDLE":                  reflect.ValueOf(syscall.ETHERTYPE_DIDDLE),
+		"ETHERTYPE_DLOG1":                   reflect.ValueOf(syscall.ETHERTYPE_DLOG1),
+		"ETHERTYPE_DLOG2":                   reflect.ValueOf(syscall.ETHERTYPE_DLOG2),
+		"ETHERTYPE_DN":                      reflect.ValueOf(syscall.ETHERTYPE_DN),
+		"ETHERTYPE_DOGFIGHT":                reflect.ValueOf(syscall.ETHERTYPE_DOGFIGHT),
+		"ETHERTYPE_DSMD":                    reflect.ValueOf(syscall.ETHERTYPE_DSMD),
+		"ETHERTYPE_EAPOL":                   reflect.ValueOf(syscall.ETHERTYPE_EAPOL),
+		"ETHERTYPE_ECMA":                    reflect.ValueOf(syscall.ETHERTYPE_ECMA),
+		"ETHERTYPE_ENCRYPT":                 reflect.ValueOf(syscall.ETHERTYPE_ENCRYPT),
+		"ETHERTYPE_ES":                      reflect.ValueOf(syscall.ETHERTYPE_ES),
+		"ETHERTYPE_EXCELAN":                 reflect.ValueOf(syscall.ETHERTYPE_EXCELAN),
+		"ETHERTYPE_EXPERDATA":               reflect.ValueOf(syscall.ETHERTYPE_EXPERDATA),
+		"ETHERTYPE_FLIP":                    reflect.ValueOf(syscall.ETHERTYPE_FLIP),
+		"ETHERTYPE_FLOWCONTROL":             reflect.ValueOf(syscall.ETHERTYPE_FLOWCONTROL),
+		"ETHERTYPE_FRARP":                   reflect.ValueOf(syscall.ETHERTYPE_FRARP),
+		"ETHERTYPE_GENDYN":                  reflect.ValueOf(syscall.ETHERTYPE_GENDYN),
+		"ETHERTYPE_HAYES":                   reflect.ValueOf(syscall.ETHERTYPE_HAYES),
+		"ETHERTYPE_HIPPI_FP":                reflect.ValueOf(syscall.ETHERTYPE_HIPPI_FP),
+		"ETHERTYPE_HITACHI":                 reflect.ValueOf(syscall.ETHERTYPE_HITACHI),
+		"ETHERTYPE_HP":                      reflect.ValueOf(syscall.ETHERTYPE_HP),
+		"ETHERTYPE_IEEEPUP":                 reflect.ValueOf(syscall.ETHERTYPE_IEEEPUP),
+		"ETHERTYPE_IEEEPUPAT":               reflect.ValueOf(syscall.ETHERTYPE_IEEEPUPAT),
+		"ETHERTYPE_IMLBL":                   reflect.ValueOf(syscall.ETHERTYPE_IMLBL),
+		"ETHERTYPE_IMLBLDIAG":               reflect.ValueOf(syscall.ETHERTYPE_IMLBLDIAG),
+		"ETHERTYPE_IP":                      reflect.ValueOf(syscall.ETHERTYPE_IP),
+		"ETHERTYPE_IPAS":                    reflect.ValueOf(syscall.ETHERTYPE_IPAS),
+		"ETHERTYPE_IPV6":                    reflect.ValueOf(syscall.ETHERTYPE_IPV6),
+		"ETHERTYPE_IPX":                     reflect.ValueOf(syscall.ETHERTYPE_IPX),
+		"ETHERTYPE_IPXNEW":                  reflect.ValueOf(syscall.ETHERTYPE_IPXNEW),
+		"ETHERTYPE_KALPANA":                 reflect.ValueOf(syscall.ETHERTYPE_KALPANA),
+		"ETHERTYPE_LANBRIDGE":               reflect.ValueOf(syscall.ETHERTYPE_LANBRIDGE),
+		"ETHERTYPE_LANPROBE":                reflect.ValueOf(syscall.ETHERTYPE_LANPROBE),
+		"ETHERTYPE_LAT":                     reflect.ValueOf(syscall.ETHERTYPE_LAT),
+		"ETHERTYPE_LBACK":                   reflect.ValueOf(syscall.ETHERTYPE_LBACK),
+		"ETHERTYPE_LITTLE":                  reflect.ValueOf(syscall.ETHERTYPE_LITTLE),
+		"ETHERTYPE_LLDP":                    reflect.ValueOf(syscall.ETHERTYPE_LLDP),
+		"ETHERTYPE_LOGICRAFT":               reflect.ValueOf(syscall.ETHERTYPE_LOGICRAFT),
+		"ETHERTYPE_LOOPBACK":                reflect.ValueOf(syscall.ETHERTYPE_LOOPBACK),
+		"ETHERTYPE_MACSEC":                  reflect.ValueOf(syscall.ETHERTYPE_MACSEC),
+		"ETHERTYPE_MATRA":                   reflect.ValueOf(syscall.ETHERTYPE_MATRA),
+		"ETHERTYPE_MAX":                     reflect.ValueOf(syscall.ETHERTYPE_MAX),
+		"ETHERTYPE_MERIT":                   reflect.ValueOf(syscall.ETHERTYPE_MERIT),
+		"ETHERTYPE_MICP":                    reflect.ValueOf(syscall.ETHERTYPE_MICP),
+		"ETHERTYPE_MOPDL":                   reflect.ValueOf(syscall.ETHERTYPE_MOPDL),
+		"ETHERTYPE_MOPRC":                   reflect.ValueOf(syscall.ETHERTYPE_MOPRC),
+		"ETHERTYPE_MOTOROLA":                reflect.ValueOf(syscall.ETHERTYPE_MOTOROLA),
+		"ETHERTYPE_MPLS":                    reflect.ValueOf(syscall.ETHERTYPE_MPLS),
+		"ETHERTYPE_MPLS_MCAST":              reflect.ValueOf(syscall.ETHERTYPE_MPLS_MCAST),
+		"ETHERTYPE_MUMPS":                   reflect.ValueOf(syscall.ETHERTYPE_MUMPS),
+		"ETHERTYPE_NBPCC":                   reflect.ValueOf(syscall.ETHERTYPE_NBPCC),
+		"ETHERTYPE_NBPCLAIM":                reflect.ValueOf(syscall.ETHERTYPE_NBPCLAIM),
+		"ETHERTYPE_NBPCLREQ":                reflect.ValueOf(syscall.ETHERTYPE_NBPCLREQ),
+		"ETHERTYPE_NBPCLRSP":                reflect.ValueOf(syscall.ETHERTYPE_NBPCLRSP),
+		"ETHERTYPE_NBPCREQ":                 reflect.ValueOf(syscall.ETHERTYPE_NBPCREQ),
+		"ETHERTYPE_NBPCRSP":                 reflect.ValueOf(syscall.ETHERTYPE_NBPCRSP),
+		"ETHERTYPE_NBPDG":                   reflect.ValueOf(syscall.ETHERTYPE_NBPDG),
+		"ETHERTYPE_NBPDGB":                  reflect.ValueOf(syscall.ETHERTYPE_NBPDGB),
+		"ETHERTYPE_NBPDLTE":                 reflect.ValueOf(syscall.ETHERTYPE_NBPDLTE),
+		"ETHERTYPE_NBPRAR":                  reflect.ValueOf(syscall.ETHERTYPE_NBPRAR),
+		"ETHERTYPE_NBPRAS":                  reflect.ValueOf(syscall.ETHERTYPE_NBPRAS),
+		"ETHERTYPE_NBPRST":                  reflect.ValueOf(syscall.ETHERTYPE_NBPRST),
+		"ETHERTYPE_NBPSCD":                  reflect.ValueOf(syscall.ETHERTYPE_NBPSCD),
+		"ETHERTYPE_NBPVCD":                  reflect.ValueOf(syscall.ETHERTYPE_NBPVCD),
+		"ETHERTYPE_NBS":                     reflect.ValueOf(syscall.ETHERTYPE_NBS),
+		"ETHERTYPE_NCD":                     reflect.ValueOf(syscall.ETHERTYPE_NCD),
+		"ETHERTYPE_NESTAR":                  reflect.ValueOf(syscall.ETHERTYPE_NESTAR),
+		"ETHERTYPE_NETBEUI":                 reflect.ValueOf(syscall.ETHERTYPE_NETBEUI),
+		"ETHERTYPE_NHRP":                    reflect.ValueOf(syscall.ETHERTYPE_NHRP),
+		"ETHERTYPE_NOVELL":                  reflect.ValueOf(syscall.ETHERTYPE_NOVELL),
+		"ETHERTYPE_NS":                      reflect.ValueOf(syscall.ETHERTYPE_NS),
+		"ETHERTYPE_NSAT":                    reflect.ValueOf(syscall.ETHERTYPE_NSAT),
+		"ETHERTYPE_NSCOMPAT":                reflect.ValueOf(syscall.ETHERTYPE_NSCOMPAT),
+		"ETHERTYPE_NSH":                     reflect.ValueOf(syscall.ETHERTYPE_NSH),
+		"ETHERTYPE_NTRAILER":                reflect.ValueOf(syscall.ETHERTYPE_NTRAILER),
+		"ETHERTYPE_OS9":                     reflect.ValueOf(syscall.ETHERTYPE_OS9),
+		"ETHERTYPE_OS9NET":                  reflect.ValueOf(syscall.ETHERTYPE_OS9NET),
+		"ETHERTYPE_PACER":                   reflect.ValueOf(syscall.ETHERTYPE_PACER),
+		"ETHERTYPE_PBB":                     reflect.ValueOf(syscall.ETHERTYPE_PBB),
+		"ETHERTYPE_PCS":                     reflect.ValueOf(syscall.ETHERTYPE_PCS),
+		"ETHERTYPE_PLANNING":                reflect.ValueOf(syscall.ETHERTYPE_PLANNING),
+		"ETHERTYPE_PPP":                     reflect.ValueOf(syscall.ETHERTYPE_PPP),
+		"ETHERTYPE_PPPOE":                   reflect.ValueOf(syscall.ETHERTYPE_PPPOE),
+		"ETHERTYPE_PPPOEDISC":               reflect.ValueOf(syscall.ETHERTYPE_PPPOEDISC),
+		"ETHERTYPE_PRIMENTS":                reflect.ValueOf(syscall.ETHERTYPE_PRIMENTS),
+		"ETHERTYPE_PUP":                     reflect.ValueOf(syscall.ETHERTYPE_PUP),
+		"ETHERTYPE_PUPAT":                   reflect.ValueOf(syscall.ETHERTYPE_PUPAT),
+		"ETHERTYPE_QINQ":                    reflect.ValueOf(syscall.ETHERTYPE_QINQ),
+		"ETHERTYPE_RACAL":                   reflect.ValueOf(syscall.ETHERTYPE_RACAL),
+		"ETHERTYPE_RATIONAL":                reflect.ValueOf(syscall.ETHERTYPE_RATIONAL),
+		"ETHERTYPE_RAWFR":                   reflect.ValueOf(syscall.ETHERTYPE_RAWFR),
+		"ETHERTYPE_RCL":                     reflect.ValueOf(syscall.ETHERTYPE_RCL),
+		"ETHERTYPE_RDP":                     reflect.ValueOf(syscall.ETHERTYPE_RDP),
+		"ETHERTYPE_RETIX":                   reflect.ValueOf(syscall.ETHERTYPE_RETIX),
+		"ETHERTYPE_REVARP":                  reflect.ValueOf(syscall.ETHERTYPE_REVARP),
+		"ETHERTYPE_SCA":                     reflect.ValueOf(syscall.ETHERTYPE_SCA),
+		"ETHERTYPE_SECTRA":                  reflect.ValueOf(syscall.ETHERTYPE_SECTRA),
+		"ETHERTYPE_SECUREDATA":              reflect.ValueOf(syscall.ETHERTYPE_SECUREDATA),
+		"ETHERTYPE_SGITW":                   reflect.ValueOf(syscall.ETHERTYPE_SGITW),
+		"ETHERTYPE_SG_BOUNCE":               reflect.ValueOf(syscall.ETHERTYPE_SG_BOUNCE),
+		"ETHERTYPE_SG_DIAG":                 reflect.ValueOf(syscall.ETHERTYPE_SG_DIAG),
+		"ETHERTYPE_SG_NETGAMES":             reflect.ValueOf(syscall.ETHERTYPE_SG_NETGAMES),
+		"ETHERTYPE_SG_RESV":                 reflect.ValueOf(syscall.ETHERTYPE_SG_RESV),
+		"ETHERTYPE_SIMNET":                  reflect.ValueOf(syscall.ETHERTYPE_SIMNET),
+		"ETHERTYPE_SLOW":                    reflect.ValueOf(syscall.ETHERTYPE_SLOW),
+		"ETHERTYPE_SNA":                     reflect.ValueOf(syscall.ETHERTYPE_SNA),
+		"ETHERTYPE_SNMP":                    reflect.ValueOf(syscall.ETHERTYPE_SNMP),
+		"ETHERTYPE_SONIX":                   reflect.ValueOf(syscall.ETHERTYPE_SONIX),
+		"ETHERTYPE_SPIDER":                  reflect.ValueOf(syscall.ETHERTYPE_SPIDER),
+		"ETHERTYPE_SPRITE":                  reflect.ValueOf(syscall.ETHERTYPE_SPRITE),
+		"ETHERTYPE_STP":                     reflect.ValueOf(syscall.ETHERTYPE_STP),
+		"ETHERTYPE_TALARIS":                 reflect.ValueOf(syscall.ETHERTYPE_TALARIS),
+		"ETHERTYPE_TALARISMC":               reflect.ValueOf(syscall.ETHERTYPE_TALARISMC),
+		"ETHERTYPE_TCPCOMP":                 reflect.ValueOf(syscall.ETHERTYPE_TCPCOMP),
+		"ETHERTYPE_TCPSM":                   reflect.ValueOf(syscall.ETHERTYPE_TCPSM),
+		"ETHERTYPE_TEC":                     reflect.ValueOf(syscall.ETHERTYPE_TEC),
+		"ETHERTYPE_TIGAN":                   reflect.ValueOf(syscall.ETHERTYPE_TIGAN),
+		"ETHERTYPE_TRAIL":                   reflect.ValueOf(syscall.ETHERTYPE_TRAIL),
+		"ETHERTYPE_TRANSETHER":              reflect.ValueOf(syscall.ETHERTYPE_TRANSETHER),
+		"ETHERTYPE_TYMSHARE":                reflect.ValueOf(syscall.ETHERTYPE_TYMSHARE),
+		"ETHERTYPE_UBBST":                   reflect.ValueOf(syscall.ETHERTYPE_UBBST),
+		"ETHERTYPE_UBDEBUG":                 reflect.ValueOf(syscall.ETHERTYPE_UBDEBUG),
+		"ETHERTYPE_UBDIAGLOOP":              reflect.ValueOf(syscall.ETHERTYPE_UBDIAGLOOP),
+		"ETHERTYPE_UBDL":                    reflect.ValueOf(syscall.ETHERTYPE_UBDL),
+		"ETHERTYPE_UBNIU":                   reflect.ValueOf(syscall.ETHERTYPE_UBNIU),
+		"ETHERTYPE_UBNMC":                   reflect.ValueOf(syscall.ETHERTYPE_UBNMC),
+		"ETHERTYPE_VALID":                   reflect.ValueOf(syscall.ETHERTYPE_VALID),
+		"ETHERTYPE_VARIAN":                  reflect.ValueOf(syscall.ETHERTYPE_VARIAN),
+		"ETHERTYPE_VAXELN":                  reflect.ValueOf(syscall.ETHERTYPE_VAXELN),
+		"ETHERTYPE_VEECO":                   reflect.ValueOf(syscall.ETHERTYPE_VEECO),
+		"ETHERTYPE_VEXP":                    reflect.ValueOf(syscall.ETHERTYPE_VEXP),
+		"ETHERTYPE_VGLAB":                   reflect.ValueOf(syscall.ETHERTYPE_VGLAB),
+		"ETHERTYPE_VINES":                   reflect.ValueOf(syscall.ETHERTYPE_VINES),
+		"ETHERTYPE_VINESECHO":               reflect.ValueOf(syscall.ETHERTYPE_VINESECHO),
+		"ETHERTYPE_VINESLOOP":               reflect.ValueOf(syscall.ETHERTYPE_VINESLOOP),
+		"ETHERTYPE_VITAL":                   reflect.ValueOf(syscall.ETHERTYPE_VITAL),
+		"ETHERTYPE_VLAN":                    reflect.ValueOf(syscall.ETHERTYPE_VLAN),
+		"ETHERTYPE_VLTLMAN":                 reflect.ValueOf(syscall.ETHERTYPE_VLTLMAN),
+		"ETHERTYPE_VPROD":                   reflect.ValueOf(syscall.ETHERTYPE_VPROD),
+		"ETHERTYPE_VURESERVED":              reflect.ValueOf(syscall.ETHERTYPE_VURESERVED),
+		"ETHERTYPE_WATERLOO":                reflect.ValueOf(syscall.ETHERTYPE_WATERLOO),
+		"ETHERTYPE_WELLFLEET":               reflect.ValueOf(syscall.ETHERTYPE_WELLFLEET),
+		"ETHERTYPE_X25":                     reflect.ValueOf(syscall.ETHERTYPE_X25),
+		"ETHERTYPE_X75":                     reflect.ValueOf(syscall.ETHERTYPE_X75),
+		"ETHERTYPE_XNSSM":                   reflect.ValueOf(syscall.ETHERTYPE_XNSSM),
+		"ETHERTYPE_XTP":                     reflect.ValueOf(syscall.ETHERTYPE_XTP),
+		"ETHER_ADDR_LEN":                    reflect.ValueOf(syscall.ETHER_ADDR_LEN),
+		"ETHER_ALIGN":                       reflect.ValueOf(syscall.ETHER_ALIGN),
+		"ETHER_CRC_LEN":                     reflect.ValueOf(syscall.ETHER_CRC_LEN),
+		"ETHER_CRC_POLY_BE":                 reflect.ValueOf(syscall.ETHER_CRC_POLY_BE),
+		"ETHER_CRC_POLY_LE":                 reflect.ValueOf(uint32(syscall.ETHER_CRC_POLY_LE)),
+		"ETHER_HDR_LEN":                     reflect.ValueOf(syscall.ETHER_HDR_LEN),
+		"ETHER_MAX_DIX_LEN":                 reflect.ValueOf(syscall.ETHER_MAX_DIX_LEN),
+		"ETHER_MAX_HARDMTU_LEN":             reflect.ValueOf(syscall.ETHER_MAX_HARDMTU_LEN),
+		"ETHER_MAX_LEN":                     reflect.ValueOf(syscall.ETHER_MAX_LEN),
+		"ETHER_MIN_LEN":                     reflect.ValueOf(syscall.ETHER_MIN_LEN),
+		"ETHER_TYPE_LEN":                    reflect.ValueOf(syscall.ETHER_TYPE_LEN),
+		"ETHER_VLAN_ENCAP_LEN":              reflect.ValueOf(syscall.ETHER_VLAN_ENCAP_LEN),
+		"ETIMEDOUT":                         reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                      reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                           reflect.ValueOf(syscall.ETXTBSY),
+		"EUSERS":                            reflect.ValueOf(syscall.EUSERS),
+		"EVFILT_AIO":                        reflect.ValueOf(syscall.EVFILT_AIO),
+		"EVFILT_DEVICE":                     reflect.ValueOf(syscall.EVFILT_DEVICE),
+		"EVFILT_EXCEPT":                     reflect.ValueOf(syscall.EVFILT_EXCEPT),
+		"EVFILT_PROC":                       reflect.ValueOf(syscall.EVFILT_PROC),
+		"EVFILT_READ":                       reflect.ValueOf(syscall.EVFILT_READ),
+		"EVFILT_SIGNAL":                     reflect.ValueOf(syscall.EVFILT_SIGNAL),
+		"EVFILT_SYSCOUNT":                   reflect.ValueOf(syscall.EVFILT_SYSCOUNT),
+		"EVFILT_TIMER":                      reflect.ValueOf(syscall.EVFILT_TIMER),
+		"EVFILT_VNODE":                      reflect.ValueOf(syscall.EVFILT_VNODE),
+		"EVFILT_WRITE":                      reflect.ValueOf(syscall.EVFILT_WRITE),
+		"EVL_ENCAPLEN":                      reflect.ValueOf(syscall.EVL_ENCAPLEN),
+		"EVL_PRIO_BITS":                     reflect.ValueOf(syscall.EVL_PRIO_BITS),
+		"EVL_PRIO_MAX":                      reflect.ValueOf(syscall.EVL_PRIO_MAX),
+		"EVL_VLID_MASK":                     reflect.ValueOf(syscall.EVL_VLID_MASK),
+		"EVL_VLID_MAX":                      reflect.ValueOf(syscall.EVL_VLID_MAX),
+		"EVL_VLID_MIN":                      reflect.ValueOf(syscall.EVL_VLID_MIN),
+		"EVL_VLID_NULL":                     reflect.ValueOf(syscall.EVL_VLID_NULL),
+		"EV_ADD":                            reflect.ValueOf(syscall.EV_ADD),
+		"EV_CLEAR":                          reflect.ValueOf(syscall.EV_CLEAR),
+		"EV_DELETE":                         reflect.ValueOf(syscall.EV_DELETE),
+		"EV_DISABLE":                        reflect.ValueOf(syscall.EV_DISABLE),
+		"EV_DISPATCH":                       reflect.ValueOf(syscall.EV_DISPATCH),
+		"EV_ENABLE":                         reflect.ValueOf(syscall.EV_ENABLE),
+		"EV_EOF":                            reflect.ValueOf(syscall.EV_EOF),
+		"EV_ERROR":                          reflect.ValueOf(syscall.EV_ERROR),
+		"EV_FLAG1":                          reflect.ValueOf(syscall.EV_FLAG1),
+		"EV_ONESHOT":                        reflect.ValueOf(syscall.EV_ONESHOT),
+		"EV_RECEIPT":                        reflect.ValueOf(syscall.EV_RECEIPT),
+		"EV_SYSFLAGS":                       reflect.ValueOf(syscall.EV_SYSFLAGS),
+		"EWOULDBLOCK":                       reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EXDEV":                             reflect.ValueOf(syscall.EXDEV),
+		"EXTA":                              reflect.ValueOf(syscall.EXTA),
+		"EXTB":                              reflect.ValueOf(syscall.EXTB),
+		"EXTPROC":                           reflect.ValueOf(syscall.EXTPROC),
+		"Environ":                           reflect.ValueOf(syscall.Environ),
+		"Exec":                              reflect.ValueOf(syscall.Exec),
+		"Exit":                              reflect.ValueOf(syscall.Exit),
+		"FD_CLOEXEC":                        reflect.ValueOf(syscall.FD_CLOEXEC),
+		"FD_SETSIZE":                        reflect.ValueOf(syscall.FD_SETSIZE),
+		"FLUSHO":                            reflect.ValueOf(syscall.FLUSHO),
+		"F_DUPFD":                           reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":                   reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_GETFD":                           reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                           reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLK":                           reflect.ValueOf(syscall.F_GETLK),
+		"F_GETOWN":                          reflect.ValueOf(syscall.F_GETOWN),
+		"F_ISATTY":                          reflect.ValueOf(syscall.F_ISATTY),
+		"F_RDLCK":                           reflect.ValueOf(syscall.F_RDLCK),
+		"F_SETFD":                           reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                           reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLK":                           reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLKW":                          reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETOWN":                          reflect.ValueOf(syscall.F_SETOWN),
+		"F_UNLCK":                           reflect.ValueOf(syscall.F_UNLCK),
+		"F_WRLCK":                           reflect.ValueOf(syscall.F_WRLCK),
+		"Fchdir":                            reflect.ValueOf(syscall.Fchdir),
+		"Fchflags":                          reflect.ValueOf(syscall.Fchflags),
+		"Fchmod":                            reflect.ValueOf(syscall.Fchmod),
+		"Fchown":                            reflect.ValueOf(syscall.Fchown),
+		"FcntlFlock":                        reflect.ValueOf(syscall.FcntlFlock),
+		"Flock":                             reflect.ValueOf(syscall.Flock),
+		"FlushBpf":                          reflect.ValueOf(syscall.FlushBpf),
+		"ForkExec":                          reflect.ValueOf(syscall.ForkExec),
+		"ForkLock":                          reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"Fpathconf":                         reflect.ValueOf(syscall.Fpathconf),
+		"Fstat":                             reflect.ValueOf(syscall.Fstat),
+		"Fstatfs":                           reflect.ValueOf(syscall.Fstatfs),
+		"Fsync":                             reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                         reflect.ValueOf(syscall.Ftruncate),
+		"Futimes":                           reflect.ValueOf(syscall.Futimes),
+		"Getdirentries":                     reflect.ValueOf(syscall.Getdirentries),
+		"Getegid":                           reflect.ValueOf(syscall.Getegid),
+		"Getenv":                            reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                           reflect.ValueOf(syscall.Geteuid),
+		"Getfsstat":                         reflect.ValueOf(syscall.Getfsstat),
+		"Getgid":                            reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                         reflect.ValueOf(syscall.Getgroups),
+		"Getpagesize":                       reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                       reflect.ValueOf(syscall.Getpeername),
+		"Getpgid":                           reflect.ValueOf(syscall.Getpgid),
+		"Getpgrp":                           reflect.ValueOf(syscall.Getpgrp),
+		"Getpid":                            reflect.ValueOf(syscall.Getpid),
+		"Getppid":                           reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                       reflect.ValueOf(syscall.Getpriority),
+		"Getrlimit":                         reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                         reflect.ValueOf(syscall.Getrusage),
+		"Getsid":                            reflect.ValueOf(syscall.Getsid),
+		"Getsockname":                       reflect.ValueOf(syscall.Getsockname),
+		"GetsockoptByte":                    reflect.ValueOf(syscall.GetsockoptByte),
+		"GetsockoptICMPv6Filter":            reflect.ValueOf(syscall.GetsockoptICMPv6Filter),
+		"GetsockoptIPMreq":                  reflect.ValueOf(syscall.GetsockoptIPMreq),
+		"GetsockoptIPv6MTUInfo":             reflect.ValueOf(syscall.GetsockoptIPv6MTUInfo),
+		"GetsockoptIPv6Mreq":                reflect.ValueOf(syscall.GetsockoptIPv6Mreq),
+		"GetsockoptInet4Addr":               reflect.ValueOf(syscall.GetsockoptInet4Addr),
+		"GetsockoptInt":                     reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                      reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                            reflect.ValueOf(syscall.Getuid),
+		"Getwd":                             reflect.ValueOf(syscall.Getwd),
+		"HUPCL":                             reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                            reflect.ValueOf(syscall.ICANON),
+		"ICMP6_FILTER":                      reflect.ValueOf(syscall.ICMP6_FILTER),
+		"ICRNL":                             reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                            reflect.ValueOf(syscall.IEXTEN),
+		"IFAN_ARRIVAL":                      reflect.ValueOf(syscall.IFAN_ARRIVAL),
+		"IFAN_DEPARTURE":                    reflect.ValueOf(syscall.IFAN_DEPARTURE),
+		"IFF_ALLMULTI":                      reflect.ValueOf(syscall.IFF_ALLMULTI),
+		"IFF_BROADCAST":                     reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_CANTCHANGE":                    reflect.ValueOf(syscall.IFF_CANTCHANGE),
+		"IFF_DEBUG":                         reflect.ValueOf(syscall.IFF_DEBUG),
+		"IFF_LINK0":                         reflect.ValueOf(syscall.IFF_LINK0),
+		"IFF_LINK1":                         reflect.ValueOf(syscall.IFF_LINK1),
+		"IFF_LINK2":                         reflect.ValueOf(syscall.IFF_LINK2),
+		"IFF_LOOPBACK":                      reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_MULTICAST":                     reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_NOARP":                         reflect.ValueOf(syscall.IFF_NOARP),
+		"IFF_OACTIVE":                       reflect.ValueOf(syscall.IFF_OACTIVE),
+		"IFF_POINTOPOINT":                   reflect.ValueOf(syscall.IFF_POINTOPOINT),
+		"IFF_PROMISC":                       reflect.ValueOf(syscall.IFF_PROMISC),
+		"IFF_RUNNING":                       reflect.ValueOf(syscall.IFF_RUNNING),
+		"IFF_SIMPLEX":                       reflect.ValueOf(syscall.IFF_SIMPLEX),
+		"IFF_STATICARP":                     reflect.ValueOf(syscall.IFF_STATICARP),
+		"IFF_UP":                            reflect.ValueOf(syscall.IFF_UP),
+		"IFNAMSIZ":                          reflect.ValueOf(syscall.IFNAMSIZ),
+		"IFT_1822":                          reflect.ValueOf(syscall.IFT_1822),
+		"IFT_A12MPPSWITCH":                  reflect.ValueOf(syscall.IFT_A12MPPSWITCH),
+		"IFT_AAL2":                          reflect.ValueOf(syscall.IFT_AAL2),
+		"IFT_AAL5":                          reflect.ValueOf(syscall.IFT_AAL5),
+		"IFT_ADSL":                          reflect.ValueOf(syscall.IFT_ADSL),
+		"IFT_AFLANE8023":                    reflect.ValueOf(syscall.IFT_AFLANE8023),
+		"IFT_AFLANE8025":                    reflect.ValueOf(syscall.IFT_AFLANE8025),
+		"IFT_ARAP":                          reflect.ValueOf(syscall.IFT_ARAP),
+		"IFT_ARCNET":                        reflect.ValueOf(syscall.IFT_ARCNET),
+		"IFT_ARCNETPLUS":                    reflect.ValueOf(syscall.IFT_ARCNETPLUS),
+		"IFT_ASYNC":                         reflect.ValueOf(syscall.IFT_ASYNC),
+		"IFT_ATM":                           reflect.ValueOf(syscall.IFT_ATM),
+		"IFT_ATMDXI":                        reflect.ValueOf(syscall.IFT_ATMDXI),
+		"IFT_ATMFUNI":                       reflect.ValueOf(syscall.IFT_ATMFUNI),
+		"IFT_ATMIMA":                        reflect.ValueOf(syscall.IFT_ATMIMA),
+		"IFT_ATMLOGICAL":                    reflect.ValueOf(syscall.IFT_ATMLOGICAL),
+		"IFT_ATMRADIO":                      reflect.ValueOf(syscall.IFT_ATMRADIO),
+		"IFT_ATMSUBINTERFACE":               reflect.ValueOf(syscall.IFT_ATMSUBINTERFACE),
+		"IFT_ATMVCIENDPT":                   reflect.ValueOf(syscall.IFT_ATMVCIENDPT),
+		"IFT_ATMVIRTUAL":                    reflect.ValueOf(syscall.IFT_ATMVIRTUAL),
+		"IFT_BGPPOLICYACCOUNTING":           reflect.ValueOf(syscall.IFT_BGPPOLICYACCOUNTING),
+		"IFT_BLUETOOTH":                     reflect.ValueOf(syscall.IFT_BLUETOOTH),
+		"IFT_BRIDGE":                        reflect.ValueOf(syscall.IFT_BRIDGE),
+		"IFT_BSC":                           reflect.ValueOf(syscall.IFT_BSC),
+		"IFT_CARP":                          reflect.ValueOf(syscall.IFT_CARP),
+		"IFT_CCTEMUL":                       reflect.ValueOf(syscall.IFT_CCTEMUL),
+		"IFT_CEPT":                          reflect.ValueOf(syscall.IFT_CEPT),
+		"IFT_CES":                           reflect.ValueOf(syscall.IFT_CES),
+		"IFT_CHANNEL":                       reflect.ValueOf(syscall.IFT_CHANNEL),
+		"IFT_CNR":                           reflect.ValueOf(syscall.IFT_CNR),
+		"IFT_COFFEE":                        reflect.ValueOf(syscall.IFT_COFFEE),
+		"IFT_COMPOSITELINK":                 reflect.ValueOf(syscall.IFT_COMPOSITELINK),
+		"IFT_DCN":                           reflect.ValueOf(syscall.IFT_DCN),
+		"IFT_DIGITALPOWERLINE":              reflect.ValueOf(syscall.IFT_DIGITALPOWERLINE),
+		"IFT_DIGITALWRAPPEROVERHEADCHANNEL": reflect.ValueOf(syscall.IFT_DIGITALWRAPPEROVERHEADCHANNEL),
+		"IFT_DLSW":                          reflect.ValueOf(syscall.IFT_DLSW),
+		"IFT_DOCSCABLEDOWNSTREAM":           reflect.ValueOf(syscall.IFT_DOCSCABLEDOWNSTREAM),
+		"IFT_DOCSCABLEMACLAYER":             reflect.ValueOf(syscall.IFT_DOCSCABLEMACLAYER),
+		"IFT_DOCSCABLEUPSTREAM":             reflect.ValueOf(syscall.IFT_DOCSCABLEUPSTREAM),
+		"IFT_DOCSCABLEUPSTREAMCHANNEL":      reflect.ValueOf(syscall.IFT_DOCSCABLEUPSTREAMCHANNEL),
+		"IFT_DS0":                           reflect.ValueOf(syscall.IFT_DS0),
+		"IFT_DS0BUNDLE":                     reflect.ValueOf(syscall.IFT_DS0BUNDLE),
+		"IFT_DS1FDL":                        reflect.ValueOf(syscall.IFT_DS1FDL),
+		"IFT_DS3":                           reflect.ValueOf(syscall.IFT_DS3),
+		"IFT_DTM":                           reflect.ValueOf(syscall.IFT_DTM),
+		"IFT_DUMMY":                         reflect.ValueOf(syscall.IFT_DUMMY),
+		"IFT_DVBASILN":                      reflect.ValueOf(syscall.IFT_DVBASILN),
+		"IFT_DVBASIOUT":                     reflect.ValueOf(syscall.IFT_DVBASIOUT),
+		"IFT_DVBRCCDOWNSTREAM":              reflect.ValueOf(syscall.IFT_DVBRCCDOWNSTREAM),
+		"IFT_DVBRCCMACLAYER":                reflect.ValueOf(syscall.IFT_DVBRCCMACLAYER),
+		"IFT_DVBRCCUPSTREAM":                reflect.ValueOf(syscall.IFT_DVBRCCUPSTREAM),
+		"IFT_ECONET":                        reflect.ValueOf(syscall.IFT_ECONET),
+		"IFT_ENC":                           reflect.ValueOf(syscall.IFT_ENC),
+		"IFT_EON":                           reflect.ValueOf(syscall.IFT_EON),
+		"IFT_EPLRS":                         reflect.ValueOf(syscall.IFT_EPLRS),
+		"IFT_ESCON":                         reflect.ValueOf(syscall.IFT_ESCON),
+		"IFT_ETHER":                         reflect.ValueOf(syscall.IFT_ETHER),
+		"IFT_FAITH":                         reflect.ValueOf(syscall.IFT_FAITH),
+		"IFT_FAST":                          reflect.ValueOf(syscall.IFT_FAST),
+		"IFT_FASTETHER":                     reflect.ValueOf(syscall.IFT_FASTETHER),
+		"IFT_FASTETHERFX":                   reflect.ValueOf(syscall.IFT_FASTETHERFX),
+		"IFT_FDDI":                          reflect.ValueOf(syscall.IFT_FDDI),
+		"IFT_FIBRECHANNEL":                  reflect.ValueOf(syscall.IFT_FIBRECHANNEL),
+		"IFT_FRAMERELAYINTERCONNECT":        reflect.ValueOf(syscall.IFT_FRAMERELAYINTERCONNECT),
+		"IFT_FRAMERELAYMPI":                 reflect.ValueOf(syscall.IFT_FRAMERELAYMPI),
+		"IFT_FRDLCIENDPT":                   reflect.ValueOf(syscall.IFT_FRDLCIENDPT),
+		"IFT_FRELAY":                        reflect.ValueOf(syscall.IFT_FRELAY),
+		"IFT_FRELAYDCE":                     reflect.ValueOf(syscall.IFT_FRELAYDCE),
+		"IFT_FRF16MFRBUNDLE":                reflect.ValueOf(syscall.IFT_FRF16MFRBUNDLE),
+		"IFT_FRFORWARD":                     reflect.ValueOf(syscall.IFT_FRFORWARD),
+		"IFT_G703AT2MB":                     reflect.ValueOf(syscall.IFT_G703AT2MB),
+		"IFT_G703AT64K":                     reflect.ValueOf(syscall.IFT_G703AT64K),
+		"IFT_GIF":                           reflect.ValueOf(syscall.IFT_GIF),
+		"IFT_GIGABITETHERNET":               reflect.ValueOf(syscall.IFT_GIGABITETHERNET),
+		"IFT_GR303IDT":                      reflect.ValueOf(syscall.IFT_GR303IDT),
+		"IFT_GR303RDT":                      reflect.ValueOf(syscall.IFT_GR303RDT),
+		"IFT_H323GATEKEEPER":                reflect.ValueOf(syscall.IFT_H323GATEKEEPER),
+		"IFT_H323PROXY":                     reflect.ValueOf(syscall.IFT_H323PROXY),
+		"IFT_HDH1822":                       reflect.ValueOf(syscall.IFT_HDH1822),
+		"IFT_HDLC":                          reflect.ValueOf(syscall.IFT_HDLC),
+		"IFT_HDSL2":                         reflect.ValueOf(syscall.IFT_HDSL2),
+		"IFT_HIPERLAN2":                     reflect.ValueOf(syscall.IFT_HIPERLAN2),
+		"IFT_HIPPI":                         reflect.ValueOf(syscall.IFT_HIPPI),
+		"IFT_HIPPIINTERFACE":                reflect.ValueOf(syscall.IFT_HIPPIINTERFACE),
+		"IFT_HOSTPAD":                       reflect.ValueOf(syscall.IFT_HOSTPAD),
+		"IFT_HSSI":                          reflect.ValueOf(syscall.IFT_HSSI),
+		"IFT_HY":                            reflect.ValueOf(syscall.IFT_HY),
+		"IFT_IBM370PARCHAN":                 reflect.ValueOf(syscall.IFT_IBM370PARCHAN),
+		"IFT_IDSL":                          reflect.ValueOf(syscall.IFT_IDSL),
+		"IFT_IEEE1394":                      reflect.ValueOf(syscall.IFT_IEEE1394),
+		"IFT_IEEE80211":                     reflect.ValueOf(syscall.IFT_IEEE80211),
+		"IFT_IEEE80212":                     reflect.ValueOf(syscall.IFT_IEEE80212),
+		"IFT_IEEE8023ADLAG":                 reflect.ValueOf(syscall.IFT_IEEE8023ADLAG),
+		"IFT_IFGSN":                         reflect.ValueOf(syscall.IFT_IFGSN),
+		"IFT_IMT":                           reflect.ValueOf(syscall.IFT_IMT),
+		"IFT_INFINIBAND":                    reflect.ValueOf(syscall.IFT_INFINIBAND),
+		"IFT_INTERLEAVE":                    reflect.ValueOf(syscall.IFT_INTERLEAVE),
+		"IFT_IP":                            reflect.ValueOf(syscall.IFT_IP),
+		"IFT_IPFORWARD":                     reflect.ValueOf(syscall.IFT_IPFORWARD),
+		"IFT_IPOVERATM":                     reflect.ValueOf(syscall.IFT_IPOVERATM),
+		"IFT_IPOVERCDLC":                    reflect.ValueOf(syscall.IFT_IPOVERCDLC),
+		"IFT_IPOVERCLAW":                    reflect.ValueOf(syscall.IFT_IPOVERCLAW),
+		"IFT_IPSWITCH":                      reflect.ValueOf(syscall.IFT_IPSWITCH),
+		"IFT_ISDN":                          reflect.ValueOf(syscall.IFT_ISDN),
+		"IFT_ISDNBASIC":                     reflect.ValueOf(syscall.IFT_ISDNBASIC),
+		"IFT_ISDNPRIMARY":                   reflect.ValueOf(syscall.IFT_ISDNPRIMARY),
+		"IFT_ISDNS":                         reflect.ValueOf(syscall.IFT_ISDNS),
+		"IFT_ISDNU":                         reflect.ValueOf(syscall.IFT_ISDNU),
+		"IFT_ISO88022LLC":                   reflect.ValueOf(syscall.IFT_ISO88022LLC),
+		"IFT_ISO88023":                      reflect.ValueOf(syscall.IFT_ISO88023),
+		"IFT_ISO88024":                      reflect.ValueOf(syscall.IFT_ISO88024),
+		"IFT_ISO88025":                      reflect.ValueOf(syscall.IFT_ISO88025),
+		"IFT_ISO88025CRFPINT":               reflect.ValueOf(syscall.IFT_ISO88025CRFPINT),
+		"IFT_ISO88025DTR":                   reflect.ValueOf(syscall.IFT_ISO88025DTR),
+		"IFT_ISO88025FIBER":                 reflect.ValueOf(syscall.IFT_ISO88025FIBER),
+		"IFT_ISO88026":                      reflect.ValueOf(syscall.IFT_ISO88026),
+		"IFT_ISUP":                          reflect.ValueOf(syscall.IFT_ISUP),
+		"IFT_L2VLAN":                        reflect.ValueOf(syscall.IFT_L2VLAN),
+		"IFT_L3IPVLAN":                      reflect.ValueOf(syscall.IFT_L3IPVLAN),
+		"IFT_L3IPXVLAN":                     reflect.ValueOf(syscall.IFT_L3IPXVLAN),
+		"IFT_LAPB":                          reflect.ValueOf(syscall.IFT_LAPB),
+		"IFT_LAPD":                          reflect.ValueOf(syscall.IFT_LAPD),
+		"IFT_LAPF":                          reflect.ValueOf(syscall.IFT_LAPF),
+		"IFT_LINEGROUP":                     reflect.ValueOf(syscall.IFT_LINEGROUP),
+		"IFT_LOCALTALK":                     reflect.ValueOf(syscall.IFT_LOCALTALK),
+		"IFT_LOOP":                          reflect.ValueOf(syscall.IFT_LOOP),
+		"IFT_MBIM":                          reflect.ValueOf(syscall.IFT_MBIM),
+		"IFT_MEDIAMAILOVERIP":               reflect.ValueOf(syscall.IFT_MEDIAMAILOVERIP),
+		"IFT_MFSIGLINK":                     reflect.ValueOf(syscall.IFT_MFSIGLINK),
+		"IFT_MIOX25":                        reflect.ValueOf(syscall.IFT_MIOX25),
+		"IFT_MODEM":                         reflect.ValueOf(syscall.IFT_MODEM),
+		"IFT_MPC":                           reflect.ValueOf(syscall.IFT_MPC),
+		"IFT_MPLS":                          reflect.ValueOf(syscall.IFT_MPLS),
+		"IFT_MPLSTUNNEL":                    reflect.ValueOf(syscall.IFT_MPLSTUNNEL),
+		"IFT_MSDSL":                         reflect.ValueOf(syscall.IFT_MSDSL),
+		"IFT_MVL":                           reflect.ValueOf(syscall.IFT_MVL),
+		"IFT_MYRINET":                       reflect.ValueOf(syscall.IFT_MYRINET),
+		"IFT_NFAS":                          reflect.ValueOf(syscall.IFT_NFAS),
+		"IFT_NSIP":                          reflect.ValueOf(syscall.IFT_NSIP),
+		"IFT_OPTICALCHANNEL":                reflect.ValueOf(syscall.IFT_OPTICALCHANNEL),
+		"IFT_OPTICALTRANSPORT":              reflect.ValueOf(syscall.IFT_OPTICALTRANSPORT),
+		"IFT_OTHER":                         reflect.ValueOf(syscall.IFT_OTHER),
+		"IFT_P10":                           reflect.ValueOf(syscall.IFT_P10),
+		"IFT_P80":                           reflect.ValueOf(syscall.IFT_P80),
+		"IFT_PARA":                          reflect.ValueOf(syscall.IFT_PARA),
+		"IFT_PFLOG":                         reflect.ValueOf(syscall.IFT_PFLOG),
+		"IFT_PFLOW":                         reflect.ValueOf(syscall.IFT_PFLOW),
+		"IFT_PFSYNC":                        reflect.ValueOf(syscall.IFT_PFSYNC),
+		"IFT_PLC":                           reflect.ValueOf(syscall.IFT_PLC),
+		"IFT_PON155":                        reflect.ValueOf(syscall.IFT_PON155),
+		"IFT_PON622":                        reflect.ValueOf(syscall.IFT_PON622),
+		"IFT_POS":                           reflect.ValueOf(syscall.IFT_POS),
+		"IFT_PPP":                           reflect.ValueOf(syscall.IFT_PPP),
+		"IFT_PPPMULTILINKBUNDLE":            reflect.ValueOf(syscall.IFT_PPPMULTILINKBUNDLE),
+		"IFT_PROPATM":                       reflect.ValueOf(syscall.IFT_PROPATM),
+		"IFT_PROPBWAP2MP":                   reflect.ValueOf(syscall.IFT_PROPBWAP2MP),
+		"IFT_PROPCNLS":                      reflect.ValueOf(syscall.IFT_PROPCNLS),
+		"IFT_PROPDOCSWIRELESSDOWNSTREAM":    reflect.ValueOf(syscall.IFT_PROPDOCSWIRELESSDOWNSTREAM),
+		"IFT_PROPDOCSWIRELESSMACLAYER":      reflect.ValueOf(syscall.IFT_PROPDOCSWIRELESSMACLAYER),
+		"IFT_PROPDOCSWIRELESSUPSTREAM":      reflect.ValueOf(syscall.IFT_PROPDOCSWIRELESSUPSTREAM),
+		"IFT_PROPMUX":                       reflect.ValueOf(syscall.IFT_PROPMUX),
+		"IFT_PROPVIRTUAL":                   reflect.ValueOf(syscall.IFT_PROPVIRTUAL),
+		"IFT_PROPWIRELESSP2P":               reflect.ValueOf(syscall.IFT_PROPWIRELESSP2P),
+		"IFT_PTPSERIAL":                     reflect.ValueOf(syscall.IFT_PTPSERIAL),
+		"IFT_PVC":                           reflect.ValueOf(syscall.IFT_PVC),
+		"IFT_Q2931":                         reflect.ValueOf(syscall.IFT_Q2931),
+		"IFT_QLLC":                          reflect.ValueOf(syscall.IFT_QLLC),
+		"IFT_RADIOMAC":                      reflect.ValueOf(syscall.IFT_RADIOMAC),
+		"IFT_RADSL":                         reflect.ValueOf(syscall.IFT_RADSL),
+		"IFT_REACHDSL":                      reflect.ValueOf(syscall.IFT_REACHDSL),
+		"IFT_RFC1483":                       reflect.ValueOf(syscall.IFT_RFC1483),
+		"IFT_RS232":                         reflect.ValueOf(syscall.IFT_RS232),
+		"IFT_RSRB":                          reflect.ValueOf(syscall.IFT_RSRB),
+		"IFT_SDLC":                          reflect.ValueOf(syscall.IFT_SDLC),
+		"IFT_SDSL":                          reflect.ValueOf(syscall.IFT_SDSL),
+		"IFT_SHDSL":                         reflect.ValueOf(syscall.IFT_SHDSL),
+		"IFT_SIP":                           reflect.ValueOf(syscall.IFT_SIP),
+		"IFT_SIPSIG":                        reflect.ValueOf(syscall.IFT_SIPSIG),
+		"IFT_SIPTG":                         reflect.ValueOf(syscall.IFT_SIPTG),
+		"IFT_SLIP":                          reflect.ValueOf(syscall.IFT_SLIP),
+		"IFT_SMDSDXI":                       reflect.ValueOf(syscall.IFT_SMDSDXI),
+		"IFT_SMDSICIP":                      reflect.ValueOf(syscall.IFT_SMDSICIP),
+		"IFT_SONET":                         reflect.ValueOf(syscall.IFT_SONET),
+		"IFT_SONETOVERHEADCHANNEL":          reflect.ValueOf(syscall.IFT_SONETOVERHEADCHANNEL),
+		"IFT_SONETPATH":                     reflect.ValueOf(syscall.IFT_SONETPATH),
+		"IFT_SONETVT":                       reflect.ValueOf(syscall.IFT_SONETVT),
+		"IFT_SRP":                           reflect.ValueOf(syscall.IFT_SRP),
+		"IFT_SS7SIGLINK":                    reflect.ValueOf(syscall.IFT_SS7SIGLINK),
+		"IFT_STACKTOSTACK":                  reflect.ValueOf(syscall.IFT_STACKTOSTACK),
+		"IFT_STARLAN":                       reflect.ValueOf(syscall.IFT_STARLAN),
+		"IFT_T1":                            reflect.ValueOf(syscall.IFT_T1),
+		"IFT_TDLC":                          reflect.ValueOf(syscall.IFT_TDLC),
+		"IFT_TELINK":                        reflect.ValueOf(syscall.IFT_TELINK),
+		"IFT_TERMPAD":                       reflect.ValueOf(syscall.IFT_TERMPAD),
+		"IFT_TR008":                         reflect.ValueOf(syscall.IFT_TR008),
+		"IFT_TRANSPHDLC":                    reflect.ValueOf(syscall.IFT_TRANSPHDLC),
+		"IFT_TUNNEL":                        reflect.ValueOf(syscall.IFT_TUNNEL),
+		"IFT_ULTRA":                         reflect.ValueOf(syscall.IFT_ULTRA),
+		"IFT_USB":                           reflect.ValueOf(syscall.IFT_USB),
+		"IFT_V11":                           reflect.ValueOf(syscall.IFT_V11),
+		"IFT_V35":                           reflect.ValueOf(syscall.IFT_V35),
+		"IFT_V36":                           reflect.ValueOf(syscall.IFT_V36),
+		"IFT_V37":                           reflect.ValueOf(syscall.IFT_V37),
+		"IFT_VDSL":                          reflect.ValueOf(syscall.IFT_VDSL),
+		"IFT_VIRTUALIPADDRESS":              reflect.ValueOf(syscall.IFT_VIRTUALIPADDRESS),
+		"IFT_VIRTUALTG":                     reflect.ValueOf(syscall.IFT_VIRTUALTG),
+		"IFT_VOICEDID":                      reflect.ValueOf(syscall.IFT_VOICEDID),
+		"IFT_VOICEEM":                       reflect.ValueOf(syscall.IFT_VOICEEM),
+		"IFT_VOICEEMFGD":                    reflect.ValueOf(syscall.IFT_VOICEEMFGD),
+		"IFT_VOICEENCAP":                    reflect.ValueOf(syscall.IFT_VOICEENCAP),
+		"IFT_VOICEFGDEANA":                  reflect.ValueOf(syscall.IFT_VOICEFGDEANA),
+		"IFT_VOICEFXO":                      reflect.ValueOf(syscall.IFT_VOICEFXO),
+		"IFT_VOICEFXS":                      reflect.ValueOf(syscall.IFT_VOICEFXS),
+		"IFT_VOICEOVERATM":                  reflect.ValueOf(syscall.IFT_VOICEOVERATM),
+		"IFT_VOICEOVERCABLE":                reflect.ValueOf(syscall.IFT_VOICEOVERCABLE),
+		"IFT_VOICEOVERFRAMERELAY":           reflect.ValueOf(syscall.IFT_VOICEOVERFRAMERELAY),
+		"IFT_VOICEOVERIP":                   reflect.ValueOf(syscall.IFT_VOICEOVERIP),
+		"IFT_WIREGUARD":                     reflect.ValueOf(syscall.IFT_WIREGUARD),
+		"IFT_X213":                          reflect.ValueOf(syscall.IFT_X213),
+		"IFT_X25":                           reflect.ValueOf(syscall.IFT_X25),
+		"IFT_X25DDN":                        reflect.ValueOf(syscall.IFT_X25DDN),
+		"IFT_X25HUNTGROUP":                  reflect.ValueOf(syscall.IFT_X25HUNTGROUP),
+		"IFT_X25MLP":                        reflect.ValueOf(syscall.IFT_X25MLP),
+		"IFT_X25PLE":                        reflect.ValueOf(syscall.IFT_X25PLE),
+		"IFT_XETHER":                        reflect.ValueOf(syscall.IFT_XETHER),
+		"IGNBRK":                            reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                             reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                            reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                           reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                             reflect.ValueOf(syscall.INLCR),
+		"INPCK":                             reflect.ValueOf(syscall.INPCK),
+		"IN_CLASSA_HOST":                    reflect.ValueOf(syscall.IN_CLASSA_HOST),
+		"IN_CLASSA_MAX":                     reflect.ValueOf(syscall.IN_CLASSA_MAX),
+		"IN_CLASSA_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
+		"IN_CLASSA_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSA_NSHIFT),
+		"IN_CLASSB_HOST":                    reflect.ValueOf(syscall.IN_CLASSB_HOST),
+		"IN_CLASSB_MAX":                     reflect.ValueOf(syscall.IN_CLASSB_MAX),
+		"IN_CLASSB_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSB_NET)),
+		"IN_CLASSB_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSB_NSHIFT),
+		"IN_CLASSC_HOST":                    reflect.ValueOf(syscall.IN_CLASSC_HOST),
+		"IN_CLASSC_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSC_NET)),
+		"IN_CLASSC_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSC_NSHIFT),
+		"IN_CLASSD_HOST":                    reflect.ValueOf(syscall.IN_CLASSD_HOST),
+		"IN_CLASSD_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSD_NET)),
+		"IN_CLASSD_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSD_NSHIFT),
+		"IN_LOOPBACKNET":                    reflect.ValueOf(syscall.IN_LOOPBACKNET),
+		"IN_RFC3021_HOST":                   reflect.ValueOf(syscall.IN_RFC3021_HOST),
+		"IN_RFC3021_NET":                    reflect.ValueOf(uint32(syscall.IN_RFC3021_NET)),
+		"IN_RFC3021_NSHIFT":                 reflect.ValueOf(syscall.IN_RFC3021_NSHIFT),
+		"IPPROTO_AH":                        reflect.ValueOf(syscall.IPPROTO_AH),
+		"IPPROTO_CARP":                      reflect.ValueOf(syscall.IPPROTO_CARP),
+		"IPPROTO_DIVERT":                    reflect.ValueOf(syscall.IPPROTO_DIVERT),
+		"IPPROTO_DONE":                      reflect.ValueOf(syscall.IPPROTO_DONE),
+		"IPPROTO_DSTOPTS":                   reflect.ValueOf(syscall.IPPROTO_DSTOPTS),
+		"IPPROTO_EGP":                       reflect.ValueOf(syscall.IPPROTO_EGP),
+		"IPPROTO_ENCAP":                     reflect.ValueOf(syscall.IPPROTO_ENCAP),
+		"IPPROTO_EON":                       reflect.ValueOf(syscall.IPPROTO_EON),
+		"IPPROTO_ESP":                       reflect.ValueOf(syscall.IPPROTO_ESP),
+		"IPPROTO_ETHERIP":                   reflect.ValueOf(syscall.IPPROTO_ETHERIP),
+		"IPPROTO_FRAGMENT":                  reflect.ValueOf(syscall.IPPROTO_FRAGMENT),
+		"IPPROTO_GGP":                       reflect.ValueOf(syscall.IPPROTO_GGP),
+		"IPPROTO_GRE":                       reflect.ValueOf(syscall.IPPROTO_GRE),
+		"IPPROTO_HOPOPTS":                   reflect.ValueOf(syscall.IPPROTO_HOPOPTS),
+		"IPPROTO_ICMP":                      reflect.ValueOf(syscall.IPPROTO_ICMP),
+		"IPPROTO_ICMPV6":                    reflect.ValueOf(syscall.IPPROTO_ICMPV6),
+		"IPPROTO_IDP":                       reflect.ValueOf(syscall.IPPROTO_IDP),
+		"IPPROTO_IGMP":                      reflect.ValueOf(syscall.IPPROTO_IGMP),
+		"IPPROTO_IP":                        reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPCOMP":                    reflect.ValueOf(syscall.IPPROTO_IPCOMP),
+		"IPPROTO_IPIP":                      reflect.ValueOf(syscall.IPPROTO_IPIP),
+		"IPPROTO_IPV4":                      reflect.ValueOf(syscall.IPPROTO_IPV4),
+		"IPPROTO_IPV6":                      reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_MAX":                       reflect.ValueOf(syscall.IPPROTO_MAX),
+		"IPPROTO_MAXID":                     reflect.ValueOf(syscall.IPPROTO_MAXID),
+		"IPPROTO_MOBILE":                    reflect.ValueOf(syscall.IPPROTO_MOBILE),
+		"IPPROTO_MPLS":                      reflect.ValueOf(syscall.IPPROTO_MPLS),
+		"IPPROTO_NONE":                      reflect.ValueOf(syscall.IPPROTO_NONE),
+		"IPPROTO_PFSYNC":                    reflect.ValueOf(syscall.IPPROTO_PFSYNC),
+		"IPPROTO_PIM":                       reflect.ValueOf(syscall.IPPROTO_PIM),
+		"IPPROTO_PUP":                       reflect.ValueOf(syscall.IPPROTO_PUP),
+		"IPPROTO_RAW":                       reflect.ValueOf(syscall.IPPROTO_RAW),
+		"IPPROTO_ROUTING":                   reflect.ValueOf(syscall.IPPROTO_ROUTING),
+		"IPPROTO_RSVP":                      reflect.ValueOf(syscall.IPPROTO_RSVP),
+		"IPPROTO_SCTP":                      reflect.ValueOf(syscall.IPPROTO_SCTP),
+		"IPPROTO_TCP":                       reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_TP":                        reflect.ValueOf(syscall.IPPROTO_TP),
+		"IPPROTO_UDP":                       reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPPROTO_UDPLITE":                   reflect.ValueOf(syscall.IPPROTO_UDPLITE),
+		"IPV6_AUTH_LEVEL":                   reflect.ValueOf(syscall.IPV6_AUTH_LEVEL),
+		"IPV6_AUTOFLOWLABEL":                reflect.ValueOf(syscall.IPV6_AUTOFLOWLABEL),
+		"IPV6_CHECKSUM":                     reflect.ValueOf(syscall.IPV6_CHECKSUM),
+		"IPV6_DEFAULT_MULTICAST_HOPS":       reflect.ValueOf(syscall.IPV6_DEFAULT_MULTICAST_HOPS),
+		"IPV6_DEFAULT_MULTICAST_LOOP":       reflect.ValueOf(syscall.IPV6_DEFAULT_MULTICAST_LOOP),
+		"IPV6_DEFHLIM":                      reflect.ValueOf(syscall.IPV6_DEFHLIM),
+		"IPV6_DONTFRAG":                     reflect.ValueOf(syscall.IPV6_DONTFRAG),
+		"IPV6_DSTOPTS":                      reflect.ValueOf(syscall.IPV6_DSTOPTS),
+		"IPV6_ESP_NETWORK_LEVEL":            reflect.ValueOf(syscall.IPV6_ESP_NETWORK_LEVEL),
+		"IPV6_ESP_TRANS_LEVEL":              reflect.ValueOf(syscall.IPV6_ESP_TRANS_LEVEL),
+		"IPV6_FAITH":                        reflect.ValueOf(syscall.IPV6_FAITH),
+		"IPV6_FLOWINFO_MASK":                reflect.ValueOf(uint32(syscall.IPV6_FLOWINFO_MASK)),
+		"IPV6_FLOWLABEL_MASK":               reflect.ValueOf(uint32(syscall.IPV6_FLOWLABEL_MASK)),
+		"IPV6_FRAGTTL":                      reflect.ValueOf(syscall.IPV6_FRAGTTL),
+		"IPV6_HLIMDEC":                      reflect.ValueOf(syscall.IPV6_HLIMDEC),
+		"IPV6_HOPLIMIT":                     reflect.ValueOf(syscall.IPV6_HOPLIMIT),
+		"IPV6_HOPOPTS":                      reflect.ValueOf(syscall.IPV6_HOPOPTS),
+		"IPV6_IPCOMP_LEVEL":                 reflect.ValueOf(syscall.IPV6_IPCOMP_LEVEL),
+		"IPV6_JOIN_GROUP":                   reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_GROUP":                  reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MAXHLIM":                      reflect.ValueOf(syscall.IPV6_MAXHLIM),
+		"IPV6_MAXPACKET":                    reflect.ValueOf(syscall.IPV6_MAXPACKET),
+		"IPV6_MINHOPCOUNT":                  reflect.ValueOf(syscall.IPV6_MINHOPCOUNT),
+		"IPV6_MMTU":                         reflect.ValueOf(syscall.IPV6_MMTU),
+		"IPV6_MULTICAST_HOPS":               reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":                 reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":               reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_NEXTHOP":                      reflect.ValueOf(syscall.IPV6_NEXTHOP),
+		"IPV6_OPTIONS":                      reflect.ValueOf(syscall.IPV6_OPTIONS),
+		"IPV6_PATHMTU":                      reflect.ValueOf(syscall.IPV6_PATHMTU),
+		"IPV6_PIPEX":                        reflect.ValueOf(syscall.IPV6_PIPEX),
+		"IPV6_PKTINFO":                      reflect.ValueOf(syscall.IPV6_PKTINFO),
+		"IPV6_PORTRANGE":                    reflect.ValueOf(syscall.IPV6_PORTRANGE),
+		"IPV6_PORTRANGE_DEFAULT":            reflect.ValueOf(syscall.IPV6_PORTRANGE_DEFAULT),
+		"IPV6_PORTRANGE_HIGH":               reflect.ValueOf(syscall.IPV6_PORTRANGE_HIGH),
+		"IPV6_PORTRANGE_LOW":                reflect.ValueOf(syscall.IPV6_PORTRANGE_LOW),
+		"IPV6_RECVDSTOPTS":                  reflect.ValueOf(syscall.IPV6_RECVDSTOPTS),
+		"IPV6_RECVDSTPORT":                  reflect.ValueOf(syscall.IPV6_RECVDSTPORT),
+		"IPV6_RECVHOPLIMIT":                 reflect.ValueOf(syscall.IPV6_RECVHOPLIMIT),
+		"IPV6_RECVHOPOPTS":                  reflect.ValueOf(syscall.IPV6_RECVHOPOPTS),
+		"IPV6_RECVPATHMTU":                  reflect.ValueOf(syscall.IPV6_RECVPATHMTU),
+		"IPV6_RECVPKTINFO":                  reflect.ValueOf(syscall.IPV6_RECVPKTINFO),
+		"IPV6_RECVRTHDR":                    reflect.ValueOf(syscall.IPV6_RECVRTHDR),
+		"IPV6_RECVTCLASS":                   reflect.ValueOf(syscall.IPV6_RECVTCLASS),
+		"IPV6_RTABLE":                       reflect.ValueOf(syscall.IPV6_RTABLE),
+		"IPV6_RTHDR":                        reflect.ValueOf(syscall.IPV6_RTHDR),
+		"IPV6_RTHDRDSTOPTS":                 reflect.ValueOf(syscall.IPV6_RTHDRDSTOPTS),
+		"IPV6_RTHDR_LOOSE":                  reflect.ValueOf(syscall.IPV6_RTHDR_LOOSE),
+		"IPV6_RTHDR_STRICT":                 reflect.ValueOf(syscall.IPV6_RTHDR_STRICT),
+		"IPV6_RTHDR_TYPE_0":                 reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_0),
+		"IPV6_SOCKOPT_RESERVED1":            reflect.ValueOf(syscall.IPV6_SOCKOPT_RESERVED1),
+		"IPV6_TCLASS":                       reflect.ValueOf(syscall.IPV6_TCLASS),
+		"IPV6_UNICAST_HOPS":                 reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_USE_MIN_MTU":                  reflect.ValueOf(syscall.IPV6_USE_MIN_MTU),
+		"IPV6_V6ONLY":                       reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IPV6_VERSION":                      reflect.ValueOf(syscall.IPV6_VERSION),
+		"IPV6_VERSION_MASK":                 reflect.ValueOf(syscall.IPV6_VERSION_MASK),
+		"IP_ADD_MEMBERSHIP":                 reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_AUTH_LEVEL":                     reflect.ValueOf(syscall.IP_AUTH_LEVEL),
+		"IP_DEFAULT_MULTICAST_LOOP":         reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_LOOP),
+		"IP_DEFAULT_MULTICAST_TTL":          reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_TTL),
+		"IP_DF":                             reflect.ValueOf(syscall.IP_DF),
+		"IP_DROP_MEMBERSHIP":                reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_ESP_NETWORK_LEVEL":              reflect.ValueOf(syscall.IP_ESP_NETWORK_LEVEL),
+		"IP_ESP_TRANS_LEVEL":                reflect.ValueOf(syscall.IP_ESP_TRANS_LEVEL),
+		"IP_HDRINCL":                        reflect.ValueOf(syscall.IP_HDRINCL),
+		"IP_IPCOMP_LEVEL":                   reflect.ValueOf(syscall.IP_IPCOMP_LEVEL),
+		"IP_IPDEFTTL":                       reflect.ValueOf(syscall.IP_IPDEFTTL),
+		"IP_IPSECFLOWINFO":                  reflect.ValueOf(syscall.IP_IPSECFLOWINFO),
+		"IP_IPSEC_LOCAL_AUTH":               reflect.ValueOf(syscall.IP_IPSEC_LOCAL_AUTH),
+		"IP_IPSEC_LOCAL_CRED":               reflect.ValueOf(syscall.IP_IPSEC_LOCAL_CRED),
+		"IP_IPSEC_LOCAL_ID":                 reflect.ValueOf(syscall.IP_IPSEC_LOCAL_ID),
+		"IP_IPSEC_REMOTE_AUTH":              reflect.ValueOf(syscall.IP_IPSEC_REMOTE_AUTH),
+		"IP_IPSEC_REMOTE_CRED":              reflect.ValueOf(syscall.IP_IPSEC_REMOTE_CRED),
+		"IP_IPSEC_REMOTE_ID":                reflect.ValueOf(syscall.IP_IPSEC_REMOTE_ID),
+		"IP_MAXPACKET":                      reflect.ValueOf(syscall.IP_MAXPACKET),
+		"IP_MAX_MEMBERSHIPS":                reflect.ValueOf(syscall.IP_MAX_MEMBERSHIPS),
+		"IP_MF":                             reflect.ValueOf(syscall.IP_MF),
+		"IP_MINTTL":                         reflect.ValueOf(syscall.IP_MINTTL),
+		"IP_MIN_MEMBERSHIPS":                reflect.ValueOf(syscall.IP_MIN_MEMBERSHIPS),
+		"IP_MSS":                            reflect.ValueOf(syscall.IP_MSS),
+		"IP_MULTICAST_IF":                   reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_LOOP":                 reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":                  reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_OFFMASK":                        reflect.ValueOf(syscall.IP_OFFMASK),
+		"IP_OPTIONS":                        reflect.ValueOf(syscall.IP_OPTIONS),
+		"IP_PIPEX":                          reflect.ValueOf(syscall.IP_PIPEX),
+		"IP_PORTRANGE":                      reflect.ValueOf(syscall.IP_PORTRANGE),
+		"IP_PORTRANGE_DEFAULT":              reflect.ValueOf(syscall.IP_PORTRANGE_DEFAULT),
+		"IP_PORTRANGE_HIGH":                 reflect.ValueOf(syscall.IP_PORTRANGE_HIGH),
+		"IP_PORTRANGE_LOW":                  reflect.ValueOf(syscall.IP_PORTRANGE_LOW),
+		"IP_RECVDSTADDR":                    reflect.ValueOf(syscall.IP_RECVDSTADDR),
+		"IP_RECVDSTPORT":                    reflect.ValueOf(syscall.IP_RECVDSTPORT),
+		"IP_RECVIF":                         reflect.ValueOf(syscall.IP_RECVIF),
+		"IP_RECVOPTS":                       reflect.ValueOf(syscall.IP_RECVOPTS),
+		"IP_RECVRETOPTS":                    reflect.ValueOf(syscall.IP_RECVRETOPTS),
+		"IP_RECVRTABLE":                     reflect.ValueOf(syscall.IP_RECVRTABLE),
+		"IP_RECVTTL":                        reflect.ValueOf(syscall.IP_RECVTTL),
+		"IP_RETOPTS":                        reflect.ValueOf(syscall.IP_RETOPTS),
+		"IP_RF":                             reflect.ValueOf(syscall.IP_RF),
+		"IP_RTABLE":                         reflect.ValueOf(syscall.IP_RTABLE),
+		"IP_SENDSRCADDR":                    reflect.ValueOf(syscall.IP_SENDSRCADDR),
+		"IP_TOS":                            reflect.ValueOf(syscall.IP_TOS),
+		"IP_TTL":                            reflect.ValueOf(syscall.IP_TTL),
+		"ISIG":                              reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                            reflect.ValueOf(syscall.ISTRIP),
+		"IXANY":                             reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                             reflect.ValueOf(syscall.IXOFF),
+		"IXON":                              reflect.ValueOf(syscall.IXON),
+		"ImplementsGetwd":                   reflect.ValueOf(syscall.ImplementsGetwd),
+		"Issetugid":                         reflect.ValueOf(syscall.Issetugid),
+		"Kevent":                            reflect.ValueOf(syscall.Kevent),
+		"Kill":                              reflect.ValueOf(syscall.Kill),
+		"Kqueue":                            reflect.ValueOf(syscall.Kqueue),
+		"LCNT_OVERLOAD_FLUSH":               reflect.ValueOf(syscall.LCNT_OVERLOAD_FLUSH),
+		"LOCK_EX":                           reflect.ValueOf(syscall.LOCK_EX),
+		"LOCK_NB":                           reflect.ValueOf(syscall.LOCK_NB),
+		"LOCK_SH":                           reflect.ValueOf(syscall.LOCK_SH),
+		"LOCK_UN":                           reflect.ValueOf(syscall.LOCK_UN),
+		"Lchown":                            reflect.ValueOf(syscall.Lchown),
+		"Link":                              reflect.ValueOf(syscall.Link),
+		"Listen":                            reflect.ValueOf(syscall.Listen),
+		"Lstat":                             reflect.ValueOf(syscall.Lstat),
+		"MADV_DONTNEED":                     reflect.ValueOf(syscall.MADV_DONTNEED),
+		"MADV_FREE":                         reflect.ValueOf(syscall.MADV_FREE),
+		"MADV_NORMAL":                       reflect.ValueOf(syscall.MADV_NORMAL),
+		"MADV_RANDOM":                       reflect.ValueOf(syscall.MADV_RANDOM),
+		"MADV_SEQUENTIAL":                   reflect.ValueOf(syscall.MADV_SEQUENTIAL),
+		"MADV_SPACEAVAIL":                   reflect.ValueOf(syscall.MADV_SPACEAVAIL),
+		"MADV_WILLNEED":                     reflect.ValueOf(syscall.MADV_WILLNEED),
+		"MAP_ANON":                          reflect.ValueOf(syscall.MAP_ANON),
+		"MAP_ANONYMOUS":                     reflect.ValueOf(syscall.MAP_ANONYMOUS),
+		"MAP_CONCEAL":                       reflect.ValueOf(syscall.MAP_CONCEAL),
+		"MAP_COPY":                          reflect.ValueOf(syscall.MAP_COPY),
+		"MAP_FILE":                          reflect.ValueOf(syscall.MAP_FILE),
+		"MAP_FIXED":                         reflect.ValueOf(syscall.MAP_FIXED),
+		"MAP_FLAGMASK":                      reflect.ValueOf(syscall.MAP_FLAGMASK),
+		"MAP_HASSEMAPHORE":                  reflect.ValueOf(syscall.MAP_HASSEMAPHORE),
+		"MAP_INHERIT":                       reflect.ValueOf(syscall.MAP_INHERIT),
+		"MAP_INHERIT_COPY":                  reflect.ValueOf(syscall.MAP_INHERIT_COPY),
+		"MAP_INHERIT_NONE":                  reflect.ValueOf(syscall.MAP_INHERIT_NONE),
+		"MAP_INHERIT_SHARE":                 reflect.ValueOf(syscall.MAP_INHERIT_SHARE),
+		"MAP_INHERIT_ZERO":                  reflect.ValueOf(syscall.MAP_INHERIT_ZERO),
+		"MAP_NOEXTEND":                      reflect.ValueOf(syscall.MAP_NOEXTEND),
+		"MAP_NORESERVE":                     reflect.ValueOf(syscall.MAP_NORESERVE),
+		"MAP_PRIVATE":                       reflect.ValueOf(syscall.MAP_PRIVATE),
+		"MAP_RENAME":                        reflect.ValueOf(syscall.MAP_RENAME),
+		"MAP_SHARED":                        reflect.ValueOf(syscall.MAP_SHARED),
+		"MAP_STACK":                         reflect.ValueOf(syscall.MAP_STACK),
+		"MAP_TRYFIXED":                      reflect.ValueOf(syscall.MAP_TRYFIXED),
+		"MCL_CURRENT":                       reflect.ValueOf(syscall.MCL_CURRENT),
+		"MCL_FUTURE":                        reflect.ValueOf(syscall.MCL_FUTURE),
+		"MSG_BCAST":                         reflect.ValueOf(syscall.MSG_BCAST),
+		"MSG_CMSG_CLOEXEC":                  reflect.ValueOf(syscall.MSG_CMSG_CLOEXEC),
+		"MSG_CTRUNC":                        reflect.ValueOf(syscall.MSG_CTRUNC),
+		"MSG_DONTROUTE":                     reflect.ValueOf(syscall.MSG_DONTROUTE),
+		"MSG_DONTWAIT":                      reflect.ValueOf(syscall.MSG_DONTWAIT),
+		"MSG_EOR":                           reflect.ValueOf(syscall.MSG_EOR),
+		"MSG_MCAST":                         reflect.ValueOf(syscall.MSG_MCAST),
+		"MSG_NOSIGNAL":                      reflect.ValueOf(syscall.MSG_NOSIGNAL),
+		"MSG_OOB":                           reflect.ValueOf(syscall.MSG_OOB),
+		"MSG_PEEK":                          reflect.ValueOf(syscall.MSG_PEEK),
+		"MSG_TRUNC":                         reflect.ValueOf(syscall.MSG_TRUNC),
+		"MSG_WAITALL":                       reflect.ValueOf(syscall.MSG_WAITALL),
+		"MS_ASYNC":                          reflect.ValueOf(syscall.MS_ASYNC),
+		"MS_INVALIDATE":                     reflect.ValueOf(syscall.MS_INVALIDATE),
+		"MS_SYNC":                           reflect.ValueOf(syscall.MS_SYNC),
+		"Mkdir":                             reflect.ValueOf(syscall.Mkdir),
+		"Mkfifo":                            reflect.ValueOf(syscall.Mkfifo),
+		"Mknod":                             reflect.ValueOf(syscall.Mknod),
+		"Mmap":                              reflect.ValueOf(syscall.Mmap),
+		"Munmap":                            reflect.ValueOf(syscall.Munmap),
+		"NAME_MAX":                          reflect.ValueOf(syscall.NAME_MAX),
+		"NET_RT_DUMP":                       reflect.ValueOf(syscall.NET_RT_DUMP),
+		"NET_RT_FLAGS":                      reflect.ValueOf(syscall.NET_RT_FLAGS),
+		"NET_RT_IFLIST":                     reflect.ValueOf(syscall.NET_RT_IFLIST),
+		"NET_RT_IFNAMES":                    reflect.ValueOf(syscall.NET_RT_IFNAMES),
+		"NET_RT_MAXID":                      reflect.ValueOf(syscall.NET_RT_MAXID),
+		"NET_RT_SOURCE":                     reflect.ValueOf(syscall.NET_RT_SOURCE),
+		"NET_RT_STATS":                      reflect.ValueOf(syscall.NET_RT_STATS),
+		"NET_RT_TABLE":                      reflect.ValueOf(syscall.NET_RT_TABLE),
+		"NOFLSH":                            reflect.ValueOf(uint32(syscall.NOFLSH)),
+		"NOTE_ATTRIB":                       reflect.ValueOf(syscall.NOTE_ATTRIB),
+		"NOTE_CHANGE":                       reflect.ValueOf(syscall.NOTE_CHANGE),
+		"NOTE_CHILD":                        reflect.ValueOf(syscall.NOTE_CHILD),
+		"NOTE_DELETE":                       reflect.ValueOf(syscall.NOTE_DELETE),
+		"NOTE_EOF":                          reflect.ValueOf(syscall.NOTE_EOF),
+		"NOTE_EXEC":                         reflect.ValueOf(syscall.NOTE_EXEC),
+		"NOTE_EXIT":                         reflect.ValueOf(uint32(syscall.NOTE_EXIT)),
+		"NOTE_EXTEND":                       reflect.ValueOf(syscall.NOTE_EXTEND),
+		"NOTE_FORK":                         reflect.ValueOf(syscall.NOTE_FORK),
+		"NOTE_LINK":                         reflect.ValueOf(syscall.NOTE_LINK),
+		"NOTE_LOWAT":                        reflect.ValueOf(syscall.NOTE_LOWAT),
+		"NOTE_OOB":                          reflect.ValueOf(syscall.NOTE_OOB),
+		"NOTE_PCTRLMASK":                    reflect.ValueOf(uint32(syscall.NOTE_PCTRLMASK)),
+		"NOTE_PDATAMASK":                    reflect.ValueOf(syscall.NOTE_PDATAMASK),
+		"NOTE_RENAME":                       reflect.ValueOf(syscall.NOTE_RENAME),
+		"NOTE_REVOKE":                       reflect.ValueOf(syscall.NOTE_REVOKE),
+		"NOTE_TRACK":                        reflect.ValueOf(syscall.NOTE_TRACK),
+		"NOTE_TRACKERR":                     reflect.ValueOf(syscall.NOTE_TRACKERR),
+		"NOTE_TRUNCATE":                     reflect.ValueOf(syscall.NOTE_TRUNCATE),
+		"NOTE_WRITE":                        reflect.ValueOf(syscall.NOTE_WRITE),
+		"Nanosleep":                         reflect.ValueOf(syscall.Nanosleep),
+		"NsecToTimespec":                    reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                     reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                             reflect.ValueOf(syscall.OCRNL),
+		"ONLCR":                             reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                            reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                             reflect.ValueOf(syscall.ONOCR),
+		"ONOEOT":                            reflect.ValueOf(syscall.ONOEOT),
+		"OPOST":                             reflect.ValueOf(syscall.OPOST),
+		"O_ACCMODE":                         reflect.ValueOf(syscall.O_ACCMODE),
+		"O_APPEND":                          reflect.ValueOf(syscall.O_APPEND),
+		"O_ASYNC":                           reflect.ValueOf(syscall.O_ASYNC),
+		"O_CLOEXEC":                         reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                           reflect.ValueOf(syscall.O_CREAT),
+		"O_DIRECTORY":                       reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_DSYNC":                           reflect.ValueOf(syscall.O_DSYNC),
+		"O_EXCL":                            reflect.ValueOf(syscall.O_EXCL),
+		"O_EXLOCK":                          reflect.ValueOf(syscall.O_EXLOCK),
+		"O_FSYNC":                           reflect.ValueOf(syscall.O_FSYNC),
+		"O_NDELAY":                          reflect.ValueOf(syscall.O_NDELAY),
+		"O_NOCTTY":                          reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NOFOLLOW":                        reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_NONBLOCK":                        reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_RDONLY":                          reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                            reflect.ValueOf(syscall.O_RDWR),
+		"O_RSYNC":                           reflect.ValueOf(syscall.O_RSYNC),
+		"O_SHLOCK":                          reflect.ValueOf(syscall.O_SHLOCK),
+		"O_SYNC":                            reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                           reflect.ValueOf(syscall.O_TRUNC),
+		"O_WRONLY":                          reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                              reflect.ValueOf(syscall.Open),
+		"PARENB":                            reflect.ValueOf(syscall.PARENB),
+		"PARMRK":                            reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                            reflect.ValueOf(syscall.PARODD),
+		"PENDIN":                            reflect.ValueOf(syscall.PENDIN),
+		"PF_FLUSH":                          reflect.ValueOf(syscall.PF_FLUSH),
+		"PRIO_PGRP":                         reflect.ValueOf(syscall.PRIO_PGRP),
+		"PRIO_PROCESS":                      reflect.ValueOf(syscall.PRIO_PROCESS),
+		"PRIO_USER":                         reflect.ValueOf(syscall.PRIO_USER),
+		"PROT_EXEC":                         reflect.ValueOf(syscall.PROT_EXEC),
+		"PROT_NONE":                         reflect.ValueOf(syscall.PROT_NONE),
+		"PROT_READ":                         reflect.ValueOf(syscall.PROT_READ),
+		"PROT_WRITE":                        reflect.ValueOf(syscall.PROT_WRITE),
+		"PTRACE_CONT":                       reflect.ValueOf(syscall.PTRACE_CONT),
+		"PTRACE_KILL":                       reflect.ValueOf(syscall.PTRACE_KILL),
+		"PTRACE_TRACEME":                    reflect.ValueOf(syscall.PTRACE_TRACEME),
+		"ParseDirent":                       reflect.ValueOf(syscall.ParseDirent),
+		"ParseRoutingMessage":               reflect.ValueOf(syscall.ParseRoutingMessage),
+		"ParseRoutingSockaddr":              reflect.ValueOf(syscall.ParseRoutingSockaddr),
+		"ParseSocketControlMessage":         reflect.ValueOf(syscall.ParseSocketControlMessage),
+		"ParseUnixRights":                   reflect.ValueOf(syscall.ParseUnixRights),
+		"Pathconf":                          reflect.ValueOf(syscall.Pathconf),
+		"Pipe":                              reflect.ValueOf(syscall.Pipe),
+		"Pipe2":                             reflect.ValueOf(syscall.Pipe2),
+		"Pread":                             reflect.ValueOf(syscall.Pread),
+		"Pwrite":                            reflect.ValueOf(syscall.Pwrite),
+		"RLIMIT_CORE":                       reflect.ValueOf(syscall.RLIMIT_CORE),
+		"RLIMIT_CPU":                        reflect.ValueOf(syscall.RLIMIT_CPU),
+		"RLIMIT_DATA":                       reflect.ValueOf(syscall.RLIMIT_DATA),
+		"RLIMIT_FSIZE":                      reflect.ValueOf(syscall.RLIMIT_FSIZE),
+		"RLIMIT_NOFILE":                     reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RLIMIT_STACK":                      reflect.ValueOf(syscall.RLIMIT_STACK),
+		"RLIM_INFINITY":                     reflect.ValueOf(int64(syscall.RLIM_INFINITY)),
+		"RTAX_AUTHOR":                       reflect.ValueOf(syscall.RTAX_AUTHOR),
+		"RTAX_BFD":                          reflect.ValueOf(syscall.RTAX_BFD),
+		"RTAX_BRD":                          reflect.ValueOf(syscall.RTAX_BRD),
+		"RTAX_DNS":                          reflect.ValueOf(syscall.RTAX_DNS),
+		"RTAX_DST":                          reflect.ValueOf(syscall.RTAX_DST),
+		"RTAX_GATEWAY":                      reflect.ValueOf(syscall.RTAX_GATEWAY),
+		"RTAX_GENMASK":                      reflect.ValueOf(syscall.RTAX_GENMASK),
+		"RTAX_IFA":                          reflect.ValueOf(syscall.RTAX_IFA),
+		"RTAX_IFP":                          reflect.ValueOf(syscall.RTAX_IFP),
+		"RTAX_LABEL":                        reflect.ValueOf(syscall.RTAX_LABEL),
+		"RTAX_MAX":                          reflect.ValueOf(syscall.RTAX_MAX),
+		"RTAX_NETMASK":                      reflect.ValueOf(syscall.RTAX_NETMASK),
+		"RTAX_SEARCH":                       reflect.ValueOf(syscall.RTAX_SEARCH),
+		"RTAX_SRC":                          reflect.ValueOf(syscall.RTAX_SRC),
+		"RTAX_SRCMASK":                      reflect.ValueOf(syscall.RTAX_SRCMASK),
+		"RTAX_STATIC":                       reflect.ValueOf(syscall.RTAX_STATIC),
+		"RTA_AUTHOR":                        reflect.ValueOf(syscall.RTA_AUTHOR),
+		"RTA_BFD":                           reflect.ValueOf(syscall.RTA_BFD),
+		"RTA_BRD":                           reflect.ValueOf(syscall.RTA_BRD),
+		"RTA_DNS":                           reflect.ValueOf(syscall.RTA_DNS),
+		"RTA_DST":                           reflect.ValueOf(syscall.RTA_DST),
+		"RTA_GATEWAY":                       reflect.ValueOf(syscall.RTA_GATEWAY),
+		"RTA_GENMASK":                       reflect.ValueOf(syscall.RTA_GENMASK),
+		"RTA_IFA":                           reflect.ValueOf(syscall.RTA_IFA),
+		"RTA_IFP":                           reflect.ValueOf(syscall.RTA_IFP),
+		"RTA_LABEL":                         reflect.ValueOf(syscall.RTA_LABEL),
+		"RTA_NETMASK":                       reflect.ValueOf(syscall.RTA_NETMASK),
+		"RTA_SEARCH":                        reflect.ValueOf(syscall.RTA_SEARCH),
+		"RTA_SRC":                           reflect.ValueOf(syscall.RTA_SRC),
+		"RTA_SRCMASK":                       reflect.ValueOf(syscall.RTA_SRCMASK),
+		"RTA_STATIC":                        reflect.ValueOf(syscall.RTA_STATIC),
+		"RTF_ANNOUNCE":                      reflect.ValueOf(syscall.RTF_ANNOUNCE),
+		"RTF_BFD":                           reflect.ValueOf(syscall.RTF_BFD),
+		"RTF_BLACKHOLE":                     reflect.ValueOf(syscall.RTF_BLACKHOLE),
+		"RTF_BROADCAST":                     reflect.ValueOf(syscall.RTF_BROADCAST),
+		"RTF_CACHED":                        reflect.ValueOf(syscall.RTF_CACHED),
+		"RTF_CLONED":                        reflect.ValueOf(syscall.RTF_CLONED),
+		"RTF_CLONING":                       reflect.ValueOf(syscall.RTF_CLONING),
+		"RTF_CONNECTED":                     reflect.ValueOf(syscall.RTF_CONNECTED),
+		"RTF_DONE":                          reflect.ValueOf(syscall.RTF_DONE),
+		"RTF_DYNAMIC":                       reflect.ValueOf(syscall.RTF_DYNAMIC),
+		"RTF_FMASK":                         reflect.ValueOf(syscall.RTF_FMASK),
+		"RTF_GATEWAY":                       reflect.ValueOf(syscall.RTF_GATEWAY),
+		"RTF_HOST":                          reflect.ValueOf(syscall.RTF_HOST),
+		"RTF_LLINFO":                        reflect.ValueOf(syscall.RTF_LLINFO),
+		"RTF_LOCAL":                         reflect.ValueOf(syscall.RTF_LOCAL),
+		"RTF_MODIFIED":                      reflect.ValueOf(syscall.RTF_MODIFIED),
+		"RTF_MPATH":                         reflect.ValueOf(syscall.RTF_MPATH),
+		"RTF_MPLS":                          reflect.ValueOf(syscall.RTF_MPLS),
+		"RTF_MULTICAST":                     reflect.ValueOf(syscall.RTF_MULTICAST),
+		"RTF_PERMANENT_ARP":                 reflect.ValueOf(syscall.RTF_PERMANENT_ARP),
+		"RTF_PROTO1":                        reflect.ValueOf(syscall.RTF_PROTO1),
+		"RTF_PROTO2":                        reflect.ValueOf(syscall.RTF_PROTO2),
+		"RTF_PROTO3":                        reflect.ValueOf(syscall.RTF_PROTO3),
+		"RTF_REJECT":                        reflect.ValueOf(syscall.RTF_REJECT),
+		"RTF_STATIC":                        reflect.ValueOf(syscall.RTF_STATIC),
+		"RTF_UP":                            reflect.ValueOf(syscall.RTF_UP),
+		"RTF_USETRAILERS":                   reflect.ValueOf(syscall.RTF_USETRAILERS),
+		"RTM_80211INFO":                     reflect.ValueOf(syscall.RTM_80211INFO),
+		"RTM_ADD":                           reflect.ValueOf(syscall.RTM_ADD),
+		"RTM_BFD":                           reflect.ValueOf(syscall.RTM_BFD),
+		"RTM_CHANGE":                        reflect.ValueOf(syscall.RTM_CHANGE),
+		"RTM_CHGADDRATTR":                   reflect.ValueOf(syscall.RTM_CHGADDRATTR),
+		"RTM_DELADDR":                       reflect.ValueOf(syscall.RTM_DELADDR),
+		"RTM_DELETE":                        reflect.ValueOf(syscall.RTM_DELETE),
+		"RTM_DESYNC":                        reflect.ValueOf(syscall.RTM_DESYNC),
+		"RTM_GET":                           reflect.ValueOf(syscall.RTM_GET),
+		"RTM_IFANNOUNCE":                    reflect.ValueOf(syscall.RTM_IFANNOUNCE),
+		"RTM_IFINFO":                        reflect.ValueOf(syscall.RTM_IFINFO),
+		"RTM_INVALIDATE":                    reflect.ValueOf(syscall.RTM_INVALIDATE),
+		"RTM_LOCK":                          reflect.ValueOf(syscall.RTM_LOCK),
+		"RTM_LOSING":                        reflect.ValueOf(syscall.RTM_LOSING),
+		"RTM_MAXSIZE":                       reflect.ValueOf(syscall.RTM_MAXSIZE),
+		"RTM_MISS":                          reflect.ValueOf(syscall.RTM_MISS),
+		"RTM_NEWADDR":                       reflect.ValueOf(syscall.RTM_NEWADDR),
+		"RTM_PROPOSAL":                      reflect.ValueOf(syscall.RTM_PROPOSAL),
+		"RTM_REDIRECT":                      reflect.ValueOf(syscall.RTM_REDIRECT),
+		"RTM_RESOLVE":                       reflect.ValueOf(syscall.RTM_RESOLVE),
+		"RTM_SOURCE":                        reflect.ValueOf(syscall.RTM_SOURCE),
+		"RTM_VERSION":                       reflect.ValueOf(syscall.RTM_VERSION),
+		"RTV_EXPIRE":                        reflect.ValueOf(syscall.RTV_EXPIRE),
+		"RTV_HOPCOUNT":                      reflect.ValueOf(syscall.RTV_HOPCOUNT),
+		"RTV_MTU":                           reflect.ValueOf(syscall.RTV_MTU),
+		"RTV_RPIPE":                         reflect.ValueOf(syscall.RTV_RPIPE),
+		"RTV_RTT":                           reflect.ValueOf(syscall.RTV_RTT),
+		"RTV_RTTVAR":                        reflect.ValueOf(syscall.RTV_RTTVAR),
+		"RTV_SPIPE":                         reflect.ValueOf(syscall.RTV_SPIPE),
+		"RTV_SSTHRESH":                      reflect.ValueOf(syscall.RTV_SSTHRESH),
+		"RT_TABLEID_BITS":                   reflect.ValueOf(syscall.RT_TABLEID_BITS),
+		"RT_TABLEID_MASK":                   reflect.ValueOf(syscall.RT_TABLEID_MASK),
+		"RT_TABLEID_MAX":                    reflect.ValueOf(syscall.RT_TABLEID_MAX),
+		"RUSAGE_CHILDREN":                   reflect.ValueOf(syscall.RUSAGE_CHILDREN),
+		"RUSAGE_SELF":                       reflect.ValueOf(syscall.RUSAGE_SELF),
+		"RUSAGE_THREAD":                     reflect.ValueOf(syscall.RUSAGE_THREAD),
+		"RawSyscall":                        reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                       reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                              reflect.ValueOf(syscall.Read),
+		"ReadDirent":                        reflect.ValueOf(syscall.ReadDirent),
+		"Readlink":                          reflect.ValueOf(syscall.Readlink),
+		"Recvfrom":                          reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                           reflect.ValueOf(syscall.Recvmsg),
+		"Rename":                            reflect.ValueOf(syscall.Rename),
+		"Revoke":                            reflect.ValueOf(syscall.Revoke),
+		"Rmdir":                             reflect.ValueOf(syscall.Rmdir),
+		"RouteRIB":                          reflect.ValueOf(syscall.RouteRIB),
+		"SCM_RIGHTS":                        reflect.ValueOf(syscall.SCM_RIGHTS),
+		"SCM_TIMESTAMP":                     reflect.ValueOf(syscall.SCM_TIMESTAMP),
+		"SHUT_RD":                           reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                         reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                           reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                           reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                           reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                            reflect.ValueOf(syscall.SIGBUS),
+		"SIGCHLD":                           reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCONT":                           reflect.ValueOf(syscall.SIGCONT),
+		"SIGEMT":                            reflect.ValueOf(syscall.SIGEMT),
+		"SIGFPE":                            reflect.ValueOf(syscall.SIGFPE),
+		"SIGHUP":                            reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                            reflect.ValueOf(syscall.SIGILL),
+		"SIGINFO":                           reflect.ValueOf(syscall.SIGINFO),
+		"SIGINT":                            reflect.ValueOf(syscall.SIGINT),
+		"SIGIO":                             reflect.ValueOf(syscall.SIGIO),
+		"SIGIOT":                            reflect.ValueOf(syscall.SIGIOT),
+		"SIGKILL":                           reflect.ValueOf(syscall.SIGKILL),
+		"SIGPIPE":                           reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPROF":                           reflect.ValueOf(syscall.SIGPROF),
+		"SIGQUIT":                           reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                           reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSTOP":                           reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                            reflect.ValueOf(syscall.SIGSYS),
+		"SIGTERM":                           reflect.ValueOf(syscall.SIGTERM),
+		"SIGTHR":                            reflect.ValueOf(syscall.SIGTHR),
+		"SIGTRAP":                           reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                           reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                           reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                           reflect.ValueOf(syscall.SIGTTOU),
+		"SIGURG":                            reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                           reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                           reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVTALRM":                         reflect.ValueOf(syscall.SIGVTALRM),
+		"SIGWINCH":                          reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                           reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                           reflect.ValueOf(syscall.SIGXFSZ),
+		"SIOCADDMULTI":                      reflect.ValueOf(uint32(syscall.SIOCADDMULTI)),
+		"SIOCAIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCAIFADDR)),
+		"SIOCAIFGROUP":                      reflect.ValueOf(uint32(syscall.SIOCAIFGROUP)),
+		"SIOCATMARK":                        reflect.ValueOf(syscall.SIOCATMARK),
+		"SIOCBRDGADD":                       reflect.ValueOf(uint32(syscall.SIOCBRDGADD)),
+		"SIOCBRDGADDL":                      reflect.ValueOf(uint32(syscall.SIOCBRDGADDL)),
+		"SIOCBRDGADDS":                      reflect.ValueOf(uint32(syscall.SIOCBRDGADDS)),
+		"SIOCBRDGARL":                       reflect.ValueOf(uint32(syscall.SIOCBRDGARL)),
+		"SIOCBRDGDADDR":                     reflect.ValueOf(uint32(syscall.SIOCBRDGDADDR)),
+		"SIOCBRDGDEL":                       reflect.ValueOf(uint32(syscall.SIOCBRDGDEL)),
+		"SIOCBRDGDELS":                      reflect.ValueOf(uint32(syscall.SIOCBRDGDELS)),
+		"SIOCBRDGFLUSH":                     reflect.ValueOf(uint32(syscall.SIOCBRDGFLUSH)),
+		"SIOCBRDGFRL":                       reflect.ValueOf(uint32(syscall.SIOCBRDGFRL)),
+		"SIOCBRDGGCACHE":                    reflect.ValueOf(uint32(syscall.SIOCBRDGGCACHE)),
+		"SIOCBRDGGFD":                       reflect.ValueOf(uint32(syscall.SIOCBRDGGFD)),
+		"SIOCBRDGGHT":                       reflect.ValueOf(uint32(syscall.SIOCBRDGGHT)),
+		"SIOCBRDGGIFFLGS":                   reflect.ValueOf(uint32(syscall.SIOCBRDGGIFFLGS)),
+		"SIOCBRDGGMA":                       reflect.ValueOf(uint32(syscall.SIOCBRDGGMA)),
+		"SIOCBRDGGPARAM":                    reflect.ValueOf(uint32(syscall.SIOCBRDGGPARAM)),
+		"SIOCBRDGGPRI":                      reflect.ValueOf(uint32(syscall.SIOCBRDGGPRI)),
+		"SIOCBRDGGRL":                       reflect.ValueOf(uint32(syscall.SIOCBRDGGRL)),
+		"SIOCBRDGGTO":                       reflect.ValueOf(uint32(syscall.SIOCBRDGGTO)),
+		"SIOCBRDGIFS":                       reflect.ValueOf(uint32(syscall.SIOCBRDGIFS)),
+		"SIOCBRDGRTS":                       reflect.ValueOf(uint32(syscall.SIOCBRDGRTS)),
+		"SIOCBRDGSADDR":                     reflect.ValueOf(uint32(syscall.SIOCBRDGSADDR)),
+		"SIOCBRDGSCACHE":                    reflect.ValueOf(uint32(syscall.SIOCBRDGSCACHE)),
+		"SIOCBRDGSFD":                       reflect.ValueOf(uint32(syscall.SIOCBRDGSFD)),
+		"SIOCBRDGSHT":                       reflect.ValueOf(uint32(syscall.SIOCBRDGSHT)),
+		"SIOCBRDGSIFCOST":                   reflect.ValueOf(uint32(syscall.SIOCBRDGSIFCOST)),
+		"SIOCBRDGSIFFLGS":                   reflect.ValueOf(uint32(syscall.SIOCBRDGSIFFLGS)),
+		"SIOCBRDGSIFPRIO":                   reflect.ValueOf(uint32(syscall.SIOCBRDGSIFPRIO)),
+		"SIOCBRDGSIFPROT":                   reflect.ValueOf(uint32(syscall.SIOCBRDGSIFPROT)),
+		"SIOCBRDGSMA":                       reflect.ValueOf(uint32(syscall.SIOCBRDGSMA)),
+		"SIOCBRDGSPRI":                      reflect.ValueOf(uint32(syscall.SIOCBRDGSPRI)),
+		"SIOCBRDGSPROTO":                    reflect.ValueOf(uint32(syscall.SIOCBRDGSPROTO)),
+		"SIOCBRDGSTO":                       reflect.ValueOf(uint32(syscall.SIOCBRDGSTO)),
+		"SIOCBRDGSTXHC":                     reflect.ValueOf(uint32(syscall.SIOCBRDGSTXHC)),
+		"SIOCDELLABEL":                      reflect.ValueOf(uint32(syscall.SIOCDELLABEL)),
+		"SIOCDELMULTI":                      reflect.ValueOf(uint32(syscall.SIOCDELMULTI)),
+		"SIOCDIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCDIFADDR)),
+		"SIOCDIFGROUP":                      reflect.ValueOf(uint32(syscall.SIOCDIFGROUP)),
+		"SIOCDIFPARENT":                     reflect.ValueOf(uint32(syscall.SIOCDIFPARENT)),
+		"SIOCDIFPHYADDR":                    reflect.ValueOf(uint32(syscall.SIOCDIFPHYADDR)),
+		"SIOCDPWE3NEIGHBOR":                 reflect.ValueOf(uint32(syscall.SIOCDPWE3NEIGHBOR)),
+		"SIOCDVNETID":                       reflect.ValueOf(uint32(syscall.SIOCDVNETID)),
+		"SIOCGETKALIVE":                     reflect.ValueOf(uint32(syscall.SIOCGETKALIVE)),
+		"SIOCGETLABEL":                      reflect.ValueOf(uint32(syscall.SIOCGETLABEL)),
+		"SIOCGETMPWCFG":                     reflect.ValueOf(uint32(syscall.SIOCGETMPWCFG)),
+		"SIOCGETPFLOW":                      reflect.ValueOf(uint32(syscall.SIOCGETPFLOW)),
+		"SIOCGETPFSYNC":                     reflect.ValueOf(uint32(syscall.SIOCGETPFSYNC)),
+		"SIOCGETSGCNT":                      reflect.ValueOf(uint32(syscall.SIOCGETSGCNT)),
+		"SIOCGETVIFCNT":                     reflect.ValueOf(uint32(syscall.SIOCGETVIFCNT)),
+		"SIOCGETVLAN":                       reflect.ValueOf(uint32(syscall.SIOCGETVLAN)),
+		"SIOCGIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCGIFADDR)),
+		"SIOCGIFBRDADDR":                    reflect.ValueOf(uint32(syscall.SIOCGIFBRDADDR)),
+		"SIOCGIFCONF":                       reflect.ValueOf(uint32(syscall.SIOCGIFCONF)),
+		"SIOCGIFDATA":                       reflect.ValueOf(uint32(syscall.SIOCGIFDATA)),
+		"SIOCGIFDESCR":                      reflect.ValueOf(uint32(syscall.SIOCGIFDESCR)),
+		"SIOCGIFDSTADDR":                    reflect.ValueOf(uint32(syscall.SIOCGIFDSTADDR)),
+		"SIOCGIFFLAGS":                      reflect.ValueOf(uint32(syscall.SIOCGIFFLAGS)),
+		"SIOCGIFGATTR":                      reflect.ValueOf(uint32(syscall.SIOCGIFGATTR)),
+		"SIOCGIFGENERIC":                    reflect.ValueOf(uint32(syscall.SIOCGIFGENERIC)),
+		"SIOCGIFGLIST":                      reflect.ValueOf(uint32(syscall.SIOCGIFGLIST)),
+		"SIOCGIFGMEMB":                      reflect.ValueOf(uint32(syscall.SIOCGIFGMEMB)),
+		"SIOCGIFGROUP":                      reflect.ValueOf(uint32(syscall.SIOCGIFGROUP)),
+		"SIOCGIFHARDMTU":                    reflect.ValueOf(uint32(syscall.SIOCGIFHARDMTU)),
+		"SIOCGIFLLPRIO":                     reflect.ValueOf(uint32(syscall.SIOCGIFLLPRIO)),
+		"SIOCGIFMEDIA":                      reflect.ValueOf(uint32(syscall.SIOCGIFMEDIA)),
+		"SIOCGIFMETRIC":                     reflect.ValueOf(uint32(syscall.SIOCGIFMETRIC)),
+		"SIOCGIFMTU":                        reflect.ValueOf(uint32(syscall.SIOCGIFMTU)),
+		"SIOCGIFNETMASK":                    reflect.ValueOf(uint32(syscall.SIOCGIFNETMASK)),
+		"SIOCGIFPAIR":                       reflect.ValueOf(uint32(syscall.SIOCGIFPAIR)),
+		"SIOCGIFPARENT":                     reflect.ValueOf(uint32(syscall.SIOCGIFPARENT)),
+		"SIOCGIFPRIORITY":                   reflect.ValueOf(uint32(syscall.SIOCGIFPRIORITY)),
+		"SIOCGIFRDOMAIN":                    reflect.ValueOf(uint32(syscall.SIOCGIFRDOMAIN)),
+		"SIOCGIFRTLABEL":                    reflect.ValueOf(uint32(syscall.SIOCGIFRTLABEL)),
+		"SIOCGIFRXR":                        reflect.ValueOf(uint32(syscall.SIOCGIFRXR)),
+		"SIOCGIFSFFPAGE":                    reflect.ValueOf(uint32(syscall.SIOCGIFSFFPAGE)),
+		"SIOCGIFXFLAGS":                     reflect.ValueOf(uint32(syscall.SIOCGIFXFLAGS)),
+		"SIOCGLIFPHYADDR":                   reflect.ValueOf(uint32(syscall.SIOCGLIFPHYADDR)),
+		"SIOCGLIFPHYDF":                     reflect.ValueOf(uint32(syscall.SIOCGLIFPHYDF)),
+		"SIOCGLIFPHYECN":                    reflect.ValueOf(uint32(syscall.SIOCGLIFPHYECN)),
+		"SIOCGLIFPHYRTABLE":                 reflect.ValueOf(uint32(syscall.SIOCGLIFPHYRTABLE)),
+		"SIOCGLIFPHYTTL":                    reflect.ValueOf(uint32(syscall.SIOCGLIFPHYTTL)),
+		"SIOCGPGRP":                         reflect.ValueOf(syscall.SIOCGPGRP),
+		"SIOCGPWE3":                         reflect.ValueOf(uint32(syscall.SIOCGPWE3)),
+		"SIOCGPWE3CTRLWORD":                 reflect.ValueOf(uint32(syscall.SIOCGPWE3CTRLWORD)),
+		"SIOCGPWE3FAT":                      reflect.ValueOf(uint32(syscall.SIOCGPWE3FAT)),
+		"SIOCGPWE3NEIGHBOR":                 reflect.ValueOf(uint32(syscall.SIOCGPWE3NEIGHBOR)),
+		"SIOCGRXHPRIO":                      reflect.ValueOf(uint32(syscall.SIOCGRXHPRIO)),
+		"SIOCGSPPPPARAMS":                   reflect.ValueOf(uint32(syscall.SIOCGSPPPPARAMS)),
+		"SIOCGTXHPRIO":                      reflect.ValueOf(uint32(syscall.SIOCGTXHPRIO)),
+		"SIOCGUMBINFO":                      reflect.ValueOf(uint32(syscall.SIOCGUMBINFO)),
+		"SIOCGUMBPARAM":                     reflect.ValueOf(uint32(syscall.SIOCGUMBPARAM)),
+		"SIOCGVH":                           reflect.ValueOf(uint32(syscall.SIOCGVH)),
+		"SIOCGVNETFLOWID":                   reflect.ValueOf(uint32(syscall.SIOCGVNETFLOWID)),
+		"SIOCGVNETID":                       reflect.ValueOf(uint32(syscall.SIOCGVNETID)),
+		"SIOCIFAFATTACH":                    reflect.ValueOf(uint32(syscall.SIOCIFAFATTACH)),
+		"SIOCIFAFDETACH":                    reflect.ValueOf(uint32(syscall.SIOCIFAFDETACH)),
+		"SIOCIFCREATE":                      reflect.ValueOf(uint32(syscall.SIOCIFCREATE)),
+		"SIOCIFDESTROY":                     reflect.ValueOf(uint32(syscall.SIOCIFDESTROY)),
+		"SIOCIFGCLONERS":                    reflect.ValueOf(uint32(syscall.SIOCIFGCLONERS)),
+		"SIOCSETKALIVE":                     reflect.ValueOf(uint32(syscall.SIOCSETKALIVE)),
+		"SIOCSETLABEL":                      reflect.ValueOf(uint32(syscall.SIOCSETLABEL)),
+		"SIOCSETMPWCFG":                     reflect.ValueOf(uint32(syscall.SIOCSETMPWCFG)),
+		"SIOCSETPFLOW":                      reflect.ValueOf(uint32(syscall.SIOCSETPFLOW)),
+		"SIOCSETPFSYNC":                     reflect.ValueOf(uint32(syscall.SIOCSETPFSYNC)),
+		"SIOCSETVLAN":                       reflect.ValueOf(uint32(syscall.SIOCSETVLAN)),
+		"SIOCSIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCSIFADDR)),
+		"SIOCSIFBRDADDR":                    reflect.ValueOf(uint32(syscall.SIOCSIFBRDADDR)),
+		"SIOCSIFDESCR":                      reflect.ValueOf(uint32(syscall.SIOCSIFDESCR)),
+		"SIOCSIFDSTADDR":                    reflect.ValueOf(uint32(syscall.SIOCSIFDSTADDR)),
+		"SIOCSIFFLAGS":                      reflect.ValueOf(uint32(syscall.SIOCSIFFLAGS)),
+		"SIOCSIFGATTR":                      reflect.ValueOf(uint32(syscall.SIOCSIFGATTR)),
+		"SIOCSIFGENERIC":                    reflect.ValueOf(uint32(syscall.SIOCSIFGENERIC)),
+		"SIOCSIFLLADDR":                     reflect.ValueOf(uint32(syscall.SIOCSIFLLADDR)),
+		"SIOCSIFLLPRIO":                     reflect.ValueOf(uint32(syscall.SIOCSIFLLPRIO)),
+		"SIOCSIFMEDIA":                      reflect.ValueOf(uint32(syscall.SIOCSIFMEDIA)),
+		"SIOCSIFMETRIC":                     reflect.ValueOf(uint32(syscall.SIOCSIFMETRIC)),
+		"SIOCSIFMTU":                        reflect.ValueOf(uint32(syscall.SIOCSIFMTU)),
+		"SIOCSIFNETMASK":                    reflect.ValueOf(uint32(syscall.SIOCSIFNETMASK)),
+		"SIOCSIFPAIR":                       reflect.ValueOf(uint32(syscall.SIOCSIFPAIR)),
+		"SIOCSIFPARENT":                     reflect.ValueOf(uint32(syscall.SIOCSIFPARENT)),
+		"SIOCSIFPRIORITY":                   reflect.ValueOf(uint32(syscall.SIOCSIFPRIORITY)),
+		"SIOCSIFRDOMAIN":                    reflect.ValueOf(uint32(syscall.SIOCSIFRDOMAIN)),
+		"SIOCSIFRTLABEL":                    reflect.ValueOf(uint32(syscall.SIOCSIFRTLABEL)),
+		"SIOCSIFXFLAGS":                     reflect.ValueOf(uint32(syscall.SIOCSIFXFLAGS)),
+		"SIOCSLIFPHYADDR":                   reflect.ValueOf(uint32(syscall.SIOCSLIFPHYADDR)),
+		"SIOCSLIFPHYDF":                     reflect.ValueOf(uint32(syscall.SIOCSLIFPHYDF)),
+		"SIOCSLIFPHYECN":                    reflect.ValueOf(uint32(syscall.SIOCSLIFPHYECN)),
+		"SIOCSLIFPHYRTABLE":                 reflect.ValueOf(uint32(syscall.SIOCSLIFPHYRTABLE)),
+		"SIOCSLIFPHYTTL":                    reflect.ValueOf(uint32(syscall.SIOCSLIFPHYTTL)),
+		"SIOCSPGRP":                         reflect.ValueOf(uint32(syscall.SIOCSPGRP)),
+		"SIOCSPWE3CTRLWORD":                 reflect.ValueOf(uint32(syscall.SIOCSPWE3CTRLWORD)),
+		"SIOCSPWE3FAT":                      reflect.ValueOf(uint32(syscall.SIOCSPWE3FAT)),
+		"SIOCSPWE3NEIGHBOR":                 reflect.ValueOf(uint32(syscall.SIOCSPWE3NEIGHBOR)),
+		"SIOCSRXHPRIO":                      reflect.ValueOf(uint32(syscall.SIOCSRXHPRIO)),
+		"SIOCSSPPPPARAMS":                   reflect.ValueOf(uint32(syscall.SIOCSSPPPPARAMS)),
+		"SIOCSTXHPRIO":                      reflect.ValueOf(uint32(syscall.SIOCSTXHPRIO)),
+		"SIOCSUMBPARAM":                     reflect.ValueOf(uint32(syscall.SIOCSUMBPARAM)),
+		"SIOCSVH":                           reflect.ValueOf(uint32(syscall.SIOCSVH)),
+		"SIOCSVNETFLOWID":                   reflect.ValueOf(uint32(syscall.SIOCSVNETFLOWID)),
+		"SIOCSVNETID":                       reflect.ValueOf(uint32(syscall.SIOCSVNETID)),
+		"SOCK_CLOEXEC":                      reflect.ValueOf(syscall.SOCK_CLOEXEC),
+		"SOCK_DGRAM":                        reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_DNS":                          reflect.ValueOf(syscall.SOCK_DNS),
+		"SOCK_NONBLOCK":                     reflect.ValueOf(syscall.SOCK_NONBLOCK),
+		"SOCK_RAW":                          reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_RDM":                          reflect.ValueOf(syscall.SOCK_RDM),
+		"SOCK_SEQPACKET":                    reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                       reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOL_SOCKET":                        reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOMAXCONN":                         reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ACCEPTCONN":                     reflect.ValueOf(syscall.SO_ACCEPTCONN),
+		"SO_BINDANY":                        reflect.ValueOf(syscall.SO_BINDANY),
+		"SO_BROADCAST":                      reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_DEBUG":                          reflect.ValueOf(syscall.SO_DEBUG),
+		"SO_DOMAIN":                         reflect.ValueOf(syscall.SO_DOMAIN),
+		"SO_DONTROUTE":                      reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_ERROR":                          reflect.ValueOf(syscall.SO_ERROR),
+		"SO_KEEPALIVE":                      reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_LINGER":                         reflect.ValueOf(syscall.SO_LINGER),
+		"SO_NETPROC":                        reflect.ValueOf(syscall.SO_NETPROC),
+		"SO_OOBINLINE":                      reflect.ValueOf(syscall.SO_OOBINLINE),
+		"SO_PEERCRED":                       reflect.ValueOf(syscall.SO_PEERCRED),
+		"SO_PROTOCOL":                       reflect.ValueOf(syscall.SO_PROTOCOL),
+		"SO_RCVBUF":                         reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_RCVLOWAT":                       reflect.ValueOf(syscall.SO_RCVLOWAT),
+		"SO_RCVTIMEO":                       reflect.ValueOf(syscall.SO_RCVTIMEO),
+		"SO_REUSEADDR":                      reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_REUSEPORT":                      reflect.ValueOf(syscall.SO_REUSEPORT),
+		"SO_RTABLE":                         reflect.ValueOf(syscall.SO_RTABLE),
+		"SO_SNDBUF":                         reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_SNDLOWAT":                       reflect.ValueOf(syscall.SO_SNDLOWAT),
+		"SO_SNDTIMEO":                       reflect.ValueOf(syscall.SO_SNDTIMEO),
+		"SO_SPLICE":                         reflect.ValueOf(syscall.SO_SPLICE),
+		"SO_TIMESTAMP":                      reflect.ValueOf(syscall.SO_TIMESTAMP),
+		"SO_TYPE":                           reflect.ValueOf(syscall.SO_TYPE),
+		"SO_USELOOPBACK":                    reflect.ValueOf(syscall.SO_USELOOPBACK),
+		"SO_ZEROIZE":                        reflect.ValueOf(syscall.SO_ZEROIZE),
+		"SYS_ACCEPT":                        reflect.ValueOf(syscall.SYS_ACCEPT),
+		"SYS_ACCEPT4":                       reflect.ValueOf(syscall.SYS_ACCEPT4),
+		"SYS_ACCESS":                        reflect.ValueOf(syscall.SYS_ACCESS),
+		"SYS_ACCT":                          reflect.ValueOf(syscall.SYS_ACCT),
+		"SYS_ADJFREQ":                       reflect.ValueOf(syscall.SYS_ADJFREQ),
+		"SYS_ADJTIME":                       reflect.ValueOf(syscall.SYS_ADJTIME),
+		"SYS_BIND":                          reflect.ValueOf(syscall.SYS_BIND),
+		"SYS_CHDIR":                         reflect.ValueOf(syscall.SYS_CHDIR),
+		"SYS_CHFLAGS":                       reflect.ValueOf(syscall.SYS_CHFLAGS),
+		"SYS_CHFLAGSAT":                     reflect.ValueOf(syscall.SYS_CHFLAGSAT),
+		"SYS_CHMOD":                         reflect.ValueOf(syscall.SYS_CHMOD),
+		"SYS_CHOWN":                         reflect.ValueOf(syscall.SYS_CHOWN),
+		"SYS_CHROOT":                        reflect.ValueOf(syscall.SYS_CHROOT),
+		"SYS_CLOCK_GETRES":                  reflect.ValueOf(syscall.SYS_CLOCK_GETRES),
+		"SYS_CLOCK_GETTIME":                 reflect.ValueOf(syscall.SYS_CLOCK_GETTIME),
+		"SYS_CLOCK_SETTIME":                 reflect.ValueOf(syscall.SYS_CLOCK_SETTIME),
+		"SYS_CLOSE":                         reflect.ValueOf(syscall.SYS_CLOSE),
+		"SYS_CLOSEFROM":                     reflect.ValueOf(syscall.SYS_CLOSEFROM),
+		"SYS_CONNECT":                       reflect.ValueOf(syscall.SYS_CONNECT),
+		"SYS_DUP":                           reflect.ValueOf(syscall.SYS_DUP),
+		"SYS_DUP2":                          reflect.ValueOf(syscall.SYS_DUP2),
+		"SYS_DUP3":                          reflect.ValueOf(syscall.SYS_DUP3),
+		"SYS_EXECVE":                        reflect.ValueOf(syscall.SYS_EXECVE),
+		"SYS_EXIT":                          reflect.ValueOf(syscall.SYS_EXIT),
+		"SYS_FACCESSAT":                     reflect.ValueOf(syscall.SYS_FACCESSAT),
+		"SYS_FCHDIR":                        reflect.ValueOf(syscall.SYS_FCHDIR),
+		"SYS_FCHFLAGS":                      reflect.ValueOf(syscall.SYS_FCHFLAGS),
+		"SYS_FCHMOD":                        reflect.ValueOf(syscall.SYS_FCHMOD),
+		"SYS_FCHMODAT":                      reflect.ValueOf(syscall.SYS_FCHMODAT),
+		"SYS_FCHOWN":                        reflect.ValueOf(syscall.SYS_FCHOWN),
+		"SYS_FCHOWNAT":                      reflect.ValueOf(syscall.SYS_FCHOWNAT),
+		"SYS_FCNTL":                         reflect.ValueOf(syscall.SYS_FCNTL),
+		"SYS_FHOPEN":                        reflect.ValueOf(syscall.SYS_FHOPEN),
+		"SYS_FHSTAT":                        reflect.ValueOf(syscall.SYS_FHSTAT),
+		"SYS_FHSTATFS":                      reflect.ValueOf(syscall.SYS_FHSTATFS),
+		"SYS_FLOCK":                         reflect.ValueOf(syscall.SYS_FLOCK),
+		"SYS_FORK":                          reflect.ValueOf(syscall.SYS_FORK),
+		"SYS_FPATHCONF":                     reflect.ValueOf(syscall.SYS_FPATHCONF),
+		"SYS_FSTAT":                         reflect.ValueOf(syscall.SYS_FSTAT),
+		"SYS_FSTATAT":                       reflect.ValueOf(syscall.SYS_FSTATAT),
+		"SYS_FSTATFS":                       reflect.ValueOf(syscall.SYS_FSTATFS),
+		"SYS_FSYNC":                         reflect.ValueOf(syscall.SYS_FSYNC),
+		"SYS_FTRUNCATE":                     reflect.ValueOf(syscall.SYS_FTRUNCATE),
+		"SYS_FUTEX":                         reflect.ValueOf(syscall.SYS_FUTEX),
+		"SYS_FUTIMENS":                      reflect.ValueOf(syscall.SYS_FUTIMENS),
+		"SYS_FUTIMES":                       reflect.ValueOf(syscall.SYS_FUTIMES),
+		"SYS_GETDENTS":                      reflect.ValueOf(syscall.SYS_GETDENTS),
+		"SYS_GETDTABLECOUNT":                reflect.ValueOf(syscall.SYS_GETDTABLECOUNT),
+		"SYS_GETEGID":                       reflect.ValueOf(syscall.SYS_GETEGID),
+		"SYS_GETENTROPY":                    reflect.ValueOf(syscall.SYS_GETENTROPY),
+		"SYS_GETEUID":                       reflect.ValueOf(syscall.SYS_GETEUID),
+		"SYS_GETFH":                         reflect.ValueOf(syscall.SYS_GETFH),
+		"SYS_GETFSSTAT":                     reflect.ValueOf(syscall.SYS_GETFSSTAT),
+		"SYS_GETGID":                        reflect.ValueOf(syscall.SYS_GETGID),
+		"SYS_GETGROUPS":                     reflect.ValueOf(syscall.SYS_GETGROUPS),
+		"SYS_GETITIMER":                     reflect.ValueOf(syscall.SYS_GETITIMER),
+		"SYS_GETLOGIN_R":                    reflect.ValueOf(syscall.SYS_GETLOGIN_R),
+		"SYS_GETPEERNAME":                   reflect.ValueOf(syscall.SYS_GETPEERNAME),
+		"SYS_GETPGID":                       reflect.ValueOf(syscall.SYS_GETPGID),
+		"SYS_GETPGRP":                       reflect.ValueOf(syscall.SYS_GETPGRP),
+		"SYS_GETPID":                        reflect.ValueOf(syscall.SYS_GETPID),
+		"SYS_GETPPID":                       reflect.ValueOf(syscall.SYS_GETPPID),
+		"SYS_GETPRIORITY":                   reflect.ValueOf(syscall.SYS_GETPRIORITY),
+		"SYS_GETRESGID":                     reflect.ValueOf(syscall.SYS_GETRESGID),
+		"SYS_GETRESUID":                     reflect.ValueOf(syscall.SYS_GETRESUID),
+		"SYS_GETRLIMIT":                     reflect.ValueOf(syscall.SYS_GETRLIMIT),
+		"SYS_GETRTABLE":                     reflect.ValueOf(syscall.SYS_GETRTABLE),
+		"SYS_GETRUSAGE":                     reflect.ValueOf(syscall.SYS_GETRUSAGE),
+		"SYS_GETSID":                        reflect.ValueOf(syscall.SYS_GETSID),
+		"SYS_GETSOCKNAME":                   reflect.ValueOf(syscall.SYS_GETSOCKNAME),
+		"SYS_GETSOCKOPT":                    reflect.ValueOf(syscall.SYS_GETSOCKOPT),
+		"SYS_GETTHRID":                      reflect.ValueOf(syscall.SYS_GETTHRID),
+		"SYS_GETTIMEOFDAY":                  reflect.ValueOf(syscall.SYS_GETTIMEOFDAY),
+		"SYS_GETUID":                        reflect.ValueOf(syscall.SYS_GETUID),
+		"SYS_IOCTL":                         reflect.ValueOf(syscall.SYS_IOCTL),
+		"SYS_ISSETUGID":                     reflect.ValueOf(syscall.SYS_ISSETUGID),
+		"SYS_KBIND":                         reflect.ValueOf(syscall.SYS_KBIND),
+		"SYS_KEVENT":                        reflect.ValueOf(syscall.SYS_KEVENT),
+		"SYS_KILL":                          reflect.ValueOf(syscall.SYS_KILL),
+		"SYS_KQUEUE":                        reflect.ValueOf(syscall.SYS_KQUEUE),
+		"SYS_KTRACE":                        reflect.ValueOf(syscall.SYS_KTRACE),
+		"SYS_LCHOWN":                        reflect.ValueOf(syscall.SYS_LCHOWN),
+		"SYS_LINK":                          reflect.ValueOf(syscall.SYS_LINK),
+		"SYS_LINKAT":                        reflect.ValueOf(syscall.SYS_LINKAT),
+		"SYS_LISTEN":                        reflect.ValueOf(syscall.SYS_LISTEN),
+		"SYS_LSEEK":                         reflect.ValueOf(syscall.SYS_LSEEK),
+		"SYS_LSTAT":                         reflect.ValueOf(syscall.SYS_LSTAT),
+		"SYS_MADVISE":                       reflect.ValueOf(syscall.SYS_MADVISE),
+		"SYS_MINHERIT":                      reflect.ValueOf(syscall.SYS_MINHERIT),
+		"SYS_MKDIR":                         reflect.ValueOf(syscall.SYS_MKDIR),
+		"SYS_MKDIRAT":                       reflect.ValueOf(syscall.SYS_MKDIRAT),
+		"SYS_MKFIFO":                        reflect.ValueOf(syscall.SYS_MKFIFO),
+		"SYS_MKFIFOAT":                      reflect.ValueOf(syscall.SYS_MKFIFOAT),
+		"SYS_MKNOD":                         reflect.ValueOf(syscall.SYS_MKNOD),
+		"SYS_MKNODAT":                       reflect.ValueOf(syscall.SYS_MKNODAT),
+		"SYS_MLOCK":                         reflect.ValueOf(syscall.SYS_MLOCK),
+		"SYS_MLOCKALL":                      reflect.ValueOf(syscall.SYS_MLOCKALL),
+		"SYS_MMAP":                          reflect.ValueOf(syscall.SYS_MMAP),
+		"SYS_MOUNT":                         reflect.ValueOf(syscall.SYS_MOUNT),
+		"SYS_MPROTECT":                      reflect.ValueOf(syscall.SYS_MPROTECT),
+		"SYS_MQUERY":                        reflect.ValueOf(syscall.SYS_MQUERY),
+		"SYS_MSGCTL":                        reflect.ValueOf(syscall.SYS_MSGCTL),
+		"SYS_MSGGET":                        reflect.ValueOf(syscall.SYS_MSGGET),
+		"SYS_MSGRCV":                        reflect.ValueOf(syscall.SYS_MSGRCV),
+		"SYS_MSGSND":                        reflect.ValueOf(syscall.SYS_MSGSND),
+		"SYS_MSYNC":                         reflect.ValueOf(syscall.SYS_MSYNC),
+		"SYS_MSYSCALL":                      reflect.ValueOf(syscall.SYS_MSYSCALL),
+		"SYS_MUNLOCK":                       reflect.ValueOf(syscall.SYS_MUNLOCK),
+		"SYS_MUNLOCKALL":                    reflect.ValueOf(syscall.SYS_MUNLOCKALL),
+		"SYS_MUNMAP":                        reflect.ValueOf(syscall.SYS_MUNMAP),
+		"SYS_NANOSLEEP":                     reflect.ValueOf(syscall.SYS_NANOSLEEP),
+		"SYS_NFSSVC":                        reflect.ValueOf(syscall.SYS_NFSSVC),
+		"SYS_OBREAK":                        reflect.ValueOf(syscall.SYS_OBREAK),
+		"SYS_OPEN":                          reflect.ValueOf(syscall.SYS_OPEN),
+		"SYS_OPENAT":                        reflect.ValueOf(syscall.SYS_OPENAT),
+		"SYS_PAD_FTRUNCATE":                 reflect.ValueOf(syscall.SYS_PAD_FTRUNCATE),
+		"SYS_PAD_LSEEK":                     reflect.ValueOf(syscall.SYS_PAD_LSEEK),
+		"SYS_PAD_MMAP":                      reflect.ValueOf(syscall.SYS_PAD_MMAP),
+		"SYS_PAD_MQUERY":                    reflect.ValueOf(syscall.SYS_PAD_MQUERY),
+		"SYS_PAD_PREAD":                     reflect.ValueOf(syscall.SYS_PAD_PREAD),
+		"SYS_PAD_PREADV":                    reflect.ValueOf(syscall.SYS_PAD_PREADV),
+		"SYS_PAD_PWRITE":                    reflect.ValueOf(syscall.SYS_PAD_PWRITE),
+		"SYS_PAD_PWRITEV":                   reflect.ValueOf(syscall.SYS_PAD_PWRITEV),
+		"SYS_PAD_TRUNCATE":                  reflect.ValueOf(syscall.SYS_PAD_TRUNCATE),
+		"SYS_PATHCONF":                      reflect.ValueOf(syscall.SYS_PATHCONF),
+		"SYS_PIPE":                          reflect.ValueOf(syscall.SYS_PIPE),
+		"SYS_PIPE2":                         reflect.ValueOf(syscall.SYS_PIPE2),
+		"SYS_PLEDGE":                        reflect.ValueOf(syscall.SYS_PLEDGE),
+		"SYS_POLL":                          reflect.ValueOf(syscall.SYS_POLL),
+		"SYS_PPOLL":                         reflect.ValueOf(syscall.SYS_PPOLL),
+		"SYS_PREAD":                         reflect.ValueOf(syscall.SYS_PREAD),
+		"SYS_PREADV":                        reflect.ValueOf(syscall.SYS_PREADV),
+		"SYS_PROFIL":                        reflect.ValueOf(syscall.SYS_PROFIL),
+		"SYS_PSELECT":                       reflect.ValueOf(syscall.SYS_PSELECT),
+		"SYS_PTRACE":                        reflect.ValueOf(syscall.SYS_PTRACE),
+		"SYS_PWRITE":                        reflect.ValueOf(syscall.SYS_PWRITE),
+		"SYS_PWRITEV":                       reflect.ValueOf(syscall.SYS_PWRITEV),
+		"SYS_QUOTACTL":                      reflect.ValueOf(syscall.SYS_QUOTACTL),
+		"SYS_READ":                          reflect.ValueOf(syscall.SYS_READ),
+		"SYS_READLINK":                      reflect.ValueOf(syscall.SYS_READLINK),
+		"SYS_READLINKAT":                    reflect.ValueOf(syscall.SYS_READLINKAT),
+		"SYS_READV":                         reflect.ValueOf(syscall.SYS_READV),
+		"SYS_REBOOT":                        reflect.ValueOf(syscall.SYS_REBOOT),
+		"SYS_RECVFROM":                      reflect.ValueOf(syscall.SYS_RECVFROM),
+		"SYS_RECVMSG":                       reflect.ValueOf(syscall.SYS_RECVMSG),
+		"SYS_RENAME":                        reflect.ValueOf(syscall.SYS_RENAME),
+		"SYS_RENAMEAT":                      reflect.ValueOf(syscall.SYS_RENAMEAT),
+		"SYS_REVOKE":                        reflect.ValueOf(syscall.SYS_REVOKE),
+		"SYS_RMDIR":                         reflect.ValueOf(syscall.SYS_RMDIR),
+		"SYS_SCHED_YIELD":                   reflect.ValueOf(syscall.SYS_SCHED_YIELD),
+		"SYS_SELECT":                        reflect.ValueOf(syscall.SYS_SELECT),
+		"SYS_SEMGET":                        reflect.ValueOf(syscall.SYS_SEMGET),
+		"SYS_SEMOP":                         reflect.ValueOf(syscall.SYS_SEMOP),
+		"SYS_SENDMSG":                       reflect.ValueOf(syscall.SYS_SENDMSG),
+		"SYS_SENDSYSLOG":                    reflect.ValueOf(syscall.SYS_SENDSYSLOG),
+		"SYS_SENDTO":                        reflect.ValueOf(syscall.SYS_SENDTO),
+		"SYS_SETEGID":                       reflect.ValueOf(syscall.SYS_SETEGID),
+		"SYS_SETEUID":                       reflect.ValueOf(syscall.SYS_SETEUID),
+		"SYS_SETGID":                        reflect.ValueOf(syscall.SYS_SETGID),
+		"SYS_SETGROUPS":                     reflect.ValueOf(syscall.SYS_SETGROUPS),
+		"SYS_SETITIMER":                     reflect.ValueOf(syscall.SYS_SETITIMER),
+		"SYS_SETLOGIN":                      reflect.ValueOf(syscall.SYS_SETLOGIN),
+		"SYS_SETPGID":                       reflect.ValueOf(syscall.SYS_SETPGID),
+		"SYS_SETPRIORITY":                   reflect.ValueOf(syscall.SYS_SETPRIORITY),
+		"SYS_SETREGID":                      reflect.ValueOf(syscall.SYS_SETREGID),
+		"SYS_SETRESGID":                     reflect.ValueOf(syscall.SYS_SETRESGID),
+		"SYS_SETRESUID":                     reflect.ValueOf(syscall.SYS_SETRESUID),
+		"SYS_SETREUID":                      reflect.ValueOf(syscall.SYS_SETREUID),
+		"SYS_SETRLIMIT":                     reflect.ValueOf(syscall.SYS_SETRLIMIT),
+		"SYS_SETRTABLE":                     reflect.ValueOf(syscall.SYS_SETRTABLE),
+		"SYS_SETSID":                        reflect.ValueOf(syscall.SYS_SETSID),
+		"SYS_SETSOCKOPT":                    reflect.ValueOf(syscall.SYS_SETSOCKOPT),
+		"SYS_SETTIMEOFDAY":                  reflect.ValueOf(syscall.SYS_SETTIMEOFDAY),
+		"SYS_SETUID":                        reflect.ValueOf(syscall.SYS_SETUID),
+		"SYS_SHMAT":                         reflect.ValueOf(syscall.SYS_SHMAT),
+		"SYS_SHMCTL":                        reflect.ValueOf(syscall.SYS_SHMCTL),
+		"SYS_SHMDT":                         reflect.ValueOf(syscall.SYS_SHMDT),
+		"SYS_SHMGET":                        reflect.ValueOf(syscall.SYS_SHMGET),
+		"SYS_SHUTDOWN":                      reflect.ValueOf(syscall.SYS_SHUTDOWN),
+		"SYS_SIGACTION":                     reflect.ValueOf(syscall.SYS_SIGACTION),
+		"SYS_SIGALTSTACK":                   reflect.ValueOf(syscall.SYS_SIGALTSTACK),
+		"SYS_SIGPENDING":                    reflect.ValueOf(syscall.SYS_SIGPENDING),
+		"SYS_SIGPROCMASK":                   reflect.ValueOf(syscall.SYS_SIGPROCMASK),
+		"SYS_SIGRETURN":                     reflect.ValueOf(syscall.SYS_SIGRETURN),
+		"SYS_SIGSUSPEND":                    reflect.ValueOf(syscall.SYS_SIGSUSPEND),
+		"SYS_SOCKET":                        reflect.ValueOf(syscall.SYS_SOCKET),
+		"SYS_SOCKETPAIR":                    reflect.ValueOf(syscall.SYS_SOCKETPAIR),
+		"SYS_STAT":                          reflect.ValueOf(syscall.SYS_STAT),
+		"SYS_STATFS":                        reflect.ValueOf(syscall.SYS_STATFS),
+		"SYS_SWAPCTL":                       reflect.ValueOf(syscall.SYS_SWAPCTL),
+		"SYS_SYMLINK":                       reflect.ValueOf(syscall.SYS_SYMLINK),
+		"SYS_SYMLINKAT":                     reflect.ValueOf(syscall.SYS_SYMLINKAT),
+		"SYS_SYNC":                          reflect.ValueOf(syscall.SYS_SYNC),
+		"SYS_SYSARCH":                       reflect.ValueOf(syscall.SYS_SYSARCH),
+		"SYS_SYSCTL":                        reflect.ValueOf(syscall.SYS_SYSCTL),
+		"SYS_THRKILL":                       reflect.ValueOf(syscall.SYS_THRKILL),
+		"SYS_TRUNCATE":                      reflect.ValueOf(syscall.SYS_TRUNCATE),
+		"SYS_UMASK":                         reflect.ValueOf(syscall.SYS_UMASK),
+		"SYS_UNLINK":                        reflect.ValueOf(syscall.SYS_UNLINK),
+		"SYS_UNLINKAT":                      reflect.ValueOf(syscall.SYS_UNLINKAT),
+		"SYS_UNMOUNT":                       reflect.ValueOf(syscall.SYS_UNMOUNT),
+		"SYS_UNVEIL":                        reflect.ValueOf(syscall.SYS_UNVEIL),
+		"SYS_UTIMENSAT":                     reflect.ValueOf(syscall.SYS_UTIMENSAT),
+		"SYS_UTIMES":                        reflect.ValueOf(syscall.SYS_UTIMES),
+		"SYS_UTRACE":                        reflect.ValueOf(syscall.SYS_UTRACE),
+		"SYS_VFORK":                         reflect.ValueOf(syscall.SYS_VFORK),
+		"SYS_WAIT4":                         reflect.ValueOf(syscall.SYS_WAIT4),
+		"SYS_WRITE":                         reflect.ValueOf(syscall.SYS_WRITE),
+		"SYS_WRITEV":                        reflect.ValueOf(syscall.SYS_WRITEV),
+		"SYS_YPCONNECT":                     reflect.ValueOf(syscall.SYS_YPCONNECT),
+		"SYS___GETCWD":                      reflect.ValueOf(syscall.SYS___GETCWD),
+		"SYS___GET_TCB":                     reflect.ValueOf(syscall.SYS___GET_TCB),
+		"SYS___REALPATH":                    reflect.ValueOf(syscall.SYS___REALPATH),
+		"SYS___SEMCTL":                      reflect.ValueOf(syscall.SYS___SEMCTL),
+		"SYS___SET_TCB":                     reflect.ValueOf(syscall.SYS___SET_TCB),
+		"SYS___SYSCTL":                      reflect.ValueOf(syscall.SYS___SYSCTL),
+		"SYS___TFORK":                       reflect.ValueOf(syscall.SYS___TFORK),
+		"SYS___THREXIT":                     reflect.ValueOf(syscall.SYS___THREXIT),
+		"SYS___THRSIGDIVERT":                reflect.ValueOf(syscall.SYS___THRSIGDIVERT),
+		"SYS___THRSLEEP":                    reflect.ValueOf(syscall.SYS___THRSLEEP),
+		"SYS___THRWAKEUP":                   reflect.ValueOf(syscall.SYS___THRWAKEUP),
+		"SYS___TMPFD":                       reflect.ValueOf(syscall.SYS___TMPFD),
+		"S_IFBLK":                           reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                           reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                           reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                           reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                           reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                            reflect.ValueOf(syscall.S_IFMT),
+		"S_IFREG":                           reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                          reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IRUSR":                           reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                           reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                           reflect.ValueOf(syscall.S_IRWXO),
+		"S_ISGID":                           reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                           reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                           reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWUSR":                           reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXUSR":                           reflect.ValueOf(syscall.S_IXUSR),
+		"Seek":                              reflect.ValueOf(syscall.Seek),
+		"Select":                            reflect.ValueOf(syscall.Select),
+		"Sendfile":                          reflect.ValueOf(syscall.Sendfile),
+		"Sendmsg":                           reflect.ValueOf(syscall.Sendmsg),
+		"SendmsgN":                          reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                            reflect.ValueOf(syscall.Sendto),
+		"SetBpf":                            reflect.ValueOf(syscall.SetBpf),
+		"SetBpfBuflen":                      reflect.ValueOf(syscall.SetBpfBuflen),
+		"SetBpfDatalink":                    reflect.ValueOf(syscall.SetBpfDatalink),
+		"SetBpfHeadercmpl":                  reflect.ValueOf(syscall.SetBpfHeadercmpl),
+		"SetBpfImmediate":                   reflect.ValueOf(syscall.SetBpfImmediate),
+		"SetBpfInterface":                   reflect.ValueOf(syscall.SetBpfInterface),
+		"SetBpfPromisc":                     reflect.ValueOf(syscall.SetBpfPromisc),
+		"SetBpfTimeout":                     reflect.ValueOf(syscall.SetBpfTimeout),
+		"SetKevent":                         reflect.ValueOf(syscall.SetKevent),
+		"SetNonblock":                       reflect.ValueOf(syscall.SetNonblock),
+		"Setegid":                           reflect.ValueOf(syscall.Setegid),
+		"Setenv":                            reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                           reflect.ValueOf(syscall.Seteuid),
+		"Setgid":                            reflect.ValueOf(syscall.Setgid),
+		"Setgroups":                         reflect.ValueOf(syscall.Setgroups),
+		"Setlogin":                          reflect.ValueOf(syscall.Setlogin),
+		"Setpgid":                           reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                       reflect.ValueOf(syscall.Setpriority),
+		"Setregid":                          reflect.ValueOf(syscall.Setregid),
+		"Setreuid":                          reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                         reflect.ValueOf(syscall.Setrlimit),
+		"Setsid":                            reflect.ValueOf(syscall.Setsid),
+		"SetsockoptByte":                    reflect.ValueOf(syscall.SetsockoptByte),
+		"SetsockoptICMPv6Filter":            reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
+		"SetsockoptIPMreq":                  reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPv6Mreq":                reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":               reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                     reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":                  reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptString":                  reflect.ValueOf(syscall.SetsockoptString),
+		"SetsockoptTimeval":                 reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Settimeofday":                      reflect.ValueOf(syscall.Settimeofday),
+		"Setuid":                            reflect.ValueOf(syscall.Setuid),
+		"Shutdown":                          reflect.ValueOf(syscall.Shutdown),
+		"SizeofBpfHdr":                      reflect.ValueOf(syscall.SizeofBpfHdr),
+		"SizeofBpfInsn":                     reflect.ValueOf(syscall.SizeofBpfInsn),
+		"SizeofBpfProgram":                  reflect.ValueOf(syscall.SizeofBpfProgram),
+		"SizeofBpfStat":                     reflect.ValueOf(syscall.SizeofBpfStat),
+		"SizeofBpfVersion":                  reflect.ValueOf(syscall.SizeofBpfVersion),
+		"SizeofCmsghdr":                     reflect.ValueOf(syscall.SizeofCmsghdr),
+		"SizeofICMPv6Filter":                reflect.ValueOf(syscall.SizeofICMPv6Filter),
+		"SizeofIPMreq":                      reflect.ValueOf(syscall.SizeofIPMreq),
+		"SizeofIPv6MTUInfo":                 reflect.ValueOf(syscall.SizeofIPv6MTUInfo),
+		"SizeofIPv6Mreq":                    reflect.ValueOf(syscall.SizeofIPv6Mreq),
+		"SizeofIfAnnounceMsghdr":            reflect.ValueOf(syscall.SizeofIfAnnounceMsghdr),
+		"SizeofIfData":                      reflect.ValueOf(syscall.SizeofIfData),
+		"SizeofIfMsghdr":                    reflect.ValueOf(syscall.SizeofIfMsghdr),
+		"SizeofIfaMsghdr":                   reflect.ValueOf(syscall.SizeofIfaMsghdr),
+		"SizeofInet6Pktinfo":                reflect.ValueOf(syscall.SizeofInet6Pktinfo),
+		"SizeofLinger":                      reflect.ValueOf(syscall.SizeofLinger),
+		"SizeofMsghdr":                      reflect.ValueOf(syscall.SizeofMsghdr),
+		"SizeofRtMetrics":                   reflect.ValueOf(syscall.SizeofRtMetrics),
+		"SizeofRtMsghdr":                    reflect.ValueOf(syscall.SizeofRtMsghdr),
+		"SizeofSockaddrAny":                 reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrDatalink":            reflect.ValueOf(syscall.SizeofSockaddrDatalink),
+		"SizeofSockaddrInet4":               reflect.ValueOf(syscall.SizeofSockaddrInet4),
+		"SizeofSockaddrInet6":               reflect.ValueOf(syscall.SizeofSockaddrInet6),
+		"SizeofSockaddrUnix":                reflect.ValueOf(syscall.SizeofSockaddrUnix),
+		"SlicePtrFromStrings":               reflect.ValueOf(syscall.SlicePtrFromStrings),
+		"Socket":                            reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":                 reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"Socketpair":                        reflect.ValueOf(syscall.Socketpair),
+		"StartProcess":                      reflect.ValueOf(syscall.StartProcess),
+		"Stat":                              reflect.ValueOf(syscall.Stat),
+		"Statfs":                            reflect.ValueOf(syscall.Statfs),
+		"Stderr":                            reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                             reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                            reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                     reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":                   reflect.ValueOf(syscall.StringByteSlice),
+		"StringSlicePtr":                    reflect.ValueOf(syscall.StringSlicePtr),
+		"Symlink":                           reflect.ValueOf(syscall.Symlink),
+		"Sync":                              reflect.ValueOf(syscall.Sync),
+		"Syscall":                           reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                          reflect.ValueOf(syscall.Syscall6),
+		"Syscall9":                          reflect.ValueOf(syscall.Syscall9),
+		"Sysctl":                            reflect.ValueOf(syscall.Sysctl),
+		"SysctlUint32":                      reflect.ValueOf(syscall.SysctlUint32),
+		"TCIFLUSH":                          reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                         reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                          reflect.ValueOf(syscall.TCOFLUSH),
+		"TCP_INFO":                          reflect.ValueOf(syscall.TCP_INFO),
+		"TCP_MAXSEG":                        reflect.ValueOf(syscall.TCP_MAXSEG),
+		"TCP_MAXWIN":                        reflect.ValueOf(syscall.TCP_MAXWIN),
+		"TCP_MAX_SACK":                      reflect.ValueOf(syscall.TCP_MAX_SACK),
+		"TCP_MAX_WINSHIFT":                  reflect.ValueOf(syscall.TCP_MAX_WINSHIFT),
+		"TCP_MD5SIG":                        reflect.ValueOf(syscall.TCP_MD5SIG),
+		"TCP_MSS":                           reflect.ValueOf(syscall.TCP_MSS),
+		"TCP_NODELAY":                       reflect.ValueOf(syscall.TCP_NODELAY),
+		"TCP_NOPUSH":                        reflect.ValueOf(syscall.TCP_NOPUSH),
+		"TCP_SACKHOLE_LIMIT":                reflect.ValueOf(syscall.TCP_SACKHOLE_LIMIT),
+		"TCP_SACK_ENABLE":                   reflect.ValueOf(syscall.TCP_SACK_ENABLE),
+		"TCSAFLUSH":                         reflect.ValueOf(syscall.TCSAFLUSH),
+		"TIOCCBRK":                          reflect.ValueOf(syscall.TIOCCBRK),
+		"TIOCCDTR":                          reflect.ValueOf(syscall.TIOCCDTR),
+		"TIOCCHKVERAUTH":                    reflect.ValueOf(syscall.TIOCCHKVERAUTH),
+		"TIOCCLRVERAUTH":                    reflect.ValueOf(syscall.TIOCCLRVERAUTH),
+		"TIOCCONS":                          reflect.ValueOf(uint32(syscall.TIOCCONS)),
+		"TIOCDRAIN":                         reflect.ValueOf(syscall.TIOCDRAIN),
+		"TIOCEXCL":                          reflect.ValueOf(syscall.TIOCEXCL),
+		"TIOCEXT":                           reflect.ValueOf(uint32(syscall.TIOCEXT)),
+		"TIOCFLAG_CLOCAL":                   reflect.ValueOf(syscall.TIOCFLAG_CLOCAL),
+		"TIOCFLAG_CRTSCTS":                  reflect.ValueOf(syscall.TIOCFLAG_CRTSCTS),
+		"TIOCFLAG_MDMBUF":                   reflect.ValueOf(syscall.TIOCFLAG_MDMBUF),
+		"TIOCFLAG_PPS":                      reflect.ValueOf(syscall.TIOCFLAG_PPS),
+		"TIOCFLAG_SOFTCAR":                  reflect.ValueOf(syscall.TIOCFLAG_SOFTCAR),
+		"TIOCFLUSH":                         reflect.ValueOf(uint32(syscall.TIOCFLUSH)),
+		"TIOCGETA":                          reflect.ValueOf(syscall.TIOCGETA),
+		"TIOCGETD":                          reflect.ValueOf(syscall.TIOCGETD),
+		"TIOCGFLAGS":                        reflect.ValueOf(syscall.TIOCGFLAGS),
+		"TIOCGPGRP":                         reflect.ValueOf(syscall.TIOCGPGRP),
+		"TIOCGSID":                          reflect.ValueOf(syscall.TIOCGSID),
+		"TIOCGTSTAMP":                       reflect.ValueOf(syscall.TIOCGTSTAMP),
+		"TIOCGWINSZ":                        reflect.ValueOf(syscall.TIOCGWINSZ),
+		"TIOCMBIC":                          reflect.ValueOf(uint32(syscall.TIOCMBIC)),
+		"TIOCMBIS":                          reflect.ValueOf(uint32(syscall.TIOCMBIS)),
+		"TIOCMGET":                          reflect.ValueOf(syscall.TIOCMGET),
+		"TIOCMODG":                          reflect.ValueOf(syscall.TIOCMODG),
+		"TIOCMODS":                          reflect.ValueOf(uint32(syscall.TIOCMODS)),
+		"TIOCMSET":                          reflect.ValueOf(uint32(syscall.TIOCMSET)),
+		"TIOCM_CAR":                         reflect.ValueOf(syscall.TIOCM_CAR),
+		"TIOCM_CD":                          reflect.ValueOf(syscall.TIOCM_CD),
+		"TIOCM_CTS":                         reflect.ValueOf(syscall.TIOCM_CTS),
+		"TIOCM_DSR":                         reflect.ValueOf(syscall.TIOCM_DSR),
+		"TIOCM_DTR":                         reflect.ValueOf(syscall.TIOCM_DTR),
+		"TIOCM_LE":                          reflect.ValueOf(syscall.TIOCM_LE),
+		"TIOCM_RI":                          reflect.ValueOf(syscall.TIOCM_RI),
+		"TIOCM_RNG":                         reflect.ValueOf(syscall.TIOCM_RNG),
+		"TIOCM_RTS":                         reflect.ValueOf(syscall.TIOCM_RTS),
+		"TIOCM_SR":                          reflect.ValueOf(syscall.TIOCM_SR),
+		"TIOCM_ST":                          reflect.ValueOf(syscall.TIOCM_ST),
+		"TIOCNOTTY":                         reflect.ValueOf(syscall.TIOCNOTTY),
+		"TIOCNXCL":                          reflect.ValueOf(syscall.TIOCNXCL),
+		"TIOCOUTQ":                          reflect.ValueOf(syscall.TIOCOUTQ),
+		"TIOCPKT":                           reflect.ValueOf(uint32(syscall.TIOCPKT)),
+		"TIOCPKT_DATA":                      reflect.ValueOf(syscall.TIOCPKT_DATA),
+		"TIOCPKT_DOSTOP":                    reflect.ValueOf(syscall.TIOCPKT_DOSTOP),
+		"TIOCPKT_FLUSHREAD":                 reflect.ValueOf(syscall.TIOCPKT_FLUSHREAD),
+		"TIOCPKT_FLUSHWRITE":                reflect.ValueOf(syscall.TIOCPKT_FLUSHWRITE),
+		"TIOCPKT_IOCTL":                     reflect.ValueOf(syscall.TIOCPKT_IOCTL),
+		"TIOCPKT_NOSTOP":                    reflect.ValueOf(syscall.TIOCPKT_NOSTOP),
+		"TIOCPKT_START":                     reflect.ValueOf(syscall.TIOCPKT_START),
+		"TIOCPKT_STOP":                      reflect.ValueOf(syscall.TIOCPKT_STOP),
+		"TIOCREMOTE":                        reflect.ValueOf(uint32(syscall.TIOCREMOTE)),
+		"TIOCSBRK":                          reflect.ValueOf(syscall.TIOCSBRK),
+		"TIOCSCTTY":                         reflect.ValueOf(syscall.TIOCSCTTY),
+		"TIOCSDTR":                          reflect.ValueOf(syscall.TIOCSDTR),
+		"TIOCSETA":                          reflect.ValueOf(uint32(syscall.TIOCSETA)),
+		"TIOCSETAF":                         reflect.ValueOf(uint32(syscall.TIOCSETAF)),
+		"TIOCSETAW":                         reflect.ValueOf(uint32(syscall.TIOCSETAW)),
+		"TIOCSETD":                          reflect.ValueOf(uint32(syscall.TIOCSETD)),
+		"TIOCSETVERAUTH":                    reflect.ValueOf(uint32(syscall.TIOCSETVERAUTH)),
+		"TIOCSFLAGS":                        reflect.ValueOf(uint32(syscall.TIOCSFLAGS)),
+		"TIOCSIG":                           reflect.ValueOf(uint32(syscall.TIOCSIG)),
+		"TIOCSPGRP":                         reflect.ValueOf(uint32(syscall.TIOCSPGRP)),
+		"TIOCSTART":                         reflect.ValueOf(syscall.TIOCSTART),
+		"TIOCSTAT":                          reflect.ValueOf(syscall.TIOCSTAT),
+		"TIOCSTOP":                          reflect.ValueOf(syscall.TIOCSTOP),
+		"TIOCSTSTAMP":                       reflect.ValueOf(uint32(syscall.TIOCSTSTAMP)),
+		"TIOCSWINSZ":                        reflect.ValueOf(uint32(syscall.TIOCSWINSZ)),
+		"TIOCUCNTL":                         reflect.ValueOf(uint32(syscall.TIOCUCNTL)),
+		"TIOCUCNTL_CBRK":                    reflect.ValueOf(syscall.TIOCUCNTL_CBRK),
+		"TIOCUCNTL_SBRK":                    reflect.ValueOf(syscall.TIOCUCNTL_SBRK),
+		"TOSTOP":                            reflect.ValueOf(syscall.TOSTOP),
+		"TimespecToNsec":                    reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                     reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                          reflect.ValueOf(syscall.Truncate),
+		"Umask":                             reflect.ValueOf(syscall.Umask),
+		"UnixRights":                        reflect.ValueOf(syscall.UnixRights),
+		"Unlink":                            reflect.ValueOf(syscall.Unlink),
+		"Unmount":                           reflect.ValueOf(syscall.Unmount),
+		"Unsetenv":                          reflect.ValueOf(syscall.Unsetenv),
+		"Utimes":                            reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                        reflect.ValueOf(syscall.UtimesNano),
+		"VDISCARD":                          reflect.ValueOf(syscall.VDISCARD),
+		"VDSUSP":                            reflect.ValueOf(syscall.VDSUSP),
+		"VEOF":                              reflect.ValueOf(syscall.VEOF),
+		"VEOL":                              reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                             reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                            reflect.ValueOf(syscall.VERASE),
+		"VINTR":                             reflect.ValueOf(syscall.VINTR),
+		"VKILL":                             reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                            reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                              reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                             reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                          reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                            reflect.ValueOf(syscall.VSTART),
+		"VSTATUS":                           reflect.ValueOf(syscall.VSTATUS),
+		"VSTOP":                             reflect.ValueOf(syscall.VSTOP),
+		"VSUSP":                             reflect.ValueOf(syscall.VSUSP),
+		"VTIME":                             reflect.ValueOf(syscall.VTIME),
+		"VWERASE":                           reflect.ValueOf(syscall.VWERASE),
+		"WALTSIG":                           reflect.ValueOf(syscall.WALTSIG),
+		"WCONTINUED":                        reflect.ValueOf(syscall.WCONTINUED),
+		"WCOREFLAG":                         reflect.ValueOf(syscall.WCOREFLAG),
+		"WNOHANG":                           reflect.ValueOf(syscall.WNOHANG),
+		"WUNTRACED":                         reflect.ValueOf(syscall.WUNTRACED),
+		"Wait4":                             reflect.ValueOf(syscall.Wait4),
+		"Write":                             reflect.ValueOf(syscall.Write),
+
+		// type definitions
+		"BpfHdr":                   reflect.ValueOf((*syscall.BpfHdr)(nil)),
+		"BpfInsn":                  reflect.ValueOf((*syscall.BpfInsn)(nil)),
+		"BpfProgram":               reflect.ValueOf((*syscall.BpfProgram)(nil)),
+		"BpfStat":                  reflect.ValueOf((*syscall.BpfStat)(nil)),
+		"BpfTimeval":               reflect.ValueOf((*syscall.BpfTimeval)(nil)),
+		"BpfVersion":               reflect.ValueOf((*syscall.BpfVersion)(nil)),
+		"Cmsghdr":                  reflect.ValueOf((*syscall.Cmsghdr)(nil)),
+		"Conn":                     reflect.ValueOf((*syscall.Conn)(nil)),
+		"Credential":               reflect.ValueOf((*syscall.Credential)(nil)),
+		"Dirent":                   reflect.ValueOf((*syscall.Dirent)(nil)),
+		"Errno":                    reflect.ValueOf((*syscall.Errno)(nil)),
+		"FdSet":                    reflect.ValueOf((*syscall.FdSet)(nil)),
+		"Flock_t":                  reflect.ValueOf((*syscall.Flock_t)(nil)),
+		"Fsid":                     reflect.ValueOf((*syscall.Fsid)(nil)),
+		"ICMPv6Filter":             reflect.ValueOf((*syscall.ICMPv6Filter)(nil)),
+		"IPMreq":                   reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPv6MTUInfo":              reflect.ValueOf((*syscall.IPv6MTUInfo)(nil)),
+		"IPv6Mreq":                 reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"IfAnnounceMsghdr":         reflect.ValueOf((*syscall.IfAnnounceMsghdr)(nil)),
+		"IfData":                   reflect.ValueOf((*syscall.IfData)(nil)),
+		"IfMsghdr":                 reflect.ValueOf((*syscall.IfMsghdr)(nil)),
+		"IfaMsghdr":                reflect.ValueOf((*syscall.IfaMsghdr)(nil)),
+		"Inet6Pktinfo":             reflect.ValueOf((*syscall.Inet6Pktinfo)(nil)),
+		"InterfaceAddrMessage":     reflect.ValueOf((*syscall.InterfaceAddrMessage)(nil)),
+		"InterfaceAnnounceMessage": reflect.ValueOf((*syscall.InterfaceAnnounceMessage)(nil)),
+		"InterfaceMessage":         reflect.ValueOf((*syscall.InterfaceMessage)(nil)),
+		"Iovec":                    reflect.ValueOf((*syscall.Iovec)(nil)),
+		"Kevent_t":                 reflect.ValueOf((*syscall.Kevent_t)(nil)),
+		"Linger":                   reflect.ValueOf((*syscall.Linger)(nil)),
+		"Mclpool":                  reflect.ValueOf((*syscall.Mclpool)(nil)),
+		"Msghdr":                   reflect.ValueOf((*syscall.Msghdr)(nil)),
+		"ProcAttr":                 reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"RawConn":                  reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":              reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":           reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrDatalink":      reflect.ValueOf((*syscall.RawSockaddrDatalink)(nil)),
+		"RawSockaddrInet4":         reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":         reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":          reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rlimit":                   reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"RouteMessage":             reflect.ValueOf((*syscall.RouteMessage)(nil)),
+		"RoutingMessage":           reflect.ValueOf((*syscall.RoutingMessage)(nil)),
+		"RtMetrics":                reflect.ValueOf((*syscall.RtMetrics)(nil)),
+		"RtMsghdr":                 reflect.ValueOf((*syscall.RtMsghdr)(nil)),
+		"Rusage":                   reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":                   reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":                 reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrDatalink":         reflect.ValueOf((*syscall.SockaddrDatalink)(nil)),
+		"SockaddrInet4":            reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":            reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":             reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"SocketControlMessage":     reflect.ValueOf((*syscall.SocketControlMessage)(nil)),
+		"Stat_t":                   reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"Statfs_t":                 reflect.ValueOf((*syscall.Statfs_t)(nil)),
+		"SysProcAttr":              reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Termios":                  reflect.ValueOf((*syscall.Termios)(nil)),
+		"Timespec":                 reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":                  reflect.ValueOf((*syscall.Timeval)(nil)),
+		"WaitStatus":               reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":           reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":        reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_RoutingMessage": reflect.ValueOf((*_syscall_RoutingMessage)(nil)),
+		"_Sockaddr":       reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
+type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
+}
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
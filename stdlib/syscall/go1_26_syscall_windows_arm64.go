@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.26 && !go1.27
+// +build go1.26,!go1.27
 
 package syscall
 
@@ -76,7 +77,10 @@ func init() {
 		"CRYPT_SILENT":                                 reflect.ValueOf(syscall.CRYPT_SILENT),
 		"CRYPT_VERIFYCONTEXT":                          reflect.ValueOf(uint32(syscall.CRYPT_VERIFYCONTEXT)),
 		"CTRL_BREAK_EVENT":                             reflect.ValueOf(syscall.CTRL_BREAK_EVENT),
+		"CTRL_CLOSE_EVENT":                             reflect.ValueOf(syscall.CTRL_CLOSE_EVENT),
 		"CTRL_C_EVENT":                                 reflect.ValueOf(syscall.CTRL_C_EVENT),
+		"CTRL_LOGOFF_EVENT":                            reflect.ValueOf(syscall.CTRL_LOGOFF_EVENT),
+		"CTRL_SHUTDOWN_EVENT":                          reflect.ValueOf(syscall.CTRL_SHUTDOWN_EVENT),
 		"CancelIo":                                     reflect.ValueOf(syscall.CancelIo),
 		"CancelIoEx":                                   reflect.ValueOf(syscall.CancelIoEx),
 		"CertAddCertificateContextToStore":             reflect.ValueOf(syscall.CertAddCertificateContextToStore),
@@ -790,8 +794,10 @@ func init() {
 		"Syscall":                                      reflect.ValueOf(syscall.Syscall),
 		"Syscall12":                                    reflect.ValueOf(syscall.Syscall12),
 		"Syscall15":                                    reflect.ValueOf(syscall.Syscall15),
+		"Syscall18":                                    reflect.ValueOf(syscall.Syscall18),
 		"Syscall6":                                     reflect.ValueOf(syscall.Syscall6),
 		"Syscall9":                                     reflect.ValueOf(syscall.Syscall9),
+		"SyscallN":                                     reflect.ValueOf(syscall.SyscallN),
 		"TCP_NODELAY":                                  reflect.ValueOf(syscall.TCP_NODELAY),
 		"TF_DISCONNECT":                                reflect.ValueOf(syscall.TF_DISCONNECT),
 		"TF_REUSE_SOCKET":                              reflect.ValueOf(syscall.TF_REUSE_SOCKET),
@@ -856,6 +862,7 @@ func init() {
 		"TranslateAccountName":                         reflect.ValueOf(syscall.TranslateAccountName),
 		"TranslateName":                                reflect.ValueOf(syscall.TranslateName),
 		"TransmitFile":                                 reflect.ValueOf(syscall.TransmitFile),
+		"UNIX_PATH_MAX":                                reflect.ValueOf(syscall.UNIX_PATH_MAX),
 		"USAGE_MATCH_TYPE_AND":                         reflect.ValueOf(syscall.USAGE_MATCH_TYPE_AND),
 		"USAGE_MATCH_TYPE_OR":                          reflect.ValueOf(syscall.USAGE_MATCH_TYPE_OR),
 		"UTF16FromString":                              reflect.ValueOf(syscall.UTF16FromString),
@@ -877,6 +884,7 @@ func init() {
 		"WSAEACCES":                                    reflect.ValueOf(syscall.WSAEACCES),
 		"WSAECONNABORTED":                              reflect.ValueOf(syscall.WSAECONNABORTED),
 		"WSAECONNRESET":                                reflect.ValueOf(syscall.WSAECONNRESET),
+		"WSAENOPROTOOPT":                               reflect.ValueOf(syscall.WSAENOPROTOOPT),
 		"WSAEnumProtocols":                             reflect.ValueOf(syscall.WSAEnumProtocols),
 		"WSAID_CONNECTEX":                              reflect.ValueOf(&syscall.WSAID_CONNECTEX).Elem(),
 		"WSAIoctl":                                     reflect.ValueOf(syscall.WSAIoctl),
@@ -967,6 +975,7 @@ func init() {
 		"RawSockaddrAny":              reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
 		"RawSockaddrInet4":            reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
 		"RawSockaddrInet6":            reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":             reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
 		"Rusage":                      reflect.ValueOf((*syscall.Rusage)(nil)),
 		"SID":                         reflect.ValueOf((*syscall.SID)(nil)),
 		"SIDAndAttributes":            reflect.ValueOf((*syscall.SIDAndAttributes)(nil)),
@@ -1026,4 +1035,5 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -0,0 +1,1394 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_APPLETALK":                  reflect.ValueOf(syscall.AF_APPLETALK),
+		"AF_BYPASS":                     reflect.ValueOf(syscall.AF_BYPASS),
+		"AF_CCITT":                      reflect.ValueOf(syscall.AF_CCITT),
+		"AF_CHAOS":                      reflect.ValueOf(syscall.AF_CHAOS),
+		"AF_DATAKIT":                    reflect.ValueOf(syscall.AF_DATAKIT),
+		"AF_DECnet":                     reflect.ValueOf(syscall.AF_DECnet),
+		"AF_DLI":                        reflect.ValueOf(syscall.AF_DLI),
+		"AF_ECMA":                       reflect.ValueOf(syscall.AF_ECMA),
+		"AF_HYLINK":                     reflect.ValueOf(syscall.AF_HYLINK),
+		"AF_IMPLINK":                    reflect.ValueOf(syscall.AF_IMPLINK),
+		"AF_INET":                       reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                      reflect.ValueOf(syscall.AF_INET6),
+		"AF_INTF":                       reflect.ValueOf(syscall.AF_INTF),
+		"AF_ISO":                        reflect.ValueOf(syscall.AF_ISO),
+		"AF_LAT":                        reflect.ValueOf(syscall.AF_LAT),
+		"AF_LINK":                       reflect.ValueOf(syscall.AF_LINK),
+		"AF_LOCAL":                      reflect.ValueOf(syscall.AF_LOCAL),
+		"AF_MAX":                        reflect.ValueOf(syscall.AF_MAX),
+		"AF_NDD":                        reflect.ValueOf(syscall.AF_NDD),
+		"AF_NETWARE":                    reflect.ValueOf(syscall.AF_NETWARE),
+		"AF_NS":                         reflect.ValueOf(syscall.AF_NS),
+		"AF_OSI":                        reflect.ValueOf(syscall.AF_OSI),
+		"AF_PUP":                        reflect.ValueOf(syscall.AF_PUP),
+		"AF_RIF":                        reflect.ValueOf(syscall.AF_RIF),
+		"AF_ROUTE":                      reflect.ValueOf(syscall.AF_ROUTE),
+		"AF_SNA":                        reflect.ValueOf(syscall.AF_SNA),
+		"AF_UNIX":                       reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                     reflect.ValueOf(syscall.AF_UNSPEC),
+		"ARPHRD_802_3":                  reflect.ValueOf(syscall.ARPHRD_802_3),
+		"ARPHRD_802_5":                  reflect.ValueOf(syscall.ARPHRD_802_5),
+		"ARPHRD_ETHER":                  reflect.ValueOf(syscall.ARPHRD_ETHER),
+		"ARPHRD_FDDI":                   reflect.ValueOf(syscall.ARPHRD_FDDI),
+		"Accept":                        reflect.ValueOf(syscall.Accept),
+		"Access":                        reflect.ValueOf(syscall.Access),
+		"Acct":                          reflect.ValueOf(syscall.Acct),
+		"B0":                            reflect.ValueOf(syscall.B0),
+		"B110":                          reflect.ValueOf(syscall.B110),
+		"B1200":                         reflect.ValueOf(syscall.B1200),
+		"B134":                          reflect.ValueOf(syscall.B134),
+		"B150":                          reflect.ValueOf(syscall.B150),
+		"B1800":                         reflect.ValueOf(syscall.B1800),
+		"B19200":                        reflect.ValueOf(syscall.B19200),
+		"B200":                          reflect.ValueOf(syscall.B200),
+		"B2400":                         reflect.ValueOf(syscall.B2400),
+		"B300":                          reflect.ValueOf(syscall.B300),
+		"B38400":                        reflect.ValueOf(syscall.B38400),
+		"B4800":                         reflect.ValueOf(syscall.B4800),
+		"B50":                           reflect.ValueOf(syscall.B50),
+		"B600":                          reflect.ValueOf(syscall.B600),
+		"B75":                           reflect.ValueOf(syscall.B75),
+		"B9600":                         reflect.ValueOf(syscall.B9600),
+		"BRKINT":                        reflect.ValueOf(syscall.BRKINT),
+		"Bind":                          reflect.ValueOf(syscall.Bind),
+		"BytePtrFromString":             reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":           reflect.ValueOf(syscall.ByteSliceFromString),
+		"CFLUSH":                        reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                        reflect.ValueOf(syscall.CLOCAL),
+		"CREAD":                         reflect.ValueOf(syscall.CREAD),
+		"CS5":                           reflect.ValueOf(syscall.CS5),
+		"CS6":                           reflect.ValueOf(syscall.CS6),
+		"CS7":                           reflect.ValueOf(syscall.CS7),
+		"CS8":                           reflect.ValueOf(syscall.CS8),
+		"CSIOCGIFCONF":                  reflect.ValueOf(syscall.CSIOCGIFCONF),
+		"CSIZE":                         reflect.ValueOf(syscall.CSIZE),
+		"CSMAP_DIR":                     reflect.ValueOf(syscall.CSMAP_DIR),
+		"CSTART":                        reflect.ValueOf(syscall.CSTART),
+		"CSTOP":                         reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                        reflect.ValueOf(syscall.CSTOPB),
+		"CSUSP":                         reflect.ValueOf(syscall.CSUSP),
+		"Chdir":                         reflect.ValueOf(syscall.Chdir),
+		"Chmod":                         reflect.ValueOf(syscall.Chmod),
+		"Chown":                         reflect.ValueOf(syscall.Chown),
+		"Chroot":                        reflect.ValueOf(syscall.Chroot),
+		"Clearenv":                      reflect.ValueOf(syscall.Clearenv),
+		"Close":                         reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                   reflect.ValueOf(syscall.CloseOnExec),
+		"CmsgLen":                       reflect.ValueOf(syscall.CmsgLen),
+		"CmsgSpace":                     reflect.ValueOf(syscall.CmsgSpace),
+		"Connect":                       reflect.ValueOf(syscall.Connect),
+		"Dup":                           reflect.ValueOf(syscall.Dup),
+		"Dup2":                          reflect.ValueOf(syscall.Dup2),
+		"E2BIG":                         reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                        reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                    reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                 reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EAFNOSUPPORT":                  reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                        reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                      reflect.ValueOf(syscall.EALREADY),
+		"EBADF":                         reflect.ValueOf(syscall.EBADF),
+		"EBADMSG":                       reflect.ValueOf(syscall.EBADMSG),
+		"EBUSY":                         reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                     reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                        reflect.ValueOf(syscall.ECHILD),
+		"ECHO":                          reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                       reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                         reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                         reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                        reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                        reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                       reflect.ValueOf(syscall.ECHOPRT),
+		"ECHRNG":                        reflect.ValueOf(syscall.ECHRNG),
+		"ECH_ICMPID":                    reflect.ValueOf(syscall.ECH_ICMPID),
+		"ECLONEME":                      reflect.ValueOf(syscall.ECLONEME),
+		"ECONNABORTED":                  reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                  reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                    reflect.ValueOf(syscall.ECONNRESET),
+		"ECORRUPT":                      reflect.ValueOf(syscall.ECORRUPT),
+		"EDEADLK":                       reflect.ValueOf(syscall.EDEADLK),
+		"EDESTADDREQ":                   reflect.ValueOf(syscall.EDESTADDREQ),
+		"EDESTADDRREQ":                  reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDIST":                         reflect.ValueOf(syscall.EDIST),
+		"EDOM":                          reflect.ValueOf(syscall.EDOM),
+		"EDQUOT":                        reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                        reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                        reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                         reflect.ValueOf(syscall.EFBIG),
+		"EFORMAT":                       reflect.ValueOf(syscall.EFORMAT),
+		"EHOSTDOWN":                     reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                  reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                         reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                        reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                   reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                         reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                        reflect.ValueOf(syscall.EINVAL),
+		"EIO":                           reflect.ValueOf(syscall.EIO),
+		"EISCONN":                       reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                        reflect.ValueOf(syscall.EISDIR),
+		"EL2HLT":                        reflect.ValueOf(syscall.EL2HLT),
+		"EL2NSYNC":                      reflect.ValueOf(syscall.EL2NSYNC),
+		"EL3HLT":                        reflect.ValueOf(syscall.EL3HLT),
+		"EL3RST":                        reflect.ValueOf(syscall.EL3RST),
+		"ELNRNG":                        reflect.ValueOf(syscall.ELNRNG),
+		"ELOOP":                         reflect.ValueOf(syscall.ELOOP),
+		"EMEDIA":                        reflect.ValueOf(syscall.EMEDIA),
+		"EMFILE":                        reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                        reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                      reflect.ValueOf(syscall.EMSGSIZE),
+		"EMULTIHOP":                     reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                  reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENETDOWN":                      reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                     reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                   reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                        reflect.ValueOf(syscall.ENFILE),
+		"ENOATTR":                       reflect.ValueOf(syscall.ENOATTR),
+		"ENOBUFS":                       reflect.ValueOf(syscall.ENOBUFS),
+		"ENOCONNECT":                    reflect.ValueOf(syscall.ENOCONNECT),
+		"ENOCSI":                        reflect.ValueOf(syscall.ENOCSI),
+		"ENODATA":                       reflect.ValueOf(syscall.ENODATA),
+		"ENODEV":                        reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                        reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                       reflect.ValueOf(syscall.ENOEXEC),
+		"ENOLCK":                        reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                       reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEM":                        reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                        reflect.ValueOf(syscall.ENOMSG),
+		"ENOPROTOOPT":                   reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                        reflect.ValueOf(syscall.ENOSPC),
+		"ENOSR":                         reflect.ValueOf(syscall.ENOSR),
+		"ENOSTR":                        reflect.ValueOf(syscall.ENOSTR),
+		"ENOSYS":                        reflect.ValueOf(syscall.ENOSYS),
+		"ENOTBLK":                       reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCONN":                      reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                       reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                     reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTREADY":                     reflect.ValueOf(syscall.ENOTREADY),
+		"ENOTRECOVERABLE":               reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTRUST":                      reflect.ValueOf(syscall.ENOTRUST),
+		"ENOTSOCK":                      reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                       reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                        reflect.ValueOf(syscall.ENOTTY),
+		"ENXIO":                         reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                    reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                     reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                    reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                         reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                  reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                         reflect.ValueOf(syscall.EPIPE),
+		"EPROCLIM":                      reflect.ValueOf(syscall.EPROCLIM),
+		"EPROTO":                        reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":               reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                    reflect.ValueOf(syscall.EPROTOTYPE),
+		"ERANGE":                        reflect.ValueOf(syscall.ERANGE),
+		"EREMOTE":                       reflect.ValueOf(syscall.EREMOTE),
+		"ERESTART":                      reflect.ValueOf(syscall.ERESTART),
+		"EROFS":                         reflect.ValueOf(syscall.EROFS),
+		"ESAD":                          reflect.ValueOf(syscall.ESAD),
+		"ESHUTDOWN":                     reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":               reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESOFT":                         reflect.ValueOf(syscall.ESOFT),
+		"ESPIPE":                        reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                         reflect.ValueOf(syscall.ESRCH),
+		"ESTALE":                        reflect.ValueOf(syscall.ESTALE),
+		"ESYSERROR":                     reflect.ValueOf(syscall.ESYSERROR),
+		"ETHERNET_CSMACD":               reflect.ValueOf(syscall.ETHERNET_CSMACD),
+		"ETIME":                         reflect.ValueOf(syscall.ETIME),
+		"ETIMEDOUT":                     reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                  reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                       reflect.ValueOf(syscall.ETXTBSY),
+		"EUNATCH":                       reflect.ValueOf(syscall.EUNATCH),
+		"EUSERS":                        reflect.ValueOf(syscall.EUSERS),
+		"EVENP":                         reflect.ValueOf(syscall.EVENP),
+		"EWOULDBLOCK":                   reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EWRPROTECT":                    reflect.ValueOf(syscall.EWRPROTECT),
+		"EXCONTINUE":                    reflect.ValueOf(syscall.EXCONTINUE),
+		"EXDEV":                         reflect.ValueOf(syscall.EXDEV),
+		"EXDLOK":                        reflect.ValueOf(syscall.EXDLOK),
+		"EXIO":                          reflect.ValueOf(syscall.EXIO),
+		"EXPGIO":                        reflect.ValueOf(syscall.EXPGIO),
+		"EXRESUME":                      reflect.ValueOf(syscall.EXRESUME),
+		"EXRETURN":                      reflect.ValueOf(syscall.EXRETURN),
+		"EXSIG":                         reflect.ValueOf(syscall.EXSIG),
+		"EXTA":                          reflect.ValueOf(syscall.EXTA),
+		"EXTB":                          reflect.ValueOf(syscall.EXTB),
+		"EXTRAP":                        reflect.ValueOf(syscall.EXTRAP),
+		"EYEC_RTENTRYA":                 reflect.ValueOf(int64(syscall.EYEC_RTENTRYA)),
+		"EYEC_RTENTRYF":                 reflect.ValueOf(int64(syscall.EYEC_RTENTRYF)),
+		"E_ACC":                         reflect.ValueOf(syscall.E_ACC),
+		"Environ":                       reflect.ValueOf(syscall.Environ),
+		"Exec":                          reflect.ValueOf(syscall.Exec),
+		"Exit":                          reflect.ValueOf(syscall.Exit),
+		"FD_CLOEXEC":                    reflect.ValueOf(syscall.FD_CLOEXEC),
+		"FD_SETSIZE":                    reflect.ValueOf(syscall.FD_SETSIZE),
+		"FLUSHBAND":                     reflect.ValueOf(syscall.FLUSHBAND),
+		"FLUSHLOW":                      reflect.ValueOf(syscall.FLUSHLOW),
+		"FLUSHO":                        reflect.ValueOf(syscall.FLUSHO),
+		"FLUSHR":                        reflect.ValueOf(syscall.FLUSHR),
+		"FLUSHRW":                       reflect.ValueOf(syscall.FLUSHRW),
+		"FLUSHW":                        reflect.ValueOf(syscall.FLUSHW),
+		"F_CLOSEM":                      reflect.ValueOf(syscall.F_CLOSEM),
+		"F_DUP2FD":                      reflect.ValueOf(syscall.F_DUP2FD),
+		"F_DUPFD":                       reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":               reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_GETFD":                       reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                       reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLK":                       reflect.ValueOf(syscall.F_GETLK),
+		"F_GETLK64":                     reflect.ValueOf(syscall.F_GETLK64),
+		"F_GETOWN":                      reflect.ValueOf(syscall.F_GETOWN),
+		"F_LOCK":                        reflect.ValueOf(syscall.F_LOCK),
+		"F_OK":                          reflect.ValueOf(syscall.F_OK),
+		"F_RDLCK":                       reflect.ValueOf(syscall.F_RDLCK),
+		"F_SETFD":                       reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                       reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLK":                       reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLK64":                     reflect.ValueOf(syscall.F_SETLK64),
+		"F_SETLKW":                      reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETLKW64":                    reflect.ValueOf(syscall.F_SETLKW64),
+		"F_SETOWN":                      reflect.ValueOf(syscall.F_SETOWN),
+		"F_TEST":                        reflect.ValueOf(syscall.F_TEST),
+		"F_TLOCK":                       reflect.ValueOf(syscall.F_TLOCK),
+		"F_TSTLK":                       reflect.ValueOf(syscall.F_TSTLK),
+		"F_ULOCK":                       reflect.ValueOf(syscall.F_ULOCK),
+		"F_UNLCK":                       reflect.ValueOf(syscall.F_UNLCK),
+		"F_WRLCK":                       reflect.ValueOf(syscall.F_WRLCK),
+		"Faccessat":                     reflect.ValueOf(syscall.Faccessat),
+		"Fchdir":                        reflect.ValueOf(syscall.Fchdir),
+		"Fchmod":                        reflect.ValueOf(syscall.Fchmod),
+		"Fchmodat":                      reflect.ValueOf(syscall.Fchmodat),
+		"Fchown":                        reflect.ValueOf(syscall.Fchown),
+		"Fchownat":                      reflect.ValueOf(syscall.Fchownat),
+		"FcntlFlock":                    reflect.ValueOf(syscall.FcntlFlock),
+		"ForkExec":                      reflect.ValueOf(syscall.ForkExec),
+		"ForkLock":                      reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"Fpathconf":                     reflect.ValueOf(syscall.Fpathconf),
+		"Fstat":                         reflect.ValueOf(syscall.Fstat),
+		"Fstatfs":                       reflect.ValueOf(syscall.Fstatfs),
+		"Fsync":                         reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                     reflect.ValueOf(syscall.Ftruncate),
+		"Getcwd":                        reflect.ValueOf(syscall.Getcwd),
+		"Getegid":                       reflect.ValueOf(syscall.Getegid),
+		"Getenv":                        reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                       reflect.ValueOf(syscall.Geteuid),
+		"Getgid":                        reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                     reflect.ValueOf(syscall.Getgroups),
+		"Getkerninfo":                   reflect.ValueOf(syscall.Getkerninfo),
+		"Getpagesize":                   reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                   reflect.ValueOf(syscall.Getpeername),
+		"Getpid":                        reflect.ValueOf(syscall.Getpid),
+		"Getppid":                       reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                   reflect.ValueOf(syscall.Getpriority),
+		"Getrlimit":                     reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                     reflect.ValueOf(syscall.Getrusage),
+		"Getsockname":                   reflect.ValueOf(syscall.Getsockname),
+		"GetsockoptInt":                 reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                  reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                        reflect.ValueOf(syscall.Getuid),
+		"Getwd":                         reflect.ValueOf(syscall.Getwd),
+		"HUPCL":                         reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                        reflect.ValueOf(syscall.ICANON),
+		"ICMP6_FILTER":                  reflect.ValueOf(syscall.ICMP6_FILTER),
+		"ICMP6_SEC_SEND_DEL":            reflect.ValueOf(syscall.ICMP6_SEC_SEND_DEL),
+		"ICMP6_SEC_SEND_GET":            reflect.ValueOf(syscall.ICMP6_SEC_SEND_GET),
+		"ICMP6_SEC_SEND_SET":            reflect.ValueOf(syscall.ICMP6_SEC_SEND_SET),
+		"ICMP6_SEC_SEND_SET_CGA_ADDR":   reflect.ValueOf(syscall.ICMP6_SEC_SEND_SET_CGA_ADDR),
+		"ICRNL":                         reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                        reflect.ValueOf(syscall.IEXTEN),
+		"IFA_FIRSTALIAS":                reflect.ValueOf(syscall.IFA_FIRSTALIAS),
+		"IFA_ROUTE":                     reflect.ValueOf(syscall.IFA_ROUTE),
+		"IFF_64BIT":                     reflect.ValueOf(syscall.IFF_64BIT),
+		"IFF_ALLCAST":                   reflect.ValueOf(syscall.IFF_ALLCAST),
+		"IFF_ALLMULTI":                  reflect.ValueOf(syscall.IFF_ALLMULTI),
+		"IFF_BPF":                       reflect.ValueOf(syscall.IFF_BPF),
+		"IFF_BRIDGE":                    reflect.ValueOf(syscall.IFF_BRIDGE),
+		"IFF_BROADCAST":                 reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_CANTCHANGE":                reflect.ValueOf(syscall.IFF_CANTCHANGE),
+		"IFF_CHECKSUM_OFFLOAD":          reflect.ValueOf(syscall.IFF_CHECKSUM_OFFLOAD),
+		"IFF_D1":                        reflect.ValueOf(syscall.IFF_D1),
+		"IFF_D2":                        reflect.ValueOf(syscall.IFF_D2),
+		"IFF_D3":                        reflect.ValueOf(syscall.IFF_D3),
+		"IFF_D4":                        reflect.ValueOf(syscall.IFF_D4),
+		"IFF_DEBUG":                     reflect.ValueOf(syscall.IFF_DEBUG),
+		"IFF_DEVHEALTH":                 reflect.ValueOf(syscall.IFF_DEVHEALTH),
+		"IFF_DO_HW_LOOPBACK":            reflect.ValueOf(syscall.IFF_DO_HW_LOOPBACK),
+		"IFF_GROUP_ROUTING":             reflect.ValueOf(syscall.IFF_GROUP_ROUTING),
+		"IFF_IFBUFMGT":                  reflect.ValueOf(syscall.IFF_IFBUFMGT),
+		"IFF_LINK0":                     reflect.ValueOf(syscall.IFF_LINK0),
+		"IFF_LINK1":                     reflect.ValueOf(syscall.IFF_LINK1),
+		"IFF_LINK2":                     reflect.ValueOf(syscall.IFF_LINK2),
+		"IFF_LOOPBACK":                  reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_MULTICAST":                 reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_NOARP":                     reflect.ValueOf(syscall.IFF_NOARP),
+		"IFF_NOECHO":                    reflect.ValueOf(syscall.IFF_NOECHO),
+		"IFF_NOTRAILERS":                reflect.ValueOf(syscall.IFF_NOTRAILERS),
+		"IFF_OACTIVE":                   reflect.ValueOf(syscall.IFF_OACTIVE),
+		"IFF_POINTOPOINT":               reflect.ValueOf(syscall.IFF_POINTOPOINT),
+		"IFF_PROMISC":                   reflect.ValueOf(syscall.IFF_PROMISC),
+		"IFF_PSEG":                      reflect.ValueOf(syscall.IFF_PSEG),
+		"IFF_RUNNING":                   reflect.ValueOf(syscall.IFF_RUNNING),
+		"IFF_SIMPLEX":                   reflect.ValueOf(syscall.IFF_SIMPLEX),
+		"IFF_SNAP":                      reflect.ValueOf(syscall.IFF_SNAP),
+		"IFF_TCP_DISABLE_CKSUM":         reflect.ValueOf(syscall.IFF_TCP_DISABLE_CKSUM),
+		"IFF_TCP_NOCKSUM":               reflect.ValueOf(syscall.IFF_TCP_NOCKSUM),
+		"IFF_UP":                        reflect.ValueOf(syscall.IFF_UP),
+		"IFF_VIPA":                      reflect.ValueOf(uint32(syscall.IFF_VIPA)),
+		"IFNAMSIZ":                      reflect.ValueOf(syscall.IFNAMSIZ),
+		"IFO_FLUSH":                     reflect.ValueOf(syscall.IFO_FLUSH),
+		"IFT_1822":                      reflect.ValueOf(syscall.IFT_1822),
+		"IFT_AAL5":                      reflect.ValueOf(syscall.IFT_AAL5),
+		"IFT_ARCNET":                    reflect.ValueOf(syscall.IFT_ARCNET),
+		"IFT_ARCNETPLUS":                reflect.ValueOf(syscall.IFT_ARCNETPLUS),
+		"IFT_ATM":                       reflect.ValueOf(syscall.IFT_ATM),
+		"IFT_CEPT":                      reflect.ValueOf(syscall.IFT_CEPT),
+		"IFT_CLUSTER":                   reflect.ValueOf(syscall.IFT_CLUSTER),
+		"IFT_DS3":                       reflect.ValueOf(syscall.IFT_DS3),
+		"IFT_EON":                       reflect.ValueOf(syscall.IFT_EON),
+		"IFT_ETHER":                     reflect.ValueOf(syscall.IFT_ETHER),
+		"IFT_FCS":                       reflect.ValueOf(syscall.IFT_FCS),
+		"IFT_FDDI":                      reflect.ValueOf(syscall.IFT_FDDI),
+		"IFT_FRELAY":                    reflect.ValueOf(syscall.IFT_FRELAY),
+		"IFT_FRELAYDCE":                 reflect.ValueOf(syscall.IFT_FRELAYDCE),
+		"IFT_GIFTUNNEL":                 reflect.ValueOf(syscall.IFT_GIFTUNNEL),
+		"IFT_HDH1822":                   reflect.ValueOf(syscall.IFT_HDH1822),
+		"IFT_HF":                        reflect.ValueOf(syscall.IFT_HF),
+		"IFT_HIPPI":                     reflect.ValueOf(syscall.IFT_HIPPI),
+		"IFT_HSSI":                      reflect.ValueOf(syscall.IFT_HSSI),
+		"IFT_HY":                        reflect.ValueOf(syscall.IFT_HY),
+		"IFT_IB":                        reflect.ValueOf(syscall.IFT_IB),
+		"IFT_ISDNBASIC":                 reflect.ValueOf(syscall.IFT_ISDNBASIC),
+		"IFT_ISDNPRIMARY":               reflect.ValueOf(syscall.IFT_ISDNPRIMARY),
+		"IFT_ISO88022LLC":               reflect.ValueOf(syscall.IFT_ISO88022LLC),
+		"IFT_ISO88023":                  reflect.ValueOf(syscall.IFT_ISO88023),
+		"IFT_ISO88024":                  reflect.ValueOf(syscall.IFT_ISO88024),
+		"IFT_ISO88025":                  reflect.ValueOf(syscall.IFT_ISO88025),
+		"IFT_ISO88026":                  reflect.ValueOf(syscall.IFT_ISO88026),
+		"IFT_LAPB":                      reflect.ValueOf(syscall.IFT_LAPB),
+		"IFT_LOCALTALK":                 reflect.ValueOf(syscall.IFT_LOCALTALK),
+		"IFT_LOOP":                      reflect.ValueOf(syscall.IFT_LOOP),
+		"IFT_MIOX25":                    reflect.ValueOf(syscall.IFT_MIOX25),
+		"IFT_MODEM":                     reflect.ValueOf(syscall.IFT_MODEM),
+		"IFT_NSIP":                      reflect.ValueOf(syscall.IFT_NSIP),
+		"IFT_OTHER":                     reflect.ValueOf(syscall.IFT_OTHER),
+		"IFT_P10":                       reflect.ValueOf(syscall.IFT_P10),
+		"IFT_P80":                       reflect.ValueOf(syscall.IFT_P80),
+		"IFT_PARA":                      reflect.ValueOf(syscall.IFT_PARA),
+		"IFT_PPP":                       reflect.ValueOf(syscall.IFT_PPP),
+		"IFT_PROPMUX":                   reflect.ValueOf(syscall.IFT_PROPMUX),
+		"IFT_PROPVIRTUAL":               reflect.ValueOf(syscall.IFT_PROPVIRTUAL),
+		"IFT_PTPSERIAL":                 reflect.ValueOf(syscall.IFT_PTPSERIAL),
+		"IFT_RS232":                     reflect.ValueOf(syscall.IFT_RS232),
+		"IFT_SDLC":                      reflect.ValueOf(syscall.IFT_SDLC),
+		"IFT_SIP":                       reflect.ValueOf(syscall.IFT_SIP),
+		"IFT_SLIP":                      reflect.ValueOf(syscall.IFT_SLIP),
+		"IFT_SMDSDXI":                   reflect.ValueOf(syscall.IFT_SMDSDXI),
+		"IFT_SMDSICIP":                  reflect.ValueOf(syscall.IFT_SMDSICIP),
+		"IFT_SN":                        reflect.ValueOf(syscall.IFT_SN),
+		"IFT_SONET":                     reflect.ValueOf(syscall.IFT_SONET),
+		"IFT_SONETPATH":                 reflect.ValueOf(syscall.IFT_SONETPATH),
+		"IFT_SONETVT":                   reflect.ValueOf(syscall.IFT_SONETVT),
+		"IFT_SP":                        reflect.ValueOf(syscall.IFT_SP),
+		"IFT_STARLAN":                   reflect.ValueOf(syscall.IFT_STARLAN),
+		"IFT_T1":                        reflect.ValueOf(syscall.IFT_T1),
+		"IFT_TUNNEL":                    reflect.ValueOf(syscall.IFT_TUNNEL),
+		"IFT_ULTRA":                     reflect.ValueOf(syscall.IFT_ULTRA),
+		"IFT_V35":                       reflect.ValueOf(syscall.IFT_V35),
+		"IFT_VIPA":                      reflect.ValueOf(syscall.IFT_VIPA),
+		"IFT_X25":                       reflect.ValueOf(syscall.IFT_X25),
+		"IFT_X25DDN":                    reflect.ValueOf(syscall.IFT_X25DDN),
+		"IFT_X25PLE":                    reflect.ValueOf(syscall.IFT_X25PLE),
+		"IFT_XETHER":                    reflect.ValueOf(syscall.IFT_XETHER),
+		"IGNBRK":                        reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                         reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                        reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                       reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                         reflect.ValueOf(syscall.INLCR),
+		"INPCK":                         reflect.ValueOf(syscall.INPCK),
+		"IN_CLASSA_HOST":                reflect.ValueOf(syscall.IN_CLASSA_HOST),
+		"IN_CLASSA_MAX":                 reflect.ValueOf(syscall.IN_CLASSA_MAX),
+		"IN_CLASSA_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
+		"IN_CLASSA_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSA_NSHIFT),
+		"IN_CLASSB_HOST":                reflect.ValueOf(syscall.IN_CLASSB_HOST),
+		"IN_CLASSB_MAX":                 reflect.ValueOf(syscall.IN_CLASSB_MAX),
+		"IN_CLASSB_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSB_NET)),
+		"IN_CLASSB_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSB_NSHIFT),
+		"IN_CLASSC_HOST":                reflect.ValueOf(syscall.IN_CLASSC_HOST),
+		"IN_CLASSC_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSC_NET)),
+		"IN_CLASSC_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSC_NSHIFT),
+		"IN_CLASSD_HOST":                reflect.ValueOf(syscall.IN_CLASSD_HOST),
+		"IN_CLASSD_NET":                 reflect.ValueOf(uint32(syscall.IN_CLASSD_NET)),
+		"IN_CLASSD_NSHIFT":              reflect.ValueOf(syscall.IN_CLASSD_NSHIFT),
+		"IN_LOOPBACKNET":                reflect.ValueOf(syscall.IN_LOOPBACKNET),
+		"IN_USE":                        reflect.ValueOf(syscall.IN_USE),
+		"IPPROTO_AH":                    reflect.ValueOf(syscall.IPPROTO_AH),
+		"IPPROTO_BIP":                   reflect.ValueOf(syscall.IPPROTO_BIP),
+		"IPPROTO_DSTOPTS":               reflect.ValueOf(syscall.IPPROTO_DSTOPTS),
+		"IPPROTO_EGP":                   reflect.ValueOf(syscall.IPPROTO_EGP),
+		"IPPROTO_EON":                   reflect.ValueOf(syscall.IPPROTO_EON),
+		"IPPROTO_ESP":                   reflect.ValueOf(syscall.IPPROTO_ESP),
+		"IPPROTO_FRAGMENT":              reflect.ValueOf(syscall.IPPROTO_FRAGMENT),
+		"IPPROTO_GGP":                   reflect.ValueOf(syscall.IPPROTO_GGP),
+		"IPPROTO_GIF":                   reflect.ValueOf(syscall.IPPROTO_GIF),
+		"IPPROTO_GRE":                   reflect.ValueOf(syscall.IPPROTO_GRE),
+		"IPPROTO_HOPOPTS":               reflect.ValueOf(syscall.IPPROTO_HOPOPTS),
+		"IPPROTO_ICMP":                  reflect.ValueOf(syscall.IPPROTO_ICMP),
+		"IPPROTO_ICMPV6":                reflect.ValueOf(syscall.IPPROTO_ICMPV6),
+		"IPPROTO_IDP":                   reflect.ValueOf(syscall.IPPROTO_IDP),
+		"IPPROTO_IGMP":                  reflect.ValueOf(syscall.IPPROTO_IGMP),
+		"IPPROTO_IP":                    reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPIP":                  reflect.ValueOf(syscall.IPPROTO_IPIP),
+		"IPPROTO_IPV6":                  reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_LOCAL":                 reflect.ValueOf(syscall.IPPROTO_LOCAL),
+		"IPPROTO_MAX":                   reflect.ValueOf(syscall.IPPROTO_MAX),
+		"IPPROTO_MH":                    reflect.ValueOf(syscall.IPPROTO_MH),
+		"IPPROTO_NONE":                  reflect.ValueOf(syscall.IPPROTO_NONE),
+		"IPPROTO_PUP":                   reflect.ValueOf(syscall.IPPROTO_PUP),
+		"IPPROTO_QOS":                   reflect.ValueOf(syscall.IPPROTO_QOS),
+		"IPPROTO_RAW":                   reflect.ValueOf(syscall.IPPROTO_RAW),
+		"IPPROTO_ROUTING":               reflect.ValueOf(syscall.IPPROTO_ROUTING),
+		"IPPROTO_RSVP":                  reflect.ValueOf(syscall.IPPROTO_RSVP),
+		"IPPROTO_SCTP":                  reflect.ValueOf(syscall.IPPROTO_SCTP),
+		"IPPROTO_TCP":                   reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_TP":                    reflect.ValueOf(syscall.IPPROTO_TP),
+		"IPPROTO_UDP":                   reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPV6_ADDRFORM":                 reflect.ValueOf(syscall.IPV6_ADDRFORM),
+		"IPV6_ADDR_PREFERENCES":         reflect.ValueOf(syscall.IPV6_ADDR_PREFERENCES),
+		"IPV6_ADD_MEMBERSHIP":           reflect.ValueOf(syscall.IPV6_ADD_MEMBERSHIP),
+		"IPV6_AIXRAWSOCKET":             reflect.ValueOf(syscall.IPV6_AIXRAWSOCKET),
+		"IPV6_CHECKSUM":                 reflect.ValueOf(syscall.IPV6_CHECKSUM),
+		"IPV6_DONTFRAG":                 reflect.ValueOf(syscall.IPV6_DONTFRAG),
+		"IPV6_DROP_MEMBERSHIP":          reflect.ValueOf(syscall.IPV6_DROP_MEMBERSHIP),
+		"IPV6_DSTOPTS":                  reflect.ValueOf(syscall.IPV6_DSTOPTS),
+		"IPV6_FLOWINFO_FLOWLABEL":       reflect.ValueOf(syscall.IPV6_FLOWINFO_FLOWLABEL),
+		"IPV6_FLOWINFO_PRIFLOW":         reflect.ValueOf(syscall.IPV6_FLOWINFO_PRIFLOW),
+		"IPV6_FLOWINFO_PRIORITY":        reflect.ValueOf(syscall.IPV6_FLOWINFO_PRIORITY),
+		"IPV6_FLOWINFO_SRFLAG":          reflect.ValueOf(syscall.IPV6_FLOWINFO_SRFLAG),
+		"IPV6_FLOWINFO_VERSION":         reflect.ValueOf(uint32(syscall.IPV6_FLOWINFO_VERSION)),
+		"IPV6_HOPLIMIT":                 reflect.ValueOf(syscall.IPV6_HOPLIMIT),
+		"IPV6_HOPOPTS":                  reflect.ValueOf(syscall.IPV6_HOPOPTS),
+		"IPV6_JOIN_GROUP":               reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_GROUP":              reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MIPDSTOPTS":               reflect.ValueOf(syscall.IPV6_MIPDSTOPTS),
+		"IPV6_MULTICAST_HOPS":           reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":             reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":           reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_NEXTHOP":                  reflect.ValueOf(syscall.IPV6_NEXTHOP),
+		"IPV6_NOPROBE":                  reflect.ValueOf(syscall.IPV6_NOPROBE),
+		"IPV6_PATHMTU":                  reflect.ValueOf(syscall.IPV6_PATHMTU),
+		"IPV6_PKTINFO":                  reflect.ValueOf(syscall.IPV6_PKTINFO),
+		"IPV6_PKTOPTIONS":               reflect.ValueOf(syscall.IPV6_PKTOPTIONS),
+		"IPV6_PRIORITY_10":              reflect.ValueOf(syscall.IPV6_PRIORITY_10),
+		"IPV6_PRIORITY_11":              reflect.ValueOf(syscall.IPV6_PRIORITY_11),
+		"IPV6_PRIORITY_12":              reflect.ValueOf(syscall.IPV6_PRIORITY_12),
+		"IPV6_PRIORITY_13":              reflect.ValueOf(syscall.IPV6_PRIORITY_13),
+		"IPV6_PRIORITY_14":              reflect.ValueOf(syscall.IPV6_PRIORITY_14),
+		"IPV6_PRIORITY_15":              reflect.ValueOf(syscall.IPV6_PRIORITY_15),
+		"IPV6_PRIORITY_8":               reflect.ValueOf(syscall.IPV6_PRIORITY_8),
+		"IPV6_PRIORITY_9":               reflect.ValueOf(syscall.IPV6_PRIORITY_9),
+		"IPV6_PRIORITY_BULK":            reflect.ValueOf(syscall.IPV6_PRIORITY_BULK),
+		"IPV6_PRIORITY_CONTROL":         reflect.ValueOf(syscall.IPV6_PRIORITY_CONTROL),
+		"IPV6_PRIORITY_FILLER":          reflect.ValueOf(syscall.IPV6_PRIORITY_FILLER),
+		"IPV6_PRIORITY_INTERACTIVE":     reflect.ValueOf(syscall.IPV6_PRIORITY_INTERACTIVE),
+		"IPV6_PRIORITY_RESERVED1":       reflect.ValueOf(syscall.IPV6_PRIORITY_RESERVED1),
+		"IPV6_PRIORITY_RESERVED2":       reflect.ValueOf(syscall.IPV6_PRIORITY_RESERVED2),
+		"IPV6_PRIORITY_UNATTENDED":      reflect.ValueOf(syscall.IPV6_PRIORITY_UNATTENDED),
+		"IPV6_PRIORITY_UNCHARACTERIZED": reflect.ValueOf(syscall.IPV6_PRIORITY_UNCHARACTERIZED),
+		"IPV6_RECVDSTOPTS":              reflect.ValueOf(syscall.IPV6_RECVDSTOPTS),
+		"IPV6_RECVHOPLIMIT":             reflect.ValueOf(syscall.IPV6_RECVHOPLIMIT),
+		"IPV6_RECVHOPOPTS":              reflect.ValueOf(syscall.IPV6_RECVHOPOPTS),
+		"IPV6_RECVHOPS":                 reflect.ValueOf(syscall.IPV6_RECVHOPS),
+		"IPV6_RECVIF":                   reflect.ValueOf(syscall.IPV6_RECVIF),
+		"IPV6_RECVPATHMTU":              reflect.ValueOf(syscall.IPV6_RECVPATHMTU),
+		"IPV6_RECVPKTINFO":              reflect.ValueOf(syscall.IPV6_RECVPKTINFO),
+		"IPV6_RECVRTHDR":                reflect.ValueOf(syscall.IPV6_RECVRTHDR),
+		"IPV6_RECVSRCRT":                reflect.ValueOf(syscall.IPV6_RECVSRCRT),
+		"IPV6_RECVTCLASS":               reflect.ValueOf(syscall.IPV6_RECVTCLASS),
+		"IPV6_RTHDR":                    reflect.ValueOf(syscall.IPV6_RTHDR),
+		"IPV6_RTHDRDSTOPTS":             reflect.ValueOf(syscall.IPV6_RTHDRDSTOPTS),
+		"IPV6_RTHDR_TYPE_0":             reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_0),
+		"IPV6_RTHDR_TYPE_2":             reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_2),
+		"IPV6_SENDIF":                   reflect.ValueOf(syscall.IPV6_SENDIF),
+		"IPV6_SRFLAG_LOOSE":             reflect.ValueOf(syscall.IPV6_SRFLAG_LOOSE),
+		"IPV6_SRFLAG_STRICT":            reflect.ValueOf(syscall.IPV6_SRFLAG_STRICT),
+		"IPV6_TCLASS":                   reflect.ValueOf(syscall.IPV6_TCLASS),
+		"IPV6_TOKEN_LENGTH":             reflect.ValueOf(syscall.IPV6_TOKEN_LENGTH),
+		"IPV6_UNICAST_HOPS":             reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_USE_MIN_MTU":              reflect.ValueOf(syscall.IPV6_USE_MIN_MTU),
+		"IPV6_V6ONLY":                   reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IPV6_VERSION":                  reflect.ValueOf(syscall.IPV6_VERSION),
+		"IP_ADDRFORM":                   reflect.ValueOf(syscall.IP_ADDRFORM),
+		"IP_ADD_MEMBERSHIP":             reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_ADD_SOURCE_MEMBERSHIP":      reflect.ValueOf(syscall.IP_ADD_SOURCE_MEMBERSHIP),
+		"IP_BLOCK_SOURCE":               reflect.ValueOf(syscall.IP_BLOCK_SOURCE),
+		"IP_BROADCAST_IF":               reflect.ValueOf(syscall.IP_BROADCAST_IF),
+		"IP_CACHE_LINE_SIZE":            reflect.ValueOf(syscall.IP_CACHE_LINE_SIZE),
+		"IP_DEFAULT_MULTICAST_LOOP":     reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_LOOP),
+		"IP_DEFAULT_MULTICAST_TTL":      reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_TTL),
+		"IP_DF":                         reflect.ValueOf(syscall.IP_DF),
+		"IP_DHCPMODE":                   reflect.ValueOf(syscall.IP_DHCPMODE),
+		"IP_DONTFRAG":                   reflect.ValueOf(syscall.IP_DONTFRAG),
+		"IP_DROP_MEMBERSHIP":            reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_DROP_SOURCE_MEMBERSHIP":     reflect.ValueOf(syscall.IP_DROP_SOURCE_MEMBERSHIP),
+		"IP_FINDPMTU":                   reflect.ValueOf(syscall.IP_FINDPMTU),
+		"IP_HDRINCL":                    reflect.ValueOf(syscall.IP_HDRINCL),
+		"IP_INC_MEMBERSHIPS":            reflect.ValueOf(syscall.IP_INC_MEMBERSHIPS),
+		"IP_INIT_MEMBERSHIP":            reflect.ValueOf(syscall.IP_INIT_MEMBERSHIP),
+		"IP_MAXPACKET":                  reflect.ValueOf(syscall.IP_MAXPACKET),
+		"IP_MF":                         reflect.ValueOf(syscall.IP_MF),
+		"IP_MSS":                        reflect.ValueOf(syscall.IP_MSS),
+		"IP_MULTICAST_HOPS":             reflect.ValueOf(syscall.IP_MULTICAST_HOPS),
+		"IP_MULTICAST_IF":               reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_LOOP":             reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":              reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_OPT":                        reflect.ValueOf(syscall.IP_OPT),
+		"IP_OPTIONS":                    reflect.ValueOf(syscall.IP_OPTIONS),
+		"IP_PMTUAGE":                    reflect.ValueOf(syscall.IP_PMTUAGE),
+		"IP_RECVDSTADDR":                reflect.ValueOf(syscall.IP_RECVDSTADDR),
+		"IP_RECVIF":                     reflect.ValueOf(syscall.IP_RECVIF),
+		"IP_RECVIFINFO":                 reflect.ValueOf(syscall.IP_RECVIFINFO),
+		"IP_RECVINTERFACE":              reflect.ValueOf(syscall.IP_RECVINTERFACE),
+		"IP_RECVMACHDR":                 reflect.ValueOf(syscall.IP_RECVMACHDR),
+		"IP_RECVOPTS":                   reflect.ValueOf(syscall.IP_RECVOPTS),
+		"IP_RECVRETOPTS":                reflect.ValueOf(syscall.IP_RECVRETOPTS),
+		"IP_RECVTTL":                    reflect.ValueOf(syscall.IP_RECVTTL),
+		"IP_RETOPTS":                    reflect.ValueOf(syscall.IP_RETOPTS),
+		"IP_SOURCE_FILTER":              reflect.ValueOf(syscall.IP_SOURCE_FILTER),
+		"IP_TOS":                        reflect.ValueOf(syscall.IP_TOS),
+		"IP_TTL":                        reflect.ValueOf(syscall.IP_TTL),
+		"IP_UNBLOCK_SOURCE":             reflect.ValueOf(syscall.IP_UNBLOCK_SOURCE),
+		"IP_UNICAST_HOPS":               reflect.ValueOf(syscall.IP_UNICAST_HOPS),
+		"ISIG":                          reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                        reflect.ValueOf(syscall.ISTRIP),
+		"IXANY":                         reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                         reflect.ValueOf(syscall.IXOFF),
+		"IXON":                          reflect.ValueOf(syscall.IXON),
+		"I_FLUSH":                       reflect.ValueOf(syscall.I_FLUSH),
+		"ImplementsGetwd":               reflect.ValueOf(syscall.ImplementsGetwd),
+		"Kill":                          reflect.ValueOf(syscall.Kill),
+		"LNOFLSH":                       reflect.ValueOf(syscall.LNOFLSH),
+		"LOCK_EX":                       reflect.ValueOf(syscall.LOCK_EX),
+		"LOCK_NB":                       reflect.ValueOf(syscall.LOCK_NB),
+		"LOCK_SH":                       reflect.ValueOf(syscall.LOCK_SH),
+		"LOCK_UN":                       reflect.ValueOf(syscall.LOCK_UN),
+		"Lchown":                        reflect.ValueOf(syscall.Lchown),
+		"Link":                          reflect.ValueOf(syscall.Link),
+		"Listen":                        reflect.ValueOf(syscall.Listen),
+		"Lstat":                         reflect.ValueOf(syscall.Lstat),
+		"MADV_DONTNEED":                 reflect.ValueOf(syscall.MADV_DONTNEED),
+		"MADV_NORMAL":                   reflect.ValueOf(syscall.MADV_NORMAL),
+		"MADV_RANDOM":                   reflect.ValueOf(syscall.MADV_RANDOM),
+		"MADV_SEQUENTIAL":               reflect.ValueOf(syscall.MADV_SEQUENTIAL),
+		"MADV_SPACEAVAIL":               reflect.ValueOf(syscall.MADV_SPACEAVAIL),
+		"MADV_WILLNEED":                 reflect.ValueOf(syscall.MADV_WILLNEED),
+		"MAP_ANON":                      reflect.ValueOf(syscall.MAP_ANON),
+		"MAP_ANONYMOUS":                 reflect.ValueOf(syscall.MAP_ANONYMOUS),
+		"MAP_FILE":                      reflect.ValueOf(syscall.MAP_FILE),
+		"MAP_FIXED":                     reflect.ValueOf(syscall.MAP_FIXED),
+		"MAP_PRIVATE":                   reflect.ValueOf(syscall.MAP_PRIVATE),
+		"MAP_SHARED":                    reflect.ValueOf(syscall.MAP_SHARED),
+		"MAP_TYPE":                      reflect.ValueOf(syscall.MAP_TYPE),
+		"MAP_VARIABLE":                  reflect.ValueOf(syscall.MAP_VARIABLE),
+		"MCL_CURRENT":                   reflect.ValueOf(syscall.MCL_CURRENT),
+		"MCL_FUTURE":                    reflect.ValueOf(syscall.MCL_FUTURE),
+		"MSG_ANY":                       reflect.ValueOf(syscall.MSG_ANY),
+		"MSG_ARGEXT":                    reflect.ValueOf(syscall.MSG_ARGEXT),
+		"MSG_BAND":                      reflect.ValueOf(syscall.MSG_BAND),
+		"MSG_COMPAT":                    reflect.ValueOf(syscall.MSG_COMPAT),
+		"MSG_CTRUNC":                    reflect.ValueOf(syscall.MSG_CTRUNC),
+		"MSG_DONTROUTE":                 reflect.ValueOf(syscall.MSG_DONTROUTE),
+		"MSG_EOR":                       reflect.ValueOf(syscall.MSG_EOR),
+		"MSG_HIPRI":                     reflect.ValueOf(syscall.MSG_HIPRI),
+		"MSG_MAXIOVLEN":                 reflect.ValueOf(syscall.MSG_MAXIOVLEN),
+		"MSG_MPEG2":                     reflect.ValueOf(syscall.MSG_MPEG2),
+		"MSG_NONBLOCK":                  reflect.ValueOf(syscall.MSG_NONBLOCK),
+		"MSG_NOSIGNAL":                  reflect.ValueOf(syscall.MSG_NOSIGNAL),
+		"MSG_OOB":                       reflect.ValueOf(syscall.MSG_OOB),
+		"MSG_PEEK":                      reflect.ValueOf(syscall.MSG_PEEK),
+		"MSG_TRUNC":                     reflect.ValueOf(syscall.MSG_TRUNC),
+		"MSG_WAITALL":                   reflect.ValueOf(syscall.MSG_WAITALL),
+		"MSG_WAITFORONE":                reflect.ValueOf(syscall.MSG_WAITFORONE),
+		"MS_ASYNC":                      reflect.ValueOf(syscall.MS_ASYNC),
+		"MS_EINTR":                      reflect.ValueOf(syscall.MS_EINTR),
+		"MS_INVALIDATE":                 reflect.ValueOf(syscall.MS_INVALIDATE),
+		"MS_PER_SEC":                    reflect.ValueOf(syscall.MS_PER_SEC),
+		"MS_SYNC":                       reflect.ValueOf(syscall.MS_SYNC),
+		"Mkdir":                         reflect.ValueOf(syscall.Mkdir),
+		"Mkdirat":                       reflect.ValueOf(syscall.Mkdirat),
+		"Mknodat":                       reflect.ValueOf(syscall.Mknodat),
+		"Mmap":                          reflect.ValueOf(syscall.Mmap),
+		"Munmap":                        reflect.ValueOf(syscall.Munmap),
+		"NOFLSH":                        reflect.ValueOf(syscall.NOFLSH),
+		"NOFLUSH":                       reflect.ValueOf(uint32(syscall.NOFLUSH)),
+		"NsecToTimespec":                reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                 reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                         reflect.ValueOf(syscall.OCRNL),
+		"OFDEL":                         reflect.ValueOf(syscall.OFDEL),
+		"OFILL":                         reflect.ValueOf(syscall.OFILL),
+		"ONLCR":                         reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                        reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                         reflect.ValueOf(syscall.ONOCR),
+		"ONOEOT":                        reflect.ValueOf(syscall.ONOEOT),
+		"OPOST":                         reflect.ValueOf(syscall.OPOST),
+		"O_ACCMODE":                     reflect.ValueOf(syscall.O_ACCMODE),
+		"O_APPEND":                      reflect.ValueOf(syscall.O_APPEND),
+		"O_CIO":                         reflect.ValueOf(syscall.O_CIO),
+		"O_CIOR":                        reflect.ValueOf(int64(syscall.O_CIOR)),
+		"O_CLOEXEC":                     reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                       reflect.ValueOf(syscall.O_CREAT),
+		"O_DEFER":                       reflect.ValueOf(syscall.O_DEFER),
+		"O_DELAY":                       reflect.ValueOf(syscall.O_DELAY),
+		"O_DIRECT":                      reflect.ValueOf(syscall.O_DIRECT),
+		"O_DIRECTORY":                   reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_DSYNC":                       reflect.ValueOf(syscall.O_DSYNC),
+		"O_EFSOFF":                      reflect.ValueOf(int64(syscall.O_EFSOFF)),
+		"O_EFSON":                       reflect.ValueOf(int64(syscall.O_EFSON)),
+		"O_EXCL":                        reflect.ValueOf(syscall.O_EXCL),
+		"O_EXEC":                        reflect.ValueOf(syscall.O_EXEC),
+		"O_LARGEFILE":                   reflect.ValueOf(syscall.O_LARGEFILE),
+		"O_NDELAY":                      reflect.ValueOf(syscall.O_NDELAY),
+		"O_NOCACHE":                     reflect.ValueOf(syscall.O_NOCACHE),
+		"O_NOCTTY":                      reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NOFOLLOW":                    reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_NONBLOCK":                    reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_NONE":                        reflect.ValueOf(syscall.O_NONE),
+		"O_NSHARE":                      reflect.ValueOf(syscall.O_NSHARE),
+		"O_RAW":                         reflect.ValueOf(int64(syscall.O_RAW)),
+		"O_RDONLY":                      reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                        reflect.ValueOf(syscall.O_RDWR),
+		"O_RSHARE":                      reflect.ValueOf(syscall.O_RSHARE),
+		"O_RSYNC":                       reflect.ValueOf(syscall.O_RSYNC),
+		"O_SEARCH":                      reflect.ValueOf(syscall.O_SEARCH),
+		"O_SNAPSHOT":                    reflect.ValueOf(syscall.O_SNAPSHOT),
+		"O_SYNC":                        reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                       reflect.ValueOf(syscall.O_TRUNC),
+		"O_TTY_INIT":                    reflect.ValueOf(syscall.O_TTY_INIT),
+		"O_WRONLY":                      reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                          reflect.ValueOf(syscall.Open),
+		"Openat":                        reflect.ValueOf(syscall.Openat),
+		"PARENB":                        reflect.ValueOf(syscall.PARENB),
+		"PAREXT":                        reflect.ValueOf(syscall.PAREXT),
+		"PARMRK":                        reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                        reflect.ValueOf(syscall.PARODD),
+		"PENDIN":                        reflect.ValueOf(syscall.PENDIN),
+		"PRIO_PGRP":                     reflect.ValueOf(syscall.PRIO_PGRP),
+		"PRIO_PROCESS":                  reflect.ValueOf(syscall.PRIO_PROCESS),
+		"PRIO_USER":                     reflect.ValueOf(syscall.PRIO_USER),
+		"PROT_EXEC":                     reflect.ValueOf(syscall.PROT_EXEC),
+		"PROT_NONE":                     reflect.ValueOf(syscall.PROT_NONE),
+		"PROT_READ":                     reflect.ValueOf(syscall.PROT_READ),
+		"PROT_WRITE":                    reflect.ValueOf(syscall.PROT_WRITE),
+		"PR_64BIT":                      reflect.ValueOf(syscall.PR_64BIT),
+		"PR_ADDR":                       reflect.ValueOf(syscall.PR_ADDR),
+		"PR_ARGEXT":                     reflect.ValueOf(syscall.PR_ARGEXT),
+		"PR_ATOMIC":                     reflect.ValueOf(syscall.PR_ATOMIC),
+		"PR_CONNREQUIRED":               reflect.ValueOf(syscall.PR_CONNREQUIRED),
+		"PR_FASTHZ":                     reflect.ValueOf(syscall.PR_FASTHZ),
+		"PR_INP":                        reflect.ValueOf(syscall.PR_INP),
+		"PR_INTRLEVEL":                  reflect.ValueOf(syscall.PR_INTRLEVEL),
+		"PR_MLS":                        reflect.ValueOf(syscall.PR_MLS),
+		"PR_MLS_1_LABEL":                reflect.ValueOf(syscall.PR_MLS_1_LABEL),
+		"PR_NOEOR":                      reflect.ValueOf(syscall.PR_NOEOR),
+		"PR_RIGHTS":                     reflect.ValueOf(syscall.PR_RIGHTS),
+		"PR_SLOWHZ":                     reflect.ValueOf(syscall.PR_SLOWHZ),
+		"PR_WANTRCVD":                   reflect.ValueOf(syscall.PR_WANTRCVD),
+		"PTRACE_CONT":                   reflect.ValueOf(syscall.PTRACE_CONT),
+		"PTRACE_KILL":                   reflect.ValueOf(syscall.PTRACE_KILL),
+		"PTRACE_TRACEME":                reflect.ValueOf(syscall.PTRACE_TRACEME),
+		"PT_ATTACH":                     reflect.ValueOf(syscall.PT_ATTACH),
+		"PT_CLEAR":                      reflect.ValueOf(syscall.PT_CLEAR),
+		"PT_COMMAND_MAX":                reflect.ValueOf(syscall.PT_COMMAND_MAX),
+		"PT_CONTINUE":                   reflect.ValueOf(syscall.PT_CONTINUE),
+		"PT_DETACH":                     reflect.ValueOf(syscall.PT_DETACH),
+		"PT_GET_UKEY":                   reflect.ValueOf(syscall.PT_GET_UKEY),
+		"PT_KILL":                       reflect.ValueOf(syscall.PT_KILL),
+		"PT_LDINFO":                     reflect.ValueOf(syscall.PT_LDINFO),
+		"PT_LDXINFO":                    reflect.ValueOf(syscall.PT_LDXINFO),
+		"PT_MULTI":                      reflect.ValueOf(syscall.PT_MULTI),
+		"PT_NEXT":                       reflect.ValueOf(syscall.PT_NEXT),
+		"PT_QUERY":                      reflect.ValueOf(syscall.PT_QUERY),
+		"PT_READ_BLOCK":                 reflect.ValueOf(syscall.PT_READ_BLOCK),
+		"PT_READ_D":                     reflect.ValueOf(syscall.PT_READ_D),
+		"PT_READ_FPR":                   reflect.ValueOf(syscall.PT_READ_FPR),
+		"PT_READ_GPR":                   reflect.ValueOf(syscall.PT_READ_GPR),
+		"PT_READ_I":                     reflect.ValueOf(syscall.PT_READ_I),
+		"PT_REATT":                      reflect.ValueOf(syscall.PT_REATT),
+		"PT_REGSET":                     reflect.ValueOf(syscall.PT_REGSET),
+		"PT_SET":                        reflect.ValueOf(syscall.PT_SET),
+		"PT_STEP":                       reflect.ValueOf(syscall.PT_STEP),
+		"PT_TRACE_ME":                   reflect.ValueOf(syscall.PT_TRACE_ME),
+		"PT_WATCH":                      reflect.ValueOf(syscall.PT_WATCH),
+		"PT_WRITE_BLOCK":                reflect.ValueOf(syscall.PT_WRITE_BLOCK),
+		"PT_WRITE_D":                    reflect.ValueOf(syscall.PT_WRITE_D),
+		"PT_WRITE_FPR":                  reflect.ValueOf(syscall.PT_WRITE_FPR),
+		"PT_WRITE_GPR":                  reflect.ValueOf(syscall.PT_WRITE_GPR),
+		"PT_WRITE_I":                    reflect.ValueOf(syscall.PT_WRITE_I),
+		"ParseDirent":                   reflect.ValueOf(syscall.ParseDirent),
+		"ParseSocketControlMessage":     reflect.ValueOf(syscall.ParseSocketControlMessage),
+		"ParseUnixRights":               reflect.ValueOf(syscall.ParseUnixRights),
+		"PathMax":                       reflect.ValueOf(syscall.PathMax),
+		"Pipe":                          reflect.ValueOf(syscall.Pipe),
+		"Pread":                         reflect.ValueOf(syscall.Pread),
+		"PtraceAttach":                  reflect.ValueOf(syscall.PtraceAttach),
+		"PtraceCont":                    reflect.ValueOf(syscall.PtraceCont),
+		"PtraceDetach":                  reflect.ValueOf(syscall.PtraceDetach),
+		"PtracePeekData":                reflect.ValueOf(syscall.PtracePeekData),
+		"PtracePeekText":                reflect.ValueOf(syscall.PtracePeekText),
+		"PtracePokeData":                reflect.ValueOf(syscall.PtracePokeData),
+		"PtracePokeText":                reflect.ValueOf(syscall.PtracePokeText),
+		"PtraceSingleStep":              reflect.ValueOf(syscall.PtraceSingleStep),
+		"Pwrite":                        reflect.ValueOf(syscall.Pwrite),
+		"RLIMIT_AS":                     reflect.ValueOf(syscall.RLIMIT_AS),
+		"RLIMIT_CORE":                   reflect.ValueOf(syscall.RLIMIT_CORE),
+		"RLIMIT_CPU":                    reflect.ValueOf(syscall.RLIMIT_CPU),
+		"RLIMIT_DATA":                   reflect.ValueOf(syscall.RLIMIT_DATA),
+		"RLIMIT_FSIZE":                  reflect.ValueOf(syscall.RLIMIT_FSIZE),
+		"RLIMIT_NOFILE":                 reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RLIMIT_STACK":                  reflect.ValueOf(syscall.RLIMIT_STACK),
+		"RLIM_INFINITY":                 reflect.ValueOf(int64(syscall.RLIM_INFINITY)),
+		"RTAX_AUTHOR":                   reflect.ValueOf(syscall.RTAX_AUTHOR),
+		"RTAX_BRD":                      reflect.ValueOf(syscall.RTAX_BRD),
+		"RTAX_DST":                      reflect.ValueOf(syscall.RTAX_DST),
+		"RTAX_GATEWAY":                  reflect.ValueOf(syscall.RTAX_GATEWAY),
+		"RTAX_GENMASK":                  reflect.ValueOf(syscall.RTAX_GENMASK),
+		"RTAX_IFA":                      reflect.ValueOf(syscall.RTAX_IFA),
+		"RTAX_IFP":                      reflect.ValueOf(syscall.RTAX_IFP),
+		"RTAX_MAX":                      reflect.ValueOf(syscall.RTAX_MAX),
+		"RTAX_NETMASK":                  reflect.ValueOf(syscall.RTAX_NETMASK),
+		"RTA_AUTHOR":                    reflect.ValueOf(syscall.RTA_AUTHOR),
+		"RTA_BRD":                       reflect.ValueOf(syscall.RTA_BRD),
+		"RTA_DOWNSTREAM":                reflect.ValueOf(syscall.RTA_DOWNSTREAM),
+		"RTA_DST":                       reflect.ValueOf(syscall.RTA_DST),
+		"RTA_GATEWAY":                   reflect.ValueOf(syscall.RTA_GATEWAY),
+		"RTA_GENMASK":                   reflect.ValueOf(syscall.RTA_GENMASK),
+		"RTA_IFA":                       reflect.ValueOf(syscall.RTA_IFA),
+		"RTA_IFP":                       reflect.ValueOf(syscall.RTA_IFP),
+		"RTA_NETMASK":                   reflect.ValueOf(syscall.RTA_NETMASK),
+		"RTF_ACTIVE_DGD":                reflect.ValueOf(syscall.RTF_ACTIVE_DGD),
+		"RTF_BCE":                       reflect.ValueOf(syscall.RTF_BCE),
+		"RTF_BLACKHOLE":                 reflect.ValueOf(syscall.RTF_BLACKHOLE),
+		"RTF_BROADCAST":                 reflect.ValueOf(syscall.RTF_BROADCAST),
+		"RTF_BUL":                       reflect.ValueOf(syscall.RTF_BUL),
+		"RTF_CLONE":                     reflect.ValueOf(syscall.RTF_CLONE),
+		"RTF_CLONED":                    reflect.ValueOf(syscall.RTF_CLONED),
+		"RTF_CLONING":                   reflect.ValueOf(syscall.RTF_CLONING),
+		"RTF_DONE":                      reflect.ValueOf(syscall.RTF_DONE),
+		"RTF_DYNAMIC":                   reflect.ValueOf(syscall.RTF_DYNAMIC),
+		"RTF_FREE_IN_PROG":              reflect.ValueOf(syscall.RTF_FREE_IN_PROG),
+		"RTF_GATEWAY":                   reflect.ValueOf(syscall.RTF_GATEWAY),
+		"RTF_HOST":                      reflect.ValueOf(syscall.RTF_HOST),
+		"RTF_LLINFO":                    reflect.ValueOf(syscall.RTF_LLINFO),
+		"RTF_LOCAL":                     reflect.ValueOf(syscall.RTF_LOCAL),
+		"RTF_MASK":                      reflect.ValueOf(syscall.RTF_MASK),
+		"RTF_MODIFIED":                  reflect.ValueOf(syscall.RTF_MODIFIED),
+		"RTF_MULTICAST":                 reflect.ValueOf(syscall.RTF_MULTICAST),
+		"RTF_PERMANENT6":                reflect.ValueOf(syscall.RTF_PERMANENT6),
+		"RTF_PINNED":                    reflect.ValueOf(syscall.RTF_PINNED),
+		"RTF_PROTO1":                    reflect.ValueOf(syscall.RTF_PROTO1),
+		"RTF_PROTO2":                    reflect.ValueOf(syscall.RTF_PROTO2),
+		"RTF_PROTO3":                    reflect.ValueOf(syscall.RTF_PROTO3),
+		"RTF_REJECT":                    reflect.ValueOf(syscall.RTF_REJECT),
+		"RTF_SMALLMTU":                  reflect.ValueOf(syscall.RTF_SMALLMTU),
+		"RTF_STATIC":                    reflect.ValueOf(syscall.RTF_STATIC),
+		"RTF_STOPSRCH":                  reflect.ValueOf(syscall.RTF_STOPSRCH),
+		"RTF_UNREACHABLE":               reflect.ValueOf(syscall.RTF_UNREACHABLE),
+		"RTF_UP":                        reflect.ValueOf(syscall.RTF_UP),
+		"RTF_XRESOLVE":                  reflect.ValueOf(syscall.RTF_XRESOLVE),
+		"RTM_ADD":                       reflect.ValueOf(syscall.RTM_ADD),
+		"RTM_CHANGE":                    reflect.ValueOf(syscall.RTM_CHANGE),
+		"RTM_DELADDR":                   reflect.ValueOf(syscall.RTM_DELADDR),
+		"RTM_DELETE":                    reflect.ValueOf(syscall.RTM_DELETE),
+		"RTM_EXPIRE":                    reflect.ValueOf(syscall.RTM_EXPIRE),
+		"RTM_GET":                       reflect.ValueOf(syscall.RTM_GET),
+		"RTM_GETNEXT":                   reflect.ValueOf(syscall.RTM_GETNEXT),
+		"RTM_IFINFO":                    reflect.ValueOf(syscall.RTM_IFINFO),
+		"RTM_LOCK":                      reflect.ValueOf(syscall.RTM_LOCK),
+		"RTM_LOSING":                    reflect.ValueOf(syscall.RTM_LOSING),
+		"RTM_MISS":                      reflect.ValueOf(syscall.RTM_MISS),
+		"RTM_NEWADDR":                   reflect.ValueOf(syscall.RTM_NEWADDR),
+		"RTM_OLDADD":                    reflect.ValueOf(syscall.RTM_OLDADD),
+		"RTM_OLDDEL":                    reflect.ValueOf(syscall.RTM_OLDDEL),
+		"RTM_REDIRECT":                  reflect.ValueOf(syscall.RTM_REDIRECT),
+		"RTM_RESOLVE":                   reflect.ValueOf(syscall.RTM_RESOLVE),
+		"RTM_RTLOST":                    reflect.ValueOf(syscall.RTM_RTLOST),
+		"RTM_RTTUNIT":                   reflect.ValueOf(syscall.RTM_RTTUNIT),
+		"RTM_SAMEADDR":                  reflect.ValueOf(syscall.RTM_SAMEADDR),
+		"RTM_SET":                       reflect.ValueOf(syscall.RTM_SET),
+		"RTM_VERSION":                   reflect.ValueOf(syscall.RTM_VERSION),
+		"RTM_VERSION_GR":                reflect.ValueOf(syscall.RTM_VERSION_GR),
+		"RTM_VERSION_GR_COMPAT":         reflect.ValueOf(syscall.RTM_VERSION_GR_COMPAT),
+		"RTM_VERSION_POLICY":            reflect.ValueOf(syscall.RTM_VERSION_POLICY),
+		"RTM_VERSION_POLICY_EXT":        reflect.ValueOf(syscall.RTM_VERSION_POLICY_EXT),
+		"RTM_VERSION_POLICY_PRFN":       reflect.ValueOf(syscall.RTM_VERSION_POLICY_PRFN),
+		"RTV_EXPIRE":                    reflect.ValueOf(syscall.RTV_EXPIRE),
+		"RTV_HOPCOUNT":                  reflect.ValueOf(syscall.RTV_HOPCOUNT),
+		"RTV_MTU":                       reflect.ValueOf(syscall.RTV_MTU),
+		"RTV_RPIPE":                     reflect.ValueOf(syscall.RTV_RPIPE),
+		"RTV_RTT":                       reflect.ValueOf(syscall.RTV_RTT),
+		"RTV_RTTVAR":                    reflect.ValueOf(syscall.RTV_RTTVAR),
+		"RTV_SPIPE":                     reflect.ValueOf(syscall.RTV_SPIPE),
+		"RTV_SSTHRESH":                  reflect.ValueOf(syscall.RTV_SSTHRESH),
+		"RUSAGE_CHILDREN":               reflect.ValueOf(syscall.RUSAGE_CHILDREN),
+		"RUSAGE_SELF":                   reflect.ValueOf(syscall.RUSAGE_SELF),
+		"RUSAGE_THREAD":                 reflect.ValueOf(syscall.RUSAGE_THREAD),
+		"RawSyscall":                    reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                   reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                          reflect.ValueOf(syscall.Read),
+		"ReadDirent":                    reflect.ValueOf(syscall.ReadDirent),
+		"Readlink":                      reflect.ValueOf(syscall.Readlink),
+		"Reboot":                        reflect.ValueOf(syscall.Reboot),
+		"Recvfrom":                      reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                       reflect.ValueOf(syscall.Recvmsg),
+		"Rename":                        reflect.ValueOf(syscall.Rename),
+		"Renameat":                      reflect.ValueOf(syscall.Renameat),
+		"Rmdir":                         reflect.ValueOf(syscall.Rmdir),
+		"SCM_RIGHTS":                    reflect.ValueOf(syscall.SCM_RIGHTS),
+		"SHUT_RD":                       reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                     reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                       reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                       reflect.ValueOf(syscall.SIGABRT),
+		"SIGAIO":                        reflect.ValueOf(syscall.SIGAIO),
+		"SIGALRM":                       reflect.ValueOf(syscall.SIGALRM),
+		"SIGALRM1":                      reflect.ValueOf(syscall.SIGALRM1),
+		"SIGBUS":                        reflect.ValueOf(syscall.SIGBUS),
+		"SIGCAPI":                       reflect.ValueOf(syscall.SIGCAPI),
+		"SIGCHLD":                       reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCLD":                        reflect.ValueOf(syscall.SIGCLD),
+		"SIGCONT":                       reflect.ValueOf(syscall.SIGCONT),
+		"SIGCPUFAIL":                    reflect.ValueOf(syscall.SIGCPUFAIL),
+		"SIGDANGER":                     reflect.ValueOf(syscall.SIGDANGER),
+		"SIGEMT":                        reflect.ValueOf(syscall.SIGEMT),
+		"SIGFPE":                        reflect.ValueOf(syscall.SIGFPE),
+		"SIGGRANT":                      reflect.ValueOf(syscall.SIGGRANT),
+		"SIGHUP":                        reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                        reflect.ValueOf(syscall.SIGILL),
+		"SIGINT":                        reflect.ValueOf(syscall.SIGINT),
+		"SIGIO":                         reflect.ValueOf(syscall.SIGIO),
+		"SIGIOINT":                      reflect.ValueOf(syscall.SIGIOINT),
+		"SIGIOT":                        reflect.ValueOf(syscall.SIGIOT),
+		"SIGKAP":                        reflect.ValueOf(syscall.SIGKAP),
+		"SIGKILL":                       reflect.ValueOf(syscall.SIGKILL),
+		"SIGLOST":                       reflect.ValueOf(syscall.SIGLOST),
+		"SIGMAX":                        reflect.ValueOf(syscall.SIGMAX),
+		"SIGMAX32":                      reflect.ValueOf(syscall.SIGMAX32),
+		"SIGMAX64":                      reflect.ValueOf(syscall.SIGMAX64),
+		"SIGMIGRATE":                    reflect.ValueOf(syscall.SIGMIGRATE),
+		"SIGMSG":                        reflect.ValueOf(syscall.SIGMSG),
+		"SIGPIPE":                       reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPOLL":                       reflect.ValueOf(syscall.SIGPOLL),
+		"SIGPRE":                        reflect.ValueOf(syscall.SIGPRE),
+		"SIGPROF":                       reflect.ValueOf(syscall.SIGPROF),
+		"SIGPTY":                        reflect.ValueOf(syscall.SIGPTY),
+		"SIGPWR":                        reflect.ValueOf(syscall.SIGPWR),
+		"SIGQUEUE_MAX":                  reflect.ValueOf(syscall.SIGQUEUE_MAX),
+		"SIGQUIT":                       reflect.ValueOf(syscall.SIGQUIT),
+		"SIGRECONFIG":                   reflect.ValueOf(syscall.SIGRECONFIG),
+		"SIGRETRACT":                    reflect.ValueOf(syscall.SIGRETRACT),
+		"SIGSAK":                        reflect.ValueOf(syscall.SIGSAK),
+		"SIGSEGV":                       reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSOUND":                      reflect.ValueOf(syscall.SIGSOUND),
+		"SIGSTOP":                       reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                        reflect.ValueOf(syscall.SIGSYS),
+		"SIGSYSERROR":                   reflect.ValueOf(syscall.SIGSYSERROR),
+		"SIGTALRM":                      reflect.ValueOf(syscall.SIGTALRM),
+		"SIGTERM":                       reflect.ValueOf(syscall.SIGTERM),
+		"SIGTRAP":                       reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                       reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                       reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                       reflect.ValueOf(syscall.SIGTTOU),
+		"SIGURG":                        reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                       reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                       reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVIRT":                       reflect.ValueOf(syscall.SIGVIRT),
+		"SIGVTALRM":                     reflect.ValueOf(syscall.SIGVTALRM),
+		"SIGWAITING":                    reflect.ValueOf(syscall.SIGWAITING),
+		"SIGWINCH":                      reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                       reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                       reflect.ValueOf(syscall.SIGXFSZ),
+		"SIOCADDIFVIPA":                 reflect.ValueOf(syscall.SIOCADDIFVIPA),
+		"SIOCADDMTU":                    reflect.ValueOf(syscall.SIOCADDMTU),
+		"SIOCADDMULTI":                  reflect.ValueOf(syscall.SIOCADDMULTI),
+		"SIOCADDNETID":                  reflect.ValueOf(syscall.SIOCADDNETID),
+		"SIOCADDRT":                     reflect.ValueOf(syscall.SIOCADDRT),
+		"SIOCAIFADDR":                   reflect.ValueOf(syscall.SIOCAIFADDR),
+		"SIOCATMARK":                    reflect.ValueOf(syscall.SIOCATMARK),
+		"SIOCDARP":                      reflect.ValueOf(syscall.SIOCDARP),
+		"SIOCDELIFVIPA":                 reflect.ValueOf(syscall.SIOCDELIFVIPA),
+		"SIOCDELMTU":                    reflect.ValueOf(syscall.SIOCDELMTU),
+		"SIOCDELMULTI":                  reflect.ValueOf(syscall.SIOCDELMULTI),
+		"SIOCDELPMTU":                   reflect.ValueOf(syscall.SIOCDELPMTU),
+		"SIOCDELRT":                     reflect.ValueOf(syscall.SIOCDELRT),
+		"SIOCDIFADDR":                   reflect.ValueOf(syscall.SIOCDIFADDR),
+		"SIOCDNETOPT":                   reflect.ValueOf(syscall.SIOCDNETOPT),
+		"SIOCDX25XLATE":                 reflect.ValueOf(syscall.SIOCDX25XLATE),
+		"SIOCFIFADDR":                   reflect.ValueOf(syscall.SIOCFIFADDR),
+		"SIOCGARP":                      reflect.ValueOf(syscall.SIOCGARP),
+		"SIOCGETMTUS":                   reflect.ValueOf(syscall.SIOCGETMTUS),
+		"SIOCGETSGCNT":                  reflect.ValueOf(syscall.SIOCGETSGCNT),
+		"SIOCGETVIFCNT":                 reflect.ValueOf(syscall.SIOCGETVIFCNT),
+		"SIOCGHIWAT":                    reflect.ValueOf(syscall.SIOCGHIWAT),
+		"SIOCGIFADDR":                   reflect.ValueOf(syscall.SIOCGIFADDR),
+		"SIOCGIFADDRS":                  reflect.ValueOf(syscall.SIOCGIFADDRS),
+		"SIOCGIFBAUDRATE":               reflect.ValueOf(syscall.SIOCGIFBAUDRATE),
+		"SIOCGIFBRDADDR":                reflect.ValueOf(syscall.SIOCGIFBRDADDR),
+		"SIOCGIFCONF":                   reflect.ValueOf(syscall.SIOCGIFCONF),
+		"SIOCGIFCONFGLOB":               reflect.ValueOf(syscall.SIOCGIFCONFGLOB),
+		"SIOCGIFDSTADDR":                reflect.ValueOf(syscall.SIOCGIFDSTADDR),
+		"SIOCGIFFLAGS":                  reflect.ValueOf(syscall.SIOCGIFFLAGS),
+		"SIOCGIFGIDLIST":                reflect.ValueOf(syscall.SIOCGIFGIDLIST),
+		"SIOCGIFHWADDR":                 reflect.ValueOf(syscall.SIOCGIFHWADDR),
+		"SIOCGIFMETRIC":                 reflect.ValueOf(syscall.SIOCGIFMETRIC),
+		"SIOCGIFMTU":                    reflect.ValueOf(syscall.SIOCGIFMTU),
+		"SIOCGIFNETMASK":                reflect.ValueOf(syscall.SIOCGIFNETMASK),
+		"SIOCGIFOPTIONS":                reflect.ValueOf(syscall.SIOCGIFOPTIONS),
+		"SIOCGISNO":                     reflect.ValueOf(syscall.SIOCGISNO),
+		"SIOCGLOADF":                    reflect.ValueOf(syscall.SIOCGLOADF),
+		"SIOCGLOWAT":                    reflect.ValueOf(syscall.SIOCGLOWAT),
+		"SIOCGNETOPT":                   reflect.ValueOf(syscall.SIOCGNETOPT),
+		"SIOCGNETOPT1":                  reflect.ValueOf(syscall.SIOCGNETOPT1),
+		"SIOCGNMTUS":                    reflect.ValueOf(syscall.SIOCGNMTUS),
+		"SIOCGPGRP":                     reflect.ValueOf(syscall.SIOCGPGRP),
+		"SIOCGSIZIFCONF":                reflect.ValueOf(syscall.SIOCGSIZIFCONF),
+		"SIOCGSRCFILTER":                reflect.ValueOf(syscall.SIOCGSRCFILTER),
+		"SIOCGTUNEPHASE":                reflect.ValueOf(syscall.SIOCGTUNEPHASE),
+		"SIOCGX25XLATE":                 reflect.ValueOf(syscall.SIOCGX25XLATE),
+		"SIOCIFATTACH":                  reflect.ValueOf(syscall.SIOCIFATTACH),
+		"SIOCIFDETACH":                  reflect.ValueOf(syscall.SIOCIFDETACH),
+		"SIOCIFGETPKEY":                 reflect.ValueOf(syscall.SIOCIFGETPKEY),
+		"SIOCIF_ATM_DARP":               reflect.ValueOf(syscall.SIOCIF_ATM_DARP),
+		"SIOCIF_ATM_DUMPARP":            reflect.ValueOf(syscall.SIOCIF_ATM_DUMPARP),
+		"SIOCIF_ATM_GARP":               reflect.ValueOf(syscall.SIOCIF_ATM_GARP),
+		"SIOCIF_ATM_IDLE":               reflect.ValueOf(syscall.SIOCIF_ATM_IDLE),
+		"SIOCIF_ATM_SARP":               reflect.ValueOf(syscall.SIOCIF_ATM_SARP),
+		"SIOCIF_ATM_SNMPARP":            reflect.ValueOf(syscall.SIOCIF_ATM_SNMPARP),
+		"SIOCIF_ATM_SVC":                reflect.ValueOf(syscall.SIOCIF_ATM_SVC),
+		"SIOCIF_ATM_UBR":                reflect.ValueOf(syscall.SIOCIF_ATM_UBR),
+		"SIOCIF_DEVHEALTH":              reflect.ValueOf(syscall.SIOCIF_DEVHEALTH),
+		"SIOCIF_IB_ARP_INCOMP":          reflect.ValueOf(syscall.SIOCIF_IB_ARP_INCOMP),
+		"SIOCIF_IB_ARP_TIMER":           reflect.ValueOf(syscall.SIOCIF_IB_ARP_TIMER),
+		"SIOCIF_IB_CLEAR_PINFO":         reflect.ValueOf(syscall.SIOCIF_IB_CLEAR_PINFO),
+		"SIOCIF_IB_DEL_ARP":             reflect.ValueOf(syscall.SIOCIF_IB_DEL_ARP),
+		"SIOCIF_IB_DEL_PINFO":           reflect.ValueOf(syscall.SIOCIF_IB_DEL_PINFO),
+		"SIOCIF_IB_DUMP_ARP":            reflect.ValueOf(syscall.SIOCIF_IB_DUMP_ARP),
+		"SIOCIF_IB_GET_ARP":             reflect.ValueOf(syscall.SIOCIF_IB_GET_ARP),
+		"SIOCIF_IB_GET_INFO":            reflect.ValueOf(syscall.SIOCIF_IB_GET_INFO),
+		"SIOCIF_IB_GET_STATS":           reflect.ValueOf(syscall.SIOCIF_IB_GET_STATS),
+		"SIOCIF_IB_NOTIFY_ADDR_REM":     reflect.ValueOf(syscall.SIOCIF_IB_NOTIFY_ADDR_REM),
+		"SIOCIF_IB_RESET_STATS":         reflect.ValueOf(syscall.SIOCIF_IB_RESET_STATS),
+		"SIOCIF_IB_RESIZE_CQ":           reflect.ValueOf(syscall.SIOCIF_IB_RESIZE_CQ),
+		"SIOCIF_IB_SET_ARP":             reflect.ValueOf(syscall.SIOCIF_IB_SET_ARP),
+		"SIOCIF_IB_SET_PKEY":            reflect.ValueOf(syscall.SIOCIF_IB_SET_PKEY),
+		"SIOCIF_IB_SET_PORT":            reflect.ValueOf(syscall.SIOCIF_IB_SET_PORT),
+		"SIOCIF_IB_SET_QKEY":            reflect.ValueOf(syscall.SIOCIF_IB_SET_QKEY),
+		"SIOCIF_IB_SET_QSIZE":           reflect.ValueOf(syscall.SIOCIF_IB_SET_QSIZE),
+		"SIOCLISTIFVIPA":                reflect.ValueOf(syscall.SIOCLISTIFVIPA),
+		"SIOCSARP":                      reflect.ValueOf(syscall.SIOCSARP),
+		"SIOCSHIWAT":                    reflect.ValueOf(uint64(syscall.SIOCSHIWAT)),
+		"SIOCSIFADDR":                   reflect.ValueOf(syscall.SIOCSIFADDR),
+		"SIOCSIFADDRORI":                reflect.ValueOf(syscall.SIOCSIFADDRORI),
+		"SIOCSIFBRDADDR":                reflect.ValueOf(syscall.SIOCSIFBRDADDR),
+		"SIOCSIFDSTADDR":                reflect.ValueOf(syscall.SIOCSIFDSTADDR),
+		"SIOCSIFFLAGS":                  reflect.ValueOf(syscall.SIOCSIFFLAGS),
+		"SIOCSIFGIDLIST":                reflect.ValueOf(syscall.SIOCSIFGIDLIST),
+		"SIOCSIFMETRIC":                 reflect.ValueOf(syscall.SIOCSIFMETRIC),
+		"SIOCSIFMTU":                    reflect.ValueOf(syscall.SIOCSIFMTU),
+		"SIOCSIFNETDUMP":                reflect.ValueOf(syscall.SIOCSIFNETDUMP),
+		"SIOCSIFNETMASK":                reflect.ValueOf(syscall.SIOCSIFNETMASK),
+		"SIOCSIFOPTIONS":                reflect.ValueOf(syscall.SIOCSIFOPTIONS),
+		"SIOCSIFSUBCHAN":                reflect.ValueOf(syscall.SIOCSIFSUBCHAN),
+		"SIOCSISNO":                     reflect.ValueOf(syscall.SIOCSISNO),
+		"SIOCSLOADF":                    reflect.ValueOf(syscall.SIOCSLOADF),
+		"SIOCSLOWAT":                    reflect.ValueOf(uint64(syscall.SIOCSLOWAT)),
+		"SIOCSNETOPT":                   reflect.ValueOf(syscall.SIOCSNETOPT),
+		"SIOCSPGRP":                     reflect.ValueOf(uint64(syscall.SIOCSPGRP)),
+		"SIOCSX25XLATE":                 reflect.ValueOf(syscall.SIOCSX25XLATE),
+		"SOCK_CONN_DGRAM":               reflect.ValueOf(syscall.SOCK_CONN_DGRAM),
+		"SOCK_DGRAM":                    reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_RAW":                      reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_RDM":                      reflect.ValueOf(syscall.SOCK_RDM),
+		"SOCK_SEQPACKET":                reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                   reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOL_SOCKET":                    reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOMAXCONN":                     reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ACCEPTCONN":                 reflect.ValueOf(syscall.SO_ACCEPTCONN),
+		"SO_AUDIT":                      reflect.ValueOf(syscall.SO_AUDIT),
+		"SO_BROADCAST":                  reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_CKSUMRECV":                  reflect.ValueOf(syscall.SO_CKSUMRECV),
+		"SO_DEBUG":                      reflect.ValueOf(syscall.SO_DEBUG),
+		"SO_DONTROUTE":                  reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_ERROR":                      reflect.ValueOf(syscall.SO_ERROR),
+		"SO_KEEPALIVE":                  reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_KERNACCEPT":                 reflect.ValueOf(syscall.SO_KERNACCEPT),
+		"SO_LINGER":                     reflect.ValueOf(syscall.SO_LINGER),
+		"SO_NOMULTIPATH":                reflect.ValueOf(syscall.SO_NOMULTIPATH),
+		"SO_NOREUSEADDR":                reflect.ValueOf(syscall.SO_NOREUSEADDR),
+		"SO_OOBINLINE":                  reflect.ValueOf(syscall.SO_OOBINLINE),
+		"SO_PEERID":                     reflect.ValueOf(syscall.SO_PEERID),
+		"SO_RCVBUF":                     reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_RCVLOWAT":                   reflect.ValueOf(syscall.SO_RCVLOWAT),
+		"SO_RCVTIMEO":                   reflect.ValueOf(syscall.SO_RCVTIMEO),
+		"SO_REUSEADDR":                  reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_REUSEPORT":                  reflect.ValueOf(syscall.SO_REUSEPORT),
+		"SO_SNDBUF":                     reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_SNDLOWAT":                   reflect.ValueOf(syscall.SO_SNDLOWAT),
+		"SO_SNDTIMEO":                   reflect.ValueOf(syscall.SO_SNDTIMEO),
+		"SO_TIMESTAMPNS":                reflect.ValueOf(syscall.SO_TIMESTAMPNS),
+		"SO_TYPE":                       reflect.ValueOf(syscall.SO_TYPE),
+		"SO_USELOOPBACK":                reflect.ValueOf(syscall.SO_USELOOPBACK),
+		"SO_USE_IFBUFS":                 reflect.ValueOf(syscall.SO_USE_IFBUFS),
+		"SYS_EXECVE":                    reflect.ValueOf(syscall.SYS_EXECVE),
+		"SYS_FCNTL":                     reflect.ValueOf(syscall.SYS_FCNTL),
+		"S_BANDURG":                     reflect.ValueOf(syscall.S_BANDURG),
+		"S_EMODFMT":                     reflect.ValueOf(syscall.S_EMODFMT),
+		"S_ENFMT":                       reflect.ValueOf(syscall.S_ENFMT),
+		"S_ERROR":                       reflect.ValueOf(syscall.S_ERROR),
+		"S_HANGUP":                      reflect.ValueOf(syscall.S_HANGUP),
+		"S_HIPRI":                       reflect.ValueOf(syscall.S_HIPRI),
+		"S_ICRYPTO":                     reflect.ValueOf(syscall.S_ICRYPTO),
+		"S_IEXEC":                       reflect.ValueOf(syscall.S_IEXEC),
+		"S_IFBLK":                       reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                       reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                       reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                       reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFJOURNAL":                   reflect.ValueOf(syscall.S_IFJOURNAL),
+		"S_IFLNK":                       reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMPX":                       reflect.ValueOf(syscall.S_IFMPX),
+		"S_IFMT":                        reflect.ValueOf(syscall.S_IFMT),
+		"S_IFPDIR":                      reflect.ValueOf(syscall.S_IFPDIR),
+		"S_IFPSDIR":                     reflect.ValueOf(syscall.S_IFPSDIR),
+		"S_IFPSSDIR":                    reflect.ValueOf(syscall.S_IFPSSDIR),
+		"S_IFREG":                       reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                      reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IFSYSEA":                     reflect.ValueOf(syscall.S_IFSYSEA),
+		"S_INPUT":                       reflect.ValueOf(syscall.S_INPUT),
+		"S_IREAD":                       reflect.ValueOf(syscall.S_IREAD),
+		"S_IRGRP":                       reflect.ValueOf(syscall.S_IRGRP),
+		"S_IROTH":                       reflect.ValueOf(syscall.S_IROTH),
+		"S_IRUSR":                       reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                       reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                       reflect.ValueOf(syscall.S_IRWXO),
+		"S_IRWXU":                       reflect.ValueOf(syscall.S_IRWXU),
+		"S_ISGID":                       reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                       reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                       reflect.ValueOf(syscall.S_ISVTX),
+		"S_ITCB":                        reflect.ValueOf(syscall.S_ITCB),
+		"S_ITP":                         reflect.ValueOf(syscall.S_ITP),
+		"S_IWGRP":                       reflect.ValueOf(syscall.S_IWGRP),
+		"S_IWOTH":                       reflect.ValueOf(syscall.S_IWOTH),
+		"S_IWRITE":                      reflect.ValueOf(syscall.S_IWRITE),
+		"S_IWUSR":                       reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXACL":                       reflect.ValueOf(syscall.S_IXACL),
+		"S_IXATTR":                      reflect.ValueOf(syscall.S_IXATTR),
+		"S_IXGRP":                       reflect.ValueOf(syscall.S_IXGRP),
+		"S_IXINTERFACE":                 reflect.ValueOf(syscall.S_IXINTERFACE),
+		"S_IXMOD":                       reflect.ValueOf(syscall.S_IXMOD),
+		"S_IXOTH":                       reflect.ValueOf(syscall.S_IXOTH),
+		"S_IXUSR":                       reflect.ValueOf(syscall.S_IXUSR),
+		"S_MSG":                         reflect.ValueOf(syscall.S_MSG),
+		"S_OUTPUT":                      reflect.ValueOf(syscall.S_OUTPUT),
+		"S_RDBAND":                      reflect.ValueOf(syscall.S_RDBAND),
+		"S_RDNORM":                      reflect.ValueOf(syscall.S_RDNORM),
+		"S_RESERVED1":                   reflect.ValueOf(syscall.S_RESERVED1),
+		"S_RESERVED2":                   reflect.ValueOf(syscall.S_RESERVED2),
+		"S_RESERVED3":                   reflect.ValueOf(syscall.S_RESERVED3),
+		"S_RESERVED4":                   reflect.ValueOf(uint32(syscall.S_RESERVED4)),
+		"S_RESFMT1":                     reflect.ValueOf(syscall.S_RESFMT1),
+		"S_RESFMT10":                    reflect.ValueOf(syscall.S_RESFMT10),
+		"S_RESFMT11":                    reflect.ValueOf(syscall.S_RESFMT11),
+		"S_RESFMT12":                    reflect.ValueOf(syscall.S_RESFMT12),
+		"S_RESFMT2":                     reflect.ValueOf(syscall.S_RESFMT2),
+		"S_RESFMT3":                     reflect.ValueOf(syscall.S_RESFMT3),
+		"S_RESFMT4":                     reflect.ValueOf(syscall.S_RESFMT4),
+		"S_RESFMT5":                     reflect.ValueOf(syscall.S_RESFMT5),
+		"S_RESFMT6":                     reflect.ValueOf(syscall.S_RESFMT6),
+		"S_RESFMT7":                     reflect.ValueOf(syscall.S_RESFMT7),
+		"S_RESFMT8":                     reflect.ValueOf(syscall.S_RESFMT8),
+		"S_WRBAND":                      reflect.ValueOf(syscall.S_WRBAND),
+		"S_WRNORM":                      reflect.ValueOf(syscall.S_WRNORM),
+		"Seek":                          reflect.ValueOf(syscall.Seek),
+		"Sendfile":                      reflect.ValueOf(syscall.Sendfile),
+		"Sendmsg":                       reflect.ValueOf(syscall.Sendmsg),
+		"SendmsgN":                      reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                        reflect.ValueOf(syscall.Sendto),
+		"SetNonblock":                   reflect.ValueOf(syscall.SetNonblock),
+		"Setegid":                       reflect.ValueOf(syscall.Setegid),
+		"Setenv":                        reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                       reflect.ValueOf(syscall.Seteuid),
+		"Setgid":                        reflect.ValueOf(syscall.Setgid),
+		"Setgroups":                     reflect.ValueOf(syscall.Setgroups),
+		"Setpgid":                       reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                   reflect.ValueOf(syscall.Setpriority),
+		"Setregid":                      reflect.ValueOf(syscall.Setregid),
+		"Setreuid":                      reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                     reflect.ValueOf(syscall.Setrlimit),
+		"SetsockoptByte":                reflect.ValueOf(syscall.SetsockoptByte),
+		"SetsockoptICMPv6Filter":        reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
+		"SetsockoptIPMreq":              reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPv6Mreq":            reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":           reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                 reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":              reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptString":              reflect.ValueOf(syscall.SetsockoptString),
+		"SetsockoptTimeval":             reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Setuid":                        reflect.ValueOf(syscall.Setuid),
+		"Shutdown":                      reflect.ValueOf(syscall.Shutdown),
+		"SizeofCmsghdr":                 reflect.ValueOf(syscall.SizeofCmsghdr),
+		"SizeofICMPv6Filter":            reflect.ValueOf(syscall.SizeofICMPv6Filter),
+		"SizeofIPMreq":                  reflect.ValueOf(syscall.SizeofIPMreq),
+		"SizeofIPv6Mreq":                reflect.ValueOf(syscall.SizeofIPv6Mreq),
+		"SizeofIfMsghdr":                reflect.ValueOf(syscall.SizeofIfMsghdr),
+		"SizeofLinger":                  reflect.ValueOf(syscall.SizeofLinger),
+		"SizeofMsghdr":                  reflect.ValueOf(syscall.SizeofMsghdr),
+		"SizeofSockaddrAny":             reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrDatalink":        reflect.ValueOf(syscall.SizeofSockaddrDatalink),
+		"SizeofSockaddrInet4":           reflect.ValueOf(syscall.SizeofSockaddrInet4),
+		"SizeofSockaddrInet6":           reflect.ValueOf(syscall.SizeofSockaddrInet6),
+		"SizeofSockaddrUnix":            reflect.ValueOf(syscall.SizeofSockaddrUnix),
+		"SlicePtrFromStrings":           reflect.ValueOf(syscall.SlicePtrFromStrings),
+		"Socket":                        reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":             reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"Socketpair":                    reflect.ValueOf(syscall.Socketpair),
+		"StartProcess":                  reflect.ValueOf(syscall.StartProcess),
+		"Stat":                          reflect.ValueOf(syscall.Stat),
+		"Statfs":                        reflect.ValueOf(syscall.Statfs),
+		"Stderr":                        reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                         reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                        reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                 reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":               reflect.ValueOf(syscall.StringByteSlice),
+		"StringSlicePtr":                reflect.ValueOf(syscall.StringSlicePtr),
+		"Symlink":                       reflect.ValueOf(syscall.Symlink),
+		"Syscall":                       reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                      reflect.ValueOf(syscall.Syscall6),
+		"TCIFLUSH":                      reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                     reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                      reflect.ValueOf(syscall.TCOFLUSH),
+		"TCP_24DAYS_WORTH_OF_SLOWTICKS": reflect.ValueOf(syscall.TCP_24DAYS_WORTH_OF_SLOWTICKS),
+		"TCP_ACLADD":                    reflect.ValueOf(syscall.TCP_ACLADD),
+		"TCP_ACLBIND":                   reflect.ValueOf(syscall.TCP_ACLBIND),
+		"TCP_ACLCLEAR":                  reflect.ValueOf(syscall.TCP_ACLCLEAR),
+		"TCP_ACLDEL":                    reflect.ValueOf(syscall.TCP_ACLDEL),
+		"TCP_ACLDENY":                   reflect.ValueOf(syscall.TCP_ACLDENY),
+		"TCP_ACLFLUSH":                  reflect.ValueOf(syscall.TCP_ACLFLUSH),
+		"TCP_ACLGID":                    reflect.ValueOf(syscall.TCP_ACLGID),
+		"TCP_ACLLS":                     reflect.ValueOf(syscall.TCP_ACLLS),
+		"TCP_ACLSUBNET":                 reflect.ValueOf(syscall.TCP_ACLSUBNET),
+		"TCP_ACLUID":                    reflect.ValueOf(syscall.TCP_ACLUID),
+		"TCP_CWND_DF":                   reflect.ValueOf(syscall.TCP_CWND_DF),
+		"TCP_CWND_IF":                   reflect.ValueOf(syscall.TCP_CWND_IF),
+		"TCP_DELAY_ACK_FIN":             reflect.ValueOf(syscall.TCP_DELAY_ACK_FIN),
+		"TCP_DELAY_ACK_SYN":             reflect.ValueOf(syscall.TCP_DELAY_ACK_SYN),
+		"TCP_FASTNAME":                  reflect.ValueOf(syscall.TCP_FASTNAME),
+		"TCP_KEEPCNT":                   reflect.ValueOf(syscall.TCP_KEEPCNT),
+		"TCP_KEEPIDLE":                  reflect.ValueOf(syscall.TCP_KEEPIDLE),
+		"TCP_KEEPINTVL":                 reflect.ValueOf(syscall.TCP_KEEPINTVL),
+		"TCP_LSPRIV":                    reflect.ValueOf(syscall.TCP_LSPRIV),
+		"TCP_LUID":                      reflect.ValueOf(syscall.TCP_LUID),
+		"TCP_MAXBURST":                  reflect.ValueOf(syscall.TCP_MAXBURST),
+		"TCP_MAXDF":                     reflect.ValueOf(syscall.TCP_MAXDF),
+		"TCP_MAXIF":                     reflect.ValueOf(syscall.TCP_MAXIF),
+		"TCP_MAXSEG":                    reflect.ValueOf(syscall.TCP_MAXSEG),
+		"TCP_MAXWIN":                    reflect.ValueOf(syscall.TCP_MAXWIN),
+		"TCP_MAXWINDOWSCALE":            reflect.ValueOf(syscall.TCP_MAXWINDOWSCALE),
+		"TCP_MAX_SACK":                  reflect.ValueOf(syscall.TCP_MAX_SACK),
+		"TCP_MSS":                       reflect.ValueOf(syscall.TCP_MSS),
+		"TCP_NODELAY":                   reflect.ValueOf(syscall.TCP_NODELAY),
+		"TCP_NODELAYACK":                reflect.ValueOf(syscall.TCP_NODELAYACK),
+		"TCP_NOREDUCE_CWND_EXIT_FRXMT":  reflect.ValueOf(syscall.TCP_NOREDUCE_CWND_EXIT_FRXMT),
+		"TCP_NOREDUCE_CWND_IN_FRXMT":    reflect.ValueOf(syscall.TCP_NOREDUCE_CWND_IN_FRXMT),
+		"TCP_NOTENTER_SSTART":           reflect.ValueOf(syscall.TCP_NOTENTER_SSTART),
+		"TCP_OPT":                       reflect.ValueOf(syscall.TCP_OPT),
+		"TCP_RFC1323":                   reflect.ValueOf(syscall.TCP_RFC1323),
+		"TCP_SETPRIV":                   reflect.ValueOf(syscall.TCP_SETPRIV),
+		"TCP_STDURG":                    reflect.ValueOf(syscall.TCP_STDURG),
+		"TCP_TIMESTAMP_OPTLEN":          reflect.ValueOf(syscall.TCP_TIMESTAMP_OPTLEN),
+		"TCP_UNSETPRIV":                 reflect.ValueOf(syscall.TCP_UNSETPRIV),
+		"TCSAFLUSH":                     reflect.ValueOf(syscall.TCSAFLUSH),
+		"TIOCCBRK":                      reflect.ValueOf(syscall.TIOCCBRK),
+		"TIOCCDTR":                      reflect.ValueOf(syscall.TIOCCDTR),
+		"TIOCCONS":                      reflect.ValueOf(uint64(syscall.TIOCCONS)),
+		"TIOCEXCL":                      reflect.ValueOf(syscall.TIOCEXCL),
+		"TIOCFLUSH":                     reflect.ValueOf(uint64(syscall.TIOCFLUSH)),
+		"TIOCGETC":                      reflect.ValueOf(syscall.TIOCGETC),
+		"TIOCGETD":                      reflect.ValueOf(syscall.TIOCGETD),
+		"TIOCGETP":                      reflect.ValueOf(syscall.TIOCGETP),
+		"TIOCGLTC":                      reflect.ValueOf(syscall.TIOCGLTC),
+		"TIOCGPGRP":                     reflect.ValueOf(syscall.TIOCGPGRP),
+		"TIOCGSID":                      reflect.ValueOf(syscall.TIOCGSID),
+		"TIOCGSIZE":                     reflect.ValueOf(syscall.TIOCGSIZE),
+		"TIOCGWINSZ":                    reflect.ValueOf(syscall.TIOCGWINSZ),
+		"TIOCHPCL":                      reflect.ValueOf(syscall.TIOCHPCL),
+		"TIOCLBIC":                      reflect.ValueOf(uint64(syscall.TIOCLBIC)),
+		"TIOCLBIS":                      reflect.ValueOf(uint64(syscall.TIOCLBIS)),
+		"TIOCLGET":                      reflect.ValueOf(syscall.TIOCLGET),
+		"TIOCLSET":                      reflect.ValueOf(uint64(syscall.TIOCLSET)),
+		"TIOCMBIC":                      reflect.ValueOf(uint64(syscall.TIOCMBIC)),
+		"TIOCMBIS":                      reflect.ValueOf(uint64(syscall.TIOCMBIS)),
+		"TIOCMGET":                      reflect.ValueOf(syscall.TIOCMGET),
+		"TIOCMIWAIT":                    reflect.ValueOf(uint64(syscall.TIOCMIWAIT)),
+		"TIOCMODG":                      reflect.ValueOf(syscall.TIOCMODG),
+		"TIOCMODS":                      reflect.ValueOf(uint64(syscall.TIOCMODS)),
+		"TIOCMSET":                      reflect.ValueOf(uint64(syscall.TIOCMSET)),
+		"TIOCM_CAR":                     reflect.ValueOf(syscall.TIOCM_CAR),
+		"TIOCM_CD":                      reflect.ValueOf(syscall.TIOCM_CD),
+		"TIOCM_CTS":                     reflect.ValueOf(syscall.TIOCM_CTS),
+		"TIOCM_DSR":                     reflect.ValueOf(syscall.TIOCM_DSR),
+		"TIOCM_DTR":                     reflect.ValueOf(syscall.TIOCM_DTR),
+		"TIOCM_LE":                      reflect.ValueOf(syscall.TIOCM_LE),
+		"TIOCM_RI":                      reflect.ValueOf(syscall.TIOCM_RI),
+		"TIOCM_RNG":                     reflect.ValueOf(syscall.TIOCM_RNG),
+		"TIOCM_RTS":                     reflect.ValueOf(syscall.TIOCM_RTS),
+		"TIOCM_SR":                      reflect.ValueOf(syscall.TIOCM_SR),
+		"TIOCM_ST":                      reflect.ValueOf(syscall.TIOCM_ST),
+		"TIOCNOTTY":                     reflect.ValueOf(syscall.TIOCNOTTY),
+		"TIOCNXCL":                      reflect.ValueOf(syscall.TIOCNXCL),
+		"TIOCOUTQ":                      reflect.ValueOf(syscall.TIOCOUTQ),
+		"TIOCPKT":                       reflect.ValueOf(uint64(syscall.TIOCPKT)),
+		"TIOCPKT_DATA":                  reflect.ValueOf(syscall.TIOCPKT_DATA),
+		"TIOCPKT_DOSTOP":                reflect.ValueOf(syscall.TIOCPKT_DOSTOP),
+		"TIOCPKT_FLUSHREAD":             reflect.ValueOf(syscall.TIOCPKT_FLUSHREAD),
+		"TIOCPKT_FLUSHWRITE":            reflect.ValueOf(syscall.TIOCPKT_FLUSHWRITE),
+		"TIOCPKT_NOSTOP":                reflect.ValueOf(syscall.TIOCPKT_NOSTOP),
+		"TIOCPKT_START":                 reflect.ValueOf(syscall.TIOCPKT_START),
+		"TIOCPKT_STOP":                  reflect.ValueOf(syscall.TIOCPKT_STOP),
+		"TIOCREMOTE":                    reflect.ValueOf(uint64(syscall.TIOCREMOTE)),
+		"TIOCSBRK":                      reflect.ValueOf(syscall.TIOCSBRK),
+		"TIOCSCTTY":                     reflect.ValueOf(syscall.TIOCSCTTY),
+		"TIOCSDTR":                      reflect.ValueOf(syscall.TIOCSDTR),
+		"TIOCSETC":                      reflect.ValueOf(uint64(syscall.TIOCSETC)),
+		"TIOCSETD":                      reflect.ValueOf(uint64(syscall.TIOCSETD)),
+		"TIOCSETN":                      reflect.ValueOf(uint64(syscall.TIOCSETN)),
+		"TIOCSETP":                      reflect.ValueOf(uint64(syscall.TIOCSETP)),
+		"TIOCSLTC":                      reflect.ValueOf(uint64(syscall.TIOCSLTC)),
+		"TIOCSPGRP":                     reflect.ValueOf(uint64(syscall.TIOCSPGRP)),
+		"TIOCSSIZE":                     reflect.ValueOf(uint64(syscall.TIOCSSIZE)),
+		"TIOCSTART":                     reflect.ValueOf(syscall.TIOCSTART),
+		"TIOCSTI":                       reflect.ValueOf(uint64(syscall.TIOCSTI)),
+		"TIOCSTOP":                      reflect.ValueOf(syscall.TIOCSTOP),
+		"TIOCSWINSZ":                    reflect.ValueOf(uint64(syscall.TIOCSWINSZ)),
+		"TIOCUCNTL":                     reflect.ValueOf(uint64(syscall.TIOCUCNTL)),
+		"TOSTOP":                        reflect.ValueOf(syscall.TOSTOP),
+		"TimespecToNsec":                reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                 reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                      reflect.ValueOf(syscall.Truncate),
+		"Umask":                         reflect.ValueOf(syscall.Umask),
+		"Uname":                         reflect.ValueOf(syscall.Uname),
+		"UnixRights":                    reflect.ValueOf(syscall.UnixRights),
+		"Unlink":                        reflect.ValueOf(syscall.Unlink),
+		"Unlinkat":                      reflect.ValueOf(syscall.Unlinkat),
+		"Unsetenv":                      reflect.ValueOf(syscall.Unsetenv),
+		"Utimes":                        reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                    reflect.ValueOf(syscall.UtimesNano),
+		"VDISCRD":                       reflect.ValueOf(syscall.VDISCRD),
+		"VDSUSP":                        reflect.ValueOf(syscall.VDSUSP),
+		"VEOF":                          reflect.ValueOf(syscall.VEOF),
+		"VEOL":                          reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                         reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                        reflect.ValueOf(syscall.VERASE),
+		"VINTR":                         reflect.ValueOf(syscall.VINTR),
+		"VKILL":                         reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                        reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                          reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                         reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                      reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                        reflect.ValueOf(syscall.VSTART),
+		"VSTOP":                         reflect.ValueOf(syscall.VSTOP),
+		"VSTRT":                         reflect.ValueOf(syscall.VSTRT),
+		"VSUSP":                         reflect.ValueOf(syscall.VSUSP),
+		"VT0":                           reflect.ValueOf(syscall.VT0),
+		"VT1":                           reflect.ValueOf(syscall.VT1),
+		"VTDELAY":                       reflect.ValueOf(syscall.VTDELAY),
+		"VTDLY":                         reflect.ValueOf(syscall.VTDLY),
+		"VTIME":                         reflect.ValueOf(syscall.VTIME),
+		"VWERSE":                        reflect.ValueOf(syscall.VWERSE),
+		"WPARSTART":                     reflect.ValueOf(syscall.WPARSTART),
+		"WPARSTOP":                      reflect.ValueOf(syscall.WPARSTOP),
+		"WPARTTYNAME":                   reflect.ValueOf(syscall.WPARTTYNAME),
+		"Wait4":                         reflect.ValueOf(syscall.Wait4),
+		"Write":                         reflect.ValueOf(syscall.Write),
+
+		// type definitions
+		"Cmsghdr":              reflect.ValueOf((*syscall.Cmsghdr)(nil)),
+		"Conn":                 reflect.ValueOf((*syscall.Conn)(nil)),
+		"Credential":           reflect.ValueOf((*syscall.Credential)(nil)),
+		"Dirent":               reflect.ValueOf((*syscall.Dirent)(nil)),
+		"Errno":                reflect.ValueOf((*syscall.Errno)(nil)),
+		"Flock_t":              reflect.ValueOf((*syscall.Flock_t)(nil)),
+		"Fsid64_t":             reflect.ValueOf((*syscall.Fsid64_t)(nil)),
+		"ICMPv6Filter":         reflect.ValueOf((*syscall.ICMPv6Filter)(nil)),
+		"IPMreq":               reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPv6Mreq":             reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"IfMsgHdr":             reflect.ValueOf((*syscall.IfMsgHdr)(nil)),
+		"Iovec":                reflect.ValueOf((*syscall.Iovec)(nil)),
+		"Linger":               reflect.ValueOf((*syscall.Linger)(nil)),
+		"Msghdr":               reflect.ValueOf((*syscall.Msghdr)(nil)),
+		"ProcAttr":             reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"RawConn":              reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":          reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":       reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrDatalink":  reflect.ValueOf((*syscall.RawSockaddrDatalink)(nil)),
+		"RawSockaddrInet4":     reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":     reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":      reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rlimit":               reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"Rusage":               reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":               reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":             reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrDatalink":     reflect.ValueOf((*syscall.SockaddrDatalink)(nil)),
+		"SockaddrInet4":        reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":        reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":         reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"SocketControlMessage": reflect.ValueOf((*syscall.SocketControlMessage)(nil)),
+		"StTimespec_t":         reflect.ValueOf((*syscall.StTimespec_t)(nil)),
+		"Stat_t":               reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"Statfs_t":             reflect.ValueOf((*syscall.Statfs_t)(nil)),
+		"SysProcAttr":          reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Termios":              reflect.ValueOf((*syscall.Termios)(nil)),
+		"Timespec":             reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":              reflect.ValueOf((*syscall.Timeval)(nil)),
+		"Timeval32":            reflect.ValueOf((*syscall.Timeval32)(nil)),
+		"Timezone":             reflect.ValueOf((*syscall.Timezone)(nil)),
+		"Utsname":              reflect.ValueOf((*syscall.Utsname)(nil)),
+		"WaitStatus":           reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":     reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":  reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_Sockaddr": reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
@@ -0,0 +1,1960 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_APPLETALK":                       reflect.ValueOf(syscall.AF_APPLETALK),
+		"AF_CCITT":                           reflect.ValueOf(syscall.AF_CCITT),
+		"AF_CHAOS":                           reflect.ValueOf(syscall.AF_CHAOS),
+		"AF_CNT":                             reflect.ValueOf(syscall.AF_CNT),
+		"AF_COIP":                            reflect.ValueOf(syscall.AF_COIP),
+		"AF_DATAKIT":                         reflect.ValueOf(syscall.AF_DATAKIT),
+		"AF_DECnet":                          reflect.ValueOf(syscall.AF_DECnet),
+		"AF_DLI":                             reflect.ValueOf(syscall.AF_DLI),
+		"AF_E164":                            reflect.ValueOf(syscall.AF_E164),
+		"AF_ECMA":                            reflect.ValueOf(syscall.AF_ECMA),
+		"AF_HYLINK":                          reflect.ValueOf(syscall.AF_HYLINK),
+		"AF_IEEE80211":                       reflect.ValueOf(syscall.AF_IEEE80211),
+		"AF_IMPLINK":                         reflect.ValueOf(syscall.AF_IMPLINK),
+		"AF_INET":                            reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                           reflect.ValueOf(syscall.AF_INET6),
+		"AF_IPX":                             reflect.ValueOf(syscall.AF_IPX),
+		"AF_ISDN":                            reflect.ValueOf(syscall.AF_ISDN),
+		"AF_ISO":                             reflect.ValueOf(syscall.AF_ISO),
+		"AF_LAT":                             reflect.ValueOf(syscall.AF_LAT),
+		"AF_LINK":                            reflect.ValueOf(syscall.AF_LINK),
+		"AF_LOCAL":                           reflect.ValueOf(syscall.AF_LOCAL),
+		"AF_MAX":                             reflect.ValueOf(syscall.AF_MAX),
+		"AF_NATM":                            reflect.ValueOf(syscall.AF_NATM),
+		"AF_NDRV":                            reflect.ValueOf(syscall.AF_NDRV),
+		"AF_NETBIOS":                         reflect.ValueOf(syscall.AF_NETBIOS),
+		"AF_NS":                              reflect.ValueOf(syscall.AF_NS),
+		"AF_OSI":                             reflect.ValueOf(syscall.AF_OSI),
+		"AF_PPP":                             reflect.ValueOf(syscall.AF_PPP),
+		"AF_PUP":                             reflect.ValueOf(syscall.AF_PUP),
+		"AF_RESERVED_36":                     reflect.ValueOf(syscall.AF_RESERVED_36),
+		"AF_ROUTE":                           reflect.ValueOf(syscall.AF_ROUTE),
+		"AF_SIP":                             reflect.ValueOf(syscall.AF_SIP),
+		"AF_SNA":                             reflect.ValueOf(syscall.AF_SNA),
+		"AF_SYSTEM":                          reflect.ValueOf(syscall.AF_SYSTEM),
+		"AF_UNIX":                            reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                          reflect.ValueOf(syscall.AF_UNSPEC),
+		"AF_UTUN":                            reflect.ValueOf(syscall.AF_UTUN),
+		"Accept":                             reflect.ValueOf(syscall.Accept),
+		"Access":                             reflect.ValueOf(syscall.Access),
+		"Adjtime":                            reflect.ValueOf(syscall.Adjtime),
+		"B0":                                 reflect.ValueOf(syscall.B0),
+		"B110":                               reflect.ValueOf(syscall.B110),
+		"B115200":                            reflect.ValueOf(syscall.B115200),
+		"B1200":                              reflect.ValueOf(syscall.B1200),
+		"B134":                               reflect.ValueOf(syscall.B134),
+		"B14400":                             reflect.ValueOf(syscall.B14400),
+		"B150":                               reflect.ValueOf(syscall.B150),
+		"B1800":                              reflect.ValueOf(syscall.B1800),
+		"B19200":                             reflect.ValueOf(syscall.B19200),
+		"B200":                               reflect.ValueOf(syscall.B200),
+		"B230400":                            reflect.ValueOf(syscall.B230400),
+		"B2400":                              reflect.ValueOf(syscall.B2400),
+		"B28800":                             reflect.ValueOf(syscall.B28800),
+		"B300":                               reflect.ValueOf(syscall.B300),
+		"B38400":                             reflect.ValueOf(syscall.B38400),
+		"B4800":                              reflect.ValueOf(syscall.B4800),
+		"B50":                                reflect.ValueOf(syscall.B50),
+		"B57600":                             reflect.ValueOf(syscall.B57600),
+		"B600":                               reflect.ValueOf(syscall.B600),
+		"B7200":                              reflect.ValueOf(syscall.B7200),
+		"B75":                                reflect.ValueOf(syscall.B75),
+		"B76800":                             reflect.ValueOf(syscall.B76800),
+		"B9600":                              reflect.ValueOf(syscall.B9600),
+		"BIOCFLUSH":                          reflect.ValueOf(syscall.BIOCFLUSH),
+		"BIOCGBLEN":                          reflect.ValueOf(syscall.BIOCGBLEN),
+		"BIOCGDLT":                           reflect.ValueOf(syscall.BIOCGDLT),
+		"BIOCGDLTLIST":                       reflect.ValueOf(uint32(syscall.BIOCGDLTLIST)),
+		"BIOCGETIF":                          reflect.ValueOf(syscall.BIOCGETIF),
+		"BIOCGHDRCMPLT":                      reflect.ValueOf(syscall.BIOCGHDRCMPLT),
+		"BIOCGRSIG":                          reflect.ValueOf(syscall.BIOCGRSIG),
+		"BIOCGRTIMEOUT":                      reflect.ValueOf(syscall.BIOCGRTIMEOUT),
+		"BIOCGSEESENT":                       reflect.ValueOf(syscall.BIOCGSEESENT),
+		"BIOCGSTATS":                         reflect.ValueOf(syscall.BIOCGSTATS),
+		"BIOCIMMEDIATE":                      reflect.ValueOf(uint32(syscall.BIOCIMMEDIATE)),
+		"BIOCPROMISC":                        reflect.ValueOf(syscall.BIOCPROMISC),
+		"BIOCSBLEN":                          reflect.ValueOf(uint32(syscall.BIOCSBLEN)),
+		"BIOCSDLT":                           reflect.ValueOf(uint32(syscall.BIOCSDLT)),
+		"BIOCSETF":                           reflect.ValueOf(uint32(syscall.BIOCSETF)),
+		"BIOCSETIF":                          reflect.ValueOf(uint32(syscall.BIOCSETIF)),
+		"BIOCSHDRCMPLT":                      reflect.ValueOf(uint32(syscall.BIOCSHDRCMPLT)),
+		"BIOCSRSIG":                          reflect.ValueOf(uint32(syscall.BIOCSRSIG)),
+		"BIOCSRTIMEOUT":                      reflect.ValueOf(uint32(syscall.BIOCSRTIMEOUT)),
+		"BIOCSSEESENT":                       reflect.ValueOf(uint32(syscall.BIOCSSEESENT)),
+		"BIOCVERSION":                        reflect.ValueOf(syscall.BIOCVERSION),
+		"BPF_A":                              reflect.ValueOf(syscall.BPF_A),
+		"BPF_ABS":                            reflect.ValueOf(syscall.BPF_ABS),
+		"BPF_ADD":                            reflect.ValueOf(syscall.BPF_ADD),
+		"BPF_ALIGNMENT":                      reflect.ValueOf(syscall.BPF_ALIGNMENT),
+		"BPF_ALU":                            reflect.ValueOf(syscall.BPF_ALU),
+		"BPF_AND":                            reflect.ValueOf(syscall.BPF_AND),
+		"BPF_B":                              reflect.ValueOf(syscall.BPF_B),
+		"BPF_DIV":                            reflect.ValueOf(syscall.BPF_DIV),
+		"BPF_H":                              reflect.ValueOf(syscall.BPF_H),
+		"BPF_IMM":                            reflect.ValueOf(syscall.BPF_IMM),
+		"BPF_IND":                            reflect.ValueOf(syscall.BPF_IND),
+		"BPF_JA":                             reflect.ValueOf(syscall.BPF_JA),
+		"BPF_JEQ":                            reflect.ValueOf(syscall.BPF_JEQ),
+		"BPF_JGE":                            reflect.ValueOf(syscall.BPF_JGE),
+		"BPF_JGT":                            reflect.ValueOf(syscall.BPF_JGT),
+		"BPF_JMP":                            reflect.ValueOf(syscall.BPF_JMP),
+		"BPF_JSET":                           reflect.ValueOf(syscall.BPF_JSET),
+		"BPF_K":                              reflect.ValueOf(syscall.BPF_K),
+		"BPF_LD":                             reflect.ValueOf(syscall.BPF_LD),
+		"BPF_LDX":                            reflect.ValueOf(syscall.BPF_LDX),
+		"BPF_LEN":                            reflect.ValueOf(syscall.BPF_LEN),
+		"BPF_LSH":                            reflect.ValueOf(syscall.BPF_LSH),
+		"BPF_MAJOR_VERSION":                  reflect.ValueOf(syscall.BPF_MAJOR_VERSION),
+		"BPF_MAXBUFSIZE":                     reflect.ValueOf(syscall.BPF_MAXBUFSIZE),
+		"BPF_MAXINSNS":                       reflect.ValueOf(syscall.BPF_MAXINSNS),
+		"BPF_MEM":                            reflect.ValueOf(syscall.BPF_MEM),
+		"BPF_MEMWORDS":                       reflect.ValueOf(syscall.BPF_MEMWORDS),
+		"BPF_MINBUFSIZE":                     reflect.ValueOf(syscall.BPF_MINBUFSIZE),
+		"BPF_MINOR_VERSION":                  reflect.ValueOf(syscall.BPF_MINOR_VERSION),
+		"BPF_MISC":                           reflect.ValueOf(syscall.BPF_MISC),
+		"BPF_MSH":                            reflect.ValueOf(syscall.BPF_MSH),
+		"BPF_MUL":                            reflect.ValueOf(syscall.BPF_MUL),
+		"BPF_NEG":                            reflect.ValueOf(syscall.BPF_NEG),
+		"BPF_OR":                             reflect.ValueOf(syscall.BPF_OR),
+		"BPF_RELEASE":                        reflect.ValueOf(syscall.BPF_RELEASE),
+		"BPF_RET":                            reflect.ValueOf(syscall.BPF_RET),
+		"BPF_RSH":                            reflect.ValueOf(syscall.BPF_RSH),
+		"BPF_ST":                             reflect.ValueOf(syscall.BPF_ST),
+		"BPF_STX":                            reflect.ValueOf(syscall.BPF_STX),
+		"BPF_SUB":                            reflect.ValueOf(syscall.BPF_SUB),
+		"BPF_TAX":                            reflect.ValueOf(syscall.BPF_TAX),
+		"BPF_TXA":                            reflect.ValueOf(syscall.BPF_TXA),
+		"BPF_W":                              reflect.ValueOf(syscall.BPF_W),
+		"BPF_X":                              reflect.ValueOf(syscall.BPF_X),
+		"BRKINT":                             reflect.ValueOf(syscall.BRKINT),
+		"Bind":                               reflect.ValueOf(syscall.Bind),
+		"BpfBuflen":                          reflect.ValueOf(syscall.BpfBuflen),
+		"BpfDatalink":                        reflect.ValueOf(syscall.BpfDatalink),
+		"BpfHeadercmpl":                      reflect.ValueOf(syscall.BpfHeadercmpl),
+		"BpfInterface":                       reflect.ValueOf(syscall.BpfInterface),
+		"BpfJump":                            reflect.ValueOf(syscall.BpfJump),
+		"BpfStats":                           reflect.ValueOf(syscall.BpfStats),
+		"BpfStmt":                            reflect.ValueOf(syscall.BpfStmt),
+		"BpfTimeout":                         reflect.ValueOf(syscall.BpfTimeout),
+		"BytePtrFromString":                  reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":                reflect.ValueOf(syscall.ByteSliceFromString),
+		"CFLUSH":                             reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                             reflect.ValueOf(syscall.CLOCAL),
+		"CREAD":                              reflect.ValueOf(syscall.CREAD),
+		"CS5":                                reflect.ValueOf(syscall.CS5),
+		"CS6":                                reflect.ValueOf(syscall.CS6),
+		"CS7":                                reflect.ValueOf(syscall.CS7),
+		"CS8":                                reflect.ValueOf(syscall.CS8),
+		"CSIZE":                              reflect.ValueOf(syscall.CSIZE),
+		"CSTART":                             reflect.ValueOf(syscall.CSTART),
+		"CSTATUS":                            reflect.ValueOf(syscall.CSTATUS),
+		"CSTOP":                              reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                             reflect.ValueOf(syscall.CSTOPB),
+		"CSUSP":                              reflect.ValueOf(syscall.CSUSP),
+		"CTL_MAXNAME":                        reflect.ValueOf(syscall.CTL_MAXNAME),
+		"CTL_NET":                            reflect.ValueOf(syscall.CTL_NET),
+		"Chdir":                              reflect.ValueOf(syscall.Chdir),
+		"CheckBpfVersion":                    reflect.ValueOf(syscall.CheckBpfVersion),
+		"Chflags":                            reflect.ValueOf(syscall.Chflags),
+		"Chmod":                              reflect.ValueOf(syscall.Chmod),
+		"Chown":                              reflect.ValueOf(syscall.Chown),
+		"Chroot":                             reflect.ValueOf(syscall.Chroot),
+		"Clearenv":                           reflect.ValueOf(syscall.Clearenv),
+		"Close":                              reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                        reflect.ValueOf(syscall.CloseOnExec),
+		"CmsgLen":                            reflect.ValueOf(syscall.CmsgLen),
+		"CmsgSpace":                          reflect.ValueOf(syscall.CmsgSpace),
+		"Connect":                            reflect.ValueOf(syscall.Connect),
+		"DLT_APPLE_IP_OVER_IEEE1394":         reflect.ValueOf(syscall.DLT_APPLE_IP_OVER_IEEE1394),
+		"DLT_ARCNET":                         reflect.ValueOf(syscall.DLT_ARCNET),
+		"DLT_ATM_CLIP":                       reflect.ValueOf(syscall.DLT_ATM_CLIP),
+		"DLT_ATM_RFC1483":                    reflect.ValueOf(syscall.DLT_ATM_RFC1483),
+		"DLT_AX25":                           reflect.ValueOf(syscall.DLT_AX25),
+		"DLT_CHAOS":                          reflect.ValueOf(syscall.DLT_CHAOS),
+		"DLT_CHDLC":                          reflect.ValueOf(syscall.DLT_CHDLC),
+		"DLT_C_HDLC":                         reflect.ValueOf(syscall.DLT_C_HDLC),
+		"DLT_EN10MB":                         reflect.ValueOf(syscall.DLT_EN10MB),
+		"DLT_EN3MB":                          reflect.ValueOf(syscall.DLT_EN3MB),
+		"DLT_FDDI":                           reflect.ValueOf(syscall.DLT_FDDI),
+		"DLT_IEEE802":                        reflect.ValueOf(syscall.DLT_IEEE802),
+		"DLT_IEEE802_11":                     reflect.ValueOf(syscall.DLT_IEEE802_11),
+		"DLT_IEEE802_11_RADIO":               reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO),
+		"DLT_IEEE802_11_RADIO_AVS":           reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO_AVS),
+		"DLT_LINUX_SLL":                      reflect.ValueOf(syscall.DLT_LINUX_SLL),
+		"DLT_LOOP":                           reflect.ValueOf(syscall.DLT_LOOP),
+		"DLT_NULL":                           reflect.ValueOf(syscall.DLT_NULL),
+		"DLT_PFLOG":                          reflect.ValueOf(syscall.DLT_PFLOG),
+		"DLT_PFSYNC":                         reflect.ValueOf(syscall.DLT_PFSYNC),
+		"DLT_PPP":                            reflect.ValueOf(syscall.DLT_PPP),
+		"DLT_PPP_BSDOS":                      reflect.ValueOf(syscall.DLT_PPP_BSDOS),
+		"DLT_PPP_SERIAL":                     reflect.ValueOf(syscall.DLT_PPP_SERIAL),
+		"DLT_PRONET":                         reflect.ValueOf(syscall.DLT_PRONET),
+		"DLT_RAW":                            reflect.ValueOf(syscall.DLT_RAW),
+		"DLT_SLIP":                           reflect.ValueOf(syscall.DLT_SLIP),
+		"DLT_SLIP_BSDOS":                     reflect.ValueOf(syscall.DLT_SLIP_BSDOS),
+		"DT_BLK":                             reflect.ValueOf(syscall.DT_BLK),
+		"DT_CHR":                             reflect.ValueOf(syscall.DT_CHR),
+		"DT_DIR":                             reflect.ValueOf(syscall.DT_DIR),
+		"DT_FIFO":                            reflect.ValueOf(syscall.DT_FIFO),
+		"DT_LNK":                             reflect.ValueOf(syscall.DT_LNK),
+		"DT_REG":                             reflect.ValueOf(syscall.DT_REG),
+		"DT_SOCK":                            reflect.ValueOf(syscall.DT_SOCK),
+		"DT_UNKNOWN":                         reflect.ValueOf(syscall.DT_UNKNOWN),
+		"DT_WHT":                             reflect.ValueOf(syscall.DT_WHT),
+		"Dup":                                reflect.ValueOf(syscall.Dup),
+		"Dup2":                               reflect.ValueOf(syscall.Dup2),
+		"E2BIG":                              reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                             reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                         reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                      reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EAFNOSUPPORT":                       reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                             reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                           reflect.ValueOf(syscall.EALREADY),
+		"EAUTH":                              reflect.ValueOf(syscall.EAUTH),
+		"EBADARCH":                           reflect.ValueOf(syscall.EBADARCH),
+		"EBADEXEC":                           reflect.ValueOf(syscall.EBADEXEC),
+		"EBADF":                              reflect.ValueOf(syscall.EBADF),
+		"EBADMACHO":                          reflect.ValueOf(syscall.EBADMACHO),
+		"EBADMSG":                            reflect.ValueOf(syscall.EBADMSG),
+		"EBADRPC":                            reflect.ValueOf(syscall.EBADRPC),
+		"EBUSY":                              reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                          reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                             reflect.ValueOf(syscall.ECHILD),
+		"ECHO":                               reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                            reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                              reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                              reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                             reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                             reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                            reflect.ValueOf(syscall.ECHOPRT),
+		"ECONNABORTED":                       reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                       reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                         reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                            reflect.ValueOf(syscall.EDEADLK),
+		"EDESTADDRREQ":                       reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDEVERR":                            reflect.ValueOf(syscall.EDEVERR),
+		"EDOM":                               reflect.ValueOf(syscall.EDOM),
+		"EDQUOT":                             reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                             reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                             reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                              reflect.ValueOf(syscall.EFBIG),
+		"EFTYPE":                             reflect.ValueOf(syscall.EFTYPE),
+		"EHOSTDOWN":                          reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                       reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                              reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                             reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                        reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                              reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                             reflect.ValueOf(syscall.EINVAL),
+		"EIO":                                reflect.ValueOf(syscall.EIO),
+		"EISCONN":                            reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                             reflect.ValueOf(syscall.EISDIR),
+		"ELAST":                              reflect.ValueOf(syscall.ELAST),
+		"ELOOP":                              reflect.ValueOf(syscall.ELOOP),
+		"EMFILE":                             reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                             reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                           reflect.ValueOf(syscall.EMSGSIZE),
+		"EMULTIHOP":                          reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                       reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENEEDAUTH":                          reflect.ValueOf(syscall.ENEEDAUTH),
+		"ENETDOWN":                           reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                          reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                        reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                             reflect.ValueOf(syscall.ENFILE),
+		"ENOATTR":                            reflect.ValueOf(syscall.ENOATTR),
+		"ENOBUFS":                            reflect.ValueOf(syscall.ENOBUFS),
+		"ENODATA":                            reflect.ValueOf(syscall.ENODATA),
+		"ENODEV":                             reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                             reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                            reflect.ValueOf(syscall.ENOEXEC),
+		"ENOLCK":                             reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                            reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEM":                             reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                             reflect.ValueOf(syscall.ENOMSG),
+		"ENOPOLICY":                          reflect.ValueOf(syscall.ENOPOLICY),
+		"ENOPROTOOPT":                        reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                             reflect.ValueOf(syscall.ENOSPC),
+		"ENOSR":                              reflect.ValueOf(syscall.ENOSR),
+		"ENOSTR":                             reflect.ValueOf(syscall.ENOSTR),
+		"ENOSYS":                             reflect.ValueOf(syscall.ENOSYS),
+		"ENOTBLK":                            reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCONN":                           reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                            reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                          reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTRECOVERABLE":                    reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                           reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                            reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                             reflect.ValueOf(syscall.ENOTTY),
+		"ENXIO":                              reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                         reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                          reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                         reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                              reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                       reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                              reflect.ValueOf(syscall.EPIPE),
+		"EPROCLIM":                           reflect.ValueOf(syscall.EPROCLIM),
+		"EPROCUNAVAIL":                       reflect.ValueOf(syscall.EPROCUNAVAIL),
+		"EPROGMISMATCH":                      reflect.ValueOf(syscall.EPROGMISMATCH),
+		"EPROGUNAVAIL":                       reflect.ValueOf(syscall.EPROGUNAVAIL),
+		"EPROTO":                             reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":                    reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                         reflect.ValueOf(syscall.EPROTOTYPE),
+		"EPWROFF":                            reflect.ValueOf(syscall.EPWROFF),
+		"EQFULL":                             reflect.ValueOf(syscall.EQFULL),
+		"ERANGE":                             reflect.ValueOf(syscall.ERANGE),
+		"EREMOTE":                            reflect.ValueOf(syscall.EREMOTE),
+		"EROFS":                              reflect.ValueOf(syscall.EROFS),
+		"ERPCMISMATCH":                       reflect.ValueOf(syscall.ERPCMISMATCH),
+		"ESHLIBVERS":                         reflect.ValueOf(syscall.ESHLIBVERS),
+		"ESHUTDOWN":                          reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":                    reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESPIPE":                             reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                              reflect.ValueOf(syscall.ESRCH),
+		"ESTALE":                             reflect.ValueOf(syscall.ESTALE),
+		"ETIME":                              reflect.ValueOf(syscall.ETIME),
+		"ETIMEDOUT":                          reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                       reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                            reflect.ValueOf(syscall.ETXTBSY),
+		"EUSERS":                             reflect.ValueOf(syscall.EUSERS),
+		"EVFILT_AIO":                         reflect.ValueOf(syscall.EVFILT_AIO),
+		"EVFILT_FS":                          reflect.ValueOf(syscall.EVFILT_FS),
+		"EVFILT_MACHPORT":                    reflect.ValueOf(syscall.EVFILT_MACHPORT),
+		"EVFILT_PROC":                        reflect.ValueOf(syscall.EVFILT_PROC),
+		"EVFILT_READ":                        reflect.ValueOf(syscall.EVFILT_READ),
+		"EVFILT_SIGNAL":                      reflect.ValueOf(syscall.EVFILT_SIGNAL),
+		"EVFILT_SYSCOUNT":                    reflect.ValueOf(syscall.EVFILT_SYSCOUNT),
+		"EVFILT_THREADMARKER":                reflect.ValueOf(syscall.EVFILT_THREADMARKER),
+		"EVFILT_TIMER":                       reflect.ValueOf(syscall.EVFILT_TIMER),
+		"EVFILT_USER":                        reflect.ValueOf(syscall.EVFILT_USER),
+		"EVFILT_VM":                          reflect.ValueOf(syscall.EVFILT_VM),
+		"EVFILT_VNODE":                       reflect.ValueOf(syscall.EVFILT_VNODE),
+		"EVFILT_WRITE":                       reflect.ValueOf(syscall.EVFILT_WRITE),
+		"EV_ADD":                             reflect.ValueOf(syscall.EV_ADD),
+		"EV_CLEAR":                           reflect.ValueOf(syscall.EV_CLEAR),
+		"EV_DELETE":                          reflect.ValueOf(syscall.EV_DELETE),
+		"EV_DISABLE":                         reflect.ValueOf(syscall.EV_DISABLE),
+		"EV_DISPATCH":                        reflect.ValueOf(syscall.EV_DISPATCH),
+		"EV_ENABLE":                          reflect.ValueOf(syscall.EV_ENABLE),
+		"EV_EOF":                             reflect.ValueOf(syscall.EV_EOF),
+		"EV_ERROR":                           reflect.ValueOf(syscall.EV_ERROR),
+		"EV_FLAG0":                           reflect.ValueOf(syscall.EV_FLAG0),
+		"EV_FLAG1":                           reflect.ValueOf(syscall.EV_FLAG1),
+		"EV_ONESHOT":                         reflect.ValueOf(syscall.EV_ONESHOT),
+		"EV_OOBAND":                          reflect.ValueOf(syscall.EV_OOBAND),
+		"EV_POLL":                            reflect.ValueOf(syscall.EV_POLL),
+		"EV_RECEIPT":                         reflect.ValueOf(syscall.EV_RECEIPT),
+		"EV_SYSFLAGS":                        reflect.ValueOf(syscall.EV_SYSFLAGS),
+		"EWOULDBLOCK":                        reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EXDEV":                              reflect.ValueOf(syscall.EXDEV),
+		"EXTA":                               reflect.ValueOf(syscall.EXTA),
+		"EXTB":                               reflect.ValueOf(syscall.EXTB),
+		"EXTPROC":                            reflect.ValueOf(syscall.EXTPROC),
+		"Environ":                            reflect.ValueOf(syscall.Environ),
+		"Exchangedata":                       reflect.ValueOf(syscall.Exchangedata),
+		"Exec":                               reflect.ValueOf(syscall.Exec),
+		"Exit":                               reflect.ValueOf(syscall.Exit),
+		"FD_CLOEXEC":                         reflect.ValueOf(syscall.FD_CLOEXEC),
+		"FD_SETSIZE":                         reflect.ValueOf(syscall.FD_SETSIZE),
+		"FLUSHO":                             reflect.ValueOf(syscall.FLUSHO),
+		"F_ADDFILESIGS":                      reflect.ValueOf(syscall.F_ADDFILESIGS),
+		"F_ADDSIGS":                          reflect.ValueOf(syscall.F_ADDSIGS),
+		"F_ALLOCATEALL":                      reflect.ValueOf(syscall.F_ALLOCATEALL),
+		"F_ALLOCATECONTIG":                   reflect.ValueOf(syscall.F_ALLOCATECONTIG),
+		"F_CHKCLEAN":                         reflect.ValueOf(syscall.F_CHKCLEAN),
+		"F_DUPFD":                            reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":                    reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_FINDSIGS":                         reflect.ValueOf(syscall.F_FINDSIGS),
+		"F_FLUSH_DATA":                       reflect.ValueOf(syscall.F_FLUSH_DATA),
+		"F_FREEZE_FS":                        reflect.ValueOf(syscall.F_FREEZE_FS),
+		"F_FULLFSYNC":                        reflect.ValueOf(syscall.F_FULLFSYNC),
+		"F_GETCODEDIR":                       reflect.ValueOf(syscall.F_GETCODEDIR),
+		"F_GETFD":                            reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                            reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLK":                            reflect.ValueOf(syscall.F_GETLK),
+		"F_GETLKPID":                         reflect.ValueOf(syscall.F_GETLKPID),
+		"F_GETNOSIGPIPE":                     reflect.ValueOf(syscall.F_GETNOSIGPIPE),
+		"F_GETOWN":                           reflect.ValueOf(syscall.F_GETOWN),
+		"F_GETPATH":                          reflect.ValueOf(syscall.F_GETPATH),
+		"F_GETPATH_MTMINFO":                  reflect.ValueOf(syscall.F_GETPATH_MTMINFO),
+		"F_GETPROTECTIONCLASS":               reflect.ValueOf(syscall.F_GETPROTECTIONCLASS),
+		"F_GETPROTECTIONLEVEL":               reflect.ValueOf(syscall.F_GETPROTECTIONLEVEL),
+		"F_GLOBAL_NOCACHE":                   reflect.ValueOf(syscall.F_GLOBAL_NOCACHE),
+		"F_LOG2PHYS":                         reflect.ValueOf(syscall.F_LOG2PHYS),
+		"F_LOG2PHYS_EXT":                     reflect.ValueOf(syscall.F_LOG2PHYS_EXT),
+		"F_NOCACHE":                          reflect.ValueOf(syscall.F_NOCACHE),
+		"F_NODIRECT":                         reflect.ValueOf(syscall.F_NODIRECT),
+		"F_OK":                               reflect.ValueOf(syscall.F_OK),
+		"F_PATHPKG_CHECK":                    reflect.ValueOf(syscall.F_PATHPKG_CHECK),
+		"F_PEOFPOSMODE":                      reflect.ValueOf(syscall.F_PEOFPOSMODE),
+		"F_PREALLOCATE":                      reflect.ValueOf(syscall.F_PREALLOCATE),
+		"F_RDADVISE":                         reflect.ValueOf(syscall.F_RDADVISE),
+		"F_RDAHEAD":                          reflect.ValueOf(syscall.F_RDAHEAD),
+		"F_RDLCK":                            reflect.ValueOf(syscall.F_RDLCK),
+		"F_SETBACKINGSTORE":                  reflect.ValueOf(syscall.F_SETBACKINGSTORE),
+		"F_SETFD":                            reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                            reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLK":                            reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLKW":                           reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETLKWTIMEOUT":                    reflect.ValueOf(syscall.F_SETLKWTIMEOUT),
+		"F_SETNOSIGPIPE":                     reflect.ValueOf(syscall.F_SETNOSIGPIPE),
+		"F_SETOWN":                           reflect.ValueOf(syscall.F_SETOWN),
+		"F_SETPROTECTIONCLASS":               reflect.ValueOf(syscall.F_SETPROTECTIONCLASS),
+		"F_SETSIZE":                          reflect.ValueOf(syscall.F_SETSIZE),
+		"F_SINGLE_WRITER":                    reflect.ValueOf(syscall.F_SINGLE_WRITER),
+		"F_THAW_FS":                          reflect.ValueOf(syscall.F_THAW_FS),
+		"F_TRANSCODEKEY":                     reflect.ValueOf(syscall.F_TRANSCODEKEY),
+		"F_UNLCK":                            reflect.ValueOf(syscall.F_UNLCK),
+		"F_VOLPOSMODE":                       reflect.ValueOf(syscall.F_VOLPOSMODE),
+		"F_WRLCK":                            reflect.ValueOf(syscall.F_WRLCK),
+		"Fchdir":                             reflect.ValueOf(syscall.Fchdir),
+		"Fchflags":                           reflect.ValueOf(syscall.Fchflags),
+		"Fchmod":                             reflect.ValueOf(syscall.Fchmod),
+		"Fchown":                             reflect.ValueOf(syscall.Fchown),
+		"FcntlFlock":                         reflect.ValueOf(syscall.FcntlFlock),
+		"Flock":                              reflect.ValueOf(syscall.Flock),
+		"FlushBpf":                           reflect.ValueOf(syscall.FlushBpf),
+		"ForkExec":                           reflect.ValueOf(syscall.ForkExec),
+		"ForkLock":                           reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"Fpathconf":                          reflect.ValueOf(syscall.Fpathconf),
+		"Fstat":                              reflect.ValueOf(syscall.Fstat),
+		"Fstatfs":                            reflect.ValueOf(syscall.Fstatfs),
+		"Fsync":                              reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                          reflect.ValueOf(syscall.Ftruncate),
+		"Futimes":                            reflect.ValueOf(syscall.Futimes),
+		"Getdirentries":                      reflect.ValueOf(syscall.Getdirentries),
+		"Getdtablesize":                      reflect.ValueOf(syscall.Getdtablesize),
+		"Getegid":                            reflect.ValueOf(syscall.Getegid),
+		"Getenv":                             reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                            reflect.ValueOf(syscall.Geteuid),
+		"Getfsstat":                          reflect.ValueOf(syscall.Getfsstat),
+		"Getgid":                             reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                          reflect.ValueOf(syscall.Getgroups),
+		"Getpagesize":                        reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                        reflect.ValueOf(syscall.Getpeername),
+		"Getpgid":                            reflect.ValueOf(syscall.Getpgid),
+		"Getpgrp":                            reflect.ValueOf(syscall.Getpgrp),
+		"Getpid":                             reflect.ValueOf(syscall.Getpid),
+		"Getppid":                            reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                        reflect.ValueOf(syscall.Getpriority),
+		"Getrlimit":                          reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                          reflect.ValueOf(syscall.Getrusage),
+		"Getsid":                             reflect.ValueOf(syscall.Getsid),
+		"Getsockname":                        reflect.ValueOf(syscall.Getsockname),
+		"GetsockoptByte":                     reflect.ValueOf(syscall.GetsockoptByte),
+		"GetsockoptICMPv6Filter":             reflect.ValueOf(syscall.GetsockoptICMPv6Filter),
+		"GetsockoptIPMreq":                   reflect.ValueOf(syscall.GetsockoptIPMreq),
+		"GetsockoptIPv6MTUInfo":              reflect.ValueOf(syscall.GetsockoptIPv6MTUInfo),
+		"GetsockoptIPv6Mreq":                 reflect.ValueOf(syscall.GetsockoptIPv6Mreq),
+		"GetsockoptInet4Addr":                reflect.ValueOf(syscall.GetsockoptInet4Addr),
+		"GetsockoptInt":                      reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                       reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                             reflect.ValueOf(syscall.Getuid),
+		"Getwd":                              reflect.ValueOf(syscall.Getwd),
+		"HUPCL":                              reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                             reflect.ValueOf(syscall.ICANON),
+		"ICMP6_FILTER":                       reflect.ValueOf(syscall.ICMP6_FILTER),
+		"ICRNL":                              reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                             reflect.ValueOf(syscall.IEXTEN),
+		"IFF_ALLMULTI":                       reflect.ValueOf(syscall.IFF_ALLMULTI),
+		"IFF_ALTPHYS":                        reflect.ValueOf(syscall.IFF_ALTPHYS),
+		"IFF_BROADCAST":                      reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_DEBUG":                          reflect.ValueOf(syscall.IFF_DEBUG),
+		"IFF_LINK0":                          reflect.ValueOf(syscall.IFF_LINK0),
+		"IFF_LINK1":                          reflect.ValueOf(syscall.IFF_LINK1),
+		"IFF_LINK2":                          reflect.ValueOf(syscall.IFF_LINK2),
+		"IFF_LOOPBACK":                       reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_MULTICAST":                      reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_NOARP":                          reflect.ValueOf(syscall.IFF_NOARP),
+		"IFF_NOTRAILERS":                     reflect.ValueOf(syscall.IFF_NOTRAILERS),
+		"IFF_OACTIVE":                        reflect.ValueOf(syscall.IFF_OACTIVE),
+		"IFF_POINTOPOINT":                    reflect.ValueOf(syscall.IFF_POINTOPOINT),
+		"IFF_PROMISC":                        reflect.ValueOf(syscall.IFF_PROMISC),
+		"IFF_RUNNING":                        reflect.ValueOf(syscall.IFF_RUNNING),
+		"IFF_SIMPLEX":                        reflect.ValueOf(syscall.IFF_SIMPLEX),
+		"IFF_UP":                             reflect.ValueOf(syscall.IFF_UP),
+		"IFNAMSIZ":                           reflect.ValueOf(syscall.IFNAMSIZ),
+		"IFT_1822":                           reflect.ValueOf(syscall.IFT_1822),
+		"IFT_AAL5":                           reflect.ValueOf(syscall.IFT_AAL5),
+		"IFT_ARCNET":                         reflect.ValueOf(syscall.IFT_ARCNET),
+		"IFT_ARCNETPLUS":                     reflect.ValueOf(syscall.IFT_ARCNETPLUS),
+		"IFT_ATM":                            reflect.ValueOf(syscall.IFT_ATM),
+		"IFT_BRIDGE":                         reflect.ValueOf(syscall.IFT_BRIDGE),
+		"IFT_CARP":                           reflect.ValueOf(syscall.IFT_CARP),
+		"IFT_CELLULAR":                       reflect.ValueOf(syscall.IFT_CELLULAR),
+		"IFT_CEPT":                           reflect.ValueOf(syscall.IFT_CEPT),
+		"IFT_DS3":                            reflect.ValueOf(syscall.IFT_DS3),
+		"IFT_ENC":                            reflect.ValueOf(syscall.IFT_ENC),
+		"IFT_EON":                            reflect.ValueOf(syscall.IFT_EON),
+		"IFT_ETHER":                          reflect.ValueOf(syscall.IFT_ETHER),
+		"IFT_FAITH":                          reflect.ValueOf(syscall.IFT_FAITH),
+		"IFT_FDDI":                           reflect.ValueOf(syscall.IFT_FDDI),
+		"IFT_FRELAY":                         reflect.ValueOf(syscall.IFT_FRELAY),
+		"IFT_FRELAYDCE":                      reflect.ValueOf(syscall.IFT_FRELAYDCE),
+		"IFT_GIF":                            reflect.ValueOf(syscall.IFT_GIF),
+		"IFT_HDH1822":                        reflect.ValueOf(syscall.IFT_HDH1822),
+		"IFT_HIPPI":                          reflect.ValueOf(syscall.IFT_HIPPI),
+		"IFT_HSSI":                           reflect.ValueOf(syscall.IFT_HSSI),
+		"IFT_HY":                             reflect.ValueOf(syscall.IFT_HY),
+		"IFT_IEEE1394":                       reflect.ValueOf(syscall.IFT_IEEE1394),
+		"IFT_IEEE8023ADLAG":                  reflect.ValueOf(syscall.IFT_IEEE8023ADLAG),
+		"IFT_ISDNBASIC":                      reflect.ValueOf(syscall.IFT_ISDNBASIC),
+		"IFT_ISDNPRIMARY":                    reflect.ValueOf(syscall.IFT_ISDNPRIMARY),
+		"IFT_ISO88022LLC":                    reflect.ValueOf(syscall.IFT_ISO88022LLC),
+		"IFT_ISO88023":                       reflect.ValueOf(syscall.IFT_ISO88023),
+		"IFT_ISO88024":                       reflect.ValueOf(syscall.IFT_ISO88024),
+		"IFT_ISO88025":                       reflect.ValueOf(syscall.IFT_ISO88025),
+		"IFT_ISO88026":                       reflect.ValueOf(syscall.IFT_ISO88026),
+		"IFT_L2VLAN":                         reflect.ValueOf(syscall.IFT_L2VLAN),
+		"IFT_LAPB":                           reflect.ValueOf(syscall.IFT_LAPB),
+		"IFT_LOCALTALK":                      reflect.ValueOf(syscall.IFT_LOCALTALK),
+		"IFT_LOOP":                           reflect.ValueOf(syscall.IFT_LOOP),
+		"IFT_MIOX25":                         reflect.ValueOf(syscall.IFT_MIOX25),
+		"IFT_MODEM":                          reflect.ValueOf(syscall.IFT_MODEM),
+		"IFT_NSIP":                           reflect.ValueOf(syscall.IFT_NSIP),
+		"IFT_OTHER":                          reflect.ValueOf(syscall.IFT_OTHER),
+		"IFT_P10":                            reflect.ValueOf(syscall.IFT_P10),
+		"IFT_P80":                            reflect.ValueOf(syscall.IFT_P80),
+		"IFT_PARA":                           reflect.ValueOf(syscall.IFT_PARA),
+		"IFT_PDP":                            reflect.ValueOf(syscall.IFT_PDP),
+		"IFT_PFLOG":                          reflect.ValueOf(syscall.IFT_PFLOG),
+		"IFT_PFSYNC":                         reflect.ValueOf(syscall.IFT_PFSYNC),
+		"IFT_PPP":                            reflect.ValueOf(syscall.IFT_PPP),
+		"IFT_PROPMUX":                        reflect.ValueOf(syscall.IFT_PROPMUX),
+		"IFT_PROPVIRTUAL":                    reflect.ValueOf(syscall.IFT_PROPVIRTUAL),
+		"IFT_PTPSERIAL":                      reflect.ValueOf(syscall.IFT_PTPSERIAL),
+		"IFT_RS232":                          reflect.ValueOf(syscall.IFT_RS232),
+		"IFT_SDLC":                           reflect.ValueOf(syscall.IFT_SDLC),
+		"IFT_SIP":                            reflect.ValueOf(syscall.IFT_SIP),
+		"IFT_SLIP":                           reflect.ValueOf(syscall.IFT_SLIP),
+		"IFT_SMDSDXI":                        reflect.ValueOf(syscall.IFT_SMDSDXI),
+		"IFT_SMDSICIP":                       reflect.ValueOf(syscall.IFT_SMDSICIP),
+		"IFT_SONET":                          reflect.ValueOf(syscall.IFT_SONET),
+		"IFT_SONETPATH":                      reflect.ValueOf(syscall.IFT_SONETPATH),
+		"IFT_SONETVT":                        reflect.ValueOf(syscall.IFT_SONETVT),
+		"IFT_STARLAN":                        reflect.ValueOf(syscall.IFT_STARLAN),
+		"IFT_STF":                            reflect.ValueOf(syscall.IFT_STF),
+		"IFT_T1":                             reflect.ValueOf(syscall.IFT_T1),
+		"IFT_ULTRA":                          reflect.ValueOf(syscall.IFT_ULTRA),
+		"IFT_V35":                            reflect.ValueOf(syscall.IFT_V35),
+		"IFT_X25":                            reflect.ValueOf(syscall.IFT_X25),
+		"IFT_X25DDN":                         reflect.ValueOf(syscall.IFT_X25DDN),
+		"IFT_X25PLE":                         reflect.ValueOf(syscall.IFT_X25PLE),
+		"IFT_XETHER":                         reflect.ValueOf(syscall.IFT_XETHER),
+		"IGNBRK":                             reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                              reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                             reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                            reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                              reflect.ValueOf(syscall.INLCR),
+		"INPCK":                              reflect.ValueOf(syscall.INPCK),
+		"IN_CLASSA_HOST":                     reflect.ValueOf(syscall.IN_CLASSA_HOST),
+		"IN_CLASSA_MAX":                      reflect.ValueOf(syscall.IN_CLASSA_MAX),
+		"IN_CLASSA_NET":                      reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
+		"IN_CLASSA_NSHIFT":                   reflect.ValueOf(syscall.IN_CLASSA_NSHIFT),
+		"IN_CLASSB_HOST":                     reflect.ValueOf(syscall.IN_CLASSB_HOST),
+		"IN_CLASSB_MAX":                      reflect.ValueOf(syscall.IN_CLASSB_MAX),
+		"IN_CLASSB_NET":                      reflect.ValueOf(uint32(syscall.IN_CLASSB_NET)),
+		"IN_CLASSB_NSHIFT":                   reflect.ValueOf(syscall.IN_CLASSB_NSHIFT),
+		"IN_CLASSC_HOST":                     reflect.ValueOf(syscall.IN_CLASSC_HOST),
+		"IN_CLASSC_NET":                      reflect.ValueOf(uint32(syscall.IN_CLASSC_NET)),
+		"IN_CLASSC_NSHIFT":                   reflect.ValueOf(syscall.IN_CLASSC_NSHIFT),
+		"IN_CLASSD_HOST":                     reflect.ValueOf(syscall.IN_CLASSD_HOST),
+		"IN_CLASSD_NET":                      reflect.ValueOf(uint32(syscall.IN_CLASSD_NET)),
+		"IN_CLASSD_NSHIFT":                   reflect.ValueOf(syscall.IN_CLASSD_NSHIFT),
+		"IN_LINKLOCALNETNUM":                 reflect.ValueOf(uint32(syscall.IN_LINKLOCALNETNUM)),
+		"IN_LOOPBACKNET":                     reflect.ValueOf(syscall.IN_LOOPBACKNET),
+		"IPPROTO_3PC":                        reflect.ValueOf(syscall.IPPROTO_3PC),
+		"IPPROTO_ADFS":                       reflect.ValueOf(syscall.IPPROTO_ADFS),
+		"IPPROTO_AH":                         reflect.ValueOf(syscall.IPPROTO_AH),
+		"IPPROTO_AHIP":                       reflect.ValueOf(syscall.IPPROTO_AHIP),
+		"IPPROTO_APES":                       reflect.ValueOf(syscall.IPPROTO_APES),
+		"IPPROTO_ARGUS":                      reflect.ValueOf(syscall.IPPROTO_ARGUS),
+		"IPPROTO_AX25":                       reflect.ValueOf(syscall.IPPROTO_AX25),
+		"IPPROTO_BHA":                        reflect.ValueOf(syscall.IPPROTO_BHA),
+		"IPPROTO_BLT":                        reflect.ValueOf(syscall.IPPROTO_BLT),
+		"IPPROTO_BRSATMON":                   reflect.ValueOf(syscall.IPPROTO_BRSATMON),
+		"IPPROTO_CFTP":                       reflect.ValueOf(syscall.IPPROTO_CFTP),
+		"IPPROTO_CHAOS":                      reflect.ValueOf(syscall.IPPROTO_CHAOS),
+		"IPPROTO_CMTP":                       reflect.ValueOf(syscall.IPPROTO_CMTP),
+		"IPPROTO_CPHB":                       reflect.ValueOf(syscall.IPPROTO_CPHB),
+		"IPPROTO_CPNX":                       reflect.ValueOf(syscall.IPPROTO_CPNX),
+		"IPPROTO_DDP":                        reflect.ValueOf(syscall.IPPROTO_DDP),
+		"IPPROTO_DGP":                        reflect.ValueOf(syscall.IPPROTO_DGP),
+		"IPPROTO_DIVERT":                     reflect.ValueOf(syscall.IPPROTO_DIVERT),
+		"IPPROTO_DONE":                       reflect.ValueOf(syscall.IPPROTO_DONE),
+		"IPPROTO_DSTOPTS":                    reflect.ValueOf(syscall.IPPROTO_DSTOPTS),
+		"IPPROTO_EGP":                        reflect.ValueOf(syscall.IPPROTO_EGP),
+		"IPPROTO_EMCON":                      reflect.ValueOf(syscall.IPPROTO_EMCON),
+		"IPPROTO_ENCAP":                      reflect.ValueOf(syscall.IPPROTO_ENCAP),
+		"IPPROTO_EON":                        reflect.ValueOf(syscall.IPPROTO_EON),
+		"IPPROTO_ESP":                        reflect.ValueOf(syscall.IPPROTO_ESP),
+		"IPPROTO_ETHERIP":                    reflect.ValueOf(syscall.IPPROTO_ETHERIP),
+		"IPPROTO_FRAGMENT":                   reflect.ValueOf(syscall.IPPROTO_FRAGMENT),
+		"IPPROTO_GGP":                        reflect.ValueOf(syscall.IPPROTO_GGP),
+		"IPPROTO_GMTP":                       reflect.ValueOf(syscall.IPPROTO_GMTP),
+		"IPPROTO_GRE":                        reflect.ValueOf(syscall.IPPROTO_GRE),
+		"IPPROTO_HELLO":                      reflect.ValueOf(syscall.IPPROTO_HELLO),
+		"IPPROTO_HMP":                        reflect.ValueOf(syscall.IPPROTO_HMP),
+		"IPPROTO_HOPOPTS":                    reflect.ValueOf(syscall.IPPROTO_HOPOPTS),
+		"IPPROTO_ICMP":                       reflect.ValueOf(syscall.IPPROTO_ICMP),
+		"IPPROTO_ICMPV6":                     reflect.ValueOf(syscall.IPPROTO_ICMPV6),
+		"IPPROTO_IDP":                        reflect.ValueOf(syscall.IPPROTO_IDP),
+		"IPPROTO_IDPR":                       reflect.ValueOf(syscall.IPPROTO_IDPR),
+		"IPPROTO_IDRP":                       reflect.ValueOf(syscall.IPPROTO_IDRP),
+		"IPPROTO_IGMP":                       reflect.ValueOf(syscall.IPPROTO_IGMP),
+		"IPPROTO_IGP":                        reflect.ValueOf(syscall.IPPROTO_IGP),
+		"IPPROTO_IGRP":                       reflect.ValueOf(syscall.IPPROTO_IGRP),
+		"IPPROTO_IL":                         reflect.ValueOf(syscall.IPPROTO_IL),
+		"IPPROTO_INLSP":                      reflect.ValueOf(syscall.IPPROTO_INLSP),
+		"IPPROTO_INP":                        reflect.ValueOf(syscall.IPPROTO_INP),
+		"IPPROTO_IP":                         reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPCOMP":                     reflect.ValueOf(syscall.IPPROTO_IPCOMP),
+		"IPPROTO_IPCV":                       reflect.ValueOf(syscall.IPPROTO_IPCV),
+		"IPPROTO_IPEIP":                      reflect.ValueOf(syscall.IPPROTO_IPEIP),
+		"IPPROTO_IPIP":                       reflect.ValueOf(syscall.IPPROTO_IPIP),
+		"IPPROTO_IPPC":                       reflect.ValueOf(syscall.IPPROTO_IPPC),
+		"IPPROTO_IPV4":                       reflect.ValueOf(syscall.IPPROTO_IPV4),
+		"IPPROTO_IPV6":                       reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_IRTP":                       reflect.ValueOf(syscall.IPPROTO_IRTP),
+		"IPPROTO_KRYPTOLAN":                  reflect.ValueOf(syscall.IPPROTO_KRYPTOLAN),
+		"IPPROTO_LARP":                       reflect.ValueOf(syscall.IPPROTO_LARP),
+		"IPPROTO_LEAF1":                      reflect.ValueOf(syscall.IPPROTO_LEAF1),
+		"IPPROTO_LEAF2":                      reflect.ValueOf(syscall.IPPROTO_LEAF2),
+		"IPPROTO_MAX":                        reflect.ValueOf(syscall.IPPROTO_MAX),
+		"IPPROTO_MAXID":                      reflect.ValueOf(syscall.IPPROTO_MAXID),
+		"IPPROTO_MEAS":                       reflect.ValueOf(syscall.IPPROTO_MEAS),
+		"IPPROTO_MHRP":                       reflect.ValueOf(syscall.IPPROTO_MHRP),
+		"IPPROTO_MICP":                       reflect.ValueOf(syscall.IPPROTO_MICP),
+		"IPPROTO_MTP":                        reflect.ValueOf(syscall.IPPROTO_MTP),
+		"IPPROTO_MUX":                        reflect.ValueOf(syscall.IPPROTO_MUX),
+		"IPPROTO_ND":                         reflect.ValueOf(syscall.IPPROTO_ND),
+		"IPPROTO_NHRP":                       reflect.ValueOf(syscall.IPPROTO_NHRP),
+		"IPPROTO_NONE":                       reflect.ValueOf(syscall.IPPROTO_NONE),
+		"IPPROTO_NSP":                        reflect.ValueOf(syscall.IPPROTO_NSP),
+		"IPPROTO_NVPII":                      reflect.ValueOf(syscall.IPPROTO_NVPII),
+		"IPPROTO_OSPFIGP":                    reflect.ValueOf(syscall.IPPROTO_OSPFIGP),
+		"IPPROTO_PGM":                        reflect.ValueOf(syscall.IPPROTO_PGM),
+		"IPPROTO_PIGP":                       reflect.ValueOf(syscall.IPPROTO_PIGP),
+		"IPPROTO_PIM":                        reflect.ValueOf(syscall.IPPROTO_PIM),
+		"IPPROTO_PRM":                        reflect.ValueOf(syscall.IPPROTO_PRM),
+		"IPPROTO_PUP":                        reflect.ValueOf(syscall.IPPROTO_PUP),
+		"IPPROTO_PVP":                        reflect.ValueOf(syscall.IPPROTO_PVP),
+		"IPPROTO_RAW":                        reflect.ValueOf(syscall.IPPROTO_RAW),
+		"IPPROTO_RCCMON":                     reflect.ValueOf(syscall.IPPROTO_RCCMON),
+		"IPPROTO_RDP":                        reflect.ValueOf(syscall.IPPROTO_RDP),
+		"IPPROTO_ROUTING":                    reflect.ValueOf(syscall.IPPROTO_ROUTING),
+		"IPPROTO_RSVP":                       reflect.ValueOf(syscall.IPPROTO_RSVP),
+		"IPPROTO_RVD":                        reflect.ValueOf(syscall.IPPROTO_RVD),
+		"IPPROTO_SATEXPAK":                   reflect.ValueOf(syscall.IPPROTO_SATEXPAK),
+		"IPPROTO_SATMON":                     reflect.ValueOf(syscall.IPPROTO_SATMON),
+		"IPPROTO_SCCSP":                      reflect.ValueOf(syscall.IPPROTO_SCCSP),
+		"IPPROTO_SCTP":                       reflect.ValueOf(syscall.IPPROTO_SCTP),
+		"IPPROTO_SDRP":                       reflect.ValueOf(syscall.IPPROTO_SDRP),
+		"IPPROTO_SEP":                        reflect.ValueOf(syscall.IPPROTO_SEP),
+		"IPPROTO_SRPC":                       reflect.ValueOf(syscall.IPPROTO_SRPC),
+		"IPPROTO_ST":                         reflect.ValueOf(syscall.IPPROTO_ST),
+		"IPPROTO_SVMTP":                      reflect.ValueOf(syscall.IPPROTO_SVMTP),
+		"IPPROTO_SWIPE":                      reflect.ValueOf(syscall.IPPROTO_SWIPE),
+		"IPPROTO_TCF":                        reflect.ValueOf(syscall.IPPROTO_TCF),
+		"IPPROTO_TCP":                        reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_TP":                         reflect.ValueOf(syscall.IPPROTO_TP),
+		"IPPROTO_TPXX":                       reflect.ValueOf(syscall.IPPROTO_TPXX),
+		"IPPROTO_TRUNK1":                     reflect.ValueOf(syscall.IPPROTO_TRUNK1),
+		"IPPROTO_TRUNK2":                     reflect.ValueOf(syscall.IPPROTO_TRUNK2),
+		"IPPROTO_TTP":                        reflect.ValueOf(syscall.IPPROTO_TTP),
+		"IPPROTO_UDP":                        reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPPROTO_VINES":                      reflect.ValueOf(syscall.IPPROTO_VINES),
+		"IPPROTO_VISA":                       reflect.ValueOf(syscall.IPPROTO_VISA),
+		"IPPROTO_VMTP":                       reflect.ValueOf(syscall.IPPROTO_VMTP),
+		"IPPROTO_WBEXPAK":                    reflect.ValueOf(syscall.IPPROTO_WBEXPAK),
+		"IPPROTO_WBMON":                      reflect.ValueOf(syscall.IPPROTO_WBMON),
+		"IPPROTO_WSN":                        reflect.ValueOf(syscall.IPPROTO_WSN),
+		"IPPROTO_XNET":                       reflect.ValueOf(syscall.IPPROTO_XNET),
+		"IPPROTO_XTP":                        reflect.ValueOf(syscall.IPPROTO_XTP),
+		"IPV6_2292DSTOPTS":                   reflect.ValueOf(syscall.IPV6_2292DSTOPTS),
+		"IPV6_2292HOPLIMIT":                  reflect.ValueOf(syscall.IPV6_2292HOPLIMIT),
+		"IPV6_2292HOPOPTS":                   reflect.ValueOf(syscall.IPV6_2292HOPOPTS),
+		"IPV6_2292NEXTHOP":                   reflect.ValueOf(syscall.IPV6_2292NEXTHOP),
+		"IPV6_2292PKTINFO":                   reflect.ValueOf(syscall.IPV6_2292PKTINFO),
+		"IPV6_2292PKTOPTIONS":                reflect.ValueOf(syscall.IPV6_2292PKTOPTIONS),
+		"IPV6_2292RTHDR":                     reflect.ValueOf(syscall.IPV6_2292RTHDR),
+		"IPV6_BINDV6ONLY":                    reflect.ValueOf(syscall.IPV6_BINDV6ONLY),
+		"IPV6_BOUND_IF":                      reflect.ValueOf(syscall.IPV6_BOUND_IF),
+		"IPV6_CHECKSUM":                      reflect.ValueOf(syscall.IPV6_CHECKSUM),
+		"IPV6_DEFAULT_MULTICAST_HOPS":        reflect.ValueOf(syscall.IPV6_DEFAULT_MULTICAST_HOPS),
+		"IPV6_DEFAULT_MULTICAST_LOOP":        reflect.ValueOf(syscall.IPV6_DEFAULT_MULTICAST_LOOP),
+		"IPV6_DEFHLIM":                       reflect.ValueOf(syscall.IPV6_DEFHLIM),
+		"IPV6_FAITH":                         reflect.ValueOf(syscall.IPV6_FAITH),
+		"IPV6_FLOWINFO_MASK":                 reflect.ValueOf(uint32(syscall.IPV6_FLOWINFO_MASK)),
+		"IPV6_FLOWLABEL_MASK":                reflect.ValueOf(uint32(syscall.IPV6_FLOWLABEL_MASK)),
+		"IPV6_FRAGTTL":                       reflect.ValueOf(syscall.IPV6_FRAGTTL),
+		"IPV6_FW_ADD":                        reflect.ValueOf(syscall.IPV6_FW_ADD),
+		"IPV6_FW_DEL":                        reflect.ValueOf(syscall.IPV6_FW_DEL),
+		"IPV6_FW_FLUSH":                      reflect.ValueOf(syscall.IPV6_FW_FLUSH),
+		"IPV6_FW_GET":                        reflect.ValueOf(syscall.IPV6_FW_GET),
+		"IPV6_FW_ZERO":                       reflect.ValueOf(syscall.IPV6_FW_ZERO),
+		"IPV6_HLIMDEC":                       reflect.ValueOf(syscall.IPV6_HLIMDEC),
+		"IPV6_IPSEC_POLICY":                  reflect.ValueOf(syscall.IPV6_IPSEC_POLICY),
+		"IPV6_JOIN_GROUP":                    reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_GROUP":                   reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MAXHLIM":                       reflect.ValueOf(syscall.IPV6_MAXHLIM),
+		"IPV6_MAXOPTHDR":                     reflect.ValueOf(syscall.IPV6_MAXOPTHDR),
+		"IPV6_MAXPACKET":                     reflect.ValueOf(syscall.IPV6_MAXPACKET),
+		"IPV6_MAX_GROUP_SRC_FILTER":          reflect.ValueOf(syscall.IPV6_MAX_GROUP_SRC_FILTER),
+		"IPV6_MAX_MEMBERSHIPS":               reflect.ValueOf(syscall.IPV6_MAX_MEMBERSHIPS),
+		"IPV6_MAX_SOCK_SRC_FILTER":           reflect.ValueOf(syscall.IPV6_MAX_SOCK_SRC_FILTER),
+		"IPV6_MIN_MEMBERSHIPS":               reflect.ValueOf(syscall.IPV6_MIN_MEMBERSHIPS),
+		"IPV6_MMTU":                          reflect.ValueOf(syscall.IPV6_MMTU),
+		"IPV6_MULTICAST_HOPS":                reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":                  reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":                reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_PORTRANGE":                     reflect.ValueOf(syscall.IPV6_PORTRANGE),
+		"IPV6_PORTRANGE_DEFAULT":             reflect.ValueOf(syscall.IPV6_PORTRANGE_DEFAULT),
+		"IPV6_PORTRANGE_HIGH":                reflect.ValueOf(syscall.IPV6_PORTRANGE_HIGH),
+		"IPV6_PORTRANGE_LOW":                 reflect.ValueOf(syscall.IPV6_PORTRANGE_LOW),
+		"IPV6_RECVTCLASS":                    reflect.ValueOf(syscall.IPV6_RECVTCLASS),
+		"IPV6_RTHDR_LOOSE":                   reflect.ValueOf(syscall.IPV6_RTHDR_LOOSE),
+		"IPV6_RTHDR_STRICT":                  reflect.ValueOf(syscall.IPV6_RTHDR_STRICT),
+		"IPV6_RTHDR_TYPE_0":                  reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_0),
+		"IPV6_SOCKOPT_RESERVED1":             reflect.ValueOf(syscall.IPV6_SOCKOPT_RESERVED1),
+		"IPV6_TCLASS":                        reflect.ValueOf(syscall.IPV6_TCLASS),
+		"IPV6_UNICAST_HOPS":                  reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_V6ONLY":                        reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IPV6_VERSION":                       reflect.ValueOf(syscall.IPV6_VERSION),
+		"IPV6_VERSION_MASK":                  reflect.ValueOf(syscall.IPV6_VERSION_MASK),
+		"IP_ADD_MEMBERSHIP":                  reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_ADD_SOURCE_MEMBERSHIP":           reflect.ValueOf(syscall.IP_ADD_SOURCE_MEMBERSHIP),
+		"IP_BLOCK_SOURCE":                    reflect.ValueOf(syscall.IP_BLOCK_SOURCE),
+		"IP_BOUND_IF":                        reflect.ValueOf(syscall.IP_BOUND_IF),
+		"IP_DEFAULT_MULTICAST_LOOP":          reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_LOOP),
+		"IP_DEFAULT_MULTICAST_TTL":           reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_TTL),
+		"IP_DF":                              reflect.ValueOf(syscall.IP_DF),
+		"IP_DROP_MEMBERSHIP":                 reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_DROP_SOURCE_MEMBERSHIP":          reflect.ValueOf(syscall.IP_DROP_SOURCE_MEMBERSHIP),
+		"IP_DUMMYNET_CONFIGURE":              reflect.ValueOf(syscall.IP_DUMMYNET_CONFIGURE),
+		"IP_DUMMYNET_DEL":                    reflect.ValueOf(syscall.IP_DUMMYNET_DEL),
+		"IP_DUMMYNET_FLUSH":                  reflect.ValueOf(syscall.IP_DUMMYNET_FLUSH),
+		"IP_DUMMYNET_GET":                    reflect.ValueOf(syscall.IP_DUMMYNET_GET),
+		"IP_FAITH":                           reflect.ValueOf(syscall.IP_FAITH),
+		"IP_FW_ADD":                          reflect.ValueOf(syscall.IP_FW_ADD),
+		"IP_FW_DEL":                          reflect.ValueOf(syscall.IP_FW_DEL),
+		"IP_FW_FLUSH":                        reflect.ValueOf(syscall.IP_FW_FLUSH),
+		"IP_FW_GET":                          reflect.ValueOf(syscall.IP_FW_GET),
+		"IP_FW_RESETLOG":                     reflect.ValueOf(syscall.IP_FW_RESETLOG),
+		"IP_FW_ZERO":                         reflect.ValueOf(syscall.IP_FW_ZERO),
+		"IP_HDRINCL":                         reflect.ValueOf(syscall.IP_HDRINCL),
+		"IP_IPSEC_POLICY":                    reflect.ValueOf(syscall.IP_IPSEC_POLICY),
+		"IP_MAXPACKET":                       reflect.ValueOf(syscall.IP_MAXPACKET),
+		"IP_MAX_GROUP_SRC_FILTER":            reflect.ValueOf(syscall.IP_MAX_GROUP_SRC_FILTER),
+		"IP_MAX_MEMBERSHIPS":                 reflect.ValueOf(syscall.IP_MAX_MEMBERSHIPS),
+		"IP_MAX_SOCK_MUTE_FILTER":            reflect.ValueOf(syscall.IP_MAX_SOCK_MUTE_FILTER),
+		"IP_MAX_SOCK_SRC_FILTER":             reflect.ValueOf(syscall.IP_MAX_SOCK_SRC_FILTER),
+		"IP_MF":                              reflect.ValueOf(syscall.IP_MF),
+		"IP_MIN_MEMBERSHIPS":                 reflect.ValueOf(syscall.IP_MIN_MEMBERSHIPS),
+		"IP_MSFILTER":                        reflect.ValueOf(syscall.IP_MSFILTER),
+		"IP_MSS":                             reflect.ValueOf(syscall.IP_MSS),
+		"IP_MULTICAST_IF":                    reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_IFINDEX":               reflect.ValueOf(syscall.IP_MULTICAST_IFINDEX),
+		"IP_MULTICAST_LOOP":                  reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":                   reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_MULTICAST_VIF":                   reflect.ValueOf(syscall.IP_MULTICAST_VIF),
+		"IP_NAT__XXX":                        reflect.ValueOf(syscall.IP_NAT__XXX),
+		"IP_OFFMASK":                         reflect.ValueOf(syscall.IP_OFFMASK),
+		"IP_OLD_FW_ADD":                      reflect.ValueOf(syscall.IP_OLD_FW_ADD),
+		"IP_OLD_FW_DEL":                      reflect.ValueOf(syscall.IP_OLD_FW_DEL),
+		"IP_OLD_FW_FLUSH":                    reflect.ValueOf(syscall.IP_OLD_FW_FLUSH),
+		"IP_OLD_FW_GET":                      reflect.ValueOf(syscall.IP_OLD_FW_GET),
+		"IP_OLD_FW_RESETLOG":                 reflect.ValueOf(syscall.IP_OLD_FW_RESETLOG),
+		"IP_OLD_FW_ZERO":                     reflect.ValueOf(syscall.IP_OLD_FW_ZERO),
+		"IP_OPTIONS":                         reflect.ValueOf(syscall.IP_OPTIONS),
+		"IP_PKTINFO":                         reflect.ValueOf(syscall.IP_PKTINFO),
+		"IP_PORTRANGE":                       reflect.ValueOf(syscall.IP_PORTRANGE),
+		"IP_PORTRANGE_DEFAULT":               reflect.ValueOf(syscall.IP_PORTRANGE_DEFAULT),
+		"IP_PORTRANGE_HIGH":                  reflect.ValueOf(syscall.IP_PORTRANGE_HIGH),
+		"IP_PORTRANGE_LOW":                   reflect.ValueOf(syscall.IP_PORTRANGE_LOW),
+		"IP_RECVDSTADDR":                     reflect.ValueOf(syscall.IP_RECVDSTADDR),
+		"IP_RECVIF":                          reflect.ValueOf(syscall.IP_RECVIF),
+		"IP_RECVOPTS":                        reflect.ValueOf(syscall.IP_RECVOPTS),
+		"IP_RECVPKTINFO":                     reflect.ValueOf(syscall.IP_RECVPKTINFO),
+		"IP_RECVRETOPTS":                     reflect.ValueOf(syscall.IP_RECVRETOPTS),
+		"IP_RECVTTL":                         reflect.ValueOf(syscall.IP_RECVTTL),
+		"IP_RETOPTS":                         reflect.ValueOf(syscall.IP_RETOPTS),
+		"IP_RF":                              reflect.ValueOf(syscall.IP_RF),
+		"IP_RSVP_OFF":                        reflect.ValueOf(syscall.IP_RSVP_OFF),
+		"IP_RSVP_ON":                         reflect.ValueOf(syscall.IP_RSVP_ON),
+		"IP_RSVP_VIF_OFF":                    reflect.ValueOf(syscall.IP_RSVP_VIF_OFF),
+		"IP_RSVP_VIF_ON":                     reflect.ValueOf(syscall.IP_RSVP_VIF_ON),
+		"IP_STRIPHDR":                        reflect.ValueOf(syscall.IP_STRIPHDR),
+		"IP_TOS":                             reflect.ValueOf(syscall.IP_TOS),
+		"IP_TRAFFIC_MGT_BACKGROUND":          reflect.ValueOf(syscall.IP_TRAFFIC_MGT_BACKGROUND),
+		"IP_TTL":                             reflect.ValueOf(syscall.IP_TTL),
+		"IP_UNBLOCK_SOURCE":                  reflect.ValueOf(syscall.IP_UNBLOCK_SOURCE),
+		"ISIG":                               reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                             reflect.ValueOf(syscall.ISTRIP),
+		"IUTF8":                              reflect.ValueOf(syscall.IUTF8),
+		"IXANY":                              reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                              reflect.ValueOf(syscall.IXOFF),
+		"IXON":                               reflect.ValueOf(syscall.IXON),
+		"ImplementsGetwd":                    reflect.ValueOf(syscall.ImplementsGetwd),
+		"Issetugid":                          reflect.ValueOf(syscall.Issetugid),
+		"Kevent":                             reflect.ValueOf(syscall.Kevent),
+		"Kill":                               reflect.ValueOf(syscall.Kill),
+		"Kqueue":                             reflect.ValueOf(syscall.Kqueue),
+		"LOCK_EX":                            reflect.ValueOf(syscall.LOCK_EX),
+		"LOCK_NB":                            reflect.ValueOf(syscall.LOCK_NB),
+		"LOCK_SH":                            reflect.ValueOf(syscall.LOCK_SH),
+		"LOCK_UN":                            reflect.ValueOf(syscall.LOCK_UN),
+		"Lchown":                             reflect.ValueOf(syscall.Lchown),
+		"Link":                               reflect.ValueOf(syscall.Link),
+		"Listen":                             reflect.ValueOf(syscall.Listen),
+		"Lstat":                              reflect.ValueOf(syscall.Lstat),
+		"MADV_CAN_REUSE":                     reflect.ValueOf(syscall.MADV_CAN_REUSE),
+		"MADV_DONTNEED":                      reflect.ValueOf(syscall.MADV_DONTNEED),
+		"MADV_FREE":                          reflect.ValueOf(syscall.MADV_FREE),
+		"MADV_FREE_REUSABLE":                 reflect.ValueOf(syscall.MADV_FREE_REUSABLE),
+		"MADV_FREE_REUSE":                    reflect.ValueOf(syscall.MADV_FREE_REUSE),
+		"MADV_NORMAL":                        reflect.ValueOf(syscall.MADV_NORMAL),
+		"MADV_RANDOM":                        reflect.ValueOf(syscall.MADV_RANDOM),
+		"MADV_SEQUENTIAL":                    reflect.ValueOf(syscall.MADV_SEQUENTIAL),
+		"MADV_WILLNEED":                      reflect.ValueOf(syscall.MADV_WILLNEED),
+		"MADV_ZERO_WIRED_PAGES":              reflect.ValueOf(syscall.MADV_ZERO_WIRED_PAGES),
+		"MAP_ANON":                           reflect.ValueOf(syscall.MAP_ANON),
+		"MAP_COPY":                           reflect.ValueOf(syscall.MAP_COPY),
+		"MAP_FILE":                           reflect.ValueOf(syscall.MAP_FILE),
+		"MAP_FIXED":                          reflect.ValueOf(syscall.MAP_FIXED),
+		"MAP_HASSEMAPHORE":                   reflect.ValueOf(syscall.MAP_HASSEMAPHORE),
+		"MAP_JIT":                            reflect.ValueOf(syscall.MAP_JIT),
+		"MAP_NOCACHE":                        reflect.ValueOf(syscall.MAP_NOCACHE),
+		"MAP_NOEXTEND":                       reflect.ValueOf(syscall.MAP_NOEXTEND),
+		"MAP_NORESERVE":                      reflect.ValueOf(syscall.MAP_NORESERVE),
+		"MAP_PRIVATE":                        reflect.ValueOf(syscall.MAP_PRIVATE),
+		"MAP_RENAME":                         reflect.ValueOf(syscall.MAP_RENAME),
+		"MAP_RESERVED0080":                   reflect.ValueOf(syscall.MAP_RESERVED0080),
+		"MAP_SHARED":                         reflect.ValueOf(syscall.MAP_SHARED),
+		"MCL_CURRENT":                        reflect.ValueOf(syscall.MCL_CURRENT),
+		"MCL_FUTURE":                         reflect.ValueOf(syscall.MCL_FUTURE),
+		"MSG_CTRUNC":                         reflect.ValueOf(syscall.MSG_CTRUNC),
+		"MSG_DONTROUTE":                      reflect.ValueOf(syscall.MSG_DONTROUTE),
+		"MSG_DONTWAIT":                       reflect.ValueOf(syscall.MSG_DONTWAIT),
+		"MSG_EOF":                            reflect.ValueOf(syscall.MSG_EOF),
+		"MSG_EOR":                            reflect.ValueOf(syscall.MSG_EOR),
+		"MSG_FLUSH":                          reflect.ValueOf(syscall.MSG_FLUSH),
+		"MSG_HAVEMORE":                       reflect.ValueOf(syscall.MSG_HAVEMORE),
+		"MSG_HOLD":                           reflect.ValueOf(syscall.MSG_HOLD),
+		"MSG_NEEDSA":                         reflect.ValueOf(syscall.MSG_NEEDSA),
+		"MSG_OOB":                            reflect.ValueOf(syscall.MSG_OOB),
+		"MSG_PEEK":                           reflect.ValueOf(syscall.MSG_PEEK),
+		"MSG_RCVMORE":                        reflect.ValueOf(syscall.MSG_RCVMORE),
+		"MSG_SEND":                           reflect.ValueOf(syscall.MSG_SEND),
+		"MSG_TRUNC":                          reflect.ValueOf(syscall.MSG_TRUNC),
+		"MSG_WAITALL":                        reflect.ValueOf(syscall.MSG_WAITALL),
+		"MSG_WAITSTREAM":                     reflect.ValueOf(syscall.MSG_WAITSTREAM),
+		"MS_ASYNC":                           reflect.ValueOf(syscall.MS_ASYNC),
+		"MS_DEACTIVATE":                      reflect.ValueOf(syscall.MS_DEACTIVATE),
+		"MS_INVALIDATE":                      reflect.ValueOf(syscall.MS_INVALIDATE),
+		"MS_KILLPAGES":                       reflect.ValueOf(syscall.MS_KILLPAGES),
+		"MS_SYNC":                            reflect.ValueOf(syscall.MS_SYNC),
+		"Mkdir":                              reflect.ValueOf(syscall.Mkdir),
+		"Mkfifo":                             reflect.ValueOf(syscall.Mkfifo),
+		"Mknod":                              reflect.ValueOf(syscall.Mknod),
+		"Mlock":                              reflect.ValueOf(syscall.Mlock),
+		"Mlockall":                           reflect.ValueOf(syscall.Mlockall),
+		"Mmap":                               reflect.ValueOf(syscall.Mmap),
+		"Mprotect":                           reflect.ValueOf(syscall.Mprotect),
+		"Munlock":                            reflect.ValueOf(syscall.Munlock),
+		"Munlockall":                         reflect.ValueOf(syscall.Munlockall),
+		"Munmap":                             reflect.ValueOf(syscall.Munmap),
+		"NAME_MAX":                           reflect.ValueOf(syscall.NAME_MAX),
+		"NET_RT_DUMP":                        reflect.ValueOf(syscall.NET_RT_DUMP),
+		"NET_RT_DUMP2":                       reflect.ValueOf(syscall.NET_RT_DUMP2),
+		"NET_RT_FLAGS":                       reflect.ValueOf(syscall.NET_RT_FLAGS),
+		"NET_RT_IFLIST":                      reflect.ValueOf(syscall.NET_RT_IFLIST),
+		"NET_RT_IFLIST2":                     reflect.ValueOf(syscall.NET_RT_IFLIST2),
+		"NET_RT_MAXID":                       reflect.ValueOf(syscall.NET_RT_MAXID),
+		"NET_RT_STAT":                        reflect.ValueOf(syscall.NET_RT_STAT),
+		"NET_RT_TRASH":                       reflect.ValueOf(syscall.NET_RT_TRASH),
+		"NOFLSH":                             reflect.ValueOf(uint32(syscall.NOFLSH)),
+		"NOTE_ABSOLUTE":                      reflect.ValueOf(syscall.NOTE_ABSOLUTE),
+		"NOTE_ATTRIB":                        reflect.ValueOf(syscall.NOTE_ATTRIB),
+		"NOTE_BACKGROUND":                    reflect.ValueOf(syscall.NOTE_BACKGROUND),
+		"NOTE_CHILD":                         reflect.ValueOf(syscall.NOTE_CHILD),
+		"NOTE_CRITICAL":                      reflect.ValueOf(syscall.NOTE_CRITICAL),
+		"NOTE_DELETE":                        reflect.ValueOf(syscall.NOTE_DELETE),
+		"NOTE_EXEC":                          reflect.ValueOf(syscall.NOTE_EXEC),
+		"NOTE_EXIT":                          reflect.ValueOf(uint32(syscall.NOTE_EXIT)),
+		"NOTE_EXITSTATUS":                    reflect.ValueOf(syscall.NOTE_EXITSTATUS),
+		"NOTE_EXIT_CSERROR":                  reflect.ValueOf(syscall.NOTE_EXIT_CSERROR),
+		"NOTE_EXIT_DECRYPTFAIL":              reflect.ValueOf(syscall.NOTE_EXIT_DECRYPTFAIL),
+		"NOTE_EXIT_DETAIL":                   reflect.ValueOf(syscall.NOTE_EXIT_DETAIL),
+		"NOTE_EXIT_DETAIL_MASK":              reflect.ValueOf(syscall.NOTE_EXIT_DETAIL_MASK),
+		"NOTE_EXIT_MEMORY":                   reflect.ValueOf(syscall.NOTE_EXIT_MEMORY),
+		"NOTE_EXIT_REPARENTED":               reflect.ValueOf(syscall.NOTE_EXIT_REPARENTED),
+		"NOTE_EXTEND":                        reflect.ValueOf(syscall.NOTE_EXTEND),
+		"NOTE_FFAND":                         reflect.ValueOf(syscall.NOTE_FFAND),
+		"NOTE_FFCOPY":                        reflect.ValueOf(uint32(syscall.NOTE_FFCOPY)),
+		"NOTE_FFCTRLMASK":                    reflect.ValueOf(uint32(syscall.NOTE_FFCTRLMASK)),
+		"NOTE_FFLAGSMASK":                    reflect.ValueOf(syscall.NOTE_FFLAGSMASK),
+		"NOTE_FFNOP":                         reflect.ValueOf(syscall.NOTE_FFNOP),
+		"NOTE_FFOR":                          reflect.ValueOf(uint32(syscall.NOTE_FFOR)),
+		"NOTE_FORK":                          reflect.ValueOf(syscall.NOTE_FORK),
+		"NOTE_LEEWAY":                        reflect.ValueOf(syscall.NOTE_LEEWAY),
+		"NOTE_LINK":                          reflect.ValueOf(syscall.NOTE_LINK),
+		"NOTE_LOWAT":                         reflect.ValueOf(syscall.NOTE_LOWAT),
+		"NOTE_NONE":                          reflect.ValueOf(syscall.NOTE_NONE),
+		"NOTE_NSECONDS":                      reflect.ValueOf(syscall.NOTE_NSECONDS),
+		"NOTE_PCTRLMASK":                     reflect.ValueOf(syscall.NOTE_PCTRLMASK),
+		"NOTE_PDATAMASK":                     reflect.ValueOf(syscall.NOTE_PDATAMASK),
+		"NOTE_REAP":                          reflect.ValueOf(syscall.NOTE_REAP),
+		"NOTE_RENAME":                        reflect.ValueOf(syscall.NOTE_RENAME),
+		"NOTE_REVOKE":                        reflect.ValueOf(syscall.NOTE_REVOKE),
+		"NOTE_SECONDS":                       reflect.ValueOf(syscall.NOTE_SECONDS),
+		"NOTE_SIGNAL":                        reflect.ValueOf(syscall.NOTE_SIGNAL),
+		"NOTE_TRACK":                         reflect.ValueOf(syscall.NOTE_TRACK),
+		"NOTE_TRACKERR":                      reflect.ValueOf(syscall.NOTE_TRACKERR),
+		"NOTE_TRIGGER":                       reflect.ValueOf(syscall.NOTE_TRIGGER),
+		"NOTE_USECONDS":                      reflect.ValueOf(syscall.NOTE_USECONDS),
+		"NOTE_VM_ERROR":                      reflect.ValueOf(syscall.NOTE_VM_ERROR),
+		"NOTE_VM_PRESSURE":                   reflect.ValueOf(uint32(syscall.NOTE_VM_PRESSURE)),
+		"NOTE_VM_PRESSURE_SUDDEN_TERMINATE":  reflect.ValueOf(syscall.NOTE_VM_PRESSURE_SUDDEN_TERMINATE),
+		"NOTE_VM_PRESSURE_TERMINATE":         reflect.ValueOf(syscall.NOTE_VM_PRESSURE_TERMINATE),
+		"NOTE_WRITE":                         reflect.ValueOf(syscall.NOTE_WRITE),
+		"NsecToTimespec":                     reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                      reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                              reflect.ValueOf(syscall.OCRNL),
+		"OFDEL":                              reflect.ValueOf(syscall.OFDEL),
+		"OFILL":                              reflect.ValueOf(syscall.OFILL),
+		"ONLCR":                              reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                             reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                              reflect.ValueOf(syscall.ONOCR),
+		"ONOEOT":                             reflect.ValueOf(syscall.ONOEOT),
+		"OPOST":                              reflect.ValueOf(syscall.OPOST),
+		"O_ACCMODE":                          reflect.ValueOf(syscall.O_ACCMODE),
+		"O_ALERT":                            reflect.ValueOf(syscall.O_ALERT),
+		"O_APPEND":                           reflect.ValueOf(syscall.O_APPEND),
+		"O_ASYNC":                            reflect.ValueOf(syscall.O_ASYNC),
+		"O_CLOEXEC":                          reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                            reflect.ValueOf(syscall.O_CREAT),
+		"O_DIRECTORY":                        reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_DP_GETRAWENCRYPTED":               reflect.ValueOf(syscall.O_DP_GETRAWENCRYPTED),
+		"O_DSYNC":                            reflect.ValueOf(syscall.O_DSYNC),
+		"O_EVTONLY":                          reflect.ValueOf(syscall.O_EVTONLY),
+		"O_EXCL":                             reflect.ValueOf(syscall.O_EXCL),
+		"O_EXLOCK":                           reflect.ValueOf(syscall.O_EXLOCK),
+		"O_FSYNC":                            reflect.ValueOf(syscall.O_FSYNC),
+		"O_NDELAY":                           reflect.ValueOf(syscall.O_NDELAY),
+		"O_NOCTTY":                           reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NOFOLLOW":                         reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_NONBLOCK":                         reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_POPUP":                            reflect.ValueOf(uint32(syscall.O_POPUP)),
+		"O_RDONLY":                           reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                             reflect.ValueOf(syscall.O_RDWR),
+		"O_SHLOCK":                           reflect.ValueOf(syscall.O_SHLOCK),
+		"O_SYMLINK":                          reflect.ValueOf(syscall.O_SYMLINK),
+		"O_SYNC":                             reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                            reflect.ValueOf(syscall.O_TRUNC),
+		"O_WRONLY":                           reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                               reflect.ValueOf(syscall.Open),
+		"PARENB":                             reflect.ValueOf(syscall.PARENB),
+		"PARMRK":                             reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                             reflect.ValueOf(syscall.PARODD),
+		"PENDIN":                             reflect.ValueOf(syscall.PENDIN),
+		"PRIO_PGRP":                          reflect.ValueOf(syscall.PRIO_PGRP),
+		"PRIO_PROCESS":                       reflect.ValueOf(syscall.PRIO_PROCESS),
+		"PRIO_USER":                          reflect.ValueOf(syscall.PRIO_USER),
+		"PROT_EXEC":                          reflect.ValueOf(syscall.PROT_EXEC),
+		"PROT_NONE":                          reflect.ValueOf(syscall.PROT_NONE),
+		"PROT_READ":                          reflect.ValueOf(syscall.PROT_READ),
+		"PROT_WRITE":                         reflect.ValueOf(syscall.PROT_WRITE),
+		"PTRACE_CONT":                        reflect.ValueOf(syscall.PTRACE_CONT),
+		"PTRACE_KILL":                        reflect.ValueOf(syscall.PTRACE_KILL),
+		"PTRACE_TRACEME":                     reflect.ValueOf(syscall.PTRACE_TRACEME),
+		"PT_ATTACH":                          reflect.ValueOf(syscall.PT_ATTACH),
+		"PT_ATTACHEXC":                       reflect.ValueOf(syscall.PT_ATTACHEXC),
+		"PT_CONTINUE":                        reflect.ValueOf(syscall.PT_CONTINUE),
+		"PT_DENY_ATTACH":                     reflect.ValueOf(syscall.PT_DENY_ATTACH),
+		"PT_DETACH":                          reflect.ValueOf(syscall.PT_DETACH),
+		"PT_FIRSTMACH":                       reflect.ValueOf(syscall.PT_FIRSTMACH),
+		"PT_FORCEQUOTA":                      reflect.ValueOf(syscall.PT_FORCEQUOTA),
+		"PT_KILL":                            reflect.ValueOf(syscall.PT_KILL),
+		"PT_READ_D":                          reflect.ValueOf(syscall.PT_READ_D),
+		"PT_READ_I":                          reflect.ValueOf(syscall.PT_READ_I),
+		"PT_READ_U":                          reflect.ValueOf(syscall.PT_READ_U),
+		"PT_SIGEXC":                          reflect.ValueOf(syscall.PT_SIGEXC),
+		"PT_STEP":                            reflect.ValueOf(syscall.PT_STEP),
+		"PT_THUPDATE":                        reflect.ValueOf(syscall.PT_THUPDATE),
+		"PT_TRACE_ME":                        reflect.ValueOf(syscall.PT_TRACE_ME),
+		"PT_WRITE_D":                         reflect.ValueOf(syscall.PT_WRITE_D),
+		"PT_WRITE_I":                         reflect.ValueOf(syscall.PT_WRITE_I),
+		"PT_WRITE_U":                         reflect.ValueOf(syscall.PT_WRITE_U),
+		"ParseDirent":                        reflect.ValueOf(syscall.ParseDirent),
+		"ParseRoutingMessage":                reflect.ValueOf(syscall.ParseRoutingMessage),
+		"ParseRoutingSockaddr":               reflect.ValueOf(syscall.ParseRoutingSockaddr),
+		"ParseSocketControlMessage":          reflect.ValueOf(syscall.ParseSocketControlMessage),
+		"ParseUnixRights":                    reflect.ValueOf(syscall.ParseUnixRights),
+		"Pathconf":                           reflect.ValueOf(syscall.Pathconf),
+		"Pipe":                               reflect.ValueOf(syscall.Pipe),
+		"Pread":                              reflect.ValueOf(syscall.Pread),
+		"PtraceAttach":                       reflect.ValueOf(syscall.PtraceAttach),
+		"PtraceDetach":                       reflect.ValueOf(syscall.PtraceDetach),
+		"Pwrite":                             reflect.ValueOf(syscall.Pwrite),
+		"RLIMIT_AS":                          reflect.ValueOf(syscall.RLIMIT_AS),
+		"RLIMIT_CORE":                        reflect.ValueOf(syscall.RLIMIT_CORE),
+		"RLIMIT_CPU":                         reflect.ValueOf(syscall.RLIMIT_CPU),
+		"RLIMIT_CPU_USAGE_MONITOR":           reflect.ValueOf(syscall.RLIMIT_CPU_USAGE_MONITOR),
+		"RLIMIT_DATA":                        reflect.ValueOf(syscall.RLIMIT_DATA),
+		"RLIMIT_FSIZE":                       reflect.ValueOf(syscall.RLIMIT_FSIZE),
+		"RLIMIT_NOFILE":                      reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RLIMIT_STACK":                       reflect.ValueOf(syscall.RLIMIT_STACK),
+		"RLIM_INFINITY":                      reflect.ValueOf(int64(syscall.RLIM_INFINITY)),
+		"RTAX_AUTHOR":                        reflect.ValueOf(syscall.RTAX_AUTHOR),
+		"RTAX_BRD":                           reflect.ValueOf(syscall.RTAX_BRD),
+		"RTAX_DST":                           reflect.ValueOf(syscall.RTAX_DST),
+		"RTAX_GATEWAY":                       reflect.ValueOf(syscall.RTAX_GATEWAY),
+		"RTAX_GENMASK":                       reflect.ValueOf(syscall.RTAX_GENMASK),
+		"RTAX_IFA":                           reflect.ValueOf(syscall.RTAX_IFA),
+		"RTAX_IFP":                           reflect.ValueOf(syscall.RTAX_IFP),
+		"RTAX_MAX":                           reflect.ValueOf(syscall.RTAX_MAX),
+		"RTAX_NETMASK":                       reflect.ValueOf(syscall.RTAX_NETMASK),
+		"RTA_AUTHOR":                         reflect.ValueOf(syscall.RTA_AUTHOR),
+		"RTA_BRD":                            reflect.ValueOf(syscall.RTA_BRD),
+		"RTA_DST":                            reflect.ValueOf(syscall.RTA_DST),
+		"RTA_GATEWAY":                        reflect.ValueOf(syscall.RTA_GATEWAY),
+		"RTA_GENMASK":                        reflect.ValueOf(syscall.RTA_GENMASK),
+		"RTA_IFA":                            reflect.ValueOf(syscall.RTA_IFA),
+		"RTA_IFP":                            reflect.ValueOf(syscall.RTA_IFP),
+		"RTA_NETMASK":                        reflect.ValueOf(syscall.RTA_NETMASK),
+		"RTF_BLACKHOLE":                      reflect.ValueOf(syscall.RTF_BLACKHOLE),
+		"RTF_BROADCAST":                      reflect.ValueOf(syscall.RTF_BROADCAST),
+		"RTF_CLONING":                        reflect.ValueOf(syscall.RTF_CLONING),
+		"RTF_CONDEMNED":                      reflect.ValueOf(syscall.RTF_CONDEMNED),
+		"RTF_DELCLONE":                       reflect.ValueOf(syscall.RTF_DELCLONE),
+		"RTF_DONE":                           reflect.ValueOf(syscall.RTF_DONE),
+		"RTF_DYNAMIC":                        reflect.ValueOf(syscall.RTF_DYNAMIC),
+		"RTF_GATEWAY":                        reflect.ValueOf(syscall.RTF_GATEWAY),
+		"RTF_HOST":                           reflect.ValueOf(syscall.RTF_HOST),
+		"RTF_IFREF":                          reflect.ValueOf(syscall.RTF_IFREF),
+		"RTF_IFSCOPE":                        reflect.ValueOf(syscall.RTF_IFSCOPE),
+		"RTF_LLINFO":                         reflect.ValueOf(syscall.RTF_LLINFO),
+		"RTF_LOCAL":                          reflect.ValueOf(syscall.RTF_LOCAL),
+		"RTF_MODIFIED":                       reflect.ValueOf(syscall.RTF_MODIFIED),
+		"RTF_MULTICAST":                      reflect.ValueOf(syscall.RTF_MULTICAST),
+		"RTF_PINNED":                         reflect.ValueOf(syscall.RTF_PINNED),
+		"RTF_PRCLONING":                      reflect.ValueOf(syscall.RTF_PRCLONING),
+		"RTF_PROTO1":                         reflect.ValueOf(syscall.RTF_PROTO1),
+		"RTF_PROTO2":                         reflect.ValueOf(syscall.RTF_PROTO2),
+		"RTF_PROTO3":                         reflect.ValueOf(syscall.RTF_PROTO3),
+		"RTF_PROXY":                          reflect.ValueOf(syscall.RTF_PROXY),
+		"RTF_REJECT":                         reflect.ValueOf(syscall.RTF_REJECT),
+		"RTF_ROUTER":                         reflect.ValueOf(syscall.RTF_ROUTER),
+		"RTF_STATIC":                         reflect.ValueOf(syscall.RTF_STATIC),
+		"RTF_UP":                             reflect.ValueOf(syscall.RTF_UP),
+		"RTF_WASCLONED":                      reflect.ValueOf(syscall.RTF_WASCLONED),
+		"RTF_XRESOLVE":                       reflect.ValueOf(syscall.RTF_XRESOLVE),
+		"RTM_ADD":                            reflect.ValueOf(syscall.RTM_ADD),
+		"RTM_CHANGE":                         reflect.ValueOf(syscall.RTM_CHANGE),
+		"RTM_DELADDR":                        reflect.ValueOf(syscall.RTM_DELADDR),
+		"RTM_DELETE":                         reflect.ValueOf(syscall.RTM_DELETE),
+		"RTM_DELMADDR":                       reflect.ValueOf(syscall.RTM_DELMADDR),
+		"RTM_GET":                            reflect.ValueOf(syscall.RTM_GET),
+		"RTM_GET2":                           reflect.ValueOf(syscall.RTM_GET2),
+		"RTM_IFINFO":                         reflect.ValueOf(syscall.RTM_IFINFO),
+		"RTM_IFINFO2":                        reflect.ValueOf(syscall.RTM_IFINFO2),
+		"RTM_LOCK":                           reflect.ValueOf(syscall.RTM_LOCK),
+		"RTM_LOSING":                         reflect.ValueOf(syscall.RTM_LOSING),
+		"RTM_MISS":                           reflect.ValueOf(syscall.RTM_MISS),
+		"RTM_NEWADDR":                        reflect.ValueOf(syscall.RTM_NEWADDR),
+		"RTM_NEWMADDR":                       reflect.ValueOf(syscall.RTM_NEWMADDR),
+		"RTM_NEWMADDR2":                      reflect.ValueOf(syscall.RTM_NEWMADDR2),
+		"RTM_OLDADD":                         reflect.ValueOf(syscall.RTM_OLDADD),
+		"RTM_OLDDEL":                         reflect.ValueOf(syscall.RTM_OLDDEL),
+		"RTM_REDIRECT":                       reflect.ValueOf(syscall.RTM_REDIRECT),
+		"RTM_RESOLVE":                        reflect.ValueOf(syscall.RTM_RESOLVE),
+		"RTM_RTTUNIT":                        reflect.ValueOf(syscall.RTM_RTTUNIT),
+		"RTM_VERSION":                        reflect.ValueOf(syscall.RTM_VERSION),
+		"RTV_EXPIRE":                         reflect.ValueOf(syscall.RTV_EXPIRE),
+		"RTV_HOPCOUNT":                       reflect.ValueOf(syscall.RTV_HOPCOUNT),
+		"RTV_MTU":                            reflect.ValueOf(syscall.RTV_MTU),
+		"RTV_RPIPE":                          reflect.ValueOf(syscall.RTV_RPIPE),
+		"RTV_RTT":                            reflect.ValueOf(syscall.RTV_RTT),
+		"RTV_RTTVAR":                         reflect.ValueOf(syscall.RTV_RTTVAR),
+		"RTV_SPIPE":                          reflect.ValueOf(syscall.RTV_SPIPE),
+		"RTV_SSTHRESH":                       reflect.ValueOf(syscall.RTV_SSTHRESH),
+		"RUSAGE_CHILDREN":                    reflect.ValueOf(syscall.RUSAGE_CHILDREN),
+		"RUSAGE_SELF":                        reflect.ValueOf(syscall.RUSAGE_SELF),
+		"RawSyscall":                         reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                        reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                               reflect.ValueOf(syscall.Read),
+		"ReadDirent":                         reflect.ValueOf(syscall.ReadDirent),
+		"Readlink":                           reflect.ValueOf(syscall.Readlink),
+		"Recvfrom":                           reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                            reflect.ValueOf(syscall.Recvmsg),
+		"Rename":                             reflect.ValueOf(syscall.Rename),
+		"Revoke":                             reflect.ValueOf(syscall.Revoke),
+		"Rmdir":                              reflect.ValueOf(syscall.Rmdir),
+		"RouteRIB":                           reflect.ValueOf(syscall.RouteRIB),
+		"SCM_CREDS":                          reflect.ValueOf(syscall.SCM_CREDS),
+		"SCM_RIGHTS":                         reflect.ValueOf(syscall.SCM_RIGHTS),
+		"SCM_TIMESTAMP":                      reflect.ValueOf(syscall.SCM_TIMESTAMP),
+		"SCM_TIMESTAMP_MONOTONIC":            reflect.ValueOf(syscall.SCM_TIMESTAMP_MONOTONIC),
+		"SHUT_RD":                            reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                          reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                            reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                            reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                            reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                             reflect.ValueOf(syscall.SIGBUS),
+		"SIGCHLD":                            reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCONT":                            reflect.ValueOf(syscall.SIGCONT),
+		"SIGEMT":                             reflect.ValueOf(syscall.SIGEMT),
+		"SIGFPE":                             reflect.ValueOf(syscall.SIGFPE),
+		"SIGHUP":                             reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                             reflect.ValueOf(syscall.SIGILL),
+		"SIGINFO":                            reflect.ValueOf(syscall.SIGINFO),
+		"SIGINT":                             reflect.ValueOf(syscall.SIGINT),
+		"SIGIO":                              reflect.ValueOf(syscall.SIGIO),
+		"SIGIOT":                             reflect.ValueOf(syscall.SIGIOT),
+		"SIGKILL":                            reflect.ValueOf(syscall.SIGKILL),
+		"SIGPIPE":                            reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPROF":                            reflect.ValueOf(syscall.SIGPROF),
+		"SIGQUIT":                            reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                            reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSTOP":                            reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                             reflect.ValueOf(syscall.SIGSYS),
+		"SIGTERM":                            reflect.ValueOf(syscall.SIGTERM),
+		"SIGTRAP":                            reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                            reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                            reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                            reflect.ValueOf(syscall.SIGTTOU),
+		"SIGURG":                             reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                            reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                            reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVTALRM":                          reflect.ValueOf(syscall.SIGVTALRM),
+		"SIGWINCH":                           reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                            reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                            reflect.ValueOf(syscall.SIGXFSZ),
+		"SIOCADDMULTI":                       reflect.ValueOf(uint32(syscall.SIOCADDMULTI)),
+		"SIOCAIFADDR":                        reflect.ValueOf(uint32(syscall.SIOCAIFADDR)),
+		"SIOCARPIPLL":                        reflect.ValueOf(uint32(syscall.SIOCARPIPLL)),
+		"SIOCATMARK":                         reflect.ValueOf(syscall.SIOCATMARK),
+		"SIOCAUTOADDR":                       reflect.ValueOf(uint32(syscall.SIOCAUTOADDR)),
+		"SIOCAUTONETMASK":                    reflect.ValueOf(uint32(syscall.SIOCAUTONETMASK)),
+		"SIOCDELMULTI":                       reflect.ValueOf(uint32(syscall.SIOCDELMULTI)),
+		"SIOCDIFADDR":                        reflect.ValueOf(uint32(syscall.SIOCDIFADDR)),
+		"SIOCDIFPHYADDR":                     reflect.ValueOf(uint32(syscall.SIOCDIFPHYADDR)),
+		"SIOCGDRVSPEC":                       reflect.ValueOf(uint32(syscall.SIOCGDRVSPEC)),
+		"SIOCGETVLAN":                        reflect.ValueOf(uint32(syscall.SIOCGETVLAN)),
+		"SIOCGHIWAT":                         reflect.ValueOf(syscall.SIOCGHIWAT),
+		"SIOCGIFADDR":                        reflect.ValueOf(uint32(syscall.SIOCGIFADDR)),
+		"SIOCGIFALTMTU":                      reflect.ValueOf(uint32(syscall.SIOCGIFALTMTU)),
+		"SIOCGIFASYNCMAP":                    reflect.ValueOf(uint32(syscall.SIOCGIFASYNCMAP)),
+		"SIOCGIFBOND":                        reflect.ValueOf(uint32(syscall.SIOCGIFBOND)),
+		"SIOCGIFBRDADDR":                     reflect.ValueOf(uint32(syscall.SIOCGIFBRDADDR)),
+		"SIOCGIFCAP":                         reflect.ValueOf(uint32(syscall.SIOCGIFCAP)),
+		"SIOCGIFCONF":                        reflect.ValueOf(uint32(syscall.SIOCGIFCONF)),
+		"SIOCGIFDEVMTU":                      reflect.ValueOf(uint32(syscall.SIOCGIFDEVMTU)),
+		"SIOCGIFDSTADDR":                     reflect.ValueOf(uint32(syscall.SIOCGIFDSTADDR)),
+		"SIOCGIFFLAGS":                       reflect.ValueOf(uint32(syscall.SIOCGIFFLAGS)),
+		"SIOCGIFGENERIC":                     reflect.ValueOf(uint32(syscall.SIOCGIFGENERIC)),
+		"SIOCGIFKPI":                         reflect.ValueOf(uint32(syscall.SIOCGIFKPI)),
+		"SIOCGIFMAC":                         reflect.ValueOf(uint32(syscall.SIOCGIFMAC)),
+		"SIOCGIFMEDIA":                       reflect.ValueOf(uint32(syscall.SIOCGIFMEDIA)),
+		"SIOCGIFMETRIC":                      reflect.ValueOf(uint32(syscall.SIOCGIFMETRIC)),
+		"SIOCGIFMTU":                         reflect.ValueOf(uint32(syscall.SIOCGIFMTU)),
+		"SIOCGIFNETMASK":                     reflect.ValueOf(uint32(syscall.SIOCGIFNETMASK)),
+		"SIOCGIFPDSTADDR":                    reflect.ValueOf(uint32(syscall.SIOCGIFPDSTADDR)),
+		"SIOCGIFPHYS":                        reflect.ValueOf(uint32(syscall.SIOCGIFPHYS)),
+		"SIOCGIFPSRCADDR":                    reflect.ValueOf(uint32(syscall.SIOCGIFPSRCADDR)),
+		"SIOCGIFSTATUS":                      reflect.ValueOf(uint32(syscall.SIOCGIFSTATUS)),
+		"SIOCGIFVLAN":                        reflect.ValueOf(uint32(syscall.SIOCGIFVLAN)),
+		"SIOCGIFWAKEFLAGS":                   reflect.ValueOf(uint32(syscall.SIOCGIFWAKEFLAGS)),
+		"SIOCGLOWAT":                         reflect.ValueOf(syscall.SIOCGLOWAT),
+		"SIOCGPGRP":                          reflect.ValueOf(syscall.SIOCGPGRP),
+		"SIOCIFCREATE":                       reflect.ValueOf(uint32(syscall.SIOCIFCREATE)),
+		"SIOCIFCREATE2":                      reflect.ValueOf(uint32(syscall.SIOCIFCREATE2)),
+		"SIOCIFDESTROY":                      reflect.ValueOf(uint32(syscall.SIOCIFDESTROY)),
+		"SIOCIFGCLONERS":                     reflect.ValueOf(uint32(syscall.SIOCIFGCLONERS)),
+		"SIOCRSLVMULTI":                      reflect.ValueOf(uint32(syscall.SIOCRSLVMULTI)),
+		"SIOCSDRVSPEC":                       reflect.ValueOf(uint32(syscall.SIOCSDRVSPEC)),
+		"SIOCSETVLAN":                        reflect.ValueOf(uint32(syscall.SIOCSETVLAN)),
+		"SIOCSHIWAT":                         reflect.ValueOf(uint32(syscall.SIOCSHIWAT)),
+		"SIOCSIFADDR":                        reflect.ValueOf(uint32(syscall.SIOCSIFADDR)),
+		"SIOCSIFALTMTU":                      reflect.ValueOf(uint32(syscall.SIOCSIFALTMTU)),
+		"SIOCSIFASYNCMAP":                    reflect.ValueOf(uint32(syscall.SIOCSIFASYNCMAP)),
+		"SIOCSIFBOND":                        reflect.ValueOf(uint32(syscall.SIOCSIFBOND)),
+		"SIOCSIFBRDADDR":                     reflect.ValueOf(uint32(syscall.SIOCSIFBRDADDR)),
+		"SIOCSIFCAP":                         reflect.ValueOf(uint32(syscall.SIOCSIFCAP)),
+		"SIOCSIFDSTADDR":                     reflect.ValueOf(uint32(syscall.SIOCSIFDSTADDR)),
+		"SIOCSIFFLAGS":                       reflect.ValueOf(uint32(syscall.SIOCSIFFLAGS)),
+		"SIOCSIFGENERIC":                     reflect.ValueOf(uint32(syscall.SIOCSIFGENERIC)),
+		"SIOCSIFKPI":                         reflect.ValueOf(uint32(syscall.SIOCSIFKPI)),
+		"SIOCSIFLLADDR":                      reflect.ValueOf(uint32(syscall.SIOCSIFLLADDR)),
+		"SIOCSIFMAC":                         reflect.ValueOf(uint32(syscall.SIOCSIFMAC)),
+		"SIOCSIFMEDIA":                       reflect.ValueOf(uint32(syscall.SIOCSIFMEDIA)),
+		"SIOCSIFMETRIC":                      reflect.ValueOf(uint32(syscall.SIOCSIFMETRIC)),
+		"SIOCSIFMTU":                         reflect.ValueOf(uint32(syscall.SIOCSIFMTU)),
+		"SIOCSIFNETMASK":                     reflect.ValueOf(uint32(syscall.SIOCSIFNETMASK)),
+		"SIOCSIFPHYADDR":                     reflect.ValueOf(uint32(syscall.SIOCSIFPHYADDR)),
+		"SIOCSIFPHYS":                        reflect.ValueOf(uint32(syscall.SIOCSIFPHYS)),
+		"SIOCSIFVLAN":                        reflect.ValueOf(uint32(syscall.SIOCSIFVLAN)),
+		"SIOCSLOWAT":                         reflect.ValueOf(uint32(syscall.SIOCSLOWAT)),
+		"SIOCSPGRP":                          reflect.ValueOf(uint32(syscall.SIOCSPGRP)),
+		"SOCK_DGRAM":                         reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_MAXADDRLEN":                    reflect.ValueOf(syscall.SOCK_MAXADDRLEN),
+		"SOCK_RAW":                           reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_RDM":                           reflect.ValueOf(syscall.SOCK_RDM),
+		"SOCK_SEQPACKET":                     reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                        reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOL_SOCKET":                         reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOMAXCONN":                          reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ACCEPTCONN":                      reflect.ValueOf(syscall.SO_ACCEPTCONN),
+		"SO_BROADCAST":                       reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_DEBUG":                           reflect.ValueOf(syscall.SO_DEBUG),
+		"SO_DONTROUTE":                       reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_DONTTRUNC":                       reflect.ValueOf(syscall.SO_DONTTRUNC),
+		"SO_ERROR":                           reflect.ValueOf(syscall.SO_ERROR),
+		"SO_KEEPALIVE":                       reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_LABEL":                           reflect.ValueOf(syscall.SO_LABEL),
+		"SO_LINGER":                          reflect.ValueOf(syscall.SO_LINGER),
+		"SO_LINGER_SEC":                      reflect.ValueOf(syscall.SO_LINGER_SEC),
+		"SO_NKE":                             reflect.ValueOf(syscall.SO_NKE),
+		"SO_NOADDRERR":                       reflect.ValueOf(syscall.SO_NOADDRERR),
+		"SO_NOSIGPIPE":                       reflect.ValueOf(syscall.SO_NOSIGPIPE),
+		"SO_NOTIFYCONFLICT":                  reflect.ValueOf(syscall.SO_NOTIFYCONFLICT),
+		"SO_NP_EXTENSIONS":                   reflect.ValueOf(syscall.SO_NP_EXTENSIONS),
+		"SO_NREAD":                           reflect.ValueOf(syscall.SO_NREAD),
+		"SO_NUMRCVPKT":                       reflect.ValueOf(syscall.SO_NUMRCVPKT),
+		"SO_NWRITE":                          reflect.ValueOf(syscall.SO_NWRITE),
+		"SO_OOBINLINE":                       reflect.ValueOf(syscall.SO_OOBINLINE),
+		"SO_PEERLABEL":                       reflect.ValueOf(syscall.SO_PEERLABEL),
+		"SO_RANDOMPORT":                      reflect.ValueOf(syscall.SO_RANDOMPORT),
+		"SO_RCVBUF":                          reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_RCVLOWAT":                        reflect.ValueOf(syscall.SO_RCVLOWAT),
+		"SO_RCVTIMEO":                        reflect.ValueOf(syscall.SO_RCVTIMEO),
+		"SO_REUSEADDR":                       reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_REUSEPORT":                       reflect.ValueOf(syscall.SO_REUSEPORT),
+		"SO_REUSESHAREUID":                   reflect.ValueOf(syscall.SO_REUSESHAREUID),
+		"SO_SNDBUF":                          reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_SNDLOWAT":                        reflect.ValueOf(syscall.SO_SNDLOWAT),
+		"SO_SNDTIMEO":                        reflect.ValueOf(syscall.SO_SNDTIMEO),
+		"SO_TIMESTAMP":                       reflect.ValueOf(syscall.SO_TIMESTAMP),
+		"SO_TIMESTAMP_MONOTONIC":             reflect.ValueOf(syscall.SO_TIMESTAMP_MONOTONIC),
+		"SO_TYPE":                            reflect.ValueOf(syscall.SO_TYPE),
+		"SO_UPCALLCLOSEWAIT":                 reflect.ValueOf(syscall.SO_UPCALLCLOSEWAIT),
+		"SO_USELOOPBACK":                     reflect.ValueOf(syscall.SO_USELOOPBACK),
+		"SO_WANTMORE":                        reflect.ValueOf(syscall.SO_WANTMORE),
+		"SO_WANTOOBFLAG":                     reflect.ValueOf(syscall.SO_WANTOOBFLAG),
+		"SYS_ACCEPT":                         reflect.ValueOf(syscall.SYS_ACCEPT),
+		"SYS_ACCEPT_NOCANCEL":                reflect.ValueOf(syscall.SYS_ACCEPT_NOCANCEL),
+		"SYS_ACCESS":                         reflect.ValueOf(syscall.SYS_ACCESS),
+		"SYS_ACCESS_EXTENDED":                reflect.ValueOf(syscall.SYS_ACCESS_EXTENDED),
+		"SYS_ACCT":                           reflect.ValueOf(syscall.SYS_ACCT),
+		"SYS_ADJTIME":                        reflect.ValueOf(syscall.SYS_ADJTIME),
+		"SYS_AIO_CANCEL":                     reflect.ValueOf(syscall.SYS_AIO_CANCEL),
+		"SYS_AIO_ERROR":                      reflect.ValueOf(syscall.SYS_AIO_ERROR),
+		"SYS_AIO_FSYNC":                      reflect.ValueOf(syscall.SYS_AIO_FSYNC),
+		"SYS_AIO_READ":                       reflect.ValueOf(syscall.SYS_AIO_READ),
+		"SYS_AIO_RETURN":                     reflect.ValueOf(syscall.SYS_AIO_RETURN),
+		"SYS_AIO_SUSPEND":                    reflect.ValueOf(syscall.SYS_AIO_SUSPEND),
+		"SYS_AIO_SUSPEND_NOCANCEL":           reflect.ValueOf(syscall.SYS_AIO_SUSPEND_NOCANCEL),
+		"SYS_AIO_WRITE":                      reflect.ValueOf(syscall.SYS_AIO_WRITE),
+		"SYS_ATGETMSG":                       reflect.ValueOf(syscall.SYS_ATGETMSG),
+		"SYS_ATPGETREQ":                      reflect.ValueOf(syscall.SYS_ATPGETREQ),
+		"SYS_ATPGETRSP":                      reflect.ValueOf(syscall.SYS_ATPGETRSP),
+		"SYS_ATPSNDREQ":                      reflect.ValueOf(syscall.SYS_ATPSNDREQ),
+		"SYS_ATPSNDRSP":                      reflect.ValueOf(syscall.SYS_ATPSNDRSP),
+		"SYS_ATPUTMSG":                       reflect.ValueOf(syscall.SYS_ATPUTMSG),
+		"SYS_ATSOCKET":                       reflect.ValueOf(syscall.SYS_ATSOCKET),
+		"SYS_AUDIT":                          reflect.ValueOf(syscall.SYS_AUDIT),
+		"SYS_AUDITCTL":                       reflect.ValueOf(syscall.SYS_AUDITCTL),
+		"SYS_AUDITON":                        reflect.ValueOf(syscall.SYS_AUDITON),
+		"SYS_AUDIT_SESSION_JOIN":             reflect.ValueOf(syscall.SYS_AUDIT_SESSION_JOIN),
+		"SYS_AUDIT_SESSION_PORT":             reflect.ValueOf(syscall.SYS_AUDIT_SESSION_PORT),
+		"SYS_AUDIT_SESSION_SELF":             reflect.ValueOf(syscall.SYS_AUDIT_SESSION_SELF),
+		"SYS_BIND":                           reflect.ValueOf(syscall.SYS_BIND),
+		"SYS_BSDTHREAD_CREATE":               reflect.ValueOf(syscall.SYS_BSDTHREAD_CREATE),
+		"SYS_BSDTHREAD_REGISTER":             reflect.ValueOf(syscall.SYS_BSDTHREAD_REGISTER),
+		"SYS_BSDTHREAD_TERMINATE":            reflect.ValueOf(syscall.SYS_BSDTHREAD_TERMINATE),
+		"SYS_CHDIR":                          reflect.ValueOf(syscall.SYS_CHDIR),
+		"SYS_CHFLAGS":                        reflect.ValueOf(syscall.SYS_CHFLAGS),
+		"SYS_CHMOD":                          reflect.ValueOf(syscall.SYS_CHMOD),
+		"SYS_CHMOD_EXTENDED":                 reflect.ValueOf(syscall.SYS_CHMOD_EXTENDED),
+		"SYS_CHOWN":                          reflect.ValueOf(syscall.SYS_CHOWN),
+		"SYS_CHROOT":                         reflect.ValueOf(syscall.SYS_CHROOT),
+		"SYS_CHUD":                           reflect.ValueOf(syscall.SYS_CHUD),
+		"SYS_CLOSE":                          reflect.ValueOf(syscall.SYS_CLOSE),
+		"SYS_CLOSE_NOCANCEL":                 reflect.ValueOf(syscall.SYS_CLOSE_NOCANCEL),
+		"SYS_CONNECT":                        reflect.ValueOf(syscall.SYS_CONNECT),
+		"SYS_CONNECT_NOCANCEL":               reflect.ValueOf(syscall.SYS_CONNECT_NOCANCEL),
+		"SYS_COPYFILE":                       reflect.ValueOf(syscall.SYS_COPYFILE),
+		"SYS_CSOPS":                          reflect.ValueOf(syscall.SYS_CSOPS),
+		"SYS_CSOPS_AUDITTOKEN":               reflect.ValueOf(syscall.SYS_CSOPS_AUDITTOKEN),
+		"SYS_DELETE":                         reflect.ValueOf(syscall.SYS_DELETE),
+		"SYS_DUP":                            reflect.ValueOf(syscall.SYS_DUP),
+		"SYS_DUP2":                           reflect.ValueOf(syscall.SYS_DUP2),
+		"SYS_EXCHANGEDATA":                   reflect.ValueOf(syscall.SYS_EXCHANGEDATA),
+		"SYS_EXECVE":                         reflect.ValueOf(syscall.SYS_EXECVE),
+		"SYS_EXIT":                           reflect.ValueOf(syscall.SYS_EXIT),
+		"SYS_FCHDIR":                         reflect.ValueOf(syscall.SYS_FCHDIR),
+		"SYS_FCHFLAGS":                       reflect.ValueOf(syscall.SYS_FCHFLAGS),
+		"SYS_FCHMOD":                         reflect.ValueOf(syscall.SYS_FCHMOD),
+		"SYS_FCHMOD_EXTENDED":                reflect.ValueOf(syscall.SYS_FCHMOD_EXTENDED),
+		"SYS_FCHOWN":                         reflect.ValueOf(syscall.SYS_FCHOWN),
+		"SYS_FCNTL":                          reflect.ValueOf(syscall.SYS_FCNTL),
+		"SYS_FCNTL_NOCANCEL":                 reflect.ValueOf(syscall.SYS_FCNTL_NOCANCEL),
+		"SYS_FDATASYNC":                      reflect.ValueOf(syscall.SYS_FDATASYNC),
+		"SYS_FFSCTL":                         reflect.ValueOf(syscall.SYS_FFSCTL),
+		"SYS_FGETATTRLIST":                   reflect.ValueOf(syscall.SYS_FGETATTRLIST),
+		"SYS_FGETXATTR":                      reflect.ValueOf(syscall.SYS_FGETXATTR),
+		"SYS_FHOPEN":                         reflect.ValueOf(syscall.SYS_FHOPEN),
+		"SYS_FILEPORT_MAKEFD":                reflect.ValueOf(syscall.SYS_FILEPORT_MAKEFD),
+		"SYS_FILEPORT_MAKEPORT":              reflect.ValueOf(syscall.SYS_FILEPORT_MAKEPORT),
+		"SYS_FLISTXATTR":                     reflect.ValueOf(syscall.SYS_FLISTXATTR),
+		"SYS_FLOCK":                          reflect.ValueOf(syscall.SYS_FLOCK),
+		"SYS_FORK":                           reflect.ValueOf(syscall.SYS_FORK),
+		"SYS_FPATHCONF":                      reflect.ValueOf(syscall.SYS_FPATHCONF),
+		"SYS_FREMOVEXATTR":                   reflect.ValueOf(syscall.SYS_FREMOVEXATTR),
+		"SYS_FSCTL":                          reflect.ValueOf(syscall.SYS_FSCTL),
+		"SYS_FSETATTRLIST":                   reflect.ValueOf(syscall.SYS_FSETATTRLIST),
+		"SYS_FSETXATTR":                      reflect.ValueOf(syscall.SYS_FSETXATTR),
+		"SYS_FSGETPATH":                      reflect.ValueOf(syscall.SYS_FSGETPATH),
+		"SYS_FSTAT":                          reflect.ValueOf(syscall.SYS_FSTAT),
+		"SYS_FSTAT64":                        reflect.ValueOf(syscall.SYS_FSTAT64),
+		"SYS_FSTAT64_EXTENDED":               reflect.ValueOf(syscall.SYS_FSTAT64_EXTENDED),
+		"SYS_FSTATFS":                        reflect.ValueOf(syscall.SYS_FSTATFS),
+		"SYS_FSTATFS64":                      reflect.ValueOf(syscall.SYS_FSTATFS64),
+		"SYS_FSTAT_EXTENDED":                 reflect.ValueOf(syscall.SYS_FSTAT_EXTENDED),
+		"SYS_FSYNC":                          reflect.ValueOf(syscall.SYS_FSYNC),
+		"SYS_FSYNC_NOCANCEL":                 reflect.ValueOf(syscall.SYS_FSYNC_NOCANCEL),
+		"SYS_FTRUNCATE":                      reflect.ValueOf(syscall.SYS_FTRUNCATE),
+		"SYS_FUTIMES":                        reflect.ValueOf(syscall.SYS_FUTIMES),
+		"SYS_GETATTRLIST":                    reflect.ValueOf(syscall.SYS_GETATTRLIST),
+		"SYS_GETAUDIT_ADDR":                  reflect.ValueOf(syscall.SYS_GETAUDIT_ADDR),
+		"SYS_GETAUID":                        reflect.ValueOf(syscall.SYS_GETAUID),
+		"SYS_GETDIRENTRIES":                  reflect.ValueOf(syscall.SYS_GETDIRENTRIES),
+		"SYS_GETDIRENTRIES64":                reflect.ValueOf(syscall.SYS_GETDIRENTRIES64),
+		"SYS_GETDIRENTRIESATTR":              reflect.ValueOf(syscall.SYS_GETDIRENTRIESATTR),
+		"SYS_GETDTABLESIZE":                  reflect.ValueOf(syscall.SYS_GETDTABLESIZE),
+		"SYS_GETEGID":                        reflect.ValueOf(syscall.SYS_GETEGID),
+		"SYS_GETEUID":                        reflect.ValueOf(syscall.SYS_GETEUID),
+		"SYS_GETFH":                          reflect.ValueOf(syscall.SYS_GETFH),
+		"SYS_GETFSSTAT":                      reflect.ValueOf(syscall.SYS_GETFSSTAT),
+		"SYS_GETFSSTAT64":                    reflect.ValueOf(syscall.SYS_GETFSSTAT64),
+		"SYS_GETGID":                         reflect.ValueOf(syscall.SYS_GETGID),
+		"SYS_GETGROUPS":                      reflect.ValueOf(syscall.SYS_GETGROUPS),
+		"SYS_GETHOSTUUID":                    reflect.ValueOf(syscall.SYS_GETHOSTUUID),
+		"SYS_GETITIMER":                      reflect.ValueOf(syscall.SYS_GETITIMER),
+		"SYS_GETLCID":                        reflect.ValueOf(syscall.SYS_GETLCID),
+		"SYS_GETLOGIN":                       reflect.ValueOf(syscall.SYS_GETLOGIN),
+		"SYS_GETPEERNAME":                    reflect.ValueOf(syscall.SYS_GETPEERNAME),
+		"SYS_GETPGID":                        reflect.ValueOf(syscall.SYS_GETPGID),
+		"SYS_GETPGRP":                        reflect.ValueOf(syscall.SYS_GETPGRP),
+		"SYS_GETPID":                         reflect.ValueOf(syscall.SYS_GETPID),
+		"SYS_GETPPID":                        reflect.ValueOf(syscall.SYS_GETPPID),
+		"SYS_GETPRIORITY":                    reflect.ValueOf(syscall.SYS_GETPRIORITY),
+		"SYS_GETRLIMIT":                      reflect.ValueOf(syscall.SYS_GETRLIMIT),
+		"SYS_GETRUSAGE":                      reflect.ValueOf(syscall.SYS_GETRUSAGE),
+		"SYS_GETSGROUPS":                     reflect.ValueOf(syscall.SYS_GETSGROUPS),
+		"SYS_GETSID":                         reflect.ValueOf(syscall.SYS_GETSID),
+		"SYS_GETSOCKNAME":                    reflect.ValueOf(syscall.SYS_GETSOCKNAME),
+		"SYS_GETSOCKOPT":                     reflect.ValueOf(syscall.SYS_GETSOCKOPT),
+		"SYS_GETTID":                         reflect.ValueOf(syscall.SYS_GETTID),
+		"SYS_GETTIMEOFDAY":                   reflect.ValueOf(syscall.SYS_GETTIMEOFDAY),
+		"SYS_GETUID":                         reflect.ValueOf(syscall.SYS_GETUID),
+		"SYS_GETWGROUPS":                     reflect.ValueOf(syscall.SYS_GETWGROUPS),
+		"SYS_GETXATTR":                       reflect.ValueOf(syscall.SYS_GETXATTR),
+		"SYS_IDENTITYSVC":                    reflect.ValueOf(syscall.SYS_IDENTITYSVC),
+		"SYS_INITGROUPS":                     reflect.ValueOf(syscall.SYS_INITGROUPS),
+		"SYS_IOCTL":                          reflect.ValueOf(syscall.SYS_IOCTL),
+		"SYS_IOPOLICYSYS":                    reflect.ValueOf(syscall.SYS_IOPOLICYSYS),
+		"SYS_ISSETUGID":                      reflect.ValueOf(syscall.SYS_ISSETUGID),
+		"SYS_KAS_INFO":                       reflect.ValueOf(syscall.SYS_KAS_INFO),
+		"SYS_KDEBUG_TRACE":                   reflect.ValueOf(syscall.SYS_KDEBUG_TRACE),
+		"SYS_KEVENT":                         reflect.ValueOf(syscall.SYS_KEVENT),
+		"SYS_KEVENT64":                       reflect.ValueOf(syscall.SYS_KEVENT64),
+		"SYS_KILL":                           reflect.ValueOf(syscall.SYS_KILL),
+		"SYS_KQUEUE":                         reflect.ValueOf(syscall.SYS_KQUEUE),
+		"SYS_LCHOWN":                         reflect.ValueOf(syscall.SYS_LCHOWN),
+		"SYS_LEDGER":                         reflect.ValueOf(syscall.SYS_LEDGER),
+		"SYS_LINK":                           reflect.ValueOf(syscall.SYS_LINK),
+		"SYS_LIO_LISTIO":                     reflect.ValueOf(syscall.SYS_LIO_LISTIO),
+		"SYS_LISTEN":                         reflect.ValueOf(syscall.SYS_LISTEN),
+		"SYS_LISTXATTR":                      reflect.ValueOf(syscall.SYS_LISTXATTR),
+		"SYS_LSEEK":                          reflect.ValueOf(syscall.SYS_LSEEK),
+		"SYS_LSTAT":                          reflect.ValueOf(syscall.SYS_LSTAT),
+		"SYS_LSTAT64":                        reflect.ValueOf(syscall.SYS_LSTAT64),
+		"SYS_LSTAT64_EXTENDED":               reflect.ValueOf(syscall.SYS_LSTAT64_EXTENDED),
+		"SYS_LSTAT_EXTENDED":                 reflect.ValueOf(syscall.SYS_LSTAT_EXTENDED),
+		"SYS_MADVISE":                        reflect.ValueOf(syscall.SYS_MADVISE),
+		"SYS_MAXSYSCALL":                     reflect.ValueOf(syscall.SYS_MAXSYSCALL),
+		"SYS_MINCORE":                        reflect.ValueOf(syscall.SYS_MINCORE),
+		"SYS_MINHERIT":                       reflect.ValueOf(syscall.SYS_MINHERIT),
+		"SYS_MKDIR":                          reflect.ValueOf(syscall.SYS_MKDIR),
+		"SYS_MKDIR_EXTENDED":                 reflect.ValueOf(syscall.SYS_MKDIR_EXTENDED),
+		"SYS_MKFIFO":                         reflect.ValueOf(syscall.SYS_MKFIFO),
+		"SYS_MKFIFO_EXTENDED":                reflect.ValueOf(syscall.SYS_MKFIFO_EXTENDED),
+		"SYS_MKNOD":                          reflect.ValueOf(syscall.SYS_MKNOD),
+		"SYS_MLOCK":                          reflect.ValueOf(syscall.SYS_MLOCK),
+		"SYS_MLOCKALL":                       reflect.ValueOf(syscall.SYS_MLOCKALL),
+		"SYS_MMAP":                           reflect.ValueOf(syscall.SYS_MMAP),
+		"SYS_MODWATCH":                       reflect.ValueOf(syscall.SYS_MODWATCH),
+		"SYS_MOUNT":                          reflect.ValueOf(syscall.SYS_MOUNT),
+		"SYS_MPROTECT":                       reflect.ValueOf(syscall.SYS_MPROTECT),
+		"SYS_MSGCTL":                         reflect.ValueOf(syscall.SYS_MSGCTL),
+		"SYS_MSGGET":                         reflect.ValueOf(syscall.SYS_MSGGET),
+		"SYS_MSGRCV":                         reflect.ValueOf(syscall.SYS_MSGRCV),
+		"SYS_MSGRCV_NOCANCEL":                reflect.ValueOf(syscall.SYS_MSGRCV_NOCANCEL),
+		"SYS_MSGSND":                         reflect.ValueOf(syscall.SYS_MSGSND),
+		"SYS_MSGSND_NOCANCEL":                reflect.ValueOf(syscall.SYS_MSGSND_NOCANCEL),
+		"SYS_MSGSYS":                         reflect.ValueOf(syscall.SYS_MSGSYS),
+		"SYS_MSYNC":                          reflect.ValueOf(syscall.SYS_MSYNC),
+		"SYS_MSYNC_NOCANCEL":                 reflect.ValueOf(syscall.SYS_MSYNC_NOCANCEL),
+		"SYS_MUNLOCK":                        reflect.ValueOf(syscall.SYS_MUNLOCK),
+		"SYS_MUNLOCKALL":                     reflect.ValueOf(syscall.SYS_MUNLOCKALL),
+		"SYS_MUNMAP":                         reflect.ValueOf(syscall.SYS_MUNMAP),
+		"SYS_NFSCLNT":                        reflect.ValueOf(syscall.SYS_NFSCLNT),
+		"SYS_NFSSVC":                         reflect.ValueOf(syscall.SYS_NFSSVC),
+		"SYS_OPEN":                           reflect.ValueOf(syscall.SYS_OPEN),
+		"SYS_OPEN_DPROTECTED_NP":             reflect.ValueOf(syscall.SYS_OPEN_DPROTECTED_NP),
+		"SYS_OPEN_EXTENDED":                  reflect.ValueOf(syscall.SYS_OPEN_EXTENDED),
+		"SYS_OPEN_NOCANCEL":                  reflect.ValueOf(syscall.SYS_OPEN_NOCANCEL),
+		"SYS_PATHCONF":                       reflect.ValueOf(syscall.SYS_PATHCONF),
+		"SYS_PID_HIBERNATE":                  reflect.ValueOf(syscall.SYS_PID_HIBERNATE),
+		"SYS_PID_RESUME":                     reflect.ValueOf(syscall.SYS_PID_RESUME),
+		"SYS_PID_SHUTDOWN_SOCKETS":           reflect.ValueOf(syscall.SYS_PID_SHUTDOWN_SOCKETS),
+		"SYS_PID_SUSPEND":                    reflect.ValueOf(syscall.SYS_PID_SUSPEND),
+		"SYS_PIPE":                           reflect.ValueOf(syscall.SYS_PIPE),
+		"SYS_POLL":                           reflect.ValueOf(syscall.SYS_POLL),
+		"SYS_POLL_NOCANCEL":                  reflect.ValueOf(syscall.SYS_POLL_NOCANCEL),
+		"SYS_POSIX_SPAWN":                    reflect.ValueOf(syscall.SYS_POSIX_SPAWN),
+		"SYS_PREAD":                          reflect.ValueOf(syscall.SYS_PREAD),
+		"SYS_PREAD_NOCANCEL":                 reflect.ValueOf(syscall.SYS_PREAD_NOCANCEL),
+		"SYS_PROCESS_POLICY":                 reflect.ValueOf(syscall.SYS_PROCESS_POLICY),
+		"SYS_PROC_INFO":                      reflect.ValueOf(syscall.SYS_PROC_INFO),
+		"SYS_PSYNCH_CVBROAD":                 reflect.ValueOf(syscall.SYS_PSYNCH_CVBROAD),
+		"SYS_PSYNCH_CVCLRPREPOST":            reflect.ValueOf(syscall.SYS_PSYNCH_CVCLRPREPOST),
+		"SYS_PSYNCH_CVSIGNAL":                reflect.ValueOf(syscall.SYS_PSYNCH_CVSIGNAL),
+		"SYS_PSYNCH_CVWAIT":                  reflect.ValueOf(syscall.SYS_PSYNCH_CVWAIT),
+		"SYS_PSYNCH_MUTEXDROP":               reflect.ValueOf(syscall.SYS_PSYNCH_MUTEXDROP),
+		"SYS_PSYNCH_MUTEXWAIT":               reflect.ValueOf(syscall.SYS_PSYNCH_MUTEXWAIT),
+		"SYS_PSYNCH_RW_DOWNGRADE":            reflect.ValueOf(syscall.SYS_PSYNCH_RW_DOWNGRADE),
+		"SYS_PSYNCH_RW_LONGRDLOCK":           reflect.ValueOf(syscall.SYS_PSYNCH_RW_LONGRDLOCK),
+		"SYS_PSYNCH_RW_RDLOCK":               reflect.ValueOf(syscall.SYS_PSYNCH_RW_RDLOCK),
+		"SYS_PSYNCH_RW_UNLOCK":               reflect.ValueOf(syscall.SYS_PSYNCH_RW_UNLOCK),
+		"SYS_PSYNCH_RW_UNLOCK2":              reflect.ValueOf(syscall.SYS_PSYNCH_RW_UNLOCK2),
+		"SYS_PSYNCH_RW_UPGRADE":              reflect.ValueOf(syscall.SYS_PSYNCH_RW_UPGRADE),
+		"SYS_PSYNCH_RW_WRLOCK":               reflect.ValueOf(syscall.SYS_PSYNCH_RW_WRLOCK),
+		"SYS_PSYNCH_RW_YIELDWRLOCK":          reflect.ValueOf(syscall.SYS_PSYNCH_RW_YIELDWRLOCK),
+		"SYS_PTRACE":                         reflect.ValueOf(syscall.SYS_PTRACE),
+		"SYS_PWRITE":                         reflect.ValueOf(syscall.SYS_PWRITE),
+		"SYS_PWRITE_NOCANCEL":                reflect.ValueOf(syscall.SYS_PWRITE_NOCANCEL),
+		"SYS_QUOTACTL":                       reflect.ValueOf(syscall.SYS_QUOTACTL),
+		"SYS_READ":                           reflect.ValueOf(syscall.SYS_READ),
+		"SYS_READLINK":                       reflect.ValueOf(syscall.SYS_READLINK),
+		"SYS_READV":                          reflect.ValueOf(syscall.SYS_READV),
+		"SYS_READV_NOCANCEL":                 reflect.ValueOf(syscall.SYS_READV_NOCANCEL),
+		"SYS_READ_NOCANCEL":                  reflect.ValueOf(syscall.SYS_READ_NOCANCEL),
+		"SYS_REBOOT":                         reflect.ValueOf(syscall.SYS_REBOOT),
+		"SYS_RECVFROM":                       reflect.ValueOf(syscall.SYS_RECVFROM),
+		"SYS_RECVFROM_NOCANCEL":              reflect.ValueOf(syscall.SYS_RECVFROM_NOCANCEL),
+		"SYS_RECVMSG":                        reflect.ValueOf(syscall.SYS_RECVMSG),
+		"SYS_RECVMSG_NOCANCEL":               reflect.ValueOf(syscall.SYS_RECVMSG_NOCANCEL),
+		"SYS_REMOVEXATTR":                    reflect.ValueOf(syscall.SYS_REMOVEXATTR),
+		"SYS_RENAME":                         reflect.ValueOf(syscall.SYS_RENAME),
+		"SYS_REVOKE":                         reflect.ValueOf(syscall.SYS_REVOKE),
+		"SYS_RMDIR":                          reflect.ValueOf(syscall.SYS_RMDIR),
+		"SYS_SEARCHFS":                       reflect.ValueOf(syscall.SYS_SEARCHFS),
+		"SYS_SELECT":                         reflect.ValueOf(syscall.SYS_SELECT),
+		"SYS_SELECT_NOCANCEL":                reflect.ValueOf(syscall.SYS_SELECT_NOCANCEL),
+		"SYS_SEMCTL":                         reflect.ValueOf(syscall.SYS_SEMCTL),
+		"SYS_SEMGET":                         reflect.ValueOf(syscall.SYS_SEMGET),
+		"SYS_SEMOP":                          reflect.ValueOf(syscall.SYS_SEMOP),
+		"SYS_SEMSYS":                         reflect.ValueOf(syscall.SYS_SEMSYS),
+		"SYS_SEM_CLOSE":                      reflect.ValueOf(syscall.SYS_SEM_CLOSE),
+		"SYS_SEM_DESTROY":                    reflect.ValueOf(syscall.SYS_SEM_DESTROY),
+		"SYS_SEM_GETVALUE":                   reflect.ValueOf(syscall.SYS_SEM_GETVALUE),
+		"SYS_SEM_INIT":                       reflect.ValueOf(syscall.SYS_SEM_INIT),
+		"SYS_SEM_OPEN":                       reflect.ValueOf(syscall.SYS_SEM_OPEN),
+		"SYS_SEM_POST":                       reflect.ValueOf(syscall.SYS_SEM_POST),
+		"SYS_SEM_TRYWAIT":                    reflect.ValueOf(syscall.SYS_SEM_TRYWAIT),
+		"SYS_SEM_UNLINK":                     reflect.ValueOf(syscall.SYS_SEM_UNLINK),
+		"SYS_SEM_WAIT":                       reflect.ValueOf(syscall.SYS_SEM_WAIT),
+		"SYS_SEM_WAIT_NOCANCEL":              reflect.ValueOf(syscall.SYS_SEM_WAIT_NOCANCEL),
+		"SYS_SENDFILE":                       reflect.ValueOf(syscall.SYS_SENDFILE),
+		"SYS_SENDMSG":                        reflect.ValueOf(syscall.SYS_SENDMSG),
+		"SYS_SENDMSG_NOCANCEL":               reflect.ValueOf(syscall.SYS_SENDMSG_NOCANCEL),
+		"SYS_SENDTO":                         reflect.ValueOf(syscall.SYS_SENDTO),
+		"SYS_SENDTO_NOCANCEL":                reflect.ValueOf(syscall.SYS_SENDTO_NOCANCEL),
+		"SYS_SETATTRLIST":                    reflect.ValueOf(syscall.SYS_SETATTRLIST),
+		"SYS_SETAUDIT_ADDR":                  reflect.ValueOf(syscall.SYS_SETAUDIT_ADDR),
+		"SYS_SETAUID":                        reflect.ValueOf(syscall.SYS_SETAUID),
+		"SYS_SETEGID":                        reflect.ValueOf(syscall.SYS_SETEGID),
+		"SYS_SETEUID":                        reflect.ValueOf(syscall.SYS_SETEUID),
+		"SYS_SETGID":                         reflect.ValueOf(syscall.SYS_SETGID),
+		"SYS_SETGROUPS":                      reflect.ValueOf(syscall.SYS_SETGROUPS),
+		"SYS_SETITIMER":                      reflect.ValueOf(syscall.SYS_SETITIMER),
+		"SYS_SETLCID":                        reflect.ValueOf(syscall.SYS_SETLCID),
+		"SYS_SETLOGIN":                       reflect.ValueOf(syscall.SYS_SETLOGIN),
+		"SYS_SETPGID":                        reflect.ValueOf(syscall.SYS_SETPGID),
+		"SYS_SETPRIORITY":                    reflect.ValueOf(syscall.SYS_SETPRIORITY),
+		"SYS_SETPRIVEXEC":                    reflect.ValueOf(syscall.SYS_SETPRIVEXEC),
+		"SYS_SETREGID":                       reflect.ValueOf(syscall.SYS_SETREGID),
+		"SYS_SETREUID":                       reflect.ValueOf(syscall.SYS_SETREUID),
+		"SYS_SETRLIMIT":                      reflect.ValueOf(syscall.SYS_SETRLIMIT),
+		"SYS_SETSGROUPS":                     reflect.ValueOf(syscall.SYS_SETSGROUPS),
+		"SYS_SETSID":                         reflect.ValueOf(syscall.SYS_SETSID),
+		"SYS_SETSOCKOPT":                     reflect.ValueOf(syscall.SYS_SETSOCKOPT),
+		"SYS_SETTID":                         reflect.ValueOf(syscall.SYS_SETTID),
+		"SYS_SETTID_WITH_PID":                reflect.ValueOf(syscall.SYS_SETTID_WITH_PID),
+		"SYS_SETTIMEOFDAY":                   reflect.ValueOf(syscall.SYS_SETTIMEOFDAY),
+		"SYS_SETUID":                         reflect.ValueOf(syscall.SYS_SETUID),
+		"SYS_SETWGROUPS":                     reflect.ValueOf(syscall.SYS_SETWGROUPS),
+		"SYS_SETXATTR":                       reflect.ValueOf(syscall.SYS_SETXATTR),
+		"SYS_SHARED_REGION_CHECK_NP":         reflect.ValueOf(syscall.SYS_SHARED_REGION_CHECK_NP),
+		"SYS_SHARED_REGION_MAP_AND_SLIDE_NP": reflect.ValueOf(syscall.SYS_SHARED_REGION_MAP_AND_SLIDE_NP),
+		"SYS_SHMAT":                          reflect.ValueOf(syscall.SYS_SHMAT),
+		"SYS_SHMCTL":                         reflect.ValueOf(syscall.SYS_SHMCTL),
+		"SYS_SHMDT":                          reflect.ValueOf(syscall.SYS_SHMDT),
+		"SYS_SHMGET":                         reflect.ValueOf(syscall.SYS_SHMGET),
+		"SYS_SHMSYS":                         reflect.ValueOf(syscall.SYS_SHMSYS),
+		"SYS_SHM_OPEN":                       reflect.ValueOf(syscall.SYS_SHM_OPEN),
+		"SYS_SHM_UNLINK":                     reflect.ValueOf(syscall.SYS_SHM_UNLINK),
+		"SYS_SHUTDOWN":                       reflect.ValueOf(syscall.SYS_SHUTDOWN),
+		"SYS_SIGACTION":                      reflect.ValueOf(syscall.SYS_SIGACTION),
+		"SYS_SIGALTSTACK":                    reflect.ValueOf(syscall.SYS_SIGALTSTACK),
+		"SYS_SIGPENDING":                     reflect.ValueOf(syscall.SYS_SIGPENDING),
+		"SYS_SIGPROCMASK":                    reflect.ValueOf(syscall.SYS_SIGPROCMASK),
+		"SYS_SIGRETURN":                      reflect.ValueOf(syscall.SYS_SIGRETURN),
+		"SYS_SIGSUSPEND":                     reflect.ValueOf(syscall.SYS_SIGSUSPEND),
+		"SYS_SIGSUSPEND_NOCANCEL":            reflect.ValueOf(syscall.SYS_SIGSUSPEND_NOCANCEL),
+		"SYS_SOCKET":                         reflect.ValueOf(syscall.SYS_SOCKET),
+		"SYS_SOCKETPAIR":                     reflect.ValueOf(syscall.SYS_SOCKETPAIR),
+		"SYS_STACK_SNAPSHOT":                 reflect.ValueOf(syscall.SYS_STACK_SNAPSHOT),
+		"SYS_STAT":                           reflect.ValueOf(syscall.SYS_STAT),
+		"SYS_STAT64":                         reflect.ValueOf(syscall.SYS_STAT64),
+		"SYS_STAT64_EXTENDED":                reflect.ValueOf(syscall.SYS_STAT64_EXTENDED),
+		"SYS_STATFS":                         reflect.ValueOf(syscall.SYS_STATFS),
+		"SYS_STATFS64":                       reflect.ValueOf(syscall.SYS_STATFS64),
+		"SYS_STAT_EXTENDED":                  reflect.ValueOf(syscall.SYS_STAT_EXTENDED),
+		"SYS_SWAPON":                         reflect.ValueOf(syscall.SYS_SWAPON),
+		"SYS_SYMLINK":                        reflect.ValueOf(syscall.SYS_SYMLINK),
+		"SYS_SYNC":                           reflect.ValueOf(syscall.SYS_SYNC),
+		"SYS_SYSCALL":                        reflect.ValueOf(syscall.SYS_SYSCALL),
+		"SYS_THREAD_SELFID":                  reflect.ValueOf(syscall.SYS_THREAD_SELFID),
+		"SYS_TRUNCATE":                       reflect.ValueOf(syscall.SYS_TRUNCATE),
+		"SYS_UMASK":                          reflect.ValueOf(syscall.SYS_UMASK),
+		"SYS_UMASK_EXTENDED":                 reflect.ValueOf(syscall.SYS_UMASK_EXTENDED),
+		"SYS_UNDELETE":                       reflect.ValueOf(syscall.SYS_UNDELETE),
+		"SYS_UNLINK":                         reflect.ValueOf(syscall.SYS_UNLINK),
+		"SYS_UNMOUNT":                        reflect.ValueOf(syscall.SYS_UNMOUNT),
+		"SYS_UTIMES":                         reflect.ValueOf(syscall.SYS_UTIMES),
+		"SYS_VFORK":                          reflect.ValueOf(syscall.SYS_VFORK),
+		"SYS_VM_PRESSURE_MONITOR":            reflect.ValueOf(syscall.SYS_VM_PRESSURE_MONITOR),
+		"SYS_WAIT4":                          reflect.ValueOf(syscall.SYS_WAIT4),
+		"SYS_WAIT4_NOCANCEL":                 reflect.ValueOf(syscall.SYS_WAIT4_NOCANCEL),
+		"SYS_WAITEVENT":                      reflect.ValueOf(syscall.SYS_WAITEVENT),
+		"SYS_WAITID":                         reflect.ValueOf(syscall.SYS_WAITID),
+		"SYS_WAITID_NOCANCEL":                reflect.ValueOf(syscall.SYS_WAITID_NOCANCEL),
+		"SYS_WATCHEVENT":                     reflect.ValueOf(syscall.SYS_WATCHEVENT),
+		"SYS_WORKQ_KERNRETURN":               reflect.ValueOf(syscall.SYS_WORKQ_KERNRETURN),
+		"SYS_WORKQ_OPEN":                     reflect.ValueOf(syscall.SYS_WORKQ_OPEN),
+		"SYS_WRITE":                          reflect.ValueOf(syscall.SYS_WRITE),
+		"SYS_WRITEV":                         reflect.ValueOf(syscall.SYS_WRITEV),
+		"SYS_WRITEV_NOCANCEL":                reflect.ValueOf(syscall.SYS_WRITEV_NOCANCEL),
+		"SYS_WRITE_NOCANCEL":                 reflect.ValueOf(syscall.SYS_WRITE_NOCANCEL),
+		"SYS___DISABLE_THREADSIGNAL":         reflect.ValueOf(syscall.SYS___DISABLE_THREADSIGNAL),
+		"SYS___MAC_EXECVE":                   reflect.ValueOf(syscall.SYS___MAC_EXECVE),
+		"SYS___MAC_GETFSSTAT":                reflect.ValueOf(syscall.SYS___MAC_GETFSSTAT),
+		"SYS___MAC_GET_FD":                   reflect.ValueOf(syscall.SYS___MAC_GET_FD),
+		"SYS___MAC_GET_FILE":                 reflect.ValueOf(syscall.SYS___MAC_GET_FILE),
+		"SYS___MAC_GET_LCID":                 reflect.ValueOf(syscall.SYS___MAC_GET_LCID),
+		"SYS___MAC_GET_LCTX":                 reflect.ValueOf(syscall.SYS___MAC_GET_LCTX),
+		"SYS___MAC_GET_LINK":                 reflect.ValueOf(syscall.SYS___MAC_GET_LINK),
+		"SYS___MAC_GET_MOUNT":                reflect.ValueOf(syscall.SYS___MAC_GET_MOUNT),
+		"SYS___MAC_GET_PID":                  reflect.ValueOf(syscall.SYS___MAC_GET_PID),
+		"SYS___MAC_GET_PROC":                 reflect.ValueOf(syscall.SYS___MAC_GET_PROC),
+		"SYS___MAC_MOUNT":                    reflect.ValueOf(syscall.SYS___MAC_MOUNT),
+		"SYS___MAC_SET_FD":                   reflect.ValueOf(syscall.SYS___MAC_SET_FD),
+		"SYS___MAC_SET_FILE":                 reflect.ValueOf(syscall.SYS___MAC_SET_FILE),
+		"SYS___MAC_SET_LCTX":                 reflect.ValueOf(syscall.SYS___MAC_SET_LCTX),
+		"SYS___MAC_SET_LINK":                 reflect.ValueOf(syscall.SYS___MAC_SET_LINK),
+		"SYS___MAC_SET_PROC":                 reflect.ValueOf(syscall.SYS___MAC_SET_PROC),
+		"SYS___MAC_SYSCALL":                  reflect.ValueOf(syscall.SYS___MAC_SYSCALL),
+		"SYS___OLD_SEMWAIT_SIGNAL":           reflect.ValueOf(syscall.SYS___OLD_SEMWAIT_SIGNAL),
+		"SYS___OLD_SEMWAIT_SIGNAL_NOCANCEL":  reflect.ValueOf(syscall.SYS___OLD_SEMWAIT_SIGNAL_NOCANCEL),
+		"SYS___PTHREAD_CANCELED":             reflect.ValueOf(syscall.SYS___PTHREAD_CANCELED),
+		"SYS___PTHREAD_CHDIR":                reflect.ValueOf(syscall.SYS___PTHREAD_CHDIR),
+		"SYS___PTHREAD_FCHDIR":               reflect.ValueOf(syscall.SYS___PTHREAD_FCHDIR),
+		"SYS___PTHREAD_KILL":                 reflect.ValueOf(syscall.SYS___PTHREAD_KILL),
+		"SYS___PTHREAD_MARKCANCEL":           reflect.ValueOf(syscall.SYS___PTHREAD_MARKCANCEL),
+		"SYS___PTHREAD_SIGMASK":              reflect.ValueOf(syscall.SYS___PTHREAD_SIGMASK),
+		"SYS___SEMWAIT_SIGNAL":               reflect.ValueOf(syscall.SYS___SEMWAIT_SIGNAL),
+		"SYS___SEMWAIT_SIGNAL_NOCANCEL":      reflect.ValueOf(syscall.SYS___SEMWAIT_SIGNAL_NOCANCEL),
+		"SYS___SIGWAIT":                      reflect.ValueOf(syscall.SYS___SIGWAIT),
+		"SYS___SIGWAIT_NOCANCEL":             reflect.ValueOf(syscall.SYS___SIGWAIT_NOCANCEL),
+		"SYS___SYSCTL":                       reflect.ValueOf(syscall.SYS___SYSCTL),
+		"S_IEXEC":                            reflect.ValueOf(syscall.S_IEXEC),
+		"S_IFBLK":                            reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                            reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                            reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                            reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                            reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                             reflect.ValueOf(syscall.S_IFMT),
+		"S_IFREG":                            reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                           reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IFWHT":                            reflect.ValueOf(syscall.S_IFWHT),
+		"S_IREAD":                            reflect.ValueOf(syscall.S_IREAD),
+		"S_IRGRP":                            reflect.ValueOf(syscall.S_IRGRP),
+		"S_IROTH":                            reflect.ValueOf(syscall.S_IROTH),
+		"S_IRUSR":                            reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                            reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                            reflect.ValueOf(syscall.S_IRWXO),
+		"S_IRWXU":                            reflect.ValueOf(syscall.S_IRWXU),
+		"S_ISGID":                            reflect.ValueOf(syscall.S_ISGID),
+		"S_ISTXT":                            reflect.ValueOf(syscall.S_ISTXT),
+		"S_ISUID":                            reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                            reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWGRP":                            reflect.ValueOf(syscall.S_IWGRP),
+		"S_IWOTH":                            reflect.ValueOf(syscall.S_IWOTH),
+		"S_IWRITE":                           reflect.ValueOf(syscall.S_IWRITE),
+		"S_IWUSR":                            reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXGRP":                            reflect.ValueOf(syscall.S_IXGRP),
+		"S_IXOTH":                            reflect.ValueOf(syscall.S_IXOTH),
+		"S_IXUSR":                            reflect.ValueOf(syscall.S_IXUSR),
+		"Seek":                               reflect.ValueOf(syscall.Seek),
+		"Select":                             reflect.ValueOf(syscall.Select),
+		"Sendfile":                           reflect.ValueOf(syscall.Sendfile),
+		"Sendmsg":                            reflect.ValueOf(syscall.Sendmsg),
+		"SendmsgN":                           reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                             reflect.ValueOf(syscall.Sendto),
+		"SetBpf":                             reflect.ValueOf(syscall.SetBpf),
+		"SetBpfBuflen":                       reflect.ValueOf(syscall.SetBpfBuflen),
+		"SetBpfDatalink":                     reflect.ValueOf(syscall.SetBpfDatalink),
+		"SetBpfHeadercmpl":                   reflect.ValueOf(syscall.SetBpfHeadercmpl),
+		"SetBpfImmediate":                    reflect.ValueOf(syscall.SetBpfImmediate),
+		"SetBpfInterface":                    reflect.ValueOf(syscall.SetBpfInterface),
+		"SetBpfPromisc":                      reflect.ValueOf(syscall.SetBpfPromisc),
+		"SetBpfTimeout":                      reflect.ValueOf(syscall.SetBpfTimeout),
+		"SetKevent":                          reflect.ValueOf(syscall.SetKevent),
+		"SetNonblock":                        reflect.ValueOf(syscall.SetNonblock),
+		"Setegid":                            reflect.ValueOf(syscall.Setegid),
+		"Setenv":                             reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                            reflect.ValueOf(syscall.Seteuid),
+		"Setgid":                             reflect.ValueOf(syscall.Setgid),
+		"Setgroups":                          reflect.ValueOf(syscall.Setgroups),
+		"Setlogin":                           reflect.ValueOf(syscall.Setlogin),
+		"Setpgid":                            reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                        reflect.ValueOf(syscall.Setpriority),
+		"Setprivexec":                        reflect.ValueOf(syscall.Setprivexec),
+		"Setregid":                           reflect.ValueOf(syscall.Setregid),
+		"Setreuid":                           reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                          reflect.ValueOf(syscall.Setrlimit),
+		"Setsid":                             reflect.ValueOf(syscall.Setsid),
+		"SetsockoptByte":                     reflect.ValueOf(syscall.SetsockoptByte),
+		"SetsockoptICMPv6Filter":             reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
+		"SetsockoptIPMreq":                   reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPv6Mreq":                 reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":                reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                      reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":                   reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptString":                   reflect.ValueOf(syscall.SetsockoptString),
+		"SetsockoptTimeval":                  reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Settimeofday":                       reflect.ValueOf(syscall.Settimeofday),
+		"Setuid":                             reflect.ValueOf(syscall.Setuid),
+		"Shutdown":                           reflect.ValueOf(syscall.Shutdown),
+		"SizeofBpfHdr":                       reflect.ValueOf(syscall.SizeofBpfHdr),
+		"SizeofBpfInsn":                      reflect.ValueOf(syscall.SizeofBpfInsn),
+		"SizeofBpfProgram":                   reflect.ValueOf(syscall.SizeofBpfProgram),
+		"SizeofBpfStat":                      reflect.ValueOf(syscall.SizeofBpfStat),
+		"SizeofBpfVersion":                   reflect.ValueOf(syscall.SizeofBpfVersion),
+		"SizeofCmsghdr":                      reflect.ValueOf(syscall.SizeofCmsghdr),
+		"SizeofICMPv6Filter":                 reflect.ValueOf(syscall.SizeofICMPv6Filter),
+		"SizeofIPMreq":                       reflect.ValueOf(syscall.SizeofIPMreq),
+		"SizeofIPv6MTUInfo":                  reflect.ValueOf(syscall.SizeofIPv6MTUInfo),
+		"SizeofIPv6Mreq":                     reflect.ValueOf(syscall.SizeofIPv6Mreq),
+		"SizeofIfData":                       reflect.ValueOf(syscall.SizeofIfData),
+		"SizeofIfMsghdr":                     reflect.ValueOf(syscall.SizeofIfMsghdr),
+		"SizeofIfaMsghdr":                    reflect.ValueOf(syscall.SizeofIfaMsghdr),
+		"SizeofIfmaMsghdr":                   reflect.ValueOf(syscall.SizeofIfmaMsghdr),
+		"SizeofIfmaMsghdr2":                  reflect.ValueOf(syscall.SizeofIfmaMsghdr2),
+		"SizeofInet4Pktinfo":                 reflect.ValueOf(syscall.SizeofInet4Pktinfo),
+		"SizeofInet6Pktinfo":                 reflect.ValueOf(syscall.SizeofInet6Pktinfo),
+		"SizeofLinger":                       reflect.ValueOf(syscall.SizeofLinger),
+		"SizeofMsghdr":                       reflect.ValueOf(syscall.SizeofMsghdr),
+		"SizeofRtMetrics":                    reflect.ValueOf(syscall.SizeofRtMetrics),
+		"SizeofRtMsghdr":                     reflect.ValueOf(syscall.SizeofRtMsghdr),
+		"SizeofSockaddrAny":                  reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrDatalink":             reflect.ValueOf(syscall.SizeofSockaddrDatalink),
+		"SizeofSockaddrInet4":                reflect.ValueOf(syscall.SizeofSockaddrInet4),
+		"SizeofSockaddrInet6":                reflect.ValueOf(syscall.SizeofSockaddrInet6),
+		"SizeofSockaddrUnix":                 reflect.ValueOf(syscall.SizeofSockaddrUnix),
+		"SlicePtrFromStrings":                reflect.ValueOf(syscall.SlicePtrFromStrings),
+		"Socket":                             reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":                  reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"Socketpair":                         reflect.ValueOf(syscall.Socketpair),
+		"StartProcess":                       reflect.ValueOf(syscall.StartProcess),
+		"Stat":                               reflect.ValueOf(syscall.Stat),
+		"Statfs":                             reflect.ValueOf(syscall.Statfs),
+		"Stderr":                             reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                              reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                             reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                      reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":                    reflect.ValueOf(syscall.StringByteSlice),
+		"StringSlicePtr":                     reflect.ValueOf(syscall.StringSlicePtr),
+		"Symlink":                            reflect.ValueOf(syscall.Symlink),
+		"Sync":                               reflect.ValueOf(syscall.Sync),
+		"Syscall":                            reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                           reflect.ValueOf(syscall.Syscall6),
+		"Syscall9":                           reflect.ValueOf(syscall.Syscall9),
+		"Sysctl":                             reflect.ValueOf(syscall.Sysctl),
+		"SysctlUint32":                       reflect.ValueOf(syscall.SysctlUint32),
+		"TCIFLUSH":                           reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                          reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                           reflect.ValueOf(syscall.TCOFLUSH),
+		"TCP_CONNECTIONTIMEOUT":              reflect.ValueOf(syscall.TCP_CONNECTIONTIMEOUT),
+		"TCP_ENABLE_ECN":                     reflect.ValueOf(syscall.TCP_ENABLE_ECN),
+		"TCP_KEEPALIVE":                      reflect.ValueOf(syscall.TCP_KEEPALIVE),
+		"TCP_KEEPCNT":                        reflect.ValueOf(syscall.TCP_KEEPCNT),
+		"TCP_KEEPINTVL":                      reflect.ValueOf(syscall.TCP_KEEPINTVL),
+		"TCP_MAXHLEN":                        reflect.ValueOf(syscall.TCP_MAXHLEN),
+		"TCP_MAXOLEN":                        reflect.ValueOf(syscall.TCP_MAXOLEN),
+		"TCP_MAXSEG":                         reflect.ValueOf(syscall.TCP_MAXSEG),
+		"TCP_MAXWIN":                         reflect.ValueOf(syscall.TCP_MAXWIN),
+		"TCP_MAX_SACK":                       reflect.ValueOf(syscall.TCP_MAX_SACK),
+		"TCP_MAX_WINSHIFT":                   reflect.ValueOf(syscall.TCP_MAX_WINSHIFT),
+		"TCP_MINMSS":                         reflect.ValueOf(syscall.TCP_MINMSS),
+		"TCP_MSS":                            reflect.ValueOf(syscall.TCP_MSS),
+		"TCP_NODELAY":                        reflect.ValueOf(syscall.TCP_NODELAY),
+		"TCP_NOOPT":                          reflect.ValueOf(syscall.TCP_NOOPT),
+		"TCP_NOPUSH":                         reflect.ValueOf(syscall.TCP_NOPUSH),
+		"TCP_NOTSENT_LOWAT":                  reflect.ValueOf(syscall.TCP_NOTSENT_LOWAT),
+		"TCP_RXT_CONNDROPTIME":               reflect.ValueOf(syscall.TCP_RXT_CONNDROPTIME),
+		"TCP_RXT_FINDROP":                    reflect.ValueOf(syscall.TCP_RXT_FINDROP),
+		"TCP_SENDMOREACKS":                   reflect.ValueOf(syscall.TCP_SENDMOREACKS),
+		"TCSAFLUSH":                          reflect.ValueOf(syscall.TCSAFLUSH),
+		"TIOCCBRK":                           reflect.ValueOf(syscall.TIOCCBRK),
+		"TIOCCDTR":                           reflect.ValueOf(syscall.TIOCCDTR),
+		"TIOCCONS":                           reflect.ValueOf(uint32(syscall.TIOCCONS)),
+		"TIOCDCDTIMESTAMP":                   reflect.ValueOf(syscall.TIOCDCDTIMESTAMP),
+		"TIOCDRAIN":                          reflect.ValueOf(syscall.TIOCDRAIN),
+		"TIOCDSIMICROCODE":                   reflect.ValueOf(syscall.TIOCDSIMICROCODE),
+		"TIOCEXCL":                           reflect.ValueOf(syscall.TIOCEXCL),
+		"TIOCEXT":                            reflect.ValueOf(uint32(syscall.TIOCEXT)),
+		"TIOCFLUSH":                          reflect.ValueOf(uint32(syscall.TIOCFLUSH)),
+		"TIOCGDRAINWAIT":                     reflect.ValueOf(syscall.TIOCGDRAINWAIT),
+		"TIOCGETA":                           reflect.ValueOf(syscall.TIOCGETA),
+		"TIOCGETD":                           reflect.ValueOf(syscall.TIOCGETD),
+		"TIOCGPGRP":                          reflect.ValueOf(syscall.TIOCGPGRP),
+		"TIOCGWINSZ":                         reflect.ValueOf(syscall.TIOCGWINSZ),
+		"TIOCIXOFF":                          reflect.ValueOf(syscall.TIOCIXOFF),
+		"TIOCIXON":                           reflect.ValueOf(syscall.TIOCIXON),
+		"TIOCMBIC":                           reflect.ValueOf(uint32(syscall.TIOCMBIC)),
+		"TIOCMBIS":                           reflect.ValueOf(uint32(syscall.TIOCMBIS)),
+		"TIOCMGDTRWAIT":                      reflect.ValueOf(syscall.TIOCMGDTRWAIT),
+		"TIOCMGET":                           reflect.ValueOf(syscall.TIOCMGET),
+		"TIOCMODG":                           reflect.ValueOf(syscall.TIOCMODG),
+		"TIOCMODS":                           reflect.ValueOf(uint32(syscall.TIOCMODS)),
+		"TIOCMSDTRWAIT":                      reflect.ValueOf(uint32(syscall.TIOCMSDTRWAIT)),
+		"TIOCMSET":                           reflect.ValueOf(uint32(syscall.TIOCMSET)),
+		"TIOCM_CAR":                          reflect.ValueOf(syscall.TIOCM_CAR),
+		"TIOCM_CD":                           reflect.ValueOf(syscall.TIOCM_CD),
+		"TIOCM_CTS":                          reflect.ValueOf(syscall.TIOCM_CTS),
+		"TIOCM_DSR":                          reflect.ValueOf(syscall.TIOCM_DSR),
+		"TIOCM_DTR":                          reflect.ValueOf(syscall.TIOCM_DTR),
+		"TIOCM_LE":                           reflect.ValueOf(syscall.TIOCM_LE),
+		"TIOCM_RI":                           reflect.ValueOf(syscall.TIOCM_RI),
+		"TIOCM_RNG":                          reflect.ValueOf(syscall.TIOCM_RNG),
+		"TIOCM_RTS":                          reflect.ValueOf(syscall.TIOCM_RTS),
+		"TIOCM_SR":                           reflect.ValueOf(syscall.TIOCM_SR),
+		"TIOCM_ST":                           reflect.ValueOf(syscall.TIOCM_ST),
+		"TIOCNOTTY":                          reflect.ValueOf(syscall.TIOCNOTTY),
+		"TIOCNXCL":                           reflect.ValueOf(syscall.TIOCNXCL),
+		"TIOCOUTQ":                           reflect.ValueOf(syscall.TIOCOUTQ),
+		"TIOCPKT":                            reflect.ValueOf(uint32(syscall.TIOCPKT)),
+		"TIOCPKT_DATA":                       reflect.ValueOf(syscall.TIOCPKT_DATA),
+		"TIOCPKT_DOSTOP":                     reflect.ValueOf(syscall.TIOCPKT_DOSTOP),
+		"TIOCPKT_FLUSHREAD":                  reflect.ValueOf(syscall.TIOCPKT_FLUSHREAD),
+		"TIOCPKT_FLUSHWRITE":                 reflect.ValueOf(syscall.TIOCPKT_FLUSHWRITE),
+		"TIOCPKT_IOCTL":                      reflect.ValueOf(syscall.TIOCPKT_IOCTL),
+		"TIOCPKT_NOSTOP":                     reflect.ValueOf(syscall.TIOCPKT_NOSTOP),
+		"TIOCPKT_START":                      reflect.ValueOf(syscall.TIOCPKT_START),
+		"TIOCPKT_STOP":                       reflect.ValueOf(syscall.TIOCPKT_STOP),
+		"TIOCPTYGNAME":                       reflect.ValueOf(syscall.TIOCPTYGNAME),
+		"TIOCPTYGRANT":                       reflect.ValueOf(syscall.TIOCPTYGRANT),
+		"TIOCPTYUNLK":                        reflect.ValueOf(syscall.TIOCPTYUNLK),
+		"TIOCREMOTE":                         reflect.ValueOf(uint32(syscall.TIOCREMOTE)),
+		"TIOCSBRK":                           reflect.ValueOf(syscall.TIOCSBRK),
+		"TIOCSCONS":                          reflect.ValueOf(syscall.TIOCSCONS),
+		"TIOCSCTTY":                          reflect.ValueOf(syscall.TIOCSCTTY),
+		"TIOCSDRAINWAIT":                     reflect.ValueOf(uint32(syscall.TIOCSDRAINWAIT)),
+		"TIOCSDTR":                           reflect.ValueOf(syscall.TIOCSDTR),
+		"TIOCSETA":                           reflect.ValueOf(uint32(syscall.TIOCSETA)),
+		"TIOCSETAF":                          reflect.ValueOf(uint32(syscall.TIOCSETAF)),
+		"TIOCSETAW":                          reflect.ValueOf(uint32(syscall.TIOCSETAW)),
+		"TIOCSETD":                           reflect.ValueOf(uint32(syscall.TIOCSETD)),
+		"TIOCSIG":                            reflect.ValueOf(syscall.TIOCSIG),
+		"TIOCSPGRP":                          reflect.ValueOf(uint32(syscall.TIOCSPGRP)),
+		"TIOCSTART":                          reflect.ValueOf(syscall.TIOCSTART),
+		"TIOCSTAT":                           reflect.ValueOf(syscall.TIOCSTAT),
+		"TIOCSTI":                            reflect.ValueOf(uint32(syscall.TIOCSTI)),
+		"TIOCSTOP":                           reflect.ValueOf(syscall.TIOCSTOP),
+		"TIOCSWINSZ":                         reflect.ValueOf(uint32(syscall.TIOCSWINSZ)),
+		"TIOCTIMESTAMP":                      reflect.ValueOf(syscall.TIOCTIMESTAMP),
+		"TIOCUCNTL":                          reflect.ValueOf(uint32(syscall.TIOCUCNTL)),
+		"TOSTOP":                             reflect.ValueOf(syscall.TOSTOP),
+		"TimespecToNsec":                     reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                      reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                           reflect.ValueOf(syscall.Truncate),
+		"Umask":                              reflect.ValueOf(syscall.Umask),
+		"Undelete":                           reflect.ValueOf(syscall.Undelete),
+		"UnixRights":                         reflect.ValueOf(syscall.UnixRights),
+		"Unlink":                             reflect.ValueOf(syscall.Unlink),
+		"Unmount":                            reflect.ValueOf(syscall.Unmount),
+		"Unsetenv":                           reflect.ValueOf(syscall.Unsetenv),
+		"Utimes":                             reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                         reflect.ValueOf(syscall.UtimesNano),
+		"VDISCARD":                           reflect.ValueOf(syscall.VDISCARD),
+		"VDSUSP":                             reflect.ValueOf(syscall.VDSUSP),
+		"VEOF":                               reflect.ValueOf(syscall.VEOF),
+		"VEOL":                               reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                              reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                             reflect.ValueOf(syscall.VERASE),
+		"VINTR":                              reflect.ValueOf(syscall.VINTR),
+		"VKILL":                              reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                             reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                               reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                              reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                           reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                             reflect.ValueOf(syscall.VSTART),
+		"VSTATUS":                            reflect.ValueOf(syscall.VSTATUS),
+		"VSTOP":                              reflect.ValueOf(syscall.VSTOP),
+		"VSUSP":                              reflect.ValueOf(syscall.VSUSP),
+		"VT0":                                reflect.ValueOf(syscall.VT0),
+		"VT1":                                reflect.ValueOf(syscall.VT1),
+		"VTDLY":                              reflect.ValueOf(syscall.VTDLY),
+		"VTIME":                              reflect.ValueOf(syscall.VTIME),
+		"VWERASE":                            reflect.ValueOf(syscall.VWERASE),
+		"WCONTINUED":                         reflect.ValueOf(syscall.WCONTINUED),
+		"WCOREFLAG":                          reflect.ValueOf(syscall.WCOREFLAG),
+		"WEXITED":                            reflect.ValueOf(syscall.WEXITED),
+		"WNOHANG":                            reflect.ValueOf(syscall.WNOHANG),
+		"WNOWAIT":                            reflect.ValueOf(syscall.WNOWAIT),
+		"WORDSIZE":                           reflect.ValueOf(syscall.WORDSIZE),
+		"WSTOPPED":                           reflect.ValueOf(syscall.WSTOPPED),
+		"WUNTRACED":                          reflect.ValueOf(syscall.WUNTRACED),
+		"Wait4":                              reflect.ValueOf(syscall.Wait4),
+		"Write":                              reflect.ValueOf(syscall.Write),
+
+		// type definitions
+		"BpfHdr":                        reflect.ValueOf((*syscall.BpfHdr)(nil)),
+		"BpfInsn":                       reflect.ValueOf((*syscall.BpfInsn)(nil)),
+		"BpfProgram":                    reflect.ValueOf((*syscall.BpfProgram)(nil)),
+		"BpfStat":                       reflect.ValueOf((*syscall.BpfStat)(nil)),
+		"BpfVersion":                    reflect.ValueOf((*syscall.BpfVersion)(nil)),
+		"Cmsghdr":                       reflect.ValueOf((*syscall.Cmsghdr)(nil)),
+		"Conn":                          reflect.ValueOf((*syscall.Conn)(nil)),
+		"Credential":                    reflect.ValueOf((*syscall.Credential)(nil)),
+		"Dirent":                        reflect.ValueOf((*syscall.Dirent)(nil)),
+		"Errno":                         reflect.ValueOf((*syscall.Errno)(nil)),
+		"Fbootstraptransfer_t":          reflect.ValueOf((*syscall.Fbootstraptransfer_t)(nil)),
+		"FdSet":                         reflect.ValueOf((*syscall.FdSet)(nil)),
+		"Flock_t":                       reflect.ValueOf((*syscall.Flock_t)(nil)),
+		"Fsid":                          reflect.ValueOf((*syscall.Fsid)(nil)),
+		"Fstore_t":                      reflect.ValueOf((*syscall.Fstore_t)(nil)),
+		"ICMPv6Filter":                  reflect.ValueOf((*syscall.ICMPv6Filter)(nil)),
+		"IPMreq":                        reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPv6MTUInfo":                   reflect.ValueOf((*syscall.IPv6MTUInfo)(nil)),
+		"IPv6Mreq":                      reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"IfData":                        reflect.ValueOf((*syscall.IfData)(nil)),
+		"IfMsghdr":                      reflect.ValueOf((*syscall.IfMsghdr)(nil)),
+		"IfaMsghdr":                     reflect.ValueOf((*syscall.IfaMsghdr)(nil)),
+		"IfmaMsghdr":                    reflect.ValueOf((*syscall.IfmaMsghdr)(nil)),
+		"IfmaMsghdr2":                   reflect.ValueOf((*syscall.IfmaMsghdr2)(nil)),
+		"Inet4Pktinfo":                  reflect.ValueOf((*syscall.Inet4Pktinfo)(nil)),
+		"Inet6Pktinfo":                  reflect.ValueOf((*syscall.Inet6Pktinfo)(nil)),
+		"InterfaceAddrMessage":          reflect.ValueOf((*syscall.InterfaceAddrMessage)(nil)),
+		"InterfaceMessage":              reflect.ValueOf((*syscall.InterfaceMessage)(nil)),
+		"InterfaceMulticastAddrMessage": reflect.ValueOf((*syscall.InterfaceMulticastAddrMessage)(nil)),
+		"Iovec":                         reflect.ValueOf((*syscall.Iovec)(nil)),
+		"Kevent_t":                      reflect.ValueOf((*syscall.Kevent_t)(nil)),
+		"Linger":                        reflect.ValueOf((*syscall.Linger)(nil)),
+		"Log2phys_t":                    reflect.ValueOf((*syscall.Log2phys_t)(nil)),
+		"Msghdr":                        reflect.ValueOf((*syscall.Msghdr)(nil)),
+		"ProcAttr":                      reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"Radvisory_t":                   reflect.ValueOf((*syscall.Radvisory_t)(nil)),
+		"RawConn":                       reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":                   reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":                reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrDatalink":           reflect.ValueOf((*syscall.RawSockaddrDatalink)(nil)),
+		"RawSockaddrInet4":              reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":              reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":               reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rlimit":                        reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"RouteMessage":                  reflect.ValueOf((*syscall.RouteMessage)(nil)),
+		"RoutingMessage":                reflect.ValueOf((*syscall.RoutingMessage)(nil)),
+		"RtMetrics":                     reflect.ValueOf((*syscall.RtMetrics)(nil)),
+		"RtMsghdr":                      reflect.ValueOf((*syscall.RtMsghdr)(nil)),
+		"Rusage":                        reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":                        reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":                      reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrDatalink":              reflect.ValueOf((*syscall.SockaddrDatalink)(nil)),
+		"SockaddrInet4":                 reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":                 reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":                  reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"SocketControlMessage":          reflect.ValueOf((*syscall.SocketControlMessage)(nil)),
+		"Stat_t":                        reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"Statfs_t":                      reflect.ValueOf((*syscall.Statfs_t)(nil)),
+		"SysProcAttr":                   reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Termios":                       reflect.ValueOf((*syscall.Termios)(nil)),
+		"Timespec":                      reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":                       reflect.ValueOf((*syscall.Timeval)(nil)),
+		"Timeval32":                     reflect.ValueOf((*syscall.Timeval32)(nil)),
+		"WaitStatus":                    reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":           reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":        reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_RoutingMessage": reflect.ValueOf((*_syscall_RoutingMessage)(nil)),
+		"_Sockaddr":       reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
+type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
+}
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
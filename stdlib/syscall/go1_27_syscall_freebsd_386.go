@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package syscall
 
@@ -614,6 +615,7 @@ func init() {
 		"ForkLock":                          reflect.ValueOf(&syscall.ForkLock).Elem(),
 		"Fpathconf":                         reflect.ValueOf(syscall.Fpathconf),
 		"Fstat":                             reflect.ValueOf(syscall.Fstat),
+		"Fstatat":                           reflect.ValueOf(syscall.Fstatat),
 		"Fstatfs":                           reflect.ValueOf(syscall.Fstatfs),
 		"Fsync":                             reflect.ValueOf(syscall.Fsync),
 		"Ftruncate":                         reflect.ValueOf(syscall.Ftruncate),
@@ -1193,7 +1195,6 @@ func init() {
 		"MADV_RANDOM":                       reflect.ValueOf(syscall.MADV_RANDOM),
 		"MADV_SEQUENTIAL":                   reflect.ValueOf(syscall.MADV_SEQUENTIAL),
 		"MADV_WILLNEED":                     reflect.ValueOf(syscall.MADV_WILLNEED),
-		"MAP_32BIT":                         reflect.ValueOf(syscall.MAP_32BIT),
 		"MAP_ALIGNED_SUPER":                 reflect.ValueOf(syscall.MAP_ALIGNED_SUPER),
 		"MAP_ALIGNMENT_MASK":                reflect.ValueOf(syscall.MAP_ALIGNMENT_MASK),
 		"MAP_ALIGNMENT_SHIFT":               reflect.ValueOf(syscall.MAP_ALIGNMENT_SHIFT),
@@ -1724,7 +1725,6 @@ func init() {
 		"SYS_LISTEN":                        reflect.ValueOf(syscall.SYS_LISTEN),
 		"SYS_LPATHCONF":                     reflect.ValueOf(syscall.SYS_LPATHCONF),
 		"SYS_LSEEK":                         reflect.ValueOf(syscall.SYS_LSEEK),
-		"SYS_LSTAT":                         reflect.ValueOf(syscall.SYS_LSTAT),
 		"SYS_LUTIMES":                       reflect.ValueOf(syscall.SYS_LUTIMES),
 		"SYS_MAC_SYSCALL":                   reflect.ValueOf(syscall.SYS_MAC_SYSCALL),
 		"SYS_MADVISE":                       reflect.ValueOf(syscall.SYS_MADVISE),
@@ -1855,7 +1855,6 @@ func init() {
 		"SYS_SOCKET":                        reflect.ValueOf(syscall.SYS_SOCKET),
 		"SYS_SOCKETPAIR":                    reflect.ValueOf(syscall.SYS_SOCKETPAIR),
 		"SYS_SSTK":                          reflect.ValueOf(syscall.SYS_SSTK),
-		"SYS_STAT":                          reflect.ValueOf(syscall.SYS_STAT),
 		"SYS_STATFS":                        reflect.ValueOf(syscall.SYS_STATFS),
 		"SYS_SWAPCONTEXT":                   reflect.ValueOf(syscall.SYS_SWAPCONTEXT),
 		"SYS_SWAPOFF":                       reflect.ValueOf(syscall.SYS_SWAPOFF),
@@ -1926,6 +1925,8 @@ func init() {
 		"S_IFREG":                           reflect.ValueOf(syscall.S_IFREG),
 		"S_IFSOCK":                          reflect.ValueOf(syscall.S_IFSOCK),
 		"S_IRUSR":                           reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                           reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                           reflect.ValueOf(syscall.S_IRWXO),
 		"S_ISGID":                           reflect.ValueOf(syscall.S_ISGID),
 		"S_ISUID":                           reflect.ValueOf(syscall.S_ISUID),
 		"S_ISVTX":                           reflect.ValueOf(syscall.S_ISVTX),
@@ -2242,8 +2243,10 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
 type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
 }
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -0,0 +1,2252 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_APPLETALK":                      reflect.ValueOf(syscall.AF_APPLETALK),
+		"AF_ARP":                            reflect.ValueOf(syscall.AF_ARP),
+		"AF_ATM":                            reflect.ValueOf(syscall.AF_ATM),
+		"AF_BLUETOOTH":                      reflect.ValueOf(syscall.AF_BLUETOOTH),
+		"AF_CCITT":                          reflect.ValueOf(syscall.AF_CCITT),
+		"AF_CHAOS":                          reflect.ValueOf(syscall.AF_CHAOS),
+		"AF_CNT":                            reflect.ValueOf(syscall.AF_CNT),
+		"AF_COIP":                           reflect.ValueOf(syscall.AF_COIP),
+		"AF_DATAKIT":                        reflect.ValueOf(syscall.AF_DATAKIT),
+		"AF_DECnet":                         reflect.ValueOf(syscall.AF_DECnet),
+		"AF_DLI":                            reflect.ValueOf(syscall.AF_DLI),
+		"AF_E164":                           reflect.ValueOf(syscall.AF_E164),
+		"AF_ECMA":                           reflect.ValueOf(syscall.AF_ECMA),
+		"AF_HYLINK":                         reflect.ValueOf(syscall.AF_HYLINK),
+		"AF_IEEE80211":                      reflect.ValueOf(syscall.AF_IEEE80211),
+		"AF_IMPLINK":                        reflect.ValueOf(syscall.AF_IMPLINK),
+		"AF_INET":                           reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                          reflect.ValueOf(syscall.AF_INET6),
+		"AF_INET6_SDP":                      reflect.ValueOf(syscall.AF_INET6_SDP),
+		"AF_INET_SDP":                       reflect.ValueOf(syscall.AF_INET_SDP),
+		"AF_IPX":                            reflect.ValueOf(syscall.AF_IPX),
+		"AF_ISDN":                           reflect.ValueOf(syscall.AF_ISDN),
+		"AF_ISO":                            reflect.ValueOf(syscall.AF_ISO),
+		"AF_LAT":                            reflect.ValueOf(syscall.AF_LAT),
+		"AF_LINK":                           reflect.ValueOf(syscall.AF_LINK),
+		"AF_LOCAL":                          reflect.ValueOf(syscall.AF_LOCAL),
+		"AF_MAX":                            reflect.ValueOf(syscall.AF_MAX),
+		"AF_NATM":                           reflect.ValueOf(syscall.AF_NATM),
+		"AF_NETBIOS":                        reflect.ValueOf(syscall.AF_NETBIOS),
+		"AF_NETGRAPH":                       reflect.ValueOf(syscall.AF_NETGRAPH),
+		"AF_OSI":                            reflect.ValueOf(syscall.AF_OSI),
+		"AF_PUP":                            reflect.ValueOf(syscall.AF_PUP),
+		"AF_ROUTE":                          reflect.ValueOf(syscall.AF_ROUTE),
+		"AF_SCLUSTER":                       reflect.ValueOf(syscall.AF_SCLUSTER),
+		"AF_SIP":                            reflect.ValueOf(syscall.AF_SIP),
+		"AF_SLOW":                           reflect.ValueOf(syscall.AF_SLOW),
+		"AF_SNA":                            reflect.ValueOf(syscall.AF_SNA),
+		"AF_UNIX":                           reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                         reflect.ValueOf(syscall.AF_UNSPEC),
+		"AF_VENDOR00":                       reflect.ValueOf(syscall.AF_VENDOR00),
+		"AF_VENDOR01":                       reflect.ValueOf(syscall.AF_VENDOR01),
+		"AF_VENDOR02":                       reflect.ValueOf(syscall.AF_VENDOR02),
+		"AF_VENDOR03":                       reflect.ValueOf(syscall.AF_VENDOR03),
+		"AF_VENDOR04":                       reflect.ValueOf(syscall.AF_VENDOR04),
+		"AF_VENDOR05":                       reflect.ValueOf(syscall.AF_VENDOR05),
+		"AF_VENDOR06":                       reflect.ValueOf(syscall.AF_VENDOR06),
+		"AF_VENDOR07":                       reflect.ValueOf(syscall.AF_VENDOR07),
+		"AF_VENDOR08":                       reflect.ValueOf(syscall.AF_VENDOR08),
+		"AF_VENDOR09":                       reflect.ValueOf(syscall.AF_VENDOR09),
+		"AF_VENDOR10":                       reflect.ValueOf(syscall.AF_VENDOR10),
+		"AF_VENDOR11":                       reflect.ValueOf(syscall.AF_VENDOR11),
+		"AF_VENDOR12":                       reflect.ValueOf(syscall.AF_VENDOR12),
+		"AF_VENDOR13":                       reflect.ValueOf(syscall.AF_VENDOR13),
+		"AF_VENDOR14":                       reflect.ValueOf(syscall.AF_VENDOR14),
+		"AF_VENDOR15":                       reflect.ValueOf(syscall.AF_VENDOR15),
+		"AF_VENDOR16":                       reflect.ValueOf(syscall.AF_VENDOR16),
+		"AF_VENDOR17":                       reflect.ValueOf(syscall.AF_VENDOR17),
+		"AF_VENDOR18":                       reflect.ValueOf(syscall.AF_VENDOR18),
+		"AF_VENDOR19":                       reflect.ValueOf(syscall.AF_VENDOR19),
+		"AF_VENDOR20":                       reflect.ValueOf(syscall.AF_VENDOR20),
+		"AF_VENDOR21":                       reflect.ValueOf(syscall.AF_VENDOR21),
+		"AF_VENDOR22":                       reflect.ValueOf(syscall.AF_VENDOR22),
+		"AF_VENDOR23":                       reflect.ValueOf(syscall.AF_VENDOR23),
+		"AF_VENDOR24":                       reflect.ValueOf(syscall.AF_VENDOR24),
+		"AF_VENDOR25":                       reflect.ValueOf(syscall.AF_VENDOR25),
+		"AF_VENDOR26":                       reflect.ValueOf(syscall.AF_VENDOR26),
+		"AF_VENDOR27":                       reflect.ValueOf(syscall.AF_VENDOR27),
+		"AF_VENDOR28":                       reflect.ValueOf(syscall.AF_VENDOR28),
+		"AF_VENDOR29":                       reflect.ValueOf(syscall.AF_VENDOR29),
+		"AF_VENDOR30":                       reflect.ValueOf(syscall.AF_VENDOR30),
+		"AF_VENDOR31":                       reflect.ValueOf(syscall.AF_VENDOR31),
+		"AF_VENDOR32":                       reflect.ValueOf(syscall.AF_VENDOR32),
+		"AF_VENDOR33":                       reflect.ValueOf(syscall.AF_VENDOR33),
+		"AF_VENDOR34":                       reflect.ValueOf(syscall.AF_VENDOR34),
+		"AF_VENDOR35":                       reflect.ValueOf(syscall.AF_VENDOR35),
+		"AF_VENDOR36":                       reflect.ValueOf(syscall.AF_VENDOR36),
+		"AF_VENDOR37":                       reflect.ValueOf(syscall.AF_VENDOR37),
+		"AF_VENDOR38":                       reflect.ValueOf(syscall.AF_VENDOR38),
+		"AF_VENDOR39":                       reflect.ValueOf(syscall.AF_VENDOR39),
+		"AF_VENDOR40":                       reflect.ValueOf(syscall.AF_VENDOR40),
+		"AF_VENDOR41":                       reflect.ValueOf(syscall.AF_VENDOR41),
+		"AF_VENDOR42":                       reflect.ValueOf(syscall.AF_VENDOR42),
+		"AF_VENDOR43":                       reflect.ValueOf(syscall.AF_VENDOR43),
+		"AF_VENDOR44":                       reflect.ValueOf(syscall.AF_VENDOR44),
+		"AF_VENDOR45":                       reflect.ValueOf(syscall.AF_VENDOR45),
+		"AF_VENDOR46":                       reflect.ValueOf(syscall.AF_VENDOR46),
+		"AF_VENDOR47":                       reflect.ValueOf(syscall.AF_VENDOR47),
+		"Accept":                            reflect.ValueOf(syscall.Accept),
+		"Accept4":                           reflect.ValueOf(syscall.Accept4),
+		"Access":                            reflect.ValueOf(syscall.Access),
+		"Adjtime":                           reflect.ValueOf(syscall.Adjtime),
+		"B0":                                reflect.ValueOf(syscall.B0),
+		"B110":                              reflect.ValueOf(syscall.B110),
+		"B115200":                           reflect.ValueOf(syscall.B115200),
+		"B1200":                             reflect.ValueOf(syscall.B1200),
+		"B134":                              reflect.ValueOf(syscall.B134),
+		"B14400":                            reflect.ValueOf(syscall.B14400),
+		"B150":                              reflect.ValueOf(syscall.B150),
+		"B1800":                             reflect.ValueOf(syscall.B1800),
+		"B19200":                            reflect.ValueOf(syscall.B19200),
+		"B200":                              reflect.ValueOf(syscall.B200),
+		"B230400":                           reflect.ValueOf(syscall.B230400),
+		"B2400":                             reflect.ValueOf(syscall.B2400),
+		"B28800":                            reflect.ValueOf(syscall.B28800),
+		"B300":                              reflect.ValueOf(syscall.B300),
+		"B38400":                            reflect.ValueOf(syscall.B38400),
+		"B460800":                           reflect.ValueOf(syscall.B460800),
+		"B4800":                             reflect.ValueOf(syscall.B4800),
+		"B50":                               reflect.ValueOf(syscall.B50),
+		"B57600":                            reflect.ValueOf(syscall.B57600),
+		"B600":                              reflect.ValueOf(syscall.B600),
+		"B7200":                             reflect.ValueOf(syscall.B7200),
+		"B75":                               reflect.ValueOf(syscall.B75),
+		"B76800":                            reflect.ValueOf(syscall.B76800),
+		"B921600":                           reflect.ValueOf(syscall.B921600),
+		"B9600":                             reflect.ValueOf(syscall.B9600),
+		"BIOCFEEDBACK":                      reflect.ValueOf(uint32(syscall.BIOCFEEDBACK)),
+		"BIOCFLUSH":                         reflect.ValueOf(syscall.BIOCFLUSH),
+		"BIOCGBLEN":                         reflect.ValueOf(syscall.BIOCGBLEN),
+		"BIOCGDIRECTION":                    reflect.ValueOf(syscall.BIOCGDIRECTION),
+		"BIOCGDLT":                          reflect.ValueOf(syscall.BIOCGDLT),
+		"BIOCGDLTLIST":                      reflect.ValueOf(uint32(syscall.BIOCGDLTLIST)),
+		"BIOCGETBUFMODE":                    reflect.ValueOf(syscall.BIOCGETBUFMODE),
+		"BIOCGETIF":                         reflect.ValueOf(syscall.BIOCGETIF),
+		"BIOCGETZMAX":                       reflect.ValueOf(syscall.BIOCGETZMAX),
+		"BIOCGHDRCMPLT":                     reflect.ValueOf(syscall.BIOCGHDRCMPLT),
+		"BIOCGRSIG":                         reflect.ValueOf(syscall.BIOCGRSIG),
+		"BIOCGRTIMEOUT":                     reflect.ValueOf(syscall.BIOCGRTIMEOUT),
+		"BIOCGSEESENT":                      reflect.ValueOf(syscall.BIOCGSEESENT),
+		"BIOCGSTATS":                        reflect.ValueOf(syscall.BIOCGSTATS),
+		"BIOCGTSTAMP":                       reflect.ValueOf(syscall.BIOCGTSTAMP),
+		"BIOCIMMEDIATE":                     reflect.ValueOf(uint32(syscall.BIOCIMMEDIATE)),
+		"BIOCLOCK":                          reflect.ValueOf(syscall.BIOCLOCK),
+		"BIOCPROMISC":                       reflect.ValueOf(syscall.BIOCPROMISC),
+		"BIOCROTZBUF":                       reflect.ValueOf(syscall.BIOCROTZBUF),
+		"BIOCSBLEN":                         reflect.ValueOf(uint32(syscall.BIOCSBLEN)),
+		"BIOCSDIRECTION":                    reflect.ValueOf(uint32(syscall.BIOCSDIRECTION)),
+		"BIOCSDLT":                          reflect.ValueOf(uint32(syscall.BIOCSDLT)),
+		"BIOCSETBUFMODE":                    reflect.ValueOf(uint32(syscall.BIOCSETBUFMODE)),
+		"BIOCSETF":                          reflect.ValueOf(uint32(syscall.BIOCSETF)),
+		"BIOCSETFNR":                        reflect.ValueOf(uint32(syscall.BIOCSETFNR)),
+		"BIOCSETIF":                         reflect.ValueOf(uint32(syscall.BIOCSETIF)),
+		"BIOCSETWF":                         reflect.ValueOf(uint32(syscall.BIOCSETWF)),
+		"BIOCSETZBUF":                       reflect.ValueOf(uint32(syscall.BIOCSETZBUF)),
+		"BIOCSHDRCMPLT":                     reflect.ValueOf(uint32(syscall.BIOCSHDRCMPLT)),
+		"BIOCSRSIG":                         reflect.ValueOf(uint32(syscall.BIOCSRSIG)),
+		"BIOCSRTIMEOUT":                     reflect.ValueOf(uint32(syscall.BIOCSRTIMEOUT)),
+		"BIOCSSEESENT":                      reflect.ValueOf(uint32(syscall.BIOCSSEESENT)),
+		"BIOCSTSTAMP":                       reflect.ValueOf(uint32(syscall.BIOCSTSTAMP)),
+		"BIOCVERSION":                       reflect.ValueOf(syscall.BIOCVERSION),
+		"BPF_A":                             reflect.ValueOf(syscall.BPF_A),
+		"BPF_ABS":                           reflect.ValueOf(syscall.BPF_ABS),
+		"BPF_ADD":                           reflect.ValueOf(syscall.BPF_ADD),
+		"BPF_ALIGNMENT":                     reflect.ValueOf(syscall.BPF_ALIGNMENT),
+		"BPF_ALU":                           reflect.ValueOf(syscall.BPF_ALU),
+		"BPF_AND":                           reflect.ValueOf(syscall.BPF_AND),
+		"BPF_B":                             reflect.ValueOf(syscall.BPF_B),
+		"BPF_BUFMODE_BUFFER":                reflect.ValueOf(syscall.BPF_BUFMODE_BUFFER),
+		"BPF_BUFMODE_ZBUF":                  reflect.ValueOf(syscall.BPF_BUFMODE_ZBUF),
+		"BPF_DIV":                           reflect.ValueOf(syscall.BPF_DIV),
+		"BPF_H":                             reflect.ValueOf(syscall.BPF_H),
+		"BPF_IMM":                           reflect.ValueOf(syscall.BPF_IMM),
+		"BPF_IND":                           reflect.ValueOf(syscall.BPF_IND),
+		"BPF_JA":                            reflect.ValueOf(syscall.BPF_JA),
+		"BPF_JEQ":                           reflect.ValueOf(syscall.BPF_JEQ),
+		"BPF_JGE":                           reflect.ValueOf(syscall.BPF_JGE),
+		"BPF_JGT":                           reflect.ValueOf(syscall.BPF_JGT),
+		"BPF_JMP":                           reflect.ValueOf(syscall.BPF_JMP),
+		"BPF_JSET":                          reflect.ValueOf(syscall.BPF_JSET),
+		"BPF_K":                             reflect.ValueOf(syscall.BPF_K),
+		"BPF_LD":                            reflect.ValueOf(syscall.BPF_LD),
+		"BPF_LDX":                           reflect.ValueOf(syscall.BPF_LDX),
+		"BPF_LEN":                           reflect.ValueOf(syscall.BPF_LEN),
+		"BPF_LSH":                           reflect.ValueOf(syscall.BPF_LSH),
+		"BPF_MAJOR_VERSION":                 reflect.ValueOf(syscall.BPF_MAJOR_VERSION),
+		"BPF_MAXBUFSIZE":                    reflect.ValueOf(syscall.BPF_MAXBUFSIZE),
+		"BPF_MAXINSNS":                      reflect.ValueOf(syscall.BPF_MAXINSNS),
+		"BPF_MEM":                           reflect.ValueOf(syscall.BPF_MEM),
+		"BPF_MEMWORDS":                      reflect.ValueOf(syscall.BPF_MEMWORDS),
+		"BPF_MINBUFSIZE":                    reflect.ValueOf(syscall.BPF_MINBUFSIZE),
+		"BPF_MINOR_VERSION":                 reflect.ValueOf(syscall.BPF_MINOR_VERSION),
+		"BPF_MISC":                          reflect.ValueOf(syscall.BPF_MISC),
+		"BPF_MSH":                           reflect.ValueOf(syscall.BPF_MSH),
+		"BPF_MUL":                           reflect.ValueOf(syscall.BPF_MUL),
+		"BPF_NEG":                           reflect.ValueOf(syscall.BPF_NEG),
+		"BPF_OR":                            reflect.ValueOf(syscall.BPF_OR),
+		"BPF_RELEASE":                       reflect.ValueOf(syscall.BPF_RELEASE),
+		"BPF_RET":                           reflect.ValueOf(syscall.BPF_RET),
+		"BPF_RSH":                           reflect.ValueOf(syscall.BPF_RSH),
+		"BPF_ST":                            reflect.ValueOf(syscall.BPF_ST),
+		"BPF_STX":                           reflect.ValueOf(syscall.BPF_STX),
+		"BPF_SUB":                           reflect.ValueOf(syscall.BPF_SUB),
+		"BPF_TAX":                           reflect.ValueOf(syscall.BPF_TAX),
+		"BPF_TXA":                           reflect.ValueOf(syscall.BPF_TXA),
+		"BPF_T_BINTIME":                     reflect.ValueOf(syscall.BPF_T_BINTIME),
+		"BPF_T_BINTIME_FAST":                reflect.ValueOf(syscall.BPF_T_BINTIME_FAST),
+		"BPF_T_BINTIME_MONOTONIC":           reflect.ValueOf(syscall.BPF_T_BINTIME_MONOTONIC),
+		"BPF_T_BINTIME_MONOTONIC_FAST":      reflect.ValueOf(syscall.BPF_T_BINTIME_MONOTONIC_FAST),
+		"BPF_T_FAST":                        reflect.ValueOf(syscall.BPF_T_FAST),
+		"BPF_T_FLAG_MASK":                   reflect.ValueOf(syscall.BPF_T_FLAG_MASK),
+		"BPF_T_FORMAT_MASK":                 reflect.ValueOf(syscall.BPF_T_FORMAT_MASK),
+		"BPF_T_MICROTIME":                   reflect.ValueOf(syscall.BPF_T_MICROTIME),
+		"BPF_T_MICROTIME_FAST":              reflect.ValueOf(syscall.BPF_T_MICROTIME_FAST),
+		"BPF_T_MICROTIME_MONOTONIC":         reflect.ValueOf(syscall.BPF_T_MICROTIME_MONOTONIC),
+		"BPF_T_MICROTIME_MONOTONIC_FAST":    reflect.ValueOf(syscall.BPF_T_MICROTIME_MONOTONIC_FAST),
+		"BPF_T_MONOTONIC":                   reflect.ValueOf(syscall.BPF_T_MONOTONIC),
+		"BPF_T_MONOTONIC_FAST":              reflect.ValueOf(syscall.BPF_T_MONOTONIC_FAST),
+		"BPF_T_NANOTIME":                    reflect.ValueOf(syscall.BPF_T_NANOTIME),
+		"BPF_T_NANOTIME_FAST":               reflect.ValueOf(syscall.BPF_T_NANOTIME_FAST),
+		"BPF_T_NANOTIME_MONOTONIC":          reflect.ValueOf(syscall.BPF_T_NANOTIME_MONOTONIC),
+		"BPF_T_NANOTIME_MONOTONIC_FAST":     reflect.ValueOf(syscall.BPF_T_NANOTIME_MONOTONIC_FAST),
+		"BPF_T_NONE":                        reflect.ValueOf(syscall.BPF_T_NONE),
+		"BPF_T_NORMAL":                      reflect.ValueOf(syscall.BPF_T_NORMAL),
+		"BPF_W":                             reflect.ValueOf(syscall.BPF_W),
+		"BPF_X":                             reflect.ValueOf(syscall.BPF_X),
+		"BRKINT":                            reflect.ValueOf(syscall.BRKINT),
+		"Bind":                              reflect.ValueOf(syscall.Bind),
+		"BpfBuflen":                         reflect.ValueOf(syscall.BpfBuflen),
+		"BpfDatalink":                       reflect.ValueOf(syscall.BpfDatalink),
+		"BpfHeadercmpl":                     reflect.ValueOf(syscall.BpfHeadercmpl),
+		"BpfInterface":                      reflect.ValueOf(syscall.BpfInterface),
+		"BpfJump":                           reflect.ValueOf(syscall.BpfJump),
+		"BpfStats":                          reflect.ValueOf(syscall.BpfStats),
+		"BpfStmt":                           reflect.ValueOf(syscall.BpfStmt),
+		"BpfTimeout":                        reflect.ValueOf(syscall.BpfTimeout),
+		"BytePtrFromString":                 reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":               reflect.ValueOf(syscall.ByteSliceFromString),
+		"CFLUSH":                            reflect.ValueOf(syscall.CFLUSH),
+		"CLOCAL":                            reflect.ValueOf(syscall.CLOCAL),
+		"CREAD":                             reflect.ValueOf(syscall.CREAD),
+		"CS5":                               reflect.ValueOf(syscall.CS5),
+		"CS6":                               reflect.ValueOf(syscall.CS6),
+		"CS7":                               reflect.ValueOf(syscall.CS7),
+		"CS8":                               reflect.ValueOf(syscall.CS8),
+		"CSIZE":                             reflect.ValueOf(syscall.CSIZE),
+		"CSTART":                            reflect.ValueOf(syscall.CSTART),
+		"CSTATUS":                           reflect.ValueOf(syscall.CSTATUS),
+		"CSTOP":                             reflect.ValueOf(syscall.CSTOP),
+		"CSTOPB":                            reflect.ValueOf(syscall.CSTOPB),
+		"CSUSP":                             reflect.ValueOf(syscall.CSUSP),
+		"CTL_MAXNAME":                       reflect.ValueOf(syscall.CTL_MAXNAME),
+		"CTL_NET":                           reflect.ValueOf(syscall.CTL_NET),
+		"Chdir":                             reflect.ValueOf(syscall.Chdir),
+		"CheckBpfVersion":                   reflect.ValueOf(syscall.CheckBpfVersion),
+		"Chflags":                           reflect.ValueOf(syscall.Chflags),
+		"Chmod":                             reflect.ValueOf(syscall.Chmod),
+		"Chown":                             reflect.ValueOf(syscall.Chown),
+		"Chroot":                            reflect.ValueOf(syscall.Chroot),
+		"Clearenv":                          reflect.ValueOf(syscall.Clearenv),
+		"Close":                             reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                       reflect.ValueOf(syscall.CloseOnExec),
+		"CmsgLen":                           reflect.ValueOf(syscall.CmsgLen),
+		"CmsgSpace":                         reflect.ValueOf(syscall.CmsgSpace),
+		"Connect":                           reflect.ValueOf(syscall.Connect),
+		"DLT_A429":                          reflect.ValueOf(syscall.DLT_A429),
+		"DLT_A653_ICM":                      reflect.ValueOf(syscall.DLT_A653_ICM),
+		"DLT_AIRONET_HEADER":                reflect.ValueOf(syscall.DLT_AIRONET_HEADER),
+		"DLT_AOS":                           reflect.ValueOf(syscall.DLT_AOS),
+		"DLT_APPLE_IP_OVER_IEEE1394":        reflect.ValueOf(syscall.DLT_APPLE_IP_OVER_IEEE1394),
+		"DLT_ARCNET":                        reflect.ValueOf(syscall.DLT_ARCNET),
+		"DLT_ARCNET_LINUX":                  reflect.ValueOf(syscall.DLT_ARCNET_LINUX),
+		"DLT_ATM_CLIP":                      reflect.ValueOf(syscall.DLT_ATM_CLIP),
+		"DLT_ATM_RFC1483":                   reflect.ValueOf(syscall.DLT_ATM_RFC1483),
+		"DLT_AURORA":                        reflect.ValueOf(syscall.DLT_AURORA),
+		"DLT_AX25":                          reflect.ValueOf(syscall.DLT_AX25),
+		"DLT_AX25_KISS":                     reflect.ValueOf(syscall.DLT_AX25_KISS),
+		"DLT_BACNET_MS_TP":                  reflect.ValueOf(syscall.DLT_BACNET_MS_TP),
+		"DLT_BLUETOOTH_HCI_H4":              reflect.ValueOf(syscall.DLT_BLUETOOTH_HCI_H4),
+		"DLT_BLUETOOTH_HCI_H4_WITH_PHDR":    reflect.ValueOf(syscall.DLT_BLUETOOTH_HCI_H4_WITH_PHDR),
+		"DLT_CAN20B":                        reflect.ValueOf(syscall.DLT_CAN20B),
+		"DLT_CAN_SOCKETCAN":                 reflect.ValueOf(syscall.DLT_CAN_SOCKETCAN),
+		"DLT_CHAOS":                         reflect.ValueOf(syscall.DLT_CHAOS),
+		"DLT_CHDLC":                         reflect.ValueOf(syscall.DLT_CHDLC),
+		"DLT_CISCO_IOS":                     reflect.ValueOf(syscall.DLT_CISCO_IOS),
+		"DLT_C_HDLC":                        reflect.ValueOf(syscall.DLT_C_HDLC),
+		"DLT_C_HDLC_WITH_DIR":               reflect.ValueOf(syscall.DLT_C_HDLC_WITH_DIR),
+		"DLT_DBUS":                          reflect.ValueOf(syscall.DLT_DBUS),
+		"DLT_DECT":                          reflect.ValueOf(syscall.DLT_DECT),
+		"DLT_DOCSIS":                        reflect.ValueOf(syscall.DLT_DOCSIS),
+		"DLT_DVB_CI":                        reflect.ValueOf(syscall.DLT_DVB_CI),
+		"DLT_ECONET":                        reflect.ValueOf(syscall.DLT_ECONET),
+		"DLT_EN10MB":                        reflect.ValueOf(syscall.DLT_EN10MB),
+		"DLT_EN3MB":                         reflect.ValueOf(syscall.DLT_EN3MB),
+		"DLT_ENC":                           reflect.ValueOf(syscall.DLT_ENC),
+		"DLT_ERF":                           reflect.ValueOf(syscall.DLT_ERF),
+		"DLT_ERF_ETH":                       reflect.ValueOf(syscall.DLT_ERF_ETH),
+		"DLT_ERF_POS":                       reflect.ValueOf(syscall.DLT_ERF_POS),
+		"DLT_FC_2":                          reflect.ValueOf(syscall.DLT_FC_2),
+		"DLT_FC_2_WITH_FRAME_DELIMS":        reflect.ValueOf(syscall.DLT_FC_2_WITH_FRAME_DELIMS),
+		"DLT_FDDI":                          reflect.ValueOf(syscall.DLT_FDDI),
+		"DLT_FLEXRAY":                       reflect.ValueOf(syscall.DLT_FLEXRAY),
+		"DLT_FRELAY":                        reflect.ValueOf(syscall.DLT_FRELAY),
+		"DLT_FRELAY_WITH_DIR":               reflect.ValueOf(syscall.DLT_FRELAY_WITH_DIR),
+		"DLT_GCOM_SERIAL":                   reflect.ValueOf(syscall.DLT_GCOM_SERIAL),
+		"DLT_GCOM_T1E1":                     reflect.ValueOf(syscall.DLT_GCOM_T1E1),
+		"DLT_GPF_F":                         reflect.ValueOf(syscall.DLT_GPF_F),
+		"DLT_GPF_T":                         reflect.ValueOf(syscall.DLT_GPF_T),
+		"DLT_GPRS_LLC":                      reflect.ValueOf(syscall.DLT_GPRS_LLC),
+		"DLT_GSMTAP_ABIS":                   reflect.ValueOf(syscall.DLT_GSMTAP_ABIS),
+		"DLT_GSMTAP_UM":                     reflect.ValueOf(syscall.DLT_GSMTAP_UM),
+		"DLT_HHDLC":                         reflect.ValueOf(syscall.DLT_HHDLC),
+		"DLT_IBM_SN":                        reflect.ValueOf(syscall.DLT_IBM_SN),
+		"DLT_IBM_SP":                        reflect.ValueOf(syscall.DLT_IBM_SP),
+		"DLT_IEEE802":                       reflect.ValueOf(syscall.DLT_IEEE802),
+		"DLT_IEEE802_11":                    reflect.ValueOf(syscall.DLT_IEEE802_11),
+		"DLT_IEEE802_11_RADIO":              reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO),
+		"DLT_IEEE802_11_RADIO_AVS":          reflect.ValueOf(syscall.DLT_IEEE802_11_RADIO_AVS),
+		"DLT_IEEE802_15_4":                  reflect.ValueOf(syscall.DLT_IEEE802_15_4),
+		"DLT_IEEE802_15_4_LINUX":            reflect.ValueOf(syscall.DLT_IEEE802_15_4_LINUX),
+		"DLT_IEEE802_15_4_NOFCS":            reflect.ValueOf(syscall.DLT_IEEE802_15_4_NOFCS),
+		"DLT_IEEE802_15_4_NONASK_PHY":       reflect.ValueOf(syscall.DLT_IEEE802_15_4_NONASK_PHY),
+		"DLT_IEEE802_16_MAC_CPS":            reflect.ValueOf(syscall.DLT_IEEE802_16_MAC_CPS),
+		"DLT_IEEE802_16_MAC_CPS_RADIO":      reflect.ValueOf(syscall.DLT_IEEE802_16_MAC_CPS_RADIO),
+		"DLT_IPFILTER":                      reflect.ValueOf(syscall.DLT_IPFILTER),
+		"DLT_IPMB":                          reflect.ValueOf(syscall.DLT_IPMB),
+		"DLT_IPMB_LINUX":                    reflect.ValueOf(syscall.DLT_IPMB_LINUX),
+		"DLT_IPNET":                         reflect.ValueOf(syscall.DLT_IPNET),
+		"DLT_IPOIB":                         reflect.ValueOf(syscall.DLT_IPOIB),
+		"DLT_IPV4":                          reflect.ValueOf(syscall.DLT_IPV4),
+		"DLT_IPV6":                          reflect.ValueOf(syscall.DLT_IPV6),
+		"DLT_IP_OVER_FC":                    reflect.ValueOf(syscall.DLT_IP_OVER_FC),
+		"DLT_JUNIPER_ATM1":                  reflect.ValueOf(syscall.DLT_JUNIPER_ATM1),
+		"DLT_JUNIPER_ATM2":                  reflect.ValueOf(syscall.DLT_JUNIPER_ATM2),
+		"DLT_JUNIPER_ATM_CEMIC":             reflect.ValueOf(syscall.DLT_JUNIPER_ATM_CEMIC),
+		"DLT_JUNIPER_CHDLC":                 reflect.ValueOf(syscall.DLT_JUNIPER_CHDLC),
+		"DLT_JUNIPER_ES":                    reflect.ValueOf(syscall.DLT_JUNIPER_ES),
+		"DLT_JUNIPER_ETHER":                 reflect.ValueOf(syscall.DLT_JUNIPER_ETHER),
+		"DLT_JUNIPER_FIBRECHANNEL":          reflect.ValueOf(syscall.DLT_JUNIPER_FIBRECHANNEL),
+		"DLT_JUNIPER_FRELAY":                reflect.ValueOf(syscall.DLT_JUNIPER_FRELAY),
+		"DLT_JUNIPER_GGSN":                  reflect.ValueOf(syscall.DLT_JUNIPER_GGSN),
+		"DLT_JUNIPER_ISM":                   reflect.ValueOf(syscall.DLT_JUNIPER_ISM),
+		"DLT_JUNIPER_MFR":                   reflect.ValueOf(syscall.DLT_JUNIPER_MFR),
+		"DLT_JUNIPER_MLFR":                  reflect.ValueOf(syscall.DLT_JUNIPER_MLFR),
+		"DLT_JUNIPER_MLPPP":                 reflect.ValueOf(syscall.DLT_JUNIPER_MLPPP),
+		"DLT_JUNIPER_MONITOR":               reflect.ValueOf(syscall.DLT_JUNIPER_MONITOR),
+		"DLT_JUNIPER_PIC_PEER":              reflect.ValueOf(syscall.DLT_JUNIPER_PIC_PEER),
+		"DLT_JUNIPER_PPP":                   reflect.ValueOf(syscall.DLT_JUNIPER_PPP),
+		"DLT_JUNIPER_PPPOE":                 reflect.ValueOf(syscall.DLT_JUNIPER_PPPOE),
+		"DLT_JUNIPER_PPPOE_ATM":             reflect.ValueOf(syscall.DLT_JUNIPER_PPPOE_ATM),
+		"DLT_JUNIPER_SERVICES":              reflect.ValueOf(syscall.DLT_JUNIPER_SERVICES),
+		"DLT_JUNIPER_SRX_E2E":               reflect.ValueOf(syscall.DLT_JUNIPER_SRX_E2E),
+		"DLT_JUNIPER_ST":                    reflect.ValueOf(syscall.DLT_JUNIPER_ST),
+		"DLT_JUNIPER_VP":                    reflect.ValueOf(syscall.DLT_JUNIPER_VP),
+		"DLT_JUNIPER_VS":                    reflect.ValueOf(syscall.DLT_JUNIPER_VS),
+		"DLT_LAPB_WITH_DIR":                 reflect.ValueOf(syscall.DLT_LAPB_WITH_DIR),
+		"DLT_LAPD":                          reflect.ValueOf(syscall.DLT_LAPD),
+		"DLT_LIN":                           reflect.ValueOf(syscall.DLT_LIN),
+		"DLT_LINUX_EVDEV":                   reflect.ValueOf(syscall.DLT_LINUX_EVDEV),
+		"DLT_LINUX_IRDA":                    reflect.ValueOf(syscall.DLT_LINUX_IRDA),
+		"DLT_LINUX_LAPD":                    reflect.ValueOf(syscall.DLT_LINUX_LAPD),
+		"DLT_LINUX_PPP_WITHDIRECTION":       reflect.ValueOf(syscall.DLT_LINUX_PPP_WITHDIRECTION),
+		"DLT_LINUX_SLL":                     reflect.ValueOf(syscall.DLT_LINUX_SLL),
+		"DLT_LOOP":                          reflect.ValueOf(syscall.DLT_LOOP),
+		"DLT_LTALK":                         reflect.ValueOf(syscall.DLT_LTALK),
+		"DLT_MATCHING_MAX":                  reflect.ValueOf(syscall.DLT_MATCHING_MAX),
+		"DLT_MATCHING_MIN":                  reflect.ValueOf(syscall.DLT_MATCHING_MIN),
+		"DLT_MFR":                           reflect.ValueOf(syscall.DLT_MFR),
+		"DLT_MOST":                          reflect.ValueOf(syscall.DLT_MOST),
+		"DLT_MPEG_2_TS":                     reflect.ValueOf(syscall.DLT_MPEG_2_TS),
+		"DLT_MPLS":                          reflect.ValueOf(syscall.DLT_MPLS),
+		"DLT_MTP2":                          reflect.ValueOf(syscall.DLT_MTP2),
+		"DLT_MTP2_WITH_PHDR":                reflect.ValueOf(syscall.DLT_MTP2_WITH_PHDR),
+		"DLT_MTP3":                          reflect.ValueOf(syscall.DLT_MTP3),
+		"DLT_MUX27010":                      reflect.ValueOf(syscall.DLT_MUX27010),
+		"DLT_NETANALYZER":                   reflect.ValueOf(syscall.DLT_NETANALYZER),
+		"DLT_NETANALYZER_TRANSPARENT":       reflect.ValueOf(syscall.DLT_NETANALYZER_TRANSPARENT),
+		"DLT_NFC_LLCP":                      reflect.ValueOf(syscall.DLT_NFC_LLCP),
+		"DLT_NFLOG":                         reflect.ValueOf(syscall.DLT_NFLOG),
+		"DLT_NG40":                          reflect.ValueOf(syscall.DLT_NG40),
+		"DLT_NULL":                          reflect.ValueOf(syscall.DLT_NULL),
+		"DLT_PCI_EXP":                       reflect.ValueOf(syscall.DLT_PCI_EXP),
+		"DLT_PFLOG":                         reflect.ValueOf(syscall.DLT_PFLOG),
+		"DLT_PFSYNC":                        reflect.ValueOf(syscall.DLT_PFSYNC),
+		"DLT_PPI":                           reflect.ValueOf(syscall.DLT_PPI),
+		"DLT_PPP":                           reflect.ValueOf(syscall.DLT_PPP),
+		"DLT_PPP_BSDOS":                     reflect.ValueOf(syscall.DLT_PPP_BSDOS),
+		"DLT_PPP_ETHER":                     reflect.ValueOf(syscall.DLT_PPP_ETHER),
+		"DLT_PPP_PPPD":                      reflect.ValueOf(syscall.DLT_PPP_PPPD),
+		"DLT_PPP_SERIAL":                    reflect.ValueOf(syscall.DLT_PPP_SERIAL),
+		"DLT_PPP_WITH_DIR":                  reflect.ValueOf(syscall.DLT_PPP_WITH_DIR),
+		"DLT_PPP_WITH_DIRECTION":            reflect.ValueOf(syscall.DLT_PPP_WITH_DIRECTION),
+		"DLT_PRISM_HEADER":                  reflect.ValueOf(syscall.DLT_PRISM_HEADER),
+		"DLT_PRONET":                        reflect.ValueOf(syscall.DLT_PRONET),
+		"DLT_RAIF1":                         reflect.ValueOf(syscall.DLT_RAIF1),
+		"DLT_RAW":                           reflect.ValueOf(syscall.DLT_RAW),
+		"DLT_RIO":                           reflect.ValueOf(syscall.DLT_RIO),
+		"DLT_SCCP":                          reflect.ValueOf(syscall.DLT_SCCP),
+		"DLT_SITA":                          reflect.ValueOf(syscall.DLT_SITA),
+		"DLT_SLIP":                          reflect.ValueOf(syscall.DLT_SLIP),
+		"DLT_SLIP_BSDOS":                    reflect.ValueOf(syscall.DLT_SLIP_BSDOS),
+		"DLT_STANAG_5066_D_PDU":             reflect.ValueOf(syscall.DLT_STANAG_5066_D_PDU),
+		"DLT_SUNATM":                        reflect.ValueOf(syscall.DLT_SUNATM),
+		"DLT_SYMANTEC_FIREWALL":             reflect.ValueOf(syscall.DLT_SYMANTEC_FIREWALL),
+		"DLT_TZSP":                          reflect.ValueOf(syscall.DLT_TZSP),
+		"DLT_USB":                           reflect.ValueOf(syscall.DLT_USB),
+		"DLT_USB_LINUX":                     reflect.ValueOf(syscall.DLT_USB_LINUX),
+		"DLT_USB_LINUX_MMAPPED":             reflect.ValueOf(syscall.DLT_USB_LINUX_MMAPPED),
+		"DLT_USER0":                         reflect.ValueOf(syscall.DLT_USER0),
+		"DLT_USER1":                         reflect.ValueOf(syscall.DLT_USER1),
+		"DLT_USER10":                        reflect.ValueOf(syscall.DLT_USER10),
+		"DLT_USER11":                        reflect.ValueOf(syscall.DLT_USER11),
+		"DLT_USER12":                        reflect.ValueOf(syscall.DLT_USER12),
+		"DLT_USER13":                        reflect.ValueOf(syscall.DLT_USER13),
+		"DLT_USER14":                        reflect.ValueOf(syscall.DLT_USER14),
+		"DLT_USER15":                        reflect.ValueOf(syscall.DLT_USER15),
+		"DLT_USER2":                         reflect.ValueOf(syscall.DLT_USER2),
+		"DLT_USER3":                         reflect.ValueOf(syscall.DLT_USER3),
+		"DLT_USER4":                         reflect.ValueOf(syscall.DLT_USER4),
+		"DLT_USER5":                         reflect.ValueOf(syscall.DLT_USER5),
+		"DLT_USER6":                         reflect.ValueOf(syscall.DLT_USER6),
+		"DLT_USER7":                         reflect.ValueOf(syscall.DLT_USER7),
+		"DLT_USER8":                         reflect.ValueOf(syscall.DLT_USER8),
+		"DLT_USER9":                         reflect.ValueOf(syscall.DLT_USER9),
+		"DLT_WIHART":                        reflect.ValueOf(syscall.DLT_WIHART),
+		"DLT_X2E_SERIAL":                    reflect.ValueOf(syscall.DLT_X2E_SERIAL),
+		"DLT_X2E_XORAYA":                    reflect.ValueOf(syscall.DLT_X2E_XORAYA),
+		"DT_BLK":                            reflect.ValueOf(syscall.DT_BLK),
+		"DT_CHR":                            reflect.ValueOf(syscall.DT_CHR),
+		"DT_DIR":                            reflect.ValueOf(syscall.DT_DIR),
+		"DT_FIFO":                           reflect.ValueOf(syscall.DT_FIFO),
+		"DT_LNK":                            reflect.ValueOf(syscall.DT_LNK),
+		"DT_REG":                            reflect.ValueOf(syscall.DT_REG),
+		"DT_SOCK":                           reflect.ValueOf(syscall.DT_SOCK),
+		"DT_UNKNOWN":                        reflect.ValueOf(syscall.DT_UNKNOWN),
+		"DT_WHT":                            reflect.ValueOf(syscall.DT_WHT),
+		"Dup":                               reflect.ValueOf(syscall.Dup),
+		"Dup2":                              reflect.ValueOf(syscall.Dup2),
+		"E2BIG":                             reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                            reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                        reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                     reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EAFNOSUPPORT":                      reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                            reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                          reflect.ValueOf(syscall.EALREADY),
+		"EAUTH":                             reflect.ValueOf(syscall.EAUTH),
+		"EBADF":                             reflect.ValueOf(syscall.EBADF),
+		"EBADMSG":                           reflect.ValueOf(syscall.EBADMSG),
+		"EBADRPC":                           reflect.ValueOf(syscall.EBADRPC),
+		"EBUSY":                             reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                         reflect.ValueOf(syscall.ECANCELED),
+		"ECAPMODE":                          reflect.ValueOf(syscall.ECAPMODE),
+		"ECHILD":                            reflect.ValueOf(syscall.ECHILD),
+		"ECHO":                              reflect.ValueOf(syscall.ECHO),
+		"ECHOCTL":                           reflect.ValueOf(syscall.ECHOCTL),
+		"ECHOE":                             reflect.ValueOf(syscall.ECHOE),
+		"ECHOK":                             reflect.ValueOf(syscall.ECHOK),
+		"ECHOKE":                            reflect.ValueOf(syscall.ECHOKE),
+		"ECHONL":                            reflect.ValueOf(syscall.ECHONL),
+		"ECHOPRT":                           reflect.ValueOf(syscall.ECHOPRT),
+		"ECONNABORTED":                      reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                      reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                        reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                           reflect.ValueOf(syscall.EDEADLK),
+		"EDESTADDRREQ":                      reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDOM":                              reflect.ValueOf(syscall.EDOM),
+		"EDOOFUS":                           reflect.ValueOf(syscall.EDOOFUS),
+		"EDQUOT":                            reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                            reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                            reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                             reflect.ValueOf(syscall.EFBIG),
+		"EFTYPE":                            reflect.ValueOf(syscall.EFTYPE),
+		"EHOSTDOWN":                         reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                      reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                             reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                            reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                       reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                             reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                            reflect.ValueOf(syscall.EINVAL),
+		"EIO":                               reflect.ValueOf(syscall.EIO),
+		"EISCONN":                           reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                            reflect.ValueOf(syscall.EISDIR),
+		"ELAST":                             reflect.ValueOf(syscall.ELAST),
+		"ELOOP":                             reflect.ValueOf(syscall.ELOOP),
+		"EMFILE":                            reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                            reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                          reflect.ValueOf(syscall.EMSGSIZE),
+		"EMULTIHOP":                         reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                      reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENEEDAUTH":                         reflect.ValueOf(syscall.ENEEDAUTH),
+		"ENETDOWN":                          reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                         reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                       reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                            reflect.ValueOf(syscall.ENFILE),
+		"ENOATTR":                           reflect.ValueOf(syscall.ENOATTR),
+		"ENOBUFS":                           reflect.ValueOf(syscall.ENOBUFS),
+		"ENODEV":                            reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                            reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                           reflect.ValueOf(syscall.ENOEXEC),
+		"ENOLCK":                            reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                           reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEM":                            reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                            reflect.ValueOf(syscall.ENOMSG),
+		"ENOPROTOOPT":                       reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                            reflect.ValueOf(syscall.ENOSPC),
+		"ENOSYS":                            reflect.ValueOf(syscall.ENOSYS),
+		"ENOTBLK":                           reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCAPABLE":                       reflect.ValueOf(syscall.ENOTCAPABLE),
+		"ENOTCONN":                          reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                           reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                         reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTRECOVERABLE":                   reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                          reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                           reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                            reflect.ValueOf(syscall.ENOTTY),
+		"ENXIO":                             reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                        reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                         reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                        reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                             reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                      reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                             reflect.ValueOf(syscall.EPIPE),
+		"EPROCLIM":                          reflect.ValueOf(syscall.EPROCLIM),
+		"EPROCUNAVAIL":                      reflect.ValueOf(syscall.EPROCUNAVAIL),
+		"EPROGMISMATCH":                     reflect.ValueOf(syscall.EPROGMISMATCH),
+		"EPROGUNAVAIL":                      reflect.ValueOf(syscall.EPROGUNAVAIL),
+		"EPROTO":                            reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":                   reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                        reflect.ValueOf(syscall.EPROTOTYPE),
+		"ERANGE":                            reflect.ValueOf(syscall.ERANGE),
+		"EREMOTE":                           reflect.ValueOf(syscall.EREMOTE),
+		"EROFS":                             reflect.ValueOf(syscall.EROFS),
+		"ERPCMISMATCH":                      reflect.ValueOf(syscall.ERPCMISMATCH),
+		"ESHUTDOWN":                         reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":                   reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESPIPE":                            reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                             reflect.ValueOf(syscall.ESRCH),
+		"ESTALE":                            reflect.ValueOf(syscall.ESTALE),
+		"ETIMEDOUT":                         reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                      reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                           reflect.ValueOf(syscall.ETXTBSY),
+		"EUSERS":                            reflect.ValueOf(syscall.EUSERS),
+		"EVFILT_AIO":                        reflect.ValueOf(syscall.EVFILT_AIO),
+		"EVFILT_FS":                         reflect.ValueOf(syscall.EVFILT_FS),
+		"EVFILT_LIO":                        reflect.ValueOf(syscall.EVFILT_LIO),
+		"EVFILT_PROC":                       reflect.ValueOf(syscall.EVFILT_PROC),
+		"EVFILT_READ":                       reflect.ValueOf(syscall.EVFILT_READ),
+		"EVFILT_SIGNAL":                     reflect.ValueOf(syscall.EVFILT_SIGNAL),
+		"EVFILT_SYSCOUNT":                   reflect.ValueOf(syscall.EVFILT_SYSCOUNT),
+		"EVFILT_TIMER":                      reflect.ValueOf(syscall.EVFILT_TIMER),
+		"EVFILT_USER":                       reflect.ValueOf(syscall.EVFILT_USER),
+		"EVFILT_VNODE":                      reflect.ValueOf(syscall.EVFILT_VNODE),
+		"EVFILT_WRITE":                      reflect.ValueOf(syscall.EVFILT_WRITE),
+		"EV_ADD":                            reflect.ValueOf(syscall.EV_ADD),
+		"EV_CLEAR":                          reflect.ValueOf(syscall.EV_CLEAR),
+		"EV_DELETE":                         reflect.ValueOf(syscall.EV_DELETE),
+		"EV_DISABLE":                        reflect.ValueOf(syscall.EV_DISABLE),
+		"EV_DISPATCH":                       reflect.ValueOf(syscall.EV_DISPATCH),
+		"EV_DROP":                           reflect.ValueOf(syscall.EV_DROP),
+		"EV_ENABLE":                         reflect.ValueOf(syscall.EV_ENABLE),
+		"EV_EOF":                            reflect.ValueOf(syscall.EV_EOF),
+		"EV_ERROR":                          reflect.ValueOf(syscall.EV_ERROR),
+		"EV_FLAG1":                          reflect.ValueOf(syscall.EV_FLAG1),
+		"EV_ONESHOT":                        reflect.ValueOf(syscall.EV_ONESHOT),
+		"EV_RECEIPT":                        reflect.ValueOf(syscall.EV_RECEIPT),
+		"EV_SYSFLAGS":                       reflect.ValueOf(syscall.EV_SYSFLAGS),
+		"EWOULDBLOCK":                       reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EXDEV":                             reflect.ValueOf(syscall.EXDEV),
+		"EXTA":                              reflect.ValueOf(syscall.EXTA),
+		"EXTB":                              reflect.ValueOf(syscall.EXTB),
+		"EXTPROC":                           reflect.ValueOf(syscall.EXTPROC),
+		"Environ":                           reflect.ValueOf(syscall.Environ),
+		"Exec":                              reflect.ValueOf(syscall.Exec),
+		"Exit":                              reflect.ValueOf(syscall.Exit),
+		"FD_CLOEXEC":                        reflect.ValueOf(syscall.FD_CLOEXEC),
+		"FD_SETSIZE":                        reflect.ValueOf(syscall.FD_SETSIZE),
+		"FLUSHO":                            reflect.ValueOf(syscall.FLUSHO),
+		"F_CANCEL":                          reflect.ValueOf(syscall.F_CANCEL),
+		"F_DUP2FD":                          reflect.ValueOf(syscall.F_DUP2FD),
+		"F_DUP2FD_CLOEXEC":                  reflect.ValueOf(syscall.F_DUP2FD_CLOEXEC),
+		"F_DUPFD":                           reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":                   reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_GETFD":                           reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                           reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLK":                           reflect.ValueOf(syscall.F_GETLK),
+		"F_GETOWN":                          reflect.ValueOf(syscall.F_GETOWN),
+		"F_OGETLK":                          reflect.ValueOf(syscall.F_OGETLK),
+		"F_OK":                              reflect.ValueOf(syscall.F_OK),
+		"F_OSETLK":                          reflect.ValueOf(syscall.F_OSETLK),
+		"F_OSETLKW":                         reflect.ValueOf(syscall.F_OSETLKW),
+		"F_RDAHEAD":                         reflect.ValueOf(syscall.F_RDAHEAD),
+		"F_RDLCK":                           reflect.ValueOf(syscall.F_RDLCK),
+		"F_READAHEAD":                       reflect.ValueOf(syscall.F_READAHEAD),
+		"F_SETFD":                           reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                           reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLK":                           reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLKW":                          reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETLK_REMOTE":                    reflect.ValueOf(syscall.F_SETLK_REMOTE),
+		"F_SETOWN":                          reflect.ValueOf(syscall.F_SETOWN),
+		"F_UNLCK":                           reflect.ValueOf(syscall.F_UNLCK),
+		"F_UNLCKSYS":                        reflect.ValueOf(syscall.F_UNLCKSYS),
+		"F_WRLCK":                           reflect.ValueOf(syscall.F_WRLCK),
+		"Fchdir":                            reflect.ValueOf(syscall.Fchdir),
+		"Fchflags":                          reflect.ValueOf(syscall.Fchflags),
+		"Fchmod":                            reflect.ValueOf(syscall.Fchmod),
+		"Fchown":                            reflect.ValueOf(syscall.Fchown),
+		"FcntlFlock":                        reflect.ValueOf(syscall.FcntlFlock),
+		"Flock":                             reflect.ValueOf(syscall.Flock),
+		"FlushBpf":                          reflect.ValueOf(syscall.FlushBpf),
+		"ForkExec":                          reflect.ValueOf(syscall.ForkExec),
+		"ForkLock":                          reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"Fpathconf":                         reflect.ValueOf(syscall.Fpathconf),
+		"Fstat":                             reflect.ValueOf(syscall.Fstat),
+		"Fstatat":                           reflect.ValueOf(syscall.Fstatat),
+		"Fstatfs":                           reflect.ValueOf(syscall.Fstatfs),
+		"Fsync":                             reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                         reflect.ValueOf(syscall.Ftruncate),
+		"Futimes":                           reflect.ValueOf(syscall.Futimes),
+		"Getdirentries":                     reflect.ValueOf(syscall.Getdirentries),
+		"Getdtablesize":                     reflect.ValueOf(syscall.Getdtablesize),
+		"Getegid":                           reflect.ValueOf(syscall.Getegid),
+		"Getenv":                            reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                           reflect.ValueOf(syscall.Geteuid),
+		"Getfsstat":                         reflect.ValueOf(syscall.Getfsstat),
+		"Getgid":                            reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                         reflect.ValueOf(syscall.Getgroups),
+		"Getpagesize":                       reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                       reflect.ValueOf(syscall.Getpeername),
+		"Getpgid":                           reflect.ValueOf(syscall.Getpgid),
+		"Getpgrp":                           reflect.ValueOf(syscall.Getpgrp),
+		"Getpid":                            reflect.ValueOf(syscall.Getpid),
+		"Getppid":                           reflect.ValueOf(syscall.Getppid),
+		"Getpriority":                       reflect.ValueOf(syscall.Getpriority),
+		"Getrlimit":                         reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                         reflect.ValueOf(syscall.Getrusage),
+		"Getsid":                            reflect.ValueOf(syscall.Getsid),
+		"Getsockname":                       reflect.ValueOf(syscall.Getsockname),
+		"GetsockoptByte":                    reflect.ValueOf(syscall.GetsockoptByte),
+		"GetsockoptICMPv6Filter":            reflect.ValueOf(syscall.GetsockoptICMPv6Filter),
+		"GetsockoptIPMreq":                  reflect.ValueOf(syscall.GetsockoptIPMreq),
+		"GetsockoptIPMreqn":                 reflect.ValueOf(syscall.GetsockoptIPMreqn),
+		"GetsockoptIPv6MTUInfo":             reflect.ValueOf(syscall.GetsockoptIPv6MTUInfo),
+		"GetsockoptIPv6Mreq":                reflect.ValueOf(syscall.GetsockoptIPv6Mreq),
+		"GetsockoptInet4Addr":               reflect.ValueOf(syscall.GetsockoptInet4Addr),
+		"GetsockoptInt":                     reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                      reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                            reflect.ValueOf(syscall.Getuid),
+		"Getwd":                             reflect.ValueOf(syscall.Getwd),
+		"HUPCL":                             reflect.ValueOf(syscall.HUPCL),
+		"ICANON":                            reflect.ValueOf(syscall.ICANON),
+		"ICMP6_FILTER":                      reflect.ValueOf(syscall.ICMP6_FILTER),
+		"ICRNL":                             reflect.ValueOf(syscall.ICRNL),
+		"IEXTEN":                            reflect.ValueOf(syscall.IEXTEN),
+		"IFAN_ARRIVAL":                      reflect.ValueOf(syscall.IFAN_ARRIVAL),
+		"IFAN_DEPARTURE":                    reflect.ValueOf(syscall.IFAN_DEPARTURE),
+		"IFF_ALLMULTI":                      reflect.ValueOf(syscall.IFF_ALLMULTI),
+		"IFF_ALTPHYS":                       reflect.ValueOf(syscall.IFF_ALTPHYS),
+		"IFF_BROADCAST":                     reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_CANTCHANGE":                    reflect.ValueOf(syscall.IFF_CANTCHANGE),
+		"IFF_CANTCONFIG":                    reflect.ValueOf(syscall.IFF_CANTCONFIG),
+		"IFF_DEBUG":                         reflect.ValueOf(syscall.IFF_DEBUG),
+		"IFF_DRV_OACTIVE":                   reflect.ValueOf(syscall.IFF_DRV_OACTIVE),
+		"IFF_DRV_RUNNING":                   reflect.ValueOf(syscall.IFF_DRV_RUNNING),
+		"IFF_DYING":                         reflect.ValueOf(syscall.IFF_DYING),
+		"IFF_LINK0":                         reflect.ValueOf(syscall.IFF_LINK0),
+		"IFF_LINK1":                         reflect.ValueOf(syscall.IFF_LINK1),
+		"IFF_LINK2":                         reflect.ValueOf(syscall.IFF_LINK2),
+		"IFF_LOOPBACK":                      reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_MONITOR":                       reflect.ValueOf(syscall.IFF_MONITOR),
+		"IFF_MULTICAST":                     reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_NOARP":                         reflect.ValueOf(syscall.IFF_NOARP),
+		"IFF_OACTIVE":                       reflect.ValueOf(syscall.IFF_OACTIVE),
+		"IFF_POINTOPOINT":                   reflect.ValueOf(syscall.IFF_POINTOPOINT),
+		"IFF_PPROMISC":                      reflect.ValueOf(syscall.IFF_PPROMISC),
+		"IFF_PROMISC":                       reflect.ValueOf(syscall.IFF_PROMISC),
+		"IFF_RENAMING":                      reflect.ValueOf(syscall.IFF_RENAMING),
+		"IFF_RUNNING":                       reflect.ValueOf(syscall.IFF_RUNNING),
+		"IFF_SIMPLEX":                       reflect.ValueOf(syscall.IFF_SIMPLEX),
+		"IFF_SMART":                         reflect.ValueOf(syscall.IFF_SMART),
+		"IFF_STATICARP":                     reflect.ValueOf(syscall.IFF_STATICARP),
+		"IFF_UP":                            reflect.ValueOf(syscall.IFF_UP),
+		"IFNAMSIZ":                          reflect.ValueOf(syscall.IFNAMSIZ),
+		"IFT_1822":                          reflect.ValueOf(syscall.IFT_1822),
+		"IFT_A12MPPSWITCH":                  reflect.ValueOf(syscall.IFT_A12MPPSWITCH),
+		"IFT_AAL2":                          reflect.ValueOf(syscall.IFT_AAL2),
+		"IFT_AAL5":                          reflect.ValueOf(syscall.IFT_AAL5),
+		"IFT_ADSL":                          reflect.ValueOf(syscall.IFT_ADSL),
+		"IFT_AFLANE8023":                    reflect.ValueOf(syscall.IFT_AFLANE8023),
+		"IFT_AFLANE8025":                    reflect.ValueOf(syscall.IFT_AFLANE8025),
+		"IFT_ARAP":                          reflect.ValueOf(syscall.IFT_ARAP),
+		"IFT_ARCNET":                        reflect.ValueOf(syscall.IFT_ARCNET),
+		"IFT_ARCNETPLUS":                    reflect.ValueOf(syscall.IFT_ARCNETPLUS),
+		"IFT_ASYNC":                         reflect.ValueOf(syscall.IFT_ASYNC),
+		"IFT_ATM":                           reflect.ValueOf(syscall.IFT_ATM),
+		"IFT_ATMDXI":                        reflect.ValueOf(syscall.IFT_ATMDXI),
+		"IFT_ATMFUNI":                       reflect.ValueOf(syscall.IFT_ATMFUNI),
+		"IFT_ATMIMA":                        reflect.ValueOf(syscall.IFT_ATMIMA),
+		"IFT_ATMLOGICAL":                    reflect.ValueOf(syscall.IFT_ATMLOGICAL),
+		"IFT_ATMRADIO":                      reflect.ValueOf(syscall.IFT_ATMRADIO),
+		"IFT_ATMSUBINTERFACE":               reflect.ValueOf(syscall.IFT_ATMSUBINTERFACE),
+		"IFT_ATMVCIENDPT":                   reflect.ValueOf(syscall.IFT_ATMVCIENDPT),
+		"IFT_ATMVIRTUAL":                    reflect.ValueOf(syscall.IFT_ATMVIRTUAL),
+		"IFT_BGPPOLICYACCOUNTING":           reflect.ValueOf(syscall.IFT_BGPPOLICYACCOUNTING),
+		"IFT_BRIDGE":                        reflect.ValueOf(syscall.IFT_BRIDGE),
+		"IFT_BSC":                           reflect.ValueOf(syscall.IFT_BSC),
+		"IFT_CARP":                          reflect.ValueOf(syscall.IFT_CARP),
+		"IFT_CCTEMUL":                       reflect.ValueOf(syscall.IFT_CCTEMUL),
+		"IFT_CEPT":                          reflect.ValueOf(syscall.IFT_CEPT),
+		"IFT_CES":                           reflect.ValueOf(syscall.IFT_CES),
+		"IFT_CHANNEL":                       reflect.ValueOf(syscall.IFT_CHANNEL),
+		"IFT_CNR":                           reflect.ValueOf(syscall.IFT_CNR),
+		"IFT_COFFEE":                        reflect.ValueOf(syscall.IFT_COFFEE),
+		"IFT_COMPOSITELINK":                 reflect.ValueOf(syscall.IFT_COMPOSITELINK),
+		"IFT_DCN":                           reflect.ValueOf(syscall.IFT_DCN),
+		"IFT_DIGITALPOWERLINE":              reflect.ValueOf(syscall.IFT_DIGITALPOWERLINE),
+		"IFT_DIGITALWRAPPEROVERHEADCHANNEL": reflect.ValueOf(syscall.IFT_DIGITALWRAPPEROVERHEADCHANNEL),
+		"IFT_DLSW":                          reflect.ValueOf(syscall.IFT_DLSW),
+		"IFT_DOCSCABLEDOWNSTREAM":           reflect.ValueOf(syscall.IFT_DOCSCABLEDOWNSTREAM),
+		"IFT_DOCSCABLEMACLAYER":             reflect.ValueOf(syscall.IFT_DOCSCABLEMACLAYER),
+		"IFT_DOCSCABLEUPSTREAM":             reflect.ValueOf(syscall.IFT_DOCSCABLEUPSTREAM),
+		"IFT_DS0":                           reflect.ValueOf(syscall.IFT_DS0),
+		"IFT_DS0BUNDLE":                     reflect.ValueOf(syscall.IFT_DS0BUNDLE),
+		"IFT_DS1FDL":                        reflect.ValueOf(syscall.IFT_DS1FDL),
+		"IFT_DS3":                           reflect.ValueOf(syscall.IFT_DS3),
+		"IFT_DTM":                           reflect.ValueOf(syscall.IFT_DTM),
+		"IFT_DVBASILN":                      reflect.ValueOf(syscall.IFT_DVBASILN),
+		"IFT_DVBASIOUT":                     reflect.ValueOf(syscall.IFT_DVBASIOUT),
+		"IFT_DVBRCCDOWNSTREAM":              reflect.ValueOf(syscall.IFT_DVBRCCDOWNSTREAM),
+		"IFT_DVBRCCMACLAYER":                reflect.ValueOf(syscall.IFT_DVBRCCMACLAYER),
+		"IFT_DVBRCCUPSTREAM":                reflect.ValueOf(syscall.IFT_DVBRCCUPSTREAM),
+		"IFT_ENC":                           reflect.ValueOf(syscall.IFT_ENC),
+		"IFT_EON":                           reflect.ValueOf(syscall.IFT_EON),
+		"IFT_EPLRS":                         reflect.ValueOf(syscall.IFT_EPLRS),
+		"IFT_ESCON":                         reflect.ValueOf(syscall.IFT_ESCON),
+		"IFT_ETHER":                         reflect.ValueOf(syscall.IFT_ETHER),
+		"IFT_FAITH":                         reflect.ValueOf(syscall.IFT_FAITH),
+		"IFT_FAST":                          reflect.ValueOf(syscall.IFT_FAST),
+		"IFT_FASTETHER":                     reflect.ValueOf(syscall.IFT_FASTETHER),
+		"IFT_FASTETHERFX":                   reflect.ValueOf(syscall.IFT_FASTETHERFX),
+		"IFT_FDDI":                          reflect.ValueOf(syscall.IFT_FDDI),
+		"IFT_FIBRECHANNEL":                  reflect.ValueOf(syscall.IFT_FIBRECHANNEL),
+		"IFT_FRAMERELAYINTERCONNECT":        reflect.ValueOf(syscall.IFT_FRAMERELAYINTERCONNECT),
+		"IFT_FRAMERELAYMPI":                 reflect.ValueOf(syscall.IFT_FRAMERELAYMPI),
+		"IFT_FRDLCIENDPT":                   reflect.ValueOf(syscall.IFT_FRDLCIENDPT),
+		"IFT_FRELAY":                        reflect.ValueOf(syscall.IFT_FRELAY),
+		"IFT_FRELAYDCE":                     reflect.ValueOf(syscall.IFT_FRELAYDCE),
+		"IFT_FRF16MFRBUNDLE":                reflect.ValueOf(syscall.IFT_FRF16MFRBUNDLE),
+		"IFT_FRFORWARD":                     reflect.ValueOf(syscall.IFT_FRFORWARD),
+		"IFT_G703AT2MB":                     reflect.ValueOf(syscall.IFT_G703AT2MB),
+		"IFT_G703AT64K":                     reflect.ValueOf(syscall.IFT_G703AT64K),
+		"IFT_GIF":                           reflect.ValueOf(syscall.IFT_GIF),
+		"IFT_GIGABITETHERNET":               reflect.ValueOf(syscall.IFT_GIGABITETHERNET),
+		"IFT_GR303IDT":                      reflect.ValueOf(syscall.IFT_GR303IDT),
+		"IFT_GR303RDT":                      reflect.ValueOf(syscall.IFT_GR303RDT),
+		"IFT_H323GATEKEEPER":                reflect.ValueOf(syscall.IFT_H323GATEKEEPER),
+		"IFT_H323PROXY":                     reflect.ValueOf(syscall.IFT_H323PROXY),
+		"IFT_HDH1822":                       reflect.ValueOf(syscall.IFT_HDH1822),
+		"IFT_HDLC":                          reflect.ValueOf(syscall.IFT_HDLC),
+		"IFT_HDSL2":                         reflect.ValueOf(syscall.IFT_HDSL2),
+		"IFT_HIPERLAN2":                     reflect.ValueOf(syscall.IFT_HIPERLAN2),
+		"IFT_HIPPI":                         reflect.ValueOf(syscall.IFT_HIPPI),
+		"IFT_HIPPIINTERFACE":                reflect.ValueOf(syscall.IFT_HIPPIINTERFACE),
+		"IFT_HOSTPAD":                       reflect.ValueOf(syscall.IFT_HOSTPAD),
+		"IFT_HSSI":                          reflect.ValueOf(syscall.IFT_HSSI),
+		"IFT_HY":                            reflect.ValueOf(syscall.IFT_HY),
+		"IFT_IBM370PARCHAN":                 reflect.ValueOf(syscall.IFT_IBM370PARCHAN),
+		"IFT_IDSL":                          reflect.ValueOf(syscall.IFT_IDSL),
+		"IFT_IEEE1394":                      reflect.ValueOf(syscall.IFT_IEEE1394),
+		"IFT_IEEE80211":                     reflect.ValueOf(syscall.IFT_IEEE80211),
+		"IFT_IEEE80212":                     reflect.ValueOf(syscall.IFT_IEEE80212),
+		"IFT_IEEE8023ADLAG":                 reflect.ValueOf(syscall.IFT_IEEE8023ADLAG),
+		"IFT_IFGSN":                         reflect.ValueOf(syscall.IFT_IFGSN),
+		"IFT_IMT":                           reflect.ValueOf(syscall.IFT_IMT),
+		"IFT_INFINIBAND":                    reflect.ValueOf(syscall.IFT_INFINIBAND),
+		"IFT_INTERLEAVE":                    reflect.ValueOf(syscall.IFT_INTERLEAVE),
+		"IFT_IP":                            reflect.ValueOf(syscall.IFT_IP),
+		"IFT_IPFORWARD":                     reflect.ValueOf(syscall.IFT_IPFORWARD),
+		"IFT_IPOVERATM":                     reflect.ValueOf(syscall.IFT_IPOVERATM),
+		"IFT_IPOVERCDLC":                    reflect.ValueOf(syscall.IFT_IPOVERCDLC),
+		"IFT_IPOVERCLAW":                    reflect.ValueOf(syscall.IFT_IPOVERCLAW),
+		"IFT_IPSWITCH":                      reflect.ValueOf(syscall.IFT_IPSWITCH),
+		"IFT_IPXIP":                         reflect.ValueOf(syscall.IFT_IPXIP),
+		"IFT_ISDN":                          reflect.ValueOf(syscall.IFT_ISDN),
+		"IFT_ISDNBASIC":                     reflect.ValueOf(syscall.IFT_ISDNBASIC),
+		"IFT_ISDNPRIMARY":                   reflect.ValueOf(syscall.IFT_ISDNPRIMARY),
+		"IFT_ISDNS":                         reflect.ValueOf(syscall.IFT_ISDNS),
+		"IFT_ISDNU":                         reflect.ValueOf(syscall.IFT_ISDNU),
+		"IFT_ISO88022LLC":                   reflect.ValueOf(syscall.IFT_ISO88022LLC),
+		"IFT_ISO88023":                      reflect.ValueOf(syscall.IFT_ISO88023),
+		"IFT_ISO88024":                      reflect.ValueOf(syscall.IFT_ISO88024),
+		"IFT_ISO88025":                      reflect.ValueOf(syscall.IFT_ISO88025),
+		"IFT_ISO88025CRFPINT":               reflect.ValueOf(syscall.IFT_ISO88025CRFPINT),
+		"IFT_ISO88025DTR":                   reflect.ValueOf(syscall.IFT_ISO88025DTR),
+		"IFT_ISO88025FIBER":                 reflect.ValueOf(syscall.IFT_ISO88025FIBER),
+		"IFT_ISO88026":                      reflect.ValueOf(syscall.IFT_ISO88026),
+		"IFT_ISUP":                          reflect.ValueOf(syscall.IFT_ISUP),
+		"IFT_L2VLAN":                        reflect.ValueOf(syscall.IFT_L2VLAN),
+		"IFT_L3IPVLAN":                      reflect.ValueOf(syscall.IFT_L3IPVLAN),
+		"IFT_L3IPXVLAN":                     reflect.ValueOf(syscall.IFT_L3IPXVLAN),
+		"IFT_LAPB":                          reflect.ValueOf(syscall.IFT_LAPB),
+		"IFT_LAPD":                          reflect.ValueOf(syscall.IFT_LAPD),
+		"IFT_LAPF":                          reflect.ValueOf(syscall.IFT_LAPF),
+		"IFT_LOCALTALK":                     reflect.ValueOf(syscall.IFT_LOCALTALK),
+		"IFT_LOOP":                          reflect.ValueOf(syscall.IFT_LOOP),
+		"IFT_MEDIAMAILOVERIP":               reflect.ValueOf(syscall.IFT_MEDIAMAILOVERIP),
+		"IFT_MFSIGLINK":                     reflect.ValueOf(syscall.IFT_MFSIGLINK),
+		"IFT_MIOX25":                        reflect.ValueOf(syscall.IFT_MIOX25),
+		"IFT_MODEM":                         reflect.ValueOf(syscall.IFT_MODEM),
+		"IFT_MPC":                           reflect.ValueOf(syscall.IFT_MPC),
+		"IFT_MPLS":                          reflect.ValueOf(syscall.IFT_MPLS),
+		"IFT_MPLSTUNNEL":                    reflect.ValueOf(syscall.IFT_MPLSTUNNEL),
+		"IFT_MSDSL":                         reflect.ValueOf(syscall.IFT_MSDSL),
+		"IFT_MVL":                           reflect.ValueOf(syscall.IFT_MVL),
+		"IFT_MYRINET":                       reflect.ValueOf(syscall.IFT_MYRINET),
+		"IFT_NFAS":                          reflect.ValueOf(syscall.IFT_NFAS),
+		"IFT_NSIP":                          reflect.ValueOf(syscall.IFT_NSIP),
+		"IFT_OPTICALCHANNEL":                reflect.ValueOf(syscall.IFT_OPTICALCHANNEL),
+		"IFT_OPTICALTRANSPORT":              reflect.ValueOf(syscall.IFT_OPTICALTRANSPORT),
+		"IFT_OTHER":                         reflect.ValueOf(syscall.IFT_OTHER),
+		"IFT_P10":                           reflect.ValueOf(syscall.IFT_P10),
+		"IFT_P80":                           reflect.ValueOf(syscall.IFT_P80),
+		"IFT_PARA":                          reflect.ValueOf(syscall.IFT_PARA),
+		"IFT_PFLOG":                         reflect.ValueOf(syscall.IFT_PFLOG),
+		"IFT_PFSYNC":                        reflect.ValueOf(syscall.IFT_PFSYNC),
+		"IFT_PLC":                           reflect.ValueOf(syscall.IFT_PLC),
+		"IFT_POS":                           reflect.ValueOf(syscall.IFT_POS),
+		"IFT_PPP":                           reflect.ValueOf(syscall.IFT_PPP),
+		"IFT_PPPMULTILINKBUNDLE":            reflect.ValueOf(syscall.IFT_PPPMULTILINKBUNDLE),
+		"IFT_PROPBWAP2MP":                   reflect.ValueOf(syscall.IFT_PROPBWAP2MP),
+		"IFT_PROPCNLS":                      reflect.ValueOf(syscall.IFT_PROPCNLS),
+		"IFT_PROPDOCSWIRELESSDOWNSTREAM":    reflect.ValueOf(syscall.IFT_PROPDOCSWIRELESSDOWNSTREAM),
+		"IFT_PROPDOCSWIRELESSMACLAYER":      reflect.ValueOf(syscall.IFT_PROPDOCSWIRELESSMACLAYER),
+		"IFT_PROPDOCSWIRELESSUPSTREAM":      reflect.ValueOf(syscall.IFT_PROPDOCSWIRELESSUPSTREAM),
+		"IFT_PROPMUX":                       reflect.ValueOf(syscall.IFT_PROPMUX),
+		"IFT_PROPVIRTUAL":                   reflect.ValueOf(syscall.IFT_PROPVIRTUAL),
+		"IFT_PROPWIRELESSP2P":               reflect.ValueOf(syscall.IFT_PROPWIRELESSP2P),
+		"IFT_PTPSERIAL":                     reflect.ValueOf(syscall.IFT_PTPSERIAL),
+		"IFT_PVC":                           reflect.ValueOf(syscall.IFT_PVC),
+		"IFT_QLLC":                          reflect.ValueOf(syscall.IFT_QLLC),
+		"IFT_RADIOMAC":                      reflect.ValueOf(syscall.IFT_RADIOMAC),
+		"IFT_RADSL":                         reflect.ValueOf(syscall.IFT_RADSL),
+		"IFT_REACHDSL":                      reflect.ValueOf(syscall.IFT_REACHDSL),
+		"IFT_RFC1483":                       reflect.ValueOf(syscall.IFT_RFC1483),
+		"IFT_RS232":                         reflect.ValueOf(syscall.IFT_RS232),
+		"IFT_RSRB":                          reflect.ValueOf(syscall.IFT_RSRB),
+		"IFT_SDLC":                          reflect.ValueOf(syscall.IFT_SDLC),
+		"IFT_SDSL":                          reflect.ValueOf(syscall.IFT_SDSL),
+		"IFT_SHDSL":                         reflect.ValueOf(syscall.IFT_SHDSL),
+		"IFT_SIP":                           reflect.ValueOf(syscall.IFT_SIP),
+		"IFT_SLIP":                          reflect.ValueOf(syscall.IFT_SLIP),
+		"IFT_SMDSDXI":                       reflect.ValueOf(syscall.IFT_SMDSDXI),
+		"IFT_SMDSICIP":                      reflect.ValueOf(syscall.IFT_SMDSICIP),
+		"IFT_SONET":                         reflect.ValueOf(syscall.IFT_SONET),
+		"IFT_SONETOVERHEADCHANNEL":          reflect.ValueOf(syscall.IFT_SONETOVERHEADCHANNEL),
+		"IFT_SONETPATH":                     reflect.ValueOf(syscall.IFT_SONETPATH),
+		"IFT_SONETVT":                       reflect.ValueOf(syscall.IFT_SONETVT),
+		"IFT_SRP":                           reflect.ValueOf(syscall.IFT_SRP),
+		"IFT_SS7SIGLINK":                    reflect.ValueOf(syscall.IFT_SS7SIGLINK),
+		"IFT_STACKTOSTACK":                  reflect.ValueOf(syscall.IFT_STACKTOSTACK),
+		"IFT_STARLAN":                       reflect.ValueOf(syscall.IFT_STARLAN),
+		"IFT_STF":                           reflect.ValueOf(syscall.IFT_STF),
+		"IFT_T1":                            reflect.ValueOf(syscall.IFT_T1),
+		"IFT_TDLC":                          reflect.ValueOf(syscall.IFT_TDLC),
+		"IFT_TERMPAD":                       reflect.ValueOf(syscall.IFT_TERMPAD),
+		"IFT_TR008":                         reflect.ValueOf(syscall.IFT_TR008),
+		"IFT_TRANSPHDLC":                    reflect.ValueOf(syscall.IFT_TRANSPHDLC),
+		"IFT_TUNNEL":                        reflect.ValueOf(syscall.IFT_TUNNEL),
+		"IFT_ULTRA":                         reflect.ValueOf(syscall.IFT_ULTRA),
+		"IFT_USB":                           reflect.ValueOf(syscall.IFT_USB),
+		"IFT_V11":                           reflect.ValueOf(syscall.IFT_V11),
+		"IFT_V35":                           reflect.ValueOf(syscall.IFT_V35),
+		"IFT_V36":                           reflect.ValueOf(syscall.IFT_V36),
+		"IFT_V37":                           reflect.ValueOf(syscall.IFT_V37),
+		"IFT_VDSL":                          reflect.ValueOf(syscall.IFT_VDSL),
+		"IFT_VIRTUALIPADDRESS":              reflect.ValueOf(syscall.IFT_VIRTUALIPADDRESS),
+		"IFT_VOICEEM":                       reflect.ValueOf(syscall.IFT_VOICEEM),
+		"IFT_VOICEENCAP":                    reflect.ValueOf(syscall.IFT_VOICEENCAP),
+		"IFT_VOICEFXO":                      reflect.ValueOf(syscall.IFT_VOICEFXO),
+		"IFT_VOICEFXS":                      reflect.ValueOf(syscall.IFT_VOICEFXS),
+		"IFT_VOICEOVERATM":                  reflect.ValueOf(syscall.IFT_VOICEOVERATM),
+		"IFT_VOICEOVERFRAMERELAY":           reflect.ValueOf(syscall.IFT_VOICEOVERFRAMERELAY),
+		"IFT_VOICEOVERIP":                   reflect.ValueOf(syscall.IFT_VOICEOVERIP),
+		"IFT_X213":                          reflect.ValueOf(syscall.IFT_X213),
+		"IFT_X25":                           reflect.ValueOf(syscall.IFT_X25),
+		"IFT_X25DDN":                        reflect.ValueOf(syscall.IFT_X25DDN),
+		"IFT_X25HUNTGROUP":                  reflect.ValueOf(syscall.IFT_X25HUNTGROUP),
+		"IFT_X25MLP":                        reflect.ValueOf(syscall.IFT_X25MLP),
+		"IFT_X25PLE":                        reflect.ValueOf(syscall.IFT_X25PLE),
+		"IFT_XETHER":                        reflect.ValueOf(syscall.IFT_XETHER),
+		"IGNBRK":                            reflect.ValueOf(syscall.IGNBRK),
+		"IGNCR":                             reflect.ValueOf(syscall.IGNCR),
+		"IGNPAR":                            reflect.ValueOf(syscall.IGNPAR),
+		"IMAXBEL":                           reflect.ValueOf(syscall.IMAXBEL),
+		"INLCR":                             reflect.ValueOf(syscall.INLCR),
+		"INPCK":                             reflect.ValueOf(syscall.INPCK),
+		"IN_CLASSA_HOST":                    reflect.ValueOf(syscall.IN_CLASSA_HOST),
+		"IN_CLASSA_MAX":                     reflect.ValueOf(syscall.IN_CLASSA_MAX),
+		"IN_CLASSA_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSA_NET)),
+		"IN_CLASSA_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSA_NSHIFT),
+		"IN_CLASSB_HOST":                    reflect.ValueOf(syscall.IN_CLASSB_HOST),
+		"IN_CLASSB_MAX":                     reflect.ValueOf(syscall.IN_CLASSB_MAX),
+		"IN_CLASSB_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSB_NET)),
+		"IN_CLASSB_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSB_NSHIFT),
+		"IN_CLASSC_HOST":                    reflect.ValueOf(syscall.IN_CLASSC_HOST),
+		"IN_CLASSC_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSC_NET)),
+		"IN_CLASSC_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSC_NSHIFT),
+		"IN_CLASSD_HOST":                    reflect.ValueOf(syscall.IN_CLASSD_HOST),
+		"IN_CLASSD_NET":                     reflect.ValueOf(uint32(syscall.IN_CLASSD_NET)),
+		"IN_CLASSD_NSHIFT":                  reflect.ValueOf(syscall.IN_CLASSD_NSHIFT),
+		"IN_LOOPBACKNET":                    reflect.ValueOf(syscall.IN_LOOPBACKNET),
+		"IN_RFC3021_MASK":                   reflect.ValueOf(uint32(syscall.IN_RFC3021_MASK)),
+		"IPPROTO_3PC":                       reflect.ValueOf(syscall.IPPROTO_3PC),
+		"IPPROTO_ADFS":                      reflect.ValueOf(syscall.IPPROTO_ADFS),
+		"IPPROTO_AH":                        reflect.ValueOf(syscall.IPPROTO_AH),
+		"IPPROTO_AHIP":                      reflect.ValueOf(syscall.IPPROTO_AHIP),
+		"IPPROTO_APES":                      reflect.ValueOf(syscall.IPPROTO_APES),
+		"IPPROTO_ARGUS":                     reflect.ValueOf(syscall.IPPROTO_ARGUS),
+		"IPPROTO_AX25":                      reflect.ValueOf(syscall.IPPROTO_AX25),
+		"IPPROTO_BHA":                       reflect.ValueOf(syscall.IPPROTO_BHA),
+		"IPPROTO_BLT":                       reflect.ValueOf(syscall.IPPROTO_BLT),
+		"IPPROTO_BRSATMON":                  reflect.ValueOf(syscall.IPPROTO_BRSATMON),
+		"IPPROTO_CARP":                      reflect.ValueOf(syscall.IPPROTO_CARP),
+		"IPPROTO_CFTP":                      reflect.ValueOf(syscall.IPPROTO_CFTP),
+		"IPPROTO_CHAOS":                     reflect.ValueOf(syscall.IPPROTO_CHAOS),
+		"IPPROTO_CMTP":                      reflect.ValueOf(syscall.IPPROTO_CMTP),
+		"IPPROTO_CPHB":                      reflect.ValueOf(syscall.IPPROTO_CPHB),
+		"IPPROTO_CPNX":                      reflect.ValueOf(syscall.IPPROTO_CPNX),
+		"IPPROTO_DDP":                       reflect.ValueOf(syscall.IPPROTO_DDP),
+		"IPPROTO_DGP":                       reflect.ValueOf(syscall.IPPROTO_DGP),
+		"IPPROTO_DIVERT":                    reflect.ValueOf(syscall.IPPROTO_DIVERT),
+		"IPPROTO_DONE":                      reflect.ValueOf(syscall.IPPROTO_DONE),
+		"IPPROTO_DSTOPTS":                   reflect.ValueOf(syscall.IPPROTO_DSTOPTS),
+		"IPPROTO_EGP":                       reflect.ValueOf(syscall.IPPROTO_EGP),
+		"IPPROTO_EMCON":                     reflect.ValueOf(syscall.IPPROTO_EMCON),
+		"IPPROTO_ENCAP":                     reflect.ValueOf(syscall.IPPROTO_ENCAP),
+		"IPPROTO_EON":                       reflect.ValueOf(syscall.IPPROTO_EON),
+		"IPPROTO_ESP":                       reflect.ValueOf(syscall.IPPROTO_ESP),
+		"IPPROTO_ETHERIP":                   reflect.ValueOf(syscall.IPPROTO_ETHERIP),
+		"IPPROTO_FRAGMENT":                  reflect.ValueOf(syscall.IPPROTO_FRAGMENT),
+		"IPPROTO_GGP":                       reflect.ValueOf(syscall.IPPROTO_GGP),
+		"IPPROTO_GMTP":                      reflect.ValueOf(syscall.IPPROTO_GMTP),
+		"IPPROTO_GRE":                       reflect.ValueOf(syscall.IPPROTO_GRE),
+		"IPPROTO_HELLO":                     reflect.ValueOf(syscall.IPPROTO_HELLO),
+		"IPPROTO_HMP":                       reflect.ValueOf(syscall.IPPROTO_HMP),
+		"IPPROTO_HOPOPTS":                   reflect.ValueOf(syscall.IPPROTO_HOPOPTS),
+		"IPPROTO_ICMP":                      reflect.ValueOf(syscall.IPPROTO_ICMP),
+		"IPPROTO_ICMPV6":                    reflect.ValueOf(syscall.IPPROTO_ICMPV6),
+		"IPPROTO_IDP":                       reflect.ValueOf(syscall.IPPROTO_IDP),
+		"IPPROTO_IDPR":                      reflect.ValueOf(syscall.IPPROTO_IDPR),
+		"IPPROTO_IDRP":                      reflect.ValueOf(syscall.IPPROTO_IDRP),
+		"IPPROTO_IGMP":                      reflect.ValueOf(syscall.IPPROTO_IGMP),
+		"IPPROTO_IGP":                       reflect.ValueOf(syscall.IPPROTO_IGP),
+		"IPPROTO_IGRP":                      reflect.ValueOf(syscall.IPPROTO_IGRP),
+		"IPPROTO_IL":                        reflect.ValueOf(syscall.IPPROTO_IL),
+		"IPPROTO_INLSP":                     reflect.ValueOf(syscall.IPPROTO_INLSP),
+		"IPPROTO_INP":                       reflect.ValueOf(syscall.IPPROTO_INP),
+		"IPPROTO_IP":                        reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPCOMP":                    reflect.ValueOf(syscall.IPPROTO_IPCOMP),
+		"IPPROTO_IPCV":                      reflect.ValueOf(syscall.IPPROTO_IPCV),
+		"IPPROTO_IPEIP":                     reflect.ValueOf(syscall.IPPROTO_IPEIP),
+		"IPPROTO_IPIP":                      reflect.ValueOf(syscall.IPPROTO_IPIP),
+		"IPPROTO_IPPC":                      reflect.ValueOf(syscall.IPPROTO_IPPC),
+		"IPPROTO_IPV4":                      reflect.ValueOf(syscall.IPPROTO_IPV4),
+		"IPPROTO_IPV6":                      reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_IRTP":                      reflect.ValueOf(syscall.IPPROTO_IRTP),
+		"IPPROTO_KRYPTOLAN":                 reflect.ValueOf(syscall.IPPROTO_KRYPTOLAN),
+		"IPPROTO_LARP":                      reflect.ValueOf(syscall.IPPROTO_LARP),
+		"IPPROTO_LEAF1":                     reflect.ValueOf(syscall.IPPROTO_LEAF1),
+		"IPPROTO_LEAF2":                     reflect.ValueOf(syscall.IPPROTO_LEAF2),
+		"IPPROTO_MAX":                       reflect.ValueOf(syscall.IPPROTO_MAX),
+		"IPPROTO_MAXID":                     reflect.ValueOf(syscall.IPPROTO_MAXID),
+		"IPPROTO_MEAS":                      reflect.ValueOf(syscall.IPPROTO_MEAS),
+		"IPPROTO_MH":                        reflect.ValueOf(syscall.IPPROTO_MH),
+		"IPPROTO_MHRP":                      reflect.ValueOf(syscall.IPPROTO_MHRP),
+		"IPPROTO_MICP":                      reflect.ValueOf(syscall.IPPROTO_MICP),
+		"IPPROTO_MOBILE":                    reflect.ValueOf(syscall.IPPROTO_MOBILE),
+		"IPPROTO_MPLS":                      reflect.ValueOf(syscall.IPPROTO_MPLS),
+		"IPPROTO_MTP":                       reflect.ValueOf(syscall.IPPROTO_MTP),
+		"IPPROTO_MUX":                       reflect.ValueOf(syscall.IPPROTO_MUX),
+		"IPPROTO_ND":                        reflect.ValueOf(syscall.IPPROTO_ND),
+		"IPPROTO_NHRP":                      reflect.ValueOf(syscall.IPPROTO_NHRP),
+		"IPPROTO_NONE":                      reflect.ValueOf(syscall.IPPROTO_NONE),
+		"IPPROTO_NSP":                       reflect.ValueOf(syscall.IPPROTO_NSP),
+		"IPPROTO_NVPII":                     reflect.ValueOf(syscall.IPPROTO_NVPII),
+		"IPPROTO_OLD_DIVERT":                reflect.ValueOf(syscall.IPPROTO_OLD_DIVERT),
+		"IPPROTO_OSPFIGP":                   reflect.ValueOf(syscall.IPPROTO_OSPFIGP),
+		"IPPROTO_PFSYNC":                    reflect.ValueOf(syscall.IPPROTO_PFSYNC),
+		"IPPROTO_PGM":                       reflect.ValueOf(syscall.IPPROTO_PGM),
+		"IPPROTO_PIGP":                      reflect.ValueOf(syscall.IPPROTO_PIGP),
+		"IPPROTO_PIM":                       reflect.ValueOf(syscall.IPPROTO_PIM),
+		"IPPROTO_PRM":                       reflect.ValueOf(syscall.IPPROTO_PRM),
+		"IPPROTO_PUP":                       reflect.ValueOf(syscall.IPPROTO_PUP),
+		"IPPROTO_PVP":                       reflect.ValueOf(syscall.IPPROTO_PVP),
+		"IPPROTO_RAW":                       reflect.ValueOf(syscall.IPPROTO_RAW),
+		"IPPROTO_RCCMON":                    reflect.ValueOf(syscall.IPPROTO_RCCMON),
+		"IPPROTO_RDP":                       reflect.ValueOf(syscall.IPPROTO_RDP),
+		"IPPROTO_ROUTING":                   reflect.ValueOf(syscall.IPPROTO_ROUTING),
+		"IPPROTO_RSVP":                      reflect.ValueOf(syscall.IPPROTO_RSVP),
+		"IPPROTO_RVD":                       reflect.ValueOf(syscall.IPPROTO_RVD),
+		"IPPROTO_SATEXPAK":                  reflect.ValueOf(syscall.IPPROTO_SATEXPAK),
+		"IPPROTO_SATMON":                    reflect.ValueOf(syscall.IPPROTO_SATMON),
+		"IPPROTO_SCCSP":                     reflect.ValueOf(syscall.IPPROTO_SCCSP),
+		"IPPROTO_SCTP":                      reflect.ValueOf(syscall.IPPROTO_SCTP),
+		"IPPROTO_SDRP":                      reflect.ValueOf(syscall.IPPROTO_SDRP),
+		"IPPROTO_SEND":                      reflect.ValueOf(syscall.IPPROTO_SEND),
+		"IPPROTO_SEP":                       reflect.ValueOf(syscall.IPPROTO_SEP),
+		"IPPROTO_SKIP":                      reflect.ValueOf(syscall.IPPROTO_SKIP),
+		"IPPROTO_SPACER":                    reflect.ValueOf(syscall.IPPROTO_SPACER),
+		"IPPROTO_SRPC":                      reflect.ValueOf(syscall.IPPROTO_SRPC),
+		"IPPROTO_ST":                        reflect.ValueOf(syscall.IPPROTO_ST),
+		"IPPROTO_SVMTP":                     reflect.ValueOf(syscall.IPPROTO_SVMTP),
+		"IPPROTO_SWIPE":                     reflect.ValueOf(syscall.IPPROTO_SWIPE),
+		"IPPROTO_TCF":                       reflect.ValueOf(syscall.IPPROTO_TCF),
+		"IPPROTO_TCP":                       reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_TLSP":                      reflect.ValueOf(syscall.IPPROTO_TLSP),
+		"IPPROTO_TP":                        reflect.ValueOf(syscall.IPPROTO_TP),
+		"IPPROTO_TPXX":                      reflect.ValueOf(syscall.IPPROTO_TPXX),
+		"IPPROTO_TRUNK1":                    reflect.ValueOf(syscall.IPPROTO_TRUNK1),
+		"IPPROTO_TRUNK2":                    reflect.ValueOf(syscall.IPPROTO_TRUNK2),
+		"IPPROTO_TTP":                       reflect.ValueOf(syscall.IPPROTO_TTP),
+		"IPPROTO_UDP":                       reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPPROTO_VINES":                     reflect.ValueOf(syscall.IPPROTO_VINES),
+		"IPPROTO_VISA":                      reflect.ValueOf(syscall.IPPROTO_VISA),
+		"IPPROTO_VMTP":                      reflect.ValueOf(syscall.IPPROTO_VMTP),
+		"IPPROTO_WBEXPAK":                   reflect.ValueOf(syscall.IPPROTO_WBEXPAK),
+		"IPPROTO_WBMON":                     reflect.ValueOf(syscall.IPPROTO_WBMON),
+		"IPPROTO_WSN":                       reflect.ValueOf(syscall.IPPROTO_WSN),
+		"IPPROTO_XNET":                      reflect.ValueOf(syscall.IPPROTO_XNET),
+		"IPPROTO_XTP":                       reflect.ValueOf(syscall.IPPROTO_XTP),
+		"IPV6_AUTOFLOWLABEL":                reflect.ValueOf(syscall.IPV6_AUTOFLOWLABEL),
+		"IPV6_BINDANY":                      reflect.ValueOf(syscall.IPV6_BINDANY),
+		"IPV6_BINDV6ONLY":                   reflect.ValueOf(syscall.IPV6_BINDV6ONLY),
+		"IPV6_CHECKSUM":                     reflect.ValueOf(syscall.IPV6_CHECKSUM),
+		"IPV6_DEFAULT_MULTICAST_HOPS":       reflect.ValueOf(syscall.IPV6_DEFAULT_MULTICAST_HOPS),
+		"IPV6_DEFAULT_MULTICAST_LOOP":       reflect.ValueOf(syscall.IPV6_DEFAULT_MULTICAST_LOOP),
+		"IPV6_DEFHLIM":                      reflect.ValueOf(syscall.IPV6_DEFHLIM),
+		"IPV6_DONTFRAG":                     reflect.ValueOf(syscall.IPV6_DONTFRAG),
+		"IPV6_DSTOPTS":                      reflect.ValueOf(syscall.IPV6_DSTOPTS),
+		"IPV6_FAITH":                        reflect.ValueOf(syscall.IPV6_FAITH),
+		"IPV6_FLOWINFO_MASK":                reflect.ValueOf(uint32(syscall.IPV6_FLOWINFO_MASK)),
+		"IPV6_FLOWLABEL_MASK":               reflect.ValueOf(uint32(syscall.IPV6_FLOWLABEL_MASK)),
+		"IPV6_FRAGTTL":                      reflect.ValueOf(syscall.IPV6_FRAGTTL),
+		"IPV6_FW_ADD":                       reflect.ValueOf(syscall.IPV6_FW_ADD),
+		"IPV6_FW_DEL":                       reflect.ValueOf(syscall.IPV6_FW_DEL),
+		"IPV6_FW_FLUSH":                     reflect.ValueOf(syscall.IPV6_FW_FLUSH),
+		"IPV6_FW_GET":                       reflect.ValueOf(syscall.IPV6_FW_GET),
+		"IPV6_FW_ZERO":                      reflect.ValueOf(syscall.IPV6_FW_ZERO),
+		"IPV6_HLIMDEC":                      reflect.ValueOf(syscall.IPV6_HLIMDEC),
+		"IPV6_HOPLIMIT":                     reflect.ValueOf(syscall.IPV6_HOPLIMIT),
+		"IPV6_HOPOPTS":                      reflect.ValueOf(syscall.IPV6_HOPOPTS),
+		"IPV6_IPSEC_POLICY":                 reflect.ValueOf(syscall.IPV6_IPSEC_POLICY),
+		"IPV6_JOIN_GROUP":                   reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_GROUP":                  reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MAXHLIM":                      reflect.ValueOf(syscall.IPV6_MAXHLIM),
+		"IPV6_MAXOPTHDR":                    reflect.ValueOf(syscall.IPV6_MAXOPTHDR),
+		"IPV6_MAXPACKET":                    reflect.ValueOf(syscall.IPV6_MAXPACKET),
+		"IPV6_MAX_GROUP_SRC_FILTER":         reflect.ValueOf(syscall.IPV6_MAX_GROUP_SRC_FILTER),
+		"IPV6_MAX_MEMBERSHIPS":              reflect.ValueOf(syscall.IPV6_MAX_MEMBERSHIPS),
+		"IPV6_MAX_SOCK_SRC_FILTER":          reflect.ValueOf(syscall.IPV6_MAX_SOCK_SRC_FILTER),
+		"IPV6_MIN_MEMBERSHIPS":              reflect.ValueOf(syscall.IPV6_MIN_MEMBERSHIPS),
+		"IPV6_MMTU":                         reflect.ValueOf(syscall.IPV6_MMTU),
+		"IPV6_MSFILTER":                     reflect.ValueOf(syscall.IPV6_MSFILTER),
+		"IPV6_MULTICAST_HOPS":               reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":                 reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":               reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_NEXTHOP":                      reflect.ValueOf(syscall.IPV6_NEXTHOP),
+		"IPV6_PATHMTU":                      reflect.ValueOf(syscall.IPV6_PATHMTU),
+		"IPV6_PKTINFO":                      reflect.ValueOf(syscall.IPV6_PKTINFO),
+		"IPV6_PORTRANGE":                    reflect.ValueOf(syscall.IPV6_PORTRANGE),
+		"IPV6_PORTRANGE_DEFAULT":            reflect.ValueOf(syscall.IPV6_PORTRANGE_DEFAULT),
+		"IPV6_PORTRANGE_HIGH":               reflect.ValueOf(syscall.IPV6_PORTRANGE_HIGH),
+		"IPV6_PORTRANGE_LOW":                reflect.ValueOf(syscall.IPV6_PORTRANGE_LOW),
+		"IPV6_PREFER_TEMPADDR":              reflect.ValueOf(syscall.IPV6_PREFER_TEMPADDR),
+		"IPV6_RECVDSTOPTS":                  reflect.ValueOf(syscall.IPV6_RECVDSTOPTS),
+		"IPV6_RECVHOPLIMIT":                 reflect.ValueOf(syscall.IPV6_RECVHOPLIMIT),
+		"IPV6_RECVHOPOPTS":                  reflect.ValueOf(syscall.IPV6_RECVHOPOPTS),
+		"IPV6_RECVPATHMTU":                  reflect.ValueOf(syscall.IPV6_RECVPATHMTU),
+		"IPV6_RECVPKTINFO":                  reflect.ValueOf(syscall.IPV6_RECVPKTINFO),
+		"IPV6_RECVRTHDR":                    reflect.ValueOf(syscall.IPV6_RECVRTHDR),
+		"IPV6_RECVTCLASS":                   reflect.ValueOf(syscall.IPV6_RECVTCLASS),
+		"IPV6_RTHDR":                        reflect.ValueOf(syscall.IPV6_RTHDR),
+		"IPV6_RTHDRDSTOPTS":                 reflect.ValueOf(syscall.IPV6_RTHDRDSTOPTS),
+		"IPV6_RTHDR_LOOSE":                  reflect.ValueOf(syscall.IPV6_RTHDR_LOOSE),
+		"IPV6_RTHDR_STRICT":                 reflect.ValueOf(syscall.IPV6_RTHDR_STRICT),
+		"IPV6_RTHDR_TYPE_0":                 reflect.ValueOf(syscall.IPV6_RTHDR_TYPE_0),
+		"IPV6_SOCKOPT_RESERVED1":            reflect.ValueOf(syscall.IPV6_SOCKOPT_RESERVED1),
+		"IPV6_TCLASS":                       reflect.ValueOf(syscall.IPV6_TCLASS),
+		"IPV6_UNICAST_HOPS":                 reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_USE_MIN_MTU":                  reflect.ValueOf(syscall.IPV6_USE_MIN_MTU),
+		"IPV6_V6ONLY":                       reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IPV6_VERSION":                      reflect.ValueOf(syscall.IPV6_VERSION),
+		"IPV6_VERSION_MASK":                 reflect.ValueOf(syscall.IPV6_VERSION_MASK),
+		"IP_ADD_MEMBERSHIP":                 reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_ADD_SOURCE_MEMBERSHIP":          reflect.ValueOf(syscall.IP_ADD_SOURCE_MEMBERSHIP),
+		"IP_BINDANY":                        reflect.ValueOf(syscall.IP_BINDANY),
+		"IP_BLOCK_SOURCE":                   reflect.ValueOf(syscall.IP_BLOCK_SOURCE),
+		"IP_DEFAULT_MULTICAST_LOOP":         reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_LOOP),
+		"IP_DEFAULT_MULTICAST_TTL":          reflect.ValueOf(syscall.IP_DEFAULT_MULTICAST_TTL),
+		"IP_DF":                             reflect.ValueOf(syscall.IP_DF),
+		"IP_DONTFRAG":                       reflect.ValueOf(syscall.IP_DONTFRAG),
+		"IP_DROP_MEMBERSHIP":                reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_DROP_SOURCE_MEMBERSHIP":         reflect.ValueOf(syscall.IP_DROP_SOURCE_MEMBERSHIP),
+		"IP_DUMMYNET3":                      reflect.ValueOf(syscall.IP_DUMMYNET3),
+		"IP_DUMMYNET_CONFIGURE":             reflect.ValueOf(syscall.IP_DUMMYNET_CONFIGURE),
+		"IP_DUMMYNET_DEL":                   reflect.ValueOf(syscall.IP_DUMMYNET_DEL),
+		"IP_DUMMYNET_FLUSH":                 reflect.ValueOf(syscall.IP_DUMMYNET_FLUSH),
+		"IP_DUMMYNET_GET":                   reflect.ValueOf(syscall.IP_DUMMYNET_GET),
+		"IP_FAITH":                          reflect.ValueOf(syscall.IP_FAITH),
+		"IP_FW3":                            reflect.ValueOf(syscall.IP_FW3),
+		"IP_FW_ADD":                         reflect.ValueOf(syscall.IP_FW_ADD),
+		"IP_FW_DEL":                         reflect.ValueOf(syscall.IP_FW_DEL),
+		"IP_FW_FLUSH":                       reflect.ValueOf(syscall.IP_FW_FLUSH),
+		"IP_FW_GET":                         reflect.ValueOf(syscall.IP_FW_GET),
+		"IP_FW_NAT_CFG":                     reflect.ValueOf(syscall.IP_FW_NAT_CFG),
+		"IP_FW_NAT_DEL":                     reflect.ValueOf(syscall.IP_FW_NAT_DEL),
+		"IP_FW_NAT_GET_CONFIG":              reflect.ValueOf(syscall.IP_FW_NAT_GET_CONFIG),
+		"IP_FW_NAT_GET_LOG":                 reflect.ValueOf(syscall.IP_FW_NAT_GET_LOG),
+		"IP_FW_RESETLOG":                    reflect.ValueOf(syscall.IP_FW_RESETLOG),
+		"IP_FW_TABLE_ADD":                   reflect.ValueOf(syscall.IP_FW_TABLE_ADD),
+		"IP_FW_TABLE_DEL":                   reflect.ValueOf(syscall.IP_FW_TABLE_DEL),
+		"IP_FW_TABLE_FLUSH":                 reflect.ValueOf(syscall.IP_FW_TABLE_FLUSH),
+		"IP_FW_TABLE_GETSIZE":               reflect.ValueOf(syscall.IP_FW_TABLE_GETSIZE),
+		"IP_FW_TABLE_LIST":                  reflect.ValueOf(syscall.IP_FW_TABLE_LIST),
+		"IP_FW_ZERO":                        reflect.ValueOf(syscall.IP_FW_ZERO),
+		"IP_HDRINCL":                        reflect.ValueOf(syscall.IP_HDRINCL),
+		"IP_IPSEC_POLICY":                   reflect.ValueOf(syscall.IP_IPSEC_POLICY),
+		"IP_MAXPACKET":                      reflect.ValueOf(syscall.IP_MAXPACKET),
+		"IP_MAX_GROUP_SRC_FILTER":           reflect.ValueOf(syscall.IP_MAX_GROUP_SRC_FILTER),
+		"IP_MAX_MEMBERSHIPS":                reflect.ValueOf(syscall.IP_MAX_MEMBERSHIPS),
+		"IP_MAX_SOCK_MUTE_FILTER":           reflect.ValueOf(syscall.IP_MAX_SOCK_MUTE_FILTER),
+		"IP_MAX_SOCK_SRC_FILTER":            reflect.ValueOf(syscall.IP_MAX_SOCK_SRC_FILTER),
+		"IP_MAX_SOURCE_FILTER":              reflect.ValueOf(syscall.IP_MAX_SOURCE_FILTER),
+		"IP_MF":                             reflect.ValueOf(syscall.IP_MF),
+		"IP_MINTTL":                         reflect.ValueOf(syscall.IP_MINTTL),
+		"IP_MIN_MEMBERSHIPS":                reflect.ValueOf(syscall.IP_MIN_MEMBERSHIPS),
+		"IP_MSFILTER":                       reflect.ValueOf(syscall.IP_MSFILTER),
+		"IP_MSS":                            reflect.ValueOf(syscall.IP_MSS),
+		"IP_MULTICAST_IF":                   reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_LOOP":                 reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":                  reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_MULTICAST_VIF":                  reflect.ValueOf(syscall.IP_MULTICAST_VIF),
+		"IP_OFFMASK":                        reflect.ValueOf(syscall.IP_OFFMASK),
+		"IP_ONESBCAST":                      reflect.ValueOf(syscall.IP_ONESBCAST),
+		"IP_OPTIONS":                        reflect.ValueOf(syscall.IP_OPTIONS),
+		"IP_PORTRANGE":                      reflect.ValueOf(syscall.IP_PORTRANGE),
+		"IP_PORTRANGE_DEFAULT":              reflect.ValueOf(syscall.IP_PORTRANGE_DEFAULT),
+		"IP_PORTRANGE_HIGH":                 reflect.ValueOf(syscall.IP_PORTRANGE_HIGH),
+		"IP_PORTRANGE_LOW":                  reflect.ValueOf(syscall.IP_PORTRANGE_LOW),
+		"IP_RECVDSTADDR":                    reflect.ValueOf(syscall.IP_RECVDSTADDR),
+		"IP_RECVIF":                         reflect.ValueOf(syscall.IP_RECVIF),
+		"IP_RECVOPTS":                       reflect.ValueOf(syscall.IP_RECVOPTS),
+		"IP_RECVRETOPTS":                    reflect.ValueOf(syscall.IP_RECVRETOPTS),
+		"IP_RECVTOS":                        reflect.ValueOf(syscall.IP_RECVTOS),
+		"IP_RECVTTL":                        reflect.ValueOf(syscall.IP_RECVTTL),
+		"IP_RETOPTS":                        reflect.ValueOf(syscall.IP_RETOPTS),
+		"IP_RF":                             reflect.ValueOf(syscall.IP_RF),
+		"IP_RSVP_OFF":                       reflect.ValueOf(syscall.IP_RSVP_OFF),
+		"IP_RSVP_ON":                        reflect.ValueOf(syscall.IP_RSVP_ON),
+		"IP_RSVP_VIF_OFF":                   reflect.ValueOf(syscall.IP_RSVP_VIF_OFF),
+		"IP_RSVP_VIF_ON":                    reflect.ValueOf(syscall.IP_RSVP_VIF_ON),
+		"IP_SENDSRCADDR":                    reflect.ValueOf(syscall.IP_SENDSRCADDR),
+		"IP_TOS":                            reflect.ValueOf(syscall.IP_TOS),
+		"IP_TTL":                            reflect.ValueOf(syscall.IP_TTL),
+		"IP_UNBLOCK_SOURCE":                 reflect.ValueOf(syscall.IP_UNBLOCK_SOURCE),
+		"ISIG":                              reflect.ValueOf(syscall.ISIG),
+		"ISTRIP":                            reflect.ValueOf(syscall.ISTRIP),
+		"IXANY":                             reflect.ValueOf(syscall.IXANY),
+		"IXOFF":                             reflect.ValueOf(syscall.IXOFF),
+		"IXON":                              reflect.ValueOf(syscall.IXON),
+		"ImplementsGetwd":                   reflect.ValueOf(syscall.ImplementsGetwd),
+		"Issetugid":                         reflect.ValueOf(syscall.Issetugid),
+		"Kevent":                            reflect.ValueOf(syscall.Kevent),
+		"Kill":                              reflect.ValueOf(syscall.Kill),
+		"Kqueue":                            reflect.ValueOf(syscall.Kqueue),
+		"LOCK_EX":                           reflect.ValueOf(syscall.LOCK_EX),
+		"LOCK_NB":                           reflect.ValueOf(syscall.LOCK_NB),
+		"LOCK_SH":                           reflect.ValueOf(syscall.LOCK_SH),
+		"LOCK_UN":                           reflect.ValueOf(syscall.LOCK_UN),
+		"Lchown":                            reflect.ValueOf(syscall.Lchown),
+		"Link":                              reflect.ValueOf(syscall.Link),
+		"Listen":                            reflect.ValueOf(syscall.Listen),
+		"Lstat":                             reflect.ValueOf(syscall.Lstat),
+		"MADV_AUTOSYNC":                     reflect.ValueOf(syscall.MADV_AUTOSYNC),
+		"MADV_CORE":                         reflect.ValueOf(syscall.MADV_CORE),
+		"MADV_DONTNEED":                     reflect.ValueOf(syscall.MADV_DONTNEED),
+		"MADV_FREE":                         reflect.ValueOf(syscall.MADV_FREE),
+		"MADV_NOCORE":                       reflect.ValueOf(syscall.MADV_NOCORE),
+		"MADV_NORMAL":                       reflect.ValueOf(syscall.MADV_NORMAL),
+		"MADV_NOSYNC":                       reflect.ValueOf(syscall.MADV_NOSYNC),
+		"MADV_PROTECT":                      reflect.ValueOf(syscall.MADV_PROTECT),
+		"MADV_RANDOM":                       reflect.ValueOf(syscall.MADV_RANDOM),
+		"MADV_SEQUENTIAL":                   reflect.ValueOf(syscall.MADV_SEQUENTIAL),
+		"MADV_WILLNEED":                     reflect.ValueOf(syscall.MADV_WILLNEED),
+		"MAP_ALIGNED_SUPER":                 reflect.ValueOf(syscall.MAP_ALIGNED_SUPER),
+		"MAP_ALIGNMENT_MASK":                reflect.ValueOf(syscall.MAP_ALIGNMENT_MASK),
+		"MAP_ALIGNMENT_SHIFT":               reflect.ValueOf(syscall.MAP_ALIGNMENT_SHIFT),
+		"MAP_ANON":                          reflect.ValueOf(syscall.MAP_ANON),
+		"MAP_ANONYMOUS":                     reflect.ValueOf(syscall.MAP_ANONYMOUS),
+		"MAP_COPY":                          reflect.ValueOf(syscall.MAP_COPY),
+		"MAP_FILE":                          reflect.ValueOf(syscall.MAP_FILE),
+		"MAP_FIXED":                         reflect.ValueOf(syscall.MAP_FIXED),
+		"MAP_HASSEMAPHORE":                  reflect.ValueOf(syscall.MAP_HASSEMAPHORE),
+		"MAP_NOCORE":                        reflect.ValueOf(syscall.MAP_NOCORE),
+		"MAP_NORESERVE":                     reflect.ValueOf(syscall.MAP_NORESERVE),
+		"MAP_NOSYNC":                        reflect.ValueOf(syscall.MAP_NOSYNC),
+		"MAP_PREFAULT_READ":                 reflect.ValueOf(syscall.MAP_PREFAULT_READ),
+		"MAP_PRIVATE":                       reflect.ValueOf(syscall.MAP_PRIVATE),
+		"MAP_RENAME":                        reflect.ValueOf(syscall.MAP_RENAME),
+		"MAP_RESERVED0080":                  reflect.ValueOf(syscall.MAP_RESERVED0080),
+		"MAP_RESERVED0100":                  reflect.ValueOf(syscall.MAP_RESERVED0100),
+		"MAP_SHARED":                        reflect.ValueOf(syscall.MAP_SHARED),
+		"MAP_STACK":                         reflect.ValueOf(syscall.MAP_STACK),
+		"MCL_CURRENT":                       reflect.ValueOf(syscall.MCL_CURRENT),
+		"MCL_FUTURE":                        reflect.ValueOf(syscall.MCL_FUTURE),
+		"MSG_CMSG_CLOEXEC":                  reflect.ValueOf(syscall.MSG_CMSG_CLOEXEC),
+		"MSG_COMPAT":                        reflect.ValueOf(syscall.MSG_COMPAT),
+		"MSG_CTRUNC":                        reflect.ValueOf(syscall.MSG_CTRUNC),
+		"MSG_DONTROUTE":                     reflect.ValueOf(syscall.MSG_DONTROUTE),
+		"MSG_DONTWAIT":                      reflect.ValueOf(syscall.MSG_DONTWAIT),
+		"MSG_EOF":                           reflect.ValueOf(syscall.MSG_EOF),
+		"MSG_EOR":                           reflect.ValueOf(syscall.MSG_EOR),
+		"MSG_NBIO":                          reflect.ValueOf(syscall.MSG_NBIO),
+		"MSG_NOSIGNAL":                      reflect.ValueOf(syscall.MSG_NOSIGNAL),
+		"MSG_NOTIFICATION":                  reflect.ValueOf(syscall.MSG_NOTIFICATION),
+		"MSG_OOB":                           reflect.ValueOf(syscall.MSG_OOB),
+		"MSG_PEEK":                          reflect.ValueOf(syscall.MSG_PEEK),
+		"MSG_TRUNC":                         reflect.ValueOf(syscall.MSG_TRUNC),
+		"MSG_WAITALL":                       reflect.ValueOf(syscall.MSG_WAITALL),
+		"MS_ASYNC":                          reflect.ValueOf(syscall.MS_ASYNC),
+		"MS_INVALIDATE":                     reflect.ValueOf(syscall.MS_INVALIDATE),
+		"MS_SYNC":                           reflect.ValueOf(syscall.MS_SYNC),
+		"Mkdir":                             reflect.ValueOf(syscall.Mkdir),
+		"Mkfifo":                            reflect.ValueOf(syscall.Mkfifo),
+		"Mknod":                             reflect.ValueOf(syscall.Mknod),
+		"Mmap":                              reflect.ValueOf(syscall.Mmap),
+		"Munmap":                            reflect.ValueOf(syscall.Munmap),
+		"NAME_MAX":                          reflect.ValueOf(syscall.NAME_MAX),
+		"NET_RT_DUMP":                       reflect.ValueOf(syscall.NET_RT_DUMP),
+		"NET_RT_FLAGS":                      reflect.ValueOf(syscall.NET_RT_FLAGS),
+		"NET_RT_IFLIST":                     reflect.ValueOf(syscall.NET_RT_IFLIST),
+		"NET_RT_IFLISTL":                    reflect.ValueOf(syscall.NET_RT_IFLISTL),
+		"NET_RT_IFMALIST":                   reflect.ValueOf(syscall.NET_RT_IFMALIST),
+		"NET_RT_MAXID":                      reflect.ValueOf(syscall.NET_RT_MAXID),
+		"NOFLSH":                            reflect.ValueOf(uint32(syscall.NOFLSH)),
+		"NOTE_ATTRIB":                       reflect.ValueOf(syscall.NOTE_ATTRIB),
+		"NOTE_CHILD":                        reflect.ValueOf(syscall.NOTE_CHILD),
+		"NOTE_DELETE":                       reflect.ValueOf(syscall.NOTE_DELETE),
+		"NOTE_EXEC":                         reflect.ValueOf(syscall.NOTE_EXEC),
+		"NOTE_EXIT":                         reflect.ValueOf(uint32(syscall.NOTE_EXIT)),
+		"NOTE_EXTEND":                       reflect.ValueOf(syscall.NOTE_EXTEND),
+		"NOTE_FFAND":                        reflect.ValueOf(syscall.NOTE_FFAND),
+		"NOTE_FFCOPY":                       reflect.ValueOf(uint32(syscall.NOTE_FFCOPY)),
+		"NOTE_FFCTRLMASK":                   reflect.ValueOf(uint32(syscall.NOTE_FFCTRLMASK)),
+		"NOTE_FFLAGSMASK":                   reflect.ValueOf(syscall.NOTE_FFLAGSMASK),
+		"NOTE_FFNOP":                        reflect.ValueOf(syscall.NOTE_FFNOP),
+		"NOTE_FFOR":                         reflect.ValueOf(uint32(syscall.NOTE_FFOR)),
+		"NOTE_FORK":                         reflect.ValueOf(syscall.NOTE_FORK),
+		"NOTE_LINK":                         reflect.ValueOf(syscall.NOTE_LINK),
+		"NOTE_LOWAT":                        reflect.ValueOf(syscall.NOTE_LOWAT),
+		"NOTE_PCTRLMASK":                    reflect.ValueOf(uint32(syscall.NOTE_PCTRLMASK)),
+		"NOTE_PDATAMASK":                    reflect.ValueOf(syscall.NOTE_PDATAMASK),
+		"NOTE_RENAME":                       reflect.ValueOf(syscall.NOTE_RENAME),
+		"NOTE_REVOKE":                       reflect.ValueOf(syscall.NOTE_REVOKE),
+		"NOTE_TRACK":                        reflect.ValueOf(syscall.NOTE_TRACK),
+		"NOTE_TRACKERR":                     reflect.ValueOf(syscall.NOTE_TRACKERR),
+		"NOTE_TRIGGER":                      reflect.ValueOf(syscall.NOTE_TRIGGER),
+		"NOTE_WRITE":                        reflect.ValueOf(syscall.NOTE_WRITE),
+		"Nanosleep":                         reflect.ValueOf(syscall.Nanosleep),
+		"NsecToTimespec":                    reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                     reflect.ValueOf(syscall.NsecToTimeval),
+		"OCRNL":                             reflect.ValueOf(syscall.OCRNL),
+		"ONLCR":                             reflect.ValueOf(syscall.ONLCR),
+		"ONLRET":                            reflect.ValueOf(syscall.ONLRET),
+		"ONOCR":                             reflect.ValueOf(syscall.ONOCR),
+		"ONOEOT":                            reflect.ValueOf(syscall.ONOEOT),
+		"OPOST":                             reflect.ValueOf(syscall.OPOST),
+		"O_ACCMODE":                         reflect.ValueOf(syscall.O_ACCMODE),
+		"O_APPEND":                          reflect.ValueOf(syscall.O_APPEND),
+		"O_ASYNC":                           reflect.ValueOf(syscall.O_ASYNC),
+		"O_CLOEXEC":                         reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                           reflect.ValueOf(syscall.O_CREAT),
+		"O_DIRECT":                          reflect.ValueOf(syscall.O_DIRECT),
+		"O_DIRECTORY":                       reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_EXCL":                            reflect.ValueOf(syscall.O_EXCL),
+		"O_EXEC":                            reflect.ValueOf(syscall.O_EXEC),
+		"O_EXLOCK":                          reflect.ValueOf(syscall.O_EXLOCK),
+		"O_FSYNC":                           reflect.ValueOf(syscall.O_FSYNC),
+		"O_NDELAY":                          reflect.ValueOf(syscall.O_NDELAY),
+		"O_NOCTTY":                          reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NOFOLLOW":                        reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_NONBLOCK":                        reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_RDONLY":                          reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                            reflect.ValueOf(syscall.O_RDWR),
+		"O_SHLOCK":                          reflect.ValueOf(syscall.O_SHLOCK),
+		"O_SYNC":                            reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                           reflect.ValueOf(syscall.O_TRUNC),
+		"O_TTY_INIT":                        reflect.ValueOf(syscall.O_TTY_INIT),
+		"O_WRONLY":                          reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                              reflect.ValueOf(syscall.Open),
+		"PARENB":                            reflect.ValueOf(syscall.PARENB),
+		"PARMRK":                            reflect.ValueOf(syscall.PARMRK),
+		"PARODD":                            reflect.ValueOf(syscall.PARODD),
+		"PENDIN":                            reflect.ValueOf(syscall.PENDIN),
+		"PRIO_PGRP":                         reflect.ValueOf(syscall.PRIO_PGRP),
+		"PRIO_PROCESS":                      reflect.ValueOf(syscall.PRIO_PROCESS),
+		"PRIO_USER":                         reflect.ValueOf(syscall.PRIO_USER),
+		"PROT_EXEC":                         reflect.ValueOf(syscall.PROT_EXEC),
+		"PROT_NONE":                         reflect.ValueOf(syscall.PROT_NONE),
+		"PROT_READ":                         reflect.ValueOf(syscall.PROT_READ),
+		"PROT_WRITE":                        reflect.ValueOf(syscall.PROT_WRITE),
+		"PTRACE_CONT":                       reflect.ValueOf(syscall.PTRACE_CONT),
+		"PTRACE_KILL":                       reflect.ValueOf(syscall.PTRACE_KILL),
+		"PTRACE_TRACEME":                    reflect.ValueOf(syscall.PTRACE_TRACEME),
+		"ParseDirent":                       reflect.ValueOf(syscall.ParseDirent),
+		"ParseRoutingMessage":               reflect.ValueOf(syscall.ParseRoutingMessage),
+		"ParseRoutingSockaddr":              reflect.ValueOf(syscall.ParseRoutingSockaddr),
+		"ParseSocketControlMessage":         reflect.ValueOf(syscall.ParseSocketControlMessage),
+		"ParseUnixRights":                   reflect.ValueOf(syscall.ParseUnixRights),
+		"Pathconf":                          reflect.ValueOf(syscall.Pathconf),
+		"Pipe":                              reflect.ValueOf(syscall.Pipe),
+		"Pipe2":                             reflect.ValueOf(syscall.Pipe2),
+		"Pread":                             reflect.ValueOf(syscall.Pread),
+		"Pwrite":                            reflect.ValueOf(syscall.Pwrite),
+		"RLIMIT_AS":                         reflect.ValueOf(syscall.RLIMIT_AS),
+		"RLIMIT_CORE":                       reflect.ValueOf(syscall.RLIMIT_CORE),
+		"RLIMIT_CPU":                        reflect.ValueOf(syscall.RLIMIT_CPU),
+		"RLIMIT_DATA":                       reflect.ValueOf(syscall.RLIMIT_DATA),
+		"RLIMIT_FSIZE":                      reflect.ValueOf(syscall.RLIMIT_FSIZE),
+		"RLIMIT_NOFILE":                     reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RLIMIT_STACK":                      reflect.ValueOf(syscall.RLIMIT_STACK),
+		"RLIM_INFINITY":                     reflect.ValueOf(int64(syscall.RLIM_INFINITY)),
+		"RTAX_AUTHOR":                       reflect.ValueOf(syscall.RTAX_AUTHOR),
+		"RTAX_BRD":                          reflect.ValueOf(syscall.RTAX_BRD),
+		"RTAX_DST":                          reflect.ValueOf(syscall.RTAX_DST),
+		"RTAX_GATEWAY":                      reflect.ValueOf(syscall.RTAX_GATEWAY),
+		"RTAX_GENMASK":                      reflect.ValueOf(syscall.RTAX_GENMASK),
+		"RTAX_IFA":                          reflect.ValueOf(syscall.RTAX_IFA),
+		"RTAX_IFP":                          reflect.ValueOf(syscall.RTAX_IFP),
+		"RTAX_MAX":                          reflect.ValueOf(syscall.RTAX_MAX),
+		"RTAX_NETMASK":                      reflect.ValueOf(syscall.RTAX_NETMASK),
+		"RTA_AUTHOR":                        reflect.ValueOf(syscall.RTA_AUTHOR),
+		"RTA_BRD":                           reflect.ValueOf(syscall.RTA_BRD),
+		"RTA_DST":                           reflect.ValueOf(syscall.RTA_DST),
+		"RTA_GATEWAY":                       reflect.ValueOf(syscall.RTA_GATEWAY),
+		"RTA_GENMASK":                       reflect.ValueOf(syscall.RTA_GENMASK),
+		"RTA_IFA":                           reflect.ValueOf(syscall.RTA_IFA),
+		"RTA_IFP":                           reflect.ValueOf(syscall.RTA_IFP),
+		"RTA_NETMASK":                       reflect.ValueOf(syscall.RTA_NETMASK),
+		"RTF_BLACKHOLE":                     reflect.ValueOf(syscall.RTF_BLACKHOLE),
+		"RTF_BROADCAST":                     reflect.ValueOf(syscall.RTF_BROADCAST),
+		"RTF_DONE":                          reflect.ValueOf(syscall.RTF_DONE),
+		"RTF_DYNAMIC":                       reflect.ValueOf(syscall.RTF_DYNAMIC),
+		"RTF_FMASK":                         reflect.ValueOf(syscall.RTF_FMASK),
+		"RTF_GATEWAY":                       reflect.ValueOf(syscall.RTF_GATEWAY),
+		"RTF_GWFLAG_COMPAT":                 reflect.ValueOf(uint32(syscall.RTF_GWFLAG_COMPAT)),
+		"RTF_HOST":                          reflect.ValueOf(syscall.RTF_HOST),
+		"RTF_LLDATA":                        reflect.ValueOf(syscall.RTF_LLDATA),
+		"RTF_LLINFO":                        reflect.ValueOf(syscall.RTF_LLINFO),
+		"RTF_LOCAL":                         reflect.ValueOf(syscall.RTF_LOCAL),
+		"RTF_MODIFIED":                      reflect.ValueOf(syscall.RTF_MODIFIED),
+		"RTF_MULTICAST":                     reflect.ValueOf(syscall.RTF_MULTICAST),
+		"RTF_PINNED":                        reflect.ValueOf(syscall.RTF_PINNED),
+		"RTF_PRCLONING":                     reflect.ValueOf(syscall.RTF_PRCLONING),
+		"RTF_PROTO1":                        reflect.ValueOf(syscall.RTF_PROTO1),
+		"RTF_PROTO2":                        reflect.ValueOf(syscall.RTF_PROTO2),
+		"RTF_PROTO3":                        reflect.ValueOf(syscall.RTF_PROTO3),
+		"RTF_REJECT":                        reflect.ValueOf(syscall.RTF_REJECT),
+		"RTF_RNH_LOCKED":                    reflect.ValueOf(syscall.RTF_RNH_LOCKED),
+		"RTF_STATIC":                        reflect.ValueOf(syscall.RTF_STATIC),
+		"RTF_STICKY":                        reflect.ValueOf(syscall.RTF_STICKY),
+		"RTF_UP":                            reflect.ValueOf(syscall.RTF_UP),
+		"RTF_XRESOLVE":                      reflect.ValueOf(syscall.RTF_XRESOLVE),
+		"RTM_ADD":                           reflect.ValueOf(syscall.RTM_ADD),
+		"RTM_CHANGE":                        reflect.ValueOf(syscall.RTM_CHANGE),
+		"RTM_DELADDR":                       reflect.ValueOf(syscall.RTM_DELADDR),
+		"RTM_DELETE":                        reflect.ValueOf(syscall.RTM_DELETE),
+		"RTM_DELMADDR":                      reflect.ValueOf(syscall.RTM_DELMADDR),
+		"RTM_GET":                           reflect.ValueOf(syscall.RTM_GET),
+		"RTM_IEEE80211":                     reflect.ValueOf(syscall.RTM_IEEE80211),
+		"RTM_IFANNOUNCE":                    reflect.ValueOf(syscall.RTM_IFANNOUNCE),
+		"RTM_IFINFO":                        reflect.ValueOf(syscall.RTM_IFINFO),
+		"RTM_LOCK":                          reflect.ValueOf(syscall.RTM_LOCK),
+		"RTM_LOSING":                        reflect.ValueOf(syscall.RTM_LOSING),
+		"RTM_MISS":                          reflect.ValueOf(syscall.RTM_MISS),
+		"RTM_NEWADDR":                       reflect.ValueOf(syscall.RTM_NEWADDR),
+		"RTM_NEWMADDR":                      reflect.ValueOf(syscall.RTM_NEWMADDR),
+		"RTM_OLDADD":                        reflect.ValueOf(syscall.RTM_OLDADD),
+		"RTM_OLDDEL":                        reflect.ValueOf(syscall.RTM_OLDDEL),
+		"RTM_REDIRECT":                      reflect.ValueOf(syscall.RTM_REDIRECT),
+		"RTM_RESOLVE":                       reflect.ValueOf(syscall.RTM_RESOLVE),
+		"RTM_RTTUNIT":                       reflect.ValueOf(syscall.RTM_RTTUNIT),
+		"RTM_VERSION":                       reflect.ValueOf(syscall.RTM_VERSION),
+		"RTV_EXPIRE":                        reflect.ValueOf(syscall.RTV_EXPIRE),
+		"RTV_HOPCOUNT":                      reflect.ValueOf(syscall.RTV_HOPCOUNT),
+		"RTV_MTU":                           reflect.ValueOf(syscall.RTV_MTU),
+		"RTV_RPIPE":                         reflect.ValueOf(syscall.RTV_RPIPE),
+		"RTV_RTT":                           reflect.ValueOf(syscall.RTV_RTT),
+		"RTV_RTTVAR":                        reflect.ValueOf(syscall.RTV_RTTVAR),
+		"RTV_SPIPE":                         reflect.ValueOf(syscall.RTV_SPIPE),
+		"RTV_SSTHRESH":                      reflect.ValueOf(syscall.RTV_SSTHRESH),
+		"RTV_WEIGHT":                        reflect.ValueOf(syscall.RTV_WEIGHT),
+		"RT_CACHING_CONTEXT":                reflect.ValueOf(syscall.RT_CACHING_CONTEXT),
+		"RT_DEFAULT_FIB":                    reflect.ValueOf(syscall.RT_DEFAULT_FIB),
+		"RT_NORTREF":                        reflect.ValueOf(syscall.RT_NORTREF),
+		"RUSAGE_CHILDREN":                   reflect.ValueOf(syscall.RUSAGE_CHILDREN),
+		"RUSAGE_SELF":                       reflect.ValueOf(syscall.RUSAGE_SELF),
+		"RUSAGE_THREAD":                     reflect.ValueOf(syscall.RUSAGE_THREAD),
+		"RawSyscall":                        reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                       reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                              reflect.ValueOf(syscall.Read),
+		"ReadDirent":                        reflect.ValueOf(syscall.ReadDirent),
+		"Readlink":                          reflect.ValueOf(syscall.Readlink),
+		"Recvfrom":                          reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                           reflect.ValueOf(syscall.Recvmsg),
+		"Rename":                            reflect.ValueOf(syscall.Rename),
+		"Revoke":                            reflect.ValueOf(syscall.Revoke),
+		"Rmdir":                             reflect.ValueOf(syscall.Rmdir),
+		"RouteRIB":                          reflect.ValueOf(syscall.RouteRIB),
+		"SCM_BINTIME":                       reflect.ValueOf(syscall.SCM_BINTIME),
+		"SCM_CREDS":                         reflect.ValueOf(syscall.SCM_CREDS),
+		"SCM_RIGHTS":                        reflect.ValueOf(syscall.SCM_RIGHTS),
+		"SCM_TIMESTAMP":                     reflect.ValueOf(syscall.SCM_TIMESTAMP),
+		"SHUT_RD":                           reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                         reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                           reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                           reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                           reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                            reflect.ValueOf(syscall.SIGBUS),
+		"SIGCHLD":                           reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCONT":                           reflect.ValueOf(syscall.SIGCONT),
+		"SIGEMT":                            reflect.ValueOf(syscall.SIGEMT),
+		"SIGFPE":                            reflect.ValueOf(syscall.SIGFPE),
+		"SIGHUP":                            reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                            reflect.ValueOf(syscall.SIGILL),
+		"SIGINFO":                           reflect.ValueOf(syscall.SIGINFO),
+		"SIGINT":                            reflect.ValueOf(syscall.SIGINT),
+		"SIGIO":                             reflect.ValueOf(syscall.SIGIO),
+		"SIGIOT":                            reflect.ValueOf(syscall.SIGIOT),
+		"SIGKILL":                           reflect.ValueOf(syscall.SIGKILL),
+		"SIGLIBRT":                          reflect.ValueOf(syscall.SIGLIBRT),
+		"SIGLWP":                            reflect.ValueOf(syscall.SIGLWP),
+		"SIGPIPE":                           reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPROF":                           reflect.ValueOf(syscall.SIGPROF),
+		"SIGQUIT":                           reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                           reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSTOP":                           reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                            reflect.ValueOf(syscall.SIGSYS),
+		"SIGTERM":                           reflect.ValueOf(syscall.SIGTERM),
+		"SIGTHR":                            reflect.ValueOf(syscall.SIGTHR),
+		"SIGTRAP":                           reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                           reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                           reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                           reflect.ValueOf(syscall.SIGTTOU),
+		"SIGURG":                            reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                           reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                           reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVTALRM":                         reflect.ValueOf(syscall.SIGVTALRM),
+		"SIGWINCH":                          reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                           reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                           reflect.ValueOf(syscall.SIGXFSZ),
+		"SIOCADDMULTI":                      reflect.ValueOf(uint32(syscall.SIOCADDMULTI)),
+		"SIOCADDRT":                         reflect.ValueOf(uint32(syscall.SIOCADDRT)),
+		"SIOCAIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCAIFADDR)),
+		"SIOCAIFGROUP":                      reflect.ValueOf(uint32(syscall.SIOCAIFGROUP)),
+		"SIOCALIFADDR":                      reflect.ValueOf(uint32(syscall.SIOCALIFADDR)),
+		"SIOCATMARK":                        reflect.ValueOf(syscall.SIOCATMARK),
+		"SIOCDELMULTI":                      reflect.ValueOf(uint32(syscall.SIOCDELMULTI)),
+		"SIOCDELRT":                         reflect.ValueOf(uint32(syscall.SIOCDELRT)),
+		"SIOCDIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCDIFADDR)),
+		"SIOCDIFGROUP":                      reflect.ValueOf(uint32(syscall.SIOCDIFGROUP)),
+		"SIOCDIFPHYADDR":                    reflect.ValueOf(uint32(syscall.SIOCDIFPHYADDR)),
+		"SIOCDLIFADDR":                      reflect.ValueOf(uint32(syscall.SIOCDLIFADDR)),
+		"SIOCGDRVSPEC":                      reflect.ValueOf(uint32(syscall.SIOCGDRVSPEC)),
+		"SIOCGETSGCNT":                      reflect.ValueOf(uint32(syscall.SIOCGETSGCNT)),
+		"SIOCGETVIFCNT":                     reflect.ValueOf(uint32(syscall.SIOCGETVIFCNT)),
+		"SIOCGHIWAT":                        reflect.ValueOf(syscall.SIOCGHIWAT),
+		"SIOCGIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCGIFADDR)),
+		"SIOCGIFBRDADDR":                    reflect.ValueOf(uint32(syscall.SIOCGIFBRDADDR)),
+		"SIOCGIFCAP":                        reflect.ValueOf(uint32(syscall.SIOCGIFCAP)),
+		"SIOCGIFCONF":                       reflect.ValueOf(uint32(syscall.SIOCGIFCONF)),
+		"SIOCGIFDESCR":                      reflect.ValueOf(uint32(syscall.SIOCGIFDESCR)),
+		"SIOCGIFDSTADDR":                    reflect.ValueOf(uint32(syscall.SIOCGIFDSTADDR)),
+		"SIOCGIFFIB":                        reflect.ValueOf(uint32(syscall.SIOCGIFFIB)),
+		"SIOCGIFFLAGS":                      reflect.ValueOf(uint32(syscall.SIOCGIFFLAGS)),
+		"SIOCGIFGENERIC":                    reflect.ValueOf(uint32(syscall.SIOCGIFGENERIC)),
+		"SIOCGIFGMEMB":                      reflect.ValueOf(uint32(syscall.SIOCGIFGMEMB)),
+		"SIOCGIFGROUP":                      reflect.ValueOf(uint32(syscall.SIOCGIFGROUP)),
+		"SIOCGIFINDEX":                      reflect.ValueOf(uint32(syscall.SIOCGIFINDEX)),
+		"SIOCGIFMAC":                        reflect.ValueOf(uint32(syscall.SIOCGIFMAC)),
+		"SIOCGIFMEDIA":                      reflect.ValueOf(uint32(syscall.SIOCGIFMEDIA)),
+		"SIOCGIFMETRIC":                     reflect.ValueOf(uint32(syscall.SIOCGIFMETRIC)),
+		"SIOCGIFMTU":                        reflect.ValueOf(uint32(syscall.SIOCGIFMTU)),
+		"SIOCGIFNETMASK":                    reflect.ValueOf(uint32(syscall.SIOCGIFNETMASK)),
+		"SIOCGIFPDSTADDR":                   reflect.ValueOf(uint32(syscall.SIOCGIFPDSTADDR)),
+		"SIOCGIFPHYS":                       reflect.ValueOf(uint32(syscall.SIOCGIFPHYS)),
+		"SIOCGIFPSRCADDR":                   reflect.ValueOf(uint32(syscall.SIOCGIFPSRCADDR)),
+		"SIOCGIFSTATUS":                     reflect.ValueOf(uint32(syscall.SIOCGIFSTATUS)),
+		"SIOCGLIFADDR":                      reflect.ValueOf(uint32(syscall.SIOCGLIFADDR)),
+		"SIOCGLIFPHYADDR":                   reflect.ValueOf(uint32(syscall.SIOCGLIFPHYADDR)),
+		"SIOCGLOWAT":                        reflect.ValueOf(syscall.SIOCGLOWAT),
+		"SIOCGPGRP":                         reflect.ValueOf(syscall.SIOCGPGRP),
+		"SIOCGPRIVATE_0":                    reflect.ValueOf(uint32(syscall.SIOCGPRIVATE_0)),
+		"SIOCGPRIVATE_1":                    reflect.ValueOf(uint32(syscall.SIOCGPRIVATE_1)),
+		"SIOCIFCREATE":                      reflect.ValueOf(uint32(syscall.SIOCIFCREATE)),
+		"SIOCIFCREATE2":                     reflect.ValueOf(uint32(syscall.SIOCIFCREATE2)),
+		"SIOCIFDESTROY":                     reflect.ValueOf(uint32(syscall.SIOCIFDESTROY)),
+		"SIOCIFGCLONERS":                    reflect.ValueOf(uint32(syscall.SIOCIFGCLONERS)),
+		"SIOCSDRVSPEC":                      reflect.ValueOf(uint32(syscall.SIOCSDRVSPEC)),
+		"SIOCSHIWAT":                        reflect.ValueOf(uint32(syscall.SIOCSHIWAT)),
+		"SIOCSIFADDR":                       reflect.ValueOf(uint32(syscall.SIOCSIFADDR)),
+		"SIOCSIFBRDADDR":                    reflect.ValueOf(uint32(syscall.SIOCSIFBRDADDR)),
+		"SIOCSIFCAP":                        reflect.ValueOf(uint32(syscall.SIOCSIFCAP)),
+		"SIOCSIFDESCR":                      reflect.ValueOf(uint32(syscall.SIOCSIFDESCR)),
+		"SIOCSIFDSTADDR":                    reflect.ValueOf(uint32(syscall.SIOCSIFDSTADDR)),
+		"SIOCSIFFIB":                        reflect.ValueOf(uint32(syscall.SIOCSIFFIB)),
+		"SIOCSIFFLAGS":                      reflect.ValueOf(uint32(syscall.SIOCSIFFLAGS)),
+		"SIOCSIFGENERIC":                    reflect.ValueOf(uint32(syscall.SIOCSIFGENERIC)),
+		"SIOCSIFLLADDR":                     reflect.ValueOf(uint32(syscall.SIOCSIFLLADDR)),
+		"SIOCSIFMAC":                        reflect.ValueOf(uint32(syscall.SIOCSIFMAC)),
+		"SIOCSIFMEDIA":                      reflect.ValueOf(uint32(syscall.SIOCSIFMEDIA)),
+		"SIOCSIFMETRIC":                     reflect.ValueOf(uint32(syscall.SIOCSIFMETRIC)),
+		"SIOCSIFMTU":                        reflect.ValueOf(uint32(syscall.SIOCSIFMTU)),
+		"SIOCSIFNAME":                       reflect.ValueOf(uint32(syscall.SIOCSIFNAME)),
+		"SIOCSIFNETMASK":                    reflect.ValueOf(uint32(syscall.SIOCSIFNETMASK)),
+		"SIOCSIFPHYADDR":                    reflect.ValueOf(uint32(syscall.SIOCSIFPHYADDR)),
+		"SIOCSIFPHYS":                       reflect.ValueOf(uint32(syscall.SIOCSIFPHYS)),
+		"SIOCSIFRVNET":                      reflect.ValueOf(uint32(syscall.SIOCSIFRVNET)),
+		"SIOCSIFVNET":                       reflect.ValueOf(uint32(syscall.SIOCSIFVNET)),
+		"SIOCSLIFPHYADDR":                   reflect.ValueOf(uint32(syscall.SIOCSLIFPHYADDR)),
+		"SIOCSLOWAT":                        reflect.ValueOf(uint32(syscall.SIOCSLOWAT)),
+		"SIOCSPGRP":                         reflect.ValueOf(uint32(syscall.SIOCSPGRP)),
+		"SOCK_CLOEXEC":                      reflect.ValueOf(syscall.SOCK_CLOEXEC),
+		"SOCK_DGRAM":                        reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_MAXADDRLEN":                   reflect.ValueOf(syscall.SOCK_MAXADDRLEN),
+		"SOCK_NONBLOCK":                     reflect.ValueOf(syscall.SOCK_NONBLOCK),
+		"SOCK_RAW":                          reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_RDM":                          reflect.ValueOf(syscall.SOCK_RDM),
+		"SOCK_SEQPACKET":                    reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                       reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOL_SOCKET":                        reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOMAXCONN":                         reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ACCEPTCONN":                     reflect.ValueOf(syscall.SO_ACCEPTCONN),
+		"SO_ACCEPTFILTER":                   reflect.ValueOf(syscall.SO_ACCEPTFILTER),
+		"SO_BINTIME":                        reflect.ValueOf(syscall.SO_BINTIME),
+		"SO_BROADCAST":                      reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_DEBUG":                          reflect.ValueOf(syscall.SO_DEBUG),
+		"SO_DONTROUTE":                      reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_ERROR":                          reflect.ValueOf(syscall.SO_ERROR),
+		"SO_KEEPALIVE":                      reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_LABEL":                          reflect.ValueOf(syscall.SO_LABEL),
+		"SO_LINGER":                         reflect.ValueOf(syscall.SO_LINGER),
+		"SO_LISTENINCQLEN":                  reflect.ValueOf(syscall.SO_LISTENINCQLEN),
+		"SO_LISTENQLEN":                     reflect.ValueOf(syscall.SO_LISTENQLEN),
+		"SO_LISTENQLIMIT":                   reflect.ValueOf(syscall.SO_LISTENQLIMIT),
+		"SO_NOSIGPIPE":                      reflect.ValueOf(syscall.SO_NOSIGPIPE),
+		"SO_NO_DDP":                         reflect.ValueOf(syscall.SO_NO_DDP),
+		"SO_NO_OFFLOAD":                     reflect.ValueOf(syscall.SO_NO_OFFLOAD),
+		"SO_OOBINLINE":                      reflect.ValueOf(syscall.SO_OOBINLINE),
+		"SO_PEERLABEL":                      reflect.ValueOf(syscall.SO_PEERLABEL),
+		"SO_PROTOCOL":                       reflect.ValueOf(syscall.SO_PROTOCOL),
+		"SO_PROTOTYPE":                      reflect.ValueOf(syscall.SO_PROTOTYPE),
+		"SO_RCVBUF":                         reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_RCVLOWAT":                       reflect.ValueOf(syscall.SO_RCVLOWAT),
+		"SO_RCVTIMEO":                       reflect.ValueOf(syscall.SO_RCVTIMEO),
+		"SO_REUSEADDR":                      reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_REUSEPORT":                      reflect.ValueOf(syscall.SO_REUSEPORT),
+		"SO_SETFIB":                         reflect.ValueOf(syscall.SO_SETFIB),
+		"SO_SNDBUF":                         reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_SNDLOWAT":                       reflect.ValueOf(syscall.SO_SNDLOWAT),
+		"SO_SNDTIMEO":                       reflect.ValueOf(syscall.SO_SNDTIMEO),
+		"SO_TIMESTAMP":                      reflect.ValueOf(syscall.SO_TIMESTAMP),
+		"SO_TYPE":                           reflect.ValueOf(syscall.SO_TYPE),
+		"SO_USELOOPBACK":                    reflect.ValueOf(syscall.SO_USELOOPBACK),
+		"SO_USER_COOKIE":                    reflect.ValueOf(syscall.SO_USER_COOKIE),
+		"SO_VENDOR":                         reflect.ValueOf(uint32(syscall.SO_VENDOR)),
+		"SYS_ABORT2":                        reflect.ValueOf(syscall.SYS_ABORT2),
+		"SYS_ACCEPT":                        reflect.ValueOf(syscall.SYS_ACCEPT),
+		"SYS_ACCEPT4":                       reflect.ValueOf(syscall.SYS_ACCEPT4),
+		"SYS_ACCESS":                        reflect.ValueOf(syscall.SYS_ACCESS),
+		"SYS_ACCT":                          reflect.ValueOf(syscall.SYS_ACCT),
+		"SYS_ADJTIME":                       reflect.ValueOf(syscall.SYS_ADJTIME),
+		"SYS_AUDIT":                         reflect.ValueOf(syscall.SYS_AUDIT),
+		"SYS_AUDITCTL":                      reflect.ValueOf(syscall.SYS_AUDITCTL),
+		"SYS_AUDITON":                       reflect.ValueOf(syscall.SYS_AUDITON),
+		"SYS_BIND":                          reflect.ValueOf(syscall.SYS_BIND),
+		"SYS_BINDAT":                        reflect.ValueOf(syscall.SYS_BINDAT),
+		"SYS_CAP_ENTER":                     reflect.ValueOf(syscall.SYS_CAP_ENTER),
+		"SYS_CAP_GETMODE":                   reflect.ValueOf(syscall.SYS_CAP_GETMODE),
+		"SYS_CAP_GETRIGHTS":                 reflect.ValueOf(syscall.SYS_CAP_GETRIGHTS),
+		"SYS_CAP_NEW":                       reflect.ValueOf(syscall.SYS_CAP_NEW),
+		"SYS_CHDIR":                         reflect.ValueOf(syscall.SYS_CHDIR),
+		"SYS_CHFLAGS":                       reflect.ValueOf(syscall.SYS_CHFLAGS),
+		"SYS_CHFLAGSAT":                     reflect.ValueOf(syscall.SYS_CHFLAGSAT),
+		"SYS_CHMOD":                         reflect.ValueOf(syscall.SYS_CHMOD),
+		"SYS_CHOWN":                         reflect.ValueOf(syscall.SYS_CHOWN),
+		"SYS_CHROOT":                        reflect.ValueOf(syscall.SYS_CHROOT),
+		"SYS_CLOCK_GETCPUCLOCKID2":          reflect.ValueOf(syscall.SYS_CLOCK_GETCPUCLOCKID2),
+		"SYS_CLOCK_GETRES":                  reflect.ValueOf(syscall.SYS_CLOCK_GETRES),
+		"SYS_CLOCK_GETTIME":                 reflect.ValueOf(syscall.SYS_CLOCK_GETTIME),
+		"SYS_CLOCK_SETTIME":                 reflect.ValueOf(syscall.SYS_CLOCK_SETTIME),
+		"SYS_CLOSE":                         reflect.ValueOf(syscall.SYS_CLOSE),
+		"SYS_CLOSEFROM":                     reflect.ValueOf(syscall.SYS_CLOSEFROM),
+		"SYS_CONNECT":                       reflect.ValueOf(syscall.SYS_CONNECT),
+		"SYS_CONNECTAT":                     reflect.ValueOf(syscall.SYS_CONNECTAT),
+		"SYS_CPUSET":                        reflect.ValueOf(syscall.SYS_CPUSET),
+		"SYS_CPUSET_GETAFFINITY":            reflect.ValueOf(syscall.SYS_CPUSET_GETAFFINITY),
+		"SYS_CPUSET_GETID":                  reflect.ValueOf(syscall.SYS_CPUSET_GETID),
+		"SYS_CPUSET_SETAFFINITY":            reflect.ValueOf(syscall.SYS_CPUSET_SETAFFINITY),
+		"SYS_CPUSET_SETID":                  reflect.ValueOf(syscall.SYS_CPUSET_SETID),
+		"SYS_DUP":                           reflect.ValueOf(syscall.SYS_DUP),
+		"SYS_DUP2":                          reflect.ValueOf(syscall.SYS_DUP2),
+		"SYS_EACCESS":                       reflect.ValueOf(syscall.SYS_EACCESS),
+		"SYS_EXECVE":                        reflect.ValueOf(syscall.SYS_EXECVE),
+		"SYS_EXIT":                          reflect.ValueOf(syscall.SYS_EXIT),
+		"SYS_EXTATTRCTL":                    reflect.ValueOf(syscall.SYS_EXTATTRCTL),
+		"SYS_EXTATTR_DELETE_FD":             reflect.ValueOf(syscall.SYS_EXTATTR_DELETE_FD),
+		"SYS_EXTATTR_DELETE_FILE":           reflect.ValueOf(syscall.SYS_EXTATTR_DELETE_FILE),
+		"SYS_EXTATTR_DELETE_LINK":           reflect.ValueOf(syscall.SYS_EXTATTR_DELETE_LINK),
+		"SYS_EXTATTR_GET_FD":                reflect.ValueOf(syscall.SYS_EXTATTR_GET_FD),
+		"SYS_EXTATTR_GET_FILE":              reflect.ValueOf(syscall.SYS_EXTATTR_GET_FILE),
+		"SYS_EXTATTR_GET_LINK":              reflect.ValueOf(syscall.SYS_EXTATTR_GET_LINK),
+		"SYS_EXTATTR_LIST_FD":               reflect.ValueOf(syscall.SYS_EXTATTR_LIST_FD),
+		"SYS_EXTATTR_LIST_FILE":             reflect.ValueOf(syscall.SYS_EXTATTR_LIST_FILE),
+		"SYS_EXTATTR_LIST_LINK":             reflect.ValueOf(syscall.SYS_EXTATTR_LIST_LINK),
+		"SYS_EXTATTR_SET_FD":                reflect.ValueOf(syscall.SYS_EXTATTR_SET_FD),
+		"SYS_EXTATTR_SET_FILE":              reflect.ValueOf(syscall.SYS_EXTATTR_SET_FILE),
+		"SYS_EXTATTR_SET_LINK":              reflect.ValueOf(syscall.SYS_EXTATTR_SET_LINK),
+		"SYS_FACCESSAT":                     reflect.ValueOf(syscall.SYS_FACCESSAT),
+		"SYS_FCHDIR":                        reflect.ValueOf(syscall.SYS_FCHDIR),
+		"SYS_FCHFLAGS":                      reflect.ValueOf(syscall.SYS_FCHFLAGS),
+		"SYS_FCHMOD":                        reflect.ValueOf(syscall.SYS_FCHMOD),
+		"SYS_FCHMODAT":                      reflect.ValueOf(syscall.SYS_FCHMODAT),
+		"SYS_FCHOWN":                        reflect.ValueOf(syscall.SYS_FCHOWN),
+		"SYS_FCHOWNAT":                      reflect.ValueOf(syscall.SYS_FCHOWNAT),
+		"SYS_FCNTL":                         reflect.ValueOf(syscall.SYS_FCNTL),
+		"SYS_FEXECVE":                       reflect.ValueOf(syscall.SYS_FEXECVE),
+		"SYS_FFCLOCK_GETCOUNTER":            reflect.ValueOf(syscall.SYS_FFCLOCK_GETCOUNTER),
+		"SYS_FFCLOCK_GETESTIMATE":           reflect.ValueOf(syscall.SYS_FFCLOCK_GETESTIMATE),
+		"SYS_FFCLOCK_SETESTIMATE":           reflect.ValueOf(syscall.SYS_FFCLOCK_SETESTIMATE),
+		"SYS_FHOPEN":                        reflect.ValueOf(syscall.SYS_FHOPEN),
+		"SYS_FHSTAT":                        reflect.ValueOf(syscall.SYS_FHSTAT),
+		"SYS_FHSTATFS":                      reflect.ValueOf(syscall.SYS_FHSTATFS),
+		"SYS_FLOCK":                         reflect.ValueOf(syscall.SYS_FLOCK),
+		"SYS_FORK":                          reflect.ValueOf(syscall.SYS_FORK),
+		"SYS_FPATHCONF":                     reflect.ValueOf(syscall.SYS_FPATHCONF),
+		"SYS_FREEBSD6_FTRUNCATE":            reflect.ValueOf(syscall.SYS_FREEBSD6_FTRUNCATE),
+		"SYS_FREEBSD6_LSEEK":                reflect.ValueOf(syscall.SYS_FREEBSD6_LSEEK),
+		"SYS_FREEBSD6_MMAP":                 reflect.ValueOf(syscall.SYS_FREEBSD6_MMAP),
+		"SYS_FREEBSD6_PREAD":                reflect.ValueOf(syscall.SYS_FREEBSD6_PREAD),
+		"SYS_FREEBSD6_PWRITE":               reflect.ValueOf(syscall.SYS_FREEBSD6_PWRITE),
+		"SYS_FREEBSD6_TRUNCATE":             reflect.ValueOf(syscall.SYS_FREEBSD6_TRUNCATE),
+		"SYS_FSTAT":                         reflect.ValueOf(syscall.SYS_FSTAT),
+		"SYS_FSTATAT":                       reflect.ValueOf(syscall.SYS_FSTATAT),
+		"SYS_FSTATFS":                       reflect.ValueOf(syscall.SYS_FSTATFS),
+		"SYS_FSYNC":                         reflect.ValueOf(syscall.SYS_FSYNC),
+		"SYS_FTRUNCATE":                     reflect.ValueOf(syscall.SYS_FTRUNCATE),
+		"SYS_FUTIMES":                       reflect.ValueOf(syscall.SYS_FUTIMES),
+		"SYS_FUTIMESAT":                     reflect.ValueOf(syscall.SYS_FUTIMESAT),
+		"SYS_GETAUDIT":                      reflect.ValueOf(syscall.SYS_GETAUDIT),
+		"SYS_GETAUDIT_ADDR":                 reflect.ValueOf(syscall.SYS_GETAUDIT_ADDR),
+		"SYS_GETAUID":                       reflect.ValueOf(syscall.SYS_GETAUID),
+		"SYS_GETCONTEXT":                    reflect.ValueOf(syscall.SYS_GETCONTEXT),
+		"SYS_GETDENTS":                      reflect.ValueOf(syscall.SYS_GETDENTS),
+		"SYS_GETDIRENTRIES":                 reflect.ValueOf(syscall.SYS_GETDIRENTRIES),
+		"SYS_GETDTABLESIZE":                 reflect.ValueOf(syscall.SYS_GETDTABLESIZE),
+		"SYS_GETEGID":                       reflect.ValueOf(syscall.SYS_GETEGID),
+		"SYS_GETEUID":                       reflect.ValueOf(syscall.SYS_GETEUID),
+		"SYS_GETFH":                         reflect.ValueOf(syscall.SYS_GETFH),
+		"SYS_GETFSSTAT":                     reflect.ValueOf(syscall.SYS_GETFSSTAT),
+		"SYS_GETGID":                        reflect.ValueOf(syscall.SYS_GETGID),
+		"SYS_GETGROUPS":                     reflect.ValueOf(syscall.SYS_GETGROUPS),
+		"SYS_GETITIMER":                     reflect.ValueOf(syscall.SYS_GETITIMER),
+		"SYS_GETLOGIN":                      reflect.ValueOf(syscall.SYS_GETLOGIN),
+		"SYS_GETLOGINCLASS":                 reflect.ValueOf(syscall.SYS_GETLOGINCLASS),
+		"SYS_GETPEERNAME":                   reflect.ValueOf(syscall.SYS_GETPEERNAME),
+		"SYS_GETPGID":                       reflect.ValueOf(syscall.SYS_GETPGID),
+		"SYS_GETPGRP":                       reflect.ValueOf(syscall.SYS_GETPGRP),
+		"SYS_GETPID":                        reflect.ValueOf(syscall.SYS_GETPID),
+		"SYS_GETPPID":                       reflect.ValueOf(syscall.SYS_GETPPID),
+		"SYS_GETPRIORITY":                   reflect.ValueOf(syscall.SYS_GETPRIORITY),
+		"SYS_GETRESGID":                     reflect.ValueOf(syscall.SYS_GETRESGID),
+		"SYS_GETRESUID":                     reflect.ValueOf(syscall.SYS_GETRESUID),
+		"SYS_GETRLIMIT":                     reflect.ValueOf(syscall.SYS_GETRLIMIT),
+		"SYS_GETRUSAGE":                     reflect.ValueOf(syscall.SYS_GETRUSAGE),
+		"SYS_GETSID":                        reflect.ValueOf(syscall.SYS_GETSID),
+		"SYS_GETSOCKNAME":                   reflect.ValueOf(syscall.SYS_GETSOCKNAME),
+		"SYS_GETSOCKOPT":                    reflect.ValueOf(syscall.SYS_GETSOCKOPT),
+		"SYS_GETTIMEOFDAY":                  reflect.ValueOf(syscall.SYS_GETTIMEOFDAY),
+		"SYS_GETUID":                        reflect.ValueOf(syscall.SYS_GETUID),
+		"SYS_IOCTL":                         reflect.ValueOf(syscall.SYS_IOCTL),
+		"SYS_ISSETUGID":                     reflect.ValueOf(syscall.SYS_ISSETUGID),
+		"SYS_JAIL":                          reflect.ValueOf(syscall.SYS_JAIL),
+		"SYS_JAIL_ATTACH":                   reflect.ValueOf(syscall.SYS_JAIL_ATTACH),
+		"SYS_JAIL_GET":                      reflect.ValueOf(syscall.SYS_JAIL_GET),
+		"SYS_JAIL_REMOVE":                   reflect.ValueOf(syscall.SYS_JAIL_REMOVE),
+		"SYS_JAIL_SET":                      reflect.ValueOf(syscall.SYS_JAIL_SET),
+		"SYS_KENV":                          reflect.ValueOf(syscall.SYS_KENV),
+		"SYS_KEVENT":                        reflect.ValueOf(syscall.SYS_KEVENT),
+		"SYS_KILL":                          reflect.ValueOf(syscall.SYS_KILL),
+		"SYS_KLDFIND":                       reflect.ValueOf(syscall.SYS_KLDFIND),
+		"SYS_KLDFIRSTMOD":                   reflect.ValueOf(syscall.SYS_KLDFIRSTMOD),
+		"SYS_KLDLOAD":                       reflect.ValueOf(syscall.SYS_KLDLOAD),
+		"SYS_KLDNEXT":                       reflect.ValueOf(syscall.SYS_KLDNEXT),
+		"SYS_KLDSTAT":                       reflect.ValueOf(syscall.SYS_KLDSTAT),
+		"SYS_KLDSYM":                        reflect.ValueOf(syscall.SYS_KLDSYM),
+		"SYS_KLDUNLOAD":                     reflect.ValueOf(syscall.SYS_KLDUNLOAD),
+		"SYS_KLDUNLOADF":                    reflect.ValueOf(syscall.SYS_KLDUNLOADF),
+		"SYS_KQUEUE":                        reflect.ValueOf(syscall.SYS_KQUEUE),
+		"SYS_KTIMER_CREATE":                 reflect.ValueOf(syscall.SYS_KTIMER_CREATE),
+		"SYS_KTIMER_DELETE":                 reflect.ValueOf(syscall.SYS_KTIMER_DELETE),
+		"SYS_KTIMER_GETOVERRUN":             reflect.ValueOf(syscall.SYS_KTIMER_GETOVERRUN),
+		"SYS_KTIMER_GETTIME":                reflect.ValueOf(syscall.SYS_KTIMER_GETTIME),
+		"SYS_KTIMER_SETTIME":                reflect.ValueOf(syscall.SYS_KTIMER_SETTIME),
+		"SYS_KTRACE":                        reflect.ValueOf(syscall.SYS_KTRACE),
+		"SYS_LCHFLAGS":                      reflect.ValueOf(syscall.SYS_LCHFLAGS),
+		"SYS_LCHMOD":                        reflect.ValueOf(syscall.SYS_LCHMOD),
+		"SYS_LCHOWN":                        reflect.ValueOf(syscall.SYS_LCHOWN),
+		"SYS_LGETFH":                        reflect.ValueOf(syscall.SYS_LGETFH),
+		"SYS_LINK":                          reflect.ValueOf(syscall.SYS_LINK),
+		"SYS_LINKAT":                        reflect.ValueOf(syscall.SYS_LINKAT),
+		"SYS_LISTEN":                        reflect.ValueOf(syscall.SYS_LISTEN),
+		"SYS_LPATHCONF":                     reflect.ValueOf(syscall.SYS_LPATHCONF),
+		"SYS_LSEEK":                         reflect.ValueOf(syscall.SYS_LSEEK),
+		"SYS_LUTIMES":                       reflect.ValueOf(syscall.SYS_LUTIMES),
+		"SYS_MAC_SYSCALL":                   reflect.ValueOf(syscall.SYS_MAC_SYSCALL),
+		"SYS_MADVISE":                       reflect.ValueOf(syscall.SYS_MADVISE),
+		"SYS_MINCORE":                       reflect.ValueOf(syscall.SYS_MINCORE),
+		"SYS_MINHERIT":                      reflect.ValueOf(syscall.SYS_MINHERIT),
+		"SYS_MKDIR":                         reflect.ValueOf(syscall.SYS_MKDIR),
+		"SYS_MKDIRAT":                       reflect.ValueOf(syscall.SYS_MKDIRAT),
+		"SYS_MKFIFO":                        reflect.ValueOf(syscall.SYS_MKFIFO),
+		"SYS_MKFIFOAT":                      reflect.ValueOf(syscall.SYS_MKFIFOAT),
+		"SYS_MKNOD":                         reflect.ValueOf(syscall.SYS_MKNOD),
+		"SYS_MKNODAT":                       reflect.ValueOf(syscall.SYS_MKNODAT),
+		"SYS_MLOCK":                         reflect.ValueOf(syscall.SYS_MLOCK),
+		"SYS_MLOCKALL":                      reflect.ValueOf(syscall.SYS_MLOCKALL),
+		"SYS_MMAP":                          reflect.ValueOf(syscall.SYS_MMAP),
+		"SYS_MODFIND":                       reflect.ValueOf(syscall.SYS_MODFIND),
+		"SYS_MODFNEXT":                      reflect.ValueOf(syscall.SYS_MODFNEXT),
+		"SYS_MODNEXT":                       reflect.ValueOf(syscall.SYS_MODNEXT),
+		"SYS_MODSTAT":                       reflect.ValueOf(syscall.SYS_MODSTAT),
+		"SYS_MOUNT":                         reflect.ValueOf(syscall.SYS_MOUNT),
+		"SYS_MPROTECT":                      reflect.ValueOf(syscall.SYS_MPROTECT),
+		"SYS_MSYNC":                         reflect.ValueOf(syscall.SYS_MSYNC),
+		"SYS_MUNLOCK":                       reflect.ValueOf(syscall.SYS_MUNLOCK),
+		"SYS_MUNLOCKALL":                    reflect.ValueOf(syscall.SYS_MUNLOCKALL),
+		"SYS_MUNMAP":                        reflect.ValueOf(syscall.SYS_MUNMAP),
+		"SYS_NANOSLEEP":                     reflect.ValueOf(syscall.SYS_NANOSLEEP),
+		"SYS_NFSTAT":                        reflect.ValueOf(syscall.SYS_NFSTAT),
+		"SYS_NLSTAT":                        reflect.ValueOf(syscall.SYS_NLSTAT),
+		"SYS_NMOUNT":                        reflect.ValueOf(syscall.SYS_NMOUNT),
+		"SYS_NSTAT":                         reflect.ValueOf(syscall.SYS_NSTAT),
+		"SYS_NTP_ADJTIME":                   reflect.ValueOf(syscall.SYS_NTP_ADJTIME),
+		"SYS_NTP_GETTIME":                   reflect.ValueOf(syscall.SYS_NTP_GETTIME),
+		"SYS_OBREAK":                        reflect.ValueOf(syscall.SYS_OBREAK),
+		"SYS_OPEN":                          reflect.ValueOf(syscall.SYS_OPEN),
+		"SYS_OPENAT":                        reflect.ValueOf(syscall.SYS_OPENAT),
+		"SYS_OPENBSD_POLL":                  reflect.ValueOf(syscall.SYS_OPENBSD_POLL),
+		"SYS_OVADVISE":                      reflect.ValueOf(syscall.SYS_OVADVISE),
+		"SYS_PATHCONF":                      reflect.ValueOf(syscall.SYS_PATHCONF),
+		"SYS_PDFORK":                        reflect.ValueOf(syscall.SYS_PDFORK),
+		"SYS_PDGETPID":                      reflect.ValueOf(syscall.SYS_PDGETPID),
+		"SYS_PDKILL":                        reflect.ValueOf(syscall.SYS_PDKILL),
+		"SYS_PIPE":                          reflect.ValueOf(syscall.SYS_PIPE),
+		"SYS_PIPE2":                         reflect.ValueOf(syscall.SYS_PIPE2),
+		"SYS_POLL":                          reflect.ValueOf(syscall.SYS_POLL),
+		"SYS_POSIX_FADVISE":                 reflect.ValueOf(syscall.SYS_POSIX_FADVISE),
+		"SYS_POSIX_FALLOCATE":               reflect.ValueOf(syscall.SYS_POSIX_FALLOCATE),
+		"SYS_POSIX_OPENPT":                  reflect.ValueOf(syscall.SYS_POSIX_OPENPT),
+		"SYS_PREAD":                         reflect.ValueOf(syscall.SYS_PREAD),
+		"SYS_PREADV":                        reflect.ValueOf(syscall.SYS_PREADV),
+		"SYS_PROCCTL":                       reflect.ValueOf(syscall.SYS_PROCCTL),
+		"SYS_PROFIL":                        reflect.ValueOf(syscall.SYS_PROFIL),
+		"SYS_PSELECT":                       reflect.ValueOf(syscall.SYS_PSELECT),
+		"SYS_PTRACE":                        reflect.ValueOf(syscall.SYS_PTRACE),
+		"SYS_PWRITE":                        reflect.ValueOf(syscall.SYS_PWRITE),
+		"SYS_PWRITEV":                       reflect.ValueOf(syscall.SYS_PWRITEV),
+		"SYS_QUOTACTL":                      reflect.ValueOf(syscall.SYS_QUOTACTL),
+		"SYS_RCTL_ADD_RULE":                 reflect.ValueOf(syscall.SYS_RCTL_ADD_RULE),
+		"SYS_RCTL_GET_LIMITS":               reflect.ValueOf(syscall.SYS_RCTL_GET_LIMITS),
+		"SYS_RCTL_GET_RACCT":                reflect.ValueOf(syscall.SYS_RCTL_GET_RACCT),
+		"SYS_RCTL_GET_RULES":                reflect.ValueOf(syscall.SYS_RCTL_GET_RULES),
+		"SYS_RCTL_REMOVE_RULE":              reflect.ValueOf(syscall.SYS_RCTL_REMOVE_RULE),
+		"SYS_READ":                          reflect.ValueOf(syscall.SYS_READ),
+		"SYS_READLINK":                      reflect.ValueOf(syscall.SYS_READLINK),
+		"SYS_READLINKAT":                    reflect.ValueOf(syscall.SYS_READLINKAT),
+		"SYS_READV":                         reflect.ValueOf(syscall.SYS_READV),
+		"SYS_REBOOT":                        reflect.ValueOf(syscall.SYS_REBOOT),
+		"SYS_RECVFROM":                      reflect.ValueOf(syscall.SYS_RECVFROM),
+		"SYS_RECVMSG":                       reflect.ValueOf(syscall.SYS_RECVMSG),
+		"SYS_RENAME":                        reflect.ValueOf(syscall.SYS_RENAME),
+		"SYS_RENAMEAT":                      reflect.ValueOf(syscall.SYS_RENAMEAT),
+		"SYS_REVOKE":                        reflect.ValueOf(syscall.SYS_REVOKE),
+		"SYS_RFORK":                         reflect.ValueOf(syscall.SYS_RFORK),
+		"SYS_RMDIR":                         reflect.ValueOf(syscall.SYS_RMDIR),
+		"SYS_RTPRIO":                        reflect.ValueOf(syscall.SYS_RTPRIO),
+		"SYS_RTPRIO_THREAD":                 reflect.ValueOf(syscall.SYS_RTPRIO_THREAD),
+		"SYS_SBRK":                          reflect.ValueOf(syscall.SYS_SBRK),
+		"SYS_SCHED_GETPARAM":                reflect.ValueOf(syscall.SYS_SCHED_GETPARAM),
+		"SYS_SCHED_GETSCHEDULER":            reflect.ValueOf(syscall.SYS_SCHED_GETSCHEDULER),
+		"SYS_SCHED_GET_PRIORITY_MAX":        reflect.ValueOf(syscall.SYS_SCHED_GET_PRIORITY_MAX),
+		"SYS_SCHED_GET_PRIORITY_MIN":        reflect.ValueOf(syscall.SYS_SCHED_GET_PRIORITY_MIN),
+		"SYS_SCHED_RR_GET_INTERVAL":         reflect.ValueOf(syscall.SYS_SCHED_RR_GET_INTERVAL),
+		"SYS_SCHED_SETPARAM":                reflect.ValueOf(syscall.SYS_SCHED_SETPARAM),
+		"SYS_SCHED_SETSCHEDULER":            reflect.ValueOf(syscall.SYS_SCHED_SETSCHEDULER),
+		"SYS_SCHED_YIELD":                   reflect.ValueOf(syscall.SYS_SCHED_YIELD),
+		"SYS_SCTP_GENERIC_RECVMSG":          reflect.ValueOf(syscall.SYS_SCTP_GENERIC_RECVMSG),
+		"SYS_SCTP_GENERIC_SENDMSG":          reflect.ValueOf(syscall.SYS_SCTP_GENERIC_SENDMSG),
+		"SYS_SCTP_GENERIC_SENDMSG_IOV":      reflect.ValueOf(syscall.SYS_SCTP_GENERIC_SENDMSG_IOV),
+		"SYS_SCTP_PEELOFF":                  reflect.ValueOf(syscall.SYS_SCTP_PEELOFF),
+		"SYS_SELECT":                        reflect.ValueOf(syscall.SYS_SELECT),
+		"SYS_SENDFILE":                      reflect.ValueOf(syscall.SYS_SENDFILE),
+		"SYS_SENDMSG":                       reflect.ValueOf(syscall.SYS_SENDMSG),
+		"SYS_SENDTO":                        reflect.ValueOf(syscall.SYS_SENDTO),
+		"SYS_SETAUDIT":                      reflect.ValueOf(syscall.SYS_SETAUDIT),
+		"SYS_SETAUDIT_ADDR":                 reflect.ValueOf(syscall.SYS_SETAUDIT_ADDR),
+		"SYS_SETAUID":                       reflect.ValueOf(syscall.SYS_SETAUID),
+		"SYS_SETCONTEXT":                    reflect.ValueOf(syscall.SYS_SETCONTEXT),
+		"SYS_SETEGID":                       reflect.ValueOf(syscall.SYS_SETEGID),
+		"SYS_SETEUID":                       reflect.ValueOf(syscall.SYS_SETEUID),
+		"SYS_SETFIB":                        reflect.ValueOf(syscall.SYS_SETFIB),
+		"SYS_SETGID":                        reflect.ValueOf(syscall.SYS_SETGID),
+		"SYS_SETGROUPS":                     reflect.ValueOf(syscall.SYS_SETGROUPS),
+		"SYS_SETITIMER":                     reflect.ValueOf(syscall.SYS_SETITIMER),
+		"SYS_SETLOGIN":                      reflect.ValueOf(syscall.SYS_SETLOGIN),
+		"SYS_SETLOGINCLASS":                 reflect.ValueOf(syscall.SYS_SETLOGINCLASS),
+		"SYS_SETPGID":                       reflect.ValueOf(syscall.SYS_SETPGID),
+		"SYS_SETPRIORITY":                   reflect.ValueOf(syscall.SYS_SETPRIORITY),
+		"SYS_SETREGID":                      reflect.ValueOf(syscall.SYS_SETREGID),
+		"SYS_SETRESGID":                     reflect.ValueOf(syscall.SYS_SETRESGID),
+		"SYS_SETRESUID":                     reflect.ValueOf(syscall.SYS_SETRESUID),
+		"SYS_SETREUID":                      reflect.ValueOf(syscall.SYS_SETREUID),
+		"SYS_SETRLIMIT":                     reflect.ValueOf(syscall.SYS_SETRLIMIT),
+		"SYS_SETSID":                        reflect.ValueOf(syscall.SYS_SETSID),
+		"SYS_SETSOCKOPT":                    reflect.ValueOf(syscall.SYS_SETSOCKOPT),
+		"SYS_SETTIMEOFDAY":                  reflect.ValueOf(syscall.SYS_SETTIMEOFDAY),
+		"SYS_SETUID":                        reflect.ValueOf(syscall.SYS_SETUID),
+		"SYS_SHM_OPEN":                      reflect.ValueOf(syscall.SYS_SHM_OPEN),
+		"SYS_SHM_UNLINK":                    reflect.ValueOf(syscall.SYS_SHM_UNLINK),
+		"SYS_SHUTDOWN":                      reflect.ValueOf(syscall.SYS_SHUTDOWN),
+		"SYS_SIGACTION":                     reflect.ValueOf(syscall.SYS_SIGACTION),
+		"SYS_SIGALTSTACK":                   reflect.ValueOf(syscall.SYS_SIGALTSTACK),
+		"SYS_SIGPENDING":                    reflect.ValueOf(syscall.SYS_SIGPENDING),
+		"SYS_SIGPROCMASK":                   reflect.ValueOf(syscall.SYS_SIGPROCMASK),
+		"SYS_SIGQUEUE":                      reflect.ValueOf(syscall.SYS_SIGQUEUE),
+		"SYS_SIGRETURN":                     reflect.ValueOf(syscall.SYS_SIGRETURN),
+		"SYS_SIGSUSPEND":                    reflect.ValueOf(syscall.SYS_SIGSUSPEND),
+		"SYS_SIGTIMEDWAIT":                  reflect.ValueOf(syscall.SYS_SIGTIMEDWAIT),
+		"SYS_SIGWAIT":                       reflect.ValueOf(syscall.SYS_SIGWAIT),
+		"SYS_SIGWAITINFO":                   reflect.ValueOf(syscall.SYS_SIGWAITINFO),
+		"SYS_SOCKET":                        reflect.ValueOf(syscall.SYS_SOCKET),
+		"SYS_SOCKETPAIR":                    reflect.ValueOf(syscall.SYS_SOCKETPAIR),
+		"SYS_SSTK":                          reflect.ValueOf(syscall.SYS_SSTK),
+		"SYS_STATFS":                        reflect.ValueOf(syscall.SYS_STATFS),
+		"SYS_SWAPCONTEXT":                   reflect.ValueOf(syscall.SYS_SWAPCONTEXT),
+		"SYS_SWAPOFF":                       reflect.ValueOf(syscall.SYS_SWAPOFF),
+		"SYS_SWAPON":                        reflect.ValueOf(syscall.SYS_SWAPON),
+		"SYS_SYMLINK":                       reflect.ValueOf(syscall.SYS_SYMLINK),
+		"SYS_SYMLINKAT":                     reflect.ValueOf(syscall.SYS_SYMLINKAT),
+		"SYS_SYNC":                          reflect.ValueOf(syscall.SYS_SYNC),
+		"SYS_SYSARCH":                       reflect.ValueOf(syscall.SYS_SYSARCH),
+		"SYS_THR_CREATE":                    reflect.ValueOf(syscall.SYS_THR_CREATE),
+		"SYS_THR_EXIT":                      reflect.ValueOf(syscall.SYS_THR_EXIT),
+		"SYS_THR_KILL":                      reflect.ValueOf(syscall.SYS_THR_KILL),
+		"SYS_THR_KILL2":                     reflect.ValueOf(syscall.SYS_THR_KILL2),
+		"SYS_THR_NEW":                       reflect.ValueOf(syscall.SYS_THR_NEW),
+		"SYS_THR_SELF":                      reflect.ValueOf(syscall.SYS_THR_SELF),
+		"SYS_THR_SET_NAME":                  reflect.ValueOf(syscall.SYS_THR_SET_NAME),
+		"SYS_THR_SUSPEND":                   reflect.ValueOf(syscall.SYS_THR_SUSPEND),
+		"SYS_THR_WAKE":                      reflect.ValueOf(syscall.SYS_THR_WAKE),
+		"SYS_TRUNCATE":                      reflect.ValueOf(syscall.SYS_TRUNCATE),
+		"SYS_UMASK":                         reflect.ValueOf(syscall.SYS_UMASK),
+		"SYS_UNDELETE":                      reflect.ValueOf(syscall.SYS_UNDELETE),
+		"SYS_UNLINK":                        reflect.ValueOf(syscall.SYS_UNLINK),
+		"SYS_UNLINKAT":                      reflect.ValueOf(syscall.SYS_UNLINKAT),
+		"SYS_UNMOUNT":                       reflect.ValueOf(syscall.SYS_UNMOUNT),
+		"SYS_UTIMENSAT":                     reflect.ValueOf(syscall.SYS_UTIMENSAT),
+		"SYS_UTIMES":                        reflect.ValueOf(syscall.SYS_UTIMES),
+		"SYS_UTRACE":                        reflect.ValueOf(syscall.SYS_UTRACE),
+		"SYS_UUIDGEN":                       reflect.ValueOf(syscall.SYS_UUIDGEN),
+		"SYS_VFORK":                         reflect.ValueOf(syscall.SYS_VFORK),
+		"SYS_WAIT4":                         reflect.ValueOf(syscall.SYS_WAIT4),
+		"SYS_WAIT6":                         reflect.ValueOf(syscall.SYS_WAIT6),
+		"SYS_WRITE":                         reflect.ValueOf(syscall.SYS_WRITE),
+		"SYS_WRITEV":                        reflect.ValueOf(syscall.SYS_WRITEV),
+		"SYS_YIELD":                         reflect.ValueOf(syscall.SYS_YIELD),
+		"SYS__UMTX_LOCK":                    reflect.ValueOf(syscall.SYS__UMTX_LOCK),
+		"SYS__UMTX_OP":                      reflect.ValueOf(syscall.SYS__UMTX_OP),
+		"SYS__UMTX_UNLOCK":                  reflect.ValueOf(syscall.SYS__UMTX_UNLOCK),
+		"SYS___ACL_ACLCHECK_FD":             reflect.ValueOf(syscall.SYS___ACL_ACLCHECK_FD),
+		"SYS___ACL_ACLCHECK_FILE":           reflect.ValueOf(syscall.SYS___ACL_ACLCHECK_FILE),
+		"SYS___ACL_ACLCHECK_LINK":           reflect.ValueOf(syscall.SYS___ACL_ACLCHECK_LINK),
+		"SYS___ACL_DELETE_FD":               reflect.ValueOf(syscall.SYS___ACL_DELETE_FD),
+		"SYS___ACL_DELETE_FILE":             reflect.ValueOf(syscall.SYS___ACL_DELETE_FILE),
+		"SYS___ACL_DELETE_LINK":             reflect.ValueOf(syscall.SYS___ACL_DELETE_LINK),
+		"SYS___ACL_GET_FD":                  reflect.ValueOf(syscall.SYS___ACL_GET_FD),
+		"SYS___ACL_GET_FILE":                reflect.ValueOf(syscall.SYS___ACL_GET_FILE),
+		"SYS___ACL_GET_LINK":                reflect.ValueOf(syscall.SYS___ACL_GET_LINK),
+		"SYS___ACL_SET_FD":                  reflect.ValueOf(syscall.SYS___ACL_SET_FD),
+		"SYS___ACL_SET_FILE":                reflect.ValueOf(syscall.SYS___ACL_SET_FILE),
+		"SYS___ACL_SET_LINK":                reflect.ValueOf(syscall.SYS___ACL_SET_LINK),
+		"SYS___GETCWD":                      reflect.ValueOf(syscall.SYS___GETCWD),
+		"SYS___MAC_EXECVE":                  reflect.ValueOf(syscall.SYS___MAC_EXECVE),
+		"SYS___MAC_GET_FD":                  reflect.ValueOf(syscall.SYS___MAC_GET_FD),
+		"SYS___MAC_GET_FILE":                reflect.ValueOf(syscall.SYS___MAC_GET_FILE),
+		"SYS___MAC_GET_LINK":                reflect.ValueOf(syscall.SYS___MAC_GET_LINK),
+		"SYS___MAC_GET_PID":                 reflect.ValueOf(syscall.SYS___MAC_GET_PID),
+		"SYS___MAC_GET_PROC":                reflect.ValueOf(syscall.SYS___MAC_GET_PROC),
+		"SYS___MAC_SET_FD":                  reflect.ValueOf(syscall.SYS___MAC_SET_FD),
+		"SYS___MAC_SET_FILE":                reflect.ValueOf(syscall.SYS___MAC_SET_FILE),
+		"SYS___MAC_SET_LINK":                reflect.ValueOf(syscall.SYS___MAC_SET_LINK),
+		"SYS___MAC_SET_PROC":                reflect.ValueOf(syscall.SYS___MAC_SET_PROC),
+		"SYS___SETUGID":                     reflect.ValueOf(syscall.SYS___SETUGID),
+		"SYS___SYSCTL":                      reflect.ValueOf(syscall.SYS___SYSCTL),
+		"S_IFBLK":                           reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                           reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                           reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                           reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                           reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                            reflect.ValueOf(syscall.S_IFMT),
+		"S_IFREG":                           reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                          reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IRUSR":                           reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                           reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                           reflect.ValueOf(syscall.S_IRWXO),
+		"S_ISGID":                           reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                           reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                           reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWUSR":                           reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXUSR":                           reflect.ValueOf(syscall.S_IXUSR),
+		"Seek":                              reflect.ValueOf(syscall.Seek),
+		"Select":                            reflect.ValueOf(syscall.Select),
+		"Sendfile":                          reflect.ValueOf(syscall.Sendfile),
+		"Sendmsg":                           reflect.ValueOf(syscall.Sendmsg),
+		"SendmsgN":                          reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                            reflect.ValueOf(syscall.Sendto),
+		"SetBpf":                            reflect.ValueOf(syscall.SetBpf),
+		"SetBpfBuflen":                      reflect.ValueOf(syscall.SetBpfBuflen),
+		"SetBpfDatalink":                    reflect.ValueOf(syscall.SetBpfDatalink),
+		"SetBpfHeadercmpl":                  reflect.ValueOf(syscall.SetBpfHeadercmpl),
+		"SetBpfImmediate":                   reflect.ValueOf(syscall.SetBpfImmediate),
+		"SetBpfInterface":                   reflect.ValueOf(syscall.SetBpfInterface),
+		"SetBpfPromisc":                     reflect.ValueOf(syscall.SetBpfPromisc),
+		"SetBpfTimeout":                     reflect.ValueOf(syscall.SetBpfTimeout),
+		"SetKevent":                         reflect.ValueOf(syscall.SetKevent),
+		"SetNonblock":                       reflect.ValueOf(syscall.SetNonblock),
+		"Setegid":                           reflect.ValueOf(syscall.Setegid),
+		"Setenv":                            reflect.ValueOf(syscall.Setenv),
+		"Seteuid":                           reflect.ValueOf(syscall.Seteuid),
+		"Setgid":                            reflect.ValueOf(syscall.Setgid),
+		"Setgroups":                         reflect.ValueOf(syscall.Setgroups),
+		"Setlogin":                          reflect.ValueOf(syscall.Setlogin),
+		"Setpgid":                           reflect.ValueOf(syscall.Setpgid),
+		"Setpriority":                       reflect.ValueOf(syscall.Setpriority),
+		"Setregid":                          reflect.ValueOf(syscall.Setregid),
+		"Setreuid":                          reflect.ValueOf(syscall.Setreuid),
+		"Setrlimit":                         reflect.ValueOf(syscall.Setrlimit),
+		"Setsid":                            reflect.ValueOf(syscall.Setsid),
+		"SetsockoptByte":                    reflect.ValueOf(syscall.SetsockoptByte),
+		"SetsockoptICMPv6Filter":            reflect.ValueOf(syscall.SetsockoptICMPv6Filter),
+		"SetsockoptIPMreq":                  reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPMreqn":                 reflect.ValueOf(syscall.SetsockoptIPMreqn),
+		"SetsockoptIPv6Mreq":                reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":               reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                     reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":                  reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptString":                  reflect.ValueOf(syscall.SetsockoptString),
+		"SetsockoptTimeval":                 reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Settimeofday":                      reflect.ValueOf(syscall.Settimeofday),
+		"Setuid":                            reflect.ValueOf(syscall.Setuid),
+		"Shutdown":                          reflect.ValueOf(syscall.Shutdown),
+		"SizeofBpfHdr":                      reflect.ValueOf(syscall.SizeofBpfHdr),
+		"SizeofBpfInsn":                     reflect.ValueOf(syscall.SizeofBpfInsn),
+		"SizeofBpfProgram":                  reflect.ValueOf(syscall.SizeofBpfProgram),
+		"SizeofBpfStat":                     reflect.ValueOf(syscall.SizeofBpfStat),
+		"SizeofBpfVersion":                  reflect.ValueOf(syscall.SizeofBpfVersion),
+		"SizeofBpfZbuf":                     reflect.ValueOf(syscall.SizeofBpfZbuf),
+		"SizeofBpfZbufHeader":               reflect.ValueOf(syscall.SizeofBpfZbufHeader),
+		"SizeofCmsghdr":                     reflect.ValueOf(syscall.SizeofCmsghdr),
+		"SizeofICMPv6Filter":                reflect.ValueOf(syscall.SizeofICMPv6Filter),
+		"SizeofIPMreq":                      reflect.ValueOf(syscall.SizeofIPMreq),
+		"SizeofIPMreqn":                     reflect.ValueOf(syscall.SizeofIPMreqn),
+		"SizeofIPv6MTUInfo":                 reflect.ValueOf(syscall.SizeofIPv6MTUInfo),
+		"SizeofIPv6Mreq":                    reflect.ValueOf(syscall.SizeofIPv6Mreq),
+		"SizeofIfAnnounceMsghdr":            reflect.ValueOf(syscall.SizeofIfAnnounceMsghdr),
+		"SizeofIfData":                      reflect.ValueOf(syscall.SizeofIfData),
+		"SizeofIfMsghdr":                    reflect.ValueOf(syscall.SizeofIfMsghdr),
+		"SizeofIfaMsghdr":                   reflect.ValueOf(syscall.SizeofIfaMsghdr),
+		"SizeofIfmaMsghdr":                  reflect.ValueOf(syscall.SizeofIfmaMsghdr),
+		"SizeofInet6Pktinfo":                reflect.ValueOf(syscall.SizeofInet6Pktinfo),
+		"SizeofLinger":                      reflect.ValueOf(syscall.SizeofLinger),
+		"SizeofMsghdr":                      reflect.ValueOf(syscall.SizeofMsghdr),
+		"SizeofRtMetrics":                   reflect.ValueOf(syscall.SizeofRtMetrics),
+		"SizeofRtMsghdr":                    reflect.ValueOf(syscall.SizeofRtMsghdr),
+		"SizeofSockaddrAny":                 reflect.ValueOf(syscall.SizeofSockaddrAny),
+		"SizeofSockaddrDatalink":            reflect.ValueOf(syscall.SizeofSockaddrDatalink),
+		"SizeofSockaddrInet4":               reflect.ValueOf(syscall.SizeofSockaddrInet4),
+		"SizeofSockaddrInet6":               reflect.ValueOf(syscall.SizeofSockaddrInet6),
+		"SizeofSockaddrUnix":                reflect.ValueOf(syscall.SizeofSockaddrUnix),
+		"SlicePtrFromStrings":               reflect.ValueOf(syscall.SlicePtrFromStrings),
+		"Socket":                            reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":                 reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"Socketpair":                        reflect.ValueOf(syscall.Socketpair),
+		"StartProcess":                      reflect.ValueOf(syscall.StartProcess),
+		"Stat":                              reflect.ValueOf(syscall.Stat),
+		"Statfs":                            reflect.ValueOf(syscall.Statfs),
+		"Stderr":                            reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                             reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                            reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                     reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":                   reflect.ValueOf(syscall.StringByteSlice),
+		"StringSlicePtr":                    reflect.ValueOf(syscall.StringSlicePtr),
+		"Symlink":                           reflect.ValueOf(syscall.Symlink),
+		"Sync":                              reflect.ValueOf(syscall.Sync),
+		"Syscall":                           reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                          reflect.ValueOf(syscall.Syscall6),
+		"Syscall9":                          reflect.ValueOf(syscall.Syscall9),
+		"Sysctl":                            reflect.ValueOf(syscall.Sysctl),
+		"SysctlUint32":                      reflect.ValueOf(syscall.SysctlUint32),
+		"TCIFLUSH":                          reflect.ValueOf(syscall.TCIFLUSH),
+		"TCIOFLUSH":                         reflect.ValueOf(syscall.TCIOFLUSH),
+		"TCOFLUSH":                          reflect.ValueOf(syscall.TCOFLUSH),
+		"TCP_CA_NAME_MAX":                   reflect.ValueOf(syscall.TCP_CA_NAME_MAX),
+		"TCP_CONGESTION":                    reflect.ValueOf(syscall.TCP_CONGESTION),
+		"TCP_INFO":                          reflect.ValueOf(syscall.TCP_INFO),
+		"TCP_KEEPCNT":                       reflect.ValueOf(syscall.TCP_KEEPCNT),
+		"TCP_KEEPIDLE":                      reflect.ValueOf(syscall.TCP_KEEPIDLE),
+		"TCP_KEEPINIT":                      reflect.ValueOf(syscall.TCP_KEEPINIT),
+		"TCP_KEEPINTVL":                     reflect.ValueOf(syscall.TCP_KEEPINTVL),
+		"TCP_MAXBURST":                      reflect.ValueOf(syscall.TCP_MAXBURST),
+		"TCP_MAXHLEN":                       reflect.ValueOf(syscall.TCP_MAXHLEN),
+		"TCP_MAXOLEN":                       reflect.ValueOf(syscall.TCP_MAXOLEN),
+		"TCP_MAXSEG":                        reflect.ValueOf(syscall.TCP_MAXSEG),
+		"TCP_MAXWIN":                        reflect.ValueOf(syscall.TCP_MAXWIN),
+		"TCP_MAX_SACK":                      reflect.ValueOf(syscall.TCP_MAX_SACK),
+		"TCP_MAX_WINSHIFT":                  reflect.ValueOf(syscall.TCP_MAX_WINSHIFT),
+		"TCP_MD5SIG":                        reflect.ValueOf(syscall.TCP_MD5SIG),
+		"TCP_MINMSS":                        reflect.ValueOf(syscall.TCP_MINMSS),
+		"TCP_MSS":                           reflect.ValueOf(syscall.TCP_MSS),
+		"TCP_NODELAY":                       reflect.ValueOf(syscall.TCP_NODELAY),
+		"TCP_NOOPT":                         reflect.ValueOf(syscall.TCP_NOOPT),
+		"TCP_NOPUSH":                        reflect.ValueOf(syscall.TCP_NOPUSH),
+		"TCP_VENDOR":                        reflect.ValueOf(uint32(syscall.TCP_VENDOR)),
+		"TCSAFLUSH":                         reflect.ValueOf(syscall.TCSAFLUSH),
+		"TIOCCBRK":                          reflect.ValueOf(syscall.TIOCCBRK),
+		"TIOCCDTR":                          reflect.ValueOf(syscall.TIOCCDTR),
+		"TIOCCONS":                          reflect.ValueOf(uint32(syscall.TIOCCONS)),
+		"TIOCDRAIN":                         reflect.ValueOf(syscall.TIOCDRAIN),
+		"TIOCEXCL":                          reflect.ValueOf(syscall.TIOCEXCL),
+		"TIOCEXT":                           reflect.ValueOf(uint32(syscall.TIOCEXT)),
+		"TIOCFLUSH":                         reflect.ValueOf(uint32(syscall.TIOCFLUSH)),
+		"TIOCGDRAINWAIT":                    reflect.ValueOf(syscall.TIOCGDRAINWAIT),
+		"TIOCGETA":                          reflect.ValueOf(syscall.TIOCGETA),
+		"TIOCGETD":                          reflect.ValueOf(syscall.TIOCGETD),
+		"TIOCGPGRP":                         reflect.ValueOf(syscall.TIOCGPGRP),
+		"TIOCGPTN":                          reflect.ValueOf(syscall.TIOCGPTN),
+		"TIOCGSID":                          reflect.ValueOf(syscall.TIOCGSID),
+		"TIOCGWINSZ":                        reflect.ValueOf(syscall.TIOCGWINSZ),
+		"TIOCMBIC":                          reflect.ValueOf(uint32(syscall.TIOCMBIC)),
+		"TIOCMBIS":                          reflect.ValueOf(uint32(syscall.TIOCMBIS)),
+		"TIOCMGDTRWAIT":                     reflect.ValueOf(syscall.TIOCMGDTRWAIT),
+		"TIOCMGET":                          reflect.ValueOf(syscall.TIOCMGET),
+		"TIOCMSDTRWAIT":                     reflect.ValueOf(uint32(syscall.TIOCMSDTRWAIT)),
+		"TIOCMSET":                          reflect.ValueOf(uint32(syscall.TIOCMSET)),
+		"TIOCM_CAR":                         reflect.ValueOf(syscall.TIOCM_CAR),
+		"TIOCM_CD":                          reflect.ValueOf(syscall.TIOCM_CD),
+		"TIOCM_CTS":                         reflect.ValueOf(syscall.TIOCM_CTS),
+		"TIOCM_DCD":                         reflect.ValueOf(syscall.TIOCM_DCD),
+		"TIOCM_DSR":                         reflect.ValueOf(syscall.TIOCM_DSR),
+		"TIOCM_DTR":                         reflect.ValueOf(syscall.TIOCM_DTR),
+		"TIOCM_LE":                          reflect.ValueOf(syscall.TIOCM_LE),
+		"TIOCM_RI":                          reflect.ValueOf(syscall.TIOCM_RI),
+		"TIOCM_RNG":                         reflect.ValueOf(syscall.TIOCM_RNG),
+		"TIOCM_RTS":                         reflect.ValueOf(syscall.TIOCM_RTS),
+		"TIOCM_SR":                          reflect.ValueOf(syscall.TIOCM_SR),
+		"TIOCM_ST":                          reflect.ValueOf(syscall.TIOCM_ST),
+		"TIOCNOTTY":                         reflect.ValueOf(syscall.TIOCNOTTY),
+		"TIOCNXCL":                          reflect.ValueOf(syscall.TIOCNXCL),
+		"TIOCOUTQ":                          reflect.ValueOf(syscall.TIOCOUTQ),
+		"TIOCPKT":                           reflect.ValueOf(uint32(syscall.TIOCPKT)),
+		"TIOCPKT_DATA":                      reflect.ValueOf(syscall.TIOCPKT_DATA),
+		"TIOCPKT_DOSTOP":                    reflect.ValueOf(syscall.TIOCPKT_DOSTOP),
+		"TIOCPKT_FLUSHREAD":                 reflect.ValueOf(syscall.TIOCPKT_FLUSHREAD),
+		"TIOCPKT_FLUSHWRITE":                reflect.ValueOf(syscall.TIOCPKT_FLUSHWRITE),
+		"TIOCPKT_IOCTL":                     reflect.ValueOf(syscall.TIOCPKT_IOCTL),
+		"TIOCPKT_NOSTOP":                    reflect.ValueOf(syscall.TIOCPKT_NOSTOP),
+		"TIOCPKT_START":                     reflect.ValueOf(syscall.TIOCPKT_START),
+		"TIOCPKT_STOP":                      reflect.ValueOf(syscall.TIOCPKT_STOP),
+		"TIOCPTMASTER":                      reflect.ValueOf(syscall.TIOCPTMASTER),
+		"TIOCSBRK":                          reflect.ValueOf(syscall.TIOCSBRK),
+		"TIOCSCTTY":                         reflect.ValueOf(syscall.TIOCSCTTY),
+		"TIOCSDRAINWAIT":                    reflect.ValueOf(uint32(syscall.TIOCSDRAINWAIT)),
+		"TIOCSDTR":                          reflect.ValueOf(syscall.TIOCSDTR),
+		"TIOCSETA":                          reflect.ValueOf(uint32(syscall.TIOCSETA)),
+		"TIOCSETAF":                         reflect.ValueOf(uint32(syscall.TIOCSETAF)),
+		"TIOCSETAW":                         reflect.ValueOf(uint32(syscall.TIOCSETAW)),
+		"TIOCSETD":                          reflect.ValueOf(uint32(syscall.TIOCSETD)),
+		"TIOCSIG":                           reflect.ValueOf(syscall.TIOCSIG),
+		"TIOCSPGRP":                         reflect.ValueOf(uint32(syscall.TIOCSPGRP)),
+		"TIOCSTART":                         reflect.ValueOf(syscall.TIOCSTART),
+		"TIOCSTAT":                          reflect.ValueOf(syscall.TIOCSTAT),
+		"TIOCSTI":                           reflect.ValueOf(uint32(syscall.TIOCSTI)),
+		"TIOCSTOP":                          reflect.ValueOf(syscall.TIOCSTOP),
+		"TIOCSWINSZ":                        reflect.ValueOf(uint32(syscall.TIOCSWINSZ)),
+		"TIOCTIMESTAMP":                     reflect.ValueOf(syscall.TIOCTIMESTAMP),
+		"TIOCUCNTL":                         reflect.ValueOf(uint32(syscall.TIOCUCNTL)),
+		"TOSTOP":                            reflect.ValueOf(syscall.TOSTOP),
+		"TimespecToNsec":                    reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                     reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                          reflect.ValueOf(syscall.Truncate),
+		"Umask":                             reflect.ValueOf(syscall.Umask),
+		"Undelete":                          reflect.ValueOf(syscall.Undelete),
+		"UnixRights":                        reflect.ValueOf(syscall.UnixRights),
+		"Unlink":                            reflect.ValueOf(syscall.Unlink),
+		"Unmount":                           reflect.ValueOf(syscall.Unmount),
+		"Unsetenv":                          reflect.ValueOf(syscall.Unsetenv),
+		"Utimes":                            reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                        reflect.ValueOf(syscall.UtimesNano),
+		"VDISCARD":                          reflect.ValueOf(syscall.VDISCARD),
+		"VDSUSP":                            reflect.ValueOf(syscall.VDSUSP),
+		"VEOF":                              reflect.ValueOf(syscall.VEOF),
+		"VEOL":                              reflect.ValueOf(syscall.VEOL),
+		"VEOL2":                             reflect.ValueOf(syscall.VEOL2),
+		"VERASE":                            reflect.ValueOf(syscall.VERASE),
+		"VERASE2":                           reflect.ValueOf(syscall.VERASE2),
+		"VINTR":                             reflect.ValueOf(syscall.VINTR),
+		"VKILL":                             reflect.ValueOf(syscall.VKILL),
+		"VLNEXT":                            reflect.ValueOf(syscall.VLNEXT),
+		"VMIN":                              reflect.ValueOf(syscall.VMIN),
+		"VQUIT":                             reflect.ValueOf(syscall.VQUIT),
+		"VREPRINT":                          reflect.ValueOf(syscall.VREPRINT),
+		"VSTART":                            reflect.ValueOf(syscall.VSTART),
+		"VSTATUS":                           reflect.ValueOf(syscall.VSTATUS),
+		"VSTOP":                             reflect.ValueOf(syscall.VSTOP),
+		"VSUSP":                             reflect.ValueOf(syscall.VSUSP),
+		"VTIME":                             reflect.ValueOf(syscall.VTIME),
+		"VWERASE":                           reflect.ValueOf(syscall.VWERASE),
+		"WCONTINUED":                        reflect.ValueOf(syscall.WCONTINUED),
+		"WCOREFLAG":                         reflect.ValueOf(syscall.WCOREFLAG),
+		"WEXITED":                           reflect.ValueOf(syscall.WEXITED),
+		"WLINUXCLONE":                       reflect.ValueOf(uint32(syscall.WLINUXCLONE)),
+		"WNOHANG":                           reflect.ValueOf(syscall.WNOHANG),
+		"WNOWAIT":                           reflect.ValueOf(syscall.WNOWAIT),
+		"WSTOPPED":                          reflect.ValueOf(syscall.WSTOPPED),
+		"WTRAPPED":                          reflect.ValueOf(syscall.WTRAPPED),
+		"WUNTRACED":                         reflect.ValueOf(syscall.WUNTRACED),
+		"Wait4":                             reflect.ValueOf(syscall.Wait4),
+		"Write":                             reflect.ValueOf(syscall.Write),
+
+		// type definitions
+		"BpfHdr":                        reflect.ValueOf((*syscall.BpfHdr)(nil)),
+		"BpfInsn":                       reflect.ValueOf((*syscall.BpfInsn)(nil)),
+		"BpfProgram":                    reflect.ValueOf((*syscall.BpfProgram)(nil)),
+		"BpfStat":                       reflect.ValueOf((*syscall.BpfStat)(nil)),
+		"BpfVersion":                    reflect.ValueOf((*syscall.BpfVersion)(nil)),
+		"BpfZbuf":                       reflect.ValueOf((*syscall.BpfZbuf)(nil)),
+		"BpfZbufHeader":                 reflect.ValueOf((*syscall.BpfZbufHeader)(nil)),
+		"Cmsghdr":                       reflect.ValueOf((*syscall.Cmsghdr)(nil)),
+		"Conn":                          reflect.ValueOf((*syscall.Conn)(nil)),
+		"Credential":                    reflect.ValueOf((*syscall.Credential)(nil)),
+		"Dirent":                        reflect.ValueOf((*syscall.Dirent)(nil)),
+		"Errno":                         reflect.ValueOf((*syscall.Errno)(nil)),
+		"FdSet":                         reflect.ValueOf((*syscall.FdSet)(nil)),
+		"Flock_t":                       reflect.ValueOf((*syscall.Flock_t)(nil)),
+		"Fsid":                          reflect.ValueOf((*syscall.Fsid)(nil)),
+		"ICMPv6Filter":                  reflect.ValueOf((*syscall.ICMPv6Filter)(nil)),
+		"IPMreq":                        reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPMreqn":                       reflect.ValueOf((*syscall.IPMreqn)(nil)),
+		"IPv6MTUInfo":                   reflect.ValueOf((*syscall.IPv6MTUInfo)(nil)),
+		"IPv6Mreq":                      reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"IfAnnounceMsghdr":              reflect.ValueOf((*syscall.IfAnnounceMsghdr)(nil)),
+		"IfData":                        reflect.ValueOf((*syscall.IfData)(nil)),
+		"IfMsghdr":                      reflect.ValueOf((*syscall.IfMsghdr)(nil)),
+		"IfaMsghdr":                     reflect.ValueOf((*syscall.IfaMsghdr)(nil)),
+		"IfmaMsghdr":                    reflect.ValueOf((*syscall.IfmaMsghdr)(nil)),
+		"Inet6Pktinfo":                  reflect.ValueOf((*syscall.Inet6Pktinfo)(nil)),
+		"InterfaceAddrMessage":          reflect.ValueOf((*syscall.InterfaceAddrMessage)(nil)),
+		"InterfaceAnnounceMessage":      reflect.ValueOf((*syscall.InterfaceAnnounceMessage)(nil)),
+		"InterfaceMessage":              reflect.ValueOf((*syscall.InterfaceMessage)(nil)),
+		"InterfaceMulticastAddrMessage": reflect.ValueOf((*syscall.InterfaceMulticastAddrMessage)(nil)),
+		"Iovec":                         reflect.ValueOf((*syscall.Iovec)(nil)),
+		"Kevent_t":                      reflect.ValueOf((*syscall.Kevent_t)(nil)),
+		"Linger":                        reflect.ValueOf((*syscall.Linger)(nil)),
+		"Msghdr":                        reflect.ValueOf((*syscall.Msghdr)(nil)),
+		"ProcAttr":                      reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"RawConn":                       reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":                   reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":                reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrDatalink":           reflect.ValueOf((*syscall.RawSockaddrDatalink)(nil)),
+		"RawSockaddrInet4":              reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":              reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":               reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rlimit":                        reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"RouteMessage":                  reflect.ValueOf((*syscall.RouteMessage)(nil)),
+		"RoutingMessage":                reflect.ValueOf((*syscall.RoutingMessage)(nil)),
+		"RtMetrics":                     reflect.ValueOf((*syscall.RtMetrics)(nil)),
+		"RtMsghdr":                      reflect.ValueOf((*syscall.RtMsghdr)(nil)),
+		"Rusage":                        reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":                        reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":                      reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrDatalink":              reflect.ValueOf((*syscall.SockaddrDatalink)(nil)),
+		"SockaddrInet4":                 reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":                 reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":                  reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"SocketControlMessage":          reflect.ValueOf((*syscall.SocketControlMessage)(nil)),
+		"Stat_t":                        reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"Statfs_t":                      reflect.ValueOf((*syscall.Statfs_t)(nil)),
+		"SysProcAttr":                   reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Termios":                       reflect.ValueOf((*syscall.Termios)(nil)),
+		"Timespec":                      reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":                       reflect.ValueOf((*syscall.Timeval)(nil)),
+		"WaitStatus":                    reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":           reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":        reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_RoutingMessage": reflect.ValueOf((*_syscall_RoutingMessage)(nil)),
+		"_Sockaddr":       reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
+type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
+}
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
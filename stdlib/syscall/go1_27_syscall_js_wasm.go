@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package syscall
 
@@ -211,6 +212,7 @@ func init() {
 		"O_CLOEXEC":           reflect.ValueOf(syscall.O_CLOEXEC),
 		"O_CREAT":             reflect.ValueOf(syscall.O_CREAT),
 		"O_CREATE":            reflect.ValueOf(syscall.O_CREATE),
+		"O_DIRECTORY":         reflect.ValueOf(syscall.O_DIRECTORY),
 		"O_EXCL":              reflect.ValueOf(syscall.O_EXCL),
 		"O_RDONLY":            reflect.ValueOf(syscall.O_RDONLY),
 		"O_RDWR":              reflect.ValueOf(syscall.O_RDWR),
@@ -236,6 +238,7 @@ func init() {
 		"SIGINT":              reflect.ValueOf(syscall.SIGINT),
 		"SIGKILL":             reflect.ValueOf(syscall.SIGKILL),
 		"SIGQUIT":             reflect.ValueOf(syscall.SIGQUIT),
+		"SIGTERM":             reflect.ValueOf(syscall.SIGTERM),
 		"SIGTRAP":             reflect.ValueOf(syscall.SIGTRAP),
 		"SOCK_DGRAM":          reflect.ValueOf(syscall.SOCK_DGRAM),
 		"SOCK_RAW":            reflect.ValueOf(syscall.SOCK_RAW),
@@ -305,6 +308,7 @@ func init() {
 		"TimespecToNsec":      reflect.ValueOf(syscall.TimespecToNsec),
 		"TimevalToNsec":       reflect.ValueOf(syscall.TimevalToNsec),
 		"Truncate":            reflect.ValueOf(syscall.Truncate),
+		"Umask":               reflect.ValueOf(syscall.Umask),
 		"Unlink":              reflect.ValueOf(syscall.Unlink),
 		"Unsetenv":            reflect.ValueOf(syscall.Unsetenv),
 		"UtimesNano":          reflect.ValueOf(syscall.UtimesNano),
@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package syscall
 
@@ -1755,6 +1756,7 @@ func init() {
 		"SYS_VADVISE":                       reflect.ValueOf(syscall.SYS_VADVISE),
 		"SYS_VFORK":                         reflect.ValueOf(syscall.SYS_VFORK),
 		"SYS_WAIT4":                         reflect.ValueOf(syscall.SYS_WAIT4),
+		"SYS_WAIT6":                         reflect.ValueOf(syscall.SYS_WAIT6),
 		"SYS_WRITE":                         reflect.ValueOf(syscall.SYS_WRITE),
 		"SYS_WRITEV":                        reflect.ValueOf(syscall.SYS_WRITEV),
 		"SYS__LWP_CONTINUE":                 reflect.ValueOf(syscall.SYS__LWP_CONTINUE),
@@ -2031,6 +2033,7 @@ func init() {
 		"WALTSIG":                           reflect.ValueOf(syscall.WALTSIG),
 		"WCLONE":                            reflect.ValueOf(syscall.WCLONE),
 		"WCOREFLAG":                         reflect.ValueOf(syscall.WCOREFLAG),
+		"WEXITED":                           reflect.ValueOf(syscall.WEXITED),
 		"WNOHANG":                           reflect.ValueOf(syscall.WNOHANG),
 		"WNOWAIT":                           reflect.ValueOf(syscall.WNOWAIT),
 		"WNOZOMBIE":                         reflect.ValueOf(syscall.WNOZOMBIE),
@@ -2130,8 +2133,10 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_RoutingMessage is an interface wrapper for RoutingMessage type
 type _syscall_RoutingMessage struct {
+	syscall.RoutingMessage
 }
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
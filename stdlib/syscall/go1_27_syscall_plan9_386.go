@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package syscall
 
@@ -204,6 +205,7 @@ func init() {
 		// type definitions
 		"Conn":        reflect.ValueOf((*syscall.Conn)(nil)),
 		"Dir":         reflect.ValueOf((*syscall.Dir)(nil)),
+		"Errno":       reflect.ValueOf((*syscall.Errno)(nil)),
 		"ErrorString": reflect.ValueOf((*syscall.ErrorString)(nil)),
 		"Note":        reflect.ValueOf((*syscall.Note)(nil)),
 		"ProcAttr":    reflect.ValueOf((*syscall.ProcAttr)(nil)),
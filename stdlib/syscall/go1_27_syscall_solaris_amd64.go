@@ -1,4 +1,5 @@
-// +build go1.11,!go1.12
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
 
 package syscall
 
@@ -63,6 +64,7 @@ func init() {
 		"ARPHRD_METRICOM":               reflect.ValueOf(syscall.ARPHRD_METRICOM),
 		"ARPHRD_TUNNEL":                 reflect.ValueOf(syscall.ARPHRD_TUNNEL),
 		"Accept":                        reflect.ValueOf(syscall.Accept),
+		"Accept4":                       reflect.ValueOf(syscall.Accept4),
 		"Access":                        reflect.ValueOf(syscall.Access),
 		"Adjtime":                       reflect.ValueOf(syscall.Adjtime),
 		"B0":                            reflect.ValueOf(syscall.B0),
@@ -490,6 +492,7 @@ func init() {
 		"Getppid":                       reflect.ValueOf(syscall.Getppid),
 		"Getpriority":                   reflect.ValueOf(syscall.Getpriority),
 		"Getrlimit":                     reflect.ValueOf(syscall.Getrlimit),
+		"Getrusage":                     reflect.ValueOf(syscall.Getrusage),
 		"Getsockname":                   reflect.ValueOf(syscall.Getsockname),
 		"GetsockoptInt":                 reflect.ValueOf(syscall.GetsockoptInt),
 		"Gettimeofday":                  reflect.ValueOf(syscall.Gettimeofday),
@@ -775,6 +778,7 @@ func init() {
 		"MAP_ALIGN":                     reflect.ValueOf(syscall.MAP_ALIGN),
 		"MAP_ANON":                      reflect.ValueOf(syscall.MAP_ANON),
 		"MAP_ANONYMOUS":                 reflect.ValueOf(syscall.MAP_ANONYMOUS),
+		"MAP_FILE":                      reflect.ValueOf(syscall.MAP_FILE),
 		"MAP_FIXED":                     reflect.ValueOf(syscall.MAP_FIXED),
 		"MAP_INITDATA":                  reflect.ValueOf(syscall.MAP_INITDATA),
 		"MAP_NORESERVE":                 reflect.ValueOf(syscall.MAP_NORESERVE),
@@ -804,6 +808,8 @@ func init() {
 		"M_FLUSH":                       reflect.ValueOf(syscall.M_FLUSH),
 		"Mkdir":                         reflect.ValueOf(syscall.Mkdir),
 		"Mknod":                         reflect.ValueOf(syscall.Mknod),
+		"Mmap":                          reflect.ValueOf(syscall.Mmap),
+		"Munmap":                        reflect.ValueOf(syscall.Munmap),
 		"NOFLSH":                        reflect.ValueOf(syscall.NOFLSH),
 		"Nanosleep":                     reflect.ValueOf(syscall.Nanosleep),
 		"NsecToTimespec":                reflect.ValueOf(syscall.NsecToTimespec),
@@ -820,6 +826,7 @@ func init() {
 		"O_APPEND":                      reflect.ValueOf(syscall.O_APPEND),
 		"O_CLOEXEC":                     reflect.ValueOf(syscall.O_CLOEXEC),
 		"O_CREAT":                       reflect.ValueOf(syscall.O_CREAT),
+		"O_DIRECTORY":                   reflect.ValueOf(syscall.O_DIRECTORY),
 		"O_DSYNC":                       reflect.ValueOf(syscall.O_DSYNC),
 		"O_EXCL":                        reflect.ValueOf(syscall.O_EXCL),
 		"O_EXEC":                        reflect.ValueOf(syscall.O_EXEC),
@@ -858,6 +865,7 @@ func init() {
 		"PathMax":                       reflect.ValueOf(syscall.PathMax),
 		"Pathconf":                      reflect.ValueOf(syscall.Pathconf),
 		"Pipe":                          reflect.ValueOf(syscall.Pipe),
+		"Pipe2":                         reflect.ValueOf(syscall.Pipe2),
 		"Pread":                         reflect.ValueOf(syscall.Pread),
 		"Pwrite":                        reflect.ValueOf(syscall.Pwrite),
 		"RLIMIT_AS":                     reflect.ValueOf(syscall.RLIMIT_AS),
@@ -1206,6 +1214,8 @@ func init() {
 		"S_IFREG":                       reflect.ValueOf(syscall.S_IFREG),
 		"S_IFSOCK":                      reflect.ValueOf(syscall.S_IFSOCK),
 		"S_IRUSR":                       reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                       reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                       reflect.ValueOf(syscall.S_IRWXO),
 		"S_ISGID":                       reflect.ValueOf(syscall.S_ISGID),
 		"S_ISUID":                       reflect.ValueOf(syscall.S_ISUID),
 		"S_ISVTX":                       reflect.ValueOf(syscall.S_ISVTX),
@@ -1492,4 +1502,5 @@ func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W
 
 // _syscall_Sockaddr is an interface wrapper for Sockaddr type
 type _syscall_Sockaddr struct {
+	syscall.Sockaddr
 }
@@ -0,0 +1,407 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_INET":                       reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                      reflect.ValueOf(syscall.AF_INET6),
+		"AF_UNIX":                       reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                     reflect.ValueOf(syscall.AF_UNSPEC),
+		"Accept":                        reflect.ValueOf(syscall.Accept),
+		"Bind":                          reflect.ValueOf(syscall.Bind),
+		"BytePtrFromString":             reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":           reflect.ValueOf(syscall.ByteSliceFromString),
+		"Chdir":                         reflect.ValueOf(syscall.Chdir),
+		"Chmod":                         reflect.ValueOf(syscall.Chmod),
+		"Chown":                         reflect.ValueOf(syscall.Chown),
+		"Clearenv":                      reflect.ValueOf(syscall.Clearenv),
+		"Close":                         reflect.ValueOf(syscall.Close),
+		"CloseOnExec":                   reflect.ValueOf(syscall.CloseOnExec),
+		"Connect":                       reflect.ValueOf(syscall.Connect),
+		"Dup":                           reflect.ValueOf(syscall.Dup),
+		"Dup2":                          reflect.ValueOf(syscall.Dup2),
+		"E2BIG":                         reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                        reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                    reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                 reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EAFNOSUPPORT":                  reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                        reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                      reflect.ValueOf(syscall.EALREADY),
+		"EBADF":                         reflect.ValueOf(syscall.EBADF),
+		"EBADFD":                        reflect.ValueOf(syscall.EBADFD),
+		"EBADMSG":                       reflect.ValueOf(syscall.EBADMSG),
+		"EBUSY":                         reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                     reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                        reflect.ValueOf(syscall.ECHILD),
+		"ECONNABORTED":                  reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                  reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                    reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                       reflect.ValueOf(syscall.EDEADLK),
+		"EDESTADDRREQ":                  reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDOM":                          reflect.ValueOf(syscall.EDOM),
+		"EDQUOT":                        reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                        reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                        reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                         reflect.ValueOf(syscall.EFBIG),
+		"EHOSTUNREACH":                  reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                         reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                        reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                   reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                         reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                        reflect.ValueOf(syscall.EINVAL),
+		"EIO":                           reflect.ValueOf(syscall.EIO),
+		"EISCONN":                       reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                        reflect.ValueOf(syscall.EISDIR),
+		"ELOOP":                         reflect.ValueOf(syscall.ELOOP),
+		"EMFILE":                        reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                        reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                      reflect.ValueOf(syscall.EMSGSIZE),
+		"EMULTIHOP":                     reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                  reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENETDOWN":                      reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                     reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                   reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                        reflect.ValueOf(syscall.ENFILE),
+		"ENOBUFS":                       reflect.ValueOf(syscall.ENOBUFS),
+		"ENODEV":                        reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                        reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                       reflect.ValueOf(syscall.ENOEXEC),
+		"ENOLCK":                        reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                       reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEM":                        reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                        reflect.ValueOf(syscall.ENOMSG),
+		"ENOPROTOOPT":                   reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                        reflect.ValueOf(syscall.ENOSPC),
+		"ENOSYS":                        reflect.ValueOf(syscall.ENOSYS),
+		"ENOTCAPABLE":                   reflect.ValueOf(syscall.ENOTCAPABLE),
+		"ENOTCONN":                      reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                       reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                     reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTRECOVERABLE":               reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                      reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                       reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                        reflect.ValueOf(syscall.ENOTTY),
+		"ENXIO":                         reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                    reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                     reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                    reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                         reflect.ValueOf(syscall.EPERM),
+		"EPIPE":                         reflect.ValueOf(syscall.EPIPE),
+		"EPROTO":                        reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":               reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                    reflect.ValueOf(syscall.EPROTOTYPE),
+		"ERANGE":                        reflect.ValueOf(syscall.ERANGE),
+		"EROFS":                         reflect.ValueOf(syscall.EROFS),
+		"ESPIPE":                        reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                         reflect.ValueOf(syscall.ESRCH),
+		"ESTALE":                        reflect.ValueOf(syscall.ESTALE),
+		"ETIMEDOUT":                     reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETXTBSY":                       reflect.ValueOf(syscall.ETXTBSY),
+		"EXDEV":                         reflect.ValueOf(syscall.EXDEV),
+		"Environ":                       reflect.ValueOf(syscall.Environ),
+		"Exit":                          reflect.ValueOf(syscall.Exit),
+		"FDFLAG_APPEND":                 reflect.ValueOf(syscall.FDFLAG_APPEND),
+		"FDFLAG_DSYNC":                  reflect.ValueOf(syscall.FDFLAG_DSYNC),
+		"FDFLAG_NONBLOCK":               reflect.ValueOf(syscall.FDFLAG_NONBLOCK),
+		"FDFLAG_RSYNC":                  reflect.ValueOf(syscall.FDFLAG_RSYNC),
+		"FDFLAG_SYNC":                   reflect.ValueOf(syscall.FDFLAG_SYNC),
+		"FILESTAT_SET_ATIM":             reflect.ValueOf(syscall.FILESTAT_SET_ATIM),
+		"FILESTAT_SET_ATIM_NOW":         reflect.ValueOf(syscall.FILESTAT_SET_ATIM_NOW),
+		"FILESTAT_SET_MTIM":             reflect.ValueOf(syscall.FILESTAT_SET_MTIM),
+		"FILESTAT_SET_MTIM_NOW":         reflect.ValueOf(syscall.FILESTAT_SET_MTIM_NOW),
+		"FILETYPE_BLOCK_DEVICE":         reflect.ValueOf(syscall.FILETYPE_BLOCK_DEVICE),
+		"FILETYPE_CHARACTER_DEVICE":     reflect.ValueOf(syscall.FILETYPE_CHARACTER_DEVICE),
+		"FILETYPE_DIRECTORY":            reflect.ValueOf(syscall.FILETYPE_DIRECTORY),
+		"FILETYPE_REGULAR_FILE":         reflect.ValueOf(syscall.FILETYPE_REGULAR_FILE),
+		"FILETYPE_SOCKET_DGRAM":         reflect.ValueOf(syscall.FILETYPE_SOCKET_DGRAM),
+		"FILETYPE_SOCKET_STREAM":        reflect.ValueOf(syscall.FILETYPE_SOCKET_STREAM),
+		"FILETYPE_SYMBOLIC_LINK":        reflect.ValueOf(syscall.FILETYPE_SYMBOLIC_LINK),
+		"FILETYPE_UNKNOWN":              reflect.ValueOf(syscall.FILETYPE_UNKNOWN),
+		"F_CNVT":                        reflect.ValueOf(syscall.F_CNVT),
+		"F_DUPFD":                       reflect.ValueOf(syscall.F_DUPFD),
+		"F_DUPFD_CLOEXEC":               reflect.ValueOf(syscall.F_DUPFD_CLOEXEC),
+		"F_GETFD":                       reflect.ValueOf(syscall.F_GETFD),
+		"F_GETFL":                       reflect.ValueOf(syscall.F_GETFL),
+		"F_GETLK":                       reflect.ValueOf(syscall.F_GETLK),
+		"F_GETOWN":                      reflect.ValueOf(syscall.F_GETOWN),
+		"F_RDLCK":                       reflect.ValueOf(syscall.F_RDLCK),
+		"F_RGETLK":                      reflect.ValueOf(syscall.F_RGETLK),
+		"F_RSETLK":                      reflect.ValueOf(syscall.F_RSETLK),
+		"F_RSETLKW":                     reflect.ValueOf(syscall.F_RSETLKW),
+		"F_SETFD":                       reflect.ValueOf(syscall.F_SETFD),
+		"F_SETFL":                       reflect.ValueOf(syscall.F_SETFL),
+		"F_SETLK":                       reflect.ValueOf(syscall.F_SETLK),
+		"F_SETLKW":                      reflect.ValueOf(syscall.F_SETLKW),
+		"F_SETOWN":                      reflect.ValueOf(syscall.F_SETOWN),
+		"F_UNLCK":                       reflect.ValueOf(syscall.F_UNLCK),
+		"F_UNLKSYS":                     reflect.ValueOf(syscall.F_UNLKSYS),
+		"F_WRLCK":                       reflect.ValueOf(syscall.F_WRLCK),
+		"Fchmod":                        reflect.ValueOf(syscall.Fchmod),
+		"Fchown":                        reflect.ValueOf(syscall.Fchown),
+		"Fstat":                         reflect.ValueOf(syscall.Fstat),
+		"Fsync":                         reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                     reflect.ValueOf(syscall.Ftruncate),
+		"Getegid":                       reflect.ValueOf(syscall.Getegid),
+		"Getenv":                        reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                       reflect.ValueOf(syscall.Geteuid),
+		"Getgid":                        reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                     reflect.ValueOf(syscall.Getgroups),
+		"Getpagesize":                   reflect.ValueOf(syscall.Getpagesize),
+		"Getpid":                        reflect.ValueOf(syscall.Getpid),
+		"Getppid":                       reflect.ValueOf(syscall.Getppid),
+		"Getrlimit":                     reflect.ValueOf(syscall.Getrlimit),
+		"GetsockoptInt":                 reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                  reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                        reflect.ValueOf(syscall.Getuid),
+		"Getwd":                         reflect.ValueOf(syscall.Getwd),
+		"IPPROTO_IP":                    reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPV4":                  reflect.ValueOf(syscall.IPPROTO_IPV4),
+		"IPPROTO_IPV6":                  reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_TCP":                   reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_UDP":                   reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPV6_V6ONLY":                   reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"ImplementsGetwd":               reflect.ValueOf(syscall.ImplementsGetwd),
+		"Kill":                          reflect.ValueOf(syscall.Kill),
+		"LOOKUP_SYMLINK_FOLLOW":         reflect.ValueOf(syscall.LOOKUP_SYMLINK_FOLLOW),
+		"Lchown":                        reflect.ValueOf(syscall.Lchown),
+		"Link":                          reflect.ValueOf(syscall.Link),
+		"Listen":                        reflect.ValueOf(syscall.Listen),
+		"Lstat":                         reflect.ValueOf(syscall.Lstat),
+		"Mkdir":                         reflect.ValueOf(syscall.Mkdir),
+		"NsecToTimespec":                reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                 reflect.ValueOf(syscall.NsecToTimeval),
+		"OFLAG_CREATE":                  reflect.ValueOf(syscall.OFLAG_CREATE),
+		"OFLAG_DIRECTORY":               reflect.ValueOf(syscall.OFLAG_DIRECTORY),
+		"OFLAG_EXCL":                    reflect.ValueOf(syscall.OFLAG_EXCL),
+		"OFLAG_TRUNC":                   reflect.ValueOf(syscall.OFLAG_TRUNC),
+		"O_APPEND":                      reflect.ValueOf(syscall.O_APPEND),
+		"O_CLOEXEC":                     reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                       reflect.ValueOf(syscall.O_CREAT),
+		"O_CREATE":                      reflect.ValueOf(syscall.O_CREATE),
+		"O_DIRECTORY":                   reflect.ValueOf(syscall.O_DIRECTORY),
+		"O_EXCL":                        reflect.ValueOf(syscall.O_EXCL),
+		"O_NOFOLLOW":                    reflect.ValueOf(syscall.O_NOFOLLOW),
+		"O_RDONLY":                      reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                        reflect.ValueOf(syscall.O_RDWR),
+		"O_SYNC":                        reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                       reflect.ValueOf(syscall.O_TRUNC),
+		"O_WRONLY":                      reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                          reflect.ValueOf(syscall.Open),
+		"Openat":                        reflect.ValueOf(syscall.Openat),
+		"ParseDirent":                   reflect.ValueOf(syscall.ParseDirent),
+		"Pipe":                          reflect.ValueOf(syscall.Pipe),
+		"Pread":                         reflect.ValueOf(syscall.Pread),
+		"ProcExit":                      reflect.ValueOf(syscall.ProcExit),
+		"Pwrite":                        reflect.ValueOf(syscall.Pwrite),
+		"RIGHT_FDSTAT_SET_FLAGS":        reflect.ValueOf(syscall.RIGHT_FDSTAT_SET_FLAGS),
+		"RIGHT_FD_ADVISE":               reflect.ValueOf(syscall.RIGHT_FD_ADVISE),
+		"RIGHT_FD_ALLOCATE":             reflect.ValueOf(syscall.RIGHT_FD_ALLOCATE),
+		"RIGHT_FD_DATASYNC":             reflect.ValueOf(syscall.RIGHT_FD_DATASYNC),
+		"RIGHT_FD_FILESTAT_GET":         reflect.ValueOf(syscall.RIGHT_FD_FILESTAT_GET),
+		"RIGHT_FD_FILESTAT_SET_SIZE":    reflect.ValueOf(syscall.RIGHT_FD_FILESTAT_SET_SIZE),
+		"RIGHT_FD_FILESTAT_SET_TIMES":   reflect.ValueOf(syscall.RIGHT_FD_FILESTAT_SET_TIMES),
+		"RIGHT_FD_READ":                 reflect.ValueOf(syscall.RIGHT_FD_READ),
+		"RIGHT_FD_READDIR":              reflect.ValueOf(syscall.RIGHT_FD_READDIR),
+		"RIGHT_FD_SEEK":                 reflect.ValueOf(syscall.RIGHT_FD_SEEK),
+		"RIGHT_FD_SYNC":                 reflect.ValueOf(syscall.RIGHT_FD_SYNC),
+		"RIGHT_FD_TELL":                 reflect.ValueOf(syscall.RIGHT_FD_TELL),
+		"RIGHT_FD_WRITE":                reflect.ValueOf(syscall.RIGHT_FD_WRITE),
+		"RIGHT_PATH_CREATE_DIRECTORY":   reflect.ValueOf(syscall.RIGHT_PATH_CREATE_DIRECTORY),
+		"RIGHT_PATH_CREATE_FILE":        reflect.ValueOf(syscall.RIGHT_PATH_CREATE_FILE),
+		"RIGHT_PATH_FILESTAT_GET":       reflect.ValueOf(syscall.RIGHT_PATH_FILESTAT_GET),
+		"RIGHT_PATH_FILESTAT_SET_SIZE":  reflect.ValueOf(syscall.RIGHT_PATH_FILESTAT_SET_SIZE),
+		"RIGHT_PATH_FILESTAT_SET_TIMES": reflect.ValueOf(syscall.RIGHT_PATH_FILESTAT_SET_TIMES),
+		"RIGHT_PATH_LINK_SOURCE":        reflect.ValueOf(syscall.RIGHT_PATH_LINK_SOURCE),
+		"RIGHT_PATH_LINK_TARGET":        reflect.ValueOf(syscall.RIGHT_PATH_LINK_TARGET),
+		"RIGHT_PATH_OPEN":               reflect.ValueOf(syscall.RIGHT_PATH_OPEN),
+		"RIGHT_PATH_READLINK":           reflect.ValueOf(syscall.RIGHT_PATH_READLINK),
+		"RIGHT_PATH_REMOVE_DIRECTORY":   reflect.ValueOf(syscall.RIGHT_PATH_REMOVE_DIRECTORY),
+		"RIGHT_PATH_RENAME_SOURCE":      reflect.ValueOf(syscall.RIGHT_PATH_RENAME_SOURCE),
+		"RIGHT_PATH_RENAME_TARGET":      reflect.ValueOf(syscall.RIGHT_PATH_RENAME_TARGET),
+		"RIGHT_PATH_SYMLINK":            reflect.ValueOf(syscall.RIGHT_PATH_SYMLINK),
+		"RIGHT_PATH_UNLINK_FILE":        reflect.ValueOf(syscall.RIGHT_PATH_UNLINK_FILE),
+		"RIGHT_POLL_FD_READWRITE":       reflect.ValueOf(syscall.RIGHT_POLL_FD_READWRITE),
+		"RIGHT_SOCK_ACCEPT":             reflect.ValueOf(syscall.RIGHT_SOCK_ACCEPT),
+		"RIGHT_SOCK_SHUTDOWN":           reflect.ValueOf(syscall.RIGHT_SOCK_SHUTDOWN),
+		"RLIMIT_NOFILE":                 reflect.ValueOf(syscall.RLIMIT_NOFILE),
+		"RandomGet":                     reflect.ValueOf(syscall.RandomGet),
+		"RawSyscall":                    reflect.ValueOf(syscall.RawSyscall),
+		"RawSyscall6":                   reflect.ValueOf(syscall.RawSyscall6),
+		"Read":                          reflect.ValueOf(syscall.Read),
+		"ReadDir":                       reflect.ValueOf(syscall.ReadDir),
+		"Readlink":                      reflect.ValueOf(syscall.Readlink),
+		"Recvfrom":                      reflect.ValueOf(syscall.Recvfrom),
+		"Recvmsg":                       reflect.ValueOf(syscall.Recvmsg),
+		"Rename":                        reflect.ValueOf(syscall.Rename),
+		"Rmdir":                         reflect.ValueOf(syscall.Rmdir),
+		"SHUT_RD":                       reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                     reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                       reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                       reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                       reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                        reflect.ValueOf(syscall.SIGBUS),
+		"SIGCHLD":                       reflect.ValueOf(syscall.SIGCHLD),
+		"SIGCONT":                       reflect.ValueOf(syscall.SIGCONT),
+		"SIGFPE":                        reflect.ValueOf(syscall.SIGFPE),
+		"SIGHUP":                        reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                        reflect.ValueOf(syscall.SIGILL),
+		"SIGINT":                        reflect.ValueOf(syscall.SIGINT),
+		"SIGKILL":                       reflect.ValueOf(syscall.SIGKILL),
+		"SIGNONE":                       reflect.ValueOf(syscall.SIGNONE),
+		"SIGPIPE":                       reflect.ValueOf(syscall.SIGPIPE),
+		"SIGPOLL":                       reflect.ValueOf(syscall.SIGPOLL),
+		"SIGPROF":                       reflect.ValueOf(syscall.SIGPROF),
+		"SIGPWR":                        reflect.ValueOf(syscall.SIGPWR),
+		"SIGQUIT":                       reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                       reflect.ValueOf(syscall.SIGSEGV),
+		"SIGSTOP":                       reflect.ValueOf(syscall.SIGSTOP),
+		"SIGSYS":                        reflect.ValueOf(syscall.SIGSYS),
+		"SIGTERM":                       reflect.ValueOf(syscall.SIGTERM),
+		"SIGTRAP":                       reflect.ValueOf(syscall.SIGTRAP),
+		"SIGTSTP":                       reflect.ValueOf(syscall.SIGTSTP),
+		"SIGTTIN":                       reflect.ValueOf(syscall.SIGTTIN),
+		"SIGTTOU":                       reflect.ValueOf(syscall.SIGTTOU),
+		"SIGURG":                        reflect.ValueOf(syscall.SIGURG),
+		"SIGUSR1":                       reflect.ValueOf(syscall.SIGUSR1),
+		"SIGUSR2":                       reflect.ValueOf(syscall.SIGUSR2),
+		"SIGVTARLM":                     reflect.ValueOf(syscall.SIGVTARLM),
+		"SIGWINCH":                      reflect.ValueOf(syscall.SIGWINCH),
+		"SIGXCPU":                       reflect.ValueOf(syscall.SIGXCPU),
+		"SIGXFSZ":                       reflect.ValueOf(syscall.SIGXFSZ),
+		"SOCK_DGRAM":                    reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_RAW":                      reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_SEQPACKET":                reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                   reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOMAXCONN":                     reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_ERROR":                      reflect.ValueOf(syscall.SO_ERROR),
+		"SYS_FCNTL":                     reflect.ValueOf(syscall.SYS_FCNTL),
+		"S_IEXEC":                       reflect.ValueOf(syscall.S_IEXEC),
+		"S_IFBLK":                       reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFBOUNDSOCK":                 reflect.ValueOf(syscall.S_IFBOUNDSOCK),
+		"S_IFCHR":                       reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFCOND":                      reflect.ValueOf(syscall.S_IFCOND),
+		"S_IFDIR":                       reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFDSOCK":                     reflect.ValueOf(syscall.S_IFDSOCK),
+		"S_IFIFO":                       reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                       reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                        reflect.ValueOf(syscall.S_IFMT),
+		"S_IFMUTEX":                     reflect.ValueOf(syscall.S_IFMUTEX),
+		"S_IFREG":                       reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSEMA":                      reflect.ValueOf(syscall.S_IFSEMA),
+		"S_IFSHM":                       reflect.ValueOf(syscall.S_IFSHM),
+		"S_IFSHM_SYSV":                  reflect.ValueOf(syscall.S_IFSHM_SYSV),
+		"S_IFSOCK":                      reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IFSOCKADDR":                  reflect.ValueOf(syscall.S_IFSOCKADDR),
+		"S_IREAD":                       reflect.ValueOf(syscall.S_IREAD),
+		"S_IRGRP":                       reflect.ValueOf(syscall.S_IRGRP),
+		"S_IROTH":                       reflect.ValueOf(syscall.S_IROTH),
+		"S_IRUSR":                       reflect.ValueOf(syscall.S_IRUSR),
+		"S_IRWXG":                       reflect.ValueOf(syscall.S_IRWXG),
+		"S_IRWXO":                       reflect.ValueOf(syscall.S_IRWXO),
+		"S_IRWXU":                       reflect.ValueOf(syscall.S_IRWXU),
+		"S_ISGID":                       reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                       reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                       reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWGRP":                       reflect.ValueOf(syscall.S_IWGRP),
+		"S_IWOTH":                       reflect.ValueOf(syscall.S_IWOTH),
+		"S_IWRITE":                      reflect.ValueOf(syscall.S_IWRITE),
+		"S_IWUSR":                       reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXGRP":                       reflect.ValueOf(syscall.S_IXGRP),
+		"S_IXOTH":                       reflect.ValueOf(syscall.S_IXOTH),
+		"S_IXUSR":                       reflect.ValueOf(syscall.S_IXUSR),
+		"S_UNSUP":                       reflect.ValueOf(syscall.S_UNSUP),
+		"Seek":                          reflect.ValueOf(syscall.Seek),
+		"Sendfile":                      reflect.ValueOf(syscall.Sendfile),
+		"SendmsgN":                      reflect.ValueOf(syscall.SendmsgN),
+		"Sendto":                        reflect.ValueOf(syscall.Sendto),
+		"SetNonblock":                   reflect.ValueOf(syscall.SetNonblock),
+		"SetReadDeadline":               reflect.ValueOf(syscall.SetReadDeadline),
+		"SetWriteDeadline":              reflect.ValueOf(syscall.SetWriteDeadline),
+		"Setenv":                        reflect.ValueOf(syscall.Setenv),
+		"SetsockoptInt":                 reflect.ValueOf(syscall.SetsockoptInt),
+		"Shutdown":                      reflect.ValueOf(syscall.Shutdown),
+		"Socket":                        reflect.ValueOf(syscall.Socket),
+		"StartProcess":                  reflect.ValueOf(syscall.StartProcess),
+		"Stat":                          reflect.ValueOf(syscall.Stat),
+		"Stderr":                        reflect.ValueOf(syscall.Stderr),
+		"Stdin":                         reflect.ValueOf(syscall.Stdin),
+		"Stdout":                        reflect.ValueOf(syscall.Stdout),
+		"StopIO":                        reflect.ValueOf(syscall.StopIO),
+		"StringBytePtr":                 reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":               reflect.ValueOf(syscall.StringByteSlice),
+		"Symlink":                       reflect.ValueOf(syscall.Symlink),
+		"Syscall":                       reflect.ValueOf(syscall.Syscall),
+		"Syscall6":                      reflect.ValueOf(syscall.Syscall6),
+		"Sysctl":                        reflect.ValueOf(syscall.Sysctl),
+		"TimespecToNsec":                reflect.ValueOf(syscall.TimespecToNsec),
+		"TimevalToNsec":                 reflect.ValueOf(syscall.TimevalToNsec),
+		"Truncate":                      reflect.ValueOf(syscall.Truncate),
+		"Umask":                         reflect.ValueOf(syscall.Umask),
+		"Unlink":                        reflect.ValueOf(syscall.Unlink),
+		"Unsetenv":                      reflect.ValueOf(syscall.Unsetenv),
+		"UtimesNano":                    reflect.ValueOf(syscall.UtimesNano),
+		"WHENCE_CUR":                    reflect.ValueOf(syscall.WHENCE_CUR),
+		"WHENCE_END":                    reflect.ValueOf(syscall.WHENCE_END),
+		"WHENCE_SET":                    reflect.ValueOf(syscall.WHENCE_SET),
+		"Wait4":                         reflect.ValueOf(syscall.Wait4),
+		"Write":                         reflect.ValueOf(syscall.Write),
+
+		// type definitions
+		"Conn":          reflect.ValueOf((*syscall.Conn)(nil)),
+		"Dircookie":     reflect.ValueOf((*syscall.Dircookie)(nil)),
+		"Dirent":        reflect.ValueOf((*syscall.Dirent)(nil)),
+		"Errno":         reflect.ValueOf((*syscall.Errno)(nil)),
+		"Filetype":      reflect.ValueOf((*syscall.Filetype)(nil)),
+		"ProcAttr":      reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"RawConn":       reflect.ValueOf((*syscall.RawConn)(nil)),
+		"Rlimit":        reflect.ValueOf((*syscall.Rlimit)(nil)),
+		"Rusage":        reflect.ValueOf((*syscall.Rusage)(nil)),
+		"Signal":        reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":      reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrInet4": reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6": reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":  reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"Stat_t":        reflect.ValueOf((*syscall.Stat_t)(nil)),
+		"SysProcAttr":   reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Timespec":      reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":       reflect.ValueOf((*syscall.Timeval)(nil)),
+		"WaitStatus":    reflect.ValueOf((*syscall.WaitStatus)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":     reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":  reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_Sockaddr": reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+}
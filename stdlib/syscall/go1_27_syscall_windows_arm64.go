@@ -0,0 +1,1039 @@
+//go:build go1.27 && !go1.28
+// +build go1.27,!go1.28
+
+package syscall
+
+// Code generated by 'goexports syscall'. DO NOT EDIT.
+
+import (
+	"reflect"
+	"syscall"
+)
+
+func init() {
+	Symbols["syscall"] = map[string]reflect.Value{
+		// function, constant and variable definitions
+		"AF_INET":                             reflect.ValueOf(syscall.AF_INET),
+		"AF_INET6":                            reflect.ValueOf(syscall.AF_INET6),
+		"AF_NETBIOS":                          reflect.ValueOf(syscall.AF_NETBIOS),
+		"AF_UNIX":                             reflect.ValueOf(syscall.AF_UNIX),
+		"AF_UNSPEC":                           reflect.ValueOf(syscall.AF_UNSPEC),
+		"AI_CANONNAME":                        reflect.ValueOf(syscall.AI_CANONNAME),
+		"AI_NUMERICHOST":                      reflect.ValueOf(syscall.AI_NUMERICHOST),
+		"AI_PASSIVE":                          reflect.ValueOf(syscall.AI_PASSIVE),
+		"APPLICATION_ERROR":                   reflect.ValueOf(syscall.APPLICATION_ERROR),
+		"AUTHTYPE_CLIENT":                     reflect.ValueOf(syscall.AUTHTYPE_CLIENT),
+		"AUTHTYPE_SERVER":                     reflect.ValueOf(syscall.AUTHTYPE_SERVER),
+		"Accept":                              reflect.ValueOf(syscall.Accept),
+		"AcceptEx":                            reflect.ValueOf(syscall.AcceptEx),
+		"BASE_PROTOCOL":                       reflect.ValueOf(syscall.BASE_PROTOCOL),
+		"Bind":                                reflect.ValueOf(syscall.Bind),
+		"BytePtrFromString":                   reflect.ValueOf(syscall.BytePtrFromString),
+		"ByteSliceFromString":                 reflect.ValueOf(syscall.ByteSliceFromString),
+		"CERT_CHAIN_POLICY_AUTHENTICODE":      reflect.ValueOf(syscall.CERT_CHAIN_POLICY_AUTHENTICODE),
+		"CERT_CHAIN_POLICY_AUTHENTICODE_TS":   reflect.ValueOf(syscall.CERT_CHAIN_POLICY_AUTHENTICODE_TS),
+		"CERT_CHAIN_POLICY_BASE":              reflect.ValueOf(syscall.CERT_CHAIN_POLICY_BASE),
+		"CERT_CHAIN_POLICY_BASIC_CONSTRAINTS": reflect.ValueOf(syscall.CERT_CHAIN_POLICY_BASIC_CONSTRAINTS),
+		"CERT_CHAIN_POLICY_EV":                reflect.ValueOf(syscall.CERT_CHAIN_POLICY_EV),
+		"CERT_CHAIN_POLICY_MICROSOFT_ROOT":    reflect.ValueOf(syscall.CERT_CHAIN_POLICY_MICROSOFT_ROOT),
+		"CERT_CHAIN_POLICY_NT_AUTH":           reflect.ValueOf(syscall.CERT_CHAIN_POLICY_NT_AUTH),
+		"CERT_CHAIN_POLICY_SSL":               reflect.ValueOf(syscall.CERT_CHAIN_POLICY_SSL),
+		"CERT_E_CN_NO_MATCH":                  reflect.ValueOf(uint32(syscall.CERT_E_CN_NO_MATCH)),
+		"CERT_E_EXPIRED":                      reflect.ValueOf(uint32(syscall.CERT_E_EXPIRED)),
+		"CERT_E_PURPOSE":                      reflect.ValueOf(uint32(syscall.CERT_E_PURPOSE)),
+		"CERT_E_ROLE":                         reflect.ValueOf(uint32(syscall.CERT_E_ROLE)),
+		"CERT_E_UNTRUSTEDROOT":                reflect.ValueOf(uint32(syscall.CERT_E_UNTRUSTEDROOT)),
+		"CERT_STORE_ADD_ALWAYS":               reflect.ValueOf(syscall.CERT_STORE_ADD_ALWAYS),
+		"CERT_STORE_DEFER_CLOSE_UNTIL_LAST_FREE_FLAG":  reflect.ValueOf(syscall.CERT_STORE_DEFER_CLOSE_UNTIL_LAST_FREE_FLAG),
+		"CERT_STORE_PROV_MEMORY":                       reflect.ValueOf(syscall.CERT_STORE_PROV_MEMORY),
+		"CERT_TRUST_HAS_EXCLUDED_NAME_CONSTRAINT":      reflect.ValueOf(syscall.CERT_TRUST_HAS_EXCLUDED_NAME_CONSTRAINT),
+		"CERT_TRUST_HAS_NOT_DEFINED_NAME_CONSTRAINT":   reflect.ValueOf(syscall.CERT_TRUST_HAS_NOT_DEFINED_NAME_CONSTRAINT),
+		"CERT_TRUST_HAS_NOT_PERMITTED_NAME_CONSTRAINT": reflect.ValueOf(syscall.CERT_TRUST_HAS_NOT_PERMITTED_NAME_CONSTRAINT),
+		"CERT_TRUST_HAS_NOT_SUPPORTED_CRITICAL_EXT":    reflect.ValueOf(syscall.CERT_TRUST_HAS_NOT_SUPPORTED_CRITICAL_EXT),
+		"CERT_TRUST_HAS_NOT_SUPPORTED_NAME_CONSTRAINT": reflect.ValueOf(syscall.CERT_TRUST_HAS_NOT_SUPPORTED_NAME_CONSTRAINT),
+		"CERT_TRUST_INVALID_BASIC_CONSTRAINTS":         reflect.ValueOf(syscall.CERT_TRUST_INVALID_BASIC_CONSTRAINTS),
+		"CERT_TRUST_INVALID_EXTENSION":                 reflect.ValueOf(syscall.CERT_TRUST_INVALID_EXTENSION),
+		"CERT_TRUST_INVALID_NAME_CONSTRAINTS":          reflect.ValueOf(syscall.CERT_TRUST_INVALID_NAME_CONSTRAINTS),
+		"CERT_TRUST_INVALID_POLICY_CONSTRAINTS":        reflect.ValueOf(syscall.CERT_TRUST_INVALID_POLICY_CONSTRAINTS),
+		"CERT_TRUST_IS_CYCLIC":                         reflect.ValueOf(syscall.CERT_TRUST_IS_CYCLIC),
+		"CERT_TRUST_IS_EXPLICIT_DISTRUST":              reflect.ValueOf(syscall.CERT_TRUST_IS_EXPLICIT_DISTRUST),
+		"CERT_TRUST_IS_NOT_SIGNATURE_VALID":            reflect.ValueOf(syscall.CERT_TRUST_IS_NOT_SIGNATURE_VALID),
+		"CERT_TRUST_IS_NOT_TIME_VALID":                 reflect.ValueOf(syscall.CERT_TRUST_IS_NOT_TIME_VALID),
+		"CERT_TRUST_IS_NOT_VALID_FOR_USAGE":            reflect.ValueOf(syscall.CERT_TRUST_IS_NOT_VALID_FOR_USAGE),
+		"CERT_TRUST_IS_OFFLINE_REVOCATION":             reflect.ValueOf(syscall.CERT_TRUST_IS_OFFLINE_REVOCATION),
+		"CERT_TRUST_IS_REVOKED":                        reflect.ValueOf(syscall.CERT_TRUST_IS_REVOKED),
+		"CERT_TRUST_IS_UNTRUSTED_ROOT":                 reflect.ValueOf(syscall.CERT_TRUST_IS_UNTRUSTED_ROOT),
+		"CERT_TRUST_NO_ERROR":                          reflect.ValueOf(syscall.CERT_TRUST_NO_ERROR),
+		"CERT_TRUST_NO_ISSUANCE_CHAIN_POLICY":          reflect.ValueOf(syscall.CERT_TRUST_NO_ISSUANCE_CHAIN_POLICY),
+		"CERT_TRUST_REVOCATION_STATUS_UNKNOWN":         reflect.ValueOf(syscall.CERT_TRUST_REVOCATION_STATUS_UNKNOWN),
+		"CREATE_ALWAYS":                                reflect.ValueOf(syscall.CREATE_ALWAYS),
+		"CREATE_NEW":                                   reflect.ValueOf(syscall.CREATE_NEW),
+		"CREATE_NEW_PROCESS_GROUP":                     reflect.ValueOf(syscall.CREATE_NEW_PROCESS_GROUP),
+		"CREATE_UNICODE_ENVIRONMENT":                   reflect.ValueOf(syscall.CREATE_UNICODE_ENVIRONMENT),
+		"CRYPT_DEFAULT_CONTAINER_OPTIONAL":             reflect.ValueOf(syscall.CRYPT_DEFAULT_CONTAINER_OPTIONAL),
+		"CRYPT_DELETEKEYSET":                           reflect.ValueOf(syscall.CRYPT_DELETEKEYSET),
+		"CRYPT_MACHINE_KEYSET":                         reflect.ValueOf(syscall.CRYPT_MACHINE_KEYSET),
+		"CRYPT_NEWKEYSET":                              reflect.ValueOf(syscall.CRYPT_NEWKEYSET),
+		"CRYPT_SILENT":                                 reflect.ValueOf(syscall.CRYPT_SILENT),
+		"CRYPT_VERIFYCONTEXT":                          reflect.ValueOf(uint32(syscall.CRYPT_VERIFYCONTEXT)),
+		"CTRL_BREAK_EVENT":                             reflect.ValueOf(syscall.CTRL_BREAK_EVENT),
+		"CTRL_CLOSE_EVENT":                             reflect.ValueOf(syscall.CTRL_CLOSE_EVENT),
+		"CTRL_C_EVENT":                                 reflect.ValueOf(syscall.CTRL_C_EVENT),
+		"CTRL_LOGOFF_EVENT":                            reflect.ValueOf(syscall.CTRL_LOGOFF_EVENT),
+		"CTRL_SHUTDOWN_EVENT":                          reflect.ValueOf(syscall.CTRL_SHUTDOWN_EVENT),
+		"CancelIo":                                     reflect.ValueOf(syscall.CancelIo),
+		"CancelIoEx":                                   reflect.ValueOf(syscall.CancelIoEx),
+		"CertAddCertificateContextToStore":             reflect.ValueOf(syscall.CertAddCertificateContextToStore),
+		"CertCloseStore":                               reflect.ValueOf(syscall.CertCloseStore),
+		"CertCreateCertificateContext":                 reflect.ValueOf(syscall.CertCreateCertificateContext),
+		"CertEnumCertificatesInStore":                  reflect.ValueOf(syscall.CertEnumCertificatesInStore),
+		"CertFreeCertificateChain":                     reflect.ValueOf(syscall.CertFreeCertificateChain),
+		"CertFreeCertificateContext":                   reflect.ValueOf(syscall.CertFreeCertificateContext),
+		"CertGetCertificateChain":                      reflect.ValueOf(syscall.CertGetCertificateChain),
+		"CertOpenStore":                                reflect.ValueOf(syscall.CertOpenStore),
+		"CertOpenSystemStore":                          reflect.ValueOf(syscall.CertOpenSystemStore),
+		"CertVerifyCertificateChainPolicy":             reflect.ValueOf(syscall.CertVerifyCertificateChainPolicy),
+		"Chdir":                                        reflect.ValueOf(syscall.Chdir),
+		"Chmod":                                        reflect.ValueOf(syscall.Chmod),
+		"Chown":                                        reflect.ValueOf(syscall.Chown),
+		"Clearenv":                                     reflect.ValueOf(syscall.Clearenv),
+		"Close":                                        reflect.ValueOf(syscall.Close),
+		"CloseHandle":                                  reflect.ValueOf(syscall.CloseHandle),
+		"CloseOnExec":                                  reflect.ValueOf(syscall.CloseOnExec),
+		"Closesocket":                                  reflect.ValueOf(syscall.Closesocket),
+		"CommandLineToArgv":                            reflect.ValueOf(syscall.CommandLineToArgv),
+		"ComputerName":                                 reflect.ValueOf(syscall.ComputerName),
+		"Connect":                                      reflect.ValueOf(syscall.Connect),
+		"ConnectEx":                                    reflect.ValueOf(syscall.ConnectEx),
+		"ConvertSidToStringSid":                        reflect.ValueOf(syscall.ConvertSidToStringSid),
+		"ConvertStringSidToSid":                        reflect.ValueOf(syscall.ConvertStringSidToSid),
+		"CopySid":                                      reflect.ValueOf(syscall.CopySid),
+		"CreateDirectory":                              reflect.ValueOf(syscall.CreateDirectory),
+		"CreateFile":                                   reflect.ValueOf(syscall.CreateFile),
+		"CreateFileMapping":                            reflect.ValueOf(syscall.CreateFileMapping),
+		"CreateHardLink":                               reflect.ValueOf(syscall.CreateHardLink),
+		"CreateIoCompletionPort":                       reflect.ValueOf(syscall.CreateIoCompletionPort),
+		"CreatePipe":                                   reflect.ValueOf(syscall.CreatePipe),
+		"CreateProcess":                                reflect.ValueOf(syscall.CreateProcess),
+		"CreateProcessAsUser":                          reflect.ValueOf(syscall.CreateProcessAsUser),
+		"CreateSymbolicLink":                           reflect.ValueOf(syscall.CreateSymbolicLink),
+		"CreateToolhelp32Snapshot":                     reflect.ValueOf(syscall.CreateToolhelp32Snapshot),
+		"CryptAcquireContext":                          reflect.ValueOf(syscall.CryptAcquireContext),
+		"CryptGenRandom":                               reflect.ValueOf(syscall.CryptGenRandom),
+		"CryptReleaseContext":                          reflect.ValueOf(syscall.CryptReleaseContext),
+		"DNS_INFO_NO_RECORDS":                          reflect.ValueOf(syscall.DNS_INFO_NO_RECORDS),
+		"DNS_TYPE_A":                                   reflect.ValueOf(syscall.DNS_TYPE_A),
+		"DNS_TYPE_A6":                                  reflect.ValueOf(syscall.DNS_TYPE_A6),
+		"DNS_TYPE_AAAA":                                reflect.ValueOf(syscall.DNS_TYPE_AAAA),
+		"DNS_TYPE_ADDRS":                               reflect.ValueOf(syscall.DNS_TYPE_ADDRS),
+		"DNS_TYPE_AFSDB":                               reflect.ValueOf(syscall.DNS_TYPE_AFSDB),
+		"DNS_TYPE_ALL":                                 reflect.ValueOf(syscall.DNS_TYPE_ALL),
+		"DNS_TYPE_ANY":                                 reflect.ValueOf(syscall.DNS_TYPE_ANY),
+		"DNS_TYPE_ATMA":                                reflect.ValueOf(syscall.DNS_TYPE_ATMA),
+		"DNS_TYPE_AXFR":                                reflect.ValueOf(syscall.DNS_TYPE_AXFR),
+		"DNS_TYPE_CERT":                                reflect.ValueOf(syscall.DNS_TYPE_CERT),
+		"DNS_TYPE_CNAME":                               reflect.ValueOf(syscall.DNS_TYPE_CNAME),
+		"DNS_TYPE_DHCID":                               reflect.ValueOf(syscall.DNS_TYPE_DHCID),
+		"DNS_TYPE_DNAME":                               reflect.ValueOf(syscall.DNS_TYPE_DNAME),
+		"DNS_TYPE_DNSKEY":                              reflect.ValueOf(syscall.DNS_TYPE_DNSKEY),
+		"DNS_TYPE_DS":                                  reflect.ValueOf(syscall.DNS_TYPE_DS),
+		"DNS_TYPE_EID":                                 reflect.ValueOf(syscall.DNS_TYPE_EID),
+		"DNS_TYPE_GID":                                 reflect.ValueOf(syscall.DNS_TYPE_GID),
+		"DNS_TYPE_GPOS":                                reflect.ValueOf(syscall.DNS_TYPE_GPOS),
+		"DNS_TYPE_HINFO":                               reflect.ValueOf(syscall.DNS_TYPE_HINFO),
+		"DNS_TYPE_ISDN":                                reflect.ValueOf(syscall.DNS_TYPE_ISDN),
+		"DNS_TYPE_IXFR":                                reflect.ValueOf(syscall.DNS_TYPE_IXFR),
+		"DNS_TYPE_KEY":                                 reflect.ValueOf(syscall.DNS_TYPE_KEY),
+		"DNS_TYPE_KX":                                  reflect.ValueOf(syscall.DNS_TYPE_KX),
+		"DNS_TYPE_LOC":                                 reflect.ValueOf(syscall.DNS_TYPE_LOC),
+		"DNS_TYPE_MAILA":                               reflect.ValueOf(syscall.DNS_TYPE_MAILA),
+		"DNS_TYPE_MAILB":                               reflect.ValueOf(syscall.DNS_TYPE_MAILB),
+		"DNS_TYPE_MB":                                  reflect.ValueOf(syscall.DNS_TYPE_MB),
+		"DNS_TYPE_MD":                                  reflect.ValueOf(syscall.DNS_TYPE_MD),
+		"DNS_TYPE_MF":                                  reflect.ValueOf(syscall.DNS_TYPE_MF),
+		"DNS_TYPE_MG":                                  reflect.ValueOf(syscall.DNS_TYPE_MG),
+		"DNS_TYPE_MINFO":                               reflect.ValueOf(syscall.DNS_TYPE_MINFO),
+		"DNS_TYPE_MR":                                  reflect.ValueOf(syscall.DNS_TYPE_MR),
+		"DNS_TYPE_MX":                                  reflect.ValueOf(syscall.DNS_TYPE_MX),
+		"DNS_TYPE_NAPTR":                               reflect.ValueOf(syscall.DNS_TYPE_NAPTR),
+		"DNS_TYPE_NBSTAT":                              reflect.ValueOf(syscall.DNS_TYPE_NBSTAT),
+		"DNS_TYPE_NIMLOC":                              reflect.ValueOf(syscall.DNS_TYPE_NIMLOC),
+		"DNS_TYPE_NS":                                  reflect.ValueOf(syscall.DNS_TYPE_NS),
+		"DNS_TYPE_NSAP":                                reflect.ValueOf(syscall.DNS_TYPE_NSAP),
+		"DNS_TYPE_NSAPPTR":                             reflect.ValueOf(syscall.DNS_TYPE_NSAPPTR),
+		"DNS_TYPE_NSEC":                                reflect.ValueOf(syscall.DNS_TYPE_NSEC),
+		"DNS_TYPE_NULL":                                reflect.ValueOf(syscall.DNS_TYPE_NULL),
+		"DNS_TYPE_NXT":                                 reflect.ValueOf(syscall.DNS_TYPE_NXT),
+		"DNS_TYPE_OPT":                                 reflect.ValueOf(syscall.DNS_TYPE_OPT),
+		"DNS_TYPE_PTR":                                 reflect.ValueOf(syscall.DNS_TYPE_PTR),
+		"DNS_TYPE_PX":                                  reflect.ValueOf(syscall.DNS_TYPE_PX),
+		"DNS_TYPE_RP":                                  reflect.ValueOf(syscall.DNS_TYPE_RP),
+		"DNS_TYPE_RRSIG":                               reflect.ValueOf(syscall.DNS_TYPE_RRSIG),
+		"DNS_TYPE_RT":                                  reflect.ValueOf(syscall.DNS_TYPE_RT),
+		"DNS_TYPE_SIG":                                 reflect.ValueOf(syscall.DNS_TYPE_SIG),
+		"DNS_TYPE_SINK":                                reflect.ValueOf(syscall.DNS_TYPE_SINK),
+		"DNS_TYPE_SOA":                                 reflect.ValueOf(syscall.DNS_TYPE_SOA),
+		"DNS_TYPE_SRV":                                 reflect.ValueOf(syscall.DNS_TYPE_SRV),
+		"DNS_TYPE_TEXT":                                reflect.ValueOf(syscall.DNS_TYPE_TEXT),
+		"DNS_TYPE_TKEY":                                reflect.ValueOf(syscall.DNS_TYPE_TKEY),
+		"DNS_TYPE_TSIG":                                reflect.ValueOf(syscall.DNS_TYPE_TSIG),
+		"DNS_TYPE_UID":                                 reflect.ValueOf(syscall.DNS_TYPE_UID),
+		"DNS_TYPE_UINFO":                               reflect.ValueOf(syscall.DNS_TYPE_UINFO),
+		"DNS_TYPE_UNSPEC":                              reflect.ValueOf(syscall.DNS_TYPE_UNSPEC),
+		"DNS_TYPE_WINS":                                reflect.ValueOf(syscall.DNS_TYPE_WINS),
+		"DNS_TYPE_WINSR":                               reflect.ValueOf(syscall.DNS_TYPE_WINSR),
+		"DNS_TYPE_WKS":                                 reflect.ValueOf(syscall.DNS_TYPE_WKS),
+		"DNS_TYPE_X25":                                 reflect.ValueOf(syscall.DNS_TYPE_X25),
+		"DUPLICATE_CLOSE_SOURCE":                       reflect.ValueOf(syscall.DUPLICATE_CLOSE_SOURCE),
+		"DUPLICATE_SAME_ACCESS":                        reflect.ValueOf(syscall.DUPLICATE_SAME_ACCESS),
+		"DeleteFile":                                   reflect.ValueOf(syscall.DeleteFile),
+		"DeviceIoControl":                              reflect.ValueOf(syscall.DeviceIoControl),
+		"DnsNameCompare":                               reflect.ValueOf(syscall.DnsNameCompare),
+		"DnsQuery":                                     reflect.ValueOf(syscall.DnsQuery),
+		"DnsRecordListFree":                            reflect.ValueOf(syscall.DnsRecordListFree),
+		"DnsSectionAdditional":                         reflect.ValueOf(syscall.DnsSectionAdditional),
+		"DnsSectionAnswer":                             reflect.ValueOf(syscall.DnsSectionAnswer),
+		"DnsSectionAuthority":                          reflect.ValueOf(syscall.DnsSectionAuthority),
+		"DnsSectionQuestion":                           reflect.ValueOf(syscall.DnsSectionQuestion),
+		"DuplicateHandle":                              reflect.ValueOf(syscall.DuplicateHandle),
+		"E2BIG":                                        reflect.ValueOf(syscall.E2BIG),
+		"EACCES":                                       reflect.ValueOf(syscall.EACCES),
+		"EADDRINUSE":                                   reflect.ValueOf(syscall.EADDRINUSE),
+		"EADDRNOTAVAIL":                                reflect.ValueOf(syscall.EADDRNOTAVAIL),
+		"EADV":                                         reflect.ValueOf(syscall.EADV),
+		"EAFNOSUPPORT":                                 reflect.ValueOf(syscall.EAFNOSUPPORT),
+		"EAGAIN":                                       reflect.ValueOf(syscall.EAGAIN),
+		"EALREADY":                                     reflect.ValueOf(syscall.EALREADY),
+		"EBADE":                                        reflect.ValueOf(syscall.EBADE),
+		"EBADF":                                        reflect.ValueOf(syscall.EBADF),
+		"EBADFD":                                       reflect.ValueOf(syscall.EBADFD),
+		"EBADMSG":                                      reflect.ValueOf(syscall.EBADMSG),
+		"EBADR":                                        reflect.ValueOf(syscall.EBADR),
+		"EBADRQC":                                      reflect.ValueOf(syscall.EBADRQC),
+		"EBADSLT":                                      reflect.ValueOf(syscall.EBADSLT),
+		"EBFONT":                                       reflect.ValueOf(syscall.EBFONT),
+		"EBUSY":                                        reflect.ValueOf(syscall.EBUSY),
+		"ECANCELED":                                    reflect.ValueOf(syscall.ECANCELED),
+		"ECHILD":                                       reflect.ValueOf(syscall.ECHILD),
+		"ECHRNG":                                       reflect.ValueOf(syscall.ECHRNG),
+		"ECOMM":                                        reflect.ValueOf(syscall.ECOMM),
+		"ECONNABORTED":                                 reflect.ValueOf(syscall.ECONNABORTED),
+		"ECONNREFUSED":                                 reflect.ValueOf(syscall.ECONNREFUSED),
+		"ECONNRESET":                                   reflect.ValueOf(syscall.ECONNRESET),
+		"EDEADLK":                                      reflect.ValueOf(syscall.EDEADLK),
+		"EDEADLOCK":                                    reflect.ValueOf(syscall.EDEADLOCK),
+		"EDESTADDRREQ":                                 reflect.ValueOf(syscall.EDESTADDRREQ),
+		"EDOM":                                         reflect.ValueOf(syscall.EDOM),
+		"EDOTDOT":                                      reflect.ValueOf(syscall.EDOTDOT),
+		"EDQUOT":                                       reflect.ValueOf(syscall.EDQUOT),
+		"EEXIST":                                       reflect.ValueOf(syscall.EEXIST),
+		"EFAULT":                                       reflect.ValueOf(syscall.EFAULT),
+		"EFBIG":                                        reflect.ValueOf(syscall.EFBIG),
+		"EHOSTDOWN":                                    reflect.ValueOf(syscall.EHOSTDOWN),
+		"EHOSTUNREACH":                                 reflect.ValueOf(syscall.EHOSTUNREACH),
+		"EIDRM":                                        reflect.ValueOf(syscall.EIDRM),
+		"EILSEQ":                                       reflect.ValueOf(syscall.EILSEQ),
+		"EINPROGRESS":                                  reflect.ValueOf(syscall.EINPROGRESS),
+		"EINTR":                                        reflect.ValueOf(syscall.EINTR),
+		"EINVAL":                                       reflect.ValueOf(syscall.EINVAL),
+		"EIO":                                          reflect.ValueOf(syscall.EIO),
+		"EISCONN":                                      reflect.ValueOf(syscall.EISCONN),
+		"EISDIR":                                       reflect.ValueOf(syscall.EISDIR),
+		"EISNAM":                                       reflect.ValueOf(syscall.EISNAM),
+		"EKEYEXPIRED":                                  reflect.ValueOf(syscall.EKEYEXPIRED),
+		"EKEYREJECTED":                                 reflect.ValueOf(syscall.EKEYREJECTED),
+		"EKEYREVOKED":                                  reflect.ValueOf(syscall.EKEYREVOKED),
+		"EL2HLT":                                       reflect.ValueOf(syscall.EL2HLT),
+		"EL2NSYNC":                                     reflect.ValueOf(syscall.EL2NSYNC),
+		"EL3HLT":                                       reflect.ValueOf(syscall.EL3HLT),
+		"EL3RST":                                       reflect.ValueOf(syscall.EL3RST),
+		"ELIBACC":                                      reflect.ValueOf(syscall.ELIBACC),
+		"ELIBBAD":                                      reflect.ValueOf(syscall.ELIBBAD),
+		"ELIBEXEC":                                     reflect.ValueOf(syscall.ELIBEXEC),
+		"ELIBMAX":                                      reflect.ValueOf(syscall.ELIBMAX),
+		"ELIBSCN":                                      reflect.ValueOf(syscall.ELIBSCN),
+		"ELNRNG":                                       reflect.ValueOf(syscall.ELNRNG),
+		"ELOOP":                                        reflect.ValueOf(syscall.ELOOP),
+		"EMEDIUMTYPE":                                  reflect.ValueOf(syscall.EMEDIUMTYPE),
+		"EMFILE":                                       reflect.ValueOf(syscall.EMFILE),
+		"EMLINK":                                       reflect.ValueOf(syscall.EMLINK),
+		"EMSGSIZE":                                     reflect.ValueOf(syscall.EMSGSIZE),
+		"EMULTIHOP":                                    reflect.ValueOf(syscall.EMULTIHOP),
+		"ENAMETOOLONG":                                 reflect.ValueOf(syscall.ENAMETOOLONG),
+		"ENAVAIL":                                      reflect.ValueOf(syscall.ENAVAIL),
+		"ENETDOWN":                                     reflect.ValueOf(syscall.ENETDOWN),
+		"ENETRESET":                                    reflect.ValueOf(syscall.ENETRESET),
+		"ENETUNREACH":                                  reflect.ValueOf(syscall.ENETUNREACH),
+		"ENFILE":                                       reflect.ValueOf(syscall.ENFILE),
+		"ENOANO":                                       reflect.ValueOf(syscall.ENOANO),
+		"ENOBUFS":                                      reflect.ValueOf(syscall.ENOBUFS),
+		"ENOCSI":                                       reflect.ValueOf(syscall.ENOCSI),
+		"ENODATA":                                      reflect.ValueOf(syscall.ENODATA),
+		"ENODEV":                                       reflect.ValueOf(syscall.ENODEV),
+		"ENOENT":                                       reflect.ValueOf(syscall.ENOENT),
+		"ENOEXEC":                                      reflect.ValueOf(syscall.ENOEXEC),
+		"ENOKEY":                                       reflect.ValueOf(syscall.ENOKEY),
+		"ENOLCK":                                       reflect.ValueOf(syscall.ENOLCK),
+		"ENOLINK":                                      reflect.ValueOf(syscall.ENOLINK),
+		"ENOMEDIUM":                                    reflect.ValueOf(syscall.ENOMEDIUM),
+		"ENOMEM":                                       reflect.ValueOf(syscall.ENOMEM),
+		"ENOMSG":                                       reflect.ValueOf(syscall.ENOMSG),
+		"ENONET":                                       reflect.ValueOf(syscall.ENONET),
+		"ENOPKG":                                       reflect.ValueOf(syscall.ENOPKG),
+		"ENOPROTOOPT":                                  reflect.ValueOf(syscall.ENOPROTOOPT),
+		"ENOSPC":                                       reflect.ValueOf(syscall.ENOSPC),
+		"ENOSR":                                        reflect.ValueOf(syscall.ENOSR),
+		"ENOSTR":                                       reflect.ValueOf(syscall.ENOSTR),
+		"ENOSYS":                                       reflect.ValueOf(syscall.ENOSYS),
+		"ENOTBLK":                                      reflect.ValueOf(syscall.ENOTBLK),
+		"ENOTCONN":                                     reflect.ValueOf(syscall.ENOTCONN),
+		"ENOTDIR":                                      reflect.ValueOf(syscall.ENOTDIR),
+		"ENOTEMPTY":                                    reflect.ValueOf(syscall.ENOTEMPTY),
+		"ENOTNAM":                                      reflect.ValueOf(syscall.ENOTNAM),
+		"ENOTRECOVERABLE":                              reflect.ValueOf(syscall.ENOTRECOVERABLE),
+		"ENOTSOCK":                                     reflect.ValueOf(syscall.ENOTSOCK),
+		"ENOTSUP":                                      reflect.ValueOf(syscall.ENOTSUP),
+		"ENOTTY":                                       reflect.ValueOf(syscall.ENOTTY),
+		"ENOTUNIQ":                                     reflect.ValueOf(syscall.ENOTUNIQ),
+		"ENXIO":                                        reflect.ValueOf(syscall.ENXIO),
+		"EOPNOTSUPP":                                   reflect.ValueOf(syscall.EOPNOTSUPP),
+		"EOVERFLOW":                                    reflect.ValueOf(syscall.EOVERFLOW),
+		"EOWNERDEAD":                                   reflect.ValueOf(syscall.EOWNERDEAD),
+		"EPERM":                                        reflect.ValueOf(syscall.EPERM),
+		"EPFNOSUPPORT":                                 reflect.ValueOf(syscall.EPFNOSUPPORT),
+		"EPIPE":                                        reflect.ValueOf(syscall.EPIPE),
+		"EPROTO":                                       reflect.ValueOf(syscall.EPROTO),
+		"EPROTONOSUPPORT":                              reflect.ValueOf(syscall.EPROTONOSUPPORT),
+		"EPROTOTYPE":                                   reflect.ValueOf(syscall.EPROTOTYPE),
+		"ERANGE":                                       reflect.ValueOf(syscall.ERANGE),
+		"EREMCHG":                                      reflect.ValueOf(syscall.EREMCHG),
+		"EREMOTE":                                      reflect.ValueOf(syscall.EREMOTE),
+		"EREMOTEIO":                                    reflect.ValueOf(syscall.EREMOTEIO),
+		"ERESTART":                                     reflect.ValueOf(syscall.ERESTART),
+		"EROFS":                                        reflect.ValueOf(syscall.EROFS),
+		"ERROR_ACCESS_DENIED":                          reflect.ValueOf(syscall.ERROR_ACCESS_DENIED),
+		"ERROR_ALREADY_EXISTS":                         reflect.ValueOf(syscall.ERROR_ALREADY_EXISTS),
+		"ERROR_BROKEN_PIPE":                            reflect.ValueOf(syscall.ERROR_BROKEN_PIPE),
+		"ERROR_BUFFER_OVERFLOW":                        reflect.ValueOf(syscall.ERROR_BUFFER_OVERFLOW),
+		"ERROR_DIR_NOT_EMPTY":                          reflect.ValueOf(syscall.ERROR_DIR_NOT_EMPTY),
+		"ERROR_ENVVAR_NOT_FOUND":                       reflect.ValueOf(syscall.ERROR_ENVVAR_NOT_FOUND),
+		"ERROR_FILE_EXISTS":                            reflect.ValueOf(syscall.ERROR_FILE_EXISTS),
+		"ERROR_FILE_NOT_FOUND":                         reflect.ValueOf(syscall.ERROR_FILE_NOT_FOUND),
+		"ERROR_HANDLE_EOF":                             reflect.ValueOf(syscall.ERROR_HANDLE_EOF),
+		"ERROR_INSUFFICIENT_BUFFER":                    reflect.ValueOf(syscall.ERROR_INSUFFICIENT_BUFFER),
+		"ERROR_IO_PENDING":                             reflect.ValueOf(syscall.ERROR_IO_PENDING),
+		"ERROR_MOD_NOT_FOUND":                          reflect.ValueOf(syscall.ERROR_MOD_NOT_FOUND),
+		"ERROR_MORE_DATA":                              reflect.ValueOf(syscall.ERROR_MORE_DATA),
+		"ERROR_NETNAME_DELETED":                        reflect.ValueOf(syscall.ERROR_NETNAME_DELETED),
+		"ERROR_NOT_FOUND":                              reflect.ValueOf(syscall.ERROR_NOT_FOUND),
+		"ERROR_NO_MORE_FILES":                          reflect.ValueOf(syscall.ERROR_NO_MORE_FILES),
+		"ERROR_OPERATION_ABORTED":                      reflect.ValueOf(syscall.ERROR_OPERATION_ABORTED),
+		"ERROR_PATH_NOT_FOUND":                         reflect.ValueOf(syscall.ERROR_PATH_NOT_FOUND),
+		"ERROR_PRIVILEGE_NOT_HELD":                     reflect.ValueOf(syscall.ERROR_PRIVILEGE_NOT_HELD),
+		"ERROR_PROC_NOT_FOUND":                         reflect.ValueOf(syscall.ERROR_PROC_NOT_FOUND),
+		"ESHUTDOWN":                                    reflect.ValueOf(syscall.ESHUTDOWN),
+		"ESOCKTNOSUPPORT":                              reflect.ValueOf(syscall.ESOCKTNOSUPPORT),
+		"ESPIPE":                                       reflect.ValueOf(syscall.ESPIPE),
+		"ESRCH":                                        reflect.ValueOf(syscall.ESRCH),
+		"ESRMNT":                                       reflect.ValueOf(syscall.ESRMNT),
+		"ESTALE":                                       reflect.ValueOf(syscall.ESTALE),
+		"ESTRPIPE":                                     reflect.ValueOf(syscall.ESTRPIPE),
+		"ETIME":                                        reflect.ValueOf(syscall.ETIME),
+		"ETIMEDOUT":                                    reflect.ValueOf(syscall.ETIMEDOUT),
+		"ETOOMANYREFS":                                 reflect.ValueOf(syscall.ETOOMANYREFS),
+		"ETXTBSY":                                      reflect.ValueOf(syscall.ETXTBSY),
+		"EUCLEAN":                                      reflect.ValueOf(syscall.EUCLEAN),
+		"EUNATCH":                                      reflect.ValueOf(syscall.EUNATCH),
+		"EUSERS":                                       reflect.ValueOf(syscall.EUSERS),
+		"EWINDOWS":                                     reflect.ValueOf(syscall.EWINDOWS),
+		"EWOULDBLOCK":                                  reflect.ValueOf(syscall.EWOULDBLOCK),
+		"EXDEV":                                        reflect.ValueOf(syscall.EXDEV),
+		"EXFULL":                                       reflect.ValueOf(syscall.EXFULL),
+		"Environ":                                      reflect.ValueOf(syscall.Environ),
+		"EscapeArg":                                    reflect.ValueOf(syscall.EscapeArg),
+		"Exec":                                         reflect.ValueOf(syscall.Exec),
+		"Exit":                                         reflect.ValueOf(syscall.Exit),
+		"ExitProcess":                                  reflect.ValueOf(syscall.ExitProcess),
+		"FILE_ACTION_ADDED":                            reflect.ValueOf(syscall.FILE_ACTION_ADDED),
+		"FILE_ACTION_MODIFIED":                         reflect.ValueOf(syscall.FILE_ACTION_MODIFIED),
+		"FILE_ACTION_REMOVED":                          reflect.ValueOf(syscall.FILE_ACTION_REMOVED),
+		"FILE_ACTION_RENAMED_NEW_NAME":                 reflect.ValueOf(syscall.FILE_ACTION_RENAMED_NEW_NAME),
+		"FILE_ACTION_RENAMED_OLD_NAME":                 reflect.ValueOf(syscall.FILE_ACTION_RENAMED_OLD_NAME),
+		"FILE_APPEND_DATA":                             reflect.ValueOf(syscall.FILE_APPEND_DATA),
+		"FILE_ATTRIBUTE_ARCHIVE":                       reflect.ValueOf(syscall.FILE_ATTRIBUTE_ARCHIVE),
+		"FILE_ATTRIBUTE_DIRECTORY":                     reflect.ValueOf(syscall.FILE_ATTRIBUTE_DIRECTORY),
+		"FILE_ATTRIBUTE_HIDDEN":                        reflect.ValueOf(syscall.FILE_ATTRIBUTE_HIDDEN),
+		"FILE_ATTRIBUTE_NORMAL":                        reflect.ValueOf(syscall.FILE_ATTRIBUTE_NORMAL),
+		"FILE_ATTRIBUTE_READONLY":                      reflect.ValueOf(syscall.FILE_ATTRIBUTE_READONLY),
+		"FILE_ATTRIBUTE_REPARSE_POINT":                 reflect.ValueOf(syscall.FILE_ATTRIBUTE_REPARSE_POINT),
+		"FILE_ATTRIBUTE_SYSTEM":                        reflect.ValueOf(syscall.FILE_ATTRIBUTE_SYSTEM),
+		"FILE_BEGIN":                                   reflect.ValueOf(syscall.FILE_BEGIN),
+		"FILE_CURRENT":                                 reflect.ValueOf(syscall.FILE_CURRENT),
+		"FILE_END":                                     reflect.ValueOf(syscall.FILE_END),
+		"FILE_FLAG_BACKUP_SEMANTICS":                   reflect.ValueOf(syscall.FILE_FLAG_BACKUP_SEMANTICS),
+		"FILE_FLAG_OPEN_REPARSE_POINT":                 reflect.ValueOf(syscall.FILE_FLAG_OPEN_REPARSE_POINT),
+		"FILE_FLAG_OVERLAPPED":                         reflect.ValueOf(syscall.FILE_FLAG_OVERLAPPED),
+		"FILE_LIST_DIRECTORY":                          reflect.ValueOf(syscall.FILE_LIST_DIRECTORY),
+		"FILE_MAP_COPY":                                reflect.ValueOf(syscall.FILE_MAP_COPY),
+		"FILE_MAP_EXECUTE":                             reflect.ValueOf(syscall.FILE_MAP_EXECUTE),
+		"FILE_MAP_READ":                                reflect.ValueOf(syscall.FILE_MAP_READ),
+		"FILE_MAP_WRITE":                               reflect.ValueOf(syscall.FILE_MAP_WRITE),
+		"FILE_NOTIFY_CHANGE_ATTRIBUTES":                reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_ATTRIBUTES),
+		"FILE_NOTIFY_CHANGE_CREATION":                  reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_CREATION),
+		"FILE_NOTIFY_CHANGE_DIR_NAME":                  reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_DIR_NAME),
+		"FILE_NOTIFY_CHANGE_FILE_NAME":                 reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_FILE_NAME),
+		"FILE_NOTIFY_CHANGE_LAST_ACCESS":               reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_LAST_ACCESS),
+		"FILE_NOTIFY_CHANGE_LAST_WRITE":                reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_LAST_WRITE),
+		"FILE_NOTIFY_CHANGE_SIZE":                      reflect.ValueOf(syscall.FILE_NOTIFY_CHANGE_SIZE),
+		"FILE_SHARE_DELETE":                            reflect.ValueOf(syscall.FILE_SHARE_DELETE),
+		"FILE_SHARE_READ":                              reflect.ValueOf(syscall.FILE_SHARE_READ),
+		"FILE_SHARE_WRITE":                             reflect.ValueOf(syscall.FILE_SHARE_WRITE),
+		"FILE_SKIP_COMPLETION_PORT_ON_SUCCESS":         reflect.ValueOf(syscall.FILE_SKIP_COMPLETION_PORT_ON_SUCCESS),
+		"FILE_SKIP_SET_EVENT_ON_HANDLE":                reflect.ValueOf(syscall.FILE_SKIP_SET_EVENT_ON_HANDLE),
+		"FILE_TYPE_CHAR":                               reflect.ValueOf(syscall.FILE_TYPE_CHAR),
+		"FILE_TYPE_DISK":                               reflect.ValueOf(syscall.FILE_TYPE_DISK),
+		"FILE_TYPE_PIPE":                               reflect.ValueOf(syscall.FILE_TYPE_PIPE),
+		"FILE_TYPE_REMOTE":                             reflect.ValueOf(syscall.FILE_TYPE_REMOTE),
+		"FILE_TYPE_UNKNOWN":                            reflect.ValueOf(syscall.FILE_TYPE_UNKNOWN),
+		"FILE_WRITE_ATTRIBUTES":                        reflect.ValueOf(syscall.FILE_WRITE_ATTRIBUTES),
+		"FORMAT_MESSAGE_ALLOCATE_BUFFER":               reflect.ValueOf(syscall.FORMAT_MESSAGE_ALLOCATE_BUFFER),
+		"FORMAT_MESSAGE_ARGUMENT_ARRAY":                reflect.ValueOf(syscall.FORMAT_MESSAGE_ARGUMENT_ARRAY),
+		"FORMAT_MESSAGE_FROM_HMODULE":                  reflect.ValueOf(syscall.FORMAT_MESSAGE_FROM_HMODULE),
+		"FORMAT_MESSAGE_FROM_STRING":                   reflect.ValueOf(syscall.FORMAT_MESSAGE_FROM_STRING),
+		"FORMAT_MESSAGE_FROM_SYSTEM":                   reflect.ValueOf(syscall.FORMAT_MESSAGE_FROM_SYSTEM),
+		"FORMAT_MESSAGE_IGNORE_INSERTS":                reflect.ValueOf(syscall.FORMAT_MESSAGE_IGNORE_INSERTS),
+		"FORMAT_MESSAGE_MAX_WIDTH_MASK":                reflect.ValueOf(syscall.FORMAT_MESSAGE_MAX_WIDTH_MASK),
+		"FSCTL_GET_REPARSE_POINT":                      reflect.ValueOf(syscall.FSCTL_GET_REPARSE_POINT),
+		"Fchdir":                                       reflect.ValueOf(syscall.Fchdir),
+		"Fchmod":                                       reflect.ValueOf(syscall.Fchmod),
+		"Fchown":                                       reflect.ValueOf(syscall.Fchown),
+		"FindClose":                                    reflect.ValueOf(syscall.FindClose),
+		"FindFirstFile":                                reflect.ValueOf(syscall.FindFirstFile),
+		"FindNextFile":                                 reflect.ValueOf(syscall.FindNextFile),
+		"FlushFileBuffers":                             reflect.ValueOf(syscall.FlushFileBuffers),
+		"FlushViewOfFile":                              reflect.ValueOf(syscall.FlushViewOfFile),
+		"ForkLock":                                     reflect.ValueOf(&syscall.ForkLock).Elem(),
+		"FormatMessage":                                reflect.ValueOf(syscall.FormatMessage),
+		"FreeAddrInfoW":                                reflect.ValueOf(syscall.FreeAddrInfoW),
+		"FreeEnvironmentStrings":                       reflect.ValueOf(syscall.FreeEnvironmentStrings),
+		"FreeLibrary":                                  reflect.ValueOf(syscall.FreeLibrary),
+		"Fsync":                                        reflect.ValueOf(syscall.Fsync),
+		"Ftruncate":                                    reflect.ValueOf(syscall.Ftruncate),
+		"FullPath":                                     reflect.ValueOf(syscall.FullPath),
+		"GENERIC_ALL":                                  reflect.ValueOf(syscall.GENERIC_ALL),
+		"GENERIC_EXECUTE":                              reflect.ValueOf(syscall.GENERIC_EXECUTE),
+		"GENERIC_READ":                                 reflect.ValueOf(uint32(syscall.GENERIC_READ)),
+		"GENERIC_WRITE":                                reflect.ValueOf(syscall.GENERIC_WRITE),
+		"GetAcceptExSockaddrs":                         reflect.ValueOf(syscall.GetAcceptExSockaddrs),
+		"GetAdaptersInfo":                              reflect.ValueOf(syscall.GetAdaptersInfo),
+		"GetAddrInfoW":                                 reflect.ValueOf(syscall.GetAddrInfoW),
+		"GetCommandLine":                               reflect.ValueOf(syscall.GetCommandLine),
+		"GetComputerName":                              reflect.ValueOf(syscall.GetComputerName),
+		"GetConsoleMode":                               reflect.ValueOf(syscall.GetConsoleMode),
+		"GetCurrentDirectory":                          reflect.ValueOf(syscall.GetCurrentDirectory),
+		"GetCurrentProcess":                            reflect.ValueOf(syscall.GetCurrentProcess),
+		"GetEnvironmentStrings":                        reflect.ValueOf(syscall.GetEnvironmentStrings),
+		"GetEnvironmentVariable":                       reflect.ValueOf(syscall.GetEnvironmentVariable),
+		"GetExitCodeProcess":                           reflect.ValueOf(syscall.GetExitCodeProcess),
+		"GetFileAttributes":                            reflect.ValueOf(syscall.GetFileAttributes),
+		"GetFileAttributesEx":                          reflect.ValueOf(syscall.GetFileAttributesEx),
+		"GetFileExInfoStandard":                        reflect.ValueOf(syscall.GetFileExInfoStandard),
+		"GetFileExMaxInfoLevel":                        reflect.ValueOf(syscall.GetFileExMaxInfoLevel),
+		"GetFileInformationByHandle":                   reflect.ValueOf(syscall.GetFileInformationByHandle),
+		"GetFileType":                                  reflect.ValueOf(syscall.GetFileType),
+		"GetFullPathName":                              reflect.ValueOf(syscall.GetFullPathName),
+		"GetHostByName":                                reflect.ValueOf(syscall.GetHostByName),
+		"GetIfEntry":                                   reflect.ValueOf(syscall.GetIfEntry),
+		"GetLastError":                                 reflect.ValueOf(syscall.GetLastError),
+		"GetLengthSid":                                 reflect.ValueOf(syscall.GetLengthSid),
+		"GetLongPathName":                              reflect.ValueOf(syscall.GetLongPathName),
+		"GetProcAddress":                               reflect.ValueOf(syscall.GetProcAddress),
+		"GetProcessTimes":                              reflect.ValueOf(syscall.GetProcessTimes),
+		"GetProtoByName":                               reflect.ValueOf(syscall.GetProtoByName),
+		"GetQueuedCompletionStatus":                    reflect.ValueOf(syscall.GetQueuedCompletionStatus),
+		"GetServByName":                                reflect.ValueOf(syscall.GetServByName),
+		"GetShortPathName":                             reflect.ValueOf(syscall.GetShortPathName),
+		"GetStartupInfo":                               reflect.ValueOf(syscall.GetStartupInfo),
+		"GetStdHandle":                                 reflect.ValueOf(syscall.GetStdHandle),
+		"GetSystemTimeAsFileTime":                      reflect.ValueOf(syscall.GetSystemTimeAsFileTime),
+		"GetTempPath":                                  reflect.ValueOf(syscall.GetTempPath),
+		"GetTimeZoneInformation":                       reflect.ValueOf(syscall.GetTimeZoneInformation),
+		"GetTokenInformation":                          reflect.ValueOf(syscall.GetTokenInformation),
+		"GetUserNameEx":                                reflect.ValueOf(syscall.GetUserNameEx),
+		"GetUserProfileDirectory":                      reflect.ValueOf(syscall.GetUserProfileDirectory),
+		"GetVersion":                                   reflect.ValueOf(syscall.GetVersion),
+		"Getegid":                                      reflect.ValueOf(syscall.Getegid),
+		"Getenv":                                       reflect.ValueOf(syscall.Getenv),
+		"Geteuid":                                      reflect.ValueOf(syscall.Geteuid),
+		"Getgid":                                       reflect.ValueOf(syscall.Getgid),
+		"Getgroups":                                    reflect.ValueOf(syscall.Getgroups),
+		"Getpagesize":                                  reflect.ValueOf(syscall.Getpagesize),
+		"Getpeername":                                  reflect.ValueOf(syscall.Getpeername),
+		"Getpid":                                       reflect.ValueOf(syscall.Getpid),
+		"Getppid":                                      reflect.ValueOf(syscall.Getppid),
+		"Getsockname":                                  reflect.ValueOf(syscall.Getsockname),
+		"Getsockopt":                                   reflect.ValueOf(syscall.Getsockopt),
+		"GetsockoptInt":                                reflect.ValueOf(syscall.GetsockoptInt),
+		"Gettimeofday":                                 reflect.ValueOf(syscall.Gettimeofday),
+		"Getuid":                                       reflect.ValueOf(syscall.Getuid),
+		"Getwd":                                        reflect.ValueOf(syscall.Getwd),
+		"HANDLE_FLAG_INHERIT":                          reflect.ValueOf(syscall.HANDLE_FLAG_INHERIT),
+		"HKEY_CLASSES_ROOT":                            reflect.ValueOf(uint32(syscall.HKEY_CLASSES_ROOT)),
+		"HKEY_CURRENT_CONFIG":                          reflect.ValueOf(uint32(syscall.HKEY_CURRENT_CONFIG)),
+		"HKEY_CURRENT_USER":                            reflect.ValueOf(uint32(syscall.HKEY_CURRENT_USER)),
+		"HKEY_DYN_DATA":                                reflect.ValueOf(uint32(syscall.HKEY_DYN_DATA)),
+		"HKEY_LOCAL_MACHINE":                           reflect.ValueOf(uint32(syscall.HKEY_LOCAL_MACHINE)),
+		"HKEY_PERFORMANCE_DATA":                        reflect.ValueOf(uint32(syscall.HKEY_PERFORMANCE_DATA)),
+		"HKEY_USERS":                                   reflect.ValueOf(uint32(syscall.HKEY_USERS)),
+		"IFF_BROADCAST":                                reflect.ValueOf(syscall.IFF_BROADCAST),
+		"IFF_LOOPBACK":                                 reflect.ValueOf(syscall.IFF_LOOPBACK),
+		"IFF_MULTICAST":                                reflect.ValueOf(syscall.IFF_MULTICAST),
+		"IFF_POINTTOPOINT":                             reflect.ValueOf(syscall.IFF_POINTTOPOINT),
+		"IFF_UP":                                       reflect.ValueOf(syscall.IFF_UP),
+		"IGNORE":                                       reflect.ValueOf(syscall.IGNORE),
+		"INFINITE":                                     reflect.ValueOf(uint32(syscall.INFINITE)),
+		"INVALID_FILE_ATTRIBUTES":                      reflect.ValueOf(uint32(syscall.INVALID_FILE_ATTRIBUTES)),
+		"IOC_IN":                                       reflect.ValueOf(uint32(syscall.IOC_IN)),
+		"IOC_INOUT":                                    reflect.ValueOf(uint32(syscall.IOC_INOUT)),
+		"IOC_OUT":                                      reflect.ValueOf(syscall.IOC_OUT),
+		"IOC_VENDOR":                                   reflect.ValueOf(syscall.IOC_VENDOR),
+		"IOC_WS2":                                      reflect.ValueOf(syscall.IOC_WS2),
+		"IO_REPARSE_TAG_SYMLINK":                       reflect.ValueOf(uint32(syscall.IO_REPARSE_TAG_SYMLINK)),
+		"IPPROTO_IP":                                   reflect.ValueOf(syscall.IPPROTO_IP),
+		"IPPROTO_IPV6":                                 reflect.ValueOf(syscall.IPPROTO_IPV6),
+		"IPPROTO_TCP":                                  reflect.ValueOf(syscall.IPPROTO_TCP),
+		"IPPROTO_UDP":                                  reflect.ValueOf(syscall.IPPROTO_UDP),
+		"IPV6_JOIN_GROUP":                              reflect.ValueOf(syscall.IPV6_JOIN_GROUP),
+		"IPV6_LEAVE_GROUP":                             reflect.ValueOf(syscall.IPV6_LEAVE_GROUP),
+		"IPV6_MULTICAST_HOPS":                          reflect.ValueOf(syscall.IPV6_MULTICAST_HOPS),
+		"IPV6_MULTICAST_IF":                            reflect.ValueOf(syscall.IPV6_MULTICAST_IF),
+		"IPV6_MULTICAST_LOOP":                          reflect.ValueOf(syscall.IPV6_MULTICAST_LOOP),
+		"IPV6_UNICAST_HOPS":                            reflect.ValueOf(syscall.IPV6_UNICAST_HOPS),
+		"IPV6_V6ONLY":                                  reflect.ValueOf(syscall.IPV6_V6ONLY),
+		"IP_ADD_MEMBERSHIP":                            reflect.ValueOf(syscall.IP_ADD_MEMBERSHIP),
+		"IP_DROP_MEMBERSHIP":                           reflect.ValueOf(syscall.IP_DROP_MEMBERSHIP),
+		"IP_MULTICAST_IF":                              reflect.ValueOf(syscall.IP_MULTICAST_IF),
+		"IP_MULTICAST_LOOP":                            reflect.ValueOf(syscall.IP_MULTICAST_LOOP),
+		"IP_MULTICAST_TTL":                             reflect.ValueOf(syscall.IP_MULTICAST_TTL),
+		"IP_TOS":                                       reflect.ValueOf(syscall.IP_TOS),
+		"IP_TTL":                                       reflect.ValueOf(syscall.IP_TTL),
+		"ImplementsGetwd":                              reflect.ValueOf(syscall.ImplementsGetwd),
+		"InvalidHandle":                                reflect.ValueOf(uint64(syscall.InvalidHandle)),
+		"KEY_ALL_ACCESS":                               reflect.ValueOf(syscall.KEY_ALL_ACCESS),
+		"KEY_CREATE_LINK":                              reflect.ValueOf(syscall.KEY_CREATE_LINK),
+		"KEY_CREATE_SUB_KEY":                           reflect.ValueOf(syscall.KEY_CREATE_SUB_KEY),
+		"KEY_ENUMERATE_SUB_KEYS":                       reflect.ValueOf(syscall.KEY_ENUMERATE_SUB_KEYS),
+		"KEY_EXECUTE":                                  reflect.ValueOf(syscall.KEY_EXECUTE),
+		"KEY_NOTIFY":                                   reflect.ValueOf(syscall.KEY_NOTIFY),
+		"KEY_QUERY_VALUE":                              reflect.ValueOf(syscall.KEY_QUERY_VALUE),
+		"KEY_READ":                                     reflect.ValueOf(syscall.KEY_READ),
+		"KEY_SET_VALUE":                                reflect.ValueOf(syscall.KEY_SET_VALUE),
+		"KEY_WOW64_32KEY":                              reflect.ValueOf(syscall.KEY_WOW64_32KEY),
+		"KEY_WOW64_64KEY":                              reflect.ValueOf(syscall.KEY_WOW64_64KEY),
+		"KEY_WRITE":                                    reflect.ValueOf(syscall.KEY_WRITE),
+		"LANG_ENGLISH":                                 reflect.ValueOf(syscall.LANG_ENGLISH),
+		"LAYERED_PROTOCOL":                             reflect.ValueOf(syscall.LAYERED_PROTOCOL),
+		"Lchown":                                       reflect.ValueOf(syscall.Lchown),
+		"Link":                                         reflect.ValueOf(syscall.Link),
+		"Listen":                                       reflect.ValueOf(syscall.Listen),
+		"LoadCancelIoEx":                               reflect.ValueOf(syscall.LoadCancelIoEx),
+		"LoadConnectEx":                                reflect.ValueOf(syscall.LoadConnectEx),
+		"LoadCreateSymbolicLink":                       reflect.ValueOf(syscall.LoadCreateSymbolicLink),
+		"LoadDLL":                                      reflect.ValueOf(syscall.LoadDLL),
+		"LoadGetAddrInfo":                              reflect.ValueOf(syscall.LoadGetAddrInfo),
+		"LoadLibrary":                                  reflect.ValueOf(syscall.LoadLibrary),
+		"LoadSetFileCompletionNotificationModes":       reflect.ValueOf(syscall.LoadSetFileCompletionNotificationModes),
+		"LocalFree":                                    reflect.ValueOf(syscall.LocalFree),
+		"LookupAccountName":                            reflect.ValueOf(syscall.LookupAccountName),
+		"LookupAccountSid":                             reflect.ValueOf(syscall.LookupAccountSid),
+		"LookupSID":                                    reflect.ValueOf(syscall.LookupSID),
+		"MAXIMUM_REPARSE_DATA_BUFFER_SIZE":             reflect.ValueOf(syscall.MAXIMUM_REPARSE_DATA_BUFFER_SIZE),
+		"MAXLEN_IFDESCR":                               reflect.ValueOf(syscall.MAXLEN_IFDESCR),
+		"MAXLEN_PHYSADDR":                              reflect.ValueOf(syscall.MAXLEN_PHYSADDR),
+		"MAX_ADAPTER_ADDRESS_LENGTH":                   reflect.ValueOf(syscall.MAX_ADAPTER_ADDRESS_LENGTH),
+		"MAX_ADAPTER_DESCRIPTION_LENGTH":               reflect.ValueOf(syscall.MAX_ADAPTER_DESCRIPTION_LENGTH),
+		"MAX_ADAPTER_NAME_LENGTH":                      reflect.ValueOf(syscall.MAX_ADAPTER_NAME_LENGTH),
+		"MAX_COMPUTERNAME_LENGTH":                      reflect.ValueOf(syscall.MAX_COMPUTERNAME_LENGTH),
+		"MAX_INTERFACE_NAME_LEN":                       reflect.ValueOf(syscall.MAX_INTERFACE_NAME_LEN),
+		"MAX_LONG_PATH":                                reflect.ValueOf(syscall.MAX_LONG_PATH),
+		"MAX_PATH":                                     reflect.ValueOf(syscall.MAX_PATH),
+		"MAX_PROTOCOL_CHAIN":                           reflect.ValueOf(syscall.MAX_PROTOCOL_CHAIN),
+		"MapViewOfFile":                                reflect.ValueOf(syscall.MapViewOfFile),
+		"MaxTokenInfoClass":                            reflect.ValueOf(syscall.MaxTokenInfoClass),
+		"Mkdir":                                        reflect.ValueOf(syscall.Mkdir),
+		"MoveFile":                                     reflect.ValueOf(syscall.MoveFile),
+		"MustLoadDLL":                                  reflect.ValueOf(syscall.MustLoadDLL),
+		"NameCanonical":                                reflect.ValueOf(syscall.NameCanonical),
+		"NameCanonicalEx":                              reflect.ValueOf(syscall.NameCanonicalEx),
+		"NameDisplay":                                  reflect.ValueOf(syscall.NameDisplay),
+		"NameDnsDomain":                                reflect.ValueOf(syscall.NameDnsDomain),
+		"NameFullyQualifiedDN":                         reflect.ValueOf(syscall.NameFullyQualifiedDN),
+		"NameSamCompatible":                            reflect.ValueOf(syscall.NameSamCompatible),
+		"NameServicePrincipal":                         reflect.ValueOf(syscall.NameServicePrincipal),
+		"NameUniqueId":                                 reflect.ValueOf(syscall.NameUniqueId),
+		"NameUnknown":                                  reflect.ValueOf(syscall.NameUnknown),
+		"NameUserPrincipal":                            reflect.ValueOf(syscall.NameUserPrincipal),
+		"NetApiBufferFree":                             reflect.ValueOf(syscall.NetApiBufferFree),
+		"NetGetJoinInformation":                        reflect.ValueOf(syscall.NetGetJoinInformation),
+		"NetSetupDomainName":                           reflect.ValueOf(syscall.NetSetupDomainName),
+		"NetSetupUnjoined":                             reflect.ValueOf(syscall.NetSetupUnjoined),
+		"NetSetupUnknownStatus":                        reflect.ValueOf(syscall.NetSetupUnknownStatus),
+		"NetSetupWorkgroupName":                        reflect.ValueOf(syscall.NetSetupWorkgroupName),
+		"NetUserGetInfo":                               reflect.ValueOf(syscall.NetUserGetInfo),
+		"NewCallback":                                  reflect.ValueOf(syscall.NewCallback),
+		"NewCallbackCDecl":                             reflect.ValueOf(syscall.NewCallbackCDecl),
+		"NewLazyDLL":                                   reflect.ValueOf(syscall.NewLazyDLL),
+		"NsecToFiletime":                               reflect.ValueOf(syscall.NsecToFiletime),
+		"NsecToTimespec":                               reflect.ValueOf(syscall.NsecToTimespec),
+		"NsecToTimeval":                                reflect.ValueOf(syscall.NsecToTimeval),
+		"Ntohs":                                        reflect.ValueOf(syscall.Ntohs),
+		"OID_PKIX_KP_SERVER_AUTH":                      reflect.ValueOf(&syscall.OID_PKIX_KP_SERVER_AUTH).Elem(),
+		"OID_SERVER_GATED_CRYPTO":                      reflect.ValueOf(&syscall.OID_SERVER_GATED_CRYPTO).Elem(),
+		"OID_SGC_NETSCAPE":                             reflect.ValueOf(&syscall.OID_SGC_NETSCAPE).Elem(),
+		"OPEN_ALWAYS":                                  reflect.ValueOf(syscall.OPEN_ALWAYS),
+		"OPEN_EXISTING":                                reflect.ValueOf(syscall.OPEN_EXISTING),
+		"O_APPEND":                                     reflect.ValueOf(syscall.O_APPEND),
+		"O_ASYNC":                                      reflect.ValueOf(syscall.O_ASYNC),
+		"O_CLOEXEC":                                    reflect.ValueOf(syscall.O_CLOEXEC),
+		"O_CREAT":                                      reflect.ValueOf(syscall.O_CREAT),
+		"O_EXCL":                                       reflect.ValueOf(syscall.O_EXCL),
+		"O_NOCTTY":                                     reflect.ValueOf(syscall.O_NOCTTY),
+		"O_NONBLOCK":                                   reflect.ValueOf(syscall.O_NONBLOCK),
+		"O_RDONLY":                                     reflect.ValueOf(syscall.O_RDONLY),
+		"O_RDWR":                                       reflect.ValueOf(syscall.O_RDWR),
+		"O_SYNC":                                       reflect.ValueOf(syscall.O_SYNC),
+		"O_TRUNC":                                      reflect.ValueOf(syscall.O_TRUNC),
+		"O_WRONLY":                                     reflect.ValueOf(syscall.O_WRONLY),
+		"Open":                                         reflect.ValueOf(syscall.Open),
+		"OpenCurrentProcessToken":                      reflect.ValueOf(syscall.OpenCurrentProcessToken),
+		"OpenProcess":                                  reflect.ValueOf(syscall.OpenProcess),
+		"OpenProcessToken":                             reflect.ValueOf(syscall.OpenProcessToken),
+		"PAGE_EXECUTE_READ":                            reflect.ValueOf(syscall.PAGE_EXECUTE_READ),
+		"PAGE_EXECUTE_READWRITE":                       reflect.ValueOf(syscall.PAGE_EXECUTE_READWRITE),
+		"PAGE_EXECUTE_WRITECOPY":                       reflect.ValueOf(syscall.PAGE_EXECUTE_WRITECOPY),
+		"PAGE_READONLY":                                reflect.ValueOf(syscall.PAGE_READONLY),
+		"PAGE_READWRITE":                               reflect.ValueOf(syscall.PAGE_READWRITE),
+		"PAGE_WRITECOPY":                               reflect.ValueOf(syscall.PAGE_WRITECOPY),
+		"PFL_HIDDEN":                                   reflect.ValueOf(syscall.PFL_HIDDEN),
+		"PFL_MATCHES_PROTOCOL_ZERO":                    reflect.ValueOf(syscall.PFL_MATCHES_PROTOCOL_ZERO),
+		"PFL_MULTIPLE_PROTO_ENTRIES":                   reflect.ValueOf(syscall.PFL_MULTIPLE_PROTO_ENTRIES),
+		"PFL_NETWORKDIRECT_PROVIDER":                   reflect.ValueOf(syscall.PFL_NETWORKDIRECT_PROVIDER),
+		"PFL_RECOMMENDED_PROTO_ENTRY":                  reflect.ValueOf(syscall.PFL_RECOMMENDED_PROTO_ENTRY),
+		"PKCS_7_ASN_ENCODING":                          reflect.ValueOf(syscall.PKCS_7_ASN_ENCODING),
+		"PROCESS_QUERY_INFORMATION":                    reflect.ValueOf(syscall.PROCESS_QUERY_INFORMATION),
+		"PROCESS_TERMINATE":                            reflect.ValueOf(syscall.PROCESS_TERMINATE),
+		"PROV_DH_SCHANNEL":                             reflect.ValueOf(syscall.PROV_DH_SCHANNEL),
+		"PROV_DSS":                                     reflect.ValueOf(syscall.PROV_DSS),
+		"PROV_DSS_DH":                                  reflect.ValueOf(syscall.PROV_DSS_DH),
+		"PROV_EC_ECDSA_FULL":                           reflect.ValueOf(syscall.PROV_EC_ECDSA_FULL),
+		"PROV_EC_ECDSA_SIG":                            reflect.ValueOf(syscall.PROV_EC_ECDSA_SIG),
+		"PROV_EC_ECNRA_FULL":                           reflect.ValueOf(syscall.PROV_EC_ECNRA_FULL),
+		"PROV_EC_ECNRA_SIG":                            reflect.ValueOf(syscall.PROV_EC_ECNRA_SIG),
+		"PROV_FORTEZZA":                                reflect.ValueOf(syscall.PROV_FORTEZZA),
+		"PROV_INTEL_SEC":                               reflect.ValueOf(syscall.PROV_INTEL_SEC),
+		"PROV_MS_EXCHANGE":                             reflect.ValueOf(syscall.PROV_MS_EXCHANGE),
+		"PROV_REPLACE_OWF":                             reflect.ValueOf(syscall.PROV_REPLACE_OWF),
+		"PROV_RNG":                                     reflect.ValueOf(syscall.PROV_RNG),
+		"PROV_RSA_AES":                                 reflect.ValueOf(syscall.PROV_RSA_AES),
+		"PROV_RSA_FULL":                                reflect.ValueOf(syscall.PROV_RSA_FULL),
+		"PROV_RSA_SCHANNEL":                            reflect.ValueOf(syscall.PROV_RSA_SCHANNEL),
+		"PROV_RSA_SIG":                                 reflect.ValueOf(syscall.PROV_RSA_SIG),
+		"PROV_SPYRUS_LYNKS":                            reflect.ValueOf(syscall.PROV_SPYRUS_LYNKS),
+		"PROV_SSL":                                     reflect.ValueOf(syscall.PROV_SSL),
+		"Pipe":                                         reflect.ValueOf(syscall.Pipe),
+		"PostQueuedCompletionStatus":                   reflect.ValueOf(syscall.PostQueuedCompletionStatus),
+		"Process32First":                               reflect.ValueOf(syscall.Process32First),
+		"Process32Next":                                reflect.ValueOf(syscall.Process32Next),
+		"REG_BINARY":                                   reflect.ValueOf(syscall.REG_BINARY),
+		"REG_DWORD":                                    reflect.ValueOf(syscall.REG_DWORD),
+		"REG_DWORD_BIG_ENDIAN":                         reflect.ValueOf(syscall.REG_DWORD_BIG_ENDIAN),
+		"REG_DWORD_LITTLE_ENDIAN":                      reflect.ValueOf(syscall.REG_DWORD_LITTLE_ENDIAN),
+		"REG_EXPAND_SZ":                                reflect.ValueOf(syscall.REG_EXPAND_SZ),
+		"REG_FULL_RESOURCE_DESCRIPTOR":                 reflect.ValueOf(syscall.REG_FULL_RESOURCE_DESCRIPTOR),
+		"REG_LINK":                                     reflect.ValueOf(syscall.REG_LINK),
+		"REG_MULTI_SZ":                                 reflect.ValueOf(syscall.REG_MULTI_SZ),
+		"REG_NONE":                                     reflect.ValueOf(syscall.REG_NONE),
+		"REG_QWORD":                                    reflect.ValueOf(syscall.REG_QWORD),
+		"REG_QWORD_LITTLE_ENDIAN":                      reflect.ValueOf(syscall.REG_QWORD_LITTLE_ENDIAN),
+		"REG_RESOURCE_LIST":                            reflect.ValueOf(syscall.REG_RESOURCE_LIST),
+		"REG_RESOURCE_REQUIREMENTS_LIST":               reflect.ValueOf(syscall.REG_RESOURCE_REQUIREMENTS_LIST),
+		"REG_SZ":                                       reflect.ValueOf(syscall.REG_SZ),
+		"Read":                                         reflect.ValueOf(syscall.Read),
+		"ReadConsole":                                  reflect.ValueOf(syscall.ReadConsole),
+		"ReadDirectoryChanges":                         reflect.ValueOf(syscall.ReadDirectoryChanges),
+		"ReadFile":                                     reflect.ValueOf(syscall.ReadFile),
+		"Readlink":                                     reflect.ValueOf(syscall.Readlink),
+		"Recvfrom":                                     reflect.ValueOf(syscall.Recvfrom),
+		"RegCloseKey":                                  reflect.ValueOf(syscall.RegCloseKey),
+		"RegEnumKeyEx":                                 reflect.ValueOf(syscall.RegEnumKeyEx),
+		"RegOpenKeyEx":                                 reflect.ValueOf(syscall.RegOpenKeyEx),
+		"RegQueryInfoKey":                              reflect.ValueOf(syscall.RegQueryInfoKey),
+		"RegQueryValueEx":                              reflect.ValueOf(syscall.RegQueryValueEx),
+		"RemoveDirectory":                              reflect.ValueOf(syscall.RemoveDirectory),
+		"Rename":                                       reflect.ValueOf(syscall.Rename),
+		"Rmdir":                                        reflect.ValueOf(syscall.Rmdir),
+		"SHUT_RD":                                      reflect.ValueOf(syscall.SHUT_RD),
+		"SHUT_RDWR":                                    reflect.ValueOf(syscall.SHUT_RDWR),
+		"SHUT_WR":                                      reflect.ValueOf(syscall.SHUT_WR),
+		"SIGABRT":                                      reflect.ValueOf(syscall.SIGABRT),
+		"SIGALRM":                                      reflect.ValueOf(syscall.SIGALRM),
+		"SIGBUS":                                       reflect.ValueOf(syscall.SIGBUS),
+		"SIGFPE":                                       reflect.ValueOf(syscall.SIGFPE),
+		"SIGHUP":                                       reflect.ValueOf(syscall.SIGHUP),
+		"SIGILL":                                       reflect.ValueOf(syscall.SIGILL),
+		"SIGINT":                                       reflect.ValueOf(syscall.SIGINT),
+		"SIGKILL":                                      reflect.ValueOf(syscall.SIGKILL),
+		"SIGPIPE":                                      reflect.ValueOf(syscall.SIGPIPE),
+		"SIGQUIT":                                      reflect.ValueOf(syscall.SIGQUIT),
+		"SIGSEGV":                                      reflect.ValueOf(syscall.SIGSEGV),
+		"SIGTERM":                                      reflect.ValueOf(syscall.SIGTERM),
+		"SIGTRAP":                                      reflect.ValueOf(syscall.SIGTRAP),
+		"SIO_GET_EXTENSION_FUNCTION_POINTER":           reflect.ValueOf(uint32(syscall.SIO_GET_EXTENSION_FUNCTION_POINTER)),
+		"SIO_GET_INTERFACE_LIST":                       reflect.ValueOf(syscall.SIO_GET_INTERFACE_LIST),
+		"SIO_KEEPALIVE_VALS":                           reflect.ValueOf(uint32(syscall.SIO_KEEPALIVE_VALS)),
+		"SIO_UDP_CONNRESET":                            reflect.ValueOf(uint32(syscall.SIO_UDP_CONNRESET)),
+		"SOCK_DGRAM":                                   reflect.ValueOf(syscall.SOCK_DGRAM),
+		"SOCK_RAW":                                     reflect.ValueOf(syscall.SOCK_RAW),
+		"SOCK_SEQPACKET":                               reflect.ValueOf(syscall.SOCK_SEQPACKET),
+		"SOCK_STREAM":                                  reflect.ValueOf(syscall.SOCK_STREAM),
+		"SOL_SOCKET":                                   reflect.ValueOf(syscall.SOL_SOCKET),
+		"SOMAXCONN":                                    reflect.ValueOf(syscall.SOMAXCONN),
+		"SO_BROADCAST":                                 reflect.ValueOf(syscall.SO_BROADCAST),
+		"SO_DONTROUTE":                                 reflect.ValueOf(syscall.SO_DONTROUTE),
+		"SO_KEEPALIVE":                                 reflect.ValueOf(syscall.SO_KEEPALIVE),
+		"SO_LINGER":                                    reflect.ValueOf(syscall.SO_LINGER),
+		"SO_RCVBUF":                                    reflect.ValueOf(syscall.SO_RCVBUF),
+		"SO_REUSEADDR":                                 reflect.ValueOf(syscall.SO_REUSEADDR),
+		"SO_SNDBUF":                                    reflect.ValueOf(syscall.SO_SNDBUF),
+		"SO_UPDATE_ACCEPT_CONTEXT":                     reflect.ValueOf(syscall.SO_UPDATE_ACCEPT_CONTEXT),
+		"SO_UPDATE_CONNECT_CONTEXT":                    reflect.ValueOf(syscall.SO_UPDATE_CONNECT_CONTEXT),
+		"STANDARD_RIGHTS_ALL":                          reflect.ValueOf(syscall.STANDARD_RIGHTS_ALL),
+		"STANDARD_RIGHTS_EXECUTE":                      reflect.ValueOf(syscall.STANDARD_RIGHTS_EXECUTE),
+		"STANDARD_RIGHTS_READ":                         reflect.ValueOf(syscall.STANDARD_RIGHTS_READ),
+		"STANDARD_RIGHTS_REQUIRED":                     reflect.ValueOf(syscall.STANDARD_RIGHTS_REQUIRED),
+		"STANDARD_RIGHTS_WRITE":                        reflect.ValueOf(syscall.STANDARD_RIGHTS_WRITE),
+		"STARTF_USESHOWWINDOW":                         reflect.ValueOf(syscall.STARTF_USESHOWWINDOW),
+		"STARTF_USESTDHANDLES":                         reflect.ValueOf(syscall.STARTF_USESTDHANDLES),
+		"STD_ERROR_HANDLE":                             reflect.ValueOf(syscall.STD_ERROR_HANDLE),
+		"STD_INPUT_HANDLE":                             reflect.ValueOf(syscall.STD_INPUT_HANDLE),
+		"STD_OUTPUT_HANDLE":                            reflect.ValueOf(syscall.STD_OUTPUT_HANDLE),
+		"SUBLANG_ENGLISH_US":                           reflect.ValueOf(syscall.SUBLANG_ENGLISH_US),
+		"SW_FORCEMINIMIZE":                             reflect.ValueOf(syscall.SW_FORCEMINIMIZE),
+		"SW_HIDE":                                      reflect.ValueOf(syscall.SW_HIDE),
+		"SW_MAXIMIZE":                                  reflect.ValueOf(syscall.SW_MAXIMIZE),
+		"SW_MINIMIZE":                                  reflect.ValueOf(syscall.SW_MINIMIZE),
+		"SW_NORMAL":                                    reflect.ValueOf(syscall.SW_NORMAL),
+		"SW_RESTORE":                                   reflect.ValueOf(syscall.SW_RESTORE),
+		"SW_SHOW":                                      reflect.ValueOf(syscall.SW_SHOW),
+		"SW_SHOWDEFAULT":                               reflect.ValueOf(syscall.SW_SHOWDEFAULT),
+		"SW_SHOWMAXIMIZED":                             reflect.ValueOf(syscall.SW_SHOWMAXIMIZED),
+		"SW_SHOWMINIMIZED":                             reflect.ValueOf(syscall.SW_SHOWMINIMIZED),
+		"SW_SHOWMINNOACTIVE":                           reflect.ValueOf(syscall.SW_SHOWMINNOACTIVE),
+		"SW_SHOWNA":                                    reflect.ValueOf(syscall.SW_SHOWNA),
+		"SW_SHOWNOACTIVATE":                            reflect.ValueOf(syscall.SW_SHOWNOACTIVATE),
+		"SW_SHOWNORMAL":                                reflect.ValueOf(syscall.SW_SHOWNORMAL),
+		"SYMBOLIC_LINK_FLAG_DIRECTORY":                 reflect.ValueOf(syscall.SYMBOLIC_LINK_FLAG_DIRECTORY),
+		"SYNCHRONIZE":                                  reflect.ValueOf(syscall.SYNCHRONIZE),
+		"S_IFBLK":                                      reflect.ValueOf(syscall.S_IFBLK),
+		"S_IFCHR":                                      reflect.ValueOf(syscall.S_IFCHR),
+		"S_IFDIR":                                      reflect.ValueOf(syscall.S_IFDIR),
+		"S_IFIFO":                                      reflect.ValueOf(syscall.S_IFIFO),
+		"S_IFLNK":                                      reflect.ValueOf(syscall.S_IFLNK),
+		"S_IFMT":                                       reflect.ValueOf(syscall.S_IFMT),
+		"S_IFREG":                                      reflect.ValueOf(syscall.S_IFREG),
+		"S_IFSOCK":                                     reflect.ValueOf(syscall.S_IFSOCK),
+		"S_IRUSR":                                      reflect.ValueOf(syscall.S_IRUSR),
+		"S_ISGID":                                      reflect.ValueOf(syscall.S_ISGID),
+		"S_ISUID":                                      reflect.ValueOf(syscall.S_ISUID),
+		"S_ISVTX":                                      reflect.ValueOf(syscall.S_ISVTX),
+		"S_IWRITE":                                     reflect.ValueOf(syscall.S_IWRITE),
+		"S_IWUSR":                                      reflect.ValueOf(syscall.S_IWUSR),
+		"S_IXUSR":                                      reflect.ValueOf(syscall.S_IXUSR),
+		"Seek":                                         reflect.ValueOf(syscall.Seek),
+		"Sendto":                                       reflect.ValueOf(syscall.Sendto),
+		"SetCurrentDirectory":                          reflect.ValueOf(syscall.SetCurrentDirectory),
+		"SetEndOfFile":                                 reflect.ValueOf(syscall.SetEndOfFile),
+		"SetEnvironmentVariable":                       reflect.ValueOf(syscall.SetEnvironmentVariable),
+		"SetFileAttributes":                            reflect.ValueOf(syscall.SetFileAttributes),
+		"SetFileCompletionNotificationModes":           reflect.ValueOf(syscall.SetFileCompletionNotificationModes),
+		"SetFilePointer":                               reflect.ValueOf(syscall.SetFilePointer),
+		"SetFileTime":                                  reflect.ValueOf(syscall.SetFileTime),
+		"SetHandleInformation":                         reflect.ValueOf(syscall.SetHandleInformation),
+		"SetNonblock":                                  reflect.ValueOf(syscall.SetNonblock),
+		"Setenv":                                       reflect.ValueOf(syscall.Setenv),
+		"Setsockopt":                                   reflect.ValueOf(syscall.Setsockopt),
+		"SetsockoptIPMreq":                             reflect.ValueOf(syscall.SetsockoptIPMreq),
+		"SetsockoptIPv6Mreq":                           reflect.ValueOf(syscall.SetsockoptIPv6Mreq),
+		"SetsockoptInet4Addr":                          reflect.ValueOf(syscall.SetsockoptInet4Addr),
+		"SetsockoptInt":                                reflect.ValueOf(syscall.SetsockoptInt),
+		"SetsockoptLinger":                             reflect.ValueOf(syscall.SetsockoptLinger),
+		"SetsockoptTimeval":                            reflect.ValueOf(syscall.SetsockoptTimeval),
+		"Shutdown":                                     reflect.ValueOf(syscall.Shutdown),
+		"SidTypeAlias":                                 reflect.ValueOf(syscall.SidTypeAlias),
+		"SidTypeComputer":                              reflect.ValueOf(syscall.SidTypeComputer),
+		"SidTypeDeletedAccount":                        reflect.ValueOf(syscall.SidTypeDeletedAccount),
+		"SidTypeDomain":                                reflect.ValueOf(syscall.SidTypeDomain),
+		"SidTypeGroup":                                 reflect.ValueOf(syscall.SidTypeGroup),
+		"SidTypeInvalid":                               reflect.ValueOf(syscall.SidTypeInvalid),
+		"SidTypeLabel":                                 reflect.ValueOf(syscall.SidTypeLabel),
+		"SidTypeUnknown":                               reflect.ValueOf(syscall.SidTypeUnknown),
+		"SidTypeUser":                                  reflect.ValueOf(syscall.SidTypeUser),
+		"SidTypeWellKnownGroup":                        reflect.ValueOf(syscall.SidTypeWellKnownGroup),
+		"Socket":                                       reflect.ValueOf(syscall.Socket),
+		"SocketDisableIPv6":                            reflect.ValueOf(&syscall.SocketDisableIPv6).Elem(),
+		"StartProcess":                                 reflect.ValueOf(syscall.StartProcess),
+		"Stderr":                                       reflect.ValueOf(&syscall.Stderr).Elem(),
+		"Stdin":                                        reflect.ValueOf(&syscall.Stdin).Elem(),
+		"Stdout":                                       reflect.ValueOf(&syscall.Stdout).Elem(),
+		"StringBytePtr":                                reflect.ValueOf(syscall.StringBytePtr),
+		"StringByteSlice":                              reflect.ValueOf(syscall.StringByteSlice),
+		"StringToSid":                                  reflect.ValueOf(syscall.StringToSid),
+		"StringToUTF16":                                reflect.ValueOf(syscall.StringToUTF16),
+		"StringToUTF16Ptr":                             reflect.ValueOf(syscall.StringToUTF16Ptr),
+		"Symlink":                                      reflect.ValueOf(syscall.Symlink),
+		"Syscall":                                      reflect.ValueOf(syscall.Syscall),
+		"Syscall12":                                    reflect.ValueOf(syscall.Syscall12),
+		"Syscall15":                                    reflect.ValueOf(syscall.Syscall15),
+		"Syscall18":                                    reflect.ValueOf(syscall.Syscall18),
+		"Syscall6":                                     reflect.ValueOf(syscall.Syscall6),
+		"Syscall9":                                     reflect.ValueOf(syscall.Syscall9),
+		"SyscallN":                                     reflect.ValueOf(syscall.SyscallN),
+		"TCP_NODELAY":                                  reflect.ValueOf(syscall.TCP_NODELAY),
+		"TF_DISCONNECT":                                reflect.ValueOf(syscall.TF_DISCONNECT),
+		"TF_REUSE_SOCKET":                              reflect.ValueOf(syscall.TF_REUSE_SOCKET),
+		"TF_USE_DEFAULT_WORKER":                        reflect.ValueOf(syscall.TF_USE_DEFAULT_WORKER),
+		"TF_USE_KERNEL_APC":                            reflect.ValueOf(syscall.TF_USE_KERNEL_APC),
+		"TF_USE_SYSTEM_THREAD":                         reflect.ValueOf(syscall.TF_USE_SYSTEM_THREAD),
+		"TF_WRITE_BEHIND":                              reflect.ValueOf(syscall.TF_WRITE_BEHIND),
+		"TH32CS_INHERIT":                               reflect.ValueOf(uint32(syscall.TH32CS_INHERIT)),
+		"TH32CS_SNAPALL":                               reflect.ValueOf(syscall.TH32CS_SNAPALL),
+		"TH32CS_SNAPHEAPLIST":                          reflect.ValueOf(syscall.TH32CS_SNAPHEAPLIST),
+		"TH32CS_SNAPMODULE":                            reflect.ValueOf(syscall.TH32CS_SNAPMODULE),
+		"TH32CS_SNAPMODULE32":                          reflect.ValueOf(syscall.TH32CS_SNAPMODULE32),
+		"TH32CS_SNAPPROCESS":                           reflect.ValueOf(syscall.TH32CS_SNAPPROCESS),
+		"TH32CS_SNAPTHREAD":                            reflect.ValueOf(syscall.TH32CS_SNAPTHREAD),
+		"TIME_ZONE_ID_DAYLIGHT":                        reflect.ValueOf(syscall.TIME_ZONE_ID_DAYLIGHT),
+		"TIME_ZONE_ID_STANDARD":                        reflect.ValueOf(syscall.TIME_ZONE_ID_STANDARD),
+		"TIME_ZONE_ID_UNKNOWN":                         reflect.ValueOf(syscall.TIME_ZONE_ID_UNKNOWN),
+		"TOKEN_ADJUST_DEFAULT":                         reflect.ValueOf(syscall.TOKEN_ADJUST_DEFAULT),
+		"TOKEN_ADJUST_GROUPS":                          reflect.ValueOf(syscall.TOKEN_ADJUST_GROUPS),
+		"TOKEN_ADJUST_PRIVILEGES":                      reflect.ValueOf(syscall.TOKEN_ADJUST_PRIVILEGES),
+		"TOKEN_ADJUST_SESSIONID":                       reflect.ValueOf(syscall.TOKEN_ADJUST_SESSIONID),
+		"TOKEN_ALL_ACCESS":                             reflect.ValueOf(syscall.TOKEN_ALL_ACCESS),
+		"TOKEN_ASSIGN_PRIMARY":                         reflect.ValueOf(syscall.TOKEN_ASSIGN_PRIMARY),
+		"TOKEN_DUPLICATE":                              reflect.ValueOf(syscall.TOKEN_DUPLICATE),
+		"TOKEN_EXECUTE":                                reflect.ValueOf(syscall.TOKEN_EXECUTE),
+		"TOKEN_IMPERSONATE":                            reflect.ValueOf(syscall.TOKEN_IMPERSONATE),
+		"TOKEN_QUERY":                                  reflect.ValueOf(syscall.TOKEN_QUERY),
+		"TOKEN_QUERY_SOURCE":                           reflect.ValueOf(syscall.TOKEN_QUERY_SOURCE),
+		"TOKEN_READ":                                   reflect.ValueOf(syscall.TOKEN_READ),
+		"TOKEN_WRITE":                                  reflect.ValueOf(syscall.TOKEN_WRITE),
+		"TRUNCATE_EXISTING":                            reflect.ValueOf(syscall.TRUNCATE_EXISTING),
+		"TerminateProcess":                             reflect.ValueOf(syscall.TerminateProcess),
+		"TimespecToNsec":                               reflect.ValueOf(syscall.TimespecToNsec),
+		"TokenAccessInformation":                       reflect.ValueOf(syscall.TokenAccessInformation),
+		"TokenAuditPolicy":                             reflect.ValueOf(syscall.TokenAuditPolicy),
+		"TokenDefaultDacl":                             reflect.ValueOf(syscall.TokenDefaultDacl),
+		"TokenElevation":                               reflect.ValueOf(syscall.TokenElevation),
+		"TokenElevationType":                           reflect.ValueOf(syscall.TokenElevationType),
+		"TokenGroups":                                  reflect.ValueOf(syscall.TokenGroups),
+		"TokenGroupsAndPrivileges":                     reflect.ValueOf(syscall.TokenGroupsAndPrivileges),
+		"TokenHasRestrictions":                         reflect.ValueOf(syscall.TokenHasRestrictions),
+		"TokenImpersonationLevel":                      reflect.ValueOf(syscall.TokenImpersonationLevel),
+		"TokenIntegrityLevel":                          reflect.ValueOf(syscall.TokenIntegrityLevel),
+		"TokenLinkedToken":                             reflect.ValueOf(syscall.TokenLinkedToken),
+		"TokenLogonSid":                                reflect.ValueOf(syscall.TokenLogonSid),
+		"TokenMandatoryPolicy":                         reflect.ValueOf(syscall.TokenMandatoryPolicy),
+		"TokenOrigin":                                  reflect.ValueOf(syscall.TokenOrigin),
+		"TokenOwner":                                   reflect.ValueOf(syscall.TokenOwner),
+		"TokenPrimaryGroup":                            reflect.ValueOf(syscall.TokenPrimaryGroup),
+		"TokenPrivileges":                              reflect.ValueOf(syscall.TokenPrivileges),
+		"TokenRestrictedSids":                          reflect.ValueOf(syscall.TokenRestrictedSids),
+		"TokenSandBoxInert":                            reflect.ValueOf(syscall.TokenSandBoxInert),
+		"TokenSessionId":                               reflect.ValueOf(syscall.TokenSessionId),
+		"TokenSessionReference":                        reflect.ValueOf(syscall.TokenSessionReference),
+		"TokenSource":                                  reflect.ValueOf(syscall.TokenSource),
+		"TokenStatistics":                              reflect.ValueOf(syscall.TokenStatistics),
+		"TokenType":                                    reflect.ValueOf(syscall.TokenType),
+		"TokenUIAccess":                                reflect.ValueOf(syscall.TokenUIAccess),
+		"TokenUser":                                    reflect.ValueOf(syscall.TokenUser),
+		"TokenVirtualizationAllowed":                   reflect.ValueOf(syscall.TokenVirtualizationAllowed),
+		"TokenVirtualizationEnabled":                   reflect.ValueOf(syscall.TokenVirtualizationEnabled),
+		"TranslateAccountName":                         reflect.ValueOf(syscall.TranslateAccountName),
+		"TranslateName":                                reflect.ValueOf(syscall.TranslateName),
+		"TransmitFile":                                 reflect.ValueOf(syscall.TransmitFile),
+		"UNIX_PATH_MAX":                                reflect.ValueOf(syscall.UNIX_PATH_MAX),
+		"USAGE_MATCH_TYPE_AND":                         reflect.ValueOf(syscall.USAGE_MATCH_TYPE_AND),
+		"USAGE_MATCH_TYPE_OR":                          reflect.ValueOf(syscall.USAGE_MATCH_TYPE_OR),
+		"UTF16FromString":                              reflect.ValueOf(syscall.UTF16FromString),
+		"UTF16PtrFromString":                           reflect.ValueOf(syscall.UTF16PtrFromString),
+		"UTF16ToString":                                reflect.ValueOf(syscall.UTF16ToString),
+		"Unlink":                                       reflect.ValueOf(syscall.Unlink),
+		"UnmapViewOfFile":                              reflect.ValueOf(syscall.UnmapViewOfFile),
+		"Unsetenv":                                     reflect.ValueOf(syscall.Unsetenv),
+		"Utimes":                                       reflect.ValueOf(syscall.Utimes),
+		"UtimesNano":                                   reflect.ValueOf(syscall.UtimesNano),
+		"VirtualLock":                                  reflect.ValueOf(syscall.VirtualLock),
+		"VirtualUnlock":                                reflect.ValueOf(syscall.VirtualUnlock),
+		"WAIT_ABANDONED":                               reflect.ValueOf(syscall.WAIT_ABANDONED),
+		"WAIT_FAILED":                                  reflect.ValueOf(uint32(syscall.WAIT_FAILED)),
+		"WAIT_OBJECT_0":                                reflect.ValueOf(syscall.WAIT_OBJECT_0),
+		"WAIT_TIMEOUT":                                 reflect.ValueOf(syscall.WAIT_TIMEOUT),
+		"WSACleanup":                                   reflect.ValueOf(syscall.WSACleanup),
+		"WSADESCRIPTION_LEN":                           reflect.ValueOf(syscall.WSADESCRIPTION_LEN),
+		"WSAEACCES":                                    reflect.ValueOf(syscall.WSAEACCES),
+		"WSAECONNABORTED":                              reflect.ValueOf(syscall.WSAECONNABORTED),
+		"WSAECONNRESET":                                reflect.ValueOf(syscall.WSAECONNRESET),
+		"WSAENOPROTOOPT":                               reflect.ValueOf(syscall.WSAENOPROTOOPT),
+		"WSAEnumProtocols":                             reflect.ValueOf(syscall.WSAEnumProtocols),
+		"WSAID_CONNECTEX":                              reflect.ValueOf(&syscall.WSAID_CONNECTEX).Elem(),
+		"WSAIoctl":                                     reflect.ValueOf(syscall.WSAIoctl),
+		"WSAPROTOCOL_LEN":                              reflect.ValueOf(syscall.WSAPROTOCOL_LEN),
+		"WSARecv":                                      reflect.ValueOf(syscall.WSARecv),
+		"WSARecvFrom":                                  reflect.ValueOf(syscall.WSARecvFrom),
+		"WSASYS_STATUS_LEN":                            reflect.ValueOf(syscall.WSASYS_STATUS_LEN),
+		"WSASend":                                      reflect.ValueOf(syscall.WSASend),
+		"WSASendTo":                                    reflect.ValueOf(syscall.WSASendTo),
+		"WSASendto":                                    reflect.ValueOf(syscall.WSASendto),
+		"WSAStartup":                                   reflect.ValueOf(syscall.WSAStartup),
+		"WaitForSingleObject":                          reflect.ValueOf(syscall.WaitForSingleObject),
+		"Write":                                        reflect.ValueOf(syscall.Write),
+		"WriteConsole":                                 reflect.ValueOf(syscall.WriteConsole),
+		"WriteFile":                                    reflect.ValueOf(syscall.WriteFile),
+		"X509_ASN_ENCODING":                            reflect.ValueOf(syscall.X509_ASN_ENCODING),
+		"XP1_CONNECTIONLESS":                           reflect.ValueOf(syscall.XP1_CONNECTIONLESS),
+		"XP1_CONNECT_DATA":                             reflect.ValueOf(syscall.XP1_CONNECT_DATA),
+		"XP1_DISCONNECT_DATA":                          reflect.ValueOf(syscall.XP1_DISCONNECT_DATA),
+		"XP1_EXPEDITED_DATA":                           reflect.ValueOf(syscall.XP1_EXPEDITED_DATA),
+		"XP1_GRACEFUL_CLOSE":                           reflect.ValueOf(syscall.XP1_GRACEFUL_CLOSE),
+		"XP1_GUARANTEED_DELIVERY":                      reflect.ValueOf(syscall.XP1_GUARANTEED_DELIVERY),
+		"XP1_GUARANTEED_ORDER":                         reflect.ValueOf(syscall.XP1_GUARANTEED_ORDER),
+		"XP1_IFS_HANDLES":                              reflect.ValueOf(syscall.XP1_IFS_HANDLES),
+		"XP1_MESSAGE_ORIENTED":                         reflect.ValueOf(syscall.XP1_MESSAGE_ORIENTED),
+		"XP1_MULTIPOINT_CONTROL_PLANE":                 reflect.ValueOf(syscall.XP1_MULTIPOINT_CONTROL_PLANE),
+		"XP1_MULTIPOINT_DATA_PLANE":                    reflect.ValueOf(syscall.XP1_MULTIPOINT_DATA_PLANE),
+		"XP1_PARTIAL_MESSAGE":                          reflect.ValueOf(syscall.XP1_PARTIAL_MESSAGE),
+		"XP1_PSEUDO_STREAM":                            reflect.ValueOf(syscall.XP1_PSEUDO_STREAM),
+		"XP1_QOS_SUPPORTED":                            reflect.ValueOf(syscall.XP1_QOS_SUPPORTED),
+		"XP1_SAN_SUPPORT_SDP":                          reflect.ValueOf(syscall.XP1_SAN_SUPPORT_SDP),
+		"XP1_SUPPORT_BROADCAST":                        reflect.ValueOf(syscall.XP1_SUPPORT_BROADCAST),
+		"XP1_SUPPORT_MULTIPOINT":                       reflect.ValueOf(syscall.XP1_SUPPORT_MULTIPOINT),
+		"XP1_UNI_RECV":                                 reflect.ValueOf(syscall.XP1_UNI_RECV),
+		"XP1_UNI_SEND":                                 reflect.ValueOf(syscall.XP1_UNI_SEND),
+
+		// type definitions
+		"AddrinfoW":                   reflect.ValueOf((*syscall.AddrinfoW)(nil)),
+		"ByHandleFileInformation":     reflect.ValueOf((*syscall.ByHandleFileInformation)(nil)),
+		"CertChainContext":            reflect.ValueOf((*syscall.CertChainContext)(nil)),
+		"CertChainElement":            reflect.ValueOf((*syscall.CertChainElement)(nil)),
+		"CertChainPara":               reflect.ValueOf((*syscall.CertChainPara)(nil)),
+		"CertChainPolicyPara":         reflect.ValueOf((*syscall.CertChainPolicyPara)(nil)),
+		"CertChainPolicyStatus":       reflect.ValueOf((*syscall.CertChainPolicyStatus)(nil)),
+		"CertContext":                 reflect.ValueOf((*syscall.CertContext)(nil)),
+		"CertEnhKeyUsage":             reflect.ValueOf((*syscall.CertEnhKeyUsage)(nil)),
+		"CertInfo":                    reflect.ValueOf((*syscall.CertInfo)(nil)),
+		"CertRevocationCrlInfo":       reflect.ValueOf((*syscall.CertRevocationCrlInfo)(nil)),
+		"CertRevocationInfo":          reflect.ValueOf((*syscall.CertRevocationInfo)(nil)),
+		"CertSimpleChain":             reflect.ValueOf((*syscall.CertSimpleChain)(nil)),
+		"CertTrustListInfo":           reflect.ValueOf((*syscall.CertTrustListInfo)(nil)),
+		"CertTrustStatus":             reflect.ValueOf((*syscall.CertTrustStatus)(nil)),
+		"CertUsageMatch":              reflect.ValueOf((*syscall.CertUsageMatch)(nil)),
+		"Conn":                        reflect.ValueOf((*syscall.Conn)(nil)),
+		"DLL":                         reflect.ValueOf((*syscall.DLL)(nil)),
+		"DLLError":                    reflect.ValueOf((*syscall.DLLError)(nil)),
+		"DNSMXData":                   reflect.ValueOf((*syscall.DNSMXData)(nil)),
+		"DNSPTRData":                  reflect.ValueOf((*syscall.DNSPTRData)(nil)),
+		"DNSRecord":                   reflect.ValueOf((*syscall.DNSRecord)(nil)),
+		"DNSSRVData":                  reflect.ValueOf((*syscall.DNSSRVData)(nil)),
+		"DNSTXTData":                  reflect.ValueOf((*syscall.DNSTXTData)(nil)),
+		"Errno":                       reflect.ValueOf((*syscall.Errno)(nil)),
+		"FileNotifyInformation":       reflect.ValueOf((*syscall.FileNotifyInformation)(nil)),
+		"Filetime":                    reflect.ValueOf((*syscall.Filetime)(nil)),
+		"GUID":                        reflect.ValueOf((*syscall.GUID)(nil)),
+		"Handle":                      reflect.ValueOf((*syscall.Handle)(nil)),
+		"Hostent":                     reflect.ValueOf((*syscall.Hostent)(nil)),
+		"IPMreq":                      reflect.ValueOf((*syscall.IPMreq)(nil)),
+		"IPv6Mreq":                    reflect.ValueOf((*syscall.IPv6Mreq)(nil)),
+		"InterfaceInfo":               reflect.ValueOf((*syscall.InterfaceInfo)(nil)),
+		"IpAdapterInfo":               reflect.ValueOf((*syscall.IpAdapterInfo)(nil)),
+		"IpAddrString":                reflect.ValueOf((*syscall.IpAddrString)(nil)),
+		"IpAddressString":             reflect.ValueOf((*syscall.IpAddressString)(nil)),
+		"IpMaskString":                reflect.ValueOf((*syscall.IpMaskString)(nil)),
+		"LazyDLL":                     reflect.ValueOf((*syscall.LazyDLL)(nil)),
+		"LazyProc":                    reflect.ValueOf((*syscall.LazyProc)(nil)),
+		"Linger":                      reflect.ValueOf((*syscall.Linger)(nil)),
+		"MibIfRow":                    reflect.ValueOf((*syscall.MibIfRow)(nil)),
+		"Overlapped":                  reflect.ValueOf((*syscall.Overlapped)(nil)),
+		"Pointer":                     reflect.ValueOf((*syscall.Pointer)(nil)),
+		"Proc":                        reflect.ValueOf((*syscall.Proc)(nil)),
+		"ProcAttr":                    reflect.ValueOf((*syscall.ProcAttr)(nil)),
+		"ProcessEntry32":              reflect.ValueOf((*syscall.ProcessEntry32)(nil)),
+		"ProcessInformation":          reflect.ValueOf((*syscall.ProcessInformation)(nil)),
+		"Protoent":                    reflect.ValueOf((*syscall.Protoent)(nil)),
+		"RawConn":                     reflect.ValueOf((*syscall.RawConn)(nil)),
+		"RawSockaddr":                 reflect.ValueOf((*syscall.RawSockaddr)(nil)),
+		"RawSockaddrAny":              reflect.ValueOf((*syscall.RawSockaddrAny)(nil)),
+		"RawSockaddrInet4":            reflect.ValueOf((*syscall.RawSockaddrInet4)(nil)),
+		"RawSockaddrInet6":            reflect.ValueOf((*syscall.RawSockaddrInet6)(nil)),
+		"RawSockaddrUnix":             reflect.ValueOf((*syscall.RawSockaddrUnix)(nil)),
+		"Rusage":                      reflect.ValueOf((*syscall.Rusage)(nil)),
+		"SID":                         reflect.ValueOf((*syscall.SID)(nil)),
+		"SIDAndAttributes":            reflect.ValueOf((*syscall.SIDAndAttributes)(nil)),
+		"SSLExtraCertChainPolicyPara": reflect.ValueOf((*syscall.SSLExtraCertChainPolicyPara)(nil)),
+		"SecurityAttributes":          reflect.ValueOf((*syscall.SecurityAttributes)(nil)),
+		"Servent":                     reflect.ValueOf((*syscall.Servent)(nil)),
+		"Signal":                      reflect.ValueOf((*syscall.Signal)(nil)),
+		"Sockaddr":                    reflect.ValueOf((*syscall.Sockaddr)(nil)),
+		"SockaddrGen":                 reflect.ValueOf((*syscall.SockaddrGen)(nil)),
+		"SockaddrInet4":               reflect.ValueOf((*syscall.SockaddrInet4)(nil)),
+		"SockaddrInet6":               reflect.ValueOf((*syscall.SockaddrInet6)(nil)),
+		"SockaddrUnix":                reflect.ValueOf((*syscall.SockaddrUnix)(nil)),
+		"StartupInfo":                 reflect.ValueOf((*syscall.StartupInfo)(nil)),
+		"SysProcAttr":                 reflect.ValueOf((*syscall.SysProcAttr)(nil)),
+		"Systemtime":                  reflect.ValueOf((*syscall.Systemtime)(nil)),
+		"TCPKeepalive":                reflect.ValueOf((*syscall.TCPKeepalive)(nil)),
+		"Timespec":                    reflect.ValueOf((*syscall.Timespec)(nil)),
+		"Timeval":                     reflect.ValueOf((*syscall.Timeval)(nil)),
+		"Timezoneinformation":         reflect.ValueOf((*syscall.Timezoneinformation)(nil)),
+		"Token":                       reflect.ValueOf((*syscall.Token)(nil)),
+		"Tokenprimarygroup":           reflect.ValueOf((*syscall.Tokenprimarygroup)(nil)),
+		"Tokenuser":                   reflect.ValueOf((*syscall.Tokenuser)(nil)),
+		"TransmitFileBuffers":         reflect.ValueOf((*syscall.TransmitFileBuffers)(nil)),
+		"UserInfo10":                  reflect.ValueOf((*syscall.UserInfo10)(nil)),
+		"WSABuf":                      reflect.ValueOf((*syscall.WSABuf)(nil)),
+		"WSAData":                     reflect.ValueOf((*syscall.WSAData)(nil)),
+		"WSAProtocolChain":            reflect.ValueOf((*syscall.WSAProtocolChain)(nil)),
+		"WSAProtocolInfo":             reflect.ValueOf((*syscall.WSAProtocolInfo)(nil)),
+		"WaitStatus":                  reflect.ValueOf((*syscall.WaitStatus)(nil)),
+		"Win32FileAttributeData":      reflect.ValueOf((*syscall.Win32FileAttributeData)(nil)),
+		"Win32finddata":               reflect.ValueOf((*syscall.Win32finddata)(nil)),
+
+		// interface wrapper definitions
+		"_Conn":     reflect.ValueOf((*_syscall_Conn)(nil)),
+		"_RawConn":  reflect.ValueOf((*_syscall_RawConn)(nil)),
+		"_Sockaddr": reflect.ValueOf((*_syscall_Sockaddr)(nil)),
+	}
+}
+
+// _syscall_Conn is an interface wrapper for Conn type
+type _syscall_Conn struct {
+	WSyscallConn func() (syscall.RawConn, error)
+}
+
+func (W _syscall_Conn) SyscallConn() (syscall.RawConn, error) { return W.WSyscallConn() }
+
+// _syscall_RawConn is an interface wrapper for RawConn type
+type _syscall_RawConn struct {
+	WControl func(f func(fd uintptr)) error
+	WRead    func(f func(fd uintptr) (done bool)) error
+	WWrite   func(f func(fd uintptr) (done bool)) error
+}
+
+func (W _syscall_RawConn) Control(f func(fd uintptr)) error           { return W.WControl(f) }
+func (W _syscall_RawConn) Read(f func(fd uintptr) (done bool)) error  { return W.WRead(f) }
+func (W _syscall_RawConn) Write(f func(fd uintptr) (done bool)) error { return W.WWrite(f) }
+
+// _syscall_Sockaddr is an interface wrapper for Sockaddr type
+type _syscall_Sockaddr struct {
+	syscall.Sockaddr
+}
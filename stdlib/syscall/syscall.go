@@ -1,4 +1,5 @@
-// +build go1.11,!go1.13
+//go:build go1.26 && !go1.28
+// +build go1.26,!go1.28
 
 package syscall
 
@@ -14,3 +15,7 @@ func init() {
 }
 
 //go:generate ../../cmd/goexports/goexports syscall
+
+// The symbols of syscall/js, to access the JavaScript host environment from a
+// yaegi compiled to WebAssembly, are only generated for GOOS=js GOARCH=wasm,
+// by: make gen_all_syscall
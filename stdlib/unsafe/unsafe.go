@@ -1,4 +1,5 @@
-// +build go1.11,!go1.13
+//go:build go1.26 && !go1.28
+// +build go1.26,!go1.28
 
 package unsafe
 